@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+	"x/shared/response"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ============================================================================
+// ACCOUNT RESTRICTION HANDLERS
+// ============================================================================
+
+type PlaceAccountRestrictionDTO struct {
+	Type      string           `json:"type"`             // debit_freeze, credit_freeze, full_freeze or lien
+	Amount    *decimal.Decimal `json:"amount,omitempty"` // Required for a lien
+	Reason    string           `json:"reason"`
+	Reference *string          `json:"reference,omitempty"`
+	ExpiresAt *time.Time       `json:"expires_at,omitempty"` // Omit to keep until released
+}
+
+type ReleaseAccountRestrictionDTO struct {
+	Reason string `json:"reason"`
+}
+
+type CloseAccountDTO struct {
+	SweepToAccountNumber string  `json:"sweep_to_account_number"` // Required unless the balance is zero
+	Reason               string  `json:"reason"`
+	Reference            *string `json:"reference,omitempty"`
+}
+
+// GET /admin/svc/accounting/restrictions?account_number=&type=&active_only=&limit=&offset=
+// GET /admin/svc/accounting/accounts/{number}/restrictions
+func (h *AdminHandler) ListAccountRestrictions(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	req := &accountingpb.ListAccountRestrictionsRequest{}
+	if accountNumber := r.PathValue("number"); accountNumber != "" {
+		req.AccountNumber = &accountNumber
+	} else if accountNumber := q.Get("account_number"); accountNumber != "" {
+		req.AccountNumber = &accountNumber
+	}
+	if restrictionType := q.Get("type"); restrictionType != "" {
+		req.Type = &restrictionType
+	}
+	if activeStr := q.Get("active_only"); activeStr != "" {
+		active, err := strconv.ParseBool(activeStr)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid active_only flag")
+			return
+		}
+		req.ActiveOnly = active
+	}
+	if limitStr := q.Get("limit"); limitStr != "" {
+		limit, err := strconv.ParseInt(limitStr, 10, 32)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		req.Limit = int32(limit)
+	}
+	if offsetStr := q.Get("offset"); offsetStr != "" {
+		offset, err := strconv.ParseInt(offsetStr, 10, 32)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+		req.Offset = int32(offset)
+	}
+
+	resp, err := h.accountingClient.Client.ListAccountRestrictions(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to list account restrictions: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// POST /admin/svc/accounting/accounts/{number}/restrictions
+func (h *AdminHandler) PlaceAccountRestriction(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can restrict accounts")
+		return
+	}
+
+	accountNumber := r.PathValue("number")
+	if accountNumber == "" {
+		response.Error(w, http.StatusBadRequest, "account number required")
+		return
+	}
+
+	var dto PlaceAccountRestrictionDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if dto.Type == "" || dto.Reason == "" {
+		response.Error(w, http.StatusBadRequest, "type and reason are required")
+		return
+	}
+
+	req := &accountingpb.PlaceAccountRestrictionRequest{
+		AccountNumber: accountNumber,
+		Type:          dto.Type,
+		Reason:        dto.Reason,
+		Reference:     dto.Reference,
+		CreatedBy:     userID,
+	}
+	if dto.Amount != nil {
+		amount := dto.Amount.String()
+		req.Amount = &amount
+	}
+	if dto.ExpiresAt != nil {
+		req.ExpiresAt = timestamppb.New(*dto.ExpiresAt)
+	}
+
+	resp, err := h.accountingClient.Client.PlaceAccountRestriction(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to place account restriction: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, resp)
+}
+
+// POST /admin/svc/accounting/restrictions/{id}/release
+func (h *AdminHandler) ReleaseAccountRestriction(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can release account restrictions")
+		return
+	}
+
+	restrictionID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || restrictionID <= 0 {
+		response.Error(w, http.StatusBadRequest, "invalid restriction id")
+		return
+	}
+
+	var dto ReleaseAccountRestrictionDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if dto.Reason == "" {
+		response.Error(w, http.StatusBadRequest, "reason is required")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.ReleaseAccountRestriction(r.Context(), &accountingpb.ReleaseAccountRestrictionRequest{
+		RestrictionId: restrictionID,
+		Reason:        dto.Reason,
+		ReleasedBy:    userID,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to release account restriction: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// POST /admin/svc/accounting/accounts/{number}/close
+func (h *AdminHandler) CloseAccount(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can close accounts")
+		return
+	}
+
+	accountNumber := r.PathValue("number")
+	if accountNumber == "" {
+		response.Error(w, http.StatusBadRequest, "account number required")
+		return
+	}
+
+	var dto CloseAccountDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if dto.Reason == "" {
+		response.Error(w, http.StatusBadRequest, "reason is required")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.CloseAccount(r.Context(), &accountingpb.CloseAccountRequest{
+		AccountNumber:        accountNumber,
+		SweepToAccountNumber: dto.SweepToAccountNumber,
+		Reason:               dto.Reason,
+		Reference:            dto.Reference,
+		ClosedBy:             userID,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to close account: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+	"x/shared/response"
+
+	"github.com/shopspring/decimal"
+)
+
+// ============================================================================
+// APPROVAL POLICY HANDLERS
+// ============================================================================
+
+type ApprovalPolicyDTO struct {
+	Name                string           `json:"name"`
+	TransactionType     string           `json:"transaction_type,omitempty"` // Empty = any type
+	Currency            string           `json:"currency,omitempty"`         // Empty = any currency
+	MinAmount           decimal.Decimal  `json:"min_amount"`
+	MaxAmount           *decimal.Decimal `json:"max_amount,omitempty"` // Exclusive; omitted = unbounded
+	RequiredApprovals   int32            `json:"required_approvals"`
+	ApproverRoles       []string         `json:"approver_roles"` // Empty = any admin role
+	DistinctRoles       bool             `json:"distinct_roles"`
+	ExpiresAfterSeconds int64            `json:"expires_after_seconds"` // 0 = never
+	Priority            int32            `json:"priority"`
+	IsActive            *bool            `json:"is_active,omitempty"` // Defaults to true
+}
+
+func (dto *ApprovalPolicyDTO) toProto(id int64) *accountingpb.ApprovalPolicy {
+	policy := &accountingpb.ApprovalPolicy{
+		Id:                  id,
+		Name:                dto.Name,
+		MinAmount:           dto.MinAmount.String(),
+		RequiredApprovals:   dto.RequiredApprovals,
+		ApproverRoles:       dto.ApproverRoles,
+		DistinctRoles:       dto.DistinctRoles,
+		ExpiresAfterSeconds: dto.ExpiresAfterSeconds,
+		Priority:            dto.Priority,
+		IsActive:            dto.IsActive == nil || *dto.IsActive,
+	}
+	if dto.TransactionType != "" {
+		txType := mapTransactionType(dto.TransactionType)
+		policy.TransactionType = &txType
+	}
+	if dto.Currency != "" {
+		policy.Currency = &dto.Currency
+	}
+	if dto.MaxAmount != nil {
+		maxAmount := dto.MaxAmount.String()
+		policy.MaxAmount = &maxAmount
+	}
+	return policy
+}
+
+// GET /admin/svc/accounting/approval-policies?transaction_type=&currency=&active_only=
+func (h *AdminHandler) ListApprovalPolicies(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	req := &accountingpb.ListApprovalPoliciesRequest{}
+	if txType := q.Get("transaction_type"); txType != "" {
+		t := mapTransactionType(txType)
+		req.TransactionType = &t
+	}
+	if currency := q.Get("currency"); currency != "" {
+		req.Currency = &currency
+	}
+	if activeStr := q.Get("active_only"); activeStr != "" {
+		active, err := strconv.ParseBool(activeStr)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid active_only flag")
+			return
+		}
+		req.ActiveOnly = active
+	}
+
+	resp, err := h.accountingClient.Client.ListApprovalPolicies(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to list approval policies: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// POST /admin/svc/accounting/approval-policies
+func (h *AdminHandler) CreateApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can manage approval policies")
+		return
+	}
+
+	var dto ApprovalPolicyDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if dto.Name == "" || dto.RequiredApprovals < 1 {
+		response.Error(w, http.StatusBadRequest, "name and required_approvals (>= 1) are required")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.CreateApprovalPolicy(r.Context(), &accountingpb.CreateApprovalPolicyRequest{
+		Policy:    dto.toProto(0),
+		CreatedBy: userID,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to create approval policy: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, resp)
+}
+
+// PUT /admin/svc/accounting/approval-policies/{id}
+func (h *AdminHandler) UpdateApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can manage approval policies")
+		return
+	}
+
+	policyID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || policyID <= 0 {
+		response.Error(w, http.StatusBadRequest, "invalid policy id")
+		return
+	}
+
+	var dto ApprovalPolicyDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.UpdateApprovalPolicy(r.Context(), &accountingpb.UpdateApprovalPolicyRequest{
+		Policy:    dto.toProto(policyID),
+		UpdatedBy: userID,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to update approval policy: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
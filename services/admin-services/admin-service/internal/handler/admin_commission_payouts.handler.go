@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+	"x/shared/response"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ============================================================================
+// AGENT COMMISSION PAYOUT HANDLERS
+// ============================================================================
+
+var commissionPayoutStatuses = map[string]accountingpb.CommissionPayoutStatus{
+	"pending": accountingpb.CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_PENDING,
+	"paid":    accountingpb.CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_PAID,
+	"failed":  accountingpb.CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_FAILED,
+}
+
+type CommissionPayoutBatchDTO struct {
+	Currency        *string    `json:"currency,omitempty"`
+	PeriodFrom      *time.Time `json:"period_from,omitempty"`       // RFC3339
+	PeriodTo        *time.Time `json:"period_to,omitempty"`         // RFC3339, exclusive; defaults to now
+	MinPayoutAmount *string    `json:"min_payout_amount,omitempty"` // Smaller totals stay unpaid
+	DryRun          bool       `json:"dry_run"`
+}
+
+// POST /api/admin/agents/{agent_id}/commissions/payouts
+func (h *AdminHandler) CreateCommissionPayoutBatch(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	agentID := r.PathValue("agent_id")
+	if agentID == "" {
+		response.Error(w, http.StatusBadRequest, "agent_id required")
+		return
+	}
+
+	var dto CommissionPayoutBatchDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	// Anyone may preview; moving money is restricted
+	if !dto.DryRun && !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can pay out commissions")
+		return
+	}
+
+	req := &accountingpb.CreateCommissionPayoutBatchRequest{
+		AgentExternalId: &agentID,
+		MinPayoutAmount: dto.MinPayoutAmount,
+		DryRun:          dto.DryRun,
+		CreatedBy:       userID,
+	}
+	if dto.Currency != nil && *dto.Currency != "" {
+		currency := strings.ToUpper(*dto.Currency)
+		req.Currency = &currency
+	}
+	if dto.PeriodFrom != nil {
+		req.PeriodFrom = timestamppb.New(*dto.PeriodFrom)
+	}
+	if dto.PeriodTo != nil {
+		req.PeriodTo = timestamppb.New(*dto.PeriodTo)
+	}
+
+	resp, err := h.accountingClient.Client.CreateCommissionPayoutBatch(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to create commission payout: "+err.Error())
+		return
+	}
+
+	status := http.StatusCreated
+	if resp.DryRun {
+		status = http.StatusOK
+	}
+	response.JSON(w, status, resp)
+}
+
+// GET /api/admin/agents/{agent_id}/commissions/payouts?status=paid&currency=&batch_id=&limit=&offset=
+func (h *AdminHandler) ListCommissionPayouts(w http.ResponseWriter, r *http.Request) {
+	agentID := r.PathValue("agent_id")
+	if agentID == "" {
+		response.Error(w, http.StatusBadRequest, "agent_id required")
+		return
+	}
+
+	q := r.URL.Query()
+	req := &accountingpb.ListCommissionPayoutsRequest{
+		AgentExternalId: &agentID,
+		Limit:           50,
+		Offset:          0,
+	}
+
+	if statusStr := q.Get("status"); statusStr != "" {
+		status, ok := commissionPayoutStatuses[strings.ToLower(statusStr)]
+		if !ok {
+			response.Error(w, http.StatusBadRequest, "invalid status")
+			return
+		}
+		req.Status = &status
+	}
+	if currency := q.Get("currency"); currency != "" {
+		currency = strings.ToUpper(currency)
+		req.Currency = &currency
+	}
+	if batchID := q.Get("batch_id"); batchID != "" {
+		req.BatchId = &batchID
+	}
+	if limitStr := q.Get("limit"); limitStr != "" {
+		if l, err := strconv.ParseInt(limitStr, 10, 32); err == nil {
+			req.Limit = int32(l)
+		}
+	}
+	if offsetStr := q.Get("offset"); offsetStr != "" {
+		if o, err := strconv.ParseInt(offsetStr, 10, 32); err == nil {
+			req.Offset = int32(o)
+		}
+	}
+
+	resp, err := h.accountingClient.Client.ListCommissionPayouts(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to list commission payouts: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
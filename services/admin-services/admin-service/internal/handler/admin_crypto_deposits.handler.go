@@ -398,6 +398,10 @@ func stringToDepositStatusEnum(status string) cryptopb.DepositStatus {
 		return cryptopb.DepositStatus_DEPOSIT_STATUS_CREDITED
 	case "FAILED":
 		return cryptopb.DepositStatus_DEPOSIT_STATUS_FAILED
+	case "ORPHANED":
+		return cryptopb.DepositStatus_DEPOSIT_STATUS_ORPHANED
+	case "FROZEN":
+		return cryptopb.DepositStatus_DEPOSIT_STATUS_FROZEN
 	default:
 		return cryptopb.DepositStatus_DEPOSIT_STATUS_UNSPECIFIED
 	}
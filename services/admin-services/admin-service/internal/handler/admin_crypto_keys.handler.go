@@ -0,0 +1,98 @@
+// internal/handler/admin_crypto_keys.handler.go
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	cryptopb "x/shared/genproto/shared/accounting/cryptopb"
+	"x/shared/response"
+
+	"go.uber.org/zap"
+)
+
+// ============================================================================
+// WALLET KEY ENCRYPTION (Master-key rotation)
+// ============================================================================
+
+// RotateMasterKey activates a new key-encryption key version and re-wraps
+// every wallet data key with it in the background
+// POST /admin/svc/crypto/system/rotate-master-key
+func (h *AdminHandler) RotateMasterKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can rotate the master key")
+		return
+	}
+
+	var req struct {
+		ToVersion int32 `json:"to_version"` // KEK version already stored in the vault
+		BatchSize int32 `json:"batch_size"` // Optional, default 100
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if req.ToVersion <= 0 {
+		h.respondError(w, http.StatusBadRequest, "to_version is required", nil)
+		return
+	}
+
+	startedBy := userID + " (" + role + ")"
+
+	h.logger.Info("Admin rotating master key",
+		zap.Int32("to_version", req.ToVersion),
+		zap.Int32("batch_size", req.BatchSize),
+		zap.String("started_by", startedBy))
+
+	resp, err := h.cryptoClient.KeyManagementClient.RotateMasterKey(ctx, &cryptopb.RotateMasterKeyRequest{
+		ToVersion: req.ToVersion,
+		BatchSize: req.BatchSize,
+		StartedBy: startedBy,
+	})
+	if err != nil {
+		h.logger.Error("Failed to start key rotation", zap.Error(err))
+		h.respondError(w, http.StatusBadRequest, "Failed to start key rotation", err)
+		return
+	}
+
+	h.respondJSON(w, http.StatusAccepted, map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"rotation": resp.Rotation,
+		},
+	})
+}
+
+// GetKeyRotationStatus returns a rotation's progress and the state of every
+// key-encryption key version
+// GET /admin/svc/crypto/system/key-rotation?rotation_id=
+func (h *AdminHandler) GetKeyRotationStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, err := h.cryptoClient.KeyManagementClient.GetKeyRotationStatus(ctx, &cryptopb.GetKeyRotationStatusRequest{
+		RotationId: r.URL.Query().Get("rotation_id"),
+	})
+	if err != nil {
+		h.logger.Error("Failed to get key rotation status", zap.Error(err))
+		h.respondError(w, http.StatusInternalServerError, "Failed to get key rotation status", err)
+		return
+	}
+
+	h.respondJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"rotation":       resp.Rotation,
+			"keys":           resp.Keys,
+			"active_version": resp.ActiveVersion,
+		},
+	})
+}
@@ -0,0 +1,437 @@
+// internal/handler/admin_crypto_screening.handler.go
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	cryptopb "x/shared/genproto/shared/accounting/cryptopb"
+	"x/shared/response"
+
+	"go.uber.org/zap"
+)
+
+// ============================================================================
+// ADDRESS LISTS (Blacklist / Whitelist)
+// ============================================================================
+
+// ListScreeningEntries lists blacklist / whitelist entries
+// GET /admin/svc/crypto/screening/entries?list_type=&chain=&list_name=&address=&include_inactive=
+func (h *AdminHandler) ListScreeningEntries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	page := parseIntQuery(r, "page", 1)
+	pageSize := parseIntQuery(r, "page_size", 50)
+	if pageSize > 200 {
+		pageSize = 200
+	}
+
+	resp, err := h.cryptoClient.ScreeningClient.ListAddressListEntries(ctx, &cryptopb.ListAddressListEntriesRequest{
+		ListType:        stringToAddressListTypeEnum(query.Get("list_type")),
+		Chain:           query.Get("chain"),
+		ListName:        query.Get("list_name"),
+		Address:         query.Get("address"),
+		IncludeInactive: query.Get("include_inactive") == "true",
+		Pagination: &cryptopb.PaginationRequest{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		},
+	})
+	if err != nil {
+		h.logger.Error("Failed to list screening entries", zap.Error(err))
+		h.respondError(w, http.StatusInternalServerError, "Failed to list screening entries", err)
+		return
+	}
+
+	h.respondJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"entries":    resp.Entries,
+			"pagination": resp.Pagination,
+		},
+	})
+}
+
+// AddScreeningEntry adds an address to the blacklist or whitelist
+// POST /admin/svc/crypto/screening/entries
+func (h *AdminHandler) AddScreeningEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req struct {
+		ListType string `json:"list_type"` // blacklist, whitelist
+		Chain    string `json:"chain"`     // Empty = every chain
+		Address  string `json:"address"`
+		Category string `json:"category"`
+		Reason   string `json:"reason"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	listType := stringToAddressListTypeEnum(req.ListType)
+	if listType == cryptopb.AddressListType_ADDRESS_LIST_TYPE_UNSPECIFIED {
+		h.respondError(w, http.StatusBadRequest, "list_type must be blacklist or whitelist", nil)
+		return
+	}
+	if req.Address == "" {
+		h.respondError(w, http.StatusBadRequest, "address is required", nil)
+		return
+	}
+
+	addedBy := userID + " (" + role + ")"
+
+	h.logger.Info("Admin adding screening entry",
+		zap.String("list_type", req.ListType),
+		zap.String("chain", req.Chain),
+		zap.String("address", req.Address),
+		zap.String("added_by", addedBy))
+
+	resp, err := h.cryptoClient.ScreeningClient.AddAddressListEntry(ctx, &cryptopb.AddAddressListEntryRequest{
+		ListType: listType,
+		Chain:    req.Chain,
+		Address:  req.Address,
+		Category: req.Category,
+		Reason:   req.Reason,
+		AddedBy:  addedBy,
+	})
+	if err != nil {
+		h.logger.Error("Failed to add screening entry", zap.Error(err))
+		h.respondError(w, http.StatusBadRequest, "Failed to add screening entry", err)
+		return
+	}
+
+	h.respondJSON(w, http.StatusCreated, map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"entry": resp.Entry,
+		},
+	})
+}
+
+// RemoveScreeningEntry takes an address off its list
+// POST /admin/svc/crypto/screening/entries/remove
+func (h *AdminHandler) RemoveScreeningEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req struct {
+		ID int64 `json:"id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if req.ID <= 0 {
+		h.respondError(w, http.StatusBadRequest, "id is required", nil)
+		return
+	}
+
+	removedBy := userID + " (" + role + ")"
+
+	resp, err := h.cryptoClient.ScreeningClient.RemoveAddressListEntry(ctx, &cryptopb.RemoveAddressListEntryRequest{
+		Id:        req.ID,
+		RemovedBy: removedBy,
+	})
+	if err != nil {
+		h.logger.Error("Failed to remove screening entry",
+			zap.Int64("id", req.ID),
+			zap.Error(err))
+		h.respondError(w, http.StatusNotFound, "Failed to remove screening entry", err)
+		return
+	}
+
+	h.respondJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"message": resp.Message,
+		},
+	})
+}
+
+// ImportScreeningList imports (replaces) a sanction list from a CSV/JSON file
+// in the crypto service's lists directory, or from the uploaded content
+// POST /admin/svc/crypto/screening/import
+func (h *AdminHandler) ImportScreeningList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req struct {
+		ListName string `json:"list_name"` // e.g. ofac_sdn
+		ListType string `json:"list_type"` // Defaults to blacklist
+		Format   string `json:"format"`    // csv, json; derived from file_path when empty
+		FilePath string `json:"file_path"` // Relative to the lists directory
+		Content  string `json:"content"`   // Raw CSV/JSON when no file_path
+		Chain    string `json:"chain"`     // For rows without a chain
+		Category string `json:"category"`  // For rows without a category
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if req.ListName == "" {
+		h.respondError(w, http.StatusBadRequest, "list_name is required", nil)
+		return
+	}
+	if req.FilePath == "" && req.Content == "" {
+		h.respondError(w, http.StatusBadRequest, "file_path or content is required", nil)
+		return
+	}
+	if req.FilePath == "" && req.Format == "" {
+		h.respondError(w, http.StatusBadRequest, "format is required with content", nil)
+		return
+	}
+
+	importedBy := userID + " (" + role + ")"
+
+	h.logger.Info("Admin importing screening list",
+		zap.String("list_name", req.ListName),
+		zap.String("file_path", req.FilePath),
+		zap.String("imported_by", importedBy))
+
+	resp, err := h.cryptoClient.ScreeningClient.ImportScreeningList(ctx, &cryptopb.ImportScreeningListRequest{
+		ListName:   req.ListName,
+		ListType:   stringToAddressListTypeEnum(req.ListType),
+		Format:     strings.ToLower(req.Format),
+		FilePath:   req.FilePath,
+		Content:    []byte(req.Content),
+		Chain:      req.Chain,
+		Category:   req.Category,
+		ImportedBy: importedBy,
+	})
+	if err != nil {
+		h.logger.Error("Failed to import screening list",
+			zap.String("list_name", req.ListName),
+			zap.Error(err))
+		h.respondError(w, http.StatusBadRequest, "Failed to import screening list", err)
+		return
+	}
+
+	h.respondJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"list_name": resp.ListName,
+			"rows":      resp.Rows,
+			"imported":  resp.Imported,
+			"rejected":  resp.Rejected,
+			"errors":    resp.Errors,
+		},
+	})
+}
+
+// ScreenAddress screens an address on demand
+// GET /admin/svc/crypto/screening/check?chain=&address=
+func (h *AdminHandler) ScreenAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	chain := r.URL.Query().Get("chain")
+	address := r.URL.Query().Get("address")
+	if chain == "" || address == "" {
+		h.respondError(w, http.StatusBadRequest, "chain and address are required", nil)
+		return
+	}
+
+	resp, err := h.cryptoClient.ScreeningClient.ScreenAddress(ctx, &cryptopb.ScreenAddressRequest{
+		Chain:       chain,
+		Address:     address,
+		RequestedBy: userID + " (" + role + ")",
+	})
+	if err != nil {
+		h.logger.Error("Failed to screen address", zap.Error(err))
+		h.respondError(w, http.StatusInternalServerError, "Failed to screen address", err)
+		return
+	}
+
+	h.respondJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"chain":    chain,
+			"address":  address,
+			"status":   resp.Status.String(),
+			"source":   resp.Source,
+			"category": resp.Category,
+			"reason":   resp.Reason,
+		},
+	})
+}
+
+// ============================================================================
+// FROZEN DEPOSITS
+// ============================================================================
+
+// GetFrozenDeposits lists deposits frozen by address screening
+// GET /admin/svc/crypto/deposits/frozen
+func (h *AdminHandler) GetFrozenDeposits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit := parseIntQuery(r, "limit", 50)
+	if limit > 200 {
+		limit = 200
+	}
+
+	resp, err := h.cryptoClient.DepositClient.GetFrozenDeposits(ctx, &cryptopb.GetFrozenDepositsRequest{
+		Limit: int32(limit),
+	})
+	if err != nil {
+		h.logger.Error("Failed to get frozen deposits", zap.Error(err))
+		h.respondError(w, http.StatusInternalServerError, "Failed to get frozen deposits", err)
+		return
+	}
+
+	h.respondJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"deposits": resp.Deposits,
+			"total":    resp.Total,
+		},
+	})
+}
+
+// ReleaseFrozenDeposit releases a frozen deposit after review and credits it
+// POST /admin/svc/crypto/deposits/frozen/release
+func (h *AdminHandler) ReleaseFrozenDeposit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req struct {
+		DepositID string `json:"deposit_id"`
+		Notes     string `json:"notes"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if req.DepositID == "" {
+		h.respondError(w, http.StatusBadRequest, "deposit_id is required", nil)
+		return
+	}
+
+	reviewedBy := userID + " (" + role + ")"
+
+	h.logger.Info("Admin releasing frozen deposit",
+		zap.String("deposit_id", req.DepositID),
+		zap.String("reviewed_by", reviewedBy))
+
+	resp, err := h.cryptoClient.DepositClient.ReleaseFrozenDeposit(ctx, &cryptopb.ReleaseFrozenDepositRequest{
+		DepositId:  req.DepositID,
+		ReviewedBy: reviewedBy,
+		Notes:      req.Notes,
+	})
+	if err != nil {
+		h.logger.Error("Failed to release frozen deposit",
+			zap.String("deposit_id", req.DepositID),
+			zap.Error(err))
+		h.respondError(w, http.StatusBadRequest, "Failed to release frozen deposit", err)
+		return
+	}
+
+	h.respondJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"message": resp.Message,
+			"deposit": resp.Deposit,
+		},
+	})
+}
+
+// RejectFrozenDeposit rejects a frozen deposit after review
+// POST /admin/svc/crypto/deposits/frozen/reject
+func (h *AdminHandler) RejectFrozenDeposit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req struct {
+		DepositID       string `json:"deposit_id"`
+		RejectionReason string `json:"rejection_reason"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	if req.DepositID == "" {
+		h.respondError(w, http.StatusBadRequest, "deposit_id is required", nil)
+		return
+	}
+	if req.RejectionReason == "" {
+		h.respondError(w, http.StatusBadRequest, "rejection_reason is required", nil)
+		return
+	}
+
+	reviewedBy := userID + " (" + role + ")"
+
+	h.logger.Info("Admin rejecting frozen deposit",
+		zap.String("deposit_id", req.DepositID),
+		zap.String("reviewed_by", reviewedBy))
+
+	resp, err := h.cryptoClient.DepositClient.RejectFrozenDeposit(ctx, &cryptopb.RejectFrozenDepositRequest{
+		DepositId:       req.DepositID,
+		ReviewedBy:      reviewedBy,
+		RejectionReason: req.RejectionReason,
+	})
+	if err != nil {
+		h.logger.Error("Failed to reject frozen deposit",
+			zap.String("deposit_id", req.DepositID),
+			zap.Error(err))
+		h.respondError(w, http.StatusBadRequest, "Failed to reject frozen deposit", err)
+		return
+	}
+
+	h.respondJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"message": resp.Message,
+		},
+	})
+}
+
+// ============================================================================
+// HELPER FUNCTIONS
+// ============================================================================
+
+func stringToAddressListTypeEnum(listType string) cryptopb.AddressListType {
+	switch strings.ToLower(listType) {
+	case "blacklist":
+		return cryptopb.AddressListType_ADDRESS_LIST_TYPE_BLACKLIST
+	case "whitelist":
+		return cryptopb.AddressListType_ADDRESS_LIST_TYPE_WHITELIST
+	default:
+		return cryptopb.AddressListType_ADDRESS_LIST_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+	"x/shared/response"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ============================================================================
+// FEE RULE HANDLERS
+// ============================================================================
+
+type FeeRuleDTO struct {
+	ID                int64            `json:"id,omitempty"` // Set = replaces that rule, omitted = new rule
+	RuleName          string           `json:"rule_name"`
+	TransactionType   string           `json:"transaction_type"`
+	SourceCurrency    string           `json:"source_currency,omitempty"`
+	TargetCurrency    string           `json:"target_currency,omitempty"`
+	AccountType       string           `json:"account_type,omitempty"`
+	OwnerType         string           `json:"owner_type,omitempty"`
+	FeeType           string           `json:"fee_type,omitempty"` // Defaults to platform
+	CalculationMethod string           `json:"calculation_method"` // percentage, fixed or tiered
+	FeeValue          decimal.Decimal  `json:"fee_value"`
+	MinFee            *decimal.Decimal `json:"min_fee,omitempty"`
+	MaxFee            *decimal.Decimal `json:"max_fee,omitempty"`
+	Tiers             json.RawMessage  `json:"tiers,omitempty"`
+	Tariffs           json.RawMessage  `json:"tariffs,omitempty"`
+	Priority          int32            `json:"priority"`
+	IsActive          *bool            `json:"is_active,omitempty"` // Defaults to true
+}
+
+func (dto *FeeRuleDTO) toProto() *accountingpb.FeeRule {
+	rule := &accountingpb.FeeRule{
+		Id:                dto.ID,
+		RuleName:          dto.RuleName,
+		TransactionType:   mapTransactionType(dto.TransactionType),
+		FeeType:           mapFeeType(dto.FeeType),
+		CalculationMethod: dto.CalculationMethod,
+		FeeValue:          dto.FeeValue.String(),
+		MinFee:            decimalToOptionalString(dto.MinFee),
+		MaxFee:            decimalToOptionalString(dto.MaxFee),
+		Priority:          dto.Priority,
+		IsActive:          dto.IsActive == nil || *dto.IsActive,
+	}
+	if dto.SourceCurrency != "" {
+		currency := strings.ToUpper(dto.SourceCurrency)
+		rule.SourceCurrency = &currency
+	}
+	if dto.TargetCurrency != "" {
+		currency := strings.ToUpper(dto.TargetCurrency)
+		rule.TargetCurrency = &currency
+	}
+	if dto.AccountType != "" {
+		accountType := mapAccountType(dto.AccountType)
+		rule.AccountType = &accountType
+	}
+	if dto.OwnerType != "" {
+		ownerType := mapOwnerType(dto.OwnerType)
+		rule.OwnerType = &ownerType
+	}
+	if len(dto.Tiers) > 0 {
+		tiers := string(dto.Tiers)
+		rule.Tiers = &tiers
+	}
+	if len(dto.Tariffs) > 0 {
+		tariffs := string(dto.Tariffs)
+		rule.Tariffs = &tariffs
+	}
+	return rule
+}
+
+func mapFeeType(s string) accountingpb.FeeType {
+	switch s {
+	case "network":
+		return accountingpb.FeeType_FEE_TYPE_NETWORK
+	case "conversion":
+		return accountingpb.FeeType_FEE_TYPE_CONVERSION
+	case "withdrawal":
+		return accountingpb.FeeType_FEE_TYPE_WITHDRAWAL
+	case "agent_commission":
+		return accountingpb.FeeType_FEE_TYPE_AGENT_COMMISSION
+	default:
+		return accountingpb.FeeType_FEE_TYPE_PLATFORM
+	}
+}
+
+type FeeRuleSimulationDTO struct {
+	From             time.Time    `json:"from"`
+	To               time.Time    `json:"to"`
+	Rules            []FeeRuleDTO `json:"rules"`
+	RemoveRuleIDs    []int64      `json:"remove_rule_ids,omitempty"`
+	TransactionTypes []string     `json:"transaction_types,omitempty"` // Empty = all priced types
+	MaxTransactions  int32        `json:"max_transactions,omitempty"`
+}
+
+// POST /admin/svc/accounting/fee-rules/simulate
+func (h *AdminHandler) SimulateFeeRules(w http.ResponseWriter, r *http.Request) {
+	_, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can simulate fee rules")
+		return
+	}
+
+	var dto FeeRuleSimulationDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if dto.From.IsZero() || dto.To.IsZero() {
+		response.Error(w, http.StatusBadRequest, "from and to are required")
+		return
+	}
+	if len(dto.Rules) == 0 && len(dto.RemoveRuleIDs) == 0 {
+		response.Error(w, http.StatusBadRequest, "rules or remove_rule_ids are required")
+		return
+	}
+
+	req := &accountingpb.SimulateFeeRulesRequest{
+		From:            timestamppb.New(dto.From),
+		To:              timestamppb.New(dto.To),
+		RemoveRuleIds:   dto.RemoveRuleIDs,
+		MaxTransactions: dto.MaxTransactions,
+	}
+	for i := range dto.Rules {
+		req.Rules = append(req.Rules, dto.Rules[i].toProto())
+	}
+	for _, t := range dto.TransactionTypes {
+		txType := mapTransactionType(t)
+		if txType == accountingpb.TransactionType_TRANSACTION_TYPE_UNSPECIFIED {
+			response.Error(w, http.StatusBadRequest, "invalid transaction_type: "+t)
+			return
+		}
+		req.TransactionTypes = append(req.TransactionTypes, txType)
+	}
+
+	resp, err := h.accountingClient.Client.SimulateFeeRules(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to simulate fee rules: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// GET /admin/svc/accounting/fee-rules/{id}/versions
+func (h *AdminHandler) ListFeeRuleVersions(w http.ResponseWriter, r *http.Request) {
+	ruleID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || ruleID <= 0 {
+		response.Error(w, http.StatusBadRequest, "invalid rule id")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.ListFeeRuleVersions(r.Context(), &accountingpb.ListFeeRuleVersionsRequest{
+		RuleId: ruleID,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to list fee rule versions: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
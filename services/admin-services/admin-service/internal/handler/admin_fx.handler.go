@@ -2,6 +2,8 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"strconv"
 	"time"
@@ -10,6 +12,7 @@ import (
 	accountingpb "x/shared/genproto/shared/accounting/v1"
 	"x/shared/response"
 
+	"github.com/shopspring/decimal"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -19,27 +22,28 @@ import (
 
 type CreditDebitDTO struct {
 	AccountNumber string  `json:"account_number"`
-	Amount        float64 `json:"amount"`
+	Amount        decimal.Decimal `json:"amount"`
 	//Currency      string  `json:"currency"`
 	Description   string  `json:"description"`
+	PostedAt      *time.Time `json:"posted_at,omitempty"` // Back-dated accounting date (super admin only)
 }
 
 type TransferDTO struct {
 	From        string  `json:"from"`
 	To          string  `json:"to"`
-	Amount      float64 `json:"amount"`
+	Amount      decimal.Decimal `json:"amount"`
 	Description string  `json:"description"`
 }
 
 type ConversionDTO struct {
 	FromAccount string  `json:"from_account"`
 	ToAccount   string  `json:"to_account"`
-	Amount      float64 `json:"amount"`
+	Amount      decimal.Decimal `json:"amount"`
 }
 
 type TradeDTO struct {
 	AccountNumber string  `json:"account_number"`
-	Amount        float64 `json:"amount"`
+	Amount        decimal.Decimal `json:"amount"`
 	Currency      string  `json:"currency"`
 	TradeID       string  `json:"trade_id"`
 	TradeType     string  `json:"trade_type"`
@@ -49,11 +53,18 @@ type CommissionDTO struct {
 	AgentExternalID   string  `json:"agent_external_id"`
 	TransactionRef    string  `json:"transaction_ref"`
 	Currency          string  `json:"currency"`
-	TransactionAmount float64 `json:"transaction_amount"`
-	CommissionAmount  float64 `json:"commission_amount"`
+	TransactionAmount decimal.Decimal `json:"transaction_amount"`
+	CommissionAmount  decimal.Decimal `json:"commission_amount"`
 	CommissionRate    string  `json:"commission_rate,omitempty"`
 }
 
+// ReversalDTO reverses a receipt in full, or refunds part of it when Amount is set
+type ReversalDTO struct {
+	Amount         *decimal.Decimal `json:"amount,omitempty"`
+	Reason         string           `json:"reason,omitempty"`
+	IdempotencyKey string           `json:"idempotency_key,omitempty"`
+}
+
 type ApprovalDTO struct {
 	Approved bool   `json:"approved"`
 	Reason   string `json:"reason,omitempty"`
@@ -81,7 +92,7 @@ type OwnerStatementJSON struct {
 type UpdateAccountDTO struct {
 	IsActive       *bool     `json:"is_active,omitempty"`
 	IsLocked       *bool     `json:"is_locked,omitempty"`
-	OverdraftLimit *float64  `json:"overdraft_limit,omitempty"`  //  Changed to *float64
+	OverdraftLimit *decimal.Decimal `json:"overdraft_limit,omitempty"` // accepts JSON number or string
 }
 
 type DailyReportQuery struct {
@@ -97,7 +108,7 @@ type TransactionSummaryQuery struct {
 
 type FeeCalculationQuery struct {
 	TransactionType string  `json:"transaction_type"`
-	Amount          float64 `json:"amount"`
+	Amount          decimal.Decimal `json:"amount"`
 	SourceCurrency  string  `json:"source_currency,omitempty"`
 	TargetCurrency  string  `json:"target_currency,omitempty"`
 	AccountType     string  `json:"account_type,omitempty"`
@@ -309,7 +320,8 @@ func (h *AdminHandler) UpdateAccount(w http.ResponseWriter, r *http.Request) {
 		req.IsLocked = dto.IsLocked
 	}
 	if dto.OverdraftLimit != nil {
-		req.OverdraftLimit = dto.OverdraftLimit
+		overdraftLimit := dto.OverdraftLimit.String()
+		req.OverdraftLimit = &overdraftLimit
 	}
 
 	resp, err := h.accountingClient.Client.UpdateAccount(r.Context(), req)
@@ -339,22 +351,31 @@ func (h *AdminHandler) CreditAccount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if dto.Amount <= 0 {
+	if !dto.Amount.IsPositive() {
 		response.Error(w, http.StatusBadRequest, "amount must be greater than zero")
 		return
 	}
 
+	// Back-dated postings skip the approval flow, so only super admin may set them
+	if dto.PostedAt != nil && !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can back-date postings")
+		return
+	}
+
 	// Super admin: execute immediately
 	if h.isSuperAdmin(role) {
 		req := &accountingpb.CreditRequest{
 			AccountNumber:       dto.AccountNumber,
-			Amount:              dto. Amount,
+			Amount:              dto.Amount.String(),
 			//Currency:            dto.Currency,
 			AccountType:         accountingpb.AccountType_ACCOUNT_TYPE_REAL,
 			Description:         dto.Description,
 			CreatedByExternalId: userID,
 			CreatedByType:       accountingpb. OwnerType_OWNER_TYPE_ADMIN,
 		}
+		if dto.PostedAt != nil {
+			req.PostedAt = timestamppb.New(*dto.PostedAt)
+		}
 
 		resp, err := h.accountingClient.Client.Credit(r.Context(), req)
 		if err != nil {
@@ -372,7 +393,7 @@ func (h *AdminHandler) CreditAccount(w http.ResponseWriter, r *http.Request) {
 		RequestedBy:     userIDInt,
 		TransactionType: accountingpb.TransactionType_TRANSACTION_TYPE_DEPOSIT,
 		AccountNumber:   dto.AccountNumber,
-		Amount:          dto.Amount,
+		Amount:          dto.Amount.String(),
 		//Currency:        dto.Currency,
 		Description:     dto. Description,
 	}
@@ -400,22 +421,31 @@ func (h *AdminHandler) DebitAccount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if dto.Amount <= 0 {
+	if !dto.Amount.IsPositive() {
 		response.Error(w, http.StatusBadRequest, "amount must be greater than zero")
 		return
 	}
 
+	// Back-dated postings skip the approval flow, so only super admin may set them
+	if dto.PostedAt != nil && !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can back-date postings")
+		return
+	}
+
 	// Super admin: execute immediately
 	if h.isSuperAdmin(role) {
 		req := &accountingpb.DebitRequest{
 			AccountNumber:       dto.AccountNumber,
-			Amount:              dto.Amount,
+			Amount:              dto.Amount.String(),
 			//Currency:            dto.Currency,
 			AccountType:         accountingpb.AccountType_ACCOUNT_TYPE_REAL,
 			Description:         dto.Description,
 			CreatedByExternalId: userID,
 			CreatedByType:       accountingpb.OwnerType_OWNER_TYPE_ADMIN,
 		}
+		if dto.PostedAt != nil {
+			req.PostedAt = timestamppb.New(*dto.PostedAt)
+		}
 
 		resp, err := h.accountingClient.Client.Debit(r.Context(), req)
 		if err != nil {
@@ -433,7 +463,7 @@ func (h *AdminHandler) DebitAccount(w http.ResponseWriter, r *http.Request) {
 		RequestedBy:     userIDInt,
 		TransactionType: accountingpb.TransactionType_TRANSACTION_TYPE_WITHDRAWAL,
 		AccountNumber:   dto.AccountNumber,
-		Amount:          dto.Amount,
+		Amount:          dto.Amount.String(),
 		//Currency:        dto.Currency,
 		Description:     dto.Description,
 	}
@@ -465,7 +495,7 @@ func (h *AdminHandler) TransferFunds(w http.ResponseWriter, r *http.Request) {
 		response.Error(w, http.StatusBadRequest, "from and to accounts must be different")
 		return
 	}
-	if dto.Amount <= 0 {
+	if !dto.Amount.IsPositive() {
 		response.Error(w, http.StatusBadRequest, "amount must be greater than zero")
 		return
 	}
@@ -473,7 +503,7 @@ func (h *AdminHandler) TransferFunds(w http.ResponseWriter, r *http.Request) {
 	req := &accountingpb.TransferRequest{
 		FromAccountNumber:   dto.From,
 		ToAccountNumber:     dto.To,
-		Amount:              dto.Amount,
+		Amount:              dto.Amount.String(),
 		AccountType:         accountingpb. AccountType_ACCOUNT_TYPE_REAL,
 		Description:         dto.Description,
 		CreatedByExternalId: userID,
@@ -507,7 +537,7 @@ func (h *AdminHandler) ConvertAndTransfer(w http.ResponseWriter, r *http.Request
 		response.Error(w, http. StatusBadRequest, "from and to accounts must be different")
 		return
 	}
-	if dto.Amount <= 0 {
+	if !dto.Amount.IsPositive() {
 		response.Error(w, http.StatusBadRequest, "amount must be greater than zero")
 		return
 	}
@@ -515,7 +545,7 @@ func (h *AdminHandler) ConvertAndTransfer(w http.ResponseWriter, r *http.Request
 	req := &accountingpb.ConversionRequest{
 		FromAccountNumber:   dto.FromAccount,
 		ToAccountNumber:     dto. ToAccount,
-		Amount:              dto.Amount,
+		Amount:              dto.Amount.String(),
 		AccountType:         accountingpb.AccountType_ACCOUNT_TYPE_REAL,
 		CreatedByExternalId: userID,
 		CreatedByType:       accountingpb.OwnerType_OWNER_TYPE_ADMIN,
@@ -544,14 +574,14 @@ func (h *AdminHandler) ProcessTradeWin(w http. ResponseWriter, r *http.Request)
 		return
 	}
 
-	if dto.Amount <= 0 {
+	if !dto.Amount.IsPositive() {
 		response.Error(w, http.StatusBadRequest, "amount must be greater than zero")
 		return
 	}
 
 	req := &accountingpb.TradeRequest{
 		AccountNumber:       dto.AccountNumber,
-		Amount:              dto.Amount,
+		Amount:              dto.Amount.String(),
 		//Currency:            dto.Currency,
 		AccountType:         accountingpb.AccountType_ACCOUNT_TYPE_REAL,
 		TradeId:             dto.TradeID,
@@ -583,14 +613,14 @@ func (h *AdminHandler) ProcessTradeLoss(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if dto.Amount <= 0 {
+	if !dto.Amount.IsPositive() {
 		response. Error(w, http.StatusBadRequest, "amount must be greater than zero")
 		return
 	}
 
 	req := &accountingpb.TradeRequest{
 		AccountNumber:       dto.AccountNumber,
-		Amount:              dto. Amount,
+		Amount:              dto.Amount.String(),
 		//Currency:            dto.Currency,
 		AccountType:         accountingpb.AccountType_ACCOUNT_TYPE_REAL,
 		TradeId:             dto.TradeID,
@@ -616,7 +646,7 @@ func (h *AdminHandler) ProcessAgentCommission(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if dto.CommissionAmount <= 0 {
+	if !dto.CommissionAmount.IsPositive() {
 		response.Error(w, http.StatusBadRequest, "commission amount must be greater than zero")
 		return
 	}
@@ -625,8 +655,8 @@ func (h *AdminHandler) ProcessAgentCommission(w http.ResponseWriter, r *http.Req
 		AgentExternalId:   dto.AgentExternalID,
 		TransactionRef:    dto.TransactionRef,
 		Currency:          dto.Currency,
-		TransactionAmount: dto.TransactionAmount,
-		CommissionAmount:  dto.CommissionAmount,
+		TransactionAmount: dto.TransactionAmount.String(),
+		CommissionAmount:  dto.CommissionAmount.String(),
 	}
 
 	if dto.CommissionRate != "" {
@@ -685,10 +715,8 @@ func (h *AdminHandler) ApproveTransaction(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if !h.isSuperAdmin(role) {
-		response.Error(w, http.StatusForbidden, "only super admin can approve transactions")
-		return
-	}
+	// Which roles may vote (and how many votes are needed) is decided by the
+	// approval policy in the accounting service
 
 	requestID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
 	if err != nil {
@@ -704,9 +732,10 @@ func (h *AdminHandler) ApproveTransaction(w http.ResponseWriter, r *http.Request
 
 	approverID, _ := strconv.ParseInt(userID, 10, 64)
 	req := &accountingpb.ApproveTransactionRequest{
-		RequestId:  requestID,
-		ApprovedBy: approverID,
-		Approved:   dto.Approved,
+		RequestId:    requestID,
+		ApprovedBy:   approverID,
+		ApproverRole: role,
+		Approved:     dto.Approved,
 	}
 
 	if dto.Reason != "" {
@@ -891,6 +920,75 @@ func (h *AdminHandler) GetTransactionByReceipt(w http.ResponseWriter, r *http.Re
 	response.JSON(w, http.StatusOK, resp)
 }
 
+// POST /api/admin/transactions/{receipt}/reverse
+func (h *AdminHandler) ReverseTransaction(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can reverse transactions")
+		return
+	}
+
+	receiptCode := r.PathValue("receipt")
+	if receiptCode == "" {
+		response.Error(w, http.StatusBadRequest, "receipt_code required")
+		return
+	}
+
+	// Body is optional: no amount means a full reversal
+	var dto ReversalDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil && !errors.Is(err, io.EOF) {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	var idempotencyKey *string
+	if dto.IdempotencyKey != "" {
+		idempotencyKey = &dto.IdempotencyKey
+	}
+
+	if dto.Amount != nil {
+		if !dto.Amount.IsPositive() {
+			response.Error(w, http.StatusBadRequest, "amount must be greater than zero")
+			return
+		}
+
+		resp, err := h.accountingClient.Client.RefundTransaction(r.Context(), &accountingpb.RefundTransactionRequest{
+			ReceiptCode:         receiptCode,
+			Amount:              dto.Amount.String(),
+			Reason:              dto.Reason,
+			IdempotencyKey:      idempotencyKey,
+			CreatedByExternalId: userID,
+			CreatedByType:       accountingpb.OwnerType_OWNER_TYPE_ADMIN,
+		})
+		if err != nil {
+			response.Error(w, http.StatusBadGateway, "failed to refund transaction: "+err.Error())
+			return
+		}
+
+		response.JSON(w, http.StatusOK, resp)
+		return
+	}
+
+	resp, err := h.accountingClient.Client.ReverseTransaction(r.Context(), &accountingpb.ReverseTransactionRequest{
+		ReceiptCode:         receiptCode,
+		Reason:              dto.Reason,
+		IdempotencyKey:      idempotencyKey,
+		CreatedByExternalId: userID,
+		CreatedByType:       accountingpb.OwnerType_OWNER_TYPE_ADMIN,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to reverse transaction: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
 // GET /api/admin/ledgers/account/{number}
 func (h *AdminHandler) GetAccountLedgers(w http.ResponseWriter, r *http.Request) {
 	accountNumber := r.PathValue("number")
@@ -1074,7 +1172,7 @@ func (h *AdminHandler) CalculateFee(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	amount, err := strconv.ParseFloat(amountStr, 64)
+	amount, err := decimal.NewFromString(amountStr)
 	if err != nil {
 		response.Error(w, http.StatusBadRequest, "invalid amount")
 		return
@@ -1082,7 +1180,7 @@ func (h *AdminHandler) CalculateFee(w http.ResponseWriter, r *http.Request) {
 
 	req := &accountingpb. CalculateFeeRequest{
 		TransactionType: mapTransactionType(transactionType),
-		Amount:          amount,
+		Amount:          amount.String(),
 	}
 
 	if sourceCurrency != "" {
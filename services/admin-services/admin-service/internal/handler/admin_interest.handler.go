@@ -0,0 +1,270 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+	"x/shared/response"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ============================================================================
+// INTEREST HANDLERS
+// ============================================================================
+
+type InterestRatePlanDTO struct {
+	Name       string           `json:"name"`
+	Currency   string           `json:"currency"` // Fixed once created
+	Purpose    string           `json:"purpose"`  // savings or investment; fixed once created
+	AnnualRate decimal.Decimal  `json:"annual_rate"`
+	Method     string           `json:"method,omitempty"`    // simple (default) or compound
+	DayCount   int32            `json:"day_count,omitempty"` // 360 or 365 (default)
+	MinBalance *decimal.Decimal `json:"min_balance,omitempty"`
+	IsActive   *bool            `json:"is_active,omitempty"` // Defaults to true
+}
+
+func (dto *InterestRatePlanDTO) toProto(id int64, purpose accountingpb.AccountPurpose) *accountingpb.InterestRatePlan {
+	plan := &accountingpb.InterestRatePlan{
+		Id:         id,
+		Name:       dto.Name,
+		Currency:   strings.ToUpper(dto.Currency),
+		Purpose:    purpose,
+		AnnualRate: dto.AnnualRate.String(),
+		Method:     dto.Method,
+		DayCount:   dto.DayCount,
+		MinBalance: "0",
+		IsActive:   dto.IsActive == nil || *dto.IsActive,
+	}
+	if dto.MinBalance != nil {
+		plan.MinBalance = dto.MinBalance.String()
+	}
+	return plan
+}
+
+// GET /admin/svc/accounting/interest-plans?currency=&active_only=
+func (h *AdminHandler) ListInterestRatePlans(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	req := &accountingpb.ListInterestRatePlansRequest{}
+	if currency := q.Get("currency"); currency != "" {
+		currency = strings.ToUpper(currency)
+		req.Currency = &currency
+	}
+	if activeStr := q.Get("active_only"); activeStr != "" {
+		active, err := strconv.ParseBool(activeStr)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid active_only flag")
+			return
+		}
+		req.ActiveOnly = active
+	}
+
+	resp, err := h.accountingClient.Client.ListInterestRatePlans(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to list interest rate plans: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// POST /admin/svc/accounting/interest-plans
+func (h *AdminHandler) CreateInterestRatePlan(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can manage interest rate plans")
+		return
+	}
+
+	var dto InterestRatePlanDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if dto.Name == "" || dto.Currency == "" {
+		response.Error(w, http.StatusBadRequest, "name and currency are required")
+		return
+	}
+	purpose, ok := mapAccountPurpose(dto.Purpose)
+	if !ok {
+		response.Error(w, http.StatusBadRequest, "invalid purpose")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.CreateInterestRatePlan(r.Context(), &accountingpb.CreateInterestRatePlanRequest{
+		Plan:      dto.toProto(0, purpose),
+		CreatedBy: userID,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to create interest rate plan: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, resp)
+}
+
+// PUT /admin/svc/accounting/interest-plans/{id}
+func (h *AdminHandler) UpdateInterestRatePlan(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can manage interest rate plans")
+		return
+	}
+
+	planID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || planID <= 0 {
+		response.Error(w, http.StatusBadRequest, "invalid plan id")
+		return
+	}
+
+	var dto InterestRatePlanDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.UpdateInterestRatePlan(r.Context(), &accountingpb.UpdateInterestRatePlanRequest{
+		Plan:      dto.toProto(planID, accountingpb.AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED),
+		UpdatedBy: userID,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to update interest rate plan: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// GET /admin/svc/accounting/interest-plans/postings?account_number=&status=&limit=&offset=
+func (h *AdminHandler) ListInterestPostings(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	req := &accountingpb.ListInterestPostingsRequest{}
+	if accountNumber := q.Get("account_number"); accountNumber != "" {
+		req.AccountNumber = &accountNumber
+	}
+	if status := q.Get("status"); status != "" {
+		req.Status = &status
+	}
+	if limitStr := q.Get("limit"); limitStr != "" {
+		limit, err := strconv.ParseInt(limitStr, 10, 32)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		req.Limit = int32(limit)
+	}
+	if offsetStr := q.Get("offset"); offsetStr != "" {
+		offset, err := strconv.ParseInt(offsetStr, 10, 32)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+		req.Offset = int32(offset)
+	}
+
+	resp, err := h.accountingClient.Client.ListInterestPostings(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to list interest postings: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// GET /admin/svc/accounting/interest-plans/accounts/{number}?from=&to=
+// Defaults to the current month
+func (h *AdminHandler) GetAccountInterest(w http.ResponseWriter, r *http.Request) {
+	accountNumber := r.PathValue("number")
+	if accountNumber == "" {
+		response.Error(w, http.StatusBadRequest, "account number required")
+		return
+	}
+
+	now := time.Now().UTC()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := now
+	if fromStr := r.URL.Query().Get("from"); fromStr != "" {
+		t, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid from (RFC3339)")
+			return
+		}
+		from = t
+	}
+	if toStr := r.URL.Query().Get("to"); toStr != "" {
+		t, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid to (RFC3339)")
+			return
+		}
+		to = t
+	}
+
+	resp, err := h.accountingClient.Client.GetAccountInterest(r.Context(), &accountingpb.GetAccountInterestRequest{
+		AccountNumber: accountNumber,
+		From:          timestamppb.New(from),
+		To:            timestamppb.New(to),
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to get account interest: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+type RunInterestDTO struct {
+	ThroughDate *time.Time `json:"through_date,omitempty"` // Defaults to yesterday
+}
+
+// POST /admin/svc/accounting/interest-plans/run
+func (h *AdminHandler) RunInterest(w http.ResponseWriter, r *http.Request) {
+	_, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can run interest")
+		return
+	}
+
+	var dto RunInterestDTO
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+
+	req := &accountingpb.RunInterestRequest{}
+	if dto.ThroughDate != nil {
+		req.ThroughDate = timestamppb.New(*dto.ThroughDate)
+	}
+
+	resp, err := h.accountingClient.Client.RunInterest(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to run interest: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
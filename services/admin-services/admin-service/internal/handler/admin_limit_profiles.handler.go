@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+	"x/shared/response"
+
+	"github.com/shopspring/decimal"
+)
+
+// ============================================================================
+// TRANSACTION LIMIT HANDLERS
+// ============================================================================
+
+type LimitProfileDTO struct {
+	Name            string           `json:"name"`
+	OwnerType       string           `json:"owner_type,omitempty"`     // Empty = any owner type
+	KYCTier         string           `json:"kyc_tier,omitempty"`       // Empty = any tier
+	AccountNumber   string           `json:"account_number,omitempty"` // Empty = any account
+	Currency        string           `json:"currency"`
+	MaxSingleAmount *decimal.Decimal `json:"max_single_amount,omitempty"` // Omitted caps are unlimited
+	DailyAmount     *decimal.Decimal `json:"daily_amount,omitempty"`
+	WeeklyAmount    *decimal.Decimal `json:"weekly_amount,omitempty"`
+	MonthlyAmount   *decimal.Decimal `json:"monthly_amount,omitempty"`
+	DailyCount      *int32           `json:"daily_count,omitempty"`
+	WeeklyCount     *int32           `json:"weekly_count,omitempty"`
+	MonthlyCount    *int32           `json:"monthly_count,omitempty"`
+	Priority        int32            `json:"priority"`
+	IsActive        *bool            `json:"is_active,omitempty"` // Defaults to true
+}
+
+func (dto *LimitProfileDTO) toProto(id int64) *accountingpb.LimitProfile {
+	profile := &accountingpb.LimitProfile{
+		Id:              id,
+		Name:            dto.Name,
+		Currency:        strings.ToUpper(dto.Currency),
+		MaxSingleAmount: decimalToOptionalString(dto.MaxSingleAmount),
+		DailyAmount:     decimalToOptionalString(dto.DailyAmount),
+		WeeklyAmount:    decimalToOptionalString(dto.WeeklyAmount),
+		MonthlyAmount:   decimalToOptionalString(dto.MonthlyAmount),
+		DailyCount:      dto.DailyCount,
+		WeeklyCount:     dto.WeeklyCount,
+		MonthlyCount:    dto.MonthlyCount,
+		Priority:        dto.Priority,
+		IsActive:        dto.IsActive == nil || *dto.IsActive,
+	}
+	if dto.OwnerType != "" {
+		ownerType := mapOwnerType(dto.OwnerType)
+		profile.OwnerType = &ownerType
+	}
+	if dto.KYCTier != "" {
+		profile.KycTier = &dto.KYCTier
+	}
+	if dto.AccountNumber != "" {
+		profile.AccountNumber = &dto.AccountNumber
+	}
+	return profile
+}
+
+func decimalToOptionalString(d *decimal.Decimal) *string {
+	if d == nil {
+		return nil
+	}
+	s := d.String()
+	return &s
+}
+
+// GET /admin/svc/accounting/limit-profiles?owner_type=&kyc_tier=&account_number=&currency=&active_only=
+func (h *AdminHandler) ListLimitProfiles(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	req := &accountingpb.ListLimitProfilesRequest{}
+	if ownerTypeStr := q.Get("owner_type"); ownerTypeStr != "" {
+		ownerType := mapOwnerType(ownerTypeStr)
+		if ownerType == accountingpb.OwnerType_OWNER_TYPE_UNSPECIFIED {
+			response.Error(w, http.StatusBadRequest, "invalid owner_type")
+			return
+		}
+		req.OwnerType = &ownerType
+	}
+	if tier := q.Get("kyc_tier"); tier != "" {
+		req.KycTier = &tier
+	}
+	if accountNumber := q.Get("account_number"); accountNumber != "" {
+		req.AccountNumber = &accountNumber
+	}
+	if currency := q.Get("currency"); currency != "" {
+		currency = strings.ToUpper(currency)
+		req.Currency = &currency
+	}
+	if activeStr := q.Get("active_only"); activeStr != "" {
+		active, err := strconv.ParseBool(activeStr)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid active_only flag")
+			return
+		}
+		req.ActiveOnly = active
+	}
+
+	resp, err := h.accountingClient.Client.ListLimitProfiles(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to list limit profiles: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// POST /admin/svc/accounting/limit-profiles
+func (h *AdminHandler) CreateLimitProfile(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can manage limit profiles")
+		return
+	}
+
+	var dto LimitProfileDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if dto.Name == "" || dto.Currency == "" {
+		response.Error(w, http.StatusBadRequest, "name and currency are required")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.CreateLimitProfile(r.Context(), &accountingpb.CreateLimitProfileRequest{
+		Profile:   dto.toProto(0),
+		CreatedBy: userID,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to create limit profile: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, resp)
+}
+
+// PUT /admin/svc/accounting/limit-profiles/{id}
+func (h *AdminHandler) UpdateLimitProfile(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can manage limit profiles")
+		return
+	}
+
+	profileID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || profileID <= 0 {
+		response.Error(w, http.StatusBadRequest, "invalid profile id")
+		return
+	}
+
+	var dto LimitProfileDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.UpdateLimitProfile(r.Context(), &accountingpb.UpdateLimitProfileRequest{
+		Profile:   dto.toProto(profileID),
+		UpdatedBy: userID,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to update limit profile: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+type OwnerKYCTierDTO struct {
+	KYCTier string `json:"kyc_tier"`
+}
+
+// PUT /admin/svc/accounting/limit-profiles/kyc-tiers/{owner_type}/{owner_id}
+func (h *AdminHandler) SetOwnerKYCTier(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can set KYC tiers")
+		return
+	}
+
+	ownerType := mapOwnerType(r.PathValue("owner_type"))
+	if ownerType == accountingpb.OwnerType_OWNER_TYPE_UNSPECIFIED {
+		response.Error(w, http.StatusBadRequest, "invalid owner_type")
+		return
+	}
+	ownerID := r.PathValue("owner_id")
+	if ownerID == "" {
+		response.Error(w, http.StatusBadRequest, "owner_id required")
+		return
+	}
+
+	var dto OwnerKYCTierDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil || dto.KYCTier == "" {
+		response.Error(w, http.StatusBadRequest, "kyc_tier is required")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.SetOwnerKYCTier(r.Context(), &accountingpb.SetOwnerKYCTierRequest{
+		OwnerType: ownerType,
+		OwnerId:   ownerID,
+		KycTier:   dto.KYCTier,
+		SetBy:     userID,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to set KYC tier: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// GET /admin/svc/accounting/limit-profiles/accounts/{number}
+func (h *AdminHandler) GetAccountLimits(w http.ResponseWriter, r *http.Request) {
+	accountNumber := r.PathValue("number")
+	if accountNumber == "" {
+		response.Error(w, http.StatusBadRequest, "account number required")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.GetAccountLimits(r.Context(), &accountingpb.GetAccountLimitsRequest{
+		AccountNumber: accountNumber,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to get account limits: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+	"x/shared/response"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ============================================================================
+// ACCOUNTING PERIOD / TRIAL BALANCE / GENERAL LEDGER HANDLERS
+// ============================================================================
+
+type CloseAccountingPeriodDTO struct {
+	Month       string     `json:"month,omitempty"`        // "2006-01" (UTC); alternative to period_start/period_end
+	PeriodStart *time.Time `json:"period_start,omitempty"` // RFC3339
+	PeriodEnd   *time.Time `json:"period_end,omitempty"`   // RFC3339, exclusive
+	Note        string     `json:"note,omitempty"`
+}
+
+// POST /admin/svc/accounting/reports/periods/close
+func (h *AdminHandler) CloseAccountingPeriod(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can close accounting periods")
+		return
+	}
+
+	var dto CloseAccountingPeriodDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	var start, end time.Time
+	switch {
+	case dto.Month != "":
+		month, err := time.Parse("2006-01", dto.Month)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid month format (expected YYYY-MM)")
+			return
+		}
+		start = month
+		end = month.AddDate(0, 1, 0)
+	case dto.PeriodStart != nil && dto.PeriodEnd != nil:
+		start = *dto.PeriodStart
+		end = *dto.PeriodEnd
+	default:
+		response.Error(w, http.StatusBadRequest, "month or period_start and period_end required")
+		return
+	}
+
+	req := &accountingpb.CloseAccountingPeriodRequest{
+		PeriodStart: timestamppb.New(start),
+		PeriodEnd:   timestamppb.New(end),
+		ClosedBy:    userID,
+	}
+	if dto.Note != "" {
+		req.Note = &dto.Note
+	}
+
+	resp, err := h.accountingClient.Client.CloseAccountingPeriod(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to close accounting period: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// GET /admin/svc/accounting/reports/trial-balance?period_id=&from=&to=&account_type=&currency=&purpose=
+func (h *AdminHandler) GetTrialBalance(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	req := &accountingpb.GetTrialBalanceRequest{}
+
+	if periodIDStr := q.Get("period_id"); periodIDStr != "" {
+		periodID, err := strconv.ParseInt(periodIDStr, 10, 64)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid period_id")
+			return
+		}
+		req.PeriodId = &periodID
+	}
+	if fromStr := q.Get("from"); fromStr != "" {
+		from, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid from date format")
+			return
+		}
+		req.From = timestamppb.New(from)
+	}
+	if toStr := q.Get("to"); toStr != "" {
+		to, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid to date format")
+			return
+		}
+		req.To = timestamppb.New(to)
+	}
+	if accountTypeStr := q.Get("account_type"); accountTypeStr != "" {
+		accountType := mapAccountType(accountTypeStr)
+		req.AccountType = &accountType
+	}
+	if currency := q.Get("currency"); currency != "" {
+		req.Currency = &currency
+	}
+	if purposeStr := q.Get("purpose"); purposeStr != "" {
+		purpose, ok := mapAccountPurpose(purposeStr)
+		if !ok {
+			response.Error(w, http.StatusBadRequest, "invalid purpose")
+			return
+		}
+		req.Purpose = &purpose
+	}
+
+	resp, err := h.accountingClient.Client.GetTrialBalance(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to get trial balance: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// GET /admin/svc/accounting/reports/general-ledger?from=&to=&purpose=&currency=&account_type=&account_number=&limit=&offset=
+func (h *AdminHandler) GetGeneralLedger(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	fromStr := q.Get("from")
+	toStr := q.Get("to")
+
+	if fromStr == "" || toStr == "" {
+		response.Error(w, http.StatusBadRequest, "from and to parameters required (RFC3339 format)")
+		return
+	}
+
+	from, err := time.Parse(time.RFC3339, fromStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid from date format")
+		return
+	}
+
+	to, err := time.Parse(time.RFC3339, toStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid to date format")
+		return
+	}
+
+	req := &accountingpb.GetGeneralLedgerRequest{
+		From:   timestamppb.New(from),
+		To:     timestamppb.New(to),
+		Limit:  100,
+		Offset: 0,
+	}
+
+	if purposeStr := q.Get("purpose"); purposeStr != "" {
+		purpose, ok := mapAccountPurpose(purposeStr)
+		if !ok {
+			response.Error(w, http.StatusBadRequest, "invalid purpose")
+			return
+		}
+		req.Purpose = &purpose
+	}
+	if currency := q.Get("currency"); currency != "" {
+		req.Currency = &currency
+	}
+	if accountTypeStr := q.Get("account_type"); accountTypeStr != "" {
+		accountType := mapAccountType(accountTypeStr)
+		req.AccountType = &accountType
+	}
+	if accountNumber := q.Get("account_number"); accountNumber != "" {
+		req.AccountNumber = &accountNumber
+	}
+	if limitStr := q.Get("limit"); limitStr != "" {
+		if l, err := strconv.ParseInt(limitStr, 10, 32); err == nil {
+			req.Limit = int32(l)
+		}
+	}
+	if offsetStr := q.Get("offset"); offsetStr != "" {
+		if o, err := strconv.ParseInt(offsetStr, 10, 32); err == nil {
+			req.Offset = int32(o)
+		}
+	}
+
+	resp, err := h.accountingClient.Client.GetGeneralLedger(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to get general ledger: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// mapAccountPurpose maps "wallet", "fees", ... to the proto enum
+func mapAccountPurpose(s string) (accountingpb.AccountPurpose, bool) {
+	v, ok := accountingpb.AccountPurpose_value["ACCOUNT_PURPOSE_"+strings.ToUpper(s)]
+	if !ok || v == 0 {
+		return accountingpb.AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED, false
+	}
+	return accountingpb.AccountPurpose(v), true
+}
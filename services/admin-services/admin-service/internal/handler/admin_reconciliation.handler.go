@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+	"x/shared/response"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ============================================================================
+// LEDGER RECONCILIATION HANDLERS
+// ============================================================================
+
+type RunReconciliationDTO struct {
+	Currency     string     `json:"currency,omitempty"`
+	AccountType  string     `json:"account_type,omitempty"`  // "real" | "demo"; empty = both
+	JournalsFrom *time.Time `json:"journals_from,omitempty"` // RFC3339; defaults to 24h ago
+}
+
+// POST /admin/svc/accounting/reconciliation/run
+func (h *AdminHandler) RunReconciliation(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can run reconciliation")
+		return
+	}
+
+	// Body is optional: no body means a full run over the last 24h of journals
+	var dto RunReconciliationDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil && !errors.Is(err, io.EOF) {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	req := &accountingpb.RunReconciliationRequest{
+		TriggeredBy: userID,
+	}
+	if dto.Currency != "" {
+		req.Currency = &dto.Currency
+	}
+	if dto.AccountType != "" {
+		accountType := mapAccountType(dto.AccountType)
+		req.AccountType = &accountType
+	}
+	if dto.JournalsFrom != nil {
+		req.JournalsFrom = timestamppb.New(*dto.JournalsFrom)
+	}
+
+	resp, err := h.accountingClient.Client.RunReconciliation(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to run reconciliation: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// GET /admin/svc/accounting/reconciliation/breaks?run_id=&break_type=&currency=&resolved=&limit=&offset=
+func (h *AdminHandler) ListReconciliationBreaks(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	req := &accountingpb.ListReconciliationBreaksRequest{
+		Limit:  100,
+		Offset: 0,
+	}
+
+	if runIDStr := q.Get("run_id"); runIDStr != "" {
+		runID, err := strconv.ParseInt(runIDStr, 10, 64)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid run_id")
+			return
+		}
+		req.RunId = &runID
+	}
+	if breakType := q.Get("break_type"); breakType != "" {
+		req.BreakType = &breakType
+	}
+	if currency := q.Get("currency"); currency != "" {
+		req.Currency = &currency
+	}
+	if resolvedStr := q.Get("resolved"); resolvedStr != "" {
+		resolved, err := strconv.ParseBool(resolvedStr)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid resolved flag")
+			return
+		}
+		req.Resolved = &resolved
+	}
+	if limitStr := q.Get("limit"); limitStr != "" {
+		if l, err := strconv.ParseInt(limitStr, 10, 32); err == nil {
+			req.Limit = int32(l)
+		}
+	}
+	if offsetStr := q.Get("offset"); offsetStr != "" {
+		if o, err := strconv.ParseInt(offsetStr, 10, 32); err == nil {
+			req.Offset = int32(o)
+		}
+	}
+
+	resp, err := h.accountingClient.Client.ListReconciliationBreaks(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to list reconciliation breaks: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+	"x/shared/response"
+)
+
+// ============================================================================
+// TRANSACTION QUEUE (DEAD-LETTER) HANDLERS
+// ============================================================================
+
+var queuedTransactionStatuses = map[string]accountingpb.QueuedTransactionStatus{
+	"pending":    accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_PENDING,
+	"processing": accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_PROCESSING,
+	"completed":  accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_COMPLETED,
+	"failed":     accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_FAILED,
+	"dead":       accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_DEAD,
+}
+
+type RedriveTransactionsDTO struct {
+	IDs []int64 `json:"ids"`
+}
+
+// GET /admin/svc/accounting/transaction-queue?status=dead&receipt_code=&limit=&offset=
+func (h *AdminHandler) ListQueuedTransactions(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	req := &accountingpb.ListQueuedTransactionsRequest{
+		Limit:  100,
+		Offset: 0,
+	}
+
+	if statusStr := q.Get("status"); statusStr != "" {
+		status, ok := queuedTransactionStatuses[strings.ToLower(statusStr)]
+		if !ok {
+			response.Error(w, http.StatusBadRequest, "invalid status")
+			return
+		}
+		req.Status = &status
+	}
+	if receiptCode := q.Get("receipt_code"); receiptCode != "" {
+		req.ReceiptCode = &receiptCode
+	}
+	if limitStr := q.Get("limit"); limitStr != "" {
+		if l, err := strconv.ParseInt(limitStr, 10, 32); err == nil {
+			req.Limit = int32(l)
+		}
+	}
+	if offsetStr := q.Get("offset"); offsetStr != "" {
+		if o, err := strconv.ParseInt(offsetStr, 10, 32); err == nil {
+			req.Offset = int32(o)
+		}
+	}
+
+	resp, err := h.accountingClient.Client.ListQueuedTransactions(r.Context(), req)
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to list queued transactions: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// POST /admin/svc/accounting/transaction-queue/redrive
+func (h *AdminHandler) RedriveDeadLetterTransactions(w http.ResponseWriter, r *http.Request) {
+	userID, role, ok := h.getAdminContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if !h.isSuperAdmin(role) {
+		response.Error(w, http.StatusForbidden, "only super admin can redrive transactions")
+		return
+	}
+
+	var dto RedriveTransactionsDTO
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if len(dto.IDs) == 0 {
+		response.Error(w, http.StatusBadRequest, "ids are required")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.RedriveDeadLetterTransactions(r.Context(), &accountingpb.RedriveDeadLetterTransactionsRequest{
+		Ids:         dto.IDs,
+		RequestedBy: userID,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to redrive transactions: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
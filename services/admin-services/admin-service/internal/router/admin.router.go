@@ -125,6 +125,7 @@ func SetupRoutes(
 				tx.Post("/trade/loss", h.ProcessTradeLoss)
 				tx.Post("/commission", h.ProcessAgentCommission)
 				tx.Get("/{receipt}", h.GetTransactionByReceipt)
+				tx.Post("/{receipt}/reverse", h.ReverseTransaction)
 			})
 
 			// ---------------- Approval Management ----------------
@@ -134,6 +135,22 @@ func SetupRoutes(
 				apr.Get("/history", h.GetApprovalHistory)
 			})
 
+			// ---------------- Approval Policies ----------------
+			acc.Route("/approval-policies", func(pol chi.Router) {
+				pol.Get("/", h.ListApprovalPolicies)
+				pol.Post("/", h.CreateApprovalPolicy)
+				pol.Put("/{id}", h.UpdateApprovalPolicy)
+			})
+
+			// ---------------- Transaction Limits ----------------
+			acc.Route("/limit-profiles", func(lim chi.Router) {
+				lim.Get("/", h.ListLimitProfiles)
+				lim.Post("/", h.CreateLimitProfile)
+				lim.Put("/{id}", h.UpdateLimitProfile)
+				lim.Put("/kyc-tiers/{owner_type}/{owner_id}", h.SetOwnerKYCTier)
+				lim.Get("/accounts/{number}", h.GetAccountLimits)
+			})
+
 			// ---------------- Statements ----------------
 			acc.Route("/statements", func(stmt chi.Router) {
 				stmt.Post("/account", h.GetAccountStatement)
@@ -152,6 +169,21 @@ func SetupRoutes(
 				rpt.Get("/daily", h.GenerateDailyReport)
 				rpt.Get("/transaction-summary", h.GetTransactionSummary)
 				rpt.Get("/system-holdings", h.GetSystemHoldings)
+				rpt.Post("/periods/close", h.CloseAccountingPeriod)
+				rpt.Get("/trial-balance", h.GetTrialBalance)
+				rpt.Get("/general-ledger", h.GetGeneralLedger)
+			})
+
+			// ---------------- Reconciliation ----------------
+			acc.Route("/reconciliation", func(rec chi.Router) {
+				rec.Post("/run", h.RunReconciliation)
+				rec.Get("/breaks", h.ListReconciliationBreaks)
+			})
+
+			// ---------------- Transaction Queue ----------------
+			acc.Route("/transaction-queue", func(tq chi.Router) {
+				tq.Get("/", h.ListQueuedTransactions)
+				tq.Post("/redrive", h.RedriveDeadLetterTransactions)
 			})
 
 			// ---------------- Fee Management ----------------
@@ -161,6 +193,29 @@ func SetupRoutes(
 				fee.Get("/commission/{agent_id}", h.GetAgentCommissionSummary)
 			})
 
+			// ---------------- Fee Rules ----------------
+			acc.Route("/fee-rules", func(fr chi.Router) {
+				fr.Post("/simulate", h.SimulateFeeRules)
+				fr.Get("/{id}/versions", h.ListFeeRuleVersions)
+			})
+
+			// ---------------- Interest ----------------
+			acc.Route("/interest-plans", func(ip chi.Router) {
+				ip.Get("/", h.ListInterestRatePlans)
+				ip.Post("/", h.CreateInterestRatePlan)
+				ip.Put("/{id}", h.UpdateInterestRatePlan)
+				ip.Get("/postings", h.ListInterestPostings)
+				ip.Get("/accounts/{number}", h.GetAccountInterest)
+				ip.Post("/run", h.RunInterest)
+			})
+
+			// ---------------- Account Restrictions ----------------
+			acc.Get("/accounts/{number}/restrictions", h.ListAccountRestrictions)
+			acc.Post("/accounts/{number}/restrictions", h.PlaceAccountRestriction)
+			acc.Post("/accounts/{number}/close", h.CloseAccount)
+			acc.Get("/restrictions", h.ListAccountRestrictions)
+			acc.Post("/restrictions/{id}/release", h.ReleaseAccountRestriction)
+
 			// ---------------- Agent Management ----------------
 			acc.Route("/agents", func(agt chi.Router) {
 				agt.Post("/", h.CreateAgent)
@@ -172,6 +227,8 @@ func SetupRoutes(
 				agt.Get("/by-countries", h.GetAgentsByCountries)
 				agt.Get("/user/{user_id}", h.GetAgentByUserID)
 				agt.Get("/{agent_id}/commissions", h.ListCommissionsForAgent)
+				agt.Get("/{agent_id}/commissions/payouts", h.ListCommissionPayouts)
+				agt.Post("/{agent_id}/commissions/payouts", h.CreateCommissionPayoutBatch)
 			})
 		})
 
@@ -199,6 +256,10 @@ func SetupRoutes(
 				sys.Get("/wallets", h.GetSystemWallets)            // Get all system hot wallets
 				sys.Get("/balance", h.GetSystemBalance)            // Get system balance (query params: chain, asset, force_refresh)
 				sys.Get("/wallet", h.GetSystemWalletByAsset)       // Get specific system wallet (query params: chain, asset)
+
+				// Wallet key encryption
+				sys.Post("/rotate-master-key", h.RotateMasterKey)  // Re-wrap wallet data keys with a new KEK
+				sys.Get("/key-rotation", h.GetKeyRotationStatus)   // Rotation progress (query param: rotation_id)
 			})
 
 			// ---------------- Transaction Management ----------------
@@ -243,6 +304,20 @@ func SetupRoutes(
 				// Admin interventions (TODO - implement when needed)
 				dep.Post("/retry", h.RetryDepositProcessing)    // Retry stuck deposit
 				dep.Post("/fail", h.MarkDepositAsFailed)        // Mark deposit as failed
+
+				// Deposits frozen by address screening
+				dep.Get("/frozen", h.GetFrozenDeposits)                 // List frozen deposits
+				dep.Post("/frozen/release", h.ReleaseFrozenDeposit)     // Release and credit
+				dep.Post("/frozen/reject", h.RejectFrozenDeposit)       // Reject, never credited
+			})
+
+			// ---------------- Address Screening ----------------
+			crypto.Route("/screening", func(scr chi.Router) {
+				scr.Get("/entries", h.ListScreeningEntries)             // Blacklist / whitelist entries
+				scr.Post("/entries", h.AddScreeningEntry)               // Add entry
+				scr.Post("/entries/remove", h.RemoveScreeningEntry)     // Remove entry
+				scr.Post("/import", h.ImportScreeningList)              // Import sanction list (CSV/JSON)
+				scr.Get("/check", h.ScreenAddress)                      // Screen an address
 			})
 			// ---------------- Monitoring & Reports ----------------
 			crypto.Route("/monitoring", func(mon chi.Router) {
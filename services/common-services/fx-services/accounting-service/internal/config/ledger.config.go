@@ -2,7 +2,9 @@ package config
 
 import (
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type AppConfig struct {
@@ -25,6 +27,46 @@ type AppConfig struct {
 	// Environment
 	Environment string // "development", "staging", "production"
 
+	// FX rates
+	FX FXConfig
+
+	// Ledger reconciliation
+	ReconciliationInterval time.Duration // Scheduled run period (0 = manual runs only)
+
+	// Event outbox relay
+	Outbox OutboxConfig
+
+	// Demo account top-ups and resets
+	Demo DemoConfig
+}
+
+// DemoConfig configures demo account refills
+type DemoConfig struct {
+	DefaultBalances    map[string]string // Currency -> starting balance; overrides currencies.demo_initial_balance
+	TopUpCooldown      time.Duration     // Minimum gap between top-ups of one account
+	ResetCooldown      time.Duration     // Minimum gap between resets of one account
+	MaxBalanceMultiple int64             // Top-ups stop at this multiple of the default balance (0 = no cap)
+	LiquidityFloat     string            // Opening balance of each demo liquidity account
+}
+
+// OutboxConfig configures the relay from outbox_events to Redis streams
+type OutboxConfig struct {
+	RelayInterval time.Duration // Poll period for committed events
+	StreamMaxLen  int64         // Approximate entries kept per stream (replay window)
+	Retention     time.Duration // Sent rows are purged after this (0 = keep)
+}
+
+// FXConfig configures FX rate ingestion and conversion guards
+type FXConfig struct {
+	RateProviders []string          // Tried in order: "http", "static" (dev/test only, opt-in)
+	RatesURL      string            // HTTP JSON endpoint, %s = base currency
+	RatesAPIKey   string            // Sent as app_id when set
+	RatesFile     string            // Static provider file (dev/test)
+	Pairs         []string          // "BASE/QUOTE" pairs to ingest
+	SyncInterval  time.Duration     // Scheduler tick
+	RateTTL       time.Duration     // ConvertAndTransfer rejects older rates (0 = disabled)
+	DefaultSpread string            // Markup in percent applied to every pair
+	PairSpreads   map[string]string // "BASE/QUOTE" -> markup in percent
 }
 
 func Load() AppConfig {
@@ -47,6 +89,44 @@ func Load() AppConfig {
 		// Environment
 		Environment: getEnv("ENVIRONMENT", "development"),
 
+		// FX rates
+		FX: FXConfig{
+			RateProviders: getEnvSlice("FX_RATE_PROVIDERS", []string{"http"}),
+			RatesURL:      getEnv("FX_RATES_URL", ""),
+			RatesAPIKey:   getEnv("FX_RATES_API_KEY", ""),
+			RatesFile:     getEnv("FX_RATES_FILE", "config/fx_rates.json"),
+			Pairs: getEnvSlice("FX_PAIRS", []string{
+				"USD/USDT", "USDT/USD",
+				"BTC/USD", "USD/BTC",
+				"BTC/USDT", "USDT/BTC",
+				"TRX/USD", "USD/TRX",
+				"TRX/USDT", "USDT/TRX",
+				"TRX/BTC", "BTC/TRX",
+			}),
+			SyncInterval:  getEnvDuration("FX_SYNC_INTERVAL", 1*time.Minute),
+			RateTTL:       getEnvDuration("FX_RATE_TTL", 15*time.Minute),
+			DefaultSpread: getEnv("FX_DEFAULT_SPREAD", "0.2"),
+			PairSpreads:   getEnvMap("FX_PAIR_SPREADS", map[string]string{"USD/USDT": "0.05", "USDT/USD": "0.05"}),
+		},
+
+		// Ledger reconciliation
+		ReconciliationInterval: getEnvDuration("RECONCILIATION_INTERVAL", 24*time.Hour),
+
+		// Event outbox relay
+		Outbox: OutboxConfig{
+			RelayInterval: getEnvDuration("OUTBOX_RELAY_INTERVAL", 500*time.Millisecond),
+			StreamMaxLen:  getEnvInt64("OUTBOX_STREAM_MAXLEN", 1_000_000),
+			Retention:     getEnvDuration("OUTBOX_RETENTION", 7*24*time.Hour),
+		},
+
+		// Demo account top-ups and resets
+		Demo: DemoConfig{
+			DefaultBalances:    getEnvMap("DEMO_DEFAULT_BALANCES", map[string]string{}),
+			TopUpCooldown:      getEnvDuration("DEMO_TOPUP_COOLDOWN", 24*time.Hour),
+			ResetCooldown:      getEnvDuration("DEMO_RESET_COOLDOWN", 1*time.Hour),
+			MaxBalanceMultiple: getEnvInt64("DEMO_MAX_BALANCE_MULTIPLE", 10),
+			LiquidityFloat:     getEnv("DEMO_LIQUIDITY_FLOAT", "1000000000"),
+		},
 	}
 }
 
@@ -64,6 +144,40 @@ func getEnvSlice(key string, defaultValue []string) []string {
 	return defaultValue
 }
 
+func getEnvInt64(key string, defaultValue int64) int64 {
+	if value := os.Getenv(key); value != "" {
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return n
+		}
+	}
+	return defaultValue
+}
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return defaultValue
+}
+
+// getEnvMap parses "k1=v1,k2=v2"
+func getEnvMap(key string, defaultValue map[string]string) map[string]string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	result := make(map[string]string)
+	for _, item := range strings.Split(value, ",") {
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		result[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return result
+}
 
 func getEnvBool(key string, defaultValue bool) bool {
 	// Implementation to get bool from env var
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // Account represents a ledger account for user, agent, partner, or system
@@ -17,37 +19,44 @@ type Account struct {
 	AccountType   AccountType    `json:"account_type" db:"account_type"`     // real | demo
 
 	// Status and control fields
-	IsActive       bool    `json:"is_active" db:"is_active"`
-	IsLocked       bool    `json:"is_locked" db:"is_locked"`
-	OverdraftLimit float64 `json:"overdraft_limit" db:"overdraft_limit"` // In smallest currency unit (cents/satoshis)
+	IsActive       bool            `json:"is_active" db:"is_active"`
+	IsLocked       bool            `json:"is_locked" db:"is_locked"`
+	OverdraftLimit decimal.Decimal `json:"overdraft_limit" db:"overdraft_limit"` // In currency units (scaled by currencies.decimals)
 
 	// Agent-specific fields (only for accounts owned by agents or user accounts with agent parents)
 	ParentAgentExternalID *string `json:"parent_agent_external_id,omitempty" db:"parent_agent_external_id"` // Agent external ID from auth service
 	CommissionRate        *string `json:"commission_rate,omitempty" db:"commission_rate"`                   // NUMERIC(5,4) as string - e.g., "0.0025" for 0.25%
 
+	// Hierarchy fields (only for sub-accounts)
+	ParentAccountID     *int64  `json:"parent_account_id,omitempty" db:"parent_account_id"`
+	ParentAccountNumber *string `json:"parent_account_number,omitempty" db:"-"` // Loaded with the account
+	Name                *string `json:"name,omitempty" db:"name"`               // Required for sub-accounts
+
 	// Timestamps
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 
 	// Related objects (not persisted, loaded separately)
-	Balance *Balance `json:"balance,omitempty" db:"-"`
+	Balance      *Balance                 `json:"balance,omitempty" db:"-"`
+	Restrictions *AccountRestrictionState `json:"restrictions,omitempty" db:"-"` // Loaded when the account is posted to
 }
 
 // AccountPurpose represents the purpose of an account
 type AccountPurpose string
 
 const (
-	PurposeLiquidity  AccountPurpose = "liquidity"
-	PurposeClearing   AccountPurpose = "clearing"
-	PurposeFees       AccountPurpose = "fees"
-	PurposeWallet     AccountPurpose = "wallet"
-	PurposeEscrow     AccountPurpose = "escrow"
-	PurposeSettlement AccountPurpose = "settlement"
-	PurposeRevenue    AccountPurpose = "revenue"
-	PurposeContra     AccountPurpose = "contra"
-	PurposeCommission AccountPurpose = "commission"
-	PurposeInvestment AccountPurpose = "investment"
-	PurposeSavings    AccountPurpose = "savings"
+	PurposeLiquidity       AccountPurpose = "liquidity"
+	PurposeClearing        AccountPurpose = "clearing"
+	PurposeFees            AccountPurpose = "fees"
+	PurposeWallet          AccountPurpose = "wallet"
+	PurposeEscrow          AccountPurpose = "escrow"
+	PurposeSettlement      AccountPurpose = "settlement"
+	PurposeRevenue         AccountPurpose = "revenue"
+	PurposeContra          AccountPurpose = "contra"
+	PurposeCommission      AccountPurpose = "commission"
+	PurposeInvestment      AccountPurpose = "investment"
+	PurposeSavings         AccountPurpose = "savings"
+	PurposeInterestExpense AccountPurpose = "interest_expense" // System account interest is paid from
 )
 
 // AccountFilter supports efficient filtering for high-throughput queries
@@ -61,6 +70,7 @@ type AccountFilter struct {
 	IsLocked              *bool
 	AccountNumber         *string
 	ParentAgentExternalID *string
+	ParentAccountID       *int64
 }
 
 // CreateAccountRequest is used for account creation
@@ -72,17 +82,17 @@ type CreateAccountRequest struct {
 	AccountType           AccountType
 	ParentAgentExternalID *string
 	CommissionRate        *string // NUMERIC(5,4) as string
-	OverdraftLimit        float64
-	InitialBalance        float64
+	OverdraftLimit        decimal.Decimal
+	InitialBalance        decimal.Decimal
 }
 
 // AccountTotals represents calculated totals for an account
 type AccountTotals struct {
     AccountNumber    string
     AccountType      AccountType
-    TotalDebits      float64
-    TotalCredits     float64
-    NetChange        float64
+    TotalDebits      decimal.Decimal
+    TotalCredits     decimal.Decimal
+    NetChange        decimal.Decimal
     TransactionCount int64      // ✅ Changed to int64 (it's a count)
     PeriodStart      time.  Time
     PeriodEnd        time. Time
@@ -93,8 +103,16 @@ type AccountBalanceSummary struct {
 	AccountID        int64
 	AccountNumber    string
 	Currency         string
-	Balance          float64
-	AvailableBalance float64
+	Balance          decimal.Decimal
+	AvailableBalance decimal.Decimal
+
+	// Hierarchy: a main account's rolled-up balances include its sub-accounts
+	ParentAccountID          *int64
+	ParentAccountNumber      *string
+	Name                     *string
+	SubAccountCount          int
+	RolledUpBalance          decimal.Decimal
+	RolledUpAvailableBalance decimal.Decimal
 }
 
 // IsValid checks if the account has valid required fields
@@ -141,9 +159,33 @@ func (a *Account) HasAgentParent() bool {
 	return a.ParentAgentExternalID != nil && *a.ParentAgentExternalID != ""
 }
 
+// IsSubAccount returns true if this account sits under a main account
+func (a *Account) IsSubAccount() bool {
+	return a.ParentAccountID != nil
+}
+
+// MainAccountID returns the ID of the main account of this account's hierarchy
+func (a *Account) MainAccountID() int64 {
+	if a.ParentAccountID != nil {
+		return *a.ParentAccountID
+	}
+	return a.ID
+}
+
+// SharesHierarchyWith returns true if both accounts are distinct members of
+// the same hierarchy: siblings, or a main account and one of its sub-accounts
+func (a *Account) SharesHierarchyWith(other *Account) bool {
+	if a.ID == other.ID || (!a.IsSubAccount() && !other.IsSubAccount()) {
+		return false
+	}
+	return a.OwnerType == other.OwnerType &&
+		a.OwnerID == other.OwnerID &&
+		a.MainAccountID() == other.MainAccountID()
+}
+
 // CanOverdraft returns true if this account allows overdraft
 func (a *Account) CanOverdraft() bool {
-	return a.OverdraftLimit > 0
+	return a.OverdraftLimit.IsPositive()
 }
 
 // DefaultSystemAccounts returns system accounts for initialization
@@ -162,7 +204,7 @@ func DefaultSystemAccounts() []*Account {
 			AccountType:    AccountTypeReal,
 			IsActive:       true,
 			IsLocked:       false,
-			OverdraftLimit: 0,
+			OverdraftLimit: decimal.Zero,
 			CreatedAt:      now,
 			UpdatedAt:      now,
 		},
@@ -175,7 +217,7 @@ func DefaultSystemAccounts() []*Account {
 			AccountType:    AccountTypeReal,
 			IsActive:       true,
 			IsLocked:       false,
-			OverdraftLimit: 0,
+			OverdraftLimit: decimal.Zero,
 			CreatedAt:      now,
 			UpdatedAt:      now,
 		},
@@ -188,7 +230,7 @@ func DefaultSystemAccounts() []*Account {
 			AccountType:    AccountTypeReal,
 			IsActive:       true,
 			IsLocked:       false,
-			OverdraftLimit: 0,
+			OverdraftLimit: decimal.Zero,
 			CreatedAt:      now,
 			UpdatedAt:      now,
 		},
@@ -202,7 +244,7 @@ func DefaultSystemAccounts() []*Account {
 			AccountType:    AccountTypeReal,
 			IsActive:       true,
 			IsLocked:       false,
-			OverdraftLimit: 0,
+			OverdraftLimit: decimal.Zero,
 			CreatedAt:      now,
 			UpdatedAt:      now,
 		},
@@ -215,7 +257,7 @@ func DefaultSystemAccounts() []*Account {
 			AccountType:    AccountTypeReal,
 			IsActive:       true,
 			IsLocked:       false,
-			OverdraftLimit: 0,
+			OverdraftLimit: decimal.Zero,
 			CreatedAt:      now,
 			UpdatedAt:      now,
 		},
@@ -228,7 +270,7 @@ func DefaultSystemAccounts() []*Account {
 			AccountType:    AccountTypeReal,
 			IsActive:       true,
 			IsLocked:       false,
-			OverdraftLimit: 0,
+			OverdraftLimit: decimal.Zero,
 			CreatedAt:      now,
 			UpdatedAt:      now,
 		},
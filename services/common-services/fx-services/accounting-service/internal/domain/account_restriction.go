@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// AccountRestrictionType is a compliance control on an account
+type AccountRestrictionType string
+
+const (
+	RestrictionDebitFreeze  AccountRestrictionType = "debit_freeze"  // No debits; credits still arrive
+	RestrictionCreditFreeze AccountRestrictionType = "credit_freeze" // No credits; debits still allowed
+	RestrictionFullFreeze   AccountRestrictionType = "full_freeze"   // No postings either way
+	RestrictionLien         AccountRestrictionType = "lien"          // Amount debits cannot touch
+	RestrictionClosure      AccountRestrictionType = "closure"       // Permanent; never released or expired
+)
+
+func (t AccountRestrictionType) IsValid() bool {
+	switch t {
+	case RestrictionDebitFreeze, RestrictionCreditFreeze, RestrictionFullFreeze, RestrictionLien, RestrictionClosure:
+		return true
+	}
+	return false
+}
+
+// AccountRestriction is one freeze, lien or closure placed on an account.
+// Rows are never deleted: a release records who lifted it and why, so the
+// table is the account's restriction history.
+type AccountRestriction struct {
+	ID            int64                  `json:"id"`
+	AccountID     int64                  `json:"account_id"`
+	AccountNumber string                 `json:"account_number"`
+	Type          AccountRestrictionType `json:"type"`
+	Amount        *decimal.Decimal       `json:"amount,omitempty"`    // Liens only
+	Reason        string                 `json:"reason"`              // Required
+	Reference     *string                `json:"reference,omitempty"` // Case, court order or ticket number
+	CreatedBy     string                 `json:"created_by"`
+	ExpiresAt     *time.Time             `json:"expires_at,omitempty"` // nil = until released
+	CreatedAt     time.Time              `json:"created_at"`
+
+	ReleasedAt    *time.Time `json:"released_at,omitempty"`
+	ReleasedBy    *string    `json:"released_by,omitempty"`
+	ReleaseReason *string    `json:"release_reason,omitempty"`
+
+	// Closure only: where the remaining balance was swept
+	SweepAccountNumber *string          `json:"sweep_account_number,omitempty"`
+	SweptAmount        *decimal.Decimal `json:"swept_amount,omitempty"`
+	SweepReceiptCode   *string          `json:"sweep_receipt_code,omitempty"`
+}
+
+// IsActive reports whether the restriction is enforced at the given time
+func (r *AccountRestriction) IsActive(now time.Time) bool {
+	if r.ReleasedAt != nil {
+		return false
+	}
+	return r.ExpiresAt == nil || r.ExpiresAt.After(now)
+}
+
+// Status is active, released or expired
+func (r *AccountRestriction) Status(now time.Time) string {
+	switch {
+	case r.ReleasedAt != nil:
+		return "released"
+	case r.ExpiresAt != nil && !r.ExpiresAt.After(now):
+		return "expired"
+	default:
+		return "active"
+	}
+}
+
+// Validate checks a restriction about to be placed
+func (r *AccountRestriction) Validate(now time.Time) error {
+	r.Reason = strings.TrimSpace(r.Reason)
+	if !r.Type.IsValid() {
+		return fmt.Errorf("%w: unknown type %q", xerrors.ErrInvalidAccountRestriction, r.Type)
+	}
+	if r.Reason == "" {
+		return fmt.Errorf("%w: reason is required", xerrors.ErrInvalidAccountRestriction)
+	}
+	if r.CreatedBy == "" {
+		return fmt.Errorf("%w: created_by is required", xerrors.ErrInvalidAccountRestriction)
+	}
+	if r.Type == RestrictionLien {
+		if r.Amount == nil || !r.Amount.IsPositive() {
+			return fmt.Errorf("%w: a lien needs a positive amount", xerrors.ErrInvalidAccountRestriction)
+		}
+	} else if r.Amount != nil {
+		return fmt.Errorf("%w: only liens take an amount", xerrors.ErrInvalidAccountRestriction)
+	}
+	if r.ExpiresAt != nil {
+		if r.Type == RestrictionClosure {
+			return fmt.Errorf("%w: closure cannot expire", xerrors.ErrInvalidAccountRestriction)
+		}
+		if !r.ExpiresAt.After(now) {
+			return fmt.Errorf("%w: expires_at must be in the future", xerrors.ErrInvalidAccountRestriction)
+		}
+	}
+	return nil
+}
+
+// AccountRestrictionState folds an account's active restrictions into what
+// postings may do. Loaded with the account when it is posted to.
+type AccountRestrictionState struct {
+	Closed       bool            `json:"closed"`
+	DebitFrozen  bool            `json:"debit_frozen"`
+	CreditFrozen bool            `json:"credit_frozen"`
+	LienAmount   decimal.Decimal `json:"lien_amount"` // Sum of active liens
+	Restrictions int             `json:"restrictions"`
+}
+
+func NewAccountRestrictionState(restrictions []*AccountRestriction) *AccountRestrictionState {
+	state := &AccountRestrictionState{LienAmount: decimal.Zero}
+	for _, r := range restrictions {
+		state.Restrictions++
+		switch r.Type {
+		case RestrictionClosure:
+			state.Closed = true
+		case RestrictionDebitFreeze:
+			state.DebitFrozen = true
+		case RestrictionCreditFreeze:
+			state.CreditFrozen = true
+		case RestrictionFullFreeze:
+			state.DebitFrozen = true
+			state.CreditFrozen = true
+		case RestrictionLien:
+			if r.Amount != nil {
+				state.LienAmount = state.LienAmount.Add(*r.Amount)
+			}
+		}
+	}
+	return state
+}
+
+// CheckPosting rejects debits or credits the restrictions forbid. Liens are
+// checked against the balance separately.
+func (s *AccountRestrictionState) CheckPosting(debit, credit bool) error {
+	if s == nil {
+		return nil
+	}
+	if s.Closed {
+		return xerrors.ErrAccountClosed
+	}
+	if debit && s.DebitFrozen {
+		return xerrors.ErrAccountDebitFrozen
+	}
+	if credit && s.CreditFrozen {
+		return xerrors.ErrAccountCreditFrozen
+	}
+	return nil
+}
+
+// Liened is the part of the balance debits cannot use
+func (s *AccountRestrictionState) Liened() decimal.Decimal {
+	if s == nil {
+		return decimal.Zero
+	}
+	return s.LienAmount
+}
+
+// PlaceAccountRestrictionRequest freezes or liens an account
+type PlaceAccountRestrictionRequest struct {
+	AccountNumber string
+	Type          AccountRestrictionType
+	Amount        *decimal.Decimal
+	Reason        string
+	Reference     *string
+	ExpiresAt     *time.Time
+	Actor         string
+}
+
+// CloseAccountRequest sweeps the balance to SweepToAccountNumber and closes
+// the account for good
+type CloseAccountRequest struct {
+	AccountNumber        string
+	SweepToAccountNumber string // Same currency and account type; may be empty if the balance is zero
+	Reason               string
+	Reference            *string
+	Actor                string
+}
+
+type AccountRestrictionFilter struct {
+	AccountNumber *string
+	Type          *AccountRestrictionType
+	ActiveOnly    bool
+	Limit         int
+	Offset        int
+}
@@ -5,6 +5,8 @@ import (
     "encoding/json"
     "time"
 	"x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
 )
 
 type ApprovalStatus string
@@ -15,6 +17,13 @@ const (
     ApprovalStatusRejected ApprovalStatus = "rejected"
     ApprovalStatusExecuted ApprovalStatus = "executed"
     ApprovalStatusFailed   ApprovalStatus = "failed"
+    ApprovalStatusExpired  ApprovalStatus = "expired" // Quorum not reached before ExpiresAt
+)
+
+const (
+    DefaultApproverRole       = "super_admin"
+    DefaultApprovalTTL        = 72 * time.Hour // Pending requests without a policy expire after this
+    ApprovalMaxExecutionTries = 5              // Retryable execution failures before an approval is failed
 )
 
 type TransactionApproval struct {
@@ -22,14 +31,24 @@ type TransactionApproval struct {
     RequestedBy       int64                  `json:"requested_by" db:"requested_by"`
     TransactionType   TransactionType        `json:"transaction_type" db:"transaction_type"`
     AccountNumber     string                 `json:"account_number" db:"account_number"`
-    Amount            float64                `json:"amount" db:"amount"`
+    Amount            decimal.Decimal        `json:"amount" db:"amount"`
     Currency          string                 `json:"currency" db:"currency"`
     Description       *string                `json:"description,omitempty" db:"description"`
     ToAccountNumber   *string                `json:"to_account_number,omitempty" db:"to_account_number"`
     
     Status            ApprovalStatus         `json:"status" db:"status"`
-    ApprovedBy        *int64                 `json:"approved_by,omitempty" db:"approved_by"`
+    ApprovedBy        *int64                 `json:"approved_by,omitempty" db:"approved_by"` // Approver whose decision completed the quorum
     RejectionReason   *string                `json:"rejection_reason,omitempty" db:"rejection_reason"`
+
+    // Policy snapshot, copied at creation so policy edits don't move in-flight requests
+    PolicyID          *int64                 `json:"policy_id,omitempty" db:"policy_id"`
+    RequiredApprovals int                    `json:"required_approvals" db:"required_approvals"`
+    ApproverRoles     []string               `json:"approver_roles" db:"approver_roles"`
+    DistinctRoles     bool                   `json:"distinct_roles" db:"distinct_roles"`
+    ExpiresAt         *time.Time             `json:"expires_at,omitempty" db:"expires_at"`
+    Decisions         []*ApprovalDecision    `json:"decisions,omitempty" db:"-"`
+
+    ExecutionAttempts int                    `json:"execution_attempts" db:"execution_attempts"`
     
     ReceiptCode       *string                `json:"receipt_code,omitempty" db:"receipt_code"`
     ErrorMessage      *string                `json:"error_message,omitempty" db:"error_message"`
@@ -46,7 +65,7 @@ type CreateApprovalRequest struct {
     RequestedBy      int64
     TransactionType  TransactionType
     AccountNumber    string
-    Amount           float64
+    Amount           decimal.Decimal
     Currency         string
     Description      *string
     ToAccountNumber  *string
@@ -54,10 +73,22 @@ type CreateApprovalRequest struct {
 }
 
 type ApproveApprovalRequest struct {
-    RequestID  int64
-    ApprovedBy int64
-    Approved   bool
-    Reason     *string
+    RequestID    int64
+    ApprovedBy   int64
+    ApproverRole string
+    Approved     bool
+    Reason       *string
+}
+
+// ApprovalDecision is one approver's vote on a TransactionApproval
+type ApprovalDecision struct {
+    ID           int64     `json:"id" db:"id"`
+    ApprovalID   int64     `json:"approval_id" db:"approval_id"`
+    ApproverID   int64     `json:"approver_id" db:"approver_id"`
+    ApproverRole string    `json:"approver_role" db:"approver_role"`
+    Approved     bool      `json:"approved" db:"approved"`
+    Reason       *string   `json:"reason,omitempty" db:"reason"`
+    CreatedAt    time.Time `json:"created_at" db:"created_at"`
 }
 
 type ApprovalFilter struct {
@@ -77,7 +108,7 @@ func (a *TransactionApproval) Validate() error {
     if a.AccountNumber == "" {
         return xerrors.ErrInvalidAccountNumber
     }
-    if a.Amount <= 0 {
+    if !a.Amount.IsPositive() {
         return xerrors.ErrInvalidAmount
     }
     // if a.Currency == "" {
@@ -89,4 +120,56 @@ func (a *TransactionApproval) Validate() error {
         }
     }
     return nil
-}
\ No newline at end of file
+}
+
+// IsExpired reports whether a pending request has outlived its policy window
+func (a *TransactionApproval) IsExpired(now time.Time) bool {
+    return a.Status == ApprovalStatusPending && a.ExpiresAt != nil && now.After(*a.ExpiresAt)
+}
+
+// CanApprove reports whether a role may vote under the request's policy
+func (a *TransactionApproval) CanApprove(role string) bool {
+    if len(a.ApproverRoles) == 0 {
+        return true
+    }
+    for _, r := range a.ApproverRoles {
+        if r == role {
+            return true
+        }
+    }
+    return false
+}
+
+// QuorumReached counts approving decisions (one per distinct role when the
+// policy requires different roles) against RequiredApprovals
+func (a *TransactionApproval) QuorumReached() bool {
+    required := a.RequiredApprovals
+    if required <= 0 {
+        required = 1
+    }
+
+    approvers := make(map[int64]bool)
+    roles := make(map[string]bool)
+    for _, d := range a.Decisions {
+        if !d.Approved {
+            continue
+        }
+        approvers[d.ApproverID] = true
+        roles[d.ApproverRole] = true
+    }
+
+    if a.DistinctRoles {
+        return len(approvers) >= required && len(roles) >= required
+    }
+    return len(approvers) >= required
+}
+
+// HasApprovalFromRole reports whether an approving decision from role is already recorded
+func (a *TransactionApproval) HasApprovalFromRole(role string) bool {
+    for _, d := range a.Decisions {
+        if d.Approved && d.ApproverRole == role {
+            return true
+        }
+    }
+    return false
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"time"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// ApprovalPolicy decides how many approvers a manual ledger operation needs.
+// A policy matches on transaction type and currency (nil = any) and on an
+// amount band [MinAmount, MaxAmount). When several match, the most specific
+// one wins, then the highest Priority, then the highest MinAmount.
+type ApprovalPolicy struct {
+	ID                int64            `json:"id" db:"id"`
+	Name              string           `json:"name" db:"name"`
+	TransactionType   *TransactionType `json:"transaction_type,omitempty" db:"transaction_type"`
+	Currency          *string          `json:"currency,omitempty" db:"currency"`
+	MinAmount         decimal.Decimal  `json:"min_amount" db:"min_amount"`
+	MaxAmount         *decimal.Decimal `json:"max_amount,omitempty" db:"max_amount"` // Exclusive; nil = unbounded
+	RequiredApprovals int              `json:"required_approvals" db:"required_approvals"`
+	ApproverRoles     []string         `json:"approver_roles" db:"approver_roles"` // Roles allowed to vote; empty = any admin
+	DistinctRoles     bool             `json:"distinct_roles" db:"distinct_roles"` // Each approval must come from a different role
+	ExpiresAfter      time.Duration    `json:"expires_after" db:"expires_after_seconds"`
+	Priority          int              `json:"priority" db:"priority"`
+	IsActive          bool             `json:"is_active" db:"is_active"`
+	CreatedBy         string           `json:"created_by" db:"created_by"`
+	CreatedAt         time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at" db:"updated_at"`
+}
+
+// ApprovalPolicyFilter selects policies for listing
+type ApprovalPolicyFilter struct {
+	TransactionType *TransactionType
+	Currency        *string
+	ActiveOnly      bool
+}
+
+// DefaultApprovalPolicy applies when no policy matches: one super admin, as
+// before policies existed
+func DefaultApprovalPolicy() *ApprovalPolicy {
+	return &ApprovalPolicy{
+		Name:              "default",
+		RequiredApprovals: 1,
+		ApproverRoles:     []string{DefaultApproverRole},
+		ExpiresAfter:      DefaultApprovalTTL,
+		IsActive:          true,
+	}
+}
+
+func (p *ApprovalPolicy) Validate() error {
+	if p.Name == "" {
+		return xerrors.ErrRequiredFieldMissing
+	}
+	if p.MinAmount.IsNegative() {
+		return xerrors.ErrInvalidAmount
+	}
+	if p.MaxAmount != nil && p.MaxAmount.LessThanOrEqual(p.MinAmount) {
+		return xerrors.ErrInvalidAmount
+	}
+	if p.RequiredApprovals < 1 {
+		return xerrors.ErrInvalidInput
+	}
+	if p.DistinctRoles && len(p.ApproverRoles) > 0 && len(p.ApproverRoles) < p.RequiredApprovals {
+		// Not enough roles to ever reach the quorum
+		return xerrors.ErrInvalidInput
+	}
+	if p.ExpiresAfter < 0 {
+		return xerrors.ErrInvalidInput
+	}
+	return nil
+}
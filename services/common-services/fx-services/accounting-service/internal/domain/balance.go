@@ -1,24 +1,28 @@
 package domain
 
-import "time"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
 
 // Balance represents current balance of an account
 // Separate table for faster queries and optimistic locking
 type Balance struct {
-	AccountID        int64     `json:"account_id" db:"account_id"`
-	Balance          float64   `json:"balance" db:"balance"`                     // Total balance in smallest currency unit
-	AvailableBalance float64   `json:"available_balance" db:"available_balance"` // Available for withdrawal/trading
-	PendingDebit     float64   `json:"pending_debit" db:"pending_debit"`         // Pending outgoing funds
-	PendingCredit    float64   `json:"pending_credit" db:"pending_credit"`       // Pending incoming funds
-	LastLedgerID     *int64    `json:"last_ledger_id,omitempty" db:"last_ledger_id"`
-	Version          int64     `json:"version" db:"version"` // For optimistic locking
-	UpdatedAt        time.Time `json:"updated_at" db:"updated_at"`
+	AccountID        int64           `json:"account_id" db:"account_id"`
+	Balance          decimal.Decimal `json:"balance" db:"balance"`                     // Total balance in currency units
+	AvailableBalance decimal.Decimal `json:"available_balance" db:"available_balance"` // Available for withdrawal/trading
+	PendingDebit     decimal.Decimal `json:"pending_debit" db:"pending_debit"`         // Pending outgoing funds
+	PendingCredit    decimal.Decimal `json:"pending_credit" db:"pending_credit"`       // Pending incoming funds
+	LastLedgerID     *int64          `json:"last_ledger_id,omitempty" db:"last_ledger_id"`
+	Version          int64           `json:"version" db:"version"` // For optimistic locking
+	UpdatedAt        time.Time       `json:"updated_at" db:"updated_at"`
 }
 
 // BalanceUpdate represents a balance update operation
 type BalanceUpdate struct {
 	AccountID     int64
-	Amount        float64
+	Amount        decimal.Decimal
 	DrCr          string // "DR" or "CR"
 	LedgerID      int64
 	UpdatePending bool // If true, updates pending_debit/pending_credit
@@ -27,7 +31,7 @@ type BalanceUpdate struct {
 // BalanceLock represents a hold on funds
 type BalanceLock struct {
 	AccountID int64
-	Amount    float64
+	Amount    decimal.Decimal
 	Version   int64 // Expected version for optimistic locking
 }
 
@@ -37,8 +41,8 @@ type BalanceSnapshot struct {
 	AccountID        int64       `json:"account_id"`
 	AccountNumber    string      `json:"account_number,omitempty"`
 	Timestamp        time.Time   `json:"timestamp"`
-	Balance          float64     `json:"balance"`           // Total balance at this time
-	AvailableBalance float64     `json:"available_balance"` // Available balance at this time
+	Balance          decimal.Decimal `json:"balance"`           // Total balance at this time
+	AvailableBalance decimal.Decimal `json:"available_balance"` // Available balance at this time
 	Currency         string      `json:"currency"`
 	AccountType      AccountType `json:"account_type"`
 }
@@ -72,9 +76,9 @@ type BalanceHistory struct {
 	EndDate       time.Time          `json:"end_date"`
 	Interval      BalanceInterval    `json:"interval"`
 	Snapshots     []*BalanceSnapshot `json:"snapshots"`
-	MinBalance    float64            `json:"min_balance"`
-	MaxBalance    float64            `json:"max_balance"`
-	AvgBalance    float64            `json:"avg_balance"`
+	MinBalance    decimal.Decimal    `json:"min_balance"`
+	MaxBalance    decimal.Decimal    `json:"max_balance"`
+	AvgBalance    decimal.Decimal    `json:"avg_balance"`
 }
 
 // AddSnapshot adds a snapshot to the history and updates statistics
@@ -89,17 +93,17 @@ func (h *BalanceHistory) AddSnapshot(snapshot *BalanceSnapshot) {
 		h.AvgBalance = snapshot. Balance
 	} else {
 		// Update min/max
-		if snapshot.Balance < h. MinBalance {
+		if snapshot.Balance.LessThan(h.MinBalance) {
 			h.MinBalance = snapshot.Balance
 		}
-		if snapshot.Balance > h.MaxBalance {
-			h. MaxBalance = snapshot.Balance
+		if snapshot.Balance.GreaterThan(h.MaxBalance) {
+			h.MaxBalance = snapshot.Balance
 		}
 
 		// ✅ Incremental average calculation (more efficient)
 		// Formula: new_avg = old_avg + (new_value - old_avg) / n
-		n := float64(len(h.Snapshots))
-		h.AvgBalance = h.AvgBalance + (snapshot.Balance - h.AvgBalance) / n
+		n := decimal.NewFromInt(int64(len(h.Snapshots)))
+		h.AvgBalance = h.AvgBalance.Add(snapshot.Balance.Sub(h.AvgBalance).Div(n))
 	}
 }
 
@@ -128,15 +132,15 @@ func (h *BalanceHistory) GetSnapshotAt(t time.Time) *BalanceSnapshot {
 }
 
 // GetBalanceChange returns the change in balance over the period
-func (h *BalanceHistory) GetBalanceChange() float64 {
+func (h *BalanceHistory) GetBalanceChange() decimal.Decimal {
 	if len(h.Snapshots) < 2 {
-		return 0
+		return decimal.Zero
 	}
 
 	first := h.Snapshots[0]
 	last := h.Snapshots[len(h.Snapshots)-1]
 
-	return last.Balance - first.Balance
+	return last.Balance.Sub(first.Balance)
 }
 
 // GetBalanceChangePercent returns the percentage change in balance
@@ -148,10 +152,11 @@ func (h *BalanceHistory) GetBalanceChangePercent() float64 {
 	first := h.Snapshots[0]
 	last := h.Snapshots[len(h.Snapshots)-1]
 
-	if first.Balance == 0 {
+	if first.Balance.IsZero() {
 		return 0
 	}
 
-	change := last.Balance - first.Balance
-	return (float64(change) / float64(first.Balance)) * 100
+	change := last.Balance.Sub(first.Balance)
+	pct, _ := change.Div(first.Balance).Mul(decimal.NewFromInt(100)).Float64()
+	return pct
 }
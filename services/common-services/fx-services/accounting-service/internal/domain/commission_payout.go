@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// CommissionPayoutStatus is the lifecycle of a commission payout
+type CommissionPayoutStatus string
+
+const (
+	CommissionPayoutPending CommissionPayoutStatus = "pending" // Commissions reserved, transfer not yet recorded
+	CommissionPayoutPaid    CommissionPayoutStatus = "paid"    // Transfer posted, commissions marked paid
+	CommissionPayoutFailed  CommissionPayoutStatus = "failed"  // Transfer rejected, commissions released
+)
+
+// CommissionPayout settles an agent's unpaid commissions in one currency with
+// a single transfer from the system commission account to the agent account.
+// Preview payouts (dry run) have no ID.
+type CommissionPayout struct {
+	ID              int64                  `json:"id"`
+	BatchID         string                 `json:"batch_id"`
+	AgentExternalID string                 `json:"agent_external_id"`
+	Currency        string                 `json:"currency"`
+	Amount          decimal.Decimal        `json:"amount"`           // Net of clawbacks
+	CommissionCount int                    `json:"commission_count"` // Commissions included
+	PeriodFrom      *time.Time             `json:"period_from,omitempty"`
+	PeriodTo        time.Time              `json:"period_to"`
+	Status          CommissionPayoutStatus `json:"status"`
+	ReceiptCode     *string                `json:"receipt_code,omitempty"`
+	ErrorMessage    *string                `json:"error_message,omitempty"`
+	CreatedBy       string                 `json:"created_by"`
+	CreatedAt       time.Time              `json:"created_at"`
+	PaidAt          *time.Time             `json:"paid_at,omitempty"`
+}
+
+// CommissionPayoutBatchRequest selects the unpaid commissions to pay out.
+// Commissions accrued in [PeriodFrom, PeriodTo) are grouped per agent and
+// currency; groups below MinPayoutAmount stay unpaid for a later batch.
+type CommissionPayoutBatchRequest struct {
+	AgentExternalID *string         `json:"agent_external_id,omitempty"` // nil = all agents
+	Currency        *string         `json:"currency,omitempty"`          // nil = all currencies
+	PeriodFrom      *time.Time      `json:"period_from,omitempty"`       // nil = since the first commission
+	PeriodTo        time.Time       `json:"period_to"`
+	MinPayoutAmount decimal.Decimal `json:"min_payout_amount"`
+	DryRun          bool            `json:"dry_run"`
+	CreatedBy       string          `json:"created_by"`
+}
+
+func (r *CommissionPayoutBatchRequest) Validate() error {
+	if r.CreatedBy == "" {
+		return fmt.Errorf("%w: created_by", xerrors.ErrRequiredFieldMissing)
+	}
+	if r.PeriodTo.IsZero() {
+		return fmt.Errorf("%w: period_to", xerrors.ErrRequiredFieldMissing)
+	}
+	if r.PeriodFrom != nil && !r.PeriodFrom.Before(r.PeriodTo) {
+		return fmt.Errorf("%w: period_from must be before period_to", xerrors.ErrInvalidInput)
+	}
+	if r.MinPayoutAmount.IsNegative() {
+		return fmt.Errorf("%w: min_payout_amount cannot be negative", xerrors.ErrInvalidAmount)
+	}
+	return nil
+}
+
+// CommissionPayoutBatchResult reports what a batch paid (or would pay, for a
+// dry run) and which groups were held back by the threshold
+type CommissionPayoutBatchResult struct {
+	BatchID     string              `json:"batch_id"`
+	DryRun      bool                `json:"dry_run"`
+	Payouts     []*CommissionPayout `json:"payouts"`
+	Skipped     []*CommissionPayout `json:"skipped"` // Below MinPayoutAmount or net non-positive
+	PaidCount   int                 `json:"paid_count"`
+	FailedCount int                 `json:"failed_count"`
+}
+
+// UnpaidCommissionGroup is the sum of an agent's unpaid commissions in one currency
+type UnpaidCommissionGroup struct {
+	AgentExternalID string
+	Currency        string
+	Amount          decimal.Decimal
+	Count           int
+}
+
+// CommissionPayoutFilter selects payouts for listing
+type CommissionPayoutFilter struct {
+	AgentExternalID *string
+	BatchID         *string
+	Currency        *string
+	Status          *CommissionPayoutStatus
+	Limit           int
+	Offset          int
+}
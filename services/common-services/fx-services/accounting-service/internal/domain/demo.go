@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type DemoRefillAction string
+
+const (
+	DemoRefillTopUp DemoRefillAction = "top_up" // Adds play money on top of the balance
+	DemoRefillReset DemoRefillAction = "reset"  // Sets the balance back to the default
+)
+
+type DemoRefillStatus string
+
+const (
+	DemoRefillPending DemoRefillStatus = "pending" // Reserved, journal not posted yet
+	DemoRefillPosted  DemoRefillStatus = "posted"
+	DemoRefillFailed  DemoRefillStatus = "failed" // Does not count towards the cooldown
+)
+
+// DemoRefill is one top-up or reset of a demo wallet. It is reserved before
+// the demo_funding journal is posted so two concurrent requests cannot both
+// pass the cooldown, and the journal's idempotency key is derived from it.
+type DemoRefill struct {
+	ID            int64            `json:"id"`
+	AccountID     int64            `json:"account_id"`
+	AccountNumber string           `json:"account_number"`
+	OwnerID       string           `json:"owner_id"`
+	Currency      string           `json:"currency"`
+	Action        DemoRefillAction `json:"action"`
+	Amount        decimal.Decimal  `json:"amount"` // Signed: positive credits the wallet, negative debits it
+	BalanceBefore decimal.Decimal  `json:"balance_before"`
+	BalanceAfter  decimal.Decimal  `json:"balance_after"`
+	Status        DemoRefillStatus `json:"status"`
+	ReceiptCode   *string          `json:"receipt_code,omitempty"`
+	RequestedBy   string           `json:"requested_by"`
+	LastError     *string          `json:"last_error,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+	PostedAt      *time.Time       `json:"posted_at,omitempty"`
+
+	NextAvailableAt time.Time `json:"next_available_at"` // When the same action may run again
+}
+
+// IdempotencyKey of the refill's demo_funding journal
+func (r *DemoRefill) IdempotencyKey() string {
+	return fmt.Sprintf("demo-%s:%d", r.Action, r.ID)
+}
+
+// DemoRefillRequest targets the owner's demo wallet in one currency
+type DemoRefillRequest struct {
+	OwnerType   OwnerType
+	OwnerID     string
+	Currency    string
+	Amount      *decimal.Decimal // Top-up only; defaults to the currency's default demo balance
+	RequestedBy string
+}
@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	DefaultFeeSimulationMaxTransactions = 10000
+	MaxFeeSimulationTransactions        = 100000
+	MaxFeeSimulationRange               = 366 * 24 * time.Hour
+)
+
+// PricedTransactionTypes are the transaction types the fee calculator prices
+var PricedTransactionTypes = []TransactionType{
+	TransactionTypeDeposit,
+	TransactionTypeWithdrawal,
+	TransactionTypeConversion,
+	TransactionTypeTrade,
+	TransactionTypeTransfer,
+}
+
+// FeeRuleSimulationRequest replays historical transactions against a proposed
+// rule set. Proposed rules with an ID replace that rule, rules without one are
+// added, and RemoveRuleIDs drops rules from the proposed set.
+type FeeRuleSimulationRequest struct {
+	From             time.Time
+	To               time.Time
+	Rules            []*TransactionFeeRule
+	RemoveRuleIDs    []int64
+	TransactionTypes []TransactionType // Empty = PricedTransactionTypes
+	MaxTransactions  int
+}
+
+// FeeSimulationSample is a historical transaction with the inputs the fee
+// calculator priced it from. Amount is the largest debit line of the journal
+// (the gross amount the fee was calculated on).
+type FeeSimulationSample struct {
+	JournalID       int64           `json:"journal_id"`
+	TransactionType TransactionType `json:"transaction_type"`
+	AccountType     AccountType     `json:"account_type"`
+	OwnerType       *OwnerType      `json:"owner_type,omitempty"`
+	Amount          decimal.Decimal `json:"amount"`
+	SourceCurrency  string          `json:"source_currency"`
+	TargetCurrency  string          `json:"target_currency"`
+	ChargedFee      decimal.Decimal `json:"charged_fee"` // Fees recorded at the time, excluding agent commission
+	CreatedAt       time.Time       `json:"created_at"`
+}
+
+// FeeSimulationGroup totals one transaction type in one currency. Network
+// fees are not simulated; revenue is platform fees only.
+type FeeSimulationGroup struct {
+	TransactionType  TransactionType `json:"transaction_type"`
+	Currency         string          `json:"currency"`
+	TransactionCount int             `json:"transaction_count"`
+	ChangedCount     int             `json:"changed_count"` // Transactions priced differently
+	FailedCount      int             `json:"failed_count"`  // Could not be priced under one of the rule sets
+	ChargedRevenue   decimal.Decimal `json:"charged_revenue"`
+	CurrentRevenue   decimal.Decimal `json:"current_revenue"`
+	ProposedRevenue  decimal.Decimal `json:"proposed_revenue"`
+	Delta            decimal.Decimal `json:"delta"` // Proposed - current
+}
+
+type FeeRuleSimulationResult struct {
+	From             time.Time             `json:"from"`
+	To               time.Time             `json:"to"`
+	TransactionCount int                   `json:"transaction_count"`
+	Truncated        bool                  `json:"truncated"` // More transactions than MaxTransactions in range
+	Groups           []*FeeSimulationGroup `json:"groups"`
+}
+
+// ApplyFeeRuleChanges returns the rule set that results from replacing and
+// adding proposed rules and dropping removed ones. A proposed rule whose ID is
+// not in current (e.g. an inactive rule being re-activated) is added.
+func ApplyFeeRuleChanges(current, proposed []*TransactionFeeRule, removeIDs []int64) []*TransactionFeeRule {
+	currentIDs := make(map[int64]bool, len(current))
+	for _, rule := range current {
+		currentIDs[rule.ID] = true
+	}
+
+	replaced := make(map[int64]*TransactionFeeRule, len(proposed))
+	var added []*TransactionFeeRule
+	for _, rule := range proposed {
+		if rule.ID > 0 && currentIDs[rule.ID] {
+			replaced[rule.ID] = rule
+		} else {
+			added = append(added, rule)
+		}
+	}
+	removed := make(map[int64]bool, len(removeIDs))
+	for _, id := range removeIDs {
+		removed[id] = true
+	}
+
+	result := make([]*TransactionFeeRule, 0, len(current)+len(added))
+	for _, rule := range current {
+		if removed[rule.ID] {
+			continue
+		}
+		if replacement, ok := replaced[rule.ID]; ok {
+			result = append(result, replacement)
+			continue
+		}
+		result = append(result, rule)
+	}
+	return append(result, added...)
+}
+
+// SelectFeeRule picks the rule FindBestMatch would select from rules: active
+// and unexpired, scope fields unset or equal, highest priority first, then
+// the most specific scope
+func SelectFeeRule(
+	rules []*TransactionFeeRule,
+	transactionType TransactionType,
+	sourceCurrency, targetCurrency *string,
+	accountType *AccountType,
+	ownerType *OwnerType,
+) *TransactionFeeRule {
+	var candidates []*TransactionFeeRule
+	for _, rule := range rules {
+		if !rule.IsActive || rule.ValidTo != nil || rule.TransactionType != transactionType {
+			continue
+		}
+		if !scopeMatches(rule.SourceCurrency, sourceCurrency) ||
+			!scopeMatches(rule.TargetCurrency, targetCurrency) ||
+			!scopeMatches(rule.AccountType, accountType) ||
+			!scopeMatches(rule.OwnerType, ownerType) {
+			continue
+		}
+		candidates = append(candidates, rule)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		return a.specificity() > b.specificity()
+	})
+	return candidates[0]
+}
+
+// specificity orders set scope fields the way FindBestMatch does: source
+// currency outweighs target currency, which outweighs account and owner type
+func (r *TransactionFeeRule) specificity() int {
+	score := 0
+	if r.SourceCurrency != nil {
+		score += 8
+	}
+	if r.TargetCurrency != nil {
+		score += 4
+	}
+	if r.AccountType != nil {
+		score += 2
+	}
+	if r.OwnerType != nil {
+		score++
+	}
+	return score
+}
+
+func scopeMatches[T comparable](scope, value *T) bool {
+	return scope == nil || (value != nil && *scope == *value)
+}
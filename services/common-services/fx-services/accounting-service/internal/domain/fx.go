@@ -1,7 +1,11 @@
 package domain
 
 import (
+	"fmt"
+	"strings"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // Currency represents a supported currency (fiat or crypto)
@@ -13,9 +17,9 @@ type Currency struct {
 	IsFiat             bool      `json:"is_fiat" db:"is_fiat"`
 	IsActive           bool      `json:"is_active" db:"is_active"`
 	DemoEnabled        bool      `json:"demo_enabled" db:"demo_enabled"`
-	DemoInitialBalance float64   `json:"demo_initial_balance" db:"demo_initial_balance"` // ✅ In decimal units
-	MinAmount          float64   `json:"min_amount" db:"min_amount"`                     // ✅ In decimal units
-	MaxAmount          *float64  `json:"max_amount,omitempty" db:"max_amount"`           // ✅ In decimal units (NULL = unlimited)
+	DemoInitialBalance decimal.Decimal  `json:"demo_initial_balance" db:"demo_initial_balance"` // ✅ In decimal units
+	MinAmount          decimal.Decimal  `json:"min_amount" db:"min_amount"`                     // ✅ In decimal units
+	MaxAmount          *decimal.Decimal `json:"max_amount,omitempty" db:"max_amount"`           // ✅ In decimal units (NULL = unlimited)
 	CreatedAt          time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt          time.Time `json:"updated_at" db:"updated_at"`
 }
@@ -43,6 +47,43 @@ type FXRateQuery struct {
 	IncludeExpired bool      // Include rates where valid_to is not null
 }
 
+// FXPair identifies a directed currency pair, e.g. BTC/USD
+type FXPair struct {
+	Base  string
+	Quote string
+}
+
+func (p FXPair) String() string {
+	return p.Base + "/" + p.Quote
+}
+
+// ParseFXPair parses "BASE/QUOTE"
+func ParseFXPair(s string) (FXPair, error) {
+	parts := strings.Split(strings.TrimSpace(s), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return FXPair{}, fmt.Errorf("invalid fx pair %q", s)
+	}
+	pair := FXPair{
+		Base:  strings.ToUpper(strings.TrimSpace(parts[0])),
+		Quote: strings.ToUpper(strings.TrimSpace(parts[1])),
+	}
+	if pair.Base == pair.Quote || len(pair.Base) > 8 || len(pair.Quote) > 8 {
+		return FXPair{}, fmt.Errorf("invalid fx pair %q", s)
+	}
+	return pair, nil
+}
+
+// MarketRate is a mid-market rate returned by a rate provider
+type MarketRate struct {
+	Pair   FXPair
+	Rate   decimal.Decimal // Mid rate: 1 Base = Rate Quote
+	AsOf   time.Time       // Provider quote time
+	Source string
+}
+
+// FXRateScale is the scale of fx_rates.rate (NUMERIC(30,18))
+const FXRateScale = 18
+
 // DefaultCurrencies returns the static list of supported currencies with demo support
 func DefaultCurrencies() []*Currency {
 	now := time.Now()
@@ -59,8 +100,8 @@ func DefaultCurrencies() []*Currency {
 			IsFiat:             true,
 			IsActive:           true,
 			DemoEnabled:        true,
-			DemoInitialBalance: 10000.00,  // ✅ $10,000.00 (decimal)
-			MinAmount:          0.01,      // ✅ $0. 01 (decimal)
+			DemoInitialBalance: decimal.RequireFromString("10000.00"),  // ✅ $10,000.00 (decimal)
+			MinAmount:          decimal.RequireFromString("0.01"),      // ✅ $0. 01 (decimal)
 			MaxAmount:          nil,       // Unlimited
 			CreatedAt:          now,
 			UpdatedAt:          now,
@@ -73,8 +114,8 @@ func DefaultCurrencies() []*Currency {
 			IsFiat:             false,
 			IsActive:           true,
 			DemoEnabled:        true,
-			DemoInitialBalance: 10000.000000,  // ✅ 10,000 USDT (decimal with 6 places)
-			MinAmount:          0.000001,      // ✅ 0. 000001 USDT (1 micro-USDT)
+			DemoInitialBalance: decimal.RequireFromString("10000.000000"),  // ✅ 10,000 USDT (decimal with 6 places)
+			MinAmount:          decimal.RequireFromString("0.000001"),      // ✅ 0. 000001 USDT (1 micro-USDT)
 			MaxAmount:          nil,
 			CreatedAt:          now,
 			UpdatedAt:          now,
@@ -87,8 +128,8 @@ func DefaultCurrencies() []*Currency {
 			IsFiat:             false,
 			IsActive:           true,
 			DemoEnabled:        true,
-			DemoInitialBalance: 0.10000000,    // ✅ 0.1 BTC (decimal with 8 places)
-			MinAmount:          0.00000001,    // ✅ 1 satoshi (decimal)
+			DemoInitialBalance: decimal.RequireFromString("0.10000000"),    // ✅ 0.1 BTC (decimal with 8 places)
+			MinAmount:          decimal.RequireFromString("0.00000001"),    // ✅ 1 satoshi (decimal)
 			MaxAmount:          nil,
 			CreatedAt:          now,
 			UpdatedAt:          now,
@@ -107,12 +148,83 @@ func (c *Currency) SupportsDemo() bool {
 }
 
 // WithinLimits checks if an amount is within min/max limits
-func (c *Currency) WithinLimits(amount float64) bool {
-	if amount < c. MinAmount {
+func (c *Currency) WithinLimits(amount decimal.Decimal) bool {
+	if amount.LessThan(c.MinAmount) {
 		return false
 	}
-	if c.MaxAmount != nil && amount > *c.MaxAmount {
+	if c.MaxAmount != nil && amount.GreaterThan(*c.MaxAmount) {
 		return false
 	}
 	return true
-}
\ No newline at end of file
+}
+
+// Round rounds an amount to the currency's minor unit (half away from zero)
+func (c *Currency) Round(amount decimal.Decimal) decimal.Decimal {
+	return amount.Round(int32(c.Decimals))
+}
+
+// Truncate drops digits below the currency's minor unit.
+// Used when the platform must never credit more than was actually converted.
+func (c *Currency) Truncate(amount decimal.Decimal) decimal.Decimal {
+	return amount.RoundDown(int32(c.Decimals))
+}
+
+// IsRepresentable reports whether amount fits the currency's scale without rounding
+func (c *Currency) IsRepresentable(amount decimal.Decimal) bool {
+	return amount.Equal(c.Round(amount))
+}
+
+// ===============================
+// FX RATE HELPERS
+// ===============================
+
+// NewFXRateFromMarket builds an FXRate row from a mid-market rate and a
+// markup in percent (0.2 = 0.2%). bid = mid * (1 - markup), ask = mid * (1 + markup).
+func NewFXRateFromMarket(mr *MarketRate, markupPct decimal.Decimal) *FXRate {
+	factor := markupPct.Div(decimal.NewFromInt(100))
+	one := decimal.NewFromInt(1)
+
+	rate := mr.Rate.Round(FXRateScale).String()
+	bid := mr.Rate.Mul(one.Sub(factor)).Round(FXRateScale).String()
+	ask := mr.Rate.Mul(one.Add(factor)).Round(FXRateScale).String()
+	spread := markupPct.Round(6).String()
+	source := mr.Source
+
+	return &FXRate{
+		BaseCurrency:  mr.Pair.Base,
+		QuoteCurrency: mr.Pair.Quote,
+		Rate:          rate,
+		BidRate:       &bid,
+		AskRate:       &ask,
+		Spread:        &spread,
+		Source:        &source,
+		ValidFrom:     mr.AsOf,
+	}
+}
+
+// MidRate returns the parsed mid-market rate
+func (fx *FXRate) MidRate() (decimal.Decimal, error) {
+	rate, err := decimal.NewFromString(fx.Rate)
+	if err != nil || !rate.IsPositive() {
+		return decimal.Zero, fmt.Errorf("invalid fx rate %q", fx.Rate)
+	}
+	return rate, nil
+}
+
+// CustomerRate returns the rate applied when a customer sells BaseCurrency
+// for QuoteCurrency: the bid (mid less markup) when set, otherwise the mid.
+func (fx *FXRate) CustomerRate() (decimal.Decimal, error) {
+	if fx.BidRate != nil {
+		bid, err := decimal.NewFromString(*fx.BidRate)
+		if err != nil || !bid.IsPositive() {
+			return decimal.Zero, fmt.Errorf("invalid fx bid rate %q", *fx.BidRate)
+		}
+		return bid, nil
+	}
+	return fx.MidRate()
+}
+
+// IsStale reports whether the rate is older than ttl. A zero ttl disables the check.
+func (fx *FXRate) IsStale(now time.Time, ttl time.Duration) bool {
+	return ttl > 0 && now.Sub(fx.ValidFrom) > ttl
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"time"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	DefaultFXQuoteTTL = 30 * time.Second // Used when no expiry is requested
+	MaxFXQuoteTTL     = 5 * time.Minute
+)
+
+// FXQuote is a locked conversion rate and fee, stored in Redis until it
+// expires. ConvertAndTransfer with QuoteID books exactly these figures.
+type FXQuote struct {
+	QuoteID             string          `json:"quote_id"`
+	FromAccountNumber   string          `json:"from_account_number"`
+	ToAccountNumber     string          `json:"to_account_number"`
+	AccountType         AccountType     `json:"account_type"`
+	SourceCurrency      string          `json:"source_currency"`
+	DestCurrency        string          `json:"dest_currency"`
+	SourceAmount        decimal.Decimal `json:"source_amount"`
+	Rate                decimal.Decimal `json:"rate"` // Customer rate (bid)
+	MidRate             decimal.Decimal `json:"mid_rate"`
+	FXRateID            int64           `json:"fx_rate_id"`
+	RateAsOf            time.Time       `json:"rate_as_of"`
+	ConvertedAmount     decimal.Decimal `json:"converted_amount"` // Before fee
+	FeeAmount           decimal.Decimal `json:"fee_amount"`       // In dest currency
+	FeeRuleID           *int64          `json:"fee_rule_id,omitempty"`
+	FeeRuleVersionID    *int64          `json:"fee_rule_version_id,omitempty"`
+	FeeType             FeeType         `json:"fee_type"`
+	PayableAmount       decimal.Decimal `json:"payable_amount"` // Credited to destination
+	CreatedByExternalID string          `json:"created_by_external_id"`
+	CreatedByType       OwnerType       `json:"created_by_type"`
+	ExpiresAt           time.Time       `json:"expires_at"`
+	CreatedAt           time.Time       `json:"created_at"`
+}
+
+// CreateFXQuoteRequest asks for a locked conversion quote
+type CreateFXQuoteRequest struct {
+	FromAccountNumber   string
+	ToAccountNumber     string
+	Amount              decimal.Decimal
+	AccountType         AccountType
+	CreatedByExternalID string
+	CreatedByType       OwnerType
+	TTL                 time.Duration // Zero = DefaultFXQuoteTTL
+}
+
+func (r *CreateFXQuoteRequest) Validate() error {
+	if r.FromAccountNumber == "" || r.ToAccountNumber == "" {
+		return xerrors.ErrInvalidAccountNumber
+	}
+	if r.FromAccountNumber == r.ToAccountNumber {
+		return xerrors.ErrInvalidTransaction
+	}
+	if !r.Amount.IsPositive() {
+		return xerrors.ErrInvalidAmount
+	}
+	if r.CreatedByExternalID == "" {
+		return xerrors.ErrRequiredFieldMissing
+	}
+	if r.TTL < 0 || r.TTL > MaxFXQuoteTTL {
+		return xerrors.ErrInvalidInput
+	}
+	return nil
+}
+
+// IsExpired returns true once the quote can no longer be booked
+func (q *FXQuote) IsExpired(now time.Time) bool {
+	return !now.Before(q.ExpiresAt)
+}
+
+// Matches checks that a conversion request is the one the quote was issued for.
+// A zero request amount means "the quoted amount".
+func (q *FXQuote) Matches(req *ConversionRequest) error {
+	if req.FromAccountNumber != q.FromAccountNumber ||
+		req.ToAccountNumber != q.ToAccountNumber ||
+		req.CreatedByExternalID != q.CreatedByExternalID ||
+		req.CreatedByType != q.CreatedByType ||
+		req.AccountType != q.AccountType {
+		return xerrors.ErrFXQuoteMismatch
+	}
+	if !req.Amount.IsZero() && !req.Amount.Equal(q.SourceAmount) {
+		return xerrors.ErrFXQuoteMismatch
+	}
+	return nil
+}
+
+// LockedRate returns the quote's rate as an FXRate (bid = locked customer rate)
+func (q *FXQuote) LockedRate() *FXRate {
+	bid := q.Rate.String()
+	return &FXRate{
+		ID:            q.FXRateID,
+		BaseCurrency:  q.SourceCurrency,
+		QuoteCurrency: q.DestCurrency,
+		Rate:          q.MidRate.String(),
+		BidRate:       &bid,
+		ValidFrom:     q.RateAsOf,
+	}
+}
@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"time"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// HoldStatus represents the lifecycle of a funds hold
+type HoldStatus string
+
+const (
+	HoldStatusActive   HoldStatus = "active"   // Funds reserved (available -> pending_debit)
+	HoldStatusCaptured HoldStatus = "captured" // Posted to the ledger, remainder released
+	HoldStatusReleased HoldStatus = "released" // Returned to available balance
+	HoldStatusExpired  HoldStatus = "expired"  // Released by the sweeper after expires_at
+)
+
+// DefaultHoldTTL is used when a hold is placed without an expiry
+const DefaultHoldTTL = 24 * time.Hour
+
+// Hold represents reserved funds awaiting capture or release (transaction_holds)
+type Hold struct {
+	ID                   int64           `json:"id" db:"id"`
+	HoldRef              string          `json:"hold_ref" db:"hold_ref"`
+	AccountID            int64           `json:"account_id" db:"account_id"`
+	AccountNumber        string          `json:"account_number" db:"-"` // Populated via JOIN
+	AccountType          AccountType     `json:"account_type" db:"account_type"`
+	Amount               decimal.Decimal `json:"amount" db:"hold_amount"`
+	CapturedAmount       decimal.Decimal `json:"captured_amount" db:"captured_amount"`
+	Currency             string          `json:"currency" db:"currency"`
+	HoldType             string          `json:"hold_type" db:"hold_type"`
+	Status               HoldStatus      `json:"status" db:"status"`
+	TransactionType      TransactionType `json:"transaction_type" db:"transaction_type"` // Posted on capture
+	CaptureAccountNumber *string         `json:"capture_account_number,omitempty" db:"capture_account_number"`
+	ReceiptCode          *string         `json:"receipt_code,omitempty" db:"receipt_code"` // Capture receipt
+	Reason               *string         `json:"reason,omitempty" db:"reason"`
+	CreatedByExternalID  *string         `json:"created_by_external_id,omitempty" db:"created_by_external_id"`
+	CreatedByType        *OwnerType      `json:"created_by_type,omitempty" db:"created_by_type"`
+	LimitUsage           []*LimitUsage   `json:"limit_usage,omitempty" db:"limit_usage"` // Counted when placed
+	ExpiresAt            *time.Time      `json:"expires_at,omitempty" db:"expires_at"`
+	CapturedAt           *time.Time      `json:"captured_at,omitempty" db:"captured_at"`
+	ReleasedAt           *time.Time      `json:"released_at,omitempty" db:"released_at"`
+	CreatedAt            time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt            time.Time       `json:"updated_at" db:"updated_at"`
+}
+
+// PlaceHoldRequest reserves funds on an account
+type PlaceHoldRequest struct {
+	AccountNumber        string
+	Amount               decimal.Decimal
+	HoldRef              string // Generated by the usecase when empty
+	HoldType             string
+	Reason               *string
+	ExpiresAt            *time.Time
+	CaptureAccountNumber *string         // nil = system liquidity account (no fees)
+	TransactionType      TransactionType // Posted on capture, defaults to withdrawal
+	AccountType          AccountType
+	CreatedByExternalID  string
+	CreatedByType        OwnerType
+	LimitReservation     *LimitReservation // Checked by the usecase, recorded with the hold
+}
+
+// CaptureHoldRequest posts a hold in full (zero Amount) or in part
+type CaptureHoldRequest struct {
+	HoldRef             string
+	Amount              decimal.Decimal // Zero = capture the full hold
+	ToAccountNumber     *string         // Overrides Hold.CaptureAccountNumber
+	ToAddress           *string         // Crypto destination for network fee calculation
+	Description         string
+	AgentExternalID     *string
+	CreatedByExternalID string
+	CreatedByType       OwnerType
+	TransactionFee      *TransactionFee   // Calculated by the usecase
+	ReceiptCode         *string           // Assigned by the usecase
+	LimitReservation    *LimitReservation // Checked by the usecase, recorded with the journal
+}
+
+// ExtendHoldRequest moves the expiry of an active hold
+type ExtendHoldRequest struct {
+	HoldRef   string
+	ExpiresAt *time.Time // nil = never expires, for holds whose capture is in flight
+}
+
+// ReleaseHoldRequest returns held funds to the available balance
+type ReleaseHoldRequest struct {
+	HoldRef              string
+	Reason               *string
+	ReleasedByExternalID string
+	ReleasedByType       OwnerType
+	Expired              bool // Set by the sweeper
+}
+
+// HoldCaptureResult is returned by CaptureHold
+type HoldCaptureResult struct {
+	Hold           *Hold
+	Aggregate      *LedgerAggregate
+	ReleasedAmount decimal.Decimal // Uncaptured remainder returned to available
+	FeeAmount      decimal.Decimal
+}
+
+// HoldFilter filters holds for listing
+type HoldFilter struct {
+	AccountNumber *string
+	Status        *HoldStatus
+	HoldType      *string
+	Limit         int
+	Offset        int
+}
+
+func (r *PlaceHoldRequest) Validate() error {
+	if r.AccountNumber == "" {
+		return xerrors.ErrInvalidAccountNumber
+	}
+	if !r.Amount.IsPositive() {
+		return xerrors.ErrInvalidAmount
+	}
+	if r.HoldType == "" || r.CreatedByExternalID == "" {
+		return xerrors.ErrRequiredFieldMissing
+	}
+	if r.ExpiresAt != nil && !r.ExpiresAt.After(time.Now()) {
+		return xerrors.ErrInvalidInput
+	}
+	if r.CaptureAccountNumber != nil && *r.CaptureAccountNumber == r.AccountNumber {
+		return xerrors.ErrInvalidTransaction
+	}
+	return nil
+}
+
+func (r *CaptureHoldRequest) Validate() error {
+	if r.HoldRef == "" {
+		return xerrors.ErrRequiredFieldMissing
+	}
+	if r.Amount.IsNegative() {
+		return xerrors.ErrInvalidAmount
+	}
+	return nil
+}
+
+func (r *ExtendHoldRequest) Validate() error {
+	if r.HoldRef == "" {
+		return xerrors.ErrRequiredFieldMissing
+	}
+	if r.ExpiresAt != nil && !r.ExpiresAt.After(time.Now()) {
+		return xerrors.ErrInvalidInput
+	}
+	return nil
+}
+
+func (r *ReleaseHoldRequest) Validate() error {
+	if r.HoldRef == "" {
+		return xerrors.ErrRequiredFieldMissing
+	}
+	return nil
+}
+
+// IsActive returns true while the hold still reserves funds
+func (h *Hold) IsActive() bool {
+	return h.Status == HoldStatusActive
+}
+
+// IsExpired returns true if an active hold is past its expiry
+func (h *Hold) IsExpired(now time.Time) bool {
+	return h.IsActive() && h.ExpiresAt != nil && now.After(*h.ExpiresAt)
+}
@@ -0,0 +1,191 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	DefaultInterestDayCount = 365
+	MaxInterestAnnualRate   = 1 // 100% a year
+)
+
+// InterestMethod decides what a day's interest accrues on
+type InterestMethod string
+
+const (
+	InterestMethodSimple   InterestMethod = "simple"   // Posted balance only
+	InterestMethodCompound InterestMethod = "compound" // Posted balance plus interest accrued but not yet posted
+)
+
+// InterestRatePlan sets the rate savings or investment accounts in one
+// currency earn. Interest accrues daily on the end-of-day balance and is
+// capitalized monthly; at most one plan per currency and purpose is active.
+type InterestRatePlan struct {
+	ID         int64           `json:"id"`
+	Name       string          `json:"name"`
+	Currency   string          `json:"currency"`
+	Purpose    AccountPurpose  `json:"purpose"`     // savings or investment
+	AnnualRate decimal.Decimal `json:"annual_rate"` // 0.05 = 5% a year
+	Method     InterestMethod  `json:"method"`
+	DayCount   int             `json:"day_count"`   // 360 or 365
+	MinBalance decimal.Decimal `json:"min_balance"` // No interest below this balance
+	IsActive   bool            `json:"is_active"`
+	CreatedBy  string          `json:"created_by"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+func (p *InterestRatePlan) Validate() error {
+	p.Currency = strings.ToUpper(strings.TrimSpace(p.Currency))
+	if p.Name == "" {
+		return fmt.Errorf("%w: name is required", xerrors.ErrInvalidInterestRatePlan)
+	}
+	if p.Currency == "" || len(p.Currency) > 8 {
+		return fmt.Errorf("%w: invalid currency", xerrors.ErrInvalidInterestRatePlan)
+	}
+	if p.Purpose != PurposeSavings && p.Purpose != PurposeInvestment {
+		return fmt.Errorf("%w: purpose must be savings or investment", xerrors.ErrInvalidInterestRatePlan)
+	}
+	if p.AnnualRate.IsNegative() || p.AnnualRate.GreaterThan(decimal.NewFromInt(MaxInterestAnnualRate)) {
+		return fmt.Errorf("%w: annual_rate must be between 0 and %d", xerrors.ErrInvalidInterestRatePlan, MaxInterestAnnualRate)
+	}
+	if p.Method == "" {
+		p.Method = InterestMethodSimple
+	}
+	if p.Method != InterestMethodSimple && p.Method != InterestMethodCompound {
+		return fmt.Errorf("%w: method must be simple or compound", xerrors.ErrInvalidInterestRatePlan)
+	}
+	if p.DayCount == 0 {
+		p.DayCount = DefaultInterestDayCount
+	}
+	if p.DayCount != 360 && p.DayCount != 365 {
+		return fmt.Errorf("%w: day_count must be 360 or 365", xerrors.ErrInvalidInterestRatePlan)
+	}
+	if p.MinBalance.IsNegative() {
+		return fmt.Errorf("%w: min_balance cannot be negative", xerrors.ErrInvalidInterestRatePlan)
+	}
+	return nil
+}
+
+// InterestAccrualCandidate is an account due a day's accrual with the inputs
+// to price it
+type InterestAccrualCandidate struct {
+	AccountID       int64
+	AccountNumber   string
+	Currency        string
+	Plan            *InterestRatePlan
+	Balance         decimal.Decimal // End-of-day ledger balance
+	UnpostedAccrued decimal.Decimal // Accrued, not yet credited (compound plans only)
+}
+
+// DailyAccrual prices one day of interest. Amounts keep full precision;
+// rounding to the currency happens once per posting.
+func (c *InterestAccrualCandidate) DailyAccrual(date time.Time) *InterestAccrual {
+	principal := c.Balance
+	if c.Plan.Method == InterestMethodCompound {
+		principal = principal.Add(c.UnpostedAccrued)
+	}
+	if !principal.IsPositive() || principal.LessThan(c.Plan.MinBalance) {
+		return nil
+	}
+
+	amount := principal.Mul(c.Plan.AnnualRate).DivRound(decimal.NewFromInt(int64(c.Plan.DayCount)), 18)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	return &InterestAccrual{
+		AccountID:     c.AccountID,
+		AccountNumber: c.AccountNumber,
+		PlanID:        c.Plan.ID,
+		AccrualDate:   date,
+		Principal:     principal,
+		AnnualRate:    c.Plan.AnnualRate,
+		Amount:        amount,
+		Currency:      c.Currency,
+	}
+}
+
+// InterestAccrual is one day of interest on one account. There is at most
+// one per account and day, so re-running a day is a no-op.
+type InterestAccrual struct {
+	ID            int64           `json:"id"`
+	AccountID     int64           `json:"account_id"`
+	AccountNumber string          `json:"account_number"`
+	PlanID        int64           `json:"plan_id"`
+	AccrualDate   time.Time       `json:"accrual_date"`
+	Principal     decimal.Decimal `json:"principal"`
+	AnnualRate    decimal.Decimal `json:"annual_rate"`
+	Amount        decimal.Decimal `json:"amount"`
+	Currency      string          `json:"currency"`
+	PostingID     *int64          `json:"posting_id,omitempty"` // nil until reserved by a posting
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+type InterestPostingStatus string
+
+const (
+	InterestPostingPending InterestPostingStatus = "pending" // Reserved, not yet credited
+	InterestPostingPosted  InterestPostingStatus = "posted"
+	InterestPostingFailed  InterestPostingStatus = "failed" // Gave up; accruals released to the next posting
+)
+
+// InterestPosting capitalizes a month of accruals into the account. Amount is
+// the accrued total rounded down to the currency; the remainder is carried
+// into the next posting.
+type InterestPosting struct {
+	ID            int64                 `json:"id"`
+	AccountID     int64                 `json:"account_id"`
+	AccountNumber string                `json:"account_number"`
+	Currency      string                `json:"currency"`
+	PeriodFrom    time.Time             `json:"period_from"` // First accrual date
+	PeriodTo      time.Time             `json:"period_to"`   // Last accrual date
+	AccrualCount  int                   `json:"accrual_count"`
+	CarriedIn     decimal.Decimal       `json:"carried_in"` // Remainder of earlier postings
+	Accrued       decimal.Decimal       `json:"accrued"`    // Accruals + carried_in
+	Amount        decimal.Decimal       `json:"amount"`     // Credited to the account
+	Remainder     decimal.Decimal       `json:"remainder"`  // Accrued - amount
+	Status        InterestPostingStatus `json:"status"`
+	Attempts      int                   `json:"attempts"`
+	ReceiptCode   *string               `json:"receipt_code,omitempty"`
+	LastError     *string               `json:"last_error,omitempty"`
+	CreatedAt     time.Time             `json:"created_at"`
+	PostedAt      *time.Time            `json:"posted_at,omitempty"`
+}
+
+// IdempotencyKey keys the posting transfer, so a retried or re-claimed
+// posting is never credited twice
+func (p *InterestPosting) IdempotencyKey() string {
+	return fmt.Sprintf("interest-posting:%d", p.ID)
+}
+
+// InterestSummary is the interest side of an account statement
+type InterestSummary struct {
+	Accrued     decimal.Decimal `json:"accrued"`     // Accrued on days in the period
+	Paid        decimal.Decimal `json:"paid"`        // Credited by postings in the period
+	Outstanding decimal.Decimal `json:"outstanding"` // Accrued but not yet credited, as of now
+}
+
+// InterestRunResult reports one accrual and posting pass
+type InterestRunResult struct {
+	AccrualDates   []time.Time     `json:"accrual_dates"`
+	AccrualCount   int             `json:"accrual_count"`
+	PostingCount   int             `json:"posting_count"`
+	PostedCount    int             `json:"posted_count"`
+	FailedCount    int             `json:"failed_count"`
+	PostedInterest decimal.Decimal `json:"posted_interest"` // Summed across currencies; per-currency detail is in the postings
+}
+
+// InterestPostingFilter selects postings of one account or all accounts
+type InterestPostingFilter struct {
+	AccountNumber *string
+	Status        *InterestPostingStatus
+	Limit         int
+	Offset        int
+}
@@ -67,6 +67,7 @@ type JournalCreate struct {
 	CreatedByType       *OwnerType
 	IPAddress           *string
 	UserAgent           *string
+	PostedAt            *time.Time // Accounting date; nil = now
 }
 
 // IsValid checks if the journal has valid required fields
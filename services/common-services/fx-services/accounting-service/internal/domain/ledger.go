@@ -3,6 +3,8 @@ package domain
 import (
 	"encoding/json"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // DrCr represents debit or credit
@@ -16,18 +18,18 @@ const (
 // Ledger represents a single ledger line item (DR/CR)
 // This is the atomic unit of double-entry bookkeeping
 type Ledger struct {
-	ID           int64           `json:"id" db:"id"`
-	JournalID    int64           `json:"journal_id" db:"journal_id"`
-	AccountID    int64           `json:"account_id" db:"account_id"`
-	AccountType  AccountType     `json:"account_type" db:"account_type"`             // real or demo
-	Amount       float64         `json:"amount" db:"amount"`                         // In smallest currency unit
-	DrCr         DrCr            `json:"dr_cr" db:"dr_cr"`                           // DR or CR
-	Currency     string          `json:"currency" db:"currency"`                     // Max 8 chars
-	ReceiptCode  *string         `json:"receipt_code,omitempty" db:"receipt_code"`   // Link to receipt
-	BalanceAfter *float64        `json:"balance_after,omitempty" db:"balance_after"` // Account balance after this entry
-	Description  *string         `json:"description,omitempty" db:"description"`
-	Metadata     json.RawMessage `json:"metadata,omitempty" db:"metadata"` // JSONB field
-	CreatedAt    time.Time       `json:"created_at" db:"created_at"`
+	ID           int64            `json:"id" db:"id"`
+	JournalID    int64            `json:"journal_id" db:"journal_id"`
+	AccountID    int64            `json:"account_id" db:"account_id"`
+	AccountType  AccountType      `json:"account_type" db:"account_type"`             // real or demo
+	Amount       decimal.Decimal  `json:"amount" db:"amount"`                         // Exact amount, scaled by currencies.decimals
+	DrCr         DrCr             `json:"dr_cr" db:"dr_cr"`                           // DR or CR
+	Currency     string           `json:"currency" db:"currency"`                     // Max 8 chars
+	ReceiptCode  *string          `json:"receipt_code,omitempty" db:"receipt_code"`   // Link to receipt
+	BalanceAfter *decimal.Decimal `json:"balance_after,omitempty" db:"balance_after"` // Account balance after this entry
+	Description  *string          `json:"description,omitempty" db:"description"`
+	Metadata     json.RawMessage  `json:"metadata,omitempty" db:"metadata"` // JSONB field
+	CreatedAt    time.Time        `json:"created_at" db:"created_at"`
 
 	// Populated via JOIN (not in DB)
 	AccountData *Account `json:"account,omitempty" db:"-"`
@@ -39,13 +41,14 @@ type LedgerCreate struct {
 	JournalID    int64
 	AccountID    int64
 	AccountType  AccountType
-	Amount       float64
+	Amount       decimal.Decimal
 	DrCr         DrCr
 	Currency     string
 	ReceiptCode  *string
-	BalanceAfter *float64
+	BalanceAfter *decimal.Decimal
 	Description  *string
 	Metadata     json.RawMessage
+	PostedAt     *time.Time // Accounting date; nil = now
 }
 
 // LedgerEntry represents a paired debit/credit entry
@@ -70,18 +73,18 @@ type LedgerFilter struct {
 
 // LedgerBalance represents account balance calculation
 type LedgerBalance struct {
-	AccountID    int64     `json:"account_id"`
-	Currency     string    `json:"currency"`
-	TotalDebits  float64   `json:"total_debits"`
-	TotalCredits float64   `json:"total_credits"`
-	Balance      float64   `json:"balance"` // Credits - Debits
-	LastLedgerID *int64    `json:"last_ledger_id,omitempty"`
-	LastUpdated  time.Time `json:"last_updated"`
+	AccountID    int64           `json:"account_id"`
+	Currency     string          `json:"currency"`
+	TotalDebits  decimal.Decimal `json:"total_debits"`
+	TotalCredits decimal.Decimal `json:"total_credits"`
+	Balance      decimal.Decimal `json:"balance"` // Credits - Debits
+	LastLedgerID *int64          `json:"last_ledger_id,omitempty"`
+	LastUpdated  time.Time       `json:"last_updated"`
 }
 
 // IsValid checks if the ledger entry has valid required fields
 func (l *Ledger) IsValid() bool {
-	if l.JournalID <= 0 || l.AccountID <= 0 || l.Amount <= 0 {
+	if l.JournalID <= 0 || l.AccountID <= 0 || !l.Amount.IsPositive() {
 		return false
 	}
 	if l.DrCr != DrCrDebit && l.DrCr != DrCrCredit {
@@ -137,7 +140,7 @@ func (l *Ledger) GetMetadata(target interface{}) error {
 
 // ValidatePairedEntry validates that debit and credit entries balance
 func ValidatePairedEntry(debit, credit *LedgerCreate) error {
-	if debit.Amount != credit.Amount {
+	if !debit.Amount.Equal(credit.Amount) {
 		return ErrUnbalancedEntry
 	}
 	if debit.DrCr != DrCrDebit {
@@ -0,0 +1,225 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// LimitWindow is a calendar period over which cumulative debits are capped.
+// Windows are aligned to UTC: days at midnight, weeks on Monday, months on the 1st.
+type LimitWindow string
+
+const (
+	LimitWindowDaily   LimitWindow = "daily"
+	LimitWindowWeekly  LimitWindow = "weekly"
+	LimitWindowMonthly LimitWindow = "monthly"
+)
+
+// LimitWindows lists the windows in the order they are checked and reported
+var LimitWindows = []LimitWindow{LimitWindowDaily, LimitWindowWeekly, LimitWindowMonthly}
+
+// Bounds returns the start of the window containing t and the start of the next one
+func (w LimitWindow) Bounds(t time.Time) (time.Time, time.Time) {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch w {
+	case LimitWindowWeekly:
+		offset := (int(day.Weekday()) + 6) % 7 // Days since Monday
+		start := day.AddDate(0, 0, -offset)
+		return start, start.AddDate(0, 0, 7)
+	case LimitWindowMonthly:
+		start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	default:
+		return day, day.AddDate(0, 0, 1)
+	}
+}
+
+// LimitProfile caps the debits of the accounts it applies to, in one currency.
+// Scope fields left nil match anything; the most specific active profile wins
+// (account, then KYC tier, then owner type, then priority). Nil caps are unlimited.
+type LimitProfile struct {
+	ID            int64      `json:"id"`
+	Name          string     `json:"name"`
+	OwnerType     *OwnerType `json:"owner_type,omitempty"`
+	KYCTier       *string    `json:"kyc_tier,omitempty"`
+	AccountNumber *string    `json:"account_number,omitempty"`
+	Currency      string     `json:"currency"`
+
+	MaxSingleAmount *decimal.Decimal `json:"max_single_amount,omitempty"`
+	DailyAmount     *decimal.Decimal `json:"daily_amount,omitempty"`
+	WeeklyAmount    *decimal.Decimal `json:"weekly_amount,omitempty"`
+	MonthlyAmount   *decimal.Decimal `json:"monthly_amount,omitempty"`
+	DailyCount      *int             `json:"daily_count,omitempty"`
+	WeeklyCount     *int             `json:"weekly_count,omitempty"`
+	MonthlyCount    *int             `json:"monthly_count,omitempty"`
+
+	Priority  int       `json:"priority"`
+	IsActive  bool      `json:"is_active"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (p *LimitProfile) Validate() error {
+	if p.Name == "" || p.Currency == "" {
+		return xerrors.ErrRequiredFieldMissing
+	}
+	if p.OwnerType != nil && *p.OwnerType == OwnerTypeSystem {
+		return fmt.Errorf("%w: system accounts are not limited", xerrors.ErrInvalidOwnerType)
+	}
+	for _, amount := range []*decimal.Decimal{p.MaxSingleAmount, p.DailyAmount, p.WeeklyAmount, p.MonthlyAmount} {
+		if amount != nil && amount.IsNegative() {
+			return xerrors.ErrInvalidAmount
+		}
+	}
+	for _, count := range []*int{p.DailyCount, p.WeeklyCount, p.MonthlyCount} {
+		if count != nil && *count < 0 {
+			return xerrors.ErrInvalidLimit
+		}
+	}
+	return nil
+}
+
+// Caps returns the cumulative amount and count caps for a window
+func (p *LimitProfile) Caps(w LimitWindow) (*decimal.Decimal, *int) {
+	switch w {
+	case LimitWindowWeekly:
+		return p.WeeklyAmount, p.WeeklyCount
+	case LimitWindowMonthly:
+		return p.MonthlyAmount, p.MonthlyCount
+	default:
+		return p.DailyAmount, p.DailyCount
+	}
+}
+
+// LimitProfileFilter selects profiles for listing
+type LimitProfileFilter struct {
+	OwnerType     *OwnerType
+	KYCTier       *string
+	AccountNumber *string
+	Currency      *string
+	ActiveOnly    bool
+}
+
+// OwnerKYCTier is the KYC tier an owner's limits are selected by
+type OwnerKYCTier struct {
+	OwnerType OwnerType `json:"owner_type"`
+	OwnerID   string    `json:"owner_id"`
+	KYCTier   string    `json:"kyc_tier"`
+	SetBy     string    `json:"set_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LimitUsage is an account's cumulative debits in one window. On a
+// reservation Amount and Count are the deltas that were added.
+type LimitUsage struct {
+	AccountNumber string           `json:"account_number"`
+	Window        LimitWindow      `json:"window"`
+	WindowStart   time.Time        `json:"window_start"`
+	ResetsAt      time.Time        `json:"resets_at"`
+	Amount        decimal.Decimal  `json:"amount"`
+	Count         int              `json:"count"`
+	AmountCap     *decimal.Decimal `json:"amount_cap,omitempty"`
+	CountCap      *int             `json:"count_cap,omitempty"`
+}
+
+// RemainingAmount is nil when the window has no amount cap
+func (u *LimitUsage) RemainingAmount() *decimal.Decimal {
+	if u.AmountCap == nil {
+		return nil
+	}
+	remaining := decimal.Max(u.AmountCap.Sub(u.Amount), decimal.Zero)
+	return &remaining
+}
+
+// Exceeded returns the *LimitExceededError the reservation u would raise on
+// a counter already at used/count, or nil if it fits
+func (u *LimitUsage) Exceeded(used decimal.Decimal, count int) error {
+	current := &LimitUsage{Amount: used, Count: count, AmountCap: u.AmountCap, CountCap: u.CountCap}
+	window := u.Window
+	resetsAt := u.ResetsAt
+	limitErr := &LimitExceededError{
+		AccountNumber: u.AccountNumber,
+		Window:        &window,
+		ResetsAt:      &resetsAt,
+	}
+
+	switch {
+	case u.AmountCap != nil && used.Add(u.Amount).GreaterThan(*u.AmountCap):
+		limitErr.Limit = string(u.Window) + "_amount"
+		limitErr.Cap = u.AmountCap.String()
+		limitErr.Requested = u.Amount.String()
+		limitErr.Remaining = current.RemainingAmount().String()
+	case u.CountCap != nil && count+u.Count > *u.CountCap:
+		limitErr.Limit = string(u.Window) + "_count"
+		limitErr.Cap = fmt.Sprintf("%d", *u.CountCap)
+		limitErr.Requested = fmt.Sprintf("%d", u.Count)
+		limitErr.Remaining = fmt.Sprintf("%d", *current.RemainingCount())
+	default:
+		return nil
+	}
+	return limitErr
+}
+
+// RemainingCount is nil when the window has no count cap
+func (u *LimitUsage) RemainingCount() *int {
+	if u.CountCap == nil {
+		return nil
+	}
+	remaining := *u.CountCap - u.Count
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &remaining
+}
+
+// LimitReservation is the usage a transaction adds to its debited accounts'
+// counters. It travels with the request (including through the async queue)
+// and is recorded in the journal's database transaction, so it counts if and
+// only if the journal posts.
+type LimitReservation struct {
+	Usage []*LimitUsage `json:"usage"`
+}
+
+// AccountLimits is the effective limit profile of an account and its usage
+type AccountLimits struct {
+	AccountNumber   string           `json:"account_number"`
+	Currency        string           `json:"currency"`
+	KYCTier         *string          `json:"kyc_tier,omitempty"`
+	Profile         *LimitProfile    `json:"profile,omitempty"` // nil = unlimited
+	MaxSingleAmount *decimal.Decimal `json:"max_single_amount,omitempty"`
+	Usage           []*LimitUsage    `json:"usage"`
+}
+
+// LimitExceededError reports which limit a debit would break and how much
+// allowance is left. It matches xerrors.ErrLimitExceeded.
+type LimitExceededError struct {
+	AccountNumber string
+	Limit         string       // max_single_amount | <window>_amount | <window>_count
+	Window        *LimitWindow // nil for max_single_amount
+	Cap           string
+	Requested     string
+	Remaining     string
+	ResetsAt      *time.Time
+}
+
+func (e *LimitExceededError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s for account %s (limit %s, requested %s, remaining %s",
+		xerrors.ErrLimitExceeded, e.Limit, e.AccountNumber, e.Cap, e.Requested, e.Remaining)
+	if e.ResetsAt != nil {
+		fmt.Fprintf(&b, ", resets at %s", e.ResetsAt.Format(time.RFC3339))
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (e *LimitExceededError) Unwrap() error {
+	return xerrors.ErrLimitExceeded
+}
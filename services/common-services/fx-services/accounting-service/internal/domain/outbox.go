@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// OutboxStatus tracks delivery of an outbox row to its Redis stream
+type OutboxStatus string
+
+const (
+	OutboxPending OutboxStatus = "pending" // Waiting for the relay (or retrying)
+	OutboxSent    OutboxStatus = "sent"    // XADDed, StreamID is set
+)
+
+// TransactionEventsStream is the Redis stream transaction events are relayed to.
+// Consumers read it through consumer groups and ack each entry.
+const TransactionEventsStream = "stream:transaction_events"
+
+// TransactionEvent is the payload consumers of TransactionEventsStream receive
+type TransactionEvent struct {
+	EventType       string                 `json:"event_type"` // transaction.completed, transaction.failed, hold.*, ...
+	UserID          string                 `json:"user_id"`
+	ReceiptCode     string                 `json:"receipt_code"`
+	TransactionID   int64                  `json:"transaction_id"`
+	TransactionType string                 `json:"transaction_type"` // deposit, withdrawal, transfer, etc
+	Status          string                 `json:"status"`
+	Amount          decimal.Decimal        `json:"amount"` // Currency units, serialized as string
+	Currency        string                 `json:"currency"`
+	AccountNumber   string                 `json:"account_number,omitempty"`
+	FromAccount     string                 `json:"from_account,omitempty"`
+	ToAccount       string                 `json:"to_account,omitempty"`
+	BalanceAfter    decimal.Decimal        `json:"balance_after"`
+	Fee             decimal.Decimal        `json:"fee"`
+	ErrorMessage    string                 `json:"error_message,omitempty"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+	Timestamp       time.Time              `json:"timestamp"`
+}
+
+// OutboxEvent is a row of outbox_events. Rows written in the same database
+// transaction as the journal are guaranteed to be relayed once it commits.
+type OutboxEvent struct {
+	ID          int64           `json:"id" db:"id"`
+	Stream      string          `json:"stream" db:"stream"`
+	EventType   string          `json:"event_type" db:"event_type"`
+	AggregateID *string         `json:"aggregate_id,omitempty" db:"aggregate_id"` // Receipt code when known
+	Payload     json.RawMessage `json:"payload" db:"payload"`
+	Status      OutboxStatus    `json:"status" db:"status"`
+	Attempts    int             `json:"attempts" db:"attempts"`
+	LastError   *string         `json:"last_error,omitempty" db:"last_error"`
+	StreamID    *string         `json:"stream_id,omitempty" db:"stream_id"` // Redis entry ID once sent
+	AvailableAt time.Time       `json:"available_at" db:"available_at"`     // Next relay attempt
+	CreatedAt   time.Time       `json:"created_at" db:"created_at"`
+	SentAt      *time.Time      `json:"sent_at,omitempty" db:"sent_at"`
+}
+
+// NewTransactionOutboxEvent stamps the event and wraps it for TransactionEventsStream
+func NewTransactionOutboxEvent(event *TransactionEvent) (*OutboxEvent, error) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	var aggregateID *string
+	if event.ReceiptCode != "" {
+		aggregateID = &event.ReceiptCode
+	}
+
+	return &OutboxEvent{
+		Stream:      TransactionEventsStream,
+		EventType:   event.EventType,
+		AggregateID: aggregateID,
+		Payload:     payload,
+		Status:      OutboxPending,
+	}, nil
+}
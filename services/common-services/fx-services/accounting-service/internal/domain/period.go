@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PeriodStatus represents the lifecycle of an accounting period
+type PeriodStatus string
+
+const (
+	PeriodClosing PeriodStatus = "closing" // Postings blocked, snapshot not written yet
+	PeriodClosed  PeriodStatus = "closed"  // Snapshot written to period_balances
+)
+
+// AccountingPeriod is a closed (or closing) accounting period [PeriodStart, PeriodEnd)
+type AccountingPeriod struct {
+	ID            int64        `json:"id" db:"id"`
+	PeriodStart   time.Time    `json:"period_start" db:"period_start"`
+	PeriodEnd     time.Time    `json:"period_end" db:"period_end"`
+	Status        PeriodStatus `json:"status" db:"status"`
+	ClosedBy      string       `json:"closed_by" db:"closed_by"`
+	Note          *string      `json:"note,omitempty" db:"note"`
+	AccountsCount int64        `json:"accounts_count" db:"accounts_count"`
+	CreatedAt     time.Time    `json:"created_at" db:"created_at"`
+	ClosedAt      *time.Time   `json:"closed_at,omitempty" db:"closed_at"`
+}
+
+// CloseAccountingPeriodRequest closes the period [PeriodStart, PeriodEnd)
+type CloseAccountingPeriodRequest struct {
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	ClosedBy    string
+	Note        *string
+}
+
+// Validate checks the requested range. Periods can only be closed once they have ended.
+func (r *CloseAccountingPeriodRequest) Validate(now time.Time) bool {
+	return !r.PeriodStart.IsZero() &&
+		r.PeriodEnd.After(r.PeriodStart) &&
+		!r.PeriodEnd.After(now)
+}
+
+// ReportScope filters the accounts a period report covers
+type ReportScope struct {
+	AccountType   *AccountType
+	Currency      *string
+	Purpose       *AccountPurpose
+	AccountNumber *string
+}
+
+// TrialBalanceRequest selects a closed period (PeriodID) or an arbitrary range
+type TrialBalanceRequest struct {
+	PeriodID *int64
+	From     *time.Time // Defaults to the start of the current month
+	To       *time.Time // Defaults to now
+	Scope    ReportScope
+}
+
+// TrialBalanceLine aggregates accounts of one purpose, currency and account type
+type TrialBalanceLine struct {
+	Purpose        AccountPurpose  `json:"purpose"`
+	Currency       string          `json:"currency"`
+	AccountType    AccountType     `json:"account_type"`
+	AccountCount   int64           `json:"account_count"`
+	OpeningBalance decimal.Decimal `json:"opening_balance"`
+	TotalDebits    decimal.Decimal `json:"total_debits"`
+	TotalCredits   decimal.Decimal `json:"total_credits"`
+	ClosingBalance decimal.Decimal `json:"closing_balance"` // Opening + credits - debits
+}
+
+// TrialBalanceTotal sums the lines of one currency
+type TrialBalanceTotal struct {
+	Currency       string          `json:"currency"`
+	OpeningBalance decimal.Decimal `json:"opening_balance"`
+	TotalDebits    decimal.Decimal `json:"total_debits"`
+	TotalCredits   decimal.Decimal `json:"total_credits"`
+	ClosingBalance decimal.Decimal `json:"closing_balance"`
+}
+
+// TrialBalance is the trial balance of a period or range
+type TrialBalance struct {
+	From   time.Time            `json:"from"`
+	To     time.Time            `json:"to"`
+	Period *AccountingPeriod    `json:"period,omitempty"` // Set when served from a closed period snapshot
+	Lines  []*TrialBalanceLine  `json:"lines"`
+	Totals []*TrialBalanceTotal `json:"totals"`
+}
+
+// GeneralLedgerRequest lists ledger postings in [From, To)
+type GeneralLedgerRequest struct {
+	From   time.Time
+	To     time.Time
+	Scope  ReportScope
+	Limit  int
+	Offset int
+}
+
+// GeneralLedgerEntry is one ledger posting in the general ledger
+type GeneralLedgerEntry struct {
+	LedgerID        int64            `json:"ledger_id"`
+	JournalID       int64            `json:"journal_id"`
+	AccountNumber   string           `json:"account_number"`
+	Purpose         AccountPurpose   `json:"purpose"`
+	AccountType     AccountType      `json:"account_type"`
+	Currency        string           `json:"currency"`
+	DrCr            DrCr             `json:"dr_cr"`
+	Amount          decimal.Decimal  `json:"amount"`
+	BalanceAfter    *decimal.Decimal `json:"balance_after,omitempty"`
+	ReceiptCode     *string          `json:"receipt_code,omitempty"`
+	Description     *string          `json:"description,omitempty"`
+	TransactionType TransactionType  `json:"transaction_type"`
+	CreatedAt       time.Time        `json:"created_at"`
+}
+
+// GeneralLedger is the general ledger of a range: per-group totals plus a page of postings
+// ordered by purpose, currency and time
+type GeneralLedger struct {
+	From         time.Time             `json:"from"`
+	To           time.Time             `json:"to"`
+	Sections     []*TrialBalanceLine   `json:"sections"`
+	Entries      []*GeneralLedgerEntry `json:"entries"`
+	TotalEntries int64                 `json:"total_entries"`
+}
+
+// TotalsByCurrency sums trial balance lines per currency, in line order
+func TotalsByCurrency(lines []*TrialBalanceLine) []*TrialBalanceTotal {
+	totals := make([]*TrialBalanceTotal, 0)
+	byCurrency := make(map[string]*TrialBalanceTotal)
+
+	for _, line := range lines {
+		t, ok := byCurrency[line.Currency]
+		if !ok {
+			t = &TrialBalanceTotal{Currency: line.Currency}
+			byCurrency[line.Currency] = t
+			totals = append(totals, t)
+		}
+		t.OpeningBalance = t.OpeningBalance.Add(line.OpeningBalance)
+		t.TotalDebits = t.TotalDebits.Add(line.TotalDebits)
+		t.TotalCredits = t.TotalCredits.Add(line.TotalCredits)
+		t.ClosingBalance = t.ClosingBalance.Add(line.ClosingBalance)
+	}
+
+	return totals
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationRunStatus represents the lifecycle of a reconciliation run
+type ReconciliationRunStatus string
+
+const (
+	ReconciliationRunning   ReconciliationRunStatus = "running"
+	ReconciliationCompleted ReconciliationRunStatus = "completed" // Finished, breaks_found may be > 0
+	ReconciliationFailed    ReconciliationRunStatus = "failed"    // A check errored, see Error
+)
+
+// ReconciliationTrigger tells who started a run
+type ReconciliationTrigger string
+
+const (
+	ReconciliationTriggerScheduled ReconciliationTrigger = "scheduled"
+	ReconciliationTriggerManual    ReconciliationTrigger = "manual"
+)
+
+// BreakType classifies a reconciliation discrepancy
+type BreakType string
+
+const (
+	BreakBalanceMismatch   BreakType = "balance_mismatch"   // balance != opening + SUM(CR) - SUM(DR)
+	BreakAvailableMismatch BreakType = "available_mismatch" // available_balance != balance - pending_debit
+	BreakJournalUnbalanced BreakType = "journal_unbalanced" // Debits != credits (or one-sided FX journal)
+	BreakJournalNoEntries  BreakType = "journal_no_entries" // Journal without ledgers
+	BreakCurrencyImbalance BreakType = "currency_imbalance" // Per-currency ledger total != 0
+)
+
+// DefaultReconciliationWindow is how far back journal checks look when no start is given
+const DefaultReconciliationWindow = 24 * time.Hour
+
+// ReconciliationRun is one execution of the integrity checks (reconciliation_runs)
+type ReconciliationRun struct {
+	ID                int64                   `json:"id" db:"id"`
+	Status            ReconciliationRunStatus `json:"status" db:"status"`
+	TriggerType       ReconciliationTrigger   `json:"trigger_type" db:"trigger_type"`
+	TriggeredBy       *string                 `json:"triggered_by,omitempty" db:"triggered_by"`
+	Currency          *string                 `json:"currency,omitempty" db:"currency"`
+	AccountType       *AccountType            `json:"account_type,omitempty" db:"account_type"`
+	JournalsFrom      time.Time               `json:"journals_from" db:"journals_from"`
+	AccountsChecked   int64                   `json:"accounts_checked" db:"accounts_checked"`
+	JournalsChecked   int64                   `json:"journals_checked" db:"journals_checked"`
+	CurrenciesChecked int64                   `json:"currencies_checked" db:"currencies_checked"`
+	BreaksFound       int64                   `json:"breaks_found" db:"breaks_found"`
+	Error             *string                 `json:"error,omitempty" db:"error"`
+	StartedAt         time.Time               `json:"started_at" db:"started_at"`
+	FinishedAt        *time.Time              `json:"finished_at,omitempty" db:"finished_at"`
+}
+
+// ReconciliationBreak is a single discrepancy found by a run (reconciliation_breaks)
+type ReconciliationBreak struct {
+	ID            int64             `json:"id" db:"id"`
+	RunID         int64             `json:"run_id" db:"run_id"`
+	BreakType     BreakType         `json:"break_type" db:"break_type"`
+	AccountID     *int64            `json:"account_id,omitempty" db:"account_id"`
+	AccountNumber *string           `json:"account_number,omitempty" db:"account_number"`
+	AccountType   *AccountType      `json:"account_type,omitempty" db:"account_type"`
+	JournalID     *int64            `json:"journal_id,omitempty" db:"journal_id"`
+	Currency      string            `json:"currency" db:"currency"`
+	Expected      decimal.Decimal   `json:"expected" db:"expected"`
+	Actual        decimal.Decimal   `json:"actual" db:"actual"`
+	Difference    decimal.Decimal   `json:"difference" db:"difference"` // Actual - Expected
+	Details       map[string]string `json:"details,omitempty" db:"details"`
+	Resolved      bool              `json:"resolved" db:"resolved"`
+	CreatedAt     time.Time         `json:"created_at" db:"created_at"`
+}
+
+// ReconciliationScope limits what a run checks
+type ReconciliationScope struct {
+	Currency     *string
+	AccountType  *AccountType
+	JournalsFrom time.Time
+}
+
+// RunReconciliationRequest starts a reconciliation run
+type RunReconciliationRequest struct {
+	Currency     *string
+	AccountType  *AccountType
+	JournalsFrom *time.Time // nil = now - DefaultReconciliationWindow
+	TriggerType  ReconciliationTrigger
+	TriggeredBy  *string
+}
+
+// ReconciliationStats are the counters a run reports
+type ReconciliationStats struct {
+	AccountsChecked   int64
+	JournalsChecked   int64
+	CurrenciesChecked int64
+}
+
+// ReconciliationBreakFilter filters breaks for listing
+type ReconciliationBreakFilter struct {
+	RunID     *int64 // nil = latest run
+	BreakType *BreakType
+	Currency  *string
+	Resolved  *bool
+	Limit     int
+	Offset    int
+}
+
+// IsValid checks the break type against the known set
+func (t BreakType) IsValid() bool {
+	switch t {
+	case BreakBalanceMismatch, BreakAvailableMismatch, BreakJournalUnbalanced,
+		BreakJournalNoEntries, BreakCurrencyImbalance:
+		return true
+	}
+	return false
+}
+
+// Scope resolves the request into the scope the checks run with
+func (r *RunReconciliationRequest) Scope(now time.Time) ReconciliationScope {
+	from := now.Add(-DefaultReconciliationWindow)
+	if r.JournalsFrom != nil {
+		from = *r.JournalsFrom
+	}
+	return ReconciliationScope{
+		Currency:     r.Currency,
+		AccountType:  r.AccountType,
+		JournalsFrom: from,
+	}
+}
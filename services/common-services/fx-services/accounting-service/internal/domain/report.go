@@ -1,6 +1,10 @@
 package domain
 
-import "time"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
 
 // DailyReport represents aggregated financial data for reporting
 type DailyReport struct {
@@ -14,11 +18,11 @@ type DailyReport struct {
 	AccountType   AccountType `json:"account_type" db:"account_type"`
 	Currency      string      `json:"currency" db:"currency"`
 
-	// Aggregated values (in smallest currency unit)
-	TotalDebit  float64 `json:"total_debit" db:"total_debit"`
-	TotalCredit float64 `json:"total_credit" db:"total_credit"`
-	Balance     float64 `json:"balance" db:"balance"`
-	NetChange   float64 `json:"net_change" db:"net_change"` // TotalCredit - TotalDebit
+	// Aggregated values (in currency units)
+	TotalDebit  decimal.Decimal `json:"total_debit" db:"total_debit"`
+	TotalCredit decimal.Decimal `json:"total_credit" db:"total_credit"`
+	Balance     decimal.Decimal `json:"balance" db:"balance"`
+	NetChange   decimal.Decimal `json:"net_change" db:"net_change"` // TotalCredit - TotalDebit
 
 	// Report date
 	Date time.Time `json:"date" db:"date"`
@@ -33,15 +37,17 @@ type AccountStatement struct {
 	OwnerID       string      `json:"owner_id"`
 	Currency      string      `json:"currency"`
 
-	OpeningBalance float64 `json:"opening_balance"`
-	ClosingBalance float64 `json:"closing_balance"`
-	TotalDebits    float64 `json:"total_debits"`
-	TotalCredits   float64 `json:"total_credits"`
+	OpeningBalance decimal.Decimal `json:"opening_balance"`
+	ClosingBalance decimal.Decimal `json:"closing_balance"`
+	TotalDebits    decimal.Decimal `json:"total_debits"`
+	TotalCredits   decimal.Decimal `json:"total_credits"`
 
 	PeriodStart time.Time `json:"period_start"`
 	PeriodEnd   time.Time `json:"period_end"`
 
 	Ledgers []*Ledger `json:"ledgers"`
+
+	Interest *InterestSummary `json:"interest,omitempty"` // Accounts that have accrued interest
 }
 
 // OwnerSummary represents aggregated data for an owner across all accounts
@@ -50,7 +56,7 @@ type OwnerSummary struct {
 	OwnerID      string                   `json:"owner_id"`
 	AccountType  AccountType              `json:"account_type"`
 	Balances     []*AccountBalanceSummary `json:"balances"`                     // Changed from AccountSummary
-	TotalBalance float64                  `json:"total_balance_usd_equivalent"` // Changed from map
+	TotalBalance decimal.Decimal          `json:"total_balance_usd_equivalent"` // Changed from map
 }
 
 // TransactionSummary represents transaction statistics
@@ -59,10 +65,10 @@ type TransactionSummary struct {
     TransactionType TransactionType `json:"transaction_type"`
     Currency        string          `json:"currency"`
     Count           int64           `json:"count"`
-    TotalVolume     float64         `json:"total_volume"`      // ✅ Changed to float64
-    AverageAmount   float64         `json:"average_amount"`
-    MinAmount       float64         `json:"min_amount"`
-    MaxAmount       float64         `json:"max_amount"`
+    TotalVolume     decimal.Decimal `json:"total_volume"`
+    AverageAmount   decimal.Decimal `json:"average_amount"`
+    MinAmount       decimal.Decimal `json:"min_amount"`
+    MaxAmount       decimal.Decimal `json:"max_amount"`
     PeriodStart     time.Time       `json:"period_start"`
     PeriodEnd       time. Time       `json:"period_end"`
 }
@@ -76,8 +82,8 @@ type StatementFilter struct {
 	Currency      *string
 	StartDate     *time.Time
 	EndDate       *time.Time
-	MinAmount     *float64
-	MaxAmount     *float64
+	MinAmount     *decimal.Decimal
+	MaxAmount     *decimal.Decimal
 	Limit         int
 	Offset        int
 }
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"time"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// ReversalRequest represents a request to reverse or refund a posted journal
+type ReversalRequest struct {
+	OriginalReceiptCode string          `json:"original_receipt_code"`
+	TransactionType     TransactionType `json:"transaction_type"` // reversal or refund
+	Amount              decimal.Decimal `json:"amount"`           // Refund only, in the currency of the original debit
+	Reason              string          `json:"reason"`
+	IdempotencyKey      *string         `json:"idempotency_key,omitempty"`
+	CreatedByExternalID string          `json:"created_by_external_id"`
+	CreatedByType       OwnerType       `json:"created_by_type"`
+	ReceiptCode         *string         // New receipt, assigned by the usecase
+}
+
+// TransactionReversal links a reversal/refund journal to the journal it mirrors
+type TransactionReversal struct {
+	ID                  int64           `json:"id" db:"id"`
+	OriginalJournalID   int64           `json:"original_journal_id" db:"original_journal_id"`
+	OriginalReceiptCode string          `json:"original_receipt_code" db:"original_receipt_code"`
+	ReversalJournalID   int64           `json:"reversal_journal_id" db:"reversal_journal_id"`
+	ReversalReceiptCode string          `json:"reversal_receipt_code" db:"reversal_receipt_code"`
+	Kind                TransactionType `json:"kind" db:"kind"`     // reversal or refund
+	Amount              decimal.Decimal `json:"amount" db:"amount"` // Principal returned, in Currency
+	Currency            string          `json:"currency" db:"currency"`
+	FeeReversed         decimal.Decimal `json:"fee_reversed" db:"fee_reversed"`
+	CommissionReversed  decimal.Decimal `json:"commission_reversed" db:"commission_reversed"`
+	Reason              *string         `json:"reason,omitempty" db:"reason"`
+	CreatedByExternalID *string         `json:"created_by_external_id,omitempty" db:"created_by_external_id"`
+	CreatedByType       *OwnerType      `json:"created_by_type,omitempty" db:"created_by_type"`
+	CreatedAt           time.Time       `json:"created_at" db:"created_at"`
+}
+
+// ReversalResult is returned by ReverseTransaction / RefundTransaction
+type ReversalResult struct {
+	Aggregate           *LedgerAggregate
+	Reversal            *TransactionReversal
+	RefundableRemaining decimal.Decimal // Principal still refundable on the original journal
+}
+
+// IsFullReversal returns true for a full reversal (as opposed to a partial refund)
+func (r *ReversalRequest) IsFullReversal() bool {
+	return r.TransactionType == TransactionTypeReversal
+}
+
+func (r *ReversalRequest) Validate() error {
+	if r.OriginalReceiptCode == "" {
+		return xerrors.ErrInvalidReceiptCode
+	}
+	switch r.TransactionType {
+	case TransactionTypeReversal:
+	case TransactionTypeRefund:
+		if !r.Amount.IsPositive() {
+			return xerrors.ErrInvalidAmount
+		}
+	default:
+		return xerrors.ErrInvalidTransactionType
+	}
+	if r.CreatedByExternalID == "" {
+		return xerrors.ErrRequiredFieldMissing
+	}
+	return nil
+}
+
+// IsReversible returns true if the journal can be reversed or refunded.
+// Reversals and refunds are terminal; they are never mirrored again.
+func (j *Journal) IsReversible() bool {
+	return j.TransactionType != TransactionTypeReversal && j.TransactionType != TransactionTypeRefund
+}
@@ -0,0 +1,348 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	DefaultScheduleMaxRetries = 3
+	MaxScheduleRetries        = 10
+	MinScheduleInterval       = time.Hour // Shortest interval schedule accepted
+)
+
+type ScheduledTransferStatus string
+
+const (
+	ScheduledTransferActive    ScheduledTransferStatus = "active"
+	ScheduledTransferCompleted ScheduledTransferStatus = "completed" // No occurrences left
+	ScheduledTransferCancelled ScheduledTransferStatus = "cancelled"
+)
+
+type ScheduledTransferRunStatus string
+
+const (
+	ScheduledTransferRunSucceeded ScheduledTransferRunStatus = "succeeded"
+	ScheduledTransferRunSkipped   ScheduledTransferRunStatus = "skipped" // Retries exhausted or not executable
+)
+
+// ScheduledTransfer is a standing order or a future-dated transfer. A cron
+// expression or an interval makes it recurring; with neither it runs once at
+// StartAt. Every occurrence posts through Transfer with its own idempotency
+// key, so an occurrence is never paid twice.
+type ScheduledTransfer struct {
+	ID                int64           `json:"id"`
+	OwnerType         OwnerType       `json:"owner_type"`
+	OwnerID           string          `json:"owner_id"`
+	FromAccountNumber string          `json:"from_account_number"`
+	ToAccountNumber   string          `json:"to_account_number"`
+	Amount            decimal.Decimal `json:"amount"`
+	Currency          string          `json:"currency"`
+	AccountType       AccountType     `json:"account_type"`
+	Description       string          `json:"description"`
+
+	CronExpr        *string    `json:"cron_expr,omitempty"`        // 5-field cron, UTC
+	IntervalSeconds *int64     `json:"interval_seconds,omitempty"` // Fixed interval from StartAt
+	StartAt         time.Time  `json:"start_at"`
+	EndAt           *time.Time `json:"end_at,omitempty"`   // No occurrences after this
+	MaxRuns         *int       `json:"max_runs,omitempty"` // Occurrences, paid or skipped
+	MaxRetries      int        `json:"max_retries"`        // Retries of an occurrence that cannot be paid
+
+	Status          ScheduledTransferStatus `json:"status"`
+	NextRunAt       *time.Time              `json:"next_run_at,omitempty"` // Due occurrence; nil once finished
+	Attempts        int                     `json:"attempts"`              // Failed attempts of the due occurrence
+	RunCount        int                     `json:"run_count"`
+	LastRunAt       *time.Time              `json:"last_run_at,omitempty"`
+	LastReceiptCode *string                 `json:"last_receipt_code,omitempty"`
+	LastError       *string                 `json:"last_error,omitempty"`
+	CancelledBy     *string                 `json:"cancelled_by,omitempty"`
+	CancelledAt     *time.Time              `json:"cancelled_at,omitempty"`
+	CreatedAt       time.Time               `json:"created_at"`
+	UpdatedAt       time.Time               `json:"updated_at"`
+}
+
+func (s *ScheduledTransfer) Validate() error {
+	if s.OwnerID == "" || s.FromAccountNumber == "" || s.ToAccountNumber == "" {
+		return xerrors.ErrRequiredFieldMissing
+	}
+	if s.OwnerType == OwnerTypeSystem {
+		return xerrors.ErrInvalidOwnerType
+	}
+	if s.FromAccountNumber == s.ToAccountNumber {
+		return fmt.Errorf("%w: source and destination are the same account", xerrors.ErrInvalidSchedule)
+	}
+	if !s.Amount.IsPositive() {
+		return xerrors.ErrInvalidAmount
+	}
+	if s.StartAt.IsZero() {
+		return fmt.Errorf("%w: start_at is required", xerrors.ErrInvalidSchedule)
+	}
+	if s.CronExpr != nil && s.IntervalSeconds != nil {
+		return fmt.Errorf("%w: use either a cron expression or an interval", xerrors.ErrInvalidSchedule)
+	}
+	if s.CronExpr != nil {
+		if _, err := ParseCronSchedule(*s.CronExpr); err != nil {
+			return err
+		}
+	}
+	if s.IntervalSeconds != nil && time.Duration(*s.IntervalSeconds)*time.Second < MinScheduleInterval {
+		return fmt.Errorf("%w: interval must be at least %s", xerrors.ErrInvalidSchedule, MinScheduleInterval)
+	}
+	if s.EndAt != nil && !s.EndAt.After(s.StartAt) {
+		return fmt.Errorf("%w: end_at must be after start_at", xerrors.ErrInvalidSchedule)
+	}
+	if s.MaxRuns != nil && *s.MaxRuns < 1 {
+		return fmt.Errorf("%w: max_runs must be at least 1", xerrors.ErrInvalidSchedule)
+	}
+	if s.MaxRetries < 0 || s.MaxRetries > MaxScheduleRetries {
+		return fmt.Errorf("%w: max_retries must be between 0 and %d", xerrors.ErrInvalidSchedule, MaxScheduleRetries)
+	}
+	return nil
+}
+
+// IsRecurring reports whether the schedule has more than one occurrence
+func (s *ScheduledTransfer) IsRecurring() bool {
+	return s.CronExpr != nil || s.IntervalSeconds != nil
+}
+
+// FirstOccurrence returns the first occurrence at or after from (and StartAt)
+func (s *ScheduledTransfer) FirstOccurrence(from time.Time) *time.Time {
+	if from.Before(s.StartAt) {
+		from = s.StartAt
+	}
+	from = from.UTC().Truncate(time.Second)
+
+	var first time.Time
+	switch {
+	case s.CronExpr != nil:
+		cron, err := ParseCronSchedule(*s.CronExpr)
+		if err != nil {
+			return nil
+		}
+		next, ok := cron.Next(from.Add(-time.Second))
+		if !ok {
+			return nil
+		}
+		first = next
+	case s.IntervalSeconds != nil:
+		// Stay on the StartAt grid
+		interval := time.Duration(*s.IntervalSeconds) * time.Second
+		first = s.StartAt.UTC()
+		if from.After(first) {
+			steps := (from.Sub(first) + interval - 1) / interval
+			first = first.Add(steps * interval)
+		}
+	default:
+		if from.After(s.StartAt) {
+			first = from
+		} else {
+			first = s.StartAt.UTC()
+		}
+	}
+	return s.bounded(first, 0)
+}
+
+// NextOccurrence returns the occurrence after prev, or nil when the schedule
+// is finished. runs is the number of occurrences processed including prev.
+func (s *ScheduledTransfer) NextOccurrence(prev time.Time, runs int) *time.Time {
+	var next time.Time
+	switch {
+	case s.CronExpr != nil:
+		cron, err := ParseCronSchedule(*s.CronExpr)
+		if err != nil {
+			return nil
+		}
+		n, ok := cron.Next(prev)
+		if !ok {
+			return nil
+		}
+		next = n
+	case s.IntervalSeconds != nil:
+		next = prev.Add(time.Duration(*s.IntervalSeconds) * time.Second)
+	default:
+		return nil
+	}
+	return s.bounded(next, runs)
+}
+
+func (s *ScheduledTransfer) bounded(t time.Time, runs int) *time.Time {
+	if s.EndAt != nil && t.After(*s.EndAt) {
+		return nil
+	}
+	if s.MaxRuns != nil && runs >= *s.MaxRuns {
+		return nil
+	}
+	return &t
+}
+
+// IdempotencyKey identifies the transfer of one occurrence
+func (s *ScheduledTransfer) IdempotencyKey(occurrence time.Time) string {
+	return fmt.Sprintf("scheduled-transfer:%d:%d", s.ID, occurrence.Unix())
+}
+
+// ScheduledTransferRun records how one occurrence ended
+type ScheduledTransferRun struct {
+	ID           int64                      `json:"id"`
+	ScheduleID   int64                      `json:"schedule_id"`
+	OccurrenceAt time.Time                  `json:"occurrence_at"`
+	Status       ScheduledTransferRunStatus `json:"status"`
+	Attempts     int                        `json:"attempts"`
+	ReceiptCode  *string                    `json:"receipt_code,omitempty"`
+	ErrorMessage *string                    `json:"error_message,omitempty"`
+	CreatedAt    time.Time                  `json:"created_at"`
+}
+
+// ScheduledTransferFilter selects schedules for listing
+type ScheduledTransferFilter struct {
+	OwnerType     *OwnerType
+	OwnerID       *string
+	AccountNumber *string // Source or destination
+	Status        *ScheduledTransferStatus
+	Limit         int
+	Offset        int
+}
+
+// ===============================
+// CRON EXPRESSIONS
+// ===============================
+
+// CronSchedule is a parsed 5-field cron expression (minute hour day-of-month
+// month day-of-week) evaluated in UTC. Fields accept *, lists, ranges and
+// steps; @hourly, @daily, @weekly, @monthly and @yearly are shorthands. As in
+// standard cron, when both day fields are restricted either may match.
+type CronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+var cronShorthands = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+}
+
+func ParseCronSchedule(expr string) (*CronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+	if full, ok := cronShorthands[strings.ToLower(expr)]; ok {
+		expr = full
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("%w: cron expression needs 5 fields, got %d", xerrors.ErrInvalidSchedule, len(fields))
+	}
+
+	var c CronSchedule
+	var err error
+	if c.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if c.dom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	if c.dow, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, err
+	}
+	if c.dow&(1<<7) != 0 { // 7 is Sunday too
+		c.dow |= 1
+	}
+	c.domAny = strings.HasPrefix(fields[2], "*")
+	c.dowAny = strings.HasPrefix(fields[4], "*")
+	return &c, nil
+}
+
+func parseCronField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s < 1 {
+				return 0, fmt.Errorf("%w: bad step in %q", xerrors.ErrInvalidSchedule, part)
+			}
+			rangePart, step = part[:i], s
+		}
+
+		lo, hi := min, max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			a, errA := strconv.Atoi(bounds[0])
+			b, errB := strconv.Atoi(bounds[1])
+			if errA != nil || errB != nil || a > b {
+				return 0, fmt.Errorf("%w: bad range %q", xerrors.ErrInvalidSchedule, rangePart)
+			}
+			lo, hi = a, b
+		default:
+			v, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return 0, fmt.Errorf("%w: bad value %q", xerrors.ErrInvalidSchedule, rangePart)
+			}
+			lo, hi = v, v
+			if step > 1 { // "5/15" means from 5 to the end
+				hi = max
+			}
+		}
+		if lo < min || hi > max {
+			return 0, fmt.Errorf("%w: %q out of range %d-%d", xerrors.ErrInvalidSchedule, part, min, max)
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// Next returns the first matching minute strictly after t. ok is false when
+// nothing matches within five years (e.g. "0 0 30 2 *").
+func (c *CronSchedule) Next(t time.Time) (time.Time, bool) {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+func (c *CronSchedule) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domAny || c.dowAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// StatementFormat is the file format of an exported statement
+type StatementFormat string
+
+const (
+	StatementFormatCSV StatementFormat = "csv"
+	StatementFormatPDF StatementFormat = "pdf"
+)
+
+// ContentType returns the MIME type of the format
+func (f StatementFormat) ContentType() string {
+	if f == StatementFormatPDF {
+		return "application/pdf"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// ExportStatementRequest exports the statement of one account for [From, To]
+type ExportStatementRequest struct {
+	AccountNumber string
+	AccountType   AccountType
+	From          time.Time
+	To            time.Time
+	Format        StatementFormat
+}
+
+// Filename returns the download name, e.g. statement_ACC123_20260901_20260930.csv
+func (r *ExportStatementRequest) Filename() string {
+	return fmt.Sprintf("statement_%s_%s_%s.%s",
+		r.AccountNumber, r.From.Format("20060102"), r.To.Format("20060102"), r.Format)
+}
+
+// StatementHeader is written before any statement line
+type StatementHeader struct {
+	AccountNumber  string
+	AccountType    AccountType
+	OwnerType      OwnerType
+	OwnerID        string
+	Currency       string
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	OpeningBalance decimal.Decimal // Balance just before PeriodStart
+	GeneratedAt    time.Time
+}
+
+// StatementLine is one ledger entry of an exported statement
+type StatementLine struct {
+	LedgerID        int64
+	JournalID       int64
+	Date            time.Time
+	ReceiptCode     *string
+	TransactionType TransactionType
+	Description     *string
+	DrCr            DrCr
+	Amount          decimal.Decimal
+	Fee             decimal.Decimal // Fee charged on the receipt, shown on debits only
+	Balance         decimal.Decimal // Running balance after this line
+}
+
+// StatementFooter closes an exported statement
+type StatementFooter struct {
+	TotalDebits    decimal.Decimal
+	TotalCredits   decimal.Decimal
+	TotalFees      decimal.Decimal
+	ClosingBalance decimal.Decimal
+	LineCount      int64
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	MaxSubAccountsPerParent = 20
+	MaxSubAccountNameLength = 64
+)
+
+// CreateSubAccountRequest opens a named sub-account under a main account.
+// The sub-account takes the parent's owner, currency and account type.
+type CreateSubAccountRequest struct {
+	ParentAccountNumber string
+	Name                string
+	Purpose             AccountPurpose // Defaults to the parent's purpose
+	OwnerType           OwnerType      // Caller; must own the parent
+	OwnerID             string
+}
+
+func (r *CreateSubAccountRequest) Validate() error {
+	if r.ParentAccountNumber == "" {
+		return xerrors.ErrInvalidAccountNumber
+	}
+	r.Name = strings.TrimSpace(r.Name)
+	if r.Name == "" {
+		return fmt.Errorf("%w: name", xerrors.ErrRequiredFieldMissing)
+	}
+	if len(r.Name) > MaxSubAccountNameLength {
+		return fmt.Errorf("%w: name longer than %d characters", xerrors.ErrInvalidRequest, MaxSubAccountNameLength)
+	}
+	if r.OwnerType == "" || r.OwnerID == "" {
+		return fmt.Errorf("%w: owner_type and owner_id", xerrors.ErrRequiredFieldMissing)
+	}
+	if r.Purpose != "" && !IsSubAccountPurpose(r.Purpose) {
+		return fmt.Errorf("%w: %s", xerrors.ErrInvalidAccountPurpose, r.Purpose)
+	}
+	return nil
+}
+
+// IsSubAccountPurpose reports whether sub-accounts may be opened with purpose.
+// System purposes (liquidity, fees, revenue, ...) are never sub-accounts.
+func IsSubAccountPurpose(purpose AccountPurpose) bool {
+	switch purpose {
+	case PurposeWallet, PurposeSavings, PurposeInvestment, PurposeSettlement:
+		return true
+	}
+	return false
+}
+
+// CanHaveSubAccounts returns nil if sub-accounts may be opened under a.
+// Only active, user-facing main accounts can be parents.
+func (a *Account) CanHaveSubAccounts() error {
+	if a.IsSubAccount() {
+		return fmt.Errorf("%w: %s is itself a sub-account", xerrors.ErrSubAccountNotAllowed, a.AccountNumber)
+	}
+	if a.IsSystemAccount() || !IsSubAccountPurpose(a.Purpose) {
+		return fmt.Errorf("%w: %s is a %s account", xerrors.ErrSubAccountNotAllowed, a.AccountNumber, a.Purpose)
+	}
+	if !a.IsActive {
+		return xerrors.ErrAccountInactive
+	}
+	return nil
+}
+
+// SubAccounts is a main account with its sub-accounts and their balances
+type SubAccounts struct {
+	Parent                   *Account
+	Accounts                 []*Account // Balance loaded
+	RolledUpBalance          decimal.Decimal
+	RolledUpAvailableBalance decimal.Decimal
+}
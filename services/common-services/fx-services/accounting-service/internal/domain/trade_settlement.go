@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxTradesPerSettlement bounds one SettleTrades batch
+const MaxTradesPerSettlement = 5000
+
+type TradeResult string
+
+const (
+	TradeResultWin  TradeResult = "win"
+	TradeResultLoss TradeResult = "loss"
+)
+
+type TradeSettlementStatus string
+
+const (
+	TradeSettlementSettled   TradeSettlementStatus = "settled"
+	TradeSettlementDuplicate TradeSettlementStatus = "duplicate" // Settled by an earlier batch; original result returned
+	TradeSettlementFailed    TradeSettlementStatus = "failed"
+)
+
+// SettleTrade is one closed position in a settlement batch
+type SettleTrade struct {
+	TradeID       string          `json:"trade_id"`
+	AccountNumber string          `json:"account_number"`
+	Result        TradeResult     `json:"result"`
+	Amount        decimal.Decimal `json:"amount"` // Won or lost, always positive
+	TradeType     string          `json:"trade_type"`
+}
+
+func (t *SettleTrade) Validate() error {
+	if t.TradeID == "" {
+		return fmt.Errorf("%w: trade_id", xerrors.ErrRequiredFieldMissing)
+	}
+	if t.AccountNumber == "" {
+		return xerrors.ErrInvalidAccountNumber
+	}
+	if t.Result != TradeResultWin && t.Result != TradeResultLoss {
+		return fmt.Errorf("%w: result must be win or loss", xerrors.ErrInvalidRequest)
+	}
+	if !t.Amount.IsPositive() {
+		return xerrors.ErrInvalidAmount
+	}
+	return nil
+}
+
+// SettleTradesRequest settles many trades at once. Trades are grouped by
+// account and each group's wins and losses are netted into one journal.
+type SettleTradesRequest struct {
+	BatchID             string
+	AccountType         AccountType
+	Trades              []*SettleTrade
+	CreatedByExternalID string
+	CreatedByType       OwnerType
+}
+
+func (r *SettleTradesRequest) Validate() error {
+	if len(r.Trades) == 0 {
+		return fmt.Errorf("%w: no trades", xerrors.ErrInvalidRequest)
+	}
+	if len(r.Trades) > MaxTradesPerSettlement {
+		return fmt.Errorf("%w: at most %d trades per batch", xerrors.ErrInvalidRequest, MaxTradesPerSettlement)
+	}
+	if r.CreatedByExternalID == "" {
+		return fmt.Errorf("%w: created_by_external_id", xerrors.ErrRequiredFieldMissing)
+	}
+	return nil
+}
+
+// TradeSettlementGroup is the trades of one account settled in one database
+// transaction
+type TradeSettlementGroup struct {
+	BatchID             string
+	AccountNumber       string
+	AccountType         AccountType
+	Trades              []*SettleTrade
+	ReceiptCode         *string // Set by the usecase when the group nets to a posting
+	CreatedByExternalID string
+	CreatedByType       OwnerType
+}
+
+// NetTrades sums the trades' signed amounts
+func NetTrades(trades []*SettleTrade) (net, wins, losses decimal.Decimal) {
+	for _, t := range trades {
+		if t.Result == TradeResultWin {
+			wins = wins.Add(t.Amount)
+		} else {
+			losses = losses.Add(t.Amount)
+		}
+	}
+	return wins.Sub(losses), wins, losses
+}
+
+// TradeSettlementKey is the journal idempotency key for netting exactly
+// these trades, independent of their order in the batch
+func TradeSettlementKey(trades []*SettleTrade) string {
+	ids := make([]string, len(trades))
+	for i, t := range trades {
+		ids[i] = t.TradeID
+	}
+	sort.Strings(ids)
+	sum := sha256.Sum256([]byte(strings.Join(ids, "\x00")))
+	return "trade-settlement:" + hex.EncodeToString(sum[:16])
+}
+
+// TradeSettlement is the outcome of one trade. The row stored per trade ID
+// is what makes a replayed batch return the original result.
+type TradeSettlement struct {
+	TradeID       string                `json:"trade_id"`
+	AccountNumber string                `json:"account_number"`
+	Result        TradeResult           `json:"result"`
+	Amount        decimal.Decimal       `json:"amount"`
+	Status        TradeSettlementStatus `json:"status"`
+	BatchID       string                `json:"batch_id,omitempty"`
+	JournalID     *int64                `json:"journal_id,omitempty"`   // Nil when the group netted to zero
+	ReceiptCode   *string               `json:"receipt_code,omitempty"` // Shared by the trades netted together
+	BalanceAfter  *decimal.Decimal      `json:"balance_after,omitempty"`
+	Error         string                `json:"error,omitempty"`
+	SettledAt     *time.Time            `json:"settled_at,omitempty"`
+}
+
+// TradeSettlementSummary is the outcome of a batch, trades in request order
+type TradeSettlementSummary struct {
+	Trades         []*TradeSettlement `json:"trades"`
+	Settled        int                `json:"settled"`
+	Duplicates     int                `json:"duplicates"`
+	Failed         int                `json:"failed"`
+	JournalsPosted int                `json:"journals_posted"`
+}
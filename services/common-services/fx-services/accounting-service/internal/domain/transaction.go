@@ -3,9 +3,10 @@ package domain
 import (
 	"errors"
 	"fmt"
-	"math"
 	"time"
 	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
 )
 
 // LedgerAggregate represents a complete transaction with all its parts
@@ -14,7 +15,7 @@ type LedgerAggregate struct {
 	Ledgers []*Ledger         // Double-entry ledger entries
 	Receipt *Receipt          // Optional receipt reference
 	Fees    []*TransactionFee // Applied fees
-	PayableAmount float64   // Total payable amount (credits minus fees)
+	PayableAmount decimal.Decimal // Total payable amount (credits minus fees)
 }
 
 // Receipt represents a transaction receipt (optional)
@@ -22,7 +23,7 @@ type Receipt struct {
 	Code        string
 	ReceiptType TransactionType
 	AccountType AccountType
-	Amount      float64
+	Amount      decimal.Decimal
 	Currency    string
 	Status      string
 	ExternalRef *string
@@ -41,6 +42,7 @@ type TransactionRequest struct {
 	CreatedByType       *OwnerType
 	IPAddress           *string
 	UserAgent           *string
+	PostedAt            *time.Time // Accounting date for back-dated postings; nil = now
 
 	// Ledger entries (must balance)
 	Entries []*LedgerEntryRequest
@@ -54,12 +56,22 @@ type TransactionRequest struct {
 	AgentExternalID     *string                `json:"agent_external_id,omitempty"` // Agent who facilitated transaction
 	IsSystemTransaction bool                   `json:"is_system_transaction"`       // If true, no fees applied
 	Metadata            map[string]interface{} `json:"metadata,omitempty"`          // Additional metadata
+
+	// Limit usage checked at pre-validation; recorded in the journal's transaction
+	LimitReservation *LimitReservation `json:"limit_reservation,omitempty"`
+	// Set when the limits were already counted, e.g. when the debit's hold was placed
+	LimitsReserved bool `json:"limits_reserved,omitempty"`
+
+	// Events written to the outbox with the journal, after transaction.completed.
+	// The repository fills in TransactionID, ReceiptCode when empty and
+	// BalanceAfter of AccountNumber.
+	Events []*TransactionEvent `json:"-"`
 }
 
 // LedgerEntryRequest represents a single ledger entry
 type LedgerEntryRequest struct {
 	AccountNumber string                 // Account to debit/credit
-	Amount        float64                // Amount in smallest unit
+	Amount        decimal.Decimal        // Amount in currency units (scaled by currencies.decimals)
 	DrCr          DrCr                   // DR or CR
 	Currency      string                 // Currency code
 	Description   *string                // Optional description
@@ -77,9 +89,9 @@ type TransactionResult struct {
 	ReceiptCode    string
 	TransactionID  int64
 	Status         string
-	Amount         float64
+	Amount         decimal.Decimal
 	Currency       string
-	Fee            float64
+	Fee            decimal.Decimal
 	ProcessingTime time.Duration
 	CreatedAt      time.Time
 }
@@ -96,7 +108,7 @@ type TransactionStatus struct {
 // CreditRequest represents a simple credit operation (add money to account)
 type CreditRequest struct {
 	AccountNumber       string                 `json:"account_number"`
-	Amount              float64                `json:"amount"`
+	Amount              decimal.Decimal        `json:"amount"`
 	Currency            string                 `json:"currency"`
 	AccountType         AccountType            `json:"account_type"`
 	Description         string                 `json:"description"`
@@ -108,13 +120,14 @@ type CreditRequest struct {
 	ReceiptCode         *string
 	TransactionType     TransactionType `json:"transaction_type"`
 	ToAddress	   *string				 `json:"to_address,omitempty"`          // Destination address for crypto transfers
+	PostedAt            *time.Time             `json:"posted_at,omitempty"`           // Back-dated accounting date; nil = now
 
 }
 
 // DebitRequest represents a simple debit operation (remove money from account)
 type DebitRequest struct {
 	AccountNumber       string                 `json:"account_number"`
-	Amount              float64                `json:"amount"`
+	Amount              decimal.Decimal        `json:"amount"`
 	Currency            string                 `json:"currency"`
 	AccountType         AccountType            `json:"account_type"`
 	Description         string                 `json:"description"`
@@ -126,13 +139,14 @@ type DebitRequest struct {
 	ReceiptCode         *string
 	TransactionType     TransactionType `json:"transaction_type"`
 	ToAddress	   *string				 `json:"to_address,omitempty"`          // Destination address for crypto transfers
+	PostedAt            *time.Time             `json:"posted_at,omitempty"`           // Back-dated accounting date; nil = now
 }
 
 // TransferRequest represents a transfer between two accounts (same currency)
 type TransferRequest struct {
 	FromAccountNumber   string                 `json:"from_account_number"`
 	ToAccountNumber     string                 `json:"to_account_number"`
-	Amount              float64                `json:"amount"`
+	Amount              decimal.Decimal        `json:"amount"`
 	AccountType         AccountType            `json:"account_type"`
 	Description         string                 `json:"description"`
 	IdempotencyKey      *string                `json:"idempotency_key,omitempty"`
@@ -145,13 +159,14 @@ type TransferRequest struct {
 	TransactionType     TransactionType `json:"transaction_type"`
 	TransactionFee *TransactionFee
 	ToAddress	   *string				 `json:"to_address,omitempty"`          // Destination address for crypto transfers
+	LimitReservation *LimitReservation `json:"-"` // Checked by the usecase, recorded with the journal
 }
 
 // ConversionRequest represents a currency conversion transfer
 type ConversionRequest struct {
 	FromAccountNumber   string                 `json:"from_account_number"` // USD account
 	ToAccountNumber     string                 `json:"to_account_number"`   // EUR account
-	Amount              float64                `json:"amount"`              // Amount in source currency
+	Amount              decimal.Decimal        `json:"amount"`              // Amount in source currency
 	AccountType         AccountType            `json:"account_type"`
 	IdempotencyKey      *string                `json:"idempotency_key,omitempty"`
 	ExternalRef         *string                `json:"external_ref,omitempty"`
@@ -162,21 +177,24 @@ type ConversionRequest struct {
 	ReceiptCode         *string
 	TransactionFee *TransactionFee
 	ToAddress	   *string				 `json:"to_address,omitempty"`          // Destination address for crypto transfers
+	QuoteID        *string  `json:"quote_id,omitempty"` // Book a CreateFXQuote rate
+	Quote          *FXQuote `json:"-"`                  // Resolved by the usecase
+	LimitReservation *LimitReservation `json:"-"` // Checked by the usecase, recorded with the journal
 }
 
 type AgentCommissionRequest struct {
 	AgentExternalID   string  `json:"agent_external_id"`
 	TransactionRef    string  `json:"transaction_ref"` // Reference to original transaction
 	Currency          string  `json:"currency"`
-	TransactionAmount float64 `json:"transaction_amount"` // Original transaction amount
-	CommissionAmount  float64 `json:"commission_amount"`  // Calculated commission
+	TransactionAmount decimal.Decimal `json:"transaction_amount"` // Original transaction amount
+	CommissionAmount  decimal.Decimal `json:"commission_amount"`  // Calculated commission
 	CommissionRate    *string `json:"commission_rate"`    // Rate used for calculation
 	IdempotencyKey    *string `json:"idempotency_key,omitempty"`
 	ReceiptCode       *string
 }
 type TradeRequest struct {
 	AccountNumber       string                 `json:"account_number"`
-	Amount              float64                `json:"amount"`
+	Amount              decimal.Decimal        `json:"amount"`
 	Currency            string                 `json:"currency"`
 	AccountType         AccountType            `json:"account_type"`
 	TradeID             string                 `json:"trade_id"`
@@ -196,7 +214,7 @@ func (r *CreditRequest) Validate() error {
 	if r.AccountNumber == "" {
 		return xerrors.ErrInvalidAccountNumber
 	}
-	if r.Amount <= 0 {
+	if !r.Amount.IsPositive() {
 		return xerrors.ErrInvalidAmount
 	}
 	// if r.Currency == "" {
@@ -212,7 +230,7 @@ func (r *DebitRequest) Validate() error {
 	if r.AccountNumber == "" {
 		return xerrors.ErrInvalidAccountNumber
 	}
-	if r.Amount <= 0 {
+	if !r.Amount.IsPositive() {
 		return xerrors.ErrInvalidAmount
 	}
 	// if r.Currency == "" {
@@ -231,7 +249,7 @@ func (r *TransferRequest) Validate() error {
 	if r.FromAccountNumber == r.ToAccountNumber {
 		return xerrors.ErrInvalidTransaction
 	}
-	if r.Amount <= 0 {
+	if !r.Amount.IsPositive() {
 		return xerrors.ErrInvalidAmount
 	}
 	if r.CreatedByExternalID == "" {
@@ -247,7 +265,7 @@ func (r *ConversionRequest) Validate() error {
 	if r.FromAccountNumber == r.ToAccountNumber {
 		return xerrors.ErrInvalidTransaction
 	}
-	if r.Amount <= 0 {
+	if !r.Amount.IsPositive() {
 		return xerrors.ErrInvalidAmount
 	}
 	if r.CreatedByExternalID == "" {
@@ -260,7 +278,7 @@ func (r *TradeRequest) Validate() error {
 	if r.AccountNumber == "" {
 		return xerrors.ErrInvalidAccountNumber
 	}
-	if r.Amount <= 0 {
+	if !r.Amount.IsPositive() {
 		return xerrors.ErrInvalidAmount
 	}
 	// if r.Currency == "" {
@@ -282,7 +300,7 @@ func (r *AgentCommissionRequest) Validate() error {
 	if r.Currency == "" {
 		return xerrors.ErrInvalidCurrency
 	}
-	if r.CommissionAmount <= 0 {
+	if !r.CommissionAmount.IsPositive() {
 		return xerrors.ErrInvalidFeeAmount
 	}
 	if r.TransactionRef == "" {
@@ -296,13 +314,13 @@ func (r *AgentCommissionRequest) Validate() error {
 // ========================================
 
 // GetTotalAmount returns the debit amount (transaction amount)
-func (r *TransactionRequest) GetTotalAmount() float64 {
+func (r *TransactionRequest) GetTotalAmount() decimal.Decimal {
 	for _, entry := range r.Entries {
 		if entry.DrCr == DrCrDebit {
 			return entry.Amount
 		}
 	}
-	return 0
+	return decimal.Zero
 }
 
 // IsConversion checks if transaction involves multiple currencies
@@ -337,6 +355,12 @@ func (r *TransactionRequest) Validate() error {
 	switch r.TransactionType {
 	case TransactionTypeConversion:
 		return r.validateConversion()
+	case TransactionTypeReversal, TransactionTypeRefund:
+		// Mirror of a conversion keeps the original multi-currency shape
+		if r.IsConversion() {
+			return r.validateConversion()
+		}
+		return r.validateStandardTransaction()
 	case TransactionTypeTransfer, TransactionTypeDeposit, TransactionTypeWithdrawal:
 		return r.validateStandardTransaction()
 	default:
@@ -346,7 +370,7 @@ func (r *TransactionRequest) Validate() error {
 
 // ✅ Standard transaction validation (single currency, balanced)
 func (r *TransactionRequest) validateStandardTransaction() error {
-	var totalDebits, totalCredits float64
+	totalDebits, totalCredits := decimal.Zero, decimal.Zero
 	currencyMap := make(map[string]bool)
 
 	for _, entry := range r. Entries {
@@ -357,9 +381,9 @@ func (r *TransactionRequest) validateStandardTransaction() error {
 		currencyMap[entry.Currency] = true
 
 		if entry.DrCr == DrCrDebit {
-			totalDebits += entry. Amount
+			totalDebits = totalDebits.Add(entry.Amount)
 		} else {
-			totalCredits += entry.Amount
+			totalCredits = totalCredits.Add(entry.Amount)
 		}
 	}
 
@@ -368,9 +392,9 @@ func (r *TransactionRequest) validateStandardTransaction() error {
 		return errors.New("standard transactions must use single currency")
 	}
 
-	// Must balance
-	if math.Abs(totalDebits-totalCredits) > 0.0001 { // Use epsilon for float comparison
-		return fmt.Errorf("unbalanced transaction: debits=%.2f, credits=%.2f", totalDebits, totalCredits)
+	// Must balance exactly - amounts are fixed-point, no epsilon needed
+	if !totalDebits.Equal(totalCredits) {
+		return fmt.Errorf("unbalanced transaction: debits=%s, credits=%s", totalDebits, totalCredits)
 	}
 
 	return nil
@@ -383,8 +407,8 @@ func (r *TransactionRequest) validateConversion() error {
 	}
 
 	currencyBalances := make(map[string]struct {
-		debits  float64
-		credits float64
+		debits  decimal.Decimal
+		credits decimal.Decimal
 		count   int
 	})
 
@@ -396,9 +420,9 @@ func (r *TransactionRequest) validateConversion() error {
 		balance := currencyBalances[entry.Currency]
 		balance.count++
 		if entry.DrCr == DrCrDebit {
-			balance. debits += entry.Amount
+			balance.debits = balance.debits.Add(entry.Amount)
 		} else {
-			balance.credits += entry.Amount
+			balance.credits = balance.credits.Add(entry.Amount)
 		}
 		currencyBalances[entry. Currency] = balance
 	}
@@ -414,11 +438,11 @@ func (r *TransactionRequest) validateConversion() error {
 
 	for _, balance := range currencyBalances {
 		// Source currency: should be all debits
-		if balance.debits > 0 && balance.credits == 0 {
+		if balance.debits.IsPositive() && balance.credits.IsZero() {
 			sourceCount++
 		}
 		// Dest currency: should have credits (and maybe fee debit)
-		if balance.credits > 0 {
+		if balance.credits.IsPositive() {
 			destCount++
 		}
 	}
@@ -438,7 +462,7 @@ func (e *LedgerEntryRequest) Validate() error {
 	if e.AccountNumber == "" {
 		return errors.New("account_number required")
 	}
-	if e.Amount <= 0 {
+	if !e.Amount.IsPositive() {
 		return errors.New("amount must be positive")
 	}
 	if e.DrCr != DrCrDebit && e.DrCr != DrCrCredit {
@@ -452,17 +476,17 @@ func (e *LedgerEntryRequest) Validate() error {
 
 // IsBalanced checks if debits equal credits
 func (r *TransactionRequest) IsBalanced() bool {
-	var totalDebits, totalCredits float64
+	totalDebits, totalCredits := decimal.Zero, decimal.Zero
 
 	for _, entry := range r.Entries {
 		if entry.DrCr == DrCrDebit {
-			totalDebits += entry.Amount
+			totalDebits = totalDebits.Add(entry.Amount)
 		} else {
-			totalCredits += entry.Amount
+			totalCredits = totalCredits.Add(entry.Amount)
 		}
 	}
 
-	return totalDebits == totalCredits
+	return totalDebits.Equal(totalCredits)
 }
 
 // GetCurrency returns the currency of the transaction (assumes single currency)
@@ -474,7 +498,7 @@ func (r *TransactionRequest) GetCurrency() string {
 }
 
 // SimpleTransfer creates a simple A->B transfer request
-func SimpleTransfer(fromAccount, toAccount string, amount float64, currency string, accountType AccountType) *TransactionRequest {
+func SimpleTransfer(fromAccount, toAccount string, amount decimal.Decimal, currency string, accountType AccountType) *TransactionRequest {
 	return &TransactionRequest{
 		TransactionType: TransactionTypeTransfer,
 		AccountType:     accountType,
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // FeeType represents the type of fee
@@ -38,39 +40,53 @@ type TransactionFeeRule struct {
 	FeeType           FeeType              `json:"fee_type" db:"fee_type"`
 	CalculationMethod FeeCalculationMethod `json:"calculation_method" db:"calculation_method"`
 	FeeValue          string               `json:"fee_value" db:"fee_value"`       // NUMERIC(10,6) as string
-	MinFee            *float64             `json:"min_fee,omitempty" db:"min_fee"` // In smallest unit
-	MaxFee            *float64             `json:"max_fee,omitempty" db:"max_fee"` // In smallest unit
+	MinFee            *decimal.Decimal     `json:"min_fee,omitempty" db:"min_fee"` // In currency units
+	MaxFee            *decimal.Decimal     `json:"max_fee,omitempty" db:"max_fee"` // In currency units
 	Tiers             json.RawMessage      `json:"tiers,omitempty" db:"tiers"`     // JSONB for tiered fees
 	Tariffs           *string         `json:"tariffs,omitempty"`
 	ValidFrom         time.Time            `json:"valid_from" db:"valid_from"`
 	ValidTo           *time.Time           `json:"valid_to,omitempty" db:"valid_to"`
 	IsActive          bool                 `json:"is_active" db:"is_active"`
 	Priority          int                  `json:"priority" db:"priority"` // Higher = selected first
+	Version           int                  `json:"version" db:"version"`                                 // Bumped on every change
+	VersionID         *int64               `json:"version_id,omitempty" db:"current_version_id"`         // Immutable snapshot of this version
 	CreatedAt         time.Time            `json:"created_at" db:"created_at"`
 	UpdatedAt         time.Time            `json:"updated_at" db:"updated_at"`
 }
 
+// FeeRuleVersion is an immutable snapshot of a fee rule. Applied fees
+// reference the version that priced them, so later edits to the rule do not
+// change what an old transaction was charged under.
+type FeeRuleVersion struct {
+	ID        int64               `json:"id" db:"id"`
+	RuleID    int64               `json:"rule_id" db:"rule_id"`
+	Version   int                 `json:"version" db:"version"`
+	Rule      *TransactionFeeRule `json:"rule"` // Rule fields as of this version
+	CreatedAt time.Time           `json:"created_at" db:"created_at"`
+}
+
 // FeeTier represents a tier in tiered fee structure
 type FeeTier struct {
-	MinAmount float64  `json:"min_amount"`
-	MaxAmount *float64 `json:"max_amount,omitempty"` // NULL means unlimited
-	Rate      *float64  `json:"rate,omitempty"`       // Percentage rate as string
-	FixedFee  *float64 `json:"fixed_fee,omitempty"`  // Fixed fee in smallest unit
+	MinAmount decimal.Decimal  `json:"min_amount"`
+	MaxAmount *decimal.Decimal `json:"max_amount,omitempty"` // NULL means unlimited
+	Rate      *decimal.Decimal `json:"rate,omitempty"`       // Percentage rate
+	FixedFee  *decimal.Decimal `json:"fixed_fee,omitempty"`  // Fixed fee in currency units
 }
 
 // TransactionFee represents an applied fee
 type TransactionFee struct {
-	ID                   int64     `json:"id" db:"id"`
-	ReceiptCode          string    `json:"receipt_code" db:"receipt_code"` // FK → receipt_lookup.code
-	FeeRuleID            *int64    `json:"fee_rule_id,omitempty" db:"fee_rule_id"`
-	FeeType              FeeType   `json:"fee_type" db:"fee_type"`
-	Amount               float64   `json:"amount" db:"amount"`     // In smallest unit
-	Currency             string    `json:"currency" db:"currency"` // Max 8 chars
-	CollectedByAccountID *int64    `json:"collected_by_account_id,omitempty" db:"collected_by_account_id"`
-	LedgerID             *int64    `json:"ledger_id,omitempty" db:"ledger_id"`
-	AgentExternalID      *string   `json:"agent_external_id,omitempty" db:"agent_external_id"`
-	CommissionRate       *string   `json:"commission_rate,omitempty" db:"commission_rate"` // NUMERIC(5,4) as string
-	CreatedAt            time.Time `json:"created_at" db:"created_at"`
+	ID                   int64           `json:"id" db:"id"`
+	ReceiptCode          string          `json:"receipt_code" db:"receipt_code"` // FK → receipt_lookup.code
+	FeeRuleID            *int64          `json:"fee_rule_id,omitempty" db:"fee_rule_id"`
+	FeeRuleVersionID     *int64          `json:"fee_rule_version_id,omitempty" db:"fee_rule_version_id"` // Rule version that priced this fee
+	FeeType              FeeType         `json:"fee_type" db:"fee_type"`
+	Amount               decimal.Decimal `json:"amount" db:"amount"`     // In currency units
+	Currency             string          `json:"currency" db:"currency"` // Max 8 chars
+	CollectedByAccountID *int64          `json:"collected_by_account_id,omitempty" db:"collected_by_account_id"`
+	LedgerID             *int64          `json:"ledger_id,omitempty" db:"ledger_id"`
+	AgentExternalID      *string         `json:"agent_external_id,omitempty" db:"agent_external_id"`
+	CommissionRate       *string         `json:"commission_rate,omitempty" db:"commission_rate"` // NUMERIC(5,4) as string
+	CreatedAt            time.Time       `json:"created_at" db:"created_at"`
 }
 
 // FeeRuleCreate represents data needed to create a new fee rule
@@ -84,8 +100,8 @@ type FeeRuleCreate struct {
 	FeeType           FeeType
 	CalculationMethod FeeCalculationMethod
 	FeeValue          string
-	MinFee            *float64
-	MaxFee            *float64
+	MinFee            *decimal.Decimal
+	MaxFee            *decimal.Decimal
 	Tiers             json.RawMessage
 	ValidFrom         time.Time
 	ValidTo           *time.Time
@@ -123,22 +139,23 @@ type FeeRuleFilter struct {
 // accounting-service/internal/domain/fee. go
 
 type FeeCalculation struct {
-	RuleID    *int64  `json:"rule_id,omitempty"`
+	RuleID        *int64 `json:"rule_id,omitempty"`
+	RuleVersionID *int64 `json:"rule_version_id,omitempty"`
 	FeeType   FeeType `json:"fee_type"`
 	
 	// Platform fee
-	Amount   float64 `json:"amount"`   // Platform fee in transaction currency
-	Currency string  `json:"currency"` // Transaction currency (USDT, BTC, etc.)
+	Amount   decimal.Decimal `json:"amount"`   // Platform fee in transaction currency
+	Currency string          `json:"currency"` // Transaction currency (USDT, BTC, etc.)
 	
 	// Network fee (converted to transaction currency)
-	NetworkFee  float64 `json:"network_fee"`  // Network fee (converted to transaction currency)
+	NetworkFee  decimal.Decimal `json:"network_fee"`  // Network fee (converted to transaction currency)
 	
 	// Network fee (original)
-	NetworkFeeOriginal         float64 `json:"network_fee_original"`          // ✅ Original amount (e.g., 0.69 TRX)
-	NetworkFeeOriginalCurrency string  `json:"network_fee_original_currency"` // ✅ Original currency (e.g., "TRX")
+	NetworkFeeOriginal         decimal.Decimal `json:"network_fee_original"`          // ✅ Original amount (e.g., 0.69 TRX)
+	NetworkFeeOriginalCurrency string          `json:"network_fee_original_currency"` // ✅ Original currency (e.g., "TRX")
 	
 	// Total
-	TotalFee float64 `json:"total_fee"` // ✅ Platform + Network (both in transaction currency)
+	TotalFee decimal.Decimal `json:"total_fee"` // ✅ Platform + Network (both in transaction currency)
 	
 	// Metadata
 	AppliedRate    *string `json:"applied_rate,omitempty"`
@@ -146,13 +163,13 @@ type FeeCalculation struct {
 }
 
 // GetTotalFee returns total fee (platform + network)
-func (fc *FeeCalculation) GetTotalFee() float64 {
+func (fc *FeeCalculation) GetTotalFee() decimal.Decimal {
 	return fc.  TotalFee
 }
 
 // HasNetworkFee checks if network fee is applicable
 func (fc *FeeCalculation) HasNetworkFee() bool {
-	return fc.NetworkFee > 0
+	return fc.NetworkFee.IsPositive()
 }
 
 // GetNetworkFeeDisplay returns human-readable network fee
@@ -162,34 +179,34 @@ func (fc *FeeCalculation) GetNetworkFeeDisplay() string {
 	}
 	
 	if fc.NetworkFeeOriginalCurrency != "" && fc.NetworkFeeOriginalCurrency != fc.Currency {
-		return fmt. Sprintf("%.8f %s (from %.8f %s)",
-			fc.NetworkFee,
+		return fmt.Sprintf("%s %s (from %s %s)",
+			fc.NetworkFee.StringFixed(8),
 			fc.Currency,
-			fc.NetworkFeeOriginal,
+			fc.NetworkFeeOriginal.StringFixed(8),
 			fc.NetworkFeeOriginalCurrency)
 	}
 	
-	return fmt.Sprintf("%.8f %s", fc.NetworkFee, fc.Currency)
+	return fmt.Sprintf("%s %s", fc.NetworkFee.StringFixed(8), fc.Currency)
 }
 
 // WithdrawalFeeBreakdown contains complete withdrawal fee breakdown
 type WithdrawalFeeBreakdown struct {
-	Currency           string  `json:"currency"`
-	Amount             float64 `json:"amount"`
-	PlatformFee        float64 `json:"platform_fee"`
-	NetworkFee         float64 `json:"network_fee"`
-	NetworkFeeCurrency string  `json:"network_fee_currency,omitempty"`
-	TotalFee           float64 `json:"total_fee"`
-	Breakdown          string  `json:"breakdown"` // Human-readable explanation
+	Currency           string          `json:"currency"`
+	Amount             decimal.Decimal `json:"amount"`
+	PlatformFee        decimal.Decimal `json:"platform_fee"`
+	NetworkFee         decimal.Decimal `json:"network_fee"`
+	NetworkFeeCurrency string          `json:"network_fee_currency,omitempty"`
+	TotalFee           decimal.Decimal `json:"total_fee"`
+	Breakdown          string          `json:"breakdown"` // Human-readable explanation
 }
 
 // NetworkFeeCalculation contains network fee details
 type NetworkFeeCalculation struct {
-	Amount      float64       `json:"amount"`
-	Currency    string        `json:"currency"`
-	EstimatedAt time. Time     `json:"estimated_at"`
-	ValidFor    time.Duration `json:"valid_for"`
-	Explanation string        `json:"explanation"`
+	Amount      decimal.Decimal `json:"amount"`
+	Currency    string          `json:"currency"`
+	EstimatedAt time.Time       `json:"estimated_at"`
+	ValidFor    time.Duration   `json:"valid_for"`
+	Explanation string          `json:"explanation"`
 }
 
 // IsValid checks if the fee rule has valid required fields
@@ -254,11 +271,11 @@ func (r *TransactionFeeRule) SetTiers(tiers []FeeTier) error {
 
 // Tariff structure (amount-based pricing)
 type Tariff struct {
-    MinAmount         float64  `json:"min_amount"`                    // Minimum amount (inclusive)
-    MaxAmount         *float64 `json:"max_amount,omitempty"`          // Maximum amount (inclusive), null = infinity
-    CalculationMethod string   `json:"calculation_method"`             // ✅ NEW: "percentage" or "fixed"
-    FeeBps            *float64 `json:"fee_bps,omitempty"`             // ✅ CHANGED: Optional - for percentage
-    FixedFee          *float64 `json:"fixed_fee,omitempty"`           // Optional - can be used alone or added to percentage
+    MinAmount         decimal.Decimal  `json:"min_amount"`                    // Minimum amount (inclusive)
+    MaxAmount         *decimal.Decimal `json:"max_amount,omitempty"`          // Maximum amount (inclusive), null = infinity
+    CalculationMethod string           `json:"calculation_method"`             // ✅ NEW: "percentage" or "fixed"
+    FeeBps            *decimal.Decimal `json:"fee_bps,omitempty"`             // ✅ CHANGED: Optional - for percentage
+    FixedFee          *decimal.Decimal `json:"fixed_fee,omitempty"`           // Optional - can be used alone or added to percentage
 }
 
 // Validation constants
@@ -282,7 +299,7 @@ func (r *TransactionFeeRule) GetTariffs() ([]Tariff, error) {
 }
 
 // ✅ NEW: FindApplicableTariff finds the tariff for a given amount
-func (r *TransactionFeeRule) FindApplicableTariff(amount float64) (*Tariff, error) {
+func (r *TransactionFeeRule) FindApplicableTariff(amount decimal.Decimal) (*Tariff, error) {
 	tariffs, err := r.GetTariffs()
 	if err != nil {
 		return nil, err
@@ -294,14 +311,14 @@ func (r *TransactionFeeRule) FindApplicableTariff(amount float64) (*Tariff, erro
 
 	// Find matching tariff based on amount
 	for _, tariff := range tariffs {
-		if amount >= tariff.MinAmount {
-			if tariff.MaxAmount == nil || amount <= *tariff. MaxAmount {
+		if amount.GreaterThanOrEqual(tariff.MinAmount) {
+			if tariff.MaxAmount == nil || amount.LessThanOrEqual(*tariff.MaxAmount) {
 				return &tariff, nil
 			}
 		}
 	}
 
-	return nil, fmt.Errorf("no tariff found for amount: %.2f", amount)
+	return nil, fmt.Errorf("no tariff found for amount: %s", amount)
 }
 
 
@@ -320,8 +337,8 @@ func DefaultTransactionFeeRules() []*TransactionFeeRule {
 			FeeType:           FeeTypePlatform,
 			CalculationMethod: FeeCalculationPercentage,
 			FeeValue:          "0.001",
-			MinFee:            float64Ptr(1.00),    // ✅ $1.00
-			MaxFee:            float64Ptr(500.00),  // ✅ $500.00
+			MinFee:            decimalPtr("1.00"),    // ✅ $1.00
+			MaxFee:            decimalPtr("500.00"),  // ✅ $500.00
 			ValidFrom:         now,
 			IsActive:          true,
 			Priority:          1,
@@ -336,7 +353,7 @@ func DefaultTransactionFeeRules() []*TransactionFeeRule {
 			FeeType:           FeeTypeNetwork,
 			CalculationMethod: FeeCalculationFixed,
 			FeeValue:          "0",
-			MinFee:            float64Ptr(0.005),  // ✅ 0.005 BTC (500,000 satoshis)
+			MinFee:            decimalPtr("0.005"),  // ✅ 0.005 BTC (500,000 satoshis)
 			ValidFrom:         now,
 			IsActive:          true,
 			Priority:          1,
@@ -353,7 +370,7 @@ func DefaultTransactionFeeRules() []*TransactionFeeRule {
 			FeeType:           FeeTypePlatform,
 			CalculationMethod: FeeCalculationFixed,
 			FeeValue:          "0",
-			MinFee:            float64Ptr(2.00),  // ✅ $2.00
+			MinFee:            decimalPtr("2.00"),  // ✅ $2.00
 			ValidFrom:         now,
 			IsActive:          true,
 			Priority:          1,
@@ -368,8 +385,8 @@ func DefaultTransactionFeeRules() []*TransactionFeeRule {
 			FeeType:           FeeTypeNetwork,
 			CalculationMethod: FeeCalculationPercentage,
 			FeeValue:          "0.0005",
-			MinFee:            float64Ptr(0.0005),  // ✅ 0.0005 BTC
-			MaxFee:            float64Ptr(0.005),   // ✅ 0. 005 BTC
+			MinFee:            decimalPtr("0.0005"),  // ✅ 0.0005 BTC
+			MaxFee:            decimalPtr("0.005"),   // ✅ 0. 005 BTC
 			ValidFrom:         now,
 			IsActive:          true,
 			Priority:          1,
@@ -387,8 +404,8 @@ func DefaultTransactionFeeRules() []*TransactionFeeRule {
 			FeeType:           FeeTypeConversion,
 			CalculationMethod: FeeCalculationPercentage,
 			FeeValue:          "0.003",
-			MinFee:            float64Ptr(0.50),   // ✅ $0.50
-			MaxFee:            float64Ptr(50.00),  // ✅ $50.00
+			MinFee:            decimalPtr("0.50"),   // ✅ $0.50
+			MaxFee:            decimalPtr("50.00"),  // ✅ $50.00
 			ValidFrom:         now,
 			IsActive:          true,
 			Priority:          1,
@@ -404,8 +421,8 @@ func DefaultTransactionFeeRules() []*TransactionFeeRule {
 			FeeType:           FeeTypeConversion,
 			CalculationMethod: FeeCalculationPercentage,
 			FeeValue:          "0.005",
-			MinFee:            float64Ptr(1.00),    // ✅ $1. 00
-			MaxFee:            float64Ptr(500.00),  // ✅ $500.00
+			MinFee:            decimalPtr("1.00"),    // ✅ $1. 00
+			MaxFee:            decimalPtr("500.00"),  // ✅ $500.00
 			ValidFrom:         now,
 			IsActive:          true,
 			Priority:          1,
@@ -421,8 +438,8 @@ func DefaultTransactionFeeRules() []*TransactionFeeRule {
 			FeeType:           FeeTypePlatform,
 			CalculationMethod: FeeCalculationPercentage,
 			FeeValue:          "0.002",
-			MinFee:            float64Ptr(0.50),    // ✅ $0. 50
-			MaxFee:            float64Ptr(100.00),  // ✅ $100.00
+			MinFee:            decimalPtr("0.50"),    // ✅ $0. 50
+			MaxFee:            decimalPtr("100.00"),  // ✅ $100.00
 			ValidFrom:         now,
 			IsActive:          true,
 			Priority:          1,
@@ -439,7 +456,7 @@ func DefaultTransactionFeeRules() []*TransactionFeeRule {
 			FeeType:           FeeTypePlatform,
 			CalculationMethod: FeeCalculationFixed,
 			FeeValue:          "0",
-			MinFee:            float64Ptr(0.50),  // ✅ $0. 50
+			MinFee:            decimalPtr("0.50"),  // ✅ $0. 50
 			ValidFrom:         now,
 			IsActive:          true,
 			Priority:          2,
@@ -455,8 +472,9 @@ func int64Ptr(v int64) *int64 {
 	return &v
 }
 
-func float64Ptr(v float64) *float64 {
-	return &v
+func decimalPtr(v string) *decimal.Decimal {
+	d := decimal.RequireFromString(v)
+	return &d
 }
 
 func strPtr(s string) *string {
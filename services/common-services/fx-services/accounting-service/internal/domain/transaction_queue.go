@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+)
+
+// QueuedTransactionStatus is the lifecycle of an async transaction in transaction_queue
+type QueuedTransactionStatus string
+
+const (
+	QueuedPending    QueuedTransactionStatus = "pending"    // Waiting for a worker (or a retry)
+	QueuedProcessing QueuedTransactionStatus = "processing" // Claimed; lease in LockedUntil
+	QueuedCompleted  QueuedTransactionStatus = "completed"  // Journal posted
+	QueuedFailed     QueuedTransactionStatus = "failed"     // Rejected by the ledger, not retried
+	QueuedDead       QueuedTransactionStatus = "dead"       // Dead-lettered after MaxAttempts retryable failures; holds back its OrderingKey
+	QueuedSkipped    QueuedTransactionStatus = "skipped"    // Dead letter given up by an admin
+)
+
+// DefaultQueueMaxAttempts is how many times a retryable failure is retried before dead-lettering
+const DefaultQueueMaxAttempts = 5
+
+// QueuedTransaction is an async ExecuteTransaction request persisted until it
+// is processed. Tasks with the same OrderingKey run one at a time in id order;
+// a dead-lettered task blocks the ones after it until it is re-driven or skipped.
+type QueuedTransaction struct {
+	ID             int64                   `json:"id" db:"id"`
+	ReceiptCode    string                  `json:"receipt_code" db:"receipt_code"`
+	IdempotencyKey string                  `json:"idempotency_key" db:"idempotency_key"` // Guards re-delivery
+	OrderingKey    string                  `json:"ordering_key" db:"ordering_key"`       // Paying account number
+	Request        *TransactionRequest     `json:"request" db:"payload"`
+	Status         QueuedTransactionStatus `json:"status" db:"status"`
+	Attempts       int                     `json:"attempts" db:"attempts"`
+	MaxAttempts    int                     `json:"max_attempts" db:"max_attempts"`
+	LastError      *string                 `json:"last_error,omitempty" db:"last_error"`
+	JournalID      *int64                  `json:"journal_id,omitempty" db:"journal_id"`
+	AvailableAt    time.Time               `json:"available_at" db:"available_at"`
+	LockedUntil    *time.Time              `json:"locked_until,omitempty" db:"locked_until"`
+	LockedBy       *string                 `json:"locked_by,omitempty" db:"locked_by"` // Worker holding the lease
+	CreatedAt      time.Time               `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time               `json:"updated_at" db:"updated_at"`
+	CompletedAt    *time.Time              `json:"completed_at,omitempty" db:"completed_at"`
+}
+
+// QueuedTransactionFilter selects queue entries for inspection
+type QueuedTransactionFilter struct {
+	Status      *QueuedTransactionStatus
+	ReceiptCode *string
+	Limit       int
+	Offset      int
+}
+
+// OrderingKey returns the account whose transactions must be applied in
+// submission order: the first debited (paying) account
+func (r *TransactionRequest) OrderingKey() string {
+	for _, entry := range r.Entries {
+		if entry.DrCr == DrCrDebit && !entry.IsFee {
+			return entry.AccountNumber
+		}
+	}
+	if len(r.Entries) > 0 {
+		return r.Entries[0].AccountNumber
+	}
+	return ""
+}
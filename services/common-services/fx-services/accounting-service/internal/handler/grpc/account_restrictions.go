@@ -0,0 +1,161 @@
+package hgrpc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"accounting-service/internal/domain"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ===============================
+// ACCOUNT RESTRICTIONS
+// ===============================
+
+func (h *AccountingHandler) PlaceAccountRestriction(
+	ctx context.Context,
+	req *accountingpb.PlaceAccountRestrictionRequest,
+) (*accountingpb.PlaceAccountRestrictionResponse, error) {
+	if req.AccountNumber == "" {
+		return nil, status.Error(codes.InvalidArgument, "account_number is required")
+	}
+	if req.CreatedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "created_by is required")
+	}
+
+	placeReq := &domain.PlaceAccountRestrictionRequest{
+		AccountNumber: req.AccountNumber,
+		Type:          domain.AccountRestrictionType(req.Type),
+		Reason:        req.Reason,
+		Reference:     req.Reference,
+		ExpiresAt:     convertOptionalTimestamp(req.ExpiresAt),
+		Actor:         req.CreatedBy,
+	}
+	if req.Amount != nil {
+		amount, err := parseAmount("amount", *req.Amount)
+		if err != nil {
+			return nil, err
+		}
+		placeReq.Amount = &amount
+	}
+
+	restriction, err := h.restrictionUC.PlaceRestriction(ctx, placeReq)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.PlaceAccountRestrictionResponse{
+		Restriction: convertAccountRestrictionToProto(restriction),
+	}, nil
+}
+
+func (h *AccountingHandler) ReleaseAccountRestriction(
+	ctx context.Context,
+	req *accountingpb.ReleaseAccountRestrictionRequest,
+) (*accountingpb.ReleaseAccountRestrictionResponse, error) {
+	if req.RestrictionId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "restriction_id is required")
+	}
+	if req.ReleasedBy == "" || req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "released_by and reason are required")
+	}
+
+	restriction, err := h.restrictionUC.ReleaseRestriction(ctx, req.RestrictionId, req.ReleasedBy, req.Reason)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.ReleaseAccountRestrictionResponse{
+		Restriction: convertAccountRestrictionToProto(restriction),
+	}, nil
+}
+
+func (h *AccountingHandler) ListAccountRestrictions(
+	ctx context.Context,
+	req *accountingpb.ListAccountRestrictionsRequest,
+) (*accountingpb.ListAccountRestrictionsResponse, error) {
+	filter := &domain.AccountRestrictionFilter{
+		AccountNumber: req.AccountNumber,
+		ActiveOnly:    req.ActiveOnly,
+		Limit:         int(req.Limit),
+		Offset:        int(req.Offset),
+	}
+	if req.Type != nil && *req.Type != "" {
+		restrictionType := domain.AccountRestrictionType(*req.Type)
+		filter.Type = &restrictionType
+	}
+
+	restrictions, total, err := h.restrictionUC.ListRestrictions(ctx, filter)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	result := make([]*accountingpb.AccountRestriction, len(restrictions))
+	for i, r := range restrictions {
+		result[i] = convertAccountRestrictionToProto(r)
+	}
+
+	return &accountingpb.ListAccountRestrictionsResponse{
+		Restrictions: result,
+		Total:        total,
+	}, nil
+}
+
+func (h *AccountingHandler) CloseAccount(
+	ctx context.Context,
+	req *accountingpb.CloseAccountRequest,
+) (*accountingpb.CloseAccountResponse, error) {
+	if req.AccountNumber == "" {
+		return nil, status.Error(codes.InvalidArgument, "account_number is required")
+	}
+	if req.ClosedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "closed_by is required")
+	}
+
+	closure, err := h.restrictionUC.CloseAccount(ctx, &domain.CloseAccountRequest{
+		AccountNumber:        req.AccountNumber,
+		SweepToAccountNumber: req.SweepToAccountNumber,
+		Reason:               req.Reason,
+		Reference:            req.Reference,
+		Actor:                req.ClosedBy,
+	})
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	log.Printf("[RESTRICTION] Account %s closed by %s", req.AccountNumber, req.ClosedBy)
+
+	return &accountingpb.CloseAccountResponse{
+		Closure: convertAccountRestrictionToProto(closure),
+	}, nil
+}
+
+// ===============================
+// CONVERSION HELPERS
+// ===============================
+
+func convertAccountRestrictionToProto(r *domain.AccountRestriction) *accountingpb.AccountRestriction {
+	return &accountingpb.AccountRestriction{
+		Id:                 r.ID,
+		AccountNumber:      r.AccountNumber,
+		Type:               string(r.Type),
+		Amount:             decimalPtrToString(r.Amount),
+		Reason:             r.Reason,
+		Reference:          r.Reference,
+		CreatedBy:          r.CreatedBy,
+		ExpiresAt:          convertOptionalTimeToProto(r.ExpiresAt),
+		CreatedAt:          timestamppb.New(r.CreatedAt),
+		Status:             r.Status(time.Now()),
+		ReleasedAt:         convertOptionalTimeToProto(r.ReleasedAt),
+		ReleasedBy:         r.ReleasedBy,
+		ReleaseReason:      r.ReleaseReason,
+		SweepAccountNumber: r.SweepAccountNumber,
+		SweptAmount:        decimalPtrToString(r.SweptAmount),
+		SweepReceiptCode:   r.SweepReceiptCode,
+	}
+}
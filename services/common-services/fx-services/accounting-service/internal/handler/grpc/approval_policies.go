@@ -0,0 +1,169 @@
+package hgrpc
+
+import (
+	"context"
+	"time"
+
+	"accounting-service/internal/domain"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ===============================
+// APPROVAL POLICIES
+// ===============================
+
+func (h *AccountingHandler) CreateApprovalPolicy(
+	ctx context.Context,
+	req *accountingpb.CreateApprovalPolicyRequest,
+) (*accountingpb.CreateApprovalPolicyResponse, error) {
+	if req.CreatedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "created_by is required")
+	}
+
+	policy, err := convertApprovalPolicyToDomain(req.Policy)
+	if err != nil {
+		return nil, err
+	}
+	policy.CreatedBy = req.CreatedBy
+
+	created, err := h.approvalUC.CreateApprovalPolicy(ctx, policy)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.CreateApprovalPolicyResponse{
+		Policy: convertApprovalPolicyToProto(created),
+	}, nil
+}
+
+func (h *AccountingHandler) UpdateApprovalPolicy(
+	ctx context.Context,
+	req *accountingpb.UpdateApprovalPolicyRequest,
+) (*accountingpb.UpdateApprovalPolicyResponse, error) {
+	if req.Policy == nil || req.Policy.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "policy.id is required")
+	}
+	if req.UpdatedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "updated_by is required")
+	}
+
+	policy, err := convertApprovalPolicyToDomain(req.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := h.approvalUC.UpdateApprovalPolicy(ctx, policy)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.UpdateApprovalPolicyResponse{
+		Policy: convertApprovalPolicyToProto(updated),
+	}, nil
+}
+
+func (h *AccountingHandler) ListApprovalPolicies(
+	ctx context.Context,
+	req *accountingpb.ListApprovalPoliciesRequest,
+) (*accountingpb.ListApprovalPoliciesResponse, error) {
+	filter := &domain.ApprovalPolicyFilter{
+		TransactionType: convertOptionalTransactionTypeToDomain(req.TransactionType),
+		ActiveOnly:      req.ActiveOnly,
+	}
+	if req.Currency != nil && *req.Currency != "" {
+		filter.Currency = req.Currency
+	}
+
+	policies, err := h.approvalUC.ListApprovalPolicies(ctx, filter)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	result := make([]*accountingpb.ApprovalPolicy, len(policies))
+	for i, p := range policies {
+		result[i] = convertApprovalPolicyToProto(p)
+	}
+
+	return &accountingpb.ListApprovalPoliciesResponse{
+		Policies: result,
+	}, nil
+}
+
+// ===============================
+// CONVERSION HELPERS
+// ===============================
+
+func convertApprovalPolicyToDomain(p *accountingpb.ApprovalPolicy) (*domain.ApprovalPolicy, error) {
+	if p == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+
+	policy := &domain.ApprovalPolicy{
+		ID:                p.Id,
+		Name:              p.Name,
+		TransactionType:   convertOptionalTransactionTypeToDomain(p.TransactionType),
+		MinAmount:         decimal.Zero,
+		RequiredApprovals: int(p.RequiredApprovals),
+		ApproverRoles:     p.ApproverRoles,
+		DistinctRoles:     p.DistinctRoles,
+		ExpiresAfter:      time.Duration(p.ExpiresAfterSeconds) * time.Second,
+		Priority:          int(p.Priority),
+		IsActive:          p.IsActive,
+	}
+	if p.Currency != nil && *p.Currency != "" {
+		policy.Currency = p.Currency
+	}
+
+	if p.MinAmount != "" {
+		minAmount, err := parseAmount("min_amount", p.MinAmount)
+		if err != nil {
+			return nil, err
+		}
+		policy.MinAmount = minAmount
+	}
+	if p.MaxAmount != nil && *p.MaxAmount != "" {
+		maxAmount, err := parsePositiveAmount("max_amount", *p.MaxAmount)
+		if err != nil {
+			return nil, err
+		}
+		policy.MaxAmount = &maxAmount
+	}
+
+	return policy, nil
+}
+
+func convertApprovalPolicyToProto(p *domain.ApprovalPolicy) *accountingpb.ApprovalPolicy {
+	if p == nil {
+		return nil
+	}
+
+	result := &accountingpb.ApprovalPolicy{
+		Id:                  p.ID,
+		Name:                p.Name,
+		Currency:            p.Currency,
+		MinAmount:           p.MinAmount.String(),
+		RequiredApprovals:   int32(p.RequiredApprovals),
+		ApproverRoles:       p.ApproverRoles,
+		DistinctRoles:       p.DistinctRoles,
+		ExpiresAfterSeconds: int64(p.ExpiresAfter / time.Second),
+		Priority:            int32(p.Priority),
+		IsActive:            p.IsActive,
+		CreatedBy:           p.CreatedBy,
+		CreatedAt:           timestamppb.New(p.CreatedAt),
+		UpdatedAt:           timestamppb.New(p.UpdatedAt),
+	}
+	if p.TransactionType != nil {
+		txType := convertTransactionTypeToProto(*p.TransactionType)
+		result.TransactionType = &txType
+	}
+	if p.MaxAmount != nil {
+		maxAmount := p.MaxAmount.String()
+		result.MaxAmount = &maxAmount
+	}
+	return result
+}
@@ -3,6 +3,7 @@ package hgrpc
 
 import (
     "context"
+    "fmt"
     "time"
 
     "accounting-service/internal/domain"
@@ -31,7 +32,7 @@ func (h *AccountingHandler) CreateTransactionApproval(
         RequestedBy:      req.RequestedBy,
         TransactionType:  convertTransactionTypeToDomain(req.TransactionType),
         AccountNumber:    req.AccountNumber,
-        Amount:          req.Amount,
+        Amount:          parseAmountOrZero(req.Amount),
         Currency:        req.Currency,
         Description:     ptrString(req.Description),
         ToAccountNumber: toStringPtr(req.ToAccountNumber),
@@ -49,7 +50,7 @@ func (h *AccountingHandler) CreateTransactionApproval(
 
     return &accountingpb.CreateTransactionApprovalResponse{
         Approval: convertApprovalToProto(approval),
-        Message:  "Transaction approval request created successfully.  Awaiting super admin approval.",
+        Message:  fmt.Sprintf("Transaction approval request created successfully. Awaiting %d approval(s).", approval.RequiredApprovals),
     }, nil
 }
 
@@ -105,16 +106,20 @@ func (h *AccountingHandler) ApproveTransaction(
     if req.ApprovedBy <= 0 {
         return nil, status.Error(codes.InvalidArgument, "approved_by is required")
     }
+    if req.ApproverRole == "" {
+        return nil, status.Error(codes.InvalidArgument, "approver_role is required")
+    }
     if ! req.Approved && req. Reason == nil {
         return nil, status. Error(codes.InvalidArgument, "rejection reason is required when rejecting")
     }
 
     // Convert to domain
     domainReq := &domain.ApproveApprovalRequest{
-        RequestID:  req.RequestId,
-        ApprovedBy: req.ApprovedBy,
-        Approved:    req.Approved,
-        Reason:     toStringPtr(req.Reason),
+        RequestID:    req.RequestId,
+        ApprovedBy:   req.ApprovedBy,
+        ApproverRole: req.ApproverRole,
+        Approved:     req.Approved,
+        Reason:       toStringPtr(req.Reason),
     }
 
     // Approve or reject
@@ -127,6 +132,9 @@ func (h *AccountingHandler) ApproveTransaction(
     message := "Transaction rejected"
     if req.Approved {
         message = "Transaction approved and queued for execution"
+        if approval.Status == domain.ApprovalStatusPending {
+            message = fmt.Sprintf("Approval recorded (%d of %d required)", countApprovingDecisions(approval), approval.RequiredApprovals)
+        }
         if receiptCode != "" {
             message = "Transaction approved and executed successfully"
         }
@@ -219,8 +227,8 @@ func validateApprovalRequest(req *accountingpb.CreateTransactionApprovalRequest)
         return status. Error(codes.InvalidArgument, "account_number is required")
     }
 
-    if req.Amount <= 0 {
-        return status.Error(codes.InvalidArgument, "amount must be positive")
+    if _, err := parsePositiveAmount("amount", req.Amount); err != nil {
+        return err
     }
 
     // if req.Currency == "" {
@@ -253,7 +261,7 @@ func convertApprovalToProto(approval *domain. TransactionApproval) *accountingpb
         RequestedBy:     approval.RequestedBy,
         TransactionType: convertTransactionTypeToProto(approval.TransactionType),
         AccountNumber:   approval.AccountNumber,
-        Amount:          approval.Amount,
+        Amount:          approval.Amount.String(),
         Currency:        approval.Currency,
         Status:          convertApprovalStatusToProto(approval.Status),
         CreatedAt:       timestamppb.New(approval.CreatedAt),
@@ -281,9 +289,41 @@ func convertApprovalToProto(approval *domain. TransactionApproval) *accountingpb
         proto.ReceiptCode = approval.ReceiptCode
     }
 
+    // Policy snapshot and decisions
+    proto.PolicyId = approval.PolicyID
+    proto.RequiredApprovals = int32(approval.RequiredApprovals)
+    proto.ApproverRoles = approval.ApproverRoles
+    proto.DistinctRoles = approval.DistinctRoles
+    proto.ExpiresAt = convertOptionalTimeToProto(approval.ExpiresAt)
+    for _, d := range approval.Decisions {
+        proto.Decisions = append(proto.Decisions, &accountingpb.ApprovalDecision{
+            ApproverId:   d.ApproverID,
+            ApproverRole: d.ApproverRole,
+            Approved:     d.Approved,
+            Reason:       d.Reason,
+            CreatedAt:    timestamppb.New(d.CreatedAt),
+        })
+    }
+
+    // Execution
+    proto.ExecutionAttempts = int32(approval.ExecutionAttempts)
+    proto.ErrorMessage = approval.ErrorMessage
+    proto.ExecutedAt = convertOptionalTimeToProto(approval.ExecutedAt)
+
     return proto
 }
 
+// countApprovingDecisions counts the approvals recorded so far
+func countApprovingDecisions(approval *domain.TransactionApproval) int {
+    n := 0
+    for _, d := range approval.Decisions {
+        if d.Approved {
+            n++
+        }
+    }
+    return n
+}
+
 // convertApprovalStatusToProto converts domain status to protobuf
 func convertApprovalStatusToProto(status domain.ApprovalStatus) accountingpb.ApprovalStatus {
     switch status {
@@ -297,6 +337,8 @@ func convertApprovalStatusToProto(status domain.ApprovalStatus) accountingpb.App
         return accountingpb.ApprovalStatus_APPROVAL_STATUS_EXECUTED
     case domain.ApprovalStatusFailed:
         return accountingpb.ApprovalStatus_APPROVAL_STATUS_FAILED
+    case domain.ApprovalStatusExpired:
+        return accountingpb.ApprovalStatus_APPROVAL_STATUS_EXPIRED
     default:
         return accountingpb.ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED
     }
@@ -315,6 +357,8 @@ func convertApprovalStatusToDomain(status accountingpb.ApprovalStatus) domain.Ap
         return domain.ApprovalStatusExecuted
     case accountingpb.ApprovalStatus_APPROVAL_STATUS_FAILED:
         return domain.ApprovalStatusFailed
+    case accountingpb.ApprovalStatus_APPROVAL_STATUS_EXPIRED:
+        return domain.ApprovalStatusExpired
     default:
         return domain. ApprovalStatusPending
     }
@@ -0,0 +1,161 @@
+package hgrpc
+
+import (
+	"context"
+	"time"
+
+	"accounting-service/internal/domain"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ===============================
+// COMMISSION PAYOUTS
+// ===============================
+
+func (h *AccountingHandler) CreateCommissionPayoutBatch(
+	ctx context.Context,
+	req *accountingpb.CreateCommissionPayoutBatchRequest,
+) (*accountingpb.CreateCommissionPayoutBatchResponse, error) {
+	if req.CreatedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "created_by is required")
+	}
+
+	minAmount := decimal.Zero
+	if req.MinPayoutAmount != nil && *req.MinPayoutAmount != "" {
+		amount, err := parseAmount("min_payout_amount", *req.MinPayoutAmount)
+		if err != nil {
+			return nil, err
+		}
+		minAmount = amount
+	}
+
+	periodTo := time.Now()
+	if req.PeriodTo != nil {
+		periodTo = req.PeriodTo.AsTime()
+	}
+
+	batchReq := &domain.CommissionPayoutBatchRequest{
+		AgentExternalID: req.AgentExternalId,
+		Currency:        req.Currency,
+		PeriodTo:        periodTo,
+		MinPayoutAmount: minAmount,
+		DryRun:          req.DryRun,
+		CreatedBy:       req.CreatedBy,
+	}
+	if req.PeriodFrom != nil {
+		periodFrom := req.PeriodFrom.AsTime()
+		batchReq.PeriodFrom = &periodFrom
+	}
+
+	result, err := h.payoutUC.CreateCommissionPayoutBatch(ctx, batchReq)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.CreateCommissionPayoutBatchResponse{
+		BatchId:     result.BatchID,
+		DryRun:      result.DryRun,
+		Payouts:     convertCommissionPayoutsToProto(result.Payouts),
+		Skipped:     convertCommissionPayoutsToProto(result.Skipped),
+		PaidCount:   int32(result.PaidCount),
+		FailedCount: int32(result.FailedCount),
+	}, nil
+}
+
+func (h *AccountingHandler) ListCommissionPayouts(
+	ctx context.Context,
+	req *accountingpb.ListCommissionPayoutsRequest,
+) (*accountingpb.ListCommissionPayoutsResponse, error) {
+	filter := &domain.CommissionPayoutFilter{
+		AgentExternalID: req.AgentExternalId,
+		BatchID:         req.BatchId,
+		Currency:        req.Currency,
+		Limit:           int(req.Limit),
+		Offset:          int(req.Offset),
+	}
+	if req.Status != nil && *req.Status != accountingpb.CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_UNSPECIFIED {
+		payoutStatus := convertCommissionPayoutStatusToDomain(*req.Status)
+		filter.Status = &payoutStatus
+	}
+
+	payouts, total, err := h.payoutUC.ListCommissionPayouts(ctx, filter)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.ListCommissionPayoutsResponse{
+		Payouts: convertCommissionPayoutsToProto(payouts),
+		Total:   total,
+	}, nil
+}
+
+// ===============================
+// CONVERSION HELPERS
+// ===============================
+
+func convertCommissionPayoutToProto(p *domain.CommissionPayout) *accountingpb.CommissionPayout {
+	if p == nil {
+		return nil
+	}
+
+	payout := &accountingpb.CommissionPayout{
+		Id:              p.ID,
+		BatchId:         p.BatchID,
+		AgentExternalId: p.AgentExternalID,
+		Currency:        p.Currency,
+		Amount:          p.Amount.String(),
+		CommissionCount: int32(p.CommissionCount),
+		PeriodTo:        timestamppb.New(p.PeriodTo),
+		Status:          convertCommissionPayoutStatusToProto(p.Status),
+		ReceiptCode:     p.ReceiptCode,
+		ErrorMessage:    p.ErrorMessage,
+		CreatedBy:       p.CreatedBy,
+		PeriodFrom:      convertOptionalTimeToProto(p.PeriodFrom),
+		PaidAt:          convertOptionalTimeToProto(p.PaidAt),
+	}
+
+	if !p.CreatedAt.IsZero() {
+		payout.CreatedAt = timestamppb.New(p.CreatedAt)
+	}
+
+	return payout
+}
+
+func convertCommissionPayoutsToProto(payouts []*domain.CommissionPayout) []*accountingpb.CommissionPayout {
+	result := make([]*accountingpb.CommissionPayout, len(payouts))
+	for i, payout := range payouts {
+		result[i] = convertCommissionPayoutToProto(payout)
+	}
+	return result
+}
+
+func convertCommissionPayoutStatusToProto(s domain.CommissionPayoutStatus) accountingpb.CommissionPayoutStatus {
+	switch s {
+	case domain.CommissionPayoutPending:
+		return accountingpb.CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_PENDING
+	case domain.CommissionPayoutPaid:
+		return accountingpb.CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_PAID
+	case domain.CommissionPayoutFailed:
+		return accountingpb.CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_FAILED
+	default:
+		return accountingpb.CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_UNSPECIFIED
+	}
+}
+
+func convertCommissionPayoutStatusToDomain(s accountingpb.CommissionPayoutStatus) domain.CommissionPayoutStatus {
+	switch s {
+	case accountingpb.CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_PENDING:
+		return domain.CommissionPayoutPending
+	case accountingpb.CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_PAID:
+		return domain.CommissionPayoutPaid
+	case accountingpb.CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_FAILED:
+		return domain.CommissionPayoutFailed
+	default:
+		return ""
+	}
+}
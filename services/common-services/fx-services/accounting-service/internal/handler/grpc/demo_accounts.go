@@ -0,0 +1,104 @@
+package hgrpc
+
+import (
+	"context"
+
+	"accounting-service/internal/domain"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ===============================
+// DEMO ACCOUNTS
+// ===============================
+
+func (h *AccountingHandler) TopUpDemoAccount(
+	ctx context.Context,
+	req *accountingpb.TopUpDemoAccountRequest,
+) (*accountingpb.TopUpDemoAccountResponse, error) {
+	refillReq, err := demoRefillRequest(req.OwnerType, req.OwnerId, req.Currency, req.RequestedBy)
+	if err != nil {
+		return nil, err
+	}
+	if req.Amount != nil {
+		amount, err := parseAmount("amount", *req.Amount)
+		if err != nil {
+			return nil, err
+		}
+		refillReq.Amount = &amount
+	}
+
+	refill, err := h.demoUC.TopUp(ctx, refillReq)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.TopUpDemoAccountResponse{
+		Refill: convertDemoRefillToProto(refill),
+	}, nil
+}
+
+func (h *AccountingHandler) ResetDemoAccount(
+	ctx context.Context,
+	req *accountingpb.ResetDemoAccountRequest,
+) (*accountingpb.ResetDemoAccountResponse, error) {
+	refillReq, err := demoRefillRequest(req.OwnerType, req.OwnerId, req.Currency, req.RequestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	refill, err := h.demoUC.Reset(ctx, refillReq)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.ResetDemoAccountResponse{
+		Refill: convertDemoRefillToProto(refill),
+	}, nil
+}
+
+func demoRefillRequest(
+	ownerType accountingpb.OwnerType,
+	ownerID, currency, requestedBy string,
+) (*domain.DemoRefillRequest, error) {
+	if ownerID == "" || currency == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner_id and currency are required")
+	}
+	if requestedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "requested_by is required")
+	}
+
+	refillReq := &domain.DemoRefillRequest{
+		OwnerType:   domain.OwnerTypeUser,
+		OwnerID:     ownerID,
+		Currency:    currency,
+		RequestedBy: requestedBy,
+	}
+	if ownerType != accountingpb.OwnerType_OWNER_TYPE_UNSPECIFIED {
+		refillReq.OwnerType = convertOwnerTypeToDomain(ownerType)
+	}
+	return refillReq, nil
+}
+
+// ===============================
+// CONVERSION HELPERS
+// ===============================
+
+func convertDemoRefillToProto(r *domain.DemoRefill) *accountingpb.DemoRefill {
+	return &accountingpb.DemoRefill{
+		Id:              r.ID,
+		AccountNumber:   r.AccountNumber,
+		Currency:        r.Currency,
+		Action:          string(r.Action),
+		Amount:          r.Amount.String(),
+		BalanceBefore:   r.BalanceBefore.String(),
+		BalanceAfter:    r.BalanceAfter.String(),
+		ReceiptCode:     r.ReceiptCode,
+		RequestedBy:     r.RequestedBy,
+		CreatedAt:       timestamppb.New(r.CreatedAt),
+		NextAvailableAt: timestamppb.New(r.NextAvailableAt),
+	}
+}
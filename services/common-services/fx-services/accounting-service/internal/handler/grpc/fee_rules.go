@@ -0,0 +1,204 @@
+package hgrpc
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"accounting-service/internal/domain"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ===============================
+// FEE RULES
+// ===============================
+
+func (h *AccountingHandler) SimulateFeeRules(
+	ctx context.Context,
+	req *accountingpb.SimulateFeeRulesRequest,
+) (*accountingpb.SimulateFeeRulesResponse, error) {
+	if req.From == nil || req.To == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+
+	simReq := &domain.FeeRuleSimulationRequest{
+		From:            req.From.AsTime(),
+		To:              req.To.AsTime(),
+		RemoveRuleIDs:   req.RemoveRuleIds,
+		MaxTransactions: int(req.MaxTransactions),
+	}
+	for i, pbRule := range req.Rules {
+		rule, err := convertFeeRuleToDomain(pbRule)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "rules[%d]: %v", i, status.Convert(err).Message())
+		}
+		simReq.Rules = append(simReq.Rules, rule)
+	}
+	for _, t := range req.TransactionTypes {
+		if t == accountingpb.TransactionType_TRANSACTION_TYPE_UNSPECIFIED {
+			continue
+		}
+		simReq.TransactionTypes = append(simReq.TransactionTypes, convertTransactionTypeToDomain(t))
+	}
+
+	result, err := h.feeUC.SimulateFeeRules(ctx, simReq)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	resp := &accountingpb.SimulateFeeRulesResponse{
+		From:             timestamppb.New(result.From),
+		To:               timestamppb.New(result.To),
+		TransactionCount: int32(result.TransactionCount),
+		Truncated:        result.Truncated,
+		Groups:           make([]*accountingpb.FeeSimulationGroup, len(result.Groups)),
+	}
+	for i, g := range result.Groups {
+		resp.Groups[i] = &accountingpb.FeeSimulationGroup{
+			TransactionType:  convertTransactionTypeToProto(g.TransactionType),
+			Currency:         g.Currency,
+			TransactionCount: int32(g.TransactionCount),
+			ChangedCount:     int32(g.ChangedCount),
+			FailedCount:      int32(g.FailedCount),
+			ChargedRevenue:   g.ChargedRevenue.String(),
+			CurrentRevenue:   g.CurrentRevenue.String(),
+			ProposedRevenue:  g.ProposedRevenue.String(),
+			Delta:            g.Delta.String(),
+		}
+	}
+
+	return resp, nil
+}
+
+func (h *AccountingHandler) ListFeeRuleVersions(
+	ctx context.Context,
+	req *accountingpb.ListFeeRuleVersionsRequest,
+) (*accountingpb.ListFeeRuleVersionsResponse, error) {
+	if req.RuleId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "rule_id is required")
+	}
+
+	versions, err := h.feeRuleUC.ListVersions(ctx, req.RuleId)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	resp := &accountingpb.ListFeeRuleVersionsResponse{
+		Versions: make([]*accountingpb.FeeRuleVersion, len(versions)),
+	}
+	for i, v := range versions {
+		resp.Versions[i] = &accountingpb.FeeRuleVersion{
+			Id:        v.ID,
+			RuleId:    v.RuleID,
+			Version:   int32(v.Version),
+			Rule:      convertFeeRuleToProto(v.Rule),
+			CreatedAt: timestamppb.New(v.CreatedAt),
+		}
+	}
+
+	return resp, nil
+}
+
+// ===============================
+// CONVERSION HELPERS
+// ===============================
+
+// convertFeeRuleToDomain converts a proposed rule. Proposed rules are active
+// unless valid_to is set, matching how stored rules are selected.
+func convertFeeRuleToDomain(r *accountingpb.FeeRule) (*domain.TransactionFeeRule, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "rule is required")
+	}
+	if r.TransactionType == accountingpb.TransactionType_TRANSACTION_TYPE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "transaction_type is required")
+	}
+	if _, err := parseAmount("fee_value", r.FeeValue); err != nil {
+		return nil, err
+	}
+
+	rule := &domain.TransactionFeeRule{
+		ID:                r.Id,
+		RuleName:          r.RuleName,
+		TransactionType:   convertTransactionTypeToDomain(r.TransactionType),
+		SourceCurrency:    r.SourceCurrency,
+		TargetCurrency:    r.TargetCurrency,
+		AccountType:       convertOptionalAccountTypeToDomain(r.AccountType),
+		OwnerType:         convertOptionalOwnerTypeToDomain(r.OwnerType),
+		FeeType:           convertFeeTypeToDomain(r.FeeType),
+		CalculationMethod: domain.FeeCalculationMethod(r.CalculationMethod),
+		FeeValue:          r.FeeValue,
+		Tariffs:           r.Tariffs,
+		ValidFrom:         time.Now(),
+		ValidTo:           convertOptionalTimestamp(r.ValidTo),
+		IsActive:          r.IsActive || r.Id == 0,
+		Priority:          int(r.Priority),
+	}
+	if rule.RuleName == "" {
+		rule.RuleName = "proposed"
+	}
+	if r.ValidFrom != nil {
+		rule.ValidFrom = r.ValidFrom.AsTime()
+	}
+	if r.MinFee != nil {
+		minFee, err := parseAmount("min_fee", *r.MinFee)
+		if err != nil {
+			return nil, err
+		}
+		rule.MinFee = &minFee
+	}
+	if r.MaxFee != nil {
+		maxFee, err := parseAmount("max_fee", *r.MaxFee)
+		if err != nil {
+			return nil, err
+		}
+		rule.MaxFee = &maxFee
+	}
+	if r.Tiers != nil {
+		if !json.Valid([]byte(*r.Tiers)) {
+			return nil, status.Error(codes.InvalidArgument, "tiers must be valid JSON")
+		}
+		rule.Tiers = json.RawMessage(*r.Tiers)
+	}
+
+	return rule, nil
+}
+
+func convertFeeRuleToProto(r *domain.TransactionFeeRule) *accountingpb.FeeRule {
+	if r == nil {
+		return nil
+	}
+
+	pb := &accountingpb.FeeRule{
+		Id:                r.ID,
+		RuleName:          r.RuleName,
+		TransactionType:   convertTransactionTypeToProto(r.TransactionType),
+		SourceCurrency:    r.SourceCurrency,
+		TargetCurrency:    r.TargetCurrency,
+		AccountType:       convertOptionalAccountTypeToProto(r.AccountType),
+		FeeType:           convertFeeTypeToProto(r.FeeType),
+		CalculationMethod: string(r.CalculationMethod),
+		FeeValue:          r.FeeValue,
+		MinFee:            decimalPtrToString(r.MinFee),
+		MaxFee:            decimalPtrToString(r.MaxFee),
+		Tariffs:           r.Tariffs,
+		ValidFrom:         timestamppb.New(r.ValidFrom),
+		ValidTo:           convertOptionalTimeToProto(r.ValidTo),
+		IsActive:          r.IsActive,
+		Priority:          int32(r.Priority),
+		Version:           int32(r.Version),
+		VersionId:         r.VersionID,
+	}
+	if r.OwnerType != nil {
+		ownerType := convertOwnerTypeToProto(*r.OwnerType)
+		pb.OwnerType = &ownerType
+	}
+	if len(r.Tiers) > 0 {
+		tiers := string(r.Tiers)
+		pb.Tiers = &tiers
+	}
+	return pb
+}
@@ -186,6 +186,49 @@ func (h *AccountingHandler) GetAccountStatement(
 	}, nil
 }
 
+// ExportAccountStatement streams a CSV or PDF statement in chunks of
+// statementChunkSize; the first chunk carries the content type and filename
+func (h *AccountingHandler) ExportAccountStatement(
+	req *accountingpb.ExportAccountStatementRequest,
+	stream accountingpb.AccountingService_ExportAccountStatementServer,
+) error {
+	if req.AccountNumber == "" {
+		return status.Error(codes.InvalidArgument, "account_number is required")
+	}
+	if req.From == nil || req.To == nil {
+		return status.Error(codes.InvalidArgument, "from and to are required")
+	}
+
+	format := domain.StatementFormatCSV
+	if req.Format == accountingpb.StatementFormat_STATEMENT_FORMAT_PDF {
+		format = domain.StatementFormatPDF
+	}
+
+	domainReq := &domain.ExportStatementRequest{
+		AccountNumber: req.AccountNumber,
+		AccountType:   convertAccountTypeToDomain(req.AccountType),
+		From:          req.From.AsTime(),
+		To:            req.To.AsTime(),
+		Format:        format,
+	}
+
+	out := &statementChunkWriter{
+		stream:      stream,
+		contentType: format.ContentType(),
+		filename:    domainReq.Filename(),
+	}
+
+	if err := h.statementUC.ExportAccountStatement(stream.Context(), domainReq, out); err != nil {
+		return handleUsecaseError(err)
+	}
+
+	if err := out.Flush(); err != nil {
+		return status.Errorf(codes.Unavailable, "failed to send statement: %v", err)
+	}
+
+	return nil
+}
+
 func (h *AccountingHandler) GetOwnerStatement(
 	ctx context.Context,
 	req *accountingpb.GetOwnerStatementRequest,
@@ -277,6 +320,51 @@ func (h *AccountingHandler) GetSystemHoldings(
 	}
 
 	return &accountingpb.GetSystemHoldingsResponse{
-		Holdings: holdings,
+		Holdings: convertDecimalMapToProto(holdings),
 	}, nil
-}
\ No newline at end of file
+}
+
+// statementChunkSize bounds each streamed message
+const statementChunkSize = 32 * 1024
+
+// statementChunkWriter buffers rendered output and sends it as stream chunks
+type statementChunkWriter struct {
+	stream      accountingpb.AccountingService_ExportAccountStatementServer
+	buf         []byte
+	contentType string
+	filename    string
+	sent        bool
+}
+
+func (w *statementChunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= statementChunkSize {
+		if err := w.send(w.buf[:statementChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[statementChunkSize:]
+	}
+	return len(p), nil
+}
+
+// Flush sends whatever is buffered (and at least one chunk for empty output)
+func (w *statementChunkWriter) Flush() error {
+	if len(w.buf) == 0 && w.sent {
+		return nil
+	}
+	err := w.send(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *statementChunkWriter) send(data []byte) error {
+	chunk := &accountingpb.ExportAccountStatementChunk{
+		Data: append([]byte(nil), data...),
+	}
+	if !w.sent {
+		chunk.ContentType = &w.contentType
+		chunk.Filename = &w.filename
+		w.sent = true
+	}
+	return w.stream.Send(chunk)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 
 	"accounting-service/internal/domain"
 	//"accounting-service/internal/usecase"
@@ -12,6 +13,7 @@ import (
 	//xerrors "x/shared/utils/errors"
 
 	//"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -77,9 +79,9 @@ func (h *AccountingHandler) ExecuteTransactionSync(
 		ReceiptCode:      result.ReceiptCode,
 		TransactionId:    result.TransactionID,
 		Status:           convertTransactionStatusToProto(result.Status),
-		Amount:           result.Amount,
+		Amount:           result.Amount.String(),
 		Currency:         result.Currency,
-		Fee:              result.Fee,
+		Fee:              result.Fee.String(),
 		ProcessingTimeMs: result.ProcessingTime.Milliseconds(),
 		CreatedAt:        timestamppb.New(result.CreatedAt),
 	}, nil
@@ -144,10 +146,15 @@ func (h *AccountingHandler) CalculateFee(
 		ownerType = domain.OwnerTypeUser
 	}
 
+	amount, err := parseAmount("amount", req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
 	calculation, err := h.feeUC.CalculateFee(
 		ctx,
 		txType,
-		req.Amount,
+		amount,
 		ptrString(sourceCurrency),
 		ptrString(targetCurrency),
 		ptrAccountType(accountType),
@@ -198,7 +205,7 @@ func (h *AccountingHandler) GetAgentCommissionSummary(
 	}
 
 	return &accountingpb.GetAgentCommissionSummaryResponse{
-		Commissions: summary,
+		Commissions: convertDecimalMapToProto(summary),
 	}, nil
 }
 
@@ -211,14 +218,15 @@ func (h *AccountingHandler) Credit(
 	req *accountingpb.CreditRequest,
 ) (*accountingpb.CreditResponse, error) {
 	// Validate
-	if err := validateBasicTransaction(req. AccountNumber, req.Amount); err != nil {
+	amount, err := validateBasicTransaction(req. AccountNumber, req.Amount)
+	if err != nil {
 		return nil, err
 	}
 
 	// Convert to domain
 	domainReq := &domain.CreditRequest{
 		AccountNumber:        req.AccountNumber,
-		Amount:              req.Amount,
+		Amount:              amount,
 		AccountType:         convertAccountTypeToDomain(req. AccountType),
 		Description:         req.Description,
 		IdempotencyKey:      req.IdempotencyKey,
@@ -227,6 +235,7 @@ func (h *AccountingHandler) Credit(
 		CreatedByType:       convertOwnerTypeToDomain(req.CreatedByType),
 		TransactionType:     getTransactionType(req.TransactionType, domain.TransactionTypeTransfer),
 		ToAddress: req.ToAddress,
+		PostedAt:            convertOptionalTimestamp(req.PostedAt),
 	}
 
 	// Execute
@@ -242,9 +251,9 @@ func (h *AccountingHandler) Credit(
 	return &accountingpb.CreditResponse{
 		JournalId:    aggregate.Journal.ID,
 		ReceiptCode:  receiptCode,
-		BalanceAfter: balanceAfter,
+		BalanceAfter: balanceAfter.String(),
 		CreatedAt:    timestamppb.New(aggregate.Journal.CreatedAt),
-		PayableAmount: aggregate.PayableAmount,
+		PayableAmount: aggregate.PayableAmount.String(),
 	}, nil
 }
 
@@ -257,14 +266,15 @@ func (h *AccountingHandler) Debit(
 	req *accountingpb.DebitRequest,
 ) (*accountingpb.DebitResponse, error) {
 	// Validate
-	if err := validateBasicTransaction(req.AccountNumber, req.Amount); err != nil {
+	amount, err := validateBasicTransaction(req.AccountNumber, req.Amount)
+	if err != nil {
 		return nil, err
 	}
 
 	// Convert to domain
 	domainReq := &domain.DebitRequest{
 		AccountNumber:       req.AccountNumber,
-		Amount:              req. Amount,
+		Amount:              amount,
 		AccountType:         convertAccountTypeToDomain(req.AccountType),
 		Description:         req.Description,
 		IdempotencyKey:      req. IdempotencyKey,
@@ -273,6 +283,7 @@ func (h *AccountingHandler) Debit(
 		CreatedByType:       convertOwnerTypeToDomain(req.CreatedByType),
 		TransactionType:     getTransactionType(req.TransactionType, domain. TransactionTypeTransfer),
 		ToAddress: req.ToAddress,
+		PostedAt:            convertOptionalTimestamp(req.PostedAt),
 	}
 
 	// Execute
@@ -288,9 +299,9 @@ func (h *AccountingHandler) Debit(
 	return &accountingpb.DebitResponse{
 		JournalId:    aggregate.Journal.ID,
 		ReceiptCode:   receiptCode,
-		BalanceAfter: balanceAfter,
+		BalanceAfter: balanceAfter.String(),
 		CreatedAt:     timestamppb.New(aggregate.Journal.CreatedAt),
-		PayableAmount: aggregate.PayableAmount,
+		PayableAmount: aggregate.PayableAmount.String(),
 	}, nil
 }
 
@@ -303,7 +314,8 @@ func (h *AccountingHandler) Transfer(
 	req *accountingpb. TransferRequest,
 ) (*accountingpb.TransferResponse, error) {
 	// Validate
-	if err := validateTransfer(req.FromAccountNumber, req.ToAccountNumber, req.Amount); err != nil {
+	amount, err := validateTransfer(req.FromAccountNumber, req.ToAccountNumber, req.Amount)
+	if err != nil {
 		return nil, err
 	}
 
@@ -311,7 +323,7 @@ func (h *AccountingHandler) Transfer(
 	domainReq := &domain.TransferRequest{
 		FromAccountNumber:    req.FromAccountNumber,
 		ToAccountNumber:     req. ToAccountNumber,
-		Amount:               req.Amount,
+		Amount:               amount,
 		AccountType:         convertAccountTypeToDomain(req.AccountType),
 		Description:         req.Description,
 		IdempotencyKey:       req.IdempotencyKey,
@@ -336,10 +348,10 @@ func (h *AccountingHandler) Transfer(
 	return &accountingpb.TransferResponse{
 		JournalId:       aggregate.Journal.ID,
 		ReceiptCode:     receiptCode,
-		FeeAmount:        feeAmount,
-		AgentCommission: agentCommission,
+		FeeAmount:        feeAmount.String(),
+		AgentCommission: agentCommission.String(),
 		CreatedAt:       timestamppb.New(aggregate.Journal.CreatedAt),
-		PayableAmount: aggregate.PayableAmount,
+		PayableAmount: aggregate.PayableAmount.String(),
 	}, nil
 }
 
@@ -351,8 +363,15 @@ func (h *AccountingHandler) ConvertAndTransfer(
 	ctx context.Context,
 	req *accountingpb.ConversionRequest,
 ) (*accountingpb.ConversionResponse, error) {
-	// Validate
-	if err := validateTransfer(req.FromAccountNumber, req.ToAccountNumber, req. Amount); err != nil {
+	// Validate (with a quote the amount may be omitted)
+	amount := decimal.Zero
+	var err error
+	if req.QuoteId != nil && req.Amount == "" {
+		err = validateTransferAccounts(req.FromAccountNumber, req.ToAccountNumber)
+	} else {
+		amount, err = validateTransfer(req.FromAccountNumber, req.ToAccountNumber, req. Amount)
+	}
+	if err != nil {
 		return nil, err
 	}
 
@@ -360,7 +379,7 @@ func (h *AccountingHandler) ConvertAndTransfer(
 	domainReq := &domain.ConversionRequest{
 		FromAccountNumber:   req.FromAccountNumber,
 		ToAccountNumber:     req.ToAccountNumber,
-		Amount:              req.Amount,
+		Amount:              amount,
 		AccountType:         convertAccountTypeToDomain(req.AccountType),
 		IdempotencyKey:      req. IdempotencyKey,
 		ExternalRef:         req.ExternalRef,
@@ -368,6 +387,7 @@ func (h *AccountingHandler) ConvertAndTransfer(
 		CreatedByType:       convertOwnerTypeToDomain(req.CreatedByType),
 		AgentExternalID:     req. AgentExternalId,
 		ToAddress: req.ToAddress,
+		QuoteID:             req.QuoteId,
 	}
 
 	// Execute
@@ -385,13 +405,55 @@ func (h *AccountingHandler) ConvertAndTransfer(
 		ReceiptCode:     receiptCode,
 		SourceCurrency:  conversionData.SourceCurrency,
 		DestCurrency:    conversionData.DestCurrency,
-		SourceAmount:    conversionData.SourceAmount,
-		ConvertedAmount: conversionData.ConvertedAmount,
+		SourceAmount:    conversionData.SourceAmount.String(),
+		ConvertedAmount: conversionData.ConvertedAmount.String(),
 		FxRate:          conversionData.FxRate,
 		FxRateId:        conversionData.FxRateID,
-		FeeAmount:       conversionData.FeeAmount,
+		FeeAmount:       conversionData.FeeAmount.String(),
 		CreatedAt:       timestamppb.New(aggregate.Journal.CreatedAt),
-		PayableAmount: aggregate.PayableAmount,
+		PayableAmount: aggregate.PayableAmount.String(),
+	}, nil
+}
+
+// ===============================
+// FX QUOTE
+// ===============================
+
+func (h *AccountingHandler) CreateFXQuote(
+	ctx context.Context,
+	req *accountingpb.CreateFXQuoteRequest,
+) (*accountingpb.CreateFXQuoteResponse, error) {
+	amount, err := validateTransfer(req.FromAccountNumber, req.ToAccountNumber, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if req.CreatedByExternalId == "" {
+		return nil, status.Error(codes.InvalidArgument, "created_by_external_id is required")
+	}
+
+	domainReq := &domain.CreateFXQuoteRequest{
+		FromAccountNumber:   req.FromAccountNumber,
+		ToAccountNumber:     req.ToAccountNumber,
+		Amount:              amount,
+		AccountType:         convertAccountTypeToDomain(req.AccountType),
+		CreatedByExternalID: req.CreatedByExternalId,
+		CreatedByType:       convertOwnerTypeToDomain(req.CreatedByType),
+	}
+	if req.ExpiresInSeconds != nil {
+		ttl := time.Duration(*req.ExpiresInSeconds) * time.Second
+		if ttl <= 0 || ttl > domain.MaxFXQuoteTTL {
+			return nil, status.Errorf(codes.InvalidArgument, "expires_in_seconds must be between 1 and %d", int64(domain.MaxFXQuoteTTL.Seconds()))
+		}
+		domainReq.TTL = ttl
+	}
+
+	quote, err := h.txUC.CreateFXQuote(ctx, domainReq)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.CreateFXQuoteResponse{
+		Quote: convertFXQuoteToProto(quote),
 	}, nil
 }
 
@@ -404,12 +466,13 @@ func (h *AccountingHandler) ProcessTradeWin(
 	req *accountingpb.TradeRequest,
 ) (*accountingpb.TradeResponse, error) {
 	// Validate
-	if err := validateTrade(req.AccountNumber, req.Amount, req.TradeId); err != nil {
+	amount, err := validateTrade(req.AccountNumber, req.Amount, req.TradeId)
+	if err != nil {
 		return nil, err
 	}
 
 	// Convert and execute
-	aggregate, err := h.executeTrade(ctx, req, h.txUC. ProcessTradeWin)
+	aggregate, err := h.executeTrade(ctx, req, amount, h.txUC. ProcessTradeWin)
 	if err != nil {
 		return nil, handleUsecaseError(err)
 	}
@@ -426,12 +489,13 @@ func (h *AccountingHandler) ProcessTradeLoss(
 	req *accountingpb.TradeRequest,
 ) (*accountingpb.TradeResponse, error) {
 	// Validate
-	if err := validateTrade(req.AccountNumber, req.Amount, req.TradeId); err != nil {
+	amount, err := validateTrade(req.AccountNumber, req.Amount, req.TradeId)
+	if err != nil {
 		return nil, err
 	}
 
 	// Convert and execute
-	aggregate, err := h.executeTrade(ctx, req, h.txUC.ProcessTradeLoss)
+	aggregate, err := h.executeTrade(ctx, req, amount, h.txUC.ProcessTradeLoss)
 	if err != nil {
 		return nil, handleUsecaseError(err)
 	}
@@ -448,7 +512,8 @@ func (h *AccountingHandler) ProcessAgentCommission(
 	req *accountingpb.AgentCommissionRequest,
 ) (*accountingpb.AgentCommissionResponse, error) {
 	// Validate
-	if err := validateAgentCommission(req); err != nil {
+	transactionAmount, commissionAmount, err := validateAgentCommission(req)
+	if err != nil {
 		return nil, err
 	}
 
@@ -457,8 +522,8 @@ func (h *AccountingHandler) ProcessAgentCommission(
 		AgentExternalID:   req.AgentExternalId,
 		TransactionRef:     req.TransactionRef,
 		Currency:          req.Currency,
-		TransactionAmount: req. TransactionAmount,
-		CommissionAmount:  req.CommissionAmount,
+		TransactionAmount: transactionAmount,
+		CommissionAmount:  commissionAmount,
 		CommissionRate:    req.CommissionRate,
 		IdempotencyKey:    req.IdempotencyKey,
 	}
@@ -478,54 +543,283 @@ func (h *AccountingHandler) ProcessAgentCommission(
 	}, nil
 }
 
+// ===============================
+// REVERSAL & REFUND
+// ===============================
+
+func (h *AccountingHandler) ReverseTransaction(
+	ctx context.Context,
+	req *accountingpb.ReverseTransactionRequest,
+) (*accountingpb.ReversalResponse, error) {
+	if req.ReceiptCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "receipt_code is required")
+	}
+
+	result, err := h.txUC.ReverseTransaction(ctx, &domain.ReversalRequest{
+		OriginalReceiptCode: req.ReceiptCode,
+		Reason:              req.Reason,
+		IdempotencyKey:      req.IdempotencyKey,
+		CreatedByExternalID: req.CreatedByExternalId,
+		CreatedByType:       convertOwnerTypeToDomain(req.CreatedByType),
+	})
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return convertReversalResultToProto(result), nil
+}
+
+func (h *AccountingHandler) RefundTransaction(
+	ctx context.Context,
+	req *accountingpb.RefundTransactionRequest,
+) (*accountingpb.ReversalResponse, error) {
+	if req.ReceiptCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "receipt_code is required")
+	}
+	amount, err := parsePositiveAmount("amount", req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.txUC.RefundTransaction(ctx, &domain.ReversalRequest{
+		OriginalReceiptCode: req.ReceiptCode,
+		Amount:              amount,
+		Reason:              req.Reason,
+		IdempotencyKey:      req.IdempotencyKey,
+		CreatedByExternalID: req.CreatedByExternalId,
+		CreatedByType:       convertOwnerTypeToDomain(req.CreatedByType),
+	})
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return convertReversalResultToProto(result), nil
+}
+
+// ===============================
+// FUNDS HOLDS (AUTHORIZE / CAPTURE)
+// ===============================
+
+func (h *AccountingHandler) PlaceHold(
+	ctx context.Context,
+	req *accountingpb.PlaceHoldRequest,
+) (*accountingpb.PlaceHoldResponse, error) {
+	amount, err := validateBasicTransaction(req.AccountNumber, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if req.CreatedByExternalId == "" {
+		return nil, status.Error(codes.InvalidArgument, "created_by_external_id is required")
+	}
+
+	domainReq := &domain.PlaceHoldRequest{
+		AccountNumber:        req.AccountNumber,
+		Amount:               amount,
+		HoldRef:              getStringOrEmpty(req.HoldRef),
+		HoldType:             req.HoldType,
+		Reason:               req.Reason,
+		CaptureAccountNumber: req.CaptureAccountNumber,
+		AccountType:          convertAccountTypeToDomain(req.AccountType),
+		CreatedByExternalID:  req.CreatedByExternalId,
+		CreatedByType:        convertOwnerTypeToDomain(req.CreatedByType),
+	}
+	if req.TransactionType != accountingpb.TransactionType_TRANSACTION_TYPE_UNSPECIFIED {
+		domainReq.TransactionType = convertTransactionTypeToDomain(req.TransactionType)
+	}
+	if req.ExpiresInSeconds != nil {
+		if *req.ExpiresInSeconds <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "expires_in_seconds must be positive")
+		}
+		expiresAt := time.Now().Add(time.Duration(*req.ExpiresInSeconds) * time.Second)
+		domainReq.ExpiresAt = &expiresAt
+	}
+
+	hold, balance, err := h.txUC.PlaceHold(ctx, domainReq)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.PlaceHoldResponse{
+		Hold:             convertHoldToProto(hold),
+		AvailableBalance: balance.AvailableBalance.String(),
+	}, nil
+}
+
+func (h *AccountingHandler) CaptureHold(
+	ctx context.Context,
+	req *accountingpb.CaptureHoldRequest,
+) (*accountingpb.CaptureHoldResponse, error) {
+	if req.HoldRef == "" {
+		return nil, status.Error(codes.InvalidArgument, "hold_ref is required")
+	}
+
+	amount := decimal.Zero
+	if req.Amount != nil && *req.Amount != "" {
+		var err error
+		if amount, err = parsePositiveAmount("amount", *req.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := h.txUC.CaptureHold(ctx, &domain.CaptureHoldRequest{
+		HoldRef:             req.HoldRef,
+		Amount:              amount,
+		ToAccountNumber:     req.ToAccountNumber,
+		ToAddress:           req.ToAddress,
+		Description:         req.Description,
+		AgentExternalID:     req.AgentExternalId,
+		CreatedByExternalID: req.CreatedByExternalId,
+		CreatedByType:       convertOwnerTypeToDomain(req.CreatedByType),
+	})
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.CaptureHoldResponse{
+		Hold:           convertHoldToProto(result.Hold),
+		JournalId:      result.Aggregate.Journal.ID,
+		ReceiptCode:    getStringOrEmpty(result.Hold.ReceiptCode),
+		CapturedAmount: result.Hold.CapturedAmount.String(),
+		ReleasedAmount: result.ReleasedAmount.String(),
+		FeeAmount:      result.FeeAmount.String(),
+		CreatedAt:      timestamppb.New(result.Aggregate.Journal.CreatedAt),
+	}, nil
+}
+
+func (h *AccountingHandler) ReleaseHold(
+	ctx context.Context,
+	req *accountingpb.ReleaseHoldRequest,
+) (*accountingpb.ReleaseHoldResponse, error) {
+	if req.HoldRef == "" {
+		return nil, status.Error(codes.InvalidArgument, "hold_ref is required")
+	}
+
+	hold, err := h.txUC.ReleaseHold(ctx, &domain.ReleaseHoldRequest{
+		HoldRef:              req.HoldRef,
+		Reason:               req.Reason,
+		ReleasedByExternalID: req.ReleasedByExternalId,
+		ReleasedByType:       convertOwnerTypeToDomain(req.ReleasedByType),
+	})
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.ReleaseHoldResponse{
+		Hold: convertHoldToProto(hold),
+	}, nil
+}
+
+func (h *AccountingHandler) ExtendHold(
+	ctx context.Context,
+	req *accountingpb.ExtendHoldRequest,
+) (*accountingpb.ExtendHoldResponse, error) {
+	if req.HoldRef == "" {
+		return nil, status.Error(codes.InvalidArgument, "hold_ref is required")
+	}
+
+	domainReq := &domain.ExtendHoldRequest{HoldRef: req.HoldRef}
+	if req.ExpiresInSeconds != nil {
+		if *req.ExpiresInSeconds <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "expires_in_seconds must be positive")
+		}
+		expiresAt := time.Now().Add(time.Duration(*req.ExpiresInSeconds) * time.Second)
+		domainReq.ExpiresAt = &expiresAt
+	}
+
+	hold, err := h.txUC.ExtendHold(ctx, domainReq)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.ExtendHoldResponse{
+		Hold: convertHoldToProto(hold),
+	}, nil
+}
+
+func (h *AccountingHandler) ListHolds(
+	ctx context.Context,
+	req *accountingpb.ListHoldsRequest,
+) (*accountingpb.ListHoldsResponse, error) {
+	filter := &domain.HoldFilter{
+		AccountNumber: req.AccountNumber,
+		HoldType:      req.HoldType,
+		Limit:         int(req.Limit),
+		Offset:        int(req.Offset),
+	}
+	if req.Status != nil && *req.Status != accountingpb.HoldStatus_HOLD_STATUS_UNSPECIFIED {
+		s := convertHoldStatusToDomain(*req.Status)
+		filter.Status = &s
+	}
+
+	holds, total, err := h.txUC.ListHolds(ctx, filter)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.ListHoldsResponse{
+		Holds: convertHoldsToProto(holds),
+		Total: int32(total),
+	}, nil
+}
+
 // ===============================
 // VALIDATION HELPERS
 // ===============================
 
-func validateBasicTransaction(accountNumber string, amount float64) error {
+// validateBasicTransaction validates the request and returns the parsed amount
+func validateBasicTransaction(accountNumber string, amount string) (decimal.Decimal, error) {
 	if accountNumber == "" {
-		return status.Error(codes. InvalidArgument, "account_number is required")
+		return decimal.Zero, status.Error(codes. InvalidArgument, "account_number is required")
 	}
-	if amount <= 0 {
-		return status.Error(codes. InvalidArgument, "amount must be positive")
+	return parsePositiveAmount("amount", amount)
+}
+
+// validateTransfer validates the request and returns the parsed amount
+func validateTransfer(fromAccount, toAccount string, amount string) (decimal.Decimal, error) {
+	if err := validateTransferAccounts(fromAccount, toAccount); err != nil {
+		return decimal.Zero, err
 	}
-	return nil
+	return parsePositiveAmount("amount", amount)
 }
 
-func validateTransfer(fromAccount, toAccount string, amount float64) error {
+func validateTransferAccounts(fromAccount, toAccount string) error {
 	if fromAccount == "" || toAccount == "" {
 		return status.Error(codes.InvalidArgument, "from_account_number and to_account_number are required")
 	}
 	if fromAccount == toAccount {
 		return status.Error(codes.InvalidArgument, "cannot transfer to same account")
 	}
-	if amount <= 0 {
-		return status.Error(codes.InvalidArgument, "amount must be positive")
-	}
 	return nil
 }
 
-func validateTrade(accountNumber string, amount float64, tradeID string) error {
-	if err := validateBasicTransaction(accountNumber, amount); err != nil {
-		return err
+func validateTrade(accountNumber string, amount string, tradeID string) (decimal.Decimal, error) {
+	parsed, err := validateBasicTransaction(accountNumber, amount)
+	if err != nil {
+		return decimal.Zero, err
 	}
 	if tradeID == "" {
-		return status.Error(codes. InvalidArgument, "trade_id is required")
+		return decimal.Zero, status.Error(codes. InvalidArgument, "trade_id is required")
 	}
-	return nil
+	return parsed, nil
 }
 
-func validateAgentCommission(req *accountingpb.AgentCommissionRequest) error {
+// validateAgentCommission returns the parsed transaction and commission amounts
+func validateAgentCommission(req *accountingpb.AgentCommissionRequest) (decimal.Decimal, decimal.Decimal, error) {
 	if req.AgentExternalId == "" {
-		return status.Error(codes.InvalidArgument, "agent_external_id is required")
+		return decimal.Zero, decimal.Zero, status.Error(codes.InvalidArgument, "agent_external_id is required")
 	}
 	if req.TransactionRef == "" {
-		return status.Error(codes.InvalidArgument, "transaction_ref is required")
+		return decimal.Zero, decimal.Zero, status.Error(codes.InvalidArgument, "transaction_ref is required")
 	}
-	if req. CommissionAmount <= 0 {
-		return status.Error(codes.InvalidArgument, "commission_amount must be positive")
+	transactionAmount, err := parseAmount("transaction_amount", req.TransactionAmount)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
 	}
-	return nil
+	commissionAmount, err := parsePositiveAmount("commission_amount", req.CommissionAmount)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	return transactionAmount, commissionAmount, nil
 }
 
 // ===============================
@@ -554,9 +848,9 @@ func extractReceiptCode(aggregate *domain.LedgerAggregate) string {
 }
 
 // extractBalanceAfter extracts balance for specific debit/credit side
-func extractBalanceAfter(aggregate *domain.LedgerAggregate, drCr domain.DrCr) float64 {
+func extractBalanceAfter(aggregate *domain.LedgerAggregate, drCr domain.DrCr) decimal.Decimal {
 	if aggregate == nil || len(aggregate.Ledgers) == 0 {
-		return 0
+		return decimal.Zero
 	}
 
 	for _, ledger := range aggregate. Ledgers {
@@ -565,13 +859,13 @@ func extractBalanceAfter(aggregate *domain.LedgerAggregate, drCr domain.DrCr) fl
 		}
 	}
 
-	return 0
+	return decimal.Zero
 }
 
 // extractFees extracts platform fee and agent commission
-func extractFees(aggregate *domain.LedgerAggregate) (platformFee, agentCommission float64) {
+func extractFees(aggregate *domain.LedgerAggregate) (platformFee, agentCommission decimal.Decimal) {
 	if aggregate == nil || len(aggregate.Fees) == 0 {
-		return 0, 0
+		return decimal.Zero, decimal.Zero
 	}
 
 	for _, fee := range aggregate.Fees {
@@ -590,11 +884,11 @@ func extractFees(aggregate *domain.LedgerAggregate) (platformFee, agentCommissio
 type ConversionData struct {
 	SourceCurrency  string
 	DestCurrency    string
-	SourceAmount    float64
-	ConvertedAmount float64
+	SourceAmount    decimal.Decimal
+	ConvertedAmount decimal.Decimal
 	FxRate          string
 	FxRateID        int64
-	FeeAmount       float64
+	FeeAmount       decimal.Decimal
 }
 
 // extractConversionData extracts all conversion-related data
@@ -666,11 +960,12 @@ func extractMetadata(metadata interface{}) (fxRate string, fxRateID int64) {
 func (h *AccountingHandler) executeTrade(
 	ctx context.Context,
 	req *accountingpb.TradeRequest,
+	amount decimal.Decimal,
 	fn func(context.Context, *domain.TradeRequest) (*domain.LedgerAggregate, error),
 ) (*domain.LedgerAggregate, error) {
 	domainReq := &domain.TradeRequest{
 		AccountNumber:        req.AccountNumber,
-		Amount:              req.Amount,
+		Amount:              amount,
 		AccountType:         convertAccountTypeToDomain(req.AccountType),
 		TradeID:             req.TradeId,
 		TradeType:           req.TradeType,
@@ -689,7 +984,7 @@ func buildTradeResponse(aggregate *domain.LedgerAggregate, tradeID, result strin
 		ReceiptCode:  extractReceiptCode(aggregate),
 		TradeId:      tradeID,
 		TradeResult:  result,
-		BalanceAfter: extractBalanceAfter(aggregate, drCr),
+		BalanceAfter: extractBalanceAfter(aggregate, drCr).String(),
 		CreatedAt:    timestamppb.New(aggregate.Journal.CreatedAt),
 	}
 }
@@ -7,6 +7,9 @@ import (
 	"accounting-service/internal/domain"
 	accountingpb "x/shared/genproto/shared/accounting/v1"
 
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -90,6 +93,12 @@ func convertAccountPurposeToDomain(p accountingpb.AccountPurpose) domain.Account
 		return domain.PurposeContra
 	case accountingpb.AccountPurpose_ACCOUNT_PURPOSE_COMMISSION:
 		return domain.PurposeCommission
+	case accountingpb.AccountPurpose_ACCOUNT_PURPOSE_INVESTMENT:
+		return domain.PurposeInvestment
+	case accountingpb.AccountPurpose_ACCOUNT_PURPOSE_SAVINGS:
+		return domain.PurposeSavings
+	case accountingpb.AccountPurpose_ACCOUNT_PURPOSE_INTEREST_EXPENSE:
+		return domain.PurposeInterestExpense
 	default:
 		return domain.PurposeWallet
 	}
@@ -115,6 +124,12 @@ func convertAccountPurposeToProto(p domain.AccountPurpose) accountingpb.AccountP
 		return accountingpb.AccountPurpose_ACCOUNT_PURPOSE_CONTRA
 	case domain.PurposeCommission:
 		return accountingpb.AccountPurpose_ACCOUNT_PURPOSE_COMMISSION
+	case domain.PurposeInvestment:
+		return accountingpb.AccountPurpose_ACCOUNT_PURPOSE_INVESTMENT
+	case domain.PurposeSavings:
+		return accountingpb.AccountPurpose_ACCOUNT_PURPOSE_SAVINGS
+	case domain.PurposeInterestExpense:
+		return accountingpb.AccountPurpose_ACCOUNT_PURPOSE_INTEREST_EXPENSE
 	default:
 		return accountingpb.AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED
 	}
@@ -245,6 +260,36 @@ func convertFeeTypeToProto(t domain.FeeType) accountingpb.FeeType {
 	}
 }
 
+func convertHoldStatusToDomain(s accountingpb.HoldStatus) domain.HoldStatus {
+	switch s {
+	case accountingpb.HoldStatus_HOLD_STATUS_ACTIVE:
+		return domain.HoldStatusActive
+	case accountingpb.HoldStatus_HOLD_STATUS_CAPTURED:
+		return domain.HoldStatusCaptured
+	case accountingpb.HoldStatus_HOLD_STATUS_RELEASED:
+		return domain.HoldStatusReleased
+	case accountingpb.HoldStatus_HOLD_STATUS_EXPIRED:
+		return domain.HoldStatusExpired
+	default:
+		return domain.HoldStatusActive
+	}
+}
+
+func convertHoldStatusToProto(s domain.HoldStatus) accountingpb.HoldStatus {
+	switch s {
+	case domain.HoldStatusActive:
+		return accountingpb.HoldStatus_HOLD_STATUS_ACTIVE
+	case domain.HoldStatusCaptured:
+		return accountingpb.HoldStatus_HOLD_STATUS_CAPTURED
+	case domain.HoldStatusReleased:
+		return accountingpb.HoldStatus_HOLD_STATUS_RELEASED
+	case domain.HoldStatusExpired:
+		return accountingpb.HoldStatus_HOLD_STATUS_EXPIRED
+	default:
+		return accountingpb.HoldStatus_HOLD_STATUS_UNSPECIFIED
+	}
+}
+
 // ===============================
 // OPTIONAL CONVERSIONS
 // ===============================
@@ -281,6 +326,13 @@ func convertOptionalTimestamp(ts *timestamppb.Timestamp) *time.Time {
 	return &t
 }
 
+func convertOptionalTimeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
 // ===============================
 // ACCOUNT CONVERSIONS
 // ===============================
@@ -300,11 +352,13 @@ func convertAccountToProto(a *domain.Account) *accountingpb.Account {
 		AccountType:    convertAccountTypeToProto(a.AccountType),
 		IsActive:       a.IsActive,
 		IsLocked:       a.IsLocked,
-		OverdraftLimit: a.OverdraftLimit,
+		OverdraftLimit: a.OverdraftLimit.String(),
 		ParentAgentId:  getInt64OrZero(ptrStringToInt64(a.ParentAgentExternalID)),
 		CommissionRate: getStringOrEmpty(a.CommissionRate),
 		CreatedAt:      timestamppb.New(a.CreatedAt),
 		UpdatedAt:      timestamppb.New(a.UpdatedAt),
+		ParentAccountNumber: a.ParentAccountNumber,
+		Name:                a.Name,
 	}
 }
 
@@ -340,8 +394,8 @@ func convertCreateAccountRequestToDomain(req *accountingpb.CreateAccountRequest)
 		AccountType:           convertAccountTypeToDomain(req.AccountType),
 		ParentAgentExternalID: parentAgentID,
 		CommissionRate:        commissionRate,
-		OverdraftLimit:        req.OverdraftLimit,
-		InitialBalance:        0,
+		OverdraftLimit:        parseAmountOrZero(req.OverdraftLimit),
+		InitialBalance:        decimal.Zero,
 	}
 }
 
@@ -369,10 +423,10 @@ func convertBalanceToProto(b *domain.Balance, account *domain.Account) *accounti
 	return &accountingpb.Balance{
 		AccountId:         b.AccountID,
 		AccountNumber:     accountNumber,
-		Balance:           b.Balance,
-		AvailableBalance:  b.AvailableBalance,
-		PendingDebit:      b.PendingDebit,
-		PendingCredit:     b.PendingCredit,
+		Balance:           b.Balance.String(),
+		AvailableBalance:  b.AvailableBalance.String(),
+		PendingDebit:      b.PendingDebit.String(),
+		PendingCredit:     b.PendingCredit.String(),
 		Currency:          currency,
 		Version:           b.Version,
 		LastTransactionAt: timestamppb.New(lastTransactionAt),
@@ -395,7 +449,7 @@ func convertLedgerEntryToDomain(entry *accountingpb.LedgerEntry) *domain.LedgerE
 
 	return &domain.LedgerEntryRequest{
 		AccountNumber: entry.AccountNumber,
-		Amount:        entry.Amount,
+		Amount:        parseAmountOrZero(entry.Amount),
 		DrCr:          convertDrCrToDomain(entry.DrCr),
 		Currency:      entry.Currency,
 		Description:   description,
@@ -438,9 +492,9 @@ func convertTransactionResultToProto(r *domain.TransactionResult) *accountingpb.
 		ReceiptCode:      r.ReceiptCode,
 		TransactionId:    r.TransactionID,
 		Status:           convertTransactionStatusToProto(r.Status),
-		Amount:           r.Amount,
+		Amount:           r.Amount.String(),
 		Currency:         r.Currency,
-		Fee:              r.Fee,
+		Fee:              r.Fee.String(),
 		ProcessingTimeMs: r.ProcessingTime.Milliseconds(),
 		CreatedAt:        timestamppb.New(r.CreatedAt),
 	}
@@ -492,7 +546,7 @@ func convertLedgerToProto(l *domain.Ledger) *accountingpb.Ledger {
 		accountNumber = l.AccountData.AccountNumber
 	}
 
-	balanceAfter := float64(0)
+	balanceAfter := decimal.Zero
 	if l.BalanceAfter != nil {
 		balanceAfter = *l.BalanceAfter
 	}
@@ -502,10 +556,10 @@ func convertLedgerToProto(l *domain.Ledger) *accountingpb.Ledger {
 		JournalId:     l.JournalID,
 		AccountId:     l.AccountID,
 		AccountNumber: accountNumber,
-		Amount:        l.Amount,
+		Amount:        l.Amount.String(),
 		DrCr:          convertDrCrToProto(l.DrCr),
 		Currency:      l.Currency,
-		BalanceAfter:  balanceAfter,
+		BalanceAfter:  balanceAfter.String(),
 		Description:   l.Description,
 		ReceiptCode:   l.ReceiptCode,
 		CreatedAt:     timestamppb.New(l.CreatedAt),
@@ -534,12 +588,13 @@ func convertAccountStatementToProto(s *domain.AccountStatement) *accountingpb.Ac
 		AccountType:    convertAccountTypeToProto(s.AccountType),
 		Currency:       s.Currency,
 		Ledgers:        convertLedgersToProto(s.Ledgers),
-		OpeningBalance: s.OpeningBalance,
-		ClosingBalance: s.ClosingBalance,
-		TotalDebits:    s.TotalDebits,
-		TotalCredits:   s.TotalCredits,
+		OpeningBalance: s.OpeningBalance.String(),
+		ClosingBalance: s.ClosingBalance.String(),
+		TotalDebits:    s.TotalDebits.String(),
+		TotalCredits:   s.TotalCredits.String(),
 		PeriodStart:    timestamppb.New(s.PeriodStart),
 		PeriodEnd:      timestamppb.New(s.PeriodEnd),
+		Interest:       convertInterestSummaryToProto(s.Interest),
 	}
 }
 
@@ -563,8 +618,13 @@ func convertOwnerSummaryToProto(s *domain.OwnerSummary) *accountingpb.OwnerSumma
 			AccountId:        balance.AccountID,
 			AccountNumber:    balance.AccountNumber,
 			Currency:         balance.Currency,
-			Balance:          balance.Balance,
-			AvailableBalance: balance.AvailableBalance,
+			Balance:          balance.Balance.String(),
+			AvailableBalance: balance.AvailableBalance.String(),
+			ParentAccountNumber:      balance.ParentAccountNumber,
+			Name:                     balance.Name,
+			SubAccountCount:          int32(balance.SubAccountCount),
+			RolledUpBalance:          balance.RolledUpBalance.String(),
+			RolledUpAvailableBalance: balance.RolledUpAvailableBalance.String(),
 		}
 	}
 
@@ -573,7 +633,7 @@ func convertOwnerSummaryToProto(s *domain.OwnerSummary) *accountingpb.OwnerSumma
 		OwnerId:                   s.OwnerID,
 		AccountType:               convertAccountTypeToProto(s.AccountType),
 		AccountBalances:           accountBalances,
-		TotalBalanceUsdEquivalent: s.TotalBalance,
+		TotalBalanceUsdEquivalent: s.TotalBalance.String(),
 	}
 }
 
@@ -591,10 +651,10 @@ func convertDailyReportToProto(r *domain.DailyReport) *accountingpb.DailyReport
 		OwnerId:     r.OwnerID,
 		AccountId:   r.AccountID,
 		Currency:    r.Currency,
-		TotalDebit:  r.TotalDebit,
-		TotalCredit: r.TotalCredit,
-		Balance:     r.Balance,
-		NetChange:   r.NetChange,
+		TotalDebit:  r.TotalDebit.String(),
+		TotalCredit: r.TotalCredit.String(),
+		Balance:     r.Balance.String(),
+		NetChange:   r.NetChange.String(),
 		Date:        timestamppb.New(r.Date),
 	}
 }
@@ -616,10 +676,10 @@ func convertTransactionSummaryToProto(s *domain.TransactionSummary) *accountingp
 		TransactionType: convertTransactionTypeToProto(s.TransactionType),
 		Currency:        s.Currency,
 		Count:           s.Count,
-		TotalAmount:     s.TotalVolume,
-		MinAmount:       s.MinAmount,
-		MaxAmount:       s.MaxAmount,
-		AvgAmount:       s.AverageAmount,
+		TotalAmount:     s.TotalVolume.String(),
+		MinAmount:       s.MinAmount.String(),
+		MaxAmount:       s.MaxAmount.String(),
+		AvgAmount:       s.AverageAmount.String(),
 	}
 }
 
@@ -645,13 +705,14 @@ func convertTransactionFeeToProto(f *domain.TransactionFee) *accountingpb.Transa
 		ReceiptCode:          f.ReceiptCode,
 		FeeRuleId:            getInt64OrZero(f.FeeRuleID),
 		FeeType:              convertFeeTypeToProto(f.FeeType),
-		Amount:               f.Amount,
+		Amount:               f.Amount.String(),
 		Currency:             f.Currency,
 		CollectedByAccountId: f.CollectedByAccountID,
 		LedgerId:             f.LedgerID,
 		AgentExternalId:      f.AgentExternalID,
 		CommissionRate:       f.CommissionRate,
 		CreatedAt:            timestamppb.New(f.CreatedAt),
+		FeeRuleVersionId:     f.FeeRuleVersionID,
 	}
 }
 
@@ -672,12 +733,12 @@ func convertFeeCalculationToProto(
 
 	p := &accountingpb.FeeCalculation{
 		FeeType:                     convertFeeTypeToProto(c.FeeType),
-		Amount:                      c.Amount,
+		Amount:                      c.Amount.String(),
 		Currency:                    c.Currency,
-		NetworkFee:                  c.NetworkFee,
-		NetworkFeeOriginal:          c.NetworkFeeOriginal,
+		NetworkFee:                  c.NetworkFee.String(),
+		NetworkFeeOriginal:          c.NetworkFeeOriginal.String(),
 		NetworkFeeOriginalCurrency:  c.NetworkFeeOriginalCurrency,
-		TotalFee:                    c.TotalFee,
+		TotalFee:                    c.TotalFee.String(),
 		CalculatedFrom:              c.CalculatedFrom,
 	}
 
@@ -750,3 +811,364 @@ func int64ToString(v int64) string {
 	}
 	return fmt.Sprintf("%d", v)
 }
+
+// convertReversalResultToProto converts a reversal/refund result to its response
+func convertReversalResultToProto(result *domain.ReversalResult) *accountingpb.ReversalResponse {
+	if result == nil || result.Reversal == nil {
+		return nil
+	}
+
+	rev := result.Reversal
+	return &accountingpb.ReversalResponse{
+		JournalId:           rev.ReversalJournalID,
+		ReceiptCode:         rev.ReversalReceiptCode,
+		OriginalReceiptCode: rev.OriginalReceiptCode,
+		TransactionType:     convertTransactionTypeToProto(rev.Kind),
+		Amount:              rev.Amount.String(),
+		Currency:            rev.Currency,
+		FeeReversed:         rev.FeeReversed.String(),
+		CommissionReversed:  rev.CommissionReversed.String(),
+		RefundableRemaining: result.RefundableRemaining.String(),
+		CreatedAt:           timestamppb.New(rev.CreatedAt),
+	}
+}
+
+// convertHoldToProto converts a funds hold
+func convertHoldToProto(h *domain.Hold) *accountingpb.Hold {
+	if h == nil {
+		return nil
+	}
+
+	return &accountingpb.Hold{
+		Id:                   h.ID,
+		HoldRef:              h.HoldRef,
+		AccountNumber:        h.AccountNumber,
+		Amount:               h.Amount.String(),
+		CapturedAmount:       h.CapturedAmount.String(),
+		Currency:             h.Currency,
+		HoldType:             h.HoldType,
+		Status:               convertHoldStatusToProto(h.Status),
+		TransactionType:      convertTransactionTypeToProto(h.TransactionType),
+		CaptureAccountNumber: h.CaptureAccountNumber,
+		CaptureReceiptCode:   h.ReceiptCode,
+		Reason:               h.Reason,
+		ExpiresAt:            convertOptionalTimeToProto(h.ExpiresAt),
+		CapturedAt:           convertOptionalTimeToProto(h.CapturedAt),
+		ReleasedAt:           convertOptionalTimeToProto(h.ReleasedAt),
+		CreatedAt:            timestamppb.New(h.CreatedAt),
+		UpdatedAt:            timestamppb.New(h.UpdatedAt),
+	}
+}
+
+// convertFXQuoteToProto converts a locked FX quote
+func convertFXQuoteToProto(q *domain.FXQuote) *accountingpb.FXQuote {
+	if q == nil {
+		return nil
+	}
+
+	return &accountingpb.FXQuote{
+		QuoteId:           q.QuoteID,
+		FromAccountNumber: q.FromAccountNumber,
+		ToAccountNumber:   q.ToAccountNumber,
+		SourceCurrency:    q.SourceCurrency,
+		DestCurrency:      q.DestCurrency,
+		SourceAmount:      q.SourceAmount.String(),
+		FxRate:            q.Rate.String(),
+		MidRate:           q.MidRate.String(),
+		FxRateId:          q.FXRateID,
+		ConvertedAmount:   q.ConvertedAmount.String(),
+		FeeAmount:         q.FeeAmount.String(),
+		FeeRuleId:         q.FeeRuleID,
+		FeeType:           convertFeeTypeToProto(q.FeeType),
+		PayableAmount:     q.PayableAmount.String(),
+		ExpiresAt:         timestamppb.New(q.ExpiresAt),
+		CreatedAt:         timestamppb.New(q.CreatedAt),
+	}
+}
+
+func convertHoldsToProto(holds []*domain.Hold) []*accountingpb.Hold {
+	result := make([]*accountingpb.Hold, len(holds))
+	for i, h := range holds {
+		result[i] = convertHoldToProto(h)
+	}
+	return result
+}
+
+// ===============================
+// RECONCILIATION CONVERSIONS
+// ===============================
+
+func convertReconciliationStatusToProto(s domain.ReconciliationRunStatus) accountingpb.ReconciliationRunStatus {
+	switch s {
+	case domain.ReconciliationRunning:
+		return accountingpb.ReconciliationRunStatus_RECONCILIATION_RUN_STATUS_RUNNING
+	case domain.ReconciliationCompleted:
+		return accountingpb.ReconciliationRunStatus_RECONCILIATION_RUN_STATUS_COMPLETED
+	case domain.ReconciliationFailed:
+		return accountingpb.ReconciliationRunStatus_RECONCILIATION_RUN_STATUS_FAILED
+	default:
+		return accountingpb.ReconciliationRunStatus_RECONCILIATION_RUN_STATUS_UNSPECIFIED
+	}
+}
+
+// convertOptionalAccountTypeToProto returns nil for an unscoped account type
+func convertOptionalAccountTypeToProto(t *domain.AccountType) *accountingpb.AccountType {
+	if t == nil {
+		return nil
+	}
+	pt := convertAccountTypeToProto(*t)
+	return &pt
+}
+
+func convertReconciliationRunToProto(r *domain.ReconciliationRun) *accountingpb.ReconciliationRun {
+	if r == nil {
+		return nil
+	}
+
+	return &accountingpb.ReconciliationRun{
+		Id:                r.ID,
+		Status:            convertReconciliationStatusToProto(r.Status),
+		TriggerType:       string(r.TriggerType),
+		TriggeredBy:       r.TriggeredBy,
+		Currency:          r.Currency,
+		AccountType:       convertOptionalAccountTypeToProto(r.AccountType),
+		JournalsFrom:      timestamppb.New(r.JournalsFrom),
+		AccountsChecked:   r.AccountsChecked,
+		JournalsChecked:   r.JournalsChecked,
+		CurrenciesChecked: r.CurrenciesChecked,
+		BreaksFound:       r.BreaksFound,
+		Error:             r.Error,
+		StartedAt:         timestamppb.New(r.StartedAt),
+		FinishedAt:        convertOptionalTimeToProto(r.FinishedAt),
+	}
+}
+
+func convertReconciliationBreakToProto(b *domain.ReconciliationBreak) *accountingpb.ReconciliationBreak {
+	if b == nil {
+		return nil
+	}
+
+	return &accountingpb.ReconciliationBreak{
+		Id:            b.ID,
+		RunId:         b.RunID,
+		BreakType:     string(b.BreakType),
+		AccountId:     b.AccountID,
+		AccountNumber: b.AccountNumber,
+		AccountType:   convertOptionalAccountTypeToProto(b.AccountType),
+		JournalId:     b.JournalID,
+		Currency:      b.Currency,
+		Expected:      b.Expected.String(),
+		Actual:        b.Actual.String(),
+		Difference:    b.Difference.String(),
+		Details:       b.Details,
+		Resolved:      b.Resolved,
+		CreatedAt:     timestamppb.New(b.CreatedAt),
+	}
+}
+
+func convertReconciliationBreaksToProto(breaks []*domain.ReconciliationBreak) []*accountingpb.ReconciliationBreak {
+	result := make([]*accountingpb.ReconciliationBreak, len(breaks))
+	for i, b := range breaks {
+		result[i] = convertReconciliationBreakToProto(b)
+	}
+	return result
+}
+
+// ===============================
+// TRANSACTION QUEUE CONVERSIONS
+// ===============================
+
+func convertQueuedStatusToProto(s domain.QueuedTransactionStatus) accountingpb.QueuedTransactionStatus {
+	switch s {
+	case domain.QueuedPending:
+		return accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_PENDING
+	case domain.QueuedProcessing:
+		return accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_PROCESSING
+	case domain.QueuedCompleted:
+		return accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_COMPLETED
+	case domain.QueuedFailed:
+		return accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_FAILED
+	case domain.QueuedDead:
+		return accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_DEAD
+	case domain.QueuedSkipped:
+		return accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_SKIPPED
+	default:
+		return accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_UNSPECIFIED
+	}
+}
+
+func convertQueuedStatusToDomain(s accountingpb.QueuedTransactionStatus) domain.QueuedTransactionStatus {
+	switch s {
+	case accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_PENDING:
+		return domain.QueuedPending
+	case accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_PROCESSING:
+		return domain.QueuedProcessing
+	case accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_COMPLETED:
+		return domain.QueuedCompleted
+	case accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_FAILED:
+		return domain.QueuedFailed
+	case accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_SKIPPED:
+		return domain.QueuedSkipped
+	default:
+		return domain.QueuedDead
+	}
+}
+
+func convertQueuedTransactionToProto(t *domain.QueuedTransaction) *accountingpb.QueuedTransaction {
+	if t == nil {
+		return nil
+	}
+
+	result := &accountingpb.QueuedTransaction{
+		Id:             t.ID,
+		ReceiptCode:    t.ReceiptCode,
+		IdempotencyKey: t.IdempotencyKey,
+		OrderingKey:    t.OrderingKey,
+		Status:         convertQueuedStatusToProto(t.Status),
+		Attempts:       int32(t.Attempts),
+		MaxAttempts:    int32(t.MaxAttempts),
+		LastError:      t.LastError,
+		JournalId:      t.JournalID,
+		AvailableAt:    timestamppb.New(t.AvailableAt),
+		CreatedAt:      timestamppb.New(t.CreatedAt),
+		UpdatedAt:      timestamppb.New(t.UpdatedAt),
+		CompletedAt:    convertOptionalTimeToProto(t.CompletedAt),
+	}
+	if t.Request != nil {
+		result.TransactionType = convertTransactionTypeToProto(t.Request.TransactionType)
+		result.Description = getStringOrEmpty(t.Request.Description)
+	}
+	return result
+}
+
+func convertQueuedTransactionsToProto(tasks []*domain.QueuedTransaction) []*accountingpb.QueuedTransaction {
+	result := make([]*accountingpb.QueuedTransaction, len(tasks))
+	for i, t := range tasks {
+		result[i] = convertQueuedTransactionToProto(t)
+	}
+	return result
+}
+
+// ===============================
+// ACCOUNTING PERIOD CONVERSIONS
+// ===============================
+
+func convertAccountingPeriodToProto(p *domain.AccountingPeriod) *accountingpb.AccountingPeriod {
+	if p == nil {
+		return nil
+	}
+
+	return &accountingpb.AccountingPeriod{
+		Id:            p.ID,
+		PeriodStart:   timestamppb.New(p.PeriodStart),
+		PeriodEnd:     timestamppb.New(p.PeriodEnd),
+		Status:        string(p.Status),
+		ClosedBy:      p.ClosedBy,
+		Note:          p.Note,
+		AccountsCount: p.AccountsCount,
+		CreatedAt:     timestamppb.New(p.CreatedAt),
+		ClosedAt:      convertOptionalTimeToProto(p.ClosedAt),
+	}
+}
+
+func convertTrialBalanceLinesToProto(lines []*domain.TrialBalanceLine) []*accountingpb.TrialBalanceLine {
+	result := make([]*accountingpb.TrialBalanceLine, len(lines))
+	for i, l := range lines {
+		result[i] = &accountingpb.TrialBalanceLine{
+			Purpose:        convertAccountPurposeToProto(l.Purpose),
+			Currency:       l.Currency,
+			AccountType:    convertAccountTypeToProto(l.AccountType),
+			AccountCount:   l.AccountCount,
+			OpeningBalance: l.OpeningBalance.String(),
+			TotalDebits:    l.TotalDebits.String(),
+			TotalCredits:   l.TotalCredits.String(),
+			ClosingBalance: l.ClosingBalance.String(),
+		}
+	}
+	return result
+}
+
+func convertTrialBalanceTotalsToProto(totals []*domain.TrialBalanceTotal) []*accountingpb.TrialBalanceTotal {
+	result := make([]*accountingpb.TrialBalanceTotal, len(totals))
+	for i, t := range totals {
+		result[i] = &accountingpb.TrialBalanceTotal{
+			Currency:       t.Currency,
+			OpeningBalance: t.OpeningBalance.String(),
+			TotalDebits:    t.TotalDebits.String(),
+			TotalCredits:   t.TotalCredits.String(),
+			ClosingBalance: t.ClosingBalance.String(),
+		}
+	}
+	return result
+}
+
+func convertGeneralLedgerEntriesToProto(entries []*domain.GeneralLedgerEntry) []*accountingpb.GeneralLedgerEntry {
+	result := make([]*accountingpb.GeneralLedgerEntry, len(entries))
+	for i, e := range entries {
+		var balanceAfter *string
+		if e.BalanceAfter != nil {
+			s := e.BalanceAfter.String()
+			balanceAfter = &s
+		}
+
+		result[i] = &accountingpb.GeneralLedgerEntry{
+			LedgerId:        e.LedgerID,
+			JournalId:       e.JournalID,
+			AccountNumber:   e.AccountNumber,
+			Purpose:         convertAccountPurposeToProto(e.Purpose),
+			AccountType:     convertAccountTypeToProto(e.AccountType),
+			Currency:        e.Currency,
+			DrCr:            convertDrCrToProto(e.DrCr),
+			Amount:          e.Amount.String(),
+			BalanceAfter:    balanceAfter,
+			ReceiptCode:     e.ReceiptCode,
+			Description:     e.Description,
+			TransactionType: convertTransactionTypeToProto(e.TransactionType),
+			CreatedAt:       timestamppb.New(e.CreatedAt),
+		}
+	}
+	return result
+}
+
+// ===============================
+// AMOUNT CONVERSIONS
+// ===============================
+
+// parseAmount parses a NUMERIC-as-string amount from the wire
+func parseAmount(field, value string) (decimal.Decimal, error) {
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, status.Errorf(codes.InvalidArgument, "%s must be a decimal string, got %q", field, value)
+	}
+	return amount, nil
+}
+
+// parsePositiveAmount parses an amount and rejects zero or negative values
+func parsePositiveAmount(field, value string) (decimal.Decimal, error) {
+	amount, err := parseAmount(field, value)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if !amount.IsPositive() {
+		return decimal.Zero, status.Errorf(codes.InvalidArgument, "%s must be positive", field)
+	}
+	return amount, nil
+}
+
+// parseAmountOrZero is for converters that cannot fail; an unparseable
+// amount becomes zero and is rejected by domain validation
+func parseAmountOrZero(value string) decimal.Decimal {
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero
+	}
+	return amount
+}
+
+func convertDecimalMapToProto(m map[string]decimal.Decimal) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v.String()
+	}
+	return result
+}
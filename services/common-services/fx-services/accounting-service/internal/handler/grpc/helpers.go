@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 
 	log "github.com/sirupsen/logrus"
@@ -12,6 +13,7 @@ import (
 
 	xerrors "x/shared/utils/errors"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -45,7 +47,16 @@ func handleUsecaseError(err error) error {
 		errors.Is(err, xerrors.ErrFeeRuleNotFound),
 		errors.Is(err, xerrors.ErrAgentNotFound),
 		errors.Is(err, xerrors.ErrSystemAccountNotFound),
-		errors.Is(err, xerrors.ErrStatementNotFound):
+		errors.Is(err, xerrors.ErrStatementNotFound),
+		errors.Is(err, xerrors.ErrHoldNotFound),
+		errors.Is(err, xerrors.ErrFXQuoteNotFound),
+		errors.Is(err, xerrors.ErrReconciliationRunNotFound),
+		errors.Is(err, xerrors.ErrAccountingPeriodNotFound),
+		errors.Is(err, xerrors.ErrLimitProfileNotFound),
+		errors.Is(err, xerrors.ErrScheduledTransferNotFound),
+		errors.Is(err, xerrors.ErrInterestRatePlanNotFound),
+		errors.Is(err, xerrors.ErrAccountRestrictionNotFound),
+		errors.Is(err, xerrors.ErrDemoAccountNotFound):
 		logger.WithField("grpc_code", codes.NotFound).Warn("resource not found")
 		return status.Error(codes.NotFound, err.Error())
 
@@ -60,6 +71,21 @@ func handleUsecaseError(err error) error {
 		logger.WithField("grpc_code", codes. FailedPrecondition).Warn("insufficient balance for transaction")
 		return status.Error(codes. FailedPrecondition, err.Error())
 
+	// ===============================
+	// RESOURCE EXHAUSTED (Transaction Limits)
+	// ===============================
+	case errors.Is(err, xerrors.ErrLimitExceeded):
+		logger.WithField("grpc_code", codes.ResourceExhausted).Warn("transaction limit exceeded")
+		return limitExceededStatus(err)
+
+	case errors.Is(err, xerrors.ErrDemoRefillCooldown):
+		logger.WithField("grpc_code", codes.ResourceExhausted).Warn("demo account refill on cooldown")
+		return status.Error(codes.ResourceExhausted, err.Error())
+
+	case errors.Is(err, xerrors.ErrSubAccountLimitReached):
+		logger.WithField("grpc_code", codes.ResourceExhausted).Warn("sub-account limit reached")
+		return status.Error(codes.ResourceExhausted, err.Error())
+
 	// ===============================
 	// PERMISSION DENIED (Account State)
 	// ===============================
@@ -71,6 +97,16 @@ func handleUsecaseError(err error) error {
 		logger.WithField("grpc_code", codes.PermissionDenied).Warn("attempted operation on inactive account")
 		return status.Error(codes.PermissionDenied, "account is inactive")
 
+	case errors.Is(err, xerrors.ErrAccountClosed),
+		errors.Is(err, xerrors.ErrAccountDebitFrozen),
+		errors.Is(err, xerrors.ErrAccountCreditFrozen):
+		logger.WithField("grpc_code", codes.PermissionDenied).Warn("attempted operation on restricted account")
+		return status.Error(codes.PermissionDenied, err.Error())
+
+	case errors.Is(err, xerrors.ErrNotAccountOwner):
+		logger.WithField("grpc_code", codes.PermissionDenied).Warn("attempted operation on another owner's account")
+		return status.Error(codes.PermissionDenied, err.Error())
+
 	case errors.Is(err, xerrors.ErrDemoAccountRestricted),
 		errors.Is(err, xerrors.ErrDemoDepositNotAllowed),
 		errors.Is(err, xerrors. ErrDemoWithdrawalNotAllowed),
@@ -87,7 +123,11 @@ func handleUsecaseError(err error) error {
 
 	case errors.Is(err, xerrors.ErrDuplicateAccount),
 		errors.Is(err, xerrors.ErrDuplicateReceipt),
-		errors.Is(err, xerrors.ErrTransactionAlreadyProcessed):
+		errors.Is(err, xerrors.ErrTransactionAlreadyProcessed),
+		errors.Is(err, xerrors.ErrTransactionAlreadyReversed),
+		errors.Is(err, xerrors.ErrHoldReferenceConflict),
+		errors.Is(err, xerrors.ErrInterestRatePlanConflict),
+		errors.Is(err, xerrors.ErrSubAccountNameTaken):
 		logger.WithField("grpc_code", codes.AlreadyExists).Warn("duplicate resource detected")
 		return status.Error(codes.AlreadyExists, err.Error())
 
@@ -118,7 +158,12 @@ func handleUsecaseError(err error) error {
 		errors.Is(err, xerrors.ErrInvalidDateRange),
 		errors.Is(err, xerrors.ErrInvalidFilter),
 		errors.Is(err, xerrors. ErrInvalidStatementPeriod),
-		errors.Is(err, xerrors. ErrRequiredFieldMissing):
+		errors.Is(err, xerrors. ErrRequiredFieldMissing),
+		errors.Is(err, xerrors.ErrFXQuoteMismatch),
+		errors.Is(err, xerrors.ErrInvalidSchedule),
+		errors.Is(err, xerrors.ErrInvalidInterestRatePlan),
+		errors.Is(err, xerrors.ErrInvalidAccountRestriction),
+		errors.Is(err, xerrors.ErrDemoRealAccountMix):
 		logger.WithField("grpc_code", codes.InvalidArgument).Warn("invalid input provided")
 		return status.Error(codes.InvalidArgument, err. Error())
 
@@ -136,7 +181,24 @@ func handleUsecaseError(err error) error {
 		errors.Is(err, xerrors.ErrFeeRuleInactive),
 		errors.Is(err, xerrors.ErrReceiptExpired),
 		errors.Is(err, xerrors.ErrCommissionNotApplicable),
-		errors.Is(err, xerrors.ErrInvalidSystemOperation):
+		errors.Is(err, xerrors.ErrTransactionNotReversible),
+		errors.Is(err, xerrors.ErrRefundExceedsRemaining),
+		errors.Is(err, xerrors.ErrHoldNotActive),
+		errors.Is(err, xerrors.ErrHoldExpired),
+		errors.Is(err, xerrors.ErrHoldCaptureExceedsAmount),
+		errors.Is(err, xerrors.ErrFXRateNotFound),
+		errors.Is(err, xerrors.ErrFXRateStale),
+		errors.Is(err, xerrors.ErrFXQuoteExpired),
+		errors.Is(err, xerrors.ErrReconciliationInProgress),
+		errors.Is(err, xerrors.ErrAccountingPeriodClosed),
+		errors.Is(err, xerrors.ErrInvalidAccountingPeriod),
+		errors.Is(err, xerrors.ErrInvalidSystemOperation),
+		errors.Is(err, xerrors.ErrScheduleNotActive),
+		errors.Is(err, xerrors.ErrAccountRestrictionNotActive),
+		errors.Is(err, xerrors.ErrAccountNotEmpty),
+		errors.Is(err, xerrors.ErrDemoNotSupported),
+		errors.Is(err, xerrors.ErrDemoBalanceCap),
+		errors.Is(err, xerrors.ErrSubAccountNotAllowed):
 		logger.WithField("grpc_code", codes. FailedPrecondition).Warn("business logic constraint violation")
 		return status.Error(codes.FailedPrecondition, err.Error())
 
@@ -184,6 +246,23 @@ func handleUsecaseError(err error) error {
         logger.WithField("grpc_code", codes.NotFound).Warn("approval not found")
         return status.Error(codes.NotFound, "approval request not found")
 
+    case errors.Is(err, xerrors.ErrApprovalPolicyNotFound):
+        logger.WithField("grpc_code", codes.NotFound).Warn("approval policy not found")
+        return status.Error(codes.NotFound, "approval policy not found")
+
+    case errors.Is(err, xerrors.ErrApprovalExpired):
+        logger.WithField("grpc_code", codes.FailedPrecondition).Warn("approval expired")
+        return status.Error(codes.FailedPrecondition, "approval request has expired")
+
+    case errors.Is(err, xerrors.ErrApproverRoleNotAllowed):
+        logger.WithField("grpc_code", codes.PermissionDenied).Warn("approver role not allowed")
+        return status.Error(codes.PermissionDenied, "your role cannot approve this request")
+
+    case errors.Is(err, xerrors.ErrApprovalAlreadyVoted),
+        errors.Is(err, xerrors.ErrApprovalRoleAlreadyCounted):
+        logger.WithField("grpc_code", codes.FailedPrecondition).Warn("duplicate approval decision")
+        return status.Error(codes.FailedPrecondition, err.Error())
+
 	// ===============================
 	// DEFAULT:  INTERNAL SERVER ERROR
 	// ===============================
@@ -198,6 +277,41 @@ func handleUsecaseError(err error) error {
 	}
 }
 
+// limitExceededStatus attaches the breached limit and the remaining allowance
+// as ErrorInfo metadata so callers can show them without parsing the message
+func limitExceededStatus(err error) error {
+	st := status.New(codes.ResourceExhausted, err.Error())
+
+	var limitErr *domain.LimitExceededError
+	if !errors.As(err, &limitErr) {
+		return st.Err()
+	}
+
+	metadata := map[string]string{
+		"account_number": limitErr.AccountNumber,
+		"limit":          limitErr.Limit,
+		"cap":            limitErr.Cap,
+		"requested":      limitErr.Requested,
+		"remaining":      limitErr.Remaining,
+	}
+	if limitErr.Window != nil {
+		metadata["window"] = string(*limitErr.Window)
+	}
+	if limitErr.ResetsAt != nil {
+		metadata["resets_at"] = limitErr.ResetsAt.Format(time.RFC3339)
+	}
+
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "LIMIT_EXCEEDED",
+		Domain:   "accounting",
+		Metadata: metadata,
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
 // ===============================
 // HELPER FUNCTIONS
 // ===============================
@@ -0,0 +1,236 @@
+package hgrpc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"accounting-service/internal/domain"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ===============================
+// INTEREST
+// ===============================
+
+func (h *AccountingHandler) CreateInterestRatePlan(
+	ctx context.Context,
+	req *accountingpb.CreateInterestRatePlanRequest,
+) (*accountingpb.CreateInterestRatePlanResponse, error) {
+	if req.Plan == nil {
+		return nil, status.Error(codes.InvalidArgument, "plan is required")
+	}
+	if req.CreatedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "created_by is required")
+	}
+
+	plan, err := convertInterestRatePlanToDomain(req.Plan)
+	if err != nil {
+		return nil, err
+	}
+	plan.CreatedBy = req.CreatedBy
+
+	created, err := h.interestUC.CreateRatePlan(ctx, plan)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.CreateInterestRatePlanResponse{
+		Plan: convertInterestRatePlanToProto(created),
+	}, nil
+}
+
+func (h *AccountingHandler) UpdateInterestRatePlan(
+	ctx context.Context,
+	req *accountingpb.UpdateInterestRatePlanRequest,
+) (*accountingpb.UpdateInterestRatePlanResponse, error) {
+	if req.Plan == nil || req.Plan.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "plan.id is required")
+	}
+	if req.UpdatedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "updated_by is required")
+	}
+
+	plan, err := convertInterestRatePlanToDomain(req.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := h.interestUC.UpdateRatePlan(ctx, plan)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	log.Printf("[INTEREST] Rate plan %d updated by %s", updated.ID, req.UpdatedBy)
+
+	return &accountingpb.UpdateInterestRatePlanResponse{
+		Plan: convertInterestRatePlanToProto(updated),
+	}, nil
+}
+
+func (h *AccountingHandler) ListInterestRatePlans(
+	ctx context.Context,
+	req *accountingpb.ListInterestRatePlansRequest,
+) (*accountingpb.ListInterestRatePlansResponse, error) {
+	plans, err := h.interestUC.ListRatePlans(ctx, req.Currency, req.ActiveOnly)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	result := make([]*accountingpb.InterestRatePlan, len(plans))
+	for i, plan := range plans {
+		result[i] = convertInterestRatePlanToProto(plan)
+	}
+
+	return &accountingpb.ListInterestRatePlansResponse{Plans: result}, nil
+}
+
+func (h *AccountingHandler) GetAccountInterest(
+	ctx context.Context,
+	req *accountingpb.GetAccountInterestRequest,
+) (*accountingpb.GetAccountInterestResponse, error) {
+	if req.AccountNumber == "" {
+		return nil, status.Error(codes.InvalidArgument, "account_number is required")
+	}
+	if req.From == nil || req.To == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+
+	summary, postings, err := h.interestUC.GetAccountInterest(ctx, req.AccountNumber, req.From.AsTime(), req.To.AsTime())
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.GetAccountInterestResponse{
+		Summary:  convertInterestSummaryToProto(summary),
+		Postings: convertInterestPostingsToProto(postings),
+	}, nil
+}
+
+func (h *AccountingHandler) ListInterestPostings(
+	ctx context.Context,
+	req *accountingpb.ListInterestPostingsRequest,
+) (*accountingpb.ListInterestPostingsResponse, error) {
+	filter := &domain.InterestPostingFilter{
+		AccountNumber: req.AccountNumber,
+		Limit:         int(req.Limit),
+		Offset:        int(req.Offset),
+	}
+	if req.Status != nil && *req.Status != "" {
+		postingStatus := domain.InterestPostingStatus(*req.Status)
+		filter.Status = &postingStatus
+	}
+
+	postings, total, err := h.interestUC.ListPostings(ctx, filter)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.ListInterestPostingsResponse{
+		Postings: convertInterestPostingsToProto(postings),
+		Total:    total,
+	}, nil
+}
+
+func (h *AccountingHandler) RunInterest(
+	ctx context.Context,
+	req *accountingpb.RunInterestRequest,
+) (*accountingpb.RunInterestResponse, error) {
+	through := time.Now().UTC().AddDate(0, 0, -1)
+	if req.ThroughDate != nil {
+		through = req.ThroughDate.AsTime()
+	}
+
+	result, err := h.interestUC.RunInterest(ctx, through)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.RunInterestResponse{
+		AccrualCount: int32(result.AccrualCount),
+		PostingCount: int32(result.PostingCount),
+		PostedCount:  int32(result.PostedCount),
+		FailedCount:  int32(result.FailedCount),
+	}, nil
+}
+
+// ===============================
+// CONVERSION HELPERS
+// ===============================
+
+func convertInterestRatePlanToDomain(p *accountingpb.InterestRatePlan) (*domain.InterestRatePlan, error) {
+	annualRate, err := parseAmount("annual_rate", p.AnnualRate)
+	if err != nil {
+		return nil, err
+	}
+	minBalance := parseAmountOrZero(p.MinBalance)
+
+	return &domain.InterestRatePlan{
+		ID:         p.Id,
+		Name:       p.Name,
+		Currency:   p.Currency,
+		Purpose:    convertAccountPurposeToDomain(p.Purpose),
+		AnnualRate: annualRate,
+		Method:     domain.InterestMethod(p.Method),
+		DayCount:   int(p.DayCount),
+		MinBalance: minBalance,
+		IsActive:   p.IsActive,
+	}, nil
+}
+
+func convertInterestRatePlanToProto(p *domain.InterestRatePlan) *accountingpb.InterestRatePlan {
+	return &accountingpb.InterestRatePlan{
+		Id:         p.ID,
+		Name:       p.Name,
+		Currency:   p.Currency,
+		Purpose:    convertAccountPurposeToProto(p.Purpose),
+		AnnualRate: p.AnnualRate.String(),
+		Method:     string(p.Method),
+		DayCount:   int32(p.DayCount),
+		MinBalance: p.MinBalance.String(),
+		IsActive:   p.IsActive,
+		CreatedBy:  p.CreatedBy,
+		CreatedAt:  timestamppb.New(p.CreatedAt),
+		UpdatedAt:  timestamppb.New(p.UpdatedAt),
+	}
+}
+
+func convertInterestPostingsToProto(postings []*domain.InterestPosting) []*accountingpb.InterestPosting {
+	result := make([]*accountingpb.InterestPosting, len(postings))
+	for i, p := range postings {
+		result[i] = &accountingpb.InterestPosting{
+			Id:            p.ID,
+			AccountNumber: p.AccountNumber,
+			Currency:      p.Currency,
+			PeriodFrom:    timestamppb.New(p.PeriodFrom),
+			PeriodTo:      timestamppb.New(p.PeriodTo),
+			AccrualCount:  int32(p.AccrualCount),
+			CarriedIn:     p.CarriedIn.String(),
+			Accrued:       p.Accrued.String(),
+			Amount:        p.Amount.String(),
+			Remainder:     p.Remainder.String(),
+			Status:        string(p.Status),
+			Attempts:      int32(p.Attempts),
+			ReceiptCode:   p.ReceiptCode,
+			LastError:     p.LastError,
+			CreatedAt:     timestamppb.New(p.CreatedAt),
+			PostedAt:      convertOptionalTimeToProto(p.PostedAt),
+		}
+	}
+	return result
+}
+
+func convertInterestSummaryToProto(s *domain.InterestSummary) *accountingpb.InterestSummary {
+	if s == nil {
+		return nil
+	}
+	return &accountingpb.InterestSummary{
+		Accrued:     s.Accrued.String(),
+		Paid:        s.Paid.String(),
+		Outstanding: s.Outstanding.String(),
+	}
+}
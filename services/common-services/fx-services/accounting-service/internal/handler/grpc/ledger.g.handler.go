@@ -32,7 +32,14 @@ type AccountingHandler struct {
     feeUC       *usecase. TransactionFeeUsecase
     feeRuleUC   *usecase.TransactionFeeRuleUsecase
     agentUC     usecase. AgentUsecase
+    payoutUC    *usecase.CommissionPayoutUsecase
+    limitUC     *usecase.TransactionLimitUsecase
+    scheduleUC  *usecase.ScheduledTransferUsecase
+    interestUC  *usecase.InterestUsecase
+    restrictionUC *usecase.AccountRestrictionUsecase
+    demoUC      *usecase.DemoAccountUsecase
     approvalUC  *usecase. TransactionApprovalUsecase  // ✅ NEW
+    reconUC     *usecase.ReconciliationUsecase
 
     // Infrastructure
     redisClient *redis.Client
@@ -47,7 +54,14 @@ func NewAccountingHandler(
     feeUC *usecase. TransactionFeeUsecase,
     feeRuleUC *usecase.TransactionFeeRuleUsecase,
     agentUC usecase. AgentUsecase,
+    payoutUC *usecase.CommissionPayoutUsecase,
+    limitUC *usecase.TransactionLimitUsecase,
+    scheduleUC *usecase.ScheduledTransferUsecase,
+    interestUC *usecase.InterestUsecase,
+    restrictionUC *usecase.AccountRestrictionUsecase,
+    demoUC *usecase.DemoAccountUsecase,
     approvalUC *usecase. TransactionApprovalUsecase,  // ✅ NEW
+    reconUC *usecase.ReconciliationUsecase,
     redisClient *redis.Client,
 ) *AccountingHandler {
     return &AccountingHandler{
@@ -59,7 +73,14 @@ func NewAccountingHandler(
         feeUC:       feeUC,
         feeRuleUC:   feeRuleUC,
         agentUC:     agentUC,
+        payoutUC:    payoutUC,
+        limitUC:     limitUC,
+        scheduleUC:  scheduleUC,
+        interestUC:  interestUC,
+        restrictionUC: restrictionUC,
+        demoUC:      demoUC,
         approvalUC:  approvalUC,  // ✅ NEW
+        reconUC:     reconUC,
         redisClient: redisClient,
     }
 }
@@ -262,7 +283,11 @@ func (h *AccountingHandler) UpdateAccount(
 		account.IsLocked = *req.IsLocked
 	}
 	if req.OverdraftLimit != nil {
-		account.OverdraftLimit = *req.OverdraftLimit
+		overdraftLimit, err := parseAmount("overdraft_limit", *req.OverdraftLimit)
+		if err != nil {
+			return nil, err
+		}
+		account.OverdraftLimit = overdraftLimit
 	}
 
 	// Begin transaction
@@ -0,0 +1,122 @@
+package hgrpc
+
+import (
+	"context"
+	"strings"
+
+	"accounting-service/internal/domain"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ===============================
+// ACCOUNTING PERIODS
+// ===============================
+
+func (h *AccountingHandler) CloseAccountingPeriod(
+	ctx context.Context,
+	req *accountingpb.CloseAccountingPeriodRequest,
+) (*accountingpb.CloseAccountingPeriodResponse, error) {
+	if req.PeriodStart == nil || req.PeriodEnd == nil {
+		return nil, status.Error(codes.InvalidArgument, "period_start and period_end are required")
+	}
+	if req.ClosedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "closed_by is required")
+	}
+
+	period, err := h.statementUC.CloseAccountingPeriod(ctx, &domain.CloseAccountingPeriodRequest{
+		PeriodStart: req.PeriodStart.AsTime(),
+		PeriodEnd:   req.PeriodEnd.AsTime(),
+		ClosedBy:    req.ClosedBy,
+		Note:        req.Note,
+	})
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.CloseAccountingPeriodResponse{
+		Period: convertAccountingPeriodToProto(period),
+	}, nil
+}
+
+func (h *AccountingHandler) GetTrialBalance(
+	ctx context.Context,
+	req *accountingpb.GetTrialBalanceRequest,
+) (*accountingpb.GetTrialBalanceResponse, error) {
+	tb, err := h.statementUC.GetTrialBalance(ctx, &domain.TrialBalanceRequest{
+		PeriodID: req.PeriodId,
+		From:     convertOptionalTimestamp(req.From),
+		To:       convertOptionalTimestamp(req.To),
+		Scope:    convertReportScopeToDomain(req.AccountType, req.Currency, req.Purpose, nil),
+	})
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.GetTrialBalanceResponse{
+		Lines:  convertTrialBalanceLinesToProto(tb.Lines),
+		Totals: convertTrialBalanceTotalsToProto(tb.Totals),
+		From:   timestamppb.New(tb.From),
+		To:     timestamppb.New(tb.To),
+		Period: convertAccountingPeriodToProto(tb.Period),
+	}, nil
+}
+
+func (h *AccountingHandler) GetGeneralLedger(
+	ctx context.Context,
+	req *accountingpb.GetGeneralLedgerRequest,
+) (*accountingpb.GetGeneralLedgerResponse, error) {
+	if req.From == nil || req.To == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+
+	gl, err := h.statementUC.GetGeneralLedger(ctx, &domain.GeneralLedgerRequest{
+		From:   req.From.AsTime(),
+		To:     req.To.AsTime(),
+		Scope:  convertReportScopeToDomain(req.AccountType, req.Currency, req.Purpose, req.AccountNumber),
+		Limit:  int(req.Limit),
+		Offset: int(req.Offset),
+	})
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.GetGeneralLedgerResponse{
+		Sections:     convertTrialBalanceLinesToProto(gl.Sections),
+		Entries:      convertGeneralLedgerEntriesToProto(gl.Entries),
+		TotalEntries: int32(gl.TotalEntries),
+		From:         timestamppb.New(gl.From),
+		To:           timestamppb.New(gl.To),
+	}, nil
+}
+
+// convertReportScopeToDomain drops unspecified enums and empty strings
+func convertReportScopeToDomain(
+	accountType *accountingpb.AccountType,
+	currency *string,
+	purpose *accountingpb.AccountPurpose,
+	accountNumber *string,
+) domain.ReportScope {
+	var scope domain.ReportScope
+
+	if accountType != nil && *accountType != accountingpb.AccountType_ACCOUNT_TYPE_UNSPECIFIED {
+		t := convertAccountTypeToDomain(*accountType)
+		scope.AccountType = &t
+	}
+	if currency != nil && *currency != "" {
+		c := strings.ToUpper(*currency)
+		scope.Currency = &c
+	}
+	if purpose != nil && *purpose != accountingpb.AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED {
+		p := convertAccountPurposeToDomain(*purpose)
+		scope.Purpose = &p
+	}
+	if accountNumber != nil && *accountNumber != "" {
+		scope.AccountNumber = accountNumber
+	}
+
+	return scope
+}
@@ -0,0 +1,79 @@
+package hgrpc
+
+import (
+	"context"
+	"strings"
+
+	"accounting-service/internal/domain"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ===============================
+// RECONCILIATION
+// ===============================
+
+func (h *AccountingHandler) RunReconciliation(
+	ctx context.Context,
+	req *accountingpb.RunReconciliationRequest,
+) (*accountingpb.RunReconciliationResponse, error) {
+	if req.TriggeredBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "triggered_by is required")
+	}
+
+	domainReq := &domain.RunReconciliationRequest{
+		TriggerType: domain.ReconciliationTriggerManual,
+		TriggeredBy: &req.TriggeredBy,
+	}
+	if req.Currency != nil && *req.Currency != "" {
+		currency := strings.ToUpper(*req.Currency)
+		domainReq.Currency = &currency
+	}
+	if req.AccountType != nil && *req.AccountType != accountingpb.AccountType_ACCOUNT_TYPE_UNSPECIFIED {
+		accountType := convertAccountTypeToDomain(*req.AccountType)
+		domainReq.AccountType = &accountType
+	}
+	if req.JournalsFrom != nil {
+		from := req.JournalsFrom.AsTime()
+		domainReq.JournalsFrom = &from
+	}
+
+	run, err := h.reconUC.RunReconciliation(ctx, domainReq)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.RunReconciliationResponse{
+		Run: convertReconciliationRunToProto(run),
+	}, nil
+}
+
+func (h *AccountingHandler) ListReconciliationBreaks(
+	ctx context.Context,
+	req *accountingpb.ListReconciliationBreaksRequest,
+) (*accountingpb.ListReconciliationBreaksResponse, error) {
+	filter := &domain.ReconciliationBreakFilter{
+		RunID:    req.RunId,
+		Currency: req.Currency,
+		Resolved: req.Resolved,
+		Limit:    int(req.Limit),
+		Offset:   int(req.Offset),
+	}
+	if req.BreakType != nil && *req.BreakType != "" {
+		breakType := domain.BreakType(*req.BreakType)
+		filter.BreakType = &breakType
+	}
+
+	breaks, total, run, err := h.reconUC.ListReconciliationBreaks(ctx, filter)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.ListReconciliationBreaksResponse{
+		Breaks: convertReconciliationBreaksToProto(breaks),
+		Total:  int32(total),
+		Run:    convertReconciliationRunToProto(run),
+	}, nil
+}
@@ -0,0 +1,233 @@
+package hgrpc
+
+import (
+	"context"
+	"time"
+
+	"accounting-service/internal/domain"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ===============================
+// SCHEDULED TRANSFERS
+// ===============================
+
+func (h *AccountingHandler) CreateScheduledTransfer(
+	ctx context.Context,
+	req *accountingpb.CreateScheduledTransferRequest,
+) (*accountingpb.CreateScheduledTransferResponse, error) {
+	if req.OwnerType == accountingpb.OwnerType_OWNER_TYPE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "owner_type is required")
+	}
+	if req.OwnerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner_id is required")
+	}
+	if req.FromAccountNumber == "" || req.ToAccountNumber == "" {
+		return nil, status.Error(codes.InvalidArgument, "from_account_number and to_account_number are required")
+	}
+
+	amount, err := parsePositiveAmount("amount", req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &domain.ScheduledTransfer{
+		OwnerType:         convertOwnerTypeToDomain(req.OwnerType),
+		OwnerID:           req.OwnerId,
+		FromAccountNumber: req.FromAccountNumber,
+		ToAccountNumber:   req.ToAccountNumber,
+		Amount:            amount,
+		AccountType:       convertAccountTypeToDomain(req.AccountType),
+		Description:       req.Description,
+		CronExpr:          req.CronExpr,
+		IntervalSeconds:   req.IntervalSeconds,
+		StartAt:           time.Now(),
+		EndAt:             convertOptionalTimestamp(req.EndAt),
+		MaxRuns:           int32PtrToInt(req.MaxRuns),
+		MaxRetries:        domain.DefaultScheduleMaxRetries,
+	}
+	if req.StartAt != nil {
+		schedule.StartAt = req.StartAt.AsTime()
+	}
+	if req.MaxRetries != nil {
+		schedule.MaxRetries = int(*req.MaxRetries)
+	}
+
+	created, err := h.scheduleUC.CreateScheduledTransfer(ctx, schedule)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.CreateScheduledTransferResponse{
+		Schedule: convertScheduledTransferToProto(created),
+	}, nil
+}
+
+func (h *AccountingHandler) GetScheduledTransfer(
+	ctx context.Context,
+	req *accountingpb.GetScheduledTransferRequest,
+) (*accountingpb.GetScheduledTransferResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	schedule, runs, err := h.scheduleUC.GetScheduledTransfer(
+		ctx,
+		req.Id,
+		convertOptionalOwnerTypeToDomain(req.OwnerType),
+		req.OwnerId,
+	)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	resp := &accountingpb.GetScheduledTransferResponse{
+		Schedule:   convertScheduledTransferToProto(schedule),
+		RecentRuns: make([]*accountingpb.ScheduledTransferRun, len(runs)),
+	}
+	for i, run := range runs {
+		resp.RecentRuns[i] = convertScheduledTransferRunToProto(run)
+	}
+
+	return resp, nil
+}
+
+func (h *AccountingHandler) ListScheduledTransfers(
+	ctx context.Context,
+	req *accountingpb.ListScheduledTransfersRequest,
+) (*accountingpb.ListScheduledTransfersResponse, error) {
+	filter := &domain.ScheduledTransferFilter{
+		OwnerType:     convertOptionalOwnerTypeToDomain(req.OwnerType),
+		OwnerID:       req.OwnerId,
+		AccountNumber: req.AccountNumber,
+		Limit:         int(req.Limit),
+		Offset:        int(req.Offset),
+	}
+	if req.Status != nil && *req.Status != accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_UNSPECIFIED {
+		s := convertScheduledTransferStatusToDomain(*req.Status)
+		filter.Status = &s
+	}
+
+	schedules, total, err := h.scheduleUC.ListScheduledTransfers(ctx, filter)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	result := make([]*accountingpb.ScheduledTransfer, len(schedules))
+	for i, schedule := range schedules {
+		result[i] = convertScheduledTransferToProto(schedule)
+	}
+
+	return &accountingpb.ListScheduledTransfersResponse{
+		Schedules: result,
+		Total:     total,
+	}, nil
+}
+
+func (h *AccountingHandler) CancelScheduledTransfer(
+	ctx context.Context,
+	req *accountingpb.CancelScheduledTransferRequest,
+) (*accountingpb.CancelScheduledTransferResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.CancelledBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "cancelled_by is required")
+	}
+
+	schedule, err := h.scheduleUC.CancelScheduledTransfer(
+		ctx,
+		req.Id,
+		convertOptionalOwnerTypeToDomain(req.OwnerType),
+		req.OwnerId,
+		req.CancelledBy,
+	)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.CancelScheduledTransferResponse{
+		Schedule: convertScheduledTransferToProto(schedule),
+	}, nil
+}
+
+// ===============================
+// CONVERSION HELPERS
+// ===============================
+
+func convertScheduledTransferToProto(s *domain.ScheduledTransfer) *accountingpb.ScheduledTransfer {
+	return &accountingpb.ScheduledTransfer{
+		Id:                s.ID,
+		OwnerType:         convertOwnerTypeToProto(s.OwnerType),
+		OwnerId:           s.OwnerID,
+		FromAccountNumber: s.FromAccountNumber,
+		ToAccountNumber:   s.ToAccountNumber,
+		Amount:            s.Amount.String(),
+		Currency:          s.Currency,
+		AccountType:       convertAccountTypeToProto(s.AccountType),
+		Description:       s.Description,
+		CronExpr:          s.CronExpr,
+		IntervalSeconds:   s.IntervalSeconds,
+		StartAt:           timestamppb.New(s.StartAt),
+		EndAt:             convertOptionalTimeToProto(s.EndAt),
+		MaxRuns:           intPtrToInt32(s.MaxRuns),
+		MaxRetries:        int32(s.MaxRetries),
+		Status:            convertScheduledTransferStatusToProto(s.Status),
+		NextRunAt:         convertOptionalTimeToProto(s.NextRunAt),
+		Attempts:          int32(s.Attempts),
+		RunCount:          int32(s.RunCount),
+		LastRunAt:         convertOptionalTimeToProto(s.LastRunAt),
+		LastReceiptCode:   s.LastReceiptCode,
+		LastError:         s.LastError,
+		CancelledBy:       s.CancelledBy,
+		CancelledAt:       convertOptionalTimeToProto(s.CancelledAt),
+		CreatedAt:         timestamppb.New(s.CreatedAt),
+		UpdatedAt:         timestamppb.New(s.UpdatedAt),
+	}
+}
+
+func convertScheduledTransferRunToProto(r *domain.ScheduledTransferRun) *accountingpb.ScheduledTransferRun {
+	runStatus := accountingpb.ScheduledTransferRunStatus_SCHEDULED_TRANSFER_RUN_STATUS_SKIPPED
+	if r.Status == domain.ScheduledTransferRunSucceeded {
+		runStatus = accountingpb.ScheduledTransferRunStatus_SCHEDULED_TRANSFER_RUN_STATUS_SUCCEEDED
+	}
+
+	return &accountingpb.ScheduledTransferRun{
+		Id:           r.ID,
+		ScheduleId:   r.ScheduleID,
+		OccurrenceAt: timestamppb.New(r.OccurrenceAt),
+		Status:       runStatus,
+		Attempts:     int32(r.Attempts),
+		ReceiptCode:  r.ReceiptCode,
+		ErrorMessage: r.ErrorMessage,
+		CreatedAt:    timestamppb.New(r.CreatedAt),
+	}
+}
+
+func convertScheduledTransferStatusToProto(s domain.ScheduledTransferStatus) accountingpb.ScheduledTransferStatus {
+	switch s {
+	case domain.ScheduledTransferActive:
+		return accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_ACTIVE
+	case domain.ScheduledTransferCompleted:
+		return accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_COMPLETED
+	case domain.ScheduledTransferCancelled:
+		return accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_CANCELLED
+	default:
+		return accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_UNSPECIFIED
+	}
+}
+
+func convertScheduledTransferStatusToDomain(s accountingpb.ScheduledTransferStatus) domain.ScheduledTransferStatus {
+	switch s {
+	case accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_COMPLETED:
+		return domain.ScheduledTransferCompleted
+	case accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_CANCELLED:
+		return domain.ScheduledTransferCancelled
+	default:
+		return domain.ScheduledTransferActive
+	}
+}
@@ -0,0 +1,98 @@
+package hgrpc
+
+import (
+	"context"
+
+	"accounting-service/internal/domain"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ===============================
+// SUB-ACCOUNTS
+// ===============================
+
+func (h *AccountingHandler) CreateSubAccount(
+	ctx context.Context,
+	req *accountingpb.CreateSubAccountRequest,
+) (*accountingpb.CreateSubAccountResponse, error) {
+	if req.ParentAccountNumber == "" || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "parent_account_number and name are required")
+	}
+	if req.OwnerType == accountingpb.OwnerType_OWNER_TYPE_UNSPECIFIED || req.OwnerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner_type and owner_id are required")
+	}
+
+	subReq := &domain.CreateSubAccountRequest{
+		ParentAccountNumber: req.ParentAccountNumber,
+		Name:                req.Name,
+		OwnerType:           convertOwnerTypeToDomain(req.OwnerType),
+		OwnerID:             req.OwnerId,
+	}
+	if req.Purpose != accountingpb.AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED {
+		subReq.Purpose = convertAccountPurposeToDomain(req.Purpose)
+	}
+
+	account, err := h.accountUC.CreateSubAccount(ctx, subReq)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.CreateSubAccountResponse{
+		Account: convertAccountToProto(account),
+	}, nil
+}
+
+func (h *AccountingHandler) ListSubAccounts(
+	ctx context.Context,
+	req *accountingpb.ListSubAccountsRequest,
+) (*accountingpb.ListSubAccountsResponse, error) {
+	if req.ParentAccountNumber == "" {
+		return nil, status.Error(codes.InvalidArgument, "parent_account_number is required")
+	}
+	if req.OwnerType == accountingpb.OwnerType_OWNER_TYPE_UNSPECIFIED || req.OwnerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner_type and owner_id are required")
+	}
+
+	result, err := h.accountUC.ListSubAccounts(
+		ctx,
+		req.ParentAccountNumber,
+		convertOwnerTypeToDomain(req.OwnerType),
+		req.OwnerId,
+		req.IncludeInactive,
+	)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	subAccounts := make([]*accountingpb.SubAccount, len(result.Accounts))
+	for i, a := range result.Accounts {
+		subAccounts[i] = convertSubAccountToProto(a)
+	}
+
+	return &accountingpb.ListSubAccountsResponse{
+		Parent:                   convertSubAccountToProto(result.Parent),
+		SubAccounts:              subAccounts,
+		RolledUpBalance:          result.RolledUpBalance.String(),
+		RolledUpAvailableBalance: result.RolledUpAvailableBalance.String(),
+	}, nil
+}
+
+// ===============================
+// CONVERSION HELPERS
+// ===============================
+
+func convertSubAccountToProto(a *domain.Account) *accountingpb.SubAccount {
+	sub := &accountingpb.SubAccount{
+		Account:          convertAccountToProto(a),
+		Balance:          "0",
+		AvailableBalance: "0",
+	}
+	if a.Balance != nil {
+		sub.Balance = a.Balance.Balance.String()
+		sub.AvailableBalance = a.Balance.AvailableBalance.String()
+	}
+	return sub
+}
@@ -0,0 +1,93 @@
+package hgrpc
+
+import (
+	"context"
+
+	"accounting-service/internal/domain"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ===============================
+// BATCH TRADE SETTLEMENT
+// ===============================
+
+// SettleTrades settles a batch of trades. Malformed trades fail individually
+// in the response instead of rejecting the whole batch.
+func (h *AccountingHandler) SettleTrades(
+	ctx context.Context,
+	req *accountingpb.SettleTradesRequest,
+) (*accountingpb.SettleTradesResponse, error) {
+	if len(req.Trades) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "trades are required")
+	}
+	if len(req.Trades) > domain.MaxTradesPerSettlement {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d trades per batch", domain.MaxTradesPerSettlement)
+	}
+	if req.CreatedByExternalId == "" {
+		return nil, status.Error(codes.InvalidArgument, "created_by_external_id is required")
+	}
+
+	settleReq := &domain.SettleTradesRequest{
+		BatchID:             req.BatchId,
+		Trades:              make([]*domain.SettleTrade, len(req.Trades)),
+		CreatedByExternalID: req.CreatedByExternalId,
+	}
+	if req.AccountType != accountingpb.AccountType_ACCOUNT_TYPE_UNSPECIFIED {
+		settleReq.AccountType = convertAccountTypeToDomain(req.AccountType)
+	}
+	if req.CreatedByType != accountingpb.OwnerType_OWNER_TYPE_UNSPECIFIED {
+		settleReq.CreatedByType = convertOwnerTypeToDomain(req.CreatedByType)
+	}
+	for i, t := range req.Trades {
+		settleReq.Trades[i] = &domain.SettleTrade{
+			TradeID:       t.TradeId,
+			AccountNumber: t.AccountNumber,
+			Result:        domain.TradeResult(t.Result),
+			Amount:        parseAmountOrZero(t.Amount), // Zero fails the trade's validation
+			TradeType:     t.TradeType,
+		}
+	}
+
+	summary, err := h.txUC.SettleTrades(ctx, settleReq)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	results := make([]*accountingpb.TradeSettlementResult, len(summary.Trades))
+	for i, s := range summary.Trades {
+		results[i] = convertTradeSettlementToProto(s)
+	}
+
+	return &accountingpb.SettleTradesResponse{
+		Results:        results,
+		Settled:        int32(summary.Settled),
+		Duplicates:     int32(summary.Duplicates),
+		Failed:         int32(summary.Failed),
+		JournalsPosted: int32(summary.JournalsPosted),
+	}, nil
+}
+
+// ===============================
+// CONVERSION HELPERS
+// ===============================
+
+func convertTradeSettlementToProto(s *domain.TradeSettlement) *accountingpb.TradeSettlementResult {
+	result := &accountingpb.TradeSettlementResult{
+		TradeId:       s.TradeID,
+		AccountNumber: s.AccountNumber,
+		Result:        string(s.Result),
+		Amount:        s.Amount.String(),
+		Status:        string(s.Status),
+		JournalId:     s.JournalID,
+		ReceiptCode:   s.ReceiptCode,
+		BalanceAfter:  decimalPtrToString(s.BalanceAfter),
+		SettledAt:     convertOptionalTimeToProto(s.SettledAt),
+	}
+	if s.Error != "" {
+		result.Error = &s.Error
+	}
+	return result
+}
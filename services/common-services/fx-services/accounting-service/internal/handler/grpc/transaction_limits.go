@@ -0,0 +1,290 @@
+package hgrpc
+
+import (
+	"context"
+	"log"
+
+	"accounting-service/internal/domain"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ===============================
+// TRANSACTION LIMITS
+// ===============================
+
+func (h *AccountingHandler) CreateLimitProfile(
+	ctx context.Context,
+	req *accountingpb.CreateLimitProfileRequest,
+) (*accountingpb.CreateLimitProfileResponse, error) {
+	if req.Profile == nil {
+		return nil, status.Error(codes.InvalidArgument, "profile is required")
+	}
+	if req.CreatedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "created_by is required")
+	}
+
+	profile, err := convertLimitProfileToDomain(req.Profile)
+	if err != nil {
+		return nil, err
+	}
+	profile.CreatedBy = req.CreatedBy
+
+	created, err := h.limitUC.CreateLimitProfile(ctx, profile)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.CreateLimitProfileResponse{
+		Profile: convertLimitProfileToProto(created),
+	}, nil
+}
+
+func (h *AccountingHandler) UpdateLimitProfile(
+	ctx context.Context,
+	req *accountingpb.UpdateLimitProfileRequest,
+) (*accountingpb.UpdateLimitProfileResponse, error) {
+	if req.Profile == nil || req.Profile.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "profile.id is required")
+	}
+	if req.UpdatedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "updated_by is required")
+	}
+
+	profile, err := convertLimitProfileToDomain(req.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := h.limitUC.UpdateLimitProfile(ctx, profile)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	log.Printf("[LIMITS] Profile %d updated by %s", updated.ID, req.UpdatedBy)
+
+	return &accountingpb.UpdateLimitProfileResponse{
+		Profile: convertLimitProfileToProto(updated),
+	}, nil
+}
+
+func (h *AccountingHandler) ListLimitProfiles(
+	ctx context.Context,
+	req *accountingpb.ListLimitProfilesRequest,
+) (*accountingpb.ListLimitProfilesResponse, error) {
+	filter := &domain.LimitProfileFilter{
+		KYCTier:       req.KycTier,
+		AccountNumber: req.AccountNumber,
+		Currency:      req.Currency,
+		ActiveOnly:    req.ActiveOnly,
+	}
+	if req.OwnerType != nil && *req.OwnerType != accountingpb.OwnerType_OWNER_TYPE_UNSPECIFIED {
+		ownerType := convertOwnerTypeToDomain(*req.OwnerType)
+		filter.OwnerType = &ownerType
+	}
+
+	profiles, err := h.limitUC.ListLimitProfiles(ctx, filter)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	result := make([]*accountingpb.LimitProfile, len(profiles))
+	for i, profile := range profiles {
+		result[i] = convertLimitProfileToProto(profile)
+	}
+
+	return &accountingpb.ListLimitProfilesResponse{Profiles: result}, nil
+}
+
+func (h *AccountingHandler) SetOwnerKYCTier(
+	ctx context.Context,
+	req *accountingpb.SetOwnerKYCTierRequest,
+) (*accountingpb.SetOwnerKYCTierResponse, error) {
+	if req.OwnerType == accountingpb.OwnerType_OWNER_TYPE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "owner_type is required")
+	}
+
+	tier, err := h.limitUC.SetOwnerKYCTier(ctx, &domain.OwnerKYCTier{
+		OwnerType: convertOwnerTypeToDomain(req.OwnerType),
+		OwnerID:   req.OwnerId,
+		KYCTier:   req.KycTier,
+		SetBy:     req.SetBy,
+	})
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.SetOwnerKYCTierResponse{
+		OwnerType: req.OwnerType,
+		OwnerId:   tier.OwnerID,
+		KycTier:   tier.KYCTier,
+		UpdatedAt: timestamppb.New(tier.UpdatedAt),
+	}, nil
+}
+
+func (h *AccountingHandler) GetAccountLimits(
+	ctx context.Context,
+	req *accountingpb.GetAccountLimitsRequest,
+) (*accountingpb.GetAccountLimitsResponse, error) {
+	if req.AccountNumber == "" {
+		return nil, status.Error(codes.InvalidArgument, "account_number is required")
+	}
+
+	limits, err := h.limitUC.GetAccountLimits(ctx, req.AccountNumber)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	resp := &accountingpb.GetAccountLimitsResponse{
+		AccountNumber:   limits.AccountNumber,
+		Currency:        limits.Currency,
+		KycTier:         limits.KYCTier,
+		MaxSingleAmount: decimalPtrToString(limits.MaxSingleAmount),
+		Usage:           make([]*accountingpb.LimitUsage, len(limits.Usage)),
+	}
+	if limits.Profile != nil {
+		resp.Profile = convertLimitProfileToProto(limits.Profile)
+	}
+	for i, usage := range limits.Usage {
+		resp.Usage[i] = convertLimitUsageToProto(usage)
+	}
+
+	return resp, nil
+}
+
+// ===============================
+// CONVERSION HELPERS
+// ===============================
+
+func convertLimitProfileToDomain(p *accountingpb.LimitProfile) (*domain.LimitProfile, error) {
+	profile := &domain.LimitProfile{
+		ID:            p.Id,
+		Name:          p.Name,
+		KYCTier:       p.KycTier,
+		AccountNumber: p.AccountNumber,
+		Currency:      p.Currency,
+		DailyCount:    int32PtrToInt(p.DailyCount),
+		WeeklyCount:   int32PtrToInt(p.WeeklyCount),
+		MonthlyCount:  int32PtrToInt(p.MonthlyCount),
+		Priority:      int(p.Priority),
+		IsActive:      p.IsActive,
+	}
+	if p.OwnerType != nil && *p.OwnerType != accountingpb.OwnerType_OWNER_TYPE_UNSPECIFIED {
+		ownerType := convertOwnerTypeToDomain(*p.OwnerType)
+		profile.OwnerType = &ownerType
+	}
+
+	amounts := []struct {
+		field string
+		value *string
+		dest  **decimal.Decimal
+	}{
+		{"max_single_amount", p.MaxSingleAmount, &profile.MaxSingleAmount},
+		{"daily_amount", p.DailyAmount, &profile.DailyAmount},
+		{"weekly_amount", p.WeeklyAmount, &profile.WeeklyAmount},
+		{"monthly_amount", p.MonthlyAmount, &profile.MonthlyAmount},
+	}
+	for _, a := range amounts {
+		if a.value == nil || *a.value == "" {
+			continue
+		}
+		amount, err := parseAmount(a.field, *a.value)
+		if err != nil {
+			return nil, err
+		}
+		*a.dest = &amount
+	}
+
+	return profile, nil
+}
+
+func convertLimitProfileToProto(p *domain.LimitProfile) *accountingpb.LimitProfile {
+	if p == nil {
+		return nil
+	}
+
+	profile := &accountingpb.LimitProfile{
+		Id:              p.ID,
+		Name:            p.Name,
+		KycTier:         p.KYCTier,
+		AccountNumber:   p.AccountNumber,
+		Currency:        p.Currency,
+		MaxSingleAmount: decimalPtrToString(p.MaxSingleAmount),
+		DailyAmount:     decimalPtrToString(p.DailyAmount),
+		WeeklyAmount:    decimalPtrToString(p.WeeklyAmount),
+		MonthlyAmount:   decimalPtrToString(p.MonthlyAmount),
+		DailyCount:      intPtrToInt32(p.DailyCount),
+		WeeklyCount:     intPtrToInt32(p.WeeklyCount),
+		MonthlyCount:    intPtrToInt32(p.MonthlyCount),
+		Priority:        int32(p.Priority),
+		IsActive:        p.IsActive,
+		CreatedBy:       p.CreatedBy,
+	}
+	if p.OwnerType != nil {
+		ownerType := convertOwnerTypeToProto(*p.OwnerType)
+		profile.OwnerType = &ownerType
+	}
+	if !p.CreatedAt.IsZero() {
+		profile.CreatedAt = timestamppb.New(p.CreatedAt)
+	}
+	if !p.UpdatedAt.IsZero() {
+		profile.UpdatedAt = timestamppb.New(p.UpdatedAt)
+	}
+
+	return profile
+}
+
+func convertLimitUsageToProto(u *domain.LimitUsage) *accountingpb.LimitUsage {
+	return &accountingpb.LimitUsage{
+		Window:          convertLimitWindowToProto(u.Window),
+		WindowStart:     timestamppb.New(u.WindowStart),
+		ResetsAt:        timestamppb.New(u.ResetsAt),
+		UsedAmount:      u.Amount.String(),
+		UsedCount:       int32(u.Count),
+		AmountCap:       decimalPtrToString(u.AmountCap),
+		CountCap:        intPtrToInt32(u.CountCap),
+		RemainingAmount: decimalPtrToString(u.RemainingAmount()),
+		RemainingCount:  intPtrToInt32(u.RemainingCount()),
+	}
+}
+
+func convertLimitWindowToProto(w domain.LimitWindow) accountingpb.LimitWindow {
+	switch w {
+	case domain.LimitWindowDaily:
+		return accountingpb.LimitWindow_LIMIT_WINDOW_DAILY
+	case domain.LimitWindowWeekly:
+		return accountingpb.LimitWindow_LIMIT_WINDOW_WEEKLY
+	case domain.LimitWindowMonthly:
+		return accountingpb.LimitWindow_LIMIT_WINDOW_MONTHLY
+	default:
+		return accountingpb.LimitWindow_LIMIT_WINDOW_UNSPECIFIED
+	}
+}
+
+func decimalPtrToString(d *decimal.Decimal) *string {
+	if d == nil {
+		return nil
+	}
+	s := d.String()
+	return &s
+}
+
+func intPtrToInt32(i *int) *int32 {
+	if i == nil {
+		return nil
+	}
+	v := int32(*i)
+	return &v
+}
+
+func int32PtrToInt(i *int32) *int {
+	if i == nil {
+		return nil
+	}
+	v := int(*i)
+	return &v
+}
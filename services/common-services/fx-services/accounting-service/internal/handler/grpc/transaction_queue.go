@@ -0,0 +1,84 @@
+package hgrpc
+
+import (
+	"context"
+
+	"accounting-service/internal/domain"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ===============================
+// TRANSACTION QUEUE
+// ===============================
+
+func (h *AccountingHandler) ListQueuedTransactions(
+	ctx context.Context,
+	req *accountingpb.ListQueuedTransactionsRequest,
+) (*accountingpb.ListQueuedTransactionsResponse, error) {
+	filter := &domain.QueuedTransactionFilter{
+		ReceiptCode: req.ReceiptCode,
+		Limit:       int(req.Limit),
+		Offset:      int(req.Offset),
+	}
+	if req.Status != nil && *req.Status != accountingpb.QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_UNSPECIFIED {
+		queuedStatus := convertQueuedStatusToDomain(*req.Status)
+		filter.Status = &queuedStatus
+	}
+
+	tasks, total, err := h.txUC.ListQueuedTransactions(ctx, filter)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.ListQueuedTransactionsResponse{
+		Transactions: convertQueuedTransactionsToProto(tasks),
+		Total:        total,
+	}, nil
+}
+
+func (h *AccountingHandler) RedriveDeadLetterTransactions(
+	ctx context.Context,
+	req *accountingpb.RedriveDeadLetterTransactionsRequest,
+) (*accountingpb.RedriveDeadLetterTransactionsResponse, error) {
+	if len(req.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ids are required")
+	}
+	if req.RequestedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "requested_by is required")
+	}
+
+	tasks, err := h.txUC.RedriveDeadLetterTransactions(ctx, req.Ids, req.RequestedBy)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.RedriveDeadLetterTransactionsResponse{
+		Transactions: convertQueuedTransactionsToProto(tasks),
+		Redriven:     int32(len(tasks)),
+	}, nil
+}
+
+func (h *AccountingHandler) SkipDeadLetterTransactions(
+	ctx context.Context,
+	req *accountingpb.SkipDeadLetterTransactionsRequest,
+) (*accountingpb.SkipDeadLetterTransactionsResponse, error) {
+	if len(req.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ids are required")
+	}
+	if req.RequestedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "requested_by is required")
+	}
+
+	tasks, err := h.txUC.SkipDeadLetterTransactions(ctx, req.Ids, req.RequestedBy)
+	if err != nil {
+		return nil, handleUsecaseError(err)
+	}
+
+	return &accountingpb.SkipDeadLetterTransactionsResponse{
+		Transactions: convertQueuedTransactionsToProto(tasks),
+		Skipped:      int32(len(tasks)),
+	}, nil
+}
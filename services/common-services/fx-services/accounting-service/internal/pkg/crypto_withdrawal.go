@@ -5,13 +5,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math"
-	"strconv"
 	"time"
 
 	"accounting-service/internal/domain"
 
 	cryptopb "x/shared/genproto/shared/accounting/cryptopb"
+
+	"github.com/shopspring/decimal"
 )
 
 
@@ -19,7 +19,7 @@ import (
 func (uc *TransactionFeeCalculator) getNetworkFee(
 	ctx context.Context,
 	currency string,
-	amount float64,
+	amount decimal.Decimal,
 	toAddress string,
 ) (*domain.NetworkFeeCalculation, error) {
 	
@@ -28,7 +28,7 @@ func (uc *TransactionFeeCalculator) getNetworkFee(
 	}
 
 	// Check cache first (network fees change but not too frequently)
-	cacheKey := fmt.Sprintf("network_fee:%s: %.0f", currency, amount)
+	cacheKey := fmt.Sprintf("network_fee:%s: %s", currency, amount.StringFixed(0))
 	if val, err := uc.redisClient.Get(ctx, cacheKey).Result(); err == nil {
 		var cached domain.NetworkFeeCalculation
 		if jsonErr := json.Unmarshal([]byte(val), &cached); jsonErr == nil {
@@ -49,7 +49,7 @@ func (uc *TransactionFeeCalculator) getNetworkFee(
 	resp, err := uc.cryptoClient.TransactionClient.EstimateNetworkFee(ctx, &cryptopb.EstimateNetworkFeeRequest{
 		Chain:     chain,
 		Asset:     asset,
-		Amount:    fmt.Sprintf("%d", amountInSmallestUnit),
+		Amount:    amountInSmallestUnit.String(),
 		ToAddress: toAddress,
 	})
 	if err != nil {
@@ -110,58 +110,50 @@ func (uc *TransactionFeeCalculator) getCurrencyChainMapping(currency string) (cr
 }
 
 // ✅ NEW: Convert to smallest unit (satoshis, SUN, wei)
-func (uc *TransactionFeeCalculator) convertToSmallestUnit(amount float64, currency string) int64 {
-	decimals := map[string]int{
-		"BTC":  8,  // satoshis
-		"TRX":  6,  // SUN
-		"USDT":  6,  // SUN (on TRON)
-		"ETH":  18, // wei
-		"USDC": 6,  // (on Ethereum)
-	}
-
-	if dec, ok := decimals[currency]; ok {
-		multiplier := math.Pow(10, float64(dec))
-		return int64(amount * multiplier)
-	}
-
-	return int64(amount * 1e8) // Default to 8 decimals
+func (uc *TransactionFeeCalculator) convertToSmallestUnit(amount decimal.Decimal, currency string) decimal.Decimal {
+	return amount.Shift(chainDecimals(currency)).Truncate(0)
 }
 
 // ✅ NEW: Convert from smallest unit back to standard
-func (uc *TransactionFeeCalculator) convertFromSmallestUnit(amountStr, currency string) float64 {
-	amount, err := strconv.ParseInt(amountStr, 10, 64)
+func (uc *TransactionFeeCalculator) convertFromSmallestUnit(amountStr, currency string) decimal.Decimal {
+	amount, err := decimal.NewFromString(amountStr)
 	if err != nil {
-		return 0
+		return decimal.Zero
 	}
 
-	decimals := map[string]int{
-		"BTC":  8,
-		"TRX":  6,
-		"USDT":  6,
-		"ETH":  18,
-		"USDC": 6,
+	return amount.Shift(-chainDecimals(currency))
+}
+
+// chainDecimals returns the on-chain decimals used by the crypto service
+// (these can differ from the ledger scale in the currencies table)
+func chainDecimals(currency string) int32 {
+	decimals := map[string]int32{
+		"BTC":  8,  // satoshis
+		"TRX":  6,  // SUN
+		"USDT": 6,  // SUN (on TRON)
+		"ETH":  18, // wei
+		"USDC": 6,  // (on Ethereum)
 	}
 
 	if dec, ok := decimals[currency]; ok {
-		divisor := math.Pow(10, float64(dec))
-		return float64(amount) / divisor
+		return dec
 	}
 
-	return float64(amount) / 1e8
+	return 8 // Default to 8 decimals
 }
 
 // ✅ NEW: Get estimated network fee (fallback when crypto service unavailable)
 func (uc *TransactionFeeCalculator) getEstimatedNetworkFee(currency string) *domain.NetworkFeeCalculation {
 	// Conservative estimates for common crypto currencies
 	estimates := map[string]struct {
-		Amount   float64
+		Amount   decimal.Decimal
 		Currency string
 	}{
-		"BTC":  {0.00005, "BTC"},   // ~5000 satoshis
-		"TRX":  {0.1, "TRX"},        // 0.1 TRX for native transfer
-		"USDT": {5.0, "TRX"},        // ~5 TRX for TRC20 transfer
-		"ETH":  {0.001, "ETH"},      // ~0.001 ETH (varies with gas)
-		"USDC": {0.002, "ETH"},      // ~0.002 ETH for ERC20
+		"BTC":  {decimal.RequireFromString("0.00005"), "BTC"}, // ~5000 satoshis
+		"TRX":  {decimal.RequireFromString("0.1"), "TRX"},     // 0.1 TRX for native transfer
+		"USDT": {decimal.RequireFromString("5.0"), "TRX"},     // ~5 TRX for TRC20 transfer
+		"ETH":  {decimal.RequireFromString("0.001"), "ETH"},   // ~0.001 ETH (varies with gas)
+		"USDC": {decimal.RequireFromString("0.002"), "ETH"},   // ~0.002 ETH for ERC20
 	}
 
 	if est, ok := estimates[currency]; ok {
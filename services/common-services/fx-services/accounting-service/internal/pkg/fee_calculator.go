@@ -4,8 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math"
-	"strconv"
 	cryptoclient "x/shared/common/crypto"
 
 	//"math/big"
@@ -18,8 +16,12 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
 )
 
+// bpsDivisor converts basis points to a rate (100 bps = 1%)
+var bpsDivisor = decimal.NewFromInt(10000)
+
 // TransactionFeeCalculator handles business logic for transaction fees
 // TransactionFeeCalculator handles business logic for transaction fees
 type TransactionFeeCalculator struct {
@@ -64,7 +66,7 @@ func (uc *TransactionFeeCalculator) BeginTx(ctx context.Context) (pgx.Tx, error)
 func (uc *TransactionFeeCalculator) CalculateFee(
 	ctx context.Context,
 	transactionType domain.TransactionType,
-	amount float64,
+	amount decimal.Decimal,
 	sourceCurrency, targetCurrency *string,
 	accountType *domain.AccountType,
 	ownerType *domain.OwnerType,
@@ -99,8 +101,8 @@ func (uc *TransactionFeeCalculator) CalculateFee(
 	totalFee := platformFee.Amount
 	breakdown := platformFee.CalculatedFrom
 
-	var networkFeeInSourceCurrency float64
-	var networkFeeOriginal float64
+	var networkFeeInSourceCurrency decimal.Decimal
+	var networkFeeOriginal decimal.Decimal
 	var networkFeeOriginalCurrency string
 
 	// 3. Add network fee if applicable
@@ -135,7 +137,7 @@ func (uc *TransactionFeeCalculator) CalculateFee(
 				}
 				networkFeeInSourceCurrency = converted
 
-				breakdown += fmt.Sprintf(" | network fee: %.8f %s (%.8f %s converted)",
+				breakdown += fmt.Sprintf(" | network fee: %s %s (%s %s converted)",
 					networkFeeOriginal,
 					networkFeeOriginalCurrency,
 					networkFeeInSourceCurrency,
@@ -144,23 +146,29 @@ func (uc *TransactionFeeCalculator) CalculateFee(
 				// Same currency - no conversion needed
 				networkFeeInSourceCurrency = networkFeeCalc.Amount
 
-				breakdown += fmt.Sprintf(" | network fee: %.8f %s",
+				breakdown += fmt.Sprintf(" | network fee: %s %s",
 					networkFeeInSourceCurrency,
 					currency)
 			}
 
 			//  Add converted network fee to total (now in same currency)
-			totalFee += networkFeeInSourceCurrency
+			totalFee = totalFee.Add(networkFeeInSourceCurrency)
 		}
 	}
 
-	// 4. Round total fee
-	totalFee = math.Round(totalFee*100000000) / 100000000
-	networkFeeInSourceCurrency = math.Round(networkFeeInSourceCurrency*100000000) / 100000000
+	// 4. Round fees to the source currency's scale
+	scale, err := uc.currencyScale(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+	platformFee.Amount = platformFee.Amount.Round(scale)
+	networkFeeInSourceCurrency = networkFeeInSourceCurrency.Round(scale)
+	totalFee = platformFee.Amount.Add(networkFeeInSourceCurrency)
 
 	// 5. Return unified calculation
 	result := &domain.FeeCalculation{
 		RuleID:                     platformFee.RuleID,
+		RuleVersionID:              platformFee.RuleVersionID,
 		FeeType:                    platformFee.FeeType,
 		Amount:                     platformFee.Amount, // Platform fee only
 		Currency:                   currency,
@@ -183,7 +191,7 @@ func (uc *TransactionFeeCalculator) CalculateFee(
 func (uc *TransactionFeeCalculator) calculatePlatformFee(
 	ctx context.Context,
 	transactionType domain.TransactionType,
-	amount float64,
+	amount decimal.Decimal,
 	sourceCurrency, targetCurrency *string,
 	accountType *domain.AccountType,
 	ownerType *domain.OwnerType,
@@ -209,7 +217,7 @@ func (uc *TransactionFeeCalculator) calculatePlatformFee(
 		// No rule found = no fee
 		return &domain.FeeCalculation{
 			FeeType:        domain.FeeTypePlatform,
-			Amount:         0,
+			Amount:         decimal.Zero,
 			Currency:       ptrStrToStr(sourceCurrency),
 			CalculatedFrom: "no matching rule",
 		}, nil
@@ -224,6 +232,46 @@ func (uc *TransactionFeeCalculator) calculatePlatformFee(
 	return uc.calculateFeeFromRule(rule, amount)
 }
 
+// ===============================
+// SIMULATION
+// ===============================
+
+// SimulatePlatformFee prices a transaction with the given rule set instead of
+// the stored rules, selecting the rule as FindBestMatch would. The fee is
+// rounded to scale; network fees are not simulated.
+func (uc *TransactionFeeCalculator) SimulatePlatformFee(
+	rules []*domain.TransactionFeeRule,
+	transactionType domain.TransactionType,
+	amount decimal.Decimal,
+	sourceCurrency, targetCurrency *string,
+	accountType *domain.AccountType,
+	ownerType *domain.OwnerType,
+	scale int32,
+) (*domain.FeeCalculation, error) {
+	rule := domain.SelectFeeRule(rules, transactionType, sourceCurrency, targetCurrency, accountType, ownerType)
+	if rule == nil {
+		return &domain.FeeCalculation{
+			FeeType:        domain.FeeTypePlatform,
+			Amount:         decimal.Zero,
+			Currency:       ptrStrToStr(sourceCurrency),
+			CalculatedFrom: "no matching rule",
+		}, nil
+	}
+
+	calc, err := uc.calculateFeeFromRule(rule, amount)
+	if err != nil {
+		return nil, err
+	}
+	calc.Amount = calc.Amount.Round(scale)
+	calc.TotalFee = calc.Amount
+	return calc, nil
+}
+
+// CurrencyScale returns the number of decimals fees in a currency round to
+func (uc *TransactionFeeCalculator) CurrencyScale(ctx context.Context, code string) (int32, error) {
+	return uc.currencyScale(ctx, code)
+}
+
 // ===============================
 // BACKWARD COMPATIBILITY
 // ===============================
@@ -232,7 +280,7 @@ func (uc *TransactionFeeCalculator) calculatePlatformFee(
 func (uc *TransactionFeeCalculator) CalculateMultipleFees(
 	ctx context.Context,
 	transactionType domain.TransactionType,
-	amount float64,
+	amount decimal.Decimal,
 	sourceCurrency, targetCurrency *string,
 	accountType *domain.AccountType,
 	ownerType *domain.OwnerType,
@@ -249,10 +297,11 @@ func (uc *TransactionFeeCalculator) CalculateMultipleFees(
 
 // service/fee_calculator.go
 
-func (uc *TransactionFeeCalculator) calculateFeeFromRule(rule *domain.TransactionFeeRule, amount float64) (*domain.FeeCalculation, error) {
+func (uc *TransactionFeeCalculator) calculateFeeFromRule(rule *domain.TransactionFeeRule, amount decimal.Decimal) (*domain.FeeCalculation, error) {
 	calc := &domain.FeeCalculation{
-		RuleID:  &rule.ID,
-		FeeType: rule.FeeType,
+		RuleID:        &rule.ID,
+		RuleVersionID: rule.VersionID,
+		FeeType:       rule.FeeType,
 	}
 
 	if rule.TargetCurrency != nil && *rule.TargetCurrency != "" {
@@ -261,7 +310,7 @@ func (uc *TransactionFeeCalculator) calculateFeeFromRule(rule *domain.Transactio
 		calc.Currency = ptrStrToStr(rule.SourceCurrency)
 	}
 
-	var feeAmount float64
+	feeAmount := decimal.Zero
 
 	//  NEW: Check if rule has tariffs (amount-based pricing)
 	if rule.Tariffs != nil && *rule.Tariffs != "" {
@@ -271,23 +320,23 @@ func (uc *TransactionFeeCalculator) calculateFeeFromRule(rule *domain.Transactio
 	//  Existing calculation methods (percentage, fixed, tiered)
 	switch rule.CalculationMethod {
 	case domain.FeeCalculationPercentage:
-		basisPoints, err := strconv.ParseFloat(rule.FeeValue, 64)
+		basisPoints, err := decimal.NewFromString(rule.FeeValue)
 		if err != nil {
 			return nil, fmt.Errorf("invalid fee value (basis points expected): %s", rule.FeeValue)
 		}
 
-		if basisPoints < 0 || basisPoints > 10000 {
-			return nil, fmt.Errorf("basis points out of range (0-10000): %.2f", basisPoints)
+		if basisPoints.IsNegative() || basisPoints.GreaterThan(bpsDivisor) {
+			return nil, fmt.Errorf("basis points out of range (0-10000): %s", basisPoints)
 		}
 
-		feeRate := basisPoints / 10000.0
-		feeAmount = amount * feeRate
+		feeRate := basisPoints.Div(bpsDivisor)
+		feeAmount = amount.Mul(feeRate)
 
-		calc.AppliedRate = ptrStr(fmt.Sprintf("%.4f%%", feeRate)) //&rule.FeeValue
-		calc.CalculatedFrom = fmt.Sprintf("percentage: %.4f%% (%s bps)", feeRate*100, rule.FeeValue)
+		calc.AppliedRate = ptrStr(fmt.Sprintf("%s%%", feeRate.StringFixed(4))) //&rule.FeeValue
+		calc.CalculatedFrom = fmt.Sprintf("percentage: %s%% (%s bps)", feeRate.Shift(2).StringFixed(4), rule.FeeValue)
 
 	case domain.FeeCalculationFixed:
-		fixedAmount, err := strconv.ParseFloat(rule.FeeValue, 64)
+		fixedAmount, err := decimal.NewFromString(rule.FeeValue)
 		if err != nil {
 			if rule.MinFee != nil {
 				feeAmount = *rule.MinFee
@@ -298,7 +347,7 @@ func (uc *TransactionFeeCalculator) calculateFeeFromRule(rule *domain.Transactio
 			feeAmount = fixedAmount
 		}
 
-		calc.CalculatedFrom = fmt.Sprintf("fixed fee: %.2f", feeAmount)
+		calc.CalculatedFrom = fmt.Sprintf("fixed fee: %s", feeAmount)
 
 	// accounting-service/internal/service/fee_calculator.go
 
@@ -310,45 +359,45 @@ func (uc *TransactionFeeCalculator) calculateFeeFromRule(rule *domain.Transactio
 
 		tierFound := false
 		for _, tier := range tiers {
-			inRange := amount >= tier.MinAmount
+			inRange := amount.GreaterThanOrEqual(tier.MinAmount)
 			if tier.MaxAmount != nil {
-				inRange = inRange && amount <= *tier.MaxAmount
+				inRange = inRange && amount.LessThanOrEqual(*tier.MaxAmount)
 			}
 
 			if inRange {
 				tierFound = true
 
 				if tier.Rate != nil {
-					basisPoints := *tier.Rate // Already float64
+					basisPoints := *tier.Rate
 					
-					if basisPoints < 0 || basisPoints > 10000 {
-						return nil, fmt.Errorf("tier basis points out of range: %.2f", basisPoints)
+					if basisPoints.IsNegative() || basisPoints.GreaterThan(bpsDivisor) {
+						return nil, fmt.Errorf("tier basis points out of range: %s", basisPoints)
 					}
 
-					feeRate := basisPoints / 10000.0
-					feeAmount += amount * feeRate
+					feeRate := basisPoints.Div(bpsDivisor)
+					feeAmount = feeAmount.Add(amount.Mul(feeRate))
 
 					//  FIX: Show the actual basis points, not fee rate
-					rateStr := fmt.Sprintf("%.0f", basisPoints) // "100" not "0.01"
+					rateStr := basisPoints.StringFixed(0) // "100" not "0.01"
 					calc.AppliedRate = &rateStr
 
 					maxAmountStr := "∞"
 					if tier.MaxAmount != nil {
-						maxAmountStr = fmt.Sprintf("%.2f", *tier.MaxAmount)
+						maxAmountStr = tier.MaxAmount.String()
 					}
 					
 					//  FIX:  Show correct percentage calculation
-					calc. CalculatedFrom = fmt.Sprintf("tiered rate: %.4f%% (%.2f bps) for range %.2f-%s",
-						feeRate*100,  //  This converts 0.01 → 1.00%
-						basisPoints,  //  Shows "100 bps"
+					calc. CalculatedFrom = fmt.Sprintf("tiered rate: %s%% (%s bps) for range %s-%s",
+						feeRate.Shift(2).StringFixed(4), //  This converts 0.01 → 1.00%
+						basisPoints.StringFixed(2),      //  Shows "100 bps"
 						tier.MinAmount, 
 						maxAmountStr)
 				}
 
 				if tier.FixedFee != nil {
-					feeAmount += *tier.FixedFee
+					feeAmount = feeAmount.Add(*tier.FixedFee)
 					if calc. CalculatedFrom != "" {
-						calc.CalculatedFrom += fmt.Sprintf(" + fixed:  %.8f", *tier.FixedFee)
+						calc.CalculatedFrom += fmt.Sprintf(" + fixed:  %s", *tier.FixedFee)
 					}
 				}
 
@@ -357,7 +406,7 @@ func (uc *TransactionFeeCalculator) calculateFeeFromRule(rule *domain.Transactio
 		}
 
 		if !tierFound {
-			return nil, fmt.Errorf("no tier found for amount: %.2f", amount)
+			return nil, fmt.Errorf("no tier found for amount: %s", amount)
 		}
 
 	default:
@@ -367,17 +416,17 @@ func (uc *TransactionFeeCalculator) calculateFeeFromRule(rule *domain.Transactio
 	// Apply min/max limits
 	originalFee := feeAmount
 
-	if rule.MinFee != nil && feeAmount < *rule.MinFee {
+	if rule.MinFee != nil && feeAmount.LessThan(*rule.MinFee) {
 		feeAmount = *rule.MinFee
-		calc.CalculatedFrom += fmt.Sprintf(" → min %.2f applied (was %.2f)", *rule.MinFee, originalFee)
+		calc.CalculatedFrom += fmt.Sprintf(" → min %s applied (was %s)", *rule.MinFee, originalFee)
 	}
 
-	if rule.MaxFee != nil && feeAmount > *rule.MaxFee {
+	if rule.MaxFee != nil && feeAmount.GreaterThan(*rule.MaxFee) {
 		feeAmount = *rule.MaxFee
-		calc.CalculatedFrom += fmt.Sprintf(" → max %.2f applied (was %.2f)", *rule.MaxFee, originalFee)
+		calc.CalculatedFrom += fmt.Sprintf(" → max %s applied (was %s)", *rule.MaxFee, originalFee)
 	}
 
-	feeAmount = math.Round(feeAmount*100) / 100
+	// Rounding to the currency scale happens in CalculateFee
 	calc.Amount = feeAmount
 
 	return calc, nil
@@ -389,7 +438,7 @@ func (uc *TransactionFeeCalculator) calculateFeeFromRule(rule *domain.Transactio
 //  UPDATED: Calculate fee using tariffs (supports both percentage and fixed)
 func (uc *TransactionFeeCalculator) calculateFeeFromTariffs(
 	rule *domain.TransactionFeeRule,
-	amount float64,
+	amount decimal.Decimal,
 	calc *domain.FeeCalculation,
 ) (*domain.FeeCalculation, error) {
 	// Find applicable tariff for this amount
@@ -399,10 +448,10 @@ func (uc *TransactionFeeCalculator) calculateFeeFromTariffs(
 	}
 
 	if tariff == nil {
-		return nil, fmt.Errorf("no tariff found for amount:  %.2f", amount)
+		return nil, fmt.Errorf("no tariff found for amount:  %s", amount)
 	}
 
-	var feeAmount float64
+	var feeAmount decimal.Decimal
 
 	switch tariff.CalculationMethod {
 	case domain.TariffCalculationPercentage:
@@ -411,31 +460,31 @@ func (uc *TransactionFeeCalculator) calculateFeeFromTariffs(
 			return nil, fmt.Errorf("fee_bps is required for percentage calculation method")
 		}
 
-		if *tariff.FeeBps < 0 || *tariff.FeeBps > 10000 {
-			return nil, fmt.Errorf("tariff fee_bps out of range (0-10000): %.2f", *tariff.FeeBps)
+		if tariff.FeeBps.IsNegative() || tariff.FeeBps.GreaterThan(bpsDivisor) {
+			return nil, fmt.Errorf("tariff fee_bps out of range (0-10000): %s", *tariff.FeeBps)
 		}
 
-		feeRate := *tariff.FeeBps / 10000.0
-		feeAmount = amount * feeRate
+		feeRate := tariff.FeeBps.Div(bpsDivisor)
+		feeAmount = amount.Mul(feeRate)
 
 		// Add fixed fee if present (combination)
 		if tariff.FixedFee != nil {
-			feeAmount += *tariff.FixedFee
+			feeAmount = feeAmount.Add(*tariff.FixedFee)
 		}
 
 		// Build description
 		maxAmountStr := "∞"
 		if tariff.MaxAmount != nil {
-			maxAmountStr = fmt.Sprintf("%.2f", *tariff.MaxAmount)
+			maxAmountStr = tariff.MaxAmount.StringFixed(2)
 		}
 
-		feeBpsStr := fmt.Sprintf("%.0f", *tariff.FeeBps)
+		feeBpsStr := tariff.FeeBps.StringFixed(0)
 		calc.AppliedRate = &feeBpsStr
-		calc.CalculatedFrom = fmt.Sprintf("tariff percentage:  %.4f%% (%.0f bps) for $%.2f-$%s",
-			feeRate*100, *tariff.FeeBps, tariff.MinAmount, maxAmountStr)
+		calc.CalculatedFrom = fmt.Sprintf("tariff percentage:  %s%% (%s bps) for $%s-$%s",
+			feeRate.Shift(2).StringFixed(4), feeBpsStr, tariff.MinAmount.StringFixed(2), maxAmountStr)
 
 		if tariff.FixedFee != nil {
-			calc.CalculatedFrom += fmt.Sprintf(" + fixed: $%.2f", *tariff.FixedFee)
+			calc.CalculatedFrom += fmt.Sprintf(" + fixed: $%s", tariff.FixedFee.StringFixed(2))
 		}
 
 	case domain.TariffCalculationFixed:
@@ -449,11 +498,11 @@ func (uc *TransactionFeeCalculator) calculateFeeFromTariffs(
 		// Build description
 		maxAmountStr := "∞"
 		if tariff.MaxAmount != nil {
-			maxAmountStr = fmt.Sprintf("%.2f", *tariff.MaxAmount)
+			maxAmountStr = tariff.MaxAmount.StringFixed(2)
 		}
 
-		calc.CalculatedFrom = fmt.Sprintf("tariff fixed: $%.2f for $%.2f-$%s",
-			*tariff.FixedFee, tariff.MinAmount, maxAmountStr)
+		calc.CalculatedFrom = fmt.Sprintf("tariff fixed: $%s for $%s-$%s",
+			tariff.FixedFee.StringFixed(2), tariff.MinAmount.StringFixed(2), maxAmountStr)
 
 	default:
 		return nil, fmt.Errorf("unsupported tariff calculation method: %s", tariff.CalculationMethod)
@@ -462,18 +511,17 @@ func (uc *TransactionFeeCalculator) calculateFeeFromTariffs(
 	// Apply min/max limits (from rule-level)
 	originalFee := feeAmount
 
-	if rule.MinFee != nil && feeAmount < *rule.MinFee {
+	if rule.MinFee != nil && feeAmount.LessThan(*rule.MinFee) {
 		feeAmount = *rule.MinFee
-		calc.CalculatedFrom += fmt.Sprintf(" → min $%.2f applied (was $%.2f)", *rule.MinFee, originalFee)
+		calc.CalculatedFrom += fmt.Sprintf(" → min $%s applied (was $%s)", *rule.MinFee, originalFee)
 	}
 
-	if rule.MaxFee != nil && feeAmount > *rule.MaxFee {
+	if rule.MaxFee != nil && feeAmount.GreaterThan(*rule.MaxFee) {
 		feeAmount = *rule.MaxFee
-		calc.CalculatedFrom += fmt.Sprintf(" → max $%.2f applied (was $%.2f)", *rule.MaxFee, originalFee)
+		calc.CalculatedFrom += fmt.Sprintf(" → max $%s applied (was $%s)", *rule.MaxFee, originalFee)
 	}
 
-	// Round to 2 decimal places
-	feeAmount = math.Round(feeAmount*100) / 100
+	// Rounding to the currency scale happens in CalculateFee
 	calc.Amount = feeAmount
 
 	return calc, nil
@@ -532,9 +580,9 @@ func ptrStr(s string) *string {
 
 func (uc *TransactionFeeCalculator) convertCurrency(
 	ctx context.Context,
-	amount float64,
+	amount decimal.Decimal,
 	fromCurrency, toCurrency string,
-) (float64, error) {
+) (decimal.Decimal, error) {
 
 	// Check if conversion needed
 	if fromCurrency == toCurrency {
@@ -544,9 +592,8 @@ func (uc *TransactionFeeCalculator) convertCurrency(
 	// Try cache first
 	cacheKey := fmt.Sprintf("fx: rate:%s:%s", fromCurrency, toCurrency)
 	if val, err := uc.redisClient.Get(ctx, cacheKey).Result(); err == nil {
-		var rate float64
-		if _, parseErr := fmt.Sscanf(val, "%f", &rate); parseErr == nil {
-			return amount * rate, nil
+		if rate, parseErr := decimal.NewFromString(val); parseErr == nil {
+			return amount.Mul(rate), nil
 		}
 	}
 
@@ -556,36 +603,49 @@ func (uc *TransactionFeeCalculator) convertCurrency(
 		// Try inverse rate
 		inverseFxRate, inverseErr := uc.currencyRepo.GetCurrentFXRate(ctx, toCurrency, fromCurrency)
 		if inverseErr != nil {
-			return 0, fmt.Errorf("no FX rate found for %s/%s", fromCurrency, toCurrency)
+			return decimal.Zero, fmt.Errorf("no FX rate found for %s/%s", fromCurrency, toCurrency)
 		}
 
 		// Use inverse rate:  1 / rate
-		inverseFxRateValue, err := strconv.ParseFloat(inverseFxRate.Rate, 64)
+		inverseFxRateValue, err := decimal.NewFromString(inverseFxRate.Rate)
 		if err != nil {
-			return 0, fmt.Errorf("invalid FX rate format: %w", err)
+			return decimal.Zero, fmt.Errorf("invalid FX rate format: %w", err)
 		}
 
-		if inverseFxRateValue == 0 {
-			return 0, fmt.Errorf("invalid FX rate (zero)")
+		if inverseFxRateValue.IsZero() {
+			return decimal.Zero, fmt.Errorf("invalid FX rate (zero)")
 		}
 
-		rate := 1.0 / inverseFxRateValue
-		converted := amount * rate
+		rate := decimal.NewFromInt(1).DivRound(inverseFxRateValue, 18)
+		converted := amount.Mul(rate)
 
 		// Cache the rate (5 minutes)
-		_ = uc.redisClient.Set(ctx, cacheKey, fmt.Sprintf("%f", rate), 5*time.Minute).Err()
+		_ = uc.redisClient.Set(ctx, cacheKey, rate.String(), 5*time.Minute).Err()
 
 		return converted, nil
 	}
 
-	rateValue, err := strconv.ParseFloat(fxRate.Rate, 64)
+	rateValue, err := decimal.NewFromString(fxRate.Rate)
 	if err != nil {
-		return 0, fmt.Errorf("invalid FX rate format: %w", err)
+		return decimal.Zero, fmt.Errorf("invalid FX rate format: %w", err)
 	}
-	converted := amount * rateValue
+	converted := amount.Mul(rateValue)
 
 	// Cache the rate (5 minutes)
-	_ = uc.redisClient.Set(ctx, cacheKey, fmt.Sprintf("%f", rateValue), 5*time.Minute).Err()
+	_ = uc.redisClient.Set(ctx, cacheKey, rateValue.String(), 5*time.Minute).Err()
 
 	return converted, nil
 }
+
+// currencyScale returns the number of decimals for a currency from the
+// currencies table, falling back to 8 for unknown codes
+func (uc *TransactionFeeCalculator) currencyScale(ctx context.Context, code string) (int32, error) {
+	currency, err := uc.currencyRepo.GetCurrency(ctx, code)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get currency %s: %w", code, err)
+	}
+	if currency == nil {
+		return 8, nil
+	}
+	return int32(currency.Decimals), nil
+}
@@ -0,0 +1,73 @@
+package statement
+
+import (
+	"encoding/csv"
+	"io"
+	"time"
+
+	"accounting-service/internal/domain"
+)
+
+// csvWriter writes one row per ledger line between an opening and a closing
+// balance row
+type csvWriter struct {
+	w        *csv.Writer
+	currency string
+	end      time.Time
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) WriteHeader(h *domain.StatementHeader) error {
+	c.currency = h.Currency
+	c.end = h.PeriodEnd
+
+	if err := c.w.Write([]string{
+		"date", "receipt_code", "transaction_type", "description",
+		"debit", "credit", "fee", "balance", "currency",
+	}); err != nil {
+		return err
+	}
+
+	return c.w.Write([]string{
+		h.PeriodStart.UTC().Format(time.RFC3339), "", "", "Opening balance",
+		"", "", "", h.OpeningBalance.String(), h.Currency,
+	})
+}
+
+func (c *csvWriter) WriteLine(l *domain.StatementLine) error {
+	debit, credit, fee := "", "", ""
+	if l.DrCr == domain.DrCrDebit {
+		debit = l.Amount.String()
+		if l.Fee.IsPositive() {
+			fee = l.Fee.String()
+		}
+	} else {
+		credit = l.Amount.String()
+	}
+
+	if err := c.w.Write([]string{
+		l.Date.UTC().Format(time.RFC3339), deref(l.ReceiptCode), string(l.TransactionType), deref(l.Description),
+		debit, credit, fee, l.Balance.String(), c.currency,
+	}); err != nil {
+		return err
+	}
+
+	// Hand rows to the underlying writer as they come
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) Close(f *domain.StatementFooter) error {
+	if err := c.w.Write([]string{
+		c.end.UTC().Format(time.RFC3339), "", "", "Closing balance",
+		f.TotalDebits.String(), f.TotalCredits.String(), f.TotalFees.String(), f.ClosingBalance.String(), c.currency,
+	}); err != nil {
+		return err
+	}
+
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,292 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"accounting-service/internal/domain"
+)
+
+// Landscape A4 in points, monospaced fonts so columns line up without metrics
+const (
+	pdfPageWidth  = 842
+	pdfPageHeight = 595
+	pdfMargin     = 36
+	pdfLeading    = 11
+	pdfFontSize   = 8
+	pdfTitleSize  = 12
+)
+
+// Fixed objects; page objects are numbered from pdfFirstDynamicObj
+const (
+	pdfCatalogObj      = 1
+	pdfPagesObj        = 2 // Written last, once every page is known
+	pdfFontObj         = 3
+	pdfBoldFontObj     = 4
+	pdfFirstDynamicObj = 5
+)
+
+// Column widths in characters: date, receipt, type, description, debit, credit, fee, balance
+var pdfColumns = []int{16, 16, 12, 36, 16, 16, 12, 18}
+
+// pdfWriter streams a PDF page by page. Only the current page's content is
+// held in memory; finished pages are written out with their xref offsets
+// recorded, and the page tree goes at the end.
+type pdfWriter struct {
+	out     *countingWriter
+	offsets map[int]int64
+	nextObj int
+	pageIDs []int
+
+	header  *domain.StatementHeader
+	content bytes.Buffer
+	y       float64
+	pageNo  int
+}
+
+func newPDFWriter(w io.Writer) *pdfWriter {
+	return &pdfWriter{
+		out:     &countingWriter{w: w},
+		offsets: make(map[int]int64),
+		nextObj: pdfFirstDynamicObj,
+	}
+}
+
+func (p *pdfWriter) WriteHeader(h *domain.StatementHeader) error {
+	p.header = h
+
+	if _, err := io.WriteString(p.out, "%PDF-1.4\n%\xe2\xe3\xcf\xd3\n"); err != nil {
+		return err
+	}
+	if err := p.writeObject(pdfCatalogObj, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pdfPagesObj)); err != nil {
+		return err
+	}
+	if err := p.writeObject(pdfFontObj, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>"); err != nil {
+		return err
+	}
+	if err := p.writeObject(pdfBoldFontObj, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>"); err != nil {
+		return err
+	}
+
+	if err := p.startPage(); err != nil {
+		return err
+	}
+
+	return p.row(true, h.PeriodStart.UTC().Format("2006-01-02 15:04"), "", "", "Opening balance",
+		"", "", "", h.OpeningBalance.String())
+}
+
+func (p *pdfWriter) WriteLine(l *domain.StatementLine) error {
+	debit, credit, fee := "", "", ""
+	if l.DrCr == domain.DrCrDebit {
+		debit = l.Amount.String()
+		if l.Fee.IsPositive() {
+			fee = l.Fee.String()
+		}
+	} else {
+		credit = l.Amount.String()
+	}
+
+	return p.row(false, l.Date.UTC().Format("2006-01-02 15:04"), deref(l.ReceiptCode), string(l.TransactionType),
+		deref(l.Description), debit, credit, fee, l.Balance.String())
+}
+
+func (p *pdfWriter) Close(f *domain.StatementFooter) error {
+	if err := p.row(true, p.header.PeriodEnd.UTC().Format("2006-01-02 15:04"), "", "", "Closing balance",
+		f.TotalDebits.String(), f.TotalCredits.String(), f.TotalFees.String(), f.ClosingBalance.String()); err != nil {
+		return err
+	}
+	if err := p.text(false, pdfFontSize, fmt.Sprintf("%d transactions. Debits, credits and fees above are period totals.", f.LineCount)); err != nil {
+		return err
+	}
+	if err := p.finishPage(); err != nil {
+		return err
+	}
+
+	kids := make([]string, len(p.pageIDs))
+	for i, id := range p.pageIDs {
+		kids[i] = fmt.Sprintf("%d 0 R", id)
+	}
+	if err := p.writeObject(pdfPagesObj, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>",
+		strings.Join(kids, " "), len(p.pageIDs))); err != nil {
+		return err
+	}
+
+	return p.writeTrailer()
+}
+
+// ===============================
+// LAYOUT
+// ===============================
+
+func (p *pdfWriter) startPage() error {
+	p.pageNo++
+	p.content.Reset()
+	p.y = pdfPageHeight - pdfMargin
+
+	h := p.header
+	if err := p.text(true, pdfTitleSize, "Account Statement"); err != nil {
+		return err
+	}
+	p.y -= 4
+	lines := []string{
+		fmt.Sprintf("Account: %s   Currency: %s   Type: %s   Owner: %s %s",
+			h.AccountNumber, h.Currency, h.AccountType, h.OwnerType, h.OwnerID),
+		fmt.Sprintf("Period: %s to %s   Generated: %s   Page %d",
+			h.PeriodStart.UTC().Format("2006-01-02 15:04"), h.PeriodEnd.UTC().Format("2006-01-02 15:04"),
+			h.GeneratedAt.UTC().Format("2006-01-02 15:04 MST"), p.pageNo),
+	}
+	for _, line := range lines {
+		if err := p.text(false, pdfFontSize, line); err != nil {
+			return err
+		}
+	}
+	p.y -= pdfLeading / 2
+
+	if err := p.text(true, pdfFontSize, formatColumns(
+		"Date", "Receipt", "Type", "Description", "Debit", "Credit", "Fee", "Balance")); err != nil {
+		return err
+	}
+	return p.text(false, pdfFontSize, strings.Repeat("-", columnsWidth()))
+}
+
+// row writes a table row, breaking to a new page when the current one is full
+func (p *pdfWriter) row(bold bool, cols ...string) error {
+	if p.y < pdfMargin+pdfLeading {
+		if err := p.finishPage(); err != nil {
+			return err
+		}
+		if err := p.startPage(); err != nil {
+			return err
+		}
+	}
+	return p.text(bold, pdfFontSize, formatColumns(cols...))
+}
+
+func (p *pdfWriter) text(bold bool, size int, s string) error {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	_, err := fmt.Fprintf(&p.content, "BT /%s %d Tf %d %.1f Td (%s) Tj ET\n", font, size, pdfMargin, p.y, escapePDF(s))
+	p.y -= pdfLeading
+	if size > pdfFontSize {
+		p.y -= float64(size - pdfFontSize)
+	}
+	return err
+}
+
+// finishPage writes the current page's content stream and page object
+func (p *pdfWriter) finishPage() error {
+	contentID := p.allocObject()
+	stream := fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", p.content.Len(), p.content.String())
+	if err := p.writeObject(contentID, stream); err != nil {
+		return err
+	}
+
+	pageID := p.allocObject()
+	page := fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 %d 0 R /F2 %d 0 R >> >> /Contents %d 0 R >>",
+		pdfPagesObj, pdfPageWidth, pdfPageHeight, pdfFontObj, pdfBoldFontObj, contentID)
+	if err := p.writeObject(pageID, page); err != nil {
+		return err
+	}
+
+	p.pageIDs = append(p.pageIDs, pageID)
+	p.content.Reset()
+	return nil
+}
+
+// ===============================
+// OBJECTS & XREF
+// ===============================
+
+func (p *pdfWriter) allocObject() int {
+	id := p.nextObj
+	p.nextObj++
+	return id
+}
+
+func (p *pdfWriter) writeObject(id int, body string) error {
+	p.offsets[id] = p.out.n
+	_, err := fmt.Fprintf(p.out, "%d 0 obj\n%s\nendobj\n", id, body)
+	return err
+}
+
+func (p *pdfWriter) writeTrailer() error {
+	xrefOffset := p.out.n
+	size := p.nextObj
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", size)
+	for id := 1; id < size; id++ {
+		fmt.Fprintf(&b, "%010d 00000 n \n", p.offsets[id])
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", size, pdfCatalogObj, xrefOffset)
+
+	_, err := io.WriteString(p.out, b.String())
+	return err
+}
+
+// ===============================
+// HELPERS
+// ===============================
+
+// formatColumns pads or truncates each value to its column; amounts are right-aligned
+func formatColumns(cols ...string) string {
+	parts := make([]string, len(pdfColumns))
+	for i, width := range pdfColumns {
+		v := ""
+		if i < len(cols) {
+			v = cols[i]
+		}
+		if len(v) > width {
+			v = v[:width-1] + "~"
+		}
+		if i >= 4 {
+			parts[i] = fmt.Sprintf("%*s", width, v)
+		} else {
+			parts[i] = fmt.Sprintf("%-*s", width, v)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func columnsWidth() int {
+	total := len(pdfColumns) - 1
+	for _, w := range pdfColumns {
+		total += w
+	}
+	return total
+}
+
+// escapePDF escapes a literal string; non-ASCII is replaced since the
+// standard fonts only cover WinAnsi
+func escapePDF(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// countingWriter tracks the byte offset needed for the xref table
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,36 @@
+// Package statement renders exported account statements. Writers are fed one
+// line at a time so a statement of any length is rendered in bounded memory.
+package statement
+
+import (
+	"fmt"
+	"io"
+
+	"accounting-service/internal/domain"
+)
+
+// Writer renders a statement: one header, any number of lines, one footer
+type Writer interface {
+	WriteHeader(h *domain.StatementHeader) error
+	WriteLine(l *domain.StatementLine) error
+	Close(f *domain.StatementFooter) error
+}
+
+// NewWriter returns the writer for a format
+func NewWriter(format domain.StatementFormat, w io.Writer) (Writer, error) {
+	switch format {
+	case domain.StatementFormatCSV:
+		return newCSVWriter(w), nil
+	case domain.StatementFormatPDF:
+		return newPDFWriter(w), nil
+	default:
+		return nil, fmt.Errorf("unsupported statement format %q", format)
+	}
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,64 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// AdminAuditTopic is consumed by audit-service into its admin audit log
+const AdminAuditTopic = "auth.events.admin"
+
+// AdminAuditEvent matches audit-service's AdminEventMessage
+type AdminAuditEvent struct {
+	AdminUserID   string                 `json:"admin_user_id"`
+	TargetUserID  string                 `json:"target_user_id"`
+	Action        string                 `json:"action"`
+	RequestID     *string                `json:"request_id,omitempty"`
+	Description   *string                `json:"description,omitempty"`
+	PreviousValue map[string]interface{} `json:"previous_value,omitempty"`
+	NewValue      map[string]interface{} `json:"new_value,omitempty"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+	Timestamp     time.Time              `json:"timestamp"`
+}
+
+// AuditPublisher sends admin actions taken in this service to audit-service.
+// Publishing is best effort: the action has already committed and keeps its
+// own history, so a failed send is logged rather than returned.
+type AuditPublisher struct {
+	writer *kafka.Writer
+}
+
+func NewAuditPublisher(writer *kafka.Writer) *AuditPublisher {
+	return &AuditPublisher{writer: writer}
+}
+
+// PublishAdminAction keys the message by target user so one user's events
+// stay ordered
+func (p *AuditPublisher) PublishAdminAction(ctx context.Context, event *AdminAuditEvent) {
+	if p == nil || p.writer == nil {
+		return
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("[AuditPublisher] Failed to marshal %s: %v", event.Action, err)
+		return
+	}
+
+	if err := p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(event.TargetUserID),
+		Value: data,
+	}); err != nil {
+		log.Printf("[AuditPublisher] Failed to publish %s by %s: %v", event.Action, event.AdminUserID, err)
+		return
+	}
+
+	log.Printf("[AuditPublisher] Published %s by %s for %s", event.Action, event.AdminUserID, event.TargetUserID)
+}
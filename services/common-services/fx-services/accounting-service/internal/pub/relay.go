@@ -0,0 +1,124 @@
+package publisher
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"accounting-service/internal/domain"
+	"accounting-service/internal/repository"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	OutboxRelayBatchSize = 200
+	OutboxRelayTimeout   = 30 * time.Second
+	OutboxPurgeInterval  = 1 * time.Hour
+)
+
+// OutboxRelay publishes committed outbox rows to their Redis stream and marks
+// them sent. Each stream entry carries the outbox id, which consumers use to
+// drop the duplicates at-least-once delivery can produce.
+type OutboxRelay struct {
+	outboxRepo repository.OutboxRepository
+	rdb        *redis.Client
+	interval   time.Duration
+	maxLen     int64         // Approximate stream cap (XADD MAXLEN ~)
+	retention  time.Duration // Sent rows older than this are purged
+	stopChan   chan struct{}
+}
+
+func NewOutboxRelay(outboxRepo repository.OutboxRepository, rdb *redis.Client, interval time.Duration, maxLen int64, retention time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		outboxRepo: outboxRepo,
+		rdb:        rdb,
+		interval:   interval,
+		maxLen:     maxLen,
+		retention:  retention,
+		stopChan:   make(chan struct{}),
+	}
+}
+
+func (r *OutboxRelay) Start() {
+	go r.worker()
+}
+
+func (r *OutboxRelay) Stop() {
+	close(r.stopChan)
+}
+
+func (r *OutboxRelay) worker() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	lastPurge := time.Now()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.relay()
+			if time.Since(lastPurge) >= OutboxPurgeInterval {
+				r.purge()
+				lastPurge = time.Now()
+			}
+		case <-r.stopChan:
+			return
+		}
+	}
+}
+
+// relay drains due rows, batch after batch, until a batch comes back short
+func (r *OutboxRelay) relay() {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), OutboxRelayTimeout)
+		sent, err := r.outboxRepo.RelayPending(ctx, OutboxRelayBatchSize, r.publish)
+		cancel()
+
+		if err != nil {
+			log.Printf("[OutboxRelay] Relayed %d events, then failed: %v", sent, err)
+			return
+		}
+		if sent < OutboxRelayBatchSize {
+			return
+		}
+
+		select {
+		case <-r.stopChan:
+			return
+		default:
+		}
+	}
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, event *domain.OutboxEvent) (string, error) {
+	return r.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: event.Stream,
+		MaxLen: r.maxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"event_id":   strconv.FormatInt(event.ID, 10),
+			"event_type": event.EventType,
+			"payload":    string(event.Payload),
+		},
+	}).Result()
+}
+
+func (r *OutboxRelay) purge() {
+	if r.retention <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), OutboxRelayTimeout)
+	defer cancel()
+
+	purged, err := r.outboxRepo.PurgeSent(ctx, time.Now().Add(-r.retention))
+	if err != nil {
+		log.Printf("[OutboxRelay] Purge failed: %v", err)
+		return
+	}
+	if purged > 0 {
+		log.Printf("[OutboxRelay] Purged %d sent events older than %s", purged, r.retention)
+	}
+}
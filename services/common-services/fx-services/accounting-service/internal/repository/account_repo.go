@@ -10,8 +10,9 @@ import (
 	xerrors "x/shared/utils/errors"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
-	//"github.com/shopspring/decimal"
+	"github.com/shopspring/decimal"
 )
 
 // AccountRepository defines the interface for account persistence operations
@@ -43,6 +44,10 @@ type AccountRepository interface {
 	GetByParentAgent(ctx context.Context, agentExternalID string) ([]*domain.Account, error)
 	GetByParentAgentTx(ctx context.Context, agentExternalID string, tx pgx.Tx) ([]*domain.Account, error)
 
+	// Sub-accounts
+	CreateSubAccount(ctx context.Context, account *domain.Account, maxPerParent int) error
+	GetSubAccounts(ctx context.Context, parentAccountID int64) ([]*domain.Account, error)
+
 	// Status operations
 	Lock(ctx context.Context, accountID int64, tx pgx.Tx) error
 	Unlock(ctx context.Context, accountID int64, tx pgx.Tx) error
@@ -54,6 +59,10 @@ type AccountRepository interface {
 	GetSystemFeeAccount(ctx context.Context, currency string) (*domain.Account, error)
 	GetSystemLiquidityAccount(ctx context.Context, currency string) (*domain.Account, error)
 	GetSystemRevenueAccount(ctx context.Context, currency string) (*domain.Account, error)
+
+	// Demo liquidity: the demo-side counterpart of SYS-LIQ, funds demo top-ups and trades
+	GetDemoLiquidityAccount(ctx context.Context, currency string) (*domain.Account, error)
+	EnsureDemoLiquidityAccount(ctx context.Context, currency string, float decimal.Decimal) (*domain.Account, error)
 	GetAgentAccount(ctx context.Context, agentExternalID string, currency string) (*domain.Account, error)
 	GetOrCreateAgentAccount(ctx context.Context, tx pgx.Tx, agentExternalID string, currency string, commissionRate *string) (*domain.Account, error)
 
@@ -86,7 +95,9 @@ const (
 	baseSelectQuery = `
 		SELECT id, account_number, owner_type, owner_id, currency, purpose, 
 		       account_type, is_active, is_locked, overdraft_limit, 
-		       parent_agent_external_id, commission_rate, created_at, updated_at
+		       parent_agent_external_id, commission_rate, created_at, updated_at,
+		       parent_account_id, name,
+		       (SELECT p.account_number FROM accounts p WHERE p.id = accounts.parent_account_id)
 		FROM accounts`
 )
 
@@ -100,6 +111,7 @@ func scanAccount(row pgx.Row) (*domain.Account, error) {
 		&a.ID, &a.AccountNumber, &a.OwnerType, &a.OwnerID, &a.Currency,
 		&a.Purpose, &a.AccountType, &a.IsActive, &a.IsLocked, &a.OverdraftLimit,
 		&parentAgentID, &commissionRate, &a.CreatedAt, &a.UpdatedAt,
+		&a.ParentAccountID, &a.Name, &a.ParentAccountNumber,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -128,6 +140,7 @@ func scanAccountRows(rows pgx.Rows) ([]*domain.Account, error) {
 			&a.ID, &a.AccountNumber, &a.OwnerType, &a.OwnerID, &a.Currency,
 			&a.Purpose, &a.AccountType, &a.IsActive, &a.IsLocked, &a.OverdraftLimit,
 			&parentAgentID, &commissionRate, &a.CreatedAt, &a.UpdatedAt,
+			&a.ParentAccountID, &a.Name, &a.ParentAccountNumber,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan account row: %w", err)
@@ -166,6 +179,9 @@ func scanAccountFromRows(rows pgx.Rows) (*domain. Account, error) {
 		&commissionRate,
 		&account.CreatedAt,
 		&account.UpdatedAt,
+		&account.ParentAccountID,
+		&account.Name,
+		&account.ParentAccountNumber,
 	)
 
 	if err != nil {
@@ -287,7 +303,7 @@ func (r *accountRepo) GetOrCreateUserAccounts(
 		// For demo accounts, get all demo-enabled currencies
 		var demoCurrencies []struct {
 			Code               string
-			DemoInitialBalance float64
+			DemoInitialBalance decimal.Decimal
 		}
 
 		rows, err := tx.Query(ctx, `
@@ -303,7 +319,7 @@ func (r *accountRepo) GetOrCreateUserAccounts(
 		for rows.Next() {
 			var curr struct {
 				Code               string
-				DemoInitialBalance float64
+				DemoInitialBalance decimal.Decimal
 			}
 			if err := rows.Scan(&curr.Code, &curr.DemoInitialBalance); err != nil {
 				return nil, fmt.Errorf("failed to scan currency: %w", err)
@@ -326,7 +342,7 @@ func (r *accountRepo) GetOrCreateUserAccounts(
 				AccountType:    domain.AccountTypeDemo,
 				IsActive:       true,
 				IsLocked:       false,
-				OverdraftLimit: 0,
+				OverdraftLimit: decimal.Zero,
 				CreatedAt:      now,
 				UpdatedAt:      now,
 			})
@@ -342,7 +358,7 @@ func (r *accountRepo) GetOrCreateUserAccounts(
 			AccountType:    domain.AccountTypeReal,
 			IsActive:       true,
 			IsLocked:       false,
-			OverdraftLimit: 0,
+			OverdraftLimit: decimal.Zero,
 			CreatedAt:      now,
 			UpdatedAt:      now,
 		})
@@ -358,7 +374,7 @@ func (r *accountRepo) GetOrCreateUserAccounts(
 	if accountType == domain.AccountTypeDemo {
 		for _, acc := range accountsToCreate {
 			// Get demo initial balance for this currency
-			var demoBalance float64
+			var demoBalance decimal.Decimal
 			err := tx.QueryRow(ctx, `
 				SELECT demo_initial_balance
 				FROM currencies
@@ -379,6 +395,10 @@ func (r *accountRepo) GetOrCreateUserAccounts(
 			if err != nil {
 				return nil, fmt.Errorf("failed to set demo balance: %w", err)
 			}
+
+			if err := insertOpeningBalance(ctx, tx, acc.ID, demoBalance, "demo initial balance"); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -407,9 +427,10 @@ func (r *accountRepo) Create(ctx context.Context, account *domain.Account, tx pg
 			owner_type, owner_id, currency, purpose, account_type,
 			is_active, is_locked, overdraft_limit,
 			parent_agent_external_id, commission_rate,
-			account_number, created_at, updated_at
+			account_number, created_at, updated_at,
+			parent_account_id, name
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 		RETURNING id
 	`,
 		account.OwnerType,
@@ -425,9 +446,18 @@ func (r *accountRepo) Create(ctx context.Context, account *domain.Account, tx pg
 		account.AccountNumber,
 		now,
 		now,
+		account.ParentAccountID,
+		account.Name,
 	).Scan(&account.ID)
 
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			if pgErr.ConstraintName == "uq_sub_account_name" {
+				return xerrors.ErrSubAccountNameTaken
+			}
+			return xerrors.ErrDuplicateAccount
+		}
 		return fmt.Errorf("failed to insert account: %w", err)
 	}
 
@@ -482,6 +512,7 @@ func (r *accountRepo) CreateMany(
 			)
 			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 			ON CONFLICT (owner_type, owner_id, currency, purpose, account_type)
+				WHERE parent_account_id IS NULL
 			DO UPDATE SET updated_at = EXCLUDED.updated_at
 			RETURNING id, account_number, created_at, updated_at
 		`,
@@ -641,6 +672,11 @@ func (r *accountRepo) GetByFilter(ctx context.Context, f *domain.AccountFilter)
 		args = append(args, *f.ParentAgentExternalID)
 		argPos++
 	}
+	if f.ParentAccountID != nil {
+		query += fmt.Sprintf(` AND parent_account_id=$%d`, argPos)
+		args = append(args, *f.ParentAccountID)
+		argPos++
+	}
 
 	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
@@ -772,6 +808,7 @@ func (r *accountRepo) GetOrCreateAgentAccount(
 		  AND currency = $3
 		  AND purpose = $4
 		  AND account_type = 'real'
+		  AND parent_account_id IS NULL
 		LIMIT 1
 	`
 
@@ -801,7 +838,7 @@ func (r *accountRepo) GetOrCreateAgentAccount(
 		AccountType:    domain.AccountTypeReal,
 		IsActive:       true,
 		IsLocked:       false,
-		OverdraftLimit: 0,
+		OverdraftLimit: decimal.Zero,
 		CommissionRate: commissionRate,
 		AccountNumber:  fmt.Sprintf("AGT-COM-%s-%s-%d", agentExternalID, currency, now.UnixNano()),
 		CreatedAt:      now,
@@ -916,6 +953,106 @@ func (r *accountRepo) GetSystemRevenueAccount(
 	return r.GetSystemAccount(ctx, currency, domain.PurposeRevenue)
 }
 
+// GetDemoLiquidityAccount returns the demo system liquidity account.
+// Demo journals draw on it so they never touch the real SYS-LIQ account.
+func (r *accountRepo) GetDemoLiquidityAccount(
+	ctx context.Context,
+	currency string,
+) (*domain.Account, error) {
+	query := baseSelectQuery + `
+		WHERE owner_type = $1
+		  AND owner_id = $2
+		  AND currency = $3
+		  AND purpose = $4
+		  AND account_type = 'demo'
+		  AND is_active = true
+		LIMIT 1
+	`
+
+	row := r.db.QueryRow(ctx, query,
+		domain.OwnerTypeSystem,
+		"system",
+		currency,
+		domain.PurposeLiquidity,
+	)
+
+	account, err := scanAccount(row)
+	if err != nil {
+		if errors.Is(err, xerrors.ErrNotFound) {
+			return nil, fmt.Errorf("demo liquidity account not found for currency %s: %w",
+				currency, xerrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get demo liquidity account: %w", err)
+	}
+
+	return account, nil
+}
+
+// EnsureDemoLiquidityAccount creates the demo liquidity account for a
+// currency with the given float if it does not exist yet. The float is play
+// money, so it is recorded as an opening balance rather than posted.
+func (r *accountRepo) EnsureDemoLiquidityAccount(
+	ctx context.Context,
+	currency string,
+	float decimal.Decimal,
+) (*domain.Account, error) {
+	account, err := r.GetDemoLiquidityAccount(ctx, currency)
+	if err == nil {
+		return account, nil
+	}
+	if !errors.Is(err, xerrors.ErrNotFound) {
+		return nil, err
+	}
+
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now()
+	account = &domain.Account{
+		AccountNumber:  fmt.Sprintf("SYS-DEMO-LIQ-%s", currency),
+		OwnerType:      domain.OwnerTypeSystem,
+		OwnerID:        "system",
+		Currency:       currency,
+		Purpose:        domain.PurposeLiquidity,
+		AccountType:    domain.AccountTypeDemo,
+		IsActive:       true,
+		OverdraftLimit: decimal.Zero,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := r.Create(ctx, account, tx); err != nil {
+		// Another instance created it first (account_number is unique)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return r.GetDemoLiquidityAccount(ctx, currency)
+		}
+		return nil, fmt.Errorf("failed to create demo liquidity account: %w", err)
+	}
+
+	if float.IsPositive() {
+		if _, err := tx.Exec(ctx, `
+			UPDATE balances
+			SET balance = $1, available_balance = $1, updated_at = $2
+			WHERE account_id = $3
+		`, float, now, account.ID); err != nil {
+			return nil, fmt.Errorf("failed to set demo liquidity float: %w", err)
+		}
+
+		if err := insertOpeningBalance(ctx, tx, account.ID, float, "demo liquidity float"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit demo liquidity account: %w", err)
+	}
+
+	return account, nil
+}
+
 // GetAgentAccountTx retrieves agent account within a transaction
 func (r *accountRepo) GetAgentAccount(
 	ctx context.Context,
@@ -928,6 +1065,7 @@ func (r *accountRepo) GetAgentAccount(
 		  AND purpose = $3
 		  AND account_type = 'real'
 		  AND is_active = true
+		  AND parent_account_id IS NULL
 		LIMIT 1
 	`
 
@@ -962,6 +1100,7 @@ func (r *accountRepo) GetAgentAccountTx(
 		  AND purpose = $3
 		  AND account_type = 'real'
 		  AND is_active = true
+		  AND parent_account_id IS NULL
 		LIMIT 1
 	`
 
@@ -1066,7 +1205,7 @@ func (r *accountRepo) CreateSystemAccounts(
 	ctx context.Context,
 	tx pgx.Tx,
 	currency string,
-	initialBalance float64,
+	initialBalance decimal.Decimal,
 ) ([]*domain.Account, error) {
 	if tx == nil {
 		return nil, errors.New("transaction cannot be nil")
@@ -1127,7 +1266,7 @@ func (r *accountRepo) CreateSystemAccounts(
 	}
 
 	// Set initial balance for liquidity account if provided
-	if initialBalance > 0 {
+	if initialBalance.IsPositive() {
 		_, err := tx.Exec(ctx, `
 			UPDATE balances
 			SET balance = $1, available_balance = $1, updated_at = $2
@@ -1137,6 +1276,10 @@ func (r *accountRepo) CreateSystemAccounts(
 		if err != nil {
 			return nil, fmt.Errorf("failed to set initial balance: %w", err)
 		}
+
+		if err := insertOpeningBalance(ctx, tx, systemAccounts[0].ID, initialBalance, "system liquidity float"); err != nil {
+			return nil, err
+		}
 	}
 
 	return systemAccounts, nil
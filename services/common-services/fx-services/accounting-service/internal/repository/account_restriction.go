@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+// AccountRestrictionRepository stores freezes, liens and closures. Rows are
+// released, never deleted; a closure is final.
+type AccountRestrictionRepository interface {
+	// Create places a restriction. The account's balance version is bumped in
+	// the same transaction so postings validated against the old state fail
+	// their optimistic check and are retried.
+	Create(ctx context.Context, restriction *domain.AccountRestriction) error
+
+	// Release lifts an active restriction; closures cannot be released
+	Release(ctx context.Context, id int64, releasedBy, reason string) (*domain.AccountRestriction, error)
+
+	// Close records the closure and deactivates the account, provided the
+	// balance is zero with nothing pending. Returns ErrAccountNotEmpty
+	// otherwise, so a caller that swept the balance can retry.
+	Close(ctx context.Context, closure *domain.AccountRestriction) error
+
+	Get(ctx context.Context, id int64) (*domain.AccountRestriction, error)
+
+	// ListActive returns the restrictions in force on the account now
+	ListActive(ctx context.Context, accountID int64) ([]*domain.AccountRestriction, error)
+
+	List(ctx context.Context, filter *domain.AccountRestrictionFilter) ([]*domain.AccountRestriction, int64, error)
+}
+
+type accountRestrictionRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewAccountRestrictionRepo(db *pgxpool.Pool) AccountRestrictionRepository {
+	return &accountRestrictionRepo{db: db}
+}
+
+const accountRestrictionColumns = `
+	r.id, r.account_id, a.account_number, r.restriction_type, r.amount::text, r.reason, r.reference,
+	r.created_by, r.expires_at, r.created_at, r.released_at, r.released_by, r.release_reason,
+	r.sweep_account_number, r.swept_amount::text, r.sweep_receipt_code
+`
+
+func (r *accountRestrictionRepo) Create(ctx context.Context, restriction *domain.AccountRestriction) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE balances SET version = version + 1 WHERE account_id = $1
+	`, restriction.AccountID); err != nil {
+		return fmt.Errorf("failed to lock balance: %w", err)
+	}
+
+	active, err := listActiveRestrictions(ctx, tx, restriction.AccountID)
+	if err != nil {
+		return err
+	}
+	if domain.NewAccountRestrictionState(active).Closed {
+		return xerrors.ErrAccountClosed
+	}
+
+	if err := insertAccountRestriction(ctx, tx, restriction); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *accountRestrictionRepo) Release(
+	ctx context.Context,
+	id int64,
+	releasedBy, reason string,
+) (*domain.AccountRestriction, error) {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE account_restrictions
+		SET released_at = NOW(), released_by = $2, release_reason = $3
+		WHERE id = $1
+		  AND restriction_type <> 'closure'
+		  AND released_at IS NULL
+		  AND (expires_at IS NULL OR expires_at > NOW())
+	`, id, releasedBy, reason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to release account restriction: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		// Distinguish a missing row from one that is no longer releasable
+		if _, err := r.Get(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, xerrors.ErrAccountRestrictionNotActive
+	}
+	return r.Get(ctx, id)
+}
+
+func (r *accountRestrictionRepo) Close(ctx context.Context, closure *domain.AccountRestriction) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var balance, pendingDebit, pendingCredit decimal.Decimal
+	err = tx.QueryRow(ctx, `
+		SELECT balance, pending_debit, pending_credit
+		FROM balances
+		WHERE account_id = $1
+		FOR UPDATE
+	`, closure.AccountID).Scan(&balance, &pendingDebit, &pendingCredit)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to lock balance: %w", err)
+	}
+	if !balance.IsZero() || !pendingDebit.IsZero() || !pendingCredit.IsZero() {
+		return xerrors.ErrAccountNotEmpty
+	}
+
+	if err := insertAccountRestriction(ctx, tx, closure); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return xerrors.ErrAccountClosed
+		}
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE accounts SET is_active = false, updated_at = NOW() WHERE id = $1
+	`, closure.AccountID); err != nil {
+		return fmt.Errorf("failed to deactivate account: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *accountRestrictionRepo) Get(ctx context.Context, id int64) (*domain.AccountRestriction, error) {
+	restriction, err := scanAccountRestriction(r.db.QueryRow(ctx, `
+		SELECT `+accountRestrictionColumns+`
+		FROM account_restrictions r
+		JOIN accounts a ON a.id = r.account_id
+		WHERE r.id = $1
+	`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, xerrors.ErrAccountRestrictionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account restriction: %w", err)
+	}
+	return restriction, nil
+}
+
+func (r *accountRestrictionRepo) ListActive(ctx context.Context, accountID int64) ([]*domain.AccountRestriction, error) {
+	return listActiveRestrictions(ctx, r.db, accountID)
+}
+
+func (r *accountRestrictionRepo) List(
+	ctx context.Context,
+	filter *domain.AccountRestrictionFilter,
+) ([]*domain.AccountRestriction, int64, error) {
+	limit := filter.Limit
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	var restrictionType *string
+	if filter.Type != nil {
+		t := string(*filter.Type)
+		restrictionType = &t
+	}
+
+	const where = `
+		WHERE ($1::text IS NULL OR a.account_number = $1)
+		  AND ($2::text IS NULL OR r.restriction_type = $2)
+		  AND (NOT $3 OR (r.released_at IS NULL AND (r.expires_at IS NULL OR r.expires_at > NOW())))
+	`
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM account_restrictions r
+		JOIN accounts a ON a.id = r.account_id
+	`+where, filter.AccountNumber, restrictionType, filter.ActiveOnly).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count account restrictions: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT `+accountRestrictionColumns+`
+		FROM account_restrictions r
+		JOIN accounts a ON a.id = r.account_id
+	`+where+`
+		ORDER BY r.created_at DESC, r.id DESC
+		LIMIT $4 OFFSET $5
+	`, filter.AccountNumber, restrictionType, filter.ActiveOnly, limit, filter.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list account restrictions: %w", err)
+	}
+	defer rows.Close()
+
+	var restrictions []*domain.AccountRestriction
+	for rows.Next() {
+		restriction, err := scanAccountRestriction(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan account restriction: %w", err)
+		}
+		restrictions = append(restrictions, restriction)
+	}
+	return restrictions, total, rows.Err()
+}
+
+// listActiveRestrictions is shared with the transaction repository, which
+// loads restrictions inside the posting transaction
+func listActiveRestrictions(ctx context.Context, q pgxQuerier, accountID int64) ([]*domain.AccountRestriction, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+accountRestrictionColumns+`
+		FROM account_restrictions r
+		JOIN accounts a ON a.id = r.account_id
+		WHERE r.account_id = $1
+		  AND r.released_at IS NULL
+		  AND (r.expires_at IS NULL OR r.expires_at > NOW())
+		ORDER BY r.id
+	`, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load account restrictions: %w", err)
+	}
+	defer rows.Close()
+
+	var restrictions []*domain.AccountRestriction
+	for rows.Next() {
+		restriction, err := scanAccountRestriction(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan account restriction: %w", err)
+		}
+		restrictions = append(restrictions, restriction)
+	}
+	return restrictions, rows.Err()
+}
+
+func insertAccountRestriction(ctx context.Context, tx pgx.Tx, restriction *domain.AccountRestriction) error {
+	var amount, sweptAmount *string
+	if restriction.Amount != nil {
+		s := restriction.Amount.String()
+		amount = &s
+	}
+	if restriction.SweptAmount != nil {
+		s := restriction.SweptAmount.String()
+		sweptAmount = &s
+	}
+
+	err := tx.QueryRow(ctx, `
+		INSERT INTO account_restrictions (
+			account_id, restriction_type, amount, reason, reference, created_by, expires_at,
+			sweep_account_number, swept_amount, sweep_receipt_code
+		) VALUES ($1, $2, $3::numeric, $4, $5, $6, $7, $8, $9::numeric, $10)
+		RETURNING id, created_at
+	`, restriction.AccountID, restriction.Type, amount, restriction.Reason, restriction.Reference,
+		restriction.CreatedBy, restriction.ExpiresAt, restriction.SweepAccountNumber, sweptAmount,
+		restriction.SweepReceiptCode,
+	).Scan(&restriction.ID, &restriction.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create account restriction: %w", err)
+	}
+	return nil
+}
+
+func scanAccountRestriction(row pgx.Row) (*domain.AccountRestriction, error) {
+	var restriction domain.AccountRestriction
+	var amount, sweptAmount *string
+	err := row.Scan(
+		&restriction.ID, &restriction.AccountID, &restriction.AccountNumber, &restriction.Type, &amount,
+		&restriction.Reason, &restriction.Reference, &restriction.CreatedBy, &restriction.ExpiresAt,
+		&restriction.CreatedAt, &restriction.ReleasedAt, &restriction.ReleasedBy, &restriction.ReleaseReason,
+		&restriction.SweepAccountNumber, &sweptAmount, &restriction.SweepReceiptCode,
+	)
+	if err != nil {
+		return nil, err
+	}
+	restriction.Amount = parseOptionalDecimal(amount)
+	restriction.SweptAmount = parseOptionalDecimal(sweptAmount)
+	return &restriction, nil
+}
+
+func parseOptionalDecimal(s *string) *decimal.Decimal {
+	if s == nil {
+		return nil
+	}
+	d, err := decimal.NewFromString(*s)
+	if err != nil {
+		return nil
+	}
+	return &d
+}
@@ -34,6 +34,7 @@ type AgentRepository interface {
 	// Commissions
 	CreateCommission(ctx context.Context, tx pgx.Tx, c *domain.AgentCommission) (int64, error)
 	ListCommissionsForAgent(ctx context.Context, agentExternalID string, limit, offset int) ([]*domain.AgentCommission, error)
+	ListCommissionsByReceipt(ctx context.Context, receiptCode string) ([]*domain.AgentCommission, error)
 	MarkCommissionPaid(ctx context.Context, tx pgx.Tx, commissionID int64, payoutReceipt string) error
 }
 
@@ -475,6 +476,49 @@ func (p *pgRepo) ListCommissionsForAgent(ctx context.Context, agentExternalID st
 	return commissions, rows.Err()
 }
 
+// ListCommissionsByReceipt lists commissions accrued on a specific receipt
+func (p *pgRepo) ListCommissionsByReceipt(ctx context.Context, receiptCode string) ([]*domain.AgentCommission, error) {
+	rows, err := p.pool.Query(ctx, `
+		SELECT id, agent_external_id, user_external_id, agent_account_id, user_account_id, receipt_code,
+		       transaction_amount::text, commission_rate::text, commission_amount::text, currency, paid_out, payout_receipt_code, paid_out_at, created_at
+		FROM agent_commissions
+		WHERE receipt_code = $1
+		ORDER BY id ASC
+	`, receiptCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var commissions []*domain.AgentCommission
+	for rows.Next() {
+		var ac domain.AgentCommission
+		var txAmt string
+		var commAmt string
+		var commRate string
+		var payoutReceipt *string
+		var paidOutAt *time.Time
+
+		if err := rows.Scan(&ac.ID, &ac.AgentExternalID, &ac.UserExternalID, &ac.AgentAccountID, &ac.UserAccountID, &ac.ReceiptCode,
+			&txAmt, &commRate, &commAmt, &ac.Currency, &ac.PaidOut, &payoutReceipt, &paidOutAt, &ac.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		ac.TransactionAmount, _ = decimal.NewFromString(txAmt)
+		ac.CommissionAmount, _ = decimal.NewFromString(commAmt)
+		ac.CommissionRate, _ = decimal.NewFromString(commRate)
+
+		if payoutReceipt != nil {
+			ac.PayoutReceiptCode = *payoutReceipt
+		}
+		ac.PaidOutAt = paidOutAt
+
+		commissions = append(commissions, &ac)
+	}
+
+	return commissions, rows.Err()
+}
+
 // MarkCommissionPaid marks a commission as paid within a transaction
 func (p *pgRepo) MarkCommissionPaid(ctx context.Context, tx pgx.Tx, commissionID int64, payoutReceipt string) error {
 	if tx == nil {
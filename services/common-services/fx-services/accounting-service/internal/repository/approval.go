@@ -2,238 +2,447 @@
 package repository
 
 import (
-    "context"
-    "encoding/json"
-    "fmt"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
-    "accounting-service/internal/domain"
-    
-    "github.com/jackc/pgx/v5/pgxpool"
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type TransactionApprovalRepository interface {
-    Create(ctx context.Context, approval *domain.TransactionApproval) error
-    GetByID(ctx context.Context, id int64) (*domain.TransactionApproval, error)
-    List(ctx context.Context, filter *domain.ApprovalFilter) ([]*domain.TransactionApproval, int64, error)
-    UpdateStatus(ctx context.Context, id int64, status domain.ApprovalStatus, approvedBy *int64, reason *string) error
-    MarkExecuted(ctx context.Context, id int64, receiptCode string) error
-    MarkFailed(ctx context.Context, id int64, errorMsg string) error
+	Create(ctx context.Context, approval *domain.TransactionApproval) error
+	GetByID(ctx context.Context, id int64) (*domain.TransactionApproval, error)
+	List(ctx context.Context, filter *domain.ApprovalFilter) ([]*domain.TransactionApproval, int64, error)
+	UpdateStatus(ctx context.Context, id int64, status domain.ApprovalStatus, approvedBy *int64, reason *string) error
+	MarkExecuted(ctx context.Context, id int64, receiptCode string) error
+	MarkFailed(ctx context.Context, id int64, errorMsg string) error
+
+	// N-of-M decisions
+	Decide(ctx context.Context, decision *domain.ApprovalDecision, check func(*domain.TransactionApproval) error) (*domain.TransactionApproval, error)
+	ExpirePending(ctx context.Context) (int64, error)
+
+	// Durable execution of approved requests
+	ClaimApproved(ctx context.Context, limit int, lease time.Duration) ([]*domain.TransactionApproval, error)
+	ScheduleRetry(ctx context.Context, id int64, errorMsg string, backoff time.Duration) error
 }
 
 type transactionApprovalRepo struct {
-    db *pgxpool.Pool
+	db *pgxpool.Pool
 }
 
 func NewTransactionApprovalRepository(db *pgxpool.Pool) TransactionApprovalRepository {
-    return &transactionApprovalRepo{db: db}
+	return &transactionApprovalRepo{db: db}
 }
 
-func (r *transactionApprovalRepo) Create(ctx context. Context, approval *domain.TransactionApproval) error {
-    query := `
+const approvalColumns = `
+	id, requested_by, transaction_type, account_number, amount, currency,
+	description, to_account_number, status, approved_by, rejection_reason,
+	receipt_code, error_message, request_metadata,
+	policy_id, required_approvals, approver_roles, distinct_roles, expires_at, execution_attempts,
+	created_at, updated_at, approved_at, executed_at
+`
+
+func (r *transactionApprovalRepo) Create(ctx context.Context, approval *domain.TransactionApproval) error {
+	query := `
         INSERT INTO transaction_approvals (
             requested_by, transaction_type, account_number, amount, currency,
             description, to_account_number, status, request_metadata,
+            policy_id, required_approvals, approver_roles, distinct_roles, expires_at,
             created_at, updated_at
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NOW(), NOW())
         RETURNING id, created_at, updated_at
     `
 
-    metadataJSON, _ := json.Marshal(approval.RequestMetadata)
+	metadataJSON, _ := json.Marshal(approval.RequestMetadata)
 
-    return r.db.QueryRow(ctx, query,
-        approval.RequestedBy,
-        approval.TransactionType,
-        approval.AccountNumber,
-        approval.Amount,
-        approval.Currency,
-        approval.Description,
-        approval.ToAccountNumber,
-        approval.Status,
-        metadataJSON,
-    ).Scan(&approval.ID, &approval.CreatedAt, &approval.UpdatedAt)
+	return r.db.QueryRow(ctx, query,
+		approval.RequestedBy,
+		approval.TransactionType,
+		approval.AccountNumber,
+		approval.Amount,
+		approval.Currency,
+		approval.Description,
+		approval.ToAccountNumber,
+		approval.Status,
+		metadataJSON,
+		approval.PolicyID,
+		approval.RequiredApprovals,
+		approval.ApproverRoles,
+		approval.DistinctRoles,
+		approval.ExpiresAt,
+	).Scan(&approval.ID, &approval.CreatedAt, &approval.UpdatedAt)
 }
 
 func (r *transactionApprovalRepo) GetByID(ctx context.Context, id int64) (*domain.TransactionApproval, error) {
-    query := `
-        SELECT 
-            id, requested_by, transaction_type, account_number, amount, currency,
-            description, to_account_number, status, approved_by, rejection_reason,
-            receipt_code, error_message, request_metadata,
-            created_at, updated_at, approved_at, executed_at
-        FROM transaction_approvals
-        WHERE id = $1
-    `
+	approval, err := scanApproval(r.db.QueryRow(ctx,
+		`SELECT `+approvalColumns+` FROM transaction_approvals WHERE id = $1`, id))
+	if err != nil {
+		return nil, err
+	}
 
-    var approval domain.TransactionApproval
-    err := r.db. QueryRow(ctx, query, id).Scan(
-        &approval.ID,
-        &approval. RequestedBy,
-        &approval.TransactionType,
-        &approval.AccountNumber,
-        &approval.Amount,
-        &approval.Currency,
-        &approval. Description,
-        &approval.ToAccountNumber,
-        &approval. Status,
-        &approval.ApprovedBy,
-        &approval.RejectionReason,
-        &approval.ReceiptCode,
-        &approval.ErrorMessage,
-        &approval.RequestMetadata,
-        &approval.CreatedAt,
-        &approval. UpdatedAt,
-        &approval.ApprovedAt,
-        &approval.ExecutedAt,
-    )
-
-    if err != nil {
-        return nil, err
-    }
-
-    return &approval, nil
+	if err := r.loadDecisions(ctx, r.db, []*domain.TransactionApproval{approval}); err != nil {
+		return nil, err
+	}
+	return approval, nil
 }
 
 func (r *transactionApprovalRepo) List(ctx context.Context, filter *domain.ApprovalFilter) ([]*domain.TransactionApproval, int64, error) {
-    // Build query
-    baseQuery := `
-        SELECT 
-            id, requested_by, transaction_type, account_number, amount, currency,
-            description, to_account_number, status, approved_by, rejection_reason,
-            receipt_code, error_message, request_metadata,
-            created_at, updated_at, approved_at, executed_at
-        FROM transaction_approvals
-        WHERE 1=1
-    `
-    countQuery := `SELECT COUNT(*) FROM transaction_approvals WHERE 1=1`
-
-    args := []interface{}{}
-    argIndex := 1
-
-    // Add filters
-    if filter.Status != nil {
-        baseQuery += fmt.Sprintf(" AND status = $%d", argIndex)
-        countQuery += fmt.Sprintf(" AND status = $%d", argIndex)
-        args = append(args, *filter. Status)
-        argIndex++
-    }
-
-    if filter.RequestedBy != nil {
-        baseQuery += fmt.Sprintf(" AND requested_by = $%d", argIndex)
-        countQuery += fmt.Sprintf(" AND requested_by = $%d", argIndex)
-        args = append(args, *filter.RequestedBy)
-        argIndex++
-    }
-
-    if filter.FromDate != nil {
-        baseQuery += fmt.Sprintf(" AND created_at >= $%d", argIndex)
-        countQuery += fmt.Sprintf(" AND created_at >= $%d", argIndex)
-        args = append(args, *filter.FromDate)
-        argIndex++
-    }
-
-    if filter.ToDate != nil {
-        baseQuery += fmt. Sprintf(" AND created_at <= $%d", argIndex)
-        countQuery += fmt.Sprintf(" AND created_at <= $%d", argIndex)
-        args = append(args, *filter.ToDate)
-        argIndex++
-    }
-
-    // Get total count
-    var total int64
-    if err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
-        return nil, 0, err
-    }
-
-    // Add pagination
-    baseQuery += fmt.Sprintf(" ORDER BY created_at DESC LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
-    args = append(args, filter. Limit, filter.Offset)
-
-    // Execute query
-    rows, err := r.db.Query(ctx, baseQuery, args...)
-    if err != nil {
-        return nil, 0, err
-    }
-    defer rows.Close()
-
-    var approvals []*domain.TransactionApproval
-    for rows.Next() {
-        var approval domain.TransactionApproval
-        if err := rows. Scan(
-            &approval.ID,
-            &approval.RequestedBy,
-            &approval.TransactionType,
-            &approval. AccountNumber,
-            &approval. Amount,
-            &approval.Currency,
-            &approval.Description,
-            &approval.ToAccountNumber,
-            &approval.Status,
-            &approval.ApprovedBy,
-            &approval.RejectionReason,
-            &approval.ReceiptCode,
-            &approval.ErrorMessage,
-            &approval.RequestMetadata,
-            &approval.CreatedAt,
-            &approval.UpdatedAt,
-            &approval.ApprovedAt,
-            &approval.ExecutedAt,
-        ); err != nil {
-            return nil, 0, err
-        }
-        approvals = append(approvals, &approval)
-    }
-
-    return approvals, total, nil
-}
-
-// repository/transaction_approval_repository. go
+	// Build query
+	baseQuery := `SELECT ` + approvalColumns + ` FROM transaction_approvals WHERE 1=1`
+	countQuery := `SELECT COUNT(*) FROM transaction_approvals WHERE 1=1`
+
+	args := []interface{}{}
+	argIndex := 1
+
+	// Add filters
+	if filter.Status != nil {
+		baseQuery += fmt.Sprintf(" AND status = $%d", argIndex)
+		countQuery += fmt.Sprintf(" AND status = $%d", argIndex)
+		args = append(args, *filter.Status)
+		argIndex++
+	}
+
+	if filter.RequestedBy != nil {
+		baseQuery += fmt.Sprintf(" AND requested_by = $%d", argIndex)
+		countQuery += fmt.Sprintf(" AND requested_by = $%d", argIndex)
+		args = append(args, *filter.RequestedBy)
+		argIndex++
+	}
+
+	if filter.ApprovedBy != nil {
+		clause := fmt.Sprintf(" AND id IN (SELECT approval_id FROM transaction_approval_decisions WHERE approver_id = $%d)", argIndex)
+		baseQuery += clause
+		countQuery += clause
+		args = append(args, *filter.ApprovedBy)
+		argIndex++
+	}
+
+	if filter.FromDate != nil {
+		baseQuery += fmt.Sprintf(" AND created_at >= $%d", argIndex)
+		countQuery += fmt.Sprintf(" AND created_at >= $%d", argIndex)
+		args = append(args, *filter.FromDate)
+		argIndex++
+	}
+
+	if filter.ToDate != nil {
+		baseQuery += fmt.Sprintf(" AND created_at <= $%d", argIndex)
+		countQuery += fmt.Sprintf(" AND created_at <= $%d", argIndex)
+		args = append(args, *filter.ToDate)
+		argIndex++
+	}
+
+	// Get total count
+	var total int64
+	if err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// Add pagination
+	baseQuery += fmt.Sprintf(" ORDER BY created_at DESC LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	args = append(args, filter.Limit, filter.Offset)
+
+	// Execute query
+	rows, err := r.db.Query(ctx, baseQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var approvals []*domain.TransactionApproval
+	for rows.Next() {
+		approval, err := scanApproval(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		approvals = append(approvals, approval)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.loadDecisions(ctx, r.db, approvals); err != nil {
+		return nil, 0, err
+	}
+	return approvals, total, nil
+}
 
 func (r *transactionApprovalRepo) UpdateStatus(ctx context.Context, id int64, status domain.ApprovalStatus, approvedBy *int64, reason *string) error {
-    query := `
+	query := `
         UPDATE transaction_approvals
-        SET 
+        SET
             status = $1:: approval_status_enum,  -- ✅ Cast to enum type
             approved_by = $2,
             rejection_reason = $3,
-            approved_at = CASE 
-                WHEN $1:: approval_status_enum IN ('approved'::approval_status_enum, 'rejected'::approval_status_enum) 
-                THEN NOW() 
-                ELSE approved_at 
+            approved_at = CASE
+                WHEN $1:: approval_status_enum IN ('approved'::approval_status_enum, 'rejected'::approval_status_enum)
+                THEN NOW()
+                ELSE approved_at
             END,
             updated_at = NOW()
         WHERE id = $4
     `
 
-    _, err := r.db.Exec(ctx, query, status, approvedBy, reason, id)
-    return err
+	_, err := r.db.Exec(ctx, query, status, approvedBy, reason, id)
+	return err
 }
 
 func (r *transactionApprovalRepo) MarkExecuted(ctx context.Context, id int64, receiptCode string) error {
-    query := `
+	query := `
         UPDATE transaction_approvals
-        SET 
+        SET
             status = 'executed',
             receipt_code = $1,
+            error_message = NULL,
+            next_execution_at = NULL,
             executed_at = NOW(),
             updated_at = NOW()
         WHERE id = $2
     `
 
-    _, err := r.db.Exec(ctx, query, receiptCode, id)
-    return err
+	_, err := r.db.Exec(ctx, query, receiptCode, id)
+	return err
 }
 
 func (r *transactionApprovalRepo) MarkFailed(ctx context.Context, id int64, errorMsg string) error {
-    query := `
+	query := `
         UPDATE transaction_approvals
-        SET 
+        SET
             status = 'failed',
             error_message = $1,
+            next_execution_at = NULL,
             executed_at = NOW(),
             updated_at = NOW()
         WHERE id = $2
     `
 
-    _, err := r.db. Exec(ctx, query, errorMsg, id)
-    return err
-}
\ No newline at end of file
+	_, err := r.db.Exec(ctx, query, errorMsg, id)
+	return err
+}
+
+// Decide records one approver's decision under a row lock, so concurrent
+// approvers cannot both complete (or both miss) the quorum. check validates
+// the locked request (status, expiry, role) before the decision is written.
+// A rejection settles the request; an approval settles it once QuorumReached.
+func (r *transactionApprovalRepo) Decide(
+	ctx context.Context,
+	decision *domain.ApprovalDecision,
+	check func(*domain.TransactionApproval) error,
+) (*domain.TransactionApproval, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	approval, err := scanApproval(tx.QueryRow(ctx,
+		`SELECT `+approvalColumns+` FROM transaction_approvals WHERE id = $1 FOR UPDATE`, decision.ApprovalID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, xerrors.ErrApprovalNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock approval: %w", err)
+	}
+	if err := r.loadDecisions(ctx, tx, []*domain.TransactionApproval{approval}); err != nil {
+		return nil, err
+	}
+
+	if err := check(approval); err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO transaction_approval_decisions (approval_id, approver_id, approver_role, approved, reason)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`, decision.ApprovalID, decision.ApproverID, decision.ApproverRole, decision.Approved, decision.Reason,
+	).Scan(&decision.ID, &decision.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, xerrors.ErrApprovalAlreadyVoted
+		}
+		return nil, fmt.Errorf("failed to record decision: %w", err)
+	}
+	approval.Decisions = append(approval.Decisions, decision)
+
+	status := domain.ApprovalStatusPending
+	switch {
+	case !decision.Approved:
+		status = domain.ApprovalStatusRejected
+		approval.RejectionReason = decision.Reason
+	case approval.QuorumReached():
+		status = domain.ApprovalStatusApproved
+	}
+
+	if status != domain.ApprovalStatusPending {
+		err = tx.QueryRow(ctx, `
+			UPDATE transaction_approvals
+			SET status = $2::approval_status_enum,
+			    approved_by = $3,
+			    rejection_reason = $4,
+			    approved_at = NOW(),
+			    next_execution_at = CASE WHEN $2::approval_status_enum = 'approved' THEN NOW() END,
+			    updated_at = NOW()
+			WHERE id = $1
+			RETURNING approved_by, approved_at, updated_at
+		`, approval.ID, status, decision.ApproverID, approval.RejectionReason,
+		).Scan(&approval.ApprovedBy, &approval.ApprovedAt, &approval.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update approval status: %w", err)
+		}
+		approval.Status = status
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit decision: %w", err)
+	}
+	return approval, nil
+}
+
+// ExpirePending moves pending requests past expires_at to expired
+func (r *transactionApprovalRepo) ExpirePending(ctx context.Context) (int64, error) {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE transaction_approvals
+		SET status = 'expired', updated_at = NOW()
+		WHERE status = 'pending' AND expires_at IS NOT NULL AND expires_at < NOW()
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire approvals: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// ClaimApproved leases approved requests that are due for execution. The
+// lease pushes next_execution_at forward, so a request whose executor died is
+// picked up again once it runs out.
+func (r *transactionApprovalRepo) ClaimApproved(ctx context.Context, limit int, lease time.Duration) ([]*domain.TransactionApproval, error) {
+	rows, err := r.db.Query(ctx, `
+		WITH due AS (
+			SELECT id
+			FROM transaction_approvals
+			WHERE status = 'approved' AND next_execution_at <= NOW()
+			ORDER BY next_execution_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE transaction_approvals t
+		SET next_execution_at = NOW() + make_interval(secs => $2),
+		    execution_attempts = t.execution_attempts + 1,
+		    updated_at = NOW()
+		FROM due
+		WHERE t.id = due.id
+		RETURNING t.id, t.requested_by, t.transaction_type, t.account_number, t.amount, t.currency,
+		          t.description, t.to_account_number, t.status, t.approved_by, t.rejection_reason,
+		          t.receipt_code, t.error_message, t.request_metadata,
+		          t.policy_id, t.required_approvals, t.approver_roles, t.distinct_roles, t.expires_at, t.execution_attempts,
+		          t.created_at, t.updated_at, t.approved_at, t.executed_at
+	`, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim approved requests: %w", err)
+	}
+	defer rows.Close()
+
+	var approvals []*domain.TransactionApproval
+	for rows.Next() {
+		approval, err := scanApproval(rows)
+		if err != nil {
+			return nil, err
+		}
+		approvals = append(approvals, approval)
+	}
+	return approvals, rows.Err()
+}
+
+// ScheduleRetry keeps the request approved and due again after backoff
+func (r *transactionApprovalRepo) ScheduleRetry(ctx context.Context, id int64, errorMsg string, backoff time.Duration) error {
+	_, err := r.db.Exec(ctx, `
+		UPDATE transaction_approvals
+		SET error_message = $2,
+		    next_execution_at = NOW() + make_interval(secs => $3),
+		    updated_at = NOW()
+		WHERE id = $1 AND status = 'approved'
+	`, id, errorMsg, backoff.Seconds())
+	return err
+}
+
+// loadDecisions attaches decisions, oldest first
+func (r *transactionApprovalRepo) loadDecisions(ctx context.Context, q pgxQuerier, approvals []*domain.TransactionApproval) error {
+	if len(approvals) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*domain.TransactionApproval, len(approvals))
+	ids := make([]int64, 0, len(approvals))
+	for _, a := range approvals {
+		byID[a.ID] = a
+		ids = append(ids, a.ID)
+	}
+
+	rows, err := q.Query(ctx, `
+		SELECT id, approval_id, approver_id, approver_role, approved, reason, created_at
+		FROM transaction_approval_decisions
+		WHERE approval_id = ANY($1)
+		ORDER BY id
+	`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to load approval decisions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var d domain.ApprovalDecision
+		if err := rows.Scan(&d.ID, &d.ApprovalID, &d.ApproverID, &d.ApproverRole, &d.Approved, &d.Reason, &d.CreatedAt); err != nil {
+			return fmt.Errorf("failed to scan approval decision: %w", err)
+		}
+		if a := byID[d.ApprovalID]; a != nil {
+			a.Decisions = append(a.Decisions, &d)
+		}
+	}
+	return rows.Err()
+}
+
+// pgxQuerier is satisfied by both the pool and a transaction
+type pgxQuerier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func scanApproval(row pgx.Row) (*domain.TransactionApproval, error) {
+	var approval domain.TransactionApproval
+	err := row.Scan(
+		&approval.ID,
+		&approval.RequestedBy,
+		&approval.TransactionType,
+		&approval.AccountNumber,
+		&approval.Amount,
+		&approval.Currency,
+		&approval.Description,
+		&approval.ToAccountNumber,
+		&approval.Status,
+		&approval.ApprovedBy,
+		&approval.RejectionReason,
+		&approval.ReceiptCode,
+		&approval.ErrorMessage,
+		&approval.RequestMetadata,
+		&approval.PolicyID,
+		&approval.RequiredApprovals,
+		&approval.ApproverRoles,
+		&approval.DistinctRoles,
+		&approval.ExpiresAt,
+		&approval.ExecutionAttempts,
+		&approval.CreatedAt,
+		&approval.UpdatedAt,
+		&approval.ApprovedAt,
+		&approval.ExecutedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &approval, nil
+}
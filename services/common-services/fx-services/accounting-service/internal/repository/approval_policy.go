@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+type ApprovalPolicyRepository interface {
+	Create(ctx context.Context, policy *domain.ApprovalPolicy) error
+	Update(ctx context.Context, policy *domain.ApprovalPolicy) error
+	GetByID(ctx context.Context, id int64) (*domain.ApprovalPolicy, error)
+	List(ctx context.Context, filter *domain.ApprovalPolicyFilter) ([]*domain.ApprovalPolicy, error)
+
+	// Match returns the active policy for an operation, or nil when none applies
+	Match(ctx context.Context, txType domain.TransactionType, currency string, amount decimal.Decimal) (*domain.ApprovalPolicy, error)
+}
+
+type approvalPolicyRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewApprovalPolicyRepo(db *pgxpool.Pool) ApprovalPolicyRepository {
+	return &approvalPolicyRepo{db: db}
+}
+
+const approvalPolicyColumns = `
+	id, name, transaction_type, currency, min_amount, max_amount, required_approvals,
+	approver_roles, distinct_roles, expires_after_seconds, priority, is_active,
+	created_by, created_at, updated_at
+`
+
+func (r *approvalPolicyRepo) Create(ctx context.Context, p *domain.ApprovalPolicy) error {
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO approval_policies (
+			name, transaction_type, currency, min_amount, max_amount, required_approvals,
+			approver_roles, distinct_roles, expires_after_seconds, priority, is_active, created_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id, created_at, updated_at
+	`, p.Name, p.TransactionType, p.Currency, p.MinAmount, p.MaxAmount, p.RequiredApprovals,
+		p.ApproverRoles, p.DistinctRoles, int64(p.ExpiresAfter/time.Second), p.Priority, p.IsActive, p.CreatedBy,
+	).Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create approval policy: %w", err)
+	}
+	return nil
+}
+
+func (r *approvalPolicyRepo) Update(ctx context.Context, p *domain.ApprovalPolicy) error {
+	err := r.db.QueryRow(ctx, `
+		UPDATE approval_policies
+		SET name = $2, transaction_type = $3, currency = $4, min_amount = $5, max_amount = $6,
+		    required_approvals = $7, approver_roles = $8, distinct_roles = $9,
+		    expires_after_seconds = $10, priority = $11, is_active = $12, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`, p.ID, p.Name, p.TransactionType, p.Currency, p.MinAmount, p.MaxAmount, p.RequiredApprovals,
+		p.ApproverRoles, p.DistinctRoles, int64(p.ExpiresAfter/time.Second), p.Priority, p.IsActive,
+	).Scan(&p.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return xerrors.ErrApprovalPolicyNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update approval policy: %w", err)
+	}
+	return nil
+}
+
+func (r *approvalPolicyRepo) GetByID(ctx context.Context, id int64) (*domain.ApprovalPolicy, error) {
+	p, err := scanApprovalPolicy(r.db.QueryRow(ctx,
+		`SELECT `+approvalPolicyColumns+` FROM approval_policies WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, xerrors.ErrApprovalPolicyNotFound
+	}
+	return p, err
+}
+
+func (r *approvalPolicyRepo) List(ctx context.Context, filter *domain.ApprovalPolicyFilter) ([]*domain.ApprovalPolicy, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+approvalPolicyColumns+`
+		FROM approval_policies
+		WHERE ($1::transaction_type_enum IS NULL OR transaction_type = $1)
+		  AND ($2::text IS NULL OR currency = $2)
+		  AND (NOT $3 OR is_active)
+		ORDER BY transaction_type NULLS LAST, currency NULLS LAST, min_amount, priority DESC, id
+	`, filter.TransactionType, filter.Currency, filter.ActiveOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approval policies: %w", err)
+	}
+	defer rows.Close()
+
+	var policies []*domain.ApprovalPolicy
+	for rows.Next() {
+		p, err := scanApprovalPolicy(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan approval policy: %w", err)
+		}
+		policies = append(policies, p)
+	}
+	return policies, rows.Err()
+}
+
+func (r *approvalPolicyRepo) Match(
+	ctx context.Context,
+	txType domain.TransactionType,
+	currency string,
+	amount decimal.Decimal,
+) (*domain.ApprovalPolicy, error) {
+	p, err := scanApprovalPolicy(r.db.QueryRow(ctx, `
+		SELECT `+approvalPolicyColumns+`
+		FROM approval_policies
+		WHERE is_active
+		  AND (transaction_type IS NULL OR transaction_type = $1)
+		  AND (currency IS NULL OR currency = $2)
+		  AND min_amount <= $3
+		  AND (max_amount IS NULL OR $3 < max_amount)
+		ORDER BY (transaction_type IS NOT NULL) DESC,
+		         (currency IS NOT NULL) DESC,
+		         priority DESC,
+		         min_amount DESC
+		LIMIT 1
+	`, txType, currency, amount))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to match approval policy: %w", err)
+	}
+	return p, nil
+}
+
+func scanApprovalPolicy(row pgx.Row) (*domain.ApprovalPolicy, error) {
+	var p domain.ApprovalPolicy
+	var expiresAfterSeconds int64
+	err := row.Scan(
+		&p.ID, &p.Name, &p.TransactionType, &p.Currency, &p.MinAmount, &p.MaxAmount, &p.RequiredApprovals,
+		&p.ApproverRoles, &p.DistinctRoles, &expiresAfterSeconds, &p.Priority, &p.IsActive,
+		&p.CreatedBy, &p.CreatedAt, &p.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.ExpiresAfter = time.Duration(expiresAfterSeconds) * time.Second
+	return &p, nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
 )
 
 type BalanceRepository interface {
@@ -28,8 +29,8 @@ type BalanceRepository interface {
 	UpdateBalanceOptimistic(ctx context.Context, tx pgx.Tx, update *domain.BalanceUpdate, expectedVersion int64) error
 
 	// Pending balance operations
-	ReserveFunds(ctx context.Context, tx pgx.Tx, accountID int64, amount float64) error
-	ReleaseFunds(ctx context.Context, tx pgx.Tx, accountID int64, amount float64, complete bool) error
+	ReserveFunds(ctx context.Context, tx pgx.Tx, accountID int64, amount decimal.Decimal) error
+	ReleaseFunds(ctx context.Context, tx pgx.Tx, accountID int64, amount decimal.Decimal, complete bool) error
 
 	// Utility methods
 	GetCachedBalance(ctx context.Context, accountNumber string) (*domain.Balance, error)
@@ -266,15 +267,15 @@ func (r *balanceRepo) UpdateBalance(ctx context.Context, tx pgx.Tx, update *doma
 	newAvailable := balance.AvailableBalance
 
 	if update.DrCr == "CR" {
-		newBalance += update.Amount
-		newAvailable += update.Amount
+		newBalance = newBalance.Add(update.Amount)
+		newAvailable = newAvailable.Add(update.Amount)
 	} else { // DR
-		newBalance -= update.Amount
-		newAvailable -= update.Amount
+		newBalance = newBalance.Sub(update.Amount)
+		newAvailable = newAvailable.Sub(update.Amount)
 	}
 
 	// Check for negative available balance (overdraft protection)
-	if newAvailable < 0 {
+	if newAvailable.IsNegative() {
 		return xerrors.ErrInsufficientFunds
 	}
 
@@ -404,15 +405,15 @@ func (r *balanceRepo) UpdateBalanceBatch(ctx context.Context, tx pgx.Tx, updates
 		newAvailable := balance.AvailableBalance
 
 		if update.DrCr == "CR" {
-			newBalance += update.Amount
-			newAvailable += update.Amount
+			newBalance = newBalance.Add(update.Amount)
+			newAvailable = newAvailable.Add(update.Amount)
 		} else {
-			newBalance -= update.Amount
-			newAvailable -= update.Amount
+			newBalance = newBalance.Sub(update.Amount)
+			newAvailable = newAvailable.Sub(update.Amount)
 		}
 
 		// Overdraft check
-		if newAvailable < 0 {
+		if newAvailable.IsNegative() {
 			return fmt.Errorf("insufficient funds for account %d: %w", update.AccountID, xerrors.ErrInsufficientFunds)
 		}
 
@@ -458,11 +459,11 @@ func (r *balanceRepo) UpdateBalanceOptimistic(ctx context.Context, tx pgx.Tx, up
 
 	delta := update.Amount
 	if update.DrCr == "DR" {
-		delta = -delta
+		delta = delta.Neg()
 	}
 
-	var newBalance, newAvailable float64  // ✅ Changed to float64
-	var newVersion int64                   // ✅ Version stays int64
+	var newBalance, newAvailable decimal.Decimal
+	var newVersion int64
 	err := tx.QueryRow(ctx, query,
 		delta,
 		delta,
@@ -480,7 +481,7 @@ func (r *balanceRepo) UpdateBalanceOptimistic(ctx context.Context, tx pgx.Tx, up
 	}
 
 	// Check for overdraft
-	if newAvailable < 0 {
+	if newAvailable.IsNegative() {
 		return xerrors.ErrInsufficientFunds
 	}
 
@@ -488,7 +489,7 @@ func (r *balanceRepo) UpdateBalanceOptimistic(ctx context.Context, tx pgx.Tx, up
 }
 
 // ReserveFunds reserves funds for pending transactions (locks available balance)
-func (r *balanceRepo) ReserveFunds(ctx context.Context, tx pgx.Tx, accountID int64, amount float64) error {
+func (r *balanceRepo) ReserveFunds(ctx context.Context, tx pgx.Tx, accountID int64, amount decimal.Decimal) error {
 	if tx == nil {
 		return errors.New("transaction cannot be nil")
 	}
@@ -504,7 +505,7 @@ func (r *balanceRepo) ReserveFunds(ctx context.Context, tx pgx.Tx, accountID int
 		RETURNING available_balance
 	`
 
-	var newAvailable float64
+	var newAvailable decimal.Decimal
 	err := tx.QueryRow(ctx, query, amount, time.Now(), accountID).Scan(&newAvailable)
 
 	if err != nil {
@@ -514,7 +515,7 @@ func (r *balanceRepo) ReserveFunds(ctx context.Context, tx pgx.Tx, accountID int
 		return fmt.Errorf("failed to reserve funds: %w", err)
 	}
 
-	if newAvailable < 0 {
+	if newAvailable.IsNegative() {
 		return xerrors.ErrInsufficientFunds
 	}
 
@@ -522,7 +523,7 @@ func (r *balanceRepo) ReserveFunds(ctx context.Context, tx pgx.Tx, accountID int
 }
 
 // ReleaseFunds releases reserved funds (complete=true: deduct from balance, complete=false: return to available)
-func (r *balanceRepo) ReleaseFunds(ctx context.Context, tx pgx.Tx, accountID int64, amount float64, complete bool) error {
+func (r *balanceRepo) ReleaseFunds(ctx context.Context, tx pgx.Tx, accountID int64, amount decimal.Decimal, complete bool) error {
 	if tx == nil {
 		return errors.New("transaction cannot be nil")
 	}
@@ -625,8 +626,8 @@ func (r *balanceRepo) EnsureBalanceExists(ctx context.Context, tx pgx.Tx, accoun
 
 // createInitialBalance creates a new balance record with initial transaction
 func (r *balanceRepo) createInitialBalance(ctx context.Context, tx pgx.Tx, update *domain.BalanceUpdate) error {
-	initialBalance := float64(0)
-	initialAvailable := float64(0)
+	initialBalance := decimal.Zero
+	initialAvailable := decimal.Zero
 
 	if update.DrCr == "CR" {
 		initialBalance = update.Amount
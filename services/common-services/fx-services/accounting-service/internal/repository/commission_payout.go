@@ -0,0 +1,313 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"accounting-service/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+type CommissionPayoutRepository interface {
+	// SummarizeUnpaid groups unpaid, unreserved commissions per agent and currency
+	SummarizeUnpaid(ctx context.Context, req *domain.CommissionPayoutBatchRequest) ([]*domain.UnpaidCommissionGroup, error)
+
+	// Reserve locks the group's unpaid commissions, and when their sum reaches
+	// minAmount records the payout as pending and links the commissions to it.
+	// Returns false when the group no longer qualifies.
+	Reserve(ctx context.Context, payout *domain.CommissionPayout, minAmount decimal.Decimal) (bool, error)
+
+	// MarkPaid records the payout receipt on the payout and every linked commission
+	MarkPaid(ctx context.Context, payoutID int64, receiptCode string) error
+
+	// MarkFailed records the error and releases the linked commissions of a
+	// payout that is still pending
+	MarkFailed(ctx context.Context, payoutID int64, errMsg string) error
+
+	// ClaimStalePending leases pending payouts whose batch died before
+	// recording a result. A claimed payout is not returned again until the
+	// lease runs out, so concurrent batches never resume the same payout.
+	ClaimStalePending(ctx context.Context, olderThan, lease time.Duration, agentExternalID, currency *string) ([]*domain.CommissionPayout, error)
+
+	List(ctx context.Context, filter *domain.CommissionPayoutFilter) ([]*domain.CommissionPayout, int64, error)
+}
+
+type commissionPayoutRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewCommissionPayoutRepo(db *pgxpool.Pool) CommissionPayoutRepository {
+	return &commissionPayoutRepo{db: db}
+}
+
+const commissionPayoutColumns = `
+	id, batch_id, agent_external_id, currency, amount::text, commission_count, period_from, period_to,
+	status, receipt_code, error_message, created_by, created_at, paid_at
+`
+
+func (r *commissionPayoutRepo) SummarizeUnpaid(
+	ctx context.Context,
+	req *domain.CommissionPayoutBatchRequest,
+) ([]*domain.UnpaidCommissionGroup, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT agent_external_id, currency, SUM(commission_amount)::text, COUNT(*)
+		FROM agent_commissions
+		WHERE NOT paid_out
+		  AND payout_id IS NULL
+		  AND ($1::text IS NULL OR agent_external_id = $1)
+		  AND ($2::text IS NULL OR currency = $2)
+		  AND ($3::timestamptz IS NULL OR created_at >= $3)
+		  AND created_at < $4
+		GROUP BY agent_external_id, currency
+		ORDER BY agent_external_id, currency
+	`, req.AgentExternalID, req.Currency, req.PeriodFrom, req.PeriodTo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize unpaid commissions: %w", err)
+	}
+	defer rows.Close()
+
+	var groups []*domain.UnpaidCommissionGroup
+	for rows.Next() {
+		var g domain.UnpaidCommissionGroup
+		var amount string
+		if err := rows.Scan(&g.AgentExternalID, &g.Currency, &amount, &g.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan unpaid commissions: %w", err)
+		}
+		g.Amount, _ = decimal.NewFromString(amount)
+		groups = append(groups, &g)
+	}
+	return groups, rows.Err()
+}
+
+func (r *commissionPayoutRepo) Reserve(
+	ctx context.Context,
+	p *domain.CommissionPayout,
+	minAmount decimal.Decimal,
+) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Rows taken by a concurrent batch are skipped, so the sum is re-checked here
+	rows, err := tx.Query(ctx, `
+		SELECT id, commission_amount::text
+		FROM agent_commissions
+		WHERE NOT paid_out
+		  AND payout_id IS NULL
+		  AND agent_external_id = $1
+		  AND currency = $2
+		  AND ($3::timestamptz IS NULL OR created_at >= $3)
+		  AND created_at < $4
+		ORDER BY id
+		FOR UPDATE SKIP LOCKED
+	`, p.AgentExternalID, p.Currency, p.PeriodFrom, p.PeriodTo)
+	if err != nil {
+		return false, fmt.Errorf("failed to lock commissions: %w", err)
+	}
+
+	var ids []int64
+	total := decimal.Zero
+	for rows.Next() {
+		var id int64
+		var amount string
+		if err := rows.Scan(&id, &amount); err != nil {
+			rows.Close()
+			return false, fmt.Errorf("failed to scan commission: %w", err)
+		}
+		a, _ := decimal.NewFromString(amount)
+		total = total.Add(a)
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return false, fmt.Errorf("failed to lock commissions: %w", err)
+	}
+
+	if len(ids) == 0 || !total.IsPositive() || total.LessThan(minAmount) {
+		return false, nil
+	}
+
+	p.Amount = total
+	p.CommissionCount = len(ids)
+	p.Status = domain.CommissionPayoutPending
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO commission_payouts (
+			batch_id, agent_external_id, currency, amount, commission_count,
+			period_from, period_to, status, created_by
+		) VALUES ($1, $2, $3, $4::numeric, $5, $6, $7, $8, $9)
+		RETURNING id, created_at
+	`, p.BatchID, p.AgentExternalID, p.Currency, p.Amount.String(), p.CommissionCount,
+		p.PeriodFrom, p.PeriodTo, p.Status, p.CreatedBy,
+	).Scan(&p.ID, &p.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to create commission payout: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE agent_commissions SET payout_id = $1 WHERE id = ANY($2)
+	`, p.ID, ids); err != nil {
+		return false, fmt.Errorf("failed to reserve commissions: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit commission payout: %w", err)
+	}
+	return true, nil
+}
+
+func (r *commissionPayoutRepo) MarkPaid(ctx context.Context, payoutID int64, receiptCode string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE commission_payouts
+		SET status = 'paid', receipt_code = $2, error_message = NULL, paid_at = NOW()
+		WHERE id = $1
+	`, payoutID, receiptCode); err != nil {
+		return fmt.Errorf("failed to mark payout paid: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE agent_commissions
+		SET paid_out = true, payout_receipt_code = $2, paid_out_at = NOW()
+		WHERE payout_id = $1
+	`, payoutID, receiptCode); err != nil {
+		return fmt.Errorf("failed to mark commissions paid: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *commissionPayoutRepo) MarkFailed(ctx context.Context, payoutID int64, errMsg string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// A payout recorded as paid in the meantime keeps its commissions
+	tag, err := tx.Exec(ctx, `
+		UPDATE commission_payouts SET status = 'failed', error_message = $2
+		WHERE id = $1 AND status = 'pending'
+	`, payoutID, errMsg)
+	if err != nil {
+		return fmt.Errorf("failed to mark payout failed: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("payout %d is no longer pending", payoutID)
+	}
+
+	// Released commissions are picked up by the next batch
+	if _, err := tx.Exec(ctx, `
+		UPDATE agent_commissions SET payout_id = NULL WHERE payout_id = $1 AND NOT paid_out
+	`, payoutID); err != nil {
+		return fmt.Errorf("failed to release commissions: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *commissionPayoutRepo) ClaimStalePending(
+	ctx context.Context,
+	olderThan, lease time.Duration,
+	agentExternalID, currency *string,
+) ([]*domain.CommissionPayout, error) {
+	rows, err := r.db.Query(ctx, `
+		UPDATE commission_payouts
+		SET lease_until = NOW() + make_interval(secs => $4)
+		WHERE id IN (
+			SELECT id FROM commission_payouts
+			WHERE status = 'pending'
+			  AND created_at < NOW() - make_interval(secs => $1)
+			  AND (lease_until IS NULL OR lease_until < NOW())
+			  AND ($2::text IS NULL OR agent_external_id = $2)
+			  AND ($3::text IS NULL OR currency = $3)
+			ORDER BY id
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+commissionPayoutColumns+`
+	`, olderThan.Seconds(), agentExternalID, currency, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim pending payouts: %w", err)
+	}
+	defer rows.Close()
+
+	payouts, err := collectCommissionPayouts(rows)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(payouts, func(i, j int) bool { return payouts[i].ID < payouts[j].ID })
+	return payouts, nil
+}
+
+func (r *commissionPayoutRepo) List(
+	ctx context.Context,
+	filter *domain.CommissionPayoutFilter,
+) ([]*domain.CommissionPayout, int64, error) {
+	const where = `
+		WHERE ($1::text IS NULL OR agent_external_id = $1)
+		  AND ($2::text IS NULL OR batch_id = $2)
+		  AND ($3::text IS NULL OR currency = $3)
+		  AND ($4::text IS NULL OR status = $4)
+	`
+	args := []interface{}{filter.AgentExternalID, filter.BatchID, filter.Currency, filter.Status}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM commission_payouts`+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count commission payouts: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT `+commissionPayoutColumns+`
+		FROM commission_payouts`+where+`
+		ORDER BY created_at DESC, id DESC
+		LIMIT $5 OFFSET $6
+	`, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list commission payouts: %w", err)
+	}
+	defer rows.Close()
+
+	payouts, err := collectCommissionPayouts(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return payouts, total, nil
+}
+
+func collectCommissionPayouts(rows pgx.Rows) ([]*domain.CommissionPayout, error) {
+	var payouts []*domain.CommissionPayout
+	for rows.Next() {
+		p, err := scanCommissionPayout(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan commission payout: %w", err)
+		}
+		payouts = append(payouts, p)
+	}
+	return payouts, rows.Err()
+}
+
+func scanCommissionPayout(row pgx.Row) (*domain.CommissionPayout, error) {
+	var p domain.CommissionPayout
+	var amount string
+	err := row.Scan(
+		&p.ID, &p.BatchID, &p.AgentExternalID, &p.Currency, &amount, &p.CommissionCount, &p.PeriodFrom, &p.PeriodTo,
+		&p.Status, &p.ReceiptCode, &p.ErrorMessage, &p.CreatedBy, &p.CreatedAt, &p.PaidAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.Amount, _ = decimal.NewFromString(amount)
+	return &p, nil
+}
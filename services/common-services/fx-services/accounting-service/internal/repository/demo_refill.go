@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+// DemoRefillRepository records demo wallet top-ups and resets and enforces
+// their cooldowns. The journal itself is posted by the transaction usecase
+// between Reserve and MarkPosted.
+type DemoRefillRepository interface {
+	// Reserve inserts a pending refill. The account's balance row is locked
+	// while the cooldown is checked, so concurrent requests for one account
+	// are serialised. Returns ErrDemoRefillCooldown while the newest
+	// non-failed refill of the same action is younger than cooldown, and
+	// ErrConcurrentModification if the balance moved since BalanceBefore was
+	// read.
+	Reserve(ctx context.Context, refill *domain.DemoRefill, cooldown time.Duration) error
+
+	// MarkPosted records the journal's receipt. A posted reset also bumps
+	// the owner's reset counter in demo_account_metadata.
+	MarkPosted(ctx context.Context, refill *domain.DemoRefill, receiptCode string) error
+
+	// MarkFailed releases the reservation so it no longer counts towards
+	// the cooldown
+	MarkFailed(ctx context.Context, id int64, reason string) error
+}
+
+type demoRefillRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewDemoRefillRepo(db *pgxpool.Pool) DemoRefillRepository {
+	return &demoRefillRepo{db: db}
+}
+
+func (r *demoRefillRepo) Reserve(ctx context.Context, refill *domain.DemoRefill, cooldown time.Duration) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var balance decimal.Decimal
+	err = tx.QueryRow(ctx, `
+		SELECT balance FROM balances WHERE account_id = $1 FOR UPDATE
+	`, refill.AccountID).Scan(&balance)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return xerrors.ErrBalanceNotFound
+		}
+		return fmt.Errorf("failed to lock balance: %w", err)
+	}
+	if !balance.Equal(refill.BalanceBefore) {
+		return xerrors.ErrConcurrentModification
+	}
+
+	if cooldown > 0 {
+		var lastAt time.Time
+		err = tx.QueryRow(ctx, `
+			SELECT created_at
+			FROM demo_account_refills
+			WHERE account_id = $1 AND action = $2 AND status <> 'failed'
+			ORDER BY created_at DESC
+			LIMIT 1
+		`, refill.AccountID, refill.Action).Scan(&lastAt)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to check demo refill cooldown: %w", err)
+		}
+		if err == nil {
+			if next := lastAt.Add(cooldown); time.Now().Before(next) {
+				return fmt.Errorf("%w: next %s available at %s",
+					xerrors.ErrDemoRefillCooldown, refill.Action, next.UTC().Format(time.RFC3339))
+			}
+		}
+	}
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO demo_account_refills (
+			account_id, action, amount, balance_before, balance_after, status, requested_by
+		)
+		VALUES ($1, $2, $3, $4, $5, 'pending', $6)
+		RETURNING id, status, created_at
+	`,
+		refill.AccountID,
+		refill.Action,
+		refill.Amount,
+		refill.BalanceBefore,
+		refill.BalanceAfter,
+		refill.RequestedBy,
+	).Scan(&refill.ID, &refill.Status, &refill.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to reserve demo refill: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *demoRefillRepo) MarkPosted(ctx context.Context, refill *domain.DemoRefill, receiptCode string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, `
+		UPDATE demo_account_refills
+		SET status = 'posted', receipt_code = $2, last_error = NULL, posted_at = NOW()
+		WHERE id = $1
+		RETURNING posted_at
+	`, refill.ID, receiptCode).Scan(&refill.PostedAt)
+	if err != nil {
+		return fmt.Errorf("failed to mark demo refill posted: %w", err)
+	}
+
+	if refill.Action == domain.DemoRefillReset {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO demo_account_metadata (user_external_id, demo_reset_count, last_demo_reset)
+			VALUES ($1, 1, NOW())
+			ON CONFLICT (user_external_id) DO UPDATE
+			SET demo_reset_count = demo_account_metadata.demo_reset_count + 1,
+			    last_demo_reset = NOW(),
+			    updated_at = NOW()
+		`, refill.OwnerID); err != nil {
+			return fmt.Errorf("failed to record demo reset: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit demo refill: %w", err)
+	}
+
+	refill.Status = domain.DemoRefillPosted
+	refill.ReceiptCode = &receiptCode
+	return nil
+}
+
+func (r *demoRefillRepo) MarkFailed(ctx context.Context, id int64, reason string) error {
+	_, err := r.db.Exec(ctx, `
+		UPDATE demo_account_refills
+		SET status = 'failed', last_error = $2
+		WHERE id = $1 AND status = 'pending'
+	`, id, reason)
+	if err != nil {
+		return fmt.Errorf("failed to mark demo refill failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,355 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+// HoldRepository persists funds holds (transaction_holds). Balance movements
+// are done by the caller through BalanceRepository in the same transaction.
+type HoldRepository interface {
+	Create(ctx context.Context, tx pgx.Tx, hold *domain.Hold) error
+	GetByRef(ctx context.Context, holdRef string) (*domain.Hold, error)
+	GetByRefForUpdate(ctx context.Context, tx pgx.Tx, holdRef string) (*domain.Hold, error)
+	List(ctx context.Context, filter *domain.HoldFilter) ([]*domain.Hold, int64, error)
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]string, error)
+	SetExpiry(ctx context.Context, tx pgx.Tx, id int64, expiresAt *time.Time) error
+	MarkCaptured(ctx context.Context, tx pgx.Tx, id int64, captured decimal.Decimal, receiptCode string) error
+	MarkReleased(ctx context.Context, tx pgx.Tx, id int64, status domain.HoldStatus, reason *string) error
+}
+
+type holdRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewHoldRepo(db *pgxpool.Pool) HoldRepository {
+	return &holdRepo{db: db}
+}
+
+const holdSelectColumns = `
+	h.id, h.hold_ref, h.account_id, a.account_number, h.account_type,
+	h.hold_amount, h.captured_amount, h.currency, h.hold_type, h.status,
+	h.transaction_type, h.capture_account_number, h.receipt_code, h.reason,
+	h.created_by_external_id, h.created_by_type, h.limit_usage,
+	h.expires_at, h.captured_at, h.released_at, h.created_at, h.updated_at
+`
+
+// Create inserts a new active hold
+func (r *holdRepo) Create(ctx context.Context, tx pgx.Tx, hold *domain.Hold) error {
+	if tx == nil {
+		return errors.New("transaction cannot be nil")
+	}
+
+	var limitUsage []byte
+	if len(hold.LimitUsage) > 0 {
+		limitUsage, _ = json.Marshal(hold.LimitUsage)
+	}
+
+	query := `
+		INSERT INTO transaction_holds (
+			hold_ref, account_id, account_type, hold_amount, currency, hold_type, status,
+			transaction_type, capture_account_number, reason,
+			created_by_external_id, created_by_type, expires_at, limit_usage
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		RETURNING id, captured_amount, created_at, updated_at
+	`
+
+	err := tx.QueryRow(ctx, query,
+		hold.HoldRef,
+		hold.AccountID,
+		hold.AccountType,
+		hold.Amount,
+		hold.Currency,
+		hold.HoldType,
+		hold.Status,
+		hold.TransactionType,
+		hold.CaptureAccountNumber,
+		hold.Reason,
+		hold.CreatedByExternalID,
+		hold.CreatedByType,
+		hold.ExpiresAt,
+		limitUsage,
+	).Scan(&hold.ID, &hold.CapturedAmount, &hold.CreatedAt, &hold.UpdatedAt)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return xerrors.ErrHoldReferenceConflict
+		}
+		return fmt.Errorf("failed to create hold: %w", err)
+	}
+
+	return nil
+}
+
+// GetByRef fetches a hold by its reference
+func (r *holdRepo) GetByRef(ctx context.Context, holdRef string) (*domain.Hold, error) {
+	query := `SELECT ` + holdSelectColumns + `
+		FROM transaction_holds h
+		JOIN accounts a ON a.id = h.account_id
+		WHERE h.hold_ref = $1
+	`
+
+	hold, err := scanHold(r.db.QueryRow(ctx, query, holdRef))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, xerrors.ErrHoldNotFound
+		}
+		return nil, fmt.Errorf("failed to get hold: %w", err)
+	}
+
+	return hold, nil
+}
+
+// GetByRefForUpdate fetches and locks a hold row within a transaction
+func (r *holdRepo) GetByRefForUpdate(ctx context.Context, tx pgx.Tx, holdRef string) (*domain.Hold, error) {
+	if tx == nil {
+		return nil, errors.New("transaction cannot be nil")
+	}
+
+	query := `SELECT ` + holdSelectColumns + `
+		FROM transaction_holds h
+		JOIN accounts a ON a.id = h.account_id
+		WHERE h.hold_ref = $1
+		FOR UPDATE OF h
+	`
+
+	hold, err := scanHold(tx.QueryRow(ctx, query, holdRef))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, xerrors.ErrHoldNotFound
+		}
+		return nil, fmt.Errorf("failed to lock hold: %w", err)
+	}
+
+	return hold, nil
+}
+
+// List returns holds matching the filter, newest first
+func (r *holdRepo) List(ctx context.Context, filter *domain.HoldFilter) ([]*domain.Hold, int64, error) {
+	baseQuery := `SELECT ` + holdSelectColumns + `
+		FROM transaction_holds h
+		JOIN accounts a ON a.id = h.account_id
+		WHERE 1=1
+	`
+	countQuery := `
+		SELECT COUNT(*)
+		FROM transaction_holds h
+		JOIN accounts a ON a.id = h.account_id
+		WHERE 1=1
+	`
+
+	args := []interface{}{}
+	argIndex := 1
+
+	if filter.AccountNumber != nil {
+		baseQuery += fmt.Sprintf(" AND a.account_number = $%d", argIndex)
+		countQuery += fmt.Sprintf(" AND a.account_number = $%d", argIndex)
+		args = append(args, *filter.AccountNumber)
+		argIndex++
+	}
+
+	if filter.Status != nil {
+		baseQuery += fmt.Sprintf(" AND h.status = $%d", argIndex)
+		countQuery += fmt.Sprintf(" AND h.status = $%d", argIndex)
+		args = append(args, *filter.Status)
+		argIndex++
+	}
+
+	if filter.HoldType != nil {
+		baseQuery += fmt.Sprintf(" AND h.hold_type = $%d", argIndex)
+		countQuery += fmt.Sprintf(" AND h.hold_type = $%d", argIndex)
+		args = append(args, *filter.HoldType)
+		argIndex++
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count holds: %w", err)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	baseQuery += fmt.Sprintf(" ORDER BY h.created_at DESC LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	args = append(args, limit, filter.Offset)
+
+	rows, err := r.db.Query(ctx, baseQuery, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list holds: %w", err)
+	}
+	defer rows.Close()
+
+	var holds []*domain.Hold
+	for rows.Next() {
+		hold, err := scanHold(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan hold: %w", err)
+		}
+		holds = append(holds, hold)
+	}
+
+	return holds, total, rows.Err()
+}
+
+// ListExpired returns references of active holds past their expiry
+func (r *holdRepo) ListExpired(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	query := `
+		SELECT hold_ref
+		FROM transaction_holds
+		WHERE status = 'active' AND expires_at IS NOT NULL AND expires_at <= $1
+		ORDER BY expires_at ASC
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired holds: %w", err)
+	}
+	defer rows.Close()
+
+	var refs []string
+	for rows.Next() {
+		var ref string
+		if err := rows.Scan(&ref); err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+
+	return refs, rows.Err()
+}
+
+// SetExpiry moves the expiry of an active hold; nil exempts it from the sweeper
+func (r *holdRepo) SetExpiry(ctx context.Context, tx pgx.Tx, id int64, expiresAt *time.Time) error {
+	if tx == nil {
+		return errors.New("transaction cannot be nil")
+	}
+
+	query := `
+		UPDATE transaction_holds
+		SET expires_at = $1,
+			updated_at = now()
+		WHERE id = $2 AND status = 'active'
+	`
+
+	cmdTag, err := tx.Exec(ctx, query, expiresAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to extend hold: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return xerrors.ErrHoldNotActive
+	}
+
+	return nil
+}
+
+// MarkCaptured closes a hold as captured
+func (r *holdRepo) MarkCaptured(ctx context.Context, tx pgx.Tx, id int64, captured decimal.Decimal, receiptCode string) error {
+	if tx == nil {
+		return errors.New("transaction cannot be nil")
+	}
+
+	query := `
+		UPDATE transaction_holds
+		SET status = 'captured',
+			captured_amount = $1,
+			receipt_code = $2,
+			released = true,
+			released_at = now(),
+			captured_at = now(),
+			updated_at = now()
+		WHERE id = $3 AND status = 'active'
+	`
+
+	cmdTag, err := tx.Exec(ctx, query, captured, receiptCode, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark hold captured: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return xerrors.ErrHoldNotActive
+	}
+
+	return nil
+}
+
+// MarkReleased closes a hold as released or expired
+func (r *holdRepo) MarkReleased(ctx context.Context, tx pgx.Tx, id int64, status domain.HoldStatus, reason *string) error {
+	if tx == nil {
+		return errors.New("transaction cannot be nil")
+	}
+
+	query := `
+		UPDATE transaction_holds
+		SET status = $1,
+			reason = COALESCE($2, reason),
+			released = true,
+			released_at = now(),
+			updated_at = now()
+		WHERE id = $3 AND status = 'active'
+	`
+
+	cmdTag, err := tx.Exec(ctx, query, status, reason, id)
+	if err != nil {
+		return fmt.Errorf("failed to release hold: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return xerrors.ErrHoldNotActive
+	}
+
+	return nil
+}
+
+// scanHold scans a row selected with holdSelectColumns
+func scanHold(row pgx.Row) (*domain.Hold, error) {
+	var hold domain.Hold
+	var limitUsage []byte
+	err := row.Scan(
+		&hold.ID,
+		&hold.HoldRef,
+		&hold.AccountID,
+		&hold.AccountNumber,
+		&hold.AccountType,
+		&hold.Amount,
+		&hold.CapturedAmount,
+		&hold.Currency,
+		&hold.HoldType,
+		&hold.Status,
+		&hold.TransactionType,
+		&hold.CaptureAccountNumber,
+		&hold.ReceiptCode,
+		&hold.Reason,
+		&hold.CreatedByExternalID,
+		&hold.CreatedByType,
+		&limitUsage,
+		&hold.ExpiresAt,
+		&hold.CapturedAt,
+		&hold.ReleasedAt,
+		&hold.CreatedAt,
+		&hold.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(limitUsage) > 0 {
+		if err := json.Unmarshal(limitUsage, &hold.LimitUsage); err != nil {
+			return nil, fmt.Errorf("failed to decode hold limit usage: %w", err)
+		}
+	}
+	return &hold, nil
+}
@@ -0,0 +1,542 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+// InterestRepository stores rate plans, daily accruals and the postings that
+// capitalize them. Accruals are unique per account and day and postings per
+// account and period end, so re-running either step is a no-op.
+type InterestRepository interface {
+	CreatePlan(ctx context.Context, plan *domain.InterestRatePlan) error
+	UpdatePlan(ctx context.Context, plan *domain.InterestRatePlan) error
+	GetPlan(ctx context.Context, id int64) (*domain.InterestRatePlan, error)
+	ListPlans(ctx context.Context, currency *string, activeOnly bool) ([]*domain.InterestRatePlan, error)
+
+	// ListAccrualCandidates returns accounts under an active plan that have
+	// not accrued for date, ordered by account ID after afterAccountID
+	ListAccrualCandidates(ctx context.Context, date time.Time, afterAccountID int64, limit int) ([]*domain.InterestAccrualCandidate, error)
+
+	// CreateAccruals inserts accruals, skipping days already accrued, and
+	// returns how many were inserted
+	CreateAccruals(ctx context.Context, accruals []*domain.InterestAccrual) (int, error)
+
+	// ReserveDuePostings groups unreserved accruals dated before the given
+	// date into one pending posting per account, and returns how many
+	// postings were created
+	ReserveDuePostings(ctx context.Context, before time.Time, limit int) (int, error)
+
+	// ClaimDuePostings leases up to limit pending postings whose next attempt
+	// is due
+	ClaimDuePostings(ctx context.Context, limit int, lease time.Duration) ([]*domain.InterestPosting, error)
+
+	MarkPosted(ctx context.Context, id int64, receiptCode *string) error
+
+	// RecordPostingFailure counts a failed attempt and retries at retryAt.
+	// With giveUp the posting fails and its accruals are released to the
+	// account's next posting.
+	RecordPostingFailure(ctx context.Context, id int64, errMsg string, retryAt time.Time, giveUp bool) error
+
+	ListPostings(ctx context.Context, filter *domain.InterestPostingFilter) ([]*domain.InterestPosting, int64, error)
+
+	// GetSummary totals interest accrued and paid in [from, to) and what is
+	// outstanding now. Returns nil for an account that never accrued.
+	GetSummary(ctx context.Context, accountID int64, from, to time.Time) (*domain.InterestSummary, error)
+}
+
+type interestRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewInterestRepo(db *pgxpool.Pool) InterestRepository {
+	return &interestRepo{db: db}
+}
+
+// ===============================
+// RATE PLANS
+// ===============================
+
+const interestPlanColumns = `
+	id, name, currency, purpose, annual_rate::text, method, day_count, min_balance::text,
+	is_active, created_by, created_at, updated_at
+`
+
+func (r *interestRepo) CreatePlan(ctx context.Context, p *domain.InterestRatePlan) error {
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO interest_rate_plans (
+			name, currency, purpose, annual_rate, method, day_count, min_balance, is_active, created_by
+		) VALUES ($1, $2, $3, $4::numeric, $5, $6, $7::numeric, $8, $9)
+		RETURNING id, created_at, updated_at
+	`, p.Name, p.Currency, p.Purpose, p.AnnualRate.String(), p.Method, p.DayCount, p.MinBalance.String(),
+		p.IsActive, p.CreatedBy,
+	).Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return xerrors.ErrInterestRatePlanConflict
+		}
+		return fmt.Errorf("failed to create interest rate plan: %w", err)
+	}
+	return nil
+}
+
+// UpdatePlan changes the rate terms of a plan; currency and purpose are fixed.
+// New terms apply from the next accrual day.
+func (r *interestRepo) UpdatePlan(ctx context.Context, p *domain.InterestRatePlan) error {
+	err := r.db.QueryRow(ctx, `
+		UPDATE interest_rate_plans
+		SET name = $2, annual_rate = $3::numeric, method = $4, day_count = $5,
+		    min_balance = $6::numeric, is_active = $7
+		WHERE id = $1
+		RETURNING created_by, created_at, updated_at
+	`, p.ID, p.Name, p.AnnualRate.String(), p.Method, p.DayCount, p.MinBalance.String(), p.IsActive,
+	).Scan(&p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return xerrors.ErrInterestRatePlanNotFound
+	}
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return xerrors.ErrInterestRatePlanConflict
+		}
+		return fmt.Errorf("failed to update interest rate plan: %w", err)
+	}
+	return nil
+}
+
+func (r *interestRepo) GetPlan(ctx context.Context, id int64) (*domain.InterestRatePlan, error) {
+	p, err := scanInterestPlan(r.db.QueryRow(ctx, `
+		SELECT `+interestPlanColumns+` FROM interest_rate_plans WHERE id = $1
+	`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, xerrors.ErrInterestRatePlanNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest rate plan: %w", err)
+	}
+	return p, nil
+}
+
+func (r *interestRepo) ListPlans(ctx context.Context, currency *string, activeOnly bool) ([]*domain.InterestRatePlan, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+interestPlanColumns+`
+		FROM interest_rate_plans
+		WHERE ($1::text IS NULL OR currency = $1)
+		  AND (NOT $2 OR is_active)
+		ORDER BY currency, purpose, is_active DESC, id DESC
+	`, currency, activeOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list interest rate plans: %w", err)
+	}
+	defer rows.Close()
+
+	var plans []*domain.InterestRatePlan
+	for rows.Next() {
+		p, err := scanInterestPlan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan interest rate plan: %w", err)
+		}
+		plans = append(plans, p)
+	}
+	return plans, rows.Err()
+}
+
+func scanInterestPlan(row pgx.Row) (*domain.InterestRatePlan, error) {
+	var p domain.InterestRatePlan
+	var rate, minBalance string
+	err := row.Scan(
+		&p.ID, &p.Name, &p.Currency, &p.Purpose, &rate, &p.Method, &p.DayCount, &minBalance,
+		&p.IsActive, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.AnnualRate, _ = decimal.NewFromString(rate)
+	p.MinBalance, _ = decimal.NewFromString(minBalance)
+	return &p, nil
+}
+
+// ===============================
+// ACCRUALS
+// ===============================
+
+// ListAccrualCandidates prices from the balance after the account's last
+// ledger entry before the end of date; accounts opened after that are skipped
+func (r *interestRepo) ListAccrualCandidates(
+	ctx context.Context,
+	date time.Time,
+	afterAccountID int64,
+	limit int,
+) ([]*domain.InterestAccrualCandidate, error) {
+	dayEnd := date.AddDate(0, 0, 1)
+
+	rows, err := r.db.Query(ctx, `
+		SELECT a.id, a.account_number, a.currency,
+		       p.id, p.name, p.currency, p.purpose, p.annual_rate::text, p.method, p.day_count,
+		       p.min_balance::text, p.is_active, p.created_by, p.created_at, p.updated_at,
+		       COALESCE(l.balance_after, 0)::text,
+		       COALESCE(u.unposted, 0)::text
+		FROM accounts a
+		JOIN interest_rate_plans p
+		  ON p.currency = a.currency AND p.purpose = a.purpose AND p.is_active AND p.created_at < $2
+		LEFT JOIN LATERAL (
+			SELECT balance_after
+			FROM ledgers
+			WHERE account_id = a.id AND created_at < $2
+			ORDER BY created_at DESC, id DESC
+			LIMIT 1
+		) l ON true
+		LEFT JOIN LATERAL (
+			SELECT SUM(ia.amount) AS unposted
+			FROM interest_accruals ia
+			LEFT JOIN interest_postings ip ON ip.id = ia.posting_id
+			WHERE ia.account_id = a.id
+			  AND ia.accrual_date < $1
+			  AND (ia.posting_id IS NULL OR ip.status = 'pending')
+		) u ON p.method = 'compound'
+		WHERE a.purpose IN ('savings', 'investment')
+		  AND a.account_type = 'real'
+		  AND a.is_active
+		  AND a.created_at < $2
+		  AND a.id > $3
+		  AND NOT EXISTS (
+			SELECT 1 FROM interest_accruals x WHERE x.account_id = a.id AND x.accrual_date = $1
+		  )
+		ORDER BY a.id
+		LIMIT $4
+	`, date, dayEnd, afterAccountID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list interest accrual candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []*domain.InterestAccrualCandidate
+	for rows.Next() {
+		var c domain.InterestAccrualCandidate
+		var p domain.InterestRatePlan
+		var rate, minBalance, balance, unposted string
+		if err := rows.Scan(
+			&c.AccountID, &c.AccountNumber, &c.Currency,
+			&p.ID, &p.Name, &p.Currency, &p.Purpose, &rate, &p.Method, &p.DayCount, &minBalance,
+			&p.IsActive, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt,
+			&balance, &unposted,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan interest accrual candidate: %w", err)
+		}
+		p.AnnualRate, _ = decimal.NewFromString(rate)
+		p.MinBalance, _ = decimal.NewFromString(minBalance)
+		c.Balance, _ = decimal.NewFromString(balance)
+		c.UnpostedAccrued, _ = decimal.NewFromString(unposted)
+		c.Plan = &p
+		candidates = append(candidates, &c)
+	}
+	return candidates, rows.Err()
+}
+
+func (r *interestRepo) CreateAccruals(ctx context.Context, accruals []*domain.InterestAccrual) (int, error) {
+	if len(accruals) == 0 {
+		return 0, nil
+	}
+
+	n := len(accruals)
+	accountIDs := make([]int64, n)
+	planIDs := make([]int64, n)
+	dates := make([]time.Time, n)
+	principals := make([]string, n)
+	rates := make([]string, n)
+	amounts := make([]string, n)
+	currencies := make([]string, n)
+	for i, a := range accruals {
+		accountIDs[i] = a.AccountID
+		planIDs[i] = a.PlanID
+		dates[i] = a.AccrualDate
+		principals[i] = a.Principal.String()
+		rates[i] = a.AnnualRate.String()
+		amounts[i] = a.Amount.String()
+		currencies[i] = a.Currency
+	}
+
+	tag, err := r.db.Exec(ctx, `
+		INSERT INTO interest_accruals (
+			account_id, plan_id, accrual_date, principal, annual_rate, amount, currency
+		)
+		SELECT account_id, plan_id, accrual_date, principal::numeric, annual_rate::numeric, amount::numeric, currency
+		FROM unnest($1::bigint[], $2::bigint[], $3::date[], $4::text[], $5::text[], $6::text[], $7::text[])
+		     AS t(account_id, plan_id, accrual_date, principal, annual_rate, amount, currency)
+		ON CONFLICT (account_id, accrual_date) DO NOTHING
+	`, accountIDs, planIDs, dates, principals, rates, amounts, currencies)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create interest accruals: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// ===============================
+// POSTINGS
+// ===============================
+
+const interestPostingColumns = `
+	p.id, p.account_id, a.account_number, p.currency, p.period_from, p.period_to, p.accrual_count,
+	p.carried_in::text, p.accrued::text, p.amount::text, p.remainder::text, p.status, p.attempts,
+	p.receipt_code, p.last_error, p.created_at, p.posted_at
+`
+
+// ReserveDuePostings rounds each account's accrued total down to the currency
+// and carries the remainder. The carry available to a new posting is the
+// remainder of posted postings less what live postings already carried in.
+func (r *interestRepo) ReserveDuePostings(ctx context.Context, before time.Time, limit int) (int, error) {
+	var created int
+	err := r.db.QueryRow(ctx, `
+		WITH due AS (
+			SELECT account_id, currency,
+			       MIN(accrual_date) AS period_from, MAX(accrual_date) AS period_to,
+			       COUNT(*) AS accrual_count, SUM(amount) AS accrued, array_agg(id) AS accrual_ids
+			FROM interest_accruals
+			WHERE posting_id IS NULL AND accrual_date < $1
+			GROUP BY account_id, currency
+			ORDER BY account_id
+			LIMIT $2
+		),
+		carry AS (
+			SELECT p.account_id,
+			       GREATEST(
+			           COALESCE(SUM(p.remainder) FILTER (WHERE p.status = 'posted'), 0) -
+			           COALESCE(SUM(p.carried_in) FILTER (WHERE p.status <> 'failed'), 0),
+			           0
+			       ) AS carried
+			FROM interest_postings p
+			JOIN due ON due.account_id = p.account_id
+			GROUP BY p.account_id
+		),
+		priced AS (
+			SELECT due.*,
+			       COALESCE(carry.carried, 0) AS carried_in,
+			       due.accrued + COALESCE(carry.carried, 0) AS total,
+			       TRUNC(due.accrued + COALESCE(carry.carried, 0), COALESCE(c.decimals, 8)) AS amount
+			FROM due
+			LEFT JOIN carry ON carry.account_id = due.account_id
+			LEFT JOIN currencies c ON c.code = due.currency
+		),
+		ins AS (
+			INSERT INTO interest_postings (
+				account_id, currency, period_from, period_to, accrual_count,
+				carried_in, accrued, amount, remainder
+			)
+			SELECT account_id, currency, period_from, period_to, accrual_count,
+			       carried_in, total, amount, total - amount
+			FROM priced
+			RETURNING id, account_id
+		),
+		reserved AS (
+			UPDATE interest_accruals ia
+			SET posting_id = ins.id
+			FROM ins
+			JOIN priced ON priced.account_id = ins.account_id
+			WHERE ia.id = ANY(priced.accrual_ids)
+			RETURNING ia.id
+		)
+		SELECT COUNT(*) FROM ins
+	`, before, limit).Scan(&created)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reserve interest postings: %w", err)
+	}
+	return created, nil
+}
+
+func (r *interestRepo) ClaimDuePostings(ctx context.Context, limit int, lease time.Duration) ([]*domain.InterestPosting, error) {
+	rows, err := r.db.Query(ctx, `
+		WITH due AS (
+			SELECT id
+			FROM interest_postings
+			WHERE status = 'pending' AND available_at <= NOW()
+			ORDER BY available_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		),
+		claimed AS (
+			UPDATE interest_postings p
+			SET available_at = NOW() + make_interval(secs => $2)
+			FROM due
+			WHERE p.id = due.id
+			RETURNING p.*
+		)
+		SELECT `+interestPostingColumns+`
+		FROM claimed p
+		JOIN accounts a ON a.id = p.account_id
+		ORDER BY p.id
+	`, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim interest postings: %w", err)
+	}
+	defer rows.Close()
+
+	return collectInterestPostings(rows)
+}
+
+func (r *interestRepo) MarkPosted(ctx context.Context, id int64, receiptCode *string) error {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE interest_postings
+		SET status = 'posted', receipt_code = $2, last_error = NULL, posted_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+	`, id, receiptCode)
+	if err != nil {
+		return fmt.Errorf("failed to mark interest posting %d posted: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return xerrors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *interestRepo) RecordPostingFailure(
+	ctx context.Context,
+	id int64,
+	errMsg string,
+	retryAt time.Time,
+	giveUp bool,
+) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	status := domain.InterestPostingPending
+	if giveUp {
+		status = domain.InterestPostingFailed
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE interest_postings
+		SET status = $2, attempts = attempts + 1, last_error = $3, available_at = $4
+		WHERE id = $1 AND status = 'pending'
+	`, id, status, errMsg, retryAt)
+	if err != nil {
+		return fmt.Errorf("failed to record interest posting failure: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return xerrors.ErrNotFound
+	}
+
+	if giveUp {
+		if _, err := tx.Exec(ctx, `
+			UPDATE interest_accruals SET posting_id = NULL WHERE posting_id = $1
+		`, id); err != nil {
+			return fmt.Errorf("failed to release interest accruals: %w", err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *interestRepo) ListPostings(
+	ctx context.Context,
+	filter *domain.InterestPostingFilter,
+) ([]*domain.InterestPosting, int64, error) {
+	const where = `
+		WHERE ($1::text IS NULL OR a.account_number = $1)
+		  AND ($2::text IS NULL OR p.status = $2)
+	`
+	args := []interface{}{filter.AccountNumber, filter.Status}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `
+		SELECT COUNT(*) FROM interest_postings p JOIN accounts a ON a.id = p.account_id`+where,
+		args...,
+	).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count interest postings: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT `+interestPostingColumns+`
+		FROM interest_postings p
+		JOIN accounts a ON a.id = p.account_id`+where+`
+		ORDER BY p.period_to DESC, p.id DESC
+		LIMIT $3 OFFSET $4
+	`, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list interest postings: %w", err)
+	}
+	defer rows.Close()
+
+	postings, err := collectInterestPostings(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return postings, total, nil
+}
+
+func (r *interestRepo) GetSummary(ctx context.Context, accountID int64, from, to time.Time) (*domain.InterestSummary, error) {
+	var accrued, paid, outstanding string
+	var hasAccruals bool
+	err := r.db.QueryRow(ctx, `
+		SELECT
+			EXISTS (SELECT 1 FROM interest_accruals WHERE account_id = $1),
+			COALESCE((
+				SELECT SUM(amount) FROM interest_accruals
+				WHERE account_id = $1 AND accrual_date >= $2::date AND accrual_date < $3::date
+			), 0)::text,
+			COALESCE((
+				SELECT SUM(amount) FROM interest_postings
+				WHERE account_id = $1 AND status = 'posted' AND posted_at >= $2 AND posted_at < $3
+			), 0)::text,
+			(
+				COALESCE((
+					SELECT SUM(ia.amount)
+					FROM interest_accruals ia
+					LEFT JOIN interest_postings ip ON ip.id = ia.posting_id
+					WHERE ia.account_id = $1 AND (ia.posting_id IS NULL OR ip.status = 'pending')
+				), 0) +
+				COALESCE((
+					SELECT SUM(remainder) FILTER (WHERE status = 'posted') -
+					       COALESCE(SUM(carried_in) FILTER (WHERE status = 'posted'), 0)
+					FROM interest_postings
+					WHERE account_id = $1
+				), 0)
+			)::text
+	`, accountID, from, to).Scan(&hasAccruals, &accrued, &paid, &outstanding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest summary: %w", err)
+	}
+	if !hasAccruals {
+		return nil, nil
+	}
+
+	summary := &domain.InterestSummary{}
+	summary.Accrued, _ = decimal.NewFromString(accrued)
+	summary.Paid, _ = decimal.NewFromString(paid)
+	summary.Outstanding, _ = decimal.NewFromString(outstanding)
+	return summary, nil
+}
+
+func collectInterestPostings(rows pgx.Rows) ([]*domain.InterestPosting, error) {
+	var postings []*domain.InterestPosting
+	for rows.Next() {
+		var p domain.InterestPosting
+		var carriedIn, accrued, amount, remainder string
+		if err := rows.Scan(
+			&p.ID, &p.AccountID, &p.AccountNumber, &p.Currency, &p.PeriodFrom, &p.PeriodTo, &p.AccrualCount,
+			&carriedIn, &accrued, &amount, &remainder, &p.Status, &p.Attempts,
+			&p.ReceiptCode, &p.LastError, &p.CreatedAt, &p.PostedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan interest posting: %w", err)
+		}
+		p.CarriedIn, _ = decimal.NewFromString(carriedIn)
+		p.Accrued, _ = decimal.NewFromString(accrued)
+		p.Amount, _ = decimal.NewFromString(amount)
+		p.Remainder, _ = decimal.NewFromString(remainder)
+		postings = append(postings, &p)
+	}
+	return postings, rows.Err()
+}
@@ -73,6 +73,9 @@ func (r *journalRepo) Create(ctx context.Context, tx pgx.Tx, journal *domain.Jou
 	`
 
 	now := time.Now()
+	if journal.PostedAt != nil {
+		now = *journal.PostedAt
+	}
 	var j domain.Journal
 	j.IdempotencyKey = journal.IdempotencyKey
 	j.TransactionType = journal.TransactionType
@@ -13,6 +13,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
 )
 
 type LedgerRepository interface {
@@ -58,7 +59,7 @@ func (r *ledgerRepo) Create(ctx context.Context, tx pgx.Tx, ledger *domain.Ledge
 	}
 
 	// Validate amount
-	if ledger.Amount <= 0 {
+	if !ledger.Amount.IsPositive() {
 		return nil, errors.New("amount must be positive")
 	}
 
@@ -77,6 +78,9 @@ func (r *ledgerRepo) Create(ctx context.Context, tx pgx.Tx, ledger *domain.Ledge
 	`
 
 	now := time.Now()
+	if ledger.PostedAt != nil {
+		now = *ledger.PostedAt
+	}
 	var l domain.Ledger
 	l.JournalID = ledger.JournalID
 	l.AccountID = ledger.AccountID
@@ -143,7 +147,7 @@ func (r *ledgerRepo) CreateBatch(ctx context.Context, tx pgx.Tx, ledgers []*doma
 			errs[i] = errors.New("currency code must be 8 characters or less")
 			continue
 		}
-		if ledger.Amount <= 0 {
+		if !ledger.Amount.IsPositive() {
 			errs[i] = errors.New("amount must be positive")
 			continue
 		}
@@ -152,6 +156,11 @@ func (r *ledgerRepo) CreateBatch(ctx context.Context, tx pgx.Tx, ledgers []*doma
 			continue
 		}
 
+		createdAt := now
+		if ledger.PostedAt != nil {
+			createdAt = *ledger.PostedAt
+		}
+
 		batch.Queue(query,
 			ledger.JournalID,
 			ledger.AccountID,
@@ -163,7 +172,7 @@ func (r *ledgerRepo) CreateBatch(ctx context.Context, tx pgx.Tx, ledgers []*doma
 			ledger.BalanceAfter,
 			ledger.Description,
 			ledger.Metadata,
-			now,
+			createdAt,
 		)
 
 		indexMap[len(validLedgers)] = i
@@ -719,9 +728,9 @@ func (r *ledgerRepo) CalculateBalance(ctx context.Context, accountID int64, upTo
 			// No ledger entries for this account
 			return &domain.LedgerBalance{
 				AccountID:    accountID,
-				TotalDebits:  0,
-				TotalCredits: 0,
-				Balance:      0,
+				TotalDebits:  decimal.Zero,
+				TotalCredits: decimal.Zero,
+				Balance:      decimal.Zero,
 				LastUpdated:  time.Now(),
 			}, nil
 		}
@@ -729,7 +738,7 @@ func (r *ledgerRepo) CalculateBalance(ctx context.Context, accountID int64, upTo
 	}
 
 	balance.Currency = currency
-	balance.Balance = balance.TotalCredits - balance.TotalDebits
+	balance.Balance = balance.TotalCredits.Sub(balance.TotalDebits)
 	balance.LastUpdated = lastUpdated
 
 	return &balance, nil
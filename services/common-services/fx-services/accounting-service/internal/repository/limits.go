@@ -0,0 +1,334 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+type LimitRepository interface {
+	// Profiles
+	CreateProfile(ctx context.Context, profile *domain.LimitProfile) error
+	UpdateProfile(ctx context.Context, profile *domain.LimitProfile) error
+	GetProfileByID(ctx context.Context, id int64) (*domain.LimitProfile, error)
+	ListProfiles(ctx context.Context, filter *domain.LimitProfileFilter) ([]*domain.LimitProfile, error)
+
+	// MatchProfile returns the profile that applies to an account, or nil when none does
+	MatchProfile(ctx context.Context, account *domain.Account, kycTier *string) (*domain.LimitProfile, error)
+
+	// KYC tiers
+	GetKYCTier(ctx context.Context, ownerType domain.OwnerType, ownerID string) (*string, error)
+	SetKYCTier(ctx context.Context, tier *domain.OwnerKYCTier) error
+
+	// Usage counters
+	// Reserve adds every item's Amount/Count to its counter in tx, the
+	// transaction that posts the journal. A counter that would pass its cap
+	// fails with *domain.LimitExceededError.
+	Reserve(ctx context.Context, tx pgx.Tx, usage []*domain.LimitUsage) error
+	// Release gives back usage counted by Reserve, e.g. when a hold is
+	// released before it is captured. Counters never drop below zero.
+	Release(ctx context.Context, tx pgx.Tx, usage []*domain.LimitUsage) error
+	GetUsage(ctx context.Context, accountNumber string, window domain.LimitWindow, windowStart time.Time) (decimal.Decimal, int, error)
+}
+
+type limitRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewLimitRepo(db *pgxpool.Pool) LimitRepository {
+	return &limitRepo{db: db}
+}
+
+const limitProfileColumns = `
+	id, name, owner_type, kyc_tier, account_number, currency,
+	max_single_amount, daily_amount, weekly_amount, monthly_amount,
+	daily_count, weekly_count, monthly_count, priority, is_active,
+	created_by, created_at, updated_at
+`
+
+// ===============================
+// PROFILES
+// ===============================
+
+func (r *limitRepo) CreateProfile(ctx context.Context, p *domain.LimitProfile) error {
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO limit_profiles (
+			name, owner_type, kyc_tier, account_number, currency,
+			max_single_amount, daily_amount, weekly_amount, monthly_amount,
+			daily_count, weekly_count, monthly_count, priority, is_active, created_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		RETURNING id, created_at, updated_at
+	`, p.Name, p.OwnerType, p.KYCTier, p.AccountNumber, p.Currency,
+		p.MaxSingleAmount, p.DailyAmount, p.WeeklyAmount, p.MonthlyAmount,
+		p.DailyCount, p.WeeklyCount, p.MonthlyCount, p.Priority, p.IsActive, p.CreatedBy,
+	).Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create limit profile: %w", err)
+	}
+	return nil
+}
+
+func (r *limitRepo) UpdateProfile(ctx context.Context, p *domain.LimitProfile) error {
+	err := r.db.QueryRow(ctx, `
+		UPDATE limit_profiles
+		SET name = $2, owner_type = $3, kyc_tier = $4, account_number = $5, currency = $6,
+		    max_single_amount = $7, daily_amount = $8, weekly_amount = $9, monthly_amount = $10,
+		    daily_count = $11, weekly_count = $12, monthly_count = $13,
+		    priority = $14, is_active = $15, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`, p.ID, p.Name, p.OwnerType, p.KYCTier, p.AccountNumber, p.Currency,
+		p.MaxSingleAmount, p.DailyAmount, p.WeeklyAmount, p.MonthlyAmount,
+		p.DailyCount, p.WeeklyCount, p.MonthlyCount, p.Priority, p.IsActive,
+	).Scan(&p.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return xerrors.ErrLimitProfileNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update limit profile: %w", err)
+	}
+	return nil
+}
+
+func (r *limitRepo) GetProfileByID(ctx context.Context, id int64) (*domain.LimitProfile, error) {
+	p, err := scanLimitProfile(r.db.QueryRow(ctx,
+		`SELECT `+limitProfileColumns+` FROM limit_profiles WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, xerrors.ErrLimitProfileNotFound
+	}
+	return p, err
+}
+
+func (r *limitRepo) ListProfiles(ctx context.Context, filter *domain.LimitProfileFilter) ([]*domain.LimitProfile, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+limitProfileColumns+`
+		FROM limit_profiles
+		WHERE ($1::owner_type_enum IS NULL OR owner_type = $1)
+		  AND ($2::text IS NULL OR kyc_tier = $2)
+		  AND ($3::text IS NULL OR account_number = $3)
+		  AND ($4::text IS NULL OR currency = $4)
+		  AND (NOT $5 OR is_active)
+		ORDER BY currency, account_number NULLS LAST, kyc_tier NULLS LAST, owner_type NULLS LAST, priority DESC, id
+	`, filter.OwnerType, filter.KYCTier, filter.AccountNumber, filter.Currency, filter.ActiveOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list limit profiles: %w", err)
+	}
+	defer rows.Close()
+
+	var profiles []*domain.LimitProfile
+	for rows.Next() {
+		p, err := scanLimitProfile(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan limit profile: %w", err)
+		}
+		profiles = append(profiles, p)
+	}
+	return profiles, rows.Err()
+}
+
+func (r *limitRepo) MatchProfile(
+	ctx context.Context,
+	account *domain.Account,
+	kycTier *string,
+) (*domain.LimitProfile, error) {
+	p, err := scanLimitProfile(r.db.QueryRow(ctx, `
+		SELECT `+limitProfileColumns+`
+		FROM limit_profiles
+		WHERE is_active
+		  AND currency = $1
+		  AND (account_number IS NULL OR account_number = $2)
+		  AND (kyc_tier IS NULL OR kyc_tier = $3)
+		  AND (owner_type IS NULL OR owner_type = $4)
+		ORDER BY (account_number IS NOT NULL) DESC,
+		         (kyc_tier IS NOT NULL) DESC,
+		         (owner_type IS NOT NULL) DESC,
+		         priority DESC,
+		         id DESC
+		LIMIT 1
+	`, account.Currency, account.AccountNumber, kycTier, account.OwnerType))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to match limit profile: %w", err)
+	}
+	return p, nil
+}
+
+// ===============================
+// KYC TIERS
+// ===============================
+
+func (r *limitRepo) GetKYCTier(ctx context.Context, ownerType domain.OwnerType, ownerID string) (*string, error) {
+	var tier string
+	err := r.db.QueryRow(ctx, `
+		SELECT kyc_tier FROM owner_kyc_tiers WHERE owner_type = $1 AND owner_id = $2
+	`, ownerType, ownerID).Scan(&tier)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kyc tier: %w", err)
+	}
+	return &tier, nil
+}
+
+func (r *limitRepo) SetKYCTier(ctx context.Context, t *domain.OwnerKYCTier) error {
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO owner_kyc_tiers (owner_type, owner_id, kyc_tier, set_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (owner_type, owner_id) DO UPDATE
+		SET kyc_tier = EXCLUDED.kyc_tier, set_by = EXCLUDED.set_by, updated_at = NOW()
+		RETURNING updated_at
+	`, t.OwnerType, t.OwnerID, t.KYCTier, t.SetBy).Scan(&t.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set kyc tier: %w", err)
+	}
+	return nil
+}
+
+// ===============================
+// USAGE COUNTERS
+// ===============================
+
+func (r *limitRepo) Reserve(ctx context.Context, tx pgx.Tx, usage []*domain.LimitUsage) error {
+	if len(usage) == 0 {
+		return nil
+	}
+	if tx == nil {
+		return errors.New("transaction cannot be nil")
+	}
+
+	// Lock counters in a fixed order so concurrent reservations cannot deadlock
+	sorted := make([]*domain.LimitUsage, len(usage))
+	copy(sorted, usage)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].AccountNumber != sorted[j].AccountNumber {
+			return sorted[i].AccountNumber < sorted[j].AccountNumber
+		}
+		return sorted[i].Window < sorted[j].Window
+	})
+
+	for _, u := range sorted {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO account_limit_usage (account_number, limit_window, window_start)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (account_number, limit_window, window_start) DO NOTHING
+		`, u.AccountNumber, u.Window, u.WindowStart); err != nil {
+			return fmt.Errorf("failed to create limit counter: %w", err)
+		}
+
+		// The caps are re-evaluated against the locked row, so this either
+		// fits or touches nothing
+		tag, err := tx.Exec(ctx, `
+			UPDATE account_limit_usage
+			SET amount = amount + $4::numeric, txn_count = txn_count + $5, updated_at = NOW()
+			WHERE account_number = $1 AND limit_window = $2 AND window_start = $3
+			  AND ($6::numeric IS NULL OR amount + $4::numeric <= $6::numeric)
+			  AND ($7::int IS NULL OR txn_count + $5 <= $7::int)
+		`, u.AccountNumber, u.Window, u.WindowStart, u.Amount.String(), u.Count, u.AmountCap, u.CountCap)
+		if err != nil {
+			return fmt.Errorf("failed to reserve limit usage: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return r.exceededError(ctx, tx, u)
+		}
+	}
+
+	return nil
+}
+
+func (r *limitRepo) Release(ctx context.Context, tx pgx.Tx, usage []*domain.LimitUsage) error {
+	if len(usage) == 0 {
+		return nil
+	}
+	if tx == nil {
+		return errors.New("transaction cannot be nil")
+	}
+
+	sorted := make([]*domain.LimitUsage, len(usage))
+	copy(sorted, usage)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].AccountNumber != sorted[j].AccountNumber {
+			return sorted[i].AccountNumber < sorted[j].AccountNumber
+		}
+		return sorted[i].Window < sorted[j].Window
+	})
+
+	for _, u := range sorted {
+		if _, err := tx.Exec(ctx, `
+			UPDATE account_limit_usage
+			SET amount = GREATEST(amount - $4::numeric, 0),
+			    txn_count = GREATEST(txn_count - $5, 0),
+			    updated_at = NOW()
+			WHERE account_number = $1 AND limit_window = $2 AND window_start = $3
+		`, u.AccountNumber, u.Window, u.WindowStart, u.Amount.String(), u.Count); err != nil {
+			return fmt.Errorf("failed to release limit usage: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// exceededError reports which cap of a counter the reservation would break
+func (r *limitRepo) exceededError(ctx context.Context, tx pgx.Tx, u *domain.LimitUsage) error {
+	var amountText string
+	var count int
+	if err := tx.QueryRow(ctx, `
+		SELECT amount::text, txn_count FROM account_limit_usage
+		WHERE account_number = $1 AND limit_window = $2 AND window_start = $3
+	`, u.AccountNumber, u.Window, u.WindowStart).Scan(&amountText, &count); err != nil {
+		return fmt.Errorf("failed to read limit usage: %w", err)
+	}
+	used, _ := decimal.NewFromString(amountText)
+
+	if err := u.Exceeded(used, count); err != nil {
+		return err
+	}
+	return fmt.Errorf("limit counter %s/%s did not update", u.AccountNumber, u.Window)
+}
+
+func (r *limitRepo) GetUsage(
+	ctx context.Context,
+	accountNumber string,
+	window domain.LimitWindow,
+	windowStart time.Time,
+) (decimal.Decimal, int, error) {
+	var amountText string
+	var count int
+	err := r.db.QueryRow(ctx, `
+		SELECT amount::text, txn_count FROM account_limit_usage
+		WHERE account_number = $1 AND limit_window = $2 AND window_start = $3
+	`, accountNumber, window, windowStart).Scan(&amountText, &count)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return decimal.Zero, 0, nil
+	}
+	if err != nil {
+		return decimal.Zero, 0, fmt.Errorf("failed to get limit usage: %w", err)
+	}
+	amount, _ := decimal.NewFromString(amountText)
+	return amount, count, nil
+}
+
+func scanLimitProfile(row pgx.Row) (*domain.LimitProfile, error) {
+	var p domain.LimitProfile
+	err := row.Scan(
+		&p.ID, &p.Name, &p.OwnerType, &p.KYCTier, &p.AccountNumber, &p.Currency,
+		&p.MaxSingleAmount, &p.DailyAmount, &p.WeeklyAmount, &p.MonthlyAmount,
+		&p.DailyCount, &p.WeeklyCount, &p.MonthlyCount, &p.Priority, &p.IsActive,
+		&p.CreatedBy, &p.CreatedAt, &p.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
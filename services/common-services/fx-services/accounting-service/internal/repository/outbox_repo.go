@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// OutboxRepository stores events next to the writes that produce them.
+// Create must be called inside the journal's transaction; the relay then
+// moves committed rows to Redis streams with RelayPending.
+type OutboxRepository interface {
+	Create(ctx context.Context, tx pgx.Tx, event *domain.OutboxEvent) error
+	Enqueue(ctx context.Context, event *domain.OutboxEvent) error
+
+	RelayPending(ctx context.Context, limit int, publish OutboxPublishFunc) (int, error)
+	PurgeSent(ctx context.Context, before time.Time) (int64, error)
+}
+
+// OutboxPublishFunc delivers one event and returns its stream entry ID
+type OutboxPublishFunc func(ctx context.Context, event *domain.OutboxEvent) (string, error)
+
+type outboxRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewOutboxRepo(db *pgxpool.Pool) OutboxRepository {
+	return &outboxRepo{db: db}
+}
+
+const outboxColumns = `
+	id, stream, event_type, aggregate_id, payload, status, attempts,
+	last_error, stream_id, available_at, created_at, sent_at
+`
+
+// Retry backoff for rows the relay failed to publish: 2^attempts seconds, capped
+const outboxMaxBackoff = 5 * time.Minute
+
+const insertOutboxQuery = `
+	INSERT INTO outbox_events (stream, event_type, aggregate_id, payload)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id, status, available_at, created_at
+`
+
+// Create writes the event in the caller's transaction
+func (r *outboxRepo) Create(ctx context.Context, tx pgx.Tx, event *domain.OutboxEvent) error {
+	if tx == nil {
+		return errors.New("transaction cannot be nil")
+	}
+
+	err := tx.QueryRow(ctx, insertOutboxQuery,
+		event.Stream, event.EventType, event.AggregateID, event.Payload,
+	).Scan(&event.ID, &event.Status, &event.AvailableAt, &event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create outbox event: %w", err)
+	}
+	return nil
+}
+
+// Enqueue writes an event that has no surrounding transaction (e.g. a
+// transaction that failed and rolled back)
+func (r *outboxRepo) Enqueue(ctx context.Context, event *domain.OutboxEvent) error {
+	err := r.db.QueryRow(ctx, insertOutboxQuery,
+		event.Stream, event.EventType, event.AggregateID, event.Payload,
+	).Scan(&event.ID, &event.Status, &event.AvailableAt, &event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue outbox event: %w", err)
+	}
+	return nil
+}
+
+// RelayPending claims up to limit due rows in id order and hands each to
+// publish. Rows stay locked (SKIP LOCKED) until the outcome is recorded, so
+// relays running on several instances never send the same row twice at once.
+// The batch stops at the first failure; the failed row is retried with backoff.
+// A crash between publish and commit re-sends the row: delivery is
+// at-least-once and consumers dedupe on the outbox id.
+func (r *outboxRepo) RelayPending(ctx context.Context, limit int, publish OutboxPublishFunc) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin relay transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		SELECT `+outboxColumns+`
+		FROM outbox_events
+		WHERE status = 'pending' AND available_at <= now()
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+
+	var events []*domain.OutboxEvent
+	for rows.Next() {
+		e, err := scanOutboxEvent(rows)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		events = append(events, e)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read outbox events: %w", err)
+	}
+
+	sent := 0
+	var publishErr error
+	for _, e := range events {
+		streamID, pubErr := publish(ctx, e)
+		if pubErr != nil {
+			publishErr = fmt.Errorf("outbox event %d: %w", e.ID, pubErr)
+			_, err := tx.Exec(ctx, `
+				UPDATE outbox_events
+				SET attempts     = attempts + 1,
+				    last_error   = $2,
+				    available_at = now() + LEAST(
+				        make_interval(secs => power(2, LEAST(attempts, 16))),
+				        make_interval(secs => $3)
+				    )
+				WHERE id = $1
+			`, e.ID, pubErr.Error(), outboxMaxBackoff.Seconds())
+			if err != nil {
+				return sent, fmt.Errorf("failed to record outbox failure: %w", err)
+			}
+			break
+		}
+
+		_, err := tx.Exec(ctx, `
+			UPDATE outbox_events
+			SET status = 'sent', stream_id = $2, sent_at = now(),
+			    attempts = attempts + 1, last_error = NULL
+			WHERE id = $1
+		`, e.ID, streamID)
+		if err != nil {
+			return sent, fmt.Errorf("failed to mark outbox event sent: %w", err)
+		}
+		sent++
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit relay transaction: %w", err)
+	}
+	return sent, publishErr
+}
+
+// PurgeSent deletes rows relayed before the cutoff
+func (r *outboxRepo) PurgeSent(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, `
+		DELETE FROM outbox_events
+		WHERE status = 'sent' AND sent_at < $1
+	`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge outbox events: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func scanOutboxEvent(row pgx.Row) (*domain.OutboxEvent, error) {
+	var e domain.OutboxEvent
+	err := row.Scan(
+		&e.ID, &e.Stream, &e.EventType, &e.AggregateID, &e.Payload, &e.Status, &e.Attempts,
+		&e.LastError, &e.StreamID, &e.AvailableAt, &e.CreatedAt, &e.SentAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+	}
+	return &e, nil
+}
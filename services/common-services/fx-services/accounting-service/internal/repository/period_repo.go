@@ -0,0 +1,367 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// periodCloseLockKey is the advisory lock shared by postings and taken
+// exclusively while a period is being marked closed
+const periodCloseLockKey = "accounting:period_close"
+
+// PeriodRepository manages accounting periods, their balance snapshots and the
+// trial balance / general ledger reports built on them
+type PeriodRepository interface {
+	BeginClose(ctx context.Context, req *domain.CloseAccountingPeriodRequest) (*domain.AccountingPeriod, error)
+	SnapshotBalances(ctx context.Context, period *domain.AccountingPeriod) error
+	GetByID(ctx context.Context, id int64) (*domain.AccountingPeriod, error)
+	GetByRange(ctx context.Context, start, end time.Time) (*domain.AccountingPeriod, error)
+
+	TrialBalanceFromSnapshot(ctx context.Context, periodID int64, scope domain.ReportScope) ([]*domain.TrialBalanceLine, error)
+	TrialBalance(ctx context.Context, from, to time.Time, scope domain.ReportScope) ([]*domain.TrialBalanceLine, error)
+	ListGeneralLedgerEntries(ctx context.Context, req *domain.GeneralLedgerRequest) ([]*domain.GeneralLedgerEntry, int64, error)
+}
+
+type periodRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPeriodRepo(db *pgxpool.Pool) PeriodRepository {
+	return &periodRepo{db: db}
+}
+
+const accountingPeriodColumns = `
+	id, period_start, period_end, status, closed_by, note,
+	accounts_count, created_at, closed_at
+`
+
+// accountActivityCTE computes per account, for [$1, $2): the opening balance,
+// debits and credits. The opening starts from the latest closed period ending
+// at or before $1 and only replays ledgers after it. Scope filters go in %s and
+// reference the accounts alias "a".
+const accountActivityCTE = `
+	WITH base AS (
+		SELECT id, period_end
+		FROM accounting_periods
+		WHERE status = 'closed' AND period_end <= $1
+		ORDER BY period_end DESC
+		LIMIT 1
+	),
+	scoped AS (
+		SELECT a.id, a.account_number, a.owner_type, a.purpose, a.account_type, a.currency
+		FROM accounts a
+		WHERE a.created_at < $2 %s
+	),
+	activity AS (
+		SELECT
+			l.account_id,
+			COALESCE(SUM(CASE WHEN l.dr_cr = 'CR' THEN l.amount ELSE -l.amount END)
+				FILTER (WHERE l.created_at < $1), 0) AS net_before,
+			COALESCE(SUM(l.amount) FILTER (WHERE l.created_at >= $1 AND l.dr_cr = 'DR'), 0) AS debits,
+			COALESCE(SUM(l.amount) FILTER (WHERE l.created_at >= $1 AND l.dr_cr = 'CR'), 0) AS credits
+		FROM ledgers l
+		JOIN scoped s ON s.id = l.account_id
+		WHERE l.created_at < $2
+		  AND l.created_at >= COALESCE((SELECT period_end FROM base), '-infinity'::timestamptz)
+		GROUP BY l.account_id
+	),
+	account_activity AS (
+		SELECT
+			s.id AS account_id, s.account_number, s.owner_type, s.purpose, s.account_type, s.currency,
+			COALESCE(pb.closing_balance, ob.amount, 0) + COALESCE(act.net_before, 0) AS opening_balance,
+			COALESCE(act.debits, 0) AS total_debits,
+			COALESCE(act.credits, 0) AS total_credits
+		FROM scoped s
+		LEFT JOIN period_balances pb ON pb.period_id = (SELECT id FROM base) AND pb.account_id = s.id
+		LEFT JOIN account_opening_balances ob ON ob.account_id = s.id
+		LEFT JOIN activity act ON act.account_id = s.id
+	)
+`
+
+// ===============================
+// PERIOD CLOSE
+// ===============================
+
+// BeginClose records the period as closing under the exclusive period lock.
+// Once it commits, no posting can be dated before period_end. Re-closing a
+// period left in 'closing' by a failed snapshot returns it so the snapshot can
+// be retried.
+func (r *periodRepo) BeginClose(ctx context.Context, req *domain.CloseAccountingPeriodRequest) (*domain.AccountingPeriod, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Waits for in-flight postings holding the shared lock
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, periodCloseLockKey); err != nil {
+		return nil, fmt.Errorf("failed to acquire period lock: %w", err)
+	}
+
+	latest, err := scanAccountingPeriod(tx.QueryRow(ctx,
+		`SELECT `+accountingPeriodColumns+` FROM accounting_periods ORDER BY period_end DESC LIMIT 1`))
+	if err != nil && !errors.Is(err, xerrors.ErrAccountingPeriodNotFound) {
+		return nil, err
+	}
+
+	if latest != nil {
+		sameRange := latest.PeriodStart.Equal(req.PeriodStart) && latest.PeriodEnd.Equal(req.PeriodEnd)
+		switch {
+		case sameRange && latest.Status == domain.PeriodClosing:
+			return latest, nil
+		case sameRange:
+			return nil, xerrors.ErrAccountingPeriodClosed
+		case latest.Status == domain.PeriodClosing:
+			return nil, fmt.Errorf("%w: period %d is still closing", xerrors.ErrInvalidAccountingPeriod, latest.ID)
+		case !latest.PeriodEnd.Equal(req.PeriodStart):
+			return nil, fmt.Errorf("%w: next period must start at %s",
+				xerrors.ErrInvalidAccountingPeriod, latest.PeriodEnd.Format(time.RFC3339))
+		}
+	}
+
+	period, err := scanAccountingPeriod(tx.QueryRow(ctx, `
+		INSERT INTO accounting_periods (period_start, period_end, status, closed_by, note)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING `+accountingPeriodColumns,
+		req.PeriodStart, req.PeriodEnd, domain.PeriodClosing, req.ClosedBy, req.Note,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create accounting period: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit accounting period: %w", err)
+	}
+
+	return period, nil
+}
+
+// SnapshotBalances writes the closing balance of every account into
+// period_balances and marks the period closed
+func (r *periodRepo) SnapshotBalances(ctx context.Context, period *domain.AccountingPeriod) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// A retried close replaces the partial snapshot
+	if _, err := tx.Exec(ctx, `DELETE FROM period_balances WHERE period_id = $1`, period.ID); err != nil {
+		return fmt.Errorf("failed to clear period balances: %w", err)
+	}
+
+	query := fmt.Sprintf(accountActivityCTE, "") + `
+		INSERT INTO period_balances (
+			period_id, account_id, account_number, owner_type, purpose, account_type, currency,
+			opening_balance, total_debits, total_credits, closing_balance
+		)
+		SELECT
+			$3, account_id, account_number, owner_type, purpose, account_type, currency,
+			opening_balance, total_debits, total_credits,
+			opening_balance + total_credits - total_debits
+		FROM account_activity
+	`
+
+	tag, err := tx.Exec(ctx, query, period.PeriodStart, period.PeriodEnd, period.ID)
+	if err != nil {
+		return fmt.Errorf("failed to snapshot period balances: %w", err)
+	}
+
+	err = tx.QueryRow(ctx, `
+		UPDATE accounting_periods
+		SET status = $2, accounts_count = $3, closed_at = now()
+		WHERE id = $1
+		RETURNING status, accounts_count, closed_at
+	`, period.ID, domain.PeriodClosed, tag.RowsAffected()).Scan(&period.Status, &period.AccountsCount, &period.ClosedAt)
+	if err != nil {
+		return fmt.Errorf("failed to close accounting period: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit period snapshot: %w", err)
+	}
+
+	return nil
+}
+
+func (r *periodRepo) GetByID(ctx context.Context, id int64) (*domain.AccountingPeriod, error) {
+	return scanAccountingPeriod(r.db.QueryRow(ctx,
+		`SELECT `+accountingPeriodColumns+` FROM accounting_periods WHERE id = $1`, id))
+}
+
+func (r *periodRepo) GetByRange(ctx context.Context, start, end time.Time) (*domain.AccountingPeriod, error) {
+	return scanAccountingPeriod(r.db.QueryRow(ctx,
+		`SELECT `+accountingPeriodColumns+` FROM accounting_periods WHERE period_start = $1 AND period_end = $2`,
+		start, end))
+}
+
+// ===============================
+// REPORTS
+// ===============================
+
+// TrialBalanceFromSnapshot aggregates a closed period's snapshot
+func (r *periodRepo) TrialBalanceFromSnapshot(ctx context.Context, periodID int64, scope domain.ReportScope) ([]*domain.TrialBalanceLine, error) {
+	args := []interface{}{periodID}
+	filters, args := reportScopeFilters("pb", scope, args)
+
+	query := `
+		SELECT
+			pb.purpose, pb.currency, pb.account_type, COUNT(*),
+			COALESCE(SUM(pb.opening_balance), 0), COALESCE(SUM(pb.total_debits), 0),
+			COALESCE(SUM(pb.total_credits), 0), COALESCE(SUM(pb.closing_balance), 0)
+		FROM period_balances pb
+		WHERE pb.period_id = $1` + filters + `
+		GROUP BY pb.purpose, pb.currency, pb.account_type
+		ORDER BY pb.currency, pb.purpose, pb.account_type
+	`
+
+	return r.queryTrialBalanceLines(ctx, query, args...)
+}
+
+// TrialBalance aggregates account activity over [from, to) from ledgers
+func (r *periodRepo) TrialBalance(ctx context.Context, from, to time.Time, scope domain.ReportScope) ([]*domain.TrialBalanceLine, error) {
+	args := []interface{}{from, to}
+	filters, args := reportScopeFilters("a", scope, args)
+
+	query := fmt.Sprintf(accountActivityCTE, filters) + `
+		SELECT
+			purpose, currency, account_type, COUNT(*),
+			COALESCE(SUM(opening_balance), 0), COALESCE(SUM(total_debits), 0),
+			COALESCE(SUM(total_credits), 0), COALESCE(SUM(opening_balance + total_credits - total_debits), 0)
+		FROM account_activity
+		GROUP BY purpose, currency, account_type
+		ORDER BY currency, purpose, account_type
+	`
+
+	return r.queryTrialBalanceLines(ctx, query, args...)
+}
+
+// ListGeneralLedgerEntries lists postings in [from, to) ordered by purpose, currency and time
+func (r *periodRepo) ListGeneralLedgerEntries(ctx context.Context, req *domain.GeneralLedgerRequest) ([]*domain.GeneralLedgerEntry, int64, error) {
+	args := []interface{}{req.From, req.To}
+	filters, args := reportScopeFilters("a", req.Scope, args)
+
+	from := `
+		FROM ledgers l
+		JOIN accounts a ON a.id = l.account_id
+		JOIN journals j ON j.id = l.journal_id
+		WHERE l.created_at >= $1 AND l.created_at < $2` + filters
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*)`+from, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count general ledger entries: %w", err)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	argIndex := len(args) + 1
+
+	query := `
+		SELECT
+			l.id, l.journal_id, a.account_number, a.purpose, a.account_type, l.currency,
+			l.dr_cr, l.amount, l.balance_after, l.receipt_code, l.description,
+			j.transaction_type, l.created_at` + from +
+		fmt.Sprintf(" ORDER BY a.purpose, l.currency, l.created_at, l.id LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	args = append(args, limit, req.Offset)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list general ledger entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]*domain.GeneralLedgerEntry, 0)
+	for rows.Next() {
+		var e domain.GeneralLedgerEntry
+		if err := rows.Scan(
+			&e.LedgerID, &e.JournalID, &e.AccountNumber, &e.Purpose, &e.AccountType, &e.Currency,
+			&e.DrCr, &e.Amount, &e.BalanceAfter, &e.ReceiptCode, &e.Description,
+			&e.TransactionType, &e.CreatedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan general ledger entry: %w", err)
+		}
+		entries = append(entries, &e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate general ledger entries: %w", err)
+	}
+
+	return entries, total, nil
+}
+
+func (r *periodRepo) queryTrialBalanceLines(ctx context.Context, query string, args ...interface{}) ([]*domain.TrialBalanceLine, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query trial balance: %w", err)
+	}
+	defer rows.Close()
+
+	lines := make([]*domain.TrialBalanceLine, 0)
+	for rows.Next() {
+		var line domain.TrialBalanceLine
+		if err := rows.Scan(
+			&line.Purpose, &line.Currency, &line.AccountType, &line.AccountCount,
+			&line.OpeningBalance, &line.TotalDebits, &line.TotalCredits, &line.ClosingBalance,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan trial balance line: %w", err)
+		}
+		lines = append(lines, &line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate trial balance: %w", err)
+	}
+
+	return lines, nil
+}
+
+// reportScopeFilters appends the scope as " AND alias.col = $n" conditions
+func reportScopeFilters(alias string, scope domain.ReportScope, args []interface{}) (string, []interface{}) {
+	filters := ""
+
+	if scope.AccountType != nil {
+		args = append(args, *scope.AccountType)
+		filters += fmt.Sprintf(" AND %s.account_type = $%d", alias, len(args))
+	}
+	if scope.Currency != nil {
+		args = append(args, *scope.Currency)
+		filters += fmt.Sprintf(" AND %s.currency = $%d", alias, len(args))
+	}
+	if scope.Purpose != nil {
+		args = append(args, *scope.Purpose)
+		filters += fmt.Sprintf(" AND %s.purpose = $%d", alias, len(args))
+	}
+	if scope.AccountNumber != nil {
+		args = append(args, *scope.AccountNumber)
+		filters += fmt.Sprintf(" AND %s.account_number = $%d", alias, len(args))
+	}
+
+	return filters, args
+}
+
+func scanAccountingPeriod(row pgx.Row) (*domain.AccountingPeriod, error) {
+	var p domain.AccountingPeriod
+	err := row.Scan(
+		&p.ID, &p.PeriodStart, &p.PeriodEnd, &p.Status, &p.ClosedBy, &p.Note,
+		&p.AccountsCount, &p.CreatedAt, &p.ClosedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, xerrors.ErrAccountingPeriodNotFound
+		}
+		return nil, fmt.Errorf("failed to scan accounting period: %w", err)
+	}
+	return &p, nil
+}
@@ -0,0 +1,613 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"accounting-service/internal/domain"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationRepository recomputes balances from ledgers and stores the
+// runs and breaks. The Check* methods only read; run them inside the snapshot
+// returned by BeginSnapshot so balances and ledgers are seen at one instant.
+type ReconciliationRepository interface {
+	BeginSnapshot(ctx context.Context) (pgx.Tx, error)
+	CheckBalances(ctx context.Context, tx pgx.Tx, scope domain.ReconciliationScope) ([]*domain.ReconciliationBreak, int64, error)
+	CheckJournals(ctx context.Context, tx pgx.Tx, scope domain.ReconciliationScope) ([]*domain.ReconciliationBreak, int64, error)
+	CheckCurrencies(ctx context.Context, tx pgx.Tx, scope domain.ReconciliationScope) ([]*domain.ReconciliationBreak, int64, error)
+
+	CreateRun(ctx context.Context, run *domain.ReconciliationRun) error
+	FinishRun(ctx context.Context, run *domain.ReconciliationRun) error
+	GetRun(ctx context.Context, id int64) (*domain.ReconciliationRun, error)
+	GetLatestRun(ctx context.Context) (*domain.ReconciliationRun, error)
+	InsertBreaks(ctx context.Context, runID int64, breaks []*domain.ReconciliationBreak) error
+	ListBreaks(ctx context.Context, filter *domain.ReconciliationBreakFilter) ([]*domain.ReconciliationBreak, int64, error)
+}
+
+type reconciliationRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewReconciliationRepo(db *pgxpool.Pool) ReconciliationRepository {
+	return &reconciliationRepo{db: db}
+}
+
+const reconciliationRunColumns = `
+	id, status, trigger_type, triggered_by, currency, account_type, journals_from,
+	accounts_checked, journals_checked, currencies_checked, breaks_found,
+	error, started_at, finished_at
+`
+
+const reconciliationBreakColumns = `
+	id, run_id, break_type, account_id, account_number, account_type, journal_id,
+	currency, expected, actual, difference, details, resolved, created_at
+`
+
+// BeginSnapshot starts a read-only repeatable-read transaction
+func (r *reconciliationRepo) BeginSnapshot(ctx context.Context) (pgx.Tx, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin reconciliation snapshot: %w", err)
+	}
+	return tx, nil
+}
+
+// ===============================
+// CHECKS
+// ===============================
+
+// CheckBalances compares every balance row with opening + SUM(CR) - SUM(DR) of
+// its ledgers, and available_balance with balance - pending_debit
+func (r *reconciliationRepo) CheckBalances(
+	ctx context.Context,
+	tx pgx.Tx,
+	scope domain.ReconciliationScope,
+) ([]*domain.ReconciliationBreak, int64, error) {
+	if tx == nil {
+		return nil, 0, errors.New("transaction cannot be nil")
+	}
+
+	scopeFilter := `
+		($1::text IS NULL OR a.currency = $1)
+		AND ($2::account_type_enum IS NULL OR a.account_type = $2)
+	`
+
+	var checked int64
+	countQuery := `
+		SELECT COUNT(*)
+		FROM accounts a
+		JOIN balances b ON b.account_id = a.id
+		WHERE ` + scopeFilter
+
+	if err := tx.QueryRow(ctx, countQuery, scope.Currency, scope.AccountType).Scan(&checked); err != nil {
+		return nil, 0, fmt.Errorf("failed to count balances: %w", err)
+	}
+
+	query := `
+		WITH ledger_net AS (
+			SELECT l.account_id,
+			       SUM(CASE WHEN l.dr_cr = 'CR' THEN l.amount ELSE -l.amount END) AS net,
+			       COUNT(*) AS entries
+			FROM ledgers l
+			JOIN accounts a ON a.id = l.account_id
+			WHERE ` + scopeFilter + `
+			GROUP BY l.account_id
+		)
+		SELECT a.id, a.account_number, a.account_type, a.currency,
+		       COALESCE(o.amount, 0) AS opening,
+		       COALESCE(n.net, 0) AS net,
+		       COALESCE(n.entries, 0) AS entries,
+		       b.balance, b.available_balance, b.pending_debit
+		FROM accounts a
+		JOIN balances b ON b.account_id = a.id
+		LEFT JOIN account_opening_balances o ON o.account_id = a.id
+		LEFT JOIN ledger_net n ON n.account_id = a.id
+		WHERE ` + scopeFilter + `
+		  AND (
+		        b.balance <> COALESCE(o.amount, 0) + COALESCE(n.net, 0)
+		     OR b.available_balance <> b.balance - b.pending_debit
+		  )
+		ORDER BY a.id
+	`
+
+	rows, err := tx.Query(ctx, query, scope.Currency, scope.AccountType)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to check balances: %w", err)
+	}
+	defer rows.Close()
+
+	var breaks []*domain.ReconciliationBreak
+	for rows.Next() {
+		var (
+			accountID                        int64
+			accountNumber, currency          string
+			accountType                      domain.AccountType
+			opening, net                     decimal.Decimal
+			entries                          int64
+			balance, available, pendingDebit decimal.Decimal
+		)
+		if err := rows.Scan(
+			&accountID, &accountNumber, &accountType, &currency,
+			&opening, &net, &entries,
+			&balance, &available, &pendingDebit,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan balance check: %w", err)
+		}
+
+		expected := opening.Add(net)
+		if !balance.Equal(expected) {
+			breaks = append(breaks, &domain.ReconciliationBreak{
+				BreakType:     domain.BreakBalanceMismatch,
+				AccountID:     &accountID,
+				AccountNumber: &accountNumber,
+				AccountType:   &accountType,
+				Currency:      currency,
+				Expected:      expected,
+				Actual:        balance,
+				Difference:    balance.Sub(expected),
+				Details: map[string]string{
+					"opening_balance": opening.String(),
+					"ledger_net":      net.String(),
+					"ledger_entries":  fmt.Sprintf("%d", entries),
+				},
+			})
+		}
+
+		expectedAvailable := balance.Sub(pendingDebit)
+		if !available.Equal(expectedAvailable) {
+			breaks = append(breaks, &domain.ReconciliationBreak{
+				BreakType:     domain.BreakAvailableMismatch,
+				AccountID:     &accountID,
+				AccountNumber: &accountNumber,
+				AccountType:   &accountType,
+				Currency:      currency,
+				Expected:      expectedAvailable,
+				Actual:        available,
+				Difference:    available.Sub(expectedAvailable),
+				Details: map[string]string{
+					"balance":       balance.String(),
+					"pending_debit": pendingDebit.String(),
+				},
+			})
+		}
+	}
+
+	return breaks, checked, rows.Err()
+}
+
+// CheckJournals verifies double entry for journals created since scope.JournalsFrom.
+// Single-currency journals must have debits = credits. Conversion journals
+// legitimately differ per currency, so they only need both a debit and a credit.
+func (r *reconciliationRepo) CheckJournals(
+	ctx context.Context,
+	tx pgx.Tx,
+	scope domain.ReconciliationScope,
+) ([]*domain.ReconciliationBreak, int64, error) {
+	if tx == nil {
+		return nil, 0, errors.New("transaction cannot be nil")
+	}
+
+	// Ledgers are written in the journal's transaction; the one-day margin only
+	// keeps the hypertable scan bounded without missing clock skew.
+	totals := `
+		WITH totals AS (
+			SELECT j.id, j.account_type, j.transaction_type,
+			       COUNT(l.id) AS entries,
+			       COUNT(DISTINCT l.currency) AS currencies,
+			       COALESCE(MIN(l.currency), '') AS currency,
+			       COALESCE(SUM(l.amount) FILTER (WHERE l.dr_cr = 'DR'), 0) AS debits,
+			       COALESCE(SUM(l.amount) FILTER (WHERE l.dr_cr = 'CR'), 0) AS credits
+			FROM journals j
+			LEFT JOIN ledgers l
+			       ON l.journal_id = j.id
+			      AND l.created_at >= $1::timestamptz - INTERVAL '1 day'
+			WHERE j.created_at >= $1
+			  AND ($3::account_type_enum IS NULL OR j.account_type = $3)
+			GROUP BY j.id, j.account_type, j.transaction_type
+			HAVING $2::text IS NULL OR bool_or(l.currency = $2)
+		)
+	`
+	args := []interface{}{scope.JournalsFrom, scope.Currency, scope.AccountType}
+
+	var checked int64
+	if err := tx.QueryRow(ctx, totals+`SELECT COUNT(*) FROM totals`, args...).Scan(&checked); err != nil {
+		return nil, 0, fmt.Errorf("failed to count journals: %w", err)
+	}
+
+	query := totals + `
+		SELECT id, account_type, transaction_type, entries, currencies, currency, debits, credits
+		FROM totals
+		WHERE entries = 0
+		   OR (currencies = 1 AND debits <> credits)
+		   OR (currencies > 1 AND (debits = 0 OR credits = 0))
+		ORDER BY id
+	`
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to check journals: %w", err)
+	}
+	defer rows.Close()
+
+	var breaks []*domain.ReconciliationBreak
+	for rows.Next() {
+		var (
+			journalID           int64
+			accountType         domain.AccountType
+			transactionType     string
+			entries, currencies int64
+			currency            string
+			debits, credits     decimal.Decimal
+		)
+		if err := rows.Scan(
+			&journalID, &accountType, &transactionType,
+			&entries, &currencies, &currency, &debits, &credits,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan journal check: %w", err)
+		}
+
+		brk := &domain.ReconciliationBreak{
+			BreakType:   domain.BreakJournalUnbalanced,
+			AccountType: &accountType,
+			JournalID:   &journalID,
+			Currency:    currency,
+			Expected:    debits,
+			Actual:      credits,
+			Difference:  credits.Sub(debits),
+			Details: map[string]string{
+				"transaction_type": transactionType,
+				"ledger_entries":   fmt.Sprintf("%d", entries),
+			},
+		}
+		if entries == 0 {
+			brk.BreakType = domain.BreakJournalNoEntries
+		}
+		if currencies > 1 {
+			brk.Details["currencies"] = fmt.Sprintf("%d", currencies)
+		}
+
+		breaks = append(breaks, brk)
+	}
+
+	return breaks, checked, rows.Err()
+}
+
+// CheckCurrencies verifies that, per currency and account type, all ledgers of
+// single-currency journals net to zero system-wide. Conversion journals are
+// left out: they move value between currencies by design.
+func (r *reconciliationRepo) CheckCurrencies(
+	ctx context.Context,
+	tx pgx.Tx,
+	scope domain.ReconciliationScope,
+) ([]*domain.ReconciliationBreak, int64, error) {
+	if tx == nil {
+		return nil, 0, errors.New("transaction cannot be nil")
+	}
+
+	query := `
+		WITH per_journal AS (
+			SELECT l.journal_id, l.account_type,
+			       MIN(l.currency) AS currency,
+			       COUNT(DISTINCT l.currency) AS currencies,
+			       SUM(CASE WHEN l.dr_cr = 'CR' THEN l.amount ELSE -l.amount END) AS net
+			FROM ledgers l
+			WHERE ($2::account_type_enum IS NULL OR l.account_type = $2)
+			GROUP BY l.journal_id, l.account_type
+		)
+		SELECT currency, account_type, SUM(net) AS net, COUNT(*) AS journals
+		FROM per_journal
+		WHERE currencies = 1
+		  AND ($1::text IS NULL OR currency = $1)
+		GROUP BY currency, account_type
+		ORDER BY currency, account_type
+	`
+
+	rows, err := tx.Query(ctx, query, scope.Currency, scope.AccountType)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to check currency totals: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		breaks  []*domain.ReconciliationBreak
+		checked int64
+	)
+	for rows.Next() {
+		var (
+			currency    string
+			accountType domain.AccountType
+			net         decimal.Decimal
+			journals    int64
+		)
+		if err := rows.Scan(&currency, &accountType, &net, &journals); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan currency check: %w", err)
+		}
+		checked++
+
+		if net.IsZero() {
+			continue
+		}
+		breaks = append(breaks, &domain.ReconciliationBreak{
+			BreakType:   domain.BreakCurrencyImbalance,
+			AccountType: &accountType,
+			Currency:    currency,
+			Expected:    decimal.Zero,
+			Actual:      net,
+			Difference:  net,
+			Details: map[string]string{
+				"journals": fmt.Sprintf("%d", journals),
+			},
+		})
+	}
+
+	return breaks, checked, rows.Err()
+}
+
+// ===============================
+// RUNS & BREAKS
+// ===============================
+
+// CreateRun inserts a run in the running state
+func (r *reconciliationRepo) CreateRun(ctx context.Context, run *domain.ReconciliationRun) error {
+	query := `
+		INSERT INTO reconciliation_runs (status, trigger_type, triggered_by, currency, account_type, journals_from)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, started_at
+	`
+
+	err := r.db.QueryRow(ctx, query,
+		run.Status,
+		run.TriggerType,
+		run.TriggeredBy,
+		run.Currency,
+		run.AccountType,
+		run.JournalsFrom,
+	).Scan(&run.ID, &run.StartedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create reconciliation run: %w", err)
+	}
+
+	return nil
+}
+
+// FinishRun stores the final status and counters of a run
+func (r *reconciliationRepo) FinishRun(ctx context.Context, run *domain.ReconciliationRun) error {
+	query := `
+		UPDATE reconciliation_runs
+		SET status = $1,
+			accounts_checked = $2,
+			journals_checked = $3,
+			currencies_checked = $4,
+			breaks_found = $5,
+			error = $6,
+			finished_at = now()
+		WHERE id = $7
+		RETURNING finished_at
+	`
+
+	err := r.db.QueryRow(ctx, query,
+		run.Status,
+		run.AccountsChecked,
+		run.JournalsChecked,
+		run.CurrenciesChecked,
+		run.BreaksFound,
+		run.Error,
+		run.ID,
+	).Scan(&run.FinishedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return xerrors.ErrReconciliationRunNotFound
+		}
+		return fmt.Errorf("failed to finish reconciliation run: %w", err)
+	}
+
+	return nil
+}
+
+// GetRun fetches a run by ID
+func (r *reconciliationRepo) GetRun(ctx context.Context, id int64) (*domain.ReconciliationRun, error) {
+	query := `SELECT ` + reconciliationRunColumns + ` FROM reconciliation_runs WHERE id = $1`
+
+	run, err := scanReconciliationRun(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, xerrors.ErrReconciliationRunNotFound
+		}
+		return nil, fmt.Errorf("failed to get reconciliation run: %w", err)
+	}
+
+	return run, nil
+}
+
+// GetLatestRun fetches the most recently started run
+func (r *reconciliationRepo) GetLatestRun(ctx context.Context) (*domain.ReconciliationRun, error) {
+	query := `SELECT ` + reconciliationRunColumns + ` FROM reconciliation_runs ORDER BY started_at DESC, id DESC LIMIT 1`
+
+	run, err := scanReconciliationRun(r.db.QueryRow(ctx, query))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, xerrors.ErrReconciliationRunNotFound
+		}
+		return nil, fmt.Errorf("failed to get latest reconciliation run: %w", err)
+	}
+
+	return run, nil
+}
+
+// InsertBreaks stores the breaks of a run in one batch
+func (r *reconciliationRepo) InsertBreaks(ctx context.Context, runID int64, breaks []*domain.ReconciliationBreak) error {
+	if len(breaks) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO reconciliation_breaks (
+			run_id, break_type, account_id, account_number, account_type, journal_id,
+			currency, expected, actual, difference, details
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	batch := &pgx.Batch{}
+	for _, b := range breaks {
+		b.RunID = runID
+		if b.Details == nil {
+			b.Details = map[string]string{}
+		}
+		batch.Queue(query,
+			runID,
+			b.BreakType,
+			b.AccountID,
+			b.AccountNumber,
+			b.AccountType,
+			b.JournalID,
+			b.Currency,
+			b.Expected,
+			b.Actual,
+			b.Difference,
+			b.Details,
+		)
+	}
+
+	br := r.db.SendBatch(ctx, batch)
+	defer br.Close()
+
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := br.Exec(); err != nil {
+			return fmt.Errorf("failed to insert reconciliation break at index %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// ListBreaks returns breaks matching the filter in the order they were found
+func (r *reconciliationRepo) ListBreaks(ctx context.Context, filter *domain.ReconciliationBreakFilter) ([]*domain.ReconciliationBreak, int64, error) {
+	baseQuery := `SELECT ` + reconciliationBreakColumns + ` FROM reconciliation_breaks WHERE 1=1`
+	countQuery := `SELECT COUNT(*) FROM reconciliation_breaks WHERE 1=1`
+
+	args := []interface{}{}
+	argIndex := 1
+
+	if filter.RunID != nil {
+		baseQuery += fmt.Sprintf(" AND run_id = $%d", argIndex)
+		countQuery += fmt.Sprintf(" AND run_id = $%d", argIndex)
+		args = append(args, *filter.RunID)
+		argIndex++
+	}
+
+	if filter.BreakType != nil {
+		baseQuery += fmt.Sprintf(" AND break_type = $%d", argIndex)
+		countQuery += fmt.Sprintf(" AND break_type = $%d", argIndex)
+		args = append(args, *filter.BreakType)
+		argIndex++
+	}
+
+	if filter.Currency != nil {
+		baseQuery += fmt.Sprintf(" AND currency = $%d", argIndex)
+		countQuery += fmt.Sprintf(" AND currency = $%d", argIndex)
+		args = append(args, *filter.Currency)
+		argIndex++
+	}
+
+	if filter.Resolved != nil {
+		baseQuery += fmt.Sprintf(" AND resolved = $%d", argIndex)
+		countQuery += fmt.Sprintf(" AND resolved = $%d", argIndex)
+		args = append(args, *filter.Resolved)
+		argIndex++
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count reconciliation breaks: %w", err)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	baseQuery += fmt.Sprintf(" ORDER BY id ASC LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	args = append(args, limit, filter.Offset)
+
+	rows, err := r.db.Query(ctx, baseQuery, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list reconciliation breaks: %w", err)
+	}
+	defer rows.Close()
+
+	var breaks []*domain.ReconciliationBreak
+	for rows.Next() {
+		var b domain.ReconciliationBreak
+		if err := rows.Scan(
+			&b.ID,
+			&b.RunID,
+			&b.BreakType,
+			&b.AccountID,
+			&b.AccountNumber,
+			&b.AccountType,
+			&b.JournalID,
+			&b.Currency,
+			&b.Expected,
+			&b.Actual,
+			&b.Difference,
+			&b.Details,
+			&b.Resolved,
+			&b.CreatedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan reconciliation break: %w", err)
+		}
+		breaks = append(breaks, &b)
+	}
+
+	return breaks, total, rows.Err()
+}
+
+// insertOpeningBalance records a balance set outside the ledger so
+// reconciliation can account for it
+func insertOpeningBalance(ctx context.Context, tx pgx.Tx, accountID int64, amount decimal.Decimal, note string) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO account_opening_balances (account_id, amount, note)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (account_id) DO UPDATE SET amount = EXCLUDED.amount, note = EXCLUDED.note
+	`, accountID, amount, note)
+	if err != nil {
+		return fmt.Errorf("failed to record opening balance: %w", err)
+	}
+	return nil
+}
+
+// scanReconciliationRun scans a row selected with reconciliationRunColumns
+func scanReconciliationRun(row pgx.Row) (*domain.ReconciliationRun, error) {
+	var run domain.ReconciliationRun
+	err := row.Scan(
+		&run.ID,
+		&run.Status,
+		&run.TriggerType,
+		&run.TriggeredBy,
+		&run.Currency,
+		&run.AccountType,
+		&run.JournalsFrom,
+		&run.AccountsChecked,
+		&run.JournalsChecked,
+		&run.CurrenciesChecked,
+		&run.BreaksFound,
+		&run.Error,
+		&run.StartedAt,
+		&run.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
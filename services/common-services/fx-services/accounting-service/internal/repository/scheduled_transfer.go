@@ -0,0 +1,288 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+// ScheduledTransferRepository stores standing orders. The executor claims due
+// schedules with SKIP LOCKED and pushes available_at out by a lease, so a
+// schedule whose executor died is picked up again once the lease runs out.
+type ScheduledTransferRepository interface {
+	Create(ctx context.Context, s *domain.ScheduledTransfer) error
+	GetByID(ctx context.Context, id int64) (*domain.ScheduledTransfer, error)
+	List(ctx context.Context, filter *domain.ScheduledTransferFilter) ([]*domain.ScheduledTransfer, int64, error)
+	ListRuns(ctx context.Context, scheduleID int64, limit int) ([]*domain.ScheduledTransferRun, error)
+
+	// Cancel stops an active schedule. Returns ErrScheduleNotActive when it
+	// already finished or was cancelled.
+	Cancel(ctx context.Context, id int64, cancelledBy string) (*domain.ScheduledTransfer, error)
+
+	// ClaimDue leases up to limit active schedules whose next attempt is due
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*domain.ScheduledTransfer, error)
+
+	// RecordRun stores how the due occurrence ended and moves the schedule to
+	// next (nil completes it)
+	RecordRun(ctx context.Context, run *domain.ScheduledTransferRun, next *time.Time) error
+
+	// ScheduleRetry counts a failed attempt of the due occurrence and makes it
+	// due again at retryAt
+	ScheduleRetry(ctx context.Context, id int64, errMsg string, retryAt time.Time) error
+}
+
+type scheduledTransferRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewScheduledTransferRepo(db *pgxpool.Pool) ScheduledTransferRepository {
+	return &scheduledTransferRepo{db: db}
+}
+
+const scheduledTransferColumns = `
+	id, owner_type, owner_id, from_account_number, to_account_number, amount::text, currency,
+	account_type, description, cron_expr, interval_seconds, start_at, end_at, max_runs, max_retries,
+	status, next_run_at, attempts, run_count, last_run_at, last_receipt_code, last_error,
+	cancelled_by, cancelled_at, created_at, updated_at
+`
+
+func (r *scheduledTransferRepo) Create(ctx context.Context, s *domain.ScheduledTransfer) error {
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO scheduled_transfers (
+			owner_type, owner_id, from_account_number, to_account_number, amount, currency,
+			account_type, description, cron_expr, interval_seconds, start_at, end_at, max_runs, max_retries,
+			status, next_run_at, available_at
+		) VALUES ($1, $2, $3, $4, $5::numeric, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $16)
+		RETURNING id, created_at, updated_at
+	`, s.OwnerType, s.OwnerID, s.FromAccountNumber, s.ToAccountNumber, s.Amount.String(), s.Currency,
+		s.AccountType, s.Description, s.CronExpr, s.IntervalSeconds, s.StartAt, s.EndAt, s.MaxRuns, s.MaxRetries,
+		s.Status, s.NextRunAt,
+	).Scan(&s.ID, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create scheduled transfer: %w", err)
+	}
+	return nil
+}
+
+func (r *scheduledTransferRepo) GetByID(ctx context.Context, id int64) (*domain.ScheduledTransfer, error) {
+	s, err := scanScheduledTransfer(r.db.QueryRow(ctx, `
+		SELECT `+scheduledTransferColumns+` FROM scheduled_transfers WHERE id = $1
+	`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, xerrors.ErrScheduledTransferNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled transfer: %w", err)
+	}
+	return s, nil
+}
+
+func (r *scheduledTransferRepo) List(
+	ctx context.Context,
+	filter *domain.ScheduledTransferFilter,
+) ([]*domain.ScheduledTransfer, int64, error) {
+	const where = `
+		WHERE ($1::owner_type_enum IS NULL OR owner_type = $1)
+		  AND ($2::text IS NULL OR owner_id = $2)
+		  AND ($3::text IS NULL OR from_account_number = $3 OR to_account_number = $3)
+		  AND ($4::text IS NULL OR status = $4)
+	`
+	args := []interface{}{filter.OwnerType, filter.OwnerID, filter.AccountNumber, filter.Status}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM scheduled_transfers`+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count scheduled transfers: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT `+scheduledTransferColumns+`
+		FROM scheduled_transfers`+where+`
+		ORDER BY created_at DESC, id DESC
+		LIMIT $5 OFFSET $6
+	`, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list scheduled transfers: %w", err)
+	}
+	defer rows.Close()
+
+	schedules, err := collectScheduledTransfers(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return schedules, total, nil
+}
+
+func (r *scheduledTransferRepo) ListRuns(
+	ctx context.Context,
+	scheduleID int64,
+	limit int,
+) ([]*domain.ScheduledTransferRun, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, schedule_id, occurrence_at, status, attempts, receipt_code, error_message, created_at
+		FROM scheduled_transfer_runs
+		WHERE schedule_id = $1
+		ORDER BY occurrence_at DESC
+		LIMIT $2
+	`, scheduleID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled transfer runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*domain.ScheduledTransferRun
+	for rows.Next() {
+		var run domain.ScheduledTransferRun
+		if err := rows.Scan(
+			&run.ID, &run.ScheduleID, &run.OccurrenceAt, &run.Status, &run.Attempts,
+			&run.ReceiptCode, &run.ErrorMessage, &run.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan scheduled transfer run: %w", err)
+		}
+		runs = append(runs, &run)
+	}
+	return runs, rows.Err()
+}
+
+func (r *scheduledTransferRepo) Cancel(
+	ctx context.Context,
+	id int64,
+	cancelledBy string,
+) (*domain.ScheduledTransfer, error) {
+	s, err := scanScheduledTransfer(r.db.QueryRow(ctx, `
+		UPDATE scheduled_transfers
+		SET status = 'cancelled', cancelled_by = $2, cancelled_at = NOW(), next_run_at = NULL, available_at = NULL
+		WHERE id = $1 AND status = 'active'
+		RETURNING `+scheduledTransferColumns, id, cancelledBy))
+	if errors.Is(err, pgx.ErrNoRows) {
+		if _, getErr := r.GetByID(ctx, id); getErr != nil {
+			return nil, getErr
+		}
+		return nil, xerrors.ErrScheduleNotActive
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel scheduled transfer: %w", err)
+	}
+	return s, nil
+}
+
+func (r *scheduledTransferRepo) ClaimDue(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) ([]*domain.ScheduledTransfer, error) {
+	rows, err := r.db.Query(ctx, `
+		WITH due AS (
+			SELECT id
+			FROM scheduled_transfers
+			WHERE status = 'active' AND available_at <= NOW()
+			ORDER BY available_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE scheduled_transfers s
+		SET available_at = NOW() + make_interval(secs => $2)
+		FROM due
+		WHERE s.id = due.id
+		RETURNING s.id, s.owner_type, s.owner_id, s.from_account_number, s.to_account_number, s.amount::text, s.currency,
+		          s.account_type, s.description, s.cron_expr, s.interval_seconds, s.start_at, s.end_at, s.max_runs, s.max_retries,
+		          s.status, s.next_run_at, s.attempts, s.run_count, s.last_run_at, s.last_receipt_code, s.last_error,
+		          s.cancelled_by, s.cancelled_at, s.created_at, s.updated_at
+	`, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim scheduled transfers: %w", err)
+	}
+	defer rows.Close()
+
+	return collectScheduledTransfers(rows)
+}
+
+func (r *scheduledTransferRepo) RecordRun(
+	ctx context.Context,
+	run *domain.ScheduledTransferRun,
+	next *time.Time,
+) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// A re-claimed occurrence that was already recorded changes nothing
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO scheduled_transfer_runs (schedule_id, occurrence_at, status, attempts, receipt_code, error_message)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (schedule_id, occurrence_at) DO NOTHING
+	`, run.ScheduleID, run.OccurrenceAt, run.Status, run.Attempts, run.ReceiptCode, run.ErrorMessage)
+	if err != nil {
+		return fmt.Errorf("failed to record scheduled transfer run: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return tx.Commit(ctx)
+	}
+
+	// A schedule cancelled while its occurrence ran stays cancelled
+	if _, err := tx.Exec(ctx, `
+		UPDATE scheduled_transfers
+		SET run_count = run_count + 1,
+		    attempts = 0,
+		    last_run_at = NOW(),
+		    last_receipt_code = COALESCE($2, last_receipt_code),
+		    last_error = $3,
+		    next_run_at = CASE WHEN status = 'active' THEN $4::timestamptz END,
+		    available_at = CASE WHEN status = 'active' THEN $4::timestamptz END,
+		    status = CASE WHEN status = 'active' AND $4::timestamptz IS NULL THEN 'completed' ELSE status END
+		WHERE id = $1
+	`, run.ScheduleID, run.ReceiptCode, run.ErrorMessage, next); err != nil {
+		return fmt.Errorf("failed to advance scheduled transfer: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *scheduledTransferRepo) ScheduleRetry(ctx context.Context, id int64, errMsg string, retryAt time.Time) error {
+	_, err := r.db.Exec(ctx, `
+		UPDATE scheduled_transfers
+		SET attempts = attempts + 1, last_error = $2, available_at = $3
+		WHERE id = $1 AND status = 'active'
+	`, id, errMsg, retryAt)
+	if err != nil {
+		return fmt.Errorf("failed to reschedule scheduled transfer: %w", err)
+	}
+	return nil
+}
+
+func collectScheduledTransfers(rows pgx.Rows) ([]*domain.ScheduledTransfer, error) {
+	var schedules []*domain.ScheduledTransfer
+	for rows.Next() {
+		s, err := scanScheduledTransfer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scheduled transfer: %w", err)
+		}
+		schedules = append(schedules, s)
+	}
+	return schedules, rows.Err()
+}
+
+func scanScheduledTransfer(row pgx.Row) (*domain.ScheduledTransfer, error) {
+	var s domain.ScheduledTransfer
+	var amount string
+	err := row.Scan(
+		&s.ID, &s.OwnerType, &s.OwnerID, &s.FromAccountNumber, &s.ToAccountNumber, &amount, &s.Currency,
+		&s.AccountType, &s.Description, &s.CronExpr, &s.IntervalSeconds, &s.StartAt, &s.EndAt, &s.MaxRuns, &s.MaxRetries,
+		&s.Status, &s.NextRunAt, &s.Attempts, &s.RunCount, &s.LastRunAt, &s.LastReceiptCode, &s.LastError,
+		&s.CancelledBy, &s.CancelledAt, &s.CreatedAt, &s.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.Amount, _ = decimal.NewFromString(amount)
+	return &s, nil
+}
@@ -11,12 +11,14 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
 )
 
 type StatementRepository interface {
 	// Account-level statements
 	GetAccountStatement(ctx context.Context, accountNumber string, accountType domain.AccountType, from, to time.Time) (*domain.AccountStatement, error)
 	ListLedgersByAccount(ctx context.Context, accountNumber string, accountType domain.AccountType, from, to time.Time) ([]*domain. Ledger, error)
+	StreamAccountStatement(ctx context.Context, accountNumber string, accountType domain.AccountType, from, to time.Time, onHeader func(*domain.StatementHeader) error, onLine func(*domain.StatementLine) error) error
 
 	// Owner-level statements
 	ListLedgersByOwner(ctx context.Context, ownerType domain. OwnerType, ownerID string, accountType domain.AccountType, from, to time.Time) ([]*domain.Ledger, error)
@@ -32,7 +34,7 @@ type StatementRepository interface {
 	GetOwnerDailySummary(ctx context.Context, ownerType domain.OwnerType, ownerID string, accountType domain.AccountType, date time.Time) (*domain.DailyReport, error)
 
 	// Materialized view queries (fast aggregates)
-	GetSystemHoldings(ctx context.Context, accountType domain.AccountType) (map[string]float64, error)  // ✅ Fixed: Changed to float64
+	GetSystemHoldings(ctx context.Context, accountType domain.AccountType) (map[string]decimal.Decimal, error)
 	GetDailyTransactionVolume(ctx context.Context, accountType domain.AccountType, date time.Time) ([]*domain.TransactionSummary, error)
 
 	// Transaction management
@@ -110,9 +112,9 @@ func (r *statementRepo) GetAccountStatement(ctx context.Context, accountNumber s
 	// Calculate totals
 	for _, ledger := range ledgers {
 		if ledger.IsDebit() {
-			stmt.TotalDebits += ledger.Amount
+			stmt.TotalDebits = stmt.TotalDebits.Add(ledger.Amount)
 		} else {
-			stmt.TotalCredits += ledger.Amount
+			stmt.TotalCredits = stmt.TotalCredits.Add(ledger.Amount)
 		}
 	}
 
@@ -124,7 +126,7 @@ func (r *statementRepo) GetAccountStatement(ctx context.Context, accountNumber s
 	if closingBalance != nil {
 		stmt.ClosingBalance = closingBalance.Balance
 	} else {
-		stmt.ClosingBalance = stmt.OpeningBalance + stmt.TotalCredits - stmt.TotalDebits
+		stmt.ClosingBalance = stmt.OpeningBalance.Add(stmt.TotalCredits).Sub(stmt.TotalDebits)
 	}
 
 	return &stmt, nil
@@ -186,6 +188,132 @@ func (r *statementRepo) ListLedgersByAccount(ctx context.Context, accountNumber
 	return ledgers, nil
 }
 
+// StreamAccountStatement reads a statement row by row for exports. The header
+// (with the opening balance) and the lines come from one repeatable-read
+// snapshot so back-dated postings cannot make them disagree. Lines carry the
+// running balance and, on debits, the fee charged on the receipt.
+func (r *statementRepo) StreamAccountStatement(
+	ctx context.Context,
+	accountNumber string,
+	accountType domain.AccountType,
+	from, to time.Time,
+	onHeader func(*domain.StatementHeader) error,
+	onLine func(*domain.StatementLine) error,
+) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to begin statement snapshot: %w", err)
+	}
+	defer tx.Rollback(ctx) // read-only, nothing to commit
+
+	header := &domain.StatementHeader{
+		PeriodStart: from,
+		PeriodEnd:   to,
+		GeneratedAt: time.Now(),
+	}
+	var accountID int64
+
+	err = tx.QueryRow(ctx, `
+		SELECT a.id, a.account_number, a.account_type, a.owner_type, a.owner_id, a.currency
+		FROM accounts a
+		WHERE a.account_number = $1 AND a.account_type = $2
+	`, accountNumber, accountType).Scan(
+		&accountID,
+		&header.AccountNumber,
+		&header.AccountType,
+		&header.OwnerType,
+		&header.OwnerID,
+		&header.Currency,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return xerrors.ErrAccountNotFound
+		}
+		return fmt.Errorf("failed to get account info: %w", err)
+	}
+
+	// Opening = funding outside the ledger + ledger net before the period
+	err = tx.QueryRow(ctx, `
+		SELECT
+			COALESCE((SELECT amount FROM account_opening_balances WHERE account_id = $1), 0) +
+			COALESCE(SUM(CASE WHEN dr_cr = 'CR' THEN amount ELSE -amount END), 0)
+		FROM ledgers
+		WHERE account_id = $1 AND created_at < $2
+	`, accountID, from).Scan(&header.OpeningBalance)
+	if err != nil {
+		return fmt.Errorf("failed to calculate opening balance: %w", err)
+	}
+
+	if err := onHeader(header); err != nil {
+		return err
+	}
+
+	// Rows are consumed as they arrive; nothing is buffered here
+	rows, err := tx.Query(ctx, `
+		SELECT
+			l.id, l.journal_id, l.created_at, l.receipt_code, j.transaction_type,
+			COALESCE(l.description, j.description), l.dr_cr, l.amount,
+			CASE WHEN l.dr_cr = 'DR' THEN COALESCE(fee.amount, 0) ELSE 0 END
+		FROM ledgers l
+		JOIN journals j ON j.id = l.journal_id
+		LEFT JOIN LATERAL (
+			-- combined_fee already includes the network and platform parts
+			SELECT COALESCE(
+				MAX(f.amount) FILTER (WHERE f.fee_type = 'combined_fee'),
+				SUM(f.amount) FILTER (WHERE f.fee_type NOT IN ('combined_fee', 'agent_commission'))
+			) AS amount
+			FROM transaction_fees f
+			WHERE f.receipt_code = l.receipt_code AND f.currency = l.currency
+		) fee ON l.receipt_code IS NOT NULL
+		WHERE l.account_id = $1
+		  AND l.created_at >= $2
+		  AND l.created_at <= $3
+		ORDER BY l.created_at ASC, l.id ASC
+	`, accountID, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to stream statement lines: %w", err)
+	}
+	defer rows.Close()
+
+	balance := header.OpeningBalance
+	for rows.Next() {
+		var line domain.StatementLine
+		if err := rows.Scan(
+			&line.LedgerID,
+			&line.JournalID,
+			&line.Date,
+			&line.ReceiptCode,
+			&line.TransactionType,
+			&line.Description,
+			&line.DrCr,
+			&line.Amount,
+			&line.Fee,
+		); err != nil {
+			return fmt.Errorf("failed to scan statement line: %w", err)
+		}
+
+		if line.DrCr == domain.DrCrCredit {
+			balance = balance.Add(line.Amount)
+		} else {
+			balance = balance.Sub(line.Amount)
+		}
+		line.Balance = balance
+
+		if err := onLine(&line); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating statement lines: %w", err)
+	}
+
+	return nil
+}
+
 // ===============================
 // OWNER-LEVEL STATEMENTS
 // ===============================
@@ -263,13 +391,17 @@ func (r *statementRepo) GetOwnerSummary(
 			a.account_number,
 			a.currency,
 			COALESCE(b.balance, 0) AS balance,
-			COALESCE(b.available_balance, 0) AS available_balance
+			COALESCE(b.available_balance, 0) AS available_balance,
+			a.parent_account_id,
+			p.account_number AS parent_account_number,
+			a.name
 		FROM accounts a
 		LEFT JOIN balances b ON b.account_id = a.id
+		LEFT JOIN accounts p ON p.id = a.parent_account_id
 		WHERE a.owner_type = $1 
 		  AND a.owner_id = $2 
 		  AND a.account_type = $3
-		ORDER BY a.currency, a.account_number
+		ORDER BY a.currency, COALESCE(p.account_number, a.account_number), a.parent_account_id NULLS FIRST, a.account_number
 	`
 
 	rows, err := r.db.Query(ctx, query, ownerType, ownerID, accountType)
@@ -278,7 +410,7 @@ func (r *statementRepo) GetOwnerSummary(
 	}
 	defer rows.Close()
 
-	var totalBalanceUSD float64 // You may want to calculate USD equivalent here
+	totalBalanceUSD := decimal.Zero // You may want to calculate USD equivalent here
 
 	for rows.Next() {
 		var balance domain.AccountBalanceSummary
@@ -288,17 +420,22 @@ func (r *statementRepo) GetOwnerSummary(
 			&balance.Currency,
 			&balance.Balance,
 			&balance.AvailableBalance,
+			&balance.ParentAccountID,
+			&balance.ParentAccountNumber,
+			&balance.Name,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan account balance: %w", err)
 		}
+		balance.RolledUpBalance = balance.Balance
+		balance.RolledUpAvailableBalance = balance.AvailableBalance
 
 		summary.Balances = append(summary.Balances, &balance)
 
 		// TODO: Convert to USD equivalent if currency != USD
 		// For now, just sum all balances (assumes USD or add conversion logic)
 		if balance.Currency == "USD" {
-			totalBalanceUSD += balance.Balance
+			totalBalanceUSD = totalBalanceUSD.Add(balance.Balance)
 		}
 	}
 
@@ -310,6 +447,24 @@ func (r *statementRepo) GetOwnerSummary(
 		return nil, xerrors.ErrNotFound
 	}
 
+	// Roll sub-account balances up into their main account
+	mainAccounts := make(map[int64]*domain.AccountBalanceSummary)
+	for _, b := range summary.Balances {
+		if b.ParentAccountID == nil {
+			mainAccounts[b.AccountID] = b
+		}
+	}
+	for _, b := range summary.Balances {
+		if b.ParentAccountID == nil {
+			continue
+		}
+		if parent, ok := mainAccounts[*b.ParentAccountID]; ok {
+			parent.SubAccountCount++
+			parent.RolledUpBalance = parent.RolledUpBalance.Add(b.Balance)
+			parent.RolledUpAvailableBalance = parent.RolledUpAvailableBalance.Add(b.AvailableBalance)
+		}
+	}
+
 	summary.TotalBalance = totalBalanceUSD
 
 	return summary, nil
@@ -344,12 +499,12 @@ func (r *statementRepo) GetCurrentBalance(ctx context.Context, accountNumber str
 	var balance domain.Balance
 	balance.AccountID = accountID
 
-	var balanceAmount float64
+	var balanceAmount decimal.Decimal
 	err = r.db.QueryRow(ctx, balanceQuery, accountID, accountType).Scan(&balanceAmount)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			balance.Balance = 0
+			balance.Balance = decimal.Zero
 			balance.UpdatedAt = time.Now()
 			return &balance, nil
 		}
@@ -449,7 +604,7 @@ func (r *statementRepo) GetDailySummary(ctx context.Context, date time.Time, acc
 			return nil, fmt.Errorf("failed to scan daily report: %w", err)
 		}
 
-		rpt.NetChange = rpt.TotalCredit - rpt.TotalDebit
+		rpt.NetChange = rpt.TotalCredit.Sub(rpt.TotalDebit)
 		rpt.Date = startOfDay
 
 		reports = append(reports, &rpt)
@@ -509,7 +664,7 @@ func (r *statementRepo) GetOwnerDailySummary(ctx context.Context, ownerType doma
 	}
 
 	rpt.AccountType = accountType
-	rpt.NetChange = rpt.TotalCredit - rpt.TotalDebit
+	rpt.NetChange = rpt.TotalCredit.Sub(rpt.TotalDebit)
 	rpt.Date = startOfDay
 
 	return &rpt, nil
@@ -576,7 +731,7 @@ func (r *statementRepo) GetTransactionSummary(ctx context.Context, accountType d
 // ===============================
 
 // GetSystemHoldings returns total holdings by currency (uses materialized view)
-func (r *statementRepo) GetSystemHoldings(ctx context.Context, accountType domain.AccountType) (map[string]float64, error) {
+func (r *statementRepo) GetSystemHoldings(ctx context.Context, accountType domain.AccountType) (map[string]decimal.Decimal, error) {
 	// Uses materialized view: system_holdings_real
 	// Note: Schema only has system_holdings_real, not separate for demo
 	// For demo, we'll query accounts directly
@@ -606,10 +761,10 @@ func (r *statementRepo) GetSystemHoldings(ctx context.Context, accountType domai
 	}
 	defer rows.Close()
 
-	holdings := make(map[string]float64)
+	holdings := make(map[string]decimal.Decimal)
 	for rows.Next() {
 		var currency string
-		var balance float64
+		var balance decimal.Decimal
 
 		err := rows.Scan(&currency, &balance)
 		if err != nil {
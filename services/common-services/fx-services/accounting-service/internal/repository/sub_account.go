@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateSubAccount inserts a sub-account under account.ParentAccountID. The
+// parent row is locked while its sub-accounts are counted, so concurrent
+// creations cannot exceed maxPerParent.
+func (r *accountRepo) CreateSubAccount(ctx context.Context, account *domain.Account, maxPerParent int) error {
+	if account.ParentAccountID == nil {
+		return fmt.Errorf("%w: parent_account_id", xerrors.ErrRequiredFieldMissing)
+	}
+
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var parentActive bool
+	err = tx.QueryRow(ctx, `
+		SELECT is_active FROM accounts WHERE id = $1 FOR UPDATE
+	`, *account.ParentAccountID).Scan(&parentActive)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return xerrors.ErrAccountNotFound
+		}
+		return fmt.Errorf("failed to lock parent account: %w", err)
+	}
+	if !parentActive {
+		return xerrors.ErrAccountInactive
+	}
+
+	var count int
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*) FROM accounts WHERE parent_account_id = $1 AND is_active = true
+	`, *account.ParentAccountID).Scan(&count)
+	if err != nil {
+		return fmt.Errorf("failed to count sub-accounts: %w", err)
+	}
+	if count >= maxPerParent {
+		return fmt.Errorf("%w: at most %d per account", xerrors.ErrSubAccountLimitReached, maxPerParent)
+	}
+
+	if err := r.Create(ctx, account, tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetSubAccounts lists a main account's sub-accounts, oldest first
+func (r *accountRepo) GetSubAccounts(ctx context.Context, parentAccountID int64) ([]*domain.Account, error) {
+	rows, err := r.db.Query(ctx,
+		baseSelectQuery+` WHERE parent_account_id = $1 ORDER BY created_at, id`,
+		parentAccountID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sub-accounts: %w", err)
+	}
+	return scanAccountRows(rows)
+}
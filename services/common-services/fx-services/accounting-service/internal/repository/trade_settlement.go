@@ -0,0 +1,404 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// ========================================
+// BATCH TRADE SETTLEMENT
+// ========================================
+
+// GetTradeSettlements returns the stored settlements of the given trade IDs,
+// keyed by trade ID. Unknown IDs are absent from the map.
+func (r *transactionRepo) GetTradeSettlements(
+	ctx context.Context,
+	tradeIDs []string,
+) (map[string]*domain.TradeSettlement, error) {
+	settlements := make(map[string]*domain.TradeSettlement, len(tradeIDs))
+	if len(tradeIDs) == 0 {
+		return settlements, nil
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT ts.trade_id, a.account_number, ts.result, ts.amount,
+		       COALESCE(ts.batch_id, ''), ts.journal_id, ts.receipt_code, ts.settled_at
+		FROM trade_settlements ts
+		JOIN accounts a ON a.id = ts.account_id
+		WHERE ts.trade_id = ANY($1)
+	`, tradeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trade settlements: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		s := &domain.TradeSettlement{Status: domain.TradeSettlementDuplicate}
+		var result string
+		if err := rows.Scan(
+			&s.TradeID, &s.AccountNumber, &result, &s.Amount,
+			&s.BatchID, &s.JournalID, &s.ReceiptCode, &s.SettledAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan trade settlement: %w", err)
+		}
+		s.Result = domain.TradeResult(result)
+		settlements[s.TradeID] = s
+	}
+	return settlements, rows.Err()
+}
+
+// PreviewTradeSettlement builds the journal request the group would post if
+// none of its trades were settled yet. Returns nil when the group nets to
+// zero. The usecase uses it to generate the receipt code up front.
+func (r *transactionRepo) PreviewTradeSettlement(
+	ctx context.Context,
+	group *domain.TradeSettlementGroup,
+) (*domain.TransactionRequest, error) {
+	account, err := r.accountRepo.GetByAccountNumber(ctx, group.AccountNumber)
+	if err != nil {
+		return nil, fmt.Errorf("account %s not found: %w", group.AccountNumber, err)
+	}
+	return r.buildTradeSettlementRequest(ctx, group, account, group.Trades)
+}
+
+// SettleTradeGroup settles one account's trades in a single database
+// transaction. Each trade ID is claimed in trade_settlements first; trades
+// already claimed by an earlier batch are returned as duplicates with their
+// original outcome, and only the newly claimed trades are netted and posted.
+func (r *transactionRepo) SettleTradeGroup(
+	ctx context.Context,
+	group *domain.TradeSettlementGroup,
+) ([]*domain.TradeSettlement, *domain.LedgerAggregate, error) {
+	if len(group.Trades) == 0 {
+		return nil, nil, fmt.Errorf("%w: no trades", xerrors.ErrInvalidRequest)
+	}
+
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	account, err := r.accountRepo.GetByAccountNumberTx(ctx, group.AccountNumber, tx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("account %s not found: %w", group.AccountNumber, err)
+	}
+	if account.AccountType != group.AccountType {
+		return nil, nil, fmt.Errorf("%w: account %s is %s", xerrors.ErrInvalidAccountType,
+			account.AccountNumber, account.AccountType)
+	}
+
+	claimed, err := r.claimTrades(ctx, tx, account.ID, group)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fresh []*domain.SettleTrade
+	var duplicateIDs []string
+	for _, t := range group.Trades {
+		if claimed[t.TradeID] {
+			fresh = append(fresh, t)
+		} else {
+			duplicateIDs = append(duplicateIDs, t.TradeID)
+		}
+	}
+
+	var aggregate *domain.LedgerAggregate
+	if len(fresh) > 0 {
+		txReq, err := r.buildTradeSettlementRequest(ctx, group, account, fresh)
+		if err != nil {
+			return nil, nil, err
+		}
+		if txReq != nil {
+			aggregate, err = r.postTradeSettlement(ctx, tx, txReq)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			freshIDs := make([]string, len(fresh))
+			for i, t := range fresh {
+				freshIDs[i] = t.TradeID
+			}
+			_, err = tx.Exec(ctx, `
+				UPDATE trade_settlements
+				SET journal_id = $1, receipt_code = $2
+				WHERE trade_id = ANY($3)
+			`, aggregate.Journal.ID, txReq.ReceiptCode, freshIDs)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to link trade settlements: %w", err)
+			}
+
+			if err := r.createEvent(ctx, tx, tradeSettledEvent(group, account, aggregate)); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Duplicates are read after commit so they carry the original batch's outcome
+	duplicates, err := r.GetTradeSettlements(ctx, duplicateIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settlements := make([]*domain.TradeSettlement, 0, len(group.Trades))
+	for _, t := range group.Trades {
+		if !claimed[t.TradeID] {
+			if s, ok := duplicates[t.TradeID]; ok {
+				settlements = append(settlements, s)
+				continue
+			}
+			settlements = append(settlements, &domain.TradeSettlement{
+				TradeID:       t.TradeID,
+				AccountNumber: t.AccountNumber,
+				Result:        t.Result,
+				Amount:        t.Amount,
+				Status:        domain.TradeSettlementDuplicate,
+			})
+			continue
+		}
+
+		s := &domain.TradeSettlement{
+			TradeID:       t.TradeID,
+			AccountNumber: t.AccountNumber,
+			Result:        t.Result,
+			Amount:        t.Amount,
+			Status:        domain.TradeSettlementSettled,
+			BatchID:       group.BatchID,
+		}
+		if aggregate != nil {
+			s.JournalID = &aggregate.Journal.ID
+			s.ReceiptCode = group.ReceiptCode
+			s.SettledAt = &aggregate.Journal.CreatedAt
+			for _, l := range aggregate.Ledgers {
+				if l.AccountID == account.ID {
+					s.BalanceAfter = l.BalanceAfter
+				}
+			}
+		}
+		settlements = append(settlements, s)
+	}
+
+	r.logger.Info("trades settled",
+		zap.String("account_number", account.AccountNumber),
+		zap.String("batch_id", group.BatchID),
+		zap.Int("settled", len(fresh)),
+		zap.Int("duplicates", len(duplicateIDs)),
+		zap.Bool("posted", aggregate != nil))
+
+	return settlements, aggregate, nil
+}
+
+// tradeSettledEvent builds the trade.settled event of a posted group
+func tradeSettledEvent(
+	group *domain.TradeSettlementGroup,
+	account *domain.Account,
+	aggregate *domain.LedgerAggregate,
+) *domain.TransactionEvent {
+	event := &domain.TransactionEvent{
+		EventType:       "trade.settled",
+		UserID:          group.CreatedByExternalID,
+		ReceiptCode:     ptrStrToStr(group.ReceiptCode),
+		TransactionID:   aggregate.Journal.ID,
+		TransactionType: "trade",
+		Status:          "completed",
+		Amount:          aggregate.Ledgers[0].Amount,
+		Currency:        aggregate.Ledgers[0].Currency,
+		AccountNumber:   group.AccountNumber,
+		Metadata: map[string]interface{}{
+			"batch_id":    group.BatchID,
+			"trade_count": len(group.Trades),
+		},
+	}
+	for _, l := range aggregate.Ledgers {
+		if l.AccountID == account.ID && l.BalanceAfter != nil {
+			event.BalanceAfter = *l.BalanceAfter
+		}
+	}
+	return event
+}
+
+// claimTrades inserts a settlement row per trade and returns the trade IDs
+// this transaction claimed. IDs that already have a row are left out.
+func (r *transactionRepo) claimTrades(
+	ctx context.Context,
+	tx pgx.Tx,
+	accountID int64,
+	group *domain.TradeSettlementGroup,
+) (map[string]bool, error) {
+	ids := make([]string, len(group.Trades))
+	results := make([]string, len(group.Trades))
+	amounts := make([]string, len(group.Trades))
+	tradeTypes := make([]string, len(group.Trades))
+	for i, t := range group.Trades {
+		ids[i] = t.TradeID
+		results[i] = string(t.Result)
+		amounts[i] = t.Amount.String()
+		tradeTypes[i] = t.TradeType
+	}
+
+	rows, err := tx.Query(ctx, `
+		INSERT INTO trade_settlements (
+			trade_id, account_id, result, amount, trade_type, batch_id, created_by
+		)
+		SELECT t.trade_id, $1, t.result, t.amount::numeric, NULLIF(t.trade_type, ''), NULLIF($2, ''), $3
+		FROM unnest($4::text[], $5::text[], $6::text[], $7::text[]) AS t(trade_id, result, amount, trade_type)
+		ON CONFLICT (trade_id) DO NOTHING
+		RETURNING trade_id
+	`, accountID, group.BatchID, group.CreatedByExternalID, ids, results, amounts, tradeTypes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim trades: %w", err)
+	}
+	defer rows.Close()
+
+	claimed := make(map[string]bool, len(ids))
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan claimed trade: %w", err)
+		}
+		claimed[id] = true
+	}
+	return claimed, rows.Err()
+}
+
+// buildTradeSettlementRequest nets the trades into one system journal
+// between the account and the liquidity account: a net win is paid out of
+// liquidity, a net loss is collected into it (NO FEES). Returns nil when
+// wins and losses cancel out.
+func (r *transactionRepo) buildTradeSettlementRequest(
+	ctx context.Context,
+	group *domain.TradeSettlementGroup,
+	account *domain.Account,
+	trades []*domain.SettleTrade,
+) (*domain.TransactionRequest, error) {
+	currency, err := r.getCurrency(ctx, account.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	net, wins, losses := domain.NetTrades(trades)
+	net = currency.Round(net)
+	if net.IsZero() {
+		return nil, nil
+	}
+
+	liquidity, err := r.liquidityAccountFor(ctx, group.AccountType, account.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system account: %w", err)
+	}
+
+	userSide, liquiditySide := domain.DrCrCredit, domain.DrCrDebit
+	if net.IsNegative() {
+		userSide, liquiditySide = domain.DrCrDebit, domain.DrCrCredit
+	}
+	amount := net.Abs()
+
+	description := fmt.Sprintf("Trade settlement: %d trades", len(trades))
+	if group.BatchID != "" {
+		description = fmt.Sprintf("Trade settlement %s: %d trades", group.BatchID, len(trades))
+	}
+	metadata := map[string]interface{}{
+		"batch_id":     group.BatchID,
+		"trade_count":  len(trades),
+		"wins":         wins.String(),
+		"losses":       losses.String(),
+		"trade_result": "net",
+	}
+	idempotencyKey := domain.TradeSettlementKey(trades)
+
+	return &domain.TransactionRequest{
+		IdempotencyKey:      &idempotencyKey,
+		TransactionType:     domain.TransactionTypeTrade,
+		AccountType:         group.AccountType,
+		ExternalRef:         group.ReceiptCode,
+		Description:         &description,
+		CreatedByExternalID: &group.CreatedByExternalID,
+		CreatedByType:       &group.CreatedByType,
+		IsSystemTransaction: true,
+		ReceiptCode:         group.ReceiptCode,
+		Entries: []*domain.LedgerEntryRequest{
+			{
+				AccountNumber: liquidity.AccountNumber,
+				Amount:        amount,
+				DrCr:          liquiditySide,
+				Currency:      account.Currency,
+				ReceiptCode:   group.ReceiptCode,
+				Description:   &description,
+				Metadata:      metadata,
+			},
+			{
+				AccountNumber: account.AccountNumber,
+				Amount:        amount,
+				DrCr:          userSide,
+				Currency:      account.Currency,
+				ReceiptCode:   group.ReceiptCode,
+				Description:   &description,
+				Metadata:      metadata,
+			},
+		},
+		GenerateReceipt: true,
+	}, nil
+}
+
+// postTradeSettlement posts the netted journal inside the settlement
+// transaction with the same pessimistic sequence as ExecuteTransactionPessimistic
+func (r *transactionRepo) postTradeSettlement(
+	ctx context.Context,
+	tx pgx.Tx,
+	txReq *domain.TransactionRequest,
+) (*domain.LedgerAggregate, error) {
+	if err := txReq.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid trade settlement: %w", err)
+	}
+
+	journal, err := r.createJournal(ctx, tx, txReq)
+	if err != nil {
+		return nil, err
+	}
+
+	accountMap, balanceMap, err := r.lockAccountsPessimistic(ctx, tx, txReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.validateBalancesPessimistic(accountMap, balanceMap, txReq); err != nil {
+		return nil, err
+	}
+
+	ledgers, err := r.createLedgersWithBalance(ctx, tx, journal.ID, accountMap, balanceMap, txReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.updateBalancesPessimistic(ctx, tx, ledgers); err != nil {
+		return nil, err
+	}
+
+	if err := r.createCompletedEvent(ctx, tx, journal, ledgers, txReq); err != nil {
+		return nil, err
+	}
+
+	payableAmount := decimal.Zero
+	for _, e := range txReq.Entries {
+		if e.DrCr == domain.DrCrCredit && !e.IsFee {
+			payableAmount = payableAmount.Add(e.Amount)
+		}
+	}
+
+	return &domain.LedgerAggregate{
+		Journal:       journal,
+		Ledgers:       ledgers,
+		PayableAmount: payableAmount,
+	}, nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
 )
 
 type TransactionFeeRepository interface {
@@ -26,8 +27,11 @@ type TransactionFeeRepository interface {
 	GetByAgent(ctx context.Context, agentExternalID string, from, to time.Time) ([]*domain.TransactionFee, error)
 
 	// Statistics
-	GetTotalFeesByType(ctx context.Context, feeType domain.FeeType, from, to time.Time) (float64, error)  // ✅ Changed to float64
-	GetAgentCommissionSummary(ctx context.Context, agentExternalID string, from, to time.Time) (map[string]float64, error)  // ✅ Changed to map[string]float64
+	GetTotalFeesByType(ctx context.Context, feeType domain.FeeType, from, to time.Time) (decimal.Decimal, error)
+	GetAgentCommissionSummary(ctx context.Context, agentExternalID string, from, to time.Time) (map[string]decimal.Decimal, error)
+
+	// Fee simulation: historical transactions with the inputs they were priced from
+	ListSimulationSamples(ctx context.Context, from, to time.Time, types []domain.TransactionType, limit int) ([]*domain.FeeSimulationSample, error)
 
 	// Transaction management
 	BeginTx(ctx context.Context) (pgx.Tx, error)
@@ -73,10 +77,14 @@ func (r *transactionFeeRepo) Create(ctx context.Context, tx pgx.Tx, fee *domain.
 		INSERT INTO transaction_fees (
 			receipt_code, fee_rule_id, fee_type, amount, currency,
 			collected_by_account_id, ledger_id, agent_external_id, commission_rate,
-			created_at
+			created_at, fee_rule_version_id
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-		RETURNING id, created_at
+		VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10,
+			-- Callers without the pricing version record the rule's current one
+			COALESCE($11, (SELECT current_version_id FROM transaction_fee_rules WHERE id = $2))
+		)
+		RETURNING id, created_at, fee_rule_version_id
 	`
 
 	now := time.Now()
@@ -91,7 +99,8 @@ func (r *transactionFeeRepo) Create(ctx context.Context, tx pgx.Tx, fee *domain.
 		fee.AgentExternalID,
 		fee.CommissionRate,
 		now,
-	).Scan(&fee.ID, &fee.CreatedAt)
+		fee.FeeRuleVersionID,
+	).Scan(&fee.ID, &fee.CreatedAt, &fee.FeeRuleVersionID)
 
 	if err != nil {
 		return fmt.Errorf("failed to create transaction fee: %w", err)
@@ -118,10 +127,14 @@ func (r *transactionFeeRepo) CreateBatch(ctx context.Context, tx pgx.Tx, fees []
 		INSERT INTO transaction_fees (
 			receipt_code, fee_rule_id, fee_type, amount, currency,
 			collected_by_account_id, ledger_id, agent_external_id, commission_rate,
-			created_at
+			created_at, fee_rule_version_id
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-		RETURNING id, created_at
+		VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10,
+			-- Callers without the pricing version record the rule's current one
+			COALESCE($11, (SELECT current_version_id FROM transaction_fee_rules WHERE id = $2))
+		)
+		RETURNING id, created_at, fee_rule_version_id
 	`
 
 	validFees := make([]*domain.TransactionFee, 0, len(fees))
@@ -151,6 +164,7 @@ func (r *transactionFeeRepo) CreateBatch(ctx context.Context, tx pgx.Tx, fees []
 			fee.AgentExternalID,
 			fee.CommissionRate,
 			now,
+			fee.FeeRuleVersionID,
 		)
 
 		indexMap[len(validFees)] = i
@@ -164,7 +178,7 @@ func (r *transactionFeeRepo) CreateBatch(ctx context.Context, tx pgx.Tx, fees []
 		originalIdx := indexMap[batchIdx]
 		fee := validFees[batchIdx]
 
-		err := br.QueryRow().Scan(&fee.ID, &fee.CreatedAt)
+		err := br.QueryRow().Scan(&fee.ID, &fee.CreatedAt, &fee.FeeRuleVersionID)
 		if err != nil {
 			errs[originalIdx] = fmt.Errorf("failed to create transaction fee: %w", err)
 		}
@@ -177,7 +191,7 @@ func (r *transactionFeeRepo) CreateBatch(ctx context.Context, tx pgx.Tx, fees []
 func (r *transactionFeeRepo) GetByID(ctx context.Context, id int64) (*domain.TransactionFee, error) {
 	query := `
 		SELECT 
-			id, receipt_code, fee_rule_id, fee_type, amount, currency,
+			id, receipt_code, fee_rule_id, fee_rule_version_id, fee_type, amount, currency,
 			collected_by_account_id, ledger_id, agent_external_id, commission_rate,
 			created_at
 		FROM transaction_fees
@@ -189,6 +203,7 @@ func (r *transactionFeeRepo) GetByID(ctx context.Context, id int64) (*domain.Tra
 		&fee.ID,
 		&fee.ReceiptCode,
 		&fee.FeeRuleID,
+		&fee.FeeRuleVersionID,
 		&fee.FeeType,
 		&fee.Amount,
 		&fee.Currency,
@@ -217,7 +232,7 @@ func (r *transactionFeeRepo) GetByID(ctx context.Context, id int64) (*domain.Tra
 func (r *transactionFeeRepo) GetByReceipt(ctx context.Context, receiptCode string) ([]*domain.TransactionFee, error) {
 	query := `
 		SELECT 
-			id, receipt_code, fee_rule_id, fee_type, amount, currency,
+			id, receipt_code, fee_rule_id, fee_rule_version_id, fee_type, amount, currency,
 			collected_by_account_id, ledger_id, agent_external_id, commission_rate,
 			created_at
 		FROM transaction_fees
@@ -238,6 +253,7 @@ func (r *transactionFeeRepo) GetByReceipt(ctx context.Context, receiptCode strin
 			&fee.ID,
 			&fee.ReceiptCode,
 			&fee.FeeRuleID,
+			&fee.FeeRuleVersionID,
 			&fee.FeeType,
 			&fee.Amount,
 			&fee.Currency,
@@ -268,7 +284,7 @@ func (r *transactionFeeRepo) GetByFeeRule(ctx context.Context, feeRuleID int64,
 
 	query := `
 		SELECT 
-			id, receipt_code, fee_rule_id, fee_type, amount, currency,
+			id, receipt_code, fee_rule_id, fee_rule_version_id, fee_type, amount, currency,
 			collected_by_account_id, ledger_id, agent_external_id, commission_rate,
 			created_at
 		FROM transaction_fees
@@ -290,6 +306,7 @@ func (r *transactionFeeRepo) GetByFeeRule(ctx context.Context, feeRuleID int64,
 			&fee.ID,
 			&fee.ReceiptCode,
 			&fee.FeeRuleID,
+			&fee.FeeRuleVersionID,
 			&fee.FeeType,
 			&fee.Amount,
 			&fee.Currency,
@@ -316,7 +333,7 @@ func (r *transactionFeeRepo) GetByFeeRule(ctx context.Context, feeRuleID int64,
 func (r *transactionFeeRepo) GetByAgent(ctx context.Context, agentExternalID string, from, to time.Time) ([]*domain.TransactionFee, error) {
 	query := `
 		SELECT 
-			id, receipt_code, fee_rule_id, fee_type, amount, currency,
+			id, receipt_code, fee_rule_id, fee_rule_version_id, fee_type, amount, currency,
 			collected_by_account_id, ledger_id, agent_external_id, commission_rate,
 			created_at
 		FROM transaction_fees
@@ -339,6 +356,7 @@ func (r *transactionFeeRepo) GetByAgent(ctx context.Context, agentExternalID str
 			&fee.ID,
 			&fee.ReceiptCode,
 			&fee.FeeRuleID,
+			&fee.FeeRuleVersionID,
 			&fee.FeeType,
 			&fee.Amount,
 			&fee.Currency,
@@ -366,7 +384,7 @@ func (r *transactionFeeRepo) GetByAgent(ctx context.Context, agentExternalID str
 // ===============================
 
 // GetTotalFeesByType returns total fees collected by fee type in a date range
-func (r *transactionFeeRepo) GetTotalFeesByType(ctx context. Context, feeType domain.FeeType, from, to time. Time) (float64, error) {  // ✅ Changed return type
+func (r *transactionFeeRepo) GetTotalFeesByType(ctx context. Context, feeType domain.FeeType, from, to time. Time) (decimal.Decimal, error) {
 	query := `
 		SELECT COALESCE(SUM(amount), 0) AS total
 		FROM transaction_fees
@@ -375,11 +393,11 @@ func (r *transactionFeeRepo) GetTotalFeesByType(ctx context. Context, feeType do
 		  AND created_at <= $3
 	`
 
-	var total float64  // ✅ Changed to float64
+	var total decimal.Decimal
 	err := r. db.QueryRow(ctx, query, feeType, from, to).Scan(&total)
 
 	if err != nil {
-		return 0, fmt.Errorf("failed to get total fees by type: %w", err)
+		return decimal.Zero, fmt.Errorf("failed to get total fees by type: %w", err)
 	}
 
 	return total, nil
@@ -387,7 +405,7 @@ func (r *transactionFeeRepo) GetTotalFeesByType(ctx context. Context, feeType do
 
 // GetAgentCommissionSummary returns agent commission totals grouped by currency
 // GetAgentCommissionSummary returns agent commission totals grouped by currency
-func (r *transactionFeeRepo) GetAgentCommissionSummary(ctx context.Context, agentExternalID string, from, to time.Time) (map[string]float64, error) {  // ✅ Changed return type
+func (r *transactionFeeRepo) GetAgentCommissionSummary(ctx context.Context, agentExternalID string, from, to time.Time) (map[string]decimal.Decimal, error) {
 	query := `
 		SELECT 
 			currency,
@@ -407,10 +425,10 @@ func (r *transactionFeeRepo) GetAgentCommissionSummary(ctx context.Context, agen
 	}
 	defer rows.Close()
 
-	summary := make(map[string]float64)  // ✅ Already correct
+	summary := make(map[string]decimal.Decimal)
 	for rows.Next() {
 		var currency string
-		var total float64  // ✅ Already correct
+		var total decimal.Decimal
 
 		err := rows.Scan(&currency, &total)
 		if err != nil {
@@ -425,4 +443,101 @@ func (r *transactionFeeRepo) GetAgentCommissionSummary(ctx context.Context, agen
 	}
 
 	return summary, nil
-}
\ No newline at end of file
+}
+
+// ===============================
+// FEE SIMULATION
+// ===============================
+
+// ListSimulationSamples returns real, non-system journals in [from, to) with
+// the gross amount (largest debit line), the source and target currencies
+// and the fees charged at the time, oldest first
+func (r *transactionFeeRepo) ListSimulationSamples(
+	ctx context.Context,
+	from, to time.Time,
+	types []domain.TransactionType,
+	limit int,
+) ([]*domain.FeeSimulationSample, error) {
+	typeNames := make([]string, len(types))
+	for i, t := range types {
+		typeNames[i] = string(t)
+	}
+
+	query := `
+		WITH j AS (
+			SELECT id, transaction_type, account_type, created_by_type, created_at
+			FROM journals
+			WHERE created_at >= $1
+			  AND created_at < $2
+			  AND transaction_type::text = ANY($3)
+			  AND account_type = 'real'
+			  AND created_by_type IS DISTINCT FROM 'system'
+			ORDER BY created_at, id
+			LIMIT $4
+		),
+		dr AS (
+			SELECT DISTINCT ON (l.journal_id) l.journal_id, l.amount, l.currency, l.receipt_code
+			FROM ledgers l
+			JOIN j ON j.id = l.journal_id
+			WHERE l.dr_cr = 'DR'
+			ORDER BY l.journal_id, l.amount DESC
+		),
+		cr AS (
+			SELECT DISTINCT ON (l.journal_id) l.journal_id, l.currency
+			FROM ledgers l
+			JOIN j ON j.id = l.journal_id
+			WHERE l.dr_cr = 'CR'
+			ORDER BY l.journal_id, l.amount DESC
+		)
+		SELECT
+			j.id, j.transaction_type, j.account_type, j.created_by_type, j.created_at,
+			dr.amount::text, dr.currency, COALESCE(cr.currency, dr.currency),
+			COALESCE((
+				SELECT SUM(f.amount)
+				FROM transaction_fees f
+				WHERE f.receipt_code = dr.receipt_code
+				  AND f.fee_type <> 'agent_commission'
+			), 0)::text
+		FROM j
+		JOIN dr ON dr.journal_id = j.id
+		LEFT JOIN cr ON cr.journal_id = j.id
+		ORDER BY j.created_at, j.id
+	`
+
+	rows, err := r.db.Query(ctx, query, from, to, typeNames, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fee simulation samples: %w", err)
+	}
+	defer rows.Close()
+
+	var samples []*domain.FeeSimulationSample
+	for rows.Next() {
+		var (
+			sample             domain.FeeSimulationSample
+			amount, chargedFee string
+		)
+		err := rows.Scan(
+			&sample.JournalID,
+			&sample.TransactionType,
+			&sample.AccountType,
+			&sample.OwnerType,
+			&sample.CreatedAt,
+			&amount,
+			&sample.SourceCurrency,
+			&sample.TargetCurrency,
+			&chargedFee,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan fee simulation sample: %w", err)
+		}
+		if sample.Amount, err = decimal.NewFromString(amount); err != nil {
+			return nil, fmt.Errorf("invalid amount for journal %d: %w", sample.JournalID, err)
+		}
+		if sample.ChargedFee, err = decimal.NewFromString(chargedFee); err != nil {
+			return nil, fmt.Errorf("invalid fee for journal %d: %w", sample.JournalID, err)
+		}
+		samples = append(samples, &sample)
+	}
+
+	return samples, rows.Err()
+}
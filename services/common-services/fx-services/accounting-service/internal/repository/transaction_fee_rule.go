@@ -31,6 +31,9 @@ type TransactionFeeRuleRepository interface {
 	
 	// Expiration
 	ExpireRule(ctx context.Context, tx pgx.Tx, id int64, validTo time.Time) error
+
+	// Version history (every write above appends a snapshot)
+	ListVersions(ctx context.Context, ruleID int64) ([]*domain.FeeRuleVersion, error)
 	
 	// Transaction management
 	BeginTx(ctx context.Context) (pgx.Tx, error)
@@ -132,6 +135,10 @@ func (r *transactionFeeRuleRepo) Create(ctx context.Context, tx pgx. Tx, rule *d
 		return nil, fmt.Errorf("failed to create fee rule: %w", err)
 	}
 
+	if err := r.recordVersion(ctx, tx, &feeRule); err != nil {
+		return nil, err
+	}
+
 	return &feeRule, nil
 }
 
@@ -148,6 +155,7 @@ func (r *transactionFeeRuleRepo) CreateBatch(ctx context.Context, tx pgx.Tx, rul
 
 	errs := make(map[int]error)
 	results := make([]*domain.TransactionFeeRule, 0, len(rules))
+	resultIdx := make([]int, 0, len(rules)) // Original index of each result
 
 	batch := &pgx.Batch{}
 	query := `
@@ -239,6 +247,19 @@ func (r *transactionFeeRuleRepo) CreateBatch(ctx context.Context, tx pgx.Tx, rul
 		}
 
 		results = append(results, &feeRule)
+		resultIdx = append(resultIdx, originalIdx)
+	}
+
+	// The batch must be drained before the tx runs further statements
+	if err := br.Close(); err != nil {
+		errs[0] = fmt.Errorf("failed to close fee rule batch: %w", err)
+		return results, errs
+	}
+
+	for i, feeRule := range results {
+		if err := r.recordVersion(ctx, tx, feeRule); err != nil {
+			errs[resultIdx[i]] = err
+		}
 	}
 
 	return results, errs
@@ -289,7 +310,7 @@ func (r *transactionFeeRuleRepo) Update(ctx context. Context, tx pgx.Tx, rule *d
 		return xerrors.ErrNotFound
 	}
 
-	return nil
+	return r.recordVersion(ctx, tx, rule)
 }
 
 // GetByID fetches a fee rule by ID
@@ -299,7 +320,8 @@ func (r *transactionFeeRuleRepo) GetByID(ctx context.Context, id int64) (*domain
 			id, rule_name, transaction_type, source_currency, target_currency,
 			account_type, owner_type, fee_type, calculation_method,
 			fee_value, min_fee, max_fee, tiers, tariffs,
-			valid_from, valid_to, is_active, priority, created_at, updated_at
+			valid_from, valid_to, is_active, priority, created_at, updated_at,
+			version, current_version_id
 		FROM transaction_fee_rules
 		WHERE id = $1
 	`
@@ -326,6 +348,8 @@ func (r *transactionFeeRuleRepo) GetByID(ctx context.Context, id int64) (*domain
 		&rule.Priority,
 		&rule.CreatedAt,
 		&rule.UpdatedAt,
+		&rule.Version,
+		&rule.VersionID,
 	)
 
 	if err != nil {
@@ -367,7 +391,8 @@ func (r *transactionFeeRuleRepo) List(ctx context.Context, filter *domain.FeeRul
 			id, rule_name, transaction_type, source_currency, target_currency,
 			account_type, owner_type, fee_type, calculation_method,
 			fee_value, min_fee, max_fee, tiers, tariffs,
-			valid_from, valid_to, is_active, priority, created_at, updated_at
+			valid_from, valid_to, is_active, priority, created_at, updated_at,
+			version, current_version_id
 		FROM transaction_fee_rules
 		WHERE 1=1
 	`
@@ -467,6 +492,8 @@ func (r *transactionFeeRuleRepo) List(ctx context.Context, filter *domain.FeeRul
 			&rule.Priority,
 			&rule.CreatedAt,
 			&rule.UpdatedAt,
+			&rule.Version,
+			&rule.VersionID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan fee rule: %w", err)
@@ -484,7 +511,8 @@ func (r *transactionFeeRuleRepo) ListActive(ctx context. Context) ([]*domain.Tra
 			id, rule_name, transaction_type, source_currency, target_currency,
 			account_type, owner_type, fee_type, calculation_method,
 			fee_value, min_fee, max_fee, tiers, tariffs,
-			valid_from, valid_to, is_active, priority, created_at, updated_at
+			valid_from, valid_to, is_active, priority, created_at, updated_at,
+			version, current_version_id
 		FROM transaction_fee_rules
 		WHERE is_active = true AND valid_to IS NULL
 		ORDER BY priority DESC, transaction_type
@@ -520,6 +548,8 @@ func (r *transactionFeeRuleRepo) ListActive(ctx context. Context) ([]*domain.Tra
 			&rule.Priority,
 			&rule.CreatedAt,
 			&rule.UpdatedAt,
+			&rule.Version,
+			&rule.VersionID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan fee rule: %w", err)
@@ -542,7 +572,8 @@ func (r *transactionFeeRuleRepo) FindBestMatch(ctx context.Context, transactionT
 			id, rule_name, transaction_type, source_currency, target_currency,
 			account_type, owner_type, fee_type, calculation_method,
 			fee_value, min_fee, max_fee, tiers, tariffs,
-			valid_from, valid_to, is_active, priority, created_at, updated_at
+			valid_from, valid_to, is_active, priority, created_at, updated_at,
+			version, current_version_id
 		FROM transaction_fee_rules
 		WHERE is_active = true 
 		  AND valid_to IS NULL
@@ -582,6 +613,8 @@ func (r *transactionFeeRuleRepo) FindBestMatch(ctx context.Context, transactionT
 		&rule.Priority,
 		&rule.CreatedAt,
 		&rule.UpdatedAt,
+		&rule.Version,
+		&rule.VersionID,
 	)
 
 	if err != nil {
@@ -600,7 +633,8 @@ func (r *transactionFeeRuleRepo) FindAllMatches(ctx context.Context, transaction
 			id, rule_name, transaction_type, source_currency, target_currency,
 			account_type, owner_type, fee_type, calculation_method,
 			fee_value, min_fee, max_fee, tiers, tariffs,
-			valid_from, valid_to, is_active, priority, created_at, updated_at
+			valid_from, valid_to, is_active, priority, created_at, updated_at,
+			version, current_version_id
 		FROM transaction_fee_rules
 		WHERE is_active = true 
 		  AND valid_to IS NULL
@@ -642,6 +676,8 @@ func (r *transactionFeeRuleRepo) FindAllMatches(ctx context.Context, transaction
 			&rule.Priority,
 			&rule.CreatedAt,
 			&rule.UpdatedAt,
+			&rule.Version,
+			&rule.VersionID,
 		)
 		if err != nil {
 			return nil, fmt. Errorf("failed to scan fee rule: %w", err)
@@ -676,5 +712,106 @@ func (r *transactionFeeRuleRepo) ExpireRule(ctx context.Context, tx pgx.Tx, id i
 		return xerrors.ErrNotFound
 	}
 
+	return r.recordVersion(ctx, tx, &domain.TransactionFeeRule{ID: id})
+}
+
+// ===============================
+// VERSION HISTORY
+// ===============================
+
+// recordVersion snapshots the rule as stored in tx as its next version and
+// points the rule at the snapshot. Concurrent writers serialize on the row
+// lock taken by the preceding insert or update.
+func (r *transactionFeeRuleRepo) recordVersion(ctx context.Context, tx pgx.Tx, rule *domain.TransactionFeeRule) error {
+	query := `
+		WITH v AS (
+			INSERT INTO transaction_fee_rule_versions (
+				rule_id, version, rule_name, transaction_type, source_currency, target_currency,
+				account_type, owner_type, fee_type, calculation_method, fee_value, min_fee, max_fee,
+				tiers, tariffs, valid_from, valid_to, is_active, priority
+			)
+			SELECT
+				id, version + 1, rule_name, transaction_type, source_currency, target_currency,
+				account_type, owner_type, fee_type, calculation_method, fee_value, min_fee, max_fee,
+				tiers, tariffs, valid_from, valid_to, is_active, priority
+			FROM transaction_fee_rules
+			WHERE id = $1
+			RETURNING id, rule_id, version
+		)
+		UPDATE transaction_fee_rules r
+		SET version = v.version, current_version_id = v.id
+		FROM v
+		WHERE r.id = v.rule_id
+		RETURNING v.id, v.version
+	`
+
+	var versionID int64
+	err := tx.QueryRow(ctx, query, rule.ID).Scan(&versionID, &rule.Version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return xerrors.ErrNotFound
+		}
+		return fmt.Errorf("failed to record fee rule version: %w", err)
+	}
+	rule.VersionID = &versionID
+
 	return nil
+}
+
+// ListVersions returns every snapshot of a rule, newest first
+func (r *transactionFeeRuleRepo) ListVersions(ctx context.Context, ruleID int64) ([]*domain.FeeRuleVersion, error) {
+	query := `
+		SELECT
+			id, rule_id, version, rule_name, transaction_type, source_currency, target_currency,
+			account_type, owner_type, fee_type, calculation_method, fee_value::text, min_fee, max_fee,
+			tiers, tariffs::text, valid_from, valid_to, is_active, priority, created_at
+		FROM transaction_fee_rule_versions
+		WHERE rule_id = $1
+		ORDER BY version DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fee rule versions: %w", err)
+	}
+	defer rows.Close()
+
+	var versions []*domain.FeeRuleVersion
+	for rows.Next() {
+		v := &domain.FeeRuleVersion{Rule: &domain.TransactionFeeRule{}}
+		err := rows.Scan(
+			&v.ID,
+			&v.RuleID,
+			&v.Version,
+			&v.Rule.RuleName,
+			&v.Rule.TransactionType,
+			&v.Rule.SourceCurrency,
+			&v.Rule.TargetCurrency,
+			&v.Rule.AccountType,
+			&v.Rule.OwnerType,
+			&v.Rule.FeeType,
+			&v.Rule.CalculationMethod,
+			&v.Rule.FeeValue,
+			&v.Rule.MinFee,
+			&v.Rule.MaxFee,
+			&v.Rule.Tiers,
+			&v.Rule.Tariffs,
+			&v.Rule.ValidFrom,
+			&v.Rule.ValidTo,
+			&v.Rule.IsActive,
+			&v.Rule.Priority,
+			&v.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan fee rule version: %w", err)
+		}
+		v.Rule.ID = v.RuleID
+		v.Rule.Version = v.Version
+		v.Rule.VersionID = &v.ID
+		v.Rule.CreatedAt = v.CreatedAt
+		v.Rule.UpdatedAt = v.CreatedAt
+		versions = append(versions, v)
+	}
+
+	return versions, rows.Err()
 }
\ No newline at end of file
@@ -0,0 +1,556 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// ========================================
+// FUNDS HOLDS (AUTHORIZE / CAPTURE)
+// ========================================
+
+// PlaceHold reserves funds on an account. The amount moves from
+// available_balance to pending_debit; nothing is posted to the ledger until
+// the hold is captured. Placing the same hold_ref twice returns the first hold.
+func (r *transactionRepo) PlaceHold(
+	ctx context.Context,
+	req *domain.PlaceHoldRequest,
+) (*domain.Hold, *domain.Balance, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, err
+	}
+	if req.HoldRef == "" {
+		return nil, nil, xerrors.ErrRequiredFieldMissing
+	}
+
+	// Idempotent on hold_ref
+	if existing, err := r.holdRepo.GetByRef(ctx, req.HoldRef); err == nil {
+		if existing.AccountNumber != req.AccountNumber || !existing.Amount.Equal(req.Amount) {
+			return nil, nil, xerrors.ErrHoldReferenceConflict
+		}
+		balance, err := r.balanceRepo.GetByAccountID(ctx, existing.AccountID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return existing, balance, nil
+	} else if !errors.Is(err, xerrors.ErrHoldNotFound) {
+		return nil, nil, err
+	}
+
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	account, err := r.accountRepo.GetByAccountNumberTx(ctx, req.AccountNumber, tx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("account %s not found: %w", req.AccountNumber, err)
+	}
+	// A hold reserves funds for a later debit
+	if err := r.validateAccount(ctx, tx, account, req.AccountType, true, false); err != nil {
+		return nil, nil, fmt.Errorf("account %s: %w", req.AccountNumber, err)
+	}
+
+	currency, err := r.getCurrency(ctx, account.Currency)
+	if err != nil {
+		return nil, nil, err
+	}
+	amount := currency.Round(req.Amount)
+	if !amount.IsPositive() {
+		return nil, nil, xerrors.ErrInvalidAmount
+	}
+
+	// Lock balance; holds never dip into the overdraft or liened funds
+	balance, err := r.balanceRepo.GetByAccountIDWithLock(ctx, tx, account.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to lock balance for %s: %w", req.AccountNumber, err)
+	}
+	liened := account.Restrictions.Liened()
+	if balance.AvailableBalance.Sub(liened).LessThan(amount) {
+		return nil, nil, fmt.Errorf("account %s: %w (available: %s, liened: %s, required: %s)",
+			req.AccountNumber, xerrors.ErrInsufficientBalance, balance.AvailableBalance, liened, amount)
+	}
+
+	if err := r.balanceRepo.ReserveFunds(ctx, tx, account.ID, amount); err != nil {
+		return nil, nil, err
+	}
+
+	// Debit limits count when the funds are held; the capture does not count again
+	var limitUsage []*domain.LimitUsage
+	if req.LimitReservation != nil {
+		limitUsage = req.LimitReservation.Usage
+		if err := r.limitRepo.Reserve(ctx, tx, limitUsage); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	transactionType := req.TransactionType
+	if transactionType == "" {
+		transactionType = domain.TransactionTypeWithdrawal
+	}
+
+	hold := &domain.Hold{
+		HoldRef:              req.HoldRef,
+		AccountID:            account.ID,
+		AccountNumber:        account.AccountNumber,
+		AccountType:          req.AccountType,
+		Amount:               amount,
+		Currency:             account.Currency,
+		HoldType:             req.HoldType,
+		Status:               domain.HoldStatusActive,
+		TransactionType:      transactionType,
+		CaptureAccountNumber: req.CaptureAccountNumber,
+		Reason:               req.Reason,
+		CreatedByExternalID:  &req.CreatedByExternalID,
+		CreatedByType:        &req.CreatedByType,
+		LimitUsage:           limitUsage,
+		ExpiresAt:            req.ExpiresAt,
+	}
+	if err := r.holdRepo.Create(ctx, tx, hold); err != nil {
+		return nil, nil, err
+	}
+
+	if err := r.createEvent(ctx, tx, holdEvent("hold.placed", hold, 0)); err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	balance.AvailableBalance = balance.AvailableBalance.Sub(amount)
+	balance.PendingDebit = balance.PendingDebit.Add(amount)
+
+	r.logger.Info("hold placed",
+		zap.String("hold_ref", hold.HoldRef),
+		zap.String("account_number", hold.AccountNumber),
+		zap.String("amount", amount.String()),
+		zap.String("currency", hold.Currency))
+
+	return hold, balance, nil
+}
+
+// CaptureHold posts a hold to the ledger. The whole reservation is released
+// first, then the captured amount is debited through the normal pessimistic
+// path in the same DB transaction, so the uncaptured remainder ends up back
+// in available_balance.
+func (r *transactionRepo) CaptureHold(
+	ctx context.Context,
+	req *domain.CaptureHoldRequest,
+) (*domain.HoldCaptureResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.ReceiptCode == nil || *req.ReceiptCode == "" {
+		return nil, xerrors.ErrInvalidReceiptCode
+	}
+
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock hold first; every hold operation takes the hold row before balances
+	hold, err := r.holdRepo.GetByRefForUpdate(ctx, tx, req.HoldRef)
+	if err != nil {
+		return nil, err
+	}
+	if !hold.IsActive() {
+		return nil, fmt.Errorf("%w: %s is %s", xerrors.ErrHoldNotActive, hold.HoldRef, hold.Status)
+	}
+	if hold.IsExpired(time.Now()) {
+		return nil, xerrors.ErrHoldExpired
+	}
+
+	currency, err := r.getCurrency(ctx, hold.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	captured := hold.Amount
+	if req.Amount.IsPositive() {
+		captured = currency.Round(req.Amount)
+		if !captured.IsPositive() {
+			return nil, xerrors.ErrInvalidAmount
+		}
+		if captured.GreaterThan(hold.Amount) {
+			return nil, fmt.Errorf("%w: requested %s, held %s %s",
+				xerrors.ErrHoldCaptureExceedsAmount, captured, hold.Amount, hold.Currency)
+		}
+	}
+
+	// Resolve destination: explicit > hold default > system liquidity (no fees)
+	var destAccount *domain.Account
+	destNumber := req.ToAccountNumber
+	if destNumber == nil {
+		destNumber = hold.CaptureAccountNumber
+	}
+	isSystemTransaction := destNumber == nil
+	if isSystemTransaction {
+		destAccount, err = r.liquidityAccountFor(ctx, hold.AccountType, hold.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get system account: %w", err)
+		}
+	} else {
+		destAccount, err = r.accountRepo.GetByAccountNumber(ctx, *destNumber)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get destination account: %w", err)
+		}
+	}
+	if destAccount.Currency != hold.Currency {
+		return nil, xerrors.ErrCurrencyMismatch
+	}
+
+	// Fee is taken from the credited amount, as with Transfer
+	creditAmount := captured
+	feeAmount := decimal.Zero
+	var systemRevAccount *domain.Account
+	if !isSystemTransaction && req.TransactionFee != nil && req.TransactionFee.Amount.IsPositive() {
+		feeAmount = currency.Round(req.TransactionFee.Amount)
+		req.TransactionFee.Amount = feeAmount
+		creditAmount = captured.Sub(feeAmount)
+		if !creditAmount.IsPositive() {
+			return nil, xerrors.ErrInvalidFeeAmount
+		}
+
+		systemRevAccount, err = r.accountRepo.GetSystemRevenueAccount(ctx, currency.Code)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get system revenue account: %w", err)
+		}
+	}
+
+	description := req.Description
+	if description == "" {
+		description = fmt.Sprintf("Capture of hold %s", hold.HoldRef)
+	}
+	metadata := map[string]interface{}{
+		"hold_ref":  hold.HoldRef,
+		"hold_type": hold.HoldType,
+	}
+	captureKey := fmt.Sprintf("hold-capture:%s", hold.HoldRef)
+
+	txReq := &domain.TransactionRequest{
+		IdempotencyKey:      &captureKey,
+		TransactionType:     hold.TransactionType,
+		AccountType:         hold.AccountType,
+		ExternalRef:         req.ReceiptCode,
+		Description:         &description,
+		CreatedByExternalID: &req.CreatedByExternalID,
+		CreatedByType:       &req.CreatedByType,
+		AgentExternalID:     req.AgentExternalID,
+		IsSystemTransaction: isSystemTransaction,
+		ReceiptCode:         req.ReceiptCode,
+		TransactionFee:      req.TransactionFee,
+		LimitReservation:    req.LimitReservation,
+		Entries: []*domain.LedgerEntryRequest{
+			{
+				AccountNumber: hold.AccountNumber,
+				Amount:        captured,
+				DrCr:          domain.DrCrDebit,
+				Currency:      hold.Currency,
+				ReceiptCode:   req.ReceiptCode,
+				Description:   &description,
+				Metadata:      metadata,
+			},
+			{
+				AccountNumber: destAccount.AccountNumber,
+				Amount:        creditAmount,
+				DrCr:          domain.DrCrCredit,
+				Currency:      destAccount.Currency,
+				ReceiptCode:   req.ReceiptCode,
+				Description:   &description,
+				Metadata:      metadata,
+			},
+		},
+		GenerateReceipt: true,
+	}
+	if systemRevAccount != nil {
+		txReq.Entries = append(txReq.Entries, &domain.LedgerEntryRequest{
+			AccountNumber: systemRevAccount.AccountNumber,
+			Amount:        feeAmount,
+			DrCr:          domain.DrCrCredit,
+			Currency:      systemRevAccount.Currency,
+			ReceiptCode:   req.ReceiptCode,
+			IsFee:         true,
+			Description:   strPtr(fmt.Sprintf("Hold capture fee %s", hold.HoldRef)),
+			Metadata:      metadata,
+		})
+	}
+	if len(hold.LimitUsage) > 0 {
+		txReq.LimitReservation = nil
+	}
+	if err := txReq.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid capture request: %w", err)
+	}
+
+	journal, err := r.createJournal(ctx, tx, txReq)
+	if err != nil {
+		return nil, err
+	}
+
+	accountMap, balanceMap, err := r.lockAccountsPessimistic(ctx, tx, txReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// Give the reservation back before the debit is validated against it
+	if err := r.balanceRepo.ReleaseFunds(ctx, tx, hold.AccountID, hold.Amount, false); err != nil {
+		return nil, err
+	}
+	if balance, ok := balanceMap[hold.AccountID]; ok {
+		balance.AvailableBalance = balance.AvailableBalance.Add(hold.Amount)
+		balance.PendingDebit = balance.PendingDebit.Sub(hold.Amount)
+	}
+
+	if err := r.validateBalancesPessimistic(accountMap, balanceMap, txReq); err != nil {
+		return nil, err
+	}
+
+	if err := r.reserveLimits(ctx, tx, txReq); err != nil {
+		return nil, err
+	}
+	// The hold counted its full amount; give back what is not captured
+	if remainder := hold.Amount.Sub(captured); remainder.IsPositive() {
+		if err := r.limitRepo.Release(ctx, tx, limitUsageRemainder(hold.LimitUsage, remainder)); err != nil {
+			return nil, err
+		}
+	}
+
+	ledgers, err := r.createLedgersWithBalance(ctx, tx, journal.ID, accountMap, balanceMap, txReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.updateBalancesPessimistic(ctx, tx, ledgers); err != nil {
+		return nil, err
+	}
+
+	if err := r.createFees(ctx, tx, journal, txReq); err != nil {
+		return nil, err
+	}
+
+	if err := r.holdRepo.MarkCaptured(ctx, tx, hold.ID, captured, *req.ReceiptCode); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	hold.Status = domain.HoldStatusCaptured
+	hold.CapturedAmount = captured
+	hold.ReceiptCode = req.ReceiptCode
+	hold.CapturedAt = &now
+	hold.ReleasedAt = &now
+
+	if err := r.createEvent(ctx, tx, holdEvent("hold.captured", hold, journal.ID)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.logger.Info("hold captured",
+		zap.String("hold_ref", hold.HoldRef),
+		zap.String("receipt_code", *req.ReceiptCode),
+		zap.String("captured", captured.String()),
+		zap.String("released", hold.Amount.Sub(captured).String()),
+		zap.String("fee", feeAmount.String()))
+
+	return &domain.HoldCaptureResult{
+		Hold: hold,
+		Aggregate: &domain.LedgerAggregate{
+			Journal:       journal,
+			Ledgers:       ledgers,
+			PayableAmount: creditAmount,
+		},
+		ReleasedAmount: hold.Amount.Sub(captured),
+		FeeAmount:      feeAmount,
+	}, nil
+}
+
+// ReleaseHold returns held funds to the available balance. Releasing a hold
+// that is already released or expired returns it unchanged.
+func (r *transactionRepo) ReleaseHold(
+	ctx context.Context,
+	req *domain.ReleaseHoldRequest,
+) (*domain.Hold, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	hold, err := r.holdRepo.GetByRefForUpdate(ctx, tx, req.HoldRef)
+	if err != nil {
+		return nil, err
+	}
+
+	switch hold.Status {
+	case domain.HoldStatusActive:
+	case domain.HoldStatusReleased, domain.HoldStatusExpired:
+		return hold, nil
+	default:
+		return nil, fmt.Errorf("%w: %s is %s", xerrors.ErrHoldNotActive, hold.HoldRef, hold.Status)
+	}
+
+	status := domain.HoldStatusReleased
+	if req.Expired {
+		status = domain.HoldStatusExpired
+	}
+
+	if err := r.balanceRepo.ReleaseFunds(ctx, tx, hold.AccountID, hold.Amount, false); err != nil {
+		return nil, err
+	}
+
+	if err := r.limitRepo.Release(ctx, tx, hold.LimitUsage); err != nil {
+		return nil, err
+	}
+
+	if err := r.holdRepo.MarkReleased(ctx, tx, hold.ID, status, req.Reason); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	hold.Status = status
+	hold.ReleasedAt = &now
+	if req.Reason != nil {
+		hold.Reason = req.Reason
+	}
+
+	eventType := "hold.released"
+	if status == domain.HoldStatusExpired {
+		eventType = "hold.expired"
+	}
+	if err := r.createEvent(ctx, tx, holdEvent(eventType, hold, 0)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.logger.Info("hold released",
+		zap.String("hold_ref", hold.HoldRef),
+		zap.String("status", string(status)),
+		zap.String("amount", hold.Amount.String()))
+
+	return hold, nil
+}
+
+// ExtendHold moves the expiry of an active hold. The row lock orders it
+// against the sweeper, so a hold is either extended or expired, never both.
+func (r *transactionRepo) ExtendHold(
+	ctx context.Context,
+	req *domain.ExtendHoldRequest,
+) (*domain.Hold, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	hold, err := r.holdRepo.GetByRefForUpdate(ctx, tx, req.HoldRef)
+	if err != nil {
+		return nil, err
+	}
+	if !hold.IsActive() {
+		return nil, fmt.Errorf("%w: %s is %s", xerrors.ErrHoldNotActive, hold.HoldRef, hold.Status)
+	}
+
+	if err := r.holdRepo.SetExpiry(ctx, tx, hold.ID, req.ExpiresAt); err != nil {
+		return nil, err
+	}
+	hold.ExpiresAt = req.ExpiresAt
+
+	if err := r.createEvent(ctx, tx, holdEvent("hold.extended", hold, 0)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	expiresAt := "never"
+	if hold.ExpiresAt != nil {
+		expiresAt = hold.ExpiresAt.Format(time.RFC3339)
+	}
+	r.logger.Info("hold extended",
+		zap.String("hold_ref", hold.HoldRef),
+		zap.String("expires_at", expiresAt))
+
+	return hold, nil
+}
+
+// limitUsageRemainder is the amount of each counter a hold counted but did
+// not capture. The capture itself still counts as one transaction.
+func limitUsageRemainder(usage []*domain.LimitUsage, remainder decimal.Decimal) []*domain.LimitUsage {
+	out := make([]*domain.LimitUsage, 0, len(usage))
+	for _, u := range usage {
+		out = append(out, &domain.LimitUsage{
+			AccountNumber: u.AccountNumber,
+			Window:        u.Window,
+			WindowStart:   u.WindowStart,
+			Amount:        remainder,
+		})
+	}
+	return out
+}
+
+// holdEvent builds the hold.* event of a hold's current state.
+// transactionID is the capture journal, 0 for holds not posted.
+func holdEvent(eventType string, hold *domain.Hold, transactionID int64) *domain.TransactionEvent {
+	amount := hold.Amount
+	if hold.Status == domain.HoldStatusCaptured {
+		amount = hold.CapturedAmount
+	}
+
+	return &domain.TransactionEvent{
+		EventType:       eventType,
+		UserID:          ptrStrToStr(hold.CreatedByExternalID),
+		ReceiptCode:     ptrStrToStr(hold.ReceiptCode),
+		TransactionID:   transactionID,
+		TransactionType: string(hold.TransactionType),
+		Status:          string(hold.Status),
+		Amount:          amount,
+		Currency:        hold.Currency,
+		Metadata: map[string]interface{}{
+			"hold_ref":       hold.HoldRef,
+			"hold_type":      hold.HoldType,
+			"account_number": hold.AccountNumber,
+			"hold_amount":    hold.Amount.String(),
+		},
+	}
+}
+
+// GetHold fetches a hold by reference
+func (r *transactionRepo) GetHold(ctx context.Context, holdRef string) (*domain.Hold, error) {
+	return r.holdRepo.GetByRef(ctx, holdRef)
+}
+
+// ListHolds lists holds matching the filter
+func (r *transactionRepo) ListHolds(ctx context.Context, filter *domain.HoldFilter) ([]*domain.Hold, int64, error) {
+	return r.holdRepo.List(ctx, filter)
+}
+
+// ListExpiredHolds returns references of active holds past their expiry
+func (r *transactionRepo) ListExpiredHolds(ctx context.Context, limit int) ([]string, error) {
+	return r.holdRepo.ListExpired(ctx, time.Now(), limit)
+}
@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TransactionQueueRepository persists async transactions. Workers claim with
+// SKIP LOCKED under a lease, so a task survives restarts and a worker that
+// dies mid-task only delays it until the lease runs out. Complete, Fail and
+// Retry only apply while workerID still holds the lease; otherwise they
+// return xerrors.ErrQueueLeaseLost and change nothing.
+type TransactionQueueRepository interface {
+	Enqueue(ctx context.Context, task *domain.QueuedTransaction) error
+	Claim(ctx context.Context, workerID string, lease time.Duration) (*domain.QueuedTransaction, error)
+
+	Complete(ctx context.Context, id int64, workerID string, journalID int64) error
+	Fail(ctx context.Context, id int64, workerID string, errMsg string) error
+	Retry(ctx context.Context, id int64, workerID string, errMsg string, backoff time.Duration) (domain.QueuedTransactionStatus, error)
+
+	List(ctx context.Context, filter *domain.QueuedTransactionFilter) ([]*domain.QueuedTransaction, int64, error)
+	Redrive(ctx context.Context, ids []int64) ([]*domain.QueuedTransaction, error)
+	Skip(ctx context.Context, ids []int64) ([]*domain.QueuedTransaction, error)
+}
+
+type transactionQueueRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewTransactionQueueRepo(db *pgxpool.Pool) TransactionQueueRepository {
+	return &transactionQueueRepo{db: db}
+}
+
+// leaseHeld restricts an update to the row's current lease holder ($2)
+const leaseHeld = `
+		  AND status = 'processing' AND locked_by = $2 AND locked_until > now()`
+
+const transactionQueueColumns = `
+	id, receipt_code, idempotency_key, ordering_key, payload, status, attempts, max_attempts,
+	last_error, journal_id, available_at, locked_until, locked_by, created_at, updated_at, completed_at
+`
+
+// Enqueue stores a task. A receipt code is only ever queued once; a repeated
+// enqueue leaves the existing row untouched.
+func (r *transactionQueueRepo) Enqueue(ctx context.Context, task *domain.QueuedTransaction) error {
+	payload, err := json.Marshal(task.Request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal queued transaction: %w", err)
+	}
+	if task.MaxAttempts <= 0 {
+		task.MaxAttempts = domain.DefaultQueueMaxAttempts
+	}
+
+	err = r.db.QueryRow(ctx, `
+		INSERT INTO transaction_queue (receipt_code, idempotency_key, ordering_key, payload, max_attempts)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (receipt_code) DO UPDATE SET receipt_code = EXCLUDED.receipt_code
+		RETURNING id, status, attempts, available_at, created_at, updated_at
+	`, task.ReceiptCode, task.IdempotencyKey, task.OrderingKey, payload, task.MaxAttempts,
+	).Scan(&task.ID, &task.Status, &task.Attempts, &task.AvailableAt, &task.CreatedAt, &task.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue transaction: %w", err)
+	}
+	return nil
+}
+
+// Claim takes the oldest runnable task and leases it to workerID. A task is
+// runnable when it is due (or its previous lease expired) and no earlier
+// unfinished or dead-lettered task has the same ordering key, which keeps
+// each account's transactions in order. Returns nil when nothing is runnable.
+func (r *transactionQueueRepo) Claim(ctx context.Context, workerID string, lease time.Duration) (*domain.QueuedTransaction, error) {
+	row := r.db.QueryRow(ctx, `
+		WITH next AS (
+			SELECT q.id
+			FROM transaction_queue q
+			WHERE (
+			        (q.status = 'pending' AND q.available_at <= now())
+			     OR (q.status = 'processing' AND q.locked_until < now())
+			      )
+			  AND NOT EXISTS (
+			        SELECT 1 FROM transaction_queue e
+			        WHERE e.ordering_key = q.ordering_key
+			          AND e.id < q.id
+			          AND e.status IN ('pending', 'processing', 'dead')
+			      )
+			ORDER BY q.id
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE transaction_queue t
+		SET status       = 'processing',
+		    attempts     = t.attempts + 1,
+		    locked_until = now() + make_interval(secs => $1),
+		    locked_by    = $2,
+		    updated_at   = now()
+		FROM next
+		WHERE t.id = next.id
+		RETURNING t.id, t.receipt_code, t.idempotency_key, t.ordering_key, t.payload, t.status,
+		          t.attempts, t.max_attempts, t.last_error, t.journal_id, t.available_at,
+		          t.locked_until, t.locked_by, t.created_at, t.updated_at, t.completed_at
+	`, lease.Seconds(), workerID)
+
+	task, err := scanQueuedTransaction(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim queued transaction: %w", err)
+	}
+	return task, nil
+}
+
+func (r *transactionQueueRepo) Complete(ctx context.Context, id int64, workerID string, journalID int64) error {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE transaction_queue
+		SET status = 'completed', journal_id = $3, last_error = NULL,
+		    locked_until = NULL, locked_by = NULL, completed_at = now(), updated_at = now()
+		WHERE id = $1`+leaseHeld+`
+	`, id, workerID, journalID)
+	if err != nil {
+		return fmt.Errorf("failed to complete queued transaction: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return xerrors.ErrQueueLeaseLost
+	}
+	return nil
+}
+
+// Fail records a final failure (the ledger rejected the transaction)
+func (r *transactionQueueRepo) Fail(ctx context.Context, id int64, workerID string, errMsg string) error {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE transaction_queue
+		SET status = 'failed', last_error = $3,
+		    locked_until = NULL, locked_by = NULL, completed_at = now(), updated_at = now()
+		WHERE id = $1`+leaseHeld+`
+	`, id, workerID, errMsg)
+	if err != nil {
+		return fmt.Errorf("failed to mark queued transaction failed: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return xerrors.ErrQueueLeaseLost
+	}
+	return nil
+}
+
+// Retry puts the task back after backoff, or dead-letters it once it has used
+// all its attempts. Returns the resulting status.
+func (r *transactionQueueRepo) Retry(ctx context.Context, id int64, workerID string, errMsg string, backoff time.Duration) (domain.QueuedTransactionStatus, error) {
+	var status domain.QueuedTransactionStatus
+	err := r.db.QueryRow(ctx, `
+		UPDATE transaction_queue
+		SET status       = CASE WHEN attempts >= max_attempts THEN 'dead' ELSE 'pending' END,
+		    last_error   = $3,
+		    available_at = now() + make_interval(secs => $4),
+		    locked_until = NULL,
+		    locked_by    = NULL,
+		    updated_at   = now()
+		WHERE id = $1`+leaseHeld+`
+		RETURNING status
+	`, id, workerID, errMsg, backoff.Seconds()).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", xerrors.ErrQueueLeaseLost
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to reschedule queued transaction: %w", err)
+	}
+	return status, nil
+}
+
+func (r *transactionQueueRepo) List(ctx context.Context, filter *domain.QueuedTransactionFilter) ([]*domain.QueuedTransaction, int64, error) {
+	where := `WHERE ($1::text IS NULL OR status = $1) AND ($2::text IS NULL OR receipt_code = $2)`
+
+	var status *string
+	if filter.Status != nil {
+		s := string(*filter.Status)
+		status = &s
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM transaction_queue `+where,
+		status, filter.ReceiptCode).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count queued transactions: %w", err)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT `+transactionQueueColumns+`
+		FROM transaction_queue `+where+`
+		ORDER BY id DESC
+		LIMIT $3 OFFSET $4
+	`, status, filter.ReceiptCode, limit, filter.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list queued transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []*domain.QueuedTransaction
+	for rows.Next() {
+		task, err := scanQueuedTransaction(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan queued transaction: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, total, rows.Err()
+}
+
+// Redrive moves dead-lettered tasks back to pending with a fresh attempt
+// budget. Rows that are not dead are ignored. Returns the re-driven tasks.
+func (r *transactionQueueRepo) Redrive(ctx context.Context, ids []int64) ([]*domain.QueuedTransaction, error) {
+	rows, err := r.db.Query(ctx, `
+		UPDATE transaction_queue
+		SET status = 'pending', attempts = 0, available_at = now(),
+		    locked_until = NULL, locked_by = NULL, updated_at = now()
+		WHERE status = 'dead' AND id = ANY($1)
+		RETURNING `+transactionQueueColumns, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to redrive queued transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []*domain.QueuedTransaction
+	for rows.Next() {
+		task, err := scanQueuedTransaction(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan queued transaction: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+// Skip gives up dead-lettered tasks so the later tasks of their accounts can
+// run. Rows that are not dead are ignored. Returns the skipped tasks.
+func (r *transactionQueueRepo) Skip(ctx context.Context, ids []int64) ([]*domain.QueuedTransaction, error) {
+	rows, err := r.db.Query(ctx, `
+		UPDATE transaction_queue
+		SET status = 'skipped', completed_at = now(), updated_at = now()
+		WHERE status = 'dead' AND id = ANY($1)
+		RETURNING `+transactionQueueColumns, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to skip queued transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []*domain.QueuedTransaction
+	for rows.Next() {
+		task, err := scanQueuedTransaction(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan queued transaction: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+func scanQueuedTransaction(row pgx.Row) (*domain.QueuedTransaction, error) {
+	var task domain.QueuedTransaction
+	var payload []byte
+	err := row.Scan(
+		&task.ID, &task.ReceiptCode, &task.IdempotencyKey, &task.OrderingKey, &payload,
+		&task.Status, &task.Attempts, &task.MaxAttempts, &task.LastError, &task.JournalID,
+		&task.AvailableAt, &task.LockedUntil, &task.LockedBy, &task.CreatedAt, &task.UpdatedAt, &task.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Request = &domain.TransactionRequest{}
+	if err := json.Unmarshal(payload, task.Request); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal queued transaction %d: %w", task.ID, err)
+	}
+	return &task, nil
+}
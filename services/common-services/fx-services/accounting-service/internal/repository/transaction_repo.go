@@ -5,7 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strconv"
+	"time"
 
 	"accounting-service/internal/domain"
 
@@ -31,14 +31,31 @@ type TransactionRepository interface {
 
 	// Currency conversion
 	ConvertAndTransfer(ctx context.Context, req *domain.ConversionRequest) (*domain.LedgerAggregate, error)
+	PreviewConversion(ctx context.Context, req *domain.CreateFXQuoteRequest, fee decimal.Decimal) (*domain.FXQuote, error)
 
 	// Trading operations
 	ProcessTradeWin(ctx context.Context, req *domain.TradeRequest) (*domain.LedgerAggregate, error)
 	ProcessTradeLoss(ctx context.Context, req *domain.TradeRequest) (*domain.LedgerAggregate, error)
+	GetTradeSettlements(ctx context.Context, tradeIDs []string) (map[string]*domain.TradeSettlement, error)
+	PreviewTradeSettlement(ctx context.Context, group *domain.TradeSettlementGroup) (*domain.TransactionRequest, error)
+	SettleTradeGroup(ctx context.Context, group *domain.TradeSettlementGroup) ([]*domain.TradeSettlement, *domain.LedgerAggregate, error)
 
 	// Agent operations
 	ProcessAgentCommission(ctx context.Context, req *domain.AgentCommissionRequest) (*domain.LedgerAggregate, error)
 
+	// Reversals and refunds
+	ReverseTransaction(ctx context.Context, req *domain.ReversalRequest) (*domain.ReversalResult, error)
+	RefundTransaction(ctx context.Context, req *domain.ReversalRequest) (*domain.ReversalResult, error)
+
+	// Funds holds (authorize / capture)
+	PlaceHold(ctx context.Context, req *domain.PlaceHoldRequest) (*domain.Hold, *domain.Balance, error)
+	CaptureHold(ctx context.Context, req *domain.CaptureHoldRequest) (*domain.HoldCaptureResult, error)
+	ReleaseHold(ctx context.Context, req *domain.ReleaseHoldRequest) (*domain.Hold, error)
+	ExtendHold(ctx context.Context, req *domain.ExtendHoldRequest) (*domain.Hold, error)
+	GetHold(ctx context.Context, holdRef string) (*domain.Hold, error)
+	ListHolds(ctx context.Context, filter *domain.HoldFilter) ([]*domain.Hold, int64, error)
+	ListExpiredHolds(ctx context.Context, limit int) ([]string, error)
+
 	// Idempotency and transaction management
 	GetByIdempotencyKey(ctx context.Context, key string) (*domain.LedgerAggregate, error)
 	BeginTx(ctx context.Context) (pgx.Tx, error)
@@ -53,6 +70,10 @@ type transactionRepo struct {
 	currencyRepo CurrencyRepository // Use existing currency repo for FX
 	feeRepo      TransactionFeeRepository
 	agent        AgentRepository
+	holdRepo     HoldRepository
+	outboxRepo   OutboxRepository
+	limitRepo    LimitRepository
+	fxRateTTL    time.Duration // Max FX rate age for conversions (0 = no limit)
 	logger       *zap.Logger
 }
 
@@ -65,6 +86,10 @@ func NewTransactionRepo(
 	currencyRepo CurrencyRepository, // FX operations
 	feeRepo TransactionFeeRepository,
 	agent        AgentRepository,
+	holdRepo     HoldRepository,
+	outboxRepo   OutboxRepository,
+	limitRepo    LimitRepository,
+	fxRateTTL    time.Duration,
 
 	logger *zap.Logger,
 ) TransactionRepository {
@@ -77,6 +102,10 @@ func NewTransactionRepo(
 		currencyRepo: currencyRepo,
 		feeRepo:      feeRepo,
 		agent:        agent,
+		holdRepo:     holdRepo,
+		outboxRepo:   outboxRepo,
+		limitRepo:    limitRepo,
+		fxRateTTL:    fxRateTTL,
 		logger:       logger,
 	}
 }
@@ -102,12 +131,34 @@ func (r *transactionRepo) Credit(
 	ctx context.Context,
 	req *domain.CreditRequest,
 ) (*domain.LedgerAggregate, error) {
+	txReq, err := r.buildCreditRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	txReq.Events = []*domain.TransactionEvent{{
+		EventType:       "deposit.completed",
+		UserID:          req.CreatedByExternalID,
+		TransactionType: "deposit",
+		Status:          "completed",
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+		AccountNumber:   req.AccountNumber,
+	}}
+
+	return r.ExecuteTransaction(ctx, txReq)
+}
+
+// buildCreditRequest builds the system → user journal of a credit
+func (r *transactionRepo) buildCreditRequest(
+	ctx context.Context,
+	req *domain.CreditRequest,
+) (*domain.TransactionRequest, error) {
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
 
 	// Get system liquidity account for currency
-	systemAccount, err := r.accountRepo.GetSystemLiquidityAccount(ctx, req.Currency)
+	systemAccount, err := r.liquidityAccountFor(ctx, req.AccountType, req.Currency)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get system account: %w", err)
 	}
@@ -133,6 +184,7 @@ func (r *transactionRepo) Credit(
 		CreatedByType:       &req.CreatedByType,
 		IsSystemTransaction: true, // NO FEES
 		ReceiptCode: req.ReceiptCode,
+		PostedAt:            req.PostedAt,
 		Entries: []*domain.LedgerEntryRequest{
 			{
 				AccountNumber: systemAccount.AccountNumber,
@@ -156,7 +208,7 @@ func (r *transactionRepo) Credit(
 		GenerateReceipt: true,
 	}
 
-	return r.ExecuteTransaction(ctx, txReq)
+	return txReq, nil
 }
 
 // Debit removes money from account (trade loss, withdrawal)
@@ -165,12 +217,34 @@ func (r *transactionRepo) Debit(
 	ctx context.Context,
 	req *domain.DebitRequest,
 ) (*domain.LedgerAggregate, error) {
+	txReq, err := r.buildDebitRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	txReq.Events = []*domain.TransactionEvent{{
+		EventType:       "withdrawal.completed",
+		UserID:          req.CreatedByExternalID,
+		TransactionType: "withdrawal",
+		Status:          "completed",
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+		AccountNumber:   req.AccountNumber,
+	}}
+
+	return r.ExecuteTransaction(ctx, txReq)
+}
+
+// buildDebitRequest builds the user → system journal of a debit
+func (r *transactionRepo) buildDebitRequest(
+	ctx context.Context,
+	req *domain.DebitRequest,
+) (*domain.TransactionRequest, error) {
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
 
 	// Get system liquidity account
-	systemAccount, err := r.accountRepo.GetSystemLiquidityAccount(ctx, req.Currency)
+	systemAccount, err := r.liquidityAccountFor(ctx, req.AccountType, req.Currency)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get system account: %w", err)
 	}
@@ -196,6 +270,7 @@ func (r *transactionRepo) Debit(
 		CreatedByType:       &req.CreatedByType,
 		IsSystemTransaction: true, // NO FEES
 		ReceiptCode: req.ReceiptCode,
+		PostedAt:            req.PostedAt,
 		Entries: []*domain.LedgerEntryRequest{
 			{
 				AccountNumber: userAccount.AccountNumber,
@@ -220,7 +295,21 @@ func (r *transactionRepo) Debit(
 		GenerateReceipt: true,
 	}
 
-	return r.ExecuteTransaction(ctx, txReq)
+	return txReq, nil
+}
+
+// liquidityAccountFor returns the system liquidity account on the same side
+// of the demo/real divide as the journal, so a demo credit or debit never
+// touches real money
+func (r *transactionRepo) liquidityAccountFor(
+	ctx context.Context,
+	accountType domain.AccountType,
+	currency string,
+) (*domain.Account, error) {
+	if accountType == domain.AccountTypeDemo {
+		return r.accountRepo.GetDemoLiquidityAccount(ctx, currency)
+	}
+	return r.accountRepo.GetSystemLiquidityAccount(ctx, currency)
 }
 
 // Transfer moves money between user accounts (P2P)
@@ -250,30 +339,36 @@ func (r *transactionRepo) Transfer(
 		return nil, xerrors.ErrCurrencyMismatch
 	}
 
+	currency, err := r.getCurrency(ctx, sourceAccount.Currency)
+	if err != nil {
+		return nil, err
+	}
+
 	// ✅ Calculate amounts
 	var (
-		debitAmount      = req.Amount // Amount to debit from source
-		creditAmount     = req.Amount // Amount to credit to destination
-		feeAmount        = 0.0
+		debitAmount      = currency.Round(req.Amount) // Amount to debit from source
+		creditAmount     = debitAmount                // Amount to credit to destination
+		feeAmount        = decimal.Zero
 		systemRevAccount *domain.Account
 	)
 
 	// ✅ If there's a fee, reduce the credit amount
-	if req.TransactionFee != nil && req.TransactionFee.Amount > 0 {
-		feeAmount = req.TransactionFee.Amount
-		creditAmount = req.Amount - feeAmount // ✅ Destination gets less
+	if req.TransactionFee != nil && req.TransactionFee.Amount.IsPositive() {
+		feeAmount = currency.Round(req.TransactionFee.Amount)
+		req.TransactionFee.Amount = feeAmount
+		creditAmount = debitAmount.Sub(feeAmount) // ✅ Destination gets less
+		if !creditAmount.IsPositive() {
+			return nil, xerrors.ErrInvalidFeeAmount
+		}
 
 		// Get system revenue account for fee
-		currency := sourceAccount.Currency
-
-		var err error
-		systemRevAccount, err = r.accountRepo.GetSystemRevenueAccount(ctx, currency)
+		systemRevAccount, err = r.accountRepo.GetSystemRevenueAccount(ctx, currency.Code)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get system revenue account: %w", err)
 		}
 
 		if systemRevAccount == nil {
-			return nil, fmt.Errorf("system revenue account not found for currency %s", currency)
+			return nil, fmt.Errorf("system revenue account not found for currency %s", currency.Code)
 		}
 	}
 
@@ -290,6 +385,7 @@ func (r *transactionRepo) Transfer(
 		IsSystemTransaction: false, // FEES APPLY
 		ReceiptCode: req.ReceiptCode,
 		TransactionFee: req.TransactionFee,
+		LimitReservation: req.LimitReservation,
 		Entries: []*domain.LedgerEntryRequest{
 			// Source: Debit full amount
 			{
@@ -316,7 +412,7 @@ func (r *transactionRepo) Transfer(
 	}
 
 	// Add fee entry if applicable
-	if systemRevAccount != nil && feeAmount > 0 {
+	if systemRevAccount != nil && feeAmount.IsPositive() {
 		txReq.Entries = append(txReq.  Entries, &domain.LedgerEntryRequest{
 			AccountNumber: systemRevAccount.AccountNumber,
 			Amount:        feeAmount,
@@ -324,11 +420,23 @@ func (r *transactionRepo) Transfer(
 			Currency:      systemRevAccount.Currency,
 			ReceiptCode:   req.ReceiptCode,
 			IsFee:         true,
-			Description:   strPtr(fmt.Sprintf("Transfer fee (%.2f%%)", (feeAmount/req.Amount)*100)),
+			Description:   strPtr(fmt.Sprintf("Transfer fee (%s%%)", feeAmount.Div(debitAmount).Mul(decimal.NewFromInt(100)).StringFixed(2))),
 			Metadata:      req.Metadata,
 		})
 	}
 
+	txReq.Events = []*domain.TransactionEvent{{
+		EventType:       "transfer.completed",
+		UserID:          req.CreatedByExternalID,
+		TransactionType: "transfer",
+		Status:          "completed",
+		Amount:          debitAmount,
+		Currency:        sourceAccount.Currency,
+		FromAccount:     sourceAccount.AccountNumber,
+		ToAccount:       destAccount.AccountNumber,
+		Fee:             feeAmount,
+	}}
+
 	return r.ExecuteTransaction(ctx, txReq)
 }
 
@@ -363,34 +471,44 @@ func (r *transactionRepo) ConvertAndTransfer(
 		return nil, errors.New("use Transfer for same currency operations")
 	}
 
-	// Get current FX rate
-	fxRate, err := r.currencyRepo.GetCurrentFXRate(ctx, sourceAccount. Currency, destAccount.Currency)
+	// Locked quote rate, or the current customer rate
+	fxRate, rate, err := r.resolveConversionRate(ctx, sourceAccount.Currency, destAccount.Currency, req.Quote)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get FX rate for %s/%s: %w",
-			sourceAccount.Currency, destAccount.Currency, err)
+		return nil, err
 	}
 
-	// Parse rate
-	rate, err := strconv. ParseFloat(fxRate.Rate, 64)
+	sourceCurrency, err := r.getCurrency(ctx, sourceAccount.Currency)
 	if err != nil {
-		return nil, fmt.Errorf("invalid fx rate %q: %w", fxRate.Rate, err)
+		return nil, err
+	}
+	destCurrency, err := r.getCurrency(ctx, destAccount.Currency)
+	if err != nil {
+		return nil, err
 	}
 
 	// Calculate converted amount (before fee)
-	convertedAmount := r. calculateConversion(req.Amount, rate)
+	sourceAmount := sourceCurrency.Round(req.Amount)
+	convertedAmount := r.calculateConversion(sourceAmount, rate, destCurrency)
+	if !convertedAmount.IsPositive() {
+		return nil, xerrors.ErrInvalidAmount
+	}
 
 	// Calculate fee and final credit amount
 	var (
-		feeAmount          = 0.0
+		feeAmount          = decimal.Zero
 		finalCreditAmount  = convertedAmount // Amount to credit after fee deduction
 		systemRevAccount   *domain.Account
 	)
 
 	// Get fee if applicable
-	if req.TransactionFee != nil && req.TransactionFee.Amount > 0 {
+	if req.TransactionFee != nil && req.TransactionFee.Amount.IsPositive() {
 		// Fee is typically in destination currency for conversions
-		feeAmount = req.TransactionFee.Amount
-		finalCreditAmount = convertedAmount - feeAmount // Reduce credit by fee
+		feeAmount = destCurrency.Round(req.TransactionFee.Amount)
+		req.TransactionFee.Amount = feeAmount
+		finalCreditAmount = convertedAmount.Sub(feeAmount) // Reduce credit by fee
+		if !finalCreditAmount.IsPositive() {
+			return nil, xerrors.ErrInvalidFeeAmount
+		}
 
 		// Get system revenue account in destination currency
 		systemRevAccount, err = r.accountRepo.GetSystemRevenueAccount(ctx, destAccount.Currency)
@@ -404,14 +522,19 @@ func (r *transactionRepo) ConvertAndTransfer(
 	if metadata == nil {
 		metadata = make(map[string]interface{})
 	}
-	metadata["fx_rate"] = fxRate.Rate
+	metadata["fx_rate"] = rate.String()
+	metadata["mid_rate"] = fxRate.Rate
 	metadata["fx_rate_id"] = fxRate.ID
+	metadata["fx_rate_as_of"] = fxRate.ValidFrom.Format(time.RFC3339)
+	if req.Quote != nil {
+		metadata["fx_quote_id"] = req.Quote.QuoteID
+	}
 	metadata["source_currency"] = sourceAccount.Currency
 	metadata["dest_currency"] = destAccount.Currency
-	metadata["source_amount"] = req.Amount
-	metadata["converted_amount"] = convertedAmount
-	metadata["fee_amount"] = feeAmount
-	metadata["final_credit_amount"] = finalCreditAmount
+	metadata["source_amount"] = sourceAmount.String()
+	metadata["converted_amount"] = convertedAmount.String()
+	metadata["fee_amount"] = feeAmount.String()
+	metadata["final_credit_amount"] = finalCreditAmount.String()
 	if fxRate.BidRate != nil {
 		metadata["bid_rate"] = *fxRate. BidRate
 	}
@@ -426,18 +549,19 @@ func (r *transactionRepo) ConvertAndTransfer(
 		AccountType:     req.AccountType,
 		ExternalRef:     req.ExternalRef,
 		Description: strPtr(fmt.Sprintf("Currency conversion: %s to %s (Rate: %s)",
-			sourceAccount.Currency, destAccount.Currency, fxRate.Rate)),
+			sourceAccount.Currency, destAccount.Currency, rate)),
 		CreatedByExternalID: &req. CreatedByExternalID,
 		CreatedByType:       &req.CreatedByType,
 		AgentExternalID:     req.AgentExternalID,
 		IsSystemTransaction: false, // FEES APPLY
 		ReceiptCode:         req.ReceiptCode,
 		TransactionFee:     req.TransactionFee,
+		LimitReservation:   req.LimitReservation,
 		Entries: []*domain.LedgerEntryRequest{
 			// Debit source account (original amount)
 			{
 				AccountNumber: sourceAccount.AccountNumber,
-				Amount:        req. Amount,
+				Amount:        sourceAmount,
 				DrCr:          domain.DrCrDebit,
 				Currency:      sourceAccount.Currency,
 				ReceiptCode:   req.ReceiptCode,
@@ -451,7 +575,7 @@ func (r *transactionRepo) ConvertAndTransfer(
 				DrCr:          domain.DrCrCredit,
 				Currency:      destAccount. Currency,
 				ReceiptCode:   req.ReceiptCode,
-				Description:   strPtr(fmt.Sprintf("Convert to %s (%.2f %s - %.2f fee)", 
+				Description:   strPtr(fmt.Sprintf("Convert to %s (%s %s - %s fee)", 
 					destAccount.Currency, convertedAmount, destAccount.Currency, feeAmount)),
 				Metadata:      metadata,
 			},
@@ -460,7 +584,7 @@ func (r *transactionRepo) ConvertAndTransfer(
 	}
 
 	// Add fee entry if applicable
-	if systemRevAccount != nil && feeAmount > 0 {
+	if systemRevAccount != nil && feeAmount.IsPositive() {
 		txReq.Entries = append(txReq.Entries, &domain.LedgerEntryRequest{
 			AccountNumber: systemRevAccount.AccountNumber,
 			Amount:        feeAmount,
@@ -473,14 +597,146 @@ func (r *transactionRepo) ConvertAndTransfer(
 		})
 	}
 
+	txReq.Events = []*domain.TransactionEvent{{
+		EventType:       "conversion.completed",
+		UserID:          req.CreatedByExternalID,
+		TransactionType: "conversion",
+		Status:          "completed",
+		Amount:          sourceAmount,
+		Currency:        sourceAccount.Currency,
+		FromAccount:     req.FromAccountNumber,
+		ToAccount:       req.ToAccountNumber,
+		Metadata: map[string]interface{}{
+			"source_currency":  sourceAccount.Currency,
+			"dest_currency":    destAccount.Currency,
+			"source_amount":    sourceAmount.String(),
+			"converted_amount": finalCreditAmount.String(),
+		},
+	}}
+
 	return r.ExecuteTransaction(ctx, txReq)
 }
 
-// calculateConversion applies FX rate to amount
-func (r *transactionRepo) calculateConversion(amount float64, rate float64) float64 {
-	// Convert to float, apply rate, round to nearest float64
-	converted := float64(amount) * rate
-	return converted // Round to nearest
+// PreviewConversion prices a conversion without booking it. The caller
+// supplies the platform fee; the result is what ConvertAndTransfer would
+// post for the same rate.
+func (r *transactionRepo) PreviewConversion(
+	ctx context.Context,
+	req *domain.CreateFXQuoteRequest,
+	fee decimal.Decimal,
+) (*domain.FXQuote, error) {
+	sourceAccount, err := r.accountRepo.GetByAccountNumber(ctx, req.FromAccountNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source account: %w", err)
+	}
+	destAccount, err := r.accountRepo.GetByAccountNumber(ctx, req.ToAccountNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get destination account: %w", err)
+	}
+	if sourceAccount.Currency == destAccount.Currency {
+		return nil, errors.New("use Transfer for same currency operations")
+	}
+
+	fxRate, rate, err := r.resolveConversionRate(ctx, sourceAccount.Currency, destAccount.Currency, nil)
+	if err != nil {
+		return nil, err
+	}
+	midRate, err := fxRate.MidRate()
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, xerrors.ErrInvalidFXRate)
+	}
+
+	sourceCurrency, err := r.getCurrency(ctx, sourceAccount.Currency)
+	if err != nil {
+		return nil, err
+	}
+	destCurrency, err := r.getCurrency(ctx, destAccount.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceAmount := sourceCurrency.Round(req.Amount)
+	convertedAmount := r.calculateConversion(sourceAmount, rate, destCurrency)
+	if !convertedAmount.IsPositive() {
+		return nil, xerrors.ErrInvalidAmount
+	}
+
+	feeAmount := destCurrency.Round(fee)
+	payable := convertedAmount.Sub(feeAmount)
+	if !payable.IsPositive() {
+		return nil, xerrors.ErrInvalidFeeAmount
+	}
+
+	return &domain.FXQuote{
+		FromAccountNumber:   sourceAccount.AccountNumber,
+		ToAccountNumber:     destAccount.AccountNumber,
+		AccountType:         req.AccountType,
+		SourceCurrency:      sourceAccount.Currency,
+		DestCurrency:        destAccount.Currency,
+		SourceAmount:        sourceAmount,
+		Rate:                rate,
+		MidRate:             midRate,
+		FXRateID:            fxRate.ID,
+		RateAsOf:            fxRate.ValidFrom,
+		ConvertedAmount:     convertedAmount,
+		FeeAmount:           feeAmount,
+		PayableAmount:       payable,
+		CreatedByExternalID: req.CreatedByExternalID,
+		CreatedByType:       req.CreatedByType,
+	}, nil
+}
+
+// resolveConversionRate returns the rate row and the customer rate to apply.
+// A quote locks both; otherwise the current rate must be within fxRateTTL.
+func (r *transactionRepo) resolveConversionRate(
+	ctx context.Context,
+	base, quote string,
+	fxQuote *domain.FXQuote,
+) (*domain.FXRate, decimal.Decimal, error) {
+	if fxQuote != nil {
+		if fxQuote.SourceCurrency != base || fxQuote.DestCurrency != quote {
+			return nil, decimal.Zero, xerrors.ErrFXQuoteMismatch
+		}
+		return fxQuote.LockedRate(), fxQuote.Rate, nil
+	}
+
+	fxRate, err := r.currencyRepo.GetCurrentFXRate(ctx, base, quote)
+	if err != nil {
+		if errors.Is(err, xerrors.ErrNotFound) {
+			err = xerrors.ErrFXRateNotFound
+		}
+		return nil, decimal.Zero, fmt.Errorf("failed to get FX rate for %s/%s: %w", base, quote, err)
+	}
+
+	// Refuse to convert on a rate the scheduler has not refreshed in time
+	if fxRate.IsStale(time.Now(), r.fxRateTTL) {
+		return nil, decimal.Zero, fmt.Errorf("%s/%s rate from %s: %w",
+			base, quote, fxRate.ValidFrom.Format(time.RFC3339), xerrors.ErrFXRateStale)
+	}
+
+	// Customer rate: bid (mid less the configured markup) when available
+	rate, err := fxRate.CustomerRate()
+	if err != nil {
+		return nil, decimal.Zero, fmt.Errorf("%v: %w", err, xerrors.ErrInvalidFXRate)
+	}
+
+	return fxRate, rate, nil
+}
+
+// calculateConversion applies FX rate to amount and truncates the result to
+// the destination currency's scale, so a conversion never credits more than
+// the rate allows. The sub-unit remainder stays with the platform.
+func (r *transactionRepo) calculateConversion(amount, rate decimal.Decimal, dest *domain.Currency) decimal.Decimal {
+	return dest.Truncate(amount.Mul(rate))
+}
+
+// getCurrency fetches currency metadata (scale) for rounding amounts
+func (r *transactionRepo) getCurrency(ctx context.Context, code string) (*domain.Currency, error) {
+	currency, err := r.currencyRepo.GetCurrency(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get currency %s: %w", code, err)
+	}
+	return currency, nil
 }
 
 // ========================================
@@ -522,7 +778,13 @@ func (r *transactionRepo) ProcessTradeWin(
 		TransactionType:     domain.TransactionTypeTrade,
 	}
 
-	return r.Credit(ctx, creditReq)
+	txReq, err := r.buildCreditRequest(ctx, creditReq)
+	if err != nil {
+		return nil, err
+	}
+	txReq.Events = []*domain.TransactionEvent{tradeEvent(req, "win")}
+
+	return r.ExecuteTransaction(ctx, txReq)
 }
 
 // ProcessTradeLoss debits user account for trade loss
@@ -559,7 +821,32 @@ func (r *transactionRepo) ProcessTradeLoss(
 		Metadata:            metadata,
 		TransactionType:     domain.TransactionTypeTrade,
 	}
-	return r.Debit(ctx, debitReq)
+
+	txReq, err := r.buildDebitRequest(ctx, debitReq)
+	if err != nil {
+		return nil, err
+	}
+	txReq.Events = []*domain.TransactionEvent{tradeEvent(req, "loss")}
+
+	return r.ExecuteTransaction(ctx, txReq)
+}
+
+// tradeEvent builds the trade.win / trade.loss event of a trade
+func tradeEvent(req *domain.TradeRequest, result string) *domain.TransactionEvent {
+	return &domain.TransactionEvent{
+		EventType:       fmt.Sprintf("trade.%s", result),
+		UserID:          req.CreatedByExternalID,
+		TransactionType: "trade",
+		Status:          "completed",
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+		AccountNumber:   req.AccountNumber,
+		Metadata: map[string]interface{}{
+			"trade_id":     req.TradeID,
+			"trade_type":   req.TradeType,
+			"trade_result": result,
+		},
+	}
 }
 
 // ========================================
@@ -690,6 +977,11 @@ func (r *transactionRepo) ExecuteTransactionOptimistic(
 		return nil, err
 	}
 
+	// Limit usage counts only if the journal commits
+	if err := r.reserveLimits(ctx, tx, req); err != nil {
+		return nil, err
+	}
+
 	// Create ledgers
 	ledgers, err := r.createLedgers(ctx, tx, journal.ID, accountMap, req)
 	if err != nil {
@@ -706,15 +998,23 @@ func (r *transactionRepo) ExecuteTransactionOptimistic(
 		return nil, err
 	}
 
+	// Completion event commits (or rolls back) with the journal
+	if err := r.createCompletedEvent(ctx, tx, journal, ledgers, req); err != nil {
+		return nil, err
+	}
+	if err := r.createRequestEvents(ctx, tx, journal, accountMap, ledgers, req); err != nil {
+		return nil, err
+	}
+
 	// Commit
 	if err := tx.Commit(ctx); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
-	var payableAmount float64 = 0
+	payableAmount := decimal.Zero
 
 	for _, l := range req.Entries  {
 		if l.DrCr == domain.DrCrCredit && !l.IsFee {
-			payableAmount += l.Amount
+			payableAmount = payableAmount.Add(l.Amount)
 		}
 	}
 
@@ -770,6 +1070,11 @@ func (r *transactionRepo) ExecuteTransactionPessimistic(
 		return nil, err
 	}
 
+	// Limit usage counts only if the journal commits
+	if err := r.reserveLimits(ctx, tx, req); err != nil {
+		return nil, err
+	}
+
 	// Create ledgers with balance_after
 	ledgers, err := r.createLedgersWithBalance(ctx, tx, journal.ID, accountMap, balanceMap, req)
 	if err != nil {
@@ -786,15 +1091,23 @@ func (r *transactionRepo) ExecuteTransactionPessimistic(
 		return nil, err
 	}
 
+	// Completion event commits (or rolls back) with the journal
+	if err := r.createCompletedEvent(ctx, tx, journal, ledgers, req); err != nil {
+		return nil, err
+	}
+	if err := r.createRequestEvents(ctx, tx, journal, accountMap, ledgers, req); err != nil {
+		return nil, err
+	}
+
 	// Commit
 	if err := tx.Commit(ctx); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
-	var payableAmount float64 = 0
+	payableAmount := decimal.Zero
 
 	for _, l := range req.Entries  {
 		if l.DrCr == domain.DrCrCredit && !l.IsFee {
-			payableAmount += l.Amount
+			payableAmount = payableAmount.Add(l.Amount)
 		}
 	}
 
@@ -809,12 +1122,111 @@ func (r *transactionRepo) ExecuteTransactionPessimistic(
 // HELPER METHODS
 // ========================================
 
+// createCompletedEvent writes the transaction.completed outbox event
+func (r *transactionRepo) createCompletedEvent(
+	ctx context.Context,
+	tx pgx.Tx,
+	journal *domain.Journal,
+	ledgers []*domain.Ledger,
+	req *domain.TransactionRequest,
+) error {
+	event := &domain.TransactionEvent{
+		EventType:       "transaction.completed",
+		UserID:          ptrStrToStr(req.CreatedByExternalID),
+		ReceiptCode:     ptrStrToStr(journal.ExternalRef),
+		TransactionID:   journal.ID,
+		TransactionType: string(req.TransactionType),
+		Status:          "completed",
+		Fee:             decimal.Zero,
+	}
+
+	for _, l := range ledgers {
+		if l.DrCr == domain.DrCrDebit {
+			event.Amount = l.Amount
+			event.Currency = l.Currency
+			if l.BalanceAfter != nil {
+				event.BalanceAfter = *l.BalanceAfter
+			}
+			break
+		}
+	}
+
+	for _, entry := range req.Entries {
+		switch {
+		case entry.IsFee && entry.DrCr == domain.DrCrCredit:
+			event.Fee = event.Fee.Add(entry.Amount)
+		case entry.IsFee:
+		case entry.DrCr == domain.DrCrDebit && event.FromAccount == "":
+			event.FromAccount = entry.AccountNumber
+		case entry.DrCr == domain.DrCrCredit && event.ToAccount == "":
+			event.ToAccount = entry.AccountNumber
+		}
+	}
+
+	return r.createEvent(ctx, tx, event)
+}
+
+// reserveLimits adds the request's limit usage to the counters in tx
+func (r *transactionRepo) reserveLimits(ctx context.Context, tx pgx.Tx, req *domain.TransactionRequest) error {
+	if req.LimitReservation == nil {
+		return nil
+	}
+	return r.limitRepo.Reserve(ctx, tx, req.LimitReservation.Usage)
+}
+
+// createRequestEvents writes the events attached to req, completed with
+// what is only known once the journal is posted
+func (r *transactionRepo) createRequestEvents(
+	ctx context.Context,
+	tx pgx.Tx,
+	journal *domain.Journal,
+	accountMap map[string]*domain.Account,
+	ledgers []*domain.Ledger,
+	req *domain.TransactionRequest,
+) error {
+	for _, event := range req.Events {
+		event.TransactionID = journal.ID
+		if event.ReceiptCode == "" {
+			event.ReceiptCode = ptrStrToStr(journal.ExternalRef)
+		}
+		if account, ok := accountMap[event.AccountNumber]; ok {
+			for _, l := range ledgers {
+				if l.AccountID == account.ID && l.BalanceAfter != nil {
+					event.BalanceAfter = *l.BalanceAfter
+				}
+			}
+		}
+
+		if err := r.createEvent(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createEvent writes event to the outbox in tx, so it is relayed if and
+// only if tx commits
+func (r *transactionRepo) createEvent(ctx context.Context, tx pgx.Tx, event *domain.TransactionEvent) error {
+	outboxEvent, err := domain.NewTransactionOutboxEvent(event)
+	if err != nil {
+		return err
+	}
+	return r.outboxRepo.Create(ctx, tx, outboxEvent)
+}
+
 // createJournal creates a journal entry
 func (r *transactionRepo) createJournal(
 	ctx context.Context,
 	tx pgx.Tx,
 	req *domain.TransactionRequest,
 ) (*domain.Journal, error) {
+	postedAt, err := r.resolvePostingDate(ctx, tx, req.PostedAt)
+	if err != nil {
+		return nil, err
+	}
+	// Ledgers created later in this transaction reuse the same accounting date
+	req.PostedAt = &postedAt
+
 	journalCreate := &domain.JournalCreate{
 		IdempotencyKey:      req.IdempotencyKey,
 		TransactionType:     req.TransactionType,
@@ -825,6 +1237,7 @@ func (r *transactionRepo) createJournal(
 		CreatedByType:       req.CreatedByType,
 		IPAddress:           req.IPAddress,
 		UserAgent:           req.UserAgent,
+		PostedAt:            req.PostedAt,
 	}
 
 	journal, err := r.journalRepo.Create(ctx, tx, journalCreate)
@@ -839,6 +1252,41 @@ func (r *transactionRepo) createJournal(
 	return journal, nil
 }
 
+// resolvePostingDate returns the accounting date of a posting and rejects dates
+// inside a closed (or closing) period. The shared advisory lock makes a
+// concurrent CloseAccountingPeriod wait for in-flight postings, and postings
+// wait for the close to commit before reading the watermark.
+func (r *transactionRepo) resolvePostingDate(
+	ctx context.Context,
+	tx pgx.Tx,
+	requested *time.Time,
+) (time.Time, error) {
+	now := time.Now()
+	postedAt := now
+	if requested != nil {
+		if requested.After(now) {
+			return time.Time{}, fmt.Errorf("%w: posting date cannot be in the future", xerrors.ErrInvalidInput)
+		}
+		postedAt = *requested
+	}
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock_shared(hashtext($1))`, periodCloseLockKey); err != nil {
+		return time.Time{}, fmt.Errorf("failed to acquire period lock: %w", err)
+	}
+
+	var closedThrough *time.Time
+	if err := tx.QueryRow(ctx, `SELECT MAX(period_end) FROM accounting_periods`).Scan(&closedThrough); err != nil {
+		return time.Time{}, fmt.Errorf("failed to check closed periods: %w", err)
+	}
+
+	if closedThrough != nil && postedAt.Before(*closedThrough) {
+		return time.Time{}, fmt.Errorf("%w: postings before %s are not allowed",
+			xerrors.ErrAccountingPeriodClosed, closedThrough.Format(time.RFC3339))
+	}
+
+	return postedAt, nil
+}
+
 // fetchAccountsOptimistic fetches accounts without locking
 func (r *transactionRepo) fetchAccountsOptimistic(
 	ctx context.Context,
@@ -858,7 +1306,8 @@ func (r *transactionRepo) fetchAccountsOptimistic(
 			return nil, nil, fmt.Errorf("account %s not found: %w", entry.AccountNumber, err)
 		}
 
-		if err := r.validateAccount(account, req.AccountType); err != nil {
+		debit, credit := postingDirections(req.Entries, entry.AccountNumber)
+		if err := r.validateAccount(ctx, tx, account, req.AccountType, debit, credit); err != nil {
 			return nil, nil, fmt.Errorf("account %s: %w", entry.AccountNumber, err)
 		}
 
@@ -904,7 +1353,8 @@ func (r *transactionRepo) lockAccountsPessimistic(
 			return nil, nil, fmt.Errorf("account %s not found: %w", accountNumber, err)
 		}
 
-		if err := r.validateAccount(account, req.AccountType); err != nil {
+		debit, credit := postingDirections(req.Entries, accountNumber)
+		if err := r.validateAccount(ctx, tx, account, req.AccountType, debit, credit); err != nil {
 			return nil, nil, fmt.Errorf("account %s: %w", accountNumber, err)
 		}
 
@@ -920,18 +1370,53 @@ func (r *transactionRepo) lockAccountsPessimistic(
 	return accountMap, balanceMap, nil
 }
 
-// validateAccount checks account status
-func (r *transactionRepo) validateAccount(account *domain.Account, requiredType domain.AccountType) error {
+// validateAccount checks account status and the freezes and closure in
+// force for the directions the account is posted in. The account's
+// restrictions are loaded onto it so the balance checks can honour liens.
+func (r *transactionRepo) validateAccount(
+	ctx context.Context,
+	tx pgx.Tx,
+	account *domain.Account,
+	requiredType domain.AccountType,
+	debit, credit bool,
+) error {
+	restrictions, err := listActiveRestrictions(ctx, tx, account.ID)
+	if err != nil {
+		return err
+	}
+	account.Restrictions = domain.NewAccountRestrictionState(restrictions)
+
+	// Closure is checked first: a closed account is also inactive
+	if account.Restrictions.Closed {
+		return xerrors.ErrAccountClosed
+	}
 	if !account.IsActive {
 		return xerrors.ErrAccountInactive
 	}
 	if account.IsLocked {
 		return xerrors.ErrAccountLocked
 	}
+	// Demo and real money never meet in one journal
 	if account.AccountType != requiredType {
-		return errors.New("account type mismatch")
+		return fmt.Errorf("%w: %s account %s in a %s journal",
+			xerrors.ErrDemoRealAccountMix, account.AccountType, account.AccountNumber, requiredType)
 	}
-	return nil
+	return account.Restrictions.CheckPosting(debit, credit)
+}
+
+// postingDirections reports whether entries debit and/or credit the account
+func postingDirections(entries []*domain.LedgerEntryRequest, accountNumber string) (debit, credit bool) {
+	for _, entry := range entries {
+		if entry.AccountNumber != accountNumber {
+			continue
+		}
+		if entry.DrCr == domain.DrCrDebit {
+			debit = true
+		} else {
+			credit = true
+		}
+	}
+	return debit, credit
 }
 
 // validateBalances checks sufficient balance for debits
@@ -951,11 +1436,13 @@ func (r *transactionRepo) validateBalances(
 			return fmt.Errorf("failed to get balance: %w", err)
 		}
 
-		availableWithOverdraft := balance.AvailableBalance + account.OverdraftLimit
-		if availableWithOverdraft < entry.Amount {
-			return fmt.Errorf("account %s: %w (available: %.2f, required: %.2f)",
+		// Liened funds stay in the balance but cannot be debited
+		liened := account.Restrictions.Liened()
+		availableWithOverdraft := balance.AvailableBalance.Sub(liened).Add(account.OverdraftLimit)
+		if availableWithOverdraft.LessThan(entry.Amount) {
+			return fmt.Errorf("account %s: %w (available: %s, liened: %s, required: %s)",
 				entry.AccountNumber, xerrors.ErrInsufficientBalance,
-				balance.AvailableBalance, entry.Amount)
+				balance.AvailableBalance, liened, entry.Amount)
 		}
 	}
 	return nil
@@ -975,11 +1462,13 @@ func (r *transactionRepo) validateBalancesPessimistic(
 		account := accountMap[entry.AccountNumber]
 		balance := balanceMap[account.ID]
 
-		availableWithOverdraft := balance.AvailableBalance + account.OverdraftLimit
-		if availableWithOverdraft < entry.Amount {
-			return fmt.Errorf("account %s: %w (available: %.2f, required: %.2f)",
+		// Liened funds stay in the balance but cannot be debited
+		liened := account.Restrictions.Liened()
+		availableWithOverdraft := balance.AvailableBalance.Sub(liened).Add(account.OverdraftLimit)
+		if availableWithOverdraft.LessThan(entry.Amount) {
+			return fmt.Errorf("account %s: %w (available: %s, liened: %s, required: %s)",
 				entry.AccountNumber, xerrors.ErrInsufficientBalance,
-				balance.AvailableBalance, entry.Amount)
+				balance.AvailableBalance, liened, entry.Amount)
 		}
 	}
 	return nil
@@ -1013,6 +1502,7 @@ func (r *transactionRepo) createLedgers(
 			ReceiptCode: entry.ReceiptCode,
 			Description: entry.Description,
 			Metadata:    metadata,
+			PostedAt:    req.PostedAt,
 		}
 
 		ledger, err := r.ledgerRepo.Create(ctx, tx, ledgerCreate)
@@ -1042,11 +1532,11 @@ func (r *transactionRepo) createLedgersWithBalance(
 		balance := balanceMap[account.ID]
 
 		// Calculate new balance
-		var newBalance float64
+		var newBalance decimal.Decimal
 		if entry.DrCr == domain.DrCrCredit {
-			newBalance = balance.Balance + entry.Amount
+			newBalance = balance.Balance.Add(entry.Amount)
 		} else {
-			newBalance = balance.Balance - entry.Amount
+			newBalance = balance.Balance.Sub(entry.Amount)
 		}
 
 		metadata, err := marshalMetadata(entry.Metadata)
@@ -1065,6 +1555,7 @@ func (r *transactionRepo) createLedgersWithBalance(
 			BalanceAfter: &newBalance,
 			Description:  entry.Description,
 			Metadata:     metadata,
+			PostedAt:     req.PostedAt,
 		}
 
 		ledger, err := r.ledgerRepo.Create(ctx, tx, ledgerCreate)
@@ -1077,9 +1568,9 @@ func (r *transactionRepo) createLedgersWithBalance(
 		// Update local balance for next entry
 		balance.Balance = newBalance
 		if entry.DrCr == domain.DrCrCredit {
-			balance.AvailableBalance += entry.Amount
+			balance.AvailableBalance = balance.AvailableBalance.Add(entry.Amount)
 		} else {
-			balance.AvailableBalance -= entry.Amount
+			balance.AvailableBalance = balance.AvailableBalance.Sub(entry.Amount)
 		}
 	}
 
@@ -1149,6 +1640,10 @@ func (r *transactionRepo) createFees(
 	if r.feeRepo == nil {
 		return nil // Fee repo not configured
 	}
+	// Fee and commission accounts are real; demo journals are never charged
+	if req.AccountType == domain.AccountTypeDemo {
+		return nil
+	}
 
 	// 🔥 FIX: Safely determine receipt code with proper nil checks
 	var receiptCode string
@@ -1178,7 +1673,7 @@ func (r *transactionRepo) createFees(
 	}
 
 	// Calculate and create fees for non-system transactions
-	totalFee := 0.0
+	totalFee := decimal.Zero
 	if req.TransactionFee == nil {
 		r.logger.Info("no transaction fee specified, skipping fee creation",
 			zap.String("receipt_code", receiptCode))
@@ -1188,7 +1683,7 @@ func (r *transactionRepo) createFees(
 	}
 
 	// Create platform fee record
-	if totalFee > 0 {
+	if totalFee.IsPositive() {
 		platformFee := req.TransactionFee
 		if err := r.feeRepo. Create(ctx, tx, platformFee); err != nil {
 			return fmt.Errorf("failed to create platform fee: %w", err)
@@ -1215,7 +1710,7 @@ func (r *transactionRepo) processAgentCommissionForTransaction(
 	tx pgx.Tx,
 	req *domain.TransactionRequest,
 	receiptCode string,
-	platformFee float64,
+	platformFee decimal.Decimal,
 ) error {
 	if req.AgentExternalID == nil || *req.AgentExternalID == "" {
 		return nil
@@ -1227,9 +1722,9 @@ func (r *transactionRepo) processAgentCommissionForTransaction(
 	r.logger.Info("agent commission processed",
 		zap.String("agent_id", *req.AgentExternalID),
 		zap.String("receipt_code", receiptCode),
-		zap.Float64("commission_amount", commissionAmount),
+		zap.String("commission_amount", commissionAmount.String()),
 		zap.Int64("commission_id", commissionID),
-		zap.Float64("platform_fee", platformFee),
+		zap.String("platform_fee", platformFee.String()),
 	)
 
 	return nil
@@ -1242,20 +1737,20 @@ func (r *transactionRepo) tryCreateAgentCommission(
 	tx pgx.Tx,
 	req *domain.TransactionRequest,
 	receiptCode string,
-) (float64, int64) {
+) (decimal.Decimal, int64) {
 	// Get agent
 	agent, err := r.agent.GetAgentByAgentID(ctx, *req.AgentExternalID)
 	if err != nil {
 		r.logger.Warn("failed to get agent, skipping commission",
 			zap. Error(err),
 			zap.String("agent_id", *req.AgentExternalID))
-		return 0, 0
+		return decimal.Zero, 0
 	}
 
 	if !agent.IsActive {
 		r. logger.Warn("agent inactive, skipping commission",
 			zap.String("agent_id", *req.AgentExternalID))
-		return 0, 0
+		return decimal.Zero, 0
 	}
 
 	// ✅ Select commission rate based on transaction type
@@ -1303,24 +1798,32 @@ func (r *transactionRepo) tryCreateAgentCommission(
 		r.logger.Warn("no commission rate available, skipping commission",
 			zap.String("agent_id", *req.AgentExternalID),
 			zap.String("transaction_type", string(req.TransactionType)))
-		return 0, 0
+		return decimal.Zero, 0
 	}
 
 	r.logger.Info("using commission rate",
 		zap.String("agent_id", *req.AgentExternalID),
 		zap.String("transaction_type", string(req.TransactionType)),
 		zap.String("rate_type", rateType),
-		zap.Float64("rate", commissionRate.InexactFloat64()))
+		zap.String("rate", commissionRate.String()))
 
 	// Calculate commission
 	transactionAmount := r.getTransactionAmount(req)
-	if transactionAmount == 0 {
-		return 0, 0
+	if transactionAmount.IsZero() {
+		return decimal.Zero, 0
 	}
 
-	commissionAmount := r. calculateAgentCommission(transactionAmount, commissionRate. InexactFloat64())
-	if commissionAmount <= 0 {
-		return 0, 0
+	currency, err := r.getCurrency(ctx, req.GetCurrency())
+	if err != nil {
+		r.logger.Warn("failed to get currency, skipping commission",
+			zap.Error(err),
+			zap.String("agent_id", *req.AgentExternalID))
+		return decimal.Zero, 0
+	}
+
+	commissionAmount := r.calculateAgentCommission(transactionAmount, *commissionRate, currency)
+	if !commissionAmount.IsPositive() {
+		return decimal.Zero, 0
 	}
 
 	// Get user ID
@@ -1328,13 +1831,13 @@ func (r *transactionRepo) tryCreateAgentCommission(
 	if userExternalID == "" {
 		r.logger.Warn("could not determine user, skipping commission",
 			zap.String("agent_id", *req. AgentExternalID))
-		return 0, 0
+		return decimal.Zero, 0
 	}
 
 	if userExternalID == *req.AgentExternalID {
 		r.logger. Info("agent self-transaction, skipping commission",
 			zap. String("agent_id", *req.AgentExternalID))
-		return 0, 0
+		return decimal.Zero, 0
 	}
 
 	// Get accounts
@@ -1343,7 +1846,7 @@ func (r *transactionRepo) tryCreateAgentCommission(
 		r.logger. Warn("failed to get agent account, skipping commission",
 			zap.Error(err),
 			zap.String("agent_id", *req.AgentExternalID))
-		return 0, 0
+		return decimal.Zero, 0
 	}
 
 	userAccount, err := r.getUserAccountFromEntries(ctx, req)
@@ -1351,7 +1854,7 @@ func (r *transactionRepo) tryCreateAgentCommission(
 		r.logger.Warn("failed to get user account, skipping commission",
 			zap.Error(err),
 			zap.String("agent_id", *req.AgentExternalID))
-		return 0, 0
+		return decimal.Zero, 0
 	}
 
 	// ✅ Create commission with rate type tracking
@@ -1361,9 +1864,9 @@ func (r *transactionRepo) tryCreateAgentCommission(
 		AgentAccountID:    agentAccount.ID,
 		UserAccountID:     userAccount.ID,
 		ReceiptCode:       receiptCode,
-		TransactionAmount: decimal.NewFromFloat(transactionAmount),
+		TransactionAmount: transactionAmount,
 		CommissionRate:    *commissionRate, // ✅ Store the actual rate used
-		CommissionAmount:  decimal.NewFromFloat(commissionAmount),
+		CommissionAmount:  commissionAmount,
 		Currency:          req. GetCurrency(),
 		PaidOut:           false,
 	}
@@ -1373,14 +1876,14 @@ func (r *transactionRepo) tryCreateAgentCommission(
 		req.Metadata = make(map[string]interface{})
 	}
 	req.Metadata["commission_rate_type"] = rateType
-	req.Metadata["commission_rate"] = commissionRate.InexactFloat64()
+	req.Metadata["commission_rate"] = commissionRate.String()
 
 	commissionID, err := r. agent.CreateCommission(ctx, tx, commission)
 	if err != nil {
 		r.logger.Warn("failed to create commission, skipping",
 			zap.Error(err),
 			zap.String("agent_id", *req.AgentExternalID))
-		return 0, 0
+		return decimal.Zero, 0
 	}
 
 	r.logger.Info("agent commission created",
@@ -1388,21 +1891,21 @@ func (r *transactionRepo) tryCreateAgentCommission(
 		zap.Int64("commission_id", commissionID),
 		zap.String("transaction_type", string(req.TransactionType)),
 		zap.String("rate_type", rateType),
-		zap.Float64("rate", commissionRate.InexactFloat64()),
-		zap.Float64("amount", commissionAmount))
+		zap.String("rate", commissionRate.String()),
+		zap.String("amount", commissionAmount.String()))
 
 	return commissionAmount, commissionID
 }
 
 // getTransactionAmount calculates the transaction amount from entries
-func (r *transactionRepo) getTransactionAmount(req *domain.TransactionRequest) float64 {
+func (r *transactionRepo) getTransactionAmount(req *domain.TransactionRequest) decimal.Decimal {
 	// For transfers, use the debit amount (sender's amount)
 	// For other transactions, sum all debits
-	var totalAmount float64
+	totalAmount := decimal.Zero
 
 	for _, entry := range req. Entries {
 		if entry. DrCr == domain.DrCrDebit {
-			totalAmount += entry.Amount
+			totalAmount = totalAmount.Add(entry.Amount)
 		}
 	}
 
@@ -1410,12 +1913,12 @@ func (r *transactionRepo) getTransactionAmount(req *domain.TransactionRequest) f
 }
 
 // calculateAgentCommission calculates commission based on rate
-func (r *transactionRepo) calculateAgentCommission(transactionAmount float64, commissionRate float64) float64 {
+func (r *transactionRepo) calculateAgentCommission(transactionAmount, commissionRate decimal.Decimal, currency *domain.Currency) decimal.Decimal {
 	// Commission = Transaction Amount × Commission Rate
-	commission := transactionAmount * commissionRate
-	
-	// Round to 2 decimal places
-	return float64(int(commission*100+0.5)) / 100
+	commission := transactionAmount.Mul(commissionRate)
+
+	// Round to the currency's minor unit
+	return currency.Round(commission)
 }
 
 // getUserExternalIDFromTransaction extracts user ID from transaction request
@@ -1455,7 +1958,7 @@ func (r *transactionRepo) getUserAccountFromEntries(ctx context.Context, req *do
 }
 
 // ✅ calculateTransactionFee calculates platform fee (your existing fee logic)
-func (r *transactionRepo) calculateTransactionFee(ctx context.Context, req *domain.TransactionRequest) (float64, error) {
+func (r *transactionRepo) calculateTransactionFee(ctx context.Context, req *domain.TransactionRequest) (decimal.Decimal, error) {
 	// Your existing fee calculation logic here
 	// This would call your fee rule engine
 	
@@ -1463,8 +1966,8 @@ func (r *transactionRepo) calculateTransactionFee(ctx context.Context, req *doma
 	transactionAmount := r.getTransactionAmount(req)
 	
 	// Example: 1% platform fee
-	platformFeeRate := 0.01
-	fee := transactionAmount * platformFeeRate
+	platformFeeRate := decimal.NewFromFloat(0.01)
+	fee := transactionAmount.Mul(platformFeeRate)
 	
 	return fee, nil
 }
@@ -1474,6 +1977,13 @@ func ptrString(s string) *string {
 	return &s
 }
 
+func ptrStrToStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // GetByIdempotencyKey retrieves transaction by idempotency key
 func (r *transactionRepo) GetByIdempotencyKey(
 	ctx context.Context,
@@ -0,0 +1,704 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"accounting-service/internal/domain"
+
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// ========================================
+// REVERSALS & REFUNDS
+// ========================================
+
+// ReverseTransaction posts a full mirror image of the journal behind
+// req.OriginalReceiptCode, including its fee and agent commission records.
+// A journal is reversed at most once, and never after a partial refund.
+func (r *transactionRepo) ReverseTransaction(
+	ctx context.Context,
+	req *domain.ReversalRequest,
+) (*domain.ReversalResult, error) {
+	req.TransactionType = domain.TransactionTypeReversal
+	return r.executeReversal(ctx, req)
+}
+
+// RefundTransaction posts a pro-rata mirror image for req.Amount of the
+// original principal. Refunds may repeat until the principal is exhausted.
+func (r *transactionRepo) RefundTransaction(
+	ctx context.Context,
+	req *domain.ReversalRequest,
+) (*domain.ReversalResult, error) {
+	req.TransactionType = domain.TransactionTypeRefund
+	return r.executeReversal(ctx, req)
+}
+
+// executeReversal mirrors the original journal inside a single DB transaction.
+// The original journal row is locked FOR UPDATE so concurrent reversals of the
+// same receipt serialize on it and see each other's transaction_reversals rows.
+func (r *transactionRepo) executeReversal(
+	ctx context.Context,
+	req *domain.ReversalRequest,
+) (*domain.ReversalResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.ReceiptCode == nil || *req.ReceiptCode == "" {
+		return nil, xerrors.ErrInvalidReceiptCode
+	}
+
+	// Check idempotency
+	if req.IdempotencyKey != nil {
+		existing, err := r.getReversalByIdempotencyKey(ctx, *req.IdempotencyKey)
+		if err == nil {
+			return existing, nil
+		}
+		if !errors.Is(err, xerrors.ErrNotFound) {
+			return nil, fmt.Errorf("failed to check idempotency: %w", err)
+		}
+	}
+
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock original journal
+	original, err := r.lockJournalByReceipt(ctx, tx, req.OriginalReceiptCode)
+	if err != nil {
+		return nil, err
+	}
+	if !original.IsReversible() {
+		return nil, fmt.Errorf("%w: %s is itself a %s", xerrors.ErrTransactionNotReversible,
+			req.OriginalReceiptCode, original.TransactionType)
+	}
+
+	ledgers, err := r.ledgerRepo.ListByJournal(ctx, original.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ledgers) < 2 {
+		return nil, xerrors.ErrInsufficientEntries
+	}
+	sort.Slice(ledgers, func(i, j int) bool { return ledgers[i].ID < ledgers[j].ID })
+
+	principal := principalLedger(ledgers)
+	if principal == nil {
+		return nil, fmt.Errorf("%w: no debit leg on %s", xerrors.ErrTransactionNotReversible, req.OriginalReceiptCode)
+	}
+
+	// Guard against double reversal / over-refund
+	reversed, refunded, err := r.getReversedTotals(ctx, tx, original.ID)
+	if err != nil {
+		return nil, err
+	}
+	if reversed.IsPositive() {
+		return nil, xerrors.ErrTransactionAlreadyReversed
+	}
+	remaining := principal.Amount.Sub(refunded)
+
+	principalCurrency, err := r.getCurrency(ctx, principal.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	var amount decimal.Decimal
+	if req.IsFullReversal() {
+		if refunded.IsPositive() {
+			return nil, fmt.Errorf("%w: %s %s already refunded, refund the remaining %s instead",
+				xerrors.ErrTransactionNotReversible, refunded, principal.Currency, remaining)
+		}
+		amount = principal.Amount
+	} else {
+		amount = principalCurrency.Round(req.Amount)
+		if !amount.IsPositive() {
+			return nil, xerrors.ErrInvalidAmount
+		}
+		if amount.GreaterThan(remaining) {
+			return nil, fmt.Errorf("%w: requested %s, remaining %s %s",
+				xerrors.ErrRefundExceedsRemaining, amount, remaining, principal.Currency)
+		}
+	}
+
+	// Build mirror-image entries
+	txReq, err := r.buildReversalRequest(ctx, req, original, ledgers, principal, amount)
+	if err != nil {
+		return nil, err
+	}
+	if err := txReq.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid reversal request: %w", err)
+	}
+
+	// Create journal
+	journal, err := r.createJournal(ctx, tx, txReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lock accounts in deterministic order
+	accountMap, balanceMap, err := r.lockAccountsPessimistic(ctx, tx, txReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate balances
+	if err := r.validateBalancesPessimistic(accountMap, balanceMap, txReq); err != nil {
+		return nil, err
+	}
+
+	// Create ledgers with balance_after
+	newLedgers, err := r.createLedgersWithBalance(ctx, tx, journal.ID, accountMap, balanceMap, txReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update balances (already locked)
+	if err := r.updateBalancesPessimistic(ctx, tx, newLedgers); err != nil {
+		return nil, err
+	}
+
+	// Mirror fee and commission records
+	feeReversed, err := r.reverseFees(ctx, tx, req, amount, principal.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	commissionReversed, err := r.reverseAgentCommissions(ctx, tx, req, amount, principal.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	reversal := &domain.TransactionReversal{
+		OriginalJournalID:   original.ID,
+		OriginalReceiptCode: req.OriginalReceiptCode,
+		ReversalJournalID:   journal.ID,
+		ReversalReceiptCode: *req.ReceiptCode,
+		Kind:                req.TransactionType,
+		Amount:              amount,
+		Currency:            principal.Currency,
+		FeeReversed:         feeReversed,
+		CommissionReversed:  commissionReversed,
+		Reason:              reasonPtr(req.Reason),
+		CreatedByExternalID: &req.CreatedByExternalID,
+		CreatedByType:       &req.CreatedByType,
+	}
+	if err := r.createReversalRecord(ctx, tx, reversal); err != nil {
+		return nil, err
+	}
+
+	remainingAfter := remaining.Sub(amount)
+	err = r.createEvent(ctx, tx, &domain.TransactionEvent{
+		EventType:       fmt.Sprintf("transaction.%s", req.TransactionType),
+		UserID:          req.CreatedByExternalID,
+		ReceiptCode:     reversal.ReversalReceiptCode,
+		TransactionID:   journal.ID,
+		TransactionType: string(req.TransactionType),
+		Status:          "completed",
+		Amount:          amount,
+		Currency:        reversal.Currency,
+		Fee:             feeReversed.Neg(),
+		Metadata: map[string]interface{}{
+			"parent_receipt_code":  reversal.OriginalReceiptCode,
+			"commission_reversed":  commissionReversed.String(),
+			"refundable_remaining": remainingAfter.String(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.logger.Info("transaction reversed",
+		zap.String("kind", string(req.TransactionType)),
+		zap.String("original_receipt", req.OriginalReceiptCode),
+		zap.String("receipt_code", *req.ReceiptCode),
+		zap.String("amount", amount.String()),
+		zap.String("fee_reversed", feeReversed.String()),
+		zap.String("commission_reversed", commissionReversed.String()))
+
+	return &domain.ReversalResult{
+		Aggregate: &domain.LedgerAggregate{
+			Journal:       journal,
+			Ledgers:       newLedgers,
+			PayableAmount: amount,
+		},
+		Reversal:            reversal,
+		RefundableRemaining: remainingAfter,
+	}, nil
+}
+
+// principalLedger returns the first debit leg - the payer's principal that
+// refund amounts are expressed against
+func principalLedger(ledgers []*domain.Ledger) *domain.Ledger {
+	for _, l := range ledgers {
+		if l.DrCr == domain.DrCrDebit {
+			return l
+		}
+	}
+	return nil
+}
+
+// mirrorAmount scales an original amount to the reversed share of the principal
+func mirrorAmount(amount, reversed, principal decimal.Decimal, currency *domain.Currency) decimal.Decimal {
+	if reversed.Equal(principal) {
+		return amount
+	}
+	return currency.Round(amount.Mul(reversed).DivRound(principal, 18))
+}
+
+// buildReversalRequest flips every leg of the original journal. For refunds
+// each leg is scaled pro-rata and rounded to its currency; any rounding
+// residue in a currency that balanced originally is absorbed by the largest
+// debit leg so the mirror balances exactly.
+func (r *transactionRepo) buildReversalRequest(
+	ctx context.Context,
+	req *domain.ReversalRequest,
+	original *domain.Journal,
+	ledgers []*domain.Ledger,
+	principal *domain.Ledger,
+	amount decimal.Decimal,
+) (*domain.TransactionRequest, error) {
+	label := "Reversal"
+	if !req.IsFullReversal() {
+		label = "Refund"
+	}
+	description := fmt.Sprintf("%s of %s", label, req.OriginalReceiptCode)
+	if req.Reason != "" {
+		description = fmt.Sprintf("%s: %s", description, req.Reason)
+	}
+
+	metadata := map[string]interface{}{
+		"parent_receipt_code": req.OriginalReceiptCode,
+		"original_journal_id": original.ID,
+		"reversal_type":       string(req.TransactionType),
+	}
+	if req.Reason != "" {
+		metadata["reason"] = req.Reason
+	}
+
+	type currencyTotals struct {
+		origDebits, origCredits decimal.Decimal
+	}
+	totals := make(map[string]*currencyTotals)
+
+	entries := make([]*domain.LedgerEntryRequest, 0, len(ledgers))
+	for _, l := range ledgers {
+		account, err := r.accountRepo.GetByID(ctx, l.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get account %d: %w", l.AccountID, err)
+		}
+
+		t, ok := totals[l.Currency]
+		if !ok {
+			t = &currencyTotals{}
+			totals[l.Currency] = t
+		}
+		if l.DrCr == domain.DrCrDebit {
+			t.origDebits = t.origDebits.Add(l.Amount)
+		} else {
+			t.origCredits = t.origCredits.Add(l.Amount)
+		}
+
+		legAmount := amount
+		if l.ID != principal.ID {
+			currency, err := r.getCurrency(ctx, l.Currency)
+			if err != nil {
+				return nil, err
+			}
+			legAmount = mirrorAmount(l.Amount, amount, principal.Amount, currency)
+		}
+
+		drCr := domain.DrCrCredit
+		if l.DrCr == domain.DrCrCredit {
+			drCr = domain.DrCrDebit
+		}
+
+		entries = append(entries, &domain.LedgerEntryRequest{
+			AccountNumber: account.AccountNumber,
+			Amount:        legAmount,
+			DrCr:          drCr,
+			Currency:      l.Currency,
+			ReceiptCode:   req.ReceiptCode,
+			Description:   strPtr(description),
+			Metadata:      metadata,
+		})
+	}
+
+	if !req.IsFullReversal() {
+		for code, t := range totals {
+			if !t.origDebits.Equal(t.origCredits) {
+				continue // conversions never balanced per currency
+			}
+			if err := balanceMirroredEntries(entries, code); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Legs that rounded away entirely carry no value
+	kept := entries[:0]
+	for _, e := range entries {
+		if e.Amount.IsPositive() {
+			kept = append(kept, e)
+		}
+	}
+
+	return &domain.TransactionRequest{
+		IdempotencyKey:      req.IdempotencyKey,
+		TransactionType:     req.TransactionType,
+		AccountType:         original.AccountType,
+		ExternalRef:         req.ReceiptCode,
+		Description:         strPtr(description),
+		CreatedByExternalID: &req.CreatedByExternalID,
+		CreatedByType:       &req.CreatedByType,
+		IsSystemTransaction: true, // fees are mirrored, never charged
+		ReceiptCode:         req.ReceiptCode,
+		Entries:             kept,
+		Metadata:            metadata,
+		GenerateReceipt:     true,
+	}, nil
+}
+
+// balanceMirroredEntries moves the rounding residue of one currency onto its
+// largest debit leg
+func balanceMirroredEntries(entries []*domain.LedgerEntryRequest, currency string) error {
+	debits, credits := decimal.Zero, decimal.Zero
+	var largestDebit *domain.LedgerEntryRequest
+
+	for _, e := range entries {
+		if e.Currency != currency {
+			continue
+		}
+		if e.DrCr == domain.DrCrDebit {
+			debits = debits.Add(e.Amount)
+			if largestDebit == nil || e.Amount.GreaterThan(largestDebit.Amount) {
+				largestDebit = e
+			}
+		} else {
+			credits = credits.Add(e.Amount)
+		}
+	}
+
+	residue := credits.Sub(debits)
+	if residue.IsZero() {
+		return nil
+	}
+	if largestDebit == nil || !largestDebit.Amount.Add(residue).IsPositive() {
+		return fmt.Errorf("%w: cannot absorb %s %s residue", xerrors.ErrLedgerNotBalanced, residue, currency)
+	}
+
+	largestDebit.Amount = largestDebit.Amount.Add(residue)
+	return nil
+}
+
+// reverseFees records negative fee rows against the new receipt so fee
+// revenue reports net out the reversed share. Returns the total reversed.
+func (r *transactionRepo) reverseFees(
+	ctx context.Context,
+	tx pgx.Tx,
+	req *domain.ReversalRequest,
+	amount, principal decimal.Decimal,
+) (decimal.Decimal, error) {
+	if r.feeRepo == nil {
+		return decimal.Zero, nil
+	}
+
+	fees, err := r.feeRepo.GetByReceipt(ctx, req.OriginalReceiptCode)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get original fees: %w", err)
+	}
+
+	total := decimal.Zero
+	for _, fee := range fees {
+		if !fee.Amount.IsPositive() {
+			continue
+		}
+
+		currency, err := r.getCurrency(ctx, fee.Currency)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		reversedAmount := mirrorAmount(fee.Amount, amount, principal, currency)
+		if reversedAmount.IsZero() {
+			continue
+		}
+
+		mirror := &domain.TransactionFee{
+			ReceiptCode:          *req.ReceiptCode,
+			FeeRuleID:            fee.FeeRuleID,
+			FeeRuleVersionID:     fee.FeeRuleVersionID,
+			FeeType:              fee.FeeType,
+			Amount:               reversedAmount.Neg(),
+			Currency:             fee.Currency,
+			CollectedByAccountID: fee.CollectedByAccountID,
+			AgentExternalID:      fee.AgentExternalID,
+			CommissionRate:       fee.CommissionRate,
+		}
+		if err := r.feeRepo.Create(ctx, tx, mirror); err != nil {
+			return decimal.Zero, fmt.Errorf("failed to reverse %s fee: %w", fee.FeeType, err)
+		}
+
+		total = total.Add(reversedAmount)
+	}
+
+	return total, nil
+}
+
+// reverseAgentCommissions records a negative (clawback) commission for every
+// commission accrued on the original receipt. Clawbacks are left unpaid so
+// they net against the agent's next payout, whether or not the original
+// commission was already paid out. Returns the total clawed back.
+func (r *transactionRepo) reverseAgentCommissions(
+	ctx context.Context,
+	tx pgx.Tx,
+	req *domain.ReversalRequest,
+	amount, principal decimal.Decimal,
+) (decimal.Decimal, error) {
+	if r.agent == nil {
+		return decimal.Zero, nil
+	}
+
+	commissions, err := r.agent.ListCommissionsByReceipt(ctx, req.OriginalReceiptCode)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get original commissions: %w", err)
+	}
+
+	total := decimal.Zero
+	for _, c := range commissions {
+		if !c.CommissionAmount.IsPositive() {
+			continue
+		}
+
+		currency, err := r.getCurrency(ctx, c.Currency)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		clawback := mirrorAmount(c.CommissionAmount, amount, principal, currency)
+		if clawback.IsZero() {
+			continue
+		}
+
+		mirror := &domain.AgentCommission{
+			AgentExternalID:   c.AgentExternalID,
+			UserExternalID:    c.UserExternalID,
+			AgentAccountID:    c.AgentAccountID,
+			UserAccountID:     c.UserAccountID,
+			ReceiptCode:       *req.ReceiptCode,
+			TransactionAmount: mirrorAmount(c.TransactionAmount, amount, principal, currency).Neg(),
+			CommissionRate:    c.CommissionRate,
+			CommissionAmount:  clawback.Neg(),
+			Currency:          c.Currency,
+			PaidOut:           false,
+		}
+		if _, err := r.agent.CreateCommission(ctx, tx, mirror); err != nil {
+			return decimal.Zero, fmt.Errorf("failed to claw back commission %d: %w", c.ID, err)
+		}
+
+		total = total.Add(clawback)
+	}
+
+	return total, nil
+}
+
+// lockJournalByReceipt finds the journal behind a receipt and locks it.
+// Ledger receipt codes are authoritative; journals.external_ref is the fallback.
+func (r *transactionRepo) lockJournalByReceipt(
+	ctx context.Context,
+	tx pgx.Tx,
+	receiptCode string,
+) (*domain.Journal, error) {
+	query := `
+		SELECT
+			id, idempotency_key, transaction_type, account_type, external_ref,
+			description, created_by_external_id, created_by_type,
+			ip_address, user_agent, created_at
+		FROM journals
+		WHERE id = COALESCE(
+			(SELECT journal_id FROM ledgers WHERE receipt_code = $1 ORDER BY id LIMIT 1),
+			(SELECT id FROM journals WHERE external_ref = $1 ORDER BY id LIMIT 1)
+		)
+		FOR UPDATE
+	`
+
+	var j domain.Journal
+	err := tx.QueryRow(ctx, query, receiptCode).Scan(
+		&j.ID,
+		&j.IdempotencyKey,
+		&j.TransactionType,
+		&j.AccountType,
+		&j.ExternalRef,
+		&j.Description,
+		&j.CreatedByExternalID,
+		&j.CreatedByType,
+		&j.IPAddress,
+		&j.UserAgent,
+		&j.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, xerrors.ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to lock journal: %w", err)
+	}
+
+	return &j, nil
+}
+
+// getReversedTotals returns the principal already returned by full reversals and by refunds
+func (r *transactionRepo) getReversedTotals(
+	ctx context.Context,
+	tx pgx.Tx,
+	journalID int64,
+) (reversed, refunded decimal.Decimal, err error) {
+	query := `
+		SELECT
+			COALESCE(SUM(amount) FILTER (WHERE kind = 'reversal'), 0),
+			COALESCE(SUM(amount) FILTER (WHERE kind = 'refund'), 0)
+		FROM transaction_reversals
+		WHERE original_journal_id = $1
+	`
+
+	if err = tx.QueryRow(ctx, query, journalID).Scan(&reversed, &refunded); err != nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("failed to get reversed totals: %w", err)
+	}
+	return reversed, refunded, nil
+}
+
+// createReversalRecord links the reversal journal to the original
+func (r *transactionRepo) createReversalRecord(
+	ctx context.Context,
+	tx pgx.Tx,
+	rev *domain.TransactionReversal,
+) error {
+	query := `
+		INSERT INTO transaction_reversals (
+			original_journal_id, original_receipt_code, reversal_journal_id, reversal_receipt_code,
+			kind, amount, currency, fee_reversed, commission_reversed,
+			reason, created_by_external_id, created_by_type
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id, created_at
+	`
+
+	err := tx.QueryRow(ctx, query,
+		rev.OriginalJournalID,
+		rev.OriginalReceiptCode,
+		rev.ReversalJournalID,
+		rev.ReversalReceiptCode,
+		rev.Kind,
+		rev.Amount,
+		rev.Currency,
+		rev.FeeReversed,
+		rev.CommissionReversed,
+		rev.Reason,
+		rev.CreatedByExternalID,
+		rev.CreatedByType,
+	).Scan(&rev.ID, &rev.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			// uniq_full_reversal: a concurrent reversal won the race
+			return xerrors.ErrTransactionAlreadyReversed
+		}
+		return fmt.Errorf("failed to create reversal record: %w", err)
+	}
+
+	return nil
+}
+
+// getReversalByIdempotencyKey returns a previously posted reversal for the key
+func (r *transactionRepo) getReversalByIdempotencyKey(
+	ctx context.Context,
+	key string,
+) (*domain.ReversalResult, error) {
+	aggregate, err := r.GetByIdempotencyKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT
+			id, original_journal_id, original_receipt_code, reversal_journal_id, reversal_receipt_code,
+			kind, amount, currency, fee_reversed, commission_reversed,
+			reason, created_by_external_id, created_by_type, created_at
+		FROM transaction_reversals
+		WHERE reversal_journal_id = $1
+	`
+
+	var rev domain.TransactionReversal
+	err = r.db.QueryRow(ctx, query, aggregate.Journal.ID).Scan(
+		&rev.ID,
+		&rev.OriginalJournalID,
+		&rev.OriginalReceiptCode,
+		&rev.ReversalJournalID,
+		&rev.ReversalReceiptCode,
+		&rev.Kind,
+		&rev.Amount,
+		&rev.Currency,
+		&rev.FeeReversed,
+		&rev.CommissionReversed,
+		&rev.Reason,
+		&rev.CreatedByExternalID,
+		&rev.CreatedByType,
+		&rev.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Key belongs to a journal that is not a reversal
+			return nil, xerrors.ErrDuplicateIdempotencyKey
+		}
+		return nil, fmt.Errorf("failed to get reversal: %w", err)
+	}
+
+	remaining, err := r.getRefundableRemaining(ctx, rev.OriginalJournalID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ReversalResult{
+		Aggregate:           aggregate,
+		Reversal:            &rev,
+		RefundableRemaining: remaining,
+	}, nil
+}
+
+// getRefundableRemaining returns the principal of a journal not yet reversed or refunded
+func (r *transactionRepo) getRefundableRemaining(ctx context.Context, journalID int64) (decimal.Decimal, error) {
+	query := `
+		SELECT p.amount - COALESCE(
+			(SELECT SUM(amount) FROM transaction_reversals WHERE original_journal_id = $1), 0)
+		FROM (
+			SELECT amount FROM ledgers
+			WHERE journal_id = $1 AND dr_cr = 'DR'
+			ORDER BY id LIMIT 1
+		) p
+	`
+
+	var remaining decimal.Decimal
+	if err := r.db.QueryRow(ctx, query, journalID).Scan(&remaining); err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get refundable remaining: %w", err)
+	}
+	return remaining, nil
+}
+
+func reasonPtr(reason string) *string {
+	if reason == "" {
+		return nil
+	}
+	return &reason
+}
@@ -96,6 +96,17 @@ func NewAccountingServer(cfg config.AppConfig) {
 	defer kafkaWriter.Close()
 	log.Println("✅ Kafka writer initialized")
 
+	// Admin actions taken here (freezes, liens, closures) go to audit-service.
+	// Synchronous and acked by all replicas: these are compliance records.
+	auditWriter := &kafka.Writer{
+		Addr:         kafka.TCP(cfg.KafkaBrokers...),
+		Topic:        publisher.AdminAuditTopic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}
+	defer auditWriter.Close()
+	auditPublisher := publisher.NewAuditPublisher(auditWriter)
+
 	// ===============================
 	// EXTERNAL SERVICE CLIENTS
 	// ===============================
@@ -138,11 +149,22 @@ func NewAccountingServer(cfg config.AppConfig) {
 	feeRuleRepo := repository.NewTransactionFeeRuleRepo(dbpool)
 	statementRepo := repository.NewStatementRepo(dbpool, ledgerRepo)
 	agentRepo := repository.NewAgentRepository(dbpool)
-	_ = currencyRepo  // Currently unused, but initialized for completeness
+	holdRepo := repository.NewHoldRepo(dbpool)
+	reconRepo := repository.NewReconciliationRepo(dbpool)
+	periodRepo := repository.NewPeriodRepo(dbpool)
+	outboxRepo := repository.NewOutboxRepo(dbpool)
+	queueRepo := repository.NewTransactionQueueRepo(dbpool)
+	payoutRepo := repository.NewCommissionPayoutRepo(dbpool)
+	limitRepo := repository.NewLimitRepo(dbpool)
+	scheduleRepo := repository.NewScheduledTransferRepo(dbpool)
+	interestRepo := repository.NewInterestRepo(dbpool)
+	restrictionRepo := repository.NewAccountRestrictionRepo(dbpool)
+	demoRefillRepo := repository.NewDemoRefillRepo(dbpool)
 	// Initialize repositories
     approvalRepo := repository.NewTransactionApprovalRepository(dbpool)
+    approvalPolicyRepo := repository.NewApprovalPolicyRepo(dbpool)
 
-	transactionRepo := repository.NewTransactionRepo(dbpool, accountRepo, journalRepo, ledgerRepo, balanceRepo, currencyRepo, feeRepo, agentRepo, logger)
+	transactionRepo := repository.NewTransactionRepo(dbpool, accountRepo, journalRepo, ledgerRepo, balanceRepo, currencyRepo, feeRepo, agentRepo, holdRepo, outboxRepo, limitRepo, cfg.FX.RateTTL, logger)
 
 	log.Println("✅ All repositories initialized")
 
@@ -206,6 +228,8 @@ func NewAccountingServer(cfg config.AppConfig) {
 		statementRepo,  // Repository for statement queries
 		accountRepo,    // Repository for account data
 		balanceRepo,    // Repository for balance data
+		periodRepo,     // Repository for accounting periods
+		interestRepo,   // Repository for interest accrued vs. paid
 		rdb,            // Redis for caching
 	)
 	log.Println("✅ Statement usecase initialized")
@@ -217,9 +241,13 @@ func NewAccountingServer(cfg config.AppConfig) {
 	)
 	log.Println("✅ Agent usecase initialized")
 	
+	// Debit limits are reserved during transaction pre-validation
+	limitUC := usecase.NewTransactionLimitUsecase(limitRepo, accountRepo)
+	log.Println("✅ Transaction limit usecase initialized")
+
 	// 7. Transaction Usecase - Main transaction processing
 	// This is the most complex usecase with many dependencies
-	pub := publisher.NewTransactionEventPublisher(rdb)
+	pub := publisher.NewTransactionEventPublisher(outboxRepo)
 	transactionUC := usecase.NewTransactionUsecase(
 		transactionRepo,    // Repository for transactions (or journalRepo)
 		accountRepo,        // Repository for account operations
@@ -227,9 +255,11 @@ func NewAccountingServer(cfg config.AppConfig) {
 		journalRepo,        // Repository for journal entries
 		ledgerRepo,         // Repository for ledger entries
 		feeRepo,            // Repository for fee records
+		queueRepo,          // Persistent queue for async transactions
 		accountUC,          // Account usecase for account operations
 		feeUC,              // Fee usecase for fee calculations
 		feeRuleUC,          // Fee rule usecase for fee rules
+		limitUC,            // Limit usecase for debit limits
 		authClient,         // Auth service for user verification
 		receiptCli,         // Receipt service for receipt generation
 		notificationCli,    // Notification service for notifications
@@ -242,7 +272,28 @@ func NewAccountingServer(cfg config.AppConfig) {
 	)
 	log.Println("✅ Transaction usecase initialized")
 	// Initialize usecases
-    approvalUC := usecase.NewTransactionApprovalUsecase(approvalRepo, transactionUC)
+    approvalUC := usecase.NewTransactionApprovalUsecase(approvalRepo, approvalPolicyRepo, transactionUC)
+
+	// Commission payouts post through the transaction usecase
+	payoutUC := usecase.NewCommissionPayoutUsecase(payoutRepo, accountUC, transactionUC, sf)
+
+	// Standing orders and future-dated transfers post through Transfer
+	scheduleUC := usecase.NewScheduledTransferUsecase(scheduleRepo, accountRepo, transactionUC)
+
+	// Interest is posted from the interest_expense system account through the transaction usecase
+	interestUC := usecase.NewInterestUsecase(interestRepo, accountUC, transactionUC)
+
+	// Freezes, liens and closure; closure sweeps through the transaction usecase
+	restrictionUC := usecase.NewAccountRestrictionUsecase(restrictionRepo, accountUC, transactionUC, auditPublisher)
+
+	// Demo top-ups and resets post demo_funding journals against the demo liquidity accounts
+	demoUC := usecase.NewDemoAccountUsecase(demoRefillRepo, accountRepo, currencyRepo, accountUC, transactionUC, cfg.Demo)
+	if err := demoUC.EnsureLiquidityAccounts(ctx); err != nil {
+		log.Printf("⚠️  Warning: demo liquidity accounts not ensured (created on first refill): %v", err)
+	}
+
+	// 8. Reconciliation Usecase - Ledger vs balance integrity checks
+	reconUC := usecase.NewReconciliationUsecase(reconRepo, rdb)
 
 	log.Println("✅ All usecases initialized successfully")
 
@@ -299,20 +350,91 @@ func NewAccountingServer(cfg config.AppConfig) {
 		log.Println("ℹ️  System seeding skipped (cfg.SeedOnStartup = false)")
 	}
 
+	// ===============================
+	// FX RATE INGESTION
+	// ===============================
+	// Providers are tried in order (FX_RATE_PROVIDERS, default "http"; add
+	// "static" only in development);
+	// conversions are refused once a rate is older than FX_RATE_TTL
+	rateProvider := service.NewRateProvider(cfg.FX.RateProviders, cfg.FX.RatesURL, cfg.FX.RatesAPIKey, cfg.FX.RatesFile)
+	if rateProvider != nil {
+		fxService := service.NewFXService(currencyRepo, rateProvider, cfg.FX)
+		fxScheduler := service.NewFXRateScheduler(fxService, cfg.FX.SyncInterval)
+		fxScheduler.Start()
+		defer fxScheduler.Stop()
+		log.Printf("✅ FX rate scheduler started (providers=%s, interval=%s, ttl=%s)",
+			rateProvider.Name(), cfg.FX.SyncInterval, cfg.FX.RateTTL)
+	} else {
+		log.Println("⚠️  No FX rate provider configured - fx_rates will not be refreshed")
+	}
+
+	// ===============================
+	// LEDGER RECONCILIATION
+	// ===============================
+	// Recomputes balances from ledgers and records breaks (RECONCILIATION_INTERVAL, 0 = manual only)
+	if cfg.ReconciliationInterval > 0 {
+		reconScheduler := usecase.NewReconciliationScheduler(reconUC, cfg.ReconciliationInterval)
+		reconScheduler.Start()
+		defer reconScheduler.Stop()
+		log.Printf("✅ Reconciliation scheduler started (interval=%s)", cfg.ReconciliationInterval)
+	} else {
+		log.Println("ℹ️  Scheduled reconciliation disabled (RECONCILIATION_INTERVAL=0)")
+	}
+
+	// ===============================
+	// EVENT OUTBOX RELAY
+	// ===============================
+	// Moves committed outbox_events rows to Redis streams (consumer groups read them)
+	outboxRelay := publisher.NewOutboxRelay(outboxRepo, rdb, cfg.Outbox.RelayInterval, cfg.Outbox.StreamMaxLen, cfg.Outbox.Retention)
+	outboxRelay.Start()
+	defer outboxRelay.Stop()
+	log.Printf("✅ Outbox relay started (interval=%s, maxlen=%d)", cfg.Outbox.RelayInterval, cfg.Outbox.StreamMaxLen)
+
+	// ===============================
+	// APPROVAL EXECUTOR
+	// ===============================
+	// Expires stale approval requests and executes approved ones (retried across restarts)
+	approvalUC.StartExecutor()
+	defer approvalUC.StopExecutor()
+	log.Printf("✅ Approval executor started (interval=%s)", usecase.ApprovalExecutorInterval)
+
+	// ===============================
+	// SCHEDULED TRANSFERS
+	// ===============================
+	// Pays due occurrences; each occurrence has its own idempotency key
+	scheduleUC.StartExecutor()
+	defer scheduleUC.StopExecutor()
+	log.Printf("✅ Scheduled transfer executor started (interval=%s)", usecase.ScheduledTransferExecutorInterval)
+
+	// ===============================
+	// INTEREST
+	// ===============================
+	// Accrues savings and investment interest daily and posts it monthly
+	interestUC.StartExecutor()
+	defer interestUC.StopExecutor()
+	log.Printf("✅ Interest executor started (interval=%s)", usecase.InterestExecutorInterval)
+
 	// ===============================
 	// GRPC HANDLER
 	// ===============================
 	// Initialize the gRPC handler with all usecases
 	accountingHandler := hgrpc.NewAccountingHandler(
 		accountUC,        // Account management (8 RPCs)
-		transactionUC,    // Transaction execution (5 RPCs)
-		statementUC,      // Statements & reports (6 RPCs)
+		transactionUC,    // Transaction execution & queue (8 RPCs)
+		statementUC,      // Statements & reports (10 RPCs)
 		journalUC,        // Journal queries (4 RPCs)
 		ledgerUC,         // Ledger queries (4 RPCs)
 		feeUC,            // Fee management (3 RPCs)
-		feeRuleUC,        // Fee rule management (covered in fee RPCs)
+		feeRuleUC,        // Fee rule versions and simulation (2 RPCs)
 		agentUc,
+		payoutUC,         // Commission payouts (2 RPCs)
+		limitUC,          // Transaction limits (5 RPCs)
+		scheduleUC,       // Scheduled transfers (4 RPCs)
+		interestUC,       // Interest rate plans, accrual and posting (6 RPCs)
+		restrictionUC,    // Account freezes, liens and closure (4 RPCs)
+		demoUC,           // Demo account top-up and reset (2 RPCs)
 		approvalUC,
+		reconUC,          // Reconciliation (2 RPCs)
 		rdb,              // Redis for health checks
 	)
 
@@ -356,11 +478,11 @@ func NewAccountingServer(cfg config.AppConfig) {
 	restHandler := hrest.NewAccountingRestHandler(
 		accountUC,        // Account management (8 RPCs)
 		transactionUC,    // Transaction execution (5 RPCs)
-		statementUC,      // Statements & reports (6 RPCs)
+		statementUC,      // Statements & reports (10 RPCs)
 		journalUC,        // Journal queries (4 RPCs)
 		ledgerUC,         // Ledger queries (4 RPCs)
 		feeUC,            // Fee management (3 RPCs)
-		feeRuleUC,        // Fee rule management (covered in fee RPCs)
+		feeRuleUC,        // Fee rule versions and simulation (2 RPCs)
 		agentUc,
 		approvalUC,
 	)
@@ -379,14 +501,16 @@ func NewAccountingServer(cfg config.AppConfig) {
 	log.Println("╚════════════════════════════════════════════════════════════╝")
 	log.Printf("🚀 Server listening on: %s", cfg.GRPCAddr)
 	log.Println("")
-	log.Println("📡 Available RPCs (28 total):")
-	log.Println("   ├─ Account Management (8 RPCs)")
+	log.Println("📡 Available RPCs (71 total):")
+	log.Println("   ├─ Account Management (10 RPCs)")
 	log.Println("   │  ├─ CreateAccount")
 	log.Println("   │  ├─ CreateAccounts")
 	log.Println("   │  ├─ GetAccount")
 	log.Println("   │  ├─ GetAccountsByOwner")
 	log.Println("   │  ├─ GetOrCreateUserAccounts")
 	log.Println("   │  ├─ UpdateAccount")
+	log.Println("   │  ├─ CreateSubAccount")
+	log.Println("   │  ├─ ListSubAccounts")
 	log.Println("   │  ├─ GetBalance")
 	log.Println("   │  └─ BatchGetBalances")
 	log.Println("   ├─ Transaction Execution (5 RPCs)")
@@ -395,18 +519,74 @@ func NewAccountingServer(cfg config.AppConfig) {
 	log.Println("   │  ├─ BatchExecuteTransactions")
 	log.Println("   │  ├─ GetTransactionStatus")
 	log.Println("   │  └─ GetTransactionByReceipt")
+	log.Println("   ├─ FX Quotes (1 RPC)")
+	log.Println("   │  └─ CreateFXQuote")
+	log.Println("   ├─ Reversals & Refunds (2 RPCs)")
+	log.Println("   │  ├─ ReverseTransaction")
+	log.Println("   │  └─ RefundTransaction")
+	log.Println("   ├─ Funds Holds (5 RPCs)")
+	log.Println("   │  ├─ PlaceHold")
+	log.Println("   │  ├─ CaptureHold")
+	log.Println("   │  ├─ ReleaseHold")
+	log.Println("   │  ├─ ExtendHold")
+	log.Println("   │  └─ ListHolds")
+	log.Println("   ├─ Trade Settlement (1 RPC)")
+	log.Println("   │  └─ SettleTrades")
+	log.Println("   ├─ Reconciliation (2 RPCs)")
+	log.Println("   │  ├─ RunReconciliation")
+	log.Println("   │  └─ ListReconciliationBreaks")
+	log.Println("   ├─ Transaction Queue (3 RPCs)")
+	log.Println("   │  ├─ ListQueuedTransactions")
+	log.Println("   │  ├─ RedriveDeadLetterTransactions")
+	log.Println("   │  └─ SkipDeadLetterTransactions")
+	log.Println("   ├─ Commission Payouts (2 RPCs)")
+	log.Println("   │  ├─ CreateCommissionPayoutBatch")
+	log.Println("   │  └─ ListCommissionPayouts")
+	log.Println("   ├─ Transaction Limits (5 RPCs)")
+	log.Println("   │  ├─ CreateLimitProfile")
+	log.Println("   │  ├─ UpdateLimitProfile")
+	log.Println("   │  ├─ ListLimitProfiles")
+	log.Println("   │  ├─ SetOwnerKYCTier")
+	log.Println("   │  └─ GetAccountLimits")
+	log.Println("   ├─ Scheduled Transfers (4 RPCs)")
+	log.Println("   │  ├─ CreateScheduledTransfer")
+	log.Println("   │  ├─ GetScheduledTransfer")
+	log.Println("   │  ├─ ListScheduledTransfers")
+	log.Println("   │  └─ CancelScheduledTransfer")
+	log.Println("   ├─ Fee Rules (2 RPCs)")
+	log.Println("   │  ├─ SimulateFeeRules")
+	log.Println("   │  └─ ListFeeRuleVersions")
+	log.Println("   ├─ Interest (6 RPCs)")
+	log.Println("   │  ├─ CreateInterestRatePlan")
+	log.Println("   │  ├─ UpdateInterestRatePlan")
+	log.Println("   │  ├─ ListInterestRatePlans")
+	log.Println("   │  ├─ GetAccountInterest")
+	log.Println("   │  ├─ ListInterestPostings")
+	log.Println("   │  └─ RunInterest")
+	log.Println("   ├─ Account Restrictions (4 RPCs)")
+	log.Println("   │  ├─ PlaceAccountRestriction")
+	log.Println("   │  ├─ ReleaseAccountRestriction")
+	log.Println("   │  ├─ ListAccountRestrictions")
+	log.Println("   │  └─ CloseAccount")
+	log.Println("   ├─ Demo Accounts (2 RPCs)")
+	log.Println("   │  ├─ TopUpDemoAccount")
+	log.Println("   │  └─ ResetDemoAccount")
 	log.Println("   ├─ Journal & Ledger (4 RPCs)")
 	log.Println("   │  ├─ GetJournal")
 	log.Println("   │  ├─ ListJournals")
 	log.Println("   │  ├─ ListLedgersByJournal")
 	log.Println("   │  └─ ListLedgersByAccount")
-	log.Println("   ├─ Statements & Reports (6 RPCs)")
+	log.Println("   ├─ Statements & Reports (10 RPCs)")
 	log.Println("   │  ├─ GetAccountStatement")
+	log.Println("   │  ├─ ExportAccountStatement (stream)")
 	log.Println("   │  ├─ GetOwnerStatement")
 	log.Println("   │  ├─ GetOwnerSummary")
 	log.Println("   │  ├─ GenerateDailyReport")
 	log.Println("   │  ├─ GetTransactionSummary")
-	log.Println("   │  └─ GetSystemHoldings")
+	log.Println("   │  ├─ GetSystemHoldings")
+	log.Println("   │  ├─ CloseAccountingPeriod")
+	log.Println("   │  ├─ GetTrialBalance")
+	log.Println("   │  └─ GetGeneralLedger")
 	log.Println("   ├─ Fee Management (3 RPCs)")
 	log.Println("   │  ├─ CalculateFee")
 	log.Println("   │  ├─ GetFeesByReceipt")
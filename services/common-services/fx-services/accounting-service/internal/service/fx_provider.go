@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"accounting-service/internal/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+// RateProvider fetches mid-market rates for a base currency.
+// Quotes the provider does not know are simply left out of the result.
+type RateProvider interface {
+	Name() string
+	FetchRates(ctx context.Context, base string, quotes []string) ([]*domain.MarketRate, error)
+}
+
+// NewRateProvider builds a provider chain from provider names ("http", "static").
+// Unknown or unconfigured providers are skipped with a warning.
+func NewRateProvider(names []string, ratesURL, apiKey, ratesFile string) RateProvider {
+	var providers []RateProvider
+	for _, name := range names {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "http":
+			if ratesURL == "" {
+				log.Println("⚠️  FX http provider skipped: FX_RATES_URL not set")
+				continue
+			}
+			providers = append(providers, NewHTTPRateProvider(ratesURL, apiKey))
+		case "static":
+			providers = append(providers, NewStaticRateProvider(ratesFile))
+		default:
+			log.Printf("⚠️  Unknown FX rate provider %q skipped", name)
+		}
+	}
+	if len(providers) == 0 {
+		return nil
+	}
+	if len(providers) == 1 {
+		return providers[0]
+	}
+	return &ChainRateProvider{providers: providers}
+}
+
+// ===============================
+// CHAIN PROVIDER
+// ===============================
+
+// ChainRateProvider asks each provider in order and fills in quotes that
+// earlier providers did not return (e.g. two HTTP sources, or HTTP and the
+// static file in development).
+type ChainRateProvider struct {
+	providers []RateProvider
+}
+
+func (c *ChainRateProvider) Name() string {
+	names := make([]string, 0, len(c.providers))
+	for _, p := range c.providers {
+		names = append(names, p.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+func (c *ChainRateProvider) FetchRates(ctx context.Context, base string, quotes []string) ([]*domain.MarketRate, error) {
+	var (
+		result  []*domain.MarketRate
+		lastErr error
+	)
+	missing := quotes
+
+	for _, p := range c.providers {
+		if len(missing) == 0 {
+			break
+		}
+
+		rates, err := p.FetchRates(ctx, base, missing)
+		if err != nil {
+			log.Printf("⚠️  FX provider %s failed for base=%s: %v", p.Name(), base, err)
+			lastErr = err
+			continue
+		}
+
+		found := make(map[string]bool, len(rates))
+		for _, r := range rates {
+			found[r.Pair.Quote] = true
+		}
+		result = append(result, rates...)
+
+		var still []string
+		for _, q := range missing {
+			if !found[q] {
+				still = append(still, q)
+			}
+		}
+		missing = still
+	}
+
+	if len(result) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	return result, nil
+}
+
+// ===============================
+// HTTP JSON PROVIDER
+// ===============================
+
+// HTTPRateProvider reads an openexchangerates-style endpoint:
+//
+//	{"base": "USD", "timestamp": 1700000000, "rates": {"BTC": 0.0000097, ...}}
+//
+// The URL may contain %s for the base currency; otherwise ?base= is added.
+type HTTPRateProvider struct {
+	urlTemplate string
+	apiKey      string
+	client      *http.Client
+}
+
+func NewHTTPRateProvider(urlTemplate, apiKey string) *HTTPRateProvider {
+	return &HTTPRateProvider{
+		urlTemplate: urlTemplate,
+		apiKey:      apiKey,
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+func (p *HTTPRateProvider) Name() string { return "http" }
+
+func (p *HTTPRateProvider) FetchRates(ctx context.Context, base string, quotes []string) ([]*domain.MarketRate, error) {
+	endpoint, err := p.buildURL(base)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build FX request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch FX rates: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("FX rate endpoint returned %s", resp.Status)
+	}
+
+	var data struct {
+		Base      string                 `json:"base"`
+		Timestamp int64                  `json:"timestamp"`
+		Rates     map[string]json.Number `json:"rates"`
+	}
+	dec := json.NewDecoder(resp.Body)
+	dec.UseNumber() // keep full precision
+	if err := dec.Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode FX rates: %w", err)
+	}
+	if data.Base != "" && !strings.EqualFold(data.Base, base) {
+		return nil, fmt.Errorf("FX rate endpoint returned base %s, expected %s", data.Base, base)
+	}
+
+	asOf := time.Now()
+	if data.Timestamp > 0 {
+		asOf = time.Unix(data.Timestamp, 0)
+	}
+
+	rates := make([]*domain.MarketRate, 0, len(quotes))
+	for _, quote := range quotes {
+		raw, ok := data.Rates[quote]
+		if !ok {
+			continue
+		}
+		rate, err := decimal.NewFromString(raw.String())
+		if err != nil || !rate.IsPositive() {
+			log.Printf("⚠️  FX http provider: invalid rate %s/%s=%q", base, quote, raw)
+			continue
+		}
+		rates = append(rates, &domain.MarketRate{
+			Pair:   domain.FXPair{Base: base, Quote: quote},
+			Rate:   rate,
+			AsOf:   asOf,
+			Source: p.Name(),
+		})
+	}
+
+	return rates, nil
+}
+
+func (p *HTTPRateProvider) buildURL(base string) (string, error) {
+	raw := p.urlTemplate
+	if strings.Contains(raw, "%s") {
+		raw = fmt.Sprintf(raw, url.QueryEscape(base))
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid FX rates URL: %w", err)
+	}
+
+	q := u.Query()
+	if !strings.Contains(p.urlTemplate, "%s") {
+		q.Set("base", base)
+	}
+	if p.apiKey != "" {
+		q.Set("app_id", p.apiKey)
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// ===============================
+// STATIC FILE PROVIDER
+// ===============================
+
+// StaticRateProvider serves rates from a JSON file for development and tests;
+// it is only used when listed in FX_RATE_PROVIDERS. Each pair is listed once;
+// the inverse is derived when requested:
+//
+//	{"as_of": "2025-01-06T00:00:00Z", "rates": [{"base": "BTC", "quote": "USD", "rate": "102500"}, ...]}
+//
+// The file is re-read on every fetch. Its rates are stamped with as_of, or the
+// file's modification time when as_of is missing, so FX_RATE_TTL rejects them
+// once the file is stale.
+type StaticRateProvider struct {
+	path string
+}
+
+func NewStaticRateProvider(path string) *StaticRateProvider {
+	return &StaticRateProvider{path: path}
+}
+
+func (p *StaticRateProvider) Name() string { return "static" }
+
+func (p *StaticRateProvider) FetchRates(ctx context.Context, base string, quotes []string) ([]*domain.MarketRate, error) {
+	table, asOf, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make([]*domain.MarketRate, 0, len(quotes))
+	for _, quote := range quotes {
+		pair := domain.FXPair{Base: base, Quote: quote}
+
+		rate, ok := table[pair]
+		if !ok {
+			inverse, found := table[domain.FXPair{Base: quote, Quote: base}]
+			if !found {
+				continue
+			}
+			rate = decimal.NewFromInt(1).DivRound(inverse, domain.FXRateScale)
+		}
+
+		rates = append(rates, &domain.MarketRate{
+			Pair:   pair,
+			Rate:   rate,
+			AsOf:   asOf,
+			Source: p.Name(),
+		})
+	}
+
+	return rates, nil
+}
+
+func (p *StaticRateProvider) load() (map[domain.FXPair]decimal.Decimal, time.Time, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to read FX rates file: %w", err)
+	}
+	raw, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to read FX rates file: %w", err)
+	}
+
+	var data struct {
+		AsOf  *time.Time `json:"as_of"`
+		Rates []struct {
+			Base  string          `json:"base"`
+			Quote string          `json:"quote"`
+			Rate  decimal.Decimal `json:"rate"`
+		} `json:"rates"`
+	}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to decode FX rates file: %w", err)
+	}
+
+	asOf := info.ModTime()
+	if data.AsOf != nil {
+		asOf = *data.AsOf
+	}
+
+	table := make(map[domain.FXPair]decimal.Decimal, len(data.Rates))
+	for _, r := range data.Rates {
+		pair, err := domain.ParseFXPair(r.Base + "/" + r.Quote)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		if !r.Rate.IsPositive() {
+			return nil, time.Time{}, errors.New("FX rates file contains a non-positive rate for " + pair.String())
+		}
+		table[pair] = r.Rate
+	}
+
+	return table, asOf, nil
+}
@@ -2,105 +2,266 @@ package service
 
 import (
 	"context"
-	//"encoding/json"
-	//"fmt"
-	"net/http"
+	"fmt"
+	"log"
 	"time"
-	//"log"
 
-	//"accounting-service/internal/domain"
+	"accounting-service/internal/config"
+	"accounting-service/internal/domain"
 	"accounting-service/internal/repository"
+
 	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
 )
 
+// fxSyncLockKey serialises rate ingestion across service instances
+const fxSyncLockKey = "accounting:fx_rate_sync"
+
 type FXService struct {
-	repo repository.CurrencyRepository
-	client *http.Client
+	repo          repository.CurrencyRepository
+	provider      RateProvider
+	pairs         []domain.FXPair
+	defaultSpread decimal.Decimal
+	pairSpreads   map[domain.FXPair]decimal.Decimal
 }
 
 // NewFXService creates a new FXService instance
-func NewFXService(repo repository.CurrencyRepository) *FXService {
-	return &FXService{
-		repo: repo,
-		client: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+func NewFXService(repo repository.CurrencyRepository, provider RateProvider, cfg config.FXConfig) *FXService {
+	s := &FXService{
+		repo:          repo,
+		provider:      provider,
+		defaultSpread: decimal.Zero,
+		pairSpreads:   make(map[domain.FXPair]decimal.Decimal),
 	}
+
+	for _, raw := range cfg.Pairs {
+		pair, err := domain.ParseFXPair(raw)
+		if err != nil {
+			log.Printf("⚠️  FX pair skipped: %v", err)
+			continue
+		}
+		s.pairs = append(s.pairs, pair)
+	}
+
+	if cfg.DefaultSpread != "" {
+		spread, err := decimal.NewFromString(cfg.DefaultSpread)
+		if err != nil || spread.IsNegative() {
+			log.Printf("⚠️  Invalid FX default spread %q, using 0", cfg.DefaultSpread)
+		} else {
+			s.defaultSpread = spread
+		}
+	}
+
+	for raw, value := range cfg.PairSpreads {
+		pair, err := domain.ParseFXPair(raw)
+		if err != nil {
+			log.Printf("⚠️  FX spread skipped: %v", err)
+			continue
+		}
+		spread, err := decimal.NewFromString(value)
+		if err != nil || spread.IsNegative() {
+			log.Printf("⚠️  Invalid FX spread %q for %s skipped", value, pair)
+			continue
+		}
+		s.pairSpreads[pair] = spread
+	}
+
+	return s
 }
 
-// FetchCommonCurrencies fetches common currencies from a public URL
-func (s *FXService) FetchCommonCurrencies(ctx context.Context, tx pgx.Tx) map[int]error {
-	// if tx == nil {
-	// 	return map[int]error{0: fmt.Errorf("transaction cannot be nil")}
-	// }
-
-	// // Use static defaults instead of fetching online
-	// currencies := domain.DefaultCurrencies()
-	// log.Printf("➡️ Seeding %d default currencies (USD, BTC, USDT)", len(currencies))
-
-	// errMap := s.repo.CreateCurrencies(ctx, currencies, tx)
-	// if len(errMap) > 0 {
-	// 	for i, e := range errMap {
-	// 		log.Printf("⚠️ currency insert error #%d: %v", i, e)
-	// 	}
-	// } else {
-	// 	log.Println("✅ all default currencies inserted successfully")
-	// }
-
-	// return errMap
-	return map[int]error{0: nil}
+// SpreadFor returns the markup (percent) applied to a pair
+func (s *FXService) SpreadFor(pair domain.FXPair) decimal.Decimal {
+	if spread, ok := s.pairSpreads[pair]; ok {
+		return spread
+	}
+	return s.defaultSpread
 }
 
+// FetchCommonCurrencies seeds the default currencies (USD, USDT, BTC)
+func (s *FXService) FetchCommonCurrencies(ctx context.Context, tx pgx.Tx) map[int]error {
+	if tx == nil {
+		return map[int]error{0: fmt.Errorf("transaction cannot be nil")}
+	}
+
+	// Use static defaults instead of fetching online
+	currencies := domain.DefaultCurrencies()
+	log.Printf("➡️ Seeding %d default currencies (USD, BTC, USDT)", len(currencies))
+
+	errMap := s.repo.CreateCurrencies(ctx, tx, currencies)
+	if len(errMap) > 0 {
+		for i, e := range errMap {
+			log.Printf("⚠️ currency insert error #%d: %v", i, e)
+		}
+	} else {
+		log.Println("✅ all default currencies inserted successfully")
+	}
+
+	return errMap
+}
 
-// FetchFXRates fetches conversion rates for a base currency and saves to DB
+// FetchFXRates fetches rates for every configured pair with the given base,
+// writes them as new fx_rates rows and expires the rows they replace.
+// Rates that are not newer than the current row are skipped.
 func (s *FXService) FetchFXRates(ctx context.Context, base string, tx pgx.Tx) map[int]error {
-	// if tx == nil {
-	// 	return map[int]error{0: fmt.Errorf("transaction cannot be nil")}
-	// }
-
-	// url := fmt.Sprintf("https://openexchangerates.org/api/latest.json?base=%s", base)
-	// log.Printf("➡️ Fetching FX rates for base=%s from %s", base, url)
-
-	// resp, err := s.client.Get(url)
-	// if err != nil {
-	// 	log.Printf("❌ failed HTTP request for FX rates: %v", err)
-	// 	return map[int]error{0: fmt.Errorf("failed to fetch FX rates: %w", err)}
-	// }
-	// defer resp.Body.Close()
-	// log.Printf("✅ received response for FX rates, status=%s", resp.Status)
-
-	// var data struct {
-	// 	Rates     map[string]float64 `json:"rates"`
-	// 	Timestamp int64              `json:"timestamp"`
-	// }
-	// if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-	// 	log.Printf("❌ failed to decode FX rates JSON: %v", err)
-	// 	return map[int]error{0: fmt.Errorf("failed to decode FX rates: %w", err)}
-	// }
-	// log.Printf("✅ decoded %d FX rates (timestamp=%d)", len(data.Rates), data.Timestamp)
-
-	// rates := []*domain.FXRate{}
-	// asOf := time.Unix(data.Timestamp, 0)
-	// for quote, rate := range data.Rates {
-	// 	rates = append(rates, &domain.FXRate{
-	// 		BaseCurrency:  base,
-	// 		QuoteCurrency: quote,
-	// 		Rate:          rate,
-	// 		AsOf:          asOf,
-	// 	})
-	// }
-	// log.Printf("➡️ inserting %d FX rates into DB (as_of=%s)", len(rates), asOf)
-
-	// errMap := s.repo.CreateFXRates(ctx, rates, tx)
-	// if len(errMap) > 0 {
-	// 	for i, e := range errMap {
-	// 		log.Printf("⚠️ FX rate insert error #%d: %v", i, e)
-	// 	}
-	// } else {
-	// 	log.Println("✅ all FX rates inserted successfully")
-	// }
-
-	// return errMap
-	return map[int]error{0: nil}
+	if tx == nil {
+		return map[int]error{0: fmt.Errorf("transaction cannot be nil")}
+	}
+	if s.provider == nil {
+		return map[int]error{0: fmt.Errorf("no FX rate provider configured")}
+	}
+
+	var quotes []string
+	for _, pair := range s.pairs {
+		if pair.Base == base {
+			quotes = append(quotes, pair.Quote)
+		}
+	}
+	if len(quotes) == 0 {
+		return nil
+	}
+
+	market, err := s.provider.FetchRates(ctx, base, quotes)
+	if err != nil {
+		return map[int]error{0: fmt.Errorf("failed to fetch FX rates for %s: %w", base, err)}
+	}
+
+	errs := make(map[int]error)
+	now := time.Now()
+	var rates []*domain.FXRate
+
+	for i, mr := range market {
+		if mr.AsOf.IsZero() || mr.AsOf.After(now) {
+			mr.AsOf = now
+		}
+
+		open, err := s.repo.GetFXRates(ctx, &domain.FXRateQuery{
+			BaseCurrency:  mr.Pair.Base,
+			QuoteCurrency: mr.Pair.Quote,
+		})
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		// Open rows are ordered newest first
+		if len(open) > 0 && !mr.AsOf.After(open[0].ValidFrom) {
+			continue
+		}
+
+		for _, old := range open {
+			if err := s.repo.ExpireFXRate(ctx, tx, old.ID, mr.AsOf); err != nil {
+				errs[i] = fmt.Errorf("failed to expire FX rate %d: %w", old.ID, err)
+				break
+			}
+		}
+		if errs[i] != nil {
+			continue
+		}
+
+		rates = append(rates, domain.NewFXRateFromMarket(mr, s.SpreadFor(mr.Pair)))
+	}
+
+	if len(rates) == 0 {
+		return errs
+	}
+
+	for i, e := range s.repo.CreateFXRates(ctx, tx, rates) {
+		errs[len(market)+i] = e
+	}
+
+	log.Printf("✅ FX rates for %s: %d written (%d quoted)", base, len(rates), len(market))
+	return errs
+}
+
+// SyncRates refreshes every configured pair in one transaction.
+// Only one instance syncs at a time; the others skip the tick.
+func (s *FXService) SyncRates(ctx context.Context) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var locked bool
+	if err := tx.QueryRow(ctx, "SELECT pg_try_advisory_xact_lock(hashtext($1))", fxSyncLockKey).Scan(&locked); err != nil {
+		return fmt.Errorf("failed to acquire FX sync lock: %w", err)
+	}
+	if !locked {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, pair := range s.pairs {
+		if seen[pair.Base] {
+			continue
+		}
+		seen[pair.Base] = true
+
+		for i, e := range s.FetchFXRates(ctx, pair.Base, tx) {
+			if e != nil {
+				log.Printf("⚠️ FX rate sync error for %s #%d: %v", pair.Base, i, e)
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit FX rates: %w", err)
+	}
+
+	return nil
+}
+
+// ===============================
+// FX RATE SCHEDULER
+// ===============================
+
+// FXRateScheduler periodically ingests rates through FXService
+type FXRateScheduler struct {
+	svc      *FXService
+	interval time.Duration
+	stopChan chan struct{}
+}
+
+func NewFXRateScheduler(svc *FXService, interval time.Duration) *FXRateScheduler {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &FXRateScheduler{
+		svc:      svc,
+		interval: interval,
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (s *FXRateScheduler) Start() {
+	go s.worker()
+}
+
+func (s *FXRateScheduler) Stop() {
+	close(s.stopChan)
 }
 
+func (s *FXRateScheduler) worker() {
+	s.sync() // don't wait a full interval after startup
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.sync()
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
+func (s *FXRateScheduler) sync() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := s.svc.SyncRates(ctx); err != nil {
+		log.Printf("❌ FX rate sync failed: %v", err)
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
 )
 
 // SystemSeeder handles initial setup of user and partner accounts
@@ -108,7 +109,7 @@ func (s *SystemSeeder) seedUserAccounts(ctx context.Context, tx pgx.Tx) error {
 				Currency:       "BTC",
 				Purpose:        domain.PurposeWallet,
 				AccountType:    domain.AccountTypeReal,
-				InitialBalance: 0,
+				InitialBalance: decimal.Zero,
 			},
 			&domain.CreateAccountRequest{
 				OwnerType:       domain.OwnerTypeUser,
@@ -116,7 +117,7 @@ func (s *SystemSeeder) seedUserAccounts(ctx context.Context, tx pgx.Tx) error {
 				Currency:       "TRX",
 				Purpose:        domain.PurposeWallet,
 				AccountType:    domain.AccountTypeReal,
-				InitialBalance: 0,
+				InitialBalance: decimal.Zero,
 			},
 			&domain.CreateAccountRequest{
 				OwnerType:      domain.OwnerTypeUser,
@@ -124,7 +125,7 @@ func (s *SystemSeeder) seedUserAccounts(ctx context.Context, tx pgx.Tx) error {
 				Currency:       "USDT",
 				Purpose:        domain.PurposeWallet,
 				AccountType:    domain.AccountTypeReal,
-				InitialBalance:  0,
+				InitialBalance:  decimal.Zero,
 			},
 			&domain.CreateAccountRequest{
 				OwnerType:      domain.OwnerTypeUser,
@@ -132,7 +133,7 @@ func (s *SystemSeeder) seedUserAccounts(ctx context.Context, tx pgx.Tx) error {
 				Currency:       "USD",
 				Purpose:        domain.PurposeWallet,
 				AccountType:    domain.AccountTypeDemo,
-				InitialBalance: 1000000, // $10,000 demo
+				InitialBalance: decimal.NewFromInt(10000), // $10,000 demo
 			},
 			&domain.CreateAccountRequest{
 				OwnerType:      domain.OwnerTypeUser,
@@ -140,7 +141,7 @@ func (s *SystemSeeder) seedUserAccounts(ctx context.Context, tx pgx.Tx) error {
 				Currency:       "USD",
 				Purpose:        domain.PurposeWallet,
 				AccountType:    domain.AccountTypeReal,
-				InitialBalance: 0,
+				InitialBalance: decimal.Zero,
 			},
 		)
 
@@ -258,7 +259,7 @@ func (s *SystemSeeder) seedPartnerAccounts(ctx context.Context, tx pgx.Tx) error
 			Currency:       currency,
 			Purpose:        domain.PurposeSettlement,
 			AccountType:    domain.AccountTypeReal,
-			InitialBalance:  0,
+			InitialBalance:  decimal.Zero,
 		})
 
 		//  Collect partner info for wallet creation
@@ -434,7 +435,7 @@ func (s *SystemSeeder) SeedAgentAccounts(ctx context.Context) error {
 			Purpose:        domain.PurposeCommission,
 			AccountType:    domain.AccountTypeReal,
 			CommissionRate: &commissionRate,
-			InitialBalance: 0,
+			InitialBalance: decimal.Zero,
 		})
 	}
 
@@ -477,8 +478,8 @@ func (s *SystemSeeder) SeedSystemAccounts(ctx context.Context) error {
 			Currency:       currency,
 			Purpose:        domain.PurposeLiquidity,
 			AccountType:    domain.AccountTypeReal,
-			InitialBalance: 100000000000, // $1,000,000.00 initial liquidity
-			OverdraftLimit: 0,
+			InitialBalance: decimal.NewFromInt(1000000), // 1,000,000.00 initial liquidity
+			OverdraftLimit: decimal.Zero,
 		})
 
 		// 2. System Fee Account
@@ -488,8 +489,8 @@ func (s *SystemSeeder) SeedSystemAccounts(ctx context.Context) error {
 			Currency:       currency,
 			Purpose:        domain.PurposeFees,
 			AccountType:    domain.AccountTypeReal,
-			InitialBalance: 0,
-			OverdraftLimit: 0,
+			InitialBalance: decimal.Zero,
+			OverdraftLimit: decimal.Zero,
 		})
 
 		// 3. System Clearing Account
@@ -499,8 +500,8 @@ func (s *SystemSeeder) SeedSystemAccounts(ctx context.Context) error {
 			Currency:       currency,
 			Purpose:        domain.PurposeClearing,
 			AccountType:    domain.AccountTypeReal,
-			InitialBalance: 0,
-			OverdraftLimit: 0,
+			InitialBalance: decimal.Zero,
+			OverdraftLimit: decimal.Zero,
 		})
 
 		// 4. System Settlement Account
@@ -510,8 +511,8 @@ func (s *SystemSeeder) SeedSystemAccounts(ctx context.Context) error {
 			Currency:       currency,
 			Purpose:        domain.PurposeSettlement,
 			AccountType:    domain.AccountTypeReal,
-			InitialBalance: 0,
-			OverdraftLimit: 0,
+			InitialBalance: decimal.Zero,
+			OverdraftLimit: decimal.Zero,
 		})
 
 		batch = append(batch, &domain.CreateAccountRequest{
@@ -520,8 +521,19 @@ func (s *SystemSeeder) SeedSystemAccounts(ctx context.Context) error {
 			Currency:       currency,
 			Purpose:        domain.PurposeRevenue,
 			AccountType:    domain.AccountTypeReal,
-			InitialBalance: 0,
-			OverdraftLimit: 0,
+			InitialBalance: decimal.Zero,
+			OverdraftLimit: decimal.Zero,
+		})
+
+		// Interest on savings and investment accounts is paid from here
+		batch = append(batch, &domain.CreateAccountRequest{
+			OwnerType:      domain.OwnerTypeSystem,
+			OwnerID:        "system",
+			Currency:       currency,
+			Purpose:        domain.PurposeInterestExpense,
+			AccountType:    domain.AccountTypeReal,
+			InitialBalance: decimal.Zero,
+			OverdraftLimit: decimal.Zero,
 		})
 	}
 
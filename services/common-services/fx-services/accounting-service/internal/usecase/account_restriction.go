@@ -0,0 +1,357 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+	publisher "accounting-service/internal/pub"
+	"accounting-service/internal/repository"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Audit actions sent to audit-service
+	AuditActionRestrictionPlaced   = "account.restriction.placed"
+	AuditActionRestrictionReleased = "account.restriction.released"
+	AuditActionAccountClosed       = "account.closed"
+
+	// A credit landing between the sweep and the closure is swept again
+	accountClosureMaxAttempts = 3
+)
+
+// AccountRestrictionUsecase places and lifts freezes and liens, and closes
+// accounts. Enforcement happens in the transaction repository when an
+// account is posted to; every change is sent to audit-service.
+type AccountRestrictionUsecase struct {
+	restrictionRepo repository.AccountRestrictionRepository
+	accountUC       *AccountUsecase
+	txUC            *TransactionUsecase
+	auditPublisher  *publisher.AuditPublisher
+}
+
+func NewAccountRestrictionUsecase(
+	restrictionRepo repository.AccountRestrictionRepository,
+	accountUC *AccountUsecase,
+	txUC *TransactionUsecase,
+	auditPublisher *publisher.AuditPublisher,
+) *AccountRestrictionUsecase {
+	return &AccountRestrictionUsecase{
+		restrictionRepo: restrictionRepo,
+		accountUC:       accountUC,
+		txUC:            txUC,
+		auditPublisher:  auditPublisher,
+	}
+}
+
+// PlaceRestriction freezes or liens an account. Closure goes through
+// CloseAccount, which sweeps the balance first.
+func (uc *AccountRestrictionUsecase) PlaceRestriction(
+	ctx context.Context,
+	req *domain.PlaceAccountRestrictionRequest,
+) (*domain.AccountRestriction, error) {
+	if req.Type == domain.RestrictionClosure {
+		return nil, fmt.Errorf("%w: use CloseAccount to close an account", xerrors.ErrInvalidAccountRestriction)
+	}
+
+	account, err := uc.accountUC.GetByAccountNumber(ctx, req.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	restriction := &domain.AccountRestriction{
+		AccountID:     account.ID,
+		AccountNumber: account.AccountNumber,
+		Type:          req.Type,
+		Amount:        req.Amount,
+		Reason:        req.Reason,
+		Reference:     req.Reference,
+		CreatedBy:     req.Actor,
+		ExpiresAt:     req.ExpiresAt,
+	}
+	if err := restriction.Validate(time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.restrictionRepo.Create(ctx, restriction); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("[RESTRICTION] %s placed on %s by %s (id=%d)\n",
+		restriction.Type, account.AccountNumber, req.Actor, restriction.ID)
+
+	uc.audit(ctx, account, req.Actor, AuditActionRestrictionPlaced, restriction.Reason,
+		nil, restrictionAuditValue(restriction))
+	return restriction, nil
+}
+
+// ReleaseRestriction lifts an active freeze or lien
+func (uc *AccountRestrictionUsecase) ReleaseRestriction(
+	ctx context.Context,
+	id int64,
+	actor, reason string,
+) (*domain.AccountRestriction, error) {
+	if actor == "" || reason == "" {
+		return nil, fmt.Errorf("%w: actor and reason are required", xerrors.ErrInvalidAccountRestriction)
+	}
+
+	restriction, err := uc.restrictionRepo.Release(ctx, id, actor, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("[RESTRICTION] %s on %s released by %s (id=%d)\n",
+		restriction.Type, restriction.AccountNumber, actor, restriction.ID)
+
+	account, err := uc.accountUC.GetByAccountNumber(ctx, restriction.AccountNumber)
+	if err != nil {
+		fmt.Printf("[RESTRICTION] Released %d but could not load account for audit: %v\n", id, err)
+		return restriction, nil
+	}
+
+	previous := restrictionAuditValue(restriction)
+	previous["status"] = "active"
+	uc.audit(ctx, account, actor, AuditActionRestrictionReleased, reason,
+		previous, restrictionAuditValue(restriction))
+	return restriction, nil
+}
+
+// CloseAccount sweeps the remaining balance to another account of the same
+// account type and currency, then closes the account for good. Holds, liens
+// and debit freezes must be cleared first, since the sweep would breach them.
+func (uc *AccountRestrictionUsecase) CloseAccount(
+	ctx context.Context,
+	req *domain.CloseAccountRequest,
+) (*domain.AccountRestriction, error) {
+	if req.Actor == "" {
+		return nil, fmt.Errorf("%w: actor is required", xerrors.ErrInvalidAccountRestriction)
+	}
+	if req.SweepToAccountNumber == req.AccountNumber {
+		return nil, fmt.Errorf("%w: cannot sweep an account into itself", xerrors.ErrInvalidAccountRestriction)
+	}
+
+	account, err := uc.accountUC.GetByAccountNumber(ctx, req.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := uc.restrictionRepo.ListActive(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+	state := domain.NewAccountRestrictionState(active)
+	if state.Closed {
+		return nil, xerrors.ErrAccountClosed
+	}
+	if state.DebitFrozen || state.LienAmount.IsPositive() {
+		return nil, fmt.Errorf("%w: release freezes and liens before closing", xerrors.ErrAccountRestrictionNotActive)
+	}
+
+	// A main account closes last so sub-account funds are never stranded under it
+	if !account.IsSubAccount() {
+		subAccounts, err := uc.accountUC.GetSubAccounts(ctx, account.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, sub := range subAccounts {
+			if sub.IsActive {
+				return nil, fmt.Errorf("%w: close sub-account %s before closing", xerrors.ErrAccountRestrictionNotActive, sub.AccountNumber)
+			}
+		}
+	}
+
+	var sweepTo *domain.Account
+	if req.SweepToAccountNumber != "" {
+		sweepTo, err = uc.accountUC.GetByAccountNumber(ctx, req.SweepToAccountNumber)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sweep account: %w", err)
+		}
+		if sweepTo.Currency != account.Currency {
+			return nil, xerrors.ErrCurrencyMismatch
+		}
+		if sweepTo.AccountType != account.AccountType {
+			return nil, fmt.Errorf("%w: sweep account must be %s", xerrors.ErrInvalidAccountRestriction, account.AccountType)
+		}
+		if !sweepTo.IsActive {
+			return nil, xerrors.ErrAccountInactive
+		}
+	}
+
+	closure := &domain.AccountRestriction{
+		AccountID:     account.ID,
+		AccountNumber: account.AccountNumber,
+		Type:          domain.RestrictionClosure,
+		Reason:        req.Reason,
+		Reference:     req.Reference,
+		CreatedBy:     req.Actor,
+	}
+	if err := closure.Validate(time.Now()); err != nil {
+		return nil, err
+	}
+
+	swept := decimal.Zero
+	var receiptCodes []string
+	for attempt := 1; ; attempt++ {
+		withBalance, err := uc.accountUC.GetAccountWithBalance(ctx, account.AccountNumber)
+		if err != nil {
+			return nil, err
+		}
+		balance := withBalance.Balance
+		if balance != nil && balance.PendingDebit.IsPositive() {
+			return nil, fmt.Errorf("%w: %s is held; release holds before closing",
+				xerrors.ErrAccountNotEmpty, balance.PendingDebit)
+		}
+
+		if balance != nil && balance.Balance.IsPositive() {
+			if sweepTo == nil {
+				return nil, fmt.Errorf("%w: a sweep account is required for a balance of %s %s",
+					xerrors.ErrAccountNotEmpty, balance.Balance, account.Currency)
+			}
+			receiptCode, err := uc.sweep(ctx, account, sweepTo, balance, req.Actor)
+			if err != nil {
+				return nil, fmt.Errorf("failed to sweep balance: %w", err)
+			}
+			swept = swept.Add(balance.Balance)
+			receiptCodes = append(receiptCodes, receiptCode)
+		}
+
+		if sweepTo != nil && swept.IsPositive() {
+			closure.SweepAccountNumber = &sweepTo.AccountNumber
+			closure.SweptAmount = &swept
+			if len(receiptCodes) > 0 {
+				closure.SweepReceiptCode = &receiptCodes[len(receiptCodes)-1]
+			}
+		}
+
+		err = uc.restrictionRepo.Close(ctx, closure)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, xerrors.ErrAccountNotEmpty) || attempt >= accountClosureMaxAttempts {
+			return nil, err
+		}
+		fmt.Printf("[RESTRICTION] %s received funds during closure, sweeping again (attempt %d)\n",
+			account.AccountNumber, attempt)
+	}
+
+	if err := uc.accountUC.InvalidateAccountCache(ctx, account); err != nil {
+		fmt.Printf("[RESTRICTION] Failed to invalidate cache for closed account %s: %v\n", account.AccountNumber, err)
+	}
+
+	fmt.Printf("[RESTRICTION] %s closed by %s, swept %s %s to %s\n",
+		account.AccountNumber, req.Actor, swept, account.Currency, ptrStrToStr(closure.SweepAccountNumber))
+
+	value := restrictionAuditValue(closure)
+	value["sweep_receipt_codes"] = receiptCodes
+	uc.audit(ctx, account, req.Actor, AuditActionAccountClosed, closure.Reason,
+		map[string]interface{}{"is_active": account.IsActive}, value)
+	return closure, nil
+}
+
+func (uc *AccountRestrictionUsecase) ListRestrictions(
+	ctx context.Context,
+	filter *domain.AccountRestrictionFilter,
+) ([]*domain.AccountRestriction, int64, error) {
+	return uc.restrictionRepo.List(ctx, filter)
+}
+
+// sweep moves the whole balance out. The key includes the balance version,
+// so a retry after a new credit sweeps again while a replay of the same
+// state returns the earlier receipt.
+func (uc *AccountRestrictionUsecase) sweep(
+	ctx context.Context,
+	account, sweepTo *domain.Account,
+	balance *domain.Balance,
+	actor string,
+) (string, error) {
+	idempotencyKey := fmt.Sprintf("account-closure:%d:%d", account.ID, balance.Version)
+	metadata := map[string]interface{}{
+		"closure_of": account.AccountNumber,
+		"closed_by":  actor,
+	}
+
+	txResult, err := uc.txUC.ExecuteTransactionSync(ctx, &domain.TransactionRequest{
+		IdempotencyKey:      &idempotencyKey,
+		TransactionType:     domain.TransactionTypeTransfer,
+		AccountType:         account.AccountType,
+		Description:         ptrString(fmt.Sprintf("Closure of %s", account.AccountNumber)),
+		CreatedByExternalID: ptrString(actor),
+		CreatedByType:       ptrOwnerType(domain.OwnerTypeAdmin),
+		IsSystemTransaction: true,
+		Entries: []*domain.LedgerEntryRequest{
+			{
+				AccountNumber: account.AccountNumber,
+				Amount:        balance.Balance,
+				DrCr:          domain.DrCrDebit,
+				Currency:      account.Currency,
+				Description:   ptrString(fmt.Sprintf("Closing balance swept to %s", sweepTo.AccountNumber)),
+				Metadata:      metadata,
+			},
+			{
+				AccountNumber: sweepTo.AccountNumber,
+				Amount:        balance.Balance,
+				DrCr:          domain.DrCrCredit,
+				Currency:      sweepTo.Currency,
+				Description:   ptrString(fmt.Sprintf("Closing balance of %s", account.AccountNumber)),
+				Metadata:      metadata,
+			},
+		},
+		GenerateReceipt: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	return txResult.ReceiptCode, nil
+}
+
+func (uc *AccountRestrictionUsecase) audit(
+	ctx context.Context,
+	account *domain.Account,
+	actor, action, description string,
+	previous, value map[string]interface{},
+) {
+	uc.auditPublisher.PublishAdminAction(ctx, &publisher.AdminAuditEvent{
+		AdminUserID:   actor,
+		TargetUserID:  account.OwnerID,
+		Action:        action,
+		Description:   &description,
+		PreviousValue: previous,
+		NewValue:      value,
+		Metadata: map[string]interface{}{
+			"service":        "accounting-service",
+			"account_number": account.AccountNumber,
+			"owner_type":     account.OwnerType,
+			"currency":       account.Currency,
+		},
+	})
+}
+
+func restrictionAuditValue(r *domain.AccountRestriction) map[string]interface{} {
+	value := map[string]interface{}{
+		"restriction_id": r.ID,
+		"type":           r.Type,
+		"reason":         r.Reason,
+		"status":         r.Status(time.Now()),
+	}
+	if r.Amount != nil {
+		value["amount"] = r.Amount.String()
+	}
+	if r.Reference != nil {
+		value["reference"] = *r.Reference
+	}
+	if r.ExpiresAt != nil {
+		value["expires_at"] = r.ExpiresAt.Format(time.RFC3339)
+	}
+	if r.ReleaseReason != nil {
+		value["release_reason"] = *r.ReleaseReason
+	}
+	if r.SweptAmount != nil {
+		value["swept_amount"] = r.SweptAmount.String()
+		value["sweep_account_number"] = ptrStrToStr(r.SweepAccountNumber)
+	}
+	return value
+}
@@ -616,7 +616,7 @@ func (uc *AccountUsecase) validateCreateAccountRequest(req *domain.CreateAccount
 	}
 
 	// Demo accounts cannot have overdraft
-	if req.AccountType == domain.AccountTypeDemo && req.OverdraftLimit > 0 {
+	if req.AccountType == domain.AccountTypeDemo && req.OverdraftLimit.IsPositive() {
 		return fmt.Errorf("demo accounts cannot have overdraft limit")
 	}
 
@@ -1,211 +1,385 @@
-// usecase/transaction_approval_usecase. go
+// usecase/transaction_approval_usecase.go
 package usecase
 
 import (
-    "context"
-    "fmt"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
-    "accounting-service/internal/domain"
-    "accounting-service/internal/repository"
-    xerrors "x/shared/utils/errors"
+	"accounting-service/internal/domain"
+	"accounting-service/internal/repository"
+	xerrors "x/shared/utils/errors"
+)
+
+const (
+	ApprovalExecutorInterval  = 5 * time.Second
+	ApprovalExecutorBatchSize = 20
+	ApprovalExecutionLease    = 2 * time.Minute // A claimed request is retried after this if its executor died
 )
 
 type TransactionApprovalUsecase struct {
-    approvalRepo repository.TransactionApprovalRepository
-    txUC         *TransactionUsecase
+	approvalRepo repository.TransactionApprovalRepository
+	policyRepo   repository.ApprovalPolicyRepository
+	txUC         *TransactionUsecase
+	executor     *ApprovalExecutor
 }
 
 func NewTransactionApprovalUsecase(
-    approvalRepo repository.TransactionApprovalRepository,
-    txUC *TransactionUsecase,
+	approvalRepo repository.TransactionApprovalRepository,
+	policyRepo repository.ApprovalPolicyRepository,
+	txUC *TransactionUsecase,
 ) *TransactionApprovalUsecase {
-    return &TransactionApprovalUsecase{
-        approvalRepo:  approvalRepo,
-        txUC:         txUC,
-    }
+	uc := &TransactionApprovalUsecase{
+		approvalRepo: approvalRepo,
+		policyRepo:   policyRepo,
+		txUC:         txUC,
+	}
+	uc.executor = NewApprovalExecutor(uc, ApprovalExecutorInterval)
+	return uc
 }
 
+// CreateApproval stores a request with a snapshot of the policy that matches
+// it (transaction type, currency and amount band), or the default policy
 func (uc *TransactionApprovalUsecase) CreateApproval(
-    ctx context.Context,
-    req *domain.CreateApprovalRequest,
+	ctx context.Context,
+	req *domain.CreateApprovalRequest,
 ) (*domain.TransactionApproval, error) {
-    approval := &domain.TransactionApproval{
-        RequestedBy:       req.RequestedBy,
-        TransactionType:  req.TransactionType,
-        AccountNumber:    req.AccountNumber,
-        Amount:           req.Amount,
-        Currency:         req. Currency,
-        Description:      req.Description,
-        ToAccountNumber:  req.ToAccountNumber,
-        Status:           domain.ApprovalStatusPending,
-    }
+	approval := &domain.TransactionApproval{
+		RequestedBy:     req.RequestedBy,
+		TransactionType: req.TransactionType,
+		AccountNumber:   req.AccountNumber,
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+		Description:     req.Description,
+		ToAccountNumber: req.ToAccountNumber,
+		Status:          domain.ApprovalStatusPending,
+	}
+
+	// Store metadata as JSON
+	if req.RequestMetadata != nil {
+		if metadata, err := json.Marshal(req.RequestMetadata); err == nil {
+			approval.RequestMetadata = metadata
+		}
+	}
 
-    // Store metadata as JSON
-    if req.RequestMetadata != nil {
-        // Already a map, will be marshaled by repo
-    }
+	if err := approval.Validate(); err != nil {
+		return nil, err
+	}
 
-    if err := approval.Validate(); err != nil {
-        return nil, err
-    }
+	// Policies are keyed on currency, which admins usually leave out
+	if approval.Currency == "" {
+		account, err := uc.txUC.accountUC.GetByAccountNumber(ctx, approval.AccountNumber)
+		if err != nil {
+			return nil, err
+		}
+		approval.Currency = account.Currency
+	}
 
-    if err := uc.approvalRepo.Create(ctx, approval); err != nil {
-        return nil, fmt.Errorf("failed to create approval: %w", err)
-    }
+	policy, err := uc.policyRepo.Match(ctx, approval.TransactionType, approval.Currency, approval.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		policy = domain.DefaultApprovalPolicy()
+	} else {
+		approval.PolicyID = &policy.ID
+	}
 
-    return approval, nil
+	approval.RequiredApprovals = policy.RequiredApprovals
+	approval.ApproverRoles = policy.ApproverRoles
+	approval.DistinctRoles = policy.DistinctRoles
+	if policy.ExpiresAfter > 0 {
+		expiresAt := time.Now().Add(policy.ExpiresAfter)
+		approval.ExpiresAt = &expiresAt
+	}
+
+	if err := uc.approvalRepo.Create(ctx, approval); err != nil {
+		return nil, fmt.Errorf("failed to create approval: %w", err)
+	}
+
+	return approval, nil
 }
 
 func (uc *TransactionApprovalUsecase) GetPendingApprovals(
-    ctx context.Context,
-    limit, offset int,
+	ctx context.Context,
+	limit, offset int,
 ) ([]*domain.TransactionApproval, int64, error) {
-    status := domain.ApprovalStatusPending
-    filter := &domain.ApprovalFilter{
-        Status:  &status,
-        Limit:   limit,
-        Offset:  offset,
-    }
+	status := domain.ApprovalStatusPending
+	filter := &domain.ApprovalFilter{
+		Status: &status,
+		Limit:  limit,
+		Offset: offset,
+	}
 
-    return uc.approvalRepo.List(ctx, filter)
+	return uc.approvalRepo.List(ctx, filter)
 }
 
-// usecase/transaction_approval_usecase. go
-
+// ApproveOrReject records one approver's decision. A single rejection settles
+// the request; approvals accumulate until the policy quorum is reached, after
+// which the executor runs the transaction.
 func (uc *TransactionApprovalUsecase) ApproveOrReject(
-    ctx context.Context,
-    req *domain.ApproveApprovalRequest,
+	ctx context.Context,
+	req *domain.ApproveApprovalRequest,
 ) (*domain.TransactionApproval, string, error) {
-    // Get approval
-    approval, err := uc. approvalRepo.GetByID(ctx, req.RequestID)
-    if err != nil {
-        return nil, "", xerrors.ErrNotFound
-    }
-
-    // Check if already processed
-    if approval.Status != domain. ApprovalStatusPending {
-        return nil, "", fmt. Errorf("approval already processed with status: %s", approval.Status)
-    }
-
-    // Prevent self-approval
-    if approval. RequestedBy == req.ApprovedBy {
-        return nil, "", xerrors.ErrSelfApprovalNotAllowed
-    }
-
-    // Update status
-    if req. Approved {
-        // Approve
-        if err := uc.approvalRepo.UpdateStatus(ctx, req.RequestID, domain.ApprovalStatusApproved, &req.ApprovedBy, nil); err != nil {
-            return nil, "", err
-        }
-
-        // Execute transaction asynchronously
-        go uc.executeApprovedTransaction(approval)
-
-        // Refresh approval
-        approval, _ = uc.approvalRepo. GetByID(ctx, req. RequestID)
-        return approval, "", nil
-    } else {
-        // Reject
-        if err := uc.approvalRepo. UpdateStatus(ctx, req.RequestID, domain.ApprovalStatusRejected, &req.ApprovedBy, req.Reason); err != nil {
-            return nil, "", err
-        }
-
-        // Refresh approval
-        approval, _ = uc.approvalRepo.GetByID(ctx, req.RequestID)
-        return approval, "", nil
-    }
-}
-
-func (uc *TransactionApprovalUsecase) executeApprovedTransaction(approval *domain.TransactionApproval) {
-    ctx := context.Background()
-
-    var aggregate *domain.LedgerAggregate
-    var err error
-
-    // Execute based on type
-    switch approval.TransactionType {
-    case domain.TransactionTypeDeposit:
-        aggregate, err = uc.txUC. Credit(ctx, &domain.CreditRequest{
-            AccountNumber:        approval.AccountNumber,
-            Amount:              approval.Amount,
-            Description:         ptrStrToStr(approval.Description),
-            TransactionType:     domain.TransactionTypeDeposit,
-			AccountType: domain.AccountTypeReal,
-			CreatedByExternalID: fmt.Sprintf("%d",approval.RequestedBy),
-			CreatedByType: domain.OwnerTypeAdmin,
-        })
-
-    case domain.TransactionTypeWithdrawal:
-        aggregate, err = uc.txUC. Debit(ctx, &domain. DebitRequest{
-            AccountNumber:       approval.AccountNumber,
-            Amount:              approval.Amount,
-            Description:         ptrStrToStr(approval.Description),
-            TransactionType:     domain.TransactionTypeWithdrawal,
-			AccountType: domain.AccountTypeReal,
+	decision := &domain.ApprovalDecision{
+		ApprovalID:   req.RequestID,
+		ApproverID:   req.ApprovedBy,
+		ApproverRole: req.ApproverRole,
+		Approved:     req.Approved,
+		Reason:       req.Reason,
+	}
+
+	approval, err := uc.approvalRepo.Decide(ctx, decision, func(a *domain.TransactionApproval) error {
+		if a.IsExpired(time.Now()) {
+			return xerrors.ErrApprovalExpired
+		}
+		if a.Status != domain.ApprovalStatusPending {
+			return fmt.Errorf("%w: status %s", xerrors.ErrApprovalAlreadyProcessed, a.Status)
+		}
+		// Prevent self-approval
+		if a.RequestedBy == req.ApprovedBy {
+			return xerrors.ErrSelfApprovalNotAllowed
+		}
+		if !a.CanApprove(req.ApproverRole) {
+			return xerrors.ErrApproverRoleNotAllowed
+		}
+		for _, d := range a.Decisions {
+			if d.ApproverID == req.ApprovedBy {
+				return xerrors.ErrApprovalAlreadyVoted
+			}
+		}
+		if req.Approved && a.DistinctRoles && a.HasApprovalFromRole(req.ApproverRole) {
+			return xerrors.ErrApprovalRoleAlreadyCounted
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if approval.Status == domain.ApprovalStatusApproved {
+		uc.executor.Wake()
+	}
+
+	return approval, "", nil
+}
+
+// executeApprovedTransaction runs an approved request under an idempotency key
+// derived from the approval, so a retry after a crash cannot post twice
+func (uc *TransactionApprovalUsecase) executeApprovedTransaction(
+	ctx context.Context,
+	approval *domain.TransactionApproval,
+) (string, error) {
+	idempotencyKey := fmt.Sprintf("approval:%d", approval.ID)
+
+	// An earlier attempt may have posted before its result was recorded
+	if existing, err := uc.txUC.transactionRepo.GetByIdempotencyKey(ctx, idempotencyKey); err == nil && existing != nil {
+		return extractReceiptCodeFromAggregate(existing), nil
+	}
+
+	var aggregate *domain.LedgerAggregate
+	var err error
+
+	// Execute based on type
+	switch approval.TransactionType {
+	case domain.TransactionTypeDeposit:
+		aggregate, err = uc.txUC.Credit(ctx, &domain.CreditRequest{
+			AccountNumber:       approval.AccountNumber,
+			Amount:              approval.Amount,
+			Description:         ptrStrToStr(approval.Description),
+			TransactionType:     domain.TransactionTypeDeposit,
+			AccountType:         domain.AccountTypeReal,
+			IdempotencyKey:      &idempotencyKey,
+			CreatedByExternalID: fmt.Sprintf("%d", approval.RequestedBy),
+			CreatedByType:       domain.OwnerTypeAdmin,
+		})
+
+	case domain.TransactionTypeWithdrawal:
+		aggregate, err = uc.txUC.Debit(ctx, &domain.DebitRequest{
+			AccountNumber:       approval.AccountNumber,
+			Amount:              approval.Amount,
+			Description:         ptrStrToStr(approval.Description),
+			TransactionType:     domain.TransactionTypeWithdrawal,
+			AccountType:         domain.AccountTypeReal,
+			IdempotencyKey:      &idempotencyKey,
 			CreatedByExternalID: fmt.Sprintf("%d", approval.RequestedBy),
-			CreatedByType: domain.OwnerTypeAdmin,
-			//TransactionType: approval.TransactionTypeWithdrawal,
-        })
-
-    case domain.TransactionTypeTransfer:
-        aggregate, err = uc.txUC.Transfer(ctx, &domain.TransferRequest{
-            FromAccountNumber:    approval.AccountNumber,
-            ToAccountNumber:     *approval.ToAccountNumber,
-            Amount:              approval.Amount,
-            Description:         ptrStrToStr(approval.Description),
-            TransactionType:     domain.TransactionTypeTransfer,
-			AccountType: domain.AccountTypeReal,
+			CreatedByType:       domain.OwnerTypeAdmin,
+		})
+
+	case domain.TransactionTypeTransfer:
+		aggregate, err = uc.txUC.Transfer(ctx, &domain.TransferRequest{
+			FromAccountNumber:   approval.AccountNumber,
+			ToAccountNumber:     *approval.ToAccountNumber,
+			Amount:              approval.Amount,
+			Description:         ptrStrToStr(approval.Description),
+			TransactionType:     domain.TransactionTypeTransfer,
+			AccountType:         domain.AccountTypeReal,
+			IdempotencyKey:      &idempotencyKey,
 			CreatedByExternalID: fmt.Sprintf("%d", approval.RequestedBy),
-			CreatedByType: domain.OwnerTypeAdmin,
-        })
-
-    case domain.TransactionTypeConversion:
-        aggregate, err = uc.txUC.ConvertAndTransfer(ctx, &domain.ConversionRequest{
-            FromAccountNumber:   approval.AccountNumber,
-            ToAccountNumber:     *approval.ToAccountNumber,
-            Amount:              approval.Amount,
-			AccountType: domain.AccountTypeReal,
+			CreatedByType:       domain.OwnerTypeAdmin,
+		})
+
+	case domain.TransactionTypeConversion:
+		aggregate, err = uc.txUC.ConvertAndTransfer(ctx, &domain.ConversionRequest{
+			FromAccountNumber:   approval.AccountNumber,
+			ToAccountNumber:     *approval.ToAccountNumber,
+			Amount:              approval.Amount,
+			AccountType:         domain.AccountTypeReal,
+			IdempotencyKey:      &idempotencyKey,
 			CreatedByExternalID: fmt.Sprintf("%d", approval.RequestedBy),
-			CreatedByType: domain.OwnerTypeAdmin,
-        })
-    }
+			CreatedByType:       domain.OwnerTypeAdmin,
+		})
 
-    // Update approval with result
-    if err != nil {
-        _ = uc.approvalRepo. MarkFailed(ctx, approval.ID, err.Error())
-    } else if aggregate != nil {
-        //  Extract receipt code from ledgers (primary source)
-        receiptCode := extractReceiptCodeFromAggregate(aggregate)
-        _ = uc.approvalRepo. MarkExecuted(ctx, approval.ID, receiptCode)
-    }
+	default:
+		return "", fmt.Errorf("%w: cannot execute %s approvals", xerrors.ErrInvalidInput, approval.TransactionType)
+	}
+
+	if err != nil {
+		return "", err
+	}
+	//  Extract receipt code from ledgers (primary source)
+	return extractReceiptCodeFromAggregate(aggregate), nil
 }
 
 func extractReceiptCodeFromAggregate(aggregate *domain.LedgerAggregate) string {
-    if aggregate == nil {
-        return ""
-    }
+	if aggregate == nil {
+		return ""
+	}
 
-    // Try ledgers first (primary source)
-    if len(aggregate.Ledgers) > 0 {
-        for _, ledger := range aggregate. Ledgers {
-            if ledger. ReceiptCode != nil && *ledger.ReceiptCode != "" {
-                return *ledger.ReceiptCode
-            }
-        }
-    }
+	// Try ledgers first (primary source)
+	if len(aggregate.Ledgers) > 0 {
+		for _, ledger := range aggregate.Ledgers {
+			if ledger.ReceiptCode != nil && *ledger.ReceiptCode != "" {
+				return *ledger.ReceiptCode
+			}
+		}
+	}
 
-    //  Fallback to journal external_ref (backward compatibility)
-    if aggregate.Journal. ExternalRef != nil && *aggregate.Journal.ExternalRef != "" {
-        return *aggregate.Journal.ExternalRef
-    }
+	//  Fallback to journal external_ref (backward compatibility)
+	if aggregate.Journal.ExternalRef != nil && *aggregate.Journal.ExternalRef != "" {
+		return *aggregate.Journal.ExternalRef
+	}
 
-    return ""
+	return ""
 }
 
 func (uc *TransactionApprovalUsecase) GetApprovalHistory(
-    ctx context.Context,
-    filter *domain.ApprovalFilter,
+	ctx context.Context,
+	filter *domain.ApprovalFilter,
 ) ([]*domain.TransactionApproval, int64, error) {
-    return uc.approvalRepo.List(ctx, filter)
-}
\ No newline at end of file
+	return uc.approvalRepo.List(ctx, filter)
+}
+
+// StartExecutor starts the background worker that expires stale requests and
+// executes approved ones
+func (uc *TransactionApprovalUsecase) StartExecutor() {
+	uc.executor.Start()
+}
+
+func (uc *TransactionApprovalUsecase) StopExecutor() {
+	uc.executor.Stop()
+}
+
+// ===============================
+// APPROVAL EXECUTOR
+// ===============================
+
+// ApprovalExecutor drives approved requests to executed/failed from the
+// database, so approvals survive restarts. Retryable failures (see
+// isRetryableTransactionError) back off and are retried up to
+// ApprovalMaxExecutionTries; anything else fails the request.
+type ApprovalExecutor struct {
+	uc       *TransactionApprovalUsecase
+	interval time.Duration
+	wakeChan chan struct{}
+	stopChan chan struct{}
+}
+
+func NewApprovalExecutor(uc *TransactionApprovalUsecase, interval time.Duration) *ApprovalExecutor {
+	return &ApprovalExecutor{
+		uc:       uc,
+		interval: interval,
+		wakeChan: make(chan struct{}, 1),
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (e *ApprovalExecutor) Start() {
+	go e.worker()
+}
+
+func (e *ApprovalExecutor) Stop() {
+	close(e.stopChan)
+}
+
+// Wake runs a pass now instead of at the next tick
+func (e *ApprovalExecutor) Wake() {
+	select {
+	case e.wakeChan <- struct{}{}:
+	default:
+	}
+}
+
+func (e *ApprovalExecutor) worker() {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			e.run()
+		case <-e.wakeChan:
+			e.run()
+		case <-e.stopChan:
+			return
+		}
+	}
+}
+
+func (e *ApprovalExecutor) run() {
+	ctx, cancel := context.WithTimeout(context.Background(), ApprovalExecutionLease)
+	defer cancel()
+
+	if expired, err := e.uc.approvalRepo.ExpirePending(ctx); err != nil {
+		fmt.Printf("[APPROVALS] Failed to expire stale requests: %v\n", err)
+	} else if expired > 0 {
+		fmt.Printf("[APPROVALS] Expired %d stale requests\n", expired)
+	}
+
+	approvals, err := e.uc.approvalRepo.ClaimApproved(ctx, ApprovalExecutorBatchSize, ApprovalExecutionLease)
+	if err != nil {
+		fmt.Printf("[APPROVALS] Failed to claim approved requests: %v\n", err)
+		return
+	}
+
+	for _, approval := range approvals {
+		e.execute(ctx, approval)
+	}
+}
+
+func (e *ApprovalExecutor) execute(ctx context.Context, approval *domain.TransactionApproval) {
+	receiptCode, err := e.uc.executeApprovedTransaction(ctx, approval)
+	if err == nil {
+		if err := e.uc.approvalRepo.MarkExecuted(ctx, approval.ID, receiptCode); err != nil {
+			// The posting is idempotent; the next claim records it
+			fmt.Printf("[APPROVALS] Failed to mark approval %d executed: %v\n", approval.ID, err)
+			return
+		}
+		fmt.Printf("[APPROVALS] ✅ Approval %d executed (receipt %s)\n", approval.ID, receiptCode)
+		return
+	}
+
+	if isRetryableTransactionError(err) && approval.ExecutionAttempts < domain.ApprovalMaxExecutionTries {
+		fmt.Printf("[APPROVALS] Approval %d attempt %d failed, retrying: %v\n", approval.ID, approval.ExecutionAttempts, err)
+		if err := e.uc.approvalRepo.ScheduleRetry(ctx, approval.ID, err.Error(), queueRetryDelay(approval.ExecutionAttempts)); err != nil {
+			fmt.Printf("[APPROVALS] Failed to reschedule approval %d: %v\n", approval.ID, err)
+		}
+		return
+	}
+
+	fmt.Printf("[APPROVALS] ❌ Approval %d failed: %v\n", approval.ID, err)
+	if err := e.uc.approvalRepo.MarkFailed(ctx, approval.ID, err.Error()); err != nil {
+		fmt.Printf("[APPROVALS] Failed to mark approval %d failed: %v\n", approval.ID, err)
+	}
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"accounting-service/internal/domain"
+)
+
+// ===============================
+// APPROVAL POLICIES
+// ===============================
+
+func (uc *TransactionApprovalUsecase) CreateApprovalPolicy(
+	ctx context.Context,
+	policy *domain.ApprovalPolicy,
+) (*domain.ApprovalPolicy, error) {
+	normalizeApprovalPolicy(policy)
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.policyRepo.Create(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("[APPROVALS] Policy %d (%s) created by %s\n", policy.ID, policy.Name, policy.CreatedBy)
+	return policy, nil
+}
+
+// UpdateApprovalPolicy replaces a policy's rules. Pending requests keep the
+// rules they were created under.
+func (uc *TransactionApprovalUsecase) UpdateApprovalPolicy(
+	ctx context.Context,
+	policy *domain.ApprovalPolicy,
+) (*domain.ApprovalPolicy, error) {
+	existing, err := uc.policyRepo.GetByID(ctx, policy.ID)
+	if err != nil {
+		return nil, err
+	}
+	policy.CreatedBy = existing.CreatedBy
+	policy.CreatedAt = existing.CreatedAt
+
+	normalizeApprovalPolicy(policy)
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.policyRepo.Update(ctx, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (uc *TransactionApprovalUsecase) ListApprovalPolicies(
+	ctx context.Context,
+	filter *domain.ApprovalPolicyFilter,
+) ([]*domain.ApprovalPolicy, error) {
+	return uc.policyRepo.List(ctx, filter)
+}
+
+func normalizeApprovalPolicy(p *domain.ApprovalPolicy) {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Currency != nil {
+		currency := strings.ToUpper(strings.TrimSpace(*p.Currency))
+		if currency == "" {
+			p.Currency = nil
+		} else {
+			p.Currency = &currency
+		}
+	}
+
+	roles := make([]string, 0, len(p.ApproverRoles))
+	seen := make(map[string]bool)
+	for _, r := range p.ApproverRoles {
+		r = strings.TrimSpace(r)
+		if r != "" && !seen[r] {
+			seen[r] = true
+			roles = append(roles, r)
+		}
+	}
+	p.ApproverRoles = roles
+}
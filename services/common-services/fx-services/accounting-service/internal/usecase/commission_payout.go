@@ -0,0 +1,269 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"accounting-service/internal/domain"
+	"accounting-service/internal/repository"
+	xerrors "x/shared/utils/errors"
+	"x/shared/utils/id"
+)
+
+const (
+	// A pending payout older than this belongs to a batch that died before
+	// recording the transfer result; the next batch for the agent resumes it
+	CommissionPayoutStaleAfter = 10 * time.Minute
+	// How long a batch resuming a stale payout holds it before another may
+	CommissionPayoutResumeLease = 5 * time.Minute
+
+	commissionPayoutDefaultLimit = 50
+	commissionPayoutMaxLimit     = 200
+)
+
+// CommissionPayoutUsecase settles accrued agent commissions. Commissions are
+// only recorded when a transaction is posted; a payout moves the net amount
+// from the system commission account to the agent account in one transfer.
+type CommissionPayoutUsecase struct {
+	payoutRepo repository.CommissionPayoutRepository
+	accountUC  *AccountUsecase
+	txUC       *TransactionUsecase
+	sf         *id.Snowflake
+}
+
+func NewCommissionPayoutUsecase(
+	payoutRepo repository.CommissionPayoutRepository,
+	accountUC *AccountUsecase,
+	txUC *TransactionUsecase,
+	sf *id.Snowflake,
+) *CommissionPayoutUsecase {
+	return &CommissionPayoutUsecase{
+		payoutRepo: payoutRepo,
+		accountUC:  accountUC,
+		txUC:       txUC,
+		sf:         sf,
+	}
+}
+
+// CreateCommissionPayoutBatch pays out unpaid commissions per agent and
+// currency. Groups below the minimum stay unpaid and accumulate into a later
+// batch. A dry run returns the same grouping without reserving or posting.
+func (uc *CommissionPayoutUsecase) CreateCommissionPayoutBatch(
+	ctx context.Context,
+	req *domain.CommissionPayoutBatchRequest,
+) (*domain.CommissionPayoutBatchResult, error) {
+	if req.Currency != nil {
+		currency := strings.ToUpper(strings.TrimSpace(*req.Currency))
+		req.Currency = &currency
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := &domain.CommissionPayoutBatchResult{DryRun: req.DryRun}
+	if !req.DryRun {
+		result.BatchID = uc.sf.Generate()
+		uc.resumeStalePayouts(ctx, req, result)
+	}
+
+	groups, err := uc.payoutRepo.SummarizeUnpaid(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, g := range groups {
+		payout := &domain.CommissionPayout{
+			BatchID:         result.BatchID,
+			AgentExternalID: g.AgentExternalID,
+			Currency:        g.Currency,
+			Amount:          g.Amount,
+			CommissionCount: g.Count,
+			PeriodFrom:      req.PeriodFrom,
+			PeriodTo:        req.PeriodTo,
+			CreatedBy:       req.CreatedBy,
+		}
+
+		// Clawbacks can leave a group net negative; it is carried forward too
+		if !g.Amount.IsPositive() || g.Amount.LessThan(req.MinPayoutAmount) {
+			result.Skipped = append(result.Skipped, payout)
+			continue
+		}
+
+		if req.DryRun {
+			result.Payouts = append(result.Payouts, payout)
+			continue
+		}
+
+		reserved, err := uc.payoutRepo.Reserve(ctx, payout, req.MinPayoutAmount)
+		if err != nil {
+			return nil, err
+		}
+		if !reserved {
+			// Taken by a concurrent batch since the summary was read
+			continue
+		}
+
+		uc.executePayout(ctx, payout, result)
+	}
+
+	if !req.DryRun {
+		fmt.Printf("[COMMISSIONS] Payout batch %s by %s: %d paid, %d failed, %d below threshold\n",
+			result.BatchID, req.CreatedBy, result.PaidCount, result.FailedCount, len(result.Skipped))
+	}
+	return result, nil
+}
+
+// resumeStalePayouts finishes payouts left pending by a crashed batch. Each
+// payout is leased to this batch first. The transfer is keyed by payout ID,
+// so one that was already posted resolves to its original receipt instead
+// of paying twice.
+func (uc *CommissionPayoutUsecase) resumeStalePayouts(
+	ctx context.Context,
+	req *domain.CommissionPayoutBatchRequest,
+	result *domain.CommissionPayoutBatchResult,
+) {
+	stale, err := uc.payoutRepo.ClaimStalePending(ctx, CommissionPayoutStaleAfter, CommissionPayoutResumeLease, req.AgentExternalID, req.Currency)
+	if err != nil {
+		fmt.Printf("[COMMISSIONS] Failed to claim stale payouts: %v\n", err)
+		return
+	}
+	for _, payout := range stale {
+		fmt.Printf("[COMMISSIONS] Resuming payout %d from batch %s\n", payout.ID, payout.BatchID)
+		uc.executePayout(ctx, payout, result)
+	}
+}
+
+func (uc *CommissionPayoutUsecase) executePayout(
+	ctx context.Context,
+	payout *domain.CommissionPayout,
+	result *domain.CommissionPayoutBatchResult,
+) {
+	receiptCode, err := uc.postPayoutTransfer(ctx, payout)
+	if err != nil {
+		errMsg := err.Error()
+		payout.Status = domain.CommissionPayoutFailed
+		payout.ErrorMessage = &errMsg
+		if markErr := uc.payoutRepo.MarkFailed(ctx, payout.ID, errMsg); markErr != nil {
+			fmt.Printf("[COMMISSIONS] Failed to record failure of payout %d: %v\n", payout.ID, markErr)
+		}
+		fmt.Printf("[COMMISSIONS] Payout %d to agent %s failed: %v\n", payout.ID, payout.AgentExternalID, err)
+		result.FailedCount++
+		result.Payouts = append(result.Payouts, payout)
+		return
+	}
+
+	// The transfer is posted; if recording fails the payout stays pending and
+	// is resumed (idempotently) by a later batch
+	if err := uc.payoutRepo.MarkPaid(ctx, payout.ID, receiptCode); err != nil {
+		fmt.Printf("[COMMISSIONS] Payout %d posted as %s but not recorded: %v\n", payout.ID, receiptCode, err)
+		payout.ReceiptCode = &receiptCode
+		result.Payouts = append(result.Payouts, payout)
+		return
+	}
+
+	now := time.Now()
+	payout.Status = domain.CommissionPayoutPaid
+	payout.ReceiptCode = &receiptCode
+	payout.PaidAt = &now
+	result.PaidCount++
+	result.Payouts = append(result.Payouts, payout)
+}
+
+// postPayoutTransfer posts the payout through the transaction usecase under
+// an idempotency key derived from the payout ID
+func (uc *CommissionPayoutUsecase) postPayoutTransfer(
+	ctx context.Context,
+	payout *domain.CommissionPayout,
+) (string, error) {
+	commissionAccount, err := uc.accountUC.GetSystemAccount(ctx, payout.Currency, domain.PurposeCommission)
+	if err != nil {
+		return "", fmt.Errorf("failed to get system commission account: %w", err)
+	}
+
+	agentAccount, err := uc.accountUC.GetAgentAccount(ctx, payout.AgentExternalID, payout.Currency)
+	if err != nil {
+		return "", fmt.Errorf("failed to get agent account: %w", err)
+	}
+
+	txReq := buildCommissionPayoutDoubleEntry(payout, commissionAccount, agentAccount)
+
+	txResult, err := uc.txUC.ExecuteTransactionSync(ctx, txReq)
+	if errors.Is(err, xerrors.ErrDuplicateIdempotencyKey) {
+		// Posted by an earlier attempt; the payout is paid with that receipt
+		if existing := uc.txUC.checkIdempotency(ctx, *txReq.IdempotencyKey); existing != nil {
+			return existing.ReceiptCode, nil
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return txResult.ReceiptCode, nil
+}
+
+func (uc *CommissionPayoutUsecase) ListCommissionPayouts(
+	ctx context.Context,
+	filter *domain.CommissionPayoutFilter,
+) ([]*domain.CommissionPayout, int64, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = commissionPayoutDefaultLimit
+	}
+	if filter.Limit > commissionPayoutMaxLimit {
+		filter.Limit = commissionPayoutMaxLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return uc.payoutRepo.List(ctx, filter)
+}
+
+// buildCommissionPayoutDoubleEntry moves a payout from the system commission
+// account to the agent account
+func buildCommissionPayoutDoubleEntry(
+	payout *domain.CommissionPayout,
+	commissionAccount *domain.Account,
+	agentAccount *domain.Account,
+) *domain.TransactionRequest {
+	idempotencyKey := fmt.Sprintf("commission-payout:%d", payout.ID)
+	metadata := map[string]interface{}{
+		"agent_id":         payout.AgentExternalID,
+		"payout_id":        payout.ID,
+		"batch_id":         payout.BatchID,
+		"commission_count": payout.CommissionCount,
+		"period_to":        payout.PeriodTo.Format(time.RFC3339),
+	}
+	if payout.PeriodFrom != nil {
+		metadata["period_from"] = payout.PeriodFrom.Format(time.RFC3339)
+	}
+
+	return &domain.TransactionRequest{
+		IdempotencyKey:      &idempotencyKey,
+		TransactionType:     domain.TransactionTypeCommission,
+		AccountType:         domain.AccountTypeReal,
+		Description:         ptrString(fmt.Sprintf("Commission payout %d (%d commissions)", payout.ID, payout.CommissionCount)),
+		CreatedByExternalID: ptrString(payout.CreatedBy),
+		CreatedByType:       ptrOwnerType(domain.OwnerTypeAdmin),
+		IsSystemTransaction: true,
+		Entries: []*domain.LedgerEntryRequest{
+			{
+				AccountNumber: commissionAccount.AccountNumber,
+				Amount:        payout.Amount,
+				DrCr:          domain.DrCrDebit,
+				Currency:      payout.Currency,
+				Description:   ptrString(fmt.Sprintf("Commission payout to agent %s", payout.AgentExternalID)),
+				Metadata:      metadata,
+			},
+			{
+				AccountNumber: agentAccount.AccountNumber,
+				Amount:        payout.Amount,
+				DrCr:          domain.DrCrCredit,
+				Currency:      payout.Currency,
+				Description:   ptrString(fmt.Sprintf("Commission payout %d", payout.ID)),
+				Metadata:      metadata,
+			},
+		},
+		GenerateReceipt: true,
+	}
+}
@@ -0,0 +1,327 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"accounting-service/internal/config"
+	"accounting-service/internal/domain"
+	"accounting-service/internal/repository"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// DemoAccountUsecase tops up and resets demo wallets. Every refill posts a
+// demo_funding journal against the currency's demo liquidity account, so
+// demo balances stay explained by the demo ledger and never touch real money.
+type DemoAccountUsecase struct {
+	refillRepo   repository.DemoRefillRepository
+	accountRepo  repository.AccountRepository
+	currencyRepo repository.CurrencyRepository
+	accountUC    *AccountUsecase
+	txUC         *TransactionUsecase
+
+	defaultBalances    map[string]decimal.Decimal
+	topUpCooldown      time.Duration
+	resetCooldown      time.Duration
+	maxBalanceMultiple int64
+	liquidityFloat     decimal.Decimal
+}
+
+func NewDemoAccountUsecase(
+	refillRepo repository.DemoRefillRepository,
+	accountRepo repository.AccountRepository,
+	currencyRepo repository.CurrencyRepository,
+	accountUC *AccountUsecase,
+	txUC *TransactionUsecase,
+	cfg config.DemoConfig,
+) *DemoAccountUsecase {
+	uc := &DemoAccountUsecase{
+		refillRepo:         refillRepo,
+		accountRepo:        accountRepo,
+		currencyRepo:       currencyRepo,
+		accountUC:          accountUC,
+		txUC:               txUC,
+		defaultBalances:    make(map[string]decimal.Decimal, len(cfg.DefaultBalances)),
+		topUpCooldown:      cfg.TopUpCooldown,
+		resetCooldown:      cfg.ResetCooldown,
+		maxBalanceMultiple: cfg.MaxBalanceMultiple,
+	}
+
+	for currency, value := range cfg.DefaultBalances {
+		amount, err := decimal.NewFromString(value)
+		if err != nil || amount.IsNegative() {
+			fmt.Printf("[DEMO] Ignoring invalid default balance %q for %s\n", value, currency)
+			continue
+		}
+		uc.defaultBalances[strings.ToUpper(currency)] = amount
+	}
+
+	float, err := decimal.NewFromString(cfg.LiquidityFloat)
+	if err != nil || !float.IsPositive() {
+		fmt.Printf("[DEMO] Invalid demo liquidity float %q, using 1000000000\n", cfg.LiquidityFloat)
+		float = decimal.NewFromInt(1_000_000_000)
+	}
+	uc.liquidityFloat = float
+
+	return uc
+}
+
+// EnsureLiquidityAccounts creates the demo liquidity account of every
+// demo-enabled currency that lacks one. Refills also create it on first use.
+func (uc *DemoAccountUsecase) EnsureLiquidityAccounts(ctx context.Context) error {
+	currencies, err := uc.currencyRepo.ListDemoCurrencies(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list demo currencies: %w", err)
+	}
+	for _, currency := range currencies {
+		if _, err := uc.accountRepo.EnsureDemoLiquidityAccount(ctx, currency.Code, uc.liquidityFloat); err != nil {
+			return fmt.Errorf("failed to ensure demo liquidity for %s: %w", currency.Code, err)
+		}
+	}
+	return nil
+}
+
+// TopUp adds play money to the owner's demo wallet: the requested amount or
+// the currency's default demo balance. With a balance cap configured the
+// top-up is trimmed to the cap, and rejected once the wallet is at it.
+func (uc *DemoAccountUsecase) TopUp(ctx context.Context, req *domain.DemoRefillRequest) (*domain.DemoRefill, error) {
+	currency, wallet, err := uc.resolve(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defaultBalance := uc.DefaultBalance(currency)
+
+	amount := defaultBalance
+	if req.Amount != nil {
+		amount = currency.Round(*req.Amount)
+	}
+	if !amount.IsPositive() {
+		return nil, xerrors.ErrInvalidAmount
+	}
+
+	balance := walletBalance(wallet)
+	if uc.maxBalanceMultiple > 0 {
+		limit := defaultBalance.Mul(decimal.NewFromInt(uc.maxBalanceMultiple))
+		if balance.GreaterThanOrEqual(limit) {
+			return nil, fmt.Errorf("%w: balance %s is at the %s %s cap",
+				xerrors.ErrDemoBalanceCap, balance, limit, currency.Code)
+		}
+		if balance.Add(amount).GreaterThan(limit) {
+			amount = limit.Sub(balance)
+		}
+	}
+
+	return uc.refill(ctx, req, wallet, domain.DemoRefillTopUp, amount, uc.topUpCooldown)
+}
+
+// Reset moves the owner's demo wallet back to the default demo balance,
+// crediting or debiting the difference. A wallet already at the default is
+// left alone and does not start the cooldown.
+func (uc *DemoAccountUsecase) Reset(ctx context.Context, req *domain.DemoRefillRequest) (*domain.DemoRefill, error) {
+	currency, wallet, err := uc.resolve(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defaultBalance := uc.DefaultBalance(currency)
+
+	balance := walletBalance(wallet)
+	amount := defaultBalance.Sub(balance)
+	if amount.IsZero() {
+		now := time.Now()
+		return &domain.DemoRefill{
+			AccountID:       wallet.ID,
+			AccountNumber:   wallet.AccountNumber,
+			OwnerID:         wallet.OwnerID,
+			Currency:        wallet.Currency,
+			Action:          domain.DemoRefillReset,
+			Amount:          decimal.Zero,
+			BalanceBefore:   balance,
+			BalanceAfter:    balance,
+			Status:          domain.DemoRefillPosted,
+			RequestedBy:     req.RequestedBy,
+			CreatedAt:       now,
+			NextAvailableAt: now,
+		}, nil
+	}
+
+	return uc.refill(ctx, req, wallet, domain.DemoRefillReset, amount, uc.resetCooldown)
+}
+
+// DefaultBalance is the configured demo balance for the currency, falling
+// back to currencies.demo_initial_balance
+func (uc *DemoAccountUsecase) DefaultBalance(currency *domain.Currency) decimal.Decimal {
+	if amount, ok := uc.defaultBalances[currency.Code]; ok {
+		return currency.Round(amount)
+	}
+	return currency.DemoInitialBalance
+}
+
+// resolve checks the currency allows demo trading and loads the owner's
+// demo wallet with its balance
+func (uc *DemoAccountUsecase) resolve(
+	ctx context.Context,
+	req *domain.DemoRefillRequest,
+) (*domain.Currency, *domain.Account, error) {
+	if req.OwnerID == "" || req.Currency == "" || req.RequestedBy == "" {
+		return nil, nil, xerrors.ErrRequiredFieldMissing
+	}
+	if req.OwnerType == domain.OwnerTypeSystem {
+		return nil, nil, xerrors.ErrInvalidOwnerType
+	}
+
+	currencyCode := strings.ToUpper(req.Currency)
+	currency, err := uc.currencyRepo.GetCurrency(ctx, currencyCode)
+	if err != nil {
+		if errors.Is(err, xerrors.ErrNotFound) {
+			return nil, nil, xerrors.ErrCurrencyNotSupported
+		}
+		return nil, nil, err
+	}
+	if !currency.SupportsDemo() {
+		return nil, nil, fmt.Errorf("%w: %s", xerrors.ErrDemoNotSupported, currency.Code)
+	}
+
+	accounts, err := uc.accountUC.GetByOwner(ctx, req.OwnerType, req.OwnerID, domain.AccountTypeDemo)
+	if err != nil {
+		return nil, nil, err
+	}
+	var walletNumber string
+	for _, account := range accounts {
+		if account.Currency == currency.Code && account.Purpose == domain.PurposeWallet && !account.IsSubAccount() {
+			walletNumber = account.AccountNumber
+			break
+		}
+	}
+	if walletNumber == "" {
+		return nil, nil, fmt.Errorf("%w: %s %s has no %s demo wallet",
+			xerrors.ErrDemoAccountNotFound, req.OwnerType, req.OwnerID, currency.Code)
+	}
+
+	wallet, err := uc.accountUC.GetAccountWithBalance(ctx, walletNumber)
+	if err != nil {
+		return nil, nil, err
+	}
+	if wallet.AccountType != domain.AccountTypeDemo {
+		return nil, nil, xerrors.ErrDemoRealAccountMix
+	}
+	return currency, wallet, nil
+}
+
+// refill reserves the refill against its cooldown, then posts the journal.
+// A failed post releases the reservation so the owner can try again at once.
+func (uc *DemoAccountUsecase) refill(
+	ctx context.Context,
+	req *domain.DemoRefillRequest,
+	wallet *domain.Account,
+	action domain.DemoRefillAction,
+	amount decimal.Decimal,
+	cooldown time.Duration,
+) (*domain.DemoRefill, error) {
+	liquidity, err := uc.accountRepo.EnsureDemoLiquidityAccount(ctx, wallet.Currency, uc.liquidityFloat)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := walletBalance(wallet)
+	refill := &domain.DemoRefill{
+		AccountID:     wallet.ID,
+		AccountNumber: wallet.AccountNumber,
+		OwnerID:       wallet.OwnerID,
+		Currency:      wallet.Currency,
+		Action:        action,
+		Amount:        amount,
+		BalanceBefore: balance,
+		BalanceAfter:  balance.Add(amount),
+		RequestedBy:   req.RequestedBy,
+	}
+	if err := uc.refillRepo.Reserve(ctx, refill, cooldown); err != nil {
+		return nil, err
+	}
+
+	receiptCode, err := uc.post(ctx, refill, liquidity, req)
+	if err != nil {
+		if markErr := uc.refillRepo.MarkFailed(ctx, refill.ID, err.Error()); markErr != nil {
+			fmt.Printf("[DEMO] Failed to release refill %d: %v\n", refill.ID, markErr)
+		}
+		return nil, err
+	}
+
+	if err := uc.refillRepo.MarkPosted(ctx, refill, receiptCode); err != nil {
+		// The journal is posted; the refill row is the only thing behind
+		fmt.Printf("[DEMO] Refill %d posted as %s but not recorded: %v\n", refill.ID, receiptCode, err)
+		refill.Status = domain.DemoRefillPosted
+		refill.ReceiptCode = &receiptCode
+	}
+	refill.NextAvailableAt = refill.CreatedAt.Add(cooldown)
+
+	fmt.Printf("[DEMO] %s of %s: %s %s (balance %s -> %s) by %s\n",
+		action, wallet.AccountNumber, amount, wallet.Currency, refill.BalanceBefore, refill.BalanceAfter, req.RequestedBy)
+	return refill, nil
+}
+
+// post writes the demo_funding journal between the demo liquidity account
+// and the wallet, in whichever direction the refill's sign says
+func (uc *DemoAccountUsecase) post(
+	ctx context.Context,
+	refill *domain.DemoRefill,
+	liquidity *domain.Account,
+	req *domain.DemoRefillRequest,
+) (string, error) {
+	from, to := liquidity.AccountNumber, refill.AccountNumber
+	if refill.Amount.IsNegative() {
+		from, to = to, from
+	}
+	amount := refill.Amount.Abs()
+	idempotencyKey := refill.IdempotencyKey()
+	description := fmt.Sprintf("Demo %s", strings.ReplaceAll(string(refill.Action), "_", "-"))
+	metadata := map[string]interface{}{
+		"demo_refill_id": refill.ID,
+		"action":         refill.Action,
+		"requested_by":   refill.RequestedBy,
+	}
+
+	txResult, err := uc.txUC.ExecuteTransactionSync(ctx, &domain.TransactionRequest{
+		IdempotencyKey:      &idempotencyKey,
+		TransactionType:     domain.TransactionTypeDemoFunding,
+		AccountType:         domain.AccountTypeDemo,
+		Description:         ptrString(description),
+		CreatedByExternalID: ptrString(req.RequestedBy),
+		CreatedByType:       ptrOwnerType(req.OwnerType),
+		IsSystemTransaction: true,
+		Entries: []*domain.LedgerEntryRequest{
+			{
+				AccountNumber: from,
+				Amount:        amount,
+				DrCr:          domain.DrCrDebit,
+				Currency:      refill.Currency,
+				Description:   ptrString(description),
+				Metadata:      metadata,
+			},
+			{
+				AccountNumber: to,
+				Amount:        amount,
+				DrCr:          domain.DrCrCredit,
+				Currency:      refill.Currency,
+				Description:   ptrString(description),
+				Metadata:      metadata,
+			},
+		},
+		GenerateReceipt: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	return txResult.ReceiptCode, nil
+}
+
+func walletBalance(account *domain.Account) decimal.Decimal {
+	if account.Balance == nil {
+		return decimal.Zero
+	}
+	return account.Balance.Balance
+}
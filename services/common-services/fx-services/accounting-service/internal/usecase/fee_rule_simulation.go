@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"accounting-service/internal/domain"
+
+	xerrors "x/shared/utils/errors"
+)
+
+// ===============================
+// FEE RULE SIMULATION
+// ===============================
+
+// SimulateFeeRules replays historical journals in [From, To) against the
+// current active rules and a proposed rule set, and reports platform fee
+// revenue per transaction type and currency. Nothing is written.
+func (uc *TransactionFeeUsecase) SimulateFeeRules(
+	ctx context.Context,
+	req *domain.FeeRuleSimulationRequest,
+) (*domain.FeeRuleSimulationResult, error) {
+	if req.From.IsZero() || req.To.IsZero() || !req.To.After(req.From) {
+		return nil, fmt.Errorf("%w: to must be after from", xerrors.ErrInvalidDateRange)
+	}
+	if req.To.Sub(req.From) > domain.MaxFeeSimulationRange {
+		return nil, fmt.Errorf("%w: range cannot exceed %d days",
+			xerrors.ErrInvalidDateRange, int(domain.MaxFeeSimulationRange.Hours()/24))
+	}
+	for i, rule := range req.Rules {
+		if rule == nil || !rule.IsValid() {
+			return nil, fmt.Errorf("%w: proposed rule %d is incomplete", xerrors.ErrInvalidInput, i)
+		}
+	}
+
+	types := req.TransactionTypes
+	if len(types) == 0 {
+		types = domain.PricedTransactionTypes
+	}
+	limit := req.MaxTransactions
+	if limit <= 0 {
+		limit = domain.DefaultFeeSimulationMaxTransactions
+	}
+	if limit > domain.MaxFeeSimulationTransactions {
+		limit = domain.MaxFeeSimulationTransactions
+	}
+
+	// Current rules come from the database, not the match cache, so the
+	// baseline is what the calculator would use once caches expire
+	current, err := uc.feeRuleRepo.ListActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load active fee rules: %w", err)
+	}
+	proposed := domain.ApplyFeeRuleChanges(current, req.Rules, req.RemoveRuleIDs)
+
+	samples, err := uc.feeRepo.ListSimulationSamples(ctx, req.From, req.To, types, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load historical transactions: %w", err)
+	}
+
+	result := &domain.FeeRuleSimulationResult{
+		From: req.From,
+		To:   req.To,
+	}
+	if len(samples) > limit {
+		samples = samples[:limit]
+		result.Truncated = true
+	}
+	result.TransactionCount = len(samples)
+
+	scales := make(map[string]int32)
+	groups := make(map[string]*domain.FeeSimulationGroup)
+
+	for _, sample := range samples {
+		key := string(sample.TransactionType) + ":" + sample.SourceCurrency
+		group, ok := groups[key]
+		if !ok {
+			group = &domain.FeeSimulationGroup{
+				TransactionType: sample.TransactionType,
+				Currency:        sample.SourceCurrency,
+			}
+			groups[key] = group
+		}
+		group.TransactionCount++
+		group.ChargedRevenue = group.ChargedRevenue.Add(sample.ChargedFee)
+
+		scale, ok := scales[sample.SourceCurrency]
+		if !ok {
+			scale, err = uc.feeCalculator.CurrencyScale(ctx, sample.SourceCurrency)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load currency %s: %w", sample.SourceCurrency, err)
+			}
+			scales[sample.SourceCurrency] = scale
+		}
+
+		source := sample.SourceCurrency
+		var target *string
+		if sample.TargetCurrency != "" && sample.TargetCurrency != sample.SourceCurrency {
+			target = &sample.TargetCurrency
+		}
+		accountType := sample.AccountType
+
+		currentFee, currentErr := uc.feeCalculator.SimulatePlatformFee(
+			current, sample.TransactionType, sample.Amount, &source, target, &accountType, sample.OwnerType, scale)
+		proposedFee, proposedErr := uc.feeCalculator.SimulatePlatformFee(
+			proposed, sample.TransactionType, sample.Amount, &source, target, &accountType, sample.OwnerType, scale)
+		if currentErr != nil || proposedErr != nil {
+			group.FailedCount++
+			continue
+		}
+
+		group.CurrentRevenue = group.CurrentRevenue.Add(currentFee.Amount)
+		group.ProposedRevenue = group.ProposedRevenue.Add(proposedFee.Amount)
+		if !currentFee.Amount.Equal(proposedFee.Amount) {
+			group.ChangedCount++
+		}
+	}
+
+	result.Groups = make([]*domain.FeeSimulationGroup, 0, len(groups))
+	for _, group := range groups {
+		group.Delta = group.ProposedRevenue.Sub(group.CurrentRevenue)
+		result.Groups = append(result.Groups, group)
+	}
+	sort.Slice(result.Groups, func(i, j int) bool {
+		a, b := result.Groups[i], result.Groups[j]
+		if a.TransactionType != b.TransactionType {
+			return a.TransactionType < b.TransactionType
+		}
+		return a.Currency < b.Currency
+	})
+
+	fmt.Printf("[FEE SIM] %s - %s: %d transactions, %d groups, %d proposed rules, truncated=%v\n",
+		req.From.Format("2006-01-02"), req.To.Format("2006-01-02"),
+		result.TransactionCount, len(result.Groups), len(proposed), result.Truncated)
+
+	return result, nil
+}
@@ -0,0 +1,476 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+	"accounting-service/internal/repository"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	InterestExecutorInterval    = time.Hour
+	InterestAccrualBatchSize    = 500
+	InterestPostingBatchSize    = 100
+	InterestAccrualCatchUpDays  = 7               // Days re-checked each pass, so a missed day is accrued late rather than never
+	InterestPostingLease        = 5 * time.Minute // A claimed posting is retried after this if its executor died
+	InterestPostingRetryDelay   = time.Hour       // Between attempts of a posting that could not be credited
+	InterestPostingMaxAttempts  = 24              // Then the posting fails and its accruals roll into the next one
+	interestPostingDefaultLimit = 50
+	interestPostingMaxLimit     = 500
+)
+
+// InterestUsecase manages interest rate plans and runs the executor that
+// accrues interest daily and capitalizes it monthly. Interest is paid from
+// the system interest_expense account of the currency through the normal
+// double-entry path.
+type InterestUsecase struct {
+	interestRepo repository.InterestRepository
+	accountUC    *AccountUsecase
+	txUC         *TransactionUsecase
+	executor     *InterestExecutor
+}
+
+func NewInterestUsecase(
+	interestRepo repository.InterestRepository,
+	accountUC *AccountUsecase,
+	txUC *TransactionUsecase,
+) *InterestUsecase {
+	uc := &InterestUsecase{
+		interestRepo: interestRepo,
+		accountUC:    accountUC,
+		txUC:         txUC,
+	}
+	uc.executor = NewInterestExecutor(uc, InterestExecutorInterval)
+	return uc
+}
+
+// ===============================
+// RATE PLANS
+// ===============================
+
+// CreateRatePlan stores a plan and makes sure the currency has an
+// interest_expense system account to pay it from
+func (uc *InterestUsecase) CreateRatePlan(
+	ctx context.Context,
+	plan *domain.InterestRatePlan,
+) (*domain.InterestRatePlan, error) {
+	if err := plan.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ensureInterestExpenseAccount(ctx, plan.Currency); err != nil {
+		return nil, err
+	}
+
+	if err := uc.interestRepo.CreatePlan(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("[INTEREST] Rate plan %d created: %s %s at %s (%s, %d days)\n",
+		plan.ID, plan.Currency, plan.Purpose, plan.AnnualRate, plan.Method, plan.DayCount)
+	return plan, nil
+}
+
+// UpdateRatePlan changes a plan's terms. Currency and purpose cannot change;
+// create a new plan instead.
+func (uc *InterestUsecase) UpdateRatePlan(
+	ctx context.Context,
+	plan *domain.InterestRatePlan,
+) (*domain.InterestRatePlan, error) {
+	existing, err := uc.interestRepo.GetPlan(ctx, plan.ID)
+	if err != nil {
+		return nil, err
+	}
+	plan.Currency = existing.Currency
+	plan.Purpose = existing.Purpose
+
+	if err := plan.Validate(); err != nil {
+		return nil, err
+	}
+	if err := uc.interestRepo.UpdatePlan(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("[INTEREST] Rate plan %d updated: %s (%s, active=%t)\n",
+		plan.ID, plan.AnnualRate, plan.Method, plan.IsActive)
+	return plan, nil
+}
+
+func (uc *InterestUsecase) ListRatePlans(
+	ctx context.Context,
+	currency *string,
+	activeOnly bool,
+) ([]*domain.InterestRatePlan, error) {
+	return uc.interestRepo.ListPlans(ctx, currency, activeOnly)
+}
+
+// ensureInterestExpenseAccount creates the currency's interest_expense system
+// account if it is missing. It starts empty and must be funded before
+// postings in that currency can be credited.
+func (uc *InterestUsecase) ensureInterestExpenseAccount(ctx context.Context, currency string) error {
+	_, err := uc.accountUC.GetSystemAccount(ctx, currency, domain.PurposeInterestExpense)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, xerrors.ErrNotFound) {
+		return fmt.Errorf("failed to get interest expense account: %w", err)
+	}
+
+	tx, err := uc.accountUC.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	account, err := uc.accountUC.CreateAccount(ctx, &domain.CreateAccountRequest{
+		OwnerType:      domain.OwnerTypeSystem,
+		OwnerID:        "system",
+		Currency:       currency,
+		Purpose:        domain.PurposeInterestExpense,
+		AccountType:    domain.AccountTypeReal,
+		OverdraftLimit: decimal.Zero,
+	}, tx)
+	if err != nil {
+		return fmt.Errorf("failed to create interest expense account: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit interest expense account: %w", err)
+	}
+	_ = uc.accountUC.InvalidateSystemAccountCache(ctx)
+
+	fmt.Printf("[INTEREST] Created %s interest expense account %s; fund it before the first posting\n",
+		currency, account.AccountNumber)
+	return nil
+}
+
+// ===============================
+// QUERIES
+// ===============================
+
+func (uc *InterestUsecase) ListPostings(
+	ctx context.Context,
+	filter *domain.InterestPostingFilter,
+) ([]*domain.InterestPosting, int64, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = interestPostingDefaultLimit
+	}
+	if filter.Limit > interestPostingMaxLimit {
+		filter.Limit = interestPostingMaxLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return uc.interestRepo.ListPostings(ctx, filter)
+}
+
+// GetAccountInterest returns the interest accrued and paid on an account in
+// [from, to) with the postings that paid it
+func (uc *InterestUsecase) GetAccountInterest(
+	ctx context.Context,
+	accountNumber string,
+	from, to time.Time,
+) (*domain.InterestSummary, []*domain.InterestPosting, error) {
+	account, err := uc.accountUC.GetByAccountNumber(ctx, accountNumber)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summary, err := uc.interestRepo.GetSummary(ctx, account.ID, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+	if summary == nil {
+		summary = &domain.InterestSummary{}
+	}
+
+	postings, _, err := uc.interestRepo.ListPostings(ctx, &domain.InterestPostingFilter{
+		AccountNumber: &accountNumber,
+		Limit:         interestPostingDefaultLimit,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return summary, postings, nil
+}
+
+// ===============================
+// ACCRUAL AND POSTING
+// ===============================
+
+// RunInterest accrues every day of the catch-up window up to and including
+// through, reserves postings for accruals of completed months and credits
+// them. Each step skips work already done, so a pass can be re-run safely.
+func (uc *InterestUsecase) RunInterest(ctx context.Context, through time.Time) (*domain.InterestRunResult, error) {
+	through = truncateToDay(through)
+	today := truncateToDay(time.Now())
+	if !through.Before(today) {
+		return nil, fmt.Errorf("%w: interest can only accrue for completed days", xerrors.ErrInvalidDateRange)
+	}
+
+	result := &domain.InterestRunResult{PostedInterest: decimal.Zero}
+
+	for day := through.AddDate(0, 0, 1-InterestAccrualCatchUpDays); !day.After(through); day = day.AddDate(0, 0, 1) {
+		count, err := uc.accrueDay(ctx, day)
+		if err != nil {
+			return result, err
+		}
+		if count > 0 {
+			result.AccrualDates = append(result.AccrualDates, day)
+			result.AccrualCount += count
+		}
+	}
+
+	monthStart := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for {
+		created, err := uc.interestRepo.ReserveDuePostings(ctx, monthStart, InterestPostingBatchSize)
+		if err != nil {
+			return result, err
+		}
+		result.PostingCount += created
+		if created < InterestPostingBatchSize {
+			break
+		}
+	}
+
+	uc.postDue(ctx, result)
+
+	return result, nil
+}
+
+// accrueDay writes the day's accrual for every account under an active plan
+func (uc *InterestUsecase) accrueDay(ctx context.Context, day time.Time) (int, error) {
+	var total int
+	var afterID int64
+	for {
+		candidates, err := uc.interestRepo.ListAccrualCandidates(ctx, day, afterID, InterestAccrualBatchSize)
+		if err != nil {
+			return total, err
+		}
+		if len(candidates) == 0 {
+			return total, nil
+		}
+
+		accruals := make([]*domain.InterestAccrual, 0, len(candidates))
+		for _, c := range candidates {
+			if accrual := c.DailyAccrual(day); accrual != nil {
+				accruals = append(accruals, accrual)
+			}
+		}
+		afterID = candidates[len(candidates)-1].AccountID
+
+		inserted, err := uc.interestRepo.CreateAccruals(ctx, accruals)
+		if err != nil {
+			return total, err
+		}
+		total += inserted
+
+		if len(candidates) < InterestAccrualBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// postDue credits claimed postings until none are due
+func (uc *InterestUsecase) postDue(ctx context.Context, result *domain.InterestRunResult) {
+	for {
+		postings, err := uc.interestRepo.ClaimDuePostings(ctx, InterestPostingBatchSize, InterestPostingLease)
+		if err != nil {
+			fmt.Printf("[INTEREST] Failed to claim due postings: %v\n", err)
+			return
+		}
+
+		for _, p := range postings {
+			if uc.post(ctx, p) {
+				result.PostedCount++
+				result.PostedInterest = result.PostedInterest.Add(p.Amount)
+			} else {
+				result.FailedCount++
+			}
+		}
+
+		if len(postings) < InterestPostingBatchSize {
+			return
+		}
+	}
+}
+
+// post credits one posting. A failed attempt is retried every
+// InterestPostingRetryDelay; after InterestPostingMaxAttempts the posting
+// fails and its accruals are picked up by the account's next posting.
+func (uc *InterestUsecase) post(ctx context.Context, p *domain.InterestPosting) bool {
+	// Accruals that round to nothing are settled without a transfer; the
+	// remainder carries over
+	if !p.Amount.IsPositive() {
+		if err := uc.interestRepo.MarkPosted(ctx, p.ID, nil); err != nil {
+			fmt.Printf("[INTEREST] Failed to settle posting %d: %v\n", p.ID, err)
+			return false
+		}
+		return true
+	}
+
+	receiptCode, err := uc.postInterestTransfer(ctx, p)
+	if err != nil {
+		attempts := p.Attempts + 1
+		giveUp := attempts >= InterestPostingMaxAttempts
+		retryAt := time.Now().Add(InterestPostingRetryDelay)
+		fmt.Printf("[INTEREST] Posting %d (%s %s to %s) attempt %d failed: %v\n",
+			p.ID, p.Amount, p.Currency, p.AccountNumber, attempts, err)
+		if err := uc.interestRepo.RecordPostingFailure(ctx, p.ID, err.Error(), retryAt, giveUp); err != nil {
+			fmt.Printf("[INTEREST] Failed to record failure of posting %d: %v\n", p.ID, err)
+		}
+		return false
+	}
+
+	if err := uc.interestRepo.MarkPosted(ctx, p.ID, &receiptCode); err != nil {
+		// The lease runs out and the posting is claimed again; its
+		// idempotency key returns the original transfer
+		fmt.Printf("[INTEREST] Failed to mark posting %d posted: %v\n", p.ID, err)
+		return false
+	}
+
+	fmt.Printf("[INTEREST] ✅ Posted %s %s to %s for %s..%s (receipt %s)\n",
+		p.Amount, p.Currency, p.AccountNumber,
+		p.PeriodFrom.Format("2006-01-02"), p.PeriodTo.Format("2006-01-02"), receiptCode)
+	return true
+}
+
+// postInterestTransfer moves the posting from the interest_expense system
+// account to the customer account under the posting's idempotency key
+func (uc *InterestUsecase) postInterestTransfer(ctx context.Context, p *domain.InterestPosting) (string, error) {
+	expenseAccount, err := uc.accountUC.GetSystemAccount(ctx, p.Currency, domain.PurposeInterestExpense)
+	if err != nil {
+		return "", fmt.Errorf("failed to get system interest expense account: %w", err)
+	}
+
+	txResult, err := uc.txUC.ExecuteTransactionSync(ctx, buildInterestPostingDoubleEntry(p, expenseAccount))
+	if err != nil {
+		return "", err
+	}
+	return txResult.ReceiptCode, nil
+}
+
+func buildInterestPostingDoubleEntry(
+	p *domain.InterestPosting,
+	expenseAccount *domain.Account,
+) *domain.TransactionRequest {
+	idempotencyKey := p.IdempotencyKey()
+	period := fmt.Sprintf("%s..%s", p.PeriodFrom.Format("2006-01-02"), p.PeriodTo.Format("2006-01-02"))
+	metadata := map[string]interface{}{
+		"interest_posting_id": p.ID,
+		"period_from":         p.PeriodFrom.Format("2006-01-02"),
+		"period_to":           p.PeriodTo.Format("2006-01-02"),
+		"accrual_count":       p.AccrualCount,
+		"accrued":             p.Accrued.String(),
+		"remainder":           p.Remainder.String(),
+	}
+
+	return &domain.TransactionRequest{
+		IdempotencyKey:      &idempotencyKey,
+		TransactionType:     domain.TransactionTypeAdjustment,
+		AccountType:         domain.AccountTypeReal,
+		Description:         ptrString(fmt.Sprintf("Interest %s", period)),
+		CreatedByExternalID: ptrString("system"),
+		CreatedByType:       ptrOwnerType(domain.OwnerTypeSystem),
+		IsSystemTransaction: true,
+		Entries: []*domain.LedgerEntryRequest{
+			{
+				AccountNumber: expenseAccount.AccountNumber,
+				Amount:        p.Amount,
+				DrCr:          domain.DrCrDebit,
+				Currency:      p.Currency,
+				Description:   ptrString(fmt.Sprintf("Interest paid to %s", p.AccountNumber)),
+				Metadata:      metadata,
+			},
+			{
+				AccountNumber: p.AccountNumber,
+				Amount:        p.Amount,
+				DrCr:          domain.DrCrCredit,
+				Currency:      p.Currency,
+				Description:   ptrString(fmt.Sprintf("Interest %s", period)),
+				Metadata:      metadata,
+			},
+		},
+		GenerateReceipt: true,
+	}
+}
+
+func truncateToDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// StartExecutor starts the background worker that accrues and posts interest
+func (uc *InterestUsecase) StartExecutor() {
+	uc.executor.Start()
+}
+
+func (uc *InterestUsecase) StopExecutor() {
+	uc.executor.Stop()
+}
+
+// ===============================
+// INTEREST EXECUTOR
+// ===============================
+
+// InterestExecutor runs RunInterest for yesterday on every tick. Days and
+// postings already done are skipped, so hourly passes only pick up new
+// accounts, missed days and postings due for a retry.
+type InterestExecutor struct {
+	uc       *InterestUsecase
+	interval time.Duration
+	stopChan chan struct{}
+}
+
+func NewInterestExecutor(uc *InterestUsecase, interval time.Duration) *InterestExecutor {
+	return &InterestExecutor{
+		uc:       uc,
+		interval: interval,
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (e *InterestExecutor) Start() {
+	go e.worker()
+}
+
+func (e *InterestExecutor) Stop() {
+	close(e.stopChan)
+}
+
+func (e *InterestExecutor) worker() {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	e.run()
+	for {
+		select {
+		case <-ticker.C:
+			e.run()
+		case <-e.stopChan:
+			return
+		}
+	}
+}
+
+func (e *InterestExecutor) run() {
+	ctx, cancel := context.WithTimeout(context.Background(), e.interval)
+	defer cancel()
+
+	yesterday := truncateToDay(time.Now()).AddDate(0, 0, -1)
+	result, err := e.uc.RunInterest(ctx, yesterday)
+	if err != nil {
+		fmt.Printf("[INTEREST] Pass through %s failed: %v\n", yesterday.Format("2006-01-02"), err)
+		return
+	}
+	if result.AccrualCount > 0 || result.PostingCount > 0 || result.PostedCount > 0 || result.FailedCount > 0 {
+		fmt.Printf("[INTEREST] Pass through %s: %d accruals, %d postings reserved, %d posted, %d failed\n",
+			yesterday.Format("2006-01-02"), result.AccrualCount, result.PostingCount, result.PostedCount, result.FailedCount)
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
 )
 
 type LedgerUsecase struct {
@@ -285,14 +286,14 @@ func (uc *LedgerUsecase) GetAccountTotals(
 	}
 
 	// Calculate totals
-	var totalDebits, totalCredits float64
+	totalDebits, totalCredits := decimal.Zero, decimal.Zero
 	var transactionCount int64
 
 	for _, ledger := range ledgers {
 		if ledger.DrCr == domain.DrCrDebit {
-			totalDebits += ledger.Amount
+			totalDebits = totalDebits.Add(ledger.Amount)
 		} else {
-			totalCredits += ledger.Amount
+			totalCredits = totalCredits.Add(ledger.Amount)
 		}
 		transactionCount++
 	}
@@ -302,7 +303,7 @@ func (uc *LedgerUsecase) GetAccountTotals(
 		AccountType:      accountType,
 		TotalDebits:      totalDebits,
 		TotalCredits:     totalCredits,
-		NetChange:        totalCredits - totalDebits,
+		NetChange:        totalCredits.Sub(totalDebits),
 		TransactionCount: transactionCount,
 		PeriodStart:      from,
 		PeriodEnd:        to,
@@ -373,7 +374,7 @@ func (uc *LedgerUsecase) validateLedgerCreate(ledger *domain.LedgerCreate) error
 		return fmt.Errorf("account_id is required")
 	}
 
-	if ledger.Amount < 0 {
+	if ledger.Amount.IsNegative() {
 		return fmt.Errorf("amount must be positive")
 	}
 
@@ -462,21 +463,21 @@ func (uc *LedgerUsecase) ValidateDoubleEntry(ledgers []*domain.LedgerCreate) err
 	}
 
 	// Group by currency
-	balancesByCurrency := make(map[string]float64)
+	balancesByCurrency := make(map[string]decimal.Decimal)
 
 	for _, ledger := range ledgers {
 		switch ledger.DrCr {
 		case domain.DrCrDebit:
-			balancesByCurrency[ledger.Currency] -= ledger.Amount
+			balancesByCurrency[ledger.Currency] = balancesByCurrency[ledger.Currency].Sub(ledger.Amount)
 		case domain.DrCrCredit:
-			balancesByCurrency[ledger.Currency] += ledger.Amount
+			balancesByCurrency[ledger.Currency] = balancesByCurrency[ledger.Currency].Add(ledger.Amount)
 		}
 	}
 
 	// Check that each currency balances
 	for currency, balance := range balancesByCurrency {
-		if balance != 0 {
-			return fmt.Errorf("ledgers don't balance for currency %s: difference = %.2f", currency, balance)
+		if !balance.IsZero() {
+			return fmt.Errorf("ledgers don't balance for currency %s: difference = %s", currency, balance)
 		}
 	}
 
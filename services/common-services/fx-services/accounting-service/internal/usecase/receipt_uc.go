@@ -10,6 +10,7 @@ import (
 	receiptclient "x/shared/common/receipt"
 	receiptpb "x/shared/genproto/shared/accounting/receipt/v3"
 
+	"github.com/shopspring/decimal"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -301,7 +302,8 @@ func (rb *ReceiptBatcher) buildReceiptRequest(ctx context.Context, req *domain.T
 	var transactionCost float64 = 0.0
 
 	if req.TransactionFee != nil {
-		transactionCost = req.TransactionFee.Amount
+		// receipt-service stores display amounts; the ledger remains the source of truth
+		transactionCost = req.TransactionFee.Amount.InexactFloat64()
 	}
 
 	for _, entry := range req.Entries {
@@ -330,7 +332,7 @@ func (rb *ReceiptBatcher) buildReceiptRequest(ctx context.Context, req *domain.T
 
 		// Use debit amount as transaction amount (convert to cents)
 		if entry.DrCr == domain.DrCrDebit {
-			amount = entry.Amount
+			amount = entry.Amount.InexactFloat64()
 			currency = entry.Currency
 		}
 	}
@@ -350,8 +352,10 @@ func (rb *ReceiptBatcher) buildReceiptRequest(ctx context.Context, req *domain.T
 		if oc, ok := req.Entries[0].Metadata["original_currency"].(string); ok {
 			originalCurrency = oc
 		}
-		if oa, ok := req.Entries[0].Metadata["source_amount"].(float64); ok {
-			originalAmount = oa
+		if oa, ok := req.Entries[0].Metadata["source_amount"].(string); ok {
+			if d, err := decimal.NewFromString(oa); err == nil {
+				originalAmount = d.InexactFloat64()
+			}
 		}
 		if rate, ok := req.Entries[0].Metadata["fx_rate"].(string); ok {
 			exchangeRate = rate
@@ -0,0 +1,262 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+	"accounting-service/internal/repository"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// reconciliationLockKey allows one run at a time across instances
+	reconciliationLockKey = "reconciliation:lock"
+	reconciliationLockTTL = 30 * time.Minute
+
+	// ReconciliationRunTimeout bounds a scheduled run
+	ReconciliationRunTimeout = 10 * time.Minute
+)
+
+// releaseLockScript deletes the lock only if this run still owns it
+var releaseLockScript = redis.NewScript(`
+	if redis.call("GET", KEYS[1]) == ARGV[1] then
+		return redis.call("DEL", KEYS[1])
+	end
+	return 0
+`)
+
+type ReconciliationUsecase struct {
+	reconRepo   repository.ReconciliationRepository
+	redisClient *redis.Client
+}
+
+func NewReconciliationUsecase(
+	reconRepo repository.ReconciliationRepository,
+	redisClient *redis.Client,
+) *ReconciliationUsecase {
+	return &ReconciliationUsecase{
+		reconRepo:   reconRepo,
+		redisClient: redisClient,
+	}
+}
+
+// ===============================
+// RECONCILIATION RUNS
+// ===============================
+
+// RunReconciliation recomputes balances from ledgers, checks per-journal double
+// entry and the per-currency zero-sum, and stores every discrepancy as a break.
+// A run that finds breaks still completes; only check errors fail it.
+func (uc *ReconciliationUsecase) RunReconciliation(
+	ctx context.Context,
+	req *domain.RunReconciliationRequest,
+) (*domain.ReconciliationRun, error) {
+	if req.TriggerType == "" {
+		req.TriggerType = domain.ReconciliationTriggerManual
+	}
+
+	token := fmt.Sprintf("%d", time.Now().UnixNano())
+	ok, err := uc.redisClient.SetNX(ctx, reconciliationLockKey, token, reconciliationLockTTL).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire reconciliation lock: %w", err)
+	}
+	if !ok {
+		return nil, xerrors.ErrReconciliationInProgress
+	}
+	defer func() {
+		// Detached: the request context may already be cancelled
+		unlockCtx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		_ = releaseLockScript.Run(unlockCtx, uc.redisClient, []string{reconciliationLockKey}, token).Err()
+	}()
+
+	scope := req.Scope(time.Now())
+	run := &domain.ReconciliationRun{
+		Status:       domain.ReconciliationRunning,
+		TriggerType:  req.TriggerType,
+		TriggeredBy:  req.TriggeredBy,
+		Currency:     scope.Currency,
+		AccountType:  scope.AccountType,
+		JournalsFrom: scope.JournalsFrom,
+	}
+	if err := uc.reconRepo.CreateRun(ctx, run); err != nil {
+		return nil, err
+	}
+
+	breaks, stats, checkErr := uc.check(ctx, scope)
+	if checkErr == nil {
+		checkErr = uc.reconRepo.InsertBreaks(ctx, run.ID, breaks)
+	}
+
+	run.AccountsChecked = stats.AccountsChecked
+	run.JournalsChecked = stats.JournalsChecked
+	run.CurrenciesChecked = stats.CurrenciesChecked
+	run.Status = domain.ReconciliationCompleted
+	run.BreaksFound = int64(len(breaks))
+	if checkErr != nil {
+		msg := checkErr.Error()
+		run.Status = domain.ReconciliationFailed
+		run.BreaksFound = 0
+		run.Error = &msg
+	}
+
+	finishCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := uc.reconRepo.FinishRun(finishCtx, run); err != nil {
+		fmt.Printf("[ERROR] Failed to finish reconciliation run %d: %v\n", run.ID, err)
+	}
+
+	if checkErr != nil {
+		return run, fmt.Errorf("reconciliation run %d failed: %w", run.ID, checkErr)
+	}
+
+	if run.BreaksFound > 0 {
+		fmt.Printf("[RECONCILIATION] Run %d found %d breaks (accounts=%d journals=%d currencies=%d)\n",
+			run.ID, run.BreaksFound, run.AccountsChecked, run.JournalsChecked, run.CurrenciesChecked)
+	}
+
+	return run, nil
+}
+
+// check runs every check against one consistent snapshot
+func (uc *ReconciliationUsecase) check(
+	ctx context.Context,
+	scope domain.ReconciliationScope,
+) ([]*domain.ReconciliationBreak, domain.ReconciliationStats, error) {
+	var stats domain.ReconciliationStats
+
+	tx, err := uc.reconRepo.BeginSnapshot(ctx)
+	if err != nil {
+		return nil, stats, err
+	}
+	defer tx.Rollback(ctx) // read-only, nothing to commit
+
+	balanceBreaks, accounts, err := uc.reconRepo.CheckBalances(ctx, tx, scope)
+	if err != nil {
+		return nil, stats, err
+	}
+	stats.AccountsChecked = accounts
+
+	journalBreaks, journals, err := uc.reconRepo.CheckJournals(ctx, tx, scope)
+	if err != nil {
+		return nil, stats, err
+	}
+	stats.JournalsChecked = journals
+
+	currencyBreaks, currencies, err := uc.reconRepo.CheckCurrencies(ctx, tx, scope)
+	if err != nil {
+		return nil, stats, err
+	}
+	stats.CurrenciesChecked = currencies
+
+	breaks := make([]*domain.ReconciliationBreak, 0, len(balanceBreaks)+len(journalBreaks)+len(currencyBreaks))
+	breaks = append(breaks, balanceBreaks...)
+	breaks = append(breaks, journalBreaks...)
+	breaks = append(breaks, currencyBreaks...)
+
+	return breaks, stats, nil
+}
+
+// ListReconciliationBreaks lists the breaks of a run (latest run when none is
+// given) and returns the run alongside
+func (uc *ReconciliationUsecase) ListReconciliationBreaks(
+	ctx context.Context,
+	filter *domain.ReconciliationBreakFilter,
+) ([]*domain.ReconciliationBreak, int64, *domain.ReconciliationRun, error) {
+	if filter.BreakType != nil && !filter.BreakType.IsValid() {
+		return nil, 0, nil, xerrors.ErrInvalidInput
+	}
+	if filter.Limit <= 0 || filter.Limit > 500 {
+		filter.Limit = 100
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	var (
+		run *domain.ReconciliationRun
+		err error
+	)
+	if filter.RunID != nil {
+		run, err = uc.reconRepo.GetRun(ctx, *filter.RunID)
+	} else {
+		run, err = uc.reconRepo.GetLatestRun(ctx)
+	}
+	if err != nil {
+		if errors.Is(err, xerrors.ErrReconciliationRunNotFound) && filter.RunID == nil {
+			return nil, 0, nil, nil // never run yet
+		}
+		return nil, 0, nil, err
+	}
+	filter.RunID = &run.ID
+
+	breaks, total, err := uc.reconRepo.ListBreaks(ctx, filter)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	return breaks, total, run, nil
+}
+
+// ===============================
+// RECONCILIATION SCHEDULER
+// ===============================
+
+// ReconciliationScheduler runs reconciliation periodically. Each run checks
+// journals from two intervals back so consecutive windows overlap.
+type ReconciliationScheduler struct {
+	uc       *ReconciliationUsecase
+	interval time.Duration
+	stopChan chan struct{}
+}
+
+func NewReconciliationScheduler(uc *ReconciliationUsecase, interval time.Duration) *ReconciliationScheduler {
+	return &ReconciliationScheduler{
+		uc:       uc,
+		interval: interval,
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (s *ReconciliationScheduler) Start() {
+	go s.worker()
+}
+
+func (s *ReconciliationScheduler) Stop() {
+	close(s.stopChan)
+}
+
+func (s *ReconciliationScheduler) worker() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.run()
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
+func (s *ReconciliationScheduler) run() {
+	ctx, cancel := context.WithTimeout(context.Background(), ReconciliationRunTimeout)
+	defer cancel()
+
+	from := time.Now().Add(-2 * s.interval)
+	triggeredBy := "system"
+	_, err := s.uc.RunReconciliation(ctx, &domain.RunReconciliationRequest{
+		JournalsFrom: &from,
+		TriggerType:  domain.ReconciliationTriggerScheduled,
+		TriggeredBy:  &triggeredBy,
+	})
+	if err != nil && !errors.Is(err, xerrors.ErrReconciliationInProgress) {
+		fmt.Printf("[RECONCILIATION] Scheduled run failed: %v\n", err)
+	}
+}
@@ -0,0 +1,367 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"accounting-service/internal/domain"
+	"accounting-service/internal/repository"
+	xerrors "x/shared/utils/errors"
+)
+
+const (
+	ScheduledTransferExecutorInterval  = 30 * time.Second
+	ScheduledTransferExecutorBatchSize = 50
+	ScheduledTransferLease             = 2 * time.Minute // A claimed schedule is retried after this if its executor died
+	ScheduledTransferRetryDelay        = time.Hour       // Between attempts of an occurrence that could not be paid
+	ScheduledTransferMissedAfter       = 24 * time.Hour  // Older occurrences (e.g. after an outage) are skipped, not paid
+	ScheduledTransferRunHistory        = 20              // Runs returned with a schedule
+)
+
+// ScheduledTransferUsecase manages standing orders and future-dated transfers
+// and runs the executor that pays them
+type ScheduledTransferUsecase struct {
+	scheduleRepo repository.ScheduledTransferRepository
+	accountRepo  repository.AccountRepository
+	txUC         *TransactionUsecase
+	executor     *ScheduledTransferExecutor
+}
+
+func NewScheduledTransferUsecase(
+	scheduleRepo repository.ScheduledTransferRepository,
+	accountRepo repository.AccountRepository,
+	txUC *TransactionUsecase,
+) *ScheduledTransferUsecase {
+	uc := &ScheduledTransferUsecase{
+		scheduleRepo: scheduleRepo,
+		accountRepo:  accountRepo,
+		txUC:         txUC,
+	}
+	uc.executor = NewScheduledTransferExecutor(uc, ScheduledTransferExecutorInterval)
+	return uc
+}
+
+// ===============================
+// MANAGEMENT
+// ===============================
+
+// CreateScheduledTransfer validates the schedule against both accounts and
+// stores it with its first occurrence (never earlier than now)
+func (uc *ScheduledTransferUsecase) CreateScheduledTransfer(
+	ctx context.Context,
+	s *domain.ScheduledTransfer,
+) (*domain.ScheduledTransfer, error) {
+	if s.CronExpr != nil && strings.TrimSpace(*s.CronExpr) == "" {
+		s.CronExpr = nil
+	}
+	if s.AccountType == "" {
+		s.AccountType = domain.AccountTypeReal
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	source, err := uc.accountRepo.GetByAccountNumber(ctx, s.FromAccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	dest, err := uc.accountRepo.GetByAccountNumber(ctx, s.ToAccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	if source.OwnerType != s.OwnerType || source.OwnerID != s.OwnerID {
+		return nil, fmt.Errorf("%w: source account does not belong to the owner", xerrors.ErrInvalidSchedule)
+	}
+	if source.IsSystemAccount() || dest.IsSystemAccount() {
+		return nil, fmt.Errorf("%w: system accounts cannot be scheduled", xerrors.ErrInvalidSchedule)
+	}
+	if source.Currency != dest.Currency {
+		return nil, xerrors.ErrCurrencyMismatch
+	}
+	if source.AccountType != s.AccountType || dest.AccountType != s.AccountType {
+		return nil, xerrors.ErrInvalidAccountType
+	}
+	s.Currency = source.Currency
+
+	first := s.FirstOccurrence(time.Now())
+	if first == nil {
+		return nil, fmt.Errorf("%w: the schedule has no occurrences", xerrors.ErrInvalidSchedule)
+	}
+	s.NextRunAt = first
+	s.Status = domain.ScheduledTransferActive
+
+	if err := uc.scheduleRepo.Create(ctx, s); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("[SCHEDULER] Schedule %d created for %s %s (%s -> %s), first run %s\n",
+		s.ID, s.Amount, s.Currency, s.FromAccountNumber, s.ToAccountNumber, first.Format(time.RFC3339))
+
+	if !first.After(time.Now()) {
+		uc.executor.Wake()
+	}
+	return s, nil
+}
+
+// GetScheduledTransfer returns a schedule and its most recent runs. A non-nil
+// owner restricts the lookup to that owner's schedules.
+func (uc *ScheduledTransferUsecase) GetScheduledTransfer(
+	ctx context.Context,
+	id int64,
+	ownerType *domain.OwnerType,
+	ownerID *string,
+) (*domain.ScheduledTransfer, []*domain.ScheduledTransferRun, error) {
+	s, err := uc.getOwned(ctx, id, ownerType, ownerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runs, err := uc.scheduleRepo.ListRuns(ctx, s.ID, ScheduledTransferRunHistory)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s, runs, nil
+}
+
+func (uc *ScheduledTransferUsecase) ListScheduledTransfers(
+	ctx context.Context,
+	filter *domain.ScheduledTransferFilter,
+) ([]*domain.ScheduledTransfer, int64, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = 50
+	}
+	if filter.Limit > 200 {
+		filter.Limit = 200
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return uc.scheduleRepo.List(ctx, filter)
+}
+
+// CancelScheduledTransfer stops future occurrences. An occurrence already
+// being paid completes; the schedule stays cancelled.
+func (uc *ScheduledTransferUsecase) CancelScheduledTransfer(
+	ctx context.Context,
+	id int64,
+	ownerType *domain.OwnerType,
+	ownerID *string,
+	cancelledBy string,
+) (*domain.ScheduledTransfer, error) {
+	if cancelledBy == "" {
+		return nil, xerrors.ErrRequiredFieldMissing
+	}
+	if _, err := uc.getOwned(ctx, id, ownerType, ownerID); err != nil {
+		return nil, err
+	}
+
+	s, err := uc.scheduleRepo.Cancel(ctx, id, cancelledBy)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("[SCHEDULER] Schedule %d cancelled by %s\n", s.ID, cancelledBy)
+	return s, nil
+}
+
+// getOwned hides other owners' schedules as not found
+func (uc *ScheduledTransferUsecase) getOwned(
+	ctx context.Context,
+	id int64,
+	ownerType *domain.OwnerType,
+	ownerID *string,
+) (*domain.ScheduledTransfer, error) {
+	s, err := uc.scheduleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ownerID != nil && s.OwnerID != *ownerID {
+		return nil, xerrors.ErrScheduledTransferNotFound
+	}
+	if ownerType != nil && s.OwnerType != *ownerType {
+		return nil, xerrors.ErrScheduledTransferNotFound
+	}
+	return s, nil
+}
+
+// StartExecutor starts the background worker that pays due occurrences
+func (uc *ScheduledTransferUsecase) StartExecutor() {
+	uc.executor.Start()
+}
+
+func (uc *ScheduledTransferUsecase) StopExecutor() {
+	uc.executor.Stop()
+}
+
+// ===============================
+// SCHEDULED TRANSFER EXECUTOR
+// ===============================
+
+// ScheduledTransferExecutor pays due occurrences from the database, so
+// schedules survive restarts. An occurrence that cannot be paid for lack of
+// funds (or limits, or an infrastructure error) is retried every
+// ScheduledTransferRetryDelay up to the schedule's MaxRetries and then
+// skipped; any other failure skips it straight away.
+type ScheduledTransferExecutor struct {
+	uc       *ScheduledTransferUsecase
+	interval time.Duration
+	wakeChan chan struct{}
+	stopChan chan struct{}
+}
+
+func NewScheduledTransferExecutor(uc *ScheduledTransferUsecase, interval time.Duration) *ScheduledTransferExecutor {
+	return &ScheduledTransferExecutor{
+		uc:       uc,
+		interval: interval,
+		wakeChan: make(chan struct{}, 1),
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (e *ScheduledTransferExecutor) Start() {
+	go e.worker()
+}
+
+func (e *ScheduledTransferExecutor) Stop() {
+	close(e.stopChan)
+}
+
+// Wake runs a pass now instead of at the next tick
+func (e *ScheduledTransferExecutor) Wake() {
+	select {
+	case e.wakeChan <- struct{}{}:
+	default:
+	}
+}
+
+func (e *ScheduledTransferExecutor) worker() {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			e.run()
+		case <-e.wakeChan:
+			e.run()
+		case <-e.stopChan:
+			return
+		}
+	}
+}
+
+func (e *ScheduledTransferExecutor) run() {
+	ctx, cancel := context.WithTimeout(context.Background(), ScheduledTransferLease)
+	defer cancel()
+
+	schedules, err := e.uc.scheduleRepo.ClaimDue(ctx, ScheduledTransferExecutorBatchSize, ScheduledTransferLease)
+	if err != nil {
+		fmt.Printf("[SCHEDULER] Failed to claim due schedules: %v\n", err)
+		return
+	}
+
+	for _, s := range schedules {
+		e.execute(ctx, s)
+	}
+}
+
+func (e *ScheduledTransferExecutor) execute(ctx context.Context, s *domain.ScheduledTransfer) {
+	if s.NextRunAt == nil {
+		return
+	}
+	occurrence := *s.NextRunAt
+	run := &domain.ScheduledTransferRun{
+		ScheduleID:   s.ID,
+		OccurrenceAt: occurrence,
+		Attempts:     s.Attempts + 1,
+	}
+
+	if time.Since(occurrence) > ScheduledTransferMissedAfter && s.Attempts == 0 {
+		e.skip(ctx, s, run, fmt.Sprintf("missed: due at %s", occurrence.Format(time.RFC3339)))
+		return
+	}
+
+	key := s.IdempotencyKey(occurrence)
+	aggregate, err := e.uc.txUC.Transfer(ctx, &domain.TransferRequest{
+		FromAccountNumber:   s.FromAccountNumber,
+		ToAccountNumber:     s.ToAccountNumber,
+		Amount:              s.Amount,
+		AccountType:         s.AccountType,
+		Description:         scheduledTransferDescription(s),
+		IdempotencyKey:      &key,
+		CreatedByExternalID: s.OwnerID,
+		CreatedByType:       s.OwnerType,
+		TransactionType:     domain.TransactionTypeTransfer,
+		Metadata: map[string]interface{}{
+			"scheduled_transfer_id": s.ID,
+			"occurrence_at":         occurrence.Format(time.RFC3339),
+		},
+	})
+	if err == nil {
+		receiptCode := extractReceiptCodeFromAggregate(aggregate)
+		run.Status = domain.ScheduledTransferRunSucceeded
+		run.ReceiptCode = &receiptCode
+		e.advance(ctx, s, run)
+		fmt.Printf("[SCHEDULER] ✅ Schedule %d paid occurrence %s (receipt %s)\n",
+			s.ID, occurrence.Format(time.RFC3339), receiptCode)
+		return
+	}
+
+	if isRetryableScheduleError(err) && s.Attempts < s.MaxRetries {
+		retryAt := time.Now().Add(ScheduledTransferRetryDelay)
+		fmt.Printf("[SCHEDULER] Schedule %d occurrence %s attempt %d failed, retrying at %s: %v\n",
+			s.ID, occurrence.Format(time.RFC3339), run.Attempts, retryAt.Format(time.RFC3339), err)
+		if err := e.uc.scheduleRepo.ScheduleRetry(ctx, s.ID, err.Error(), retryAt); err != nil {
+			fmt.Printf("[SCHEDULER] Failed to reschedule schedule %d: %v\n", s.ID, err)
+		}
+		return
+	}
+
+	e.skip(ctx, s, run, err.Error())
+}
+
+func (e *ScheduledTransferExecutor) skip(ctx context.Context, s *domain.ScheduledTransfer, run *domain.ScheduledTransferRun, reason string) {
+	run.Status = domain.ScheduledTransferRunSkipped
+	run.ErrorMessage = &reason
+	e.advance(ctx, s, run)
+	fmt.Printf("[SCHEDULER] ⏭️  Schedule %d skipped occurrence %s: %s\n",
+		s.ID, run.OccurrenceAt.Format(time.RFC3339), reason)
+}
+
+// advance records the run and moves the schedule to its next occurrence,
+// completing it when none is left
+func (e *ScheduledTransferExecutor) advance(ctx context.Context, s *domain.ScheduledTransfer, run *domain.ScheduledTransferRun) {
+	next := s.NextOccurrence(run.OccurrenceAt, s.RunCount+1)
+	if err := e.uc.scheduleRepo.RecordRun(ctx, run, next); err != nil {
+		// The lease runs out and the occurrence is claimed again; its
+		// idempotency key keeps it from being paid twice
+		fmt.Printf("[SCHEDULER] Failed to record run of schedule %d: %v\n", s.ID, err)
+		return
+	}
+	if next == nil {
+		fmt.Printf("[SCHEDULER] Schedule %d completed after %d runs\n", s.ID, s.RunCount+1)
+	}
+}
+
+// isRetryableScheduleError reports whether an occurrence may succeed later:
+// missing funds, an exhausted limit window, or an infrastructure failure
+func isRetryableScheduleError(err error) bool {
+	return errors.Is(err, xerrors.ErrInsufficientFunds) ||
+		errors.Is(err, xerrors.ErrInsufficientBalance) ||
+		errors.Is(err, xerrors.ErrInsufficientAvailable) ||
+		errors.Is(err, xerrors.ErrLimitExceeded) ||
+		isRetryableTransactionError(err)
+}
+
+func scheduledTransferDescription(s *domain.ScheduledTransfer) string {
+	if s.Description != "" {
+		return s.Description
+	}
+	if s.IsRecurring() {
+		return fmt.Sprintf("Standing order #%d", s.ID)
+	}
+	return fmt.Sprintf("Scheduled transfer #%d", s.ID)
+}
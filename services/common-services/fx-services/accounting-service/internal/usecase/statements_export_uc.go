@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"accounting-service/internal/domain"
+	"accounting-service/internal/pkg/statement"
+	xerrors "x/shared/utils/errors"
+)
+
+// ===============================
+// STATEMENT EXPORT
+// ===============================
+
+// ExportAccountStatement renders the statement of an account as CSV or PDF
+// into w while the ledger rows are read, so any date range can be exported
+// without holding it in memory. Exports are never cached.
+func (uc *StatementUsecase) ExportAccountStatement(
+	ctx context.Context,
+	req *domain.ExportStatementRequest,
+	w io.Writer,
+) error {
+	if req.AccountNumber == "" {
+		return xerrors.ErrInvalidInput
+	}
+	if req.From.IsZero() || req.To.IsZero() || req.To.Before(req.From) {
+		return xerrors.ErrInvalidDateRange
+	}
+	if req.Format == "" {
+		req.Format = domain.StatementFormatCSV
+	}
+
+	writer, err := statement.NewWriter(req.Format, w)
+	if err != nil {
+		return fmt.Errorf("%w: %v", xerrors.ErrInvalidInput, err)
+	}
+
+	footer := &domain.StatementFooter{}
+	err = uc.statementRepo.StreamAccountStatement(ctx, req.AccountNumber, req.AccountType, req.From, req.To,
+		func(h *domain.StatementHeader) error {
+			footer.ClosingBalance = h.OpeningBalance
+			return writer.WriteHeader(h)
+		},
+		func(l *domain.StatementLine) error {
+			if l.DrCr == domain.DrCrDebit {
+				footer.TotalDebits = footer.TotalDebits.Add(l.Amount)
+				footer.TotalFees = footer.TotalFees.Add(l.Fee)
+			} else {
+				footer.TotalCredits = footer.TotalCredits.Add(l.Amount)
+			}
+			footer.ClosingBalance = l.Balance
+			footer.LineCount++
+			return writer.WriteLine(l)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return writer.Close(footer)
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+)
+
+// ===============================
+// PERIOD CLOSE
+// ===============================
+
+// CloseAccountingPeriod closes [PeriodStart, PeriodEnd): postings dated inside
+// it are rejected from then on, and every account's closing balance is
+// snapshotted into period_balances. Periods must be contiguous. If the snapshot
+// fails the period stays 'closing' and calling again with the same range
+// retries it.
+func (uc *StatementUsecase) CloseAccountingPeriod(
+	ctx context.Context,
+	req *domain.CloseAccountingPeriodRequest,
+) (*domain.AccountingPeriod, error) {
+	if req.ClosedBy == "" {
+		return nil, xerrors.ErrInvalidInput
+	}
+	if !req.Validate(time.Now()) {
+		return nil, fmt.Errorf("%w: period must be non-empty and already ended", xerrors.ErrInvalidAccountingPeriod)
+	}
+
+	period, err := uc.periodRepo.BeginClose(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.periodRepo.SnapshotBalances(ctx, period); err != nil {
+		fmt.Printf("[ERROR] Period %d left in closing state: %v\n", period.ID, err)
+		return nil, fmt.Errorf("failed to snapshot period %d (retry the close): %w", period.ID, err)
+	}
+
+	fmt.Printf("[PERIOD CLOSE] Closed period %d [%s, %s) with %d accounts\n",
+		period.ID, period.PeriodStart.Format(time.RFC3339), period.PeriodEnd.Format(time.RFC3339), period.AccountsCount)
+
+	return period, nil
+}
+
+// ===============================
+// TRIAL BALANCE / GENERAL LEDGER
+// ===============================
+
+// GetTrialBalance returns opening balance, debits, credits and closing balance
+// grouped by purpose, currency and account type. A closed period (by id or by
+// exact range) is served from its snapshot; any other range is computed from
+// ledgers.
+func (uc *StatementUsecase) GetTrialBalance(
+	ctx context.Context,
+	req *domain.TrialBalanceRequest,
+) (*domain.TrialBalance, error) {
+	var period *domain.AccountingPeriod
+
+	if req.PeriodID != nil {
+		p, err := uc.periodRepo.GetByID(ctx, *req.PeriodID)
+		if err != nil {
+			return nil, err
+		}
+		period = p
+	} else {
+		from, to := resolveReportRange(req.From, req.To, time.Now())
+		if !to.After(from) {
+			return nil, xerrors.ErrInvalidDateRange
+		}
+
+		p, err := uc.periodRepo.GetByRange(ctx, from, to)
+		if err != nil && !errors.Is(err, xerrors.ErrAccountingPeriodNotFound) {
+			return nil, err
+		}
+		if p == nil {
+			lines, err := uc.periodRepo.TrialBalance(ctx, from, to, req.Scope)
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate trial balance: %w", err)
+			}
+			return &domain.TrialBalance{
+				From:   from,
+				To:     to,
+				Lines:  lines,
+				Totals: domain.TotalsByCurrency(lines),
+			}, nil
+		}
+		period = p
+	}
+
+	if period.Status != domain.PeriodClosed {
+		return nil, fmt.Errorf("%w: period %d is still closing", xerrors.ErrInvalidAccountingPeriod, period.ID)
+	}
+
+	lines, err := uc.periodRepo.TrialBalanceFromSnapshot(ctx, period.ID, req.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate trial balance: %w", err)
+	}
+
+	return &domain.TrialBalance{
+		From:   period.PeriodStart,
+		To:     period.PeriodEnd,
+		Period: period,
+		Lines:  lines,
+		Totals: domain.TotalsByCurrency(lines),
+	}, nil
+}
+
+// GetGeneralLedger returns per purpose/currency totals for [From, To) and a
+// page of the postings behind them
+func (uc *StatementUsecase) GetGeneralLedger(
+	ctx context.Context,
+	req *domain.GeneralLedgerRequest,
+) (*domain.GeneralLedger, error) {
+	if req.From.IsZero() || req.To.IsZero() || !req.To.After(req.From) {
+		return nil, xerrors.ErrInvalidDateRange
+	}
+	if req.Limit <= 0 || req.Limit > 1000 {
+		req.Limit = 100
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	sections, err := uc.periodRepo.TrialBalance(ctx, req.From, req.To, req.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate general ledger: %w", err)
+	}
+
+	entries, total, err := uc.periodRepo.ListGeneralLedgerEntries(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate general ledger: %w", err)
+	}
+
+	return &domain.GeneralLedger{
+		From:         req.From,
+		To:           req.To,
+		Sections:     sections,
+		Entries:      entries,
+		TotalEntries: total,
+	}, nil
+}
+
+// resolveReportRange defaults to month-to-date (UTC)
+func resolveReportRange(from, to *time.Time, now time.Time) (time.Time, time.Time) {
+	end := now
+	if to != nil {
+		end = *to
+	}
+	start := time.Date(end.Year(), end.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if from != nil {
+		start = *from
+	}
+	return start, end
+}
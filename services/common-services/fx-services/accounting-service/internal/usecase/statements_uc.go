@@ -12,12 +12,15 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
 )
 
 type StatementUsecase struct {
 	statementRepo repository.StatementRepository
 	accountRepo   repository.AccountRepository
 	balanceRepo   repository.BalanceRepository
+	periodRepo    repository.PeriodRepository
+	interestRepo  repository.InterestRepository
 	redisClient   *redis.Client
 }
 
@@ -26,12 +29,16 @@ func NewStatementUsecase(
 	statementRepo repository.StatementRepository,
 	accountRepo repository.AccountRepository,
 	balanceRepo repository.BalanceRepository,
+	periodRepo repository.PeriodRepository,
+	interestRepo repository.InterestRepository,
 	redisClient *redis.Client,
 ) *StatementUsecase {
 	return &StatementUsecase{
 		statementRepo: statementRepo,
 		accountRepo:   accountRepo,
 		balanceRepo:   balanceRepo,
+		periodRepo:    periodRepo,
+		interestRepo:  interestRepo,
 		redisClient:   redisClient,
 	}
 }
@@ -49,12 +56,12 @@ func (uc *StatementUsecase) GetAccountBalance(
 	ctx context.Context,
 	accountNumber string,
 	accountType domain.AccountType,
-) (float64, error) {
+) (decimal.Decimal, error) {
 	// Try cache first
 	cacheKey := fmt.Sprintf("balance:account:%s", accountNumber)
 
 	if val, err := uc.redisClient.Get(ctx, cacheKey).Result(); err == nil {
-		var balance float64
+		var balance decimal.Decimal
 		if jsonErr := json.Unmarshal([]byte(val), &balance); jsonErr == nil {
 			return balance, nil
 		}
@@ -64,9 +71,9 @@ func (uc *StatementUsecase) GetAccountBalance(
 	balance, err := uc.statementRepo.GetCurrentBalance(ctx, accountNumber, accountType)
 	if err != nil {
 		if err == xerrors.ErrNotFound {
-			return 0, nil // No balance = 0
+			return decimal.Zero, nil // No balance = 0
 		}
-		return 0, fmt.Errorf("failed to get account balance: %w", err)
+		return decimal.Zero, fmt.Errorf("failed to get account balance: %w", err)
 	}
 
 	// Cache for 30 seconds (balance changes frequently)
@@ -134,10 +141,10 @@ func (uc *StatementUsecase) GetAccountStatement(
 				AccountNumber:  accountNumber,
 				AccountType:    accountType,
 				Ledgers:        []*domain.Ledger{},
-				OpeningBalance: 0,
-				ClosingBalance: 0,
-				TotalDebits:    0,
-				TotalCredits:   0,
+				OpeningBalance: decimal.Zero,
+				ClosingBalance: decimal.Zero,
+				TotalDebits:    decimal.Zero,
+				TotalCredits:   decimal.Zero,
 				PeriodStart:    from,
 				PeriodEnd:      to,
 			}, nil
@@ -145,6 +152,13 @@ func (uc *StatementUsecase) GetAccountStatement(
 		return nil, fmt.Errorf("failed to get account statement: %w", err)
 	}
 
+	// Interest accrued vs. paid in the period (nil if the account never accrued)
+	interest, err := uc.interestRepo.GetSummary(ctx, statement.AccountID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account interest: %w", err)
+	}
+	statement.Interest = interest
+
 	// Cache for 1 minute
 	if data, err := json.Marshal(statement); err == nil {
 		_ = uc.redisClient.Set(ctx, cacheKey, data, 1*time.Minute).Err()
@@ -388,12 +402,12 @@ func (uc *StatementUsecase) GetTransactionSummary(
 func (uc *StatementUsecase) GetSystemHoldings(
 	ctx context.Context,
 	accountType domain.AccountType,
-) (map[string]float64, error) {
+) (map[string]decimal.Decimal, error) {
 	// Try cache first (5 minutes)
 	cacheKey := fmt.Sprintf("holdings:system:%s", accountType)
 
 	if val, err := uc.redisClient.Get(ctx, cacheKey).Result(); err == nil {
-		var holdings map[string]float64
+		var holdings map[string]decimal.Decimal
 		if jsonErr := json.Unmarshal([]byte(val), &holdings); jsonErr == nil {
 			return holdings, nil
 		}
@@ -525,6 +539,6 @@ func (uc *StatementUsecase) GetAccountBalanceHistory(
 
 // BalanceSnapshot represents balance at a point in time
 type BalanceSnapshot struct {
-	Timestamp time.Time `json:"timestamp"`
-	Balance   float64   `json:"balance"`
+	Timestamp time.Time       `json:"timestamp"`
+	Balance   decimal.Decimal `json:"balance"`
 }
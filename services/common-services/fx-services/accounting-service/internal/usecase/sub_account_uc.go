@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// ===============================
+// SUB-ACCOUNTS
+// ===============================
+
+// CreateSubAccount opens a named sub-account under one of the caller's main
+// accounts. The sub-account inherits the parent's owner, currency and
+// account type, starts empty and is funded by fee-free moves from the parent.
+func (uc *AccountUsecase) CreateSubAccount(
+	ctx context.Context,
+	req *domain.CreateSubAccountRequest,
+) (*domain.Account, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid sub-account request: %w", err)
+	}
+
+	parent, err := uc.getOwnedAccount(ctx, req.ParentAccountNumber, req.OwnerType, req.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := parent.CanHaveSubAccounts(); err != nil {
+		return nil, err
+	}
+
+	purpose := req.Purpose
+	if purpose == "" {
+		purpose = parent.Purpose
+	}
+	if parent.IsDemoAccount() && purpose != domain.PurposeWallet {
+		return nil, fmt.Errorf("%w: demo sub-accounts must be wallets", xerrors.ErrInvalidAccountPurpose)
+	}
+
+	now := time.Now()
+	name := req.Name
+	account := &domain.Account{
+		AccountNumber:         uc.generateAccountNumber(parent.AccountType, purpose, parent.OwnerType),
+		OwnerType:             parent.OwnerType,
+		OwnerID:               parent.OwnerID,
+		Currency:              parent.Currency,
+		Purpose:               purpose,
+		AccountType:           parent.AccountType,
+		IsActive:              true,
+		IsLocked:              false,
+		OverdraftLimit:        decimal.Zero, // Sub-accounts never overdraw
+		ParentAgentExternalID: parent.ParentAgentExternalID,
+		ParentAccountID:       &parent.ID,
+		ParentAccountNumber:   &parent.AccountNumber,
+		Name:                  &name,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+
+	if err := uc.accountRepo.CreateSubAccount(ctx, account, domain.MaxSubAccountsPerParent); err != nil {
+		return nil, err
+	}
+
+	uc.invalidateOwnerCaches(ctx, account)
+
+	return account, nil
+}
+
+// ListSubAccounts returns a main account's sub-accounts with their balances
+// and the balances rolled up across the whole hierarchy
+func (uc *AccountUsecase) ListSubAccounts(
+	ctx context.Context,
+	parentAccountNumber string,
+	ownerType domain.OwnerType,
+	ownerID string,
+	includeInactive bool,
+) (*domain.SubAccounts, error) {
+	parent, err := uc.getOwnedAccount(ctx, parentAccountNumber, ownerType, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if parent.IsSubAccount() {
+		return nil, fmt.Errorf("%w: %s is itself a sub-account", xerrors.ErrSubAccountNotAllowed, parent.AccountNumber)
+	}
+
+	subAccounts, err := uc.accountRepo.GetSubAccounts(ctx, parent.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int64{parent.ID}
+	for _, a := range subAccounts {
+		ids = append(ids, a.ID)
+	}
+	balances, err := uc.balanceRepo.GetMultipleByAccountIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balances: %w", err)
+	}
+
+	result := &domain.SubAccounts{Parent: parent, Accounts: []*domain.Account{}}
+	if b, ok := balances[parent.ID]; ok {
+		parent.Balance = b
+		result.RolledUpBalance = b.Balance
+		result.RolledUpAvailableBalance = b.AvailableBalance
+	}
+	for _, a := range subAccounts {
+		a.Balance = balances[a.ID]
+		if a.Balance != nil {
+			result.RolledUpBalance = result.RolledUpBalance.Add(a.Balance.Balance)
+			result.RolledUpAvailableBalance = result.RolledUpAvailableBalance.Add(a.Balance.AvailableBalance)
+		}
+		if a.IsActive || includeInactive {
+			result.Accounts = append(result.Accounts, a)
+		}
+	}
+
+	return result, nil
+}
+
+// GetSubAccounts returns all sub-accounts of a main account, active or not
+func (uc *AccountUsecase) GetSubAccounts(ctx context.Context, parentAccountID int64) ([]*domain.Account, error) {
+	return uc.accountRepo.GetSubAccounts(ctx, parentAccountID)
+}
+
+// getOwnedAccount loads an account and checks it belongs to the caller
+func (uc *AccountUsecase) getOwnedAccount(
+	ctx context.Context,
+	accountNumber string,
+	ownerType domain.OwnerType,
+	ownerID string,
+) (*domain.Account, error) {
+	account, err := uc.accountRepo.GetByAccountNumber(ctx, accountNumber)
+	if err != nil {
+		return nil, err
+	}
+	if account.OwnerType != ownerType || account.OwnerID != ownerID {
+		return nil, xerrors.ErrNotAccountOwner
+	}
+	return account, nil
+}
+
+// invalidateOwnerCaches drops the owner's cached account list and summary
+func (uc *AccountUsecase) invalidateOwnerCaches(ctx context.Context, account *domain.Account) {
+	keys := []string{
+		fmt.Sprintf("accounts:owner:%s:%s:%s", account.OwnerType, account.OwnerID, account.AccountType),
+		fmt.Sprintf("summary:owner:%s:%s:%s", account.OwnerType, account.OwnerID, account.AccountType),
+	}
+	_ = uc.redisClient.Del(ctx, keys...).Err()
+}
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+)
+
+// TradeSettlementWorkers is how many account groups of one batch settle
+// concurrently. Each group is its own database transaction.
+const TradeSettlementWorkers = 4
+
+// ===============================
+// BATCH TRADE SETTLEMENT
+// ===============================
+
+// SettleTrades settles a batch of closed trades. Trades are grouped by
+// account; each group's wins and losses are netted into one journal posted
+// in a single database transaction. Every trade ID settles at most once:
+// trades settled by an earlier batch come back as duplicates carrying the
+// original outcome. A failing group does not affect the other groups.
+func (uc *TransactionUsecase) SettleTrades(
+	ctx context.Context,
+	req *domain.SettleTradesRequest,
+) (*domain.TradeSettlementSummary, error) {
+	if req.AccountType == "" {
+		req.AccountType = domain.AccountTypeReal
+	}
+	if req.CreatedByType == "" {
+		req.CreatedByType = domain.OwnerTypeSystem
+	}
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid settlement request: %w", err)
+	}
+
+	results := make([]*domain.TradeSettlement, len(req.Trades))
+	positions := make(map[string]int, len(req.Trades))
+	var tradeIDs []string
+
+	for i, t := range req.Trades {
+		if err := t.Validate(); err != nil {
+			results[i] = failedTradeSettlement(t, err)
+			continue
+		}
+		if _, seen := positions[t.TradeID]; seen {
+			results[i] = failedTradeSettlement(t, fmt.Errorf("%w: trade %s repeated in batch",
+				xerrors.ErrInvalidRequest, t.TradeID))
+			continue
+		}
+		positions[t.TradeID] = i
+		tradeIDs = append(tradeIDs, t.TradeID)
+	}
+
+	// Replays are answered from the stored outcome without touching balances
+	existing, err := uc.transactionRepo.GetTradeSettlements(ctx, tradeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*domain.TradeSettlementGroup
+	groupByAccount := make(map[string]*domain.TradeSettlementGroup)
+	for _, id := range tradeIDs {
+		i := positions[id]
+		if s, ok := existing[id]; ok {
+			results[i] = s
+			continue
+		}
+
+		t := req.Trades[i]
+		group, ok := groupByAccount[t.AccountNumber]
+		if !ok {
+			group = &domain.TradeSettlementGroup{
+				BatchID:             req.BatchID,
+				AccountNumber:       t.AccountNumber,
+				AccountType:         req.AccountType,
+				CreatedByExternalID: req.CreatedByExternalID,
+				CreatedByType:       req.CreatedByType,
+			}
+			groupByAccount[t.AccountNumber] = group
+			groups = append(groups, group)
+		}
+		group.Trades = append(group.Trades, t)
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		posted  int
+		groupCh = make(chan *domain.TradeSettlementGroup)
+	)
+	for w := 0; w < TradeSettlementWorkers && w < len(groups); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for group := range groupCh {
+				settlements, journalPosted := uc.settleTradeGroup(ctx, group)
+
+				mu.Lock()
+				for _, s := range settlements {
+					results[positions[s.TradeID]] = s
+				}
+				if journalPosted {
+					posted++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, group := range groups {
+		groupCh <- group
+	}
+	close(groupCh)
+	wg.Wait()
+
+	summary := &domain.TradeSettlementSummary{
+		Trades:         results,
+		JournalsPosted: posted,
+	}
+	for _, s := range results {
+		switch s.Status {
+		case domain.TradeSettlementSettled:
+			summary.Settled++
+		case domain.TradeSettlementDuplicate:
+			summary.Duplicates++
+		default:
+			summary.Failed++
+		}
+	}
+
+	fmt.Printf("[TRADE SETTLEMENT] batch=%s trades=%d settled=%d duplicates=%d failed=%d journals=%d\n",
+		req.BatchID, len(req.Trades), summary.Settled, summary.Duplicates, summary.Failed, summary.JournalsPosted)
+
+	return summary, nil
+}
+
+// settleTradeGroup settles one account's trades. A receipt is generated only
+// when the group nets to a posting. Any error fails every trade of the group,
+// since the whole group rolls back together.
+func (uc *TransactionUsecase) settleTradeGroup(
+	ctx context.Context,
+	group *domain.TradeSettlementGroup,
+) ([]*domain.TradeSettlement, bool) {
+	failAll := func(err error) []*domain.TradeSettlement {
+		settlements := make([]*domain.TradeSettlement, len(group.Trades))
+		for i, t := range group.Trades {
+			settlements[i] = failedTradeSettlement(t, err)
+		}
+		return settlements
+	}
+
+	preview, err := uc.transactionRepo.PreviewTradeSettlement(ctx, group)
+	if err != nil {
+		return failAll(err), false
+	}
+
+	var receiptCode string
+	if preview != nil {
+		receiptCode, err = uc.generateReceiptCode(ctx, preview)
+		if err != nil {
+			return failAll(fmt.Errorf("failed to generate receipt: %w", err)), false
+		}
+		group.ReceiptCode = &receiptCode
+		uc.statusTracker.Track(receiptCode, "processing")
+	}
+
+	settlements, aggregate, err := uc.transactionRepo.SettleTradeGroup(ctx, group)
+	if err != nil {
+		if receiptCode != "" {
+			uc.handleTransactionFailure(receiptCode, err)
+		}
+		return failAll(err), false
+	}
+
+	if receiptCode != "" {
+		if aggregate == nil {
+			// Another batch claimed these trades between preview and settlement
+			uc.handleTransactionFailure(receiptCode, fmt.Errorf("trades already settled"))
+			return settlements, false
+		}
+		uc.handleTransactionSuccess(ctx, receiptCode, aggregate, func(agg *domain.LedgerAggregate) {
+			uc.queueNotifications(receiptCode, agg)
+		})
+	}
+
+	return settlements, aggregate != nil
+}
+
+func failedTradeSettlement(t *domain.SettleTrade, err error) *domain.TradeSettlement {
+	return &domain.TradeSettlement{
+		TradeID:       t.TradeID,
+		AccountNumber: t.AccountNumber,
+		Result:        t.Result,
+		Amount:        t.Amount,
+		Status:        domain.TradeSettlementFailed,
+		Error:         err.Error(),
+	}
+}
@@ -305,6 +305,24 @@ func (uc *TransactionFeeRuleUsecase) ListActive(ctx context.Context) ([]*domain.
 	return rules, nil
 }
 
+// ListVersions returns every recorded version of a rule, newest first. The
+// history is kept even if the rule itself is deleted.
+func (uc *TransactionFeeRuleUsecase) ListVersions(ctx context.Context, ruleID int64) ([]*domain.FeeRuleVersion, error) {
+	if ruleID <= 0 {
+		return nil, xerrors.ErrInvalidInput
+	}
+
+	versions, err := uc.feeRuleRepo.ListVersions(ctx, ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fee rule versions: %w", err)
+	}
+	if len(versions) == 0 {
+		return nil, xerrors.ErrNotFound
+	}
+
+	return versions, nil
+}
+
 // FindBestMatch finds the best matching rule (priority-based)
 func (uc *TransactionFeeRuleUsecase) FindBestMatch(
 	ctx context.Context,
@@ -445,15 +463,15 @@ func (uc *TransactionFeeRuleUsecase) validateFeeRuleCreate(rule *domain.FeeRuleC
 	}
 
 	// Validate min/max fees
-	if rule.MinFee != nil && *rule.MinFee < 0 {
+	if rule.MinFee != nil && rule.MinFee.IsNegative() {
 		return errors.New("min_fee cannot be negative")
 	}
 
-	if rule.MaxFee != nil && *rule.MaxFee < 0 {
+	if rule.MaxFee != nil && rule.MaxFee.IsNegative() {
 		return errors.New("max_fee cannot be negative")
 	}
 
-	if rule.MinFee != nil && rule.MaxFee != nil && *rule.MinFee > *rule.MaxFee {
+	if rule.MinFee != nil && rule.MaxFee != nil && rule.MinFee.GreaterThan(*rule.MaxFee) {
 		return errors.New("min_fee cannot be greater than max_fee")
 	}
 
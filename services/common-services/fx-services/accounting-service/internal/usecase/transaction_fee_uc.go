@@ -15,6 +15,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
 )
 
 // TransactionFeeUsecase handles business logic for transaction fees
@@ -101,7 +102,7 @@ func (uc *TransactionFeeUsecase) BatchCreate(ctx context.Context, fees []*domain
 func (uc *TransactionFeeUsecase) CalculateFee(
 	ctx context.Context,
 	transactionType domain.TransactionType,
-	amount float64,
+	amount decimal.Decimal,
 	sourceCurrency, targetCurrency *string,
 	accountType *domain.AccountType,
 	ownerType *domain.OwnerType,
@@ -114,7 +115,7 @@ func (uc *TransactionFeeUsecase) CalculateFee(
 func (uc *TransactionFeeUsecase) CalculateMultipleFees(
 	ctx context.Context,
 	transactionType domain.TransactionType,
-	amount float64,
+	amount decimal.Decimal,
 	sourceCurrency, targetCurrency *string,
 	accountType *domain.AccountType,
 	ownerType *domain.OwnerType,
@@ -210,12 +211,12 @@ func (uc *TransactionFeeUsecase) GetTotalFeesByType(
 	ctx context.Context,
 	feeType domain.FeeType,
 	from, to time.Time,
-) (float64, error) {
+) (decimal.Decimal, error) {
 	// Try cache first (5 minutes)
 	cacheKey := fmt.Sprintf("fees:total:%s:%d:%d", feeType, from.Unix(), to.Unix())
 
 	if val, err := uc.redisClient.Get(ctx, cacheKey).Result(); err == nil {
-		var total float64
+		var total decimal.Decimal
 		if jsonErr := json.Unmarshal([]byte(val), &total); jsonErr == nil {
 			return total, nil
 		}
@@ -224,7 +225,7 @@ func (uc *TransactionFeeUsecase) GetTotalFeesByType(
 	// Fetch from database
 	total, err := uc.feeRepo.GetTotalFeesByType(ctx, feeType, from, to)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get total fees: %w", err)
+		return decimal.Zero, fmt.Errorf("failed to get total fees: %w", err)
 	}
 
 	// Cache result
@@ -240,7 +241,7 @@ func (uc *TransactionFeeUsecase) GetAgentCommissionSummary(
 	ctx context.Context,
 	agentExternalID string,
 	from, to time.Time,
-) (map[string]float64, error) {  // ✅ Changed to float64
+) (map[string]decimal.Decimal, error) {
 	if agentExternalID == "" {
 		return nil, errors. New("agent external ID cannot be empty")
 	}
@@ -249,7 +250,7 @@ func (uc *TransactionFeeUsecase) GetAgentCommissionSummary(
 	cacheKey := fmt. Sprintf("fees:commission:%s:%d:%d", agentExternalID, from.Unix(), to. Unix())
 
 	if val, err := uc.redisClient. Get(ctx, cacheKey). Result(); err == nil {
-		var summary map[string]float64  // ✅ Correct
+		var summary map[string]decimal.Decimal
 		if jsonErr := json. Unmarshal([]byte(val), &summary); jsonErr == nil {
 			return summary, nil  // ✅ Now matches
 		}
@@ -283,7 +284,7 @@ func (uc *TransactionFeeUsecase) validateTransactionFee(fee *domain.TransactionF
 		return errors.New("fee_type is required")
 	}
 
-	if fee.Amount < 0 {
+	if fee.Amount.IsNegative() {
 		return errors.New("amount cannot be negative")
 	}
 
@@ -332,7 +333,7 @@ func (uc *TransactionFeeUsecase) InvalidateAgentFeeCache(ctx context.Context, ag
 func (uc *TransactionFeeUsecase) PreviewFee(
 	ctx context.Context,
 	transactionType domain.TransactionType,
-	amount float64,
+	amount decimal.Decimal,
 	sourceCurrency, targetCurrency *string,
 	accountType *domain.AccountType,
 	ownerType *domain.OwnerType,
@@ -347,11 +348,11 @@ func (uc *TransactionFeeUsecase) CreateFeeForTransaction(
 	tx pgx.Tx,
 	receiptCode string,
 	transactionType domain.TransactionType,
-	amount float64,
+	amount decimal.Decimal,
 	currency string,
 	accountType domain.AccountType,
 	ownerType *domain.OwnerType,
-) (float64, error) {
+) (decimal.Decimal, error) {
 	// Calculate fees
 	calculations, err := uc.CalculateMultipleFees(
 		ctx,
@@ -363,34 +364,35 @@ func (uc *TransactionFeeUsecase) CreateFeeForTransaction(
 		ownerType,
 	)
 	if err != nil {
-		return 0, fmt.Errorf("failed to calculate fees: %w", err)
+		return decimal.Zero, fmt.Errorf("failed to calculate fees: %w", err)
 	}
 
 	if len(calculations) == 0 {
-		return 0, nil // No fees
+		return decimal.Zero, nil // No fees
 	}
 
 	// Create fee records
 	var fees []*domain.TransactionFee
-	var totalFee float64
+	totalFee := decimal.Zero
 
 	for _, calc := range calculations {
 		fee := &domain.TransactionFee{
 			ReceiptCode: receiptCode,
 			FeeRuleID:   calc.RuleID,
+			FeeRuleVersionID: calc.RuleVersionID,
 			FeeType:     calc.FeeType,
 			Amount:      calc.Amount,
 			Currency:    currency,
 		}
 
 		fees = append(fees, fee)
-		totalFee += calc.Amount
+		totalFee = totalFee.Add(calc.Amount)
 	}
 
 	// Batch create fees
 	errs := uc.BatchCreate(ctx, fees, tx)
 	if len(errs) > 0 {
-		return 0, fmt.Errorf("failed to create fees: %v", errs)
+		return decimal.Zero, fmt.Errorf("failed to create fees: %v", errs)
 	}
 
 	return totalFee, nil
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// fxQuoteGrace keeps expired quotes readable for a while so late bookings
+// get ErrFXQuoteExpired instead of ErrFXQuoteNotFound
+const fxQuoteGrace = 5 * time.Minute
+
+// ===============================
+// FX QUOTES (QUOTE & LOCK)
+// ===============================
+
+// CreateFXQuote locks the current customer rate and fee for a conversion
+func (uc *TransactionUsecase) CreateFXQuote(
+	ctx context.Context,
+	req *domain.CreateFXQuoteRequest,
+) (*domain.FXQuote, error) {
+	if req.AccountType == "" {
+		req.AccountType = domain.AccountTypeReal
+	}
+	if req.TTL == 0 {
+		req.TTL = domain.DefaultFXQuoteTTL
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid fx quote request: %w", err)
+	}
+
+	sourceAccount, destAccount, err := uc.fetchTransferAccounts(ctx, req.FromAccountNumber, req.ToAccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	if sourceAccount.Currency == destAccount.Currency {
+		return nil, errors.New("use Transfer for same currency operations")
+	}
+
+	// Same fee calculation ConvertAndTransfer uses without a quote
+	trFee, err := uc.feeCalculator.CalculateFee(
+		ctx,
+		domain.TransactionTypeConversion,
+		req.Amount,
+		nullableStr(sourceAccount.Currency),
+		nullableStr(destAccount.Currency),
+		&req.AccountType,
+		ptrOwnerType(req.CreatedByType),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate conversion fee: %w", err)
+	}
+
+	quote, err := uc.transactionRepo.PreviewConversion(ctx, req, trFee.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	quote.QuoteID = fmt.Sprintf("FXQ-%d", now.UnixNano())
+	quote.FeeRuleID = trFee.RuleID
+	quote.FeeRuleVersionID = trFee.RuleVersionID
+	quote.FeeType = trFee.FeeType
+	quote.CreatedAt = now
+	quote.ExpiresAt = now.Add(req.TTL)
+
+	data, err := json.Marshal(quote)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode fx quote: %w", err)
+	}
+	if err := uc.redisClient.Set(ctx, fxQuoteKey(quote.QuoteID), data, req.TTL+fxQuoteGrace).Err(); err != nil {
+		return nil, fmt.Errorf("failed to store fx quote: %w", err)
+	}
+
+	return quote, nil
+}
+
+// GetFXQuote loads a quote from Redis
+func (uc *TransactionUsecase) GetFXQuote(ctx context.Context, quoteID string) (*domain.FXQuote, error) {
+	val, err := uc.redisClient.Get(ctx, fxQuoteKey(quoteID)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, xerrors.ErrFXQuoteNotFound
+		}
+		return nil, fmt.Errorf("failed to load fx quote: %w", err)
+	}
+
+	var quote domain.FXQuote
+	if err := json.Unmarshal([]byte(val), &quote); err != nil {
+		return nil, fmt.Errorf("failed to decode fx quote: %w", err)
+	}
+
+	return &quote, nil
+}
+
+// claimFXQuote resolves req.QuoteID into req.Quote and marks the quote as in
+// use so it cannot be booked twice. The returned func must be called with the
+// booking result: success consumes the quote, failure frees it for a retry.
+func (uc *TransactionUsecase) claimFXQuote(
+	ctx context.Context,
+	req *domain.ConversionRequest,
+) (func(error), error) {
+	quote, err := uc.GetFXQuote(ctx, *req.QuoteID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.AccountType == "" {
+		req.AccountType = quote.AccountType
+	}
+	if err := quote.Matches(req); err != nil {
+		return nil, err
+	}
+	if quote.IsExpired(time.Now()) {
+		return nil, xerrors.ErrFXQuoteExpired
+	}
+
+	claimKey := fxQuoteKey(quote.QuoteID) + ":claim"
+	ok, err := uc.redisClient.SetNX(ctx, claimKey, "1", time.Until(quote.ExpiresAt)+fxQuoteGrace).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim fx quote: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("quote %s is already booked: %w", quote.QuoteID, xerrors.ErrFXQuoteNotFound)
+	}
+
+	req.Quote = quote
+	req.Amount = quote.SourceAmount
+	if req.IdempotencyKey == nil {
+		key := "fx-quote:" + quote.QuoteID
+		req.IdempotencyKey = &key
+	}
+
+	return func(bookErr error) {
+		// Detached: the request context may already be cancelled
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+
+		if bookErr != nil {
+			_ = uc.redisClient.Del(cleanupCtx, claimKey).Err()
+			return
+		}
+		_ = uc.redisClient.Del(cleanupCtx, fxQuoteKey(quote.QuoteID)).Err()
+	}, nil
+}
+
+func fxQuoteKey(quoteID string) string {
+	return fmt.Sprintf("fx:quote:%s", quoteID)
+}
@@ -2,11 +2,12 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	receiptpb "x/shared/genproto/shared/accounting/receipt/v3"
 
 	"accounting-service/internal/domain"
-	//xerrors "x/shared/utils/errors"
+	xerrors "x/shared/utils/errors"
 )
 
 // ===============================
@@ -351,8 +352,27 @@ func (uc *TransactionUsecase) executeWithReceipt(
 	ctx context.Context,
 	txReq *domain.TransactionRequest,
 	repoExecutor func(context.Context) (*domain.LedgerAggregate, error),
-	eventPublisher func(*domain.LedgerAggregate),
+	onSuccess func(*domain.LedgerAggregate),
 ) (*domain.LedgerAggregate, error) {
+	// A replayed idempotency key returns the original posting untouched
+	if txReq.IdempotencyKey != nil {
+		existing, err := uc.transactionRepo.GetByIdempotencyKey(ctx, *txReq.IdempotencyKey)
+		if err == nil {
+			return existing, nil
+		}
+		if !errors.Is(err, xerrors.ErrNotFound) {
+			return nil, fmt.Errorf("failed to check idempotency: %w", err)
+		}
+	}
+
+	// Check debit limits before anything is recorded; the repository
+	// records the usage with the journal
+	reservation, err := uc.limitUC.Check(ctx, txReq)
+	if err != nil {
+		return nil, err
+	}
+	txReq.LimitReservation = reservation
+
 	// Generate receipt code
 	receiptCode, err := uc.generateReceiptCode(ctx, txReq)
 	if err != nil {
@@ -378,17 +398,18 @@ func (uc *TransactionUsecase) executeWithReceipt(
 	}
 
 	// Handle success
-	uc.handleTransactionSuccess(ctx, receiptCode, aggregate, eventPublisher)
+	uc.handleTransactionSuccess(ctx, receiptCode, aggregate, onSuccess)
 
 	return aggregate, nil
 }
 
-// handleTransactionSuccess updates status, logs, publishes events, invalidates caches
+// handleTransactionSuccess updates status, logs, runs onSuccess (notifications),
+// invalidates caches. Events were already written with the journal.
 func (uc *TransactionUsecase) handleTransactionSuccess(
 	ctx context.Context,
 	receiptCode string,
 	aggregate *domain. LedgerAggregate,
-	eventPublisher func(*domain. LedgerAggregate),
+	onSuccess func(*domain. LedgerAggregate),
 ) {
 	// Update status
 	uc.statusTracker.Update(receiptCode, "completed", "")
@@ -397,9 +418,9 @@ func (uc *TransactionUsecase) handleTransactionSuccess(
 	// Log success
 	uc.logTransactionSuccess(receiptCode, aggregate. Journal. ID)
 
-	// Publish event asynchronously
-	if eventPublisher != nil {
-		go eventPublisher(aggregate)
+	// Queue notifications asynchronously
+	if onSuccess != nil {
+		go onSuccess(aggregate)
 	}
 
 	// Invalidate caches
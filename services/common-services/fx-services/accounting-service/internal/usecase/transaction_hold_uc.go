@@ -0,0 +1,400 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Hold sweeper
+	HoldSweepInterval  = 1 * time.Minute
+	HoldSweepBatchSize = 100
+)
+
+// ===============================
+// FUNDS HOLDS (AUTHORIZE / CAPTURE)
+// ===============================
+
+// PlaceHold reserves funds on an account without posting to the ledger
+func (uc *TransactionUsecase) PlaceHold(
+	ctx context.Context,
+	req *domain.PlaceHoldRequest,
+) (*domain.Hold, *domain.Balance, error) {
+	if req.HoldRef == "" {
+		req.HoldRef = fmt.Sprintf("HOLD-%d", time.Now().UnixNano())
+	}
+	if req.HoldType == "" {
+		req.HoldType = string(domain.TransactionTypeWithdrawal)
+	}
+	if req.TransactionType == "" {
+		req.TransactionType = domain.TransactionTypeWithdrawal
+	}
+	if req.AccountType == "" {
+		req.AccountType = domain.AccountTypeReal
+	}
+	if req.ExpiresAt == nil {
+		expiresAt := time.Now().Add(domain.DefaultHoldTTL)
+		req.ExpiresAt = &expiresAt
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid hold request: %w", err)
+	}
+
+	// Debit limits are checked here and counted with the hold, so a
+	// withdrawal is limited when its funds are held. A replayed hold_ref
+	// was counted the first time.
+	if _, err := uc.transactionRepo.GetHold(ctx, req.HoldRef); errors.Is(err, xerrors.ErrHoldNotFound) {
+		reservation, err := uc.limitUC.Check(ctx, &domain.TransactionRequest{
+			TransactionType: req.TransactionType,
+			AccountType:     req.AccountType,
+			CreatedByType:   &req.CreatedByType,
+			Entries: []*domain.LedgerEntryRequest{
+				{
+					AccountNumber: req.AccountNumber,
+					Amount:        req.Amount,
+					DrCr:          domain.DrCrDebit,
+				},
+			},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		req.LimitReservation = reservation
+	}
+
+	hold, balance, err := uc.transactionRepo.PlaceHold(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uc.invalidateHoldCaches(ctx, hold)
+
+	return hold, balance, nil
+}
+
+// CaptureHold posts a hold (fully or partially) to the ledger. Capturing to
+// a destination account charges transfer fees; capturing to the system
+// liquidity account behaves like a debit (no fees). Retrying a capture
+// returns the original result.
+func (uc *TransactionUsecase) CaptureHold(
+	ctx context.Context,
+	req *domain.CaptureHoldRequest,
+) (*domain.HoldCaptureResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid capture request: %w", err)
+	}
+
+	hold, err := uc.transactionRepo.GetHold(ctx, req.HoldRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if hold.Status == domain.HoldStatusCaptured {
+		return uc.getCapturedHoldResult(ctx, hold)
+	}
+	if !hold.IsActive() {
+		return nil, fmt.Errorf("%w: %s is %s", xerrors.ErrHoldNotActive, hold.HoldRef, hold.Status)
+	}
+
+	captured := hold.Amount
+	if req.Amount.IsPositive() {
+		captured = req.Amount
+	}
+
+	txReq, err := uc.buildCapturePreview(ctx, req, hold, captured)
+	if err != nil {
+		return nil, err
+	}
+	// Holds placed with limits counted them already
+	txReq.LimitsReserved = len(hold.LimitUsage) > 0
+
+	var result *domain.HoldCaptureResult
+	_, err = uc.executeWithReceipt(
+		ctx,
+		txReq,
+		func(ctx context.Context) (*domain.LedgerAggregate, error) {
+			req.ReceiptCode = txReq.ReceiptCode
+			req.TransactionFee = txReq.TransactionFee
+			req.LimitReservation = txReq.LimitReservation
+
+			var err error
+			result, err = uc.transactionRepo.CaptureHold(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return result.Aggregate, nil
+		},
+		func(agg *domain.LedgerAggregate) {
+			if agg != nil && txReq.ReceiptCode != nil {
+				uc.queueNotifications(*txReq.ReceiptCode, agg)
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ReleaseHold returns held funds to the available balance
+func (uc *TransactionUsecase) ReleaseHold(
+	ctx context.Context,
+	req *domain.ReleaseHoldRequest,
+) (*domain.Hold, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid release request: %w", err)
+	}
+
+	hold, err := uc.transactionRepo.ReleaseHold(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.invalidateHoldCaches(ctx, hold)
+
+	return hold, nil
+}
+
+// ExtendHold moves the expiry of an active hold. Callers about to act on a
+// hold (e.g. broadcast a withdrawal) clear the expiry so the sweeper cannot
+// release the funds while the capture is in flight.
+func (uc *TransactionUsecase) ExtendHold(
+	ctx context.Context,
+	req *domain.ExtendHoldRequest,
+) (*domain.Hold, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid extend request: %w", err)
+	}
+
+	return uc.transactionRepo.ExtendHold(ctx, req)
+}
+
+// ListHolds lists holds matching the filter
+func (uc *TransactionUsecase) ListHolds(
+	ctx context.Context,
+	filter *domain.HoldFilter,
+) ([]*domain.Hold, int64, error) {
+	if filter == nil {
+		filter = &domain.HoldFilter{}
+	}
+	if filter.Limit <= 0 || filter.Limit > 100 {
+		filter.Limit = 50
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	return uc.transactionRepo.ListHolds(ctx, filter)
+}
+
+// buildCapturePreview builds the request used for fee calculation and
+// receipt generation. The repository re-resolves accounts under lock.
+func (uc *TransactionUsecase) buildCapturePreview(
+	ctx context.Context,
+	req *domain.CaptureHoldRequest,
+	hold *domain.Hold,
+	captured decimal.Decimal,
+) (*domain.TransactionRequest, error) {
+	destNumber := req.ToAccountNumber
+	if destNumber == nil {
+		destNumber = hold.CaptureAccountNumber
+	}
+
+	var destAccount *domain.Account
+	var err error
+	if destNumber == nil {
+		destAccount, err = uc.accountUC.GetSystemAccount(ctx, hold.Currency, domain.PurposeLiquidity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get system account for currency %s: %w", hold.Currency, err)
+		}
+	} else {
+		destAccount, err = uc.accountUC.GetByAccountNumber(ctx, *destNumber)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get destination account: %w", err)
+		}
+	}
+	if destAccount.Currency != hold.Currency {
+		return nil, xerrors.ErrCurrencyMismatch
+	}
+
+	description := req.Description
+	if description == "" {
+		description = fmt.Sprintf("Capture of hold %s", hold.HoldRef)
+	}
+	metadata := map[string]interface{}{
+		"hold_ref":  hold.HoldRef,
+		"hold_type": hold.HoldType,
+	}
+
+	txReq := &domain.TransactionRequest{
+		TransactionType:     hold.TransactionType,
+		AccountType:         hold.AccountType,
+		Description:         &description,
+		CreatedByExternalID: &req.CreatedByExternalID,
+		CreatedByType:       &req.CreatedByType,
+		AgentExternalID:     req.AgentExternalID,
+		IsSystemTransaction: destNumber == nil,
+		Entries: []*domain.LedgerEntryRequest{
+			{
+				AccountNumber: hold.AccountNumber,
+				Amount:        captured,
+				DrCr:          domain.DrCrDebit,
+				Currency:      hold.Currency,
+				Description:   &description,
+				Metadata:      metadata,
+			},
+			{
+				AccountNumber: destAccount.AccountNumber,
+				Amount:        captured,
+				DrCr:          domain.DrCrCredit,
+				Currency:      destAccount.Currency,
+				Description:   &description,
+				Metadata:      metadata,
+			},
+		},
+		GenerateReceipt: true,
+	}
+
+	// Only captures to a real counterparty are charged, like Transfer
+	if destNumber != nil {
+		trFee, err := uc.feeCalculator.CalculateFee(
+			ctx,
+			hold.TransactionType,
+			captured,
+			nullableStr(hold.Currency),
+			nullableStr(destAccount.Currency),
+			&hold.AccountType,
+			ptrOwnerType(req.CreatedByType),
+			req.ToAddress,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate capture fee: %w", err)
+		}
+
+		txReq.TransactionFee = &domain.TransactionFee{
+			FeeRuleID:        trFee.RuleID,
+			FeeRuleVersionID: trFee.RuleVersionID,
+			FeeType:          trFee.FeeType,
+			Amount:           trFee.Amount,
+			Currency:         destAccount.Currency,
+		}
+	}
+
+	return txReq, nil
+}
+
+// getCapturedHoldResult rebuilds the capture result for an already-captured hold
+func (uc *TransactionUsecase) getCapturedHoldResult(
+	ctx context.Context,
+	hold *domain.Hold,
+) (*domain.HoldCaptureResult, error) {
+	aggregate, err := uc.transactionRepo.GetByIdempotencyKey(ctx, fmt.Sprintf("hold-capture:%s", hold.HoldRef))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load capture of hold %s: %w", hold.HoldRef, err)
+	}
+
+	feeAmount := decimal.Zero
+	if hold.ReceiptCode != nil {
+		if fees, err := uc.feeRepo.GetByReceipt(ctx, *hold.ReceiptCode); err == nil {
+			for _, fee := range fees {
+				feeAmount = feeAmount.Add(fee.Amount)
+			}
+		}
+	}
+
+	return &domain.HoldCaptureResult{
+		Hold:           hold,
+		Aggregate:      aggregate,
+		ReleasedAmount: hold.Amount.Sub(hold.CapturedAmount),
+		FeeAmount:      feeAmount,
+	}, nil
+}
+
+// invalidateHoldCaches drops cached balances of the held account
+func (uc *TransactionUsecase) invalidateHoldCaches(ctx context.Context, hold *domain.Hold) {
+	if uc.redisClient == nil || hold == nil {
+		return
+	}
+	_ = uc.redisClient.Del(ctx, fmt.Sprintf("balance:account:%s", hold.AccountNumber)).Err()
+	_ = uc.redisClient.Del(ctx, fmt.Sprintf("balance:id:%d", hold.AccountID)).Err()
+}
+
+// ===============================
+// HOLD SWEEPER
+// ===============================
+
+// HoldSweeper releases active holds whose expiry has passed
+type HoldSweeper struct {
+	uc       *TransactionUsecase
+	interval time.Duration
+	stopChan chan struct{}
+}
+
+func NewHoldSweeper(uc *TransactionUsecase, interval time.Duration) *HoldSweeper {
+	return &HoldSweeper{
+		uc:       uc,
+		interval: interval,
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (s *HoldSweeper) Start() {
+	go s.worker()
+}
+
+func (s *HoldSweeper) Stop() {
+	close(s.stopChan)
+}
+
+func (s *HoldSweeper) worker() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.sweep()
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
+func (s *HoldSweeper) sweep() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	refs, err := s.uc.transactionRepo.ListExpiredHolds(ctx, HoldSweepBatchSize)
+	if err != nil {
+		fmt.Printf("[HOLD SWEEPER] Failed to list expired holds: %v\n", err)
+		return
+	}
+
+	reason := "hold expired"
+	for _, ref := range refs {
+		_, err := s.uc.ReleaseHold(ctx, &domain.ReleaseHoldRequest{
+			HoldRef:              ref,
+			Reason:               &reason,
+			ReleasedByExternalID: "system",
+			ReleasedByType:       domain.OwnerTypeSystem,
+			Expired:              true,
+		})
+		if err != nil && !errors.Is(err, xerrors.ErrHoldNotActive) {
+			fmt.Printf("[HOLD SWEEPER] Failed to release %s: %v\n", ref, err)
+			continue
+		}
+	}
+
+	if len(refs) > 0 {
+		fmt.Printf("[HOLD SWEEPER] Released %d expired holds\n", len(refs))
+	}
+}
@@ -0,0 +1,292 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"accounting-service/internal/domain"
+	"accounting-service/internal/repository"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// TransactionLimitUsecase enforces per-account debit limits. Profiles are
+// matched per account (account, KYC tier, owner type); usage is counted per
+// UTC calendar window in Postgres, in the transaction that posts the journal.
+type TransactionLimitUsecase struct {
+	limitRepo   repository.LimitRepository
+	accountRepo repository.AccountRepository
+}
+
+func NewTransactionLimitUsecase(
+	limitRepo repository.LimitRepository,
+	accountRepo repository.AccountRepository,
+) *TransactionLimitUsecase {
+	return &TransactionLimitUsecase{
+		limitRepo:   limitRepo,
+		accountRepo: accountRepo,
+	}
+}
+
+// ===============================
+// ENFORCEMENT
+// ===============================
+
+// Check matches the request's non-fee debits to the limits of each debited
+// account and rejects them early if they no longer fit the current usage.
+// It writes nothing: the returned reservation is recorded by the repository
+// in the journal's (or hold's) transaction, which enforces the caps again on
+// the locked counters. Exemptions depend on who moves the funds and from
+// which account, not on the destination: a user withdrawal credits a system
+// account too. Postings by the system or an admin and debits from system,
+// admin or demo accounts are not limited. Returns nil when no limit applies.
+func (uc *TransactionLimitUsecase) Check(
+	ctx context.Context,
+	req *domain.TransactionRequest,
+) (*domain.LimitReservation, error) {
+	if req.LimitsReserved || req.CreatedByType != nil && limitExemptOwner(*req.CreatedByType) {
+		return nil, nil
+	}
+
+	// Total debited per account, in entry order
+	var accountNumbers []string
+	debits := make(map[string]decimal.Decimal)
+	for _, entry := range req.Entries {
+		if entry.DrCr != domain.DrCrDebit || entry.IsFee {
+			continue
+		}
+		if _, seen := debits[entry.AccountNumber]; !seen {
+			accountNumbers = append(accountNumbers, entry.AccountNumber)
+		}
+		debits[entry.AccountNumber] = debits[entry.AccountNumber].Add(entry.Amount)
+	}
+
+	now := time.Now()
+	var usage []*domain.LimitUsage
+	for _, accountNumber := range accountNumbers {
+		account, err := uc.accountRepo.GetByAccountNumber(ctx, accountNumber)
+		if err != nil {
+			return nil, fmt.Errorf("account %s not found: %w", accountNumber, err)
+		}
+		if limitExemptAccount(account) {
+			continue
+		}
+
+		profile, _, err := uc.matchProfile(ctx, account)
+		if err != nil {
+			return nil, err
+		}
+		if profile == nil {
+			continue
+		}
+
+		amount := debits[accountNumber]
+		if profile.MaxSingleAmount != nil && amount.GreaterThan(*profile.MaxSingleAmount) {
+			return nil, &domain.LimitExceededError{
+				AccountNumber: accountNumber,
+				Limit:         "max_single_amount",
+				Cap:           profile.MaxSingleAmount.String(),
+				Requested:     amount.String(),
+				Remaining:     profile.MaxSingleAmount.String(),
+			}
+		}
+
+		for _, window := range domain.LimitWindows {
+			amountCap, countCap := profile.Caps(window)
+			if amountCap == nil && countCap == nil {
+				continue
+			}
+			start, end := window.Bounds(now)
+			usage = append(usage, &domain.LimitUsage{
+				AccountNumber: accountNumber,
+				Window:        window,
+				WindowStart:   start,
+				ResetsAt:      end,
+				Amount:        amount,
+				Count:         1,
+				AmountCap:     amountCap,
+				CountCap:      countCap,
+			})
+		}
+	}
+
+	if len(usage) == 0 {
+		return nil, nil
+	}
+	for _, u := range usage {
+		used, count, err := uc.limitRepo.GetUsage(ctx, u.AccountNumber, u.Window, u.WindowStart)
+		if err != nil {
+			return nil, err
+		}
+		if err := u.Exceeded(used, count); err != nil {
+			return nil, err
+		}
+	}
+	return &domain.LimitReservation{Usage: usage}, nil
+}
+
+func limitExemptOwner(ownerType domain.OwnerType) bool {
+	return ownerType == domain.OwnerTypeSystem || ownerType == domain.OwnerTypeAdmin
+}
+
+func limitExemptAccount(account *domain.Account) bool {
+	return limitExemptOwner(account.OwnerType) || account.IsDemoAccount()
+}
+
+func (uc *TransactionLimitUsecase) matchProfile(
+	ctx context.Context,
+	account *domain.Account,
+) (*domain.LimitProfile, *string, error) {
+	tier, err := uc.limitRepo.GetKYCTier(ctx, account.OwnerType, account.OwnerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	profile, err := uc.limitRepo.MatchProfile(ctx, account, tier)
+	if err != nil {
+		return nil, nil, err
+	}
+	return profile, tier, nil
+}
+
+// ===============================
+// QUERIES
+// ===============================
+
+// GetAccountLimits returns the limits that apply to an account and how much
+// of each window's allowance is left
+func (uc *TransactionLimitUsecase) GetAccountLimits(
+	ctx context.Context,
+	accountNumber string,
+) (*domain.AccountLimits, error) {
+	account, err := uc.accountRepo.GetByAccountNumber(ctx, accountNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := &domain.AccountLimits{
+		AccountNumber: account.AccountNumber,
+		Currency:      account.Currency,
+		Usage:         []*domain.LimitUsage{},
+	}
+	if limitExemptAccount(account) {
+		return limits, nil
+	}
+
+	profile, tier, err := uc.matchProfile(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	limits.KYCTier = tier
+	limits.Profile = profile
+	if profile == nil {
+		return limits, nil
+	}
+	limits.MaxSingleAmount = profile.MaxSingleAmount
+
+	now := time.Now()
+	for _, window := range domain.LimitWindows {
+		amountCap, countCap := profile.Caps(window)
+		start, end := window.Bounds(now)
+
+		amount, count, err := uc.limitRepo.GetUsage(ctx, account.AccountNumber, window, start)
+		if err != nil {
+			return nil, err
+		}
+		limits.Usage = append(limits.Usage, &domain.LimitUsage{
+			AccountNumber: account.AccountNumber,
+			Window:        window,
+			WindowStart:   start,
+			ResetsAt:      end,
+			Amount:        amount,
+			Count:         count,
+			AmountCap:     amountCap,
+			CountCap:      countCap,
+		})
+	}
+	return limits, nil
+}
+
+// ===============================
+// PROFILE MANAGEMENT
+// ===============================
+
+func (uc *TransactionLimitUsecase) CreateLimitProfile(
+	ctx context.Context,
+	profile *domain.LimitProfile,
+) (*domain.LimitProfile, error) {
+	normalizeLimitProfile(profile)
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.limitRepo.CreateProfile(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("[LIMITS] Profile %d (%s) created by %s\n", profile.ID, profile.Name, profile.CreatedBy)
+	return profile, nil
+}
+
+// UpdateLimitProfile replaces a profile's scope and caps. Usage already
+// counted in the current windows is kept.
+func (uc *TransactionLimitUsecase) UpdateLimitProfile(
+	ctx context.Context,
+	profile *domain.LimitProfile,
+) (*domain.LimitProfile, error) {
+	existing, err := uc.limitRepo.GetProfileByID(ctx, profile.ID)
+	if err != nil {
+		return nil, err
+	}
+	profile.CreatedBy = existing.CreatedBy
+	profile.CreatedAt = existing.CreatedAt
+
+	normalizeLimitProfile(profile)
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.limitRepo.UpdateProfile(ctx, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (uc *TransactionLimitUsecase) ListLimitProfiles(
+	ctx context.Context,
+	filter *domain.LimitProfileFilter,
+) ([]*domain.LimitProfile, error) {
+	return uc.limitRepo.ListProfiles(ctx, filter)
+}
+
+// SetOwnerKYCTier records the tier an owner's limit profiles are selected by
+func (uc *TransactionLimitUsecase) SetOwnerKYCTier(
+	ctx context.Context,
+	tier *domain.OwnerKYCTier,
+) (*domain.OwnerKYCTier, error) {
+	tier.KYCTier = strings.TrimSpace(tier.KYCTier)
+	if tier.OwnerID == "" || tier.KYCTier == "" || tier.SetBy == "" {
+		return nil, xerrors.ErrRequiredFieldMissing
+	}
+	if tier.OwnerType == domain.OwnerTypeSystem {
+		return nil, xerrors.ErrInvalidOwnerType
+	}
+
+	if err := uc.limitRepo.SetKYCTier(ctx, tier); err != nil {
+		return nil, err
+	}
+	return tier, nil
+}
+
+func normalizeLimitProfile(p *domain.LimitProfile) {
+	p.Name = strings.TrimSpace(p.Name)
+	p.Currency = strings.ToUpper(strings.TrimSpace(p.Currency))
+	if p.KYCTier != nil && strings.TrimSpace(*p.KYCTier) == "" {
+		p.KYCTier = nil
+	}
+	if p.AccountNumber != nil && strings.TrimSpace(*p.AccountNumber) == "" {
+		p.AccountNumber = nil
+	}
+}
@@ -0,0 +1,301 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"accounting-service/internal/domain"
+	"accounting-service/internal/repository"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	ProcessorPollInterval = 250 * time.Millisecond // Idle workers re-check the queue this often
+	ProcessorLease        = 2 * time.Minute        // Claimed tasks return to the queue after this
+	QueueRetryBaseDelay   = 2 * time.Second
+	QueueRetryMaxDelay    = 5 * time.Minute
+)
+
+// ===============================
+// BACKGROUND PROCESSING
+// ===============================
+
+// ProcessorTask represents a transaction to process
+type ProcessorTask struct {
+	ReceiptCode    string
+	Request        *domain.TransactionRequest
+	IdempotencyKey *string
+}
+
+// ProcessorPool runs async transactions from transaction_queue. Tasks survive
+// restarts, tasks of one paying account run in submission order, and a task
+// is retried on transient errors until it is dead-lettered.
+type ProcessorPool struct {
+	workers    int
+	instanceID string // Prefix of the lease holder recorded on claimed tasks
+	queueRepo  repository.TransactionQueueRepository
+	uc         *TransactionUsecase
+	wakeChan   chan struct{}
+	wg         sync.WaitGroup
+	stopChan   chan struct{}
+}
+
+func NewProcessorPool(workers int, queueRepo repository.TransactionQueueRepository, uc *TransactionUsecase) *ProcessorPool {
+	hostname, _ := os.Hostname()
+	return &ProcessorPool{
+		workers:    workers,
+		instanceID: fmt.Sprintf("%s:%d", hostname, os.Getpid()),
+		queueRepo:  queueRepo,
+		uc:         uc,
+		wakeChan:   make(chan struct{}, 1),
+		stopChan:   make(chan struct{}),
+	}
+}
+
+func (p *ProcessorPool) Start() {
+	for i := 0; i < p.workers; i++ {
+		p.wg.Add(1)
+		go p.worker(i)
+	}
+}
+
+// Submit persists the task. Every queued task gets an idempotency key (the
+// receipt code when the caller sent none), so a re-delivered task returns the
+// journal it already posted instead of posting twice.
+func (p *ProcessorPool) Submit(ctx context.Context, task *ProcessorTask) error {
+	if task.IdempotencyKey == nil {
+		key := "queue:" + task.ReceiptCode
+		task.IdempotencyKey = &key
+		task.Request.IdempotencyKey = &key
+	}
+
+	err := p.queueRepo.Enqueue(ctx, &domain.QueuedTransaction{
+		ReceiptCode:    task.ReceiptCode,
+		IdempotencyKey: *task.IdempotencyKey,
+		OrderingKey:    task.Request.OrderingKey(),
+		Request:        task.Request,
+		MaxAttempts:    domain.DefaultQueueMaxAttempts,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %v", xerrors.ErrTransactionFailed, err)
+	}
+
+	// Nudge an idle worker
+	select {
+	case p.wakeChan <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (p *ProcessorPool) Stop() {
+	close(p.stopChan)
+	p.wg.Wait()
+}
+
+func (p *ProcessorPool) worker(id int) {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(ProcessorPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stopChan:
+			return
+		default:
+		}
+
+		task, err := p.queueRepo.Claim(context.Background(), p.workerName(id), ProcessorLease)
+		if err != nil {
+			fmt.Printf("[QUEUE] Worker %d failed to claim task: %v\n", id, err)
+		}
+		if task != nil {
+			p.process(id, task)
+			continue
+		}
+
+		select {
+		case <-p.wakeChan:
+		case <-ticker.C:
+		case <-p.stopChan:
+			return
+		}
+	}
+}
+
+// workerName identifies worker id of this process as a lease holder
+func (p *ProcessorPool) workerName(id int) string {
+	return fmt.Sprintf("%s/%d", p.instanceID, id)
+}
+
+// process runs a claimed task and records the outcome. An outcome is only
+// recorded while the lease is held; a worker whose lease ran out leaves the
+// task to the worker that claimed it since.
+func (p *ProcessorPool) process(workerID int, task *domain.QueuedTransaction) {
+	ctx := context.Background()
+	lockedBy := p.workerName(workerID)
+
+	aggregate, err := p.uc.processTransaction(workerID, &ProcessorTask{
+		ReceiptCode:    task.ReceiptCode,
+		Request:        task.Request,
+		IdempotencyKey: &task.IdempotencyKey,
+	})
+	if err == nil {
+		if err := p.queueRepo.Complete(ctx, task.ID, lockedBy, aggregate.Journal.ID); err != nil {
+			// The journal is posted; a re-delivery resolves through the idempotency key
+			fmt.Printf("[QUEUE] Failed to mark %s completed: %v\n", task.ReceiptCode, err)
+		}
+		return
+	}
+
+	if !isRetryableTransactionError(err) {
+		if qErr := p.queueRepo.Fail(ctx, task.ID, lockedBy, err.Error()); qErr != nil {
+			fmt.Printf("[QUEUE] Failed to mark %s failed: %v\n", task.ReceiptCode, qErr)
+			if errors.Is(qErr, xerrors.ErrQueueLeaseLost) {
+				return
+			}
+		}
+		p.uc.reportTransactionFailure(task.ReceiptCode, task.Request, err)
+		return
+	}
+
+	status, qErr := p.queueRepo.Retry(ctx, task.ID, lockedBy, err.Error(), queueRetryDelay(task.Attempts))
+	if errors.Is(qErr, xerrors.ErrQueueLeaseLost) {
+		fmt.Printf("[QUEUE] Lease on %s lost, leaving the retry to its new worker: %v\n", task.ReceiptCode, err)
+		return
+	}
+	if qErr != nil {
+		// Lease expiry brings the task back
+		fmt.Printf("[QUEUE] Failed to reschedule %s: %v\n", task.ReceiptCode, qErr)
+		return
+	}
+
+	if status == domain.QueuedDead {
+		fmt.Printf("[QUEUE] ❌ %s dead-lettered after %d attempts: %v\n", task.ReceiptCode, task.Attempts, err)
+		p.uc.reportTransactionFailure(task.ReceiptCode, task.Request, err)
+		return
+	}
+
+	fmt.Printf("[QUEUE] %s attempt %d/%d failed, retrying: %v\n", task.ReceiptCode, task.Attempts, task.MaxAttempts, err)
+	p.uc.statusTracker.Update(task.ReceiptCode, "retrying", err.Error())
+}
+
+// queueRetryDelay is exponential in the attempts used so far
+func queueRetryDelay(attempts int) time.Duration {
+	delay := QueueRetryBaseDelay
+	for i := 1; i < attempts && delay < QueueRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > QueueRetryMaxDelay {
+		delay = QueueRetryMaxDelay
+	}
+	return delay
+}
+
+// isRetryableTransactionError reports whether a failure is infrastructure
+// (lost connection, deadlock, serialization conflict, timeout) rather than a
+// rejection by the ledger
+func isRetryableTransactionError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code[:2] {
+		case "08", // connection exception
+			"40", // transaction rollback (serialization failure, deadlock)
+			"53", // insufficient resources
+			"57": // operator intervention (shutdown, query canceled)
+			return true
+		}
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// ===============================
+// DEAD-LETTER QUEUE
+// ===============================
+
+// ListQueuedTransactions inspects the processing queue (dead letters by default)
+func (uc *TransactionUsecase) ListQueuedTransactions(
+	ctx context.Context,
+	filter *domain.QueuedTransactionFilter,
+) ([]*domain.QueuedTransaction, int64, error) {
+	if filter.Status == nil {
+		dead := domain.QueuedDead
+		filter.Status = &dead
+	}
+	return uc.queueRepo.List(ctx, filter)
+}
+
+// RedriveDeadLetterTransactions puts dead-lettered tasks back on the queue.
+// They run again under their original idempotency key.
+func (uc *TransactionUsecase) RedriveDeadLetterTransactions(
+	ctx context.Context,
+	ids []int64,
+	redrivenBy string,
+) ([]*domain.QueuedTransaction, error) {
+	if len(ids) == 0 {
+		return nil, xerrors.ErrInvalidInput
+	}
+
+	tasks, err := uc.queueRepo.Redrive(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range tasks {
+		uc.statusTracker.Update(task.ReceiptCode, "processing", "")
+	}
+	if len(tasks) > 0 {
+		select {
+		case uc.processorPool.wakeChan <- struct{}{}:
+		default:
+		}
+	}
+
+	fmt.Printf("[QUEUE] %s re-drove %d of %d dead-lettered transactions\n", redrivenBy, len(tasks), len(ids))
+	return tasks, nil
+}
+
+// SkipDeadLetterTransactions gives up dead-lettered tasks. A dead letter
+// holds back the later tasks of its paying account; once skipped they run.
+func (uc *TransactionUsecase) SkipDeadLetterTransactions(
+	ctx context.Context,
+	ids []int64,
+	skippedBy string,
+) ([]*domain.QueuedTransaction, error) {
+	if len(ids) == 0 {
+		return nil, xerrors.ErrInvalidInput
+	}
+
+	tasks, err := uc.queueRepo.Skip(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range tasks {
+		uc.statusTracker.Update(task.ReceiptCode, "failed", "skipped by "+skippedBy)
+	}
+	if len(tasks) > 0 {
+		select {
+		case uc.processorPool.wakeChan <- struct{}{}:
+		default:
+		}
+	}
+
+	fmt.Printf("[QUEUE] %s skipped %d of %d dead-lettered transactions\n", skippedBy, len(tasks), len(ids))
+	return tasks, nil
+}
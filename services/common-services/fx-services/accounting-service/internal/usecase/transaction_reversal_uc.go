@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"accounting-service/internal/domain"
+	xerrors "x/shared/utils/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// ===============================
+// REVERSALS & REFUNDS
+// ===============================
+
+// ReverseTransaction posts the mirror image of an existing receipt, including
+// fee and agent commission legs. A receipt can only be reversed once.
+func (uc *TransactionUsecase) ReverseTransaction(
+	ctx context.Context,
+	req *domain.ReversalRequest,
+) (*domain.ReversalResult, error) {
+	req.TransactionType = domain.TransactionTypeReversal
+	req.Amount = decimal.Zero
+	return uc.executeReversal(ctx, req)
+}
+
+// RefundTransaction returns part of an existing receipt. Every leg is mirrored
+// pro-rata; cumulative refunds can never exceed the original principal.
+func (uc *TransactionUsecase) RefundTransaction(
+	ctx context.Context,
+	req *domain.ReversalRequest,
+) (*domain.ReversalResult, error) {
+	req.TransactionType = domain.TransactionTypeRefund
+	return uc.executeReversal(ctx, req)
+}
+
+func (uc *TransactionUsecase) executeReversal(
+	ctx context.Context,
+	req *domain.ReversalRequest,
+) (*domain.ReversalResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s request: %w", req.TransactionType, err)
+	}
+
+	original, err := uc.getOriginalForReversal(ctx, req.OriginalReceiptCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// ✅ Fail fast; the repository re-checks under the journal row lock
+	if !original.Journal.IsReversible() {
+		return nil, xerrors.ErrTransactionNotReversible
+	}
+
+	txReq, err := uc.buildReversalPreview(ctx, req, original)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *domain.ReversalResult
+	_, err = uc.executeWithReceipt(
+		ctx,
+		txReq,
+		func(ctx context.Context) (*domain.LedgerAggregate, error) {
+			req.ReceiptCode = txReq.ReceiptCode
+
+			var err error
+			if req.IsFullReversal() {
+				result, err = uc.transactionRepo.ReverseTransaction(ctx, req)
+			} else {
+				result, err = uc.transactionRepo.RefundTransaction(ctx, req)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return result.Aggregate, nil
+		},
+		func(agg *domain.LedgerAggregate) {
+			if agg != nil && txReq.ReceiptCode != nil {
+				uc.queueNotifications(*txReq.ReceiptCode, agg)
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Original receipt is no longer in its cached state
+	_ = uc.redisClient.Del(ctx, fmt.Sprintf("transaction:receipt:%s", req.OriginalReceiptCode)).Err()
+
+	return result, nil
+}
+
+// getOriginalForReversal loads the journal and ledgers behind a receipt.
+// Usecase flows (credit, transfer, ...) don't always set journals.external_ref,
+// so fall back to the receipt code stamped on the ledgers.
+func (uc *TransactionUsecase) getOriginalForReversal(
+	ctx context.Context,
+	receiptCode string,
+) (*domain.LedgerAggregate, error) {
+	if aggregate, err := uc.GetTransactionByReceipt(ctx, receiptCode); err == nil {
+		return aggregate, nil
+	}
+
+	ledgers, err := uc.ledgerRepo.ListByReceipt(ctx, receiptCode)
+	if err != nil || len(ledgers) == 0 {
+		return nil, xerrors.ErrTransactionNotFound
+	}
+
+	journal, err := uc.journalRepo.GetByID(ctx, ledgers[0].JournalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch original journal: %w", err)
+	}
+
+	return &domain.LedgerAggregate{
+		Journal: journal,
+		Ledgers: ledgers,
+	}, nil
+}
+
+// buildReversalPreview builds the two-leg request used for receipt generation.
+// The repository posts the full mirror; this only carries the parties,
+// the amount and the parent receipt link shown on the new receipt.
+func (uc *TransactionUsecase) buildReversalPreview(
+	ctx context.Context,
+	req *domain.ReversalRequest,
+	original *domain.LedgerAggregate,
+) (*domain.TransactionRequest, error) {
+	ledgers := make([]*domain.Ledger, len(original.Ledgers))
+	copy(ledgers, original.Ledgers)
+	sort.Slice(ledgers, func(i, j int) bool { return ledgers[i].ID < ledgers[j].ID })
+
+	var principal, counterparty *domain.Ledger
+	for _, l := range ledgers {
+		if principal == nil && l.DrCr == domain.DrCrDebit {
+			principal = l
+		}
+	}
+	if principal == nil {
+		return nil, xerrors.ErrTransactionNotReversible
+	}
+	for _, l := range ledgers {
+		if l.DrCr == domain.DrCrCredit && l.Currency == principal.Currency {
+			counterparty = l
+			break
+		}
+	}
+	if counterparty == nil {
+		for _, l := range ledgers {
+			if l.DrCr == domain.DrCrCredit {
+				counterparty = l
+				break
+			}
+		}
+	}
+	if counterparty == nil {
+		return nil, xerrors.ErrTransactionNotReversible
+	}
+
+	amount := principal.Amount
+	if !req.IsFullReversal() {
+		if req.Amount.GreaterThan(principal.Amount) {
+			return nil, xerrors.ErrRefundExceedsRemaining
+		}
+		amount = req.Amount
+	}
+
+	payer, err := uc.accountUC.GetByID(ctx, counterparty.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get counterparty account: %w", err)
+	}
+	payee, err := uc.accountUC.GetByID(ctx, principal.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get original debit account: %w", err)
+	}
+
+	description := fmt.Sprintf("%s of %s", req.TransactionType, req.OriginalReceiptCode)
+	if req.Reason != "" {
+		description = fmt.Sprintf("%s: %s", description, req.Reason)
+	}
+
+	metadata := map[string]interface{}{
+		"parent_receipt_code": req.OriginalReceiptCode,
+		"original_journal_id": original.Journal.ID,
+		"reversal_type":       string(req.TransactionType),
+	}
+	if req.Reason != "" {
+		metadata["reason"] = req.Reason
+	}
+
+	return &domain.TransactionRequest{
+		IdempotencyKey:      req.IdempotencyKey,
+		TransactionType:     req.TransactionType,
+		AccountType:         original.Journal.AccountType,
+		Description:         &description,
+		CreatedByExternalID: &req.CreatedByExternalID,
+		CreatedByType:       &req.CreatedByType,
+		IsSystemTransaction: true,
+		Entries: []*domain.LedgerEntryRequest{
+			{
+				AccountNumber: payer.AccountNumber,
+				Amount:        amount,
+				DrCr:          domain.DrCrDebit,
+				Currency:      principal.Currency,
+				Description:   &description,
+				Metadata:      metadata,
+			},
+			{
+				AccountNumber: payee.AccountNumber,
+				Amount:        amount,
+				DrCr:          domain.DrCrCredit,
+				Currency:      principal.Currency,
+				Description:   &description,
+				Metadata:      metadata,
+			},
+		},
+		GenerateReceipt: true,
+	}, nil
+}
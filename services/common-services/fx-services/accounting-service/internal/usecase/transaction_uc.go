@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"sync"
 	"time"
 
 	"accounting-service/internal/domain"
@@ -26,6 +25,7 @@ import (
 
 	"github.com/redis/go-redis/v9"
 	"github.com/segmentio/kafka-go"
+	"github.com/shopspring/decimal"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -35,9 +35,8 @@ const (
 	NotificationBatchSize = 100 // Notifications per gRPC call
 	BatchFlushInterval    = 50 * time.Millisecond
 
-	// Worker pool
+	// Worker pool (tasks are persisted in transaction_queue)
 	NumProcessorWorkers = 50
-	ProcessorQueueSize  = 10000
 
 	// Status tracking
 	StatusCacheTTL       = 5 * time.Minute
@@ -53,11 +52,13 @@ type TransactionUsecase struct {
 	journalRepo     repository.JournalRepository
 	ledgerRepo      repository.LedgerRepository
 	feeRepo         repository.TransactionFeeRepository
+	queueRepo       repository.TransactionQueueRepository
 
 	// Other usecases
 	accountUC *AccountUsecase
 	feeUC     *TransactionFeeUsecase
 	feeRuleUC *TransactionFeeRuleUsecase
+	limitUC   *TransactionLimitUsecase
 
 	// External clients
 	authClient         *authclient.AuthService
@@ -75,6 +76,7 @@ type TransactionUsecase struct {
 	notificationBatcher *NotificationBatcher
 	statusTracker       *TransactionStatusTracker
 	processorPool       *ProcessorPool
+	holdSweeper         *HoldSweeper
 
 	// Publishers
 	eventPublisher *publisher.TransactionEventPublisher //  NEW
@@ -90,9 +92,11 @@ func NewTransactionUsecase(
 	journalRepo repository.JournalRepository,
 	ledgerRepo repository.LedgerRepository,
 	feeRepo repository.TransactionFeeRepository,
+	queueRepo repository.TransactionQueueRepository,
 	accountUC *AccountUsecase,
 	feeUC *TransactionFeeUsecase,
 	feeRuleUC *TransactionFeeRuleUsecase,
+	limitUC *TransactionLimitUsecase,
 	authClient *authclient.AuthService,
 	receiptClient *receiptclient.ReceiptClientV3,
 	notificationClient *notificationclient.NotificationService,
@@ -109,9 +113,11 @@ func NewTransactionUsecase(
 		journalRepo:        journalRepo,
 		ledgerRepo:         ledgerRepo,
 		feeRepo:            feeRepo,
+		queueRepo:          queueRepo,
 		accountUC:          accountUC,
 		feeUC:              feeUC,
 		feeRuleUC:          feeRuleUC,
+		limitUC:            limitUC,
 		authClient:         authClient,
 		receiptClient:      receiptClient,
 		notificationClient: notificationClient,
@@ -129,13 +135,17 @@ func NewTransactionUsecase(
 	uc.statusTracker = NewTransactionStatusTracker(redisClient, StatusUpdateInterval)
 
 	// Initialize processor pool
-	uc.processorPool = NewProcessorPool(NumProcessorWorkers, ProcessorQueueSize, uc)
+	uc.processorPool = NewProcessorPool(NumProcessorWorkers, queueRepo, uc)
+
+	// Initialize hold sweeper
+	uc.holdSweeper = NewHoldSweeper(uc, HoldSweepInterval)
 
 	// Start background workers
 	uc.receiptBatcher.Start()
 	uc.notificationBatcher.Start()
 	uc.statusTracker.Start()
 	uc.processorPool.Start()
+	uc.holdSweeper.Start()
 
 	return uc
 }
@@ -190,6 +200,18 @@ func (uc *TransactionUsecase) ExecuteTransaction(
 	// Initialize status tracking
 	uc.statusTracker.Track(receiptCode, "processing")
 
+	// Persist for async processing; the caller only gets a receipt once the
+	// task is durable
+	if err := uc.processorPool.Submit(ctx, &ProcessorTask{
+		ReceiptCode:    receiptCode,
+		Request:        req,
+		IdempotencyKey: req.IdempotencyKey,
+	}); err != nil {
+		uc.statusTracker.Update(receiptCode, "failed", err.Error())
+		uc.receiptBatcher.UpdateStatus(receiptCode, receiptpb.TransactionStatus_TRANSACTION_STATUS_FAILED, err.Error())
+		return nil, err
+	}
+
 	// Return immediately
 	result := &domain.TransactionResult{
 		ReceiptCode:    receiptCode,
@@ -203,13 +225,6 @@ func (uc *TransactionUsecase) ExecuteTransaction(
 	// Log request
 	uc.logTransactionStart(receiptCode, req)
 
-	// Queue for async processing
-	uc.processorPool.Submit(&ProcessorTask{
-		ReceiptCode:    receiptCode,
-		Request:        req,
-		IdempotencyKey: req.IdempotencyKey,
-	})
-
 	return result, nil
 }
 
@@ -304,74 +319,10 @@ func (uc *TransactionUsecase) ExecuteTransactionSync(
 	}, nil
 }
 
-// ===============================
-// BACKGROUND PROCESSING
-// ===============================
-
-// ProcessorTask represents a transaction to process
-type ProcessorTask struct {
-	ReceiptCode    string
-	Request        *domain.TransactionRequest
-	IdempotencyKey *string
-}
-
-// ProcessorPool manages worker goroutines
-type ProcessorPool struct {
-	workers  int
-	taskChan chan *ProcessorTask
-	uc       *TransactionUsecase
-	wg       sync.WaitGroup
-	stopChan chan struct{}
-}
-
-func NewProcessorPool(workers, queueSize int, uc *TransactionUsecase) *ProcessorPool {
-	return &ProcessorPool{
-		workers:  workers,
-		taskChan: make(chan *ProcessorTask, queueSize),
-		uc:       uc,
-		stopChan: make(chan struct{}),
-	}
-}
-
-func (p *ProcessorPool) Start() {
-	for i := 0; i < p.workers; i++ {
-		p.wg.Add(1)
-		go p.worker(i)
-	}
-}
-
-func (p *ProcessorPool) worker(id int) {
-	defer p.wg.Done()
-
-	for {
-		select {
-		case task := <-p.taskChan:
-			p.uc.processTransaction(id, task)
-		case <-p.stopChan:
-			return
-		}
-	}
-}
-
-func (p *ProcessorPool) Submit(task *ProcessorTask) {
-	select {
-	case p.taskChan <- task:
-		// Successfully queued
-	default:
-		// Queue full - log critical error
-		fmt.Printf("[CRITICAL] Processor queue full, dropping transaction: %s\n", task.ReceiptCode)
-		// Update status to failed
-		// TODO: Send alert to monitoring system
-	}
-}
-
-func (p *ProcessorPool) Stop() {
-	close(p.stopChan)
-	p.wg.Wait()
-}
-
-// processTransaction processes a single transaction
-func (uc *TransactionUsecase) processTransaction(workerID int, task *ProcessorTask) {
+// processTransaction executes a claimed queue task. Success is reported here;
+// a failure is returned so the queue can choose between a retry and giving up
+// (reportTransactionFailure).
+func (uc *TransactionUsecase) processTransaction(workerID int, task *ProcessorTask) (*domain.LedgerAggregate, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -395,16 +346,7 @@ func (uc *TransactionUsecase) processTransaction(workerID int, task *ProcessorTa
 	duration := time.Since(startTime)
 
 	if err != nil {
-		// Transaction failed
-		uc.statusTracker.Update(receiptCode, "failed", err.Error())
-		uc.receiptBatcher.UpdateStatus(receiptCode, receiptpb.TransactionStatus_TRANSACTION_STATUS_FAILED, err.Error())
-
-		// Publish failure to both Kafka and Redis
-		uc.publishTransactionEvent(ctx, receiptCode, "failed", err.Error())
-		uc.publishRedisFailureEvent(receiptCode, req, err) // ✅ NEW
-
-		uc.logTransactionError(receiptCode, err)
-		return
+		return nil, err
 	}
 
 	// Transaction succeeded
@@ -414,57 +356,35 @@ func (uc *TransactionUsecase) processTransaction(workerID int, task *ProcessorTa
 	// Queue notifications (batched)
 	uc.queueNotifications(receiptCode, aggregate)
 
-	// Publish success event to both Kafka and Redis
+	// Publish success event to Kafka (the stream event was written with the journal)
 	uc.publishTransactionEvent(ctx, receiptCode, "completed", "")
-	uc.publishRedisCompletionEvent(aggregate, req) // ✅ NEW
 
 	// Log success with metrics
 	uc.logTransactionSuccessWithMetrics(workerID, receiptCode, aggregate.Journal.ID, duration)
 
 	// Invalidate caches
 	uc.invalidateTransactionCaches(ctx, aggregate)
-}
 
-// ✅ NEW: Publish generic transaction completion to Redis
-func (uc *TransactionUsecase) publishRedisCompletionEvent(aggregate *domain.LedgerAggregate, req *domain.TransactionRequest) {
-	if uc.eventPublisher == nil || aggregate == nil {
-		return
-	}
+	return aggregate, nil
+}
 
+// reportTransactionFailure publishes the final failure of a queued transaction
+func (uc *TransactionUsecase) reportTransactionFailure(receiptCode string, req *domain.TransactionRequest, txErr error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	var totalAmount float64
-	var currency string
-	var balanceAfter float64
+	uc.statusTracker.Update(receiptCode, "failed", txErr.Error())
+	uc.receiptBatcher.UpdateStatus(receiptCode, receiptpb.TransactionStatus_TRANSACTION_STATUS_FAILED, txErr.Error())
 
-	for _, ledger := range aggregate.Ledgers {
-		if ledger.DrCr == domain.DrCrDebit {
-			totalAmount = ledger.Amount
-			currency = ledger.Currency
-			balanceAfter = ptrFloat64ToFloat64(ledger.BalanceAfter)
-			break
-		}
-	}
+	// Publish failure to Kafka and the event stream
+	uc.publishTransactionEvent(ctx, receiptCode, "failed", txErr.Error())
+	uc.publishRedisFailureEvent(receiptCode, req, txErr)
 
-	err := uc.eventPublisher.PublishTransactionCompleted(
-		ctx,
-		ptrStrToStr(req.CreatedByExternalID),
-		ptrStrToStr(aggregate.Journal.ExternalRef),
-		aggregate.Journal.ID,
-		string(req.TransactionType),
-		currency,
-		totalAmount,
-		balanceAfter,
-		0, // fee - you can calculate from aggregate.Fees
-	)
-
-	if err != nil {
-		fmt.Printf("[ERROR] Failed to publish transaction completion event: %v\n", err)
-	}
+	uc.logTransactionError(receiptCode, txErr)
 }
 
-// ✅ NEW: Publish transaction failure to Redis
+// publishRedisFailureEvent queues transaction.failed in the outbox; the
+// journal rolled back so there is no transaction to write it in
 func (uc *TransactionUsecase) publishRedisFailureEvent(receiptCode string, req *domain.TransactionRequest, txErr error) {
 	if uc.eventPublisher == nil {
 		return
@@ -473,7 +393,7 @@ func (uc *TransactionUsecase) publishRedisFailureEvent(receiptCode string, req *
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	var totalAmount float64
+	var totalAmount decimal.Decimal
 	var currency string
 
 	for _, entry := range req.Entries {
@@ -582,19 +502,19 @@ func (uc *TransactionUsecase) queueNotifications(receiptCode string, aggregate *
 		eventType := "ACCOUNT_CREDITED"
 		title := "Credit Transaction"
 		headerTitle := "Account Credited"
-		body := fmt.Sprintf("Your account was credited with %.2f %s", ledger.Amount, ledger.Currency)
+		body := fmt.Sprintf("Your account was credited with %s %s", ledger.Amount, ledger.Currency)
 
 		if ledger.DrCr == domain.DrCrDebit {
 			eventType = "ACCOUNT_DEBITED"
 			title = "Debit Transaction"
 			headerTitle = "Account Debited"
-			body = fmt. Sprintf("Your account was debited %.2f %s", ledger.Amount, ledger.Currency)
+			body = fmt. Sprintf("Your account was debited %s %s", ledger.Amount, ledger.Currency)
 		}
 
 		// ✅ Build payload for email template
 		payloadData := map[string]interface{}{
 			"HeaderTitle":       headerTitle,
-			"Amount":           fmt.Sprintf("%.2f", ledger.Amount),
+			"Amount":           ledger.Amount.String(),
 			"Currency":         ledger.Currency,
 			"Date":             aggregate.Journal.CreatedAt.Format("January 2, 2006"),
 			"Time":             aggregate.Journal.CreatedAt.Format("15:04:05"),
@@ -654,19 +574,27 @@ func (uc *TransactionUsecase) preValidateTransaction(ctx context.Context, req *d
 		}
 
 		if account.AccountType != req.AccountType {
-			return fmt.Errorf("account %s type mismatch", entry.AccountNumber)
+			return fmt.Errorf("%w: %s account %s in a %s journal",
+				xerrors.ErrDemoRealAccountMix, account.AccountType, entry.AccountNumber, req.AccountType)
 		}
 
 		// Quick balance check for debits
 		if entry.DrCr == domain.DrCrDebit {
 			balance, err := uc.balanceRepo.GetByAccountNumber(ctx, entry.AccountNumber)
-			if err == nil && balance.AvailableBalance < entry.Amount {
-				return fmt.Errorf("insufficient balance in %s: available=%.2f, required=%.2f",
-    				entry.AccountNumber, balance.AvailableBalance, entry.Amount)  // ✅ Use %.2f for float64
+			if err == nil && balance.AvailableBalance.LessThan(entry.Amount) {
+				return fmt.Errorf("insufficient balance in %s: available=%s, required=%s",
+    				entry.AccountNumber, balance.AvailableBalance, entry.Amount)
 			}
 		}
 	}
 
+	// Limits last; the usage is recorded when the journal posts
+	reservation, err := uc.limitUC.Check(ctx, req)
+	if err != nil {
+		return err
+	}
+	req.LimitReservation = reservation
+
 	return nil
 }
 
@@ -679,7 +607,7 @@ type TransactionEvent struct {
 	ReceiptCode   string    `json:"receipt_code"`
 	TransactionID int64     `json:"transaction_id,omitempty"`
 	Status        string    `json:"status"`
-	Amount        float64   `json:"amount,omitempty"`
+	Amount        string    `json:"amount,omitempty"`
 	Currency      string    `json:"currency,omitempty"`
 	ErrorMessage  string    `json:"error_message,omitempty"`
 	Timestamp     time.Time `json:"timestamp"`
@@ -874,9 +802,8 @@ func (uc *TransactionUsecase) convertReceiptStatus(status receiptpb.TransactionS
 // ===============================
 
 func (uc *TransactionUsecase) logTransactionStart(receiptCode string, req *domain.TransactionRequest) {
-	fmt.Printf("[TRANSACTION START] Receipt: %s | Type: %s | Amount: %.2f %s | Accounts: %d\n",
+	fmt.Printf("[TRANSACTION START] Receipt: %s | Type: %s | Amount: %s %s | Accounts: %d\n",
 		receiptCode, req.TransactionType, uc.getTotalAmount(req.Entries), req.GetCurrency(), len(req. Entries))
-		// ✅ Use %.2f
 }
 
 func (uc *TransactionUsecase) logTransactionSuccess(receiptCode string, journalID int64) {
@@ -896,13 +823,13 @@ func (uc *TransactionUsecase) logTransactionError(receiptCode string, err error)
 // HELPER METHODS
 // ===============================
 
-func (uc *TransactionUsecase) getTotalAmount(entries []*domain.LedgerEntryRequest) float64 {
+func (uc *TransactionUsecase) getTotalAmount(entries []*domain.LedgerEntryRequest) decimal.Decimal {
 	for _, entry := range entries {
 		if entry.DrCr == domain.DrCrDebit {
 			return entry.Amount
 		}
 	}
-	return 0
+	return decimal.Zero
 }
 
 // Shutdown gracefully stops all background workers
@@ -915,6 +842,11 @@ func (uc *TransactionUsecase) Shutdown() {
 		uc.processorPool.Stop()
 	}
 
+	if uc.holdSweeper != nil {
+		fmt.Println("[SHUTDOWN] Stopping hold sweeper...")
+		uc.holdSweeper.Stop()
+	}
+
 	// Flush remaining batches
 	if uc.receiptBatcher != nil {
 		fmt.Println("[SHUTDOWN] Flushing receipt batches...")
@@ -971,7 +903,6 @@ func (uc *TransactionUsecase) Credit(
 			return uc.transactionRepo.Credit(ctx, req)
 		},
 		func(agg *domain.LedgerAggregate) {
-			uc.publishCreditEvent(agg, req)
 			if agg != nil && txReq.ReceiptCode != nil {
 				uc.queueNotifications(*txReq. ReceiptCode, agg)
 			}
@@ -979,38 +910,6 @@ func (uc *TransactionUsecase) Credit(
 	)
 }
 
-func (uc *TransactionUsecase) publishCreditEvent(aggregate *domain.LedgerAggregate, req *domain.CreditRequest) {
-	if uc.eventPublisher == nil || aggregate == nil {
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	// Find the credited ledger
-	var balanceAfter float64
-	for _, ledger := range aggregate.Ledgers {
-		if ledger.DrCr == domain.DrCrCredit {
-			balanceAfter = ptrFloat64ToFloat64(ledger.BalanceAfter)
-			break
-		}
-	}
-
-	err := uc.eventPublisher.PublishDepositCompleted(
-		ctx,
-		req.CreatedByExternalID,
-		ptrStrToStr(aggregate.Journal.ExternalRef),
-		req.AccountNumber,
-		req.Amount,
-		req.Currency,
-		balanceAfter,
-	)
-
-	if err != nil {
-		fmt.Printf("[ERROR] Failed to publish credit event: %v\n", err)
-	}
-}
-
 // Debit removes money from account (user → system, NO FEES)
 func (uc *TransactionUsecase) Debit(
 	ctx context.Context,
@@ -1049,43 +948,10 @@ func (uc *TransactionUsecase) Debit(
 			if agg != nil && txReq.ReceiptCode != nil {
 				uc.queueNotifications(*txReq. ReceiptCode, agg)
 			}
-			uc.publishDebitEvent(agg, req)
 		},
 	)
 }
 
-func (uc *TransactionUsecase) publishDebitEvent(aggregate *domain.LedgerAggregate, req *domain.DebitRequest) {
-	if uc.eventPublisher == nil || aggregate == nil {
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	// Find the debited ledger
-	var balanceAfter float64
-	for _, ledger := range aggregate.Ledgers {
-		if ledger.DrCr == domain.DrCrDebit {
-			balanceAfter = ptrFloat64ToFloat64(ledger.BalanceAfter)
-			break
-		}
-	}
-
-	err := uc.eventPublisher.PublishWithdrawalCompleted(
-		ctx,
-		req.CreatedByExternalID,
-		ptrStrToStr(aggregate.Journal.ExternalRef),
-		req.AccountNumber,
-		req.Amount,
-		req.Currency,
-		balanceAfter,
-	)
-
-	if err != nil {
-		fmt.Printf("[ERROR] Failed to publish debit event: %v\n", err)
-	}
-}
-
 func (uc *TransactionUsecase) Transfer(
 	ctx context.Context,
 	req *domain.TransferRequest,
@@ -1111,29 +977,34 @@ func (uc *TransactionUsecase) Transfer(
 		return nil, xerrors.ErrCurrencyMismatch
 	}
 
-	trFee, err := uc.feeCalculator.CalculateFee(
-		ctx,
-		req.TransactionType,
-		req.Amount,
-		nullableStr(sourceAccount.Currency), 
-		nullableStr(destAccount.Currency),
-		&req.AccountType,
-		ptrOwnerType(req.CreatedByType),
-		req.ToAddress,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to calculate transfer fee: %w", err)
-	}
+	// Moves between accounts of one hierarchy (sub-wallets) are free
+	var transactionFee *domain.TransactionFee
+	if !sourceAccount.SharesHierarchyWith(destAccount) {
+		trFee, err := uc.feeCalculator.CalculateFee(
+			ctx,
+			req.TransactionType,
+			req.Amount,
+			nullableStr(sourceAccount.Currency), 
+			nullableStr(destAccount.Currency),
+			&req.AccountType,
+			ptrOwnerType(req.CreatedByType),
+			req.ToAddress,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate transfer fee: %w", err)
+		}
 
-	transactionFee := domain.TransactionFee{
-		ReceiptCode:  ptrStrToStr(txReq.ReceiptCode),
-		FeeRuleID: trFee.RuleID,    
-		FeeType    : trFee.FeeType ,   
-		Amount      : trFee.Amount ,  
-		Currency   : destAccount.Currency,
+		transactionFee = &domain.TransactionFee{
+			ReceiptCode:  ptrStrToStr(txReq.ReceiptCode),
+			FeeRuleID: trFee.RuleID,    
+			FeeRuleVersionID: trFee.RuleVersionID,
+			FeeType    : trFee.FeeType ,   
+			Amount      : trFee.Amount ,  
+			Currency   : destAccount.Currency,
+		}
 	}
 
-	txReq.TransactionFee = &transactionFee
+	txReq.TransactionFee = transactionFee
 
 	// Execute with common pattern
 	return uc.executeWithReceipt(
@@ -1142,13 +1013,15 @@ func (uc *TransactionUsecase) Transfer(
 		func(ctx context.Context) (*domain.LedgerAggregate, error) {
 			req.ExternalRef = txReq.ExternalRef
 			req.ReceiptCode = txReq.ReceiptCode
-			req.TransactionFee = &transactionFee
+			req.TransactionFee = transactionFee
+			req.LimitReservation = txReq.LimitReservation
 
-			transactionFee.ReceiptCode = ptrStrToStr(txReq.ReceiptCode)
+			if transactionFee != nil {
+				transactionFee.ReceiptCode = ptrStrToStr(txReq.ReceiptCode)
+			}
 			return uc.transactionRepo.Transfer(ctx, req)
 		},
 		func(agg *domain.LedgerAggregate) {
-			uc.publishTransferEvent(agg, req)
 			if agg != nil && txReq.ReceiptCode != nil {
 				uc.queueNotifications(*txReq. ReceiptCode, agg)
 			}
@@ -1156,39 +1029,22 @@ func (uc *TransactionUsecase) Transfer(
 	)
 }
 
-func (uc *TransactionUsecase) publishTransferEvent(aggregate *domain.LedgerAggregate, req *domain.TransferRequest) {
-	if uc.eventPublisher == nil || aggregate == nil {
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	// Calculate fee (if any)
-	var feeAmount float64 = 0
-	// You can fetch fee from aggregate. Fees if available
-
-	err := uc.eventPublisher.PublishTransferCompleted(
-		ctx,
-		req.CreatedByExternalID,
-		ptrStrToStr(aggregate.Journal.ExternalRef),
-		req.FromAccountNumber,
-		req.ToAccountNumber,
-		req.Amount,
-		"", // Currency - you may need to get this from accounts
-		feeAmount,
-	)
-
-	if err != nil {
-		fmt.Printf("[ERROR] Failed to publish transfer event: %v\n", err)
-	}
-}
-
 // ConvertAndTransfer performs currency conversion (FEES APPLY)
 func (uc *TransactionUsecase) ConvertAndTransfer(
 	ctx context.Context,
 	req *domain.ConversionRequest,
-) (*domain.LedgerAggregate, error) {
+) (aggregate *domain.LedgerAggregate, err error) {
+	// Quoted conversion: book the locked rate and fee
+	if req.QuoteID != nil && *req.QuoteID != "" {
+		var finish func(error)
+		finish, err = uc.claimFXQuote(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		// Reads the named return, so the quote sees the conversion's outcome
+		defer func() { finish(err) }()
+	}
+
 	if err := req.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid conversion request: %w", err)
 	}
@@ -1224,37 +1080,45 @@ func (uc *TransactionUsecase) ConvertAndTransfer(
 	// Build transaction request
 	txReq := buildConversionDoubleEntry(req, sourceAccount, destAccount)
 
-	// Calculate fee
-	trFee, err := uc.feeCalculator. CalculateFee(
-		ctx,
-		domain.TransactionTypeConversion,
-		req. Amount,
-		nullableStr(sourceAccount.Currency),
-		nullableStr(destAccount.Currency),
-		&req. AccountType,
-		ptrOwnerType(req.CreatedByType),
-		req.ToAddress,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to calculate conversion fee: %w", err)
-	}
-
+	// Calculate fee (a quote carries the fee it was issued with)
 	transactionFee := domain.TransactionFee{
 		ReceiptCode: ptrStrToStr(txReq.ReceiptCode),
-		FeeRuleID:   trFee.RuleID,
-		FeeType:     trFee.FeeType,
-		Amount:      trFee. Amount,
 		Currency:    destAccount.Currency,
 	}
+	if req.Quote != nil {
+		transactionFee.FeeRuleID = req.Quote.FeeRuleID
+		transactionFee.FeeRuleVersionID = req.Quote.FeeRuleVersionID
+		transactionFee.FeeType = req.Quote.FeeType
+		transactionFee.Amount = req.Quote.FeeAmount
+		req.Metadata["fx_quote_id"] = req.Quote.QuoteID
+	} else {
+		trFee, err := uc.feeCalculator. CalculateFee(
+			ctx,
+			domain.TransactionTypeConversion,
+			req. Amount,
+			nullableStr(sourceAccount.Currency),
+			nullableStr(destAccount.Currency),
+			&req. AccountType,
+			ptrOwnerType(req.CreatedByType),
+			req.ToAddress,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate conversion fee: %w", err)
+		}
+		transactionFee.FeeRuleID = trFee.RuleID
+		transactionFee.FeeRuleVersionID = trFee.RuleVersionID
+		transactionFee.FeeType = trFee.FeeType
+		transactionFee.Amount = trFee.Amount
+	}
 
 	txReq.TransactionFee = &transactionFee
 	
 	// Add fee info to metadata
-	req.Metadata["fee_amount"] = trFee.Amount
+	req.Metadata["fee_amount"] = transactionFee.Amount
 	req.Metadata["fee_currency"] = destAccount.Currency
-	req.Metadata["fee_type"] = string(trFee.FeeType)
-	if trFee.RuleID != nil {
-		req.Metadata["fee_rule_id"] = *trFee.RuleID
+	req.Metadata["fee_type"] = string(transactionFee.FeeType)
+	if transactionFee.FeeRuleID != nil {
+		req.Metadata["fee_rule_id"] = *transactionFee.FeeRuleID
 	}
 
 	// Execute with common pattern
@@ -1265,12 +1129,12 @@ func (uc *TransactionUsecase) ConvertAndTransfer(
 			req.ExternalRef = txReq.ExternalRef
 			req. ReceiptCode = txReq.ReceiptCode
 			req.TransactionFee = &transactionFee
+			req.LimitReservation = txReq.LimitReservation
 
 			transactionFee.ReceiptCode = ptrStrToStr(txReq.ReceiptCode)
 			return uc.transactionRepo.ConvertAndTransfer(ctx, req)
 		},
 		func(agg *domain.LedgerAggregate) {
-			uc. publishConversionEvent(agg, req)
 			if agg != nil && txReq.ReceiptCode != nil {
 				uc.queueNotifications(*txReq. ReceiptCode, agg)
 			}
@@ -1278,58 +1142,6 @@ func (uc *TransactionUsecase) ConvertAndTransfer(
 	)
 }
 
-func (uc *TransactionUsecase) publishConversionEvent(aggregate *domain.LedgerAggregate, req *domain.ConversionRequest) {
-	if uc.eventPublisher == nil || aggregate == nil {
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	// Get currencies from ledgers
-	var sourceCurrency, destCurrency string
-	var sourceAmount, convertedAmount float64
-
-	for _, ledger := range aggregate.Ledgers {
-		account, err := uc.accountRepo.GetByID(ctx, ledger.AccountID)
-		if err != nil {
-			continue
-		}
-		if account.AccountNumber == req.FromAccountNumber {
-			sourceCurrency = ledger.Currency
-			sourceAmount = ledger.Amount
-		}
-		if account.AccountNumber == req.ToAccountNumber {
-			destCurrency = ledger.Currency
-			convertedAmount = ledger.Amount
-		}
-	}
-
-	// Publish as a custom event
-	event := &publisher.TransactionEvent{
-		EventType:       "conversion.completed",
-		UserID:          req.CreatedByExternalID,
-		ReceiptCode:     ptrStrToStr(aggregate.Journal.ExternalRef),
-		TransactionID:   aggregate.Journal.ID,
-		TransactionType: "conversion",
-		Status:          "completed",
-		Amount:          sourceAmount,
-		Currency:        sourceCurrency,
-		FromAccount:     req.FromAccountNumber,
-		ToAccount:       req.ToAccountNumber,
-		Metadata: map[string]interface{}{
-			"source_currency":  sourceCurrency,
-			"dest_currency":    destCurrency,
-			"source_amount":    sourceAmount,
-			"converted_amount": convertedAmount,
-		},
-	}
-
-	if err := uc.eventPublisher.PublishTransactionEvent(ctx, event); err != nil {
-		fmt.Printf("[ERROR] Failed to publish conversion event: %v\n", err)
-	}
-}
-
 // ProcessTradeWin credits account for trade win (NO FEES)
 func (uc *TransactionUsecase) ProcessTradeWin(
 	ctx context.Context,
@@ -1364,7 +1176,6 @@ func (uc *TransactionUsecase) ProcessTradeWin(
 			return uc.transactionRepo.ProcessTradeWin(ctx, req)
 		},
 		func(agg *domain.LedgerAggregate) {
-			uc.publishTradeEvent(agg, req, "win")
 			if agg != nil && txReq.ReceiptCode != nil {
 				uc.queueNotifications(*txReq. ReceiptCode, agg)
 			}
@@ -1412,9 +1223,7 @@ func (uc *TransactionUsecase) ProcessTradeLoss(
 			req.ReceiptCode = txReq.ReceiptCode
 			return uc.transactionRepo.ProcessTradeLoss(ctx, req)
 		},
-		func(agg *domain.LedgerAggregate) {
-			uc.publishTradeEvent(agg, req, "loss")
-		},
+		nil,
 	)
 
 	if err == nil && aggregate != nil {
@@ -1425,43 +1234,6 @@ func (uc *TransactionUsecase) ProcessTradeLoss(
 	return aggregate, err
 }
 
-func (uc *TransactionUsecase) publishTradeEvent(aggregate *domain.LedgerAggregate, req *domain.TradeRequest, result string) {
-	if uc.eventPublisher == nil || aggregate == nil {
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	var balanceAfter float64
-	for _, ledger := range aggregate.Ledgers {
-		balanceAfter = ptrFloat64ToFloat64(ledger.BalanceAfter)
-		break
-	}
-
-	event := &publisher.TransactionEvent{
-		EventType:       fmt.Sprintf("trade.%s", result),
-		UserID:          req.CreatedByExternalID,
-		ReceiptCode:     ptrStrToStr(aggregate.Journal.ExternalRef),
-		TransactionID:   aggregate.Journal.ID,
-		TransactionType: "trade",
-		Status:          "completed",
-		Amount:          req.Amount,
-		Currency:        req.Currency,
-		AccountNumber:   req.AccountNumber,
-		BalanceAfter:    balanceAfter,
-		Metadata: map[string]interface{}{
-			"trade_id":     req.TradeID,
-			"trade_type":   req.TradeType,
-			"trade_result": result,
-		},
-	}
-
-	if err := uc.eventPublisher.PublishTransactionEvent(ctx, event); err != nil {
-		fmt.Printf("[ERROR] Failed to publish trade event: %v\n", err)
-	}
-}
-
 // ProcessAgentCommission pays commission to agent (NO FEES)
 func (uc *TransactionUsecase) ProcessAgentCommission(
 	ctx context.Context,
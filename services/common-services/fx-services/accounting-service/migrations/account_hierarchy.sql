@@ -0,0 +1,82 @@
+-- ===============================================================================================
+-- MIGRATION: Account hierarchy (sub-accounts)
+-- ===============================================================================================
+-- Purpose: named sub-accounts under an owner's main account - user sub-wallets ("rent",
+--          "savings pot") and partner per-merchant accounts. A sub-account has the same owner,
+--          currency and account type as its parent and is one level deep (a sub-account cannot
+--          have sub-accounts). Moves between accounts of one hierarchy are fee free.
+-- Uniqueness: uq_account (one account per owner/currency/purpose/type) now only applies to
+--          main accounts; sub-accounts are unique by name under their parent instead.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: COLUMNS
+-- ===============================
+
+ALTER TABLE accounts
+  ADD COLUMN IF NOT EXISTS parent_account_id BIGINT REFERENCES accounts(id),
+  ADD COLUMN IF NOT EXISTS name              TEXT;
+
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS chk_sub_account_name;
+ALTER TABLE accounts ADD CONSTRAINT chk_sub_account_name CHECK (
+  parent_account_id IS NULL OR length(trim(name)) > 0
+);
+
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS chk_sub_account_not_self;
+ALTER TABLE accounts ADD CONSTRAINT chk_sub_account_not_self CHECK (
+  parent_account_id IS NULL OR parent_account_id <> id
+);
+
+COMMENT ON COLUMN accounts.parent_account_id IS 'Main account this sub-account belongs to (NULL = main account)';
+COMMENT ON COLUMN accounts.name IS 'Owner-chosen label, required for sub-accounts';
+
+-- ===============================
+-- STEP 2: UNIQUENESS
+-- ===============================
+
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS uq_account;
+
+-- Account creation upserts against this index (ON CONFLICT ... WHERE parent_account_id IS NULL)
+CREATE UNIQUE INDEX IF NOT EXISTS uq_account_main
+  ON accounts (owner_type, owner_id, currency, purpose, account_type)
+  WHERE parent_account_id IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_sub_account_name
+  ON accounts (parent_account_id, lower(name))
+  WHERE parent_account_id IS NOT NULL;
+
+-- ===============================
+-- STEP 3: INDEXES
+-- ===============================
+
+CREATE INDEX IF NOT EXISTS idx_accounts_parent
+  ON accounts (parent_account_id)
+  WHERE parent_account_id IS NOT NULL;
+
+-- ===============================
+-- STEP 4: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'accounts' AND column_name = 'parent_account_id'
+    ) THEN
+        RAISE EXCEPTION 'accounts.parent_account_id was not created';
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_indexes WHERE indexname = 'uq_account_main'
+    ) THEN
+        RAISE EXCEPTION 'uq_account_main was not created';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
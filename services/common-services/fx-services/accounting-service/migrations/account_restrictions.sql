@@ -0,0 +1,96 @@
+-- ===============================================================================================
+-- MIGRATION: Account freezes, liens and closure
+-- ===============================================================================================
+-- Purpose: compliance restrictions on an account beyond is_active / is_locked. Each row records
+--          who placed it, why and until when; a release records who lifted it and why. Rows are
+--          never deleted, so the table is the account's restriction history.
+-- Types:   debit_freeze   - debits rejected, credits allowed
+--          credit_freeze  - credits rejected, debits allowed
+--          full_freeze    - all postings rejected
+--          lien           - amount held back from debits (balance - liens is what can be spent)
+--          closure        - permanent; written after the balance has been swept out, and the
+--                           account is deactivated in the same transaction
+-- Enforced by the transaction repository when an account is loaded for posting.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: RESTRICTIONS
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS account_restrictions (
+  id                    BIGSERIAL PRIMARY KEY,
+  account_id            BIGINT NOT NULL REFERENCES accounts(id),
+  restriction_type      TEXT NOT NULL,
+  amount                NUMERIC(30, 18),                -- liens only
+  reason                TEXT NOT NULL,
+  reference             TEXT,                           -- case, court order or ticket number
+  created_by            TEXT NOT NULL,
+  expires_at            TIMESTAMPTZ,                    -- NULL = until released
+  created_at            TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  released_at           TIMESTAMPTZ,
+  released_by           TEXT,
+  release_reason        TEXT,
+
+  -- closure only
+  sweep_account_number  TEXT,
+  swept_amount          NUMERIC(30, 18),
+  sweep_receipt_code    TEXT,
+
+  CONSTRAINT chk_restriction_type CHECK (
+    restriction_type IN ('debit_freeze', 'credit_freeze', 'full_freeze', 'lien', 'closure')
+  ),
+  CONSTRAINT chk_restriction_lien_amount CHECK (
+    (restriction_type = 'lien' AND amount > 0) OR (restriction_type <> 'lien' AND amount IS NULL)
+  ),
+  CONSTRAINT chk_restriction_reason CHECK (length(trim(reason)) > 0),
+  CONSTRAINT chk_restriction_closure_final CHECK (
+    restriction_type <> 'closure' OR (expires_at IS NULL AND released_at IS NULL)
+  ),
+  CONSTRAINT chk_restriction_release CHECK (
+    (released_at IS NULL AND released_by IS NULL) OR (released_at IS NOT NULL AND released_by IS NOT NULL)
+  )
+);
+
+COMMENT ON TABLE account_restrictions IS
+  'Freezes, liens and closures on accounts with actor, reason and expiry; released rows are kept as history.';
+
+-- ===============================
+-- STEP 2: INDEXES
+-- ===============================
+
+-- Loaded for every account on every posting
+CREATE INDEX IF NOT EXISTS idx_account_restrictions_open
+  ON account_restrictions (account_id)
+  WHERE released_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_account_restrictions_account
+  ON account_restrictions (account_id, created_at DESC);
+
+-- An account is closed once
+CREATE UNIQUE INDEX IF NOT EXISTS uq_account_restrictions_closure
+  ON account_restrictions (account_id)
+  WHERE restriction_type = 'closure';
+
+-- ===============================
+-- STEP 3: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'account_restrictions'
+    ) THEN
+        RAISE EXCEPTION 'account_restrictions was not created';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE account_restrictions;
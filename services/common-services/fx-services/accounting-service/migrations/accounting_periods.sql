@@ -0,0 +1,92 @@
+-- ===============================================================================================
+-- MIGRATION: Accounting periods (month-end close)
+-- ===============================================================================================
+-- Purpose: closing a period snapshots every account's opening balance, debits, credits and
+--          closing balance into period_balances and freezes the period: postings dated before
+--          the latest closed period_end are rejected by the transaction repository.
+-- Periods: contiguous, [period_start, period_end). A period is inserted as 'closing' (postings
+--          are blocked from that moment) and becomes 'closed' once its snapshot is written.
+-- Opening: the previous period's closing balance, or account_opening_balances plus ledger
+--          history for the first period / accounts opened later.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: ACCOUNTING PERIODS
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS accounting_periods (
+  id              BIGSERIAL PRIMARY KEY,
+  period_start    TIMESTAMPTZ NOT NULL,
+  period_end      TIMESTAMPTZ NOT NULL,
+  status          TEXT NOT NULL DEFAULT 'closing',
+  closed_by       TEXT NOT NULL,
+  note            TEXT,
+  accounts_count  BIGINT NOT NULL DEFAULT 0,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+  closed_at       TIMESTAMPTZ,
+
+  CONSTRAINT uq_accounting_period_start UNIQUE (period_start),
+  CONSTRAINT uq_accounting_period_end UNIQUE (period_end),
+  CONSTRAINT chk_accounting_period_range CHECK (period_end > period_start),
+  CONSTRAINT chk_accounting_period_status CHECK (status IN ('closing', 'closed'))
+);
+
+COMMENT ON TABLE accounting_periods IS
+  'Closed accounting periods. MAX(period_end) is the watermark before which postings are rejected.';
+
+-- ===============================
+-- STEP 2: PERIOD BALANCES
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS period_balances (
+  period_id        BIGINT NOT NULL REFERENCES accounting_periods(id) ON DELETE CASCADE,
+  account_id       BIGINT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  account_number   TEXT NOT NULL,
+  owner_type       owner_type_enum NOT NULL,
+  purpose          account_purpose_enum NOT NULL,
+  account_type     account_type_enum NOT NULL,
+  currency         VARCHAR(8) NOT NULL,
+  opening_balance  NUMERIC(30, 18) NOT NULL DEFAULT 0,
+  total_debits     NUMERIC(30, 18) NOT NULL DEFAULT 0,
+  total_credits    NUMERIC(30, 18) NOT NULL DEFAULT 0,
+  closing_balance  NUMERIC(30, 18) NOT NULL DEFAULT 0,
+
+  PRIMARY KEY (period_id, account_id),
+  CONSTRAINT chk_period_balance_closing
+    CHECK (closing_balance = opening_balance + total_credits - total_debits)
+);
+
+CREATE INDEX IF NOT EXISTS idx_period_balances_group
+  ON period_balances (period_id, purpose, currency, account_type);
+CREATE INDEX IF NOT EXISTS idx_period_balances_account
+  ON period_balances (account_id, period_id DESC);
+
+-- ===============================
+-- STEP 3: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'period_balances'
+    ) THEN
+        RAISE EXCEPTION 'period_balances was not created';
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'account_opening_balances'
+    ) THEN
+        RAISE EXCEPTION 'account_opening_balances missing: run reconciliation.sql first';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE accounting_periods;
+ANALYZE period_balances;
@@ -0,0 +1,156 @@
+-- ===============================================================================================
+-- MIGRATION: Policy-driven multi-level approvals
+-- ===============================================================================================
+-- Purpose: manual ledger operations needed exactly one super admin and were executed in a bare
+--          goroutine, so an approval was lost on restart. Requests now take their rules from
+--          approval_policies (transaction type / currency / amount band), collect N-of-M
+--          decisions in transaction_approval_decisions, expire when the quorum is not reached in
+--          time, and are executed by a database-driven worker with retry.
+-- Snapshot: required_approvals, approver_roles, distinct_roles and expires_at are copied onto
+--           each request at creation; editing a policy does not change requests in flight.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+-- New enum values cannot be used in the transaction that adds them
+ALTER TYPE approval_status_enum ADD VALUE IF NOT EXISTS 'expired';
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: APPROVAL POLICIES
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS approval_policies (
+  id                     BIGSERIAL PRIMARY KEY,
+  name                   TEXT NOT NULL,
+  transaction_type       transaction_type_enum,          -- NULL = any type
+  currency               TEXT,                           -- NULL = any currency
+  min_amount             NUMERIC(30, 18) NOT NULL DEFAULT 0,
+  max_amount             NUMERIC(30, 18),                -- Exclusive; NULL = unbounded
+  required_approvals     INT NOT NULL DEFAULT 1,
+  approver_roles         TEXT[] NOT NULL DEFAULT '{}',   -- Empty = any admin role
+  distinct_roles         BOOLEAN NOT NULL DEFAULT FALSE,
+  expires_after_seconds  BIGINT NOT NULL DEFAULT 259200, -- 0 = never
+  priority               INT NOT NULL DEFAULT 0,
+  is_active              BOOLEAN NOT NULL DEFAULT TRUE,
+  created_by             TEXT NOT NULL,
+  created_at             TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at             TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_approval_policy_band CHECK (min_amount >= 0 AND (max_amount IS NULL OR max_amount > min_amount)),
+  CONSTRAINT chk_approval_policy_quorum CHECK (required_approvals >= 1),
+  CONSTRAINT chk_approval_policy_expiry CHECK (expires_after_seconds >= 0)
+);
+
+COMMENT ON TABLE approval_policies IS
+  'Approval rules for manual ledger operations; the most specific active policy whose amount band contains the amount applies.';
+
+CREATE INDEX IF NOT EXISTS idx_approval_policies_match
+  ON approval_policies (transaction_type, currency, min_amount)
+  WHERE is_active;
+
+CREATE TRIGGER trg_approval_policies_set_updated_at
+    BEFORE UPDATE ON approval_policies
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- ===============================
+-- STEP 2: POLICY SNAPSHOT & EXECUTION STATE ON REQUESTS
+-- ===============================
+
+ALTER TABLE transaction_approvals
+  ADD COLUMN IF NOT EXISTS policy_id          BIGINT REFERENCES approval_policies(id),
+  ADD COLUMN IF NOT EXISTS required_approvals INT NOT NULL DEFAULT 1,
+  ADD COLUMN IF NOT EXISTS approver_roles     TEXT[] NOT NULL DEFAULT '{super_admin}',
+  ADD COLUMN IF NOT EXISTS distinct_roles     BOOLEAN NOT NULL DEFAULT FALSE,
+  ADD COLUMN IF NOT EXISTS expires_at         TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS execution_attempts INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS next_execution_at  TIMESTAMPTZ;
+
+-- Approved requests left behind by the old in-process execution get picked up by the executor
+UPDATE transaction_approvals
+SET next_execution_at = NOW()
+WHERE status = 'approved' AND next_execution_at IS NULL;
+
+ALTER TABLE transaction_approvals DROP CONSTRAINT IF EXISTS valid_status_transition;
+ALTER TABLE transaction_approvals ADD CONSTRAINT valid_status_transition CHECK (
+    (status = 'pending') OR
+    (status = 'approved' AND approved_by IS NOT NULL) OR
+    (status = 'rejected' AND approved_by IS NOT NULL AND rejection_reason IS NOT NULL) OR
+    (status = 'executed' AND receipt_code IS NOT NULL) OR
+    (status = 'failed' AND error_message IS NOT NULL) OR
+    (status = 'expired')
+);
+
+-- Executor claim
+CREATE INDEX IF NOT EXISTS idx_transaction_approvals_due
+  ON transaction_approvals (next_execution_at)
+  WHERE status = 'approved';
+
+-- Expiry sweep
+CREATE INDEX IF NOT EXISTS idx_transaction_approvals_expires_at
+  ON transaction_approvals (expires_at)
+  WHERE status = 'pending';
+
+-- ===============================
+-- STEP 3: DECISIONS
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS transaction_approval_decisions (
+  id             BIGSERIAL PRIMARY KEY,
+  approval_id    BIGINT NOT NULL REFERENCES transaction_approvals(id) ON DELETE CASCADE,
+  approver_id    BIGINT NOT NULL,
+  approver_role  TEXT NOT NULL,
+  approved       BOOLEAN NOT NULL,
+  reason         TEXT,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT uq_approval_decision_approver UNIQUE (approval_id, approver_id)
+);
+
+COMMENT ON TABLE transaction_approval_decisions IS
+  'One row per approver vote; a request is approved once its quorum of approving votes is reached.';
+
+CREATE INDEX IF NOT EXISTS idx_approval_decisions_approver
+  ON transaction_approval_decisions (approver_id);
+
+-- Carry over single-approver decisions made before this migration
+INSERT INTO transaction_approval_decisions (approval_id, approver_id, approver_role, approved, reason, created_at)
+SELECT id, approved_by, 'super_admin', status <> 'rejected', rejection_reason, COALESCE(approved_at, updated_at)
+FROM transaction_approvals
+WHERE approved_by IS NOT NULL
+ON CONFLICT (approval_id, approver_id) DO NOTHING;
+
+-- ===============================
+-- STEP 4: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'approval_policies'
+    ) THEN
+        RAISE EXCEPTION 'approval_policies was not created';
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'transaction_approval_decisions'
+    ) THEN
+        RAISE EXCEPTION 'transaction_approval_decisions was not created';
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'transaction_approvals' AND column_name = 'next_execution_at'
+    ) THEN
+        RAISE EXCEPTION 'transaction_approvals.next_execution_at was not added';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE approval_policies;
+ANALYZE transaction_approvals;
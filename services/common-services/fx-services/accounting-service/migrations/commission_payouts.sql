@@ -0,0 +1,101 @@
+-- ===============================================================================================
+-- MIGRATION: Agent commission payouts
+-- ===============================================================================================
+-- Purpose: agent_commissions rows were recorded on every agent transaction but never settled;
+--          paid_out / payout_receipt_code / paid_out_at were never set. A payout batch now groups
+--          unpaid commissions per agent and currency over a period and moves the net amount from
+--          the system commission account to the agent account in a single transfer.
+-- Reservation: commissions included in a payout are linked through payout_id before the
+--              transfer is posted, so concurrent batches cannot pay them twice. A failed payout
+--              releases them (payout_id = NULL) for the next batch. A pending payout left by a
+--              crashed batch is leased (lease_until) by the batch that resumes it.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: PAYOUTS TABLE
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS commission_payouts (
+  id                 BIGSERIAL PRIMARY KEY,
+  batch_id           TEXT NOT NULL,
+  agent_external_id  TEXT NOT NULL REFERENCES agents(agent_external_id),
+  currency           TEXT NOT NULL,
+  amount             NUMERIC(30, 18) NOT NULL,
+  commission_count   INT NOT NULL,
+  period_from        TIMESTAMPTZ,                    -- NULL = since the first commission
+  period_to          TIMESTAMPTZ NOT NULL,           -- Exclusive
+  status             TEXT NOT NULL DEFAULT 'pending',
+  receipt_code       TEXT,
+  error_message      TEXT,
+  created_by         TEXT NOT NULL,
+  created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  paid_at            TIMESTAMPTZ,
+  lease_until        TIMESTAMPTZ,                    -- Batch resuming a stale pending payout
+
+  CONSTRAINT chk_commission_payout_status CHECK (status IN ('pending', 'paid', 'failed')),
+  CONSTRAINT chk_commission_payout_amount CHECK (amount > 0 AND commission_count > 0),
+  CONSTRAINT chk_commission_payout_paid CHECK (status <> 'paid' OR receipt_code IS NOT NULL)
+);
+
+COMMENT ON TABLE commission_payouts IS
+  'Settlement of an agent''s unpaid commissions in one currency; one transfer from the system commission account per row.';
+
+CREATE INDEX IF NOT EXISTS idx_commission_payouts_agent
+  ON commission_payouts (agent_external_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_commission_payouts_batch
+  ON commission_payouts (batch_id);
+
+CREATE INDEX IF NOT EXISTS idx_commission_payouts_pending
+  ON commission_payouts (created_at)
+  WHERE status = 'pending';
+
+-- ===============================
+-- STEP 2: LINK COMMISSIONS TO PAYOUTS
+-- ===============================
+
+ALTER TABLE agent_commissions
+  ADD COLUMN IF NOT EXISTS payout_id BIGINT REFERENCES commission_payouts(id);
+
+COMMENT ON COLUMN agent_commissions.payout_id IS
+  'Payout that reserved (pending) or settled (paid_out) this commission.';
+
+-- Batch selection: unpaid, unreserved commissions per agent and currency
+CREATE INDEX IF NOT EXISTS idx_agent_commissions_unpaid
+  ON agent_commissions (agent_external_id, currency, created_at)
+  WHERE NOT paid_out AND payout_id IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_agent_commissions_payout_id
+  ON agent_commissions (payout_id)
+  WHERE payout_id IS NOT NULL;
+
+-- ===============================
+-- STEP 3: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'commission_payouts'
+    ) THEN
+        RAISE EXCEPTION 'commission_payouts was not created';
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'agent_commissions' AND column_name = 'payout_id'
+    ) THEN
+        RAISE EXCEPTION 'agent_commissions.payout_id was not added';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE commission_payouts;
+ANALYZE agent_commissions;
@@ -0,0 +1,221 @@
+-- ===============================================================================================
+-- MIGRATION: Normalize stored amounts to per-currency scale
+-- ===============================================================================================
+-- Purpose: The ledger path used float64 arithmetic until now, so stored NUMERIC values can carry
+--          binary noise (e.g. 10.199999999999999289). The service now computes with exact
+--          decimals rounded to currencies.decimals; this brings existing rows to the same scale.
+-- Balances: rounded in place. System floats and demo wallets hold an opening amount with no
+--           ledger rows, so balance = opening + SUM(CR) - SUM(DR); opening is taken from
+--           account_opening_balances when it exists, else it is whatever such an account holds
+--           beyond its ledgers. The migration fails on drift from that, before or after rounding,
+--           of more than one unit at the currency's scale.
+-- Currency Precision: read from currencies.decimals (USD=2, USDT=6, BTC=8)
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: ADD HELPER FUNCTION FOR ROUNDING
+-- ===============================
+
+CREATE OR REPLACE FUNCTION round_to_currency(
+    amount NUMERIC,
+    currency_code VARCHAR(8)
+) RETURNS NUMERIC AS $$
+DECLARE
+    scale INT;
+BEGIN
+    IF amount IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    SELECT c.decimals INTO scale
+    FROM currencies c
+    WHERE c.code = currency_code;
+
+    -- If currency not found, default to 2 decimals
+    IF scale IS NULL THEN
+        scale := 2;
+    END IF;
+
+    RETURN ROUND(amount, scale);
+END;
+$$ LANGUAGE plpgsql STABLE;
+
+-- ===============================
+-- STEP 2: REPORT ROWS THAT WILL CHANGE
+-- ===============================
+
+DO $$
+DECLARE
+    v_count INT;
+BEGIN
+    SELECT COUNT(*) INTO v_count FROM ledgers
+    WHERE amount <> round_to_currency(amount, currency);
+    RAISE NOTICE 'ledgers with off-scale amounts: %', v_count;
+
+    -- These would violate CHECK (amount > 0) after rounding and are left untouched
+    SELECT COUNT(*) INTO v_count FROM ledgers
+    WHERE round_to_currency(amount, currency) = 0;
+    RAISE NOTICE 'ledgers below currency precision (skipped, review manually): %', v_count;
+
+    SELECT COUNT(*) INTO v_count FROM balances b
+    JOIN accounts a ON a.id = b.account_id
+    WHERE b.balance <> round_to_currency(b.balance, a.currency);
+    RAISE NOTICE 'balances with off-scale values: %', v_count;
+END $$;
+
+-- Per account: one unit at the currency's scale, and the opening amount and ledger net
+-- from before rounding
+CREATE TEMP TABLE balance_scale_check ON COMMIT DROP AS
+SELECT a.id AS account_id,
+       1 / POWER(10::NUMERIC, COALESCE(c.decimals, 2)) AS unit,
+       b.balance AS old_balance,
+       COALESCE(n.net, 0) AS old_net,
+       CASE WHEN a.owner_type = 'system' OR a.account_type = 'demo'
+            THEN b.balance - COALESCE(n.net, 0)
+            ELSE 0
+       END AS opening
+FROM accounts a
+JOIN balances b ON b.account_id = a.id
+LEFT JOIN currencies c ON c.code = a.currency
+LEFT JOIN (
+    SELECT account_id, SUM(CASE WHEN dr_cr = 'CR' THEN amount ELSE -amount END) AS net
+    FROM ledgers
+    GROUP BY account_id
+) n ON n.account_id = a.id;
+
+DO $$
+DECLARE
+    v_count INT;
+BEGIN
+    IF to_regclass('account_opening_balances') IS NOT NULL THEN
+        EXECUTE 'UPDATE balance_scale_check s SET opening = o.amount
+                 FROM account_opening_balances o WHERE o.account_id = s.account_id';
+    END IF;
+
+    -- Real drift is not float noise; it must be reconciled, not rounded away
+    SELECT COUNT(*) INTO v_count FROM balance_scale_check
+    WHERE ABS(old_balance - (opening + old_net)) > unit;
+    IF v_count > 0 THEN
+        RAISE EXCEPTION 'balances that drift from their ledgers by more than one unit: %', v_count;
+    END IF;
+END $$;
+
+-- ===============================
+-- STEP 3: CURRENCIES
+-- ===============================
+
+UPDATE currencies
+SET demo_initial_balance = ROUND(demo_initial_balance, decimals),
+    min_amount           = ROUND(min_amount, decimals),
+    max_amount           = ROUND(max_amount, decimals),
+    updated_at           = now()
+WHERE demo_initial_balance <> ROUND(demo_initial_balance, decimals)
+   OR min_amount <> ROUND(min_amount, decimals)
+   OR max_amount <> ROUND(max_amount, decimals);
+
+-- ===============================
+-- STEP 4: ACCOUNTS
+-- ===============================
+
+UPDATE accounts
+SET overdraft_limit = round_to_currency(overdraft_limit, currency)
+WHERE overdraft_limit <> round_to_currency(overdraft_limit, currency);
+
+-- ===============================
+-- STEP 5: LEDGERS
+-- ===============================
+
+UPDATE ledgers
+SET amount = round_to_currency(amount, currency)
+WHERE amount <> round_to_currency(amount, currency)
+  AND round_to_currency(amount, currency) > 0;
+
+UPDATE ledgers
+SET balance_after = round_to_currency(balance_after, currency)
+WHERE balance_after <> round_to_currency(balance_after, currency);
+
+-- ===============================
+-- STEP 6: BALANCES
+-- ===============================
+
+UPDATE balances b
+SET balance           = round_to_currency(b.balance, a.currency),
+    available_balance = round_to_currency(b.available_balance, a.currency),
+    pending_debit     = round_to_currency(b.pending_debit, a.currency),
+    pending_credit    = round_to_currency(b.pending_credit, a.currency),
+    updated_at        = now()
+FROM accounts a
+WHERE a.id = b.account_id
+  AND (b.balance <> round_to_currency(b.balance, a.currency)
+    OR b.available_balance <> round_to_currency(b.available_balance, a.currency)
+    OR b.pending_debit <> round_to_currency(b.pending_debit, a.currency)
+    OR b.pending_credit <> round_to_currency(b.pending_credit, a.currency));
+
+-- ===============================
+-- STEP 7: FEES & COMMISSIONS
+-- ===============================
+
+UPDATE transaction_fees
+SET amount = round_to_currency(amount, currency)
+WHERE amount <> round_to_currency(amount, currency);
+
+UPDATE agent_commissions
+SET transaction_amount = round_to_currency(transaction_amount, currency),
+    commission_amount  = round_to_currency(commission_amount, currency)
+WHERE transaction_amount <> round_to_currency(transaction_amount, currency)
+   OR commission_amount <> round_to_currency(commission_amount, currency);
+
+-- ===============================
+-- STEP 8: APPROVALS
+-- ===============================
+
+-- NUMERIC(20,2) silently truncated crypto amounts; widen to match the ledger
+ALTER TABLE transaction_approvals
+  ALTER COLUMN amount TYPE NUMERIC(30, 18);
+
+-- ===============================
+-- STEP 9: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+DECLARE
+    v_count INT;
+BEGIN
+    -- Rounding moves a balance and its ledgers by at most one unit apart
+    SELECT COUNT(*) INTO v_count FROM balances b
+    JOIN balance_scale_check s ON s.account_id = b.account_id
+    LEFT JOIN (
+        SELECT account_id, SUM(CASE WHEN dr_cr = 'CR' THEN amount ELSE -amount END) AS net
+        FROM ledgers
+        GROUP BY account_id
+    ) n ON n.account_id = b.account_id
+    WHERE ABS(b.balance - (s.opening + COALESCE(n.net, 0))) > s.unit
+       OR b.available_balance <> b.balance - b.pending_debit;
+    IF v_count > 0 THEN
+        RAISE EXCEPTION 'balances that do not match their ledgers after rounding: %', v_count;
+    END IF;
+
+    -- Journals whose legs no longer balance per currency after rounding
+    SELECT COUNT(*) INTO v_count FROM (
+        SELECT journal_id, currency
+        FROM ledgers
+        GROUP BY journal_id, currency
+        HAVING SUM(CASE WHEN dr_cr = 'DR' THEN amount ELSE 0 END)
+            <> SUM(CASE WHEN dr_cr = 'CR' THEN amount ELSE 0 END)
+    ) unbalanced;
+    RAISE NOTICE 'single-currency journals out of balance (conversions expected): %', v_count;
+
+    SELECT COUNT(*) INTO v_count FROM ledgers
+    WHERE amount <> round_to_currency(amount, currency);
+    RAISE NOTICE 'ledgers still off-scale: %', v_count;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE;
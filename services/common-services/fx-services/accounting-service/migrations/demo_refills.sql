@@ -0,0 +1,105 @@
+-- ===============================================================================================
+-- MIGRATION: Demo account top-ups, resets and demo/real isolation
+-- ===============================================================================================
+-- Purpose: demo wallets are refilled through demo_funding journals against a demo liquidity
+--          account (SYS-DEMO-LIQ-<currency>) instead of having their balance overwritten, so the
+--          demo ledger reconciles like the real one. Each refill is reserved here first; the
+--          newest non-failed refill of an action drives that action's cooldown.
+-- Actions: top_up - adds play money (capped at a multiple of the default balance)
+--          reset  - moves the balance back to the default demo balance
+-- Also adds a ledger trigger so no journal can ever carry both demo and real accounts, whatever
+-- code path writes it. The transaction repository rejects such journals before they get here.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: REFILLS
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS demo_account_refills (
+  id              BIGSERIAL PRIMARY KEY,
+  account_id      BIGINT NOT NULL REFERENCES accounts(id),
+  action          TEXT NOT NULL,
+  amount          NUMERIC(30, 18) NOT NULL,   -- signed: + credits the wallet, - debits it
+  balance_before  NUMERIC(30, 18) NOT NULL,
+  balance_after   NUMERIC(30, 18) NOT NULL,
+  status          TEXT NOT NULL DEFAULT 'pending',
+  receipt_code    TEXT,
+  requested_by    TEXT NOT NULL,
+  last_error      TEXT,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  posted_at       TIMESTAMPTZ,
+
+  CONSTRAINT chk_demo_refill_action CHECK (action IN ('top_up', 'reset')),
+  CONSTRAINT chk_demo_refill_status CHECK (status IN ('pending', 'posted', 'failed')),
+  CONSTRAINT chk_demo_refill_amount CHECK (amount <> 0),
+  CONSTRAINT chk_demo_refill_balance CHECK (balance_after >= 0)
+);
+
+COMMENT ON TABLE demo_account_refills IS
+  'Demo wallet top-ups and resets; each posts one demo_funding journal against SYS-DEMO-LIQ.';
+
+-- Cooldown lookup: newest refill of an action that did not fail
+CREATE INDEX IF NOT EXISTS idx_demo_refills_cooldown
+  ON demo_account_refills (account_id, action, created_at DESC)
+  WHERE status <> 'failed';
+
+-- ===============================
+-- STEP 2: DEMO / REAL ISOLATION
+-- ===============================
+
+CREATE OR REPLACE FUNCTION enforce_ledger_account_type()
+RETURNS TRIGGER AS $$
+DECLARE
+    v_account_type account_type_enum;
+    v_journal_type account_type_enum;
+BEGIN
+    SELECT account_type INTO v_account_type FROM accounts WHERE id = NEW.account_id;
+    SELECT account_type INTO v_journal_type FROM journals WHERE id = NEW.journal_id;
+
+    IF v_account_type IS DISTINCT FROM NEW.account_type OR v_journal_type IS DISTINCT FROM NEW.account_type THEN
+        RAISE EXCEPTION 'journal % cannot mix demo and real accounts (account % is %, journal is %)',
+            NEW.journal_id, NEW.account_id, v_account_type, v_journal_type
+            USING ERRCODE = 'check_violation';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_ledgers_account_type ON ledgers;
+CREATE TRIGGER trg_ledgers_account_type
+  BEFORE INSERT ON ledgers
+  FOR EACH ROW
+  EXECUTE FUNCTION enforce_ledger_account_type();
+
+-- ===============================
+-- STEP 3: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'demo_account_refills'
+    ) THEN
+        RAISE EXCEPTION 'demo_account_refills was not created';
+    END IF;
+
+    IF EXISTS (
+        SELECT 1
+        FROM ledgers l
+        JOIN journals j ON j.id = l.journal_id
+        WHERE l.account_type <> j.account_type
+        LIMIT 1
+    ) THEN
+        RAISE WARNING 'existing ledger rows mix demo and real accounts; review before relying on isolation';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE demo_account_refills;
@@ -0,0 +1,131 @@
+-- ===============================================================================================
+-- MIGRATION: Versioned fee rule history
+-- ===============================================================================================
+-- Purpose: transaction_fee_rules rows are edited in place, so a fee only pointed at the current
+--          state of its rule. Every change to a rule now appends an immutable snapshot to
+--          transaction_fee_rule_versions, the rule points at its latest snapshot, and applied fees
+--          record the version that priced them.
+-- Backfill: existing rules get version 1 (their state at migration time). Fees recorded before
+--           this migration keep fee_rule_version_id NULL: the version that priced them is unknown.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: VERSION SNAPSHOTS
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS transaction_fee_rule_versions (
+  id                  BIGSERIAL PRIMARY KEY,
+  rule_id             BIGINT NOT NULL,              -- No FK: history outlives deleted rules
+  version             INT NOT NULL,
+  rule_name           TEXT NOT NULL,
+  transaction_type    transaction_type_enum NOT NULL,
+  source_currency     VARCHAR(8),
+  target_currency     VARCHAR(8),
+  account_type        account_type_enum,
+  owner_type          owner_type_enum,
+  fee_type            fee_type_enum NOT NULL,
+  calculation_method  TEXT NOT NULL,
+  fee_value           NUMERIC(10,6) NOT NULL,
+  min_fee             NUMERIC(30, 18),
+  max_fee             NUMERIC(30, 18),
+  tiers               JSONB,
+  tariffs             JSONB,
+  valid_from          TIMESTAMPTZ NOT NULL,
+  valid_to            TIMESTAMPTZ,
+  is_active           BOOLEAN NOT NULL,
+  priority            INT NOT NULL,
+  created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT uq_fee_rule_version UNIQUE (rule_id, version),
+  CONSTRAINT chk_fee_rule_version_positive CHECK (version >= 1)
+);
+
+COMMENT ON TABLE transaction_fee_rule_versions IS
+  'Append-only snapshots of transaction_fee_rules; one row per change.';
+
+-- Snapshots are never rewritten
+CREATE OR REPLACE FUNCTION prevent_fee_rule_version_mutation()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'transaction_fee_rule_versions is append-only (version %)', OLD.id;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_fee_rule_versions_immutable ON transaction_fee_rule_versions;
+CREATE TRIGGER trg_fee_rule_versions_immutable
+    BEFORE UPDATE OR DELETE ON transaction_fee_rule_versions
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_fee_rule_version_mutation();
+
+-- ===============================
+-- STEP 2: CURRENT VERSION ON RULES
+-- ===============================
+
+ALTER TABLE transaction_fee_rules
+  ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS current_version_id BIGINT REFERENCES transaction_fee_rule_versions(id);
+
+COMMENT ON COLUMN transaction_fee_rules.version IS
+  'Latest snapshot number in transaction_fee_rule_versions (0 = not yet snapshotted).';
+
+-- Backfill version 1 for existing rules
+INSERT INTO transaction_fee_rule_versions (
+  rule_id, version, rule_name, transaction_type, source_currency, target_currency,
+  account_type, owner_type, fee_type, calculation_method, fee_value, min_fee, max_fee,
+  tiers, tariffs, valid_from, valid_to, is_active, priority
+)
+SELECT
+  id, 1, rule_name, transaction_type, source_currency, target_currency,
+  account_type, owner_type, fee_type, calculation_method, fee_value, min_fee, max_fee,
+  tiers, tariffs, valid_from, valid_to, is_active, priority
+FROM transaction_fee_rules
+WHERE version = 0
+ON CONFLICT (rule_id, version) DO NOTHING;
+
+UPDATE transaction_fee_rules r
+SET version = v.version,
+    current_version_id = v.id
+FROM transaction_fee_rule_versions v
+WHERE v.rule_id = r.id
+  AND v.version = 1
+  AND r.version = 0;
+
+-- ===============================
+-- STEP 3: VERSION ON APPLIED FEES
+-- ===============================
+
+ALTER TABLE transaction_fees
+  ADD COLUMN IF NOT EXISTS fee_rule_version_id BIGINT REFERENCES transaction_fee_rule_versions(id);
+
+CREATE INDEX IF NOT EXISTS idx_fees_rule_version
+  ON transaction_fees (fee_rule_version_id)
+  WHERE fee_rule_version_id IS NOT NULL;
+
+-- Simulation replays journals by date
+CREATE INDEX IF NOT EXISTS idx_journals_created_type
+  ON journals (created_at, transaction_type);
+
+-- ===============================
+-- STEP 4: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+DECLARE
+    unversioned INT;
+BEGIN
+    SELECT COUNT(*) INTO unversioned FROM transaction_fee_rules WHERE current_version_id IS NULL;
+    IF unversioned > 0 THEN
+        RAISE EXCEPTION '% fee rules have no version snapshot', unversioned;
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE transaction_fee_rule_versions;
+ANALYZE transaction_fee_rules;
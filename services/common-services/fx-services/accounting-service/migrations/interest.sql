@@ -0,0 +1,164 @@
+-- ===============================================================================================
+-- MIGRATION: Interest accrual for savings and investment accounts
+-- ===============================================================================================
+-- Purpose: rate plans per currency and purpose (savings | investment), a daily accrual per account
+--          (full precision, one row per account and day) and monthly postings that credit the
+--          accrued interest from the system interest_expense account through a normal journal
+--          keyed 'interest-posting:<id>'.
+-- Rounding: a posting credits its accrued total rounded down to the currency's decimals; the
+--           remainder is carried into the next posting of the account.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+-- New enum values must be committed before they can be used, so they are added outside the
+-- migration transaction
+ALTER TYPE account_purpose_enum ADD VALUE IF NOT EXISTS 'investment';
+ALTER TYPE account_purpose_enum ADD VALUE IF NOT EXISTS 'savings';
+ALTER TYPE account_purpose_enum ADD VALUE IF NOT EXISTS 'interest_expense';
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: RATE PLANS
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS interest_rate_plans (
+  id            BIGSERIAL PRIMARY KEY,
+  name          TEXT NOT NULL,
+  currency      VARCHAR(8) NOT NULL REFERENCES currencies(code),
+  purpose       account_purpose_enum NOT NULL,
+  annual_rate   NUMERIC(10, 8) NOT NULL,          -- 0.05 = 5% a year
+  method        TEXT NOT NULL DEFAULT 'simple',   -- simple: posted balance, compound: + unposted accruals
+  day_count     INT NOT NULL DEFAULT 365,
+  min_balance   NUMERIC(30, 18) NOT NULL DEFAULT 0,
+  is_active     BOOLEAN NOT NULL DEFAULT true,
+  created_by    TEXT NOT NULL,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_interest_plan_purpose CHECK (purpose IN ('savings', 'investment')),
+  CONSTRAINT chk_interest_plan_rate CHECK (annual_rate >= 0 AND annual_rate <= 1),
+  CONSTRAINT chk_interest_plan_method CHECK (method IN ('simple', 'compound')),
+  CONSTRAINT chk_interest_plan_day_count CHECK (day_count IN (360, 365)),
+  CONSTRAINT chk_interest_plan_min_balance CHECK (min_balance >= 0)
+);
+
+COMMENT ON TABLE interest_rate_plans IS
+  'Interest rates for savings and investment accounts; one active plan per currency and purpose.';
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_interest_plan_active
+  ON interest_rate_plans (currency, purpose)
+  WHERE is_active;
+
+CREATE TRIGGER trg_interest_rate_plans_set_updated_at
+    BEFORE UPDATE ON interest_rate_plans
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- ===============================
+-- STEP 2: POSTINGS
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS interest_postings (
+  id             BIGSERIAL PRIMARY KEY,
+  account_id     BIGINT NOT NULL REFERENCES accounts(id),
+  currency       VARCHAR(8) NOT NULL,
+  period_from    DATE NOT NULL,
+  period_to      DATE NOT NULL,
+  accrual_count  INT NOT NULL,
+  carried_in     NUMERIC(30, 18) NOT NULL DEFAULT 0,
+  accrued        NUMERIC(30, 18) NOT NULL,        -- Accruals + carried_in
+  amount         NUMERIC(30, 18) NOT NULL,        -- Credited; accrued rounded down to the currency
+  remainder      NUMERIC(30, 18) NOT NULL,        -- Carried into the next posting
+  status         TEXT NOT NULL DEFAULT 'pending',
+  attempts       INT NOT NULL DEFAULT 0,
+  available_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(), -- Next attempt; pushed out while claimed or retrying
+  receipt_code   TEXT,
+  last_error     TEXT,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  posted_at      TIMESTAMPTZ,
+
+  CONSTRAINT chk_interest_posting_status CHECK (status IN ('pending', 'posted', 'failed')),
+  CONSTRAINT chk_interest_posting_amounts CHECK (amount >= 0 AND remainder >= 0 AND amount + remainder = accrued),
+  CONSTRAINT chk_interest_posting_period CHECK (period_from <= period_to)
+);
+
+COMMENT ON TABLE interest_postings IS
+  'Monthly capitalization of accrued interest into savings and investment accounts.';
+
+-- One live posting per account and period end; two posting passes cannot both reserve a month
+CREATE UNIQUE INDEX IF NOT EXISTS uq_interest_posting_period
+  ON interest_postings (account_id, period_to)
+  WHERE status <> 'failed';
+
+CREATE INDEX IF NOT EXISTS idx_interest_postings_due
+  ON interest_postings (available_at)
+  WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS idx_interest_postings_account
+  ON interest_postings (account_id, period_to DESC);
+
+-- ===============================
+-- STEP 3: DAILY ACCRUALS
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS interest_accruals (
+  id            BIGSERIAL PRIMARY KEY,
+  account_id    BIGINT NOT NULL REFERENCES accounts(id),
+  plan_id       BIGINT NOT NULL REFERENCES interest_rate_plans(id),
+  accrual_date  DATE NOT NULL,
+  principal     NUMERIC(30, 18) NOT NULL,         -- End-of-day balance (+ unposted interest if compound)
+  annual_rate   NUMERIC(10, 8) NOT NULL,          -- Plan rate on the day
+  amount        NUMERIC(30, 18) NOT NULL,
+  currency      VARCHAR(8) NOT NULL,
+  posting_id    BIGINT REFERENCES interest_postings(id),
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT uq_interest_accrual_day UNIQUE (account_id, accrual_date),
+  CONSTRAINT chk_interest_accrual_amount CHECK (amount > 0)
+);
+
+COMMENT ON TABLE interest_accruals IS
+  'Interest accrued per account and day; posting_id is set once a posting reserves the accrual.';
+
+CREATE INDEX IF NOT EXISTS idx_interest_accruals_unposted
+  ON interest_accruals (account_id, accrual_date)
+  WHERE posting_id IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_interest_accruals_posting
+  ON interest_accruals (posting_id)
+  WHERE posting_id IS NOT NULL;
+
+-- ===============================
+-- STEP 4: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'interest_rate_plans'
+    ) THEN
+        RAISE EXCEPTION 'interest_rate_plans was not created';
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'interest_accruals'
+    ) THEN
+        RAISE EXCEPTION 'interest_accruals was not created';
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'interest_postings'
+    ) THEN
+        RAISE EXCEPTION 'interest_postings was not created';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE interest_rate_plans;
+ANALYZE interest_accruals;
+ANALYZE interest_postings;
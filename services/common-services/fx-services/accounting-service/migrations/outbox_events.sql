@@ -0,0 +1,77 @@
+-- ===============================================================================================
+-- MIGRATION: Transactional outbox
+-- ===============================================================================================
+-- Purpose: transaction events were published to Redis Pub/Sub after commit, so an event was lost
+--          whenever the subscriber was down or the process died between commit and publish.
+--          Events are now inserted into outbox_events in the same transaction as the journal;
+--          the relay publishes committed rows to Redis streams (XADD) and marks them sent.
+-- Delivery: at-least-once. Stream entries carry event_id (= outbox_events.id) for deduplication.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: OUTBOX TABLE
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS outbox_events (
+  id            BIGSERIAL PRIMARY KEY,
+  stream        TEXT NOT NULL,
+  event_type    TEXT NOT NULL,
+  aggregate_id  TEXT,
+  payload       JSONB NOT NULL,
+  status        TEXT NOT NULL DEFAULT 'pending',
+  attempts      INT NOT NULL DEFAULT 0,
+  last_error    TEXT,
+  stream_id     TEXT,
+  available_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+  sent_at       TIMESTAMPTZ,
+
+  CONSTRAINT chk_outbox_status CHECK (status IN ('pending', 'sent')),
+  CONSTRAINT chk_outbox_sent CHECK (status <> 'sent' OR (stream_id IS NOT NULL AND sent_at IS NOT NULL))
+);
+
+COMMENT ON TABLE outbox_events IS
+  'Events written with the journal that produced them; relayed to Redis streams by the accounting service.';
+COMMENT ON COLUMN outbox_events.available_at IS
+  'Earliest next relay attempt; pushed back with exponential backoff after a failed publish.';
+
+-- ===============================
+-- STEP 2: INDEXES
+-- ===============================
+
+-- Relay claim: due pending rows in id order
+CREATE INDEX IF NOT EXISTS idx_outbox_pending
+  ON outbox_events (id)
+  WHERE status = 'pending';
+
+-- Retention purge
+CREATE INDEX IF NOT EXISTS idx_outbox_sent_at
+  ON outbox_events (sent_at)
+  WHERE status = 'sent';
+
+CREATE INDEX IF NOT EXISTS idx_outbox_aggregate
+  ON outbox_events (aggregate_id)
+  WHERE aggregate_id IS NOT NULL;
+
+-- ===============================
+-- STEP 3: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'outbox_events'
+    ) THEN
+        RAISE EXCEPTION 'outbox_events was not created';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE outbox_events;
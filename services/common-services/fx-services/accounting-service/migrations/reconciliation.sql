@@ -0,0 +1,135 @@
+-- ===============================================================================================
+-- MIGRATION: Ledger reconciliation
+-- ===============================================================================================
+-- Purpose: balances are maintained incrementally next to the ledgers. A reconciliation run
+--          recomputes them from ledgers, checks every journal's double entry and the per-currency
+--          zero-sum, and records each discrepancy as a break.
+-- Opening balances: system liquidity accounts and demo wallets are seeded with a balance that
+--          has no ledger entries (new_ts.sql, CreateSystemAccounts, demo account creation).
+--          account_opening_balances records those amounts so that
+--          balance = opening + SUM(CR) - SUM(DR) holds for every account.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: OPENING BALANCES
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS account_opening_balances (
+  account_id  BIGINT PRIMARY KEY REFERENCES accounts(id) ON DELETE CASCADE,
+  amount      NUMERIC(30, 18) NOT NULL,
+  note        TEXT,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE account_opening_balances IS
+  'Balance an account started with outside the ledger (system floats, demo funding). Used by reconciliation.';
+
+-- Baseline existing seeded accounts: whatever the balance holds beyond its ledger history
+INSERT INTO account_opening_balances (account_id, amount, note)
+SELECT a.id,
+       b.balance - COALESCE((
+         SELECT SUM(CASE WHEN l.dr_cr = 'CR' THEN l.amount ELSE -l.amount END)
+         FROM ledgers l
+         WHERE l.account_id = a.id
+       ), 0),
+       'reconciliation baseline'
+FROM accounts a
+JOIN balances b ON b.account_id = a.id
+WHERE a.owner_type = 'system' OR a.account_type = 'demo'
+ON CONFLICT (account_id) DO NOTHING;
+
+-- ===============================
+-- STEP 2: RECONCILIATION RUNS
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS reconciliation_runs (
+  id                  BIGSERIAL PRIMARY KEY,
+  status              TEXT NOT NULL DEFAULT 'running',
+  trigger_type        TEXT NOT NULL,
+  triggered_by        TEXT,
+  currency            VARCHAR(8),
+  account_type        account_type_enum,
+  journals_from       TIMESTAMPTZ NOT NULL,
+  accounts_checked    BIGINT NOT NULL DEFAULT 0,
+  journals_checked    BIGINT NOT NULL DEFAULT 0,
+  currencies_checked  BIGINT NOT NULL DEFAULT 0,
+  breaks_found        BIGINT NOT NULL DEFAULT 0,
+  error               TEXT,
+  started_at          TIMESTAMPTZ NOT NULL DEFAULT now(),
+  finished_at         TIMESTAMPTZ,
+
+  CONSTRAINT chk_recon_run_status CHECK (status IN ('running', 'completed', 'failed')),
+  CONSTRAINT chk_recon_run_trigger CHECK (trigger_type IN ('scheduled', 'manual'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_recon_runs_started ON reconciliation_runs (started_at DESC);
+
+-- ===============================
+-- STEP 3: RECONCILIATION BREAKS
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS reconciliation_breaks (
+  id               BIGSERIAL PRIMARY KEY,
+  run_id           BIGINT NOT NULL REFERENCES reconciliation_runs(id) ON DELETE CASCADE,
+  break_type       TEXT NOT NULL,
+  account_id       BIGINT,
+  account_number   TEXT,
+  account_type     account_type_enum,
+  journal_id       BIGINT,
+  currency         VARCHAR(8) NOT NULL,
+  expected         NUMERIC(30, 18) NOT NULL DEFAULT 0,
+  actual           NUMERIC(30, 18) NOT NULL DEFAULT 0,
+  difference       NUMERIC(30, 18) NOT NULL DEFAULT 0,
+  details          JSONB,
+  resolved         BOOLEAN NOT NULL DEFAULT false,
+  resolved_at      TIMESTAMPTZ,
+  resolved_by      TEXT,
+  resolution_note  TEXT,
+  created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT chk_recon_break_type CHECK (break_type IN (
+    'balance_mismatch', 'available_mismatch', 'journal_unbalanced', 'journal_no_entries', 'currency_imbalance'
+  ))
+);
+
+CREATE INDEX IF NOT EXISTS idx_recon_breaks_run ON reconciliation_breaks (run_id, id);
+CREATE INDEX IF NOT EXISTS idx_recon_breaks_open
+  ON reconciliation_breaks (break_type, created_at DESC)
+  WHERE resolved = false;
+CREATE INDEX IF NOT EXISTS idx_recon_breaks_account
+  ON reconciliation_breaks (account_id)
+  WHERE account_id IS NOT NULL;
+
+-- ===============================
+-- STEP 4: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'reconciliation_breaks'
+    ) THEN
+        RAISE EXCEPTION 'reconciliation_breaks was not created';
+    END IF;
+
+    IF EXISTS (
+        SELECT 1 FROM accounts a
+        WHERE (a.owner_type = 'system' OR a.account_type = 'demo')
+          AND NOT EXISTS (SELECT 1 FROM account_opening_balances o WHERE o.account_id = a.id)
+          AND EXISTS (SELECT 1 FROM balances b WHERE b.account_id = a.id)
+    ) THEN
+        RAISE EXCEPTION 'seeded accounts missing an opening balance baseline';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE account_opening_balances;
+ANALYZE reconciliation_runs;
+ANALYZE reconciliation_breaks;
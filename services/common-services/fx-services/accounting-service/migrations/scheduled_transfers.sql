@@ -0,0 +1,130 @@
+-- ===============================================================================================
+-- MIGRATION: Scheduled and recurring transfers
+-- ===============================================================================================
+-- Purpose: standing orders (cron expression or fixed interval, optional end date and run cap) and
+--          one-off future-dated transfers. A background executor claims due schedules and posts
+--          each occurrence through Transfer with the idempotency key
+--          'scheduled-transfer:<id>:<occurrence unix seconds>', so a retried or re-claimed
+--          occurrence can never be paid twice.
+-- Failures: an occurrence that cannot be paid (insufficient funds, limits) is retried up to
+--           max_retries times, then recorded as skipped and the schedule moves on.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: SCHEDULES
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS scheduled_transfers (
+  id                   BIGSERIAL PRIMARY KEY,
+  owner_type           owner_type_enum NOT NULL,
+  owner_id             TEXT NOT NULL,
+  from_account_number  TEXT NOT NULL,
+  to_account_number    TEXT NOT NULL,
+  amount               NUMERIC(30, 18) NOT NULL,
+  currency             TEXT NOT NULL,
+  account_type         account_type_enum NOT NULL DEFAULT 'real',
+  description          TEXT NOT NULL DEFAULT '',
+
+  cron_expr            TEXT,                        -- 5-field cron, UTC
+  interval_seconds     BIGINT,                      -- Fixed interval from start_at
+  start_at             TIMESTAMPTZ NOT NULL,
+  end_at               TIMESTAMPTZ,
+  max_runs             INT,
+  max_retries          INT NOT NULL DEFAULT 3,
+
+  status               TEXT NOT NULL DEFAULT 'active',
+  next_run_at          TIMESTAMPTZ,                 -- Due occurrence (part of the idempotency key)
+  available_at         TIMESTAMPTZ,                 -- Next attempt; pushed out while retrying or claimed
+  attempts             INT NOT NULL DEFAULT 0,      -- Failed attempts of the due occurrence
+  run_count            INT NOT NULL DEFAULT 0,      -- Occurrences paid or skipped
+  last_run_at          TIMESTAMPTZ,
+  last_receipt_code    TEXT,
+  last_error           TEXT,
+  cancelled_by         TEXT,
+  cancelled_at         TIMESTAMPTZ,
+  created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_scheduled_transfer_status CHECK (status IN ('active', 'completed', 'cancelled')),
+  CONSTRAINT chk_scheduled_transfer_amount CHECK (amount > 0),
+  CONSTRAINT chk_scheduled_transfer_accounts CHECK (from_account_number <> to_account_number),
+  CONSTRAINT chk_scheduled_transfer_recurrence CHECK (cron_expr IS NULL OR interval_seconds IS NULL),
+  CONSTRAINT chk_scheduled_transfer_interval CHECK (interval_seconds IS NULL OR interval_seconds >= 3600),
+  CONSTRAINT chk_scheduled_transfer_runs CHECK (max_runs IS NULL OR max_runs >= 1),
+  CONSTRAINT chk_scheduled_transfer_retries CHECK (max_retries BETWEEN 0 AND 10),
+  CONSTRAINT chk_scheduled_transfer_due CHECK (status <> 'active' OR next_run_at IS NOT NULL)
+);
+
+COMMENT ON TABLE scheduled_transfers IS
+  'Standing orders and future-dated transfers executed by the accounting scheduler.';
+
+-- Executor claim: active schedules by next attempt
+CREATE INDEX IF NOT EXISTS idx_scheduled_transfers_due
+  ON scheduled_transfers (available_at)
+  WHERE status = 'active';
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_transfers_owner
+  ON scheduled_transfers (owner_type, owner_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_transfers_from_account
+  ON scheduled_transfers (from_account_number);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_transfers_to_account
+  ON scheduled_transfers (to_account_number);
+
+CREATE TRIGGER trg_scheduled_transfers_set_updated_at
+    BEFORE UPDATE ON scheduled_transfers
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- ===============================
+-- STEP 2: OCCURRENCE HISTORY
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS scheduled_transfer_runs (
+  id             BIGSERIAL PRIMARY KEY,
+  schedule_id    BIGINT NOT NULL REFERENCES scheduled_transfers(id) ON DELETE CASCADE,
+  occurrence_at  TIMESTAMPTZ NOT NULL,
+  status         TEXT NOT NULL,
+  attempts       INT NOT NULL,
+  receipt_code   TEXT,
+  error_message  TEXT,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT uq_scheduled_transfer_run UNIQUE (schedule_id, occurrence_at),
+  CONSTRAINT chk_scheduled_transfer_run_status CHECK (status IN ('succeeded', 'skipped')),
+  CONSTRAINT chk_scheduled_transfer_run_receipt CHECK (status <> 'succeeded' OR receipt_code IS NOT NULL)
+);
+
+COMMENT ON TABLE scheduled_transfer_runs IS
+  'One row per processed occurrence: the transfer receipt, or why it was skipped.';
+
+-- ===============================
+-- STEP 3: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'scheduled_transfers'
+    ) THEN
+        RAISE EXCEPTION 'scheduled_transfers was not created';
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'scheduled_transfer_runs'
+    ) THEN
+        RAISE EXCEPTION 'scheduled_transfer_runs was not created';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE scheduled_transfers;
+ANALYZE scheduled_transfer_runs;
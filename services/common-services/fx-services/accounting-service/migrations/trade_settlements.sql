@@ -0,0 +1,70 @@
+-- ===============================================================================================
+-- MIGRATION: Batch trade settlement
+-- ===============================================================================================
+-- Purpose: SettleTrades settles many closed positions at once. Trades are grouped per account,
+--          wins and losses are netted, and each group posts one trade journal in one database
+--          transaction. Every trade ID is recorded here in that same transaction, so a replayed
+--          batch finds its trades already settled and returns the original result instead of
+--          posting again.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: SETTLED TRADES
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS trade_settlements (
+  trade_id        TEXT PRIMARY KEY,
+  account_id      BIGINT NOT NULL REFERENCES accounts(id),
+  result          TEXT NOT NULL,
+  amount          NUMERIC(30, 18) NOT NULL,
+  trade_type      TEXT,
+  batch_id        TEXT,
+  journal_id      BIGINT REFERENCES journals(id),   -- NULL when the group netted to zero
+  receipt_code    TEXT,                             -- shared by the trades netted together
+  created_by      TEXT NOT NULL,
+  settled_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_trade_settlement_result CHECK (result IN ('win', 'loss')),
+  CONSTRAINT chk_trade_settlement_amount CHECK (amount > 0)
+);
+
+COMMENT ON TABLE trade_settlements IS
+  'One row per settled trade ID; makes SettleTrades idempotent per trade.';
+
+-- ===============================
+-- STEP 2: INDEXES
+-- ===============================
+
+CREATE INDEX IF NOT EXISTS idx_trade_settlements_account
+  ON trade_settlements (account_id, settled_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_trade_settlements_journal
+  ON trade_settlements (journal_id)
+  WHERE journal_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_trade_settlements_batch
+  ON trade_settlements (batch_id)
+  WHERE batch_id IS NOT NULL;
+
+-- ===============================
+-- STEP 3: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'trade_settlements'
+    ) THEN
+        RAISE EXCEPTION 'trade_settlements was not created';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE trade_settlements;
@@ -0,0 +1,87 @@
+-- ===============================================================================================
+-- MIGRATION: Funds holds (authorize / capture)
+-- ===============================================================================================
+-- Purpose: PlaceHold / CaptureHold / ReleaseHold build on balances.pending_debit (ReserveFunds /
+--          ReleaseFunds). transaction_holds already existed but was unused; this adds the
+--          external reference, lifecycle status and the capture details.
+-- Lifecycle: active -> captured | released | expired. `released` stays true for every closed
+--          hold so chk_hold_release and idx_holds keep working.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: NEW COLUMNS
+-- ===============================
+
+ALTER TABLE transaction_holds
+  ADD COLUMN IF NOT EXISTS hold_ref               TEXT,
+  ADD COLUMN IF NOT EXISTS status                 TEXT NOT NULL DEFAULT 'active',
+  ADD COLUMN IF NOT EXISTS captured_amount        NUMERIC(30, 18) NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS account_type           account_type_enum NOT NULL DEFAULT 'real',
+  ADD COLUMN IF NOT EXISTS transaction_type       transaction_type_enum NOT NULL DEFAULT 'withdrawal',
+  ADD COLUMN IF NOT EXISTS capture_account_number TEXT,
+  ADD COLUMN IF NOT EXISTS created_by_external_id TEXT,
+  ADD COLUMN IF NOT EXISTS created_by_type        owner_type_enum,
+  ADD COLUMN IF NOT EXISTS captured_at            TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS updated_at             TIMESTAMPTZ NOT NULL DEFAULT now();
+
+-- ===============================
+-- STEP 2: BACKFILL EXISTING ROWS
+-- ===============================
+
+UPDATE transaction_holds SET hold_ref = 'HOLD-' || id WHERE hold_ref IS NULL;
+UPDATE transaction_holds SET status = 'released' WHERE released = true AND status = 'active';
+
+ALTER TABLE transaction_holds ALTER COLUMN hold_ref SET NOT NULL;
+
+-- ===============================
+-- STEP 3: CONSTRAINTS & INDEXES
+-- ===============================
+
+ALTER TABLE transaction_holds DROP CONSTRAINT IF EXISTS chk_hold_status;
+ALTER TABLE transaction_holds ADD CONSTRAINT chk_hold_status
+  CHECK (status IN ('active', 'captured', 'released', 'expired'));
+
+ALTER TABLE transaction_holds DROP CONSTRAINT IF EXISTS chk_hold_captured_amount;
+ALTER TABLE transaction_holds ADD CONSTRAINT chk_hold_captured_amount
+  CHECK (captured_amount >= 0 AND captured_amount <= hold_amount);
+
+ALTER TABLE transaction_holds DROP CONSTRAINT IF EXISTS chk_hold_status_released;
+ALTER TABLE transaction_holds ADD CONSTRAINT chk_hold_status_released
+  CHECK ((status = 'active') = (released = false));
+
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_hold_ref ON transaction_holds (hold_ref);
+
+-- Sweeper: active holds ordered by expiry
+CREATE INDEX IF NOT EXISTS idx_holds_expiry
+  ON transaction_holds (expires_at)
+  WHERE status = 'active' AND expires_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_holds_account_created ON transaction_holds (account_id, created_at DESC);
+
+-- ===============================
+-- STEP 4: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'transaction_holds' AND column_name = 'hold_ref'
+    ) THEN
+        RAISE EXCEPTION 'transaction_holds.hold_ref was not created';
+    END IF;
+
+    IF EXISTS (SELECT 1 FROM transaction_holds WHERE (status = 'active') <> (released = false)) THEN
+        RAISE EXCEPTION 'transaction_holds status/released out of sync';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE transaction_holds;
@@ -0,0 +1,140 @@
+-- ===============================================================================================
+-- MIGRATION: Transaction limits and velocity controls
+-- ===============================================================================================
+-- Purpose: the only amount checks were currencies.min_amount / max_amount. Debits from non-system
+--          accounts are now capped by limit profiles selected per account, KYC tier or owner type:
+--          a single-transaction maximum plus daily / weekly / monthly cumulative amount and count caps.
+-- Counters: account_limit_usage holds one row per account and calendar window (UTC). The database
+--           transaction that posts the journal adds the debit to every window in one statement per
+--           row that only succeeds while the cap holds, so concurrent transactions cannot overshoot
+--           and a transaction that fails or never commits counts nothing. Pre-validation only reads
+--           the counters to reject early. Holds count in the transaction that places them.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: LIMIT PROFILES
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS limit_profiles (
+  id                 BIGSERIAL PRIMARY KEY,
+  name               TEXT NOT NULL,
+  owner_type         owner_type_enum,                -- NULL = any owner type
+  kyc_tier           TEXT,                           -- NULL = any tier
+  account_number     TEXT,                           -- NULL = any account
+  currency           TEXT NOT NULL,
+  max_single_amount  NUMERIC(30, 18),                -- NULL = unlimited
+  daily_amount       NUMERIC(30, 18),
+  weekly_amount      NUMERIC(30, 18),
+  monthly_amount     NUMERIC(30, 18),
+  daily_count        INT,
+  weekly_count       INT,
+  monthly_count      INT,
+  priority           INT NOT NULL DEFAULT 0,
+  is_active          BOOLEAN NOT NULL DEFAULT TRUE,
+  created_by         TEXT NOT NULL,
+  created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_limit_profile_owner CHECK (owner_type IS NULL OR owner_type <> 'system'),
+  CONSTRAINT chk_limit_profile_amounts CHECK (
+    COALESCE(max_single_amount, 0) >= 0 AND COALESCE(daily_amount, 0) >= 0 AND
+    COALESCE(weekly_amount, 0) >= 0 AND COALESCE(monthly_amount, 0) >= 0
+  ),
+  CONSTRAINT chk_limit_profile_counts CHECK (
+    COALESCE(daily_count, 0) >= 0 AND COALESCE(weekly_count, 0) >= 0 AND COALESCE(monthly_count, 0) >= 0
+  )
+);
+
+COMMENT ON TABLE limit_profiles IS
+  'Debit limits per currency; the most specific active profile (account, KYC tier, owner type, priority) applies.';
+
+CREATE INDEX IF NOT EXISTS idx_limit_profiles_match
+  ON limit_profiles (currency, account_number, kyc_tier, owner_type)
+  WHERE is_active;
+
+CREATE TRIGGER trg_limit_profiles_set_updated_at
+    BEFORE UPDATE ON limit_profiles
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- ===============================
+-- STEP 2: OWNER KYC TIERS
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS owner_kyc_tiers (
+  owner_type  owner_type_enum NOT NULL,
+  owner_id    TEXT NOT NULL,
+  kyc_tier    TEXT NOT NULL,
+  set_by      TEXT NOT NULL,
+  updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  PRIMARY KEY (owner_type, owner_id)
+);
+
+COMMENT ON TABLE owner_kyc_tiers IS
+  'KYC tier used to select limit profiles; owners without a row only match tier-less profiles.';
+
+-- ===============================
+-- STEP 3: USAGE COUNTERS
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS account_limit_usage (
+  account_number  TEXT NOT NULL,
+  limit_window    TEXT NOT NULL,
+  window_start    TIMESTAMPTZ NOT NULL,
+  amount          NUMERIC(30, 18) NOT NULL DEFAULT 0,
+  txn_count       INT NOT NULL DEFAULT 0,
+  updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  PRIMARY KEY (account_number, limit_window, window_start),
+  CONSTRAINT chk_account_limit_usage_window CHECK (limit_window IN ('daily', 'weekly', 'monthly')),
+  CONSTRAINT chk_account_limit_usage_non_negative CHECK (amount >= 0 AND txn_count >= 0)
+);
+
+COMMENT ON TABLE account_limit_usage IS
+  'Cumulative non-fee debits per account and UTC calendar window; rows for past windows can be pruned.';
+
+CREATE INDEX IF NOT EXISTS idx_account_limit_usage_window_start
+  ON account_limit_usage (window_start);
+
+-- Withdrawals through holds count when the hold is placed; the counted usage is kept on the hold
+-- so a release or expiry, or the uncaptured remainder of a capture, can give it back.
+ALTER TABLE transaction_holds
+  ADD COLUMN IF NOT EXISTS limit_usage JSONB;
+
+-- ===============================
+-- STEP 4: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'limit_profiles'
+    ) THEN
+        RAISE EXCEPTION 'limit_profiles was not created';
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'owner_kyc_tiers'
+    ) THEN
+        RAISE EXCEPTION 'owner_kyc_tiers was not created';
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'account_limit_usage'
+    ) THEN
+        RAISE EXCEPTION 'account_limit_usage was not created';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE limit_profiles;
+ANALYZE owner_kyc_tiers;
+ANALYZE account_limit_usage;
@@ -0,0 +1,93 @@
+-- ===============================================================================================
+-- MIGRATION: Durable transaction processing queue
+-- ===============================================================================================
+-- Purpose: async ExecuteTransaction requests were held in an in-memory channel, so a restart
+--          lost every queued transaction and a full channel dropped new ones. Requests are now
+--          persisted in transaction_queue before the receipt is returned; workers claim rows with
+--          FOR UPDATE SKIP LOCKED under a lease.
+-- Ordering: rows sharing ordering_key (the paying account) are processed one at a time in id order.
+--           A dead-lettered row holds back the later rows of its account until an admin re-drives
+--           or skips it.
+-- Delivery: at-least-once; each row carries an idempotency key so a re-delivered row resolves to
+--           the journal it already posted. Retryable failures back off exponentially and are
+--           dead-lettered (status = 'dead') after max_attempts; admins re-drive them. A worker only
+--           records the outcome of a row while it still holds the lease (locked_by, locked_until).
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: QUEUE TABLE
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS transaction_queue (
+  id               BIGSERIAL PRIMARY KEY,
+  receipt_code     TEXT NOT NULL UNIQUE,
+  idempotency_key  TEXT NOT NULL,
+  ordering_key     TEXT NOT NULL,
+  payload          JSONB NOT NULL,
+  status           TEXT NOT NULL DEFAULT 'pending',
+  attempts         INT NOT NULL DEFAULT 0,
+  max_attempts     INT NOT NULL DEFAULT 5,
+  last_error       TEXT,
+  journal_id       BIGINT,
+  available_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+  locked_until     TIMESTAMPTZ,
+  locked_by        TEXT,
+  created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+  completed_at     TIMESTAMPTZ,
+
+  CONSTRAINT chk_transaction_queue_status
+    CHECK (status IN ('pending', 'processing', 'completed', 'failed', 'dead', 'skipped')),
+  CONSTRAINT chk_transaction_queue_attempts CHECK (attempts >= 0 AND max_attempts > 0),
+  CONSTRAINT chk_transaction_queue_lease CHECK (status <> 'processing' OR locked_until IS NOT NULL)
+);
+
+COMMENT ON TABLE transaction_queue IS
+  'Async ExecuteTransaction requests awaiting (or finished with) processing by the accounting workers.';
+COMMENT ON COLUMN transaction_queue.ordering_key IS
+  'Paying account number; rows with the same key are processed strictly in id order.';
+COMMENT ON COLUMN transaction_queue.locked_until IS
+  'Lease of the worker processing the row; an expired lease makes the row claimable again.';
+COMMENT ON COLUMN transaction_queue.locked_by IS
+  'Worker holding the lease; completion, failure and retries are only recorded by this worker.';
+
+-- ===============================
+-- STEP 2: INDEXES
+-- ===============================
+
+-- Worker claim: unfinished rows in id order
+CREATE INDEX IF NOT EXISTS idx_transaction_queue_open
+  ON transaction_queue (id)
+  WHERE status IN ('pending', 'processing');
+
+-- Per-account ordering check (dead letters block their account)
+CREATE INDEX IF NOT EXISTS idx_transaction_queue_ordering
+  ON transaction_queue (ordering_key, id)
+  WHERE status IN ('pending', 'processing', 'dead');
+
+-- Admin inspection (dead letters)
+CREATE INDEX IF NOT EXISTS idx_transaction_queue_status
+  ON transaction_queue (status, id DESC);
+
+-- ===============================
+-- STEP 3: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.tables WHERE table_name = 'transaction_queue'
+    ) THEN
+        RAISE EXCEPTION 'transaction_queue was not created';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE transaction_queue;
@@ -0,0 +1,85 @@
+-- ===============================================================================================
+-- MIGRATION: Transaction reversals & partial refunds
+-- ===============================================================================================
+-- Purpose: ReverseTransaction / RefundTransaction post a mirror-image journal for an existing
+--          receipt. transaction_reversals links the new journal to the original, and is what the
+--          service uses to refuse a second reversal and to cap cumulative refunds.
+-- Fees & commissions: mirrored as negative rows on the new receipt (transaction_fees,
+--          agent_commissions) so sums per original transaction net out.
+-- ===============================================================================================
+
+\c pxyz_fx;
+
+-- ALTER TYPE ... ADD VALUE cannot run inside a transaction block on older Postgres versions
+ALTER TYPE transaction_type_enum ADD VALUE IF NOT EXISTS 'refund';
+
+BEGIN;
+
+-- ===============================
+-- STEP 1: TRANSACTION REVERSALS
+-- ===============================
+
+CREATE TABLE IF NOT EXISTS transaction_reversals (
+  id                      BIGSERIAL PRIMARY KEY,
+  original_journal_id     BIGINT NOT NULL REFERENCES journals(id),
+  original_receipt_code   TEXT NOT NULL,
+  reversal_journal_id     BIGINT NOT NULL REFERENCES journals(id),
+  reversal_receipt_code   TEXT NOT NULL,
+  kind                    TEXT NOT NULL CHECK (kind IN ('reversal', 'refund')),
+  amount                  NUMERIC(30, 18) NOT NULL CHECK (amount > 0),
+  currency                VARCHAR(8) NOT NULL REFERENCES currencies(code),
+  fee_reversed            NUMERIC(30, 18) NOT NULL DEFAULT 0,
+  commission_reversed     NUMERIC(30, 18) NOT NULL DEFAULT 0,
+  reason                  TEXT,
+  created_by_external_id  TEXT,
+  created_by_type         owner_type_enum,
+  created_at              TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT chk_not_self_reversal CHECK (original_journal_id <> reversal_journal_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_reversal_receipt ON transaction_reversals (reversal_receipt_code);
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_reversal_journal ON transaction_reversals (reversal_journal_id);
+
+-- A journal can only be fully reversed once (refunds are capped by the service)
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_full_reversal
+  ON transaction_reversals (original_journal_id)
+  WHERE kind = 'reversal';
+
+CREATE INDEX IF NOT EXISTS idx_reversals_original_journal ON transaction_reversals (original_journal_id);
+CREATE INDEX IF NOT EXISTS idx_reversals_original_receipt ON transaction_reversals (original_receipt_code);
+
+-- ===============================
+-- STEP 2: FEES & COMMISSIONS
+-- ===============================
+
+-- Reversed fees are stored as negative rows on the reversal receipt
+ALTER TABLE transaction_fees DROP CONSTRAINT IF EXISTS transaction_fees_amount_check;
+
+COMMENT ON COLUMN transaction_fees.amount IS 'Fee amount; negative rows are reversals/refunds of a prior fee';
+COMMENT ON COLUMN agent_commissions.commission_amount IS 'Accrued commission; negative rows claw back commission on reversed/refunded receipts';
+
+-- ===============================
+-- STEP 3: VERIFY MIGRATION
+-- ===============================
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_enum e
+        JOIN pg_type t ON t.oid = e.enumtypid
+        WHERE t.typname = 'transaction_type_enum' AND e.enumlabel = 'refund'
+    ) THEN
+        RAISE EXCEPTION 'transaction_type_enum is missing refund';
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = 'transaction_reversals') THEN
+        RAISE EXCEPTION 'transaction_reversals was not created';
+    END IF;
+
+    RAISE NOTICE 'Migration verification complete!';
+END $$;
+
+COMMIT;
+
+ANALYZE transaction_reversals;
@@ -3,16 +3,32 @@ package main
 
 import (
 	"crypto-service/internal/security"
+	"flag"
 	"fmt"
 	"log"
 )
 
 func main() {
+	// -hd=mainnet|testnet generates a BIP32 extended key for HD deposit wallets instead
+	hd := flag.String("hd", "", "generate an HD extended private key for network (mainnet or testnet)")
+	// -kek=N generates key-encryption key version N for a master-key rotation
+	kek := flag.Int("kek", 0, "generate key-encryption key version N (2 or later) for a master-key rotation")
+	flag.Parse()
+
+	if *hd != "" {
+		generateHDKey(*hd)
+		return
+	}
+	if *kek != 0 {
+		generateKEK(*kek)
+		return
+	}
+
 	key, err := security.GenerateMasterKey()
 	if err != nil {
 		log.Fatal(err)
 	}
-	
+
 	fmt.Println("==============================================")
 	fmt.Println("Generated AES-256 Master Key:")
 	fmt.Println("==============================================")
@@ -24,4 +40,51 @@ func main() {
 	fmt.Println("⚠️  KEEP THIS KEY SECURE!")
 	fmt.Println("⚠️  DO NOT COMMIT TO VERSION CONTROL!")
 	fmt.Println("==============================================")
-}
\ No newline at end of file
+}
+
+func generateHDKey(network string) {
+	if network != "mainnet" && network != "testnet" {
+		log.Fatalf("unsupported network: %s", network)
+	}
+
+	key, err := security.GenerateHDMasterKey(network == "mainnet")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("==============================================")
+	fmt.Println("Generated HD Extended Private Key (" + network + "):")
+	fmt.Println("==============================================")
+	fmt.Println(key)
+	fmt.Println("==============================================")
+	fmt.Println("Store one key per chain in the vault, e.g.:")
+	fmt.Println("CRYPTO_HD_TRON_XPRV=" + key)
+	fmt.Println("==============================================")
+	fmt.Println("⚠️  EVERY DERIVED DEPOSIT ADDRESS DEPENDS ON THIS KEY!")
+	fmt.Println("⚠️  BACK IT UP OFFLINE - DO NOT COMMIT TO VERSION CONTROL!")
+	fmt.Println("==============================================")
+}
+
+func generateKEK(version int) {
+	if version < 2 {
+		log.Fatalf("kek version must be 2 or later (version 1 is CRYPTO_MASTER_KEY): %d", version)
+	}
+
+	key, err := security.GenerateMasterKey()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("==============================================")
+	fmt.Printf("Generated Key-Encryption Key v%d:\n", version)
+	fmt.Println("==============================================")
+	fmt.Println(key)
+	fmt.Println("==============================================")
+	fmt.Println("Store it in the vault at " + security.KEKPath(version) + ", e.g.:")
+	fmt.Printf("CRYPTO_KEK_V%d=%s\n", version, key)
+	fmt.Println("Then rotate: POST /admin/svc/crypto/system/rotate-master-key")
+	fmt.Println("==============================================")
+	fmt.Println("⚠️  KEEP EVERY KEK UNTIL IT IS RETIRED!")
+	fmt.Println("⚠️  DO NOT COMMIT TO VERSION CONTROL!")
+	fmt.Println("==============================================")
+}
@@ -11,16 +11,21 @@ import (
 	registry "crypto-service/internal/chains/registry"
 	"crypto-service/internal/chains/tron"
 	"crypto-service/internal/config"
+	"crypto-service/internal/domain"
 	"crypto-service/internal/handler"
 	"crypto-service/internal/repository"
+	"crypto-service/internal/screening"
 	"crypto-service/internal/security"
 	"crypto-service/internal/server"
 	"crypto-service/internal/usecase"
 	"crypto-service/internal/worker"
 	"fmt"
+	accountingclient "x/shared/common/accounting"
+	notificationclient "x/shared/notification"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
@@ -56,13 +61,37 @@ func main() {
 		logger.Fatal("Failed to initialize encryption", zap. Error(err))
 	}
 
+	// Initialize vault (holds the HD extended keys and rotated KEKs)
+	vault, err := initVault(cfg.Security, logger)
+	if err != nil {
+		logger.Fatal("Failed to initialize vault", zap.Error(err))
+	}
+
+	// Envelope encryption: per-wallet data keys wrapped by a versioned KEK (v1 = master key)
+	envelope := security.NewEnvelopeEncryption(encryption, vault, logger)
+
 	// Initialize repositories
 	walletRepo := repository.NewCryptoWalletRepository(dbPool)
 	transactionRepo := repository.NewCryptoTransactionRepository(dbPool)
 	depositRepo := repository.NewCryptoDepositRepository(dbPool)
 	withdrawalApprovalRepo := repository.NewWithdrawalApprovalRepository(dbPool, logger)
+	screeningRepo := repository.NewAddressScreeningRepository(dbPool)
+	keyRotationRepo := repository.NewKeyRotationRepository(dbPool)
+
+	// Address screening: internal lists, plus an external provider if configured
+	var screeningProviders []screening.Provider
+	if cfg.Screening.ProviderURL != "" {
+		screeningProviders = append(screeningProviders, screening.NewHTTPProvider(
+			cfg.Screening.ProviderName,
+			cfg.Screening.ProviderURL,
+			cfg.Screening.ProviderAPIKey,
+			time.Duration(cfg.Screening.ProviderTimeout)*time.Second,
+		))
+		logger.Info("Address screening provider enabled", zap.String("provider", cfg.Screening.ProviderName))
+	}
+	screener := screening.NewScreener(screeningRepo, logger, screeningProviders...)
 
-	riskAssessor := risk.NewRiskAssessor(transactionRepo, walletRepo, logger)
+	riskAssessor := risk.NewRiskAssessor(transactionRepo, walletRepo, screener, logger)
 
 	// Initialize blockchain registry
 	chainRegistry := registry.NewRegistry()
@@ -90,6 +119,7 @@ func main() {
 		cfg.Bitcoin.RPCURL,
 		cfg.Bitcoin. APIKey,
 		cfg.Bitcoin.Network,
+		cfg.Bitcoin.AddressType,
 		logger,
 	)
 	if err != nil {
@@ -133,8 +163,29 @@ func main() {
 		zap.Int("count", len(registeredChains)),
 		zap. Strings("chains", registeredChains),
 	)
+	// Wallet keys: HD derivation for new deposit wallets, encrypted keys for legacy ones
+	walletKeys := usecase.NewWalletKeys(
+		walletRepo,
+		chainRegistry,
+		envelope,
+		security.NewHDKeyring(vault, logger),
+		domain.WalletKeyMode(cfg.Security.WalletKeyMode),
+		logger,
+	)
+	if err := walletKeys.Validate(context.Background()); err != nil {
+		logger.Fatal("Invalid wallet key configuration", zap.Error(err))
+	}
+	logger.Info("Wallet key mode", zap.String("mode", cfg.Security.WalletKeyMode))
+
+	// Load the KEK versions before any wallet key is sealed or opened
+	keyRotationUsecase := usecase.NewKeyRotationUsecase(keyRotationRepo, envelope, walletKeys, chainRegistry, logger)
+	if err := keyRotationUsecase.LoadKeys(context.Background()); err != nil {
+		logger.Fatal("Failed to load key-encryption keys", zap.Error(err))
+	}
+	logger.Info("Active KEK version", zap.Int("version", envelope.ActiveVersion()))
+
 	//  Initialize system usecase
-	systemUsecase := usecase.NewSystemUsecase(walletRepo, chainRegistry, encryption, logger)
+	systemUsecase := usecase.NewSystemUsecase(walletRepo, chainRegistry, walletKeys, logger)
 
 	//  Initialize system wallets (create if not exist)
 	ctx := context.Background()
@@ -143,16 +194,26 @@ func main() {
 		// Don't fatal - service can still run, just log the error
 	}
 
+	// Accounting client (withdrawal holds are captured/released from here)
+	accountingClient := accountingclient.NewAccountingClient()
+
+	// Notification client (compliance alerts for frozen deposits)
+	notificationClient := notificationclient.NewNotificationService()
+	defer notificationClient.Close()
+
 	// Initialize use cases
-	walletUsecase := usecase.NewWalletUsecase(walletRepo, chainRegistry, encryption, logger)
-	transactionUsecase := usecase.NewTransactionUsecase(transactionRepo, walletRepo, withdrawalApprovalRepo, chainRegistry, encryption, systemUsecase, riskAssessor, logger)
-	depositUsecase := usecase.NewDepositUsecase(depositRepo, walletRepo, transactionRepo, chainRegistry, encryption, logger)
+	walletUsecase := usecase.NewWalletUsecase(walletRepo, chainRegistry, walletKeys, logger)
+	transactionUsecase := usecase.NewTransactionUsecase(transactionRepo, walletRepo, withdrawalApprovalRepo, chainRegistry, walletKeys, systemUsecase, riskAssessor, accountingClient, logger)
+	depositUsecase := usecase.NewDepositUsecase(depositRepo, walletRepo, transactionRepo, chainRegistry, walletKeys, screener, notificationClient, cfg.Screening.ComplianceEmail, cfg.Scanner.StartBlocks, logger)
+	screeningUsecase := usecase.NewScreeningUsecase(screeningRepo, screener, chainRegistry, cfg.Screening.ListsDir, logger)
 
 	// Initialize handlers
 	walletHandler := handler. NewWalletHandler(walletUsecase, systemUsecase, logger)
 	transactionHandler := handler.NewTransactionHandler(transactionUsecase, logger)
 	depositHandler := handler.NewDepositHandler(depositUsecase, logger)
 	cryptoHandler := handler.NewCryptoHandler(chainRegistry, walletUsecase, systemUsecase, logger)
+	screeningHandler := handler.NewScreeningHandler(screeningUsecase, logger)
+	keyManagementHandler := handler.NewKeyManagementHandler(keyRotationUsecase, logger)
 
 	// Get gRPC port from environment or use default
 	grpcPort := getEnvAsInt("GRPC_PORT", 8028)
@@ -163,6 +224,8 @@ func main() {
 		transactionHandler,
 		depositHandler,
 		cryptoHandler,
+		screeningHandler,
+		keyManagementHandler,
 		logger,
 		grpcPort,
 	)
@@ -174,6 +237,11 @@ func main() {
 
 	go depositMonitor.Start(ctx)
 
+	// Resume a master-key rotation interrupted by the last shutdown
+	if err := keyRotationUsecase.Start(ctx); err != nil {
+		logger.Error("Failed to resume key rotation", zap.Error(err))
+	}
+
 	// Start gRPC server in goroutine
 	go func() {
 		logger.Info("Starting gRPC server", zap.Int("port", grpcPort))
@@ -243,6 +311,22 @@ func initDatabase() (*pgxpool.Pool, error) {
 	return pool, nil
 }
 
+// initVault initializes the secret store configured by VAULT_PROVIDER
+func initVault(cfg config.SecurityConfig, logger *zap.Logger) (*security.Vault, error) {
+	switch cfg.VaultProvider {
+	case "", "env":
+		return security.NewVault(security.NewEnvVaultProvider(), logger), nil
+	case "file":
+		provider, err := security.NewFileVaultProvider(cfg.FileVaultDir, cfg.FileVaultKey)
+		if err != nil {
+			return nil, err
+		}
+		return security.NewVault(provider, logger), nil
+	default:
+		return nil, fmt.Errorf("unsupported vault provider: %s", cfg.VaultProvider)
+	}
+}
+
 // Helper functions
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
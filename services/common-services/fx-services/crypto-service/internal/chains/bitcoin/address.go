@@ -0,0 +1,183 @@
+// internal/chains/bitcoin/address.go
+package bitcoin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+)
+
+// AddressType is the kind of output script an address pays to
+type AddressType string
+
+const (
+	AddressTypeP2PKH  AddressType = "p2pkh"  // Legacy (1..., m/n...)
+	AddressTypeP2SH   AddressType = "p2sh"   // Script hash (3..., 2...), pay-to only
+	AddressTypeP2WPKH AddressType = "p2wpkh" // Native SegWit v0 (bc1q..., 20-byte program)
+	AddressTypeP2WSH  AddressType = "p2wsh"  // SegWit v0 script hash (bc1q..., 32-byte program), pay-to only
+	AddressTypeP2TR   AddressType = "p2tr"   // Taproot key path (bc1p...)
+)
+
+// BIP43 purposes HD wallets of each address type are derived under
+const (
+	purposeBIP44 uint32 = 44 // P2PKH
+	purposeBIP84 uint32 = 84 // P2WPKH
+	purposeBIP86 uint32 = 86 // P2TR
+)
+
+// ParseAddressType parses the configured type of new wallet addresses.
+// Only types backed by a single key can be generated.
+func ParseAddressType(s string) (AddressType, error) {
+	switch t := AddressType(strings.ToLower(strings.TrimSpace(s))); t {
+	case AddressTypeP2PKH, AddressTypeP2WPKH, AddressTypeP2TR:
+		return t, nil
+	case "":
+		return AddressTypeP2WPKH, nil
+	default:
+		return "", fmt.Errorf("unsupported wallet address type: %q (want p2pkh, p2wpkh or p2tr)", s)
+	}
+}
+
+// IsWitness reports whether spending or paying to the type uses SegWit
+func (t AddressType) IsWitness() bool {
+	return t == AddressTypeP2WPKH || t == AddressTypeP2WSH || t == AddressTypeP2TR
+}
+
+// Purpose returns the BIP43 purpose HD wallets of this type are derived under
+func (t AddressType) Purpose() uint32 {
+	switch t {
+	case AddressTypeP2WPKH:
+		return purposeBIP84
+	case AddressTypeP2TR:
+		return purposeBIP86
+	default:
+		return purposeBIP44
+	}
+}
+
+// addressTypeForPurpose returns the address type of HD wallets derived
+// under purpose
+func addressTypeForPurpose(purpose uint32) (AddressType, error) {
+	switch purpose {
+	case purposeBIP44:
+		return AddressTypeP2PKH, nil
+	case purposeBIP84:
+		return AddressTypeP2WPKH, nil
+	case purposeBIP86:
+		return AddressTypeP2TR, nil
+	default:
+		return "", fmt.Errorf("unsupported derivation purpose: %d'", purpose)
+	}
+}
+
+// DetectAddressType returns the type of a Bitcoin address on network
+func DetectAddressType(address, network string) (AddressType, error) {
+	params, err := getNetworkParams(network)
+	if err != nil {
+		return "", err
+	}
+
+	addr, err := decodeAddress(address, params)
+	if err != nil {
+		return "", err
+	}
+
+	return addressTypeOf(addr)
+}
+
+// decodeAddress decodes address and checks that it belongs to params' network
+func decodeAddress(address string, params *chaincfg.Params) (btcutil.Address, error) {
+	addr, err := btcutil.DecodeAddress(strings.TrimSpace(address), params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Bitcoin address: %w", err)
+	}
+	if !addr.IsForNet(params) {
+		return nil, fmt.Errorf("invalid Bitcoin address: %s is not a %s address", address, params.Name)
+	}
+	return addr, nil
+}
+
+// addressTypeOf returns the type of a decoded address
+func addressTypeOf(addr btcutil.Address) (AddressType, error) {
+	switch addr.(type) {
+	case *btcutil.AddressPubKeyHash:
+		return AddressTypeP2PKH, nil
+	case *btcutil.AddressScriptHash:
+		return AddressTypeP2SH, nil
+	case *btcutil.AddressWitnessPubKeyHash:
+		return AddressTypeP2WPKH, nil
+	case *btcutil.AddressWitnessScriptHash:
+		return AddressTypeP2WSH, nil
+	case *btcutil.AddressTaproot:
+		return AddressTypeP2TR, nil
+	default:
+		return "", fmt.Errorf("unsupported Bitcoin address type: %T", addr)
+	}
+}
+
+// addressForKey returns the address of type t that publicKey controls.
+// Taproot addresses commit to the key with no script path (BIP86).
+func addressForKey(publicKey *btcec.PublicKey, t AddressType, params *chaincfg.Params) (btcutil.Address, error) {
+	switch t {
+	case AddressTypeP2PKH:
+		return btcutil.NewAddressPubKeyHash(btcutil.Hash160(publicKey.SerializeCompressed()), params)
+	case AddressTypeP2WPKH:
+		return btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(publicKey.SerializeCompressed()), params)
+	case AddressTypeP2TR:
+		outputKey := txscript.ComputeTaprootKeyNoScript(publicKey)
+		return btcutil.NewAddressTaproot(schnorr.SerializePubKey(outputKey), params)
+	default:
+		return nil, fmt.Errorf("cannot derive a %s address from a single key", t)
+	}
+}
+
+// ============================================================================
+// PRIVATE KEY ENCODING
+// ============================================================================
+
+// Wallet private keys are stored as a bare WIF for P2PKH wallets (every
+// wallet created before SegWit) and as an output-descriptor style wpkh(WIF)
+// or tr(WIF) otherwise, so importing a key always yields the same address.
+var keyDescriptors = map[AddressType]string{
+	AddressTypeP2WPKH: "wpkh",
+	AddressTypeP2TR:   "tr",
+}
+
+// formatPrivateKey encodes wif with the address type it controls
+func formatPrivateKey(wif *btcutil.WIF, t AddressType) string {
+	if name, ok := keyDescriptors[t]; ok {
+		return name + "(" + wif.String() + ")"
+	}
+	return wif.String()
+}
+
+// parsePrivateKey decodes a key written by formatPrivateKey
+func parsePrivateKey(privateKey string, params *chaincfg.Params) (*btcutil.WIF, AddressType, error) {
+	s := strings.TrimSpace(privateKey)
+	addressType := AddressTypeP2PKH
+	for t, name := range keyDescriptors {
+		if strings.HasPrefix(s, name+"(") && strings.HasSuffix(s, ")") {
+			s = s[len(name)+1 : len(s)-1]
+			addressType = t
+			break
+		}
+	}
+
+	wif, err := btcutil.DecodeWIF(s)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid WIF private key: %w", err)
+	}
+	if !wif.IsForNet(params) {
+		return nil, "", fmt.Errorf("WIF private key is not for %s", params.Name)
+	}
+	if addressType.IsWitness() && !wif.CompressPubKey {
+		return nil, "", fmt.Errorf("%s wallets require a compressed WIF private key", addressType)
+	}
+
+	return wif, addressType, nil
+}
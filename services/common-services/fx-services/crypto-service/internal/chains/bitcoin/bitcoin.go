@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto-service/internal/domain"
 	"fmt"
+	"math"
 	"math/big"
 	"time"
 
@@ -12,18 +13,25 @@ import (
 )
 
 type BitcoinChain struct {
-	client  *BitcoinClient
-	network string
-	logger  *zap. Logger
+	client      *BitcoinClient
+	network     string
+	addressType AddressType // Type of new wallet addresses
+	logger      *zap. Logger
 }
 
-// NewBitcoinChain creates a new Bitcoin chain instance
-func NewBitcoinChain(rpcURL, apiKey, network string, logger *zap.Logger) (*BitcoinChain, error) {
+// NewBitcoinChain creates a new Bitcoin chain instance. New wallets get
+// addresses of addressType (p2wpkh, p2tr or p2pkh; empty = p2wpkh).
+func NewBitcoinChain(rpcURL, apiKey, network, addressType string, logger *zap.Logger) (*BitcoinChain, error) {
 	// Validate network
 	if network != "mainnet" && network != "testnet" && network != "regtest" {
 		return nil, fmt.Errorf("unsupported network: %s", network)
 	}
 
+	walletAddressType, err := ParseAddressType(addressType)
+	if err != nil {
+		return nil, err
+	}
+
 	// Warn about mainnet
 	if network == "mainnet" {
 		logger.Warn("⚠️  BITCOIN MAINNET ACTIVE - TRANSACTIONS USE REAL BTC")
@@ -35,12 +43,14 @@ func NewBitcoinChain(rpcURL, apiKey, network string, logger *zap.Logger) (*Bitco
 
 	logger.Info("Bitcoin chain initialized",
 		zap.String("network", network),
-		zap.String("rpc_url", rpcURL))
+		zap.String("rpc_url", rpcURL),
+		zap.String("address_type", string(walletAddressType)))
 
 	return &BitcoinChain{
-		client:  client,
-		network: network,
-		logger:  logger,
+		client:      client,
+		network:     network,
+		addressType: walletAddressType,
+		logger:      logger,
 	}, nil
 }
 
@@ -57,7 +67,7 @@ func (b *BitcoinChain) Symbol() string {
 // GenerateWallet creates a new Bitcoin wallet
 func (b *BitcoinChain) GenerateWallet(ctx context.Context) (*domain.Wallet, error) {
 
-	wallet, err := GenerateBitcoinWallet(b.network)
+	wallet, err := GenerateBitcoinWallet(b.network, b.addressType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate wallet: %w", err)
 	}
@@ -65,6 +75,33 @@ func (b *BitcoinChain) GenerateWallet(ctx context.Context) (*domain.Wallet, erro
 	return wallet, nil
 }
 
+// CoinType returns the BIP44 coin type
+func (b *BitcoinChain) CoinType() uint32 {
+	return bip44CoinType(b.network)
+}
+
+// WalletFromKey builds a wallet from a private key derived under BIP44
+// (a legacy P2PKH wallet)
+func (b *BitcoinChain) WalletFromKey(privateKey []byte) (*domain.Wallet, error) {
+	return BitcoinWalletFromKey(privateKey, b.network, AddressTypeP2PKH)
+}
+
+// Purpose returns the BIP43 purpose new HD wallets are derived under:
+// 84' for P2WPKH, 86' for Taproot, 44' for P2PKH
+func (b *BitcoinChain) Purpose() uint32 {
+	return b.addressType.Purpose()
+}
+
+// WalletFromPurposeKey builds a wallet from a private key derived under
+// purpose, with the address type that purpose stands for
+func (b *BitcoinChain) WalletFromPurposeKey(privateKey []byte, purpose uint32) (*domain.Wallet, error) {
+	addressType, err := addressTypeForPurpose(purpose)
+	if err != nil {
+		return nil, err
+	}
+	return BitcoinWalletFromKey(privateKey, b.network, addressType)
+}
+
 // ImportWallet imports a wallet from private key
 func (b *BitcoinChain) ImportWallet(ctx context.Context, privateKey string) (*domain.Wallet, error) {
 
@@ -109,13 +146,54 @@ func (b *BitcoinChain) GetBalance(ctx context.Context, address string, walletID
 	}, nil
 }
 
-// EstimateFee estimates transaction fee
+// EstimateFee estimates the fee of a payment at the current fee rate. When
+// the sender's UTXOs are known the estimate uses the exact inputs and change
+// Send would pick; otherwise a one-input, two-output transaction.
 func (b *BitcoinChain) EstimateFee(ctx context.Context, req *domain.TransactionRequest) (*domain.Fee, error) {
+	feeRateSatPerVB := b.feeRate(ctx, req.Priority)
+
+	var estimatedSize int64
+	var feeSats int64
+	if selection, err := b.selectCoins(ctx, req, feeRateSatPerVB); err == nil {
+		estimatedSize = selection.VSize
+		feeSats = selection.Fee
+	} else {
+		b.logger.Debug("Coin selection unavailable, estimating a typical transaction", zap.Error(err))
+
+		inputType := b.addressType
+		if t, err := DetectAddressType(req.From, b.network); err == nil {
+			inputType = t
+		}
+		outputType := b.addressType
+		if t, err := DetectAddressType(req.To, b.network); err == nil {
+			outputType = t
+		}
 
+		estimatedSize = virtualSize(txWeight(
+			[]AddressType{inputType},
+			[]int{outputScriptLen(outputType), outputScriptLen(inputType)},
+		))
+		feeSats = int64(math.Ceil(feeRateSatPerVB * float64(estimatedSize)))
+	}
+
+	b.logger.Info("Fee estimated",
+		zap.Float64("fee_rate_sat_per_vb", feeRateSatPerVB),
+		zap.Int64("estimated_size_vb", estimatedSize),
+		zap.Int64("fee_sats", feeSats))
+
+	return &domain.Fee{
+		Amount:   big.NewInt(feeSats),
+		Currency: "BTC",
+		GasLimit: &estimatedSize,
+		GasPrice: big.NewInt(int64(math.Ceil(feeRateSatPerVB))),
+	}, nil
+}
 
+// feeRate returns the fee rate (sat/vB) for priority
+func (b *BitcoinChain) feeRate(ctx context.Context, priority domain.TxPriority) float64 {
 	// Get current fee rate
 	var confirmationTarget int
-	switch req.Priority {
+	switch priority {
 	case domain.TxPriorityHigh:
 		confirmationTarget = 1 // Next block
 	case domain.TxPriorityNormal:
@@ -130,7 +208,7 @@ func (b *BitcoinChain) EstimateFee(ctx context.Context, req *domain.TransactionR
 	if err != nil {
 		// Fallback to default rates
 		b.logger.Warn("Failed to estimate fee, using defaults", zap.Error(err))
-		switch req.Priority {
+		switch priority {
 		case domain.TxPriorityHigh:
 			feeRateSatPerVB = 50
 		case domain.TxPriorityNormal:
@@ -140,25 +218,49 @@ func (b *BitcoinChain) EstimateFee(ctx context.Context, req *domain.TransactionR
 		}
 	}
 
-	// Estimate transaction size
-	// Typical P2PKH transaction: 1 input + 2 outputs ≈ 226 vBytes
-	// For safety, calculate based on amount and UTXOs needed
-	estimatedSize := int64(250) // Conservative estimate
+	return feeRateSatPerVB
+}
 
-	// Calculate fee
-	feeSats := int64(float64(estimatedSize) * feeRateSatPerVB)
+// selectCoins selects confirmed UTXOs of req.From paying req.Amount to
+// req.To at feeRate, with change back to req.From
+func (b *BitcoinChain) selectCoins(ctx context.Context, req *domain.TransactionRequest, feeRate float64) (*CoinSelection, error) {
+	if req.Amount == nil || !req.Amount.IsInt64() {
+		return nil, fmt.Errorf("invalid amount")
+	}
 
-	b.logger.Info("Fee estimated",
-		zap.Float64("fee_rate_sat_per_vb", feeRateSatPerVB),
-		zap.Int64("estimated_size_vb", estimatedSize),
-		zap.Int64("fee_sats", feeSats))
+	inputType, err := DetectAddressType(req.From, b.network)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from address: %w", err)
+	}
+	outputType, err := DetectAddressType(req.To, b.network)
+	if err != nil {
+		return nil, fmt.Errorf("invalid to address: %w", err)
+	}
 
-	return &domain.Fee{
-		Amount:   big.NewInt(feeSats),
-		Currency: "BTC",
-		GasLimit: &estimatedSize,
-		GasPrice: big.NewInt(int64(feeRateSatPerVB)),
-	}, nil
+	// Get UTXOs for sender
+	utxos, err := b.client.GetUTXOs(ctx, req. From)
+	if err != nil {
+		return nil, fmt. Errorf("failed to get UTXOs: %w", err)
+	}
+
+	confirmed := make([]UTXO, 0, len(utxos))
+	for _, utxo := range utxos {
+		if utxo.Status.Confirmed { // Skip unconfirmed UTXOs
+			confirmed = append(confirmed, utxo)
+		}
+	}
+	if len(confirmed) == 0 {
+		return nil, fmt.Errorf("no UTXOs available")
+	}
+
+	return SelectCoins(&CoinSelectionRequest{
+		UTXOs:      confirmed,
+		InputType:  inputType,
+		Amount:     req.Amount.Int64(),
+		OutputType: outputType,
+		ChangeType: inputType,
+		FeeRate:    feeRate,
+	})
 }
 
 // Send sends a Bitcoin transaction
@@ -172,20 +274,20 @@ func (b *BitcoinChain) Send(ctx context.Context, req *domain.TransactionRequest)
 		return nil, fmt.Errorf("invalid to address: %w", err)
 	}
 
-	// Get UTXOs for sender
-	utxos, err := b.client.GetUTXOs(ctx, req. From)
+	// The key must control the sending address (and tells its type)
+	sender, err := ImportBitcoinWallet(req.PrivateKey, b.network)
 	if err != nil {
-		return nil, fmt. Errorf("failed to get UTXOs: %w", err)
+		return nil, fmt.Errorf("invalid private key: %w", err)
 	}
-
-	if len(utxos) == 0 {
-		return nil, fmt.Errorf("no UTXOs available")
+	if sender.Address != req.From {
+		return nil, fmt.Errorf("private key does not match from address %s", req.From)
 	}
 
-	// Estimate fee
-	feeEstimate, err := b.EstimateFee(ctx, req)
+	// Select UTXOs
+	feeRate := b.feeRate(ctx, req.Priority)
+	selection, err := b.selectCoins(ctx, req, feeRate)
 	if err != nil {
-		return nil, fmt. Errorf("failed to estimate fee:  %w", err)
+		return nil, err
 	}
 
 	// Create transaction builder
@@ -194,41 +296,21 @@ func (b *BitcoinChain) Send(ctx context.Context, req *domain.TransactionRequest)
 		return nil, fmt.Errorf("failed to create transaction builder: %w", err)
 	}
 
-	// Select UTXOs and add inputs
-	amountSats := req.Amount. Int64()
-	feeSats := feeEstimate. Amount.Int64()
-	totalNeeded := amountSats + feeSats
-
-	var totalInput int64
-	for _, utxo := range utxos {
-		if ! utxo.Status. Confirmed {
-			continue // Skip unconfirmed UTXOs
-		}
-
+	for _, utxo := range selection.Inputs {
 		if err := txBuilder.AddInput(utxo, req. PrivateKey); err != nil {
 			return nil, fmt. Errorf("failed to add input:  %w", err)
 		}
-
-		totalInput += utxo.Value
-
-		if totalInput >= totalNeeded {
-			break
-		}
-	}
-
-	if totalInput < totalNeeded {
-		return nil, fmt.Errorf("insufficient balance:  have %d sats, need %d sats", totalInput, totalNeeded)
 	}
 
 	// Add recipient output
+	amountSats := req.Amount. Int64()
 	if err := txBuilder. AddOutput(req.To, amountSats); err != nil {
 		return nil, fmt. Errorf("failed to add recipient output: %w", err)
 	}
 
-	// Add change output if necessary
-	change := totalInput - amountSats - feeSats
-	if change > 546 { // Dust limit is 546 satoshis
-		if err := txBuilder.AddOutput(req.From, change); err != nil {
+	// Add change output if necessary (dust change is left in the fee)
+	if selection.Change > 0 {
+		if err := txBuilder.AddOutput(req.From, selection.Change); err != nil {
 			return nil, fmt.Errorf("failed to add change output:  %w", err)
 		}
 	}
@@ -249,10 +331,14 @@ func (b *BitcoinChain) Send(ctx context.Context, req *domain.TransactionRequest)
 
 	b.logger.Info("Transaction built and signed",
 		zap.String("tx_hash", txHash),
-		zap.Int64("total_input", totalInput),
+		zap.String("coin_selection", selection.Algorithm),
+		zap.Int("inputs", len(selection.Inputs)),
+		zap.Int64("total_input", selection.Total),
 		zap.Int64("amount", amountSats),
-		zap.Int64("fee", feeSats),
-		zap.Int64("change", change))
+		zap.Int64("fee", selection.Fee),
+		zap.Int64("change", selection.Change),
+		zap.Int("vsize", txBuilder.EstimateSize()),
+		zap.Float64("fee_rate_sat_per_vb", selection.FeeRate))
 
 	// Broadcast transaction
 	broadcastedHash, err := b.client.BroadcastTransaction(ctx, rawTx)
@@ -266,7 +352,7 @@ func (b *BitcoinChain) Send(ctx context.Context, req *domain.TransactionRequest)
 	return &domain.TransactionResult{
 		TxHash:    broadcastedHash,
 		Status:    domain.TxStatusPending,
-		Fee:       big.NewInt(feeSats),
+		Fee:       big.NewInt(selection.Fee),
 		Timestamp: time.Now(),
 	}, nil
 }
@@ -330,7 +416,8 @@ func (b *BitcoinChain) GetTransaction(ctx context.Context, txHash string) (*doma
 	return tx, nil
 }
 
-// ValidateAddress validates Bitcoin address format
+// ValidateAddress validates Bitcoin address format (P2PKH, P2SH, P2WPKH,
+// P2WSH or P2TR on the configured network)
 func (b *BitcoinChain) ValidateAddress(address string) error {
 	return ValidateBitcoinAddress(address, b.network)
 }
@@ -435,6 +435,67 @@ func (c *BitcoinClient) getDefaultFeeRate(confirmationTarget int) float64 {
 	return 5.0 // Ultimate fallback
 }
 
+// GetTipHeight gets the height of the best block
+func (c *BitcoinClient) GetTipHeight(ctx context.Context) (int64, error) {
+	body, err := c.getExplorer(ctx, "/blocks/tip/height")
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tip height: %w", err)
+	}
+
+	var height int64
+	if _, err := fmt.Sscan(strings.TrimSpace(string(body)), &height); err != nil {
+		return 0, fmt.Errorf("invalid tip height %q: %w", string(body), err)
+	}
+
+	return height, nil
+}
+
+// GetBlockHash gets the hash of the block at height on the best chain
+func (c *BitcoinClient) GetBlockHash(ctx context.Context, height int64) (string, error) {
+	body, err := c.getExplorer(ctx, fmt.Sprintf("/block-height/%d", height))
+	if err != nil {
+		return "", fmt.Errorf("failed to get block hash at %d: %w", height, err)
+	}
+
+	return strings.TrimSpace(string(body)), nil
+}
+
+// GetRawBlock gets the serialized block, one request per block instead of
+// paging through its transactions
+func (c *BitcoinClient) GetRawBlock(ctx context.Context, blockHash string) ([]byte, error) {
+	body, err := c.getExplorer(ctx, "/block/"+blockHash+"/raw")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block %s: %w", blockHash, err)
+	}
+
+	return body, nil
+}
+
+// getExplorer performs a GET against the block explorer API
+func (c *BitcoinClient) getExplorer(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.getBlockExplorerURL()+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("explorer error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
+
 // getBlockExplorerURL returns the appropriate block explorer URL
 func (c *BitcoinClient) getBlockExplorerURL() string {
 	switch c.network {
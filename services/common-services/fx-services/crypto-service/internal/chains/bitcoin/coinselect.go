@@ -0,0 +1,239 @@
+// internal/chains/bitcoin/coinselect.go
+package bitcoin
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Coin selection algorithms
+const (
+	CoinSelectionBranchAndBound = "branch_and_bound" // Exact match, no change output
+	CoinSelectionLargestFirst   = "largest_first"    // Fallback, with change unless it would be dust
+)
+
+const (
+	// minRelayFeeRate is the lowest fee rate (sat/vB) nodes relay
+	minRelayFeeRate = 1.0
+
+	// dustRelayFeeRate is Bitcoin Core's default dust relay fee (sat/vB)
+	dustRelayFeeRate = 3
+
+	// bnbMaxTries bounds the branch-and-bound search, as in Bitcoin Core
+	bnbMaxTries = 100000
+)
+
+// CoinSelectionRequest describes a payment to select UTXOs for
+type CoinSelectionRequest struct {
+	UTXOs      []UTXO      // Spendable UTXOs of the sending address
+	InputType  AddressType // Type of the sending address
+	Amount     int64       // Sats paid to the recipient
+	OutputType AddressType // Type of the recipient address
+	ChangeType AddressType // Type of the change address
+	FeeRate    float64     // sat/vB
+}
+
+// CoinSelection is the inputs, change and fee chosen for a payment
+type CoinSelection struct {
+	Inputs    []UTXO
+	Total     int64 // Sum of the inputs
+	Change    int64 // 0 when there is no change output
+	Fee       int64 // Total - Amount - Change
+	VSize     int64 // Virtual size of the signed transaction
+	FeeRate   float64
+	Algorithm string
+}
+
+// SelectCoins picks the inputs for a payment. Branch-and-bound looks for a
+// set of UTXOs that pays amount and fee with less left over than a change
+// output would cost, so no change is created; otherwise UTXOs are added
+// largest first and the remainder goes to a change output, or to the fee
+// when it would be dust.
+func SelectCoins(req *CoinSelectionRequest) (*CoinSelection, error) {
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+	if dust := dustThreshold(req.OutputType); req.Amount < dust {
+		return nil, fmt.Errorf("amount %d sats is below the dust threshold of %d sats", req.Amount, dust)
+	}
+
+	feeRate := math.Max(req.FeeRate, minRelayFeeRate)
+	// Nodes price transactions by vsize, rounded up
+	fee := func(weight int64) int64 {
+		return int64(math.Ceil(feeRate * float64(virtualSize(weight))))
+	}
+
+	paymentOnly := []int{outputScriptLen(req.OutputType)}
+	withChange := []int{outputScriptLen(req.OutputType), outputScriptLen(req.ChangeType)}
+
+	// Skip UTXOs worth less than the fee of spending them
+	inputFee := fee(inputWeight(req.InputType))
+	var available int64
+	candidates := make([]UTXO, 0, len(req.UTXOs))
+	for _, utxo := range req.UTXOs {
+		available += utxo.Value
+		if utxo.Value > inputFee {
+			candidates = append(candidates, utxo)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Value > candidates[j].Value
+	})
+
+	// 1. Branch and bound over effective values (value minus input fee)
+	baseWeight := txWeight(nil, paymentOnly)
+	if req.InputType.IsWitness() {
+		baseWeight += segwitMarkerWeight
+	}
+	target := req.Amount + fee(baseWeight)
+	costOfChange := fee(outputWeight(outputScriptLen(req.ChangeType))) + fee(inputWeight(req.ChangeType))
+
+	effective := make([]int64, len(candidates))
+	for i, utxo := range candidates {
+		effective[i] = utxo.Value - inputFee
+	}
+
+	if picked := selectBranchAndBound(effective, target, costOfChange); picked != nil {
+		inputs := make([]UTXO, len(picked))
+		for i, idx := range picked {
+			inputs[i] = candidates[idx]
+		}
+		selection := req.newSelection(inputs, 0, feeRate, CoinSelectionBranchAndBound)
+		// Per-input rounding can only overpay, but recheck the exact size
+		if selection.Fee >= fee(req.weight(len(inputs), paymentOnly)) {
+			return selection, nil
+		}
+	}
+
+	// 2. Largest first
+	var total int64
+	for i, utxo := range candidates {
+		total += utxo.Value
+		if total < req.Amount+fee(req.weight(i+1, paymentOnly)) {
+			continue
+		}
+
+		change := total - req.Amount - fee(req.weight(i+1, withChange))
+		if change < dustThreshold(req.ChangeType) {
+			// Change would be dust (or unaffordable): leave it to the miner
+			change = 0
+		}
+		return req.newSelection(candidates[:i+1], change, feeRate, CoinSelectionLargestFirst), nil
+	}
+
+	need := req.Amount + fee(req.weight(len(candidates), paymentOnly))
+	return nil, fmt.Errorf("insufficient balance: have %d sats, need %d sats", available, need)
+}
+
+// selectBranchAndBound searches depth first, largest values first, for the
+// subset of effective values whose sum lands in [target, target+costOfChange]
+// with the least excess. It returns the picked indices, or nil if no subset
+// was found within bnbMaxTries.
+func selectBranchAndBound(effective []int64, target, costOfChange int64) []int {
+	var remaining int64
+	for _, v := range effective {
+		remaining += v
+	}
+	if remaining < target {
+		return nil
+	}
+
+	selected := make([]bool, len(effective))
+	var best []bool
+	bestExcess := int64(math.MaxInt64)
+	tries := 0
+
+	var search func(depth int, value, remaining int64)
+	search = func(depth int, value, remaining int64) {
+		if tries >= bnbMaxTries || bestExcess == 0 {
+			return
+		}
+		tries++
+
+		// Overshot the window, or the rest cannot reach the target
+		if value > target+costOfChange || value+remaining < target {
+			return
+		}
+		if value >= target {
+			// Adding more inputs only increases the excess
+			if excess := value - target; excess < bestExcess {
+				bestExcess = excess
+				best = append(best[:0], selected...)
+			}
+			return
+		}
+		if depth == len(effective) {
+			return
+		}
+
+		remaining -= effective[depth]
+
+		// Including a UTXO equal to an excluded previous one repeats a
+		// branch already searched
+		if depth == 0 || selected[depth-1] || effective[depth] != effective[depth-1] {
+			selected[depth] = true
+			search(depth+1, value+effective[depth], remaining)
+			selected[depth] = false
+		}
+		search(depth+1, value, remaining)
+	}
+	search(0, 0, remaining)
+
+	if best == nil {
+		return nil
+	}
+	var picked []int
+	for i, ok := range best {
+		if ok {
+			picked = append(picked, i)
+		}
+	}
+	return picked
+}
+
+// weight returns the weight of a transaction spending n inputs of the
+// request's input type into outputs with the given pkScript lengths
+func (req *CoinSelectionRequest) weight(n int, outputScriptLens []int) int64 {
+	inputTypes := make([]AddressType, n)
+	for i := range inputTypes {
+		inputTypes[i] = req.InputType
+	}
+	return txWeight(inputTypes, outputScriptLens)
+}
+
+// newSelection builds the result for inputs and change
+func (req *CoinSelectionRequest) newSelection(inputs []UTXO, change int64, feeRate float64, algorithm string) *CoinSelection {
+	var total int64
+	for _, utxo := range inputs {
+		total += utxo.Value
+	}
+
+	outputs := []int{outputScriptLen(req.OutputType)}
+	if change > 0 {
+		outputs = append(outputs, outputScriptLen(req.ChangeType))
+	}
+
+	return &CoinSelection{
+		Inputs:    inputs,
+		Total:     total,
+		Change:    change,
+		Fee:       total - req.Amount - change,
+		VSize:     virtualSize(req.weight(len(inputs), outputs)),
+		FeeRate:   feeRate,
+		Algorithm: algorithm,
+	}
+}
+
+// dustThreshold is the smallest output of addressType nodes relay: under
+// Bitcoin Core's dust rule an output is dust when spending it would cost
+// more than its value at the dust relay fee (546 sats P2PKH, 294 P2WPKH,
+// 330 P2TR)
+func dustThreshold(addressType AddressType) int64 {
+	outputSize := int64(txOutBaseSize + outputScriptLen(addressType))
+	spendSize := int64(txInBaseSize + 107)
+	if addressType.IsWitness() {
+		spendSize = txInBaseSize + 107/4
+	}
+	return (outputSize + spendSize) * dustRelayFeeRate
+}
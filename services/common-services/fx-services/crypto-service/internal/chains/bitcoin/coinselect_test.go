@@ -0,0 +1,121 @@
+// internal/chains/bitcoin/coinselect_test.go
+package bitcoin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSelectCoins(t *testing.T) {
+	utxos := []UTXO{
+		{TxID: "a", Value: 50000},
+		{TxID: "b", Value: 30000},
+		{TxID: "c", Value: 20000},
+	}
+
+	tests := []struct {
+		name      string
+		amount    int64
+		inputs    int
+		change    int64
+		fee       int64
+		algorithm string
+		err       string
+	}{
+		{
+			// 50000 pays 48900 plus the 1100 sat fee of a 110 vB transaction
+			name:      "exact match",
+			amount:    48900,
+			inputs:    1,
+			change:    0,
+			fee:       1100,
+			algorithm: CoinSelectionBranchAndBound,
+		},
+		{
+			// 200 sats left over would not cover a change output: paid as fee
+			name:      "dust change goes to fee",
+			amount:    48700,
+			inputs:    1,
+			change:    0,
+			fee:       1300,
+			algorithm: CoinSelectionBranchAndBound,
+		},
+		{
+			name:      "change output",
+			amount:    80000,
+			inputs:    3,
+			change:    17230,
+			fee:       2770,
+			algorithm: CoinSelectionLargestFirst,
+		},
+		{
+			name:   "insufficient funds",
+			amount: 99000,
+			err:    "insufficient balance",
+		},
+		{
+			name:   "amount below dust",
+			amount: 200,
+			err:    "below the dust threshold",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selection, err := SelectCoins(&CoinSelectionRequest{
+				UTXOs:      utxos,
+				InputType:  AddressTypeP2WPKH,
+				Amount:     tt.amount,
+				OutputType: AddressTypeP2WPKH,
+				ChangeType: AddressTypeP2WPKH,
+				FeeRate:    10,
+			})
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected error containing %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SelectCoins: %v", err)
+			}
+
+			if len(selection.Inputs) != tt.inputs {
+				t.Errorf("inputs = %d, want %d", len(selection.Inputs), tt.inputs)
+			}
+			if selection.Change != tt.change {
+				t.Errorf("change = %d, want %d", selection.Change, tt.change)
+			}
+			if selection.Fee != tt.fee {
+				t.Errorf("fee = %d, want %d", selection.Fee, tt.fee)
+			}
+			if selection.Algorithm != tt.algorithm {
+				t.Errorf("algorithm = %s, want %s", selection.Algorithm, tt.algorithm)
+			}
+			if selection.Total != tt.amount+selection.Change+selection.Fee {
+				t.Errorf("total %d != amount %d + change %d + fee %d",
+					selection.Total, tt.amount, selection.Change, selection.Fee)
+			}
+			if minFee := int64(float64(selection.VSize) * selection.FeeRate); selection.Fee < minFee {
+				t.Errorf("fee %d is below %d for %d vB", selection.Fee, minFee, selection.VSize)
+			}
+		})
+	}
+}
+
+func TestDustThreshold(t *testing.T) {
+	tests := []struct {
+		addressType AddressType
+		want        int64
+	}{
+		{AddressTypeP2PKH, 546},
+		{AddressTypeP2WPKH, 294},
+		{AddressTypeP2TR, 330},
+	}
+
+	for _, tt := range tests {
+		if got := dustThreshold(tt.addressType); got != tt.want {
+			t.Errorf("dustThreshold(%s) = %d, want %d", tt.addressType, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// internal/chains/bitcoin/scanner.go
+package bitcoin
+
+import (
+	"bytes"
+	"context"
+	"crypto-service/internal/domain"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// LatestBlockNumber returns the height of the best block
+func (b *BitcoinChain) LatestBlockNumber(ctx context.Context) (int64, error) {
+	return b.client.GetTipHeight(ctx)
+}
+
+// GetBlockHash returns the hash of the block at height
+func (b *BitcoinChain) GetBlockHash(ctx context.Context, number int64) (string, error) {
+	return b.client.GetBlockHash(ctx, number)
+}
+
+// GetBlock returns every output of the block at height that pays a standard
+// address, with the transaction's first input as the sender. Bitcoin has no
+// tokens, so tokens is ignored.
+func (b *BitcoinChain) GetBlock(ctx context.Context, number int64, tokens []*domain.Asset) (*domain.ScannedBlock, error) {
+	params, err := getNetworkParams(b.network)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash, err := b.client.GetBlockHash(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := b.client.GetRawBlock(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var block wire.MsgBlock
+	if err := block.Deserialize(bytes.NewReader(raw)); err != nil {
+		return nil, fmt.Errorf("failed to decode block %d: %w", number, err)
+	}
+
+	// The explorer may have moved to another block between the two calls
+	if hash := block.BlockHash().String(); hash != blockHash {
+		return nil, fmt.Errorf("block %d changed while scanning (%s != %s)", number, hash, blockHash)
+	}
+
+	scanned := &domain.ScannedBlock{
+		Number:     number,
+		Hash:       blockHash,
+		ParentHash: block.Header.PrevBlock.String(),
+		Timestamp:  block.Header.Timestamp,
+	}
+
+	for _, tx := range block.Transactions {
+		txHash := tx.TxHash().String()
+
+		// Sender is the first input's address; coinbase has none
+		var from string
+		if !isCoinbase(tx) {
+			from = inputAddress(tx.TxIn[0], params)
+		}
+
+		for vout, out := range tx.TxOut {
+			if out.Value <= 0 {
+				continue
+			}
+
+			// Multisig and non-standard scripts don't pay one of our addresses
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(out.PkScript, params)
+			if err != nil || len(addrs) != 1 {
+				continue
+			}
+
+			scanned.Transfers = append(scanned.Transfers, &domain.BlockTransfer{
+				TxHash:      txHash,
+				OutputIndex: vout,
+				From:        from,
+				To:          addrs[0].EncodeAddress(),
+				Asset:       b.Symbol(),
+				Amount:      big.NewInt(out.Value),
+			})
+		}
+	}
+
+	return scanned, nil
+}
+
+// isCoinbase reports whether tx is the block reward, which spends no output
+func isCoinbase(tx *wire.MsgTx) bool {
+	if len(tx.TxIn) != 1 {
+		return false
+	}
+	prev := tx.TxIn[0].PreviousOutPoint
+	return prev.Index == wire.MaxPrevOutIndex && prev.Hash == (chainhash.Hash{})
+}
+
+// inputAddress derives the address an input spends from the public key or
+// script it reveals, without fetching the previous transaction. Taproot
+// spends reveal neither and return "".
+func inputAddress(in *wire.TxIn, params *chaincfg.Params) string {
+	var addr btcutil.Address
+	var err error
+
+	witness := in.Witness
+	switch {
+	case len(witness) == 0:
+		// P2PKH pushes <sig> <pubkey>; P2SH pushes the redeem script last
+		pushes, pushErr := txscript.PushedData(in.SignatureScript)
+		if pushErr != nil || len(pushes) < 2 {
+			return ""
+		}
+		last := pushes[len(pushes)-1]
+		if len(pushes) == 2 && (len(last) == 33 || len(last) == 65) {
+			addr, err = btcutil.NewAddressPubKeyHash(btcutil.Hash160(last), params)
+		} else {
+			addr, err = btcutil.NewAddressScriptHash(last, params)
+		}
+
+	case len(in.SignatureScript) > 0:
+		// Nested segwit: the script sig pushes the witness program
+		pushes, pushErr := txscript.PushedData(in.SignatureScript)
+		if pushErr != nil || len(pushes) != 1 {
+			return ""
+		}
+		addr, err = btcutil.NewAddressScriptHash(pushes[0], params)
+
+	case len(witness) == 2 && len(witness[1]) == 33:
+		// P2WPKH: <sig> <pubkey>
+		addr, err = btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(witness[1]), params)
+
+	case len(witness) > 2 && !isTaprootScriptPath(witness):
+		// P2WSH: the witness script is the last item
+		scriptHash := sha256.Sum256(witness[len(witness)-1])
+		addr, err = btcutil.NewAddressWitnessScriptHash(scriptHash[:], params)
+
+	default:
+		return ""
+	}
+
+	if err != nil {
+		return ""
+	}
+	return addr.EncodeAddress()
+}
+
+// isTaprootScriptPath reports whether witness ends in a taproot control
+// block (or an annex), which a P2WSH witness script does not
+func isTaprootScriptPath(witness wire.TxWitness) bool {
+	last := witness[len(witness)-1]
+	if len(last) > 0 && last[0] == txscript.TaprootAnnexTag {
+		return true
+	}
+	return len(last) >= 33 && (len(last)-33)%32 == 0 && last[0]&0xfe == byte(txscript.BaseLeafVersion)
+}
@@ -0,0 +1,77 @@
+// internal/chains/bitcoin/scanner_test.go
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+func TestInputAddress(t *testing.T) {
+	const network = "testnet"
+	params, err := getNetworkParams(network)
+	if err != nil {
+		t.Fatalf("getNetworkParams: %v", err)
+	}
+
+	for _, tc := range []struct {
+		addressType AddressType
+		wantSender  bool // Taproot key path spends reveal no key
+	}{
+		{AddressTypeP2PKH, true},
+		{AddressTypeP2WPKH, true},
+		{AddressTypeP2TR, false},
+	} {
+		t.Run(string(tc.addressType), func(t *testing.T) {
+			sender, err := GenerateBitcoinWallet(network, tc.addressType)
+			if err != nil {
+				t.Fatalf("GenerateBitcoinWallet: %v", err)
+			}
+			recipient, err := GenerateBitcoinWallet(network, AddressTypeP2WPKH)
+			if err != nil {
+				t.Fatalf("GenerateBitcoinWallet: %v", err)
+			}
+
+			tb, err := NewTransactionBuilder(network)
+			if err != nil {
+				t.Fatalf("NewTransactionBuilder: %v", err)
+			}
+			if err := tb.AddInput(UTXO{TxID: testTxID('2'), Vout: 1, Value: 50000}, sender.PrivateKey); err != nil {
+				t.Fatalf("AddInput: %v", err)
+			}
+			if err := tb.AddOutput(recipient.Address, 40000); err != nil {
+				t.Fatalf("AddOutput: %v", err)
+			}
+			if err := tb.Sign(); err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+
+			raw, err := tb.Serialize()
+			if err != nil {
+				t.Fatalf("Serialize: %v", err)
+			}
+			rawBytes, err := hex.DecodeString(raw)
+			if err != nil {
+				t.Fatalf("decode hex: %v", err)
+			}
+			var tx wire.MsgTx
+			if err := tx.Deserialize(bytes.NewReader(rawBytes)); err != nil {
+				t.Fatalf("Deserialize: %v", err)
+			}
+
+			if isCoinbase(&tx) {
+				t.Fatal("spending transaction reported as coinbase")
+			}
+
+			want := ""
+			if tc.wantSender {
+				want = sender.Address
+			}
+			if got := inputAddress(tx.TxIn[0], params); got != want {
+				t.Fatalf("inputAddress = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -65,6 +65,7 @@ func initialize() error {
 		cfg.Bitcoin. RPCURL,
 		cfg.Bitcoin.APIKey,
 		cfg.Bitcoin. Network,
+		cfg.Bitcoin.AddressType,
 		logger,
 	)
 	if err != nil {
@@ -25,9 +25,11 @@ type TransactionBuilder struct {
 }
 
 type txInput struct {
-	utxo       UTXO
-	privateKey *btcec.PrivateKey
-	address    btcutil.Address
+	utxo        UTXO
+	privateKey  *btcec.PrivateKey
+	address     btcutil.Address
+	addressType AddressType
+	pkScript    []byte
 }
 
 // NewTransactionBuilder creates a new transaction builder
@@ -44,12 +46,14 @@ func NewTransactionBuilder(network string) (*TransactionBuilder, error) {
 	}, nil
 }
 
-// AddInput adds a UTXO input to the transaction
-func (tb *TransactionBuilder) AddInput(utxo UTXO, privateKeyWIF string) error {
-	// Decode WIF private key
-	wif, err := btcutil.DecodeWIF(privateKeyWIF)
+// AddInput adds a UTXO input to the transaction. privateKey is a wallet
+// key as stored (WIF, wpkh(WIF) or tr(WIF)); the UTXO must belong to the
+// address of that type.
+func (tb *TransactionBuilder) AddInput(utxo UTXO, privateKey string) error {
+	// Decode private key
+	wif, addressType, err := parsePrivateKey(privateKey, tb.network)
 	if err != nil {
-		return fmt.Errorf("invalid WIF:  %w", err)
+		return err
 	}
 
 	// Parse previous transaction hash
@@ -58,6 +62,16 @@ func (tb *TransactionBuilder) AddInput(utxo UTXO, privateKeyWIF string) error {
 		return fmt.Errorf("invalid txid: %w", err)
 	}
 
+	// Derive the address (and script) the UTXO is locked to
+	address, err := addressForKey(wif.PrivKey.PubKey(), addressType, tb.network)
+	if err != nil {
+		return fmt. Errorf("failed to create address: %w", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		return fmt.Errorf("failed to create pkScript: %w", err)
+	}
+
 	// Create transaction input
 	txIn := wire.NewTxIn(
 		wire.NewOutPoint(prevHash, utxo. Vout),
@@ -70,20 +84,13 @@ func (tb *TransactionBuilder) AddInput(utxo UTXO, privateKeyWIF string) error {
 
 	tb.tx.AddTxIn(txIn)
 
-	// Get address from UTXO (would need to be passed or derived)
-	// For now, derive from public key
-	publicKey := wif.PrivKey.PubKey()
-	pubKeyHash := btcutil.Hash160(publicKey.SerializeCompressed())
-	address, err := btcutil.NewAddressPubKeyHash(pubKeyHash, tb.network)
-	if err != nil {
-		return fmt. Errorf("failed to create address: %w", err)
-	}
-
 	// Store input info for signing
 	tb.inputs = append(tb.inputs, txInput{
-		utxo:       utxo,
-		privateKey:  wif. PrivKey,
+		utxo:        utxo,
+		privateKey:  wif.PrivKey,
 		address:     address,
+		addressType: addressType,
+		pkScript:    pkScript,
 	})
 
 	return nil
@@ -92,9 +99,9 @@ func (tb *TransactionBuilder) AddInput(utxo UTXO, privateKeyWIF string) error {
 // AddOutput adds an output to the transaction
 func (tb *TransactionBuilder) AddOutput(address string, amountSats int64) error {
 	// Decode address
-	addr, err := btcutil.DecodeAddress(address, tb.network)
+	addr, err := decodeAddress(address, tb.network)
 	if err != nil {
-		return fmt.Errorf("invalid address: %w", err)
+		return err
 	}
 
 	// Create pay-to-address script
@@ -110,47 +117,96 @@ func (tb *TransactionBuilder) AddOutput(address string, amountSats int64) error
 	return nil
 }
 
-// Sign signs all inputs
+// Sign signs all inputs: P2PKH with a signature script, P2WPKH with a
+// BIP143 witness and Taproot with a BIP341 key-path witness. Every input is
+// verified against its script before the transaction is returned.
 func (tb *TransactionBuilder) Sign() error {
+	// SegWit and Taproot sighashes commit to the amounts (and, for Taproot,
+	// the scripts) of all spent outputs
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
 	for i, input := range tb.inputs {
-		// Create pay-to-pubkey-hash script
-		pkScript, err := txscript.PayToAddrScript(input.address)
-		if err != nil {
-			return fmt.Errorf("failed to create pkScript: %w", err)
+		prevOuts.AddPrevOut(tb.tx.TxIn[i].PreviousOutPoint, wire.NewTxOut(input.utxo.Value, input.pkScript))
+	}
+	sigHashes := txscript.NewTxSigHashes(tb.tx, prevOuts)
+
+	for i, input := range tb.inputs {
+		switch input.addressType {
+		case AddressTypeP2WPKH:
+			witness, err := txscript.WitnessSignature(
+				tb.tx, sigHashes, i, input.utxo.Value, input.pkScript,
+				txscript.SigHashAll, input.privateKey, true,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to sign input %d: %w", i, err)
+			}
+			tb.tx.TxIn[i].Witness = witness
+
+		case AddressTypeP2TR:
+			witness, err := txscript.TaprootWitnessSignature(
+				tb.tx, sigHashes, i, input.utxo.Value, input.pkScript,
+				txscript.SigHashDefault, input.privateKey,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to sign input %d: %w", i, err)
+			}
+			tb.tx.TxIn[i].Witness = witness
+
+		default:
+			sigScript, err := tb.signP2PKH(i, input)
+			if err != nil {
+				return err
+			}
+			tb.tx.TxIn[i].SignatureScript = sigScript
 		}
+	}
 
-		// Create signature hash
-		sigHash, err := txscript. CalcSignatureHash(
-			pkScript,
-			txscript. SigHashAll,
-			tb.tx,
-			i,
+	// Never hand out a transaction the network would reject
+	for i, input := range tb.inputs {
+		vm, err := txscript.NewEngine(
+			input.pkScript, tb.tx, i, txscript.StandardVerifyFlags,
+			nil, sigHashes, input.utxo.Value, prevOuts,
 		)
 		if err != nil {
-			return fmt.Errorf("failed to calculate signature hash: %w", err)
+			return fmt.Errorf("failed to verify input %d: %w", i, err)
 		}
+		if err := vm.Execute(); err != nil {
+			return fmt.Errorf("input %d signature is invalid: %w", i, err)
+		}
+	}
 
-		// Sign
-		signature := ecdsa.Sign(input.privateKey, sigHash)
+	return nil
+}
 
-		// Serialize signature with SIGHASH_ALL flag
-		sigBytes := append(signature.Serialize(), byte(txscript.SigHashAll))
+// signP2PKH returns the signature script of a legacy P2PKH input
+func (tb *TransactionBuilder) signP2PKH(i int, input txInput) ([]byte, error) {
+	// Create signature hash
+	sigHash, err := txscript. CalcSignatureHash(
+		input.pkScript,
+		txscript. SigHashAll,
+		tb.tx,
+		i,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate signature hash: %w", err)
+	}
 
-		// Create signature script
-		publicKey := input.privateKey.PubKey().SerializeCompressed()
-		sigScript, err := txscript.NewScriptBuilder().
-			AddData(sigBytes).
-			AddData(publicKey).
-			Script()
-		if err != nil {
-			return fmt.Errorf("failed to build signature script: %w", err)
-		}
+	// Sign
+	signature := ecdsa.Sign(input.privateKey, sigHash)
+
+	// Serialize signature with SIGHASH_ALL flag
+	sigBytes := append(signature.Serialize(), byte(txscript.SigHashAll))
 
-		// Set signature script
-		tb.tx.TxIn[i].SignatureScript = sigScript
+	// Create signature script
+	publicKey := input.privateKey.PubKey().SerializeCompressed()
+	sigScript, err := txscript.NewScriptBuilder().
+		AddData(sigBytes).
+		AddData(publicKey).
+		Script()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signature script: %w", err)
 	}
 
-	return nil
+	return sigScript, nil
 }
 
 // Serialize returns the raw transaction hex
@@ -183,16 +239,93 @@ func (tb *TransactionBuilder) CalculateFee() int64 {
 	return totalInput - totalOutput
 }
 
-// EstimateSize estimates the transaction size in vBytes
+// EstimateSize estimates the signed transaction's virtual size in vBytes
 func (tb *TransactionBuilder) EstimateSize() int {
-	// Rough estimation: 
-	// Base:  10 bytes
-	// Input: ~148 bytes each (P2PKH)
-	// Output: ~34 bytes each
-	
-	baseSize := 10
-	inputSize := len(tb. tx.TxIn) * 148
-	outputSize := len(tb. tx.TxOut) * 34
-
-	return baseSize + inputSize + outputSize
-}
\ No newline at end of file
+	inputTypes := make([]AddressType, len(tb.inputs))
+	for i, input := range tb.inputs {
+		inputTypes[i] = input.addressType
+	}
+
+	outputScriptLens := make([]int, len(tb.tx.TxOut))
+	for i, output := range tb.tx.TxOut {
+		outputScriptLens[i] = len(output.PkScript)
+	}
+
+	return int(virtualSize(txWeight(inputTypes, outputScriptLens)))
+}
+
+// ============================================================================
+// SIZE ESTIMATION
+// ============================================================================
+
+// Sizes follow BIP141: non-witness bytes weigh 4 weight units (WU), witness
+// bytes 1 WU, and vsize = ceil(weight / 4). Signatures are counted at their
+// maximum DER length (72 bytes) so fees are never short.
+const (
+	txVersionLockTimeSize = 8  // nVersion + nLockTime
+	segwitMarkerWeight    = 2  // Marker and flag bytes, counted as witness
+	txInBaseSize          = 41 // Outpoint 36 + scriptSig length 1 + sequence 4
+	txOutBaseSize         = 9  // Value 8 + pkScript length 1
+)
+
+// inputWeight returns the weight of a signed input spending addressType
+func inputWeight(addressType AddressType) int64 {
+	switch addressType {
+	case AddressTypeP2WPKH:
+		// Witness: item count 1 + signature 1+72 + compressed key 1+33
+		return txInBaseSize*4 + 108
+	case AddressTypeP2TR:
+		// Witness: item count 1 + Schnorr signature 1+64 (SIGHASH_DEFAULT)
+		return txInBaseSize*4 + 66
+	default:
+		// scriptSig: signature 1+72 + compressed key 1+33
+		return (txInBaseSize + 107) * 4
+	}
+}
+
+// outputScriptLen returns the pkScript length of an output paying addressType
+func outputScriptLen(addressType AddressType) int {
+	switch addressType {
+	case AddressTypeP2SH:
+		return 23
+	case AddressTypeP2WPKH:
+		return 22
+	case AddressTypeP2WSH, AddressTypeP2TR:
+		return 34
+	default:
+		return 25
+	}
+}
+
+// outputWeight returns the weight of an output with a pkScript of scriptLen bytes
+func outputWeight(scriptLen int) int64 {
+	return int64(txOutBaseSize+scriptLen) * 4
+}
+
+// txWeight returns the weight of a signed transaction spending inputTypes
+// into outputs with the given pkScript lengths
+func txWeight(inputTypes []AddressType, outputScriptLens []int) int64 {
+	weight := int64(txVersionLockTimeSize+
+		wire.VarIntSerializeSize(uint64(len(inputTypes)))+
+		wire.VarIntSerializeSize(uint64(len(outputScriptLens)))) * 4
+
+	hasWitness := false
+	for _, t := range inputTypes {
+		weight += inputWeight(t)
+		hasWitness = hasWitness || t.IsWitness()
+	}
+	if hasWitness {
+		weight += segwitMarkerWeight
+	}
+
+	for _, n := range outputScriptLens {
+		weight += outputWeight(n)
+	}
+
+	return weight
+}
+
+// virtualSize converts a weight to vBytes
+func virtualSize(weight int64) int64 {
+	return (weight + 3) / 4
+}
@@ -0,0 +1,103 @@
+// internal/chains/bitcoin/transaction_test.go
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+func TestSignRoundTrip(t *testing.T) {
+	const (
+		network    = "testnet"
+		inputValue = 100000
+		sendValue  = 90000
+	)
+
+	for _, addressType := range []AddressType{AddressTypeP2PKH, AddressTypeP2WPKH, AddressTypeP2TR} {
+		t.Run(string(addressType), func(t *testing.T) {
+			sender, err := GenerateBitcoinWallet(network, addressType)
+			if err != nil {
+				t.Fatalf("GenerateBitcoinWallet: %v", err)
+			}
+			recipient, err := GenerateBitcoinWallet(network, addressType)
+			if err != nil {
+				t.Fatalf("GenerateBitcoinWallet: %v", err)
+			}
+
+			tb, err := NewTransactionBuilder(network)
+			if err != nil {
+				t.Fatalf("NewTransactionBuilder: %v", err)
+			}
+			utxo := UTXO{TxID: testTxID('1'), Vout: 0, Value: inputValue}
+			if err := tb.AddInput(utxo, sender.PrivateKey); err != nil {
+				t.Fatalf("AddInput: %v", err)
+			}
+			if err := tb.AddOutput(recipient.Address, sendValue); err != nil {
+				t.Fatalf("AddOutput: %v", err)
+			}
+			if err := tb.Sign(); err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+
+			// Decode the broadcast form and verify it against the spent output
+			raw, err := tb.Serialize()
+			if err != nil {
+				t.Fatalf("Serialize: %v", err)
+			}
+			rawBytes, err := hex.DecodeString(raw)
+			if err != nil {
+				t.Fatalf("decode hex: %v", err)
+			}
+			var tx wire.MsgTx
+			if err := tx.Deserialize(bytes.NewReader(rawBytes)); err != nil {
+				t.Fatalf("Deserialize: %v", err)
+			}
+
+			in := tx.TxIn[0]
+			if addressType.IsWitness() {
+				if len(in.Witness) == 0 || len(in.SignatureScript) != 0 {
+					t.Fatalf("expected a witness only, got witness=%d sigScript=%d",
+						len(in.Witness), len(in.SignatureScript))
+				}
+			} else if len(in.SignatureScript) == 0 || len(in.Witness) != 0 {
+				t.Fatalf("expected a signature script only, got witness=%d sigScript=%d",
+					len(in.Witness), len(in.SignatureScript))
+			}
+
+			pkScript := tb.inputs[0].pkScript
+			if err := verifyInput(&tx, pkScript, inputValue); err != nil {
+				t.Fatalf("signed transaction does not verify: %v", err)
+			}
+
+			// The signature commits to the outputs
+			tx.TxOut[0].Value--
+			if err := verifyInput(&tx, pkScript, inputValue); err == nil {
+				t.Fatal("transaction with a modified output still verifies")
+			}
+		})
+	}
+}
+
+// verifyInput runs the script engine on the first input of tx spending
+// pkScript worth value
+func verifyInput(tx *wire.MsgTx, pkScript []byte, value int64) error {
+	prevOuts := txscript.NewCannedPrevOutputFetcher(pkScript, value)
+	sigHashes := txscript.NewTxSigHashes(tx, prevOuts)
+	vm, err := txscript.NewEngine(
+		pkScript, tx, 0, txscript.StandardVerifyFlags,
+		nil, sigHashes, value, prevOuts,
+	)
+	if err != nil {
+		return err
+	}
+	return vm.Execute()
+}
+
+// testTxID returns a txid of 64 repeated hex digits
+func testTxID(digit byte) string {
+	return string(bytes.Repeat([]byte{digit}, 64))
+}
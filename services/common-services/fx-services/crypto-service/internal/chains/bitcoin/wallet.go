@@ -14,8 +14,8 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 )
 
-// GenerateBitcoinWallet generates a new Bitcoin wallet
-func GenerateBitcoinWallet(network string) (*domain.Wallet, error) {
+// GenerateBitcoinWallet generates a new Bitcoin wallet with an address of addressType
+func GenerateBitcoinWallet(network string, addressType AddressType) (*domain.Wallet, error) {
 	// Get network params
 	params, err := getNetworkParams(network)
 	if err != nil {
@@ -28,12 +28,32 @@ func GenerateBitcoinWallet(network string) (*domain.Wallet, error) {
 		return nil, fmt.Errorf("failed to generate private key:  %w", err)
 	}
 
+	return bitcoinWalletFromPrivKey(privateKey, addressType, params)
+}
+
+// BitcoinWalletFromKey builds a wallet from a raw 32-byte private key
+// (e.g. one derived from an HD extended key)
+func BitcoinWalletFromKey(key []byte, network string, addressType AddressType) (*domain.Wallet, error) {
+	params, err := getNetworkParams(network)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("invalid private key length: expected 32 bytes, got %d", len(key))
+	}
+
+	privateKey, _ := btcec.PrivKeyFromBytes(key)
+	return bitcoinWalletFromPrivKey(privateKey, addressType, params)
+}
+
+// bitcoinWalletFromPrivKey derives the address of addressType and the
+// encoded private key (see formatPrivateKey) for a key
+func bitcoinWalletFromPrivKey(privateKey *btcec.PrivateKey, addressType AddressType, params *chaincfg.Params) (*domain.Wallet, error) {
 	// Get public key
 	publicKey := privateKey.PubKey()
 
-	// Create address (P2PKH - Pay to Public Key Hash)
-	pubKeyHash := btcutil.Hash160(publicKey.SerializeCompressed())
-	address, err := btcutil.NewAddressPubKeyHash(pubKeyHash, params)
+	// Create address
+	address, err := addressForKey(publicKey, addressType, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create address: %w", err)
 	}
@@ -46,15 +66,16 @@ func GenerateBitcoinWallet(network string) (*domain.Wallet, error) {
 
 	return &domain.Wallet{
 		Address:    address. EncodeAddress(),
-		PrivateKey: wif. String(),
+		PrivateKey: formatPrivateKey(wif, addressType),
 		PublicKey:  hex.EncodeToString(publicKey.SerializeCompressed()),
 		Chain:      "BITCOIN",
 		CreatedAt:  time.Now(),
 	}, nil
 }
 
-// ImportBitcoinWallet imports a wallet from WIF private key
-func ImportBitcoinWallet(privateKeyWIF, network string) (*domain.Wallet, error) {
+// ImportBitcoinWallet imports a wallet from a WIF private key. A bare WIF
+// imports as P2PKH, wpkh(WIF) as P2WPKH and tr(WIF) as Taproot.
+func ImportBitcoinWallet(privateKey, network string) (*domain.Wallet, error) {
 	// Get network params
 	params, err := getNetworkParams(network)
 	if err != nil {
@@ -62,30 +83,37 @@ func ImportBitcoinWallet(privateKeyWIF, network string) (*domain.Wallet, error)
 	}
 
 	// Decode WIF
-	wif, err := btcutil.DecodeWIF(privateKeyWIF)
+	wif, addressType, err := parsePrivateKey(privateKey, params)
 	if err != nil {
-		return nil, fmt.Errorf("invalid WIF private key: %w", err)
+		return nil, err
 	}
 
 	// Get public key
 	publicKey := wif. PrivKey.PubKey()
 
 	// Create address
-	pubKeyHash := btcutil.Hash160(publicKey.SerializeCompressed())
-	address, err := btcutil.NewAddressPubKeyHash(pubKeyHash, params)
+	address, err := addressForKey(publicKey, addressType, params)
 	if err != nil {
 		return nil, fmt. Errorf("failed to create address: %w", err)
 	}
 
 	return &domain.Wallet{
 		Address:    address.EncodeAddress(),
-		PrivateKey: privateKeyWIF,
+		PrivateKey: formatPrivateKey(wif, addressType),
 		PublicKey:  hex.EncodeToString(publicKey.SerializeCompressed()),
 		Chain:      "BITCOIN",
 		CreatedAt:  time.Now(),
 	}, nil
 }
 
+// bip44CoinType returns the BIP44 coin type for network (1 for all test networks)
+func bip44CoinType(network string) uint32 {
+	if network == "mainnet" {
+		return 0
+	}
+	return 1
+}
+
 // getNetworkParams returns chaincfg params for network
 func getNetworkParams(network string) (*chaincfg.Params, error) {
 	switch network {
@@ -100,17 +128,9 @@ func getNetworkParams(network string) (*chaincfg.Params, error) {
 	}
 }
 
-// ValidateBitcoinAddress validates a Bitcoin address
+// ValidateBitcoinAddress validates a Bitcoin address of any type we can
+// pay to (P2PKH, P2SH, P2WPKH, P2WSH, P2TR) on network
 func ValidateBitcoinAddress(address, network string) error {
-	params, err := getNetworkParams(network)
-	if err != nil {
-		return err
-	}
-
-	_, err = btcutil.DecodeAddress(address, params)
-	if err != nil {
-		return fmt.Errorf("invalid Bitcoin address: %w", err)
-	}
-
-	return nil
-}
\ No newline at end of file
+	_, err := DetectAddressType(address, network)
+	return err
+}
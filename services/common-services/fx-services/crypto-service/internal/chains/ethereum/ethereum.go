@@ -130,6 +130,16 @@ func (c *EthereumChain) GenerateWallet(ctx context.Context) (*domain.Wallet, err
 	}
 }
 
+// CoinType returns the BIP44 coin type
+func (c *EthereumChain) CoinType() uint32 {
+	return 60
+}
+
+// WalletFromKey builds a standard (non-Circle) wallet from a derived private key
+func (c *EthereumChain) WalletFromKey(privateKey []byte) (*domain.Wallet, error) {
+	return ethereumWalletFromKey(privateKey)
+}
+
 func (c *EthereumChain) ImportWallet(ctx context.Context, privateKey string) (*domain.Wallet, error) {
 	return importEthereumWallet(privateKey)
 }
@@ -0,0 +1,121 @@
+// internal/chains/ethereum/scanner.go
+package ethereum
+
+import (
+	"context"
+	"crypto-service/internal/domain"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// transferEventTopic is keccak256("Transfer(address,address,uint256)")
+var transferEventTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// LatestBlockNumber returns the current block height
+func (c *EthereumChain) LatestBlockNumber(ctx context.Context) (int64, error) {
+	number, err := c.client.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get block number: %w", err)
+	}
+	return int64(number), nil
+}
+
+// GetBlockHash returns the hash of the block at number
+func (c *EthereumChain) GetBlockHash(ctx context.Context, number int64) (string, error) {
+	header, err := c.client.HeaderByNumber(ctx, big.NewInt(number))
+	if err != nil {
+		return "", fmt.Errorf("failed to get header %d: %w", number, err)
+	}
+	return header.Hash().Hex(), nil
+}
+
+// GetBlock returns the successful ETH transfers and ERC-20 Transfer logs of
+// the given tokens in block number. ETH sent by contracts (internal
+// transactions) is not visible here.
+func (c *EthereumChain) GetBlock(ctx context.Context, number int64, tokens []*domain.Asset) (*domain.ScannedBlock, error) {
+	block, err := c.client.BlockByNumber(ctx, big.NewInt(number))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block %d: %w", number, err)
+	}
+
+	// Receipts by hash so they belong to exactly this block even mid-reorg
+	receipts, err := c.client.BlockReceipts(ctx, rpc.BlockNumberOrHashWithHash(block.Hash(), false))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipts of block %d: %w", number, err)
+	}
+
+	receiptByTx := make(map[common.Hash]*types.Receipt, len(receipts))
+	for _, receipt := range receipts {
+		receiptByTx[receipt.TxHash] = receipt
+	}
+
+	tokenByContract := make(map[common.Address]*domain.Asset)
+	for _, token := range tokens {
+		if token.ContractAddr != nil {
+			tokenByContract[common.HexToAddress(*token.ContractAddr)] = token
+		}
+	}
+
+	scanned := &domain.ScannedBlock{
+		Number:     number,
+		Hash:       block.Hash().Hex(),
+		ParentHash: block.ParentHash().Hex(),
+		Timestamp:  time.Unix(int64(block.Time()), 0),
+	}
+
+	signer := types.LatestSignerForChainID(c.config.ChainID)
+
+	for _, tx := range block.Transactions() {
+		receipt, ok := receiptByTx[tx.Hash()]
+		if !ok || receipt.Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+
+		from := ""
+		if sender, err := types.Sender(signer, tx); err == nil {
+			from = sender.Hex()
+		}
+
+		// Native ETH
+		if tx.To() != nil && tx.Value().Sign() > 0 {
+			scanned.Transfers = append(scanned.Transfers, &domain.BlockTransfer{
+				TxHash:      tx.Hash().Hex(),
+				OutputIndex: domain.NativeOutputIndex,
+				From:        from,
+				To:          tx.To().Hex(),
+				Asset:       c.Symbol(),
+				Amount:      new(big.Int).Set(tx.Value()),
+			})
+		}
+
+		// ERC-20 Transfer(from, to, value) logs
+		for _, log := range receipt.Logs {
+			token, ok := tokenByContract[log.Address]
+			if !ok || len(log.Topics) != 3 || log.Topics[0] != transferEventTopic {
+				continue
+			}
+
+			amount := new(big.Int).SetBytes(log.Data)
+			if amount.Sign() <= 0 {
+				continue
+			}
+
+			scanned.Transfers = append(scanned.Transfers, &domain.BlockTransfer{
+				TxHash:      tx.Hash().Hex(),
+				OutputIndex: int(log.Index),
+				From:        common.BytesToAddress(log.Topics[1].Bytes()).Hex(),
+				To:          common.BytesToAddress(log.Topics[2].Bytes()).Hex(),
+				Asset:       token.Symbol,
+				Amount:      amount,
+			})
+		}
+	}
+
+	return scanned, nil
+}
@@ -4,6 +4,7 @@ package ethereum
 import (
 	"crypto-service/internal/domain"
 	"crypto/ecdsa"
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -78,4 +79,12 @@ func importEthereumWallet(privateKeyHex string) (*domain.Wallet, error) {
 		Chain:      "ETHEREUM",
 		CreatedAt:  time.Now(),
 	}, nil
-}
\ No newline at end of file
+}
+
+// ethereumWalletFromKey builds a wallet from a raw 32-byte private key
+func ethereumWalletFromKey(key []byte) (*domain.Wallet, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("invalid private key length: expected 32 bytes, got %d", len(key))
+	}
+	return importEthereumWallet(hex.EncodeToString(key))
+}
@@ -0,0 +1,158 @@
+// internal/chains/tron/scanner.go
+package tron
+
+import (
+	"bytes"
+	"context"
+	"crypto-service/internal/domain"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"google.golang.org/protobuf/proto"
+)
+
+// trc20TransferTopic is keccak256("Transfer(address,address,uint256)")
+var trc20TransferTopic, _ = hex.DecodeString("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+// LatestBlockNumber returns the current block height
+func (t *TronChain) LatestBlockNumber(ctx context.Context) (int64, error) {
+	block, err := t.grpcClient.GetNowBlock()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get now block: %w", err)
+	}
+	if block.BlockHeader == nil || block.BlockHeader.RawData == nil {
+		return 0, fmt.Errorf("now block has no header")
+	}
+	return block.BlockHeader.RawData.Number, nil
+}
+
+// GetBlockHash returns the ID of the block at number
+func (t *TronChain) GetBlockHash(ctx context.Context, number int64) (string, error) {
+	block, err := t.grpcClient.GetBlockByNum(number)
+	if err != nil {
+		return "", fmt.Errorf("failed to get block %d: %w", number, err)
+	}
+	return hex.EncodeToString(block.Blockid), nil
+}
+
+// GetBlock returns the successful TRX transfers and TRC20 Transfer events of
+// the given tokens in block number
+func (t *TronChain) GetBlock(ctx context.Context, number int64, tokens []*domain.Asset) (*domain.ScannedBlock, error) {
+	block, err := t.grpcClient.GetBlockByNum(number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block %d: %w", number, err)
+	}
+	if block.BlockHeader == nil || block.BlockHeader.RawData == nil {
+		return nil, fmt.Errorf("block %d has no header", number)
+	}
+
+	header := block.BlockHeader.RawData
+	scanned := &domain.ScannedBlock{
+		Number:     number,
+		Hash:       hex.EncodeToString(block.Blockid),
+		ParentHash: hex.EncodeToString(header.ParentHash),
+		Timestamp:  time.UnixMilli(header.Timestamp),
+	}
+
+	// Native TRX
+	blockTxs := make(map[string]bool, len(block.Transactions))
+	for _, txExt := range block.Transactions {
+		txID := hex.EncodeToString(txExt.Txid)
+		blockTxs[txID] = true
+
+		tx := txExt.Transaction
+		if tx == nil || tx.RawData == nil || len(tx.RawData.Contract) == 0 {
+			continue
+		}
+		if len(tx.Ret) > 0 && tx.Ret[0].ContractRet != core.Transaction_Result_SUCCESS {
+			continue
+		}
+
+		contract := tx.RawData.Contract[0]
+		if contract.Type != core.Transaction_Contract_TransferContract {
+			continue
+		}
+
+		var transfer core.TransferContract
+		if err := proto.Unmarshal(contract.Parameter.Value, &transfer); err != nil || transfer.Amount <= 0 {
+			continue
+		}
+
+		scanned.Transfers = append(scanned.Transfers, &domain.BlockTransfer{
+			TxHash:      txID,
+			OutputIndex: domain.NativeOutputIndex,
+			From:        address.Address(transfer.OwnerAddress).String(),
+			To:          address.Address(transfer.ToAddress).String(),
+			Asset:       t.Symbol(),
+			Amount:      big.NewInt(transfer.Amount),
+		})
+	}
+
+	if len(tokens) == 0 || len(block.Transactions) == 0 {
+		return scanned, nil
+	}
+
+	// TRC20 Transfer events
+	tokenByContract := make(map[string]*domain.Asset)
+	for _, token := range tokens {
+		if token.ContractAddr != nil {
+			tokenByContract[*token.ContractAddr] = token
+		}
+	}
+
+	infos, err := t.grpcClient.GetBlockInfoByNum(number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction info of block %d: %w", number, err)
+	}
+
+	for _, info := range infos.TransactionInfo {
+		txID := hex.EncodeToString(info.Id)
+		// Infos of a block that replaced this one mid-scan must not leak in
+		if !blockTxs[txID] {
+			return nil, fmt.Errorf("block %d changed while scanning", number)
+		}
+		if info.Receipt == nil || info.Receipt.Result != core.Transaction_Result_SUCCESS {
+			continue
+		}
+
+		for logIndex, log := range info.Log {
+			if len(log.Topics) != 3 || !bytes.Equal(log.Topics[0], trc20TransferTopic) {
+				continue
+			}
+
+			token, ok := tokenByContract[tronAddressFromEVM(log.Address)]
+			if !ok {
+				continue
+			}
+
+			amount := new(big.Int).SetBytes(log.Data)
+			if amount.Sign() <= 0 {
+				continue
+			}
+
+			scanned.Transfers = append(scanned.Transfers, &domain.BlockTransfer{
+				TxHash:      txID,
+				OutputIndex: logIndex,
+				From:        tronAddressFromEVM(log.Topics[1]),
+				To:          tronAddressFromEVM(log.Topics[2]),
+				Asset:       token.Symbol,
+				Amount:      amount,
+			})
+		}
+	}
+
+	return scanned, nil
+}
+
+// tronAddressFromEVM converts a 20-byte (or 32-byte padded) EVM address as
+// found in logs to a Base58 TRON address
+func tronAddressFromEVM(b []byte) string {
+	if len(b) > 20 {
+		b = b[len(b)-20:]
+	}
+	return address.Address(append([]byte{0x41}, b...)).String()
+}
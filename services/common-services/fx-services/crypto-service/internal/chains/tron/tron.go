@@ -105,6 +105,16 @@ func (t *TronChain) GenerateWallet(ctx context.Context) (*domain.Wallet, error)
 	return wallet, nil
 }
 
+// CoinType returns the BIP44 coin type
+func (t *TronChain) CoinType() uint32 {
+	return 195
+}
+
+// WalletFromKey builds a wallet from a derived private key
+func (t *TronChain) WalletFromKey(privateKey []byte) (*domain.Wallet, error) {
+	return tronWalletFromKey(privateKey)
+}
+
 // GetBalance implementation with TRC20 support
 func (t *TronChain) GetBalance(ctx context.Context, address string, walletID string, asset *domain.Asset) (*domain.Balance, error) {
 	// Validate address
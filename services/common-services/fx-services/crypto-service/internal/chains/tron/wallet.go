@@ -55,4 +55,12 @@ func importTronWallet(privateKeyHex string) (*domain.Wallet, error) {
 		Chain:      "TRON",
 		CreatedAt:  time.Now(),
 	}, nil
-}
\ No newline at end of file
+}
+
+// tronWalletFromKey builds a wallet from a raw 32-byte private key
+func tronWalletFromKey(key []byte) (*domain.Wallet, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("invalid private key length: expected 32 bytes, got %d", len(key))
+	}
+	return importTronWallet(hex.EncodeToString(key))
+}
@@ -10,11 +10,13 @@ import (
 )
 
 type Config struct {
-	Security SecurityConfig
-	Tron     TronConfig
-	Bitcoin  BitcoinConfig
-	Ethereum EthereumConfig
-	Circle   CircleConfig
+	Security  SecurityConfig
+	Tron      TronConfig
+	Bitcoin   BitcoinConfig
+	Ethereum  EthereumConfig
+	Circle    CircleConfig
+	Screening ScreeningConfig
+	Scanner   ScannerConfig
 }
 
 type SecurityConfig struct {
@@ -24,6 +26,20 @@ type SecurityConfig struct {
 	VaultToken    string
 	FileVaultDir  string
 	FileVaultKey  string
+	WalletKeyMode string // "legacy", "migrate" (HD for new wallets), "hd"
+}
+
+type ScreeningConfig struct {
+	ListsDir        string // Directory sanction list files are imported from; empty disables file imports
+	ProviderName    string
+	ProviderURL     string // External screening API; empty disables it
+	ProviderAPIKey  string
+	ProviderTimeout int64  // Seconds
+	ComplianceEmail string // Recipient of frozen-deposit alerts
+}
+
+type ScannerConfig struct {
+	StartBlocks map[string]int64 // Chain -> first block scanned when the chain has no checkpoint
 }
 
 type TronConfig struct {
@@ -34,9 +50,10 @@ type TronConfig struct {
 }
 
 type BitcoinConfig struct {
-	RPCURL  string
-	APIKey  string
-	Network string // mainnet, testnet, regtest
+	RPCURL      string
+	APIKey      string
+	Network     string // mainnet, testnet, regtest
+	AddressType string // Address type of new wallets: p2wpkh, p2tr, p2pkh
 }
 
 type EthereumConfig struct {
@@ -162,6 +179,18 @@ func Load(logger *zap.Logger) (*Config, error) {
 		circleEnabled = false
 	}
 
+	// ============================================================================
+	// Deposit Scanner Configuration
+	// ============================================================================
+	// Block each chain's first scan starts at (DEPOSIT_SCAN_START_BLOCK_<CHAIN>);
+	// a chain without a checkpoint or a start block is not scanned
+	scanStartBlocks := make(map[string]int64)
+	for _, chain := range []string{"TRON", "BITCOIN", "ETHEREUM"} {
+		if start := getEnvAsInt64("DEPOSIT_SCAN_START_BLOCK_"+chain, 0); start > 0 {
+			scanStartBlocks[chain] = start
+		}
+	}
+
 	// ============================================================================
 	// Security Configuration
 	// ============================================================================
@@ -173,9 +202,10 @@ func Load(logger *zap.Logger) (*Config, error) {
 			GRPCUrl: tronGRPCUrl,
 		},
 		Bitcoin: BitcoinConfig{
-			RPCURL:  btcRPCURL,
-			APIKey:  getEnv("BTC_API_KEY", ""),
-			Network: btcNetwork,
+			RPCURL:      btcRPCURL,
+			APIKey:      getEnv("BTC_API_KEY", ""),
+			Network:     btcNetwork,
+			AddressType: getEnv("BTC_ADDRESS_TYPE", "p2wpkh"),
 		},
 		Ethereum: EthereumConfig{
 			Enabled:     ethEnabled,
@@ -192,6 +222,18 @@ func Load(logger *zap.Logger) (*Config, error) {
 			VaultToken:    os.Getenv("VAULT_TOKEN"),
 			FileVaultDir:  getEnv("FILE_VAULT_DIR", "./vault"),
 			FileVaultKey:  os.Getenv("FILE_VAULT_KEY"),
+			WalletKeyMode: getEnv("WALLET_KEY_MODE", "legacy"),
+		},
+		Scanner: ScannerConfig{
+			StartBlocks: scanStartBlocks,
+		},
+		Screening: ScreeningConfig{
+			ListsDir:        getEnv("SCREENING_LISTS_DIR", ""),
+			ProviderName:    getEnv("SCREENING_PROVIDER_NAME", "http"),
+			ProviderURL:     getEnv("SCREENING_PROVIDER_URL", ""),
+			ProviderAPIKey:  os.Getenv("SCREENING_PROVIDER_API_KEY"),
+			ProviderTimeout: getEnvAsInt64("SCREENING_PROVIDER_TIMEOUT", 10),
+			ComplianceEmail: getEnv("COMPLIANCE_NOTIFY_EMAIL", ""),
 		},
 		Circle: CircleConfig{
 			Enabled:            circleEnabled,
@@ -0,0 +1,46 @@
+// internal/domain/block_scanner.go
+package domain
+
+import (
+	"context"
+	"math/big"
+	"time"
+)
+
+// NativeOutputIndex is the output index of the native value of an account
+// based transaction (ETH, TRX). Token transfers use their log index and
+// Bitcoin outputs their vout, so a transaction never reuses an index.
+const NativeOutputIndex = -1
+
+// BlockScanner is implemented by chains whose blocks can be walked for
+// deposits instead of polling every wallet's history
+type BlockScanner interface {
+	// LatestBlockNumber returns the height of the chain tip
+	LatestBlockNumber(ctx context.Context) (int64, error)
+
+	// GetBlockHash returns the hash of the block at number on the current chain
+	GetBlockHash(ctx context.Context, number int64) (string, error)
+
+	// GetBlock returns the block at number with its successful incoming
+	// transfers: native value plus Transfer logs of the given token assets
+	GetBlock(ctx context.Context, number int64, tokens []*Asset) (*ScannedBlock, error)
+}
+
+// ScannedBlock is a block reduced to what deposit detection needs
+type ScannedBlock struct {
+	Number     int64
+	Hash       string
+	ParentHash string
+	Timestamp  time.Time
+	Transfers  []*BlockTransfer
+}
+
+// BlockTransfer is one value transfer found in a block
+type BlockTransfer struct {
+	TxHash      string
+	OutputIndex int    // vout, log index or NativeOutputIndex
+	From        string // empty when unknown (e.g. Bitcoin inputs)
+	To          string
+	Asset       string // TRX, USDT, BTC, ETH, USDC
+	Amount      *big.Int
+}
@@ -37,6 +37,29 @@ type Chain interface {
 	ValidateAddress(address string) error
 }
 
+// HDChain is implemented by chains whose wallets can be derived from an
+// extended key (BIP32/BIP44) instead of generated from a random key
+type HDChain interface {
+	// CoinType returns the BIP44 coin type (0 BTC, 1 testnets, 60 ETH, 195 TRX)
+	CoinType() uint32
+	
+	// WalletFromKey builds a wallet from a raw 32-byte secp256k1 private key
+	WalletFromKey(privateKey []byte) (*Wallet, error)
+}
+
+// HDPurposeChain is implemented by HD chains with several address types,
+// each derived under its own BIP43 purpose (Bitcoin: 44' P2PKH, 84' P2WPKH,
+// 86' Taproot). Other HD chains derive under 44'.
+type HDPurposeChain interface {
+	HDChain
+
+	// Purpose returns the purpose new wallets are derived under
+	Purpose() uint32
+
+	// WalletFromPurposeKey builds the wallet for a key derived under purpose
+	WalletFromPurposeKey(privateKey []byte, purpose uint32) (*Wallet, error)
+}
+
 // Wallet represents a blockchain wallet
 type Wallet struct {
 	Address    string
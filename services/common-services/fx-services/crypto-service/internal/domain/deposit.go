@@ -15,6 +15,8 @@ const (
 	DepositStatusConfirmed DepositStatus = "confirmed"
 	DepositStatusCredited  DepositStatus = "credited"
 	DepositStatusFailed    DepositStatus = "failed"
+	DepositStatusOrphaned  DepositStatus = "orphaned" // Block left the chain in a reorg
+	DepositStatusFrozen    DepositStatus = "frozen"   // Source address matched a blacklist
 )
 
 // CryptoDeposit represents an incoming deposit
@@ -33,7 +35,9 @@ type CryptoDeposit struct {
 	
 	// Blockchain
 	TxHash                 string
+	OutputIndex            int     // vout, log index or NativeOutputIndex
 	BlockNumber            int64
+	BlockHash              *string
 	BlockTimestamp         *time.Time
 	Confirmations          int
 	RequiredConfirmations  int
@@ -42,6 +46,13 @@ type CryptoDeposit struct {
 	Status                 DepositStatus
 	TransactionID          *int64
 	
+	// Screening (set when the deposit is frozen)
+	RiskFactors            []RiskFactor
+	FrozenAt               *time.Time
+	ReviewedBy             *string
+	ReviewedAt             *time.Time
+	ReviewNotes            *string
+	
 	// Notifications
 	UserNotified           bool
 	NotifiedAt             *time.Time
@@ -0,0 +1,82 @@
+// internal/domain/key_rotation.go
+package domain
+
+import "time"
+
+// MasterKeyVersion is the KEK version of the service master key; legacy (v1)
+// wallet keys are encrypted with it directly
+const MasterKeyVersion = 1
+
+// EncryptionKeyStatus is the lifecycle state of a key-encryption key (KEK)
+type EncryptionKeyStatus string
+
+const (
+	// EncryptionKeyActive wraps the data keys of new wallets; one KEK at a time
+	EncryptionKeyActive EncryptionKeyStatus = "active"
+	// EncryptionKeyDecryptOnly still unwraps data keys that were not re-wrapped yet
+	EncryptionKeyDecryptOnly EncryptionKeyStatus = "decrypt_only"
+	// EncryptionKeyRetired no longer wraps any data key
+	EncryptionKeyRetired EncryptionKeyStatus = "retired"
+)
+
+// EncryptionKey is a KEK version known to the service. The key itself stays
+// in the vault; the fingerprint detects a key that changed under a version.
+type EncryptionKey struct {
+	Version     int
+	Status      EncryptionKeyStatus
+	Fingerprint string
+	ActivatedAt *time.Time
+	RetiredAt   *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// KeyRotationStatus is the state of a master-key rotation
+type KeyRotationStatus string
+
+const (
+	KeyRotationRunning            KeyRotationStatus = "running"             // Re-wrapping data keys in batches
+	KeyRotationVerifying          KeyRotationStatus = "verifying"           // Decrypting a sample of wallets
+	KeyRotationCompleted          KeyRotationStatus = "completed"           // Every key re-wrapped and the sample verified
+	KeyRotationFailed             KeyRotationStatus = "failed"              // Some keys could not be re-wrapped
+	KeyRotationVerificationFailed KeyRotationStatus = "verification_failed" // A sampled wallet did not decrypt to its address
+)
+
+// KeyRotation re-wraps every stored wallet key under a new KEK version.
+// Progress is committed with each batch so a rotation resumes after a crash
+// from LastWalletID.
+type KeyRotation struct {
+	ID                   int64
+	RotationID           string
+	FromVersion          int
+	ToVersion            int
+	Status               KeyRotationStatus
+	TotalWallets         int64
+	ProcessedWallets     int64
+	FailedWallets        int64
+	LastWalletID         int64 // Cursor: wallets up to this ID are done
+	BatchSize            int
+	VerifiedSample       int
+	VerificationFailures int
+	LastError            *string
+	StartedBy            string
+	StartedAt            time.Time
+	CompletedAt          *time.Time
+	UpdatedAt            time.Time
+}
+
+// IsFinished reports whether the rotation stopped, successfully or not
+func (r *KeyRotation) IsFinished() bool {
+	return r.Status != KeyRotationRunning && r.Status != KeyRotationVerifying
+}
+
+// RewrappedWallet is a wallet key sealed under the rotation's KEK. The update
+// only applies while the wallet still holds the key it was read with.
+type RewrappedWallet struct {
+	WalletID       int64
+	OldVersion     string
+	OldKEKVersion  *int
+	EncryptedKey   string
+	WrappedDataKey string
+	KEKVersion     int
+}
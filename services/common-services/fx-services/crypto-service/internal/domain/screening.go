@@ -0,0 +1,115 @@
+// internal/domain/screening.go
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AddressListType is the kind of an address list entry
+type AddressListType string
+
+const (
+	AddressListBlacklist AddressListType = "blacklist"
+	AddressListWhitelist AddressListType = "whitelist"
+)
+
+// AnyChain marks a list entry that applies on every chain
+const AnyChain = "ANY"
+
+// ManualListName is the list name of entries added one by one by admins;
+// imported sanction lists carry their own name (e.g. "ofac_sdn")
+const ManualListName = "manual"
+
+// AddressListEntry is one address on the internal blacklist or whitelist
+type AddressListEntry struct {
+	ID        int64
+	ListType  AddressListType
+	Chain     string // Chain name or AnyChain
+	Address   string
+	ListName  string // ManualListName or the imported list's name
+	Category  string // sanctions, scam, hack, mixer, exchange, ...
+	Reason    string
+	IsActive  bool
+	AddedBy   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ScreeningDirection tells which side of a transfer an address was on
+type ScreeningDirection string
+
+const (
+	ScreeningDirectionWithdrawal ScreeningDirection = "withdrawal" // Destination of a withdrawal
+	ScreeningDirectionDeposit    ScreeningDirection = "deposit"    // Source of a deposit
+	ScreeningDirectionManual     ScreeningDirection = "manual"     // Ad-hoc check by an admin
+)
+
+// ScreeningStatus is the outcome of screening an address
+type ScreeningStatus string
+
+const (
+	ScreeningStatusClear       ScreeningStatus = "clear"
+	ScreeningStatusHit         ScreeningStatus = "hit"
+	ScreeningStatusWhitelisted ScreeningStatus = "whitelisted"
+	ScreeningStatusUnavailable ScreeningStatus = "unavailable" // A provider failed; nothing is known
+)
+
+// ScreeningResult is the outcome of screening one address. Source is where the
+// match came from: "internal:<list name>" or "provider:<name>".
+type ScreeningResult struct {
+	Chain    string
+	Address  string
+	Status   ScreeningStatus
+	Source   string
+	Category string
+	Reason   string
+}
+
+// IsHit reports whether the address matched a blacklist
+func (r *ScreeningResult) IsHit() bool {
+	return r.Status == ScreeningStatusHit
+}
+
+// RiskFactor turns the result into the risk factor recorded on a withdrawal
+// approval or frozen deposit; nil when there is nothing to record
+func (r *ScreeningResult) RiskFactor() *RiskFactor {
+	switch r.Status {
+	case ScreeningStatusHit:
+		description := fmt.Sprintf("Address %s matched %s", r.Address, r.Source)
+		if r.Category != "" {
+			description += " (" + r.Category + ")"
+		}
+		if r.Reason != "" {
+			description += ": " + r.Reason
+		}
+		return &RiskFactor{
+			Factor:      "blacklisted_address",
+			Description: description,
+			Score:       100,
+		}
+	case ScreeningStatusUnavailable:
+		return &RiskFactor{
+			Factor:      "screening_unavailable",
+			Description: fmt.Sprintf("Address screening failed: %s", r.Reason),
+			Score:       31,
+		}
+	default:
+		return nil
+	}
+}
+
+// NormalizeAddress returns the form addresses are compared in. Hex (0x) and
+// bech32 addresses are case-insensitive; Base58 addresses (TRON, legacy
+// Bitcoin) are case-sensitive and kept as is.
+func NormalizeAddress(address string) string {
+	address = strings.TrimSpace(address)
+	lower := strings.ToLower(address)
+	for _, prefix := range []string{"0x", "bc1", "tb1", "bcrt1"} {
+		if strings.HasPrefix(lower, prefix) {
+			return lower
+		}
+	}
+	return address
+}
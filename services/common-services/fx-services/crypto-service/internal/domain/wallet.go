@@ -16,8 +16,11 @@ type CryptoWallet struct {
 	// Credentials
 	Address              string
 	PublicKey            *string
-	EncryptedPrivateKey  string
-	EncryptionVersion    string
+	EncryptedPrivateKey  string  // Empty for derived wallets
+	EncryptionVersion    string  // v1 (master key), v2 (envelope) or hd
+	WrappedDataKey       *string // v2: the wallet's data key, encrypted with the KEK
+	KEKVersion           *int    // v2: version of the KEK that wraps the data key
+	DerivationPath       *string // BIP44 path for derived (HD) wallets
 	
 	// Metadata
 	Label                *string
@@ -37,6 +40,34 @@ type CryptoWallet struct {
 	UpdatedAt            time.Time
 }
 
+// IsDerived reports whether the wallet's key is derived from the chain's
+// extended key rather than stored encrypted
+func (w *CryptoWallet) IsDerived() bool {
+	return w.DerivationPath != nil && *w.DerivationPath != ""
+}
+
+// Encryption versions of a wallet's stored key
+const (
+	// EncryptionVersionMasterKey is a key encrypted directly with the master key (KEK 1)
+	EncryptionVersionMasterKey = "v1"
+	// EncryptionVersionEnvelope is a key encrypted with its own data key, which a versioned KEK wraps
+	EncryptionVersionEnvelope = "v2"
+	// EncryptionVersionHD is a derived wallet; no key is stored
+	EncryptionVersionHD = "hd"
+)
+
+// WalletKeyMode selects how new user deposit wallets get their keys
+type WalletKeyMode string
+
+const (
+	// WalletKeyModeLegacy generates a random key per wallet and stores it encrypted
+	WalletKeyModeLegacy WalletKeyMode = "legacy"
+	// WalletKeyModeMigrate derives new wallets; existing legacy wallets keep signing with their encrypted keys
+	WalletKeyModeMigrate WalletKeyMode = "migrate"
+	// WalletKeyModeHD derives new wallets and refuses legacy user wallet keys (once they are drained)
+	WalletKeyModeHD WalletKeyMode = "hd"
+)
+
 // DecryptedWallet contains decrypted credentials for transactions
 type DecryptedWallet struct {
 	*CryptoWallet
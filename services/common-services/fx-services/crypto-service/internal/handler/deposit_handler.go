@@ -160,6 +160,100 @@ func (h *DepositHandler) GetPendingDeposits(
 	}, nil
 }
 
+// ============================================================================
+// FROZEN DEPOSITS (Admin)
+// ============================================================================
+
+// GetFrozenDeposits lists deposits frozen by address screening
+func (h *DepositHandler) GetFrozenDeposits(
+	ctx context.Context,
+	req *pb.GetFrozenDepositsRequest,
+) (*pb.GetFrozenDepositsResponse, error) {
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 50
+	}
+
+	deposits, err := h.depositUsecase.GetFrozenDeposits(ctx, limit)
+	if err != nil {
+		h.logger.Error("Failed to get frozen deposits", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get frozen deposits: %v", err)
+	}
+
+	pbDeposits := make([]*pb.Deposit, len(deposits))
+	for i, deposit := range deposits {
+		pbDeposits[i] = depositToProto(deposit)
+	}
+
+	return &pb.GetFrozenDepositsResponse{
+		Deposits: pbDeposits,
+		Total:    int32(len(pbDeposits)),
+	}, nil
+}
+
+// ReleaseFrozenDeposit releases a frozen deposit after review and credits it
+func (h *DepositHandler) ReleaseFrozenDeposit(
+	ctx context.Context,
+	req *pb.ReleaseFrozenDepositRequest,
+) (*pb.ReleaseFrozenDepositResponse, error) {
+
+	if req.DepositId == "" {
+		return nil, status.Error(codes.InvalidArgument, "deposit_id is required")
+	}
+	if req.ReviewedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "reviewed_by is required")
+	}
+
+	deposit, err := h.depositUsecase.ReleaseFrozenDeposit(ctx, req.DepositId, req.ReviewedBy, req.Notes)
+	if err != nil {
+		h.logger.Error("Failed to release frozen deposit",
+			zap.String("deposit_id", req.DepositId),
+			zap.Error(err))
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to release deposit: %v", err)
+	}
+
+	message := "Deposit released and credited"
+	if deposit.Status != domain.DepositStatusCredited {
+		message = "Deposit released, it will be credited once confirmed"
+	}
+
+	return &pb.ReleaseFrozenDepositResponse{
+		Success: true,
+		Message: message,
+		Deposit: depositToProto(deposit),
+	}, nil
+}
+
+// RejectFrozenDeposit rejects a frozen deposit after review
+func (h *DepositHandler) RejectFrozenDeposit(
+	ctx context.Context,
+	req *pb.RejectFrozenDepositRequest,
+) (*pb.RejectFrozenDepositResponse, error) {
+
+	if req.DepositId == "" {
+		return nil, status.Error(codes.InvalidArgument, "deposit_id is required")
+	}
+	if req.ReviewedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "reviewed_by is required")
+	}
+	if req.RejectionReason == "" {
+		return nil, status.Error(codes.InvalidArgument, "rejection_reason is required")
+	}
+
+	if err := h.depositUsecase.RejectFrozenDeposit(ctx, req.DepositId, req.ReviewedBy, req.RejectionReason); err != nil {
+		h.logger.Error("Failed to reject frozen deposit",
+			zap.String("deposit_id", req.DepositId),
+			zap.Error(err))
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to reject deposit: %v", err)
+	}
+
+	return &pb.RejectFrozenDepositResponse{
+		Success: true,
+		Message: "Deposit rejected",
+	}, nil
+}
+
 // ============================================================================
 // HELPER FUNCTIONS
 // ============================================================================
@@ -201,6 +295,31 @@ func depositToProto(deposit *domain.CryptoDeposit) *pb.Deposit {
 		pbDeposit.CreditedAt = timestamppb.New(*deposit.CreditedAt)
 	}
 
+	// Screening
+	for _, factor := range deposit.RiskFactors {
+		pbDeposit.RiskFactors = append(pbDeposit.RiskFactors, &pb.RiskFactor{
+			Factor:      factor.Factor,
+			Description: factor.Description,
+			Score:       int32(factor.Score),
+		})
+	}
+
+	if deposit.FrozenAt != nil {
+		pbDeposit.FrozenAt = timestamppb.New(*deposit.FrozenAt)
+	}
+
+	if deposit.ReviewedBy != nil {
+		pbDeposit.ReviewedBy = *deposit.ReviewedBy
+	}
+
+	if deposit.ReviewNotes != nil {
+		pbDeposit.ReviewNotes = *deposit.ReviewNotes
+	}
+
+	if deposit.ReviewedAt != nil {
+		pbDeposit.ReviewedAt = timestamppb.New(*deposit.ReviewedAt)
+	}
+
 	return pbDeposit
 }
 
@@ -217,6 +336,10 @@ func depositStatusToProto(status domain.DepositStatus) pb.DepositStatus {
 		return pb.DepositStatus_DEPOSIT_STATUS_CREDITED
 	case domain.DepositStatusFailed:
 		return pb.DepositStatus_DEPOSIT_STATUS_FAILED
+	case domain.DepositStatusOrphaned:
+		return pb.DepositStatus_DEPOSIT_STATUS_ORPHANED
+	case domain.DepositStatusFrozen:
+		return pb.DepositStatus_DEPOSIT_STATUS_FROZEN
 	default:
 		return pb.DepositStatus_DEPOSIT_STATUS_UNSPECIFIED
 	}
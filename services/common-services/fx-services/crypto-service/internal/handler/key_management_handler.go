@@ -0,0 +1,164 @@
+// internal/handler/key_management_handler.go
+package handler
+
+import (
+	"context"
+	"crypto-service/internal/domain"
+	"crypto-service/internal/repository"
+	"crypto-service/internal/usecase"
+	"errors"
+
+	pb "x/shared/genproto/shared/accounting/cryptopb"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type KeyManagementHandler struct {
+	pb.UnimplementedKeyManagementServiceServer
+	keyRotationUsecase *usecase.KeyRotationUsecase
+	logger             *zap.Logger
+}
+
+func NewKeyManagementHandler(
+	keyRotationUsecase *usecase.KeyRotationUsecase,
+	logger *zap.Logger,
+) *KeyManagementHandler {
+	return &KeyManagementHandler{
+		keyRotationUsecase: keyRotationUsecase,
+		logger:             logger,
+	}
+}
+
+// RotateMasterKey starts a master-key rotation to a new KEK version
+func (h *KeyManagementHandler) RotateMasterKey(
+	ctx context.Context,
+	req *pb.RotateMasterKeyRequest,
+) (*pb.RotateMasterKeyResponse, error) {
+
+	if req.ToVersion <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "to_version is required")
+	}
+	if req.BatchSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "batch_size cannot be negative")
+	}
+	if req.StartedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "started_by is required")
+	}
+
+	rotation, err := h.keyRotationUsecase.RotateMasterKey(ctx, int(req.ToVersion), req.StartedBy, int(req.BatchSize))
+	if err != nil {
+		h.logger.Error("Failed to start key rotation",
+			zap.Int32("to_version", req.ToVersion),
+			zap.Error(err))
+		if errors.Is(err, repository.ErrRotationInProgress) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to start key rotation: %v", err)
+	}
+
+	return &pb.RotateMasterKeyResponse{
+		Rotation: keyRotationToProto(rotation),
+	}, nil
+}
+
+// GetKeyRotationStatus returns a rotation's progress and the KEK versions
+func (h *KeyManagementHandler) GetKeyRotationStatus(
+	ctx context.Context,
+	req *pb.GetKeyRotationStatusRequest,
+) (*pb.GetKeyRotationStatusResponse, error) {
+
+	report, err := h.keyRotationUsecase.GetKeyRotationStatus(ctx, req.RotationId)
+	if err != nil {
+		h.logger.Error("Failed to get key rotation status", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "failed to get key rotation status: %v", err)
+	}
+
+	pbKeys := make([]*pb.EncryptionKey, len(report.Keys))
+	for i, key := range report.Keys {
+		pbKeys[i] = &pb.EncryptionKey{
+			Version:     int32(key.Version),
+			Status:      encryptionKeyStatusToProto(key.Status),
+			Fingerprint: key.Fingerprint,
+			WalletCount: report.WalletsByKEK[key.Version],
+		}
+		if key.ActivatedAt != nil {
+			pbKeys[i].ActivatedAt = timestamppb.New(*key.ActivatedAt)
+		}
+		if key.RetiredAt != nil {
+			pbKeys[i].RetiredAt = timestamppb.New(*key.RetiredAt)
+		}
+	}
+
+	resp := &pb.GetKeyRotationStatusResponse{
+		Keys:          pbKeys,
+		ActiveVersion: int32(report.ActiveVersion),
+	}
+	if report.Rotation != nil {
+		resp.Rotation = keyRotationToProto(report.Rotation)
+	}
+
+	return resp, nil
+}
+
+// ============================================================================
+// HELPER FUNCTIONS
+// ============================================================================
+
+func keyRotationToProto(rotation *domain.KeyRotation) *pb.KeyRotation {
+	pbRotation := &pb.KeyRotation{
+		RotationId:           rotation.RotationID,
+		FromVersion:          int32(rotation.FromVersion),
+		ToVersion:            int32(rotation.ToVersion),
+		Status:               keyRotationStatusToProto(rotation.Status),
+		TotalWallets:         rotation.TotalWallets,
+		ProcessedWallets:     rotation.ProcessedWallets,
+		FailedWallets:        rotation.FailedWallets,
+		LastWalletId:         rotation.LastWalletID,
+		BatchSize:            int32(rotation.BatchSize),
+		VerifiedSample:       int32(rotation.VerifiedSample),
+		VerificationFailures: int32(rotation.VerificationFailures),
+		StartedBy:            rotation.StartedBy,
+		StartedAt:            timestamppb.New(rotation.StartedAt),
+		UpdatedAt:            timestamppb.New(rotation.UpdatedAt),
+	}
+	if rotation.LastError != nil {
+		pbRotation.LastError = *rotation.LastError
+	}
+	if rotation.CompletedAt != nil {
+		pbRotation.CompletedAt = timestamppb.New(*rotation.CompletedAt)
+	}
+	return pbRotation
+}
+
+func keyRotationStatusToProto(s domain.KeyRotationStatus) pb.KeyRotationStatus {
+	switch s {
+	case domain.KeyRotationRunning:
+		return pb.KeyRotationStatus_KEY_ROTATION_STATUS_RUNNING
+	case domain.KeyRotationVerifying:
+		return pb.KeyRotationStatus_KEY_ROTATION_STATUS_VERIFYING
+	case domain.KeyRotationCompleted:
+		return pb.KeyRotationStatus_KEY_ROTATION_STATUS_COMPLETED
+	case domain.KeyRotationFailed:
+		return pb.KeyRotationStatus_KEY_ROTATION_STATUS_FAILED
+	case domain.KeyRotationVerificationFailed:
+		return pb.KeyRotationStatus_KEY_ROTATION_STATUS_VERIFICATION_FAILED
+	default:
+		return pb.KeyRotationStatus_KEY_ROTATION_STATUS_UNSPECIFIED
+	}
+}
+
+func encryptionKeyStatusToProto(s domain.EncryptionKeyStatus) pb.EncryptionKeyStatus {
+	switch s {
+	case domain.EncryptionKeyActive:
+		return pb.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_ACTIVE
+	case domain.EncryptionKeyDecryptOnly:
+		return pb.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_DECRYPT_ONLY
+	case domain.EncryptionKeyRetired:
+		return pb.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_RETIRED
+	default:
+		return pb.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_UNSPECIFIED
+	}
+}
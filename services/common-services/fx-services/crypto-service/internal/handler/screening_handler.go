@@ -0,0 +1,258 @@
+// internal/handler/screening_handler.go
+package handler
+
+import (
+	"context"
+	"crypto-service/internal/domain"
+	"crypto-service/internal/repository"
+	"crypto-service/internal/screening"
+	"crypto-service/internal/usecase"
+
+	pb "x/shared/genproto/shared/accounting/cryptopb"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ScreeningHandler struct {
+	pb.UnimplementedScreeningServiceServer
+	screeningUsecase *usecase.ScreeningUsecase
+	logger           *zap.Logger
+}
+
+func NewScreeningHandler(
+	screeningUsecase *usecase.ScreeningUsecase,
+	logger *zap.Logger,
+) *ScreeningHandler {
+	return &ScreeningHandler{
+		screeningUsecase: screeningUsecase,
+		logger:           logger,
+	}
+}
+
+// AddAddressListEntry adds an address to the blacklist or whitelist
+func (h *ScreeningHandler) AddAddressListEntry(
+	ctx context.Context,
+	req *pb.AddAddressListEntryRequest,
+) (*pb.AddAddressListEntryResponse, error) {
+
+	if req.ListType == pb.AddressListType_ADDRESS_LIST_TYPE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "list_type is required")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+	if req.AddedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "added_by is required")
+	}
+
+	entry := &domain.AddressListEntry{
+		ListType: addressListTypeFromProto(req.ListType),
+		Chain:    req.Chain,
+		Address:  req.Address,
+		ListName: domain.ManualListName,
+		Category: req.Category,
+		Reason:   req.Reason,
+		AddedBy:  req.AddedBy,
+	}
+
+	if err := h.screeningUsecase.AddEntry(ctx, entry); err != nil {
+		h.logger.Error("Failed to add address list entry", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "failed to add entry: %v", err)
+	}
+
+	return &pb.AddAddressListEntryResponse{
+		Entry: addressListEntryToProto(entry),
+	}, nil
+}
+
+// RemoveAddressListEntry takes an address off its list
+func (h *ScreeningHandler) RemoveAddressListEntry(
+	ctx context.Context,
+	req *pb.RemoveAddressListEntryRequest,
+) (*pb.RemoveAddressListEntryResponse, error) {
+
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+	if req.RemovedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "removed_by is required")
+	}
+
+	if err := h.screeningUsecase.RemoveEntry(ctx, req.Id, req.RemovedBy); err != nil {
+		h.logger.Error("Failed to remove address list entry", zap.Int64("id", req.Id), zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "failed to remove entry: %v", err)
+	}
+
+	return &pb.RemoveAddressListEntryResponse{
+		Success: true,
+		Message: "Entry removed",
+	}, nil
+}
+
+// ListAddressListEntries lists blacklist / whitelist entries
+func (h *ScreeningHandler) ListAddressListEntries(
+	ctx context.Context,
+	req *pb.ListAddressListEntriesRequest,
+) (*pb.ListAddressListEntriesResponse, error) {
+
+	limit, page := 50, 1
+	if req.Pagination != nil {
+		if req.Pagination.PageSize > 0 {
+			limit = int(req.Pagination.PageSize)
+		}
+		if req.Pagination.Page > 0 {
+			page = int(req.Pagination.Page)
+		}
+	}
+
+	filter := repository.AddressListFilter{
+		Chain:      req.Chain,
+		ListName:   req.ListName,
+		Address:    req.Address,
+		ActiveOnly: !req.IncludeInactive,
+	}
+	if req.ListType != pb.AddressListType_ADDRESS_LIST_TYPE_UNSPECIFIED {
+		filter.ListType = addressListTypeFromProto(req.ListType)
+	}
+
+	entries, total, err := h.screeningUsecase.ListEntries(ctx, filter, limit, (page-1)*limit)
+	if err != nil {
+		h.logger.Error("Failed to list address list entries", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list entries: %v", err)
+	}
+
+	pbEntries := make([]*pb.AddressListEntry, len(entries))
+	for i, entry := range entries {
+		pbEntries[i] = addressListEntryToProto(entry)
+	}
+
+	return &pb.ListAddressListEntriesResponse{
+		Entries: pbEntries,
+		Pagination: &pb.PaginationResponse{
+			Page:       int32(page),
+			PageSize:   int32(limit),
+			Total:      total,
+			TotalPages: int32((total + int64(limit) - 1) / int64(limit)),
+		},
+	}, nil
+}
+
+// ImportScreeningList imports (replaces) a sanction list
+func (h *ScreeningHandler) ImportScreeningList(
+	ctx context.Context,
+	req *pb.ImportScreeningListRequest,
+) (*pb.ImportScreeningListResponse, error) {
+
+	if req.ListName == "" {
+		return nil, status.Error(codes.InvalidArgument, "list_name is required")
+	}
+	if req.FilePath == "" && len(req.Content) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "file_path or content is required")
+	}
+	if req.ImportedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "imported_by is required")
+	}
+
+	importReq := &usecase.ImportListRequest{
+		ListName:   req.ListName,
+		Format:     screening.ListFormat(req.Format),
+		FilePath:   req.FilePath,
+		Content:    req.Content,
+		Chain:      req.Chain,
+		Category:   req.Category,
+		ImportedBy: req.ImportedBy,
+	}
+	if req.ListType != pb.AddressListType_ADDRESS_LIST_TYPE_UNSPECIFIED {
+		importReq.ListType = addressListTypeFromProto(req.ListType)
+	}
+
+	result, err := h.screeningUsecase.ImportList(ctx, importReq)
+	if err != nil {
+		h.logger.Error("Failed to import screening list",
+			zap.String("list_name", req.ListName),
+			zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "failed to import list: %v", err)
+	}
+
+	return &pb.ImportScreeningListResponse{
+		ListName: result.ListName,
+		Rows:     int32(result.Rows),
+		Imported: int32(result.Imported),
+		Rejected: int32(result.Rejected),
+		Errors:   result.Errors,
+	}, nil
+}
+
+// ScreenAddress screens an address on demand
+func (h *ScreeningHandler) ScreenAddress(
+	ctx context.Context,
+	req *pb.ScreenAddressRequest,
+) (*pb.ScreenAddressResponse, error) {
+
+	if req.Chain == "" || req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "chain and address are required")
+	}
+
+	result, err := h.screeningUsecase.ScreenAddress(ctx, req.Chain, req.Address, req.RequestedBy)
+	if err != nil {
+		h.logger.Error("Failed to screen address", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to screen address: %v", err)
+	}
+
+	return &pb.ScreenAddressResponse{
+		Status:   screeningStatusToProto(result.Status),
+		Source:   result.Source,
+		Category: result.Category,
+		Reason:   result.Reason,
+	}, nil
+}
+
+// ============================================================================
+// HELPER FUNCTIONS
+// ============================================================================
+
+func addressListEntryToProto(entry *domain.AddressListEntry) *pb.AddressListEntry {
+	listType := pb.AddressListType_ADDRESS_LIST_TYPE_BLACKLIST
+	if entry.ListType == domain.AddressListWhitelist {
+		listType = pb.AddressListType_ADDRESS_LIST_TYPE_WHITELIST
+	}
+
+	return &pb.AddressListEntry{
+		Id:        entry.ID,
+		ListType:  listType,
+		Chain:     entry.Chain,
+		Address:   entry.Address,
+		ListName:  entry.ListName,
+		Category:  entry.Category,
+		Reason:    entry.Reason,
+		IsActive:  entry.IsActive,
+		AddedBy:   entry.AddedBy,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+		UpdatedAt: timestamppb.New(entry.UpdatedAt),
+	}
+}
+
+func addressListTypeFromProto(listType pb.AddressListType) domain.AddressListType {
+	if listType == pb.AddressListType_ADDRESS_LIST_TYPE_WHITELIST {
+		return domain.AddressListWhitelist
+	}
+	return domain.AddressListBlacklist
+}
+
+func screeningStatusToProto(s domain.ScreeningStatus) pb.ScreeningStatus {
+	switch s {
+	case domain.ScreeningStatusClear:
+		return pb.ScreeningStatus_SCREENING_STATUS_CLEAR
+	case domain.ScreeningStatusHit:
+		return pb.ScreeningStatus_SCREENING_STATUS_HIT
+	case domain.ScreeningStatusWhitelisted:
+		return pb.ScreeningStatus_SCREENING_STATUS_WHITELISTED
+	case domain.ScreeningStatusUnavailable:
+		return pb.ScreeningStatus_SCREENING_STATUS_UNAVAILABLE
+	default:
+		return pb.ScreeningStatus_SCREENING_STATUS_UNSPECIFIED
+	}
+}
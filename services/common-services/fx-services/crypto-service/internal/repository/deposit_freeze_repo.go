@@ -0,0 +1,161 @@
+// internal/repository/deposit_freeze_repo.go
+package repository
+
+import (
+	"context"
+	"crypto-service/internal/domain"
+	"encoding/json"
+	"fmt"
+)
+
+// ============================================================================
+// FROZEN DEPOSITS - deposits held back by address screening
+// ============================================================================
+
+// FreezeDeposit parks a deposit that has not been credited yet as frozen,
+// recording why. Confirmation progress is kept so a released deposit doesn't
+// wait again.
+func (r *CryptoDepositRepository) FreezeDeposit(
+	ctx context.Context,
+	deposit *domain.CryptoDeposit,
+	factors []domain.RiskFactor,
+) error {
+	riskFactorsJSON, err := json.Marshal(factors)
+	if err != nil {
+		return fmt.Errorf("failed to marshal risk factors: %w", err)
+	}
+
+	query := `
+		UPDATE crypto_deposits
+		SET
+			status = $1,
+			from_address = $2,
+			confirmations = $3,
+			block_timestamp = $4,
+			risk_factors = $5,
+			frozen_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $6 AND status IN ($7, $8)
+		RETURNING frozen_at
+	`
+
+	err = r.pool.QueryRow(
+		ctx, query,
+		domain.DepositStatusFrozen,
+		deposit.FromAddress,
+		deposit.Confirmations,
+		deposit.BlockTimestamp,
+		riskFactorsJSON,
+		deposit.ID,
+		domain.DepositStatusDetected,
+		domain.DepositStatusPending,
+	).Scan(&deposit.FrozenAt)
+	if err != nil {
+		return fmt.Errorf("failed to freeze deposit: %w", err)
+	}
+
+	deposit.Status = domain.DepositStatusFrozen
+	deposit.RiskFactors = factors
+
+	return nil
+}
+
+// ReviewFrozenDeposit records an admin's decision on a frozen deposit and
+// moves it to status: pending to be credited, failed when rejected. Only a
+// deposit that is still frozen is changed, so two reviewers can't both act.
+func (r *CryptoDepositRepository) ReviewFrozenDeposit(
+	ctx context.Context,
+	id int64,
+	status domain.DepositStatus,
+	reviewedBy, notes string,
+) error {
+	query := `
+		UPDATE crypto_deposits
+		SET
+			status = $1,
+			reviewed_by = $2,
+			review_notes = $3,
+			reviewed_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $4 AND status = $5
+	`
+
+	result, err := r.pool.Exec(ctx, query, status, reviewedBy, nullableString(notes), id, domain.DepositStatusFrozen)
+	if err != nil {
+		return fmt.Errorf("failed to review frozen deposit: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("deposit is not frozen")
+	}
+
+	return nil
+}
+
+// GetFrozenDepositsToNotify returns frozen deposits compliance has not been
+// notified of yet, oldest first
+func (r *CryptoDepositRepository) GetFrozenDepositsToNotify(ctx context.Context, limit int) ([]*domain.CryptoDeposit, error) {
+	query := `
+		SELECT 
+			id, deposit_id, wallet_id, user_id,
+			chain, asset, from_address, to_address, amount,
+			tx_hash, output_index, block_number, block_hash, block_timestamp,
+			confirmations, required_confirmations,
+			status, transaction_id,
+			user_notified, notified_at, notification_sent,
+			detected_at, confirmed_at, credited_at,
+			risk_factors, frozen_at, reviewed_by, reviewed_at, review_notes,
+			created_at, updated_at
+		FROM crypto_deposits
+		WHERE status = $1 AND compliance_notified_at IS NULL
+		ORDER BY frozen_at ASC
+		LIMIT $2
+	`
+
+	rows, err := r.pool.Query(ctx, query, domain.DepositStatusFrozen, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query frozen deposits: %w", err)
+	}
+	defer rows.Close()
+
+	var deposits []*domain.CryptoDeposit
+	for rows.Next() {
+		deposit := &domain.CryptoDeposit{}
+		if err := r.scanDeposit(rows, deposit); err != nil {
+			return nil, err
+		}
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits, nil
+}
+
+// MarkComplianceNotified records that compliance was told about a frozen deposit
+func (r *CryptoDepositRepository) MarkComplianceNotified(ctx context.Context, id int64) error {
+	query := `
+		UPDATE crypto_deposits
+		SET compliance_notified_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`
+
+	if _, err := r.pool.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark compliance notified: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateFromAddress fills in a deposit's source address once it is known
+func (r *CryptoDepositRepository) UpdateFromAddress(ctx context.Context, id int64, fromAddress string) error {
+	query := `
+		UPDATE crypto_deposits
+		SET from_address = $1, updated_at = NOW()
+		WHERE id = $2
+	`
+
+	if _, err := r.pool.Exec(ctx, query, fromAddress, id); err != nil {
+		return fmt.Errorf("failed to update from address: %w", err)
+	}
+
+	return nil
+}
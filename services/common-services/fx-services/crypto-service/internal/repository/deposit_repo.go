@@ -3,6 +3,7 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"crypto-service/internal/domain"
@@ -33,17 +34,17 @@ func (r *CryptoDepositRepository) Create(ctx context.Context, deposit *domain.Cr
 		INSERT INTO crypto_deposits (
 			deposit_id, wallet_id, user_id,
 			chain, asset, from_address, to_address, amount,
-			tx_hash, block_number, block_timestamp,
+			tx_hash, output_index, block_number, block_hash, block_timestamp,
 			confirmations, required_confirmations,
 			status, user_notified, notification_sent,
 			detected_at
 		) VALUES (
 			$1, $2, $3,
 			$4, $5, $6, $7, $8,
-			$9, $10, $11,
-			$12, $13,
-			$14, $15, $16,
-			$17
+			$9, $10, $11, $12, $13,
+			$14, $15,
+			$16, $17, $18,
+			$19
 		)
 		RETURNING id, created_at, updated_at
 	`
@@ -70,7 +71,9 @@ func (r *CryptoDepositRepository) Create(ctx context.Context, deposit *domain.Cr
 		deposit.ToAddress,
 		amountStr,
 		deposit.TxHash,
+		deposit.OutputIndex,
 		deposit.BlockNumber,
+		deposit.BlockHash,
 		deposit.BlockTimestamp,
 		deposit.Confirmations,
 		deposit.RequiredConfirmations,
@@ -97,11 +100,12 @@ func (r *CryptoDepositRepository) GetByID(ctx context.Context, id int64) (*domai
 		SELECT 
 			id, deposit_id, wallet_id, user_id,
 			chain, asset, from_address, to_address, amount,
-			tx_hash, block_number, block_timestamp,
+			tx_hash, output_index, block_number, block_hash, block_timestamp,
 			confirmations, required_confirmations,
 			status, transaction_id,
 			user_notified, notified_at, notification_sent,
 			detected_at, confirmed_at, credited_at,
+			risk_factors, frozen_at, reviewed_by, reviewed_at, review_notes,
 			created_at, updated_at
 		FROM crypto_deposits
 		WHERE id = $1
@@ -126,11 +130,12 @@ func (r *CryptoDepositRepository) GetByDepositID(ctx context.Context, depositID
 		SELECT 
 			id, deposit_id, wallet_id, user_id,
 			chain, asset, from_address, to_address, amount,
-			tx_hash, block_number, block_timestamp,
+			tx_hash, output_index, block_number, block_hash, block_timestamp,
 			confirmations, required_confirmations,
 			status, transaction_id,
 			user_notified, notified_at, notification_sent,
 			detected_at, confirmed_at, credited_at,
+			risk_factors, frozen_at, reviewed_by, reviewed_at, review_notes,
 			created_at, updated_at
 		FROM crypto_deposits
 		WHERE deposit_id = $1
@@ -155,11 +160,12 @@ func (r *CryptoDepositRepository) GetByTxHash(ctx context.Context, txHash, toAdd
 		SELECT 
 			id, deposit_id, wallet_id, user_id,
 			chain, asset, from_address, to_address, amount,
-			tx_hash, block_number, block_timestamp,
+			tx_hash, output_index, block_number, block_hash, block_timestamp,
 			confirmations, required_confirmations,
 			status, transaction_id,
 			user_notified, notified_at, notification_sent,
 			detected_at, confirmed_at, credited_at,
+			risk_factors, frozen_at, reviewed_by, reviewed_at, review_notes,
 			created_at, updated_at
 		FROM crypto_deposits
 		WHERE tx_hash = $1 AND to_address = $2
@@ -336,20 +342,23 @@ func (r *CryptoDepositRepository) MarkAsNotified(ctx context.Context, id int64)
 // QUERY OPERATIONS
 // ============================================================================
 
-// GetPendingDeposits retrieves all pending deposits
+// GetPendingDeposits retrieves all pending deposits, except on chains
+// stopped for review
 func (r *CryptoDepositRepository) GetPendingDeposits(ctx context.Context) ([]*domain.CryptoDeposit, error) {
 	query := `
 		SELECT 
 			id, deposit_id, wallet_id, user_id,
 			chain, asset, from_address, to_address, amount,
-			tx_hash, block_number, block_timestamp,
+			tx_hash, output_index, block_number, block_hash, block_timestamp,
 			confirmations, required_confirmations,
 			status, transaction_id,
 			user_notified, notified_at, notification_sent,
 			detected_at, confirmed_at, credited_at,
+			risk_factors, frozen_at, reviewed_by, reviewed_at, review_notes,
 			created_at, updated_at
 		FROM crypto_deposits
 		WHERE status IN ($1, $2)
+		  AND chain NOT IN (SELECT chain FROM blockchain_sync_status WHERE needs_review)
 		ORDER BY detected_at ASC
 	`
 
@@ -385,11 +394,12 @@ func (r *CryptoDepositRepository) GetUserDeposits(
 		SELECT 
 			id, deposit_id, wallet_id, user_id,
 			chain, asset, from_address, to_address, amount,
-			tx_hash, block_number, block_timestamp,
+			tx_hash, output_index, block_number, block_hash, block_timestamp,
 			confirmations, required_confirmations,
 			status, transaction_id,
 			user_notified, notified_at, notification_sent,
 			detected_at, confirmed_at, credited_at,
+			risk_factors, frozen_at, reviewed_by, reviewed_at, review_notes,
 			created_at, updated_at
 		FROM crypto_deposits
 		WHERE user_id = $1
@@ -425,11 +435,12 @@ func (r *CryptoDepositRepository) GetWalletDeposits(ctx context.Context, walletI
 		SELECT 
 			id, deposit_id, wallet_id, user_id,
 			chain, asset, from_address, to_address, amount,
-			tx_hash, block_number, block_timestamp,
+			tx_hash, output_index, block_number, block_hash, block_timestamp,
 			confirmations, required_confirmations,
 			status, transaction_id,
 			user_notified, notified_at, notification_sent,
 			detected_at, confirmed_at, credited_at,
+			risk_factors, frozen_at, reviewed_by, reviewed_at, review_notes,
 			created_at, updated_at
 		FROM crypto_deposits
 		WHERE wallet_id = $1
@@ -464,11 +475,12 @@ func (r *CryptoDepositRepository) GetUnnotifiedDeposits(ctx context.Context) ([]
 		SELECT 
 			id, deposit_id, wallet_id, user_id,
 			chain, asset, from_address, to_address, amount,
-			tx_hash, block_number, block_timestamp,
+			tx_hash, output_index, block_number, block_hash, block_timestamp,
 			confirmations, required_confirmations,
 			status, transaction_id,
 			user_notified, notified_at, notification_sent,
 			detected_at, confirmed_at, credited_at,
+			risk_factors, frozen_at, reviewed_by, reviewed_at, review_notes,
 			created_at, updated_at
 		FROM crypto_deposits
 		WHERE user_notified = false 
@@ -540,11 +552,12 @@ func (r *CryptoDepositRepository) GetDepositsByStatus(
 		SELECT 
 			id, deposit_id, wallet_id, user_id,
 			chain, asset, from_address, to_address, amount,
-			tx_hash, block_number, block_timestamp,
+			tx_hash, output_index, block_number, block_hash, block_timestamp,
 			confirmations, required_confirmations,
 			status, transaction_id,
 			user_notified, notified_at, notification_sent,
 			detected_at, confirmed_at, credited_at,
+			risk_factors, frozen_at, reviewed_by, reviewed_at, review_notes,
 			created_at, updated_at
 		FROM crypto_deposits
 		WHERE status = $1
@@ -665,6 +678,7 @@ func (r *CryptoDepositRepository) GetDepositNotifications(ctx context.Context, l
 // scanDeposit scans a row into CryptoDeposit
 func (r *CryptoDepositRepository) scanDeposit(row pgx.Row, deposit *domain.CryptoDeposit) error {
 	var amountStr string
+	var riskFactorsJSON []byte
 
 	err := row.Scan(
 		&deposit.ID,
@@ -677,7 +691,9 @@ func (r *CryptoDepositRepository) scanDeposit(row pgx.Row, deposit *domain.Crypt
 		&deposit.ToAddress,
 		&amountStr,
 		&deposit.TxHash,
+		&deposit.OutputIndex,
 		&deposit.BlockNumber,
+		&deposit.BlockHash,
 		&deposit.BlockTimestamp,
 		&deposit.Confirmations,
 		&deposit.RequiredConfirmations,
@@ -689,6 +705,11 @@ func (r *CryptoDepositRepository) scanDeposit(row pgx.Row, deposit *domain.Crypt
 		&deposit.DetectedAt,
 		&deposit.ConfirmedAt,
 		&deposit.CreditedAt,
+		&riskFactorsJSON,
+		&deposit.FrozenAt,
+		&deposit.ReviewedBy,
+		&deposit.ReviewedAt,
+		&deposit.ReviewNotes,
 		&deposit.CreatedAt,
 		&deposit.UpdatedAt,
 	)
@@ -703,5 +724,11 @@ func (r *CryptoDepositRepository) scanDeposit(row pgx.Row, deposit *domain.Crypt
 		deposit.Amount = big.NewInt(0)
 	}
 
+	if len(riskFactorsJSON) > 0 {
+		if err := json.Unmarshal(riskFactorsJSON, &deposit.RiskFactors); err != nil {
+			return fmt.Errorf("failed to parse risk factors: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,301 @@
+// internal/repository/deposit_scan_repo.go
+package repository
+
+import (
+	"context"
+	"crypto-service/internal/domain"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ============================================================================
+// BLOCK SCANNING - checkpoints, block hashes and idempotent deposit inserts
+// ============================================================================
+
+// GetSyncCheckpoint returns the last block scanned for chain (0 = never
+// scanned) and whether scanning is stopped for manual review, creating the
+// chain's sync status row if needed
+func (r *CryptoDepositRepository) GetSyncCheckpoint(ctx context.Context, chain string) (lastSynced int64, needsReview bool, err error) {
+	query := `
+		INSERT INTO blockchain_sync_status (chain, last_synced_block)
+		VALUES ($1, 0)
+		ON CONFLICT (chain) DO UPDATE SET chain = EXCLUDED.chain
+		RETURNING last_synced_block, needs_review
+	`
+
+	if err := r.pool.QueryRow(ctx, query, chain).Scan(&lastSynced, &needsReview); err != nil {
+		return 0, false, fmt.Errorf("failed to get sync checkpoint: %w", err)
+	}
+
+	return lastSynced, needsReview, nil
+}
+
+// GetScannedBlockHash returns the stored hash of a scanned block, or "" when
+// the block is not tracked (never scanned or already pruned)
+func (r *CryptoDepositRepository) GetScannedBlockHash(ctx context.Context, chain string, number int64) (string, error) {
+	query := `
+		SELECT block_hash
+		FROM blockchain_blocks
+		WHERE chain = $1 AND block_number = $2
+	`
+
+	var hash string
+	err := r.pool.QueryRow(ctx, query, chain, number).Scan(&hash)
+	if err == pgx.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get block hash: %w", err)
+	}
+
+	return hash, nil
+}
+
+// SaveScannedBlock records a scanned block in one transaction: its deposits
+// (new rows only; a deposit orphaned by a reorg and mined again is revived),
+// its hash for reorg detection, and the chain checkpoint. Returns how many
+// deposits were recorded.
+func (r *CryptoDepositRepository) SaveScannedBlock(
+	ctx context.Context,
+	chain string,
+	block *domain.ScannedBlock,
+	deposits []*domain.CryptoDeposit,
+	tip int64,
+) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	depositQuery := `
+		INSERT INTO crypto_deposits (
+			deposit_id, wallet_id, user_id,
+			chain, asset, from_address, to_address, amount,
+			tx_hash, output_index, block_number, block_hash, block_timestamp,
+			confirmations, required_confirmations,
+			status, detected_at
+		) VALUES (
+			$1, $2, $3,
+			$4, $5, $6, $7, $8,
+			$9, $10, $11, $12, $13,
+			$14, $15,
+			$16, $17
+		)
+		ON CONFLICT (chain, tx_hash, output_index) WHERE tx_hash <> 'pending_lookup'
+		DO UPDATE SET
+			block_number = EXCLUDED.block_number,
+			block_hash = EXCLUDED.block_hash,
+			block_timestamp = EXCLUDED.block_timestamp,
+			confirmations = EXCLUDED.confirmations,
+			status = EXCLUDED.status,
+			updated_at = NOW()
+		WHERE crypto_deposits.status = 'orphaned'
+		RETURNING id, created_at, updated_at
+	`
+
+	recorded := 0
+	for _, deposit := range deposits {
+		if deposit.DepositID == "" {
+			deposit.DepositID = uuid.New().String()
+		}
+
+		err := tx.QueryRow(
+			ctx, depositQuery,
+			deposit.DepositID,
+			deposit.WalletID,
+			deposit.UserID,
+			deposit.Chain,
+			deposit.Asset,
+			deposit.FromAddress,
+			deposit.ToAddress,
+			deposit.Amount.String(),
+			deposit.TxHash,
+			deposit.OutputIndex,
+			deposit.BlockNumber,
+			deposit.BlockHash,
+			deposit.BlockTimestamp,
+			deposit.Confirmations,
+			deposit.RequiredConfirmations,
+			deposit.Status,
+			deposit.DetectedAt,
+		).Scan(&deposit.ID, &deposit.CreatedAt, &deposit.UpdatedAt)
+
+		if err == pgx.ErrNoRows {
+			// Already recorded by an earlier scan
+			deposit.ID = 0
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to record deposit %s:%d: %w", deposit.TxHash, deposit.OutputIndex, err)
+		}
+		recorded++
+	}
+
+	blockQuery := `
+		INSERT INTO blockchain_blocks (
+			chain, block_number, block_hash, parent_hash, block_timestamp, deposits_found
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (chain, block_number) DO UPDATE SET
+			block_hash = EXCLUDED.block_hash,
+			parent_hash = EXCLUDED.parent_hash,
+			block_timestamp = EXCLUDED.block_timestamp,
+			deposits_found = EXCLUDED.deposits_found,
+			scanned_at = NOW()
+	`
+
+	if _, err := tx.Exec(ctx, blockQuery,
+		chain, block.Number, block.Hash, block.ParentHash, block.Timestamp, len(deposits),
+	); err != nil {
+		return 0, fmt.Errorf("failed to save block %d: %w", block.Number, err)
+	}
+
+	// GREATEST keeps a manual rescan of old blocks from rewinding the checkpoint
+	checkpointQuery := `
+		UPDATE blockchain_sync_status
+		SET
+			last_synced_block = GREATEST(last_synced_block, $2),
+			current_block = $3,
+			blocks_behind = GREATEST($3 - GREATEST(last_synced_block, $2), 0),
+			last_sync_at = NOW(),
+			sync_error = NULL,
+			consecutive_errors = 0,
+			updated_at = NOW()
+		WHERE chain = $1
+	`
+
+	if _, err := tx.Exec(ctx, checkpointQuery, chain, block.Number, tip); err != nil {
+		return 0, fmt.Errorf("failed to update sync checkpoint: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit scanned block: %w", err)
+	}
+
+	return recorded, nil
+}
+
+// RollbackToBlock undoes everything scanned above forkPoint after a reorg:
+// deposits not yet confirmed are orphaned, block hashes are dropped and the
+// checkpoint is rewound. Deposits above the fork that were already confirmed
+// or credited keep their status and are marked reorged_at for reversal.
+// Returns the number of orphaned deposits and the deposit IDs marked.
+func (r *CryptoDepositRepository) RollbackToBlock(
+	ctx context.Context,
+	chain string,
+	forkPoint int64,
+) (orphaned int64, settled []string, err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE crypto_deposits
+		SET status = $3, updated_at = NOW()
+		WHERE chain = $1 AND block_number > $2 AND status IN ($4, $5)
+	`, chain, forkPoint, domain.DepositStatusOrphaned, domain.DepositStatusDetected, domain.DepositStatusPending)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to orphan deposits: %w", err)
+	}
+	orphaned = result.RowsAffected()
+
+	rows, err := tx.Query(ctx, `
+		UPDATE crypto_deposits
+		SET reorged_at = COALESCE(reorged_at, NOW()), updated_at = NOW()
+		WHERE chain = $1 AND block_number > $2 AND status IN ($3, $4)
+		RETURNING deposit_id
+	`, chain, forkPoint, domain.DepositStatusConfirmed, domain.DepositStatusCredited)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to mark reorged deposits: %w", err)
+	}
+	for rows.Next() {
+		var depositID string
+		if err := rows.Scan(&depositID); err != nil {
+			rows.Close()
+			return 0, nil, fmt.Errorf("failed to scan reorged deposit: %w", err)
+		}
+		settled = append(settled, depositID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("failed to mark reorged deposits: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM blockchain_blocks
+		WHERE chain = $1 AND block_number > $2
+	`, chain, forkPoint); err != nil {
+		return 0, nil, fmt.Errorf("failed to drop reorged blocks: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE blockchain_sync_status
+		SET last_synced_block = LEAST(last_synced_block, $2), updated_at = NOW()
+		WHERE chain = $1
+	`, chain, forkPoint); err != nil {
+		return 0, nil, fmt.Errorf("failed to rewind sync checkpoint: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, nil, fmt.Errorf("failed to commit rollback: %w", err)
+	}
+
+	return orphaned, settled, nil
+}
+
+// PruneScannedBlocks drops stored block hashes below keepFrom
+func (r *CryptoDepositRepository) PruneScannedBlocks(ctx context.Context, chain string, keepFrom int64) error {
+	query := `
+		DELETE FROM blockchain_blocks
+		WHERE chain = $1 AND block_number < $2
+	`
+
+	if _, err := r.pool.Exec(ctx, query, chain, keepFrom); err != nil {
+		return fmt.Errorf("failed to prune scanned blocks: %w", err)
+	}
+
+	return nil
+}
+
+// FlagChainForReview stops scanning and crediting chain until an operator
+// clears needs_review, e.g. after a reorg deeper than the stored block hashes
+// or one that orphaned settled deposits
+func (r *CryptoDepositRepository) FlagChainForReview(ctx context.Context, chain, reason string) error {
+	query := `
+		UPDATE blockchain_sync_status
+		SET
+			needs_review = true,
+			review_reason = $2,
+			sync_error = $2,
+			updated_at = NOW()
+		WHERE chain = $1
+	`
+
+	if _, err := r.pool.Exec(ctx, query, chain, reason); err != nil {
+		return fmt.Errorf("failed to flag chain for review: %w", err)
+	}
+
+	return nil
+}
+
+// RecordSyncError stores the last scan failure for chain
+func (r *CryptoDepositRepository) RecordSyncError(ctx context.Context, chain string, syncErr error) error {
+	query := `
+		UPDATE blockchain_sync_status
+		SET
+			sync_error = $2,
+			consecutive_errors = consecutive_errors + 1,
+			updated_at = NOW()
+		WHERE chain = $1
+	`
+
+	if _, err := r.pool.Exec(ctx, query, chain, syncErr.Error()); err != nil {
+		return fmt.Errorf("failed to record sync error: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,511 @@
+// internal/repository/key_rotation_repo.go
+package repository
+
+import (
+	"context"
+	"crypto-service/internal/domain"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrRotationInProgress is returned when a rotation is started while another
+// one is still running or verifying
+var ErrRotationInProgress = errors.New("a key rotation is already in progress")
+
+type KeyRotationRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewKeyRotationRepository(pool *pgxpool.Pool) *KeyRotationRepository {
+	return &KeyRotationRepository{pool: pool}
+}
+
+// ============================================================================
+// ENCRYPTION KEYS
+// ============================================================================
+
+// EnsureEncryptionKey registers a KEK version if it is unknown and returns
+// the stored record, whose fingerprint the caller compares to the loaded key
+func (r *KeyRotationRepository) EnsureEncryptionKey(
+	ctx context.Context,
+	version int,
+	status domain.EncryptionKeyStatus,
+	fingerprint string,
+) (*domain.EncryptionKey, error) {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO encryption_keys (version, status, fingerprint, activated_at)
+		VALUES ($1, $2, $3, CASE WHEN $2 = 'active' THEN NOW() END)
+		ON CONFLICT (version) DO NOTHING
+	`, version, string(status), fingerprint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register encryption key: %w", err)
+	}
+
+	return scanEncryptionKey(r.pool.QueryRow(ctx, `
+		SELECT version, status, fingerprint, activated_at, retired_at, created_at, updated_at
+		FROM encryption_keys
+		WHERE version = $1
+	`, version))
+}
+
+// GetEncryptionKeys returns every known KEK version, oldest first
+func (r *KeyRotationRepository) GetEncryptionKeys(ctx context.Context) ([]*domain.EncryptionKey, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT version, status, fingerprint, activated_at, retired_at, created_at, updated_at
+		FROM encryption_keys
+		ORDER BY version
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get encryption keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []*domain.EncryptionKey
+	for rows.Next() {
+		key, err := scanEncryptionKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// RetireEncryptionKey retires a decrypt-only KEK version
+func (r *KeyRotationRepository) RetireEncryptionKey(ctx context.Context, version int) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE encryption_keys
+		SET status = 'retired', retired_at = NOW(), updated_at = NOW()
+		WHERE version = $1 AND status = 'decrypt_only'
+	`, version)
+	if err != nil {
+		return fmt.Errorf("failed to retire encryption key: %w", err)
+	}
+	return nil
+}
+
+// CountWalletsByKEK returns how many stored wallet keys each KEK version
+// protects; master-key (v1) wallets count for version 1
+func (r *KeyRotationRepository) CountWalletsByKEK(ctx context.Context) (map[int]int64, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT
+			CASE WHEN encryption_version = 'v1' THEN 1 ELSE kek_version END AS version,
+			COUNT(*)
+		FROM crypto_wallets
+		WHERE encrypted_private_key <> ''
+		  AND encryption_version IN ('v1', 'v2')
+		GROUP BY 1
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count wallets by kek: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[int]int64)
+	for rows.Next() {
+		var version int
+		var count int64
+		if err := rows.Scan(&version, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan kek count: %w", err)
+		}
+		counts[version] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// ============================================================================
+// ROTATIONS
+// ============================================================================
+
+// StartRotation registers the rotation's KEK, makes it the active version
+// (the previous one becomes decrypt-only) and records the rotation, all in
+// one transaction. Fails with ErrRotationInProgress if another rotation has
+// not finished.
+func (r *KeyRotationRepository) StartRotation(
+	ctx context.Context,
+	rotation *domain.KeyRotation,
+	fingerprint string,
+) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO encryption_keys (version, status, fingerprint)
+		VALUES ($1, 'decrypt_only', $2)
+		ON CONFLICT (version) DO NOTHING
+	`, rotation.ToVersion, fingerprint); err != nil {
+		return fmt.Errorf("failed to register encryption key: %w", err)
+	}
+
+	var storedFingerprint string
+	if err := tx.QueryRow(ctx, `
+		SELECT fingerprint FROM encryption_keys WHERE version = $1 FOR UPDATE
+	`, rotation.ToVersion).Scan(&storedFingerprint); err != nil {
+		return fmt.Errorf("failed to get encryption key: %w", err)
+	}
+	if storedFingerprint != fingerprint {
+		return fmt.Errorf("kek v%d in the vault does not match the registered key", rotation.ToVersion)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE encryption_keys
+		SET status = 'decrypt_only', updated_at = NOW()
+		WHERE status = 'active' AND version <> $1
+	`, rotation.ToVersion); err != nil {
+		return fmt.Errorf("failed to demote active encryption key: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE encryption_keys
+		SET status = 'active', activated_at = COALESCE(activated_at, NOW()),
+		    retired_at = NULL, updated_at = NOW()
+		WHERE version = $1
+	`, rotation.ToVersion); err != nil {
+		return fmt.Errorf("failed to activate encryption key: %w", err)
+	}
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO key_rotations (
+			from_version, to_version, status, total_wallets, batch_size, started_by
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, rotation_id, started_at, updated_at
+	`,
+		rotation.FromVersion,
+		rotation.ToVersion,
+		string(rotation.Status),
+		rotation.TotalWallets,
+		rotation.BatchSize,
+		rotation.StartedBy,
+	).Scan(&rotation.ID, &rotation.RotationID, &rotation.StartedAt, &rotation.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrRotationInProgress
+		}
+		return fmt.Errorf("failed to create key rotation: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit key rotation start: %w", err)
+	}
+
+	return nil
+}
+
+// GetRotation retrieves a rotation by its rotation ID
+func (r *KeyRotationRepository) GetRotation(ctx context.Context, rotationID string) (*domain.KeyRotation, error) {
+	rotation, err := scanKeyRotation(r.pool.QueryRow(ctx, keyRotationSelect+`
+		WHERE rotation_id = $1
+	`, rotationID))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("key rotation not found")
+	}
+	return rotation, err
+}
+
+// GetLatestRotation returns the most recent rotation, or nil if the key was
+// never rotated
+func (r *KeyRotationRepository) GetLatestRotation(ctx context.Context) (*domain.KeyRotation, error) {
+	rotation, err := scanKeyRotation(r.pool.QueryRow(ctx, keyRotationSelect+`
+		ORDER BY started_at DESC, id DESC
+		LIMIT 1
+	`))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return rotation, err
+}
+
+// GetInProgressRotation returns the rotation that is running or verifying,
+// or nil
+func (r *KeyRotationRepository) GetInProgressRotation(ctx context.Context) (*domain.KeyRotation, error) {
+	rotation, err := scanKeyRotation(r.pool.QueryRow(ctx, keyRotationSelect+`
+		WHERE status IN ('running', 'verifying')
+		LIMIT 1
+	`))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return rotation, err
+}
+
+// CountWalletsToRewrap counts stored wallet keys after afterID that are not
+// wrapped by KEK toVersion yet
+func (r *KeyRotationRepository) CountWalletsToRewrap(ctx context.Context, toVersion int, afterID int64) (int64, error) {
+	var count int64
+	err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM crypto_wallets
+		WHERE id > $2
+		  AND encrypted_private_key <> ''
+		  AND (encryption_version = 'v1' OR (encryption_version = 'v2' AND kek_version <> $1))
+	`, toVersion, afterID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count wallets to rewrap: %w", err)
+	}
+	return count, nil
+}
+
+// GetWalletsToRewrap returns the next batch of wallets after afterID whose
+// key is not wrapped by KEK toVersion yet, in ID order
+func (r *KeyRotationRepository) GetWalletsToRewrap(
+	ctx context.Context,
+	toVersion int,
+	afterID int64,
+	limit int,
+) ([]*domain.CryptoWallet, error) {
+	query := `
+		SELECT
+			id, user_id, chain, asset, address, public_key,
+			encrypted_private_key, encryption_version, wrapped_data_key, kek_version, derivation_path, label,
+			is_primary, is_active, balance, last_balance_update,
+			last_deposit_check, last_transaction_block,
+			created_at, updated_at
+		FROM crypto_wallets
+		WHERE id > $2
+		  AND encrypted_private_key <> ''
+		  AND (encryption_version = 'v1' OR (encryption_version = 'v2' AND kek_version <> $1))
+		ORDER BY id
+		LIMIT $3
+	`
+
+	return r.queryWallets(ctx, query, toVersion, afterID, limit)
+}
+
+// GetVerificationSample returns up to limit random wallets with a stored key
+func (r *KeyRotationRepository) GetVerificationSample(ctx context.Context, limit int) ([]*domain.CryptoWallet, error) {
+	query := `
+		SELECT
+			id, user_id, chain, asset, address, public_key,
+			encrypted_private_key, encryption_version, wrapped_data_key, kek_version, derivation_path, label,
+			is_primary, is_active, balance, last_balance_update,
+			last_deposit_check, last_transaction_block,
+			created_at, updated_at
+		FROM crypto_wallets
+		WHERE encrypted_private_key <> ''
+		ORDER BY random()
+		LIMIT $1
+	`
+
+	return r.queryWallets(ctx, query, limit)
+}
+
+// SaveRewrapBatch stores a batch of re-wrapped wallet keys and advances the
+// rotation's cursor in one transaction, so a crash never loses or repeats
+// progress. A wallet whose key changed since it was read is left alone.
+// Returns the number of wallets updated.
+func (r *KeyRotationRepository) SaveRewrapBatch(
+	ctx context.Context,
+	rotationID int64,
+	wallets []*domain.RewrappedWallet,
+	failed int,
+	lastWalletID int64,
+	lastError *string,
+) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	updated := 0
+	if len(wallets) > 0 {
+		query := `
+			UPDATE crypto_wallets
+			SET encrypted_private_key = $2,
+			    encryption_version = 'v2',
+			    wrapped_data_key = $3,
+			    kek_version = $4,
+			    updated_at = NOW()
+			WHERE id = $1
+			  AND encryption_version = $5
+			  AND kek_version IS NOT DISTINCT FROM $6
+		`
+
+		batch := &pgx.Batch{}
+		for _, wallet := range wallets {
+			batch.Queue(query,
+				wallet.WalletID,
+				wallet.EncryptedKey,
+				wallet.WrappedDataKey,
+				wallet.KEKVersion,
+				wallet.OldVersion,
+				wallet.OldKEKVersion,
+			)
+		}
+
+		results := tx.SendBatch(ctx, batch)
+		for _, wallet := range wallets {
+			tag, err := results.Exec()
+			if err != nil {
+				results.Close()
+				return 0, fmt.Errorf("failed to rewrap wallet %d: %w", wallet.WalletID, err)
+			}
+			updated += int(tag.RowsAffected())
+		}
+		if err := results.Close(); err != nil {
+			return 0, fmt.Errorf("failed to rewrap wallets: %w", err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE key_rotations
+		SET processed_wallets = processed_wallets + $2,
+		    failed_wallets = failed_wallets + $3,
+		    last_wallet_id = GREATEST(last_wallet_id, $4),
+		    last_error = COALESCE($5, last_error),
+		    updated_at = NOW()
+		WHERE id = $1
+	`, rotationID, len(wallets), failed, lastWalletID, lastError); err != nil {
+		return 0, fmt.Errorf("failed to update key rotation progress: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit rewrap batch: %w", err)
+	}
+
+	return updated, nil
+}
+
+// UpdateRotationStatus moves a rotation to status; finished statuses set
+// completed_at
+func (r *KeyRotationRepository) UpdateRotationStatus(
+	ctx context.Context,
+	id int64,
+	status domain.KeyRotationStatus,
+	lastError *string,
+) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE key_rotations
+		SET status = $2,
+		    last_error = COALESCE($3, last_error),
+		    completed_at = CASE WHEN $2 IN ('running', 'verifying') THEN NULL ELSE NOW() END,
+		    updated_at = NOW()
+		WHERE id = $1
+	`, id, string(status), lastError)
+	if err != nil {
+		return fmt.Errorf("failed to update key rotation status: %w", err)
+	}
+	return nil
+}
+
+// SaveVerification records the verification pass and the rotation's final
+// status
+func (r *KeyRotationRepository) SaveVerification(
+	ctx context.Context,
+	id int64,
+	status domain.KeyRotationStatus,
+	sample, failures int,
+	lastError *string,
+) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE key_rotations
+		SET status = $2,
+		    verified_sample = $3,
+		    verification_failures = $4,
+		    last_error = COALESCE($5, last_error),
+		    completed_at = NOW(),
+		    updated_at = NOW()
+		WHERE id = $1
+	`, id, string(status), sample, failures, lastError)
+	if err != nil {
+		return fmt.Errorf("failed to save key rotation verification: %w", err)
+	}
+	return nil
+}
+
+// ============================================================================
+// HELPER FUNCTIONS
+// ============================================================================
+
+const keyRotationSelect = `
+	SELECT
+		id, rotation_id, from_version, to_version, status,
+		total_wallets, processed_wallets, failed_wallets, last_wallet_id, batch_size,
+		verified_sample, verification_failures, last_error,
+		started_by, started_at, completed_at, updated_at
+	FROM key_rotations
+`
+
+func (r *KeyRotationRepository) queryWallets(ctx context.Context, query string, args ...interface{}) ([]*domain.CryptoWallet, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query wallets: %w", err)
+	}
+	defer rows.Close()
+
+	var wallets []*domain.CryptoWallet
+	for rows.Next() {
+		wallet, err := scanWallet(rows)
+		if err != nil {
+			return nil, err
+		}
+		wallets = append(wallets, wallet)
+	}
+
+	return wallets, rows.Err()
+}
+
+func scanKeyRotation(scanner Scanner) (*domain.KeyRotation, error) {
+	rotation := &domain.KeyRotation{}
+	var status string
+
+	err := scanner.Scan(
+		&rotation.ID,
+		&rotation.RotationID,
+		&rotation.FromVersion,
+		&rotation.ToVersion,
+		&status,
+		&rotation.TotalWallets,
+		&rotation.ProcessedWallets,
+		&rotation.FailedWallets,
+		&rotation.LastWalletID,
+		&rotation.BatchSize,
+		&rotation.VerifiedSample,
+		&rotation.VerificationFailures,
+		&rotation.LastError,
+		&rotation.StartedBy,
+		&rotation.StartedAt,
+		&rotation.CompletedAt,
+		&rotation.UpdatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan key rotation: %w", err)
+	}
+
+	rotation.Status = domain.KeyRotationStatus(status)
+	return rotation, nil
+}
+
+func scanEncryptionKey(scanner Scanner) (*domain.EncryptionKey, error) {
+	key := &domain.EncryptionKey{}
+	var status string
+
+	err := scanner.Scan(
+		&key.Version,
+		&status,
+		&key.Fingerprint,
+		&key.ActivatedAt,
+		&key.RetiredAt,
+		&key.CreatedAt,
+		&key.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan encryption key: %w", err)
+	}
+
+	key.Status = domain.EncryptionKeyStatus(status)
+	return key, nil
+}
@@ -0,0 +1,326 @@
+// internal/repository/screening_repo.go
+package repository
+
+import (
+	"context"
+	"crypto-service/internal/domain"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AddressScreeningRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewAddressScreeningRepository(pool *pgxpool.Pool) *AddressScreeningRepository {
+	return &AddressScreeningRepository{pool: pool}
+}
+
+// AddressListFilter narrows ListEntries; empty fields match everything
+type AddressListFilter struct {
+	ListType   domain.AddressListType
+	Chain      string
+	ListName   string
+	Address    string
+	ActiveOnly bool
+}
+
+// ============================================================================
+// ADDRESS LISTS
+// ============================================================================
+
+// AddEntry adds an address to a list. Adding an address that is already on
+// the same list updates it and re-activates it.
+func (r *AddressScreeningRepository) AddEntry(ctx context.Context, entry *domain.AddressListEntry) error {
+	query := `
+		INSERT INTO address_lists (
+			list_type, chain, address, address_key,
+			list_name, category, reason, is_active, added_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, true, $8)
+		ON CONFLICT (list_type, chain, address_key, list_name) DO UPDATE SET
+			address = EXCLUDED.address,
+			category = EXCLUDED.category,
+			reason = EXCLUDED.reason,
+			is_active = true,
+			added_by = EXCLUDED.added_by,
+			updated_at = NOW()
+		RETURNING id, is_active, created_at, updated_at
+	`
+
+	err := r.pool.QueryRow(
+		ctx, query,
+		entry.ListType,
+		entry.Chain,
+		entry.Address,
+		domain.NormalizeAddress(entry.Address),
+		entry.ListName,
+		nullableString(entry.Category),
+		nullableString(entry.Reason),
+		entry.AddedBy,
+	).Scan(&entry.ID, &entry.IsActive, &entry.CreatedAt, &entry.UpdatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to add address list entry: %w", err)
+	}
+
+	return nil
+}
+
+// DeactivateEntry takes an entry off its list; the row is kept for the record
+func (r *AddressScreeningRepository) DeactivateEntry(ctx context.Context, id int64) error {
+	query := `
+		UPDATE address_lists
+		SET is_active = false, updated_at = NOW()
+		WHERE id = $1 AND is_active = true
+	`
+
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to remove address list entry: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("address list entry not found")
+	}
+
+	return nil
+}
+
+// ListEntries returns list entries matching filter, newest first, and the
+// total number of matches
+func (r *AddressScreeningRepository) ListEntries(
+	ctx context.Context,
+	filter AddressListFilter,
+	limit, offset int,
+) ([]*domain.AddressListEntry, int64, error) {
+	var conditions []string
+	var args []interface{}
+
+	if filter.ListType != "" {
+		args = append(args, filter.ListType)
+		conditions = append(conditions, fmt.Sprintf("list_type = $%d", len(args)))
+	}
+	if filter.Chain != "" {
+		args = append(args, filter.Chain)
+		conditions = append(conditions, fmt.Sprintf("chain = $%d", len(args)))
+	}
+	if filter.ListName != "" {
+		args = append(args, filter.ListName)
+		conditions = append(conditions, fmt.Sprintf("list_name = $%d", len(args)))
+	}
+	if filter.Address != "" {
+		args = append(args, domain.NormalizeAddress(filter.Address))
+		conditions = append(conditions, fmt.Sprintf("address_key = $%d", len(args)))
+	}
+	if filter.ActiveOnly {
+		conditions = append(conditions, "is_active = true")
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, "SELECT COUNT(*) FROM address_lists "+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count address list entries: %w", err)
+	}
+
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT
+			id, list_type, chain, address, list_name,
+			COALESCE(category, ''), COALESCE(reason, ''),
+			is_active, added_by, created_at, updated_at
+		FROM address_lists
+		%s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)-1, len(args))
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query address list entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries, err := scanAddressListEntries(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
+
+// FindActiveEntries returns the active blacklist and whitelist entries for an
+// address on chain, including entries that apply to every chain
+func (r *AddressScreeningRepository) FindActiveEntries(
+	ctx context.Context,
+	chain, address string,
+) ([]*domain.AddressListEntry, error) {
+	query := `
+		SELECT
+			id, list_type, chain, address, list_name,
+			COALESCE(category, ''), COALESCE(reason, ''),
+			is_active, added_by, created_at, updated_at
+		FROM address_lists
+		WHERE address_key = $1
+		  AND chain IN ($2, $3)
+		  AND is_active = true
+		ORDER BY id
+	`
+
+	rows, err := r.pool.Query(ctx, query, domain.NormalizeAddress(address), chain, domain.AnyChain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query address lists: %w", err)
+	}
+	defer rows.Close()
+
+	return scanAddressListEntries(rows)
+}
+
+// ReplaceList swaps the entire content of an imported list for entries in
+// one transaction, so screening never sees a half-imported list. Duplicate
+// entries are stored once. Returns how many entries the list now holds.
+func (r *AddressScreeningRepository) ReplaceList(
+	ctx context.Context,
+	listType domain.AddressListType,
+	listName string,
+	entries []*domain.AddressListEntry,
+	addedBy string,
+) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM address_lists
+		WHERE list_type = $1 AND list_name = $2
+	`, listType, listName); err != nil {
+		return 0, fmt.Errorf("failed to clear list %s: %w", listName, err)
+	}
+
+	query := `
+		INSERT INTO address_lists (
+			list_type, chain, address, address_key,
+			list_name, category, reason, is_active, added_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, true, $8)
+		ON CONFLICT (list_type, chain, address_key, list_name) DO NOTHING
+	`
+
+	batch := &pgx.Batch{}
+	for _, entry := range entries {
+		batch.Queue(query,
+			listType,
+			entry.Chain,
+			entry.Address,
+			domain.NormalizeAddress(entry.Address),
+			listName,
+			nullableString(entry.Category),
+			nullableString(entry.Reason),
+			addedBy,
+		)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	stored := 0
+	for i := range entries {
+		tag, err := results.Exec()
+		if err != nil {
+			results.Close()
+			return 0, fmt.Errorf("failed to import %s: %w", entries[i].Address, err)
+		}
+		stored += int(tag.RowsAffected())
+	}
+	if err := results.Close(); err != nil {
+		return 0, fmt.Errorf("failed to import list %s: %w", listName, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit list import: %w", err)
+	}
+
+	return stored, nil
+}
+
+// ============================================================================
+// SCREENING LOG
+// ============================================================================
+
+// RecordScreening appends a screening outcome to the audit log
+func (r *AddressScreeningRepository) RecordScreening(
+	ctx context.Context,
+	direction domain.ScreeningDirection,
+	reference string,
+	result *domain.ScreeningResult,
+) error {
+	query := `
+		INSERT INTO address_screenings (
+			direction, chain, address, reference,
+			result, source, category, reason
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.pool.Exec(
+		ctx, query,
+		direction,
+		result.Chain,
+		result.Address,
+		nullableString(reference),
+		result.Status,
+		nullableString(result.Source),
+		nullableString(result.Category),
+		nullableString(result.Reason),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record screening: %w", err)
+	}
+
+	return nil
+}
+
+// ============================================================================
+// HELPER FUNCTIONS
+// ============================================================================
+
+func scanAddressListEntries(rows pgx.Rows) ([]*domain.AddressListEntry, error) {
+	var entries []*domain.AddressListEntry
+	for rows.Next() {
+		entry := &domain.AddressListEntry{}
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.ListType,
+			&entry.Chain,
+			&entry.Address,
+			&entry.ListName,
+			&entry.Category,
+			&entry.Reason,
+			&entry.IsActive,
+			&entry.AddedBy,
+			&entry.CreatedAt,
+			&entry.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan address list entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating address list entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// nullableString stores empty strings as NULL
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -32,9 +32,9 @@ func (r *CryptoWalletRepository) Create(ctx context.Context, wallet *domain.Cryp
 	query := `
 		INSERT INTO crypto_wallets (
 			user_id, chain, asset, address, public_key, 
-			encrypted_private_key, encryption_version, label, 
+			encrypted_private_key, encryption_version, wrapped_data_key, kek_version, derivation_path, label, 
 			is_primary, is_active, balance
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 		RETURNING id, created_at, updated_at
 	`
 	
@@ -52,6 +52,9 @@ func (r *CryptoWalletRepository) Create(ctx context.Context, wallet *domain.Cryp
 		wallet.PublicKey,
 		wallet. EncryptedPrivateKey,
 		wallet.EncryptionVersion,
+		wallet.WrappedDataKey,
+		wallet.KEKVersion,
+		wallet.DerivationPath,
 		wallet.Label,
 		wallet.IsPrimary,
 		wallet.IsActive,
@@ -74,7 +77,7 @@ func (r *CryptoWalletRepository) GetByID(ctx context.Context, id int64) (*domain
 	query := `
 		SELECT 
 			id, user_id, chain, asset, address, public_key,
-			encrypted_private_key, encryption_version, label,
+			encrypted_private_key, encryption_version, wrapped_data_key, kek_version, derivation_path, label,
 			is_primary, is_active, balance, last_balance_update,
 			last_deposit_check, last_transaction_block,
 			created_at, updated_at
@@ -94,6 +97,9 @@ func (r *CryptoWalletRepository) GetByID(ctx context.Context, id int64) (*domain
 		&wallet.PublicKey,
 		&wallet.EncryptedPrivateKey,
 		&wallet. EncryptionVersion,
+		&wallet.WrappedDataKey,
+		&wallet.KEKVersion,
+		&wallet.DerivationPath,
 		&wallet.Label,
 		&wallet.IsPrimary,
 		&wallet.IsActive,
@@ -134,7 +140,7 @@ func (r *CryptoWalletRepository) GetByAddress(ctx context.Context, address strin
 	query := `
 		SELECT 
 			id, user_id, chain, asset, address, public_key,
-			encrypted_private_key, encryption_version, label,
+			encrypted_private_key, encryption_version, wrapped_data_key, kek_version, derivation_path, label,
 			is_primary, is_active, balance, last_balance_update,
 			last_deposit_check, last_transaction_block,
 			created_at, updated_at
@@ -154,6 +160,9 @@ func (r *CryptoWalletRepository) GetByAddress(ctx context.Context, address strin
 		&wallet.PublicKey,
 		&wallet. EncryptedPrivateKey,
 		&wallet.EncryptionVersion,
+		&wallet.WrappedDataKey,
+		&wallet.KEKVersion,
+		&wallet.DerivationPath,
 		&wallet.Label,
 		&wallet.IsPrimary,
 		&wallet. IsActive,
@@ -258,7 +267,7 @@ func (r *CryptoWalletRepository) GetUserWallets(ctx context.Context, userID stri
 	query := `
 		SELECT 
 			id, user_id, chain, asset, address, public_key,
-			encrypted_private_key, encryption_version, label,
+			encrypted_private_key, encryption_version, wrapped_data_key, kek_version, derivation_path, label,
 			is_primary, is_active, balance, last_balance_update,
 			last_deposit_check, last_transaction_block,
 			created_at, updated_at
@@ -297,7 +306,7 @@ func (r *CryptoWalletRepository) GetUserWalletByChainAsset(
 	query := `
 		SELECT 
 			id, user_id, chain, asset, address, public_key,
-			encrypted_private_key, encryption_version, label,
+			encrypted_private_key, encryption_version, wrapped_data_key, kek_version, derivation_path, label,
 			is_primary, is_active, balance, last_balance_update,
 			last_deposit_check, last_transaction_block,
 			created_at, updated_at
@@ -322,6 +331,9 @@ func (r *CryptoWalletRepository) GetUserWalletByChainAsset(
 		&wallet.PublicKey,
 		&wallet.EncryptedPrivateKey,
 		&wallet.EncryptionVersion,
+		&wallet.WrappedDataKey,
+		&wallet.KEKVersion,
+		&wallet.DerivationPath,
 		&wallet.Label,
 		&wallet.IsPrimary,
 		&wallet.IsActive,
@@ -365,7 +377,7 @@ func (r *CryptoWalletRepository) GetUserPrimaryWallet(
 	query := `
 		SELECT 
 			id, user_id, chain, asset, address, public_key,
-			encrypted_private_key, encryption_version, label,
+			encrypted_private_key, encryption_version, wrapped_data_key, kek_version, derivation_path, label,
 			is_primary, is_active, balance, last_balance_update,
 			last_deposit_check, last_transaction_block,
 			created_at, updated_at
@@ -389,6 +401,9 @@ func (r *CryptoWalletRepository) GetUserPrimaryWallet(
 		&wallet.PublicKey,
 		&wallet.EncryptedPrivateKey,
 		&wallet.EncryptionVersion,
+		&wallet.WrappedDataKey,
+		&wallet.KEKVersion,
+		&wallet.DerivationPath,
 		&wallet.Label,
 		&wallet.IsPrimary,
 		&wallet.IsActive,
@@ -500,7 +515,7 @@ func (r *CryptoWalletRepository) GetWalletsForDepositCheck(ctx context.Context,
 	query := `
 		SELECT 
 			id, user_id, chain, asset, address, public_key,
-			encrypted_private_key, encryption_version, label,
+			encrypted_private_key, encryption_version, wrapped_data_key, kek_version, derivation_path, label,
 			is_primary, is_active, balance, last_balance_update,
 			last_deposit_check, last_transaction_block,
 			created_at, updated_at
@@ -574,7 +589,7 @@ func (r *CryptoWalletRepository) GetWalletsByChain(ctx context.Context, chain st
 	query := `
 		SELECT 
 			id, user_id, chain, asset, address, public_key,
-			encrypted_private_key, encryption_version, label,
+			encrypted_private_key, encryption_version, wrapped_data_key, kek_version, derivation_path, label,
 			is_primary, is_active, balance, last_balance_update,
 			last_deposit_check, last_transaction_block,
 			created_at, updated_at
@@ -606,7 +621,7 @@ func (r *CryptoWalletRepository) GetActiveWallets(ctx context.Context) ([]*domai
 	query := `
 		SELECT 
 			id, user_id, chain, asset, address, public_key,
-			encrypted_private_key, encryption_version, label,
+			encrypted_private_key, encryption_version, wrapped_data_key, kek_version, derivation_path, label,
 			is_primary, is_active, balance, last_balance_update,
 			last_deposit_check, last_transaction_block,
 			created_at, updated_at
@@ -647,7 +662,7 @@ func (r *CryptoWalletRepository) GetWalletsWithBalance(
 	query := `
 		SELECT 
 			id, user_id, chain, asset, address, public_key,
-			encrypted_private_key, encryption_version, label,
+			encrypted_private_key, encryption_version, wrapped_data_key, kek_version, derivation_path, label,
 			is_primary, is_active, balance, last_balance_update,
 			last_deposit_check, last_transaction_block,
 			created_at, updated_at
@@ -691,7 +706,7 @@ func (r *CryptoWalletRepository) GetWalletsByChainAsset(
 	query := `
 		SELECT 
 			id, user_id, chain, asset, address, public_key,
-			encrypted_private_key, encryption_version, label,
+			encrypted_private_key, encryption_version, wrapped_data_key, kek_version, derivation_path, label,
 			is_primary, is_active, balance, last_balance_update,
 			last_deposit_check, last_transaction_block,
 			created_at, updated_at
@@ -746,6 +761,69 @@ func (r *CryptoWalletRepository) CountWalletsWithBalance(
 	return count, nil
 }
 
+// ============================================================================
+// HD DERIVATION
+// ============================================================================
+
+// AllocateDerivationIndex hands out the next BIP44 address of the user's
+// account on chain, creating the account (next account index of the chain)
+// on first use. Indexes are never reused; a wallet that fails to save just
+// leaves a gap.
+func (r *CryptoWalletRepository) AllocateDerivationIndex(
+	ctx context.Context,
+	userID, chain string,
+) (account uint32, index uint32, err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	nextAddress := `
+		UPDATE hd_wallet_accounts
+		SET next_address_index = next_address_index + 1, updated_at = NOW()
+		WHERE chain = $1 AND user_id = $2
+		RETURNING account_index, next_address_index - 1
+	`
+
+	var accountIndex, addressIndex int64
+	err = tx.QueryRow(ctx, nextAddress, chain, userID).Scan(&accountIndex, &addressIndex)
+	if err == pgx.ErrNoRows {
+		// First derived wallet of this user on chain: open an account
+		err = tx.QueryRow(ctx, `
+			INSERT INTO hd_chain_counters (chain, next_account_index)
+			VALUES ($1, 1)
+			ON CONFLICT (chain) DO UPDATE
+			SET next_account_index = hd_chain_counters.next_account_index + 1, updated_at = NOW()
+			RETURNING next_account_index - 1
+		`, chain).Scan(&accountIndex)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to allocate HD account: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO hd_wallet_accounts (chain, user_id, account_index)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (chain, user_id) DO NOTHING
+		`, chain, userID, accountIndex)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to create HD account: %w", err)
+		}
+
+		// A concurrent request may have opened the account first; use whichever won
+		err = tx.QueryRow(ctx, nextAddress, chain, userID).Scan(&accountIndex, &addressIndex)
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to allocate HD address index: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, 0, fmt.Errorf("failed to commit HD index allocation: %w", err)
+	}
+
+	return uint32(accountIndex), uint32(addressIndex), nil
+}
+
 // ============================================================================
 // HELPER FUNCTIONS
 // ============================================================================
@@ -776,6 +854,9 @@ func scanWallet(scanner Scanner) (*domain.CryptoWallet, error) {
 		&wallet.PublicKey,
 		&wallet.EncryptedPrivateKey,
 		&wallet.EncryptionVersion,
+		&wallet.WrappedDataKey,
+		&wallet.KEKVersion,
+		&wallet.DerivationPath,
 		&wallet.Label,
 		&wallet.IsPrimary,
 		&wallet.IsActive,
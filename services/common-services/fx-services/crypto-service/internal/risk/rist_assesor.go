@@ -5,6 +5,7 @@ import (
     "context"
     "crypto-service/internal/repository"
     "crypto-service/internal/domain"
+    "crypto-service/internal/screening"
     "math/big"
     "time"
     
@@ -14,17 +15,20 @@ import (
 type RiskAssessor struct {
     transactionRepo *repository.CryptoTransactionRepository
     walletRepo      *repository.CryptoWalletRepository
+    screener        *screening.Screener
     logger          *zap.Logger
 }
 
 func NewRiskAssessor(
     transactionRepo *repository.CryptoTransactionRepository,
     walletRepo      *repository.CryptoWalletRepository,
+    screener        *screening.Screener,
     logger *zap.Logger,
 ) *RiskAssessor {
     return &RiskAssessor{
         transactionRepo: transactionRepo,
         walletRepo:      walletRepo,
+        screener:        screener,
         logger:          logger,
     }
 }
@@ -76,9 +80,10 @@ func (r *RiskAssessor) AssessWithdrawal(
     }
     
     //  5. Check if address is on blacklist (instant high risk)
-    if riskFactor := r.assessBlacklistRisk(ctx, toAddress); riskFactor != nil {
-        assessment.RiskFactors = append(assessment.RiskFactors, *riskFactor)
-        assessment.RiskScore += riskFactor.Score
+    blacklistFactor := r.assessBlacklistRisk(ctx, userID, chain, toAddress)
+    if blacklistFactor != nil {
+        assessment.RiskFactors = append(assessment.RiskFactors, *blacklistFactor)
+        assessment.RiskScore += blacklistFactor.Score
     }
     
     //  Determine if approval is required
@@ -86,7 +91,10 @@ func (r *RiskAssessor) AssessWithdrawal(
     // Risk score 31-60: Review recommended
     // Risk score 61+: Approval required
     
-    if assessment.RiskScore >= 61 {
+    if blacklistFactor != nil && blacklistFactor.Factor == "blacklisted_address" {
+        assessment.RequiresApproval = true
+        assessment.Explanation = "Blacklisted destination address - manual approval required"
+    } else if assessment.RiskScore >= 61 {
         assessment.RequiresApproval = true
         assessment.Explanation = "High risk - manual approval required"
     } else if assessment.RiskScore >= 31 {
@@ -235,15 +243,24 @@ func (r *RiskAssessor) assessFrequencyRisk(ctx context.Context, userID, chain, a
     return nil
 }
 
-// assessBlacklistRisk checks if address is blacklisted
-func (r *RiskAssessor) assessBlacklistRisk(ctx context.Context, address string) *domain.RiskFactor {
-    // TODO: Check against blacklist database
-    // For now, simplified check
-    
-    // You can integrate with services like:
-    // - Chainalysis
-    // - Elliptic
-    // - Internal blacklist
-    
-    return nil // No blacklist check for now
-}
\ No newline at end of file
+// assessBlacklistRisk screens the destination against the address lists and
+// screening providers. A hit alone is enough to require approval; a failed
+// screening sends the withdrawal to review.
+func (r *RiskAssessor) assessBlacklistRisk(ctx context.Context, userID, chain, address string) *domain.RiskFactor {
+    if r.screener == nil {
+        return nil
+    }
+
+    result, err := r.screener.Screen(ctx, domain.ScreeningDirectionWithdrawal, userID, chain, address)
+    if err != nil {
+        r.logger.Error("Address screening failed", zap.String("chain", chain), zap.Error(err))
+        result = &domain.ScreeningResult{
+            Chain:   chain,
+            Address: address,
+            Status:  domain.ScreeningStatusUnavailable,
+            Reason:  err.Error(),
+        }
+    }
+
+    return result.RiskFactor()
+}
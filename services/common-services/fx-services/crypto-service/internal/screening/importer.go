@@ -0,0 +1,184 @@
+// internal/screening/importer.go
+package screening
+
+import (
+	"bytes"
+	"crypto-service/internal/domain"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ListFormat is the file format of an importable address list
+type ListFormat string
+
+const (
+	ListFormatCSV  ListFormat = "csv"
+	ListFormatJSON ListFormat = "json"
+)
+
+// maxListFileSize bounds what an import reads into memory
+const maxListFileSize = 64 << 20
+
+// ListFormatFromPath guesses the format from a file extension
+func ListFormatFromPath(path string) (ListFormat, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ListFormatCSV, nil
+	case ".json":
+		return ListFormatJSON, nil
+	default:
+		return "", fmt.Errorf("cannot tell list format of %s; use .csv or .json", path)
+	}
+}
+
+// ReadListFile reads a list file from dir. path is relative to dir and may not
+// leave it, so an admin can only import files that were put there on purpose.
+func ReadListFile(dir, path string) ([]byte, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("importing from files is disabled (SCREENING_LISTS_DIR not set)")
+	}
+
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lists directory: %w", err)
+	}
+
+	full := filepath.Join(root, filepath.Clean("/"+path))
+	if rel, err := filepath.Rel(root, full); err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return nil, fmt.Errorf("file %s is outside the lists directory", path)
+	}
+
+	file, err := os.Open(full)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open list file: %w", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxListFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read list file: %w", err)
+	}
+	if len(data) > maxListFileSize {
+		return nil, fmt.Errorf("list file is larger than %d bytes", maxListFileSize)
+	}
+
+	return data, nil
+}
+
+// ParseList parses an address list. Entries carry the chain, category and
+// reason given in the file, empty where the file has none.
+//
+// CSV needs a header row with an "address" column; "chain", "category" and
+// "reason" columns are optional:
+//
+//	address,chain,category,reason
+//	TXYZ...,TRON,sanctions,OFAC SDN
+//
+// JSON is either an array of addresses or an array of objects with the same
+// fields as the CSV columns:
+//
+//	["0xabc...", "bc1q..."]
+//	[{"address": "0xabc...", "chain": "ETHEREUM", "category": "hack"}]
+func ParseList(format ListFormat, data []byte) ([]*domain.AddressListEntry, error) {
+	switch format {
+	case ListFormatCSV:
+		return parseCSVList(data)
+	case ListFormatJSON:
+		return parseJSONList(data)
+	default:
+		return nil, fmt.Errorf("unsupported list format: %s", format)
+	}
+}
+
+func parseCSVList(data []byte) ([]*domain.AddressListEntry, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+
+	addressCol, ok := columns["address"]
+	if !ok {
+		return nil, fmt.Errorf("CSV header has no address column")
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var entries []*domain.AddressListEntry
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		if addressCol >= len(record) {
+			continue
+		}
+
+		entries = append(entries, &domain.AddressListEntry{
+			Address:  strings.TrimSpace(record[addressCol]),
+			Chain:    field(record, "chain"),
+			Category: field(record, "category"),
+			Reason:   field(record, "reason"),
+		})
+	}
+
+	return entries, nil
+}
+
+type jsonListEntry struct {
+	Address  string `json:"address"`
+	Chain    string `json:"chain"`
+	Category string `json:"category"`
+	Reason   string `json:"reason"`
+}
+
+func parseJSONList(data []byte) ([]*domain.AddressListEntry, error) {
+	var addresses []string
+	if err := json.Unmarshal(data, &addresses); err == nil {
+		entries := make([]*domain.AddressListEntry, 0, len(addresses))
+		for _, address := range addresses {
+			entries = append(entries, &domain.AddressListEntry{Address: strings.TrimSpace(address)})
+		}
+		return entries, nil
+	}
+
+	var items []jsonListEntry
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("JSON list must be an array of addresses or of objects: %w", err)
+	}
+
+	entries := make([]*domain.AddressListEntry, 0, len(items))
+	for _, item := range items {
+		entries = append(entries, &domain.AddressListEntry{
+			Address:  strings.TrimSpace(item.Address),
+			Chain:    strings.TrimSpace(item.Chain),
+			Category: strings.TrimSpace(item.Category),
+			Reason:   strings.TrimSpace(item.Reason),
+		})
+	}
+
+	return entries, nil
+}
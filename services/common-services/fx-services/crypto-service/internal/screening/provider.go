@@ -0,0 +1,124 @@
+// internal/screening/provider.go
+package screening
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Provider is an external address screening service (Chainalysis, Elliptic,
+// TRM, an in-house compliance API, ...)
+type Provider interface {
+	// Name identifies the provider in screening results
+	Name() string
+
+	// Screen checks one address. A nil error with Match false means the
+	// provider knows nothing against the address.
+	Screen(ctx context.Context, chain, address string) (*ProviderResult, error)
+}
+
+// ProviderResult is a provider's verdict on an address
+type ProviderResult struct {
+	Match    bool
+	Category string
+	Reason   string
+}
+
+// ============================================================================
+// HTTP PROVIDER
+// ============================================================================
+
+// HTTPProvider screens addresses against a JSON HTTP API:
+//
+//	POST <url>  {"chain": "TRON", "address": "T..."}
+//	200         {"match": true, "category": "sanctions", "reason": "OFAC SDN"}
+//
+// Vendor APIs with a different shape are put behind a small adapter that
+// speaks this contract, or get their own Provider implementation.
+type HTTPProvider struct {
+	name       string
+	url        string
+	apiKey     string
+	httpClient *http.Client
+}
+
+func NewHTTPProvider(name, url, apiKey string, timeout time.Duration) *HTTPProvider {
+	if name == "" {
+		name = "http"
+	}
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &HTTPProvider{
+		name:   name,
+		url:    url,
+		apiKey: apiKey,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+func (p *HTTPProvider) Name() string {
+	return p.name
+}
+
+type httpScreenRequest struct {
+	Chain   string `json:"chain"`
+	Address string `json:"address"`
+}
+
+type httpScreenResponse struct {
+	Match    bool   `json:"match"`
+	Category string `json:"category"`
+	Reason   string `json:"reason"`
+}
+
+func (p *HTTPProvider) Screen(ctx context.Context, chain, address string) (*ProviderResult, error) {
+	body, err := json.Marshal(httpScreenRequest{Chain: chain, Address: address})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s request failed: %w", p.name, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s response: %w", p.name, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %d: %s", p.name, resp.StatusCode, string(respBody))
+	}
+
+	var result httpScreenResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse %s response: %w", p.name, err)
+	}
+
+	return &ProviderResult{
+		Match:    result.Match,
+		Category: result.Category,
+		Reason:   result.Reason,
+	}, nil
+}
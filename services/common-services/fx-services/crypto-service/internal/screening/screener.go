@@ -0,0 +1,129 @@
+// internal/screening/screener.go
+package screening
+
+import (
+	"context"
+	"crypto-service/internal/domain"
+	"crypto-service/internal/repository"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Screener checks addresses against the internal whitelist, the internal
+// blacklist (manual entries and imported sanction lists) and the configured
+// external providers, in that order. A whitelisted address is never sent to
+// a provider.
+type Screener struct {
+	repo      *repository.AddressScreeningRepository
+	providers []Provider
+	logger    *zap.Logger
+}
+
+func NewScreener(
+	repo *repository.AddressScreeningRepository,
+	logger *zap.Logger,
+	providers ...Provider,
+) *Screener {
+	return &Screener{
+		repo:      repo,
+		providers: providers,
+		logger:    logger,
+	}
+}
+
+// Screen screens address on chain and records the outcome in the screening
+// log. reference ties the log entry to what was screened (user ID for
+// withdrawals, deposit ID for deposits). A provider failure without any hit
+// yields ScreeningStatusUnavailable; the error is only for internal failures.
+func (s *Screener) Screen(
+	ctx context.Context,
+	direction domain.ScreeningDirection,
+	reference, chain, address string,
+) (*domain.ScreeningResult, error) {
+	result, err := s.screen(ctx, chain, address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.RecordScreening(ctx, direction, reference, result); err != nil {
+		s.logger.Warn("Failed to record screening", zap.Error(err))
+	}
+
+	if result.IsHit() {
+		s.logger.Warn("Address screening hit",
+			zap.String("direction", string(direction)),
+			zap.String("reference", reference),
+			zap.String("chain", chain),
+			zap.String("address", address),
+			zap.String("source", result.Source),
+			zap.String("category", result.Category))
+	}
+
+	return result, nil
+}
+
+func (s *Screener) screen(ctx context.Context, chain, address string) (*domain.ScreeningResult, error) {
+	result := &domain.ScreeningResult{
+		Chain:   chain,
+		Address: address,
+		Status:  domain.ScreeningStatusClear,
+	}
+
+	entries, err := s.repo.FindActiveEntries(ctx, chain, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check address lists: %w", err)
+	}
+
+	// 1. Whitelist wins over everything
+	for _, entry := range entries {
+		if entry.ListType == domain.AddressListWhitelist {
+			result.Status = domain.ScreeningStatusWhitelisted
+			result.Source = "internal:" + entry.ListName
+			result.Reason = entry.Reason
+			return result, nil
+		}
+	}
+
+	// 2. Internal blacklist
+	for _, entry := range entries {
+		if entry.ListType == domain.AddressListBlacklist {
+			result.Status = domain.ScreeningStatusHit
+			result.Source = "internal:" + entry.ListName
+			result.Category = entry.Category
+			result.Reason = entry.Reason
+			return result, nil
+		}
+	}
+
+	// 3. External providers; one hit is enough, failures are only reported
+	// when no other provider found anything
+	var failures []string
+	for _, provider := range s.providers {
+		verdict, err := provider.Screen(ctx, chain, address)
+		if err != nil {
+			s.logger.Warn("Screening provider failed",
+				zap.String("provider", provider.Name()),
+				zap.String("chain", chain),
+				zap.Error(err))
+			failures = append(failures, provider.Name()+": "+err.Error())
+			continue
+		}
+
+		if verdict.Match {
+			result.Status = domain.ScreeningStatusHit
+			result.Source = "provider:" + provider.Name()
+			result.Category = verdict.Category
+			result.Reason = verdict.Reason
+			return result, nil
+		}
+	}
+
+	if len(failures) > 0 {
+		result.Status = domain.ScreeningStatusUnavailable
+		result.Reason = strings.Join(failures, "; ")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,255 @@
+// internal/security/envelope.go
+package security
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// masterKeyVersion is the KEK version of the service master key
+const masterKeyVersion = 1
+
+// EnvelopeEncryption encrypts every secret with its own random data key and
+// stores the data key wrapped by a versioned key-encryption key (KEK).
+// KEK 1 is the service master key; later versions are held in the vault at
+// crypto/kek/v<N> (env: CRYPTO_KEK_V<N>). Rotating the KEK only re-wraps
+// data keys, the secrets themselves are never re-encrypted.
+//
+// Several KEK versions can be loaded at once: new secrets are sealed with
+// the active version while data keys wrapped by older versions still open
+// until a rotation has re-wrapped them.
+type EnvelopeEncryption struct {
+	vault  *Vault
+	keks   map[int]*Encryption
+	active int
+	mutex  sync.RWMutex
+	logger *zap.Logger
+}
+
+// SealedSecret is a secret encrypted with a data key, plus that data key
+// wrapped by a KEK
+type SealedSecret struct {
+	Ciphertext string // Secret encrypted with the data key (base64)
+	WrappedKey string // Data key encrypted with the KEK (base64)
+	KEKVersion int
+}
+
+// NewEnvelopeEncryption creates an envelope with the master key as the
+// active KEK (version 1)
+func NewEnvelopeEncryption(masterKey *Encryption, vault *Vault, logger *zap.Logger) *EnvelopeEncryption {
+	return &EnvelopeEncryption{
+		vault:  vault,
+		keks:   map[int]*Encryption{masterKeyVersion: masterKey},
+		active: masterKeyVersion,
+		logger: logger,
+	}
+}
+
+// KEKPath returns the vault path of a KEK version
+func KEKPath(version int) string {
+	return fmt.Sprintf("crypto/kek/v%d", version)
+}
+
+// ============================================================================
+// KEY-ENCRYPTION KEYS
+// ============================================================================
+
+// LoadKEK loads a KEK version from the vault (once) and returns its
+// fingerprint
+func (e *EnvelopeEncryption) LoadKEK(ctx context.Context, version int) (string, error) {
+	kek, err := e.kek(ctx, version)
+	if err != nil {
+		return "", err
+	}
+	return fingerprint(kek.masterKey), nil
+}
+
+// SetActiveVersion makes a loaded KEK version wrap the data keys of new
+// secrets
+func (e *EnvelopeEncryption) SetActiveVersion(version int) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if _, ok := e.keks[version]; !ok {
+		return fmt.Errorf("kek v%d is not loaded", version)
+	}
+	if e.active != version {
+		e.logger.Info("Active KEK version changed",
+			zap.Int("from", e.active),
+			zap.Int("to", version))
+	}
+	e.active = version
+
+	return nil
+}
+
+// ActiveVersion returns the KEK version new secrets are sealed with
+func (e *EnvelopeEncryption) ActiveVersion() int {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	return e.active
+}
+
+// kek returns a KEK version, loading it from the vault on first use
+func (e *EnvelopeEncryption) kek(ctx context.Context, version int) (*Encryption, error) {
+	e.mutex.RLock()
+	kek, ok := e.keks[version]
+	e.mutex.RUnlock()
+	if ok {
+		return kek, nil
+	}
+
+	if version <= masterKeyVersion {
+		return nil, fmt.Errorf("invalid kek version: %d", version)
+	}
+
+	secret, err := e.vault.GetSecret(ctx, KEKPath(version))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kek v%d: %w", version, err)
+	}
+	kek, err = NewEncryption(secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kek v%d: %w", version, err)
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if existing, ok := e.keks[version]; ok {
+		return existing, nil
+	}
+	e.keks[version] = kek
+
+	e.logger.Info("KEK loaded from vault", zap.Int("version", version))
+
+	return kek, nil
+}
+
+// ============================================================================
+// SEAL / OPEN
+// ============================================================================
+
+// Seal encrypts plaintext with a new random data key and wraps the data key
+// with the active KEK
+func (e *EnvelopeEncryption) Seal(ctx context.Context, plaintext string) (*SealedSecret, error) {
+	return e.SealWith(ctx, e.ActiveVersion(), plaintext)
+}
+
+// SealWith is Seal with the data key wrapped by KEK version
+func (e *EnvelopeEncryption) SealWith(ctx context.Context, version int, plaintext string) (*SealedSecret, error) {
+	kek, err := e.kek(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	defer clear(dataKey)
+
+	ciphertext, err := (&Encryption{masterKey: dataKey}).Encrypt(plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapped, err := kek.EncryptBytes(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	return &SealedSecret{
+		Ciphertext: ciphertext,
+		WrappedKey: base64.StdEncoding.EncodeToString(wrapped),
+		KEKVersion: version,
+	}, nil
+}
+
+// Open unwraps the secret's data key with the KEK it names and decrypts the
+// secret
+func (e *EnvelopeEncryption) Open(ctx context.Context, sealed *SealedSecret) (string, error) {
+	dataKey, err := e.unwrap(ctx, sealed.WrappedKey, sealed.KEKVersion)
+	if err != nil {
+		return "", err
+	}
+	defer clear(dataKey)
+
+	return (&Encryption{masterKey: dataKey}).Decrypt(sealed.Ciphertext)
+}
+
+// Rewrap re-wraps the secret's data key with KEK version `to`; the
+// ciphertext is unchanged
+func (e *EnvelopeEncryption) Rewrap(ctx context.Context, sealed *SealedSecret, to int) (*SealedSecret, error) {
+	kek, err := e.kek(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := e.unwrap(ctx, sealed.WrappedKey, sealed.KEKVersion)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(dataKey)
+
+	// The data key must still open the secret before it is re-wrapped
+	if _, err := (&Encryption{masterKey: dataKey}).Decrypt(sealed.Ciphertext); err != nil {
+		return nil, fmt.Errorf("data key does not open the secret: %w", err)
+	}
+
+	wrapped, err := kek.EncryptBytes(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	return &SealedSecret{
+		Ciphertext: sealed.Ciphertext,
+		WrappedKey: base64.StdEncoding.EncodeToString(wrapped),
+		KEKVersion: to,
+	}, nil
+}
+
+// DecryptLegacy decrypts a secret encrypted directly with the master key,
+// as every wallet key was before envelope encryption
+func (e *EnvelopeEncryption) DecryptLegacy(ciphertext string) (string, error) {
+	e.mutex.RLock()
+	masterKey := e.keks[masterKeyVersion]
+	e.mutex.RUnlock()
+
+	return masterKey.Decrypt(ciphertext)
+}
+
+// unwrap decrypts a wrapped data key with KEK version
+func (e *EnvelopeEncryption) unwrap(ctx context.Context, wrappedKey string, version int) ([]byte, error) {
+	kek, err := e.kek(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapped, err := base64.StdEncoding.DecodeString(wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode wrapped data key: %w", err)
+	}
+
+	dataKey, err := kek.DecryptBytes(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key with kek v%d: %w", version, err)
+	}
+	if len(dataKey) != 32 {
+		return nil, fmt.Errorf("invalid data key length: %d", len(dataKey))
+	}
+
+	return dataKey, nil
+}
+
+// fingerprint identifies a key without revealing it
+func fingerprint(key []byte) string {
+	sum := sha256.Sum256(append([]byte("crypto-service/kek:"), key...))
+	return hex.EncodeToString(sum[:8])
+}
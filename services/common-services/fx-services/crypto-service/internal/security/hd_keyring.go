@@ -0,0 +1,173 @@
+// internal/security/hd_keyring.go
+package security
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"go.uber.org/zap"
+)
+
+// HDKeyring derives wallet keys from per-chain BIP32 extended private keys
+// held in the vault at crypto/hd/<chain>/xprv (env: CRYPTO_HD_<CHAIN>_XPRV).
+// Only the extended keys need protecting and backing up; every deposit
+// wallet key can be re-derived from them and its path.
+type HDKeyring struct {
+	vault  *Vault
+	logger *zap.Logger
+}
+
+// NewHDKeyring creates a keyring backed by vault
+func NewHDKeyring(vault *Vault, logger *zap.Logger) *HDKeyring {
+	return &HDKeyring{
+		vault:  vault,
+		logger: logger,
+	}
+}
+
+// DerivationPath is a BIP32 path; hardened elements include HardenedKeyStart
+type DerivationPath []uint32
+
+// PurposeBIP44 is the BIP43 purpose of BIP44 paths
+const PurposeBIP44 uint32 = 44
+
+// BIP44Path returns m/44'/coin'/account'/0/index (external chain)
+func BIP44Path(coinType, account, index uint32) DerivationPath {
+	return PurposePath(PurposeBIP44, coinType, account, index)
+}
+
+// PurposePath returns m/purpose'/coin'/account'/0/index, the BIP44 layout
+// under another BIP43 purpose (84' for BIP84, 86' for BIP86)
+func PurposePath(purpose, coinType, account, index uint32) DerivationPath {
+	h := uint32(hdkeychain.HardenedKeyStart)
+	return DerivationPath{purpose + h, coinType + h, account + h, 0, index}
+}
+
+// Purpose returns the path's BIP43 purpose, or 0 if it has none
+func (p DerivationPath) Purpose() uint32 {
+	if len(p) == 0 || p[0] < hdkeychain.HardenedKeyStart {
+		return 0
+	}
+	return p[0] - hdkeychain.HardenedKeyStart
+}
+
+// String formats the path as m/44'/60'/3'/0/0
+func (p DerivationPath) String() string {
+	var b strings.Builder
+	b.WriteString("m")
+	for _, i := range p {
+		b.WriteString("/")
+		if i >= hdkeychain.HardenedKeyStart {
+			b.WriteString(strconv.FormatUint(uint64(i-hdkeychain.HardenedKeyStart), 10))
+			b.WriteString("'")
+		} else {
+			b.WriteString(strconv.FormatUint(uint64(i), 10))
+		}
+	}
+	return b.String()
+}
+
+// ParseDerivationPath parses a path such as m/44'/60'/3'/0/0
+func ParseDerivationPath(path string) (DerivationPath, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if len(parts) < 2 || parts[0] != "m" {
+		return nil, fmt.Errorf("invalid derivation path: %q", path)
+	}
+
+	result := make(DerivationPath, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h")
+		if hardened {
+			part = part[:len(part)-1]
+		}
+		n, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || n >= uint64(hdkeychain.HardenedKeyStart) {
+			return nil, fmt.Errorf("invalid derivation path element %q in %q", part, path)
+		}
+		i := uint32(n)
+		if hardened {
+			i += hdkeychain.HardenedKeyStart
+		}
+		result = append(result, i)
+	}
+
+	return result, nil
+}
+
+// HasChain reports whether an extended key is configured for chain
+func (k *HDKeyring) HasChain(ctx context.Context, chain string) bool {
+	_, err := k.masterKey(ctx, chain)
+	return err == nil
+}
+
+// DerivePrivateKey returns the raw 32-byte secp256k1 private key at path
+// under the chain's extended key. Callers must not persist it.
+func (k *HDKeyring) DerivePrivateKey(ctx context.Context, chain string, path DerivationPath) ([]byte, error) {
+	key, err := k.masterKey(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range path {
+		key, err = key.Derive(i)
+		if err != nil {
+			// ErrInvalidChild has a ~1 in 2^127 chance; the caller picks the next index
+			return nil, fmt.Errorf("failed to derive %s for %s: %w", path, chain, err)
+		}
+	}
+
+	privateKey, err := key.ECPrivKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get private key at %s: %w", path, err)
+	}
+
+	return privateKey.Serialize(), nil
+}
+
+// masterKey loads and parses the chain's extended private key
+func (k *HDKeyring) masterKey(ctx context.Context, chain string) (*hdkeychain.ExtendedKey, error) {
+	secret, err := k.vault.GetSecret(ctx, hdKeyPath(chain))
+	if err != nil {
+		return nil, fmt.Errorf("no HD extended key for %s: %w", chain, err)
+	}
+
+	key, err := hdkeychain.NewKeyFromString(strings.TrimSpace(secret))
+	if err != nil {
+		return nil, fmt.Errorf("invalid HD extended key for %s: %w", chain, err)
+	}
+	if !key.IsPrivate() {
+		return nil, fmt.Errorf("HD extended key for %s is public, a private key (xprv/tprv) is required", chain)
+	}
+
+	return key, nil
+}
+
+// hdKeyPath is the vault path of a chain's extended key
+func hdKeyPath(chain string) string {
+	return "crypto/hd/" + strings.ToLower(chain) + "/xprv"
+}
+
+// GenerateHDMasterKey generates a random BIP32 extended private key
+// (xprv for mainnet, tprv otherwise)
+func GenerateHDMasterKey(mainnet bool) (string, error) {
+	seed, err := hdkeychain.GenerateSeed(hdkeychain.RecommendedSeedLen)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate seed: %w", err)
+	}
+
+	params := &chaincfg.TestNet3Params
+	if mainnet {
+		params = &chaincfg.MainNetParams
+	}
+
+	master, err := hdkeychain.NewMaster(seed, params)
+	if err != nil {
+		return "", fmt.Errorf("failed to create master key: %w", err)
+	}
+
+	return master.String(), nil
+}
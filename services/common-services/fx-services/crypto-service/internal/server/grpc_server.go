@@ -19,6 +19,8 @@ type GRPCServer struct {
 	transactionHandler *handler.TransactionHandler
 	depositHandler     *handler.DepositHandler
 	cryptoHandler      *handler. CryptoHandler
+	screeningHandler   *handler.ScreeningHandler
+	keyManagementHandler *handler.KeyManagementHandler
 	logger             *zap.Logger
 	port               int
 }
@@ -28,6 +30,8 @@ func NewGRPCServer(
 	transactionHandler *handler.TransactionHandler,
 	depositHandler *handler.DepositHandler,
 	cryptoHandler *handler.CryptoHandler,
+	screeningHandler *handler.ScreeningHandler,
+	keyManagementHandler *handler.KeyManagementHandler,
 	logger *zap.Logger,
 	port int,
 ) *GRPCServer {
@@ -36,6 +40,8 @@ func NewGRPCServer(
 		transactionHandler: transactionHandler,
 		depositHandler:     depositHandler,
 		cryptoHandler:      cryptoHandler,
+		screeningHandler:   screeningHandler,
+		keyManagementHandler: keyManagementHandler,
 		logger:             logger,
 		port:               port,
 	}
@@ -61,6 +67,8 @@ func (s *GRPCServer) Start() error {
 	pb.RegisterTransactionServiceServer(s.server, s.transactionHandler)
 	pb.RegisterDepositServiceServer(s.server, s.depositHandler)
 	pb.RegisterCryptoServiceServer(s.server, s.cryptoHandler)
+	pb.RegisterScreeningServiceServer(s.server, s.screeningHandler)
+	pb.RegisterKeyManagementServiceServer(s.server, s.keyManagementHandler)
 	
 	// Register reflection service (for grpcurl, Postman, etc.)
 	reflection.Register(s.server)
@@ -0,0 +1,336 @@
+// internal/usecase/deposit_scanner.go
+package usecase
+
+import (
+	"context"
+	"crypto-service/internal/domain"
+	"crypto-service/pkg/utils"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// maxBlocksPerScan bounds one scan run per chain so a chain far behind
+	// catches up over several runs instead of blocking the worker
+	maxBlocksPerScan = 100
+
+	// reorgWindow is how many recent block hashes are kept per chain; a
+	// reorg deeper than this stops the chain's scanning for manual review
+	reorgWindow = 100
+)
+
+// ============================================================================
+// BLOCK SCANNING
+// ============================================================================
+
+// ScanDeposits scans every registered chain that supports block scanning
+// (TRON, BITCOIN, ETHEREUM) from its checkpoint.
+// This should be called by a background worker/cron job
+func (uc *DepositUsecase) ScanDeposits(ctx context.Context) {
+	for _, chainName := range uc.chainRegistry.List() {
+		chain, err := uc.chainRegistry.Get(chainName)
+		if err != nil {
+			continue
+		}
+		if _, ok := chain.(domain.BlockScanner); !ok {
+			continue
+		}
+
+		if err := uc.ScanChain(ctx, chainName); err != nil {
+			uc.logger.Error("Failed to scan chain for deposits",
+				zap.String("chain", chainName),
+				zap.Error(err))
+
+			if recErr := uc.depositRepo.RecordSyncError(ctx, chainName, err); recErr != nil {
+				uc.logger.Error("Failed to record sync error", zap.Error(recErr))
+			}
+		}
+	}
+}
+
+// ScanChain scans the blocks after the chain's checkpoint, up to
+// maxBlocksPerScan blocks per call
+func (uc *DepositUsecase) ScanChain(ctx context.Context, chainName string) error {
+	scanner, err := uc.blockScanner(chainName)
+	if err != nil {
+		return err
+	}
+
+	tip, err := scanner.LatestBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	lastSynced, needsReview, err := uc.depositRepo.GetSyncCheckpoint(ctx, chainName)
+	if err != nil {
+		return err
+	}
+	if needsReview {
+		return fmt.Errorf("scanning %s is stopped pending manual review", chainName)
+	}
+
+	// First run: start at the configured block instead of replaying the whole chain
+	if lastSynced == 0 {
+		start := uc.scanStartBlocks[chainName]
+		if start <= 0 {
+			return fmt.Errorf("%s has no scan checkpoint and no start block configured (DEPOSIT_SCAN_START_BLOCK_%s)", chainName, chainName)
+		}
+		lastSynced = start - 1
+	}
+	if lastSynced >= tip {
+		return nil
+	}
+
+	toBlock := min(tip, lastSynced+maxBlocksPerScan)
+
+	return uc.ScanBlockchainForDeposits(ctx, chainName, lastSynced+1, toBlock)
+}
+
+// ScanBlockchainForDeposits scans blocks fromBlock..toBlock for transfers to
+// our wallets and records them as detected deposits. Scanning is idempotent
+// (deposits are keyed by tx hash + output index), so ranges may be rescanned.
+// A reorg stops the scan after rolling back to the fork point.
+func (uc *DepositUsecase) ScanBlockchainForDeposits(
+	ctx context.Context,
+	chainName string,
+	fromBlock, toBlock int64,
+) error {
+	scanner, err := uc.blockScanner(chainName)
+	if err != nil {
+		return err
+	}
+
+	tip, err := scanner.LatestBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	toBlock = min(toBlock, tip)
+
+	// Get all active wallets for this chain
+	wallets, err := uc.walletRepo.GetWalletsByChain(ctx, chainName)
+	if err != nil {
+		return fmt.Errorf("failed to get wallets: %w", err)
+	}
+
+	// Build address map for quick lookup: address -> asset -> wallet.
+	// System hot wallets are skipped; sweeps and change outputs aren't deposits.
+	addressMap := make(map[string]map[string]*domain.CryptoWallet)
+	var tokens []*domain.Asset
+	seenTokens := make(map[string]bool)
+	for _, wallet := range wallets {
+		if wallet.UserID == SystemUserID {
+			continue
+		}
+
+		key := addressKey(chainName, wallet.Address)
+		if addressMap[key] == nil {
+			addressMap[key] = make(map[string]*domain.CryptoWallet)
+		}
+		addressMap[key][wallet.Asset] = wallet
+
+		asset := utils.AssetFromChainAndCode(chainName, wallet.Asset)
+		if asset != nil && asset.Type == domain.AssetTypeToken && asset.ContractAddr != nil && !seenTokens[asset.Symbol] {
+			seenTokens[asset.Symbol] = true
+			tokens = append(tokens, asset)
+		}
+	}
+
+	for number := fromBlock; number <= toBlock; number++ {
+		block, err := scanner.GetBlock(ctx, number, tokens)
+		if err != nil {
+			return err
+		}
+
+		// The block we scanned last at number-1 must still be this block's parent
+		parentHash, err := uc.depositRepo.GetScannedBlockHash(ctx, chainName, number-1)
+		if err != nil {
+			return err
+		}
+		if parentHash != "" && parentHash != block.ParentHash {
+			return uc.handleReorg(ctx, chainName, scanner, number)
+		}
+
+		deposits := uc.matchDeposits(chainName, block, addressMap, tip)
+
+		recorded, err := uc.depositRepo.SaveScannedBlock(ctx, chainName, block, deposits, tip)
+		if err != nil {
+			return err
+		}
+
+		if recorded > 0 {
+			uc.logger.Info("Deposits detected",
+				zap.String("chain", chainName),
+				zap.Int64("block", number),
+				zap.Int("count", recorded))
+		}
+	}
+
+	if err := uc.depositRepo.PruneScannedBlocks(ctx, chainName, toBlock-reorgWindow); err != nil {
+		uc.logger.Warn("Failed to prune scanned blocks", zap.String("chain", chainName), zap.Error(err))
+	}
+
+	uc.logger.Debug("Chain scanned",
+		zap.String("chain", chainName),
+		zap.Int64("from_block", fromBlock),
+		zap.Int64("to_block", toBlock),
+		zap.Int64("tip", tip))
+
+	return nil
+}
+
+// matchDeposits turns the block's transfers to our wallets into deposits
+func (uc *DepositUsecase) matchDeposits(
+	chainName string,
+	block *domain.ScannedBlock,
+	addressMap map[string]map[string]*domain.CryptoWallet,
+	tip int64,
+) []*domain.CryptoDeposit {
+	var deposits []*domain.CryptoDeposit
+
+	for _, transfer := range block.Transfers {
+		byAsset, ok := addressMap[addressKey(chainName, transfer.To)]
+		if !ok {
+			continue
+		}
+
+		wallet, ok := byAsset[transfer.Asset]
+		if !ok {
+			uc.logger.Warn("Transfer to wallet address for an asset without a wallet",
+				zap.String("chain", chainName),
+				zap.String("address", transfer.To),
+				zap.String("asset", transfer.Asset),
+				zap.String("tx_hash", transfer.TxHash))
+			continue
+		}
+
+		blockHash := block.Hash
+		blockTime := block.Timestamp
+		deposits = append(deposits, &domain.CryptoDeposit{
+			WalletID:              wallet.ID,
+			UserID:                wallet.UserID,
+			Chain:                 chainName,
+			Asset:                 wallet.Asset,
+			FromAddress:           transfer.From,
+			ToAddress:             wallet.Address,
+			Amount:                transfer.Amount,
+			TxHash:                transfer.TxHash,
+			OutputIndex:           transfer.OutputIndex,
+			BlockNumber:           block.Number,
+			BlockHash:             &blockHash,
+			BlockTimestamp:        &blockTime,
+			Confirmations:         int(tip - block.Number),
+			RequiredConfirmations: utils.GetRequiredConfirmations(chainName),
+			Status:                domain.DepositStatusDetected,
+			DetectedAt:            time.Now(),
+		})
+	}
+
+	return deposits
+}
+
+// handleReorg walks back from the block before height until our stored hash
+// matches the chain again, then rolls back everything above that fork point.
+// If no stored hash matches, the fork is deeper than the window: nothing is
+// rolled back and the chain is flagged for manual review. A rollback that
+// hits confirmed or credited deposits flags the chain as well.
+func (uc *DepositUsecase) handleReorg(
+	ctx context.Context,
+	chainName string,
+	scanner domain.BlockScanner,
+	height int64,
+) error {
+	forkPoint := height - 1
+	found := false
+	for depth := 0; depth < reorgWindow && forkPoint > 0; depth++ {
+		stored, err := uc.depositRepo.GetScannedBlockHash(ctx, chainName, forkPoint)
+		if err != nil {
+			return err
+		}
+		if stored == "" {
+			// Older than the window; nothing tracked to compare against
+			break
+		}
+
+		current, err := scanner.GetBlockHash(ctx, forkPoint)
+		if err != nil {
+			return err
+		}
+		if current == stored {
+			found = true
+			break
+		}
+
+		forkPoint--
+	}
+
+	if !found {
+		reason := fmt.Sprintf("reorg detected at block %d is deeper than the stored block hashes (last checked %d)", height, forkPoint)
+		if err := uc.depositRepo.FlagChainForReview(ctx, chainName, reason); err != nil {
+			return err
+		}
+
+		uc.logger.Error("Chain reorganization deeper than the reorg window, scanning stopped for manual review",
+			zap.String("chain", chainName),
+			zap.Int64("detected_at_block", height),
+			zap.Int64("last_checked_block", forkPoint))
+
+		return fmt.Errorf("%s: %s", chainName, reason)
+	}
+
+	orphaned, settled, err := uc.depositRepo.RollbackToBlock(ctx, chainName, forkPoint)
+	if err != nil {
+		return err
+	}
+
+	uc.logger.Warn("Chain reorganization detected, rolled back",
+		zap.String("chain", chainName),
+		zap.Int64("detected_at_block", height),
+		zap.Int64("fork_point", forkPoint),
+		zap.Int64("orphaned_deposits", orphaned))
+
+	// Deposits already confirmed or credited cannot be orphaned; stop the
+	// chain until they are reversed
+	if len(settled) > 0 {
+		reason := fmt.Sprintf("reorg at block %d (fork point %d) removed %d confirmed or credited deposits: %s",
+			height, forkPoint, len(settled), strings.Join(settled, ", "))
+		if err := uc.depositRepo.FlagChainForReview(ctx, chainName, reason); err != nil {
+			return err
+		}
+
+		uc.logger.Error("Reorg removed blocks with confirmed or credited deposits, scanning and crediting stopped for manual review",
+			zap.String("chain", chainName),
+			zap.Int64("fork_point", forkPoint),
+			zap.Strings("deposit_ids", settled))
+
+		return fmt.Errorf("%s: %s", chainName, reason)
+	}
+
+	return nil
+}
+
+// blockScanner returns chainName's BlockScanner implementation
+func (uc *DepositUsecase) blockScanner(chainName string) (domain.BlockScanner, error) {
+	chain, err := uc.chainRegistry.Get(chainName)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported chain: %w", err)
+	}
+
+	scanner, ok := chain.(domain.BlockScanner)
+	if !ok {
+		return nil, fmt.Errorf("chain %s does not support block scanning", chainName)
+	}
+
+	return scanner, nil
+}
+
+// addressKey normalizes an address for lookups; Ethereum addresses are
+// case-insensitive (EIP-55 checksums only change case)
+func addressKey(chainName, address string) string {
+	if chainName == "ETHEREUM" {
+		return strings.ToLower(address)
+	}
+	return address
+}
@@ -0,0 +1,274 @@
+// internal/usecase/deposit_screening.go
+package usecase
+
+import (
+	"context"
+	"crypto-service/internal/domain"
+	"crypto-service/pkg/utils"
+	"fmt"
+	"strings"
+
+	notificationpb "x/shared/genproto/shared/notificationpb"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// EventDepositFrozen is the notification sent to compliance for a frozen deposit
+	EventDepositFrozen = "CRYPTO_DEPOSIT_FROZEN"
+
+	// frozenNotifyBatch bounds how many pending compliance alerts one cycle sends
+	frozenNotifyBatch = 100
+)
+
+// ============================================================================
+// DEPOSIT SCREENING - frozen deposits
+// ============================================================================
+
+// screenDepositSource screens where a confirmed deposit came from. A hit
+// freezes the deposit for review; when screening is unavailable the deposit
+// stays pending and is screened again next cycle. Returns true when the
+// deposit may be credited.
+func (uc *DepositUsecase) screenDepositSource(
+	ctx context.Context,
+	deposit *domain.CryptoDeposit,
+	txFrom string,
+) (bool, error) {
+	// Bitcoin deposits are detected from outputs only; the chain lookup
+	// knows the (first) input's address
+	if deposit.FromAddress == "" && txFrom != "" {
+		deposit.FromAddress = txFrom
+		if err := uc.depositRepo.UpdateFromAddress(ctx, deposit.ID, txFrom); err != nil {
+			uc.logger.Warn("Failed to store deposit source", zap.String("deposit_id", deposit.DepositID), zap.Error(err))
+		}
+	}
+
+	if uc.screener == nil {
+		return true, nil
+	}
+
+	var sources []string
+	for _, address := range []string{deposit.FromAddress, txFrom} {
+		if address == "" {
+			continue
+		}
+		if len(sources) > 0 && domain.NormalizeAddress(sources[0]) == domain.NormalizeAddress(address) {
+			continue
+		}
+		sources = append(sources, address)
+	}
+
+	if len(sources) == 0 {
+		uc.logger.Warn("Deposit has no known source address, not screened",
+			zap.String("deposit_id", deposit.DepositID),
+			zap.String("tx_hash", deposit.TxHash))
+		return true, nil
+	}
+
+	var hits []domain.RiskFactor
+	unavailable := false
+	for _, address := range sources {
+		result, err := uc.screener.Screen(ctx, domain.ScreeningDirectionDeposit, deposit.DepositID, deposit.Chain, address)
+		if err != nil {
+			uc.logger.Error("Deposit screening failed",
+				zap.String("deposit_id", deposit.DepositID),
+				zap.Error(err))
+			unavailable = true
+			continue
+		}
+
+		switch result.Status {
+		case domain.ScreeningStatusHit:
+			hits = append(hits, *result.RiskFactor())
+		case domain.ScreeningStatusUnavailable:
+			unavailable = true
+		}
+	}
+
+	if len(hits) > 0 {
+		if err := uc.depositRepo.FreezeDeposit(ctx, deposit, hits); err != nil {
+			return false, err
+		}
+
+		uc.logger.Warn("Deposit frozen, source address matched a blacklist",
+			zap.String("deposit_id", deposit.DepositID),
+			zap.String("user_id", deposit.UserID),
+			zap.String("from_address", deposit.FromAddress),
+			zap.String("reason", hits[0].Description))
+
+		// A failed alert is retried by NotifyFrozenDeposits
+		if err := uc.notifyCompliance(ctx, deposit); err != nil {
+			uc.logger.Error("Failed to notify compliance of frozen deposit, will retry",
+				zap.String("deposit_id", deposit.DepositID),
+				zap.Error(err))
+		}
+		return false, nil
+	}
+
+	if unavailable {
+		uc.logger.Warn("Deposit screening unavailable, will retry",
+			zap.String("deposit_id", deposit.DepositID))
+
+		deposit.Status = domain.DepositStatusPending
+		if err := uc.depositRepo.Update(ctx, deposit); err != nil {
+			return false, fmt.Errorf("failed to update deposit: %w", err)
+		}
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// NotifyFrozenDeposits sends the compliance alerts of frozen deposits that
+// could not be sent when the deposit was frozen
+func (uc *DepositUsecase) NotifyFrozenDeposits(ctx context.Context) error {
+	if uc.notifier == nil {
+		return nil
+	}
+
+	deposits, err := uc.depositRepo.GetFrozenDepositsToNotify(ctx, frozenNotifyBatch)
+	if err != nil {
+		return err
+	}
+
+	for _, deposit := range deposits {
+		if err := uc.notifyCompliance(ctx, deposit); err != nil {
+			return fmt.Errorf("failed to notify compliance of deposit %s: %w", deposit.DepositID, err)
+		}
+	}
+
+	return nil
+}
+
+// notifyCompliance alerts compliance admins that deposit was frozen and
+// records that they were told. The request ID is derived from the deposit
+// so a retry after a lost response is not delivered twice.
+func (uc *DepositUsecase) notifyCompliance(ctx context.Context, deposit *domain.CryptoDeposit) error {
+	if uc.notifier == nil {
+		return nil
+	}
+
+	reasons := make([]string, 0, len(deposit.RiskFactors))
+	for _, factor := range deposit.RiskFactors {
+		reasons = append(reasons, factor.Description)
+	}
+	reason := strings.Join(reasons, "; ")
+	amount := utils.FormatAmount(deposit.Amount, deposit.Asset)
+
+	payload, err := structpb.NewStruct(map[string]interface{}{
+		"deposit_id":   deposit.DepositID,
+		"user_id":      deposit.UserID,
+		"chain":        deposit.Chain,
+		"asset":        deposit.Asset,
+		"amount":       amount,
+		"tx_hash":      deposit.TxHash,
+		"from_address": deposit.FromAddress,
+		"to_address":   deposit.ToAddress,
+		"reason":       reason,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build notification payload: %w", err)
+	}
+
+	resp, err := uc.notifier.Client.CreateNotification(ctx, &notificationpb.CreateNotificationsRequest{
+		Notifications: []*notificationpb.Notification{
+			{
+				RequestId:      "crypto-deposit-frozen-" + deposit.DepositID,
+				OwnerType:      "admin",
+				OwnerId:        "compliance",
+				EventType:      EventDepositFrozen,
+				ChannelHint:    []string{"email", "ws"},
+				Title:          "Crypto deposit frozen",
+				Body:           fmt.Sprintf("Deposit %s of %s on %s from %s was frozen: %s", deposit.DepositID, amount, deposit.Chain, deposit.FromAddress, reason),
+				Payload:        payload,
+				VisibleInApp:   true,
+				RecipientEmail: uc.complianceEmail,
+				Priority:       "high",
+				Status:         "pending",
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return fmt.Errorf("notification rejected: %s", resp.Errors[0].ErrorMessage)
+	}
+
+	if err := uc.depositRepo.MarkComplianceNotified(ctx, deposit.ID); err != nil {
+		return err
+	}
+
+	uc.logger.Info("Compliance notified of frozen deposit",
+		zap.String("deposit_id", deposit.DepositID))
+
+	return nil
+}
+
+// GetFrozenDeposits returns deposits waiting for a screening review, newest first
+func (uc *DepositUsecase) GetFrozenDeposits(ctx context.Context, limit int) ([]*domain.CryptoDeposit, error) {
+	return uc.depositRepo.GetDepositsByStatus(ctx, domain.DepositStatusFrozen, limit)
+}
+
+// ReleaseFrozenDeposit clears a frozen deposit after review and credits it.
+// If crediting fails the deposit stays pending and the worker retries it
+// without screening it again.
+func (uc *DepositUsecase) ReleaseFrozenDeposit(
+	ctx context.Context,
+	depositID, reviewedBy, notes string,
+) (*domain.CryptoDeposit, error) {
+	deposit, err := uc.depositRepo.GetByDepositID(ctx, depositID)
+	if err != nil {
+		return nil, fmt.Errorf("deposit not found: %w", err)
+	}
+
+	if err := uc.depositRepo.ReviewFrozenDeposit(ctx, deposit.ID, domain.DepositStatusPending, reviewedBy, notes); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Frozen deposit released",
+		zap.String("deposit_id", depositID),
+		zap.String("reviewed_by", reviewedBy))
+
+	deposit, err = uc.depositRepo.GetByID(ctx, deposit.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.processDeposit(ctx, deposit); err != nil {
+		uc.logger.Error("Failed to credit released deposit, will retry",
+			zap.String("deposit_id", depositID),
+			zap.Error(err))
+	}
+
+	return uc.depositRepo.GetByID(ctx, deposit.ID)
+}
+
+// RejectFrozenDeposit refuses a frozen deposit after review; it is never
+// credited
+func (uc *DepositUsecase) RejectFrozenDeposit(
+	ctx context.Context,
+	depositID, reviewedBy, reason string,
+) error {
+	if reason == "" {
+		return fmt.Errorf("rejection reason is required")
+	}
+
+	deposit, err := uc.depositRepo.GetByDepositID(ctx, depositID)
+	if err != nil {
+		return fmt.Errorf("deposit not found: %w", err)
+	}
+
+	if err := uc.depositRepo.ReviewFrozenDeposit(ctx, deposit.ID, domain.DepositStatusFailed, reviewedBy, reason); err != nil {
+		return err
+	}
+
+	uc.logger.Warn("Frozen deposit rejected",
+		zap.String("deposit_id", depositID),
+		zap.String("user_id", deposit.UserID),
+		zap.String("reviewed_by", reviewedBy),
+		zap.String("reason", reason))
+
+	return nil
+}
@@ -6,12 +6,14 @@ import (
 	registry "crypto-service/internal/chains/registry"
 	"crypto-service/internal/domain"
 	"crypto-service/internal/repository"
-	"crypto-service/internal/security"
+	"crypto-service/internal/screening"
 	"crypto-service/pkg/utils"
 	"fmt"
 	"math/big"
 	"time"
 
+	notificationclient "x/shared/notification"
+
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
@@ -21,7 +23,11 @@ type DepositUsecase struct {
 	walletRepo      *repository.CryptoWalletRepository
 	transactionRepo *repository.CryptoTransactionRepository
 	chainRegistry   *registry.Registry
-	encryption      *security.Encryption
+	keys            *WalletKeys
+	screener        *screening.Screener
+	notifier        *notificationclient.NotificationService
+	complianceEmail string           // Recipient of frozen-deposit alerts
+	scanStartBlocks map[string]int64 // First block scanned per chain without a checkpoint
 	logger          *zap.Logger
 }
 
@@ -30,7 +36,11 @@ func NewDepositUsecase(
 	walletRepo *repository.CryptoWalletRepository,
 	transactionRepo *repository.CryptoTransactionRepository,
 	chainRegistry *registry.Registry,
-	encryption *security.Encryption,
+	keys *WalletKeys,
+	screener *screening.Screener,
+	notifier *notificationclient.NotificationService,
+	complianceEmail string,
+	scanStartBlocks map[string]int64,
 	logger *zap.Logger,
 ) *DepositUsecase {
 	return &DepositUsecase{
@@ -38,165 +48,15 @@ func NewDepositUsecase(
 		walletRepo:      walletRepo,
 		transactionRepo: transactionRepo,
 		chainRegistry:   chainRegistry,
-		encryption:      encryption,
+		keys:            keys,
+		screener:        screener,
+		notifier:        notifier,
+		complianceEmail: complianceEmail,
+		scanStartBlocks: scanStartBlocks,
 		logger:          logger,
 	}
 }
 
-// ============================================================================
-// DEPOSIT MONITORING
-// ============================================================================
-
-// MonitorDeposits scans blockchain for incoming deposits
-// This should be called by a background worker/cron job
-func (uc *DepositUsecase) MonitorDeposits(ctx context.Context, chainName string) error {
-	uc.logger.Info("Starting deposit monitoring", zap.String("chain", chainName))
-
-	// Get blockchain implementation
-	chain, err := uc.chainRegistry.Get(chainName)
-	if err != nil {
-		return fmt.Errorf("unsupported chain: %w", err)
-	}
-
-	// Get wallets that need deposit checking
-	wallets, err := uc.walletRepo.GetWalletsForDepositCheck(ctx, 100) // Check 100 wallets at a time
-	if err != nil {
-		return fmt.Errorf("failed to get wallets:  %w", err)
-	}
-
-	for _, wallet := range wallets {
-		// Skip if not for this chain
-		if wallet.Chain != chainName {
-			continue
-		}
-
-		// Check for deposits on this wallet
-		if err := uc.checkWalletDeposits(ctx, wallet, chain); err != nil {
-			uc.logger.Error("Failed to check wallet deposits",
-				zap.Error(err),
-				zap.Int64("wallet_id", wallet.ID),
-				zap.String("address", wallet.Address),
-			)
-			// Continue with other wallets even if one fails
-			continue
-		}
-
-		// Update last deposit check timestamp
-		uc.walletRepo.UpdateLastDepositCheck(ctx, wallet.ID)
-	}
-
-	return nil
-}
-
-// checkWalletDeposits checks a single wallet for new deposits
-func (uc *DepositUsecase) checkWalletDeposits(
-	ctx context.Context,
-	wallet *domain.CryptoWallet,
-	chain domain.Chain,
-) error {
-
-	// Get asset configuration
-	asset := utils.AssetFromChainAndCode(wallet.Chain, wallet.Asset)
-	if asset == nil {
-		return fmt.Errorf("unsupported asset: %s", wallet.Asset)
-	}
-	privateKey, err := uc.encryption.Decrypt(wallet.EncryptedPrivateKey)
-	if err != nil {
-
-		return fmt.Errorf("failed to decrypt private key: %w", err)
-	}
-	// Get current balance from blockchain
-	balance, err := chain.GetBalance(ctx, wallet.Address, privateKey, asset)
-	if err != nil {
-		return fmt.Errorf("failed to get balance: %w", err)
-	}
-
-	// Check if balance increased (potential deposit)
-	if balance.Amount.Cmp(wallet.Balance) > 0 {
-		uc.logger.Info("Balance increased, potential deposit detected",
-			zap.String("address", wallet.Address),
-			zap.String("previous", wallet.Balance.String()),
-			zap.String("current", balance.Amount.String()),
-		)
-
-		// Calculate deposit amount
-		depositAmount := new(big.Int).Sub(balance.Amount, wallet.Balance)
-
-		// TODO: Get actual transaction details from blockchain
-		// For now, we create a deposit record based on balance change
-		// In production, you'd fetch actual transaction from blockchain explorer/API
-
-		deposit := &domain.CryptoDeposit{
-			DepositID:             uuid.New().String(),
-			WalletID:              wallet.ID,
-			UserID:                wallet.UserID,
-			Chain:                 wallet.Chain,
-			Asset:                 wallet.Asset,
-			FromAddress:           "unknown", // Would get from blockchain tx
-			ToAddress:             wallet.Address,
-			Amount:                depositAmount,
-			TxHash:                "pending_lookup", // Would get from blockchain
-			BlockNumber:           0,                // Would get from blockchain
-			Confirmations:         0,
-			RequiredConfirmations: utils.GetRequiredConfirmations(wallet.Chain),
-			Status:                domain.DepositStatusDetected,
-			UserNotified:          false,
-			NotificationSent:      false,
-			DetectedAt:            time.Now(),
-		}
-
-		// Check if deposit already exists
-		exists, _ := uc.depositRepo.DepositExists(ctx, deposit.TxHash, deposit.ToAddress)
-		if !exists {
-			// Save deposit
-			if err := uc.depositRepo.Create(ctx, deposit); err != nil {
-				return fmt.Errorf("failed to create deposit: %w", err)
-			}
-
-		}
-
-		// Update wallet balance cache
-		uc.walletRepo.UpdateBalance(ctx, wallet.ID, balance.Amount)
-	}
-
-	return nil
-}
-
-// ScanBlockchainForDeposits scans blockchain for transactions to our wallets
-// More accurate than balance checking
-func (uc *DepositUsecase) ScanBlockchainForDeposits(
-	ctx context.Context,
-	chainName string,
-	fromBlock, toBlock int64,
-) error {
-
-	// Get blockchain implementation
-	chain, err := uc.chainRegistry.Get(chainName)
-	if err != nil {
-		return fmt.Errorf("unsupported chain: %w", err)
-	}
-	_ = chain
-
-	// Get all active wallets for this chain
-	wallets, err := uc.walletRepo.GetWalletsByChain(ctx, chainName)
-	if err != nil {
-		return fmt.Errorf("failed to get wallets: %w", err)
-	}
-
-	// Build address map for quick lookup
-	addressMap := make(map[string]*domain.CryptoWallet)
-	for _, wallet := range wallets {
-		addressMap[wallet.Address] = wallet
-	}
-
-	// TODO: Implement blockchain scanning logic
-	// This would use chain-specific APIs to fetch transactions
-	// For TRON:  Use TronGrid API to get transactions by block range
-	// For BTC: Use block explorer API or run full node
-
-	return nil
-}
-
 // ============================================================================
 // DEPOSIT PROCESSING
 // ============================================================================
@@ -247,6 +107,15 @@ func (uc *DepositUsecase) processDeposit(ctx context.Context, deposit *domain.Cr
 
 	// Check if enough confirmations
 	if deposit.Confirmations >= deposit.RequiredConfirmations {
+		// Screen the sender before the funds reach the user; a released
+		// deposit was already reviewed by an admin
+		if deposit.ReviewedBy == nil {
+			cleared, err := uc.screenDepositSource(ctx, deposit, tx.From)
+			if err != nil || !cleared {
+				return err
+			}
+		}
+
 		// Mark as confirmed
 		deposit.Status = domain.DepositStatusConfirmed
 
@@ -0,0 +1,527 @@
+// internal/usecase/key_rotation_usecase.go
+package usecase
+
+import (
+	"context"
+	registry "crypto-service/internal/chains/registry"
+	"crypto-service/internal/domain"
+	"crypto-service/internal/repository"
+	"crypto-service/internal/security"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultRotationBatchSize = 100
+	maxRotationBatchSize     = 1000
+
+	// verificationSampleSize is how many random wallets are decrypted after
+	// a rotation before the old KEK versions are retired
+	verificationSampleSize = 50
+)
+
+// KeyRotationUsecase manages the key-encryption keys (KEK) that wrap the
+// per-wallet data keys, and rotates the master key: a rotation activates a
+// new KEK version and re-wraps every stored wallet key in batches in the
+// background. Wallets keep working during a rotation because every KEK
+// version that still wraps a data key stays loaded.
+type KeyRotationUsecase struct {
+	rotationRepo  *repository.KeyRotationRepository
+	envelope      *security.EnvelopeEncryption
+	keys          *WalletKeys
+	chainRegistry *registry.Registry
+	logger        *zap.Logger
+
+	// jobCtx bounds the background rotation; cancelling it stops a rotation
+	// between batches, to be resumed at the next start
+	jobCtx  context.Context
+	running bool
+	mutex   sync.Mutex
+}
+
+func NewKeyRotationUsecase(
+	rotationRepo *repository.KeyRotationRepository,
+	envelope *security.EnvelopeEncryption,
+	keys *WalletKeys,
+	chainRegistry *registry.Registry,
+	logger *zap.Logger,
+) *KeyRotationUsecase {
+	return &KeyRotationUsecase{
+		rotationRepo:  rotationRepo,
+		envelope:      envelope,
+		keys:          keys,
+		chainRegistry: chainRegistry,
+		logger:        logger,
+		jobCtx:        context.Background(),
+	}
+}
+
+// KeyRotationReport is a rotation together with the state of every KEK
+type KeyRotationReport struct {
+	Rotation      *domain.KeyRotation // nil if the key was never rotated
+	Keys          []*domain.EncryptionKey
+	WalletsByKEK  map[int]int64
+	ActiveVersion int
+}
+
+// ============================================================================
+// STARTUP
+// ============================================================================
+
+// LoadKeys loads every KEK version that still wraps data keys and makes the
+// registered active version the one new wallets are sealed with. The master
+// key is registered as KEK 1 on first start. A key whose fingerprint differs
+// from the registered one is refused: it would not open the stored keys.
+func (uc *KeyRotationUsecase) LoadKeys(ctx context.Context) error {
+	masterFingerprint, err := uc.envelope.LoadKEK(ctx, domain.MasterKeyVersion)
+	if err != nil {
+		return err
+	}
+
+	keys, err := uc.rotationRepo.GetEncryptionKeys(ctx)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		key, err := uc.rotationRepo.EnsureEncryptionKey(ctx, domain.MasterKeyVersion, domain.EncryptionKeyActive, masterFingerprint)
+		if err != nil {
+			return err
+		}
+		keys = []*domain.EncryptionKey{key}
+		uc.logger.Info("Master key registered as KEK v1")
+	}
+
+	active := 0
+	for _, key := range keys {
+		if key.Status == domain.EncryptionKeyRetired {
+			continue
+		}
+
+		loaded, err := uc.envelope.LoadKEK(ctx, key.Version)
+		if err != nil {
+			return fmt.Errorf("kek v%d (%s) is not available: %w", key.Version, key.Status, err)
+		}
+		if loaded != key.Fingerprint {
+			return fmt.Errorf("kek v%d does not match its registered fingerprint", key.Version)
+		}
+
+		if key.Status == domain.EncryptionKeyActive {
+			active = key.Version
+		}
+	}
+	if active == 0 {
+		return fmt.Errorf("no active kek registered")
+	}
+
+	return uc.envelope.SetActiveVersion(active)
+}
+
+// Start resumes a rotation that was interrupted by a restart. Rotations
+// started later run under ctx as well.
+func (uc *KeyRotationUsecase) Start(ctx context.Context) error {
+	uc.mutex.Lock()
+	uc.jobCtx = ctx
+	uc.mutex.Unlock()
+
+	rotation, err := uc.rotationRepo.GetInProgressRotation(ctx)
+	if err != nil {
+		return err
+	}
+	if rotation == nil {
+		return nil
+	}
+
+	uc.logger.Info("Resuming key rotation",
+		zap.String("rotation_id", rotation.RotationID),
+		zap.Int("to_version", rotation.ToVersion),
+		zap.String("status", string(rotation.Status)),
+		zap.Int64("last_wallet_id", rotation.LastWalletID),
+		zap.Int64("processed", rotation.ProcessedWallets))
+
+	go uc.run(rotation)
+
+	return nil
+}
+
+// ============================================================================
+// ROTATION
+// ============================================================================
+
+// RotateMasterKey activates KEK toVersion and re-wraps every stored wallet
+// key with it in the background. The key must already be in the vault at
+// crypto/kek/v<N>. Running it again for the active version picks up wallets
+// a failed rotation left behind.
+func (uc *KeyRotationUsecase) RotateMasterKey(
+	ctx context.Context,
+	toVersion int,
+	startedBy string,
+	batchSize int,
+) (*domain.KeyRotation, error) {
+	if startedBy == "" {
+		return nil, fmt.Errorf("started_by is required")
+	}
+	if batchSize <= 0 {
+		batchSize = defaultRotationBatchSize
+	}
+	if batchSize > maxRotationBatchSize {
+		return nil, fmt.Errorf("batch size cannot exceed %d", maxRotationBatchSize)
+	}
+
+	fromVersion := uc.envelope.ActiveVersion()
+	if toVersion < fromVersion {
+		return nil, fmt.Errorf("kek v%d is older than the active kek v%d", toVersion, fromVersion)
+	}
+
+	inProgress, err := uc.rotationRepo.GetInProgressRotation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if inProgress != nil {
+		return nil, repository.ErrRotationInProgress
+	}
+
+	fingerprint, err := uc.envelope.LoadKEK(ctx, toVersion)
+	if err != nil {
+		return nil, fmt.Errorf("kek v%d must be stored in the vault at %s first: %w", toVersion, security.KEKPath(toVersion), err)
+	}
+
+	total, err := uc.rotationRepo.CountWalletsToRewrap(ctx, toVersion, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	rotation := &domain.KeyRotation{
+		FromVersion:  fromVersion,
+		ToVersion:    toVersion,
+		Status:       domain.KeyRotationRunning,
+		TotalWallets: total,
+		BatchSize:    batchSize,
+		StartedBy:    startedBy,
+	}
+	if err := uc.rotationRepo.StartRotation(ctx, rotation, fingerprint); err != nil {
+		return nil, err
+	}
+
+	// New wallets are sealed with the new KEK from now on
+	if err := uc.envelope.SetActiveVersion(toVersion); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Key rotation started",
+		zap.String("rotation_id", rotation.RotationID),
+		zap.Int("from_version", fromVersion),
+		zap.Int("to_version", toVersion),
+		zap.Int64("wallets", total),
+		zap.Int("batch_size", batchSize),
+		zap.String("started_by", startedBy))
+
+	go uc.run(rotation)
+
+	return rotation, nil
+}
+
+// GetKeyRotationStatus returns a rotation (the latest when rotationID is
+// empty) and the state of every KEK version
+func (uc *KeyRotationUsecase) GetKeyRotationStatus(ctx context.Context, rotationID string) (*KeyRotationReport, error) {
+	var rotation *domain.KeyRotation
+	var err error
+	if rotationID != "" {
+		rotation, err = uc.rotationRepo.GetRotation(ctx, rotationID)
+	} else {
+		rotation, err = uc.rotationRepo.GetLatestRotation(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := uc.rotationRepo.GetEncryptionKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := uc.rotationRepo.CountWalletsByKEK(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyRotationReport{
+		Rotation:      rotation,
+		Keys:          keys,
+		WalletsByKEK:  counts,
+		ActiveVersion: uc.envelope.ActiveVersion(),
+	}, nil
+}
+
+// run re-wraps the rotation's wallets from its cursor, then verifies a
+// sample. Only one rotation runs per instance.
+func (uc *KeyRotationUsecase) run(rotation *domain.KeyRotation) {
+	uc.mutex.Lock()
+	if uc.running {
+		uc.mutex.Unlock()
+		uc.logger.Warn("Key rotation already running in this instance",
+			zap.String("rotation_id", rotation.RotationID))
+		return
+	}
+	uc.running = true
+	ctx := uc.jobCtx
+	uc.mutex.Unlock()
+
+	defer func() {
+		uc.mutex.Lock()
+		uc.running = false
+		uc.mutex.Unlock()
+	}()
+
+	if rotation.Status == domain.KeyRotationRunning {
+		if err := uc.rewrapAll(ctx, rotation); err != nil {
+			if ctx.Err() != nil {
+				uc.logger.Info("Key rotation interrupted, will resume at next start",
+					zap.String("rotation_id", rotation.RotationID),
+					zap.Int64("last_wallet_id", rotation.LastWalletID))
+				return
+			}
+			uc.fail(ctx, rotation, domain.KeyRotationFailed, err.Error())
+			return
+		}
+
+		if rotation.FailedWallets > 0 {
+			uc.fail(ctx, rotation, domain.KeyRotationFailed,
+				fmt.Sprintf("%d wallet keys could not be re-wrapped", rotation.FailedWallets))
+			return
+		}
+
+		if err := uc.rotationRepo.UpdateRotationStatus(ctx, rotation.ID, domain.KeyRotationVerifying, nil); err != nil {
+			uc.logger.Error("Failed to update key rotation status", zap.Error(err))
+			return
+		}
+		rotation.Status = domain.KeyRotationVerifying
+	}
+
+	uc.verify(ctx, rotation)
+}
+
+// rewrapAll re-wraps wallet keys batch by batch; each batch and the cursor
+// are committed together
+func (uc *KeyRotationUsecase) rewrapAll(ctx context.Context, rotation *domain.KeyRotation) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		wallets, err := uc.rotationRepo.GetWalletsToRewrap(ctx, rotation.ToVersion, rotation.LastWalletID, rotation.BatchSize)
+		if err != nil {
+			return err
+		}
+		if len(wallets) == 0 {
+			return nil
+		}
+
+		rewrapped := make([]*domain.RewrappedWallet, 0, len(wallets))
+		failed := 0
+		var lastError *string
+		for _, wallet := range wallets {
+			result, err := uc.rewrap(ctx, wallet, rotation.ToVersion)
+			if err != nil {
+				failed++
+				msg := fmt.Sprintf("wallet %d: %v", wallet.ID, err)
+				lastError = &msg
+				uc.logger.Error("Failed to re-wrap wallet key",
+					zap.String("rotation_id", rotation.RotationID),
+					zap.Int64("wallet_id", wallet.ID),
+					zap.Error(err))
+				continue
+			}
+			rewrapped = append(rewrapped, result)
+		}
+
+		lastWalletID := wallets[len(wallets)-1].ID
+		updated, err := uc.rotationRepo.SaveRewrapBatch(ctx, rotation.ID, rewrapped, failed, lastWalletID, lastError)
+		if err != nil {
+			return err
+		}
+
+		rotation.ProcessedWallets += int64(len(rewrapped))
+		rotation.FailedWallets += int64(failed)
+		rotation.LastWalletID = lastWalletID
+
+		uc.logger.Info("Key rotation batch done",
+			zap.String("rotation_id", rotation.RotationID),
+			zap.Int("rewrapped", updated),
+			zap.Int("failed", failed),
+			zap.Int64("processed", rotation.ProcessedWallets),
+			zap.Int64("total", rotation.TotalWallets),
+			zap.Int64("last_wallet_id", lastWalletID))
+	}
+}
+
+// rewrap seals one wallet key under KEK toVersion: envelope keys get their
+// data key re-wrapped, master-key (v1) keys move to a data key of their own
+func (uc *KeyRotationUsecase) rewrap(
+	ctx context.Context,
+	wallet *domain.CryptoWallet,
+	toVersion int,
+) (*domain.RewrappedWallet, error) {
+	var sealed *security.SealedSecret
+	var err error
+
+	switch wallet.EncryptionVersion {
+	case domain.EncryptionVersionEnvelope:
+		if wallet.WrappedDataKey == nil || wallet.KEKVersion == nil {
+			return nil, fmt.Errorf("no wrapped data key")
+		}
+		sealed, err = uc.envelope.Rewrap(ctx, &security.SealedSecret{
+			Ciphertext: wallet.EncryptedPrivateKey,
+			WrappedKey: *wallet.WrappedDataKey,
+			KEKVersion: *wallet.KEKVersion,
+		}, toVersion)
+	case domain.EncryptionVersionMasterKey:
+		var plaintext string
+		if plaintext, err = uc.envelope.DecryptLegacy(wallet.EncryptedPrivateKey); err == nil {
+			sealed, err = uc.envelope.SealWith(ctx, toVersion, plaintext)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported encryption version %q", wallet.EncryptionVersion)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.RewrappedWallet{
+		WalletID:       wallet.ID,
+		OldVersion:     wallet.EncryptionVersion,
+		OldKEKVersion:  wallet.KEKVersion,
+		EncryptedKey:   sealed.Ciphertext,
+		WrappedDataKey: sealed.WrappedKey,
+		KEKVersion:     sealed.KEKVersion,
+	}, nil
+}
+
+// ============================================================================
+// VERIFICATION
+// ============================================================================
+
+// verify decrypts a random sample of wallets and checks that each key still
+// belongs to its address. Only then are KEK versions that no longer wrap any
+// data key retired.
+func (uc *KeyRotationUsecase) verify(ctx context.Context, rotation *domain.KeyRotation) {
+	sample, err := uc.rotationRepo.GetVerificationSample(ctx, verificationSampleSize)
+	if err != nil {
+		uc.fail(ctx, rotation, domain.KeyRotationVerificationFailed, err.Error())
+		return
+	}
+
+	failures := 0
+	var lastError *string
+	for _, wallet := range sample {
+		if err := uc.verifyWallet(ctx, wallet); err != nil {
+			failures++
+			msg := fmt.Sprintf("wallet %d: %v", wallet.ID, err)
+			lastError = &msg
+			uc.logger.Error("Key rotation verification failed for wallet",
+				zap.String("rotation_id", rotation.RotationID),
+				zap.Int64("wallet_id", wallet.ID),
+				zap.Error(err))
+		}
+	}
+
+	status := domain.KeyRotationCompleted
+	if failures > 0 {
+		status = domain.KeyRotationVerificationFailed
+	}
+
+	if err := uc.rotationRepo.SaveVerification(ctx, rotation.ID, status, len(sample), failures, lastError); err != nil {
+		uc.logger.Error("Failed to save key rotation verification", zap.Error(err))
+		return
+	}
+	rotation.Status = status
+
+	if status != domain.KeyRotationCompleted {
+		uc.logger.Error("Key rotation verification failed, old keys kept",
+			zap.String("rotation_id", rotation.RotationID),
+			zap.Int("sample", len(sample)),
+			zap.Int("failures", failures))
+		return
+	}
+
+	uc.logger.Info("Key rotation completed",
+		zap.String("rotation_id", rotation.RotationID),
+		zap.Int("to_version", rotation.ToVersion),
+		zap.Int64("processed", rotation.ProcessedWallets),
+		zap.Int("verified_sample", len(sample)))
+
+	uc.retireUnusedKeys(ctx)
+}
+
+// verifyWallet decrypts a wallet's stored key and re-imports it to compare
+// the address; Circle wallets store a wallet ID, which only has to decrypt
+func (uc *KeyRotationUsecase) verifyWallet(ctx context.Context, wallet *domain.CryptoWallet) error {
+	plaintext, err := uc.keys.DecryptStoredKey(ctx, wallet)
+	if err != nil {
+		return err
+	}
+	if walletTypeFor(wallet.Chain, wallet.Asset) == domain.WalletTypeCircle {
+		return nil
+	}
+
+	chain, err := uc.chainRegistry.Get(wallet.Chain)
+	if err != nil {
+		// Chain disabled here; the authenticated decryption is all we can check
+		return nil
+	}
+
+	imported, err := chain.ImportWallet(ctx, plaintext)
+	if err != nil {
+		return fmt.Errorf("decrypted key does not import: %w", err)
+	}
+	if domain.NormalizeAddress(imported.Address) != domain.NormalizeAddress(wallet.Address) {
+		return fmt.Errorf("decrypted key belongs to %s, not %s", imported.Address, wallet.Address)
+	}
+
+	return nil
+}
+
+// retireUnusedKeys retires decrypt-only KEK versions that no wallet needs
+func (uc *KeyRotationUsecase) retireUnusedKeys(ctx context.Context) {
+	keys, err := uc.rotationRepo.GetEncryptionKeys(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to get encryption keys", zap.Error(err))
+		return
+	}
+
+	counts, err := uc.rotationRepo.CountWalletsByKEK(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to count wallets by kek", zap.Error(err))
+		return
+	}
+
+	for _, key := range keys {
+		if key.Status != domain.EncryptionKeyDecryptOnly || counts[key.Version] > 0 {
+			continue
+		}
+		if err := uc.rotationRepo.RetireEncryptionKey(ctx, key.Version); err != nil {
+			uc.logger.Error("Failed to retire kek", zap.Int("version", key.Version), zap.Error(err))
+			continue
+		}
+		uc.logger.Info("KEK retired", zap.Int("version", key.Version))
+	}
+}
+
+func (uc *KeyRotationUsecase) fail(
+	ctx context.Context,
+	rotation *domain.KeyRotation,
+	status domain.KeyRotationStatus,
+	reason string,
+) {
+	uc.logger.Error("Key rotation failed",
+		zap.String("rotation_id", rotation.RotationID),
+		zap.String("status", string(status)),
+		zap.String("reason", reason))
+
+	if err := uc.rotationRepo.UpdateRotationStatus(ctx, rotation.ID, status, &reason); err != nil {
+		uc.logger.Error("Failed to update key rotation status", zap.Error(err))
+		return
+	}
+	rotation.Status = status
+}
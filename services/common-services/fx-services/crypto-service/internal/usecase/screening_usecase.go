@@ -0,0 +1,254 @@
+// internal/usecase/screening_usecase.go
+package usecase
+
+import (
+	"context"
+	registry "crypto-service/internal/chains/registry"
+	"crypto-service/internal/domain"
+	"crypto-service/internal/repository"
+	"crypto-service/internal/screening"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// maxImportErrors caps the rejected-row messages returned by an import
+const maxImportErrors = 20
+
+type ScreeningUsecase struct {
+	screeningRepo *repository.AddressScreeningRepository
+	screener      *screening.Screener
+	chainRegistry *registry.Registry
+	listsDir      string
+	logger        *zap.Logger
+}
+
+func NewScreeningUsecase(
+	screeningRepo *repository.AddressScreeningRepository,
+	screener *screening.Screener,
+	chainRegistry *registry.Registry,
+	listsDir string,
+	logger *zap.Logger,
+) *ScreeningUsecase {
+	return &ScreeningUsecase{
+		screeningRepo: screeningRepo,
+		screener:      screener,
+		chainRegistry: chainRegistry,
+		listsDir:      listsDir,
+		logger:        logger,
+	}
+}
+
+// ImportListRequest imports a sanction list, either from a file in the lists
+// directory or from content uploaded with the request
+type ImportListRequest struct {
+	ListName   string
+	ListType   domain.AddressListType
+	Format     screening.ListFormat // Derived from FilePath when empty
+	FilePath   string               // Relative to the lists directory
+	Content    []byte               // Used when FilePath is empty
+	Chain      string               // For rows without a chain; AnyChain when empty
+	Category   string               // For rows without a category
+	ImportedBy string
+}
+
+// ImportListResult summarizes an import
+type ImportListResult struct {
+	ListName string
+	Rows     int
+	Imported int // Entries the list holds now (duplicates stored once)
+	Rejected int
+	Errors   []string
+}
+
+// ============================================================================
+// ADDRESS LIST MANAGEMENT
+// ============================================================================
+
+// AddEntry puts one address on the blacklist or whitelist
+func (uc *ScreeningUsecase) AddEntry(ctx context.Context, entry *domain.AddressListEntry) error {
+	if entry.ListType != domain.AddressListBlacklist && entry.ListType != domain.AddressListWhitelist {
+		return fmt.Errorf("invalid list type: %s", entry.ListType)
+	}
+	if entry.AddedBy == "" {
+		return fmt.Errorf("added_by is required")
+	}
+	if entry.ListName == "" {
+		entry.ListName = domain.ManualListName
+	}
+
+	chain, err := uc.validateEntry(entry.Chain, entry.Address)
+	if err != nil {
+		return err
+	}
+	entry.Chain = chain
+	entry.Address = strings.TrimSpace(entry.Address)
+
+	if err := uc.screeningRepo.AddEntry(ctx, entry); err != nil {
+		return err
+	}
+
+	uc.logger.Info("Address list entry added",
+		zap.String("list_type", string(entry.ListType)),
+		zap.String("list_name", entry.ListName),
+		zap.String("chain", entry.Chain),
+		zap.String("address", entry.Address),
+		zap.String("added_by", entry.AddedBy))
+
+	return nil
+}
+
+// RemoveEntry takes an entry off its list
+func (uc *ScreeningUsecase) RemoveEntry(ctx context.Context, id int64, removedBy string) error {
+	if err := uc.screeningRepo.DeactivateEntry(ctx, id); err != nil {
+		return err
+	}
+
+	uc.logger.Info("Address list entry removed",
+		zap.Int64("entry_id", id),
+		zap.String("removed_by", removedBy))
+
+	return nil
+}
+
+// ListEntries returns list entries matching filter and the total match count
+func (uc *ScreeningUsecase) ListEntries(
+	ctx context.Context,
+	filter repository.AddressListFilter,
+	limit, offset int,
+) ([]*domain.AddressListEntry, int64, error) {
+	if filter.Chain != "" {
+		filter.Chain = strings.ToUpper(filter.Chain)
+	}
+	return uc.screeningRepo.ListEntries(ctx, filter, limit, offset)
+}
+
+// ImportList replaces the named list with the parsed content of a CSV/JSON
+// sanction list. Invalid rows are rejected and reported; the import fails
+// instead of emptying the list when no row is valid.
+func (uc *ScreeningUsecase) ImportList(ctx context.Context, req *ImportListRequest) (*ImportListResult, error) {
+	if req.ListName == "" {
+		return nil, fmt.Errorf("list_name is required")
+	}
+	if req.ListName == domain.ManualListName {
+		return nil, fmt.Errorf("list name %q is reserved for manual entries", domain.ManualListName)
+	}
+	if req.ListType == "" {
+		req.ListType = domain.AddressListBlacklist
+	}
+	if req.ListType != domain.AddressListBlacklist && req.ListType != domain.AddressListWhitelist {
+		return nil, fmt.Errorf("invalid list type: %s", req.ListType)
+	}
+	if req.ImportedBy == "" {
+		return nil, fmt.Errorf("imported_by is required")
+	}
+
+	data := req.Content
+	format := req.Format
+	if req.FilePath != "" {
+		var err error
+		if data, err = screening.ReadListFile(uc.listsDir, req.FilePath); err != nil {
+			return nil, err
+		}
+		if format == "" {
+			if format, err = screening.ListFormatFromPath(req.FilePath); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("file_path or content is required")
+	}
+
+	rows, err := screening.ParseList(format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImportListResult{
+		ListName: req.ListName,
+		Rows:     len(rows),
+	}
+
+	entries := make([]*domain.AddressListEntry, 0, len(rows))
+	for i, row := range rows {
+		chain := row.Chain
+		if chain == "" {
+			chain = req.Chain
+		}
+
+		chain, err := uc.validateEntry(chain, row.Address)
+		if err != nil {
+			result.Rejected++
+			if len(result.Errors) < maxImportErrors {
+				result.Errors = append(result.Errors, fmt.Sprintf("row %d: %v", i+1, err))
+			}
+			continue
+		}
+
+		row.Chain = chain
+		if row.Category == "" {
+			row.Category = req.Category
+		}
+		entries = append(entries, row)
+	}
+
+	if len(entries) == 0 {
+		return result, fmt.Errorf("list %s has no valid entries", req.ListName)
+	}
+
+	if result.Imported, err = uc.screeningRepo.ReplaceList(ctx, req.ListType, req.ListName, entries, req.ImportedBy); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Address list imported",
+		zap.String("list_name", req.ListName),
+		zap.String("list_type", string(req.ListType)),
+		zap.Int("rows", result.Rows),
+		zap.Int("imported", result.Imported),
+		zap.Int("rejected", result.Rejected),
+		zap.String("imported_by", req.ImportedBy))
+
+	return result, nil
+}
+
+// ScreenAddress screens an address on demand
+func (uc *ScreeningUsecase) ScreenAddress(
+	ctx context.Context,
+	chain, address, requestedBy string,
+) (*domain.ScreeningResult, error) {
+	chain = strings.ToUpper(chain)
+	if _, err := uc.chainRegistry.Get(chain); err != nil {
+		return nil, fmt.Errorf("unsupported chain: %w", err)
+	}
+	if address == "" {
+		return nil, fmt.Errorf("address is required")
+	}
+
+	return uc.screener.Screen(ctx, domain.ScreeningDirectionManual, requestedBy, chain, strings.TrimSpace(address))
+}
+
+// validateEntry checks a list entry's chain and address and returns the
+// chain name to store; an empty chain means every chain
+func (uc *ScreeningUsecase) validateEntry(chain, address string) (string, error) {
+	address = strings.TrimSpace(address)
+	if address == "" {
+		return "", fmt.Errorf("address is required")
+	}
+
+	chain = strings.ToUpper(strings.TrimSpace(chain))
+	if chain == "" || chain == domain.AnyChain {
+		return domain.AnyChain, nil
+	}
+
+	impl, err := uc.chainRegistry.Get(chain)
+	if err != nil {
+		return "", fmt.Errorf("unsupported chain: %s", chain)
+	}
+	if err := impl.ValidateAddress(address); err != nil {
+		return "", fmt.Errorf("invalid %s address %s: %w", chain, address, err)
+	}
+
+	return chain, nil
+}
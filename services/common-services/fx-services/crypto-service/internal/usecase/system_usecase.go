@@ -8,7 +8,6 @@ import (
 
 	"crypto-service/internal/domain"
 	"crypto-service/internal/repository"
-	"crypto-service/internal/security"
 	"fmt"
 	"math/big"
 	"time"
@@ -23,20 +22,20 @@ const (
 type SystemUsecase struct {
 	walletRepo    *repository.CryptoWalletRepository
 	chainRegistry *registry.Registry
-	encryption    *security.Encryption
+	keys          *WalletKeys
 	logger        *zap.Logger
 }
 
 func NewSystemUsecase(
 	walletRepo *repository.CryptoWalletRepository,
 	chainRegistry *registry.Registry,
-	encryption *security.Encryption,
+	keys *WalletKeys,
 	logger *zap.Logger,
 ) *SystemUsecase {
 	return &SystemUsecase{
 		walletRepo:    walletRepo,
 		chainRegistry: chainRegistry,
-		encryption:    encryption,
+		keys:          keys,
 		logger:        logger,
 	}
 }
@@ -90,7 +89,8 @@ func (uc *SystemUsecase) InitializeSystemWallets(ctx context.Context) error {
 
 			walletCtx := uc.prepareWalletContext(ctx, chainName, assetCode, "SYSTEM")
 
-			wallet, err := chain.GenerateWallet(walletCtx)
+			// Hot wallets keep their own encrypted key, even in HD mode
+			systemWallet, err := uc.keys.NewEncryptedWallet(walletCtx, chain)
 			if err != nil {
 				uc.logger.Error("Failed to generate system wallet",
 					zap.String("chain", chainName),
@@ -99,29 +99,15 @@ func (uc *SystemUsecase) InitializeSystemWallets(ctx context.Context) error {
 				continue
 			}
 
-			// Encrypt private key
-			encryptedPrivateKey, err := uc.encryption.Encrypt(wallet.PrivateKey)
-			if err != nil {
-				uc.logger.Error("Failed to encrypt private key",
-					zap.Error(err))
-				continue
-			}
-
 			// Create wallet record
 			label := fmt.Sprintf("System %s Wallet", assetCode)
-			systemWallet := &domain.CryptoWallet{
-				UserID:              SystemUserID,
-				Chain:               chainName,
-				Asset:               assetCode,
-				Address:             wallet.Address,
-				PublicKey:           &wallet.PublicKey,
-				EncryptedPrivateKey: encryptedPrivateKey,
-				EncryptionVersion:   "v1",
-				Label:               &label,
-				IsPrimary:           true,
-				IsActive:            true,
-				Balance:             big.NewInt(0),
-			}
+			systemWallet.UserID = SystemUserID
+			systemWallet.Chain = chainName
+			systemWallet.Asset = assetCode
+			systemWallet.Label = &label
+			systemWallet.IsPrimary = true
+			systemWallet.IsActive = true
+			systemWallet.Balance = big.NewInt(0)
 
 			// Save to database
 			if err := uc.walletRepo.Create(ctx, systemWallet); err != nil {
@@ -135,7 +121,7 @@ func (uc *SystemUsecase) InitializeSystemWallets(ctx context.Context) error {
 			uc.logger.Info("System wallet created successfully",
 				zap.String("chain", chainName),
 				zap.String("asset", assetCode),
-				zap.String("address", wallet.Address),
+				zap.String("address", systemWallet.Address),
 				zap.Int64("wallet_id", systemWallet.ID))
 
 			createdCount++
@@ -164,11 +150,9 @@ func (uc *SystemUsecase) prepareWalletContext(
 	ctx = context.WithValue(ctx, domain.ChainKey, chainName)
 
 	//  Determine wallet type based on chain and asset
-	walletType := "standard" // Default
+	walletType := walletTypeFor(chainName, assetCode)
 
-	// Use Circle for USDC on Ethereum
-	if chainName == "ETHEREUM" && assetCode == "USDC" {
-		walletType = "circle"
+	if walletType == domain.WalletTypeCircle {
 		uc.logger.Info("Wallet type determined",
 			zap.String("type", walletType),
 			zap.String("reason", "USDC on Ethereum with Circle enabled"))
@@ -210,12 +194,12 @@ func (uc *SystemUsecase) GetSystemBalance(
 
 	// Get fresh balance from blockchain
 	asset := utils.AssetFromChainAndCode(chainName, assetCode)
-	privateKey, err := uc.encryption.Decrypt(wallet.EncryptedPrivateKey)
+	walletID, err := uc.keys.BalanceWalletID(ctx, wallet)
 	if err != nil {
 
-		return nil, fmt.Errorf("failed to decrypt private key: %w", err)
+		return nil, fmt.Errorf("failed to decrypt wallet ID: %w", err)
 	}
-	balance, err := chain.GetBalance(ctx, wallet.Address, privateKey, asset)
+	balance, err := chain.GetBalance(ctx, wallet.Address, walletID, asset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get balance: %w", err)
 	}
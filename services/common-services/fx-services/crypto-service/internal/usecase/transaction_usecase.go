@@ -9,8 +9,8 @@ import (
 
 	"crypto-service/internal/domain"
 	"crypto-service/internal/repository"
-	"crypto-service/internal/security"
 	"fmt"
+	accountingclient "x/shared/common/accounting"
 	"math/big"
 	"time"
 
@@ -23,9 +23,10 @@ type TransactionUsecase struct {
 	walletRepo      *repository.CryptoWalletRepository
 	approvalRepo    *repository.WithdrawalApprovalRepository
 	chainRegistry   *registry.Registry
-	encryption      *security.Encryption
+	keys            *WalletKeys
 	systemUsecase   *SystemUsecase
 	riskAssessor    *risk.RiskAssessor
+	accounting      *accountingclient.AccountingClient // Captures/releases withdrawal holds
 	logger          *zap.Logger
 }
 
@@ -34,9 +35,10 @@ func NewTransactionUsecase(
 	walletRepo *repository.CryptoWalletRepository,
 	approvalRepo *repository.WithdrawalApprovalRepository,
 	chainRegistry *registry.Registry,
-	encryption *security.Encryption,
+	keys *WalletKeys,
 	systemUsecase *SystemUsecase,
 	riskAssessor *risk.RiskAssessor,
+	accounting *accountingclient.AccountingClient,
 	logger *zap.Logger,
 ) *TransactionUsecase {
 	return &TransactionUsecase{
@@ -44,9 +46,10 @@ func NewTransactionUsecase(
 		walletRepo:      walletRepo,
 		approvalRepo:    approvalRepo,
 		chainRegistry:   chainRegistry,
-		encryption:      encryption,
+		keys:            keys,
 		systemUsecase:   systemUsecase,
 		riskAssessor:    riskAssessor,
+		accounting:      accounting,
 		logger:          logger,
 	}
 }
@@ -131,10 +134,10 @@ func (uc *TransactionUsecase) SweepUserWallet(
 		return nil, err
 	}
 
-	// 9. Decrypt user wallet private key
-	privateKey, err := uc.encryption.Decrypt(userWallet.EncryptedPrivateKey)
+	// 9. Resolve user wallet private key (derived or decrypted)
+	privateKey, err := uc.keys.PrivateKey(ctx, userWallet)
 	if err != nil {
-		uc.transactionRepo.MarkAsFailed(ctx, tx.ID, "Failed to decrypt key")
+		uc.transactionRepo.MarkAsFailed(ctx, tx.ID, "Failed to resolve wallet key")
 		return nil, err
 	}
 
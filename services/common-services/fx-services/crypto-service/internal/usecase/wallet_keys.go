@@ -0,0 +1,269 @@
+// internal/usecase/wallet_keys.go
+package usecase
+
+import (
+	"context"
+	registry "crypto-service/internal/chains/registry"
+	"crypto-service/internal/domain"
+	"crypto-service/internal/repository"
+	"crypto-service/internal/security"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// WalletKeys creates wallet key material and resolves signing keys for both
+// kinds of wallets: derived (HD) wallets re-derive their key from the chain's
+// extended key, legacy wallets decrypt their stored random key (sealed with
+// a per-wallet data key, or encrypted with the master key before that).
+type WalletKeys struct {
+	walletRepo    *repository.CryptoWalletRepository
+	chainRegistry *registry.Registry
+	envelope      *security.EnvelopeEncryption
+	keyring       *security.HDKeyring
+	mode          domain.WalletKeyMode
+	logger        *zap.Logger
+}
+
+func NewWalletKeys(
+	walletRepo *repository.CryptoWalletRepository,
+	chainRegistry *registry.Registry,
+	envelope *security.EnvelopeEncryption,
+	keyring *security.HDKeyring,
+	mode domain.WalletKeyMode,
+	logger *zap.Logger,
+) *WalletKeys {
+	return &WalletKeys{
+		walletRepo:    walletRepo,
+		chainRegistry: chainRegistry,
+		envelope:      envelope,
+		keyring:       keyring,
+		mode:          mode,
+		logger:        logger,
+	}
+}
+
+// Mode returns the configured key mode
+func (k *WalletKeys) Mode() domain.WalletKeyMode {
+	return k.mode
+}
+
+// Validate checks that every HD-capable chain has an extended key when new
+// wallets are derived
+func (k *WalletKeys) Validate(ctx context.Context) error {
+	switch k.mode {
+	case domain.WalletKeyModeLegacy:
+		return nil
+	case domain.WalletKeyModeMigrate, domain.WalletKeyModeHD:
+	default:
+		return fmt.Errorf("unknown wallet key mode: %s", k.mode)
+	}
+
+	var missing []string
+	for _, name := range k.chainRegistry.List() {
+		chain, err := k.chainRegistry.Get(name)
+		if err != nil {
+			continue
+		}
+		if _, ok := chain.(domain.HDChain); ok && !k.keyring.HasChain(ctx, name) {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("wallet key mode %s needs an HD extended key for: %s", k.mode, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// ============================================================================
+// NEW WALLETS
+// ============================================================================
+
+// NewWallet creates the key material of a new user deposit wallet. The
+// wallet is derived when the mode allows it and the chain supports it;
+// Circle wallets and legacy mode fall back to NewEncryptedWallet. Only
+// Address, PublicKey and the key fields are set.
+func (k *WalletKeys) NewWallet(
+	ctx context.Context,
+	chain domain.Chain,
+	userID string,
+) (*domain.CryptoWallet, error) {
+	walletType, _ := ctx.Value(domain.WalletTypeKey).(string)
+	hdChain, ok := chain.(domain.HDChain)
+	if k.mode == domain.WalletKeyModeLegacy || !ok || walletType == domain.WalletTypeCircle {
+		return k.NewEncryptedWallet(ctx, chain)
+	}
+
+	account, index, err := k.walletRepo.AllocateDerivationIndex(ctx, userID, chain.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	path := security.PurposePath(hdPurpose(hdChain), hdChain.CoinType(), account, index)
+	wallet, err := k.deriveWallet(ctx, chain.Name(), hdChain, path)
+	if err != nil {
+		return nil, err
+	}
+
+	pathStr := path.String()
+	k.logger.Info("Derived HD wallet",
+		zap.String("user_id", userID),
+		zap.String("chain", chain.Name()),
+		zap.String("path", pathStr),
+		zap.String("address", wallet.Address))
+
+	return &domain.CryptoWallet{
+		Address:           wallet.Address,
+		PublicKey:         &wallet.PublicKey,
+		EncryptionVersion: domain.EncryptionVersionHD,
+		DerivationPath:    &pathStr,
+	}, nil
+}
+
+// NewEncryptedWallet generates a wallet from a random key (or a Circle
+// wallet) and stores the key encrypted, as all wallets did before HD. The
+// key is sealed with its own data key, wrapped by the active KEK.
+func (k *WalletKeys) NewEncryptedWallet(ctx context.Context, chain domain.Chain) (*domain.CryptoWallet, error) {
+	walletKeys, err := chain.GenerateWallet(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate wallet: %w", err)
+	}
+
+	// Encrypt private key (or Circle wallet ID)
+	sealed, err := k.envelope.Seal(ctx, walletKeys.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt private key: %w", err)
+	}
+
+	return &domain.CryptoWallet{
+		Address:             walletKeys.Address,
+		PublicKey:           &walletKeys.PublicKey,
+		EncryptedPrivateKey: sealed.Ciphertext,
+		EncryptionVersion:   domain.EncryptionVersionEnvelope,
+		WrappedDataKey:      &sealed.WrappedKey,
+		KEKVersion:          &sealed.KEKVersion,
+	}, nil
+}
+
+// ============================================================================
+// SIGNING KEYS
+// ============================================================================
+
+// PrivateKey returns the wallet's signing key in the chain's format, for
+// sweeps and withdrawals only. It is never persisted or logged.
+func (k *WalletKeys) PrivateKey(ctx context.Context, wallet *domain.CryptoWallet) (string, error) {
+	if !wallet.IsDerived() {
+		if k.mode == domain.WalletKeyModeHD && k.derivesWalletsFor(wallet) {
+			return "", fmt.Errorf("legacy key of wallet %d is disabled in hd mode", wallet.ID)
+		}
+		return k.DecryptStoredKey(ctx, wallet)
+	}
+
+	chain, err := k.chainRegistry.Get(wallet.Chain)
+	if err != nil {
+		return "", err
+	}
+	hdChain, ok := chain.(domain.HDChain)
+	if !ok {
+		return "", fmt.Errorf("chain %s does not support HD wallets", wallet.Chain)
+	}
+
+	path, err := security.ParseDerivationPath(*wallet.DerivationPath)
+	if err != nil {
+		return "", err
+	}
+
+	derived, err := k.deriveWallet(ctx, wallet.Chain, hdChain, path)
+	if err != nil {
+		return "", err
+	}
+
+	// A different address means the extended key changed; never sign with it
+	if derived.Address != wallet.Address {
+		return "", fmt.Errorf("derived address for wallet %d does not match %s", wallet.ID, wallet.Address)
+	}
+
+	return derived.PrivateKey, nil
+}
+
+// BalanceWalletID returns what Chain.GetBalance expects as walletID: the
+// Circle wallet ID for Circle wallets and nothing otherwise, so balance
+// checks never touch signing keys
+func (k *WalletKeys) BalanceWalletID(ctx context.Context, wallet *domain.CryptoWallet) (string, error) {
+	if wallet.IsDerived() || walletTypeFor(wallet.Chain, wallet.Asset) != domain.WalletTypeCircle {
+		return "", nil
+	}
+	return k.DecryptStoredKey(ctx, wallet)
+}
+
+// DecryptStoredKey decrypts the key (or Circle wallet ID) stored with a
+// non-derived wallet, whichever way it was encrypted
+func (k *WalletKeys) DecryptStoredKey(ctx context.Context, wallet *domain.CryptoWallet) (string, error) {
+	switch wallet.EncryptionVersion {
+	case domain.EncryptionVersionEnvelope:
+		if wallet.WrappedDataKey == nil || wallet.KEKVersion == nil {
+			return "", fmt.Errorf("wallet %d has no wrapped data key", wallet.ID)
+		}
+		return k.envelope.Open(ctx, &security.SealedSecret{
+			Ciphertext: wallet.EncryptedPrivateKey,
+			WrappedKey: *wallet.WrappedDataKey,
+			KEKVersion: *wallet.KEKVersion,
+		})
+	case domain.EncryptionVersionMasterKey:
+		return k.envelope.DecryptLegacy(wallet.EncryptedPrivateKey)
+	default:
+		return "", fmt.Errorf("wallet %d has unsupported encryption version %q", wallet.ID, wallet.EncryptionVersion)
+	}
+}
+
+// deriveWallet derives the key at path and builds the chain's wallet from it
+func (k *WalletKeys) deriveWallet(
+	ctx context.Context,
+	chainName string,
+	hdChain domain.HDChain,
+	path security.DerivationPath,
+) (*domain.Wallet, error) {
+	key, err := k.keyring.DerivePrivateKey(ctx, chainName, path)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(key)
+
+	if purposeChain, ok := hdChain.(domain.HDPurposeChain); ok {
+		return purposeChain.WalletFromPurposeKey(key, path.Purpose())
+	}
+	return hdChain.WalletFromKey(key)
+}
+
+// hdPurpose returns the BIP43 purpose new wallets of chain are derived under
+func hdPurpose(chain domain.HDChain) uint32 {
+	if purposeChain, ok := chain.(domain.HDPurposeChain); ok {
+		return purposeChain.Purpose()
+	}
+	return security.PurposeBIP44
+}
+
+// derivesWalletsFor reports whether a new wallet like this one would be
+// derived; system hot wallets and Circle wallets keep encrypted keys
+func (k *WalletKeys) derivesWalletsFor(wallet *domain.CryptoWallet) bool {
+	if wallet.UserID == SystemUserID || walletTypeFor(wallet.Chain, wallet.Asset) == domain.WalletTypeCircle {
+		return false
+	}
+	chain, err := k.chainRegistry.Get(wallet.Chain)
+	if err != nil {
+		return false
+	}
+	_, ok := chain.(domain.HDChain)
+	return ok
+}
+
+// walletTypeFor returns the wallet type used for chain/asset
+func walletTypeFor(chainName, assetCode string) string {
+	// Use Circle for USDC on Ethereum
+	if chainName == "ETHEREUM" && assetCode == "USDC" {
+		return domain.WalletTypeCircle
+	}
+	return domain.WalletTypeStandard
+}
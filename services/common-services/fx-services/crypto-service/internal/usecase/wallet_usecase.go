@@ -8,7 +8,6 @@ import (
 		"crypto-service/pkg/utils"
 
 	"crypto-service/internal/repository"
-	"crypto-service/internal/security"
 	"fmt"
 	"math/big"
 	"time"
@@ -19,20 +18,20 @@ import (
 type WalletUsecase struct {
 	walletRepo    *repository.CryptoWalletRepository
 	chainRegistry *registry.Registry
-	encryption    *security.Encryption
+	keys          *WalletKeys
 	logger        *zap.Logger
 }
 
 func NewWalletUsecase(
 	walletRepo *repository.CryptoWalletRepository,
 	chainRegistry *registry.Registry,
-	encryption *security.Encryption,
+	keys *WalletKeys,
 	logger *zap.Logger,
 ) *WalletUsecase {
 	return &WalletUsecase{
 		walletRepo:    walletRepo,
 		chainRegistry: chainRegistry,
-		encryption:    encryption,
+		keys:          keys,
 		logger:        logger,
 	}
 }
@@ -62,34 +61,23 @@ func (uc *WalletUsecase) CreateWallet(
 	//  3. Prepare context with wallet generation metadata
 	walletCtx := uc.prepareWalletContext(ctx, chainName, assetCode, userID)
 	
-	// 4. Generate new wallet using chain interface with context
-	walletKeys, err := chain.GenerateWallet(walletCtx)
+	// 4. Derive the wallet from the chain's HD key, or generate a random
+	//    key (or Circle wallet) stored encrypted, depending on the key mode
+	wallet, err := uc.keys.NewWallet(walletCtx, chain, userID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate wallet: %w", err)
-	}
-	
-	// 5. Encrypt private key (or Circle wallet ID)
-	encryptedPrivateKey, err := uc.encryption.Encrypt(walletKeys.PrivateKey)
-	if err != nil {
-		return nil, fmt.Errorf("failed to encrypt private key: %w", err)
+		return nil, err
 	}
 	
-	// 6. Create wallet record
-	wallet := &domain.CryptoWallet{
-		UserID:              userID,
-		Chain:               chainName,
-		Asset:               assetCode,
-		Address:             walletKeys.Address,
-		PublicKey:           &walletKeys.PublicKey,
-		EncryptedPrivateKey: encryptedPrivateKey,
-		EncryptionVersion:   "v1",
-		Label:               &label,
-		IsPrimary:           true,
-		IsActive:            true,
-		Balance:             big.NewInt(0),
-	}
+	// 5. Create wallet record
+	wallet.UserID = userID
+	wallet.Chain = chainName
+	wallet.Asset = assetCode
+	wallet.Label = &label
+	wallet.IsPrimary = true
+	wallet.IsActive = true
+	wallet.Balance = big.NewInt(0)
 	
-	// 7. Save to database
+	// 6. Save to database
 	if err := uc.walletRepo.Create(ctx, wallet); err != nil {
 		return nil, fmt.Errorf("failed to save wallet: %w", err)
 	}
@@ -113,11 +101,9 @@ func (uc *WalletUsecase) prepareWalletContext(
 	ctx = context.WithValue(ctx, domain.ChainKey, chainName)
 	
 	//  Determine wallet type based on chain and asset
-	walletType := "standard" // Default
+	walletType := walletTypeFor(chainName, assetCode)
 	
-	// Use Circle for USDC on Ethereum
-	if chainName == "ETHEREUM" && assetCode == "USDC" {
-		walletType = "circle"
+	if walletType == domain.WalletTypeCircle {
 		uc.logger.Info("Wallet type determined",
 			zap.String("type", walletType),
 			zap.String("reason", "USDC on Ethereum with Circle enabled"))
@@ -280,12 +266,12 @@ func (uc *WalletUsecase) GetWalletBalance(
 		if err != nil {
 			return nil, err
 		}
-		privateKey, err := uc.encryption.Decrypt(wallet.EncryptedPrivateKey)
+		walletID, err := uc.keys.BalanceWalletID(ctx, wallet)
 		if err != nil {
-			return nil,  fmt.Errorf("failed to decrypt private key: %w", err)
+			return nil,  fmt.Errorf("failed to decrypt wallet ID: %w", err)
 		}
 			//  Updated to match domain. Chain interface
-		balance, err := chain.GetBalance(ctx, wallet. Address, privateKey, asset)
+		balance, err := chain.GetBalance(ctx, wallet. Address, walletID, asset)
 		if err != nil {
 			uc.logger. Warn("Failed to fetch blockchain balance, using cached",
 				zap.Error(err),
@@ -343,14 +329,14 @@ func (uc *WalletUsecase) RefreshBalance(
 	if err != nil {
 		return nil, nil, err
 	}
-	privateKey, err := uc.encryption.Decrypt(wallet.EncryptedPrivateKey)
+	circleID, err := uc.keys.BalanceWalletID(ctx, wallet)
 	if err != nil {
 
-		return nil, nil, fmt.Errorf("failed to decrypt private key: %w", err)
+		return nil, nil, fmt.Errorf("failed to decrypt wallet ID: %w", err)
 	}
 	
 	//  Fetch fresh balance using domain. Chain interface
-	balanceResp, err := chain.GetBalance(ctx, wallet.Address, privateKey, asset)
+	balanceResp, err := chain.GetBalance(ctx, wallet.Address, circleID, asset)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to fetch balance: %w", err)
 	}
@@ -12,9 +12,12 @@ import (
 	"fmt"
 	"math/big"
 	"time"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ============================================================================
@@ -153,9 +156,9 @@ func (uc *TransactionUsecase) GetWithdrawalQuote(
 
 // Withdraw sends crypto from SYSTEM hot wallet to external address
 // NOTE: Accounting module should have already:
-//  1. Verified user has sufficient virtual balance
-//  2. Deducted amount + fees from user's virtual wallet
-//  3. Called this method to execute blockchain transaction
+//  1. Placed a funds hold on the user's virtual balance (hold_ref = accountingTxID)
+//  2. Called this method to execute blockchain transaction
+// The hold is captured once the chain confirms and released on failure/rejection.
 //  UPDATED: Now includes approval flow based on risk assessment
 // Withdraw sends crypto from SYSTEM hot wallet to external address
 func (uc *TransactionUsecase) Withdraw(
@@ -274,8 +277,7 @@ func (uc *TransactionUsecase) Withdraw(
 		// TODO: Alert admins to refill system wallet
 		// go uc.notifyAdminsLowBalance(ctx, systemWallet, tx)
 		
-		// TODO: Notify accounting to refund user
-		// go uc.notifyAccountingOfFailure(ctx, tx)
+		go uc.notifyAccountingOfFailure(context.Background(), tx)
 		
 		return nil, fmt.Errorf("insufficient system wallet balance: have %s, need %s",
 			utils.FormatAmount(systemWallet.Balance, assetCode),
@@ -354,6 +356,7 @@ func (uc *TransactionUsecase) executeWithdrawal(
 			utils.FormatAmount(totalNeeded, tx.Asset))
 		
 		uc.transactionRepo.MarkAsFailed(ctx, tx.ID, failureMsg)
+		tx.StatusMessage = &failureMsg
 		
 		uc.logger.Error("Insufficient balance at execution time",
 			zap.String("tx_id", tx.TransactionID),
@@ -363,8 +366,7 @@ func (uc *TransactionUsecase) executeWithdrawal(
 		// TODO: Alert admins
 		// go uc.notifyAdminsLowBalance(ctx, freshWallet, tx)
 		
-		// TODO: Notify accounting for refund
-		// go uc.notifyAccountingOfFailure(ctx, tx)
+		go uc.notifyAccountingOfFailure(context.Background(), tx)
 		
 		return
 	}
@@ -380,18 +382,34 @@ func (uc *TransactionUsecase) executeWithdrawal(
 		return
 	}
 
-	// 3. Decrypt system wallet private key
-	privateKey, err := uc.encryption.Decrypt(systemWallet.EncryptedPrivateKey)
+	// 3. Resolve system wallet private key
+	privateKey, err := uc.keys.PrivateKey(ctx, systemWallet)
 	if err != nil {
-		uc.transactionRepo.MarkAsFailed(ctx, tx.ID, "Failed to decrypt system wallet key")
-		uc.logger.Error("Failed to decrypt private key", zap.Error(err))
+		uc.transactionRepo.MarkAsFailed(ctx, tx.ID, "Failed to resolve system wallet key")
+		uc.logger.Error("Failed to resolve private key", zap.Error(err))
 		return
 	}
 
-	// 4. Update status to broadcasting
+	// 4. Make sure the user's funds are still held, and keep them held until
+	// the capture: an approval can outlast the hold's expiry
+	if err := uc.pinWithdrawalHold(ctx, tx); err != nil {
+		failureMsg := fmt.Sprintf("Withdrawal hold is no longer active: %v", err)
+		uc.transactionRepo.MarkAsFailed(ctx, tx.ID, failureMsg)
+		tx.StatusMessage = &failureMsg
+
+		uc.logger.Error("Withdrawal hold check failed, not broadcasting",
+			zap.String("tx_id", tx.TransactionID),
+			zap.Error(err))
+
+		go uc.notifyAccountingOfFailure(context.Background(), tx)
+
+		return
+	}
+
+	// 5. Update status to broadcasting
 	uc.transactionRepo.UpdateStatus(ctx, tx.ID, domain.TransactionStatusBroadcasting, nil)
 
-	// 5. Execute blockchain transaction
+	// 6. Execute blockchain transaction
 	txResult, err := chain.Send(ctx, &domain.TransactionRequest{
 		From:       systemWallet.Address,
 		To:         tx.ToAddress,
@@ -408,14 +426,15 @@ func (uc *TransactionUsecase) executeWithdrawal(
 			zap.String("tx_id", tx.TransactionID))
 
 		uc.transactionRepo.MarkAsFailed(ctx, tx.ID, err.Error())
+		failureMsg := err.Error()
+		tx.StatusMessage = &failureMsg
 
-		// TODO: Notify accounting module of failure for refund
-		// go uc.notifyAccountingOfFailure(ctx, tx)
+		go uc.notifyAccountingOfFailure(context.Background(), tx)
 		
 		return
 	}
 
-	// 6. Update transaction with blockchain details
+	// 7. Update transaction with blockchain details
 	tx.TxHash = &txResult.TxHash
 	tx.Status = domain.TransactionStatusBroadcasted
 	now := time.Now()
@@ -425,7 +444,7 @@ func (uc *TransactionUsecase) executeWithdrawal(
 		uc.logger.Error("Failed to update transaction", zap.Error(err))
 	}
 
-	// 7. Update system wallet balance
+	// 8. Update system wallet balance
 	actualCost := new(big.Int).Add(tx.Amount, txResult.Fee)
 	newSystemBalance := new(big.Int).Sub(systemWallet.Balance, actualCost)
 
@@ -436,7 +455,7 @@ func (uc *TransactionUsecase) executeWithdrawal(
 		zap.String("tx_id", tx.TransactionID),
 		zap.String("new_system_balance", newSystemBalance.String()))
 
-	// 8. Start monitoring confirmations
+	// 9. Start monitoring confirmations
 	go uc.monitorTransactionConfirmations(tx.TransactionID, tx.Chain, *tx.TxHash)
 }
 
@@ -488,6 +507,7 @@ func (uc *TransactionUsecase) ApproveWithdrawal(
 			utils.FormatAmount(totalNeeded, tx.Asset))
 		
 		uc.transactionRepo.MarkAsFailed(ctx, tx.ID, failureMsg)
+		tx.StatusMessage = &failureMsg
 		
 		// Reject the approval automatically
 		uc.approvalRepo.Reject(ctx, approvalID, "SYSTEM", failureMsg)
@@ -500,8 +520,7 @@ func (uc *TransactionUsecase) ApproveWithdrawal(
 		// TODO: Alert admins
 		// go uc.notifyAdminsLowBalance(ctx, systemWallet, tx)
 		
-		// TODO: Notify accounting for refund
-		// go uc.notifyAccountingOfFailure(ctx, tx)
+		go uc.notifyAccountingOfFailure(context.Background(), tx)
 		
 		return nil, fmt.Errorf("insufficient system wallet balance: have %s, need %s",
 			utils.FormatAmount(systemWallet.Balance, tx.Asset),
@@ -568,8 +587,9 @@ func (uc *TransactionUsecase) RejectWithdrawal(
 		return fmt.Errorf("failed to cancel transaction: %w", err)
 	}
 
-	// 7. Notify accounting to refund user
-	go uc.notifyAccountingOfRejection(ctx, approval, tx)
+	// 7. Notify accounting to release the held funds
+	tx.StatusMessage = &reason
+	go uc.notifyAccountingOfRejection(context.Background(), approval, tx)
 
 	uc.logger.Info("Withdrawal rejected",
 		zap.Int64("approval_id", approvalID),
@@ -580,7 +600,7 @@ func (uc *TransactionUsecase) RejectWithdrawal(
 }
 
 // ============================================================================
-// NOTIFICATION HELPERS (TODO: Implement)
+// NOTIFICATION HELPERS
 // ============================================================================
 
 // notifyAdminsLowBalance alerts admins that system wallet needs refilling
@@ -601,19 +621,52 @@ func (uc *TransactionUsecase) notifyAdminsLowBalance(
 		zap.String("address", wallet.Address))
 }
 
-// notifyAccountingOfFailure notifies accounting to refund user
+// notifyAccountingOfFailure releases the user's withdrawal hold
 func (uc *TransactionUsecase) notifyAccountingOfFailure(
 	ctx context.Context,
 	tx *domain.CryptoTransaction,
 ) {
-	// TODO: Implement accounting notification
-	// - Call accounting service to reverse transaction
-	// - Refund user's virtual balance
-	
-	uc.logger.Info("Accounting refund notification needed",
+	if uc.accounting == nil || tx.AccountingTxID == nil {
+		uc.logger.Warn("Accounting refund notification skipped",
+			zap.String("tx_id", tx.TransactionID),
+			zap.String("user_id", tx.UserID))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	reason := "crypto withdrawal failed"
+	if tx.StatusMessage != nil && *tx.StatusMessage != "" {
+		reason = *tx.StatusMessage
+	}
+
+	_, err := uc.accounting.Client.ReleaseHold(ctx, &accountingpb.ReleaseHoldRequest{
+		HoldRef:              *tx.AccountingTxID,
+		Reason:               &reason,
+		ReleasedByExternalId: "crypto-service",
+		ReleasedByType:       accountingpb.OwnerType_OWNER_TYPE_SYSTEM,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			// Debited up front (no hold) - needs a manual refund
+			uc.logger.Warn("No withdrawal hold found, manual refund required",
+				zap.String("tx_id", tx.TransactionID),
+				zap.String("accounting_tx_id", *tx.AccountingTxID),
+				zap.String("user_id", tx.UserID))
+			return
+		}
+		uc.logger.Error("Failed to release withdrawal hold",
+			zap.String("tx_id", tx.TransactionID),
+			zap.String("accounting_tx_id", *tx.AccountingTxID),
+			zap.Error(err))
+		return
+	}
+
+	uc.logger.Info("Withdrawal hold released",
 		zap.String("tx_id", tx.TransactionID),
 		zap.String("accounting_tx_id", *tx.AccountingTxID),
-		zap.String("user_id", tx.UserID))
+		zap.String("reason", reason))
 }
 
 // notifyAccountingOfRejection notifies accounting of rejection
@@ -622,9 +675,87 @@ func (uc *TransactionUsecase) notifyAccountingOfRejection(
 	approval *domain.WithdrawalApproval,
 	tx *domain.CryptoTransaction,
 ) {
-	// TODO: Same as notifyAccountingOfFailure
 	uc.notifyAccountingOfFailure(ctx, tx)
 }
+
+// pinWithdrawalHold checks that the user's withdrawal hold is still active
+// and clears its expiry, so the hold sweeper cannot release funds that are
+// about to be sent. Withdrawals debited up front (no hold) pass.
+func (uc *TransactionUsecase) pinWithdrawalHold(
+	ctx context.Context,
+	tx *domain.CryptoTransaction,
+) error {
+	if uc.accounting == nil || tx.AccountingTxID == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err := uc.accounting.Client.ExtendHold(ctx, &accountingpb.ExtendHoldRequest{
+		HoldRef: *tx.AccountingTxID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		return err
+	}
+
+	uc.logger.Info("Withdrawal hold pinned until capture",
+		zap.String("tx_id", tx.TransactionID),
+		zap.String("accounting_tx_id", *tx.AccountingTxID))
+
+	return nil
+}
+
+// notifyAccountingConfirmed captures the user's withdrawal hold once the
+// transaction has enough confirmations. An error means the user was paid
+// on-chain without the ledger being debited.
+func (uc *TransactionUsecase) notifyAccountingConfirmed(
+	ctx context.Context,
+	tx *domain.CryptoTransaction,
+) error {
+	if uc.accounting == nil || tx.AccountingTxID == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	description := fmt.Sprintf("Crypto withdrawal %s to %s",
+		utils.FormatAmount(tx.Amount, tx.Asset), tx.ToAddress)
+	if tx.TxHash != nil {
+		description = fmt.Sprintf("%s (%s)", description, *tx.TxHash)
+	}
+
+	resp, err := uc.accounting.Client.CaptureHold(ctx, &accountingpb.CaptureHoldRequest{
+		HoldRef:             *tx.AccountingTxID,
+		Description:         description,
+		CreatedByExternalId: tx.UserID,
+		CreatedByType:       accountingpb.OwnerType_OWNER_TYPE_USER,
+		ToAddress:           &tx.ToAddress,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			// Debited up front (no hold) - nothing to capture
+			return nil
+		}
+		uc.logger.Error("Failed to capture withdrawal hold",
+			zap.String("tx_id", tx.TransactionID),
+			zap.String("accounting_tx_id", *tx.AccountingTxID),
+			zap.Error(err))
+		return fmt.Errorf("failed to capture withdrawal hold %s: %w", *tx.AccountingTxID, err)
+	}
+
+	uc.logger.Info("Withdrawal hold captured",
+		zap.String("tx_id", tx.TransactionID),
+		zap.String("accounting_tx_id", *tx.AccountingTxID),
+		zap.String("receipt_code", resp.ReceiptCode),
+		zap.String("fee_amount", resp.FeeAmount))
+
+	return nil
+}
 // GetPendingApprovals retrieves pending withdrawal approvals for admin dashboard
 func (uc *TransactionUsecase) GetPendingApprovals(
 	ctx context.Context,
@@ -767,8 +898,13 @@ func (uc *TransactionUsecase) monitorTransactionConfirmations(
 				uc.transactionRepo.MarkAsConfirmed(ctx, tx.ID,
 					*txStatus.BlockNumber, *tx.BlockTimestamp)
 
-				// TODO: Notify accounting module that withdrawal is confirmed
-				// uc.notifyAccountingConfirmed(ctx, tx)
+				// Post the held amount to the ledger. The funds have left the
+				// hot wallet, so a failed capture must not go unnoticed.
+				if err := uc.notifyAccountingConfirmed(ctx, tx); err != nil {
+					uc.transactionRepo.UpdateStatus(ctx, tx.ID,
+						domain.TransactionStatusConfirmed,
+						utils.StringPtr(fmt.Sprintf("Withdrawal hold capture failed - requires manual review: %v", err)))
+				}
 
 				return // Stop monitoring
 			}
@@ -780,11 +916,12 @@ func (uc *TransactionUsecase) monitorTransactionConfirmations(
 					zap.String("tx_hash", txHash),
 					zap.String("reason", "unknown"))
 
-				uc.transactionRepo.MarkAsFailed(ctx, tx.ID,
-					fmt.Sprintf("Blockchain transaction failed: %s", "unkwnown reason"))
+				failureMsg := fmt.Sprintf("Blockchain transaction failed: %s", "unkwnown reason")
+				uc.transactionRepo.MarkAsFailed(ctx, tx.ID, failureMsg)
+				tx.StatusMessage = &failureMsg
 
-				// TODO: Notify accounting module of failure
-				// uc.notifyAccountingFailed(ctx, tx)
+				// Give the held funds back
+				uc.notifyAccountingOfFailure(ctx, tx)
 
 				return // Stop monitoring
 			}
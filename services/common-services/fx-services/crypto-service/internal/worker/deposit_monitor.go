@@ -30,9 +30,9 @@ func NewDepositMonitor(
 func (dm *DepositMonitor) Start(ctx context.Context) {
 	dm.logger.Info("Starting deposit monitor worker")
 	
-	// Monitor TRON deposits every 30 minutes
-	tronTicker := time.NewTicker(5 * time.Minute)
-	defer tronTicker.Stop()
+	// Scan new blocks of TRON, BITCOIN and ETHEREUM every minute
+	scanTicker := time.NewTicker(1 * time.Minute)
+	defer scanTicker.Stop()
 	
 	// Process pending deposits every 1 minute
 	processTicker := time.NewTicker(5 * time.Minute)
@@ -44,11 +44,9 @@ func (dm *DepositMonitor) Start(ctx context.Context) {
 	
 	for {
 		select {
-		case <-tronTicker.C:
-			// Monitor TRON deposits
-			if err := dm.depositUsecase.MonitorDeposits(ctx, "TRON"); err != nil {
-				dm.logger.Error("Failed to monitor TRON deposits", zap.Error(err))
-			}
+		case <-scanTicker.C:
+			// Scan blocks for deposits (errors are logged per chain)
+			dm.depositUsecase.ScanDeposits(ctx)
 			
 		case <-processTicker.C:
 			// Process pending deposits
@@ -61,6 +59,9 @@ func (dm *DepositMonitor) Start(ctx context.Context) {
 			if err := dm.depositUsecase. NotifyPendingDeposits(ctx); err != nil {
 				dm.logger.Error("Failed to send deposit notifications", zap.Error(err))
 			}
+			if err := dm.depositUsecase.NotifyFrozenDeposits(ctx); err != nil {
+				dm.logger.Error("Failed to notify compliance of frozen deposits", zap.Error(err))
+			}
 			
 		case <-dm.stopChan:
 			dm.logger.Info("Stopping deposit monitor worker")
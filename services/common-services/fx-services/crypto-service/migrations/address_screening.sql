@@ -0,0 +1,103 @@
+\c pxyz_fx_crypto;
+
+-- Enum values cannot be added inside a transaction block on older PostgreSQL
+ALTER TYPE deposit_status ADD VALUE IF NOT EXISTS 'frozen';  -- Source address matched a blacklist; held for review
+
+BEGIN;
+-- ============================================================================
+-- ADDRESS SCREENING - Blacklist / whitelist of external addresses
+-- ============================================================================
+-- Withdrawal destinations and deposit source addresses are screened against:
+--   1. the whitelist       - a match skips every other check
+--   2. the blacklist       - manual entries and imported sanction lists
+--   3. external providers  - optional, configured on the service
+--
+-- A withdrawal hit is routed to withdrawal_approvals (risk factor
+-- 'blacklisted_address'); a deposit hit is not credited but parked as
+-- 'frozen' with the match recorded in crypto_deposits.risk_factors until an
+-- admin releases or rejects it.
+--
+-- Addresses are matched on address_key: lowercase for hex (0x) and bech32
+-- addresses, verbatim for case-sensitive Base58 addresses.
+-- ============================================================================
+
+-- ============================================================================
+-- 1. ADDRESS LISTS
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS address_lists (
+    id              BIGSERIAL PRIMARY KEY,
+    list_type       VARCHAR(20) NOT NULL CHECK (list_type IN ('blacklist', 'whitelist')),
+    chain           VARCHAR(50) NOT NULL DEFAULT 'ANY',    -- Chain name or ANY
+    address         VARCHAR(255) NOT NULL,
+    address_key     VARCHAR(255) NOT NULL,
+
+    -- 'manual' for admin entries, the list name for imported lists
+    list_name       VARCHAR(100) NOT NULL DEFAULT 'manual',
+    category        VARCHAR(50),                            -- sanctions, scam, hack, mixer, ...
+    reason          TEXT,
+
+    is_active       BOOLEAN NOT NULL DEFAULT true,
+    added_by        VARCHAR(255) NOT NULL,
+
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_address_lists_entry UNIQUE (list_type, chain, address_key, list_name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_address_lists_lookup
+ON address_lists(address_key) WHERE is_active = true;
+
+CREATE INDEX IF NOT EXISTS idx_address_lists_list
+ON address_lists(list_type, list_name);
+
+COMMENT ON TABLE address_lists IS 'Internal blacklist / whitelist of external addresses, incl. imported sanction lists';
+COMMENT ON COLUMN address_lists.address_key IS 'Normalized address used for matching';
+
+-- ============================================================================
+-- 2. SCREENING LOG - every screened address and its outcome
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS address_screenings (
+    id              BIGSERIAL PRIMARY KEY,
+    direction       VARCHAR(20) NOT NULL,                   -- withdrawal, deposit, manual
+    chain           VARCHAR(50) NOT NULL,
+    address         VARCHAR(255) NOT NULL,
+    reference       VARCHAR(255),                           -- user id or deposit id
+    result          VARCHAR(20) NOT NULL,                   -- clear, hit, whitelisted, unavailable
+    source          VARCHAR(150),                           -- internal:<list> or provider:<name>
+    category        VARCHAR(50),
+    reason          TEXT,
+    screened_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_address_screenings_address
+ON address_screenings(chain, address);
+
+CREATE INDEX IF NOT EXISTS idx_address_screenings_hits
+ON address_screenings(screened_at DESC) WHERE result = 'hit';
+
+COMMENT ON TABLE address_screenings IS 'Audit log of address screening results';
+
+-- ============================================================================
+-- 3. CRYPTO DEPOSITS - frozen deposits
+-- ============================================================================
+
+ALTER TABLE crypto_deposits
+    ADD COLUMN IF NOT EXISTS risk_factors JSONB,
+    ADD COLUMN IF NOT EXISTS frozen_at    TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS reviewed_by  VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS reviewed_at  TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS review_notes TEXT,
+    ADD COLUMN IF NOT EXISTS compliance_notified_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_crypto_deposits_frozen_unnotified
+ON crypto_deposits(frozen_at)
+WHERE status = 'frozen' AND compliance_notified_at IS NULL;
+
+COMMENT ON COLUMN crypto_deposits.risk_factors IS 'Screening match that froze the deposit';
+COMMENT ON COLUMN crypto_deposits.compliance_notified_at IS 'When compliance was notified of the freeze; NULL = notification pending';
+COMMENT ON COLUMN crypto_deposits.reviewed_by IS 'Admin who released or rejected the frozen deposit';
+
+COMMIT;
@@ -0,0 +1,95 @@
+\c pxyz_fx_crypto;
+
+-- Enum values cannot be added inside a transaction block on older PostgreSQL
+ALTER TYPE deposit_status ADD VALUE IF NOT EXISTS 'orphaned';  -- Block left the chain in a reorg
+
+BEGIN;
+-- ============================================================================
+-- DEPOSIT SCANNER - Per-chain block scanning with reorg detection
+-- ============================================================================
+-- Deposits are detected by walking blocks from the checkpoint in
+-- blockchain_sync_status and matching outputs / transfer logs against our
+-- wallet addresses, instead of polling every wallet's balance.
+--
+-- A deposit is identified by (chain, tx_hash, output_index):
+--   BITCOIN   output_index = vout
+--   ETH/TRON  output_index = log index of the token Transfer event,
+--             -1 for the native value of the transaction
+--
+-- The hashes of recently scanned blocks are kept so a reorg is noticed when a
+-- new block's parent does not match; deposits above the fork point that were
+-- not credited yet are marked 'orphaned' and the checkpoint is rewound.
+-- A reorg deeper than the stored hashes, or one that removes deposits already
+-- confirmed or credited (marked reorged_at for reversal), stops the chain's
+-- scanning and crediting with needs_review set; once the fork is found and
+-- the deposits are reversed, rewind last_synced_block below it and clear
+-- needs_review to resume.
+-- ============================================================================
+
+-- ============================================================================
+-- 1. CRYPTO DEPOSITS - output index and block hash
+-- ============================================================================
+
+ALTER TABLE crypto_deposits
+    ADD COLUMN IF NOT EXISTS output_index INT NOT NULL DEFAULT -1,
+    ADD COLUMN IF NOT EXISTS block_hash   VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS reorged_at   TIMESTAMPTZ;
+
+-- Balance-delta deposits never got a real transaction hash and can't be
+-- confirmed; keep them for the record but out of processing
+UPDATE crypto_deposits
+SET status = 'failed', updated_at = NOW()
+WHERE tx_hash = 'pending_lookup' AND status IN ('detected', 'pending');
+
+-- One tx may pay several outputs to the same address
+ALTER TABLE crypto_deposits DROP CONSTRAINT IF EXISTS uq_deposit_tx_hash;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_crypto_deposits_output
+ON crypto_deposits(chain, tx_hash, output_index)
+WHERE tx_hash <> 'pending_lookup';
+
+CREATE INDEX IF NOT EXISTS idx_crypto_deposits_chain_block
+ON crypto_deposits(chain, block_number);
+
+COMMENT ON COLUMN crypto_deposits.output_index IS 'vout (BITCOIN), token log index, or -1 for native ETH/TRX value';
+COMMENT ON COLUMN crypto_deposits.block_hash IS 'Hash of the block the deposit was seen in';
+COMMENT ON COLUMN crypto_deposits.reorged_at IS 'Confirmed or credited, then removed by a reorg; needs reversal';
+
+CREATE INDEX IF NOT EXISTS idx_crypto_deposits_reorged
+ON crypto_deposits(chain)
+WHERE reorged_at IS NOT NULL;
+
+-- ============================================================================
+-- 2. SCANNED BLOCKS - recent block hashes per chain for reorg detection
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS blockchain_blocks (
+    chain           VARCHAR(50) NOT NULL,
+    block_number    BIGINT NOT NULL,
+    block_hash      VARCHAR(100) NOT NULL,
+    parent_hash     VARCHAR(100) NOT NULL,
+    block_timestamp TIMESTAMPTZ,
+    deposits_found  INT NOT NULL DEFAULT 0,
+    scanned_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (chain, block_number)
+);
+
+COMMENT ON TABLE blockchain_blocks IS 'Recently scanned blocks; pruned behind the reorg window';
+
+-- ============================================================================
+-- 3. SYNC STATUS - checkpoint for every scanned chain
+-- ============================================================================
+
+ALTER TABLE blockchain_sync_status
+    ADD COLUMN IF NOT EXISTS needs_review  BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS review_reason TEXT;
+
+INSERT INTO blockchain_sync_status (chain, last_synced_block) VALUES
+('ETHEREUM', 0)
+ON CONFLICT (chain) DO NOTHING;
+
+COMMENT ON COLUMN blockchain_sync_status.last_synced_block IS 'Last block scanned for deposits (0 = start at DEPOSIT_SCAN_START_BLOCK_<CHAIN>)';
+COMMENT ON COLUMN blockchain_sync_status.needs_review IS 'Scanning and crediting stopped: a reorg went deeper than the stored block hashes or removed settled deposits';
+
+COMMIT;
@@ -0,0 +1,101 @@
+\c pxyz_fx_crypto;
+BEGIN;
+-- ============================================================================
+-- ENVELOPE ENCRYPTION - Per-wallet data keys wrapped by a versioned KEK
+-- ============================================================================
+-- Wallet keys (and Circle wallet IDs) were encrypted directly with the one
+-- master key (encryption_version 'v1'). New wallets get their own random
+-- data key instead ('v2'): the key is encrypted with the data key and the
+-- data key is stored wrapped by a key-encryption key (KEK).
+--
+-- KEK versions:
+--   1  = the service master key (CRYPTO_MASTER_KEY)
+--   N  = vault secret crypto/kek/vN
+--
+-- RotateMasterKey activates a new KEK and re-wraps every data key in
+-- batches (converting 'v1' keys to 'v2' on the way). The previous KEK stays
+-- 'decrypt_only' until no wallet needs it, so wallets keep working while a
+-- rotation runs. Progress is committed per batch; a rotation interrupted by
+-- a restart resumes from last_wallet_id.
+-- ============================================================================
+
+-- ============================================================================
+-- 1. CRYPTO WALLETS - wrapped data key
+-- ============================================================================
+
+ALTER TABLE crypto_wallets
+    ADD COLUMN IF NOT EXISTS wrapped_data_key TEXT,
+    ADD COLUMN IF NOT EXISTS kek_version      INTEGER;
+
+ALTER TABLE crypto_wallets DROP CONSTRAINT IF EXISTS chk_wallet_envelope;
+ALTER TABLE crypto_wallets ADD CONSTRAINT chk_wallet_envelope CHECK (
+    encryption_version <> 'v2' OR (wrapped_data_key IS NOT NULL AND kek_version IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_crypto_wallets_kek_version
+ON crypto_wallets(kek_version) WHERE kek_version IS NOT NULL;
+
+COMMENT ON COLUMN crypto_wallets.encryption_version IS 'v1 = master key, v2 = envelope (data key + KEK), hd = derived';
+COMMENT ON COLUMN crypto_wallets.wrapped_data_key IS 'v2: the wallet''s data key encrypted with KEK kek_version';
+
+-- ============================================================================
+-- 2. ENCRYPTION KEYS - KEK versions (the keys themselves stay in the vault)
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS encryption_keys (
+    version         INTEGER PRIMARY KEY,
+    status          VARCHAR(20) NOT NULL CHECK (status IN ('active', 'decrypt_only', 'retired')),
+    fingerprint     VARCHAR(64) NOT NULL,                   -- Detects a different key under the same version
+
+    activated_at    TIMESTAMPTZ,
+    retired_at      TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_encryption_keys_active
+ON encryption_keys(status) WHERE status = 'active';
+
+COMMENT ON TABLE encryption_keys IS 'Key-encryption key versions and their lifecycle';
+
+-- ============================================================================
+-- 3. KEY ROTATIONS - progress of master-key rotations
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS key_rotations (
+    id                      BIGSERIAL PRIMARY KEY,
+    rotation_id             UUID NOT NULL DEFAULT gen_random_uuid() UNIQUE,
+    from_version            INTEGER NOT NULL,
+    to_version              INTEGER NOT NULL REFERENCES encryption_keys(version),
+    status                  VARCHAR(30) NOT NULL CHECK (status IN (
+                                'running', 'verifying', 'completed', 'failed', 'verification_failed'
+                            )),
+
+    -- Progress
+    total_wallets           BIGINT NOT NULL DEFAULT 0,
+    processed_wallets       BIGINT NOT NULL DEFAULT 0,
+    failed_wallets          BIGINT NOT NULL DEFAULT 0,
+    last_wallet_id          BIGINT NOT NULL DEFAULT 0,      -- Resume cursor
+    batch_size              INTEGER NOT NULL,
+
+    -- Verification
+    verified_sample         INTEGER NOT NULL DEFAULT 0,
+    verification_failures   INTEGER NOT NULL DEFAULT 0,
+
+    last_error              TEXT,
+    started_by              VARCHAR(255) NOT NULL,
+    started_at              TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at            TIMESTAMPTZ,
+    updated_at              TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Only one rotation at a time
+CREATE UNIQUE INDEX IF NOT EXISTS uq_key_rotations_in_progress
+ON key_rotations((true)) WHERE status IN ('running', 'verifying');
+
+CREATE INDEX IF NOT EXISTS idx_key_rotations_started_at
+ON key_rotations(started_at DESC);
+
+COMMENT ON TABLE key_rotations IS 'Master-key rotations: batch progress, resume cursor and verification result';
+
+COMMIT;
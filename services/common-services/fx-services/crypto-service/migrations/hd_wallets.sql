@@ -0,0 +1,67 @@
+\c pxyz_fx_crypto;
+BEGIN;
+-- ============================================================================
+-- HD WALLETS - Deposit addresses derived from per-chain extended keys
+-- ============================================================================
+-- New user deposit wallets are derived (BIP32/BIP44) from an extended private
+-- key held in the vault (crypto/hd/<chain>/xprv) instead of a random key per
+-- wallet. A derived wallet stores only its derivation path and public data;
+-- the signing key is re-derived when a sweep or withdrawal needs it.
+--
+-- Path: m/44'/<coin>'/<account>'/0/<index>
+--   account = one hardened account per user and chain (hd_wallet_accounts)
+--   index   = next address of that account, one per asset wallet
+--
+-- Legacy wallets keep their encrypted random key and keep working.
+-- ============================================================================
+
+-- ============================================================================
+-- 1. CRYPTO WALLETS - derivation path
+-- ============================================================================
+
+ALTER TABLE crypto_wallets
+    ADD COLUMN IF NOT EXISTS derivation_path VARCHAR(100);
+
+ALTER TABLE crypto_wallets DROP CONSTRAINT IF EXISTS chk_wallet_key_source;
+ALTER TABLE crypto_wallets ADD CONSTRAINT chk_wallet_key_source CHECK (
+    derivation_path IS NOT NULL OR encrypted_private_key <> ''
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_crypto_wallets_derivation_path
+ON crypto_wallets(chain, derivation_path)
+WHERE derivation_path IS NOT NULL;
+
+COMMENT ON COLUMN crypto_wallets.derivation_path IS 'BIP44 path under the chain extended key (NULL = legacy wallet with encrypted key)';
+COMMENT ON COLUMN crypto_wallets.encrypted_private_key IS 'Private key encrypted with AES-256 (empty for derived wallets)';
+
+-- ============================================================================
+-- 2. HD ACCOUNT INDEXES - one hardened BIP44 account per user and chain
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS hd_chain_counters (
+    chain               VARCHAR(50) PRIMARY KEY,
+    next_account_index  BIGINT NOT NULL DEFAULT 0,
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_hd_next_account_index CHECK (next_account_index BETWEEN 0 AND 2147483648)
+);
+
+CREATE TABLE IF NOT EXISTS hd_wallet_accounts (
+    chain               VARCHAR(50) NOT NULL,
+    user_id             VARCHAR(255) NOT NULL,
+    account_index       BIGINT NOT NULL,                -- Hardened BIP44 account
+    next_address_index  BIGINT NOT NULL DEFAULT 0,      -- Next external address
+
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (chain, user_id),
+    CONSTRAINT uq_hd_wallet_account_index UNIQUE (chain, account_index),
+    CONSTRAINT chk_hd_account_index CHECK (account_index BETWEEN 0 AND 2147483647),
+    CONSTRAINT chk_hd_address_index CHECK (next_address_index BETWEEN 0 AND 2147483648)
+);
+
+COMMENT ON TABLE hd_chain_counters IS 'Next BIP44 account index to hand out per chain';
+COMMENT ON TABLE hd_wallet_accounts IS 'BIP44 account and next address index per user and chain';
+
+COMMIT;
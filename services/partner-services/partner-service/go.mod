@@ -11,6 +11,7 @@ require (
 	github.com/jackc/pgx/v5 v5.7.6
 	github.com/joho/godotenv v1.5.1
 	github.com/redis/go-redis/v9 v9.12.1
+	github.com/shopspring/decimal v1.4.0
 	go.uber.org/zap v1.27.1
 	google.golang.org/grpc v1.74.2
 	google.golang.org/protobuf v1.36.7
@@ -12,12 +12,17 @@ import (
 )
 
 const (
+	// All events go to one stream, read by consumer groups (cashier-service).
+	// Each entry carries its channel so consumers can dispatch on it.
+	PartnerEventsStream = "stream:partner_events"
+	PartnerEventsMaxLen = 500_000 // Approximate cap, bounds the replay window
+
 	// Channel names
 	ChannelDepositCompleted    = "partner:deposit:completed"
-	ChannelDepositFailed       = "partner:deposit: failed"
+	ChannelDepositFailed       = "partner:deposit:failed"
 	ChannelWithdrawalCompleted = "partner:withdrawal:completed"
 	ChannelWithdrawalFailed    = "partner:withdrawal:failed"
-	ChannelTransactionEvent    = "partner:transaction: event"
+	ChannelTransactionEvent    = "partner:transaction:event"
 )
 
 type EventPublisher struct {
@@ -32,6 +37,19 @@ func NewEventPublisher(rdb *redis.Client, logger *zap.Logger) *EventPublisher {
 	}
 }
 
+// publish appends an event to PartnerEventsStream
+func (p *EventPublisher) publish(ctx context.Context, channel string, payload []byte) error {
+	return p.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: PartnerEventsStream,
+		MaxLen: PartnerEventsMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"channel": channel,
+			"payload": string(payload),
+		},
+	}).Err()
+}
+
 // ============================================
 // DEPOSIT EVENTS
 // ============================================
@@ -47,8 +65,8 @@ type DepositCompletedEvent struct {
 	Currency       string                 `json:"currency"`
 	ReceiptCode    string                 `json:"receipt_code"`
 	JournalID      int64                  `json:"journal_id"`
-	FeeAmount      float64                `json:"fee_amount"`
-	UserBalance    float64                `json:"user_balance"`
+	FeeAmount      string                 `json:"fee_amount"`   // NUMERIC as string
+	UserBalance    string                 `json:"user_balance"` // NUMERIC as string
 	PaymentMethod  string                 `json:"payment_method,omitempty"`
 	ExternalRef    string                 `json:"external_ref,omitempty"`
 	Metadata       map[string]interface{} `json:"metadata,omitempty"`
@@ -83,21 +101,13 @@ func (p *EventPublisher) PublishDepositCompleted(ctx context.Context, event *Dep
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
 
-	// Publish to specific channel
-	if err := p.rdb. Publish(ctx, ChannelDepositCompleted, payload).Err(); err != nil {
+	if err := p.publish(ctx, ChannelDepositCompleted, payload); err != nil {
 		p.logger.Error("failed to publish deposit completed event",
 			zap.String("transaction_ref", event.TransactionRef),
 			zap.Error(err))
 		return fmt.Errorf("failed to publish event: %w", err)
 	}
 
-	// Also publish to general transaction event channel
-	if err := p.rdb.Publish(ctx, ChannelTransactionEvent, payload).Err(); err != nil {
-		p.logger.Warn("failed to publish to general channel",
-			zap.String("transaction_ref", event.TransactionRef),
-			zap.Error(err))
-	}
-
 	p.logger.Info("deposit completed event published",
 		zap.String("transaction_ref", event.TransactionRef),
 		zap.String("user_id", event.UserID),
@@ -121,21 +131,13 @@ func (p *EventPublisher) PublishDepositFailed(ctx context.Context, event *Deposi
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
 
-	// Publish to specific channel
-	if err := p. rdb.Publish(ctx, ChannelDepositFailed, payload).Err(); err != nil {
+	if err := p.publish(ctx, ChannelDepositFailed, payload); err != nil {
 		p.logger.Error("failed to publish deposit failed event",
 			zap.String("transaction_ref", event.TransactionRef),
 			zap.Error(err))
 		return fmt.Errorf("failed to publish event:  %w", err)
 	}
 
-	// Also publish to general transaction event channel
-	if err := p.rdb.Publish(ctx, ChannelTransactionEvent, payload).Err(); err != nil {
-		p.logger. Warn("failed to publish to general channel",
-			zap.String("transaction_ref", event.TransactionRef),
-			zap.Error(err))
-	}
-
 	p.logger.Info("deposit failed event published",
 		zap. String("transaction_ref", event. TransactionRef),
 		zap.String("user_id", event.UserID),
@@ -191,21 +193,13 @@ func (p *EventPublisher) PublishWithdrawalCompleted(ctx context.Context, event *
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
 
-	// Publish to specific channel
-	if err := p.rdb. Publish(ctx, ChannelWithdrawalCompleted, payload).Err(); err != nil {
+	if err := p.publish(ctx, ChannelWithdrawalCompleted, payload); err != nil {
 		p.logger.Error("failed to publish withdrawal completed event",
 			zap.String("transaction_ref", event.TransactionRef),
 			zap.Error(err))
 		return fmt.Errorf("failed to publish event: %w", err)
 	}
 
-	// Also publish to general transaction event channel
-	if err := p.rdb.Publish(ctx, ChannelTransactionEvent, payload).Err(); err != nil {
-		p.logger.Warn("failed to publish to general channel",
-			zap.String("transaction_ref", event.TransactionRef),
-			zap.Error(err))
-	}
-
 	p.logger.Info("withdrawal completed event published",
 		zap.String("transaction_ref", event.TransactionRef),
 		zap.String("user_id", event.UserID),
@@ -229,21 +223,13 @@ func (p *EventPublisher) PublishWithdrawalFailed(ctx context.Context, event *Wit
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
 
-	// Publish to specific channel
-	if err := p.rdb.Publish(ctx, ChannelWithdrawalFailed, payload).Err(); err != nil {
+	if err := p.publish(ctx, ChannelWithdrawalFailed, payload); err != nil {
 		p. logger.Error("failed to publish withdrawal failed event",
 			zap. String("transaction_ref", event. TransactionRef),
 			zap.Error(err))
 		return fmt.Errorf("failed to publish event: %w", err)
 	}
 
-	// Also publish to general transaction event channel
-	if err := p.rdb. Publish(ctx, ChannelTransactionEvent, payload).Err(); err != nil {
-		p. logger.Warn("failed to publish to general channel",
-			zap.String("transaction_ref", event.TransactionRef),
-			zap.Error(err))
-	}
-
 	p.logger. Warn("withdrawal failed event published",
 		zap.String("transaction_ref", event.TransactionRef),
 		zap.String("user_id", event.UserID),
@@ -283,7 +269,7 @@ func (p *EventPublisher) PublishTransactionEvent(ctx context.Context, event *Tra
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
 
-	if err := p.rdb. Publish(ctx, ChannelTransactionEvent, payload).Err(); err != nil {
+	if err := p.publish(ctx, ChannelTransactionEvent, payload); err != nil {
 		p. logger.Error("failed to publish transaction event",
 			zap.String("transaction_ref", event.TransactionRef),
 			zap.String("event_type", event. EventType),
@@ -29,6 +29,7 @@ import (
 	accountingpb "x/shared/genproto/shared/accounting/v1"
 
 	//"github.com/go-chi/chi/v5"
+	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 	//"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -238,7 +239,7 @@ func (h *PartnerHandler) getWalletAccount(ctx context.Context, ownerType account
 
 	// Find wallet account in requested currency
 	for _, acc := range accountsResp. Accounts {
-		if acc.Currency == currency && acc.Purpose == accountPurpose {
+		if acc.Currency == currency && acc.Purpose == accountPurpose && acc.ParentAccountNumber == nil {
 			return acc, nil
 		}
 	}
@@ -258,11 +259,17 @@ func (h *PartnerHandler) verifyPartnerBalance(ctx context.Context, accountNumber
 		return fmt. Errorf("failed to get partner balance")
 	}
 
-	if balanceResp.Balance. AvailableBalance < requiredAmount {
+	available, err := decimal.NewFromString(balanceResp.Balance.AvailableBalance)
+	if err != nil {
+		h.logger.Error("invalid partner balance", zap.String("available", balanceResp.Balance.AvailableBalance))
+		return fmt.Errorf("failed to get partner balance")
+	}
+
+	if available.LessThan(decimal.NewFromFloat(requiredAmount)) {
 		h.logger. Warn("insufficient partner balance",
-			zap. Float64("available", balanceResp.Balance. AvailableBalance),
+			zap. String("available", balanceResp.Balance. AvailableBalance),
 			zap.Float64("required", requiredAmount))
-		return fmt.Errorf("insufficient balance: have %.2f, need %.2f",
+		return fmt.Errorf("insufficient balance: have %s, need %.2f",
 			balanceResp.Balance.AvailableBalance, requiredAmount)
 	}
 
@@ -284,7 +291,7 @@ func (h *PartnerHandler) executeTransfer(
 	transferReq := &accountingpb.TransferRequest{
 		FromAccountNumber:   partnerAccount.AccountNumber,
 		ToAccountNumber:     userAccount.AccountNumber,
-		Amount:              req.Amount,
+		Amount:              decimal.NewFromFloat(req.Amount).String(),
 		AccountType:         accountingpb. AccountType_ACCOUNT_TYPE_REAL,
 		Description:         description,
 		IdempotencyKey:      &req.TransactionRef,
@@ -315,8 +322,8 @@ func (h *PartnerHandler) completeTransaction(ctx context.Context, txnID int64, t
 
 // AccountBalances holds final account balances
 type AccountBalances struct {
-	PartnerBalance float64
-	UserBalance    float64
+	PartnerBalance string // NUMERIC as string
+	UserBalance    string // NUMERIC as string
 }
 
 // getFinalBalances retrieves final balances for both accounts
@@ -351,7 +358,7 @@ func (h *PartnerHandler) publishDepositCompleted(
 	req *CreditUser,
 	partnerTx *domain. PartnerTransaction,
 	transferResp *accountingpb.TransferResponse,
-	userBalance float64,
+	userBalance string,
 ) {
 	go func() {
 		pubCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
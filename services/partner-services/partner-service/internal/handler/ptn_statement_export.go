@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"x/shared/response"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ============================================================================
+// STATEMENT DOWNLOADS
+// ============================================================================
+
+// GET /partner/svc/accounting/statements/account/{number}/export?from=&to=&format=csv|pdf
+// GET /partner/api/statements/{number}/export?from=&to=&format=csv|pdf
+// ExportAccountStatement streams a CSV or PDF statement of a partner account
+func (h *PartnerHandler) ExportAccountStatement(w http.ResponseWriter, r *http.Request) {
+	partnerID, _, ok := h.getPartnerContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized or partner not linked")
+		return
+	}
+
+	accountNumber := chi.URLParam(r, "number")
+	q := r.URL.Query()
+
+	from, err := time.Parse(time.RFC3339, q.Get("from"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "from is required (RFC3339 format)")
+		return
+	}
+	to, err := time.Parse(time.RFC3339, q.Get("to"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "to is required (RFC3339 format)")
+		return
+	}
+
+	format := accountingpb.StatementFormat_STATEMENT_FORMAT_CSV
+	switch strings.ToLower(q.Get("format")) {
+	case "", "csv":
+	case "pdf":
+		format = accountingpb.StatementFormat_STATEMENT_FORMAT_PDF
+	default:
+		response.Error(w, http.StatusBadRequest, "format must be csv or pdf")
+		return
+	}
+
+	// Verify account belongs to this partner
+	accountResp, err := h.accountingClient.Client.GetAccount(r.Context(), &accountingpb.GetAccountRequest{
+		Identifier: &accountingpb.GetAccountRequest_AccountNumber{
+			AccountNumber: accountNumber,
+		},
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to get account: "+err.Error())
+		return
+	}
+
+	if accountResp.Account.OwnerId != partnerID {
+		response.Error(w, http.StatusForbidden, "account does not belong to this partner")
+		return
+	}
+
+	stream, err := h.accountingClient.Client.ExportAccountStatement(r.Context(), &accountingpb.ExportAccountStatementRequest{
+		AccountNumber: accountNumber,
+		AccountType:   accountingpb.AccountType_ACCOUNT_TYPE_REAL,
+		From:          timestamppb.New(from),
+		To:            timestamppb.New(to),
+		Format:        format,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to export statement: "+err.Error())
+		return
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to export statement: "+err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", first.GetContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="`+first.GetFilename()+`"`)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	// Headers are sent: from here failures can only cut the download short
+	flusher, _ := w.(http.Flusher)
+	chunk := first
+	for {
+		if _, err := w.Write(chunk.Data); err != nil {
+			h.logger.Warn("statement export client write failed",
+				zap.String("partner_id", partnerID), zap.Error(err))
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			h.logger.Error("statement export stream aborted",
+				zap.String("partner_id", partnerID),
+				zap.String("account_number", accountNumber),
+				zap.Error(err))
+			return
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"x/shared/response"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================================================
+// SUB-ACCOUNTS (per-merchant accounts)
+// ============================================================================
+
+type CreateSubAccountRequest struct {
+	Name    string `json:"name"`              // e.g. the merchant's name or ID
+	Purpose string `json:"purpose,omitempty"` // settlement, wallet; defaults to the parent's
+}
+
+// POST /partner/svc/accounting/accounts/{number}/sub-accounts
+// CreateSubAccount opens a named sub-account (e.g. per merchant) under one of
+// the partner's main accounts. Moves within the hierarchy are fee free.
+func (h *PartnerHandler) CreateSubAccount(w http.ResponseWriter, r *http.Request) {
+	partnerID, _, ok := h.getPartnerContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized or partner not linked")
+		return
+	}
+
+	var req CreateSubAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		response.Error(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	purpose := accountingpb.AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED
+	switch strings.ToLower(strings.TrimSpace(req.Purpose)) {
+	case "":
+	case "settlement":
+		purpose = accountingpb.AccountPurpose_ACCOUNT_PURPOSE_SETTLEMENT
+	case "wallet":
+		purpose = accountingpb.AccountPurpose_ACCOUNT_PURPOSE_WALLET
+	default:
+		response.Error(w, http.StatusBadRequest, "purpose must be settlement or wallet")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.CreateSubAccount(r.Context(), &accountingpb.CreateSubAccountRequest{
+		ParentAccountNumber: chi.URLParam(r, "number"),
+		Name:                req.Name,
+		Purpose:             purpose,
+		OwnerType:           accountingpb.OwnerType_OWNER_TYPE_PARTNER,
+		OwnerId:             partnerID,
+	})
+	if err != nil {
+		response.Error(w, subAccountErrorStatus(err), "failed to create sub-account: "+err.Error())
+		return
+	}
+
+	h.logger.Info("partner sub-account created",
+		zap.String("partner_id", partnerID),
+		zap.String("parent_account_number", chi.URLParam(r, "number")),
+		zap.String("account_number", resp.Account.AccountNumber),
+		zap.String("name", req.Name))
+
+	response.JSON(w, http.StatusCreated, resp)
+}
+
+// GET /partner/svc/accounting/accounts/{number}/sub-accounts?include_inactive=true
+// ListSubAccounts lists a partner account's sub-accounts with their balances
+// and the balance rolled up across the hierarchy
+func (h *PartnerHandler) ListSubAccounts(w http.ResponseWriter, r *http.Request) {
+	partnerID, _, ok := h.getPartnerContext(r)
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, "unauthorized or partner not linked")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.ListSubAccounts(r.Context(), &accountingpb.ListSubAccountsRequest{
+		ParentAccountNumber: chi.URLParam(r, "number"),
+		OwnerType:           accountingpb.OwnerType_OWNER_TYPE_PARTNER,
+		OwnerId:             partnerID,
+		IncludeInactive:     r.URL.Query().Get("include_inactive") == "true",
+	})
+	if err != nil {
+		response.Error(w, subAccountErrorStatus(err), "failed to list sub-accounts: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// subAccountErrorStatus maps accounting-service rejections to client errors;
+// anything else is an upstream failure
+func subAccountErrorStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusBadGateway
+}
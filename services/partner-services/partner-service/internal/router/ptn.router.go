@@ -56,6 +56,9 @@ func SetupRoutes(
 		
 		// List partner transactions
 		api.Get("/transactions", h.ListPartnerTransactions)
+
+		// Download account statement (CSV / PDF)
+		api.Get("/statements/{number}/export", h.ExportAccountStatement)
 	})
 
 	// ============================================================================
@@ -129,11 +132,14 @@ func SetupRoutes(
 				acc.Get("/accounts", h.GetUserAccounts)                    // List partner accounts
 				acc.Get("/accounts/{number}/balance", h.GetAccountBalance) // Get account balance
 				acc.Get("/summary", h.GetOwnerSummary)                     // Get consolidated summary
+				acc.Post("/accounts/{number}/sub-accounts", h.CreateSubAccount) // Open a per-merchant sub-account
+				acc.Get("/accounts/{number}/sub-accounts", h.ListSubAccounts)   // List sub-accounts with rolled-up balance
 
 				// Statements
 				acc.Route("/statements", func(stmt chi.Router) {
 					stmt.Post("/account", h.GetAccountStatement) // Get account statement
 					stmt. Post("/owner", h.GetOwnerStatement)     // Get owner statement (all accounts)
+					stmt.Get("/account/{number}/export", h.ExportAccountStatement) // Download statement (CSV / PDF)
 				})
 
 				// Transaction Queries
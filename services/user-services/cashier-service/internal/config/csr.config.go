@@ -14,6 +14,11 @@ type AppConfig struct {
 	MpesaConsumerSecret string
 	MpesaBaseURL        string
 	MpesaTillNumber     string
+
+	// Event stream replay: rewind the consumer group to this entry ID on start
+	// ("0" = everything still in the stream, "" = continue where the group is)
+	TransactionEventsReplayFrom string
+	PartnerEventsReplayFrom     string
 }
 
 func Load() AppConfig {
@@ -27,6 +32,9 @@ func Load() AppConfig {
 		MpesaConsumerSecret: getEnv("MPESA_CONSUMER_SECRET", ""),
 		MpesaBaseURL:        getEnv("MPESA_BASE_URL", "https://sandbox.safaricom.co.ke"),
 		MpesaTillNumber:     getEnv("MPESA_TILL_NUMBER", ""),
+
+		TransactionEventsReplayFrom: getEnv("TRANSACTION_EVENTS_REPLAY_FROM", ""),
+		PartnerEventsReplayFrom:     getEnv("PARTNER_EVENTS_REPLAY_FROM", ""),
 	}
 }
 
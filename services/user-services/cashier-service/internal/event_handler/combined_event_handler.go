@@ -7,6 +7,7 @@ import (
 	"cashier-service/internal/handler"
 	"cashier-service/internal/repository"
 	"cashier-service/internal/sub"
+	accountingclient "x/shared/common/accounting"
 
 	"go.uber.org/zap"
 )
@@ -19,12 +20,13 @@ type CombinedEventHandler struct {
 
 func NewCombinedEventHandler(
 	userRepo *repository.UserRepo,
+	accountingClient *accountingclient.AccountingClient,
 	hub *handler.Hub,
 	logger *zap.Logger,
 ) *CombinedEventHandler {
 	return &CombinedEventHandler{
 		depositHandler:     NewDepositEventHandler(userRepo, hub, logger),
-		withdrawalHandler: NewWithdrawalEventHandler(userRepo, hub, logger),
+		withdrawalHandler: NewWithdrawalEventHandler(userRepo, accountingClient, hub, logger),
 	}
 }
 
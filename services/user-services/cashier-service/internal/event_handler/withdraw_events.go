@@ -9,25 +9,32 @@ import (
 	"cashier-service/internal/handler"
 	"cashier-service/internal/repository"
 	"cashier-service/internal/sub"
+	accountingclient "x/shared/common/accounting"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type WithdrawalEventHandler struct {
-	userRepo *repository.UserRepo
-	hub      *handler.Hub // WebSocket hub
-	logger   *zap.Logger
+	userRepo         *repository.UserRepo
+	accountingClient *accountingclient.AccountingClient // Captures/releases withdrawal holds
+	hub              *handler.Hub                       // WebSocket hub
+	logger           *zap.Logger
 }
 
 func NewWithdrawalEventHandler(
 	userRepo *repository. UserRepo,
+	accountingClient *accountingclient.AccountingClient,
 	hub *handler.Hub,
 	logger *zap.Logger,
 ) *WithdrawalEventHandler {
 	return &WithdrawalEventHandler{
-		userRepo: userRepo,
-		hub:      hub,
-		logger:   logger,
+		userRepo:         userRepo,
+		accountingClient: accountingClient,
+		hub:              hub,
+		logger:           logger,
 	}
 }
 
@@ -57,7 +64,21 @@ func (h *WithdrawalEventHandler) HandleWithdrawalCompleted(ctx context.Context,
 		// Still process to ensure idempotency
 	}
 
-	// 3. Update withdrawal record with completion details
+	// 3. Capture the hold placed when the withdrawal was sent to the partner
+	captureResp, err := h.captureWithdrawalHold(ctx, withdrawal, event)
+	if err != nil {
+		return err
+	}
+	if captureResp != nil {
+		if err := h.userRepo.UpdateWithdrawalWithReceipt(ctx, withdrawal.ID, captureResp.ReceiptCode, captureResp.JournalId, true); err != nil {
+			h.logger.Error("failed to store withdrawal receipt",
+				zap.String("transaction_ref", event.TransactionRef),
+				zap.String("receipt_code", captureResp.ReceiptCode),
+				zap.Error(err))
+		}
+	}
+
+	// 4. Update withdrawal record with completion details
 	if err := h.userRepo.MarkWithdrawalCompleted(ctx, event.TransactionRef, event. ExternalRef); err != nil {
 		h.logger.Error("failed to mark withdrawal completed",
 			zap.String("transaction_ref", event.TransactionRef),
@@ -65,10 +86,10 @@ func (h *WithdrawalEventHandler) HandleWithdrawalCompleted(ctx context.Context,
 		return fmt.Errorf("failed to update withdrawal: %w", err)
 	}
 
-	// 4. Send WebSocket notification to user
+	// 5. Send WebSocket notification to user
 	h.sendWithdrawalCompletedWebSocket(withdrawal.UserID, event)
 
-	// 5. Log success
+	// 6. Log success
 	h.logger.Info("withdrawal completed event processed successfully",
 		zap.String("transaction_ref", event.TransactionRef),
 		zap.String("user_id", event.UserID),
@@ -93,7 +114,12 @@ func (h *WithdrawalEventHandler) HandleWithdrawalFailed(ctx context.Context, eve
 		return fmt.Errorf("withdrawal request not found: %w", err)
 	}
 
-	// 2. Update withdrawal as failed
+	// 2. Give the held funds back to the user
+	if err := h.releaseWithdrawalHold(ctx, event); err != nil {
+		return err
+	}
+
+	// 3. Update withdrawal as failed
 	if err := h.userRepo.MarkWithdrawalFailed(ctx, event.TransactionRef, event.ErrorMessage); err != nil {
 		h.logger.Error("failed to mark withdrawal failed",
 			zap.String("transaction_ref", event.TransactionRef),
@@ -101,10 +127,10 @@ func (h *WithdrawalEventHandler) HandleWithdrawalFailed(ctx context.Context, eve
 		return fmt.Errorf("failed to update withdrawal: %w", err)
 	}
 
-	// 3. Send WebSocket notification to user
+	// 4. Send WebSocket notification to user
 	h.sendWithdrawalFailedWebSocket(withdrawal.UserID, event)
 
-	// 4. Log failure
+	// 5. Log failure
 	h.logger.Info("withdrawal failed event processed successfully",
 		zap.String("transaction_ref", event.TransactionRef),
 		zap.String("user_id", event.UserID),
@@ -113,6 +139,73 @@ func (h *WithdrawalEventHandler) HandleWithdrawalFailed(ctx context.Context, eve
 	return nil
 }
 
+// captureWithdrawalHold posts the held withdrawal amount to the partner account.
+// Withdrawals that were debited up front (no hold) return nil, nil.
+func (h *WithdrawalEventHandler) captureWithdrawalHold(
+	ctx context.Context,
+	withdrawal *domain.WithdrawalRequest,
+	event *subscriber.WithdrawalCompletedEvent,
+) (*accountingpb.CaptureHoldResponse, error) {
+	if h.accountingClient == nil {
+		return nil, nil
+	}
+
+	resp, err := h.accountingClient.Client.CaptureHold(ctx, &accountingpb.CaptureHoldRequest{
+		HoldRef:             event.TransactionRef,
+		Description:         fmt.Sprintf("Withdrawal %s paid out (%s)", event.TransactionRef, event.ExternalRef),
+		CreatedByExternalId: fmt.Sprintf("%d", withdrawal.UserID),
+		CreatedByType:       accountingpb.OwnerType_OWNER_TYPE_USER,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			h.logger.Debug("no hold for withdrawal, already debited",
+				zap.String("transaction_ref", event.TransactionRef))
+			return nil, nil
+		}
+		h.logger.Error("failed to capture withdrawal hold",
+			zap.String("transaction_ref", event.TransactionRef),
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to capture withdrawal hold: %w", err)
+	}
+
+	h.logger.Info("withdrawal hold captured",
+		zap.String("transaction_ref", event.TransactionRef),
+		zap.String("receipt_code", resp.ReceiptCode),
+		zap.String("captured_amount", resp.CapturedAmount),
+		zap.String("fee_amount", resp.FeeAmount))
+
+	return resp, nil
+}
+
+// releaseWithdrawalHold returns held funds when the partner payout failed
+func (h *WithdrawalEventHandler) releaseWithdrawalHold(ctx context.Context, event *subscriber.WithdrawalFailedEvent) error {
+	if h.accountingClient == nil {
+		return nil
+	}
+
+	reason := event.ErrorMessage
+	_, err := h.accountingClient.Client.ReleaseHold(ctx, &accountingpb.ReleaseHoldRequest{
+		HoldRef:              event.TransactionRef,
+		Reason:               &reason,
+		ReleasedByExternalId: "cashier-service",
+		ReleasedByType:       accountingpb.OwnerType_OWNER_TYPE_SYSTEM,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		h.logger.Error("failed to release withdrawal hold",
+			zap.String("transaction_ref", event.TransactionRef),
+			zap.Error(err))
+		return fmt.Errorf("failed to release withdrawal hold: %w", err)
+	}
+
+	h.logger.Info("withdrawal hold released",
+		zap.String("transaction_ref", event.TransactionRef))
+
+	return nil
+}
+
 // sendWithdrawalCompletedWebSocket sends real-time notification to user via WebSocket
 func (h *WithdrawalEventHandler) sendWithdrawalCompletedWebSocket(userID int64, event *subscriber. WithdrawalCompletedEvent) {
 	if h.hub == nil {
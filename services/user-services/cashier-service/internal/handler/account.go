@@ -27,7 +27,7 @@ func (h *PaymentHandler) handleGetAccounts(ctx context.Context, client *Client)
 	// Format response with balances
 	var formattedAccounts []map[string]interface{}
 	for _, account := range accountsResp.Accounts {
-		formattedAccounts = append(formattedAccounts, map[string]interface{}{
+		formatted := map[string]interface{}{
 			"id":             account.Id,
 			"account_number": account.AccountNumber,
 			"currency":       account.Currency,
@@ -36,7 +36,12 @@ func (h *PaymentHandler) handleGetAccounts(ctx context.Context, client *Client)
 			"is_active":      account.IsActive,
 			"is_locked":      account.IsLocked,
 			"created_at":     account.CreatedAt.AsTime(),
-		})
+		}
+		if account.ParentAccountNumber != nil {
+			formatted["parent_account_number"] = *account.ParentAccountNumber
+			formatted["name"] = account.GetName()
+		}
+		formattedAccounts = append(formattedAccounts, formatted)
 	}
 
 	client.SendSuccess("accounts retrieved", map[string]interface{}{
@@ -139,7 +144,8 @@ func (h *PaymentHandler) handleCreateAccount(ctx context. Context, client *Clien
 	for _, acc := range existingAccounts. Accounts {
 		if acc. Currency == req.Currency && 
 		   acc.AccountType == accountTypeEnum && 
-		   acc.Purpose == accountingpb.AccountPurpose_ACCOUNT_PURPOSE_WALLET {
+		   acc.Purpose == accountingpb.AccountPurpose_ACCOUNT_PURPOSE_WALLET &&
+		   acc.ParentAccountNumber == nil {
 			client.SendError(fmt.Sprintf("%s %s wallet account already exists:  %s", 
 				req.Currency, accountTypeStr, acc.AccountNumber))
 			return
@@ -19,6 +19,7 @@ func (h *PaymentHandler) handleConvertAndTransfer(ctx context.Context, client *C
 		ToCurrency   string  `json:"to_currency"`
 		Amount       float64 `json:"amount"`
 		Description  string  `json:"description,omitempty"`
+		QuoteID      string  `json:"quote_id,omitempty"` // From create_fx_quote; books the quoted rate
 	}
 
 	if err := json.Unmarshal(data, &req); err != nil {
@@ -26,8 +27,8 @@ func (h *PaymentHandler) handleConvertAndTransfer(ctx context.Context, client *C
 		return
 	}
 
-	// Validation
-	if req.Amount <= 0 {
+	// Validation (with a quote the amount may be omitted)
+	if req.Amount <= 0 && req.QuoteID == "" {
 		client.SendError("amount must be greater than zero")
 		return
 	}
@@ -44,27 +45,9 @@ func (h *PaymentHandler) handleConvertAndTransfer(ctx context.Context, client *C
 		return
 	}
 
-	// Get user's source account
-	fromAccount, err := h.GetAccountByCurrency(ctx, client.UserID, "user", req.FromCurrency,nil)
-	if err != nil {
-		client.SendError("source account not found: " + err.Error())
-		return
-	}
-
-	// Get user's destination account
-toAccount, err := h.GetAccountByCurrency(ctx, client.UserID, "user", req.ToCurrency,nil)
+	fromAccount, toAccount, err := h.GetUserConversionAccounts(ctx, client.UserID, req.FromCurrency, req.ToCurrency)
 	if err != nil {
-		client.SendError("destination account not found: " + err.Error())
-		return
-	}
-
-	// Verify both accounts belong to the user (extra safety check)
-	if err := h.ValidateAccountOwnership(ctx, fromAccount, client.UserID, "user"); err != nil {
-		client.SendError("unauthorized: source account doesn't belong to you")
-		return
-	}
-	if err := h.ValidateAccountOwnership(ctx, toAccount, client.UserID, "user"); err != nil {
-		client.SendError("unauthorized: destination account doesn't belong to you")
+		client.SendError(err.Error())
 		return
 	}
 
@@ -72,11 +55,15 @@ toAccount, err := h.GetAccountByCurrency(ctx, client.UserID, "user", req.ToCurre
 	conversionReq := &accountingpb.ConversionRequest{
 		FromAccountNumber:   fromAccount,
 		ToAccountNumber:     toAccount,
-		Amount:              req.Amount, // decimal
 		AccountType:         accountingpb.AccountType_ACCOUNT_TYPE_REAL,
 		CreatedByExternalId: client.UserID,
 		CreatedByType:       accountingpb.OwnerType_OWNER_TYPE_USER,
 	}
+	if req.QuoteID != "" {
+		conversionReq.QuoteId = &req.QuoteID // amount comes from the quote
+	} else {
+		conversionReq.Amount = formatAmount(req.Amount)
+	}
 
 	// Add description if provided
 	if req.Description != "" {
@@ -100,11 +87,13 @@ toAccount, err := h.GetAccountByCurrency(ctx, client.UserID, "user", req.ToCurre
 		"fx_rate":           resp.FxRate,
 		"fx_rate_id":        resp.FxRateId,
 		"fee":               resp.FeeAmount,
+		"payable_amount":    resp.PayableAmount,
+		"quote_id":          req.QuoteID,
 		"created_at":        resp.CreatedAt.AsTime(),
 	})
 
 	// Log the conversion for audit
-	log.Printf("[Conversion] User %s: %.2f %s -> %.2f %s (Rate: %s, Fee: %.2f)",
+	log.Printf("[Conversion] User %s: %s %s -> %s %s (Rate: %s, Fee: %s)",
 		client.UserID,
 		resp.SourceAmount,
 		resp.SourceCurrency,
@@ -113,4 +102,58 @@ toAccount, err := h.GetAccountByCurrency(ctx, client.UserID, "user", req.ToCurre
 		resp.FxRate,
 		resp.FeeAmount,
 	)
-}
\ No newline at end of file
+}
+
+// Handle FX quote request: locks the rate and fee shown to the user so a
+// following convert_and_transfer with quote_id books exactly these figures
+func (h *PaymentHandler) handleCreateFXQuote(ctx context.Context, client *Client, data json.RawMessage) {
+	var req struct {
+		FromCurrency string  `json:"from_currency"`
+		ToCurrency   string  `json:"to_currency"`
+		Amount       float64 `json:"amount"`
+	}
+
+	if err := json.Unmarshal(data, &req); err != nil {
+		client.SendError("invalid request format")
+		return
+	}
+
+	// Validation
+	if req.Amount <= 0 {
+		client.SendError("amount must be greater than zero")
+		return
+	}
+	if req.FromCurrency == "" || req.ToCurrency == "" {
+		client.SendError("from_currency and to_currency are required")
+		return
+	}
+	if req.FromCurrency == req.ToCurrency {
+		client.SendError("from_currency and to_currency must be different")
+		return
+	}
+
+	fromAccount, toAccount, err := h.GetUserConversionAccounts(ctx, client.UserID, req.FromCurrency, req.ToCurrency)
+	if err != nil {
+		client.SendError(err.Error())
+		return
+	}
+
+	quote, err := h.CreateFXQuote(ctx, client.UserID, fromAccount, toAccount, formatAmount(req.Amount))
+	if err != nil {
+		client.SendError(err.Error())
+		return
+	}
+
+	client.SendSuccess("fx quote created", map[string]interface{}{
+		"quote_id":         quote.QuoteId,
+		"source_currency":  quote.SourceCurrency,
+		"dest_currency":    quote.DestCurrency,
+		"source_amount":    quote.SourceAmount,
+		"fx_rate":          quote.FxRate,
+		"mid_rate":         quote.MidRate,
+		"converted_amount": quote.ConvertedAmount,
+		"fee":              quote.FeeAmount,
+		"payable_amount":   quote.PayableAmount,
+		"expires_at":       quote.ExpiresAt.AsTime(),
+	})
+}
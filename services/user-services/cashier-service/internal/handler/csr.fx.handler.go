@@ -148,16 +148,17 @@ func (h *PaymentHandler) GetAccountByCurrency(ctx context.Context, ownerID, owne
 		return "", err
 	}
 
-	// Find account with matching currency and wallet purpose
+	// Find main account with matching currency and wallet purpose; named
+	// sub-wallets are only used when addressed by account number
 	for _, acct := range resp.Accounts {
-		if acct.Currency == currency && acct.Purpose == accountPurpose {
+		if acct.Currency == currency && acct.Purpose == accountPurpose && acct.ParentAccountNumber == nil {
 			return acct.AccountNumber, nil
 		}
 	}
 
 	// If no wallet account found, return first account with matching currency
 	for _, acct := range resp.Accounts {
-		if acct.Currency == currency {
+		if acct.Currency == currency && acct.ParentAccountNumber == nil {
 			return acct.AccountNumber, nil
 		}
 	}
@@ -185,6 +186,47 @@ func (h *PaymentHandler) GetAccountBalance(ctx context.Context, accountNumber st
 	return resp.Balance, nil
 }
 
+// GetUserConversionAccounts resolves a user's source and destination accounts
+// for a currency conversion and verifies the user owns both
+func (h *PaymentHandler) GetUserConversionAccounts(ctx context.Context, userID, fromCurrency, toCurrency string) (fromAccount, toAccount string, err error) {
+	fromAccount, err = h.GetAccountByCurrency(ctx, userID, "user", fromCurrency, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("source account not found: %w", err)
+	}
+
+	toAccount, err = h.GetAccountByCurrency(ctx, userID, "user", toCurrency, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("destination account not found: %w", err)
+	}
+
+	// Verify both accounts belong to the user (extra safety check)
+	if err := h.ValidateAccountOwnership(ctx, fromAccount, userID, "user"); err != nil {
+		return "", "", fmt.Errorf("unauthorized: source account doesn't belong to you")
+	}
+	if err := h.ValidateAccountOwnership(ctx, toAccount, userID, "user"); err != nil {
+		return "", "", fmt.Errorf("unauthorized: destination account doesn't belong to you")
+	}
+
+	return fromAccount, toAccount, nil
+}
+
+// CreateFXQuote locks a conversion rate and fee for a user
+func (h *PaymentHandler) CreateFXQuote(ctx context.Context, userID, fromAccount, toAccount, amount string) (*accountingpb.FXQuote, error) {
+	resp, err := h.accountingClient.Client.CreateFXQuote(ctx, &accountingpb.CreateFXQuoteRequest{
+		FromAccountNumber:   fromAccount,
+		ToAccountNumber:     toAccount,
+		Amount:              amount,
+		AccountType:         accountingpb.AccountType_ACCOUNT_TYPE_REAL,
+		CreatedByExternalId: userID,
+		CreatedByType:       accountingpb.OwnerType_OWNER_TYPE_USER,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fx quote: %w", err)
+	}
+
+	return resp.Quote, nil
+}
+
 // ============================================================================
 // COMBINED HELPERS
 // ============================================================================
@@ -76,9 +76,36 @@ func (h *PaymentHandler) HandleWSMessage(client *Client, msg *WSMessage) {
 	case "calculate_fee":
 		h.handleCalculateFee(ctx, client, msg.Data)
 
+	case "create_fx_quote":
+		h.handleCreateFXQuote(ctx, client, msg.Data)
+
 	case "convert_and_transfer":
 		h.handleConvertAndTransfer(ctx, client, msg.Data)
 
+	// ========== Scheduled Transfers ==========
+	case "schedule.create":
+		h.handleScheduleCreate(ctx, client, msg.Data)
+
+	case "schedule.list":
+		h.handleScheduleList(ctx, client, msg.Data)
+
+	case "schedule.cancel":
+		h.handleScheduleCancel(ctx, client, msg.Data)
+
+	// ========== Demo Accounts ==========
+	case "demo.top_up":
+		h.handleDemoTopUp(ctx, client, msg.Data)
+
+	case "demo.reset":
+		h.handleDemoReset(ctx, client, msg.Data)
+
+	// ========== Sub-Accounts ==========
+	case "sub_account.create":
+		h.handleSubAccountCreate(ctx, client, msg.Data)
+
+	case "sub_account.list":
+		h.handleSubAccountList(ctx, client, msg.Data)
+
 	default:
 		client.SendError(fmt.Sprintf("unknown message type: %s", msg.Type))
 	}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"go.uber.org/zap"
+)
+
+// ============================================================================
+// DEMO ACCOUNTS
+// ============================================================================
+
+type DemoTopUpRequest struct {
+	Currency string  `json:"currency"`
+	Amount   float64 `json:"amount,omitempty"` // Omit for the default demo balance
+}
+
+// handleDemoTopUp adds play money to the caller's demo wallet. The accounting
+// service applies the cooldown and balance cap.
+func (h *PaymentHandler) handleDemoTopUp(ctx context.Context, client *Client, data json.RawMessage) {
+	var req DemoTopUpRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		client.SendError("invalid request format")
+		return
+	}
+	if req.Currency == "" {
+		client.SendError("currency is required")
+		return
+	}
+
+	topUpReq := &accountingpb.TopUpDemoAccountRequest{
+		OwnerType:   accountingpb.OwnerType_OWNER_TYPE_USER,
+		OwnerId:     client.UserID,
+		Currency:    req.Currency,
+		RequestedBy: client.UserID,
+	}
+	if req.Amount != 0 {
+		req.Amount = roundTo8Decimals(req.Amount)
+		if req.Amount <= 0 {
+			client.SendError("amount must be greater than zero")
+			return
+		}
+		amount := formatAmount(req.Amount)
+		topUpReq.Amount = &amount
+	}
+
+	resp, err := h.accountingClient.Client.TopUpDemoAccount(ctx, topUpReq)
+	if err != nil {
+		client.SendError("failed to top up demo account: " + err.Error())
+		return
+	}
+
+	h.logger.Info("demo account topped up",
+		zap.String("user_id", client.UserID),
+		zap.String("account_number", resp.Refill.AccountNumber),
+		zap.String("amount", resp.Refill.Amount),
+		zap.String("currency", resp.Refill.Currency))
+
+	client.SendSuccess("demo account topped up", demoRefillToMap(resp.Refill))
+}
+
+// handleDemoReset returns the caller's demo wallet to the default demo balance
+func (h *PaymentHandler) handleDemoReset(ctx context.Context, client *Client, data json.RawMessage) {
+	var req struct {
+		Currency string `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &req); err != nil {
+		client.SendError("invalid request format")
+		return
+	}
+	if req.Currency == "" {
+		client.SendError("currency is required")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.ResetDemoAccount(ctx, &accountingpb.ResetDemoAccountRequest{
+		OwnerType:   accountingpb.OwnerType_OWNER_TYPE_USER,
+		OwnerId:     client.UserID,
+		Currency:    req.Currency,
+		RequestedBy: client.UserID,
+	})
+	if err != nil {
+		client.SendError("failed to reset demo account: " + err.Error())
+		return
+	}
+
+	h.logger.Info("demo account reset",
+		zap.String("user_id", client.UserID),
+		zap.String("account_number", resp.Refill.AccountNumber),
+		zap.String("balance", resp.Refill.BalanceAfter),
+		zap.String("currency", resp.Refill.Currency))
+
+	client.SendSuccess("demo account reset", demoRefillToMap(resp.Refill))
+}
+
+func demoRefillToMap(r *accountingpb.DemoRefill) map[string]interface{} {
+	m := map[string]interface{}{
+		"account_number":    r.AccountNumber,
+		"currency":          r.Currency,
+		"action":            r.Action,
+		"amount":            r.Amount,
+		"balance_before":    r.BalanceBefore,
+		"balance_after":     r.BalanceAfter,
+		"next_available_at": r.NextAvailableAt.AsTime(),
+	}
+	if r.ReceiptCode != nil {
+		m["receipt_code"] = *r.ReceiptCode
+	}
+	return m
+}
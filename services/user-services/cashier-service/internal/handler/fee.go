@@ -30,7 +30,7 @@ func (h *PaymentHandler) handleCalculateFee(ctx context.Context, client *Client,
 	ownerType := accountingpb.OwnerType_OWNER_TYPE_USER
 	feeReq := &accountingpb.CalculateFeeRequest{
 		TransactionType: mapTransactionType(req.TransactionType),
-		Amount:          req.Amount, // pass decimal directly
+		Amount:          formatAmount(req.Amount),
 		AccountType:     &accountType,
 		OwnerType:       &ownerType,
 	}
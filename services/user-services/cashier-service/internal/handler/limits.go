@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"x/shared/auth/middleware"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+	"x/shared/response"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// ============================================================================
+// TRANSACTION LIMITS
+// ============================================================================
+
+// GET /cashier/svc/limits/{number}
+// Returns the limits applied to the account and the allowance left in each
+// daily / weekly / monthly window.
+func (h *PaymentHandler) GetAccountLimits(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(middleware.ContextUserID).(string)
+	if !ok || userID == "" {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	accountNumber := chi.URLParam(r, "number")
+	if accountNumber == "" {
+		response.Error(w, http.StatusBadRequest, "account number required")
+		return
+	}
+
+	// Verify ownership
+	if err := h.ValidateAccountOwnership(r.Context(), accountNumber, userID, "user"); err != nil {
+		response.Error(w, http.StatusForbidden, "unauthorized: "+err.Error())
+		return
+	}
+
+	resp, err := h.accountingClient.Client.GetAccountLimits(r.Context(), &accountingpb.GetAccountLimitsRequest{
+		AccountNumber: accountNumber,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to get account limits: "+err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,271 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ============================================================================
+// SCHEDULED & RECURRING TRANSFERS
+// ============================================================================
+
+type ScheduleCreateRequest struct {
+	ToUserID        string  `json:"to_user_id"`
+	Amount          float64 `json:"amount"`
+	Currency        string  `json:"currency"`
+	Description     string  `json:"description"`
+	Cron            string  `json:"cron,omitempty"`             // 5-field cron, UTC
+	IntervalSeconds int64   `json:"interval_seconds,omitempty"` // Alternative to cron, at least 3600
+	StartAt         string  `json:"start_at,omitempty"`         // RFC3339; defaults to now
+	EndAt           string  `json:"end_at,omitempty"`           // RFC3339
+	MaxRuns         int32   `json:"max_runs,omitempty"`
+	MaxRetries      *int32  `json:"max_retries,omitempty"` // Retries on insufficient funds before skipping
+}
+
+// handleScheduleCreate creates a standing order (cron or interval) or, with
+// neither, a one-off transfer at start_at
+func (h *PaymentHandler) handleScheduleCreate(ctx context.Context, client *Client, data json.RawMessage) {
+	var req ScheduleCreateRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		client.SendError("invalid request format")
+		return
+	}
+
+	req.Amount = roundTo8Decimals(req.Amount)
+	if req.Amount <= 0 {
+		client.SendError("amount must be greater than zero")
+		return
+	}
+	if req.ToUserID == "" {
+		client.SendError("recipient user_id is required")
+		return
+	}
+	if req.ToUserID == client.UserID {
+		client.SendError("cannot transfer to yourself")
+		return
+	}
+	if req.Currency == "" {
+		client.SendError("currency is required")
+		return
+	}
+	if req.Cron != "" && req.IntervalSeconds != 0 {
+		client.SendError("use either cron or interval_seconds")
+		return
+	}
+
+	fromAccount, err := h.GetAccountByCurrency(ctx, client.UserID, "user", req.Currency, nil)
+	if err != nil {
+		client.SendError(fmt.Sprintf("failed to get your %s account: %v", req.Currency, err))
+		return
+	}
+	toAccount, err := h.GetAccountByCurrency(ctx, req.ToUserID, "user", req.Currency, nil)
+	if err != nil {
+		client.SendError(fmt.Sprintf("recipient %s account not found: %v", req.Currency, err))
+		return
+	}
+
+	createReq := &accountingpb.CreateScheduledTransferRequest{
+		OwnerType:         accountingpb.OwnerType_OWNER_TYPE_USER,
+		OwnerId:           client.UserID,
+		FromAccountNumber: fromAccount,
+		ToAccountNumber:   toAccount,
+		Amount:            formatAmount(req.Amount),
+		AccountType:       accountingpb.AccountType_ACCOUNT_TYPE_REAL,
+		Description:       req.Description,
+		MaxRetries:        req.MaxRetries,
+	}
+	if req.Cron != "" {
+		createReq.CronExpr = &req.Cron
+	}
+	if req.IntervalSeconds != 0 {
+		createReq.IntervalSeconds = &req.IntervalSeconds
+	}
+	if req.MaxRuns != 0 {
+		createReq.MaxRuns = &req.MaxRuns
+	}
+	if req.StartAt != "" {
+		startAt, err := time.Parse(time.RFC3339, req.StartAt)
+		if err != nil {
+			client.SendError("start_at must be an RFC3339 timestamp")
+			return
+		}
+		createReq.StartAt = timestamppb.New(startAt)
+	}
+	if req.EndAt != "" {
+		endAt, err := time.Parse(time.RFC3339, req.EndAt)
+		if err != nil {
+			client.SendError("end_at must be an RFC3339 timestamp")
+			return
+		}
+		createReq.EndAt = timestamppb.New(endAt)
+	}
+
+	resp, err := h.accountingClient.Client.CreateScheduledTransfer(ctx, createReq)
+	if err != nil {
+		client.SendError("failed to create schedule: " + err.Error())
+		return
+	}
+
+	h.logger.Info("scheduled transfer created",
+		zap.Int64("schedule_id", resp.Schedule.Id),
+		zap.String("from", client.UserID),
+		zap.String("to", req.ToUserID),
+		zap.Float64("amount", req.Amount),
+		zap.String("currency", req.Currency))
+
+	client.SendSuccess("schedule created", scheduleToMap(resp.Schedule))
+}
+
+// handleScheduleList lists the caller's schedules
+func (h *PaymentHandler) handleScheduleList(ctx context.Context, client *Client, data json.RawMessage) {
+	var req struct {
+		Status string `json:"status,omitempty"` // active, completed or cancelled
+		Limit  int32  `json:"limit,omitempty"`
+		Offset int32  `json:"offset,omitempty"`
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &req); err != nil {
+			client.SendError("invalid request format")
+			return
+		}
+	}
+
+	ownerType := accountingpb.OwnerType_OWNER_TYPE_USER
+	listReq := &accountingpb.ListScheduledTransfersRequest{
+		OwnerType: &ownerType,
+		OwnerId:   &client.UserID,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+	}
+	if req.Status != "" {
+		status, ok := scheduleStatusFromString(req.Status)
+		if !ok {
+			client.SendError("invalid status")
+			return
+		}
+		listReq.Status = &status
+	}
+
+	resp, err := h.accountingClient.Client.ListScheduledTransfers(ctx, listReq)
+	if err != nil {
+		client.SendError("failed to list schedules: " + err.Error())
+		return
+	}
+
+	schedules := make([]map[string]interface{}, len(resp.Schedules))
+	for i, s := range resp.Schedules {
+		schedules[i] = scheduleToMap(s)
+	}
+
+	client.SendSuccess("schedules retrieved", map[string]interface{}{
+		"schedules": schedules,
+		"total":     resp.Total,
+	})
+}
+
+// handleScheduleCancel stops future occurrences of one of the caller's schedules
+func (h *PaymentHandler) handleScheduleCancel(ctx context.Context, client *Client, data json.RawMessage) {
+	var req struct {
+		ScheduleID int64 `json:"schedule_id"`
+	}
+	if err := json.Unmarshal(data, &req); err != nil {
+		client.SendError("invalid request format")
+		return
+	}
+	if req.ScheduleID <= 0 {
+		client.SendError("schedule_id is required")
+		return
+	}
+
+	ownerType := accountingpb.OwnerType_OWNER_TYPE_USER
+	resp, err := h.accountingClient.Client.CancelScheduledTransfer(ctx, &accountingpb.CancelScheduledTransferRequest{
+		Id:          req.ScheduleID,
+		OwnerType:   &ownerType,
+		OwnerId:     &client.UserID,
+		CancelledBy: client.UserID,
+	})
+	if err != nil {
+		client.SendError("failed to cancel schedule: " + err.Error())
+		return
+	}
+
+	h.logger.Info("scheduled transfer cancelled",
+		zap.Int64("schedule_id", req.ScheduleID),
+		zap.String("user_id", client.UserID))
+
+	client.SendSuccess("schedule cancelled", scheduleToMap(resp.Schedule))
+}
+
+func scheduleToMap(s *accountingpb.ScheduledTransfer) map[string]interface{} {
+	m := map[string]interface{}{
+		"schedule_id":         s.Id,
+		"from_account_number": s.FromAccountNumber,
+		"to_account_number":   s.ToAccountNumber,
+		"amount":              s.Amount,
+		"currency":            s.Currency,
+		"description":         s.Description,
+		"status":              scheduleStatusToString(s.Status),
+		"start_at":            s.StartAt.AsTime(),
+		"max_retries":         s.MaxRetries,
+		"run_count":           s.RunCount,
+		"created_at":          s.CreatedAt.AsTime(),
+	}
+	if s.CronExpr != nil {
+		m["cron"] = *s.CronExpr
+	}
+	if s.IntervalSeconds != nil {
+		m["interval_seconds"] = *s.IntervalSeconds
+	}
+	if s.EndAt != nil {
+		m["end_at"] = s.EndAt.AsTime()
+	}
+	if s.MaxRuns != nil {
+		m["max_runs"] = *s.MaxRuns
+	}
+	if s.NextRunAt != nil {
+		m["next_run_at"] = s.NextRunAt.AsTime()
+	}
+	if s.LastRunAt != nil {
+		m["last_run_at"] = s.LastRunAt.AsTime()
+	}
+	if s.LastReceiptCode != nil {
+		m["last_receipt_code"] = *s.LastReceiptCode
+	}
+	if s.LastError != nil {
+		m["last_error"] = *s.LastError
+	}
+	return m
+}
+
+func scheduleStatusToString(s accountingpb.ScheduledTransferStatus) string {
+	switch s {
+	case accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_ACTIVE:
+		return "active"
+	case accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_COMPLETED:
+		return "completed"
+	case accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_CANCELLED:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+func scheduleStatusFromString(s string) (accountingpb.ScheduledTransferStatus, bool) {
+	switch s {
+	case "active":
+		return accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_ACTIVE, true
+	case "completed":
+		return accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_COMPLETED, true
+	case "cancelled":
+		return accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_CANCELLED, true
+	default:
+		return accountingpb.ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_UNSPECIFIED, false
+	}
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"x/shared/auth/middleware"
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+	"x/shared/response"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ============================================================================
+// STATEMENT DOWNLOADS
+// ============================================================================
+
+// GET /cashier/svc/statements/{number}/export?from=&to=&format=csv|pdf&account_type=real|demo
+func (h *PaymentHandler) ExportAccountStatement(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(middleware.ContextUserID).(string)
+	if !ok || userID == "" {
+		response.Error(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	accountNumber := chi.URLParam(r, "number")
+	q := r.URL.Query()
+
+	from, err := time.Parse(time.RFC3339, q.Get("from"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "from is required (RFC3339 format)")
+		return
+	}
+	to, err := time.Parse(time.RFC3339, q.Get("to"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "to is required (RFC3339 format)")
+		return
+	}
+
+	format := accountingpb.StatementFormat_STATEMENT_FORMAT_CSV
+	switch strings.ToLower(q.Get("format")) {
+	case "", "csv":
+	case "pdf":
+		format = accountingpb.StatementFormat_STATEMENT_FORMAT_PDF
+	default:
+		response.Error(w, http.StatusBadRequest, "format must be csv or pdf")
+		return
+	}
+
+	accountType := accountingpb.AccountType_ACCOUNT_TYPE_REAL
+	if q.Get("account_type") == "demo" {
+		accountType = accountingpb.AccountType_ACCOUNT_TYPE_DEMO
+	}
+
+	// Verify ownership
+	if err := h.ValidateAccountOwnership(r.Context(), accountNumber, userID, "user"); err != nil {
+		response.Error(w, http.StatusForbidden, "unauthorized: "+err.Error())
+		return
+	}
+
+	stream, err := h.accountingClient.Client.ExportAccountStatement(r.Context(), &accountingpb.ExportAccountStatementRequest{
+		AccountNumber: accountNumber,
+		AccountType:   accountType,
+		From:          timestamppb.New(from),
+		To:            timestamppb.New(to),
+		Format:        format,
+	})
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to export statement: "+err.Error())
+		return
+	}
+
+	writeStatementStream(w, stream)
+}
+
+// writeStatementStream copies export chunks to the response as they arrive.
+// Errors before the first chunk become a JSON error; after that the headers are
+// gone and the download is cut short.
+func writeStatementStream(w http.ResponseWriter, stream accountingpb.AccountingService_ExportAccountStatementClient) {
+	first, err := stream.Recv()
+	if err != nil {
+		response.Error(w, http.StatusBadGateway, "failed to export statement: "+err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", first.GetContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="`+first.GetFilename()+`"`)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	chunk := first
+	for {
+		if _, err := w.Write(chunk.Data); err != nil {
+			log.Printf("[StatementExport] client write failed: %v", err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			log.Printf("[StatementExport] stream aborted: %v", err)
+			return
+		}
+	}
+}
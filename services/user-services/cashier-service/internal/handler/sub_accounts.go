@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	accountingpb "x/shared/genproto/shared/accounting/v1"
+
+	"go.uber.org/zap"
+)
+
+// ============================================================================
+// SUB-ACCOUNTS (named sub-wallets)
+// ============================================================================
+
+// handleSubAccountCreate opens a named sub-wallet ("rent", "savings pot")
+// under one of the caller's wallets. Moves between the wallet and its
+// sub-wallets are free.
+func (h *PaymentHandler) handleSubAccountCreate(ctx context.Context, client *Client, data json.RawMessage) {
+	var req struct {
+		ParentAccountNumber string `json:"parent_account_number"`
+		Name                string `json:"name"`
+		Purpose             string `json:"purpose,omitempty"` // wallet (default) or savings
+	}
+	if err := json.Unmarshal(data, &req); err != nil {
+		client.SendError("invalid request format")
+		return
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.ParentAccountNumber == "" {
+		client.SendError("parent_account_number is required")
+		return
+	}
+	if req.Name == "" {
+		client.SendError("name is required")
+		return
+	}
+
+	purpose := accountingpb.AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED // Inherit the parent's
+	switch strings.ToLower(strings.TrimSpace(req.Purpose)) {
+	case "":
+	case "wallet":
+		purpose = accountingpb.AccountPurpose_ACCOUNT_PURPOSE_WALLET
+	case "savings":
+		purpose = accountingpb.AccountPurpose_ACCOUNT_PURPOSE_SAVINGS
+	default:
+		client.SendError("purpose must be 'wallet' or 'savings'")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.CreateSubAccount(ctx, &accountingpb.CreateSubAccountRequest{
+		ParentAccountNumber: req.ParentAccountNumber,
+		Name:                req.Name,
+		Purpose:             purpose,
+		OwnerType:           accountingpb.OwnerType_OWNER_TYPE_USER,
+		OwnerId:             client.UserID,
+	})
+	if err != nil {
+		client.SendError("failed to create sub-account: " + err.Error())
+		return
+	}
+
+	h.logger.Info("sub-account created",
+		zap.String("user_id", client.UserID),
+		zap.String("parent_account_number", req.ParentAccountNumber),
+		zap.String("account_number", resp.Account.AccountNumber),
+		zap.String("name", req.Name))
+
+	client.SendSuccess("sub-account created", map[string]interface{}{
+		"account": subAccountToMap(&accountingpb.SubAccount{
+			Account:          resp.Account,
+			Balance:          "0",
+			AvailableBalance: "0",
+		}),
+	})
+}
+
+// handleSubAccountList lists a wallet's sub-wallets with their balances and
+// the balance rolled up across the wallet and all its sub-wallets
+func (h *PaymentHandler) handleSubAccountList(ctx context.Context, client *Client, data json.RawMessage) {
+	var req struct {
+		ParentAccountNumber string `json:"parent_account_number"`
+		IncludeInactive     bool   `json:"include_inactive,omitempty"`
+	}
+	if err := json.Unmarshal(data, &req); err != nil {
+		client.SendError("invalid request format")
+		return
+	}
+	if req.ParentAccountNumber == "" {
+		client.SendError("parent_account_number is required")
+		return
+	}
+
+	resp, err := h.accountingClient.Client.ListSubAccounts(ctx, &accountingpb.ListSubAccountsRequest{
+		ParentAccountNumber: req.ParentAccountNumber,
+		OwnerType:           accountingpb.OwnerType_OWNER_TYPE_USER,
+		OwnerId:             client.UserID,
+		IncludeInactive:     req.IncludeInactive,
+	})
+	if err != nil {
+		client.SendError("failed to list sub-accounts: " + err.Error())
+		return
+	}
+
+	subAccounts := make([]map[string]interface{}, len(resp.SubAccounts))
+	for i, s := range resp.SubAccounts {
+		subAccounts[i] = subAccountToMap(s)
+	}
+
+	client.SendSuccess("sub-accounts retrieved", map[string]interface{}{
+		"parent":                      subAccountToMap(resp.Parent),
+		"sub_accounts":                subAccounts,
+		"count":                       len(subAccounts),
+		"rolled_up_balance":           resp.RolledUpBalance,
+		"rolled_up_available_balance": resp.RolledUpAvailableBalance,
+	})
+}
+
+func subAccountToMap(s *accountingpb.SubAccount) map[string]interface{} {
+	a := s.Account
+	m := map[string]interface{}{
+		"id":                a.Id,
+		"account_number":    a.AccountNumber,
+		"currency":          a.Currency,
+		"purpose":           a.Purpose.String(),
+		"account_type":      a.AccountType.String(),
+		"is_active":         a.IsActive,
+		"is_locked":         a.IsLocked,
+		"balance":           s.Balance,
+		"available_balance": s.AvailableBalance,
+		"created_at":        a.CreatedAt.AsTime(),
+	}
+	if a.Name != nil {
+		m["name"] = *a.Name
+	}
+	if a.ParentAccountNumber != nil {
+		m["parent_account_number"] = *a.ParentAccountNumber
+	}
+	return m
+}
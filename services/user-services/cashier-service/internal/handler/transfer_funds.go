@@ -12,6 +12,8 @@ import (
 	accountingpb "x/shared/genproto/shared/accounting/v1"
 	//"x/shared/utils/id"
 
+	"github.com/shopspring/decimal"
+
 	"go.uber.org/zap"
 )
 
@@ -256,10 +258,10 @@ func (h *PaymentHandler) validateTransferBalance(ctx context.Context, tctx *Tran
 		return fmt.Errorf("failed to check balance: %v", err)
 	}
 
-	if balanceResp.Balance.AvailableBalance < tctx.Amount {
-		return fmt.Errorf("insufficient balance: available %.8f %s, need %.8f %s",
+	if parseAmount(balanceResp.Balance.AvailableBalance).LessThan(decimal.NewFromFloat(tctx.Amount)) {
+		return fmt.Errorf("insufficient balance: available %s %s, need %s %s",
 			balanceResp.Balance.AvailableBalance, tctx.Currency,
-			tctx.Amount, tctx.Currency)
+			formatAmount(tctx.Amount), tctx.Currency)
 	}
 
 	return nil
@@ -305,7 +307,7 @@ func (h *PaymentHandler) executeTransfer(ctx context.Context, client *Client, tc
 	transferReq := &accountingpb.TransferRequest{
 		FromAccountNumber:   tctx.FromAccount,
 		ToAccountNumber:     tctx.ToAccount,
-		Amount:              tctx.Amount,
+		Amount:              formatAmount(tctx.Amount),
 		AccountType:         accountingpb.AccountType_ACCOUNT_TYPE_REAL,
 		Description:         tctx.Description,
 		CreatedByExternalId: tctx.FromUserID,
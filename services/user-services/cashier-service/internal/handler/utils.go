@@ -2,6 +2,8 @@ package handler
 
 import (
     accountingpb "x/shared/genproto/shared/accounting/v1"
+
+    "github.com/shopspring/decimal"
 )
 // ============================================================================
 // HELPER FUNCTIONS
@@ -21,3 +23,19 @@ func mapTransactionType(s string) accountingpb.TransactionType {
 		return accountingpb.TransactionType_TRANSACTION_TYPE_UNSPECIFIED
 	}
 }
+
+// formatAmount renders a client-supplied amount as the NUMERIC string the
+// accounting service expects (shortest exact representation, no float noise)
+func formatAmount(amount float64) string {
+	return decimal.NewFromFloat(amount).String()
+}
+
+// parseAmount reads a NUMERIC string returned by the accounting service.
+// Empty or malformed values are treated as zero.
+func parseAmount(s string) decimal.Decimal {
+	amount, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Zero
+	}
+	return amount
+}
@@ -11,6 +11,8 @@ import (
 	accountingpb "x/shared/genproto/shared/accounting/v1"
 	"x/shared/utils/id"
 	
+	"github.com/shopspring/decimal"
+	
 	"go.uber.org/zap"
 )
 
@@ -91,8 +93,8 @@ func (h *PaymentHandler) buildAgentWithdrawalContext(ctx context.Context, wctx *
 		return nil, fmt.Errorf("failed to check balance: %v", err)
 	}
 
-	if balanceResp.Balance.AvailableBalance < wctx.AmountInUSD {
-		return nil, fmt.Errorf("insufficient balance: need %.2f USD, have %.2f USD",
+	if parseAmount(balanceResp.Balance.AvailableBalance).LessThan(decimal.NewFromFloat(wctx.AmountInUSD)) {
+		return nil, fmt.Errorf("insufficient balance: need %.2f USD, have %s USD",
 			wctx.AmountInUSD, balanceResp.Balance.AvailableBalance)
 	}
 
@@ -171,7 +173,7 @@ func (h *PaymentHandler) executeAgentWithdrawal(withdrawal *domain.WithdrawalReq
 	transferReq := &accountingpb.TransferRequest{
 		FromAccountNumber:   wctx.UserAccount,
 		ToAccountNumber:     wctx.AgentAccount.AccountNumber,
-		Amount:              withdrawal.Amount,
+		Amount:              formatAmount(withdrawal.Amount),
 		AccountType:         accountingpb.AccountType_ACCOUNT_TYPE_REAL,
 		Description:         fmt.Sprintf("Agent withdrawal %.2f USD to %s",
 			withdrawal.Amount, withdrawal.Destination),
@@ -210,8 +212,8 @@ func (h *PaymentHandler) executeAgentWithdrawal(withdrawal *domain.WithdrawalReq
             "agent_id": "%s",
             "agent_name": "%s",
             "amount_usd": %.2f,
-            "fee_amount": %.2f,
-            "agent_commission": %.2f
+            "fee_amount": "%s",
+            "agent_commission": "%s"
         }
     }`, withdrawal.RequestRef, resp.ReceiptCode, 
 		wctx.Agent.AgentExternalId, *wctx.Agent.Name,
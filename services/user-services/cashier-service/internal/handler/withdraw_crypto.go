@@ -5,7 +5,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"math"
 	"strings"
 	"time"
 
@@ -14,9 +13,16 @@ import (
 	accountingpb "x/shared/genproto/shared/accounting/v1"
 	"x/shared/utils/id"
 
+	"github.com/shopspring/decimal"
+
 	"go.uber.org/zap"
 )
 
+// CryptoWithdrawalHoldTTL bounds how long a withdrawal may wait for manual
+// approval; crypto-service clears the expiry when it broadcasts, so the hold
+// outlives confirmation monitoring until it is captured or released
+const CryptoWithdrawalHoldTTL = 72 * time.Hour
+
 // buildCryptoWithdrawalContext builds context for crypto withdrawal
 func (h *PaymentHandler) buildCryptoWithdrawalContext(ctx context.Context, wctx *WithdrawalContext) (*WithdrawalContext, error) {
 	req := wctx.Request
@@ -112,8 +118,8 @@ func (h *PaymentHandler) buildCryptoWithdrawalContext(ctx context.Context, wctx
 		return nil, fmt.Errorf("failed to check balance: %v", err)
 	}
 
-	if balanceResp.Balance.AvailableBalance < req.Amount {
-		return nil, fmt.Errorf("insufficient %s balance: need %.8f, have %.8f",
+	if parseAmount(balanceResp.Balance.AvailableBalance).LessThan(decimal.NewFromFloat(req.Amount)) {
+		return nil, fmt.Errorf("insufficient %s balance: need %.8f, have %s",
 			req.LocalCurrency, req.Amount, balanceResp.Balance.AvailableBalance)
 	}
 
@@ -218,7 +224,7 @@ func (h *PaymentHandler) executeInternalCryptoTransfer(withdrawal *domain.Withdr
 	transferReq := &accountingpb.TransferRequest{
 		FromAccountNumber:   wctx.UserAccount,
 		ToAccountNumber:     wctx.RecipientAccount, //  Direct to recipient
-		Amount:              withdrawal.Amount,
+		Amount:              formatAmount(withdrawal.Amount),
 		AccountType:         accountingpb.AccountType_ACCOUNT_TYPE_REAL,
 		Description:         fmt.Sprintf("Internal crypto transfer %.8f %s to user %s",
 			withdrawal.Amount, withdrawal.Currency, wctx.InternalAddressInfo.UserID),
@@ -323,24 +329,27 @@ func (h *PaymentHandler) executeCryptoWithdrawal(withdrawal *domain.WithdrawalRe
 		zap.String("to_address", wctx.CryptoAddress),
 		zap.Float64("amount", withdrawal.Amount))
 
-	//  Step 1: Transfer from user account to system liquidity account
-	transferReq := &accountingpb.TransferRequest{
-		FromAccountNumber:   wctx.UserAccount,
-		ToAccountNumber:     wctx.SystemAccount,
-		Amount:              withdrawal.Amount,
-		AccountType:         accountingpb.AccountType_ACCOUNT_TYPE_REAL,
-		Description:         fmt.Sprintf("Crypto withdrawal %.8f %s to %s",
-			withdrawal.Amount, withdrawal.Currency, wctx.CryptoAddress),
-		ExternalRef:         &withdrawal.RequestRef,
-		CreatedByExternalId: fmt.Sprintf("%d", withdrawal.UserID),
-		CreatedByType:       accountingpb.OwnerType_OWNER_TYPE_USER,
-		TransactionType:     accountingpb.TransactionType_TRANSACTION_TYPE_WITHDRAWAL,
-		ToAddress:           &wctx.CryptoAddress, //  Pass destination address
-	}
-
-	transferResp, err := h.accountingClient.Client.Transfer(ctx, transferReq)
+	//  Step 1: Hold funds on the user account until the chain confirms.
+	// The crypto service captures the hold to the system liquidity account on
+	// confirmation and releases it if the withdrawal fails or is rejected.
+	holdReason := fmt.Sprintf("Crypto withdrawal %.8f %s to %s",
+		withdrawal.Amount, withdrawal.Currency, wctx.CryptoAddress)
+	holdTTL := int64(CryptoWithdrawalHoldTTL.Seconds())
+	holdResp, err := h.accountingClient.Client.PlaceHold(ctx, &accountingpb.PlaceHoldRequest{
+		AccountNumber:        wctx.UserAccount,
+		Amount:               formatAmount(withdrawal.Amount),
+		HoldRef:              &withdrawal.RequestRef,
+		HoldType:             "crypto_withdrawal",
+		Reason:               &holdReason,
+		ExpiresInSeconds:     &holdTTL,
+		CaptureAccountNumber: &wctx.SystemAccount,
+		TransactionType:      accountingpb.TransactionType_TRANSACTION_TYPE_WITHDRAWAL,
+		AccountType:          accountingpb.AccountType_ACCOUNT_TYPE_REAL,
+		CreatedByExternalId:  fmt.Sprintf("%d", withdrawal.UserID),
+		CreatedByType:        accountingpb.OwnerType_OWNER_TYPE_USER,
+	})
 	if err != nil {
-		errMsg := fmt.Sprintf("transfer failed: %v", err)
+		errMsg := fmt.Sprintf("hold failed: %v", err)
 		h.userUc.FailWithdrawal(ctx, withdrawal.RequestRef, errMsg)
 
 		h.hub.SendToUser(fmt.Sprintf("%d", withdrawal.UserID), []byte(fmt.Sprintf(`{
@@ -353,26 +362,36 @@ func (h *PaymentHandler) executeCryptoWithdrawal(withdrawal *domain.WithdrawalRe
 		return
 	}
 
-	h.logger.Info("Transfer to system account completed",
+	h.logger.Info("Funds held for crypto withdrawal",
 		zap.String("request_ref", withdrawal.RequestRef),
-		zap.String("receipt_code", transferResp.ReceiptCode),
-		zap.Int64("journal_id", transferResp.JournalId))
-
-	payableAmount := transferResp.PayableAmount
-	if payableAmount != 0 {
-		h.logger.Info("Transfer payable amount",
-			zap.Float64("payable_amount", payableAmount))
-	}else{
-		h.logger.Info("No payable amount in transfer response")
-		payableAmount = withdrawal.Amount
+		zap.String("hold_ref", holdResp.Hold.HoldRef),
+		zap.String("available_balance", holdResp.AvailableBalance))
+
+	//  Step 2: Preview the fee charged on capture; only the payable amount goes on-chain
+	payableAmount := decimal.NewFromFloat(withdrawal.Amount)
+	feeResp, err := h.accountingClient.Client.CalculateFee(ctx, &accountingpb.CalculateFeeRequest{
+		TransactionType: accountingpb.TransactionType_TRANSACTION_TYPE_WITHDRAWAL,
+		Amount:          formatAmount(withdrawal.Amount),
+		SourceCurrency:  &withdrawal.Currency,
+		TargetCurrency:  &withdrawal.Currency,
+		ToAddress:       &wctx.CryptoAddress,
+	})
+	if err != nil {
+		h.logger.Warn("Failed to preview withdrawal fee, sending full amount",
+			zap.String("request_ref", withdrawal.RequestRef),
+			zap.Error(err))
+	} else if feeResp.Calculation != nil {
+		fee := parseAmount(feeResp.Calculation.Amount)
+		if fee.IsPositive() && fee.LessThan(payableAmount) {
+			payableAmount = payableAmount.Sub(fee)
+		}
+		h.logger.Info("Withdrawal payable amount",
+			zap.String("fee", fee.String()),
+			zap.String("payable_amount", payableAmount.String()))
 	}
 
-	//  Step 2: Convert amount to smallest unit for blockchain
 	amountInSmallestUnit := h.convertToSmallestUnit(payableAmount, withdrawal.Currency)
-	accountingTxID := transferResp.ReceiptCode
-	if accountingTxID == "" {
-		accountingTxID = withdrawal.RequestRef
-	}
+	accountingTxID := holdResp.Hold.HoldRef
 
 	//  Step 3: Call crypto service to execute withdrawal
 	cryptoResp, err := h.cryptoClient.TransactionClient.Withdraw(ctx, &cryptopb.WithdrawRequest{
@@ -391,27 +410,27 @@ func (h *PaymentHandler) executeCryptoWithdrawal(withdrawal *domain.WithdrawalRe
 			zap.String("request_ref", withdrawal.RequestRef),
 			zap.Error(err))
 
-		// ⚠️ Money already transferred to system account
-		// Mark for manual review
-		h.userUc.UpdateWithdrawalStatus(ctx, withdrawal.RequestRef, "blockchain_failed", nil)
-
-		if withdrawal.Metadata == nil {
-			withdrawal.Metadata = make(map[string]interface{})
+		// Nothing was debited yet, give the held funds back
+		errMsg := fmt.Sprintf("blockchain withdrawal failed: %v", err)
+		if _, relErr := h.accountingClient.Client.ReleaseHold(ctx, &accountingpb.ReleaseHoldRequest{
+			HoldRef:              accountingTxID,
+			Reason:               &errMsg,
+			ReleasedByExternalId: "cashier-service",
+			ReleasedByType:       accountingpb.OwnerType_OWNER_TYPE_SYSTEM,
+		}); relErr != nil {
+			h.logger.Error("Failed to release crypto withdrawal hold",
+				zap.String("request_ref", withdrawal.RequestRef),
+				zap.Error(relErr))
 		}
-		withdrawal.Metadata["receipt_code"] = transferResp.ReceiptCode
-		withdrawal.Metadata["journal_id"] = transferResp.JournalId
-		withdrawal.Metadata["blockchain_error"] = err.Error()
-		withdrawal.Metadata["requires_manual_review"] = true
+		h.userUc.FailWithdrawal(ctx, withdrawal.RequestRef, errMsg)
 
 		h.hub.SendToUser(fmt.Sprintf("%d", withdrawal.UserID), []byte(fmt.Sprintf(`{
-            "type": "withdrawal_processing",
+            "type": "withdrawal_failed",
             "data": {
                 "request_ref": "%s",
-                "receipt_code": "%s",
-                "message": "Withdrawal is being processed. Blockchain transaction pending.",
-                "status": "blockchain_pending"
+                "error": "%s"
             }
-        }`, withdrawal.RequestRef, transferResp.ReceiptCode)))
+        }`, withdrawal.RequestRef, errMsg)))
 		return
 	}
 
@@ -441,8 +460,7 @@ func (h *PaymentHandler) executeCryptoWithdrawal(withdrawal *domain.WithdrawalRe
 	if withdrawal.Metadata == nil {
 		withdrawal.Metadata = make(map[string]interface{})
 	}
-	withdrawal.Metadata["receipt_code"] = transferResp.ReceiptCode
-	withdrawal.Metadata["journal_id"] = transferResp.JournalId
+	withdrawal.Metadata["hold_ref"] = accountingTxID
 	withdrawal.Metadata["crypto_transaction_id"] = tx.TransactionId
 	withdrawal.Metadata["tx_hash"] = tx.TxHash
 	withdrawal.Metadata["blockchain_status"] = tx.Status.String()
@@ -460,7 +478,7 @@ func (h *PaymentHandler) executeCryptoWithdrawal(withdrawal *domain.WithdrawalRe
         "type": "withdrawal_blockchain_initiated",
         "data": {
             "request_ref": "%s",
-            "receipt_code": "%s",
+            "hold_ref": "%s",
             "transaction_id": "%s",
             "tx_hash": "%s",
             "amount": %.8f,
@@ -475,7 +493,7 @@ func (h *PaymentHandler) executeCryptoWithdrawal(withdrawal *domain.WithdrawalRe
             "explorer_url": "%s",
             "message": "%s"
         }
-    }`, withdrawal.RequestRef, transferResp.ReceiptCode, tx.TransactionId, tx.TxHash,
+    }`, withdrawal.RequestRef, accountingTxID, tx.TransactionId, tx.TxHash,
 		withdrawal.Amount, withdrawal.Currency,
 		tx.NetworkFee.Amount, tx.NetworkFee.Currency,
 		wctx.CryptoAddress, wctx.CryptoChain,
@@ -602,7 +620,7 @@ func (h *PaymentHandler) monitorCryptoTransaction(requestRef, transactionID, cha
 }
 
 //  Helper: Convert amount to smallest unit
-func (h *PaymentHandler) convertToSmallestUnit(amount float64, currency string) string {
+func (h *PaymentHandler) convertToSmallestUnit(amount decimal.Decimal, currency string) string {
 	decimals := map[string]int{
 		"BTC":  8,  // satoshis
 		"TRX":  6,  // SUN
@@ -616,11 +634,8 @@ func (h *PaymentHandler) convertToSmallestUnit(amount float64, currency string)
 		dec = d
 	}
 
-	// Convert to smallest unit
-	multiplier := math.Pow(10, float64(dec))
-	smallestUnit := int64(amount * multiplier)
-
-	return fmt.Sprintf("%d", smallestUnit)
+	// Convert to smallest unit (truncate, never send more than was debited)
+	return amount.Shift(int32(dec)).Truncate(0).String()
 }
 
 // getBlockExplorerURL returns the block explorer URL for a transaction
@@ -14,6 +14,8 @@ import (
 	accountingpb "x/shared/genproto/shared/accounting/v1"
 	"x/shared/utils/id"
 
+	"github.com/shopspring/decimal"
+
 	"go.uber.org/zap"
 )
 
@@ -43,8 +45,8 @@ func (h *PaymentHandler) buildDirectWithdrawalContext(ctx context.Context, wctx
 		return nil, fmt.Errorf("failed to check balance: %v", err)
 	}
 
-	if balanceResp.Balance.AvailableBalance < wctx.AmountInUSD {
-		return nil, fmt.Errorf("insufficient balance: need %.2f USD, have %.2f USD",
+	if parseAmount(balanceResp.Balance.AvailableBalance).LessThan(decimal.NewFromFloat(wctx.AmountInUSD)) {
+		return nil, fmt.Errorf("insufficient balance: need %.2f USD, have %s USD",
 			wctx.AmountInUSD, balanceResp.Balance.AvailableBalance)
 	}
 
@@ -109,7 +111,7 @@ func (h *PaymentHandler) executeDirectWithdrawal(withdrawal *domain.WithdrawalRe
 	// Execute debit (in USD)
 	debitReq := &accountingpb.DebitRequest{
 		AccountNumber:       wctx.UserAccount,
-		Amount:              withdrawal.Amount,
+		Amount:              formatAmount(withdrawal.Amount),
 		AccountType:         accountingpb.AccountType_ACCOUNT_TYPE_REAL,
 		Description:         fmt.Sprintf("Direct withdrawal %.2f USD to %s",
 			withdrawal.Amount, withdrawal.Destination),
@@ -145,7 +147,7 @@ func (h *PaymentHandler) executeDirectWithdrawal(withdrawal *domain.WithdrawalRe
             "request_ref": "%s",
             "receipt_code": "%s",
             "amount_usd": %.2f,
-            "balance_after": %.2f
+            "balance_after": "%s"
         }
     }`, withdrawal.RequestRef, resp.ReceiptCode, withdrawal.Amount, resp.BalanceAfter)))
 }
@@ -13,6 +13,8 @@ import (
 	accountingpb "x/shared/genproto/shared/accounting/v1"
 	"x/shared/utils/id"
 
+	"github.com/shopspring/decimal"
+
 	"go.uber.org/zap"
 )
 
@@ -84,8 +86,8 @@ func (h *PaymentHandler) buildPartnerWithdrawalContext(ctx context.Context, wctx
 		return nil, fmt.Errorf("failed to check balance: %v", err)
 	}
 
-	if balanceResp.Balance.AvailableBalance < wctx.AmountInUSD {
-		return nil, fmt.Errorf("insufficient balance: need %.2f USD (%.2f %s), have %.2f USD",
+	if parseAmount(balanceResp.Balance.AvailableBalance).LessThan(decimal.NewFromFloat(wctx.AmountInUSD)) {
+		return nil, fmt.Errorf("insufficient balance: need %.2f USD (%.2f %s), have %s USD",
 			wctx.AmountInUSD, req.Amount, localCurrency, balanceResp.Balance.AvailableBalance)
 	}
 
@@ -183,21 +185,25 @@ func (h *PaymentHandler) executePartnerWithdrawal(withdrawal *domain.WithdrawalR
 		return
 	}
 
-	// Step 2: Transfer from user to partner (in USD)
-	transferReq := &accountingpb.TransferRequest{
-		FromAccountNumber: wctx.UserAccount,
-		ToAccountNumber:   partnerAccount,
-		Amount:            withdrawal.Amount, // USD amount
-		AccountType:       accountingpb.AccountType_ACCOUNT_TYPE_REAL,
-		Description: fmt.Sprintf("Withdrawal %.2f %s to %s via partner %s",
-			wctx.Request.Amount, wctx.Request.LocalCurrency, withdrawal.Destination, wctx.Partner.Name),
-		ExternalRef:         &withdrawal.RequestRef,
-		CreatedByExternalId: fmt.Sprintf("%d", withdrawal.UserID),
-		CreatedByType:       accountingpb.OwnerType_OWNER_TYPE_USER,
-		TransactionType:     accountingpb.TransactionType_TRANSACTION_TYPE_WITHDRAWAL,
+	// Step 2: Hold funds on the user account (in USD) until the partner confirms payout.
+	// The hold is captured to the partner account on partner:withdrawal:completed
+	// and released on partner:withdrawal:failed.
+	holdReason := fmt.Sprintf("Withdrawal %.2f %s to %s via partner %s",
+		wctx.Request.Amount, wctx.Request.LocalCurrency, withdrawal.Destination, wctx.Partner.Name)
+	holdReq := &accountingpb.PlaceHoldRequest{
+		AccountNumber:        wctx.UserAccount,
+		Amount:               formatAmount(withdrawal.Amount), // USD amount
+		HoldRef:              &withdrawal.RequestRef,
+		HoldType:             "partner_withdrawal",
+		Reason:               &holdReason,
+		CaptureAccountNumber: &partnerAccount,
+		TransactionType:      accountingpb.TransactionType_TRANSACTION_TYPE_WITHDRAWAL,
+		AccountType:          accountingpb.AccountType_ACCOUNT_TYPE_REAL,
+		CreatedByExternalId:  fmt.Sprintf("%d", withdrawal.UserID),
+		CreatedByType:        accountingpb.OwnerType_OWNER_TYPE_USER,
 	}
 
-	resp, err := h.accountingClient.Client.Transfer(ctx, transferReq)
+	resp, err := h.accountingClient.Client.PlaceHold(ctx, holdReq)
 	if err != nil {
 		errMsg := err.Error()
 		h.userUc.FailWithdrawal(ctx, withdrawal.RequestRef, errMsg)
@@ -221,8 +227,7 @@ func (h *PaymentHandler) executePartnerWithdrawal(withdrawal *domain.WithdrawalR
 		"original_currency": wctx.Request.LocalCurrency,
 		"target_currency":   "USD",
 		"exchange_rate":     fmt.Sprintf("%.4f", wctx.ExchangeRate),
-		"receipt_code":      resp.ReceiptCode,
-		"journal_id":        fmt.Sprintf("%d", resp.JournalId),
+		"hold_ref":          resp.Hold.HoldRef,
 	}
 
 	if wctx.PhoneNumber != "" {
@@ -245,37 +250,35 @@ func (h *PaymentHandler) executePartnerWithdrawal(withdrawal *domain.WithdrawalR
 		Amount:         withdrawal.Amount, // USD amount
 		Currency:       "USD",
 		PaymentMethod:  getPaymentMethod(withdrawal.Service),
-		ExternalRef:    resp.ReceiptCode,
+		ExternalRef:    resp.Hold.HoldRef,
 		Metadata:       metadata,
 	})
 
 	if err != nil {
 		log.Printf("[PartnerWithdrawal] Failed to send to partner %s: %v", wctx.Partner.Id, err)
 
-		// Money already transferred to partner
-		h.userUc.UpdateWithdrawalStatus(ctx, withdrawal.RequestRef, "sent_to_partner", nil)
-
-		if withdrawal.Metadata == nil {
-			withdrawal.Metadata = make(map[string]interface{})
+		// Nothing was debited yet, give the held funds back
+		errMsg := fmt.Sprintf("partner unavailable: %v", err)
+		if _, relErr := h.accountingClient.Client.ReleaseHold(ctx, &accountingpb.ReleaseHoldRequest{
+			HoldRef:              resp.Hold.HoldRef,
+			Reason:               &errMsg,
+			ReleasedByExternalId: "cashier-service",
+			ReleasedByType:       accountingpb.OwnerType_OWNER_TYPE_SYSTEM,
+		}); relErr != nil {
+			log.Printf("[PartnerWithdrawal] Failed to release hold %s: %v", resp.Hold.HoldRef, relErr)
 		}
-		withdrawal.Metadata["receipt_code"] = resp.ReceiptCode
-		withdrawal.Metadata["journal_id"] = resp.JournalId
-		withdrawal.Metadata["partner_notification_failed"] = true
-		withdrawal.Metadata["partner_notification_error"] = err.Error()
+		h.userUc.FailWithdrawal(ctx, withdrawal.RequestRef, errMsg)
 
 		h.hub.SendToUser(fmt.Sprintf("%d", withdrawal.UserID), []byte(fmt.Sprintf(`{
-            "type": "withdrawal_processing",
+            "type": "withdrawal_failed",
             "data": {
                 "request_ref": "%s",
-                "receipt_code": "%s",
-                "message": "Withdrawal sent to partner for processing"
+                "error": "%s"
             }
-        }`, withdrawal.RequestRef, resp.ReceiptCode)))
+        }`, withdrawal.RequestRef, errMsg)))
 		return
 	}
 
-	h.userUc.UpdateWithdrawalWithReceipt(ctx, withdrawal.ID, resp.ReceiptCode, resp.JournalId, false)
-
 	// Step 5: Mark as sent to partner
 	h.userUc.UpdateWithdrawalStatus(ctx, withdrawal.RequestRef, "sent_to_partner", nil)
 
@@ -284,8 +287,7 @@ func (h *PaymentHandler) executePartnerWithdrawal(withdrawal *domain.WithdrawalR
 	}
 	withdrawal.Metadata["partner_transaction_id"] = partnerResp.TransactionId
 	withdrawal.Metadata["partner_transaction_ref"] = partnerResp.TransactionRef
-	withdrawal.Metadata["receipt_code"] = resp.ReceiptCode
-	withdrawal.Metadata["journal_id"] = resp.JournalId
+	withdrawal.Metadata["hold_ref"] = resp.Hold.HoldRef
 	withdrawal.Metadata["sent_to_partner_at"] = time.Now()
 
 	// Notify user
@@ -293,7 +295,7 @@ func (h *PaymentHandler) executePartnerWithdrawal(withdrawal *domain.WithdrawalR
         "type": "withdrawal_sent_to_partner",
         "data": {
             "request_ref": "%s",
-            "receipt_code": "%s",
+            "hold_ref": "%s",
             "partner_id": "%s",
             "partner_name": "%s",
             "partner_transaction_id": %d,
@@ -301,10 +303,10 @@ func (h *PaymentHandler) executePartnerWithdrawal(withdrawal *domain.WithdrawalR
             "amount_usd": %.2f,
             "amount_local": %.2f,
             "local_currency": "%s",
-            "fee_amount": %.2f,
+            "available_balance": "%s",
             "status": "sent_to_partner"
         }
-    }`, withdrawal.RequestRef, resp.ReceiptCode, wctx.Partner.Id, wctx.Partner.Name,
+    }`, withdrawal.RequestRef, resp.Hold.HoldRef, wctx.Partner.Id, wctx.Partner.Name,
 		partnerResp.TransactionId, partnerResp.TransactionRef,
-		withdrawal.Amount, wctx.Request.Amount, wctx.Request.LocalCurrency, resp.FeeAmount)))
+		withdrawal.Amount, wctx.Request.Amount, wctx.Request.LocalCurrency, resp.AvailableBalance)))
 }
@@ -59,6 +59,12 @@ func SetupRoutes(
 		// ---- WebSocket Endpoint ----
 		pr.Get("/ws", h.HandleWebSocket)
 
+		// ---- Statement Downloads ----
+		pr.Get("/statements/{number}/export", h.ExportAccountStatement)
+
+		// ---- Transaction Limits ----
+		pr.Get("/limits/{number}", h.GetAccountLimits)
+
 		// ---- File Uploads ----
 		pr.Handle("/uploads/*", http.StripPrefix("/cashier/svc/uploads/", http.FileServer(http.Dir(uploadDir))))
 
@@ -95,10 +95,10 @@ func NewServer(cfg config.AppConfig) *http.Server {
 	go hub.Run() // Start hub in background goroutine
 	log.Println("[WebSocket] Hub started")
 
-	combinedHandler := transaction.NewCombinedEventHandler(userRepo, hub, logger)
+	combinedHandler := transaction.NewCombinedEventHandler(userRepo, accountingClient, hub, logger)
 
 	//  --- Start Transaction Event Subscriber (your existing one) ---
-	transactionSub := subscriber.NewTransactionEventSubscriber(rdb, hub)
+	transactionSub := subscriber.NewTransactionEventSubscriber(rdb, hub, cfg.TransactionEventsReplayFrom)
 	go func() {
 		if err := transactionSub.Start(ctx); err != nil {
 			log.Fatalf("transaction subscriber failed: %v", err)
@@ -107,13 +107,13 @@ func NewServer(cfg config.AppConfig) *http.Server {
 	log.Println("[TransactionSubscriber]  Started")
 
 	//  --- Start Deposit Event Subscriber (new one for partner events) ---
-	depositSub := subscriber.NewEventSubscriber(rdb, logger, combinedHandler)
+	depositSub := subscriber.NewEventSubscriber(rdb, logger, combinedHandler, cfg.PartnerEventsReplayFrom)
 	go func() {
 		if err := depositSub.Start(ctx); err != nil {
 			log.Fatalf("deposit subscriber failed: %v", err)
 		}
 	}()
-	log.Println("[DepositSubscriber]  Started - listening to partner:deposit:completed, partner:deposit:failed")
+	log.Println("[DepositSubscriber]  Started - consuming stream:partner_events")
 
 	// --- Init Middleware ---
 	auth := middleware.RequireAuth()
@@ -4,7 +4,6 @@ package subscriber
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -12,22 +11,27 @@ import (
 )
 
 const (
+	// Stream written by partner-service; each entry names its channel
+	PartnerEventsStream = "stream:partner_events"
+	PartnerEventsGroup  = "cashier-service"
+
 	// Deposit channels
 	ChannelDepositCompleted = "partner:deposit:completed"
 	ChannelDepositFailed    = "partner:deposit:failed"
 	
 	// Withdrawal channels
 	ChannelWithdrawalCompleted = "partner:withdrawal:completed"
-	ChannelWithdrawalFailed    = "partner: withdrawal:failed"
+	ChannelWithdrawalFailed    = "partner:withdrawal:failed"
 	
 	// General transaction events
 	ChannelTransactionEvent = "partner:transaction:event"
 )
 
 type EventSubscriber struct {
-	rdb     *redis.Client
-	logger  *zap.Logger
-	handler EventHandler
+	rdb        *redis.Client
+	logger     *zap.Logger
+	handler    EventHandler
+	replayFrom string
 }
 
 type EventHandler interface {
@@ -114,73 +118,66 @@ type WithdrawalFailedEvent struct {
 // SUBSCRIBER IMPLEMENTATION
 // ============================================
 
-func NewEventSubscriber(rdb *redis.Client, logger *zap.Logger, handler EventHandler) *EventSubscriber {
+// NewEventSubscriber reads PartnerEventsStream as the cashier consumer group.
+// replayFrom rewinds the group to a stream entry ID on start ("" = continue).
+func NewEventSubscriber(rdb *redis.Client, logger *zap.Logger, handler EventHandler, replayFrom string) *EventSubscriber {
 	return &EventSubscriber{
-		rdb:     rdb,
-		logger:  logger,
-		handler:  handler,
+		rdb:        rdb,
+		logger:     logger,
+		handler:    handler,
+		replayFrom: replayFrom,
 	}
 }
 
-// Start begins listening for events
+// Start joins the consumer group and consumes until ctx is cancelled.
+// Entries whose handler fails are not acked and get delivered again.
 func (s *EventSubscriber) Start(ctx context.Context) error {
-	// Subscribe to all channels
-	channels := []string{
-		ChannelDepositCompleted,
-		ChannelDepositFailed,
-		ChannelWithdrawalCompleted,
-		ChannelWithdrawalFailed,
-	}
-
-	pubsub := s.rdb. Subscribe(ctx, channels...)
-	defer pubsub.Close()
-
-	// Wait for confirmation that subscription is created
-	_, err := pubsub.Receive(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to subscribe: %w", err)
+	consumer := newStreamConsumer(s.rdb, PartnerEventsStream, PartnerEventsGroup, s.replayFrom,
+		"DepositSubscriber", s.handleMessage)
+	if err := consumer.setup(ctx); err != nil {
+		return err
 	}
 
 	s.logger.Info("event subscriber started",
-		zap. Strings("channels", channels))
+		zap.String("stream", PartnerEventsStream),
+		zap.String("group", PartnerEventsGroup))
 
-	// Start consuming messages
-	ch := pubsub.Channel()
+	consumer.run(ctx)
 
-	for {
-		select {
-		case <-ctx.Done():
-			s.logger.Info("event subscriber stopping")
-			return ctx.Err()
+	s.logger.Info("event subscriber stopping")
+	return ctx.Err()
+}
 
-		case msg := <-ch:
-			s.handleMessage(context.Background(), msg)
-		}
+// handleMessage dispatches a stream entry on its channel
+func (s *EventSubscriber) handleMessage(ctx context.Context, msg redis.XMessage) error {
+	channel, _ := msg.Values["channel"].(string)
+	payload, err := streamPayload(msg)
+	if err != nil {
+		s.logger.Warn("skipping malformed entry", zap.String("id", msg.ID), zap.Error(err))
+		return nil
 	}
-}
 
-// handleMessage processes incoming Redis messages
-func (s *EventSubscriber) handleMessage(ctx context. Context, msg *redis.Message) {
 	s.logger.Debug("received event",
-		zap.String("channel", msg.Channel),
-		zap.String("payload", msg.Payload))
+		zap.String("channel", channel),
+		zap.String("payload", string(payload)))
 
-	switch msg.Channel {
+	switch channel {
 	case ChannelDepositCompleted:
-		s.handleDepositCompleted(ctx, []byte(msg.Payload))
+		return s.handleDepositCompleted(ctx, payload)
 
 	case ChannelDepositFailed: 
-		s.handleDepositFailed(ctx, []byte(msg.Payload))
+		return s.handleDepositFailed(ctx, payload)
 
 	case ChannelWithdrawalCompleted:
-		s.handleWithdrawalCompleted(ctx, []byte(msg.Payload))
+		return s.handleWithdrawalCompleted(ctx, payload)
 
 	case ChannelWithdrawalFailed:
-		s. handleWithdrawalFailed(ctx, []byte(msg. Payload))
+		return s.handleWithdrawalFailed(ctx, payload)
 
 	default:
-		s.logger.Warn("received message on unknown channel",
-			zap.String("channel", msg. Channel))
+		s.logger.Debug("ignoring event on unhandled channel",
+			zap.String("channel", channel))
+		return nil
 	}
 }
 
@@ -189,13 +186,13 @@ func (s *EventSubscriber) handleMessage(ctx context. Context, msg *redis.Message
 // ============================================
 
 // handleDepositCompleted processes deposit completed events
-func (s *EventSubscriber) handleDepositCompleted(ctx context.Context, payload []byte) {
+func (s *EventSubscriber) handleDepositCompleted(ctx context.Context, payload []byte) error {
 	var event DepositCompletedEvent
 	if err := json.Unmarshal(payload, &event); err != nil {
 		s.logger.Error("failed to unmarshal deposit completed event",
 			zap.Error(err),
 			zap.String("payload", string(payload)))
-		return
+		return nil
 	}
 
 	s.logger.Info("processing deposit completed event",
@@ -212,23 +209,23 @@ func (s *EventSubscriber) handleDepositCompleted(ctx context.Context, payload []
 		s.logger.Error("failed to handle deposit completed event",
 			zap.String("transaction_ref", event.TransactionRef),
 			zap.Error(err))
-		// TODO: Implement retry mechanism or dead letter queue
-		return
+		return err
 	}
 
 	s.logger.Info("deposit completed event processed successfully",
 		zap.String("transaction_ref", event.TransactionRef),
 		zap.String("user_id", event.UserID))
+	return nil
 }
 
 // handleDepositFailed processes deposit failed events
-func (s *EventSubscriber) handleDepositFailed(ctx context.Context, payload []byte) {
+func (s *EventSubscriber) handleDepositFailed(ctx context.Context, payload []byte) error {
 	var event DepositFailedEvent
 	if err := json.Unmarshal(payload, &event); err != nil {
 		s. logger.Error("failed to unmarshal deposit failed event",
 			zap.Error(err),
 			zap.String("payload", string(payload)))
-		return
+		return nil
 	}
 
 	s.logger.Info("processing deposit failed event",
@@ -243,12 +240,13 @@ func (s *EventSubscriber) handleDepositFailed(ctx context.Context, payload []byt
 		s.logger. Error("failed to handle deposit failed event",
 			zap.String("transaction_ref", event.TransactionRef),
 			zap.Error(err))
-		return
+		return err
 	}
 
 	s.logger.Info("deposit failed event processed successfully",
 		zap.String("transaction_ref", event.TransactionRef),
 		zap.String("user_id", event.UserID))
+	return nil
 }
 
 // ============================================
@@ -256,13 +254,13 @@ func (s *EventSubscriber) handleDepositFailed(ctx context.Context, payload []byt
 // ============================================
 
 // handleWithdrawalCompleted processes withdrawal completed events
-func (s *EventSubscriber) handleWithdrawalCompleted(ctx context.Context, payload []byte) {
+func (s *EventSubscriber) handleWithdrawalCompleted(ctx context.Context, payload []byte) error {
 	var event WithdrawalCompletedEvent
 	if err := json.Unmarshal(payload, &event); err != nil {
 		s. logger.Error("failed to unmarshal withdrawal completed event",
 			zap.Error(err),
 			zap.String("payload", string(payload)))
-		return
+		return nil
 	}
 
 	s.logger.Info("processing withdrawal completed event",
@@ -280,24 +278,24 @@ func (s *EventSubscriber) handleWithdrawalCompleted(ctx context.Context, payload
 		s.logger.Error("failed to handle withdrawal completed event",
 			zap.String("transaction_ref", event.TransactionRef),
 			zap.Error(err))
-		// TODO: Implement retry mechanism or dead letter queue
-		return
+		return err
 	}
 
 	s.logger.Info("withdrawal completed event processed successfully",
 		zap.String("transaction_ref", event.TransactionRef),
 		zap.String("user_id", event.UserID),
 		zap.String("external_ref", event.ExternalRef))
+	return nil
 }
 
 // handleWithdrawalFailed processes withdrawal failed events
-func (s *EventSubscriber) handleWithdrawalFailed(ctx context.Context, payload []byte) {
+func (s *EventSubscriber) handleWithdrawalFailed(ctx context.Context, payload []byte) error {
 	var event WithdrawalFailedEvent
 	if err := json.Unmarshal(payload, &event); err != nil {
 		s.logger.Error("failed to unmarshal withdrawal failed event",
 			zap.Error(err),
 			zap.String("payload", string(payload)))
-		return
+		return nil
 	}
 
 	s.logger.Info("processing withdrawal failed event",
@@ -312,10 +310,11 @@ func (s *EventSubscriber) handleWithdrawalFailed(ctx context.Context, payload []
 		s.logger.Error("failed to handle withdrawal failed event",
 			zap.String("transaction_ref", event.TransactionRef),
 			zap.Error(err))
-		return
+		return err
 	}
 
 	s.logger. Info("withdrawal failed event processed successfully",
 		zap.String("transaction_ref", event.TransactionRef),
 		zap.String("user_id", event.UserID))
+	return nil
 }
\ No newline at end of file
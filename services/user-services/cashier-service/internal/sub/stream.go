@@ -0,0 +1,224 @@
+package subscriber
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	streamReadCount      = 50
+	streamBlock          = 5 * time.Second
+	streamClaimIdle      = 1 * time.Minute  // Pending this long = consumer died or handler failed
+	streamClaimInterval  = 30 * time.Second // How often stale entries are reclaimed
+	streamMaxDeliveries  = 10               // After this many attempts an entry is dropped
+	streamDedupeTTL      = 24 * time.Hour
+	streamRetryBackoff   = 1 * time.Second
+	streamDefaultStartID = "$" // New groups only see entries added after they are created
+)
+
+// StreamMessageHandler processes one stream entry. Returning an error leaves
+// the entry pending so it is delivered again.
+type StreamMessageHandler func(ctx context.Context, msg redis.XMessage) error
+
+// streamConsumer reads a Redis stream through a consumer group and acks each
+// entry once handled. Entries a crashed consumer or a failed handler left
+// pending are claimed again after streamClaimIdle. Entries that carry an
+// event_id (the accounting outbox id) are deduplicated, since the relay
+// delivers at least once.
+type streamConsumer struct {
+	rdb        *redis.Client
+	stream     string
+	group      string
+	consumer   string
+	replayFrom string // When set the group is rewound to this entry ID on start ("0" = whole stream)
+	handle     StreamMessageHandler
+	name       string // Log prefix
+}
+
+func newStreamConsumer(rdb *redis.Client, stream, group, replayFrom, name string, handle StreamMessageHandler) *streamConsumer {
+	consumer, err := os.Hostname()
+	if err != nil || consumer == "" {
+		consumer = fmt.Sprintf("consumer-%d", os.Getpid())
+	}
+
+	return &streamConsumer{
+		rdb:        rdb,
+		stream:     stream,
+		group:      group,
+		consumer:   consumer,
+		replayFrom: replayFrom,
+		handle:     handle,
+		name:       name,
+	}
+}
+
+// setup creates the group (and stream) if needed and applies a requested replay
+func (c *streamConsumer) setup(ctx context.Context) error {
+	err := c.rdb.XGroupCreateMkStream(ctx, c.stream, c.group, streamDefaultStartID).Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("failed to create consumer group %s on %s: %w", c.group, c.stream, err)
+	}
+
+	if c.replayFrom != "" {
+		if err := c.rdb.XGroupSetID(ctx, c.stream, c.group, c.replayFrom).Err(); err != nil {
+			return fmt.Errorf("failed to rewind consumer group %s to %s: %w", c.group, c.replayFrom, err)
+		}
+		log.Printf("[%s] Replaying %s from %s", c.name, c.stream, c.replayFrom)
+	}
+
+	return nil
+}
+
+// run consumes until ctx is cancelled
+func (c *streamConsumer) run(ctx context.Context) {
+	// Entries this consumer read but never acked before a restart come first
+	c.readPending(ctx)
+
+	lastClaim := time.Now()
+	for ctx.Err() == nil {
+		streams, err := c.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.group,
+			Consumer: c.consumer,
+			Streams:  []string{c.stream, ">"},
+			Count:    streamReadCount,
+			Block:    streamBlock,
+		}).Result()
+
+		switch {
+		case errors.Is(err, redis.Nil):
+		case err != nil:
+			if ctx.Err() != nil {
+				break
+			}
+			log.Printf("[%s] Read failed: %v", c.name, err)
+			time.Sleep(streamRetryBackoff)
+		default:
+			for _, s := range streams {
+				for _, msg := range s.Messages {
+					c.process(ctx, msg)
+				}
+			}
+		}
+
+		if time.Since(lastClaim) >= streamClaimInterval {
+			c.claimStale(ctx)
+			lastClaim = time.Now()
+		}
+	}
+
+	log.Printf("[%s] Stopping consumer %s", c.name, c.consumer)
+}
+
+func (c *streamConsumer) readPending(ctx context.Context) {
+	for ctx.Err() == nil {
+		streams, err := c.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.group,
+			Consumer: c.consumer,
+			Streams:  []string{c.stream, "0"},
+			Count:    streamReadCount,
+		}).Result()
+		if err != nil {
+			if !errors.Is(err, redis.Nil) {
+				log.Printf("[%s] Reading pending entries failed: %v", c.name, err)
+			}
+			return
+		}
+
+		handled := 0
+		for _, s := range streams {
+			for _, msg := range s.Messages {
+				c.process(ctx, msg)
+				handled++
+			}
+		}
+		if handled < streamReadCount {
+			return
+		}
+	}
+}
+
+// claimStale drops entries that keep failing, then takes over the rest of the
+// entries idle for longer than streamClaimIdle
+func (c *streamConsumer) claimStale(ctx context.Context) {
+	pending, err := c.rdb.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: c.stream,
+		Group:  c.group,
+		Idle:   streamClaimIdle,
+		Start:  "-",
+		End:    "+",
+		Count:  streamReadCount,
+	}).Result()
+	if err != nil {
+		log.Printf("[%s] Listing pending entries failed: %v", c.name, err)
+		return
+	}
+	for _, p := range pending {
+		if p.RetryCount >= streamMaxDeliveries {
+			log.Printf("[%s] ❌ Dropping entry %s after %d deliveries", c.name, p.ID, p.RetryCount)
+			c.rdb.XAck(ctx, c.stream, c.group, p.ID)
+		}
+	}
+
+	start := "0-0"
+	for ctx.Err() == nil {
+		msgs, next, err := c.rdb.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   c.stream,
+			Group:    c.group,
+			Consumer: c.consumer,
+			MinIdle:  streamClaimIdle,
+			Start:    start,
+			Count:    streamReadCount,
+		}).Result()
+		if err != nil {
+			log.Printf("[%s] Claiming stale entries failed: %v", c.name, err)
+			return
+		}
+
+		for _, msg := range msgs {
+			c.process(ctx, msg)
+		}
+		if next == "0-0" || len(msgs) == 0 {
+			return
+		}
+		start = next
+	}
+}
+
+func (c *streamConsumer) process(ctx context.Context, msg redis.XMessage) {
+	seenKey := ""
+	if eventID, ok := msg.Values["event_id"].(string); ok && eventID != "" {
+		seenKey = fmt.Sprintf("%s:seen:%s", c.group, eventID)
+		if n, err := c.rdb.Exists(ctx, seenKey).Result(); err == nil && n > 0 {
+			c.rdb.XAck(ctx, c.stream, c.group, msg.ID)
+			return
+		}
+	}
+
+	if err := c.handle(ctx, msg); err != nil {
+		log.Printf("[%s] Handling entry %s failed, will retry: %v", c.name, msg.ID, err)
+		return
+	}
+
+	if seenKey != "" {
+		c.rdb.Set(ctx, seenKey, 1, streamDedupeTTL)
+	}
+	if err := c.rdb.XAck(ctx, c.stream, c.group, msg.ID).Err(); err != nil {
+		log.Printf("[%s] Ack of entry %s failed: %v", c.name, msg.ID, err)
+	}
+}
+
+// streamPayload returns the JSON payload field of an entry
+func streamPayload(msg redis.XMessage) ([]byte, error) {
+	payload, ok := msg.Values["payload"].(string)
+	if !ok {
+		return nil, fmt.Errorf("entry %s has no payload", msg.ID)
+	}
+	return []byte(payload), nil
+}
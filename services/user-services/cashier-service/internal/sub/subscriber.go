@@ -4,7 +4,6 @@ package subscriber
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"log"
 	"time"
 
@@ -14,19 +13,26 @@ import (
 )
 
 const (
-	TransactionEventsChannel = "transaction_events"
+	// Written by the accounting outbox relay
+	TransactionEventsStream = "stream:transaction_events"
+	TransactionEventsGroup  = "cashier-service"
 )
 
 type TransactionEventSubscriber struct {
-	rdb    *redis.Client
-	hub    *handler.Hub
-	pubsub *redis.PubSub
+	rdb        *redis.Client
+	hub        *handler.Hub
+	replayFrom string
+	cancel     context.CancelFunc
 }
 
-func NewTransactionEventSubscriber(rdb *redis.Client, hub *handler.Hub) *TransactionEventSubscriber {
+// NewTransactionEventSubscriber reads TransactionEventsStream as the cashier
+// consumer group. replayFrom rewinds the group to a stream entry ID on start
+// ("0" = everything still in the stream, "" = continue where the group is).
+func NewTransactionEventSubscriber(rdb *redis.Client, hub *handler.Hub, replayFrom string) *TransactionEventSubscriber {
 	return &TransactionEventSubscriber{
-		rdb: rdb,
-		hub: hub,
+		rdb:        rdb,
+		hub:        hub,
+		replayFrom: replayFrom,
 	}
 }
 
@@ -37,73 +43,65 @@ type TransactionEvent struct {
 	TransactionID   int64                  `json:"transaction_id"`
 	TransactionType string                 `json:"transaction_type"`
 	Status          string                 `json:"status"`
-	Amount          float64                `json:"amount"`
+	Amount          string                 `json:"amount"` // NUMERIC as string
 	Currency        string                 `json:"currency"`
 	AccountNumber   string                 `json:"account_number,omitempty"`
 	FromAccount     string                 `json:"from_account,omitempty"`
 	ToAccount       string                 `json:"to_account,omitempty"`
-	BalanceAfter    float64                `json:"balance_after,omitempty"`
-	Fee             float64                `json:"fee,omitempty"`
+	BalanceAfter    string                 `json:"balance_after,omitempty"`
+	Fee             string                 `json:"fee,omitempty"`
 	ErrorMessage    string                 `json:"error_message,omitempty"`
 	Metadata        map[string]interface{} `json:"metadata,omitempty"`
 	Timestamp       time.Time              `json:"timestamp"`
 }
 
-// Start subscribes to transaction events and forwards them to WebSocket clients
+// Start joins the consumer group and forwards events to WebSocket clients
 func (s *TransactionEventSubscriber) Start(ctx context.Context) error {
-	s.pubsub = s.rdb.Subscribe(ctx, TransactionEventsChannel)
-
-	// Wait for confirmation that subscription is created
-	_, err := s.pubsub.Receive(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to subscribe: %w", err)
+	consumer := newStreamConsumer(s.rdb, TransactionEventsStream, TransactionEventsGroup, s.replayFrom,
+		"TransactionSubscriber", s.handleMessage)
+	if err := consumer.setup(ctx); err != nil {
+		return err
 	}
 
-	log.Printf("[TransactionSubscriber]  Subscribed to channel: %s", TransactionEventsChannel)
+	log.Printf("[TransactionSubscriber]  Consuming stream: %s (group=%s)", TransactionEventsStream, TransactionEventsGroup)
 
-	// Start listening for messages
-	go s.listen(ctx)
+	ctx, s.cancel = context.WithCancel(ctx)
+	go consumer.run(ctx)
 
 	return nil
 }
 
-// listen processes incoming Redis messages
-func (s *TransactionEventSubscriber) listen(ctx context.Context) {
-	ch := s.pubsub.Channel()
-
-	for {
-		select {
-		case <-ctx.Done():
-			log.Println("[TransactionSubscriber] Stopping subscriber...")
-			s.pubsub.Close()
-			return
-
-		case msg := <-ch:
-			if msg == nil {
-				continue
-			}
-
-			// Parse event
-			var event TransactionEvent
-			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
-				log.Printf("[TransactionSubscriber] Failed to parse event: %v", err)
-				continue
-			}
-
-			// Process event
-			s.processEvent(&event)
-		}
+// handleMessage parses a stream entry; malformed entries are acked and skipped
+func (s *TransactionEventSubscriber) handleMessage(ctx context.Context, msg redis.XMessage) error {
+	payload, err := streamPayload(msg)
+	if err != nil {
+		log.Printf("[TransactionSubscriber] %v", err)
+		return nil
+	}
+
+	var event TransactionEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		log.Printf("[TransactionSubscriber] Failed to parse event: %v", err)
+		return nil
 	}
+
+	s.processEvent(&event)
+	return nil
 }
 
 // processEvent handles the event and sends it to the appropriate WebSocket client
 func (s *TransactionEventSubscriber) processEvent(event *TransactionEvent) {
+	// System postings (fee sweeps, float top-ups) have nobody to notify
+	if event.UserID == "" {
+		return
+	}
+
 	log.Printf("[TransactionSubscriber] Processing event: %s for user=%s", event.EventType, event.UserID)
 
-	// Format amount from atomic units to decimal
-	amount := float64(event.Amount) / 100.0
-	balanceAfter := float64(event.BalanceAfter) / 100.0
-	fee := float64(event.Fee) / 100.0
+	// Amounts are already in currency units; forward them as exact strings
+	amount := event.Amount
+	balanceAfter := event.BalanceAfter
+	fee := event.Fee
 
 	// Create WebSocket message based on event type
 	var wsMessage map[string]interface{}
@@ -200,8 +198,8 @@ func (s *TransactionEventSubscriber) processEvent(event *TransactionEvent) {
 
 // Stop gracefully stops the subscriber
 func (s *TransactionEventSubscriber) Stop() error {
-	if s.pubsub != nil {
-		return s.pubsub.Close()
+	if s.cancel != nil {
+		s.cancel()
 	}
 	return nil
 }
@@ -18,6 +18,8 @@ type CryptoClient struct {
 	WalletClient      cryptopb.WalletServiceClient
 	TransactionClient cryptopb.TransactionServiceClient
 	DepositClient     cryptopb. DepositServiceClient
+	ScreeningClient   cryptopb.ScreeningServiceClient
+	KeyManagementClient cryptopb.KeyManagementServiceClient
 	//SystemClient      cryptopb.SystemServiceClient
 }
 
@@ -43,6 +45,8 @@ func NewCryptoClient() *CryptoClient {
 		WalletClient:      cryptopb.NewWalletServiceClient(conn),
 		TransactionClient: cryptopb.NewTransactionServiceClient(conn),
 		DepositClient:     cryptopb.NewDepositServiceClient(conn),
+		ScreeningClient:   cryptopb.NewScreeningServiceClient(conn),
+		KeyManagementClient: cryptopb.NewKeyManagementServiceClient(conn),
 		//SystemClient:      cryptopb.NewSystemServiceClient(conn),
 	}
 }
@@ -69,6 +73,8 @@ func NewCryptoClientOrNil() *CryptoClient {
 		WalletClient:      cryptopb. NewWalletServiceClient(conn),
 		TransactionClient: cryptopb.NewTransactionServiceClient(conn),
 		DepositClient:     cryptopb.NewDepositServiceClient(conn),
+		ScreeningClient:   cryptopb.NewScreeningServiceClient(conn),
+		KeyManagementClient: cryptopb.NewKeyManagementServiceClient(conn),
 		//SystemClient:      cryptopb.NewSystemServiceClient(conn),
 	}
 }
@@ -217,6 +217,8 @@ const (
 	DepositStatus_DEPOSIT_STATUS_CONFIRMED   DepositStatus = 3
 	DepositStatus_DEPOSIT_STATUS_CREDITED    DepositStatus = 4
 	DepositStatus_DEPOSIT_STATUS_FAILED      DepositStatus = 5
+	DepositStatus_DEPOSIT_STATUS_ORPHANED    DepositStatus = 6 // Block left the chain in a reorg
+	DepositStatus_DEPOSIT_STATUS_FROZEN      DepositStatus = 7 // Source address matched a blacklist; held for review
 )
 
 // Enum value maps for DepositStatus.
@@ -228,6 +230,8 @@ var (
 		3: "DEPOSIT_STATUS_CONFIRMED",
 		4: "DEPOSIT_STATUS_CREDITED",
 		5: "DEPOSIT_STATUS_FAILED",
+		6: "DEPOSIT_STATUS_ORPHANED",
+		7: "DEPOSIT_STATUS_FROZEN",
 	}
 	DepositStatus_value = map[string]int32{
 		"DEPOSIT_STATUS_UNSPECIFIED": 0,
@@ -236,6 +240,8 @@ var (
 		"DEPOSIT_STATUS_CONFIRMED":   3,
 		"DEPOSIT_STATUS_CREDITED":    4,
 		"DEPOSIT_STATUS_FAILED":      5,
+		"DEPOSIT_STATUS_ORPHANED":    6,
+		"DEPOSIT_STATUS_FROZEN":      7,
 	}
 )
 
@@ -656,14 +662,16 @@ const file_common_proto_rawDesc = "" +
 	"\x1cTRANSACTION_STATUS_CONFIRMED\x10\x05\x12 \n" +
 	"\x1cTRANSACTION_STATUS_COMPLETED\x10\x06\x12\x1d\n" +
 	"\x19TRANSACTION_STATUS_FAILED\x10\a\x12 \n" +
-	"\x1cTRANSACTION_STATUS_CANCELLED\x10\b*\xbe\x01\n" +
+	"\x1cTRANSACTION_STATUS_CANCELLED\x10\b*\xf6\x01\n" +
 	"\rDepositStatus\x12\x1e\n" +
 	"\x1aDEPOSIT_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17DEPOSIT_STATUS_DETECTED\x10\x01\x12\x1a\n" +
 	"\x16DEPOSIT_STATUS_PENDING\x10\x02\x12\x1c\n" +
 	"\x18DEPOSIT_STATUS_CONFIRMED\x10\x03\x12\x1b\n" +
 	"\x17DEPOSIT_STATUS_CREDITED\x10\x04\x12\x19\n" +
-	"\x15DEPOSIT_STATUS_FAILED\x10\x05B,Z*shared/genproto/shared/accounting/cryptopbb\x06proto3"
+	"\x15DEPOSIT_STATUS_FAILED\x10\x05\x12\x1b\n" +
+	"\x17DEPOSIT_STATUS_ORPHANED\x10\x06\x12\x19\n" +
+	"\x15DEPOSIT_STATUS_FROZEN\x10\aB,Z*shared/genproto/shared/accounting/cryptopbb\x06proto3"
 
 var (
 	file_common_proto_rawDescOnce sync.Once
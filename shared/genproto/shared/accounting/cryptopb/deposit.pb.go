@@ -45,8 +45,14 @@ type Deposit struct {
 	ConfirmedAt           *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
 	CreditedAt            *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=credited_at,json=creditedAt,proto3" json:"credited_at,omitempty"`
 	BlockTimestamp        *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// Screening (set on frozen deposits)
+	RiskFactors   []*RiskFactor          `protobuf:"bytes,19,rep,name=risk_factors,json=riskFactors,proto3" json:"risk_factors,omitempty"`
+	FrozenAt      *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,21,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewNotes   string                 `protobuf:"bytes,22,opt,name=review_notes,json=reviewNotes,proto3" json:"review_notes,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Deposit) Reset() {
@@ -205,6 +211,41 @@ func (x *Deposit) GetBlockTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Deposit) GetRiskFactors() []*RiskFactor {
+	if x != nil {
+		return x.RiskFactors
+	}
+	return nil
+}
+
+func (x *Deposit) GetFrozenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FrozenAt
+	}
+	return nil
+}
+
+func (x *Deposit) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *Deposit) GetReviewNotes() string {
+	if x != nil {
+		return x.ReviewNotes
+	}
+	return ""
+}
+
+func (x *Deposit) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
 // GetUserDeposits
 type GetUserDepositsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -528,11 +569,342 @@ func (x *GetPendingDepositsResponse) GetTotal() int32 {
 	return 0
 }
 
+// GetFrozenDeposits
+type GetFrozenDepositsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFrozenDepositsRequest) Reset() {
+	*x = GetFrozenDepositsRequest{}
+	mi := &file_deposit_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFrozenDepositsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFrozenDepositsRequest) ProtoMessage() {}
+
+func (x *GetFrozenDepositsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposit_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFrozenDepositsRequest.ProtoReflect.Descriptor instead.
+func (*GetFrozenDepositsRequest) Descriptor() ([]byte, []int) {
+	return file_deposit_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetFrozenDepositsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetFrozenDepositsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deposits      []*Deposit             `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFrozenDepositsResponse) Reset() {
+	*x = GetFrozenDepositsResponse{}
+	mi := &file_deposit_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFrozenDepositsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFrozenDepositsResponse) ProtoMessage() {}
+
+func (x *GetFrozenDepositsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposit_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFrozenDepositsResponse.ProtoReflect.Descriptor instead.
+func (*GetFrozenDepositsResponse) Descriptor() ([]byte, []int) {
+	return file_deposit_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetFrozenDepositsResponse) GetDeposits() []*Deposit {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+func (x *GetFrozenDepositsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// ReleaseFrozenDeposit
+type ReleaseFrozenDepositRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DepositId     string                 `protobuf:"bytes,1,opt,name=deposit_id,json=depositId,proto3" json:"deposit_id,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,2,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"` // Admin user ID
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`                             // Optional review notes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseFrozenDepositRequest) Reset() {
+	*x = ReleaseFrozenDepositRequest{}
+	mi := &file_deposit_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseFrozenDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseFrozenDepositRequest) ProtoMessage() {}
+
+func (x *ReleaseFrozenDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposit_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseFrozenDepositRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseFrozenDepositRequest) Descriptor() ([]byte, []int) {
+	return file_deposit_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReleaseFrozenDepositRequest) GetDepositId() string {
+	if x != nil {
+		return x.DepositId
+	}
+	return ""
+}
+
+func (x *ReleaseFrozenDepositRequest) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *ReleaseFrozenDepositRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type ReleaseFrozenDepositResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Deposit       *Deposit               `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseFrozenDepositResponse) Reset() {
+	*x = ReleaseFrozenDepositResponse{}
+	mi := &file_deposit_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseFrozenDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseFrozenDepositResponse) ProtoMessage() {}
+
+func (x *ReleaseFrozenDepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposit_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseFrozenDepositResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseFrozenDepositResponse) Descriptor() ([]byte, []int) {
+	return file_deposit_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReleaseFrozenDepositResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleaseFrozenDepositResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReleaseFrozenDepositResponse) GetDeposit() *Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+// RejectFrozenDeposit
+type RejectFrozenDepositRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DepositId       string                 `protobuf:"bytes,1,opt,name=deposit_id,json=depositId,proto3" json:"deposit_id,omitempty"`
+	ReviewedBy      string                 `protobuf:"bytes,2,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`                // Admin user ID
+	RejectionReason string                 `protobuf:"bytes,3,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"` // Required
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RejectFrozenDepositRequest) Reset() {
+	*x = RejectFrozenDepositRequest{}
+	mi := &file_deposit_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFrozenDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFrozenDepositRequest) ProtoMessage() {}
+
+func (x *RejectFrozenDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deposit_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFrozenDepositRequest.ProtoReflect.Descriptor instead.
+func (*RejectFrozenDepositRequest) Descriptor() ([]byte, []int) {
+	return file_deposit_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RejectFrozenDepositRequest) GetDepositId() string {
+	if x != nil {
+		return x.DepositId
+	}
+	return ""
+}
+
+func (x *RejectFrozenDepositRequest) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *RejectFrozenDepositRequest) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+type RejectFrozenDepositResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectFrozenDepositResponse) Reset() {
+	*x = RejectFrozenDepositResponse{}
+	mi := &file_deposit_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFrozenDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFrozenDepositResponse) ProtoMessage() {}
+
+func (x *RejectFrozenDepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deposit_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFrozenDepositResponse.ProtoReflect.Descriptor instead.
+func (*RejectFrozenDepositResponse) Descriptor() ([]byte, []int) {
+	return file_deposit_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RejectFrozenDepositResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RejectFrozenDepositResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_deposit_proto protoreflect.FileDescriptor
 
 const file_deposit_proto_rawDesc = "" +
 	"\n" +
-	"\rdeposit.proto\x12\tcrypto.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe9\x05\n" +
+	"\rdeposit.proto\x12\tcrypto.v1\x1a\fcommon.proto\x1a\x11transaction.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdd\a\n" +
 	"\aDeposit\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
@@ -556,7 +928,14 @@ const file_deposit_proto_rawDesc = "" +
 	"\fconfirmed_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\vconfirmedAt\x12;\n" +
 	"\vcredited_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"creditedAt\x12C\n" +
-	"\x0fblock_timestamp\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\x0eblockTimestamp\"\xdf\x01\n" +
+	"\x0fblock_timestamp\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\x0eblockTimestamp\x128\n" +
+	"\frisk_factors\x18\x13 \x03(\v2\x15.crypto.v1.RiskFactorR\vriskFactors\x127\n" +
+	"\tfrozen_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\bfrozenAt\x12\x1f\n" +
+	"\vreviewed_by\x18\x15 \x01(\tR\n" +
+	"reviewedBy\x12!\n" +
+	"\freview_notes\x18\x16 \x01(\tR\vreviewNotes\x12;\n" +
+	"\vreviewed_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\"\xdf\x01\n" +
 	"\x16GetUserDepositsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
 	"\x05chain\x18\x02 \x01(\x0e2\x10.crypto.v1.ChainR\x05chain\x12\x14\n" +
@@ -580,12 +959,39 @@ const file_deposit_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"b\n" +
 	"\x1aGetPendingDepositsResponse\x12.\n" +
 	"\bdeposits\x18\x01 \x03(\v2\x12.crypto.v1.DepositR\bdeposits\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total2\x98\x02\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"0\n" +
+	"\x18GetFrozenDepositsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"a\n" +
+	"\x19GetFrozenDepositsResponse\x12.\n" +
+	"\bdeposits\x18\x01 \x03(\v2\x12.crypto.v1.DepositR\bdeposits\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"s\n" +
+	"\x1bReleaseFrozenDepositRequest\x12\x1d\n" +
+	"\n" +
+	"deposit_id\x18\x01 \x01(\tR\tdepositId\x12\x1f\n" +
+	"\vreviewed_by\x18\x02 \x01(\tR\n" +
+	"reviewedBy\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\"\x80\x01\n" +
+	"\x1cReleaseFrozenDepositResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\adeposit\x18\x03 \x01(\v2\x12.crypto.v1.DepositR\adeposit\"\x87\x01\n" +
+	"\x1aRejectFrozenDepositRequest\x12\x1d\n" +
+	"\n" +
+	"deposit_id\x18\x01 \x01(\tR\tdepositId\x12\x1f\n" +
+	"\vreviewed_by\x18\x02 \x01(\tR\n" +
+	"reviewedBy\x12)\n" +
+	"\x10rejection_reason\x18\x03 \x01(\tR\x0frejectionReason\"Q\n" +
+	"\x1bRejectFrozenDepositResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xc7\x04\n" +
 	"\x0eDepositService\x12X\n" +
 	"\x0fGetUserDeposits\x12!.crypto.v1.GetUserDepositsRequest\x1a\".crypto.v1.GetUserDepositsResponse\x12I\n" +
 	"\n" +
 	"GetDeposit\x12\x1c.crypto.v1.GetDepositRequest\x1a\x1d.crypto.v1.GetDepositResponse\x12a\n" +
-	"\x12GetPendingDeposits\x12$.crypto.v1.GetPendingDepositsRequest\x1a%.crypto.v1.GetPendingDepositsResponseB,Z*shared/genproto/shared/accounting/cryptopbb\x06proto3"
+	"\x12GetPendingDeposits\x12$.crypto.v1.GetPendingDepositsRequest\x1a%.crypto.v1.GetPendingDepositsResponse\x12^\n" +
+	"\x11GetFrozenDeposits\x12#.crypto.v1.GetFrozenDepositsRequest\x1a$.crypto.v1.GetFrozenDepositsResponse\x12g\n" +
+	"\x14ReleaseFrozenDeposit\x12&.crypto.v1.ReleaseFrozenDepositRequest\x1a'.crypto.v1.ReleaseFrozenDepositResponse\x12d\n" +
+	"\x13RejectFrozenDeposit\x12%.crypto.v1.RejectFrozenDepositRequest\x1a&.crypto.v1.RejectFrozenDepositResponseB,Z*shared/genproto/shared/accounting/cryptopbb\x06proto3"
 
 var (
 	file_deposit_proto_rawDescOnce sync.Once
@@ -599,48 +1005,66 @@ func file_deposit_proto_rawDescGZIP() []byte {
 	return file_deposit_proto_rawDescData
 }
 
-var file_deposit_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_deposit_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_deposit_proto_goTypes = []any{
-	(*Deposit)(nil),                    // 0: crypto.v1.Deposit
-	(*GetUserDepositsRequest)(nil),     // 1: crypto.v1.GetUserDepositsRequest
-	(*GetUserDepositsResponse)(nil),    // 2: crypto.v1.GetUserDepositsResponse
-	(*GetDepositRequest)(nil),          // 3: crypto.v1.GetDepositRequest
-	(*GetDepositResponse)(nil),         // 4: crypto.v1.GetDepositResponse
-	(*GetPendingDepositsRequest)(nil),  // 5: crypto.v1.GetPendingDepositsRequest
-	(*GetPendingDepositsResponse)(nil), // 6: crypto.v1.GetPendingDepositsResponse
-	(Chain)(0),                         // 7: crypto.v1.Chain
-	(*Money)(nil),                      // 8: crypto.v1.Money
-	(DepositStatus)(0),                 // 9: crypto.v1.DepositStatus
-	(*timestamppb.Timestamp)(nil),      // 10: google.protobuf.Timestamp
-	(*PaginationRequest)(nil),          // 11: crypto.v1.PaginationRequest
-	(*PaginationResponse)(nil),         // 12: crypto.v1.PaginationResponse
+	(*Deposit)(nil),                      // 0: crypto.v1.Deposit
+	(*GetUserDepositsRequest)(nil),       // 1: crypto.v1.GetUserDepositsRequest
+	(*GetUserDepositsResponse)(nil),      // 2: crypto.v1.GetUserDepositsResponse
+	(*GetDepositRequest)(nil),            // 3: crypto.v1.GetDepositRequest
+	(*GetDepositResponse)(nil),           // 4: crypto.v1.GetDepositResponse
+	(*GetPendingDepositsRequest)(nil),    // 5: crypto.v1.GetPendingDepositsRequest
+	(*GetPendingDepositsResponse)(nil),   // 6: crypto.v1.GetPendingDepositsResponse
+	(*GetFrozenDepositsRequest)(nil),     // 7: crypto.v1.GetFrozenDepositsRequest
+	(*GetFrozenDepositsResponse)(nil),    // 8: crypto.v1.GetFrozenDepositsResponse
+	(*ReleaseFrozenDepositRequest)(nil),  // 9: crypto.v1.ReleaseFrozenDepositRequest
+	(*ReleaseFrozenDepositResponse)(nil), // 10: crypto.v1.ReleaseFrozenDepositResponse
+	(*RejectFrozenDepositRequest)(nil),   // 11: crypto.v1.RejectFrozenDepositRequest
+	(*RejectFrozenDepositResponse)(nil),  // 12: crypto.v1.RejectFrozenDepositResponse
+	(Chain)(0),                           // 13: crypto.v1.Chain
+	(*Money)(nil),                        // 14: crypto.v1.Money
+	(DepositStatus)(0),                   // 15: crypto.v1.DepositStatus
+	(*timestamppb.Timestamp)(nil),        // 16: google.protobuf.Timestamp
+	(*RiskFactor)(nil),                   // 17: crypto.v1.RiskFactor
+	(*PaginationRequest)(nil),            // 18: crypto.v1.PaginationRequest
+	(*PaginationResponse)(nil),           // 19: crypto.v1.PaginationResponse
 }
 var file_deposit_proto_depIdxs = []int32{
-	7,  // 0: crypto.v1.Deposit.chain:type_name -> crypto.v1.Chain
-	8,  // 1: crypto.v1.Deposit.amount:type_name -> crypto.v1.Money
-	9,  // 2: crypto.v1.Deposit.status:type_name -> crypto.v1.DepositStatus
-	10, // 3: crypto.v1.Deposit.detected_at:type_name -> google.protobuf.Timestamp
-	10, // 4: crypto.v1.Deposit.confirmed_at:type_name -> google.protobuf.Timestamp
-	10, // 5: crypto.v1.Deposit.credited_at:type_name -> google.protobuf.Timestamp
-	10, // 6: crypto.v1.Deposit.block_timestamp:type_name -> google.protobuf.Timestamp
-	7,  // 7: crypto.v1.GetUserDepositsRequest.chain:type_name -> crypto.v1.Chain
-	9,  // 8: crypto.v1.GetUserDepositsRequest.status:type_name -> crypto.v1.DepositStatus
-	11, // 9: crypto.v1.GetUserDepositsRequest.pagination:type_name -> crypto.v1.PaginationRequest
-	0,  // 10: crypto.v1.GetUserDepositsResponse.deposits:type_name -> crypto.v1.Deposit
-	12, // 11: crypto.v1.GetUserDepositsResponse.pagination:type_name -> crypto.v1.PaginationResponse
-	0,  // 12: crypto.v1.GetDepositResponse.deposit:type_name -> crypto.v1.Deposit
-	0,  // 13: crypto.v1.GetPendingDepositsResponse.deposits:type_name -> crypto.v1.Deposit
-	1,  // 14: crypto.v1.DepositService.GetUserDeposits:input_type -> crypto.v1.GetUserDepositsRequest
-	3,  // 15: crypto.v1.DepositService.GetDeposit:input_type -> crypto.v1.GetDepositRequest
-	5,  // 16: crypto.v1.DepositService.GetPendingDeposits:input_type -> crypto.v1.GetPendingDepositsRequest
-	2,  // 17: crypto.v1.DepositService.GetUserDeposits:output_type -> crypto.v1.GetUserDepositsResponse
-	4,  // 18: crypto.v1.DepositService.GetDeposit:output_type -> crypto.v1.GetDepositResponse
-	6,  // 19: crypto.v1.DepositService.GetPendingDeposits:output_type -> crypto.v1.GetPendingDepositsResponse
-	17, // [17:20] is the sub-list for method output_type
-	14, // [14:17] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	13, // 0: crypto.v1.Deposit.chain:type_name -> crypto.v1.Chain
+	14, // 1: crypto.v1.Deposit.amount:type_name -> crypto.v1.Money
+	15, // 2: crypto.v1.Deposit.status:type_name -> crypto.v1.DepositStatus
+	16, // 3: crypto.v1.Deposit.detected_at:type_name -> google.protobuf.Timestamp
+	16, // 4: crypto.v1.Deposit.confirmed_at:type_name -> google.protobuf.Timestamp
+	16, // 5: crypto.v1.Deposit.credited_at:type_name -> google.protobuf.Timestamp
+	16, // 6: crypto.v1.Deposit.block_timestamp:type_name -> google.protobuf.Timestamp
+	17, // 7: crypto.v1.Deposit.risk_factors:type_name -> crypto.v1.RiskFactor
+	16, // 8: crypto.v1.Deposit.frozen_at:type_name -> google.protobuf.Timestamp
+	16, // 9: crypto.v1.Deposit.reviewed_at:type_name -> google.protobuf.Timestamp
+	13, // 10: crypto.v1.GetUserDepositsRequest.chain:type_name -> crypto.v1.Chain
+	15, // 11: crypto.v1.GetUserDepositsRequest.status:type_name -> crypto.v1.DepositStatus
+	18, // 12: crypto.v1.GetUserDepositsRequest.pagination:type_name -> crypto.v1.PaginationRequest
+	0,  // 13: crypto.v1.GetUserDepositsResponse.deposits:type_name -> crypto.v1.Deposit
+	19, // 14: crypto.v1.GetUserDepositsResponse.pagination:type_name -> crypto.v1.PaginationResponse
+	0,  // 15: crypto.v1.GetDepositResponse.deposit:type_name -> crypto.v1.Deposit
+	0,  // 16: crypto.v1.GetPendingDepositsResponse.deposits:type_name -> crypto.v1.Deposit
+	0,  // 17: crypto.v1.GetFrozenDepositsResponse.deposits:type_name -> crypto.v1.Deposit
+	0,  // 18: crypto.v1.ReleaseFrozenDepositResponse.deposit:type_name -> crypto.v1.Deposit
+	1,  // 19: crypto.v1.DepositService.GetUserDeposits:input_type -> crypto.v1.GetUserDepositsRequest
+	3,  // 20: crypto.v1.DepositService.GetDeposit:input_type -> crypto.v1.GetDepositRequest
+	5,  // 21: crypto.v1.DepositService.GetPendingDeposits:input_type -> crypto.v1.GetPendingDepositsRequest
+	7,  // 22: crypto.v1.DepositService.GetFrozenDeposits:input_type -> crypto.v1.GetFrozenDepositsRequest
+	9,  // 23: crypto.v1.DepositService.ReleaseFrozenDeposit:input_type -> crypto.v1.ReleaseFrozenDepositRequest
+	11, // 24: crypto.v1.DepositService.RejectFrozenDeposit:input_type -> crypto.v1.RejectFrozenDepositRequest
+	2,  // 25: crypto.v1.DepositService.GetUserDeposits:output_type -> crypto.v1.GetUserDepositsResponse
+	4,  // 26: crypto.v1.DepositService.GetDeposit:output_type -> crypto.v1.GetDepositResponse
+	6,  // 27: crypto.v1.DepositService.GetPendingDeposits:output_type -> crypto.v1.GetPendingDepositsResponse
+	8,  // 28: crypto.v1.DepositService.GetFrozenDeposits:output_type -> crypto.v1.GetFrozenDepositsResponse
+	10, // 29: crypto.v1.DepositService.ReleaseFrozenDeposit:output_type -> crypto.v1.ReleaseFrozenDepositResponse
+	12, // 30: crypto.v1.DepositService.RejectFrozenDeposit:output_type -> crypto.v1.RejectFrozenDepositResponse
+	25, // [25:31] is the sub-list for method output_type
+	19, // [19:25] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_deposit_proto_init() }
@@ -649,13 +1073,14 @@ func file_deposit_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_transaction_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_deposit_proto_rawDesc), len(file_deposit_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
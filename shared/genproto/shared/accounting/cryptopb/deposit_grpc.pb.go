@@ -21,9 +21,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DepositService_GetUserDeposits_FullMethodName    = "/crypto.v1.DepositService/GetUserDeposits"
-	DepositService_GetDeposit_FullMethodName         = "/crypto.v1.DepositService/GetDeposit"
-	DepositService_GetPendingDeposits_FullMethodName = "/crypto.v1.DepositService/GetPendingDeposits"
+	DepositService_GetUserDeposits_FullMethodName      = "/crypto.v1.DepositService/GetUserDeposits"
+	DepositService_GetDeposit_FullMethodName           = "/crypto.v1.DepositService/GetDeposit"
+	DepositService_GetPendingDeposits_FullMethodName   = "/crypto.v1.DepositService/GetPendingDeposits"
+	DepositService_GetFrozenDeposits_FullMethodName    = "/crypto.v1.DepositService/GetFrozenDeposits"
+	DepositService_ReleaseFrozenDeposit_FullMethodName = "/crypto.v1.DepositService/ReleaseFrozenDeposit"
+	DepositService_RejectFrozenDeposit_FullMethodName  = "/crypto.v1.DepositService/RejectFrozenDeposit"
 )
 
 // DepositServiceClient is the client API for DepositService service.
@@ -38,6 +41,13 @@ type DepositServiceClient interface {
 	GetDeposit(ctx context.Context, in *GetDepositRequest, opts ...grpc.CallOption) (*GetDepositResponse, error)
 	// Get pending deposits (waiting confirmations)
 	GetPendingDeposits(ctx context.Context, in *GetPendingDepositsRequest, opts ...grpc.CallOption) (*GetPendingDepositsResponse, error)
+	// ========== FROZEN DEPOSITS (Admin) ==========
+	// Deposits from screened-out addresses waiting for review
+	GetFrozenDeposits(ctx context.Context, in *GetFrozenDepositsRequest, opts ...grpc.CallOption) (*GetFrozenDepositsResponse, error)
+	// Release a frozen deposit and credit it
+	ReleaseFrozenDeposit(ctx context.Context, in *ReleaseFrozenDepositRequest, opts ...grpc.CallOption) (*ReleaseFrozenDepositResponse, error)
+	// Reject a frozen deposit; it is never credited
+	RejectFrozenDeposit(ctx context.Context, in *RejectFrozenDepositRequest, opts ...grpc.CallOption) (*RejectFrozenDepositResponse, error)
 }
 
 type depositServiceClient struct {
@@ -78,6 +88,36 @@ func (c *depositServiceClient) GetPendingDeposits(ctx context.Context, in *GetPe
 	return out, nil
 }
 
+func (c *depositServiceClient) GetFrozenDeposits(ctx context.Context, in *GetFrozenDepositsRequest, opts ...grpc.CallOption) (*GetFrozenDepositsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFrozenDepositsResponse)
+	err := c.cc.Invoke(ctx, DepositService_GetFrozenDeposits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *depositServiceClient) ReleaseFrozenDeposit(ctx context.Context, in *ReleaseFrozenDepositRequest, opts ...grpc.CallOption) (*ReleaseFrozenDepositResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseFrozenDepositResponse)
+	err := c.cc.Invoke(ctx, DepositService_ReleaseFrozenDeposit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *depositServiceClient) RejectFrozenDeposit(ctx context.Context, in *RejectFrozenDepositRequest, opts ...grpc.CallOption) (*RejectFrozenDepositResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectFrozenDepositResponse)
+	err := c.cc.Invoke(ctx, DepositService_RejectFrozenDeposit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DepositServiceServer is the server API for DepositService service.
 // All implementations must embed UnimplementedDepositServiceServer
 // for forward compatibility.
@@ -90,6 +130,13 @@ type DepositServiceServer interface {
 	GetDeposit(context.Context, *GetDepositRequest) (*GetDepositResponse, error)
 	// Get pending deposits (waiting confirmations)
 	GetPendingDeposits(context.Context, *GetPendingDepositsRequest) (*GetPendingDepositsResponse, error)
+	// ========== FROZEN DEPOSITS (Admin) ==========
+	// Deposits from screened-out addresses waiting for review
+	GetFrozenDeposits(context.Context, *GetFrozenDepositsRequest) (*GetFrozenDepositsResponse, error)
+	// Release a frozen deposit and credit it
+	ReleaseFrozenDeposit(context.Context, *ReleaseFrozenDepositRequest) (*ReleaseFrozenDepositResponse, error)
+	// Reject a frozen deposit; it is never credited
+	RejectFrozenDeposit(context.Context, *RejectFrozenDepositRequest) (*RejectFrozenDepositResponse, error)
 	mustEmbedUnimplementedDepositServiceServer()
 }
 
@@ -109,6 +156,15 @@ func (UnimplementedDepositServiceServer) GetDeposit(context.Context, *GetDeposit
 func (UnimplementedDepositServiceServer) GetPendingDeposits(context.Context, *GetPendingDepositsRequest) (*GetPendingDepositsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPendingDeposits not implemented")
 }
+func (UnimplementedDepositServiceServer) GetFrozenDeposits(context.Context, *GetFrozenDepositsRequest) (*GetFrozenDepositsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFrozenDeposits not implemented")
+}
+func (UnimplementedDepositServiceServer) ReleaseFrozenDeposit(context.Context, *ReleaseFrozenDepositRequest) (*ReleaseFrozenDepositResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseFrozenDeposit not implemented")
+}
+func (UnimplementedDepositServiceServer) RejectFrozenDeposit(context.Context, *RejectFrozenDepositRequest) (*RejectFrozenDepositResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectFrozenDeposit not implemented")
+}
 func (UnimplementedDepositServiceServer) mustEmbedUnimplementedDepositServiceServer() {}
 func (UnimplementedDepositServiceServer) testEmbeddedByValue()                        {}
 
@@ -184,6 +240,60 @@ func _DepositService_GetPendingDeposits_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DepositService_GetFrozenDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFrozenDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepositServiceServer).GetFrozenDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepositService_GetFrozenDeposits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepositServiceServer).GetFrozenDeposits(ctx, req.(*GetFrozenDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepositService_ReleaseFrozenDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseFrozenDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepositServiceServer).ReleaseFrozenDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepositService_ReleaseFrozenDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepositServiceServer).ReleaseFrozenDeposit(ctx, req.(*ReleaseFrozenDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepositService_RejectFrozenDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFrozenDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepositServiceServer).RejectFrozenDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepositService_RejectFrozenDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepositServiceServer).RejectFrozenDeposit(ctx, req.(*RejectFrozenDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DepositService_ServiceDesc is the grpc.ServiceDesc for DepositService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +313,18 @@ var DepositService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPendingDeposits",
 			Handler:    _DepositService_GetPendingDeposits_Handler,
 		},
+		{
+			MethodName: "GetFrozenDeposits",
+			Handler:    _DepositService_GetFrozenDeposits_Handler,
+		},
+		{
+			MethodName: "ReleaseFrozenDeposit",
+			Handler:    _DepositService_ReleaseFrozenDeposit_Handler,
+		},
+		{
+			MethodName: "RejectFrozenDeposit",
+			Handler:    _DepositService_RejectFrozenDeposit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "deposit.proto",
@@ -132,43 +132,52 @@ func (AccountType) EnumDescriptor() ([]byte, []int) {
 type AccountPurpose int32
 
 const (
-	AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED AccountPurpose = 0
-	AccountPurpose_ACCOUNT_PURPOSE_WALLET      AccountPurpose = 1
-	AccountPurpose_ACCOUNT_PURPOSE_LIQUIDITY   AccountPurpose = 2
-	AccountPurpose_ACCOUNT_PURPOSE_CLEARING    AccountPurpose = 3
-	AccountPurpose_ACCOUNT_PURPOSE_FEES        AccountPurpose = 4
-	AccountPurpose_ACCOUNT_PURPOSE_ESCROW      AccountPurpose = 5
-	AccountPurpose_ACCOUNT_PURPOSE_SETTLEMENT  AccountPurpose = 6
-	AccountPurpose_ACCOUNT_PURPOSE_REVENUE     AccountPurpose = 7
-	AccountPurpose_ACCOUNT_PURPOSE_CONTRA      AccountPurpose = 8
-	AccountPurpose_ACCOUNT_PURPOSE_COMMISSION  AccountPurpose = 9
+	AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED      AccountPurpose = 0
+	AccountPurpose_ACCOUNT_PURPOSE_WALLET           AccountPurpose = 1
+	AccountPurpose_ACCOUNT_PURPOSE_LIQUIDITY        AccountPurpose = 2
+	AccountPurpose_ACCOUNT_PURPOSE_CLEARING         AccountPurpose = 3
+	AccountPurpose_ACCOUNT_PURPOSE_FEES             AccountPurpose = 4
+	AccountPurpose_ACCOUNT_PURPOSE_ESCROW           AccountPurpose = 5
+	AccountPurpose_ACCOUNT_PURPOSE_SETTLEMENT       AccountPurpose = 6
+	AccountPurpose_ACCOUNT_PURPOSE_REVENUE          AccountPurpose = 7
+	AccountPurpose_ACCOUNT_PURPOSE_CONTRA           AccountPurpose = 8
+	AccountPurpose_ACCOUNT_PURPOSE_COMMISSION       AccountPurpose = 9
+	AccountPurpose_ACCOUNT_PURPOSE_INVESTMENT       AccountPurpose = 10
+	AccountPurpose_ACCOUNT_PURPOSE_SAVINGS          AccountPurpose = 11
+	AccountPurpose_ACCOUNT_PURPOSE_INTEREST_EXPENSE AccountPurpose = 12
 )
 
 // Enum value maps for AccountPurpose.
 var (
 	AccountPurpose_name = map[int32]string{
-		0: "ACCOUNT_PURPOSE_UNSPECIFIED",
-		1: "ACCOUNT_PURPOSE_WALLET",
-		2: "ACCOUNT_PURPOSE_LIQUIDITY",
-		3: "ACCOUNT_PURPOSE_CLEARING",
-		4: "ACCOUNT_PURPOSE_FEES",
-		5: "ACCOUNT_PURPOSE_ESCROW",
-		6: "ACCOUNT_PURPOSE_SETTLEMENT",
-		7: "ACCOUNT_PURPOSE_REVENUE",
-		8: "ACCOUNT_PURPOSE_CONTRA",
-		9: "ACCOUNT_PURPOSE_COMMISSION",
+		0:  "ACCOUNT_PURPOSE_UNSPECIFIED",
+		1:  "ACCOUNT_PURPOSE_WALLET",
+		2:  "ACCOUNT_PURPOSE_LIQUIDITY",
+		3:  "ACCOUNT_PURPOSE_CLEARING",
+		4:  "ACCOUNT_PURPOSE_FEES",
+		5:  "ACCOUNT_PURPOSE_ESCROW",
+		6:  "ACCOUNT_PURPOSE_SETTLEMENT",
+		7:  "ACCOUNT_PURPOSE_REVENUE",
+		8:  "ACCOUNT_PURPOSE_CONTRA",
+		9:  "ACCOUNT_PURPOSE_COMMISSION",
+		10: "ACCOUNT_PURPOSE_INVESTMENT",
+		11: "ACCOUNT_PURPOSE_SAVINGS",
+		12: "ACCOUNT_PURPOSE_INTEREST_EXPENSE",
 	}
 	AccountPurpose_value = map[string]int32{
-		"ACCOUNT_PURPOSE_UNSPECIFIED": 0,
-		"ACCOUNT_PURPOSE_WALLET":      1,
-		"ACCOUNT_PURPOSE_LIQUIDITY":   2,
-		"ACCOUNT_PURPOSE_CLEARING":    3,
-		"ACCOUNT_PURPOSE_FEES":        4,
-		"ACCOUNT_PURPOSE_ESCROW":      5,
-		"ACCOUNT_PURPOSE_SETTLEMENT":  6,
-		"ACCOUNT_PURPOSE_REVENUE":     7,
-		"ACCOUNT_PURPOSE_CONTRA":      8,
-		"ACCOUNT_PURPOSE_COMMISSION":  9,
+		"ACCOUNT_PURPOSE_UNSPECIFIED":      0,
+		"ACCOUNT_PURPOSE_WALLET":           1,
+		"ACCOUNT_PURPOSE_LIQUIDITY":        2,
+		"ACCOUNT_PURPOSE_CLEARING":         3,
+		"ACCOUNT_PURPOSE_FEES":             4,
+		"ACCOUNT_PURPOSE_ESCROW":           5,
+		"ACCOUNT_PURPOSE_SETTLEMENT":       6,
+		"ACCOUNT_PURPOSE_REVENUE":          7,
+		"ACCOUNT_PURPOSE_CONTRA":           8,
+		"ACCOUNT_PURPOSE_COMMISSION":       9,
+		"ACCOUNT_PURPOSE_INVESTMENT":       10,
+		"ACCOUNT_PURPOSE_SAVINGS":          11,
+		"ACCOUNT_PURPOSE_INTEREST_EXPENSE": 12,
 	}
 )
 
@@ -434,6 +443,223 @@ func (FeeType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{6}
 }
 
+type StatementFormat int32
+
+const (
+	StatementFormat_STATEMENT_FORMAT_UNSPECIFIED StatementFormat = 0
+	StatementFormat_STATEMENT_FORMAT_CSV         StatementFormat = 1
+	StatementFormat_STATEMENT_FORMAT_PDF         StatementFormat = 2
+)
+
+// Enum value maps for StatementFormat.
+var (
+	StatementFormat_name = map[int32]string{
+		0: "STATEMENT_FORMAT_UNSPECIFIED",
+		1: "STATEMENT_FORMAT_CSV",
+		2: "STATEMENT_FORMAT_PDF",
+	}
+	StatementFormat_value = map[string]int32{
+		"STATEMENT_FORMAT_UNSPECIFIED": 0,
+		"STATEMENT_FORMAT_CSV":         1,
+		"STATEMENT_FORMAT_PDF":         2,
+	}
+)
+
+func (x StatementFormat) Enum() *StatementFormat {
+	p := new(StatementFormat)
+	*p = x
+	return p
+}
+
+func (x StatementFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_shared_accounting_account_proto_enumTypes[7].Descriptor()
+}
+
+func (StatementFormat) Type() protoreflect.EnumType {
+	return &file_proto_shared_accounting_account_proto_enumTypes[7]
+}
+
+func (x StatementFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementFormat.Descriptor instead.
+func (StatementFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{7}
+}
+
+type HoldStatus int32
+
+const (
+	HoldStatus_HOLD_STATUS_UNSPECIFIED HoldStatus = 0
+	HoldStatus_HOLD_STATUS_ACTIVE      HoldStatus = 1
+	HoldStatus_HOLD_STATUS_CAPTURED    HoldStatus = 2
+	HoldStatus_HOLD_STATUS_RELEASED    HoldStatus = 3
+	HoldStatus_HOLD_STATUS_EXPIRED     HoldStatus = 4
+)
+
+// Enum value maps for HoldStatus.
+var (
+	HoldStatus_name = map[int32]string{
+		0: "HOLD_STATUS_UNSPECIFIED",
+		1: "HOLD_STATUS_ACTIVE",
+		2: "HOLD_STATUS_CAPTURED",
+		3: "HOLD_STATUS_RELEASED",
+		4: "HOLD_STATUS_EXPIRED",
+	}
+	HoldStatus_value = map[string]int32{
+		"HOLD_STATUS_UNSPECIFIED": 0,
+		"HOLD_STATUS_ACTIVE":      1,
+		"HOLD_STATUS_CAPTURED":    2,
+		"HOLD_STATUS_RELEASED":    3,
+		"HOLD_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x HoldStatus) Enum() *HoldStatus {
+	p := new(HoldStatus)
+	*p = x
+	return p
+}
+
+func (x HoldStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HoldStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_shared_accounting_account_proto_enumTypes[8].Descriptor()
+}
+
+func (HoldStatus) Type() protoreflect.EnumType {
+	return &file_proto_shared_accounting_account_proto_enumTypes[8]
+}
+
+func (x HoldStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HoldStatus.Descriptor instead.
+func (HoldStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{8}
+}
+
+type ReconciliationRunStatus int32
+
+const (
+	ReconciliationRunStatus_RECONCILIATION_RUN_STATUS_UNSPECIFIED ReconciliationRunStatus = 0
+	ReconciliationRunStatus_RECONCILIATION_RUN_STATUS_RUNNING     ReconciliationRunStatus = 1
+	ReconciliationRunStatus_RECONCILIATION_RUN_STATUS_COMPLETED   ReconciliationRunStatus = 2
+	ReconciliationRunStatus_RECONCILIATION_RUN_STATUS_FAILED      ReconciliationRunStatus = 3
+)
+
+// Enum value maps for ReconciliationRunStatus.
+var (
+	ReconciliationRunStatus_name = map[int32]string{
+		0: "RECONCILIATION_RUN_STATUS_UNSPECIFIED",
+		1: "RECONCILIATION_RUN_STATUS_RUNNING",
+		2: "RECONCILIATION_RUN_STATUS_COMPLETED",
+		3: "RECONCILIATION_RUN_STATUS_FAILED",
+	}
+	ReconciliationRunStatus_value = map[string]int32{
+		"RECONCILIATION_RUN_STATUS_UNSPECIFIED": 0,
+		"RECONCILIATION_RUN_STATUS_RUNNING":     1,
+		"RECONCILIATION_RUN_STATUS_COMPLETED":   2,
+		"RECONCILIATION_RUN_STATUS_FAILED":      3,
+	}
+)
+
+func (x ReconciliationRunStatus) Enum() *ReconciliationRunStatus {
+	p := new(ReconciliationRunStatus)
+	*p = x
+	return p
+}
+
+func (x ReconciliationRunStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationRunStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_shared_accounting_account_proto_enumTypes[9].Descriptor()
+}
+
+func (ReconciliationRunStatus) Type() protoreflect.EnumType {
+	return &file_proto_shared_accounting_account_proto_enumTypes[9]
+}
+
+func (x ReconciliationRunStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationRunStatus.Descriptor instead.
+func (ReconciliationRunStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{9}
+}
+
+type QueuedTransactionStatus int32
+
+const (
+	QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_UNSPECIFIED QueuedTransactionStatus = 0
+	QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_PENDING     QueuedTransactionStatus = 1
+	QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_PROCESSING  QueuedTransactionStatus = 2
+	QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_COMPLETED   QueuedTransactionStatus = 3
+	QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_FAILED      QueuedTransactionStatus = 4 // Rejected by the ledger
+	QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_DEAD        QueuedTransactionStatus = 5 // Dead-lettered after max_attempts retryable failures
+	QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_SKIPPED     QueuedTransactionStatus = 6 // Dead letter given up by an admin; no longer holds back its account
+)
+
+// Enum value maps for QueuedTransactionStatus.
+var (
+	QueuedTransactionStatus_name = map[int32]string{
+		0: "QUEUED_TRANSACTION_STATUS_UNSPECIFIED",
+		1: "QUEUED_TRANSACTION_STATUS_PENDING",
+		2: "QUEUED_TRANSACTION_STATUS_PROCESSING",
+		3: "QUEUED_TRANSACTION_STATUS_COMPLETED",
+		4: "QUEUED_TRANSACTION_STATUS_FAILED",
+		5: "QUEUED_TRANSACTION_STATUS_DEAD",
+		6: "QUEUED_TRANSACTION_STATUS_SKIPPED",
+	}
+	QueuedTransactionStatus_value = map[string]int32{
+		"QUEUED_TRANSACTION_STATUS_UNSPECIFIED": 0,
+		"QUEUED_TRANSACTION_STATUS_PENDING":     1,
+		"QUEUED_TRANSACTION_STATUS_PROCESSING":  2,
+		"QUEUED_TRANSACTION_STATUS_COMPLETED":   3,
+		"QUEUED_TRANSACTION_STATUS_FAILED":      4,
+		"QUEUED_TRANSACTION_STATUS_DEAD":        5,
+		"QUEUED_TRANSACTION_STATUS_SKIPPED":     6,
+	}
+)
+
+func (x QueuedTransactionStatus) Enum() *QueuedTransactionStatus {
+	p := new(QueuedTransactionStatus)
+	*p = x
+	return p
+}
+
+func (x QueuedTransactionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueuedTransactionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_shared_accounting_account_proto_enumTypes[10].Descriptor()
+}
+
+func (QueuedTransactionStatus) Type() protoreflect.EnumType {
+	return &file_proto_shared_accounting_account_proto_enumTypes[10]
+}
+
+func (x QueuedTransactionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueuedTransactionStatus.Descriptor instead.
+func (QueuedTransactionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{10}
+}
+
 type ApprovalStatus int32
 
 const (
@@ -443,6 +669,7 @@ const (
 	ApprovalStatus_APPROVAL_STATUS_REJECTED    ApprovalStatus = 3
 	ApprovalStatus_APPROVAL_STATUS_EXECUTED    ApprovalStatus = 4
 	ApprovalStatus_APPROVAL_STATUS_FAILED      ApprovalStatus = 5
+	ApprovalStatus_APPROVAL_STATUS_EXPIRED     ApprovalStatus = 6 // Quorum not reached before expires_at
 )
 
 // Enum value maps for ApprovalStatus.
@@ -454,6 +681,7 @@ var (
 		3: "APPROVAL_STATUS_REJECTED",
 		4: "APPROVAL_STATUS_EXECUTED",
 		5: "APPROVAL_STATUS_FAILED",
+		6: "APPROVAL_STATUS_EXPIRED",
 	}
 	ApprovalStatus_value = map[string]int32{
 		"APPROVAL_STATUS_UNSPECIFIED": 0,
@@ -462,6 +690,7 @@ var (
 		"APPROVAL_STATUS_REJECTED":    3,
 		"APPROVAL_STATUS_EXECUTED":    4,
 		"APPROVAL_STATUS_FAILED":      5,
+		"APPROVAL_STATUS_EXPIRED":     6,
 	}
 )
 
@@ -476,11 +705,11 @@ func (x ApprovalStatus) String() string {
 }
 
 func (ApprovalStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_shared_accounting_account_proto_enumTypes[7].Descriptor()
+	return file_proto_shared_accounting_account_proto_enumTypes[11].Descriptor()
 }
 
 func (ApprovalStatus) Type() protoreflect.EnumType {
-	return &file_proto_shared_accounting_account_proto_enumTypes[7]
+	return &file_proto_shared_accounting_account_proto_enumTypes[11]
 }
 
 func (x ApprovalStatus) Number() protoreflect.EnumNumber {
@@ -489,7 +718,7 @@ func (x ApprovalStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ApprovalStatus.Descriptor instead.
 func (ApprovalStatus) EnumDescriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{7}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{11}
 }
 
 type RelationshipType int32
@@ -531,11 +760,11 @@ func (x RelationshipType) String() string {
 }
 
 func (RelationshipType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_shared_accounting_account_proto_enumTypes[8].Descriptor()
+	return file_proto_shared_accounting_account_proto_enumTypes[12].Descriptor()
 }
 
 func (RelationshipType) Type() protoreflect.EnumType {
-	return &file_proto_shared_accounting_account_proto_enumTypes[8]
+	return &file_proto_shared_accounting_account_proto_enumTypes[12]
 }
 
 func (x RelationshipType) Number() protoreflect.EnumNumber {
@@ -544,7 +773,7 @@ func (x RelationshipType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RelationshipType.Descriptor instead.
 func (RelationshipType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{8}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{12}
 }
 
 type AgentStatus int32
@@ -583,11 +812,11 @@ func (x AgentStatus) String() string {
 }
 
 func (AgentStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_shared_accounting_account_proto_enumTypes[9].Descriptor()
+	return file_proto_shared_accounting_account_proto_enumTypes[13].Descriptor()
 }
 
 func (AgentStatus) Type() protoreflect.EnumType {
-	return &file_proto_shared_accounting_account_proto_enumTypes[9]
+	return &file_proto_shared_accounting_account_proto_enumTypes[13]
 }
 
 func (x AgentStatus) Number() protoreflect.EnumNumber {
@@ -596,27 +825,235 @@ func (x AgentStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AgentStatus.Descriptor instead.
 func (AgentStatus) EnumDescriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{9}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{13}
+}
+
+type CommissionPayoutStatus int32
+
+const (
+	CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_UNSPECIFIED CommissionPayoutStatus = 0 // Dry-run preview
+	CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_PENDING     CommissionPayoutStatus = 1 // Commissions reserved, transfer not yet recorded
+	CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_PAID        CommissionPayoutStatus = 2
+	CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_FAILED      CommissionPayoutStatus = 3 // Commissions released for the next batch
+)
+
+// Enum value maps for CommissionPayoutStatus.
+var (
+	CommissionPayoutStatus_name = map[int32]string{
+		0: "COMMISSION_PAYOUT_STATUS_UNSPECIFIED",
+		1: "COMMISSION_PAYOUT_STATUS_PENDING",
+		2: "COMMISSION_PAYOUT_STATUS_PAID",
+		3: "COMMISSION_PAYOUT_STATUS_FAILED",
+	}
+	CommissionPayoutStatus_value = map[string]int32{
+		"COMMISSION_PAYOUT_STATUS_UNSPECIFIED": 0,
+		"COMMISSION_PAYOUT_STATUS_PENDING":     1,
+		"COMMISSION_PAYOUT_STATUS_PAID":        2,
+		"COMMISSION_PAYOUT_STATUS_FAILED":      3,
+	}
+)
+
+func (x CommissionPayoutStatus) Enum() *CommissionPayoutStatus {
+	p := new(CommissionPayoutStatus)
+	*p = x
+	return p
+}
+
+func (x CommissionPayoutStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommissionPayoutStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_shared_accounting_account_proto_enumTypes[14].Descriptor()
+}
+
+func (CommissionPayoutStatus) Type() protoreflect.EnumType {
+	return &file_proto_shared_accounting_account_proto_enumTypes[14]
+}
+
+func (x CommissionPayoutStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommissionPayoutStatus.Descriptor instead.
+func (CommissionPayoutStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{14}
+}
+
+// UTC calendar windows: days from midnight, weeks from Monday, months from the 1st
+type LimitWindow int32
+
+const (
+	LimitWindow_LIMIT_WINDOW_UNSPECIFIED LimitWindow = 0
+	LimitWindow_LIMIT_WINDOW_DAILY       LimitWindow = 1
+	LimitWindow_LIMIT_WINDOW_WEEKLY      LimitWindow = 2
+	LimitWindow_LIMIT_WINDOW_MONTHLY     LimitWindow = 3
+)
+
+// Enum value maps for LimitWindow.
+var (
+	LimitWindow_name = map[int32]string{
+		0: "LIMIT_WINDOW_UNSPECIFIED",
+		1: "LIMIT_WINDOW_DAILY",
+		2: "LIMIT_WINDOW_WEEKLY",
+		3: "LIMIT_WINDOW_MONTHLY",
+	}
+	LimitWindow_value = map[string]int32{
+		"LIMIT_WINDOW_UNSPECIFIED": 0,
+		"LIMIT_WINDOW_DAILY":       1,
+		"LIMIT_WINDOW_WEEKLY":      2,
+		"LIMIT_WINDOW_MONTHLY":     3,
+	}
+)
+
+func (x LimitWindow) Enum() *LimitWindow {
+	p := new(LimitWindow)
+	*p = x
+	return p
+}
+
+func (x LimitWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LimitWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_shared_accounting_account_proto_enumTypes[15].Descriptor()
+}
+
+func (LimitWindow) Type() protoreflect.EnumType {
+	return &file_proto_shared_accounting_account_proto_enumTypes[15]
+}
+
+func (x LimitWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LimitWindow.Descriptor instead.
+func (LimitWindow) EnumDescriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{15}
+}
+
+type ScheduledTransferStatus int32
+
+const (
+	ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_UNSPECIFIED ScheduledTransferStatus = 0
+	ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_ACTIVE      ScheduledTransferStatus = 1
+	ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_COMPLETED   ScheduledTransferStatus = 2 // Past end_at or max_runs
+	ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_CANCELLED   ScheduledTransferStatus = 3
+)
+
+// Enum value maps for ScheduledTransferStatus.
+var (
+	ScheduledTransferStatus_name = map[int32]string{
+		0: "SCHEDULED_TRANSFER_STATUS_UNSPECIFIED",
+		1: "SCHEDULED_TRANSFER_STATUS_ACTIVE",
+		2: "SCHEDULED_TRANSFER_STATUS_COMPLETED",
+		3: "SCHEDULED_TRANSFER_STATUS_CANCELLED",
+	}
+	ScheduledTransferStatus_value = map[string]int32{
+		"SCHEDULED_TRANSFER_STATUS_UNSPECIFIED": 0,
+		"SCHEDULED_TRANSFER_STATUS_ACTIVE":      1,
+		"SCHEDULED_TRANSFER_STATUS_COMPLETED":   2,
+		"SCHEDULED_TRANSFER_STATUS_CANCELLED":   3,
+	}
+)
+
+func (x ScheduledTransferStatus) Enum() *ScheduledTransferStatus {
+	p := new(ScheduledTransferStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduledTransferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduledTransferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_shared_accounting_account_proto_enumTypes[16].Descriptor()
+}
+
+func (ScheduledTransferStatus) Type() protoreflect.EnumType {
+	return &file_proto_shared_accounting_account_proto_enumTypes[16]
+}
+
+func (x ScheduledTransferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduledTransferStatus.Descriptor instead.
+func (ScheduledTransferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{16}
+}
+
+type ScheduledTransferRunStatus int32
+
+const (
+	ScheduledTransferRunStatus_SCHEDULED_TRANSFER_RUN_STATUS_UNSPECIFIED ScheduledTransferRunStatus = 0
+	ScheduledTransferRunStatus_SCHEDULED_TRANSFER_RUN_STATUS_SUCCEEDED   ScheduledTransferRunStatus = 1
+	ScheduledTransferRunStatus_SCHEDULED_TRANSFER_RUN_STATUS_SKIPPED     ScheduledTransferRunStatus = 2 // Out of retries, missed or rejected
+)
+
+// Enum value maps for ScheduledTransferRunStatus.
+var (
+	ScheduledTransferRunStatus_name = map[int32]string{
+		0: "SCHEDULED_TRANSFER_RUN_STATUS_UNSPECIFIED",
+		1: "SCHEDULED_TRANSFER_RUN_STATUS_SUCCEEDED",
+		2: "SCHEDULED_TRANSFER_RUN_STATUS_SKIPPED",
+	}
+	ScheduledTransferRunStatus_value = map[string]int32{
+		"SCHEDULED_TRANSFER_RUN_STATUS_UNSPECIFIED": 0,
+		"SCHEDULED_TRANSFER_RUN_STATUS_SUCCEEDED":   1,
+		"SCHEDULED_TRANSFER_RUN_STATUS_SKIPPED":     2,
+	}
+)
+
+func (x ScheduledTransferRunStatus) Enum() *ScheduledTransferRunStatus {
+	p := new(ScheduledTransferRunStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduledTransferRunStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduledTransferRunStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_shared_accounting_account_proto_enumTypes[17].Descriptor()
+}
+
+func (ScheduledTransferRunStatus) Type() protoreflect.EnumType {
+	return &file_proto_shared_accounting_account_proto_enumTypes[17]
+}
+
+func (x ScheduledTransferRunStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduledTransferRunStatus.Descriptor instead.
+func (ScheduledTransferRunStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{17}
 }
 
 type Account struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AccountNumber  string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	OwnerType      OwnerType              `protobuf:"varint,3,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType" json:"owner_type,omitempty"`
-	OwnerId        string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
-	Purpose        AccountPurpose         `protobuf:"varint,6,opt,name=purpose,proto3,enum=accounting.v1.AccountPurpose" json:"purpose,omitempty"`
-	AccountType    AccountType            `protobuf:"varint,7,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
-	IsActive       bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsLocked       bool                   `protobuf:"varint,9,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
-	OverdraftLimit float64                `protobuf:"fixed64,10,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"`
-	ParentAgentId  int64                  `protobuf:"varint,11,opt,name=parent_agent_id,json=parentAgentId,proto3" json:"parent_agent_id,omitempty"`
-	CommissionRate string                 `protobuf:"bytes,12,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"` // NUMERIC as string (e.g. "0.0025")
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountNumber       string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	OwnerType           OwnerType              `protobuf:"varint,3,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType" json:"owner_type,omitempty"`
+	OwnerId             string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Currency            string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Purpose             AccountPurpose         `protobuf:"varint,6,opt,name=purpose,proto3,enum=accounting.v1.AccountPurpose" json:"purpose,omitempty"`
+	AccountType         AccountType            `protobuf:"varint,7,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
+	IsActive            bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsLocked            bool                   `protobuf:"varint,9,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
+	OverdraftLimit      string                 `protobuf:"bytes,10,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"` // NUMERIC as string
+	ParentAgentId       int64                  `protobuf:"varint,11,opt,name=parent_agent_id,json=parentAgentId,proto3" json:"parent_agent_id,omitempty"`
+	CommissionRate      string                 `protobuf:"bytes,12,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"` // NUMERIC as string (e.g. "0.0025")
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ParentAccountNumber *string                `protobuf:"bytes,15,opt,name=parent_account_number,json=parentAccountNumber,proto3,oneof" json:"parent_account_number,omitempty"` // Set on sub-accounts
+	Name                *string                `protobuf:"bytes,16,opt,name=name,proto3,oneof" json:"name,omitempty"`                                                            // Sub-account label
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Account) Reset() {
@@ -712,11 +1149,11 @@ func (x *Account) GetIsLocked() bool {
 	return false
 }
 
-func (x *Account) GetOverdraftLimit() float64 {
+func (x *Account) GetOverdraftLimit() string {
 	if x != nil {
 		return x.OverdraftLimit
 	}
-	return 0
+	return ""
 }
 
 func (x *Account) GetParentAgentId() int64 {
@@ -747,14 +1184,28 @@ func (x *Account) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Account) GetParentAccountNumber() string {
+	if x != nil && x.ParentAccountNumber != nil {
+		return *x.ParentAccountNumber
+	}
+	return ""
+}
+
+func (x *Account) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
 type Balance struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	AccountId         int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	AccountNumber     string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	Balance           float64                `protobuf:"fixed64,3,opt,name=balance,proto3" json:"balance,omitempty"` // Atomic units (e.g., cents)
-	AvailableBalance  float64                `protobuf:"fixed64,4,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
-	PendingDebit      float64                `protobuf:"fixed64,5,opt,name=pending_debit,json=pendingDebit,proto3" json:"pending_debit,omitempty"`
-	PendingCredit     float64                `protobuf:"fixed64,6,opt,name=pending_credit,json=pendingCredit,proto3" json:"pending_credit,omitempty"`
+	Balance           string                 `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`                                           // NUMERIC as string
+	AvailableBalance  string                 `protobuf:"bytes,4,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"` // NUMERIC as string
+	PendingDebit      string                 `protobuf:"bytes,5,opt,name=pending_debit,json=pendingDebit,proto3" json:"pending_debit,omitempty"`             // NUMERIC as string
+	PendingCredit     string                 `protobuf:"bytes,6,opt,name=pending_credit,json=pendingCredit,proto3" json:"pending_credit,omitempty"`          // NUMERIC as string
 	Currency          string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
 	Version           int64                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
 	LastTransactionAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_transaction_at,json=lastTransactionAt,proto3" json:"last_transaction_at,omitempty"`
@@ -806,32 +1257,32 @@ func (x *Balance) GetAccountNumber() string {
 	return ""
 }
 
-func (x *Balance) GetBalance() float64 {
+func (x *Balance) GetBalance() string {
 	if x != nil {
 		return x.Balance
 	}
-	return 0
+	return ""
 }
 
-func (x *Balance) GetAvailableBalance() float64 {
+func (x *Balance) GetAvailableBalance() string {
 	if x != nil {
 		return x.AvailableBalance
 	}
-	return 0
+	return ""
 }
 
-func (x *Balance) GetPendingDebit() float64 {
+func (x *Balance) GetPendingDebit() string {
 	if x != nil {
 		return x.PendingDebit
 	}
-	return 0
+	return ""
 }
 
-func (x *Balance) GetPendingCredit() float64 {
+func (x *Balance) GetPendingCredit() string {
 	if x != nil {
 		return x.PendingCredit
 	}
-	return 0
+	return ""
 }
 
 func (x *Balance) GetCurrency() string {
@@ -862,7 +1313,7 @@ type CreateAccountRequest struct {
 	Currency       string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
 	Purpose        AccountPurpose         `protobuf:"varint,4,opt,name=purpose,proto3,enum=accounting.v1.AccountPurpose" json:"purpose,omitempty"`
 	AccountType    AccountType            `protobuf:"varint,5,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
-	OverdraftLimit float64                `protobuf:"fixed64,6,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"`
+	OverdraftLimit string                 `protobuf:"bytes,6,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"` // NUMERIC as string
 	ParentAgentId  int64                  `protobuf:"varint,7,opt,name=parent_agent_id,json=parentAgentId,proto3" json:"parent_agent_id,omitempty"`
 	CommissionRate string                 `protobuf:"bytes,8,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"`
 	unknownFields  protoimpl.UnknownFields
@@ -934,11 +1385,11 @@ func (x *CreateAccountRequest) GetAccountType() AccountType {
 	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
 }
 
-func (x *CreateAccountRequest) GetOverdraftLimit() float64 {
+func (x *CreateAccountRequest) GetOverdraftLimit() string {
 	if x != nil {
 		return x.OverdraftLimit
 	}
-	return 0
+	return ""
 }
 
 func (x *CreateAccountRequest) GetParentAgentId() int64 {
@@ -1434,7 +1885,7 @@ type UpdateAccountRequest struct {
 	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	IsActive       *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
 	IsLocked       *bool                  `protobuf:"varint,3,opt,name=is_locked,json=isLocked,proto3,oneof" json:"is_locked,omitempty"`
-	OverdraftLimit *float64               `protobuf:"fixed64,4,opt,name=overdraft_limit,json=overdraftLimit,proto3,oneof" json:"overdraft_limit,omitempty"`
+	OverdraftLimit *string                `protobuf:"bytes,4,opt,name=overdraft_limit,json=overdraftLimit,proto3,oneof" json:"overdraft_limit,omitempty"` // NUMERIC as string
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1490,11 +1941,11 @@ func (x *UpdateAccountRequest) GetIsLocked() bool {
 	return false
 }
 
-func (x *UpdateAccountRequest) GetOverdraftLimit() float64 {
+func (x *UpdateAccountRequest) GetOverdraftLimit() string {
 	if x != nil && x.OverdraftLimit != nil {
 		return *x.OverdraftLimit
 	}
-	return 0
+	return ""
 }
 
 type UpdateAccountResponse struct {
@@ -1541,31 +1992,32 @@ func (x *UpdateAccountResponse) GetAccount() *Account {
 	return nil
 }
 
-type GetBalanceRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Identifier:
-	//
-	//	*GetBalanceRequest_AccountId
-	//	*GetBalanceRequest_AccountNumber
-	Identifier    isGetBalanceRequest_Identifier `protobuf_oneof:"identifier"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Sub-accounts take the parent's owner, currency and account type
+type CreateSubAccountRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ParentAccountNumber string                 `protobuf:"bytes,1,opt,name=parent_account_number,json=parentAccountNumber,proto3" json:"parent_account_number,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                          // Unique per parent, max 64 chars
+	Purpose             AccountPurpose         `protobuf:"varint,3,opt,name=purpose,proto3,enum=accounting.v1.AccountPurpose" json:"purpose,omitempty"`                 // Defaults to the parent's purpose
+	OwnerType           OwnerType              `protobuf:"varint,4,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType" json:"owner_type,omitempty"` // Caller; must own the parent
+	OwnerId             string                 `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetBalanceRequest) Reset() {
-	*x = GetBalanceRequest{}
+func (x *CreateSubAccountRequest) Reset() {
+	*x = CreateSubAccountRequest{}
 	mi := &file_proto_shared_accounting_account_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBalanceRequest) String() string {
+func (x *CreateSubAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBalanceRequest) ProtoMessage() {}
+func (*CreateSubAccountRequest) ProtoMessage() {}
 
-func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSubAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_shared_accounting_account_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1577,73 +2029,67 @@ func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
-func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSubAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubAccountRequest) Descriptor() ([]byte, []int) {
 	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetBalanceRequest) GetIdentifier() isGetBalanceRequest_Identifier {
+func (x *CreateSubAccountRequest) GetParentAccountNumber() string {
 	if x != nil {
-		return x.Identifier
+		return x.ParentAccountNumber
 	}
-	return nil
+	return ""
 }
 
-func (x *GetBalanceRequest) GetAccountId() int64 {
+func (x *CreateSubAccountRequest) GetName() string {
 	if x != nil {
-		if x, ok := x.Identifier.(*GetBalanceRequest_AccountId); ok {
-			return x.AccountId
-		}
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *GetBalanceRequest) GetAccountNumber() string {
+func (x *CreateSubAccountRequest) GetPurpose() AccountPurpose {
 	if x != nil {
-		if x, ok := x.Identifier.(*GetBalanceRequest_AccountNumber); ok {
-			return x.AccountNumber
-		}
+		return x.Purpose
 	}
-	return ""
-}
-
-type isGetBalanceRequest_Identifier interface {
-	isGetBalanceRequest_Identifier()
+	return AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED
 }
 
-type GetBalanceRequest_AccountId struct {
-	AccountId int64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3,oneof"`
+func (x *CreateSubAccountRequest) GetOwnerType() OwnerType {
+	if x != nil {
+		return x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
 }
 
-type GetBalanceRequest_AccountNumber struct {
-	AccountNumber string `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3,oneof"`
+func (x *CreateSubAccountRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
 }
 
-func (*GetBalanceRequest_AccountId) isGetBalanceRequest_Identifier() {}
-
-func (*GetBalanceRequest_AccountNumber) isGetBalanceRequest_Identifier() {}
-
-type GetBalanceResponse struct {
+type CreateSubAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Balance       *Balance               `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBalanceResponse) Reset() {
-	*x = GetBalanceResponse{}
+func (x *CreateSubAccountResponse) Reset() {
+	*x = CreateSubAccountResponse{}
 	mi := &file_proto_shared_accounting_account_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBalanceResponse) String() string {
+func (x *CreateSubAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBalanceResponse) ProtoMessage() {}
+func (*CreateSubAccountResponse) ProtoMessage() {}
 
-func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateSubAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_shared_accounting_account_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1655,43 +2101,42 @@ func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
-func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSubAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubAccountResponse) Descriptor() ([]byte, []int) {
 	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetBalanceResponse) GetBalance() *Balance {
+func (x *CreateSubAccountResponse) GetAccount() *Account {
 	if x != nil {
-		return x.Balance
+		return x.Account
 	}
 	return nil
 }
 
-type LedgerEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"` // Atomic units
-	DrCr          DrCr                   `protobuf:"varint,3,opt,name=dr_cr,json=drCr,proto3,enum=accounting.v1.DrCr" json:"dr_cr,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListSubAccountsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ParentAccountNumber string                 `protobuf:"bytes,1,opt,name=parent_account_number,json=parentAccountNumber,proto3" json:"parent_account_number,omitempty"`
+	OwnerType           OwnerType              `protobuf:"varint,2,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType" json:"owner_type,omitempty"` // Caller; must own the parent
+	OwnerId             string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	IncludeInactive     bool                   `protobuf:"varint,4,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *LedgerEntry) Reset() {
-	*x = LedgerEntry{}
+func (x *ListSubAccountsRequest) Reset() {
+	*x = ListSubAccountsRequest{}
 	mi := &file_proto_shared_accounting_account_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LedgerEntry) String() string {
+func (x *ListSubAccountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LedgerEntry) ProtoMessage() {}
+func (*ListSubAccountsRequest) ProtoMessage() {}
 
-func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+func (x *ListSubAccountsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_shared_accounting_account_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1703,77 +2148,62 @@ func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
-func (*LedgerEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSubAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubAccountsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *LedgerEntry) GetAccountNumber() string {
+func (x *ListSubAccountsRequest) GetParentAccountNumber() string {
 	if x != nil {
-		return x.AccountNumber
+		return x.ParentAccountNumber
 	}
 	return ""
 }
 
-func (x *LedgerEntry) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *LedgerEntry) GetDrCr() DrCr {
+func (x *ListSubAccountsRequest) GetOwnerType() OwnerType {
 	if x != nil {
-		return x.DrCr
+		return x.OwnerType
 	}
-	return DrCr_DR_CR_UNSPECIFIED
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
 }
 
-func (x *LedgerEntry) GetCurrency() string {
+func (x *ListSubAccountsRequest) GetOwnerId() string {
 	if x != nil {
-		return x.Currency
+		return x.OwnerId
 	}
 	return ""
 }
 
-func (x *LedgerEntry) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *ListSubAccountsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
 	}
-	return ""
+	return false
 }
 
-type ExecuteTransactionRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	IdempotencyKey      *string                `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
-	TransactionType     TransactionType        `protobuf:"varint,2,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
-	AccountType         AccountType            `protobuf:"varint,3,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
-	Entries             []*LedgerEntry         `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
-	ExternalRef         *string                `protobuf:"bytes,5,opt,name=external_ref,json=externalRef,proto3,oneof" json:"external_ref,omitempty"`
-	Description         *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	CreatedByExternalId string                 `protobuf:"bytes,7,opt,name=created_by_external_id,json=createdByExternalId,proto3" json:"created_by_external_id,omitempty"`
-	CreatedByType       OwnerType              `protobuf:"varint,8,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
-	IpAddress           *string                `protobuf:"bytes,9,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address,omitempty"`
-	UserAgent           *string                `protobuf:"bytes,10,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent,omitempty"`
-	GenerateReceipt     bool                   `protobuf:"varint,11,opt,name=generate_receipt,json=generateReceipt,proto3" json:"generate_receipt,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type SubAccount struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Account          *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Balance          string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`                                           // NUMERIC as string
+	AvailableBalance string                 `protobuf:"bytes,3,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"` // NUMERIC as string
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ExecuteTransactionRequest) Reset() {
-	*x = ExecuteTransactionRequest{}
+func (x *SubAccount) Reset() {
+	*x = SubAccount{}
 	mi := &file_proto_shared_accounting_account_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecuteTransactionRequest) String() string {
+func (x *SubAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteTransactionRequest) ProtoMessage() {}
+func (*SubAccount) ProtoMessage() {}
 
-func (x *ExecuteTransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *SubAccount) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_shared_accounting_account_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1785,117 +2215,126 @@ func (x *ExecuteTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteTransactionRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteTransactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubAccount.ProtoReflect.Descriptor instead.
+func (*SubAccount) Descriptor() ([]byte, []int) {
 	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ExecuteTransactionRequest) GetIdempotencyKey() string {
-	if x != nil && x.IdempotencyKey != nil {
-		return *x.IdempotencyKey
+func (x *SubAccount) GetAccount() *Account {
+	if x != nil {
+		return x.Account
 	}
-	return ""
+	return nil
 }
 
-func (x *ExecuteTransactionRequest) GetTransactionType() TransactionType {
+func (x *SubAccount) GetBalance() string {
 	if x != nil {
-		return x.TransactionType
+		return x.Balance
 	}
-	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *ExecuteTransactionRequest) GetAccountType() AccountType {
+func (x *SubAccount) GetAvailableBalance() string {
 	if x != nil {
-		return x.AccountType
+		return x.AvailableBalance
 	}
-	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *ExecuteTransactionRequest) GetEntries() []*LedgerEntry {
-	if x != nil {
-		return x.Entries
-	}
-	return nil
+type ListSubAccountsResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Parent                   *SubAccount            `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	SubAccounts              []*SubAccount          `protobuf:"bytes,2,rep,name=sub_accounts,json=subAccounts,proto3" json:"sub_accounts,omitempty"`
+	RolledUpBalance          string                 `protobuf:"bytes,3,opt,name=rolled_up_balance,json=rolledUpBalance,proto3" json:"rolled_up_balance,omitempty"` // Parent plus all sub-accounts
+	RolledUpAvailableBalance string                 `protobuf:"bytes,4,opt,name=rolled_up_available_balance,json=rolledUpAvailableBalance,proto3" json:"rolled_up_available_balance,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *ExecuteTransactionRequest) GetExternalRef() string {
-	if x != nil && x.ExternalRef != nil {
-		return *x.ExternalRef
-	}
-	return ""
+func (x *ListSubAccountsResponse) Reset() {
+	*x = ListSubAccountsResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecuteTransactionRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+func (x *ListSubAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ExecuteTransactionRequest) GetCreatedByExternalId() string {
+func (*ListSubAccountsResponse) ProtoMessage() {}
+
+func (x *ListSubAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[18]
 	if x != nil {
-		return x.CreatedByExternalId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ExecuteTransactionRequest) GetCreatedByType() OwnerType {
+// Deprecated: Use ListSubAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListSubAccountsResponse) GetParent() *SubAccount {
 	if x != nil {
-		return x.CreatedByType
+		return x.Parent
 	}
-	return OwnerType_OWNER_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *ExecuteTransactionRequest) GetIpAddress() string {
-	if x != nil && x.IpAddress != nil {
-		return *x.IpAddress
+func (x *ListSubAccountsResponse) GetSubAccounts() []*SubAccount {
+	if x != nil {
+		return x.SubAccounts
 	}
-	return ""
+	return nil
 }
 
-func (x *ExecuteTransactionRequest) GetUserAgent() string {
-	if x != nil && x.UserAgent != nil {
-		return *x.UserAgent
+func (x *ListSubAccountsResponse) GetRolledUpBalance() string {
+	if x != nil {
+		return x.RolledUpBalance
 	}
 	return ""
 }
 
-func (x *ExecuteTransactionRequest) GetGenerateReceipt() bool {
+func (x *ListSubAccountsResponse) GetRolledUpAvailableBalance() string {
 	if x != nil {
-		return x.GenerateReceipt
+		return x.RolledUpAvailableBalance
 	}
-	return false
+	return ""
 }
 
-type ExecuteTransactionResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ReceiptCode      string                 `protobuf:"bytes,1,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
-	TransactionId    int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	Status           TransactionStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=accounting.v1.TransactionStatus" json:"status,omitempty"`
-	Amount           float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency         string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
-	Fee              float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
-	ProcessingTimeMs int64                  `protobuf:"varint,7,opt,name=processing_time_ms,json=processingTimeMs,proto3" json:"processing_time_ms,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type GetBalanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*GetBalanceRequest_AccountId
+	//	*GetBalanceRequest_AccountNumber
+	Identifier    isGetBalanceRequest_Identifier `protobuf_oneof:"identifier"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecuteTransactionResponse) Reset() {
-	*x = ExecuteTransactionResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[18]
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecuteTransactionResponse) String() string {
+func (x *GetBalanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteTransactionResponse) ProtoMessage() {}
+func (*GetBalanceRequest) ProtoMessage() {}
 
-func (x *ExecuteTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[18]
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1906,100 +2345,204 @@ func (x *ExecuteTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteTransactionResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ExecuteTransactionResponse) GetReceiptCode() string {
+func (x *GetBalanceRequest) GetIdentifier() isGetBalanceRequest_Identifier {
 	if x != nil {
-		return x.ReceiptCode
+		return x.Identifier
 	}
-	return ""
+	return nil
 }
 
-func (x *ExecuteTransactionResponse) GetTransactionId() int64 {
+func (x *GetBalanceRequest) GetAccountId() int64 {
 	if x != nil {
-		return x.TransactionId
+		if x, ok := x.Identifier.(*GetBalanceRequest_AccountId); ok {
+			return x.AccountId
+		}
 	}
 	return 0
 }
 
-func (x *ExecuteTransactionResponse) GetStatus() TransactionStatus {
+func (x *GetBalanceRequest) GetAccountNumber() string {
 	if x != nil {
-		return x.Status
-	}
-	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+		if x, ok := x.Identifier.(*GetBalanceRequest_AccountNumber); ok {
+			return x.AccountNumber
+		}
+	}
+	return ""
+}
+
+type isGetBalanceRequest_Identifier interface {
+	isGetBalanceRequest_Identifier()
+}
+
+type GetBalanceRequest_AccountId struct {
+	AccountId int64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3,oneof"`
+}
+
+type GetBalanceRequest_AccountNumber struct {
+	AccountNumber string `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3,oneof"`
+}
+
+func (*GetBalanceRequest_AccountId) isGetBalanceRequest_Identifier() {}
+
+func (*GetBalanceRequest_AccountNumber) isGetBalanceRequest_Identifier() {}
+
+type GetBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balance       *Balance               `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceResponse) Reset() {
+	*x = GetBalanceResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ExecuteTransactionResponse) GetAmount() float64 {
+func (*GetBalanceResponse) ProtoMessage() {}
+
+func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[20]
 	if x != nil {
-		return x.Amount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ExecuteTransactionResponse) GetCurrency() string {
+// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetBalanceResponse) GetBalance() *Balance {
 	if x != nil {
-		return x.Currency
+		return x.Balance
+	}
+	return nil
+}
+
+type LedgerEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
+	DrCr          DrCr                   `protobuf:"varint,3,opt,name=dr_cr,json=drCr,proto3,enum=accounting.v1.DrCr" json:"dr_cr,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LedgerEntry) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
 	}
 	return ""
 }
 
-func (x *ExecuteTransactionResponse) GetFee() float64 {
+func (x *LedgerEntry) GetAmount() string {
 	if x != nil {
-		return x.Fee
+		return x.Amount
 	}
-	return 0
+	return ""
 }
 
-func (x *ExecuteTransactionResponse) GetProcessingTimeMs() int64 {
+func (x *LedgerEntry) GetDrCr() DrCr {
 	if x != nil {
-		return x.ProcessingTimeMs
+		return x.DrCr
 	}
-	return 0
+	return DrCr_DR_CR_UNSPECIFIED
 }
 
-func (x *ExecuteTransactionResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *LedgerEntry) GetCurrency() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-type ExecuteTransactionSyncRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Same as ExecuteTransactionRequest
-	IdempotencyKey      *string         `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
-	TransactionType     TransactionType `protobuf:"varint,2,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
-	AccountType         AccountType     `protobuf:"varint,3,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
-	Entries             []*LedgerEntry  `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
-	ExternalRef         *string         `protobuf:"bytes,5,opt,name=external_ref,json=externalRef,proto3,oneof" json:"external_ref,omitempty"`
-	Description         *string         `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	CreatedByExternalId string          `protobuf:"bytes,7,opt,name=created_by_external_id,json=createdByExternalId,proto3" json:"created_by_external_id,omitempty"`
-	CreatedByType       OwnerType       `protobuf:"varint,8,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
-	IpAddress           *string         `protobuf:"bytes,9,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address,omitempty"`
-	UserAgent           *string         `protobuf:"bytes,10,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent,omitempty"`
-	GenerateReceipt     bool            `protobuf:"varint,11,opt,name=generate_receipt,json=generateReceipt,proto3" json:"generate_receipt,omitempty"`
+func (x *LedgerEntry) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type ExecuteTransactionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	IdempotencyKey      *string                `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	TransactionType     TransactionType        `protobuf:"varint,2,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
+	AccountType         AccountType            `protobuf:"varint,3,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
+	Entries             []*LedgerEntry         `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+	ExternalRef         *string                `protobuf:"bytes,5,opt,name=external_ref,json=externalRef,proto3,oneof" json:"external_ref,omitempty"`
+	Description         *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CreatedByExternalId string                 `protobuf:"bytes,7,opt,name=created_by_external_id,json=createdByExternalId,proto3" json:"created_by_external_id,omitempty"`
+	CreatedByType       OwnerType              `protobuf:"varint,8,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
+	IpAddress           *string                `protobuf:"bytes,9,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address,omitempty"`
+	UserAgent           *string                `protobuf:"bytes,10,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent,omitempty"`
+	GenerateReceipt     bool                   `protobuf:"varint,11,opt,name=generate_receipt,json=generateReceipt,proto3" json:"generate_receipt,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ExecuteTransactionSyncRequest) Reset() {
-	*x = ExecuteTransactionSyncRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[19]
+func (x *ExecuteTransactionRequest) Reset() {
+	*x = ExecuteTransactionRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecuteTransactionSyncRequest) String() string {
+func (x *ExecuteTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteTransactionSyncRequest) ProtoMessage() {}
+func (*ExecuteTransactionRequest) ProtoMessage() {}
 
-func (x *ExecuteTransactionSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[19]
+func (x *ExecuteTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2010,118 +2553,117 @@ func (x *ExecuteTransactionSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteTransactionSyncRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteTransactionSyncRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ExecuteTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ExecuteTransactionSyncRequest) GetIdempotencyKey() string {
+func (x *ExecuteTransactionRequest) GetIdempotencyKey() string {
 	if x != nil && x.IdempotencyKey != nil {
 		return *x.IdempotencyKey
 	}
 	return ""
 }
 
-func (x *ExecuteTransactionSyncRequest) GetTransactionType() TransactionType {
+func (x *ExecuteTransactionRequest) GetTransactionType() TransactionType {
 	if x != nil {
 		return x.TransactionType
 	}
 	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
 }
 
-func (x *ExecuteTransactionSyncRequest) GetAccountType() AccountType {
+func (x *ExecuteTransactionRequest) GetAccountType() AccountType {
 	if x != nil {
 		return x.AccountType
 	}
 	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
 }
 
-func (x *ExecuteTransactionSyncRequest) GetEntries() []*LedgerEntry {
+func (x *ExecuteTransactionRequest) GetEntries() []*LedgerEntry {
 	if x != nil {
 		return x.Entries
 	}
 	return nil
 }
 
-func (x *ExecuteTransactionSyncRequest) GetExternalRef() string {
+func (x *ExecuteTransactionRequest) GetExternalRef() string {
 	if x != nil && x.ExternalRef != nil {
 		return *x.ExternalRef
 	}
 	return ""
 }
 
-func (x *ExecuteTransactionSyncRequest) GetDescription() string {
+func (x *ExecuteTransactionRequest) GetDescription() string {
 	if x != nil && x.Description != nil {
 		return *x.Description
 	}
 	return ""
 }
 
-func (x *ExecuteTransactionSyncRequest) GetCreatedByExternalId() string {
+func (x *ExecuteTransactionRequest) GetCreatedByExternalId() string {
 	if x != nil {
 		return x.CreatedByExternalId
 	}
 	return ""
 }
 
-func (x *ExecuteTransactionSyncRequest) GetCreatedByType() OwnerType {
+func (x *ExecuteTransactionRequest) GetCreatedByType() OwnerType {
 	if x != nil {
 		return x.CreatedByType
 	}
 	return OwnerType_OWNER_TYPE_UNSPECIFIED
 }
 
-func (x *ExecuteTransactionSyncRequest) GetIpAddress() string {
+func (x *ExecuteTransactionRequest) GetIpAddress() string {
 	if x != nil && x.IpAddress != nil {
 		return *x.IpAddress
 	}
 	return ""
 }
 
-func (x *ExecuteTransactionSyncRequest) GetUserAgent() string {
+func (x *ExecuteTransactionRequest) GetUserAgent() string {
 	if x != nil && x.UserAgent != nil {
 		return *x.UserAgent
 	}
 	return ""
 }
 
-func (x *ExecuteTransactionSyncRequest) GetGenerateReceipt() bool {
+func (x *ExecuteTransactionRequest) GetGenerateReceipt() bool {
 	if x != nil {
 		return x.GenerateReceipt
 	}
 	return false
 }
 
-type ExecuteTransactionSyncResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Same as ExecuteTransactionResponse but guaranteed completed
+type ExecuteTransactionResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
 	ReceiptCode      string                 `protobuf:"bytes,1,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
 	TransactionId    int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 	Status           TransactionStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=accounting.v1.TransactionStatus" json:"status,omitempty"`
-	Amount           float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount           string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
 	Currency         string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
-	Fee              float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	Fee              string                 `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"` // NUMERIC as string
 	ProcessingTimeMs int64                  `protobuf:"varint,7,opt,name=processing_time_ms,json=processingTimeMs,proto3" json:"processing_time_ms,omitempty"`
 	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ExecuteTransactionSyncResponse) Reset() {
-	*x = ExecuteTransactionSyncResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[20]
+func (x *ExecuteTransactionResponse) Reset() {
+	*x = ExecuteTransactionResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecuteTransactionSyncResponse) String() string {
+func (x *ExecuteTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteTransactionSyncResponse) ProtoMessage() {}
+func (*ExecuteTransactionResponse) ProtoMessage() {}
 
-func (x *ExecuteTransactionSyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[20]
+func (x *ExecuteTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2132,89 +2674,315 @@ func (x *ExecuteTransactionSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteTransactionSyncResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteTransactionSyncResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ExecuteTransactionResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ExecuteTransactionSyncResponse) GetReceiptCode() string {
+func (x *ExecuteTransactionResponse) GetReceiptCode() string {
 	if x != nil {
 		return x.ReceiptCode
 	}
 	return ""
 }
 
-func (x *ExecuteTransactionSyncResponse) GetTransactionId() int64 {
+func (x *ExecuteTransactionResponse) GetTransactionId() int64 {
 	if x != nil {
 		return x.TransactionId
 	}
 	return 0
 }
 
-func (x *ExecuteTransactionSyncResponse) GetStatus() TransactionStatus {
+func (x *ExecuteTransactionResponse) GetStatus() TransactionStatus {
 	if x != nil {
 		return x.Status
 	}
 	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
 }
 
-func (x *ExecuteTransactionSyncResponse) GetAmount() float64 {
+func (x *ExecuteTransactionResponse) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return ""
 }
 
-func (x *ExecuteTransactionSyncResponse) GetCurrency() string {
+func (x *ExecuteTransactionResponse) GetCurrency() string {
 	if x != nil {
 		return x.Currency
 	}
 	return ""
 }
 
-func (x *ExecuteTransactionSyncResponse) GetFee() float64 {
+func (x *ExecuteTransactionResponse) GetFee() string {
 	if x != nil {
 		return x.Fee
 	}
-	return 0
+	return ""
 }
 
-func (x *ExecuteTransactionSyncResponse) GetProcessingTimeMs() int64 {
+func (x *ExecuteTransactionResponse) GetProcessingTimeMs() int64 {
 	if x != nil {
 		return x.ProcessingTimeMs
 	}
 	return 0
 }
 
-func (x *ExecuteTransactionSyncResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ExecuteTransactionResponse) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-type GetTransactionStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReceiptCode   string                 `protobuf:"bytes,1,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetTransactionStatusRequest) Reset() {
-	*x = GetTransactionStatusRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetTransactionStatusRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type ExecuteTransactionSyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Same as ExecuteTransactionRequest
+	IdempotencyKey      *string         `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	TransactionType     TransactionType `protobuf:"varint,2,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
+	AccountType         AccountType     `protobuf:"varint,3,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
+	Entries             []*LedgerEntry  `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+	ExternalRef         *string         `protobuf:"bytes,5,opt,name=external_ref,json=externalRef,proto3,oneof" json:"external_ref,omitempty"`
+	Description         *string         `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CreatedByExternalId string          `protobuf:"bytes,7,opt,name=created_by_external_id,json=createdByExternalId,proto3" json:"created_by_external_id,omitempty"`
+	CreatedByType       OwnerType       `protobuf:"varint,8,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
+	IpAddress           *string         `protobuf:"bytes,9,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address,omitempty"`
+	UserAgent           *string         `protobuf:"bytes,10,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent,omitempty"`
+	GenerateReceipt     bool            `protobuf:"varint,11,opt,name=generate_receipt,json=generateReceipt,proto3" json:"generate_receipt,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ExecuteTransactionSyncRequest) Reset() {
+	*x = ExecuteTransactionSyncRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteTransactionSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteTransactionSyncRequest) ProtoMessage() {}
+
+func (x *ExecuteTransactionSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteTransactionSyncRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteTransactionSyncRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExecuteTransactionSyncRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *ExecuteTransactionSyncRequest) GetTransactionType() TransactionType {
+	if x != nil {
+		return x.TransactionType
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ExecuteTransactionSyncRequest) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *ExecuteTransactionSyncRequest) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ExecuteTransactionSyncRequest) GetExternalRef() string {
+	if x != nil && x.ExternalRef != nil {
+		return *x.ExternalRef
+	}
+	return ""
+}
+
+func (x *ExecuteTransactionSyncRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ExecuteTransactionSyncRequest) GetCreatedByExternalId() string {
+	if x != nil {
+		return x.CreatedByExternalId
+	}
+	return ""
+}
+
+func (x *ExecuteTransactionSyncRequest) GetCreatedByType() OwnerType {
+	if x != nil {
+		return x.CreatedByType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *ExecuteTransactionSyncRequest) GetIpAddress() string {
+	if x != nil && x.IpAddress != nil {
+		return *x.IpAddress
+	}
+	return ""
+}
+
+func (x *ExecuteTransactionSyncRequest) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
+	}
+	return ""
+}
+
+func (x *ExecuteTransactionSyncRequest) GetGenerateReceipt() bool {
+	if x != nil {
+		return x.GenerateReceipt
+	}
+	return false
+}
+
+type ExecuteTransactionSyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Same as ExecuteTransactionResponse but guaranteed completed
+	ReceiptCode      string                 `protobuf:"bytes,1,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
+	TransactionId    int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Status           TransactionStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=accounting.v1.TransactionStatus" json:"status,omitempty"`
+	Amount           string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
+	Currency         string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Fee              string                 `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"` // NUMERIC as string
+	ProcessingTimeMs int64                  `protobuf:"varint,7,opt,name=processing_time_ms,json=processingTimeMs,proto3" json:"processing_time_ms,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExecuteTransactionSyncResponse) Reset() {
+	*x = ExecuteTransactionSyncResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteTransactionSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteTransactionSyncResponse) ProtoMessage() {}
+
+func (x *ExecuteTransactionSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteTransactionSyncResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteTransactionSyncResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExecuteTransactionSyncResponse) GetReceiptCode() string {
+	if x != nil {
+		return x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *ExecuteTransactionSyncResponse) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *ExecuteTransactionSyncResponse) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+}
+
+func (x *ExecuteTransactionSyncResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ExecuteTransactionSyncResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ExecuteTransactionSyncResponse) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *ExecuteTransactionSyncResponse) GetProcessingTimeMs() int64 {
+	if x != nil {
+		return x.ProcessingTimeMs
+	}
+	return 0
+}
+
+func (x *ExecuteTransactionSyncResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetTransactionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceiptCode   string                 `protobuf:"bytes,1,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionStatusRequest) Reset() {
+	*x = GetTransactionStatusRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*GetTransactionStatusRequest) ProtoMessage() {}
 
 func (x *GetTransactionStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[21]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2227,7 +2995,7 @@ func (x *GetTransactionStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetTransactionStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{21}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetTransactionStatusRequest) GetReceiptCode() string {
@@ -2250,7 +3018,7 @@ type GetTransactionStatusResponse struct {
 
 func (x *GetTransactionStatusResponse) Reset() {
 	*x = GetTransactionStatusResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[22]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2262,7 +3030,7 @@ func (x *GetTransactionStatusResponse) String() string {
 func (*GetTransactionStatusResponse) ProtoMessage() {}
 
 func (x *GetTransactionStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[22]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2275,7 +3043,7 @@ func (x *GetTransactionStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetTransactionStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{22}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetTransactionStatusResponse) GetReceiptCode() string {
@@ -2322,7 +3090,7 @@ type GetTransactionByReceiptRequest struct {
 
 func (x *GetTransactionByReceiptRequest) Reset() {
 	*x = GetTransactionByReceiptRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[23]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2334,7 +3102,7 @@ func (x *GetTransactionByReceiptRequest) String() string {
 func (*GetTransactionByReceiptRequest) ProtoMessage() {}
 
 func (x *GetTransactionByReceiptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[23]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2347,7 +3115,7 @@ func (x *GetTransactionByReceiptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionByReceiptRequest.ProtoReflect.Descriptor instead.
 func (*GetTransactionByReceiptRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{23}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetTransactionByReceiptRequest) GetReceiptCode() string {
@@ -2368,7 +3136,7 @@ type GetTransactionByReceiptResponse struct {
 
 func (x *GetTransactionByReceiptResponse) Reset() {
 	*x = GetTransactionByReceiptResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[24]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2380,7 +3148,7 @@ func (x *GetTransactionByReceiptResponse) String() string {
 func (*GetTransactionByReceiptResponse) ProtoMessage() {}
 
 func (x *GetTransactionByReceiptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[24]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2393,7 +3161,7 @@ func (x *GetTransactionByReceiptResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionByReceiptResponse.ProtoReflect.Descriptor instead.
 func (*GetTransactionByReceiptResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{24}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetTransactionByReceiptResponse) GetJournal() *Journal {
@@ -2436,7 +3204,7 @@ type Journal struct {
 
 func (x *Journal) Reset() {
 	*x = Journal{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[25]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2448,7 +3216,7 @@ func (x *Journal) String() string {
 func (*Journal) ProtoMessage() {}
 
 func (x *Journal) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[25]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2461,7 +3229,7 @@ func (x *Journal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Journal.ProtoReflect.Descriptor instead.
 func (*Journal) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{25}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *Journal) GetId() int64 {
@@ -2547,10 +3315,10 @@ type Ledger struct {
 	JournalId     int64                  `protobuf:"varint,2,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
 	AccountId     int64                  `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	AccountNumber string                 `protobuf:"bytes,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
 	DrCr          DrCr                   `protobuf:"varint,6,opt,name=dr_cr,json=drCr,proto3,enum=accounting.v1.DrCr" json:"dr_cr,omitempty"`
 	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
-	BalanceAfter  float64                `protobuf:"fixed64,8,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	BalanceAfter  string                 `protobuf:"bytes,8,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"` // NUMERIC as string
 	Description   *string                `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	ReceiptCode   *string                `protobuf:"bytes,10,opt,name=receipt_code,json=receiptCode,proto3,oneof" json:"receipt_code,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -2560,7 +3328,7 @@ type Ledger struct {
 
 func (x *Ledger) Reset() {
 	*x = Ledger{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[26]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2572,7 +3340,7 @@ func (x *Ledger) String() string {
 func (*Ledger) ProtoMessage() {}
 
 func (x *Ledger) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[26]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2585,7 +3353,7 @@ func (x *Ledger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ledger.ProtoReflect.Descriptor instead.
 func (*Ledger) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{26}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Ledger) GetId() int64 {
@@ -2616,11 +3384,11 @@ func (x *Ledger) GetAccountNumber() string {
 	return ""
 }
 
-func (x *Ledger) GetAmount() float64 {
+func (x *Ledger) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return ""
 }
 
 func (x *Ledger) GetDrCr() DrCr {
@@ -2637,11 +3405,11 @@ func (x *Ledger) GetCurrency() string {
 	return ""
 }
 
-func (x *Ledger) GetBalanceAfter() float64 {
+func (x *Ledger) GetBalanceAfter() string {
 	if x != nil {
 		return x.BalanceAfter
 	}
-	return 0
+	return ""
 }
 
 func (x *Ledger) GetDescription() string {
@@ -2674,7 +3442,7 @@ type GetJournalRequest struct {
 
 func (x *GetJournalRequest) Reset() {
 	*x = GetJournalRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[27]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2686,7 +3454,7 @@ func (x *GetJournalRequest) String() string {
 func (*GetJournalRequest) ProtoMessage() {}
 
 func (x *GetJournalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[27]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2699,7 +3467,7 @@ func (x *GetJournalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJournalRequest.ProtoReflect.Descriptor instead.
 func (*GetJournalRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{27}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetJournalRequest) GetId() int64 {
@@ -2718,7 +3486,7 @@ type GetJournalResponse struct {
 
 func (x *GetJournalResponse) Reset() {
 	*x = GetJournalResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[28]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2730,7 +3498,7 @@ func (x *GetJournalResponse) String() string {
 func (*GetJournalResponse) ProtoMessage() {}
 
 func (x *GetJournalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[28]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2743,7 +3511,7 @@ func (x *GetJournalResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJournalResponse.ProtoReflect.Descriptor instead.
 func (*GetJournalResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{28}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetJournalResponse) GetJournal() *Journal {
@@ -2769,7 +3537,7 @@ type ListJournalsRequest struct {
 
 func (x *ListJournalsRequest) Reset() {
 	*x = ListJournalsRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[29]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2781,7 +3549,7 @@ func (x *ListJournalsRequest) String() string {
 func (*ListJournalsRequest) ProtoMessage() {}
 
 func (x *ListJournalsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[29]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2794,7 +3562,7 @@ func (x *ListJournalsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJournalsRequest.ProtoReflect.Descriptor instead.
 func (*ListJournalsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{29}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListJournalsRequest) GetTransactionType() TransactionType {
@@ -2863,7 +3631,7 @@ type ListJournalsResponse struct {
 
 func (x *ListJournalsResponse) Reset() {
 	*x = ListJournalsResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[30]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2875,7 +3643,7 @@ func (x *ListJournalsResponse) String() string {
 func (*ListJournalsResponse) ProtoMessage() {}
 
 func (x *ListJournalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[30]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2888,7 +3656,7 @@ func (x *ListJournalsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJournalsResponse.ProtoReflect.Descriptor instead.
 func (*ListJournalsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{30}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListJournalsResponse) GetJournals() []*Journal {
@@ -2914,7 +3682,7 @@ type ListLedgersByJournalRequest struct {
 
 func (x *ListLedgersByJournalRequest) Reset() {
 	*x = ListLedgersByJournalRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[31]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2926,7 +3694,7 @@ func (x *ListLedgersByJournalRequest) String() string {
 func (*ListLedgersByJournalRequest) ProtoMessage() {}
 
 func (x *ListLedgersByJournalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[31]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2939,7 +3707,7 @@ func (x *ListLedgersByJournalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLedgersByJournalRequest.ProtoReflect.Descriptor instead.
 func (*ListLedgersByJournalRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{31}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ListLedgersByJournalRequest) GetJournalId() int64 {
@@ -2958,7 +3726,7 @@ type ListLedgersByJournalResponse struct {
 
 func (x *ListLedgersByJournalResponse) Reset() {
 	*x = ListLedgersByJournalResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[32]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2970,7 +3738,7 @@ func (x *ListLedgersByJournalResponse) String() string {
 func (*ListLedgersByJournalResponse) ProtoMessage() {}
 
 func (x *ListLedgersByJournalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[32]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2983,7 +3751,7 @@ func (x *ListLedgersByJournalResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLedgersByJournalResponse.ProtoReflect.Descriptor instead.
 func (*ListLedgersByJournalResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{32}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ListLedgersByJournalResponse) GetLedgers() []*Ledger {
@@ -3007,7 +3775,7 @@ type ListLedgersByAccountRequest struct {
 
 func (x *ListLedgersByAccountRequest) Reset() {
 	*x = ListLedgersByAccountRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[33]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3019,7 +3787,7 @@ func (x *ListLedgersByAccountRequest) String() string {
 func (*ListLedgersByAccountRequest) ProtoMessage() {}
 
 func (x *ListLedgersByAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[33]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3032,7 +3800,7 @@ func (x *ListLedgersByAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLedgersByAccountRequest.ProtoReflect.Descriptor instead.
 func (*ListLedgersByAccountRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{33}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ListLedgersByAccountRequest) GetAccountNumber() string {
@@ -3087,7 +3855,7 @@ type ListLedgersByAccountResponse struct {
 
 func (x *ListLedgersByAccountResponse) Reset() {
 	*x = ListLedgersByAccountResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[34]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3099,7 +3867,7 @@ func (x *ListLedgersByAccountResponse) String() string {
 func (*ListLedgersByAccountResponse) ProtoMessage() {}
 
 func (x *ListLedgersByAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[34]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3112,7 +3880,7 @@ func (x *ListLedgersByAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLedgersByAccountResponse.ProtoReflect.Descriptor instead.
 func (*ListLedgersByAccountResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{34}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ListLedgersByAccountResponse) GetLedgers() []*Ledger {
@@ -3134,20 +3902,21 @@ type AccountStatement struct {
 	AccountNumber  string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
 	AccountType    AccountType            `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
 	Ledgers        []*Ledger              `protobuf:"bytes,3,rep,name=ledgers,proto3" json:"ledgers,omitempty"`
-	OpeningBalance float64                `protobuf:"fixed64,4,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
-	ClosingBalance float64                `protobuf:"fixed64,5,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"`
-	TotalDebits    float64                `protobuf:"fixed64,6,opt,name=total_debits,json=totalDebits,proto3" json:"total_debits,omitempty"`
-	TotalCredits   float64                `protobuf:"fixed64,7,opt,name=total_credits,json=totalCredits,proto3" json:"total_credits,omitempty"`
+	OpeningBalance string                 `protobuf:"bytes,4,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"` // NUMERIC as string
+	ClosingBalance string                 `protobuf:"bytes,5,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"` // NUMERIC as string
+	TotalDebits    string                 `protobuf:"bytes,6,opt,name=total_debits,json=totalDebits,proto3" json:"total_debits,omitempty"`          // NUMERIC as string
+	TotalCredits   string                 `protobuf:"bytes,7,opt,name=total_credits,json=totalCredits,proto3" json:"total_credits,omitempty"`       // NUMERIC as string
 	PeriodStart    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
 	PeriodEnd      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
 	Currency       string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	Interest       *InterestSummary       `protobuf:"bytes,11,opt,name=interest,proto3,oneof" json:"interest,omitempty"` // Set for accounts that have accrued interest
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *AccountStatement) Reset() {
 	*x = AccountStatement{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[35]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3159,7 +3928,7 @@ func (x *AccountStatement) String() string {
 func (*AccountStatement) ProtoMessage() {}
 
 func (x *AccountStatement) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[35]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3172,7 +3941,7 @@ func (x *AccountStatement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccountStatement.ProtoReflect.Descriptor instead.
 func (*AccountStatement) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{35}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *AccountStatement) GetAccountNumber() string {
@@ -3196,32 +3965,32 @@ func (x *AccountStatement) GetLedgers() []*Ledger {
 	return nil
 }
 
-func (x *AccountStatement) GetOpeningBalance() float64 {
+func (x *AccountStatement) GetOpeningBalance() string {
 	if x != nil {
 		return x.OpeningBalance
 	}
-	return 0
+	return ""
 }
 
-func (x *AccountStatement) GetClosingBalance() float64 {
+func (x *AccountStatement) GetClosingBalance() string {
 	if x != nil {
 		return x.ClosingBalance
 	}
-	return 0
+	return ""
 }
 
-func (x *AccountStatement) GetTotalDebits() float64 {
+func (x *AccountStatement) GetTotalDebits() string {
 	if x != nil {
 		return x.TotalDebits
 	}
-	return 0
+	return ""
 }
 
-func (x *AccountStatement) GetTotalCredits() float64 {
+func (x *AccountStatement) GetTotalCredits() string {
 	if x != nil {
 		return x.TotalCredits
 	}
-	return 0
+	return ""
 }
 
 func (x *AccountStatement) GetPeriodStart() *timestamppb.Timestamp {
@@ -3245,6 +4014,13 @@ func (x *AccountStatement) GetCurrency() string {
 	return ""
 }
 
+func (x *AccountStatement) GetInterest() *InterestSummary {
+	if x != nil {
+		return x.Interest
+	}
+	return nil
+}
+
 type GetAccountStatementRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
@@ -3257,7 +4033,7 @@ type GetAccountStatementRequest struct {
 
 func (x *GetAccountStatementRequest) Reset() {
 	*x = GetAccountStatementRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[36]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3269,7 +4045,7 @@ func (x *GetAccountStatementRequest) String() string {
 func (*GetAccountStatementRequest) ProtoMessage() {}
 
 func (x *GetAccountStatementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[36]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3282,7 +4058,7 @@ func (x *GetAccountStatementRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccountStatementRequest.ProtoReflect.Descriptor instead.
 func (*GetAccountStatementRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{36}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetAccountStatementRequest) GetAccountNumber() string {
@@ -3322,7 +4098,7 @@ type GetAccountStatementResponse struct {
 
 func (x *GetAccountStatementResponse) Reset() {
 	*x = GetAccountStatementResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[37]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3334,7 +4110,7 @@ func (x *GetAccountStatementResponse) String() string {
 func (*GetAccountStatementResponse) ProtoMessage() {}
 
 func (x *GetAccountStatementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[37]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3347,7 +4123,7 @@ func (x *GetAccountStatementResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccountStatementResponse.ProtoReflect.Descriptor instead.
 func (*GetAccountStatementResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{37}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetAccountStatementResponse) GetStatement() *AccountStatement {
@@ -3357,6 +4133,143 @@ func (x *GetAccountStatementResponse) GetStatement() *AccountStatement {
 	return nil
 }
 
+type ExportAccountStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountType   AccountType            `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Format        StatementFormat        `protobuf:"varint,5,opt,name=format,proto3,enum=accounting.v1.StatementFormat" json:"format,omitempty"` // Defaults to CSV
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAccountStatementRequest) Reset() {
+	*x = ExportAccountStatementRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAccountStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountStatementRequest) ProtoMessage() {}
+
+func (x *ExportAccountStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountStatementRequest.ProtoReflect.Descriptor instead.
+func (*ExportAccountStatementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExportAccountStatementRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *ExportAccountStatementRequest) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *ExportAccountStatementRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportAccountStatementRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ExportAccountStatementRequest) GetFormat() StatementFormat {
+	if x != nil {
+		return x.Format
+	}
+	return StatementFormat_STATEMENT_FORMAT_UNSPECIFIED
+}
+
+type ExportAccountStatementChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Data  []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Set on the first chunk only
+	ContentType   *string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3,oneof" json:"content_type,omitempty"`
+	Filename      *string `protobuf:"bytes,3,opt,name=filename,proto3,oneof" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAccountStatementChunk) Reset() {
+	*x = ExportAccountStatementChunk{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAccountStatementChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountStatementChunk) ProtoMessage() {}
+
+func (x *ExportAccountStatementChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountStatementChunk.ProtoReflect.Descriptor instead.
+func (*ExportAccountStatementChunk) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExportAccountStatementChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportAccountStatementChunk) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportAccountStatementChunk) GetFilename() string {
+	if x != nil && x.Filename != nil {
+		return *x.Filename
+	}
+	return ""
+}
+
 type GetOwnerStatementRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OwnerType     OwnerType              `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType" json:"owner_type,omitempty"`
@@ -3370,7 +4283,7 @@ type GetOwnerStatementRequest struct {
 
 func (x *GetOwnerStatementRequest) Reset() {
 	*x = GetOwnerStatementRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[38]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3382,7 +4295,7 @@ func (x *GetOwnerStatementRequest) String() string {
 func (*GetOwnerStatementRequest) ProtoMessage() {}
 
 func (x *GetOwnerStatementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[38]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3395,7 +4308,7 @@ func (x *GetOwnerStatementRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOwnerStatementRequest.ProtoReflect.Descriptor instead.
 func (*GetOwnerStatementRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{38}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetOwnerStatementRequest) GetOwnerType() OwnerType {
@@ -3442,7 +4355,7 @@ type GetOwnerStatementResponse struct {
 
 func (x *GetOwnerStatementResponse) Reset() {
 	*x = GetOwnerStatementResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[39]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3454,7 +4367,7 @@ func (x *GetOwnerStatementResponse) String() string {
 func (*GetOwnerStatementResponse) ProtoMessage() {}
 
 func (x *GetOwnerStatementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[39]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3467,7 +4380,7 @@ func (x *GetOwnerStatementResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOwnerStatementResponse.ProtoReflect.Descriptor instead.
 func (*GetOwnerStatementResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{39}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetOwnerStatementResponse) GetStatements() []*AccountStatement {
@@ -3483,14 +4396,14 @@ type OwnerSummary struct {
 	OwnerId                   string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	AccountType               AccountType            `protobuf:"varint,3,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
 	AccountBalances           []*AccountBalance      `protobuf:"bytes,4,rep,name=account_balances,json=accountBalances,proto3" json:"account_balances,omitempty"`
-	TotalBalanceUsdEquivalent float64                `protobuf:"fixed64,5,opt,name=total_balance_usd_equivalent,json=totalBalanceUsdEquivalent,proto3" json:"total_balance_usd_equivalent,omitempty"`
+	TotalBalanceUsdEquivalent string                 `protobuf:"bytes,5,opt,name=total_balance_usd_equivalent,json=totalBalanceUsdEquivalent,proto3" json:"total_balance_usd_equivalent,omitempty"` // NUMERIC as string
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *OwnerSummary) Reset() {
 	*x = OwnerSummary{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[40]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3502,7 +4415,7 @@ func (x *OwnerSummary) String() string {
 func (*OwnerSummary) ProtoMessage() {}
 
 func (x *OwnerSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[40]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3515,7 +4428,7 @@ func (x *OwnerSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OwnerSummary.ProtoReflect.Descriptor instead.
 func (*OwnerSummary) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{40}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *OwnerSummary) GetOwnerType() OwnerType {
@@ -3546,27 +4459,32 @@ func (x *OwnerSummary) GetAccountBalances() []*AccountBalance {
 	return nil
 }
 
-func (x *OwnerSummary) GetTotalBalanceUsdEquivalent() float64 {
+func (x *OwnerSummary) GetTotalBalanceUsdEquivalent() string {
 	if x != nil {
 		return x.TotalBalanceUsdEquivalent
 	}
-	return 0
+	return ""
 }
 
 type AccountBalance struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AccountId        int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	AccountNumber    string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	Currency         string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
-	Balance          float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance,omitempty"`
-	AvailableBalance float64                `protobuf:"fixed64,5,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	AccountId                int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountNumber            string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Currency                 string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Balance                  string                 `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`                                                            // NUMERIC as string
+	AvailableBalance         string                 `protobuf:"bytes,5,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`                  // NUMERIC as string
+	ParentAccountNumber      *string                `protobuf:"bytes,6,opt,name=parent_account_number,json=parentAccountNumber,proto3,oneof" json:"parent_account_number,omitempty"` // Set on sub-accounts
+	Name                     *string                `protobuf:"bytes,7,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	SubAccountCount          int32                  `protobuf:"varint,8,opt,name=sub_account_count,json=subAccountCount,proto3" json:"sub_account_count,omitempty"`
+	RolledUpBalance          string                 `protobuf:"bytes,9,opt,name=rolled_up_balance,json=rolledUpBalance,proto3" json:"rolled_up_balance,omitempty"` // Own balance plus sub-accounts
+	RolledUpAvailableBalance string                 `protobuf:"bytes,10,opt,name=rolled_up_available_balance,json=rolledUpAvailableBalance,proto3" json:"rolled_up_available_balance,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *AccountBalance) Reset() {
 	*x = AccountBalance{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[41]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3578,7 +4496,7 @@ func (x *AccountBalance) String() string {
 func (*AccountBalance) ProtoMessage() {}
 
 func (x *AccountBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[41]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3591,7 +4509,7 @@ func (x *AccountBalance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccountBalance.ProtoReflect.Descriptor instead.
 func (*AccountBalance) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{41}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *AccountBalance) GetAccountId() int64 {
@@ -3615,44 +4533,79 @@ func (x *AccountBalance) GetCurrency() string {
 	return ""
 }
 
-func (x *AccountBalance) GetBalance() float64 {
+func (x *AccountBalance) GetBalance() string {
 	if x != nil {
 		return x.Balance
 	}
-	return 0
+	return ""
 }
 
-func (x *AccountBalance) GetAvailableBalance() float64 {
+func (x *AccountBalance) GetAvailableBalance() string {
 	if x != nil {
 		return x.AvailableBalance
 	}
-	return 0
+	return ""
 }
 
-type GetOwnerSummaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OwnerType     OwnerType              `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType" json:"owner_type,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	AccountType   AccountType            `protobuf:"varint,3,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AccountBalance) GetParentAccountNumber() string {
+	if x != nil && x.ParentAccountNumber != nil {
+		return *x.ParentAccountNumber
+	}
+	return ""
 }
 
-func (x *GetOwnerSummaryRequest) Reset() {
-	*x = GetOwnerSummaryRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[42]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AccountBalance) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
 }
 
-func (x *GetOwnerSummaryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+func (x *AccountBalance) GetSubAccountCount() int32 {
+	if x != nil {
+		return x.SubAccountCount
+	}
+	return 0
+}
+
+func (x *AccountBalance) GetRolledUpBalance() string {
+	if x != nil {
+		return x.RolledUpBalance
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetRolledUpAvailableBalance() string {
+	if x != nil {
+		return x.RolledUpAvailableBalance
+	}
+	return ""
+}
+
+type GetOwnerSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerType     OwnerType              `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType" json:"owner_type,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	AccountType   AccountType            `protobuf:"varint,3,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOwnerSummaryRequest) Reset() {
+	*x = GetOwnerSummaryRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOwnerSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
 func (*GetOwnerSummaryRequest) ProtoMessage() {}
 
 func (x *GetOwnerSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[42]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3665,7 +4618,7 @@ func (x *GetOwnerSummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOwnerSummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetOwnerSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{42}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetOwnerSummaryRequest) GetOwnerType() OwnerType {
@@ -3698,7 +4651,7 @@ type GetOwnerSummaryResponse struct {
 
 func (x *GetOwnerSummaryResponse) Reset() {
 	*x = GetOwnerSummaryResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[43]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3710,7 +4663,7 @@ func (x *GetOwnerSummaryResponse) String() string {
 func (*GetOwnerSummaryResponse) ProtoMessage() {}
 
 func (x *GetOwnerSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[43]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3723,7 +4676,7 @@ func (x *GetOwnerSummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOwnerSummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetOwnerSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{43}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetOwnerSummaryResponse) GetSummary() *OwnerSummary {
@@ -3739,10 +4692,10 @@ type DailyReport struct {
 	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	AccountId     int64                  `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	TotalDebit    float64                `protobuf:"fixed64,5,opt,name=total_debit,json=totalDebit,proto3" json:"total_debit,omitempty"`
-	TotalCredit   float64                `protobuf:"fixed64,6,opt,name=total_credit,json=totalCredit,proto3" json:"total_credit,omitempty"`
-	Balance       float64                `protobuf:"fixed64,7,opt,name=balance,proto3" json:"balance,omitempty"`
-	NetChange     float64                `protobuf:"fixed64,8,opt,name=net_change,json=netChange,proto3" json:"net_change,omitempty"`
+	TotalDebit    string                 `protobuf:"bytes,5,opt,name=total_debit,json=totalDebit,proto3" json:"total_debit,omitempty"`    // NUMERIC as string
+	TotalCredit   string                 `protobuf:"bytes,6,opt,name=total_credit,json=totalCredit,proto3" json:"total_credit,omitempty"` // NUMERIC as string
+	Balance       string                 `protobuf:"bytes,7,opt,name=balance,proto3" json:"balance,omitempty"`                            // NUMERIC as string
+	NetChange     string                 `protobuf:"bytes,8,opt,name=net_change,json=netChange,proto3" json:"net_change,omitempty"`       // NUMERIC as string
 	Date          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=date,proto3" json:"date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -3750,7 +4703,7 @@ type DailyReport struct {
 
 func (x *DailyReport) Reset() {
 	*x = DailyReport{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[44]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3762,7 +4715,7 @@ func (x *DailyReport) String() string {
 func (*DailyReport) ProtoMessage() {}
 
 func (x *DailyReport) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[44]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3775,7 +4728,7 @@ func (x *DailyReport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DailyReport.ProtoReflect.Descriptor instead.
 func (*DailyReport) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{44}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *DailyReport) GetOwnerType() OwnerType {
@@ -3806,32 +4759,32 @@ func (x *DailyReport) GetCurrency() string {
 	return ""
 }
 
-func (x *DailyReport) GetTotalDebit() float64 {
+func (x *DailyReport) GetTotalDebit() string {
 	if x != nil {
 		return x.TotalDebit
 	}
-	return 0
+	return ""
 }
 
-func (x *DailyReport) GetTotalCredit() float64 {
+func (x *DailyReport) GetTotalCredit() string {
 	if x != nil {
 		return x.TotalCredit
 	}
-	return 0
+	return ""
 }
 
-func (x *DailyReport) GetBalance() float64 {
+func (x *DailyReport) GetBalance() string {
 	if x != nil {
 		return x.Balance
 	}
-	return 0
+	return ""
 }
 
-func (x *DailyReport) GetNetChange() float64 {
+func (x *DailyReport) GetNetChange() string {
 	if x != nil {
 		return x.NetChange
 	}
-	return 0
+	return ""
 }
 
 func (x *DailyReport) GetDate() *timestamppb.Timestamp {
@@ -3851,7 +4804,7 @@ type GenerateDailyReportRequest struct {
 
 func (x *GenerateDailyReportRequest) Reset() {
 	*x = GenerateDailyReportRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[45]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3863,7 +4816,7 @@ func (x *GenerateDailyReportRequest) String() string {
 func (*GenerateDailyReportRequest) ProtoMessage() {}
 
 func (x *GenerateDailyReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[45]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3876,7 +4829,7 @@ func (x *GenerateDailyReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateDailyReportRequest.ProtoReflect.Descriptor instead.
 func (*GenerateDailyReportRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{45}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GenerateDailyReportRequest) GetDate() *timestamppb.Timestamp {
@@ -3902,7 +4855,7 @@ type GenerateDailyReportResponse struct {
 
 func (x *GenerateDailyReportResponse) Reset() {
 	*x = GenerateDailyReportResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[46]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3914,7 +4867,7 @@ func (x *GenerateDailyReportResponse) String() string {
 func (*GenerateDailyReportResponse) ProtoMessage() {}
 
 func (x *GenerateDailyReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[46]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3927,7 +4880,7 @@ func (x *GenerateDailyReportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateDailyReportResponse.ProtoReflect.Descriptor instead.
 func (*GenerateDailyReportResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{46}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GenerateDailyReportResponse) GetReports() []*DailyReport {
@@ -3942,17 +4895,17 @@ type TransactionSummary struct {
 	TransactionType TransactionType        `protobuf:"varint,1,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
 	Currency        string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
 	Count           int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	TotalAmount     float64                `protobuf:"fixed64,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	MinAmount       float64                `protobuf:"fixed64,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
-	MaxAmount       float64                `protobuf:"fixed64,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
-	AvgAmount       float64                `protobuf:"fixed64,7,opt,name=avg_amount,json=avgAmount,proto3" json:"avg_amount,omitempty"`
+	TotalAmount     string                 `protobuf:"bytes,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"` // NUMERIC as string
+	MinAmount       string                 `protobuf:"bytes,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`       // NUMERIC as string
+	MaxAmount       string                 `protobuf:"bytes,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`       // NUMERIC as string
+	AvgAmount       string                 `protobuf:"bytes,7,opt,name=avg_amount,json=avgAmount,proto3" json:"avg_amount,omitempty"`       // NUMERIC as string
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *TransactionSummary) Reset() {
 	*x = TransactionSummary{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[47]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3964,7 +4917,7 @@ func (x *TransactionSummary) String() string {
 func (*TransactionSummary) ProtoMessage() {}
 
 func (x *TransactionSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[47]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3977,7 +4930,7 @@ func (x *TransactionSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionSummary.ProtoReflect.Descriptor instead.
 func (*TransactionSummary) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{47}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *TransactionSummary) GetTransactionType() TransactionType {
@@ -4001,32 +4954,32 @@ func (x *TransactionSummary) GetCount() int64 {
 	return 0
 }
 
-func (x *TransactionSummary) GetTotalAmount() float64 {
+func (x *TransactionSummary) GetTotalAmount() string {
 	if x != nil {
 		return x.TotalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *TransactionSummary) GetMinAmount() float64 {
+func (x *TransactionSummary) GetMinAmount() string {
 	if x != nil {
 		return x.MinAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *TransactionSummary) GetMaxAmount() float64 {
+func (x *TransactionSummary) GetMaxAmount() string {
 	if x != nil {
 		return x.MaxAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *TransactionSummary) GetAvgAmount() float64 {
+func (x *TransactionSummary) GetAvgAmount() string {
 	if x != nil {
 		return x.AvgAmount
 	}
-	return 0
+	return ""
 }
 
 type GetTransactionSummaryRequest struct {
@@ -4040,7 +4993,7 @@ type GetTransactionSummaryRequest struct {
 
 func (x *GetTransactionSummaryRequest) Reset() {
 	*x = GetTransactionSummaryRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[48]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4052,7 +5005,7 @@ func (x *GetTransactionSummaryRequest) String() string {
 func (*GetTransactionSummaryRequest) ProtoMessage() {}
 
 func (x *GetTransactionSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[48]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4065,7 +5018,7 @@ func (x *GetTransactionSummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionSummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetTransactionSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{48}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetTransactionSummaryRequest) GetAccountType() AccountType {
@@ -4098,7 +5051,7 @@ type GetTransactionSummaryResponse struct {
 
 func (x *GetTransactionSummaryResponse) Reset() {
 	*x = GetTransactionSummaryResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[49]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4110,7 +5063,7 @@ func (x *GetTransactionSummaryResponse) String() string {
 func (*GetTransactionSummaryResponse) ProtoMessage() {}
 
 func (x *GetTransactionSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[49]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4123,7 +5076,7 @@ func (x *GetTransactionSummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionSummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetTransactionSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{49}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetTransactionSummaryResponse) GetSummaries() []*TransactionSummary {
@@ -4142,7 +5095,7 @@ type GetSystemHoldingsRequest struct {
 
 func (x *GetSystemHoldingsRequest) Reset() {
 	*x = GetSystemHoldingsRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[50]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4154,7 +5107,7 @@ func (x *GetSystemHoldingsRequest) String() string {
 func (*GetSystemHoldingsRequest) ProtoMessage() {}
 
 func (x *GetSystemHoldingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[50]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4167,7 +5120,7 @@ func (x *GetSystemHoldingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSystemHoldingsRequest.ProtoReflect.Descriptor instead.
 func (*GetSystemHoldingsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{50}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetSystemHoldingsRequest) GetAccountType() AccountType {
@@ -4179,14 +5132,14 @@ func (x *GetSystemHoldingsRequest) GetAccountType() AccountType {
 
 type GetSystemHoldingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Holdings      map[string]float64     `protobuf:"bytes,1,rep,name=holdings,proto3" json:"holdings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // currency -> amount
+	Holdings      map[string]string      `protobuf:"bytes,1,rep,name=holdings,proto3" json:"holdings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // currency -> amount (NUMERIC as string)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSystemHoldingsResponse) Reset() {
 	*x = GetSystemHoldingsResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[51]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4198,7 +5151,7 @@ func (x *GetSystemHoldingsResponse) String() string {
 func (*GetSystemHoldingsResponse) ProtoMessage() {}
 
 func (x *GetSystemHoldingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[51]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4211,10 +5164,10 @@ func (x *GetSystemHoldingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSystemHoldingsResponse.ProtoReflect.Descriptor instead.
 func (*GetSystemHoldingsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{51}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetSystemHoldingsResponse) GetHoldings() map[string]float64 {
+func (x *GetSystemHoldingsResponse) GetHoldings() map[string]string {
 	if x != nil {
 		return x.Holdings
 	}
@@ -4227,20 +5180,21 @@ type TransactionFee struct {
 	ReceiptCode          string                 `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
 	FeeRuleId            int64                  `protobuf:"varint,3,opt,name=fee_rule_id,json=feeRuleId,proto3" json:"fee_rule_id,omitempty"`
 	FeeType              FeeType                `protobuf:"varint,4,opt,name=fee_type,json=feeType,proto3,enum=accounting.v1.FeeType" json:"fee_type,omitempty"`
-	Amount               float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount               string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
 	Currency             string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
 	CollectedByAccountId *int64                 `protobuf:"varint,7,opt,name=collected_by_account_id,json=collectedByAccountId,proto3,oneof" json:"collected_by_account_id,omitempty"`
 	LedgerId             *int64                 `protobuf:"varint,8,opt,name=ledger_id,json=ledgerId,proto3,oneof" json:"ledger_id,omitempty"`
 	AgentExternalId      *string                `protobuf:"bytes,9,opt,name=agent_external_id,json=agentExternalId,proto3,oneof" json:"agent_external_id,omitempty"`
 	CommissionRate       *string                `protobuf:"bytes,10,opt,name=commission_rate,json=commissionRate,proto3,oneof" json:"commission_rate,omitempty"`
 	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FeeRuleVersionId     *int64                 `protobuf:"varint,12,opt,name=fee_rule_version_id,json=feeRuleVersionId,proto3,oneof" json:"fee_rule_version_id,omitempty"` // Rule version that priced it; unset for fees recorded before versioning
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
 
 func (x *TransactionFee) Reset() {
 	*x = TransactionFee{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[52]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4252,7 +5206,7 @@ func (x *TransactionFee) String() string {
 func (*TransactionFee) ProtoMessage() {}
 
 func (x *TransactionFee) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[52]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4265,7 +5219,7 @@ func (x *TransactionFee) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionFee.ProtoReflect.Descriptor instead.
 func (*TransactionFee) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{52}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *TransactionFee) GetId() int64 {
@@ -4296,11 +5250,11 @@ func (x *TransactionFee) GetFeeType() FeeType {
 	return FeeType_FEE_TYPE_UNSPECIFIED
 }
 
-func (x *TransactionFee) GetAmount() float64 {
+func (x *TransactionFee) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return ""
 }
 
 func (x *TransactionFee) GetCurrency() string {
@@ -4345,10 +5299,17 @@ func (x *TransactionFee) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *TransactionFee) GetFeeRuleVersionId() int64 {
+	if x != nil && x.FeeRuleVersionId != nil {
+		return *x.FeeRuleVersionId
+	}
+	return 0
+}
+
 type CalculateFeeRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TransactionType TransactionType        `protobuf:"varint,1,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
-	Amount          float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount          string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
 	SourceCurrency  *string                `protobuf:"bytes,3,opt,name=source_currency,json=sourceCurrency,proto3,oneof" json:"source_currency,omitempty"`
 	TargetCurrency  *string                `protobuf:"bytes,4,opt,name=target_currency,json=targetCurrency,proto3,oneof" json:"target_currency,omitempty"`
 	AccountType     *AccountType           `protobuf:"varint,5,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType,oneof" json:"account_type,omitempty"`
@@ -4360,7 +5321,7 @@ type CalculateFeeRequest struct {
 
 func (x *CalculateFeeRequest) Reset() {
 	*x = CalculateFeeRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[53]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4372,7 +5333,7 @@ func (x *CalculateFeeRequest) String() string {
 func (*CalculateFeeRequest) ProtoMessage() {}
 
 func (x *CalculateFeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[53]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4385,7 +5346,7 @@ func (x *CalculateFeeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateFeeRequest.ProtoReflect.Descriptor instead.
 func (*CalculateFeeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{53}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *CalculateFeeRequest) GetTransactionType() TransactionType {
@@ -4395,11 +5356,11 @@ func (x *CalculateFeeRequest) GetTransactionType() TransactionType {
 	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
 }
 
-func (x *CalculateFeeRequest) GetAmount() float64 {
+func (x *CalculateFeeRequest) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return ""
 }
 
 func (x *CalculateFeeRequest) GetSourceCurrency() string {
@@ -4441,15 +5402,15 @@ type FeeCalculation struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	FeeType FeeType                `protobuf:"varint,1,opt,name=fee_type,json=feeType,proto3,enum=accounting.v1.FeeType" json:"fee_type,omitempty"`
 	// Platform fee
-	Amount   float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency string  `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount   string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
 	// Network fee (converted to transaction currency)
-	NetworkFee float64 `protobuf:"fixed64,4,opt,name=network_fee,json=networkFee,proto3" json:"network_fee,omitempty"`
+	NetworkFee string `protobuf:"bytes,4,opt,name=network_fee,json=networkFee,proto3" json:"network_fee,omitempty"` // NUMERIC as string
 	// Network fee (original)
-	NetworkFeeOriginal         float64 `protobuf:"fixed64,5,opt,name=network_fee_original,json=networkFeeOriginal,proto3" json:"network_fee_original,omitempty"`
-	NetworkFeeOriginalCurrency string  `protobuf:"bytes,6,opt,name=network_fee_original_currency,json=networkFeeOriginalCurrency,proto3" json:"network_fee_original_currency,omitempty"`
+	NetworkFeeOriginal         string `protobuf:"bytes,5,opt,name=network_fee_original,json=networkFeeOriginal,proto3" json:"network_fee_original,omitempty"` // NUMERIC as string
+	NetworkFeeOriginalCurrency string `protobuf:"bytes,6,opt,name=network_fee_original_currency,json=networkFeeOriginalCurrency,proto3" json:"network_fee_original_currency,omitempty"`
 	// Total
-	TotalFee float64 `protobuf:"fixed64,7,opt,name=total_fee,json=totalFee,proto3" json:"total_fee,omitempty"`
+	TotalFee string `protobuf:"bytes,7,opt,name=total_fee,json=totalFee,proto3" json:"total_fee,omitempty"` // NUMERIC as string
 	// Metadata
 	AppliedRate    *string `protobuf:"bytes,8,opt,name=applied_rate,json=appliedRate,proto3,oneof" json:"applied_rate,omitempty"`
 	RuleId         *int64  `protobuf:"varint,9,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id,omitempty"`
@@ -4460,7 +5421,7 @@ type FeeCalculation struct {
 
 func (x *FeeCalculation) Reset() {
 	*x = FeeCalculation{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[54]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4472,7 +5433,7 @@ func (x *FeeCalculation) String() string {
 func (*FeeCalculation) ProtoMessage() {}
 
 func (x *FeeCalculation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[54]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4485,7 +5446,7 @@ func (x *FeeCalculation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeeCalculation.ProtoReflect.Descriptor instead.
 func (*FeeCalculation) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{54}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *FeeCalculation) GetFeeType() FeeType {
@@ -4495,11 +5456,11 @@ func (x *FeeCalculation) GetFeeType() FeeType {
 	return FeeType_FEE_TYPE_UNSPECIFIED
 }
 
-func (x *FeeCalculation) GetAmount() float64 {
+func (x *FeeCalculation) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return ""
 }
 
 func (x *FeeCalculation) GetCurrency() string {
@@ -4509,18 +5470,18 @@ func (x *FeeCalculation) GetCurrency() string {
 	return ""
 }
 
-func (x *FeeCalculation) GetNetworkFee() float64 {
+func (x *FeeCalculation) GetNetworkFee() string {
 	if x != nil {
 		return x.NetworkFee
 	}
-	return 0
+	return ""
 }
 
-func (x *FeeCalculation) GetNetworkFeeOriginal() float64 {
+func (x *FeeCalculation) GetNetworkFeeOriginal() string {
 	if x != nil {
 		return x.NetworkFeeOriginal
 	}
-	return 0
+	return ""
 }
 
 func (x *FeeCalculation) GetNetworkFeeOriginalCurrency() string {
@@ -4530,11 +5491,11 @@ func (x *FeeCalculation) GetNetworkFeeOriginalCurrency() string {
 	return ""
 }
 
-func (x *FeeCalculation) GetTotalFee() float64 {
+func (x *FeeCalculation) GetTotalFee() string {
 	if x != nil {
 		return x.TotalFee
 	}
-	return 0
+	return ""
 }
 
 func (x *FeeCalculation) GetAppliedRate() string {
@@ -4567,7 +5528,7 @@ type CalculateFeeResponse struct {
 
 func (x *CalculateFeeResponse) Reset() {
 	*x = CalculateFeeResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[55]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4579,7 +5540,7 @@ func (x *CalculateFeeResponse) String() string {
 func (*CalculateFeeResponse) ProtoMessage() {}
 
 func (x *CalculateFeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[55]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4592,7 +5553,7 @@ func (x *CalculateFeeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateFeeResponse.ProtoReflect.Descriptor instead.
 func (*CalculateFeeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{55}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *CalculateFeeResponse) GetCalculation() *FeeCalculation {
@@ -4611,7 +5572,7 @@ type GetFeesByReceiptRequest struct {
 
 func (x *GetFeesByReceiptRequest) Reset() {
 	*x = GetFeesByReceiptRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[56]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4623,7 +5584,7 @@ func (x *GetFeesByReceiptRequest) String() string {
 func (*GetFeesByReceiptRequest) ProtoMessage() {}
 
 func (x *GetFeesByReceiptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[56]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4636,7 +5597,7 @@ func (x *GetFeesByReceiptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFeesByReceiptRequest.ProtoReflect.Descriptor instead.
 func (*GetFeesByReceiptRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{56}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *GetFeesByReceiptRequest) GetReceiptCode() string {
@@ -4655,7 +5616,7 @@ type GetFeesByReceiptResponse struct {
 
 func (x *GetFeesByReceiptResponse) Reset() {
 	*x = GetFeesByReceiptResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[57]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4667,7 +5628,7 @@ func (x *GetFeesByReceiptResponse) String() string {
 func (*GetFeesByReceiptResponse) ProtoMessage() {}
 
 func (x *GetFeesByReceiptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[57]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4680,7 +5641,7 @@ func (x *GetFeesByReceiptResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFeesByReceiptResponse.ProtoReflect.Descriptor instead.
 func (*GetFeesByReceiptResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{57}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetFeesByReceiptResponse) GetFees() []*TransactionFee {
@@ -4701,7 +5662,7 @@ type GetAgentCommissionSummaryRequest struct {
 
 func (x *GetAgentCommissionSummaryRequest) Reset() {
 	*x = GetAgentCommissionSummaryRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[58]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4713,7 +5674,7 @@ func (x *GetAgentCommissionSummaryRequest) String() string {
 func (*GetAgentCommissionSummaryRequest) ProtoMessage() {}
 
 func (x *GetAgentCommissionSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[58]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4726,7 +5687,7 @@ func (x *GetAgentCommissionSummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentCommissionSummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetAgentCommissionSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{58}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *GetAgentCommissionSummaryRequest) GetAgentExternalId() string {
@@ -4752,14 +5713,14 @@ func (x *GetAgentCommissionSummaryRequest) GetTo() *timestamppb.Timestamp {
 
 type GetAgentCommissionSummaryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Commissions   map[string]float64     `protobuf:"bytes,1,rep,name=commissions,proto3" json:"commissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // currency -> total commission
+	Commissions   map[string]string      `protobuf:"bytes,1,rep,name=commissions,proto3" json:"commissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // currency -> total commission (NUMERIC as string)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAgentCommissionSummaryResponse) Reset() {
 	*x = GetAgentCommissionSummaryResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[59]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4771,7 +5732,7 @@ func (x *GetAgentCommissionSummaryResponse) String() string {
 func (*GetAgentCommissionSummaryResponse) ProtoMessage() {}
 
 func (x *GetAgentCommissionSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[59]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4784,10 +5745,10 @@ func (x *GetAgentCommissionSummaryResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAgentCommissionSummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetAgentCommissionSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{59}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetAgentCommissionSummaryResponse) GetCommissions() map[string]float64 {
+func (x *GetAgentCommissionSummaryResponse) GetCommissions() map[string]string {
 	if x != nil {
 		return x.Commissions
 	}
@@ -4802,7 +5763,7 @@ type HealthCheckRequest struct {
 
 func (x *HealthCheckRequest) Reset() {
 	*x = HealthCheckRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[60]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4814,7 +5775,7 @@ func (x *HealthCheckRequest) String() string {
 func (*HealthCheckRequest) ProtoMessage() {}
 
 func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[60]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4827,7 +5788,7 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
 func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{60}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{67}
 }
 
 type HealthCheckResponse struct {
@@ -4841,7 +5802,7 @@ type HealthCheckResponse struct {
 
 func (x *HealthCheckResponse) Reset() {
 	*x = HealthCheckResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[61]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4853,7 +5814,7 @@ func (x *HealthCheckResponse) String() string {
 func (*HealthCheckResponse) ProtoMessage() {}
 
 func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[61]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4866,7 +5827,7 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
 func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{61}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *HealthCheckResponse) GetStatus() string {
@@ -4900,7 +5861,7 @@ type BatchExecuteTransactionsRequest struct {
 
 func (x *BatchExecuteTransactionsRequest) Reset() {
 	*x = BatchExecuteTransactionsRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[62]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4912,7 +5873,7 @@ func (x *BatchExecuteTransactionsRequest) String() string {
 func (*BatchExecuteTransactionsRequest) ProtoMessage() {}
 
 func (x *BatchExecuteTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[62]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4925,7 +5886,7 @@ func (x *BatchExecuteTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchExecuteTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*BatchExecuteTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{62}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *BatchExecuteTransactionsRequest) GetTransactions() []*ExecuteTransactionRequest {
@@ -4952,7 +5913,7 @@ type BatchExecuteTransactionsResponse struct {
 
 func (x *BatchExecuteTransactionsResponse) Reset() {
 	*x = BatchExecuteTransactionsResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[63]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4964,7 +5925,7 @@ func (x *BatchExecuteTransactionsResponse) String() string {
 func (*BatchExecuteTransactionsResponse) ProtoMessage() {}
 
 func (x *BatchExecuteTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[63]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4977,7 +5938,7 @@ func (x *BatchExecuteTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchExecuteTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*BatchExecuteTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{63}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *BatchExecuteTransactionsResponse) GetResults() []*ExecuteTransactionResponse {
@@ -5003,7 +5964,7 @@ type BatchGetBalancesRequest struct {
 
 func (x *BatchGetBalancesRequest) Reset() {
 	*x = BatchGetBalancesRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[64]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5015,7 +5976,7 @@ func (x *BatchGetBalancesRequest) String() string {
 func (*BatchGetBalancesRequest) ProtoMessage() {}
 
 func (x *BatchGetBalancesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[64]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5028,7 +5989,7 @@ func (x *BatchGetBalancesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetBalancesRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetBalancesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{64}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *BatchGetBalancesRequest) GetAccountNumbers() []string {
@@ -5048,7 +6009,7 @@ type BatchGetBalancesResponse struct {
 
 func (x *BatchGetBalancesResponse) Reset() {
 	*x = BatchGetBalancesResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[65]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5060,7 +6021,7 @@ func (x *BatchGetBalancesResponse) String() string {
 func (*BatchGetBalancesResponse) ProtoMessage() {}
 
 func (x *BatchGetBalancesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[65]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5073,7 +6034,7 @@ func (x *BatchGetBalancesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetBalancesResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetBalancesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{65}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *BatchGetBalancesResponse) GetBalances() []*Balance {
@@ -5101,7 +6062,7 @@ type StreamTransactionEventsRequest struct {
 
 func (x *StreamTransactionEventsRequest) Reset() {
 	*x = StreamTransactionEventsRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[66]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5113,7 +6074,7 @@ func (x *StreamTransactionEventsRequest) String() string {
 func (*StreamTransactionEventsRequest) ProtoMessage() {}
 
 func (x *StreamTransactionEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[66]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5126,7 +6087,7 @@ func (x *StreamTransactionEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamTransactionEventsRequest.ProtoReflect.Descriptor instead.
 func (*StreamTransactionEventsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{66}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *StreamTransactionEventsRequest) GetOwnerType() OwnerType {
@@ -5156,7 +6117,7 @@ type TransactionEvent struct {
 	ReceiptCode   string                 `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
 	TransactionId int64                  `protobuf:"varint,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 	Status        TransactionStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=accounting.v1.TransactionStatus" json:"status,omitempty"`
-	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
 	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
 	ErrorMessage  *string                `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
 	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -5166,7 +6127,7 @@ type TransactionEvent struct {
 
 func (x *TransactionEvent) Reset() {
 	*x = TransactionEvent{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[67]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5178,7 +6139,7 @@ func (x *TransactionEvent) String() string {
 func (*TransactionEvent) ProtoMessage() {}
 
 func (x *TransactionEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[67]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5191,7 +6152,7 @@ func (x *TransactionEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionEvent.ProtoReflect.Descriptor instead.
 func (*TransactionEvent) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{67}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *TransactionEvent) GetEventType() string {
@@ -5222,11 +6183,11 @@ func (x *TransactionEvent) GetStatus() TransactionStatus {
 	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
 }
 
-func (x *TransactionEvent) GetAmount() float64 {
+func (x *TransactionEvent) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return ""
 }
 
 func (x *TransactionEvent) GetCurrency() string {
@@ -5253,7 +6214,7 @@ func (x *TransactionEvent) GetTimestamp() *timestamppb.Timestamp {
 type CreditRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	AccountNumber       string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	Amount              float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount              string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
 	Currenccy           string                 `protobuf:"bytes,3,opt,name=currenccy,proto3" json:"currenccy,omitempty"`
 	AccountType         AccountType            `protobuf:"varint,4,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
 	Description         string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
@@ -5263,13 +6224,14 @@ type CreditRequest struct {
 	CreatedByType       OwnerType              `protobuf:"varint,9,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
 	TransactionType     TransactionType        `protobuf:"varint,10,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
 	ToAddress           *string                `protobuf:"bytes,11,opt,name=to_address,json=toAddress,proto3,oneof" json:"to_address,omitempty"`
+	PostedAt            *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=posted_at,json=postedAt,proto3,oneof" json:"posted_at,omitempty"` // Back-dated accounting date; must not fall in a closed period
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *CreditRequest) Reset() {
 	*x = CreditRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[68]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5281,7 +6243,7 @@ func (x *CreditRequest) String() string {
 func (*CreditRequest) ProtoMessage() {}
 
 func (x *CreditRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[68]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5294,7 +6256,7 @@ func (x *CreditRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreditRequest.ProtoReflect.Descriptor instead.
 func (*CreditRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{68}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *CreditRequest) GetAccountNumber() string {
@@ -5304,11 +6266,11 @@ func (x *CreditRequest) GetAccountNumber() string {
 	return ""
 }
 
-func (x *CreditRequest) GetAmount() float64 {
+func (x *CreditRequest) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return ""
 }
 
 func (x *CreditRequest) GetCurrenccy() string {
@@ -5374,20 +6336,27 @@ func (x *CreditRequest) GetToAddress() string {
 	return ""
 }
 
+func (x *CreditRequest) GetPostedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PostedAt
+	}
+	return nil
+}
+
 type CreditResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	JournalId     int64                  `protobuf:"varint,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
 	ReceiptCode   string                 `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
-	BalanceAfter  float64                `protobuf:"fixed64,3,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	BalanceAfter  string                 `protobuf:"bytes,3,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"` // NUMERIC as string
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	PayableAmount float64                `protobuf:"fixed64,5,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
+	PayableAmount string                 `protobuf:"bytes,5,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"` // NUMERIC as string
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreditResponse) Reset() {
 	*x = CreditResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[69]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5399,7 +6368,7 @@ func (x *CreditResponse) String() string {
 func (*CreditResponse) ProtoMessage() {}
 
 func (x *CreditResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[69]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5412,7 +6381,7 @@ func (x *CreditResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreditResponse.ProtoReflect.Descriptor instead.
 func (*CreditResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{69}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *CreditResponse) GetJournalId() int64 {
@@ -5429,11 +6398,11 @@ func (x *CreditResponse) GetReceiptCode() string {
 	return ""
 }
 
-func (x *CreditResponse) GetBalanceAfter() float64 {
+func (x *CreditResponse) GetBalanceAfter() string {
 	if x != nil {
 		return x.BalanceAfter
 	}
-	return 0
+	return ""
 }
 
 func (x *CreditResponse) GetCreatedAt() *timestamppb.Timestamp {
@@ -5443,17 +6412,17 @@ func (x *CreditResponse) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *CreditResponse) GetPayableAmount() float64 {
+func (x *CreditResponse) GetPayableAmount() string {
 	if x != nil {
 		return x.PayableAmount
 	}
-	return 0
+	return ""
 }
 
 type DebitRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	AccountNumber       string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	Amount              float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount              string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
 	Currenccy           string                 `protobuf:"bytes,3,opt,name=currenccy,proto3" json:"currenccy,omitempty"`
 	AccountType         AccountType            `protobuf:"varint,4,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
 	Description         string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
@@ -5463,13 +6432,14 @@ type DebitRequest struct {
 	CreatedByType       OwnerType              `protobuf:"varint,9,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
 	TransactionType     TransactionType        `protobuf:"varint,10,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
 	ToAddress           *string                `protobuf:"bytes,11,opt,name=to_address,json=toAddress,proto3,oneof" json:"to_address,omitempty"`
+	PostedAt            *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=posted_at,json=postedAt,proto3,oneof" json:"posted_at,omitempty"` // Back-dated accounting date; must not fall in a closed period
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *DebitRequest) Reset() {
 	*x = DebitRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[70]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5481,7 +6451,7 @@ func (x *DebitRequest) String() string {
 func (*DebitRequest) ProtoMessage() {}
 
 func (x *DebitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[70]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5494,7 +6464,7 @@ func (x *DebitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebitRequest.ProtoReflect.Descriptor instead.
 func (*DebitRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{70}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *DebitRequest) GetAccountNumber() string {
@@ -5504,11 +6474,11 @@ func (x *DebitRequest) GetAccountNumber() string {
 	return ""
 }
 
-func (x *DebitRequest) GetAmount() float64 {
+func (x *DebitRequest) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return ""
 }
 
 func (x *DebitRequest) GetCurrenccy() string {
@@ -5574,20 +6544,27 @@ func (x *DebitRequest) GetToAddress() string {
 	return ""
 }
 
-type DebitResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JournalId     int64                  `protobuf:"varint,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+func (x *DebitRequest) GetPostedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PostedAt
+	}
+	return nil
+}
+
+type DebitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JournalId     int64                  `protobuf:"varint,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
 	ReceiptCode   string                 `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
-	BalanceAfter  float64                `protobuf:"fixed64,3,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	BalanceAfter  string                 `protobuf:"bytes,3,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"` // NUMERIC as string
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	PayableAmount float64                `protobuf:"fixed64,5,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
+	PayableAmount string                 `protobuf:"bytes,5,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"` // NUMERIC as string
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DebitResponse) Reset() {
 	*x = DebitResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[71]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5599,7 +6576,7 @@ func (x *DebitResponse) String() string {
 func (*DebitResponse) ProtoMessage() {}
 
 func (x *DebitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[71]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5612,7 +6589,7 @@ func (x *DebitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebitResponse.ProtoReflect.Descriptor instead.
 func (*DebitResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{71}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *DebitResponse) GetJournalId() int64 {
@@ -5629,11 +6606,11 @@ func (x *DebitResponse) GetReceiptCode() string {
 	return ""
 }
 
-func (x *DebitResponse) GetBalanceAfter() float64 {
+func (x *DebitResponse) GetBalanceAfter() string {
 	if x != nil {
 		return x.BalanceAfter
 	}
-	return 0
+	return ""
 }
 
 func (x *DebitResponse) GetCreatedAt() *timestamppb.Timestamp {
@@ -5643,18 +6620,18 @@ func (x *DebitResponse) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *DebitResponse) GetPayableAmount() float64 {
+func (x *DebitResponse) GetPayableAmount() string {
 	if x != nil {
 		return x.PayableAmount
 	}
-	return 0
+	return ""
 }
 
 type TransferRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	FromAccountNumber   string                 `protobuf:"bytes,1,opt,name=from_account_number,json=fromAccountNumber,proto3" json:"from_account_number,omitempty"`
 	ToAccountNumber     string                 `protobuf:"bytes,2,opt,name=to_account_number,json=toAccountNumber,proto3" json:"to_account_number,omitempty"`
-	Amount              float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount              string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
 	AccountType         AccountType            `protobuf:"varint,4,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
 	Description         string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	IdempotencyKey      *string                `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
@@ -5670,7 +6647,7 @@ type TransferRequest struct {
 
 func (x *TransferRequest) Reset() {
 	*x = TransferRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[72]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5682,7 +6659,7 @@ func (x *TransferRequest) String() string {
 func (*TransferRequest) ProtoMessage() {}
 
 func (x *TransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[72]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5695,7 +6672,7 @@ func (x *TransferRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
 func (*TransferRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{72}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *TransferRequest) GetFromAccountNumber() string {
@@ -5712,11 +6689,11 @@ func (x *TransferRequest) GetToAccountNumber() string {
 	return ""
 }
 
-func (x *TransferRequest) GetAmount() float64 {
+func (x *TransferRequest) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return ""
 }
 
 func (x *TransferRequest) GetAccountType() AccountType {
@@ -5786,18 +6763,18 @@ type TransferResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	JournalId       int64                  `protobuf:"varint,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
 	ReceiptCode     string                 `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
-	FeeAmount       float64                `protobuf:"fixed64,3,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
-	AgentCommission float64                `protobuf:"fixed64,4,opt,name=agent_commission,json=agentCommission,proto3" json:"agent_commission,omitempty"`
+	FeeAmount       string                 `protobuf:"bytes,3,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`                   // NUMERIC as string
+	AgentCommission string                 `protobuf:"bytes,4,opt,name=agent_commission,json=agentCommission,proto3" json:"agent_commission,omitempty"` // NUMERIC as string
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	BalanceAfter    float64                `protobuf:"fixed64,6,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
-	PayableAmount   float64                `protobuf:"fixed64,7,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
+	BalanceAfter    string                 `protobuf:"bytes,6,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`    // NUMERIC as string
+	PayableAmount   string                 `protobuf:"bytes,7,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"` // NUMERIC as string
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *TransferResponse) Reset() {
 	*x = TransferResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[73]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5809,7 +6786,7 @@ func (x *TransferResponse) String() string {
 func (*TransferResponse) ProtoMessage() {}
 
 func (x *TransferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[73]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5822,7 +6799,7 @@ func (x *TransferResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferResponse.ProtoReflect.Descriptor instead.
 func (*TransferResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{73}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *TransferResponse) GetJournalId() int64 {
@@ -5839,18 +6816,18 @@ func (x *TransferResponse) GetReceiptCode() string {
 	return ""
 }
 
-func (x *TransferResponse) GetFeeAmount() float64 {
+func (x *TransferResponse) GetFeeAmount() string {
 	if x != nil {
 		return x.FeeAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *TransferResponse) GetAgentCommission() float64 {
+func (x *TransferResponse) GetAgentCommission() string {
 	if x != nil {
 		return x.AgentCommission
 	}
-	return 0
+	return ""
 }
 
 func (x *TransferResponse) GetCreatedAt() *timestamppb.Timestamp {
@@ -5860,25 +6837,25 @@ func (x *TransferResponse) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *TransferResponse) GetBalanceAfter() float64 {
+func (x *TransferResponse) GetBalanceAfter() string {
 	if x != nil {
 		return x.BalanceAfter
 	}
-	return 0
+	return ""
 }
 
-func (x *TransferResponse) GetPayableAmount() float64 {
+func (x *TransferResponse) GetPayableAmount() string {
 	if x != nil {
 		return x.PayableAmount
 	}
-	return 0
+	return ""
 }
 
 type ConversionRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	FromAccountNumber   string                 `protobuf:"bytes,1,opt,name=from_account_number,json=fromAccountNumber,proto3" json:"from_account_number,omitempty"`
 	ToAccountNumber     string                 `protobuf:"bytes,2,opt,name=to_account_number,json=toAccountNumber,proto3" json:"to_account_number,omitempty"`
-	Amount              float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"` // Amount in source currency
+	Amount              string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string, in source currency
 	AccountType         AccountType            `protobuf:"varint,4,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
 	IdempotencyKey      *string                `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
 	ExternalRef         *string                `protobuf:"bytes,6,opt,name=external_ref,json=externalRef,proto3,oneof" json:"external_ref,omitempty"`
@@ -5886,13 +6863,14 @@ type ConversionRequest struct {
 	CreatedByType       OwnerType              `protobuf:"varint,8,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
 	AgentExternalId     *string                `protobuf:"bytes,9,opt,name=agent_external_id,json=agentExternalId,proto3,oneof" json:"agent_external_id,omitempty"`
 	ToAddress           *string                `protobuf:"bytes,10,opt,name=to_address,json=toAddress,proto3,oneof" json:"to_address,omitempty"`
+	QuoteId             *string                `protobuf:"bytes,11,opt,name=quote_id,json=quoteId,proto3,oneof" json:"quote_id,omitempty"` // Book the locked rate of a CreateFXQuote quote
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ConversionRequest) Reset() {
 	*x = ConversionRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[74]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5904,7 +6882,7 @@ func (x *ConversionRequest) String() string {
 func (*ConversionRequest) ProtoMessage() {}
 
 func (x *ConversionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[74]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5917,7 +6895,7 @@ func (x *ConversionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConversionRequest.ProtoReflect.Descriptor instead.
 func (*ConversionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{74}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *ConversionRequest) GetFromAccountNumber() string {
@@ -5934,11 +6912,11 @@ func (x *ConversionRequest) GetToAccountNumber() string {
 	return ""
 }
 
-func (x *ConversionRequest) GetAmount() float64 {
+func (x *ConversionRequest) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return ""
 }
 
 func (x *ConversionRequest) GetAccountType() AccountType {
@@ -5990,26 +6968,33 @@ func (x *ConversionRequest) GetToAddress() string {
 	return ""
 }
 
+func (x *ConversionRequest) GetQuoteId() string {
+	if x != nil && x.QuoteId != nil {
+		return *x.QuoteId
+	}
+	return ""
+}
+
 type ConversionResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	JournalId       int64                  `protobuf:"varint,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
 	ReceiptCode     string                 `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
 	SourceCurrency  string                 `protobuf:"bytes,3,opt,name=source_currency,json=sourceCurrency,proto3" json:"source_currency,omitempty"`
 	DestCurrency    string                 `protobuf:"bytes,4,opt,name=dest_currency,json=destCurrency,proto3" json:"dest_currency,omitempty"`
-	SourceAmount    float64                `protobuf:"fixed64,5,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
-	ConvertedAmount float64                `protobuf:"fixed64,6,opt,name=converted_amount,json=convertedAmount,proto3" json:"converted_amount,omitempty"`
+	SourceAmount    string                 `protobuf:"bytes,5,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`          // NUMERIC as string
+	ConvertedAmount string                 `protobuf:"bytes,6,opt,name=converted_amount,json=convertedAmount,proto3" json:"converted_amount,omitempty"` // NUMERIC as string
 	FxRate          string                 `protobuf:"bytes,7,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`
 	FxRateId        int64                  `protobuf:"varint,8,opt,name=fx_rate_id,json=fxRateId,proto3" json:"fx_rate_id,omitempty"`
-	FeeAmount       float64                `protobuf:"fixed64,9,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	FeeAmount       string                 `protobuf:"bytes,9,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"` // NUMERIC as string
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	PayableAmount   float64                `protobuf:"fixed64,11,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`
+	PayableAmount   string                 `protobuf:"bytes,11,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"` // NUMERIC as string
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ConversionResponse) Reset() {
 	*x = ConversionResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[75]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6021,7 +7006,7 @@ func (x *ConversionResponse) String() string {
 func (*ConversionResponse) ProtoMessage() {}
 
 func (x *ConversionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[75]
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6034,7 +7019,7 @@ func (x *ConversionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConversionResponse.ProtoReflect.Descriptor instead.
 func (*ConversionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{75}
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *ConversionResponse) GetJournalId() int64 {
@@ -6065,18 +7050,18 @@ func (x *ConversionResponse) GetDestCurrency() string {
 	return ""
 }
 
-func (x *ConversionResponse) GetSourceAmount() float64 {
+func (x *ConversionResponse) GetSourceAmount() string {
 	if x != nil {
 		return x.SourceAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *ConversionResponse) GetConvertedAmount() float64 {
+func (x *ConversionResponse) GetConvertedAmount() string {
 	if x != nil {
 		return x.ConvertedAmount
 	}
-	return 0
+	return ""
 }
 
 func (x *ConversionResponse) GetFxRate() string {
@@ -6093,11 +7078,11 @@ func (x *ConversionResponse) GetFxRateId() int64 {
 	return 0
 }
 
-func (x *ConversionResponse) GetFeeAmount() float64 {
+func (x *ConversionResponse) GetFeeAmount() string {
 	if x != nil {
 		return x.FeeAmount
 	}
-	return 0
+	return ""
 }
 
 func (x *ConversionResponse) GetCreatedAt() *timestamppb.Timestamp {
@@ -6107,43 +7092,41 @@ func (x *ConversionResponse) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *ConversionResponse) GetPayableAmount() float64 {
+func (x *ConversionResponse) GetPayableAmount() string {
 	if x != nil {
 		return x.PayableAmount
 	}
-	return 0
+	return ""
 }
 
-type TradeRequest struct {
+type CreateFXQuoteRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
-	AccountNumber       string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	Amount              float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currenccy           string                 `protobuf:"bytes,3,opt,name=currenccy,proto3" json:"currenccy,omitempty"`
+	FromAccountNumber   string                 `protobuf:"bytes,1,opt,name=from_account_number,json=fromAccountNumber,proto3" json:"from_account_number,omitempty"`
+	ToAccountNumber     string                 `protobuf:"bytes,2,opt,name=to_account_number,json=toAccountNumber,proto3" json:"to_account_number,omitempty"`
+	Amount              string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string, in source currency
 	AccountType         AccountType            `protobuf:"varint,4,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
-	TradeId             string                 `protobuf:"bytes,5,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
-	TradeType           string                 `protobuf:"bytes,6,opt,name=trade_type,json=tradeType,proto3" json:"trade_type,omitempty"` // e.g., "forex", "crypto", "sports"
-	IdempotencyKey      *string                `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
-	CreatedByExternalId string                 `protobuf:"bytes,8,opt,name=created_by_external_id,json=createdByExternalId,proto3" json:"created_by_external_id,omitempty"`
-	CreatedByType       OwnerType              `protobuf:"varint,9,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
+	CreatedByExternalId string                 `protobuf:"bytes,5,opt,name=created_by_external_id,json=createdByExternalId,proto3" json:"created_by_external_id,omitempty"`
+	CreatedByType       OwnerType              `protobuf:"varint,6,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
+	ExpiresInSeconds    *int64                 `protobuf:"varint,7,opt,name=expires_in_seconds,json=expiresInSeconds,proto3,oneof" json:"expires_in_seconds,omitempty"` // Defaults to 30s, capped at 5m
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *TradeRequest) Reset() {
-	*x = TradeRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[76]
+func (x *CreateFXQuoteRequest) Reset() {
+	*x = CreateFXQuoteRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TradeRequest) String() string {
+func (x *CreateFXQuoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TradeRequest) ProtoMessage() {}
+func (*CreateFXQuoteRequest) ProtoMessage() {}
 
-func (x *TradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[76]
+func (x *CreateFXQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6154,101 +7137,97 @@ func (x *TradeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TradeRequest.ProtoReflect.Descriptor instead.
-func (*TradeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use CreateFXQuoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateFXQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *TradeRequest) GetAccountNumber() string {
+func (x *CreateFXQuoteRequest) GetFromAccountNumber() string {
 	if x != nil {
-		return x.AccountNumber
+		return x.FromAccountNumber
 	}
 	return ""
 }
 
-func (x *TradeRequest) GetAmount() float64 {
+func (x *CreateFXQuoteRequest) GetToAccountNumber() string {
 	if x != nil {
-		return x.Amount
+		return x.ToAccountNumber
 	}
-	return 0
+	return ""
 }
 
-func (x *TradeRequest) GetCurrenccy() string {
+func (x *CreateFXQuoteRequest) GetAmount() string {
 	if x != nil {
-		return x.Currenccy
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *TradeRequest) GetAccountType() AccountType {
+func (x *CreateFXQuoteRequest) GetAccountType() AccountType {
 	if x != nil {
 		return x.AccountType
 	}
 	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
 }
 
-func (x *TradeRequest) GetTradeId() string {
-	if x != nil {
-		return x.TradeId
-	}
-	return ""
-}
-
-func (x *TradeRequest) GetTradeType() string {
-	if x != nil {
-		return x.TradeType
-	}
-	return ""
-}
-
-func (x *TradeRequest) GetIdempotencyKey() string {
-	if x != nil && x.IdempotencyKey != nil {
-		return *x.IdempotencyKey
-	}
-	return ""
-}
-
-func (x *TradeRequest) GetCreatedByExternalId() string {
+func (x *CreateFXQuoteRequest) GetCreatedByExternalId() string {
 	if x != nil {
 		return x.CreatedByExternalId
 	}
 	return ""
 }
 
-func (x *TradeRequest) GetCreatedByType() OwnerType {
+func (x *CreateFXQuoteRequest) GetCreatedByType() OwnerType {
 	if x != nil {
 		return x.CreatedByType
 	}
 	return OwnerType_OWNER_TYPE_UNSPECIFIED
 }
 
-type TradeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JournalId     int64                  `protobuf:"varint,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
-	ReceiptCode   string                 `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
-	TradeId       string                 `protobuf:"bytes,3,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
-	TradeResult   string                 `protobuf:"bytes,4,opt,name=trade_result,json=tradeResult,proto3" json:"trade_result,omitempty"` // "win" or "loss"
-	BalanceAfter  float64                `protobuf:"fixed64,5,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateFXQuoteRequest) GetExpiresInSeconds() int64 {
+	if x != nil && x.ExpiresInSeconds != nil {
+		return *x.ExpiresInSeconds
+	}
+	return 0
 }
 
-func (x *TradeResponse) Reset() {
-	*x = TradeResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[77]
+type FXQuote struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId           string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	FromAccountNumber string                 `protobuf:"bytes,2,opt,name=from_account_number,json=fromAccountNumber,proto3" json:"from_account_number,omitempty"`
+	ToAccountNumber   string                 `protobuf:"bytes,3,opt,name=to_account_number,json=toAccountNumber,proto3" json:"to_account_number,omitempty"`
+	SourceCurrency    string                 `protobuf:"bytes,4,opt,name=source_currency,json=sourceCurrency,proto3" json:"source_currency,omitempty"`
+	DestCurrency      string                 `protobuf:"bytes,5,opt,name=dest_currency,json=destCurrency,proto3" json:"dest_currency,omitempty"`
+	SourceAmount      string                 `protobuf:"bytes,6,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"` // NUMERIC as string
+	FxRate            string                 `protobuf:"bytes,7,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`                   // Locked customer rate
+	MidRate           string                 `protobuf:"bytes,8,opt,name=mid_rate,json=midRate,proto3" json:"mid_rate,omitempty"`
+	FxRateId          int64                  `protobuf:"varint,9,opt,name=fx_rate_id,json=fxRateId,proto3" json:"fx_rate_id,omitempty"`
+	ConvertedAmount   string                 `protobuf:"bytes,10,opt,name=converted_amount,json=convertedAmount,proto3" json:"converted_amount,omitempty"` // NUMERIC as string, before fee
+	FeeAmount         string                 `protobuf:"bytes,11,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`                   // NUMERIC as string, in dest currency
+	FeeRuleId         *int64                 `protobuf:"varint,12,opt,name=fee_rule_id,json=feeRuleId,proto3,oneof" json:"fee_rule_id,omitempty"`
+	FeeType           FeeType                `protobuf:"varint,13,opt,name=fee_type,json=feeType,proto3,enum=accounting.v1.FeeType" json:"fee_type,omitempty"`
+	PayableAmount     string                 `protobuf:"bytes,14,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"` // NUMERIC as string, credited to destination
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FXQuote) Reset() {
+	*x = FXQuote{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TradeResponse) String() string {
+func (x *FXQuote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TradeResponse) ProtoMessage() {}
+func (*FXQuote) ProtoMessage() {}
 
-func (x *TradeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[77]
+func (x *FXQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6259,171 +7238,145 @@ func (x *TradeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TradeResponse.ProtoReflect.Descriptor instead.
-func (*TradeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use FXQuote.ProtoReflect.Descriptor instead.
+func (*FXQuote) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *TradeResponse) GetJournalId() int64 {
+func (x *FXQuote) GetQuoteId() string {
 	if x != nil {
-		return x.JournalId
+		return x.QuoteId
 	}
-	return 0
+	return ""
 }
 
-func (x *TradeResponse) GetReceiptCode() string {
+func (x *FXQuote) GetFromAccountNumber() string {
 	if x != nil {
-		return x.ReceiptCode
+		return x.FromAccountNumber
 	}
 	return ""
 }
 
-func (x *TradeResponse) GetTradeId() string {
+func (x *FXQuote) GetToAccountNumber() string {
 	if x != nil {
-		return x.TradeId
+		return x.ToAccountNumber
 	}
 	return ""
 }
 
-func (x *TradeResponse) GetTradeResult() string {
+func (x *FXQuote) GetSourceCurrency() string {
 	if x != nil {
-		return x.TradeResult
+		return x.SourceCurrency
 	}
 	return ""
 }
 
-func (x *TradeResponse) GetBalanceAfter() float64 {
+func (x *FXQuote) GetDestCurrency() string {
 	if x != nil {
-		return x.BalanceAfter
+		return x.DestCurrency
 	}
-	return 0
+	return ""
 }
 
-func (x *TradeResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *FXQuote) GetSourceAmount() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.SourceAmount
 	}
-	return nil
-}
-
-type AgentCommissionRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	AgentExternalId   string                 `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
-	TransactionRef    string                 `protobuf:"bytes,2,opt,name=transaction_ref,json=transactionRef,proto3" json:"transaction_ref,omitempty"` // Receipt code of original transaction
-	Currency          string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
-	TransactionAmount float64                `protobuf:"fixed64,4,opt,name=transaction_amount,json=transactionAmount,proto3" json:"transaction_amount,omitempty"`
-	CommissionAmount  float64                `protobuf:"fixed64,5,opt,name=commission_amount,json=commissionAmount,proto3" json:"commission_amount,omitempty"`
-	CommissionRate    *string                `protobuf:"bytes,6,opt,name=commission_rate,json=commissionRate,proto3,oneof" json:"commission_rate,omitempty"`
-	IdempotencyKey    *string                `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *AgentCommissionRequest) Reset() {
-	*x = AgentCommissionRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[78]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AgentCommissionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*AgentCommissionRequest) ProtoMessage() {}
-
-func (x *AgentCommissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[78]
+func (x *FXQuote) GetFxRate() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FxRate
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AgentCommissionRequest.ProtoReflect.Descriptor instead.
-func (*AgentCommissionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{78}
+func (x *FXQuote) GetMidRate() string {
+	if x != nil {
+		return x.MidRate
+	}
+	return ""
 }
 
-func (x *AgentCommissionRequest) GetAgentExternalId() string {
+func (x *FXQuote) GetFxRateId() int64 {
 	if x != nil {
-		return x.AgentExternalId
+		return x.FxRateId
 	}
-	return ""
+	return 0
 }
 
-func (x *AgentCommissionRequest) GetTransactionRef() string {
+func (x *FXQuote) GetConvertedAmount() string {
 	if x != nil {
-		return x.TransactionRef
+		return x.ConvertedAmount
 	}
 	return ""
 }
 
-func (x *AgentCommissionRequest) GetCurrency() string {
+func (x *FXQuote) GetFeeAmount() string {
 	if x != nil {
-		return x.Currency
+		return x.FeeAmount
 	}
 	return ""
 }
 
-func (x *AgentCommissionRequest) GetTransactionAmount() float64 {
-	if x != nil {
-		return x.TransactionAmount
+func (x *FXQuote) GetFeeRuleId() int64 {
+	if x != nil && x.FeeRuleId != nil {
+		return *x.FeeRuleId
 	}
 	return 0
 }
 
-func (x *AgentCommissionRequest) GetCommissionAmount() float64 {
+func (x *FXQuote) GetFeeType() FeeType {
 	if x != nil {
-		return x.CommissionAmount
+		return x.FeeType
 	}
-	return 0
+	return FeeType_FEE_TYPE_UNSPECIFIED
 }
 
-func (x *AgentCommissionRequest) GetCommissionRate() string {
-	if x != nil && x.CommissionRate != nil {
-		return *x.CommissionRate
+func (x *FXQuote) GetPayableAmount() string {
+	if x != nil {
+		return x.PayableAmount
 	}
 	return ""
 }
 
-func (x *AgentCommissionRequest) GetIdempotencyKey() string {
-	if x != nil && x.IdempotencyKey != nil {
-		return *x.IdempotencyKey
+func (x *FXQuote) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-type AgentCommissionResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	JournalId        int64                  `protobuf:"varint,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
-	ReceiptCode      string                 `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
-	AgentExternalId  string                 `protobuf:"bytes,3,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
-	CommissionAmount float64                `protobuf:"fixed64,4,opt,name=commission_amount,json=commissionAmount,proto3" json:"commission_amount,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *FXQuote) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *AgentCommissionResponse) Reset() {
-	*x = AgentCommissionResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[79]
+type CreateFXQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *FXQuote               `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFXQuoteResponse) Reset() {
+	*x = CreateFXQuoteResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AgentCommissionResponse) String() string {
+func (x *CreateFXQuoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentCommissionResponse) ProtoMessage() {}
+func (*CreateFXQuoteResponse) ProtoMessage() {}
 
-func (x *AgentCommissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[79]
+func (x *CreateFXQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6434,81 +7387,153 @@ func (x *AgentCommissionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentCommissionResponse.ProtoReflect.Descriptor instead.
-func (*AgentCommissionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use CreateFXQuoteResponse.ProtoReflect.Descriptor instead.
+func (*CreateFXQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *AgentCommissionResponse) GetJournalId() int64 {
+func (x *CreateFXQuoteResponse) GetQuote() *FXQuote {
 	if x != nil {
-		return x.JournalId
+		return x.Quote
 	}
-	return 0
+	return nil
 }
 
-func (x *AgentCommissionResponse) GetReceiptCode() string {
+type TradeRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber       string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Amount              string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
+	Currenccy           string                 `protobuf:"bytes,3,opt,name=currenccy,proto3" json:"currenccy,omitempty"`
+	AccountType         AccountType            `protobuf:"varint,4,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
+	TradeId             string                 `protobuf:"bytes,5,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	TradeType           string                 `protobuf:"bytes,6,opt,name=trade_type,json=tradeType,proto3" json:"trade_type,omitempty"` // e.g., "forex", "crypto", "sports"
+	IdempotencyKey      *string                `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	CreatedByExternalId string                 `protobuf:"bytes,8,opt,name=created_by_external_id,json=createdByExternalId,proto3" json:"created_by_external_id,omitempty"`
+	CreatedByType       OwnerType              `protobuf:"varint,9,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TradeRequest) Reset() {
+	*x = TradeRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeRequest) ProtoMessage() {}
+
+func (x *TradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[86]
 	if x != nil {
-		return x.ReceiptCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeRequest.ProtoReflect.Descriptor instead.
+func (*TradeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *TradeRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
 	}
 	return ""
 }
 
-func (x *AgentCommissionResponse) GetAgentExternalId() string {
+func (x *TradeRequest) GetAmount() string {
 	if x != nil {
-		return x.AgentExternalId
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *AgentCommissionResponse) GetCommissionAmount() float64 {
+func (x *TradeRequest) GetCurrenccy() string {
 	if x != nil {
-		return x.CommissionAmount
+		return x.Currenccy
 	}
-	return 0
+	return ""
 }
 
-func (x *AgentCommissionResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *TradeRequest) GetAccountType() AccountType {
 	if x != nil {
-		return x.CreatedAt
+		return x.AccountType
 	}
-	return nil
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
 }
 
-type TransactionApproval struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	RequestedBy     int64                  `protobuf:"varint,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
-	TransactionType TransactionType        `protobuf:"varint,3,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
-	AccountNumber   string                 `protobuf:"bytes,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	Amount          float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency        string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
-	Description     string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	Status          ApprovalStatus         `protobuf:"varint,8,opt,name=status,proto3,enum=accounting.v1.ApprovalStatus" json:"status,omitempty"`
-	ApprovedBy      *int64                 `protobuf:"varint,9,opt,name=approved_by,json=approvedBy,proto3,oneof" json:"approved_by,omitempty"`
-	RejectionReason *string                `protobuf:"bytes,10,opt,name=rejection_reason,json=rejectionReason,proto3,oneof" json:"rejection_reason,omitempty"`
-	ReceiptCode     *string                `protobuf:"bytes,11,opt,name=receipt_code,json=receiptCode,proto3,oneof" json:"receipt_code,omitempty"`
-	ToAccountNumber *string                `protobuf:"bytes,12,opt,name=to_account_number,json=toAccountNumber,proto3,oneof" json:"to_account_number,omitempty"` // For transfers
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *TradeRequest) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
 }
 
-func (x *TransactionApproval) Reset() {
-	*x = TransactionApproval{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[80]
+func (x *TradeRequest) GetTradeType() string {
+	if x != nil {
+		return x.TradeType
+	}
+	return ""
+}
+
+func (x *TradeRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *TradeRequest) GetCreatedByExternalId() string {
+	if x != nil {
+		return x.CreatedByExternalId
+	}
+	return ""
+}
+
+func (x *TradeRequest) GetCreatedByType() OwnerType {
+	if x != nil {
+		return x.CreatedByType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+type TradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JournalId     int64                  `protobuf:"varint,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	ReceiptCode   string                 `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
+	TradeId       string                 `protobuf:"bytes,3,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	TradeResult   string                 `protobuf:"bytes,4,opt,name=trade_result,json=tradeResult,proto3" json:"trade_result,omitempty"`    // "win" or "loss"
+	BalanceAfter  string                 `protobuf:"bytes,5,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"` // NUMERIC as string
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradeResponse) Reset() {
+	*x = TradeResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionApproval) String() string {
+func (x *TradeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionApproval) ProtoMessage() {}
+func (*TradeResponse) ProtoMessage() {}
 
-func (x *TransactionApproval) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[80]
+func (x *TradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6519,137 +7544,9062 @@ func (x *TransactionApproval) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionApproval.ProtoReflect.Descriptor instead.
-func (*TransactionApproval) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use TradeResponse.ProtoReflect.Descriptor instead.
+func (*TradeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *TransactionApproval) GetId() int64 {
+func (x *TradeResponse) GetJournalId() int64 {
 	if x != nil {
-		return x.Id
+		return x.JournalId
 	}
 	return 0
 }
 
-func (x *TransactionApproval) GetRequestedBy() int64 {
+func (x *TradeResponse) GetReceiptCode() string {
 	if x != nil {
-		return x.RequestedBy
+		return x.ReceiptCode
 	}
-	return 0
+	return ""
 }
 
-func (x *TransactionApproval) GetTransactionType() TransactionType {
+func (x *TradeResponse) GetTradeId() string {
 	if x != nil {
-		return x.TransactionType
+		return x.TradeId
 	}
-	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *TransactionApproval) GetAccountNumber() string {
+func (x *TradeResponse) GetTradeResult() string {
 	if x != nil {
-		return x.AccountNumber
+		return x.TradeResult
 	}
 	return ""
 }
 
-func (x *TransactionApproval) GetAmount() float64 {
+func (x *TradeResponse) GetBalanceAfter() string {
 	if x != nil {
-		return x.Amount
+		return x.BalanceAfter
 	}
-	return 0
+	return ""
 }
 
-func (x *TransactionApproval) GetCurrency() string {
+func (x *TradeResponse) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Currency
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *TransactionApproval) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// One closed position in a SettleTrades batch
+type SettleTradeItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeId       string                 `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"` // Settles at most once across all batches
+	AccountNumber string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Result        string                 `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"` // "win" or "loss"
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string, always positive
+	TradeType     string                 `protobuf:"bytes,5,opt,name=trade_type,json=tradeType,proto3" json:"trade_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionApproval) GetStatus() ApprovalStatus {
+func (x *SettleTradeItem) Reset() {
+	*x = SettleTradeItem{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleTradeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleTradeItem) ProtoMessage() {}
+
+func (x *SettleTradeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[88]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *TransactionApproval) GetApprovedBy() int64 {
-	if x != nil && x.ApprovedBy != nil {
-		return *x.ApprovedBy
-	}
-	return 0
+// Deprecated: Use SettleTradeItem.ProtoReflect.Descriptor instead.
+func (*SettleTradeItem) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *TransactionApproval) GetRejectionReason() string {
-	if x != nil && x.RejectionReason != nil {
-		return *x.RejectionReason
+func (x *SettleTradeItem) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
 	}
 	return ""
 }
 
-func (x *TransactionApproval) GetReceiptCode() string {
-	if x != nil && x.ReceiptCode != nil {
-		return *x.ReceiptCode
+func (x *SettleTradeItem) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
 	}
 	return ""
 }
 
-func (x *TransactionApproval) GetToAccountNumber() string {
-	if x != nil && x.ToAccountNumber != nil {
-		return *x.ToAccountNumber
+func (x *SettleTradeItem) GetResult() string {
+	if x != nil {
+		return x.Result
 	}
 	return ""
 }
 
-func (x *TransactionApproval) GetCreatedAt() *timestamppb.Timestamp {
+func (x *SettleTradeItem) GetAmount() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Amount
 	}
-	return nil
+	return ""
 }
 
-func (x *TransactionApproval) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *SettleTradeItem) GetTradeType() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.TradeType
 	}
-	return nil
+	return ""
 }
 
-type CreateTransactionApprovalRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	RequestedBy     int64                  `protobuf:"varint,1,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
-	TransactionType TransactionType        `protobuf:"varint,2,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
-	AccountNumber   string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	Amount          float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency        string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
-	Description     string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	ToAccountNumber *string                `protobuf:"bytes,7,opt,name=to_account_number,json=toAccountNumber,proto3,oneof" json:"to_account_number,omitempty"` // For transfers and conversion
+type SettleTradesRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	BatchId             string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`                                             // Optional, recorded with each trade
+	AccountType         AccountType            `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"` // Defaults to real
+	Trades              []*SettleTradeItem     `protobuf:"bytes,3,rep,name=trades,proto3" json:"trades,omitempty"`                                                              // Max 5000
+	CreatedByExternalId string                 `protobuf:"bytes,4,opt,name=created_by_external_id,json=createdByExternalId,proto3" json:"created_by_external_id,omitempty"`
+	CreatedByType       OwnerType              `protobuf:"varint,5,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"` // Defaults to system
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SettleTradesRequest) Reset() {
+	*x = SettleTradesRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleTradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleTradesRequest) ProtoMessage() {}
+
+func (x *SettleTradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleTradesRequest.ProtoReflect.Descriptor instead.
+func (*SettleTradesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SettleTradesRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *SettleTradesRequest) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *SettleTradesRequest) GetTrades() []*SettleTradeItem {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+func (x *SettleTradesRequest) GetCreatedByExternalId() string {
+	if x != nil {
+		return x.CreatedByExternalId
+	}
+	return ""
+}
+
+func (x *SettleTradesRequest) GetCreatedByType() OwnerType {
+	if x != nil {
+		return x.CreatedByType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+type TradeSettlementResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeId       string                 `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Result        string                 `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                    // "settled", "duplicate" or "failed"
+	JournalId     *int64                 `protobuf:"varint,6,opt,name=journal_id,json=journalId,proto3,oneof" json:"journal_id,omitempty"`      // Unset when the account's trades netted to zero
+	ReceiptCode   *string                `protobuf:"bytes,7,opt,name=receipt_code,json=receiptCode,proto3,oneof" json:"receipt_code,omitempty"` // Shared by the trades netted together
+	BalanceAfter  *string                `protobuf:"bytes,8,opt,name=balance_after,json=balanceAfter,proto3,oneof" json:"balance_after,omitempty"`
+	Error         *string                `protobuf:"bytes,9,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	SettledAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradeSettlementResult) Reset() {
+	*x = TradeSettlementResult{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeSettlementResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeSettlementResult) ProtoMessage() {}
+
+func (x *TradeSettlementResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeSettlementResult.ProtoReflect.Descriptor instead.
+func (*TradeSettlementResult) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *TradeSettlementResult) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *TradeSettlementResult) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *TradeSettlementResult) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *TradeSettlementResult) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TradeSettlementResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TradeSettlementResult) GetJournalId() int64 {
+	if x != nil && x.JournalId != nil {
+		return *x.JournalId
+	}
+	return 0
+}
+
+func (x *TradeSettlementResult) GetReceiptCode() string {
+	if x != nil && x.ReceiptCode != nil {
+		return *x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *TradeSettlementResult) GetBalanceAfter() string {
+	if x != nil && x.BalanceAfter != nil {
+		return *x.BalanceAfter
+	}
+	return ""
+}
+
+func (x *TradeSettlementResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *TradeSettlementResult) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
+	}
+	return nil
+}
+
+type SettleTradesResponse struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	Results        []*TradeSettlementResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // Request order
+	Settled        int32                    `protobuf:"varint,2,opt,name=settled,proto3" json:"settled,omitempty"`
+	Duplicates     int32                    `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Failed         int32                    `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	JournalsPosted int32                    `protobuf:"varint,5,opt,name=journals_posted,json=journalsPosted,proto3" json:"journals_posted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SettleTradesResponse) Reset() {
+	*x = SettleTradesResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleTradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleTradesResponse) ProtoMessage() {}
+
+func (x *SettleTradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleTradesResponse.ProtoReflect.Descriptor instead.
+func (*SettleTradesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SettleTradesResponse) GetResults() []*TradeSettlementResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SettleTradesResponse) GetSettled() int32 {
+	if x != nil {
+		return x.Settled
+	}
+	return 0
+}
+
+func (x *SettleTradesResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *SettleTradesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *SettleTradesResponse) GetJournalsPosted() int32 {
+	if x != nil {
+		return x.JournalsPosted
+	}
+	return 0
+}
+
+type AgentCommissionRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AgentExternalId   string                 `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
+	TransactionRef    string                 `protobuf:"bytes,2,opt,name=transaction_ref,json=transactionRef,proto3" json:"transaction_ref,omitempty"` // Receipt code of original transaction
+	Currency          string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	TransactionAmount string                 `protobuf:"bytes,4,opt,name=transaction_amount,json=transactionAmount,proto3" json:"transaction_amount,omitempty"` // NUMERIC as string
+	CommissionAmount  string                 `protobuf:"bytes,5,opt,name=commission_amount,json=commissionAmount,proto3" json:"commission_amount,omitempty"`    // NUMERIC as string
+	CommissionRate    *string                `protobuf:"bytes,6,opt,name=commission_rate,json=commissionRate,proto3,oneof" json:"commission_rate,omitempty"`
+	IdempotencyKey    *string                `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AgentCommissionRequest) Reset() {
+	*x = AgentCommissionRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentCommissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentCommissionRequest) ProtoMessage() {}
+
+func (x *AgentCommissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentCommissionRequest.ProtoReflect.Descriptor instead.
+func (*AgentCommissionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *AgentCommissionRequest) GetAgentExternalId() string {
+	if x != nil {
+		return x.AgentExternalId
+	}
+	return ""
+}
+
+func (x *AgentCommissionRequest) GetTransactionRef() string {
+	if x != nil {
+		return x.TransactionRef
+	}
+	return ""
+}
+
+func (x *AgentCommissionRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AgentCommissionRequest) GetTransactionAmount() string {
+	if x != nil {
+		return x.TransactionAmount
+	}
+	return ""
+}
+
+func (x *AgentCommissionRequest) GetCommissionAmount() string {
+	if x != nil {
+		return x.CommissionAmount
+	}
+	return ""
+}
+
+func (x *AgentCommissionRequest) GetCommissionRate() string {
+	if x != nil && x.CommissionRate != nil {
+		return *x.CommissionRate
+	}
+	return ""
+}
+
+func (x *AgentCommissionRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+type AgentCommissionResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	JournalId        int64                  `protobuf:"varint,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	ReceiptCode      string                 `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
+	AgentExternalId  string                 `protobuf:"bytes,3,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
+	CommissionAmount string                 `protobuf:"bytes,4,opt,name=commission_amount,json=commissionAmount,proto3" json:"commission_amount,omitempty"` // NUMERIC as string
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AgentCommissionResponse) Reset() {
+	*x = AgentCommissionResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentCommissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentCommissionResponse) ProtoMessage() {}
+
+func (x *AgentCommissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentCommissionResponse.ProtoReflect.Descriptor instead.
+func (*AgentCommissionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AgentCommissionResponse) GetJournalId() int64 {
+	if x != nil {
+		return x.JournalId
+	}
+	return 0
+}
+
+func (x *AgentCommissionResponse) GetReceiptCode() string {
+	if x != nil {
+		return x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *AgentCommissionResponse) GetAgentExternalId() string {
+	if x != nil {
+		return x.AgentExternalId
+	}
+	return ""
+}
+
+func (x *AgentCommissionResponse) GetCommissionAmount() string {
+	if x != nil {
+		return x.CommissionAmount
+	}
+	return ""
+}
+
+func (x *AgentCommissionResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ReverseTransactionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ReceiptCode         string                 `protobuf:"bytes,1,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"` // Receipt of the journal to reverse
+	Reason              string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	IdempotencyKey      *string                `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	CreatedByExternalId string                 `protobuf:"bytes,4,opt,name=created_by_external_id,json=createdByExternalId,proto3" json:"created_by_external_id,omitempty"`
+	CreatedByType       OwnerType              `protobuf:"varint,5,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReverseTransactionRequest) Reset() {
+	*x = ReverseTransactionRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseTransactionRequest) ProtoMessage() {}
+
+func (x *ReverseTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ReverseTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ReverseTransactionRequest) GetReceiptCode() string {
+	if x != nil {
+		return x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *ReverseTransactionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReverseTransactionRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *ReverseTransactionRequest) GetCreatedByExternalId() string {
+	if x != nil {
+		return x.CreatedByExternalId
+	}
+	return ""
+}
+
+func (x *ReverseTransactionRequest) GetCreatedByType() OwnerType {
+	if x != nil {
+		return x.CreatedByType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+type RefundTransactionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ReceiptCode         string                 `protobuf:"bytes,1,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"` // Receipt of the journal to refund
+	Amount              string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`                              // NUMERIC as string, in the currency of the original debit
+	Reason              string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	IdempotencyKey      *string                `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	CreatedByExternalId string                 `protobuf:"bytes,5,opt,name=created_by_external_id,json=createdByExternalId,proto3" json:"created_by_external_id,omitempty"`
+	CreatedByType       OwnerType              `protobuf:"varint,6,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RefundTransactionRequest) Reset() {
+	*x = RefundTransactionRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundTransactionRequest) ProtoMessage() {}
+
+func (x *RefundTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundTransactionRequest.ProtoReflect.Descriptor instead.
+func (*RefundTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RefundTransactionRequest) GetReceiptCode() string {
+	if x != nil {
+		return x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *RefundTransactionRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RefundTransactionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundTransactionRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *RefundTransactionRequest) GetCreatedByExternalId() string {
+	if x != nil {
+		return x.CreatedByExternalId
+	}
+	return ""
+}
+
+func (x *RefundTransactionRequest) GetCreatedByType() OwnerType {
+	if x != nil {
+		return x.CreatedByType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+type ReversalResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	JournalId           int64                  `protobuf:"varint,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	ReceiptCode         string                 `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"` // New receipt, linked to the original via parent_receipt_code
+	OriginalReceiptCode string                 `protobuf:"bytes,3,opt,name=original_receipt_code,json=originalReceiptCode,proto3" json:"original_receipt_code,omitempty"`
+	TransactionType     TransactionType        `protobuf:"varint,4,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"` // REVERSAL or REFUND
+	Amount              string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                                                                              // NUMERIC as string
+	Currency            string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	FeeReversed         string                 `protobuf:"bytes,7,opt,name=fee_reversed,json=feeReversed,proto3" json:"fee_reversed,omitempty"`                         // NUMERIC as string
+	CommissionReversed  string                 `protobuf:"bytes,8,opt,name=commission_reversed,json=commissionReversed,proto3" json:"commission_reversed,omitempty"`    // NUMERIC as string
+	RefundableRemaining string                 `protobuf:"bytes,9,opt,name=refundable_remaining,json=refundableRemaining,proto3" json:"refundable_remaining,omitempty"` // NUMERIC as string
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReversalResponse) Reset() {
+	*x = ReversalResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReversalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReversalResponse) ProtoMessage() {}
+
+func (x *ReversalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReversalResponse.ProtoReflect.Descriptor instead.
+func (*ReversalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ReversalResponse) GetJournalId() int64 {
+	if x != nil {
+		return x.JournalId
+	}
+	return 0
+}
+
+func (x *ReversalResponse) GetReceiptCode() string {
+	if x != nil {
+		return x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *ReversalResponse) GetOriginalReceiptCode() string {
+	if x != nil {
+		return x.OriginalReceiptCode
+	}
+	return ""
+}
+
+func (x *ReversalResponse) GetTransactionType() TransactionType {
+	if x != nil {
+		return x.TransactionType
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ReversalResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ReversalResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ReversalResponse) GetFeeReversed() string {
+	if x != nil {
+		return x.FeeReversed
+	}
+	return ""
+}
+
+func (x *ReversalResponse) GetCommissionReversed() string {
+	if x != nil {
+		return x.CommissionReversed
+	}
+	return ""
+}
+
+func (x *ReversalResponse) GetRefundableRemaining() string {
+	if x != nil {
+		return x.RefundableRemaining
+	}
+	return ""
+}
+
+func (x *ReversalResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Hold struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HoldRef              string                 `protobuf:"bytes,2,opt,name=hold_ref,json=holdRef,proto3" json:"hold_ref,omitempty"`
+	AccountNumber        string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Amount               string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                                       // NUMERIC as string
+	CapturedAmount       string                 `protobuf:"bytes,5,opt,name=captured_amount,json=capturedAmount,proto3" json:"captured_amount,omitempty"` // NUMERIC as string
+	Currency             string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	HoldType             string                 `protobuf:"bytes,7,opt,name=hold_type,json=holdType,proto3" json:"hold_type,omitempty"` // e.g. withdrawal, crypto_withdrawal
+	Status               HoldStatus             `protobuf:"varint,8,opt,name=status,proto3,enum=accounting.v1.HoldStatus" json:"status,omitempty"`
+	TransactionType      TransactionType        `protobuf:"varint,9,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"` // Posted on capture
+	CaptureAccountNumber *string                `protobuf:"bytes,10,opt,name=capture_account_number,json=captureAccountNumber,proto3,oneof" json:"capture_account_number,omitempty"`             // Default capture destination
+	CaptureReceiptCode   *string                `protobuf:"bytes,11,opt,name=capture_receipt_code,json=captureReceiptCode,proto3,oneof" json:"capture_receipt_code,omitempty"`
+	Reason               *string                `protobuf:"bytes,12,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ExpiresAt            *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	CapturedAt           *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=captured_at,json=capturedAt,proto3,oneof" json:"captured_at,omitempty"`
+	ReleasedAt           *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=released_at,json=releasedAt,proto3,oneof" json:"released_at,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *Hold) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Hold) GetHoldRef() string {
+	if x != nil {
+		return x.HoldRef
+	}
+	return ""
+}
+
+func (x *Hold) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *Hold) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Hold) GetCapturedAmount() string {
+	if x != nil {
+		return x.CapturedAmount
+	}
+	return ""
+}
+
+func (x *Hold) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Hold) GetHoldType() string {
+	if x != nil {
+		return x.HoldType
+	}
+	return ""
+}
+
+func (x *Hold) GetStatus() HoldStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HoldStatus_HOLD_STATUS_UNSPECIFIED
+}
+
+func (x *Hold) GetTransactionType() TransactionType {
+	if x != nil {
+		return x.TransactionType
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *Hold) GetCaptureAccountNumber() string {
+	if x != nil && x.CaptureAccountNumber != nil {
+		return *x.CaptureAccountNumber
+	}
+	return ""
+}
+
+func (x *Hold) GetCaptureReceiptCode() string {
+	if x != nil && x.CaptureReceiptCode != nil {
+		return *x.CaptureReceiptCode
+	}
+	return ""
+}
+
+func (x *Hold) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *Hold) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Hold) GetCapturedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CapturedAt
+	}
+	return nil
+}
+
+func (x *Hold) GetReleasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return nil
+}
+
+func (x *Hold) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Hold) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PlaceHoldRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber        string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Amount               string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`                        // NUMERIC as string
+	HoldRef              *string                `protobuf:"bytes,3,opt,name=hold_ref,json=holdRef,proto3,oneof" json:"hold_ref,omitempty"` // Caller reference; placing the same ref twice returns the existing hold
+	HoldType             string                 `protobuf:"bytes,4,opt,name=hold_type,json=holdType,proto3" json:"hold_type,omitempty"`
+	Reason               *string                `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ExpiresInSeconds     *int64                 `protobuf:"varint,6,opt,name=expires_in_seconds,json=expiresInSeconds,proto3,oneof" json:"expires_in_seconds,omitempty"`                         // Defaults to 24h
+	CaptureAccountNumber *string                `protobuf:"bytes,7,opt,name=capture_account_number,json=captureAccountNumber,proto3,oneof" json:"capture_account_number,omitempty"`              // Empty = system liquidity account (no fees)
+	TransactionType      TransactionType        `protobuf:"varint,8,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"` // Defaults to WITHDRAWAL
+	AccountType          AccountType            `protobuf:"varint,9,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
+	CreatedByExternalId  string                 `protobuf:"bytes,10,opt,name=created_by_external_id,json=createdByExternalId,proto3" json:"created_by_external_id,omitempty"`
+	CreatedByType        OwnerType              `protobuf:"varint,11,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PlaceHoldRequest) Reset() {
+	*x = PlaceHoldRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceHoldRequest) ProtoMessage() {}
+
+func (x *PlaceHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceHoldRequest.ProtoReflect.Descriptor instead.
+func (*PlaceHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *PlaceHoldRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetHoldRef() string {
+	if x != nil && x.HoldRef != nil {
+		return *x.HoldRef
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetHoldType() string {
+	if x != nil {
+		return x.HoldType
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetExpiresInSeconds() int64 {
+	if x != nil && x.ExpiresInSeconds != nil {
+		return *x.ExpiresInSeconds
+	}
+	return 0
+}
+
+func (x *PlaceHoldRequest) GetCaptureAccountNumber() string {
+	if x != nil && x.CaptureAccountNumber != nil {
+		return *x.CaptureAccountNumber
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetTransactionType() TransactionType {
+	if x != nil {
+		return x.TransactionType
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *PlaceHoldRequest) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *PlaceHoldRequest) GetCreatedByExternalId() string {
+	if x != nil {
+		return x.CreatedByExternalId
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetCreatedByType() OwnerType {
+	if x != nil {
+		return x.CreatedByType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+type PlaceHoldResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Hold             *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	AvailableBalance string                 `protobuf:"bytes,2,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"` // NUMERIC as string, after the hold
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PlaceHoldResponse) Reset() {
+	*x = PlaceHoldResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceHoldResponse) ProtoMessage() {}
+
+func (x *PlaceHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceHoldResponse.ProtoReflect.Descriptor instead.
+func (*PlaceHoldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *PlaceHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *PlaceHoldResponse) GetAvailableBalance() string {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return ""
+}
+
+type CaptureHoldRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	HoldRef             string                 `protobuf:"bytes,1,opt,name=hold_ref,json=holdRef,proto3" json:"hold_ref,omitempty"`
+	Amount              *string                `protobuf:"bytes,2,opt,name=amount,proto3,oneof" json:"amount,omitempty"`                                            // NUMERIC as string; empty = capture in full
+	ToAccountNumber     *string                `protobuf:"bytes,3,opt,name=to_account_number,json=toAccountNumber,proto3,oneof" json:"to_account_number,omitempty"` // Overrides the hold's capture account
+	Description         string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	AgentExternalId     *string                `protobuf:"bytes,5,opt,name=agent_external_id,json=agentExternalId,proto3,oneof" json:"agent_external_id,omitempty"`
+	CreatedByExternalId string                 `protobuf:"bytes,6,opt,name=created_by_external_id,json=createdByExternalId,proto3" json:"created_by_external_id,omitempty"`
+	CreatedByType       OwnerType              `protobuf:"varint,7,opt,name=created_by_type,json=createdByType,proto3,enum=accounting.v1.OwnerType" json:"created_by_type,omitempty"`
+	ToAddress           *string                `protobuf:"bytes,8,opt,name=to_address,json=toAddress,proto3,oneof" json:"to_address,omitempty"` // Crypto destination, used for network fee calculation
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CaptureHoldRequest) Reset() {
+	*x = CaptureHoldRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldRequest) ProtoMessage() {}
+
+func (x *CaptureHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldRequest.ProtoReflect.Descriptor instead.
+func (*CaptureHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CaptureHoldRequest) GetHoldRef() string {
+	if x != nil {
+		return x.HoldRef
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetToAccountNumber() string {
+	if x != nil && x.ToAccountNumber != nil {
+		return *x.ToAccountNumber
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetAgentExternalId() string {
+	if x != nil && x.AgentExternalId != nil {
+		return *x.AgentExternalId
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetCreatedByExternalId() string {
+	if x != nil {
+		return x.CreatedByExternalId
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetCreatedByType() OwnerType {
+	if x != nil {
+		return x.CreatedByType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *CaptureHoldRequest) GetToAddress() string {
+	if x != nil && x.ToAddress != nil {
+		return *x.ToAddress
+	}
+	return ""
+}
+
+type CaptureHoldResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Hold           *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	JournalId      int64                  `protobuf:"varint,2,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	ReceiptCode    string                 `protobuf:"bytes,3,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
+	CapturedAmount string                 `protobuf:"bytes,4,opt,name=captured_amount,json=capturedAmount,proto3" json:"captured_amount,omitempty"` // NUMERIC as string
+	ReleasedAmount string                 `protobuf:"bytes,5,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount,omitempty"` // NUMERIC as string, returned to available balance
+	FeeAmount      string                 `protobuf:"bytes,6,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`                // NUMERIC as string
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CaptureHoldResponse) Reset() {
+	*x = CaptureHoldResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldResponse) ProtoMessage() {}
+
+func (x *CaptureHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldResponse.ProtoReflect.Descriptor instead.
+func (*CaptureHoldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CaptureHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *CaptureHoldResponse) GetJournalId() int64 {
+	if x != nil {
+		return x.JournalId
+	}
+	return 0
+}
+
+func (x *CaptureHoldResponse) GetReceiptCode() string {
+	if x != nil {
+		return x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *CaptureHoldResponse) GetCapturedAmount() string {
+	if x != nil {
+		return x.CapturedAmount
+	}
+	return ""
+}
+
+func (x *CaptureHoldResponse) GetReleasedAmount() string {
+	if x != nil {
+		return x.ReleasedAmount
+	}
+	return ""
+}
+
+func (x *CaptureHoldResponse) GetFeeAmount() string {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return ""
+}
+
+func (x *CaptureHoldResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ReleaseHoldRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	HoldRef              string                 `protobuf:"bytes,1,opt,name=hold_ref,json=holdRef,proto3" json:"hold_ref,omitempty"`
+	Reason               *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ReleasedByExternalId string                 `protobuf:"bytes,3,opt,name=released_by_external_id,json=releasedByExternalId,proto3" json:"released_by_external_id,omitempty"`
+	ReleasedByType       OwnerType              `protobuf:"varint,4,opt,name=released_by_type,json=releasedByType,proto3,enum=accounting.v1.OwnerType" json:"released_by_type,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ReleaseHoldRequest) Reset() {
+	*x = ReleaseHoldRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldRequest) ProtoMessage() {}
+
+func (x *ReleaseHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ReleaseHoldRequest) GetHoldRef() string {
+	if x != nil {
+		return x.HoldRef
+	}
+	return ""
+}
+
+func (x *ReleaseHoldRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ReleaseHoldRequest) GetReleasedByExternalId() string {
+	if x != nil {
+		return x.ReleasedByExternalId
+	}
+	return ""
+}
+
+func (x *ReleaseHoldRequest) GetReleasedByType() OwnerType {
+	if x != nil {
+		return x.ReleasedByType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+type ReleaseHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseHoldResponse) Reset() {
+	*x = ReleaseHoldResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldResponse) ProtoMessage() {}
+
+func (x *ReleaseHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ReleaseHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type ExtendHoldRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	HoldRef          string                 `protobuf:"bytes,1,opt,name=hold_ref,json=holdRef,proto3" json:"hold_ref,omitempty"`
+	ExpiresInSeconds *int64                 `protobuf:"varint,2,opt,name=expires_in_seconds,json=expiresInSeconds,proto3,oneof" json:"expires_in_seconds,omitempty"` // Empty = never expires, for holds whose capture is in flight
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExtendHoldRequest) Reset() {
+	*x = ExtendHoldRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendHoldRequest) ProtoMessage() {}
+
+func (x *ExtendHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendHoldRequest.ProtoReflect.Descriptor instead.
+func (*ExtendHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ExtendHoldRequest) GetHoldRef() string {
+	if x != nil {
+		return x.HoldRef
+	}
+	return ""
+}
+
+func (x *ExtendHoldRequest) GetExpiresInSeconds() int64 {
+	if x != nil && x.ExpiresInSeconds != nil {
+		return *x.ExpiresInSeconds
+	}
+	return 0
+}
+
+type ExtendHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendHoldResponse) Reset() {
+	*x = ExtendHoldResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendHoldResponse) ProtoMessage() {}
+
+func (x *ExtendHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendHoldResponse.ProtoReflect.Descriptor instead.
+func (*ExtendHoldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ExtendHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type ListHoldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber *string                `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3,oneof" json:"account_number,omitempty"`
+	Status        *HoldStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=accounting.v1.HoldStatus,oneof" json:"status,omitempty"`
+	HoldType      *string                `protobuf:"bytes,3,opt,name=hold_type,json=holdType,proto3,oneof" json:"hold_type,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHoldsRequest) Reset() {
+	*x = ListHoldsRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHoldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHoldsRequest) ProtoMessage() {}
+
+func (x *ListHoldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHoldsRequest.ProtoReflect.Descriptor instead.
+func (*ListHoldsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListHoldsRequest) GetAccountNumber() string {
+	if x != nil && x.AccountNumber != nil {
+		return *x.AccountNumber
+	}
+	return ""
+}
+
+func (x *ListHoldsRequest) GetStatus() HoldStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return HoldStatus_HOLD_STATUS_UNSPECIFIED
+}
+
+func (x *ListHoldsRequest) GetHoldType() string {
+	if x != nil && x.HoldType != nil {
+		return *x.HoldType
+	}
+	return ""
+}
+
+func (x *ListHoldsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListHoldsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListHoldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holds         []*Hold                `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHoldsResponse) Reset() {
+	*x = ListHoldsResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHoldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHoldsResponse) ProtoMessage() {}
+
+func (x *ListHoldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHoldsResponse.ProtoReflect.Descriptor instead.
+func (*ListHoldsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListHoldsResponse) GetHolds() []*Hold {
+	if x != nil {
+		return x.Holds
+	}
+	return nil
+}
+
+func (x *ListHoldsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ReconciliationRun struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Id                int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status            ReconciliationRunStatus `protobuf:"varint,2,opt,name=status,proto3,enum=accounting.v1.ReconciliationRunStatus" json:"status,omitempty"`
+	TriggerType       string                  `protobuf:"bytes,3,opt,name=trigger_type,json=triggerType,proto3" json:"trigger_type,omitempty"` // scheduled, manual
+	TriggeredBy       *string                 `protobuf:"bytes,4,opt,name=triggered_by,json=triggeredBy,proto3,oneof" json:"triggered_by,omitempty"`
+	Currency          *string                 `protobuf:"bytes,5,opt,name=currency,proto3,oneof" json:"currency,omitempty"`                                                          // Scope; empty = all currencies
+	AccountType       *AccountType            `protobuf:"varint,6,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType,oneof" json:"account_type,omitempty"` // Scope; empty = real and demo
+	JournalsFrom      *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=journals_from,json=journalsFrom,proto3" json:"journals_from,omitempty"`                                    // Journal checks cover journals created since
+	AccountsChecked   int64                   `protobuf:"varint,8,opt,name=accounts_checked,json=accountsChecked,proto3" json:"accounts_checked,omitempty"`
+	JournalsChecked   int64                   `protobuf:"varint,9,opt,name=journals_checked,json=journalsChecked,proto3" json:"journals_checked,omitempty"`
+	CurrenciesChecked int64                   `protobuf:"varint,10,opt,name=currencies_checked,json=currenciesChecked,proto3" json:"currencies_checked,omitempty"`
+	BreaksFound       int64                   `protobuf:"varint,11,opt,name=breaks_found,json=breaksFound,proto3" json:"breaks_found,omitempty"`
+	Error             *string                 `protobuf:"bytes,12,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	StartedAt         *timestamppb.Timestamp  `protobuf:"bytes,13,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt        *timestamppb.Timestamp  `protobuf:"bytes,14,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReconciliationRun) Reset() {
+	*x = ReconciliationRun{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationRun) ProtoMessage() {}
+
+func (x *ReconciliationRun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationRun.ProtoReflect.Descriptor instead.
+func (*ReconciliationRun) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ReconciliationRun) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetStatus() ReconciliationRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReconciliationRunStatus_RECONCILIATION_RUN_STATUS_UNSPECIFIED
+}
+
+func (x *ReconciliationRun) GetTriggerType() string {
+	if x != nil {
+		return x.TriggerType
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetTriggeredBy() string {
+	if x != nil && x.TriggeredBy != nil {
+		return *x.TriggeredBy
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetAccountType() AccountType {
+	if x != nil && x.AccountType != nil {
+		return *x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *ReconciliationRun) GetJournalsFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JournalsFrom
+	}
+	return nil
+}
+
+func (x *ReconciliationRun) GetAccountsChecked() int64 {
+	if x != nil {
+		return x.AccountsChecked
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetJournalsChecked() int64 {
+	if x != nil {
+		return x.JournalsChecked
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetCurrenciesChecked() int64 {
+	if x != nil {
+		return x.CurrenciesChecked
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetBreaksFound() int64 {
+	if x != nil {
+		return x.BreaksFound
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ReconciliationRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type ReconciliationBreak struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RunId         int64                  `protobuf:"varint,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	BreakType     string                 `protobuf:"bytes,3,opt,name=break_type,json=breakType,proto3" json:"break_type,omitempty"` // balance_mismatch, available_mismatch, journal_unbalanced, journal_no_entries, currency_imbalance
+	AccountId     *int64                 `protobuf:"varint,4,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	AccountNumber *string                `protobuf:"bytes,5,opt,name=account_number,json=accountNumber,proto3,oneof" json:"account_number,omitempty"`
+	AccountType   *AccountType           `protobuf:"varint,6,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType,oneof" json:"account_type,omitempty"`
+	JournalId     *int64                 `protobuf:"varint,7,opt,name=journal_id,json=journalId,proto3,oneof" json:"journal_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	Expected      string                 `protobuf:"bytes,9,opt,name=expected,proto3" json:"expected,omitempty"`      // NUMERIC as string
+	Actual        string                 `protobuf:"bytes,10,opt,name=actual,proto3" json:"actual,omitempty"`         // NUMERIC as string
+	Difference    string                 `protobuf:"bytes,11,opt,name=difference,proto3" json:"difference,omitempty"` // NUMERIC as string (actual - expected)
+	Details       map[string]string      `protobuf:"bytes,12,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Resolved      bool                   `protobuf:"varint,13,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconciliationBreak) Reset() {
+	*x = ReconciliationBreak{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationBreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationBreak) ProtoMessage() {}
+
+func (x *ReconciliationBreak) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationBreak.ProtoReflect.Descriptor instead.
+func (*ReconciliationBreak) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ReconciliationBreak) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReconciliationBreak) GetRunId() int64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *ReconciliationBreak) GetBreakType() string {
+	if x != nil {
+		return x.BreakType
+	}
+	return ""
+}
+
+func (x *ReconciliationBreak) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *ReconciliationBreak) GetAccountNumber() string {
+	if x != nil && x.AccountNumber != nil {
+		return *x.AccountNumber
+	}
+	return ""
+}
+
+func (x *ReconciliationBreak) GetAccountType() AccountType {
+	if x != nil && x.AccountType != nil {
+		return *x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *ReconciliationBreak) GetJournalId() int64 {
+	if x != nil && x.JournalId != nil {
+		return *x.JournalId
+	}
+	return 0
+}
+
+func (x *ReconciliationBreak) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ReconciliationBreak) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *ReconciliationBreak) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+func (x *ReconciliationBreak) GetDifference() string {
+	if x != nil {
+		return x.Difference
+	}
+	return ""
+}
+
+func (x *ReconciliationBreak) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *ReconciliationBreak) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+func (x *ReconciliationBreak) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type RunReconciliationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      *string                `protobuf:"bytes,1,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	AccountType   *AccountType           `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType,oneof" json:"account_type,omitempty"`
+	JournalsFrom  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=journals_from,json=journalsFrom,proto3,oneof" json:"journals_from,omitempty"` // Defaults to 24h ago
+	TriggeredBy   string                 `protobuf:"bytes,4,opt,name=triggered_by,json=triggeredBy,proto3" json:"triggered_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunReconciliationRequest) Reset() {
+	*x = RunReconciliationRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunReconciliationRequest) ProtoMessage() {}
+
+func (x *RunReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*RunReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *RunReconciliationRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *RunReconciliationRequest) GetAccountType() AccountType {
+	if x != nil && x.AccountType != nil {
+		return *x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *RunReconciliationRequest) GetJournalsFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JournalsFrom
+	}
+	return nil
+}
+
+func (x *RunReconciliationRequest) GetTriggeredBy() string {
+	if x != nil {
+		return x.TriggeredBy
+	}
+	return ""
+}
+
+type RunReconciliationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *ReconciliationRun     `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunReconciliationResponse) Reset() {
+	*x = RunReconciliationResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunReconciliationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunReconciliationResponse) ProtoMessage() {}
+
+func (x *RunReconciliationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunReconciliationResponse.ProtoReflect.Descriptor instead.
+func (*RunReconciliationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *RunReconciliationResponse) GetRun() *ReconciliationRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type ListReconciliationBreaksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         *int64                 `protobuf:"varint,1,opt,name=run_id,json=runId,proto3,oneof" json:"run_id,omitempty"` // Empty = latest run
+	BreakType     *string                `protobuf:"bytes,2,opt,name=break_type,json=breakType,proto3,oneof" json:"break_type,omitempty"`
+	Currency      *string                `protobuf:"bytes,3,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	Resolved      *bool                  `protobuf:"varint,4,opt,name=resolved,proto3,oneof" json:"resolved,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationBreaksRequest) Reset() {
+	*x = ListReconciliationBreaksRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationBreaksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationBreaksRequest) ProtoMessage() {}
+
+func (x *ListReconciliationBreaksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationBreaksRequest.ProtoReflect.Descriptor instead.
+func (*ListReconciliationBreaksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListReconciliationBreaksRequest) GetRunId() int64 {
+	if x != nil && x.RunId != nil {
+		return *x.RunId
+	}
+	return 0
+}
+
+func (x *ListReconciliationBreaksRequest) GetBreakType() string {
+	if x != nil && x.BreakType != nil {
+		return *x.BreakType
+	}
+	return ""
+}
+
+func (x *ListReconciliationBreaksRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *ListReconciliationBreaksRequest) GetResolved() bool {
+	if x != nil && x.Resolved != nil {
+		return *x.Resolved
+	}
+	return false
+}
+
+func (x *ListReconciliationBreaksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListReconciliationBreaksRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListReconciliationBreaksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Breaks        []*ReconciliationBreak `protobuf:"bytes,1,rep,name=breaks,proto3" json:"breaks,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Run           *ReconciliationRun     `protobuf:"bytes,3,opt,name=run,proto3,oneof" json:"run,omitempty"` // The run the breaks belong to, when run_id was resolved
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationBreaksResponse) Reset() {
+	*x = ListReconciliationBreaksResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationBreaksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationBreaksResponse) ProtoMessage() {}
+
+func (x *ListReconciliationBreaksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationBreaksResponse.ProtoReflect.Descriptor instead.
+func (*ListReconciliationBreaksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ListReconciliationBreaksResponse) GetBreaks() []*ReconciliationBreak {
+	if x != nil {
+		return x.Breaks
+	}
+	return nil
+}
+
+func (x *ListReconciliationBreaksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListReconciliationBreaksResponse) GetRun() *ReconciliationRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type QueuedTransaction struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Id              int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReceiptCode     string                  `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
+	IdempotencyKey  string                  `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	OrderingKey     string                  `protobuf:"bytes,4,opt,name=ordering_key,json=orderingKey,proto3" json:"ordering_key,omitempty"` // Paying account number
+	TransactionType TransactionType         `protobuf:"varint,5,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
+	Description     string                  `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Status          QueuedTransactionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=accounting.v1.QueuedTransactionStatus" json:"status,omitempty"`
+	Attempts        int32                   `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	MaxAttempts     int32                   `protobuf:"varint,9,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	LastError       *string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	JournalId       *int64                  `protobuf:"varint,11,opt,name=journal_id,json=journalId,proto3,oneof" json:"journal_id,omitempty"`
+	AvailableAt     *timestamppb.Timestamp  `protobuf:"bytes,12,opt,name=available_at,json=availableAt,proto3" json:"available_at,omitempty"`
+	CreatedAt       *timestamppb.Timestamp  `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp  `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt     *timestamppb.Timestamp  `protobuf:"bytes,15,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QueuedTransaction) Reset() {
+	*x = QueuedTransaction{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuedTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedTransaction) ProtoMessage() {}
+
+func (x *QueuedTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedTransaction.ProtoReflect.Descriptor instead.
+func (*QueuedTransaction) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *QueuedTransaction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QueuedTransaction) GetReceiptCode() string {
+	if x != nil {
+		return x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *QueuedTransaction) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *QueuedTransaction) GetOrderingKey() string {
+	if x != nil {
+		return x.OrderingKey
+	}
+	return ""
+}
+
+func (x *QueuedTransaction) GetTransactionType() TransactionType {
+	if x != nil {
+		return x.TransactionType
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *QueuedTransaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QueuedTransaction) GetStatus() QueuedTransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_UNSPECIFIED
+}
+
+func (x *QueuedTransaction) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *QueuedTransaction) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *QueuedTransaction) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *QueuedTransaction) GetJournalId() int64 {
+	if x != nil && x.JournalId != nil {
+		return *x.JournalId
+	}
+	return 0
+}
+
+func (x *QueuedTransaction) GetAvailableAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AvailableAt
+	}
+	return nil
+}
+
+func (x *QueuedTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *QueuedTransaction) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *QueuedTransaction) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type ListQueuedTransactionsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Status        *QueuedTransactionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=accounting.v1.QueuedTransactionStatus,oneof" json:"status,omitempty"` // Defaults to DEAD
+	ReceiptCode   *string                  `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3,oneof" json:"receipt_code,omitempty"`
+	Limit         int32                    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuedTransactionsRequest) Reset() {
+	*x = ListQueuedTransactionsRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuedTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuedTransactionsRequest) ProtoMessage() {}
+
+func (x *ListQueuedTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuedTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuedTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListQueuedTransactionsRequest) GetStatus() QueuedTransactionStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return QueuedTransactionStatus_QUEUED_TRANSACTION_STATUS_UNSPECIFIED
+}
+
+func (x *ListQueuedTransactionsRequest) GetReceiptCode() string {
+	if x != nil && x.ReceiptCode != nil {
+		return *x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *ListQueuedTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListQueuedTransactionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListQueuedTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*QueuedTransaction   `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuedTransactionsResponse) Reset() {
+	*x = ListQueuedTransactionsResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuedTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuedTransactionsResponse) ProtoMessage() {}
+
+func (x *ListQueuedTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuedTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuedTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListQueuedTransactionsResponse) GetTransactions() []*QueuedTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListQueuedTransactionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RedriveDeadLetterTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedriveDeadLetterTransactionsRequest) Reset() {
+	*x = RedriveDeadLetterTransactionsRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedriveDeadLetterTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedriveDeadLetterTransactionsRequest) ProtoMessage() {}
+
+func (x *RedriveDeadLetterTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedriveDeadLetterTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*RedriveDeadLetterTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *RedriveDeadLetterTransactionsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *RedriveDeadLetterTransactionsRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type RedriveDeadLetterTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*QueuedTransaction   `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"` // Re-queued entries; ids that were not dead are skipped
+	Redriven      int32                  `protobuf:"varint,2,opt,name=redriven,proto3" json:"redriven,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedriveDeadLetterTransactionsResponse) Reset() {
+	*x = RedriveDeadLetterTransactionsResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedriveDeadLetterTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedriveDeadLetterTransactionsResponse) ProtoMessage() {}
+
+func (x *RedriveDeadLetterTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedriveDeadLetterTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*RedriveDeadLetterTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *RedriveDeadLetterTransactionsResponse) GetTransactions() []*QueuedTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *RedriveDeadLetterTransactionsResponse) GetRedriven() int32 {
+	if x != nil {
+		return x.Redriven
+	}
+	return 0
+}
+
+type SkipDeadLetterTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipDeadLetterTransactionsRequest) Reset() {
+	*x = SkipDeadLetterTransactionsRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipDeadLetterTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipDeadLetterTransactionsRequest) ProtoMessage() {}
+
+func (x *SkipDeadLetterTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipDeadLetterTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*SkipDeadLetterTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SkipDeadLetterTransactionsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *SkipDeadLetterTransactionsRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type SkipDeadLetterTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*QueuedTransaction   `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"` // Skipped entries; ids that were not dead are ignored
+	Skipped       int32                  `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipDeadLetterTransactionsResponse) Reset() {
+	*x = SkipDeadLetterTransactionsResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipDeadLetterTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipDeadLetterTransactionsResponse) ProtoMessage() {}
+
+func (x *SkipDeadLetterTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipDeadLetterTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*SkipDeadLetterTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *SkipDeadLetterTransactionsResponse) GetTransactions() []*QueuedTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *SkipDeadLetterTransactionsResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type AccountingPeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"` // Exclusive
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                        // closing, closed
+	ClosedBy      string                 `protobuf:"bytes,5,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	Note          *string                `protobuf:"bytes,6,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	AccountsCount int64                  `protobuf:"varint,7,opt,name=accounts_count,json=accountsCount,proto3" json:"accounts_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=closed_at,json=closedAt,proto3,oneof" json:"closed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountingPeriod) Reset() {
+	*x = AccountingPeriod{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountingPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountingPeriod) ProtoMessage() {}
+
+func (x *AccountingPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountingPeriod.ProtoReflect.Descriptor instead.
+func (*AccountingPeriod) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *AccountingPeriod) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccountingPeriod) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *AccountingPeriod) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *AccountingPeriod) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetAccountsCount() int64 {
+	if x != nil {
+		return x.AccountsCount
+	}
+	return 0
+}
+
+func (x *AccountingPeriod) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AccountingPeriod) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+type CloseAccountingPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // Must equal the previous period's end
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`       // Exclusive, must not be in the future
+	ClosedBy      string                 `protobuf:"bytes,3,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	Note          *string                `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseAccountingPeriodRequest) Reset() {
+	*x = CloseAccountingPeriodRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseAccountingPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAccountingPeriodRequest) ProtoMessage() {}
+
+func (x *CloseAccountingPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAccountingPeriodRequest.ProtoReflect.Descriptor instead.
+func (*CloseAccountingPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CloseAccountingPeriodRequest) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *CloseAccountingPeriodRequest) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *CloseAccountingPeriodRequest) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
+}
+
+func (x *CloseAccountingPeriodRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type CloseAccountingPeriodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        *AccountingPeriod      `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseAccountingPeriodResponse) Reset() {
+	*x = CloseAccountingPeriodResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseAccountingPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAccountingPeriodResponse) ProtoMessage() {}
+
+func (x *CloseAccountingPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAccountingPeriodResponse.ProtoReflect.Descriptor instead.
+func (*CloseAccountingPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CloseAccountingPeriodResponse) GetPeriod() *AccountingPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+type TrialBalanceLine struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Purpose        AccountPurpose         `protobuf:"varint,1,opt,name=purpose,proto3,enum=accounting.v1.AccountPurpose" json:"purpose,omitempty"`
+	Currency       string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	AccountType    AccountType            `protobuf:"varint,3,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
+	AccountCount   int64                  `protobuf:"varint,4,opt,name=account_count,json=accountCount,proto3" json:"account_count,omitempty"`
+	OpeningBalance string                 `protobuf:"bytes,5,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"` // NUMERIC as string
+	TotalDebits    string                 `protobuf:"bytes,6,opt,name=total_debits,json=totalDebits,proto3" json:"total_debits,omitempty"`
+	TotalCredits   string                 `protobuf:"bytes,7,opt,name=total_credits,json=totalCredits,proto3" json:"total_credits,omitempty"`
+	ClosingBalance string                 `protobuf:"bytes,8,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TrialBalanceLine) Reset() {
+	*x = TrialBalanceLine{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrialBalanceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrialBalanceLine) ProtoMessage() {}
+
+func (x *TrialBalanceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrialBalanceLine.ProtoReflect.Descriptor instead.
+func (*TrialBalanceLine) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *TrialBalanceLine) GetPurpose() AccountPurpose {
+	if x != nil {
+		return x.Purpose
+	}
+	return AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED
+}
+
+func (x *TrialBalanceLine) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TrialBalanceLine) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *TrialBalanceLine) GetAccountCount() int64 {
+	if x != nil {
+		return x.AccountCount
+	}
+	return 0
+}
+
+func (x *TrialBalanceLine) GetOpeningBalance() string {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return ""
+}
+
+func (x *TrialBalanceLine) GetTotalDebits() string {
+	if x != nil {
+		return x.TotalDebits
+	}
+	return ""
+}
+
+func (x *TrialBalanceLine) GetTotalCredits() string {
+	if x != nil {
+		return x.TotalCredits
+	}
+	return ""
+}
+
+func (x *TrialBalanceLine) GetClosingBalance() string {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return ""
+}
+
+type TrialBalanceTotal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Currency       string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	OpeningBalance string                 `protobuf:"bytes,2,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"` // NUMERIC as string
+	TotalDebits    string                 `protobuf:"bytes,3,opt,name=total_debits,json=totalDebits,proto3" json:"total_debits,omitempty"`
+	TotalCredits   string                 `protobuf:"bytes,4,opt,name=total_credits,json=totalCredits,proto3" json:"total_credits,omitempty"`
+	ClosingBalance string                 `protobuf:"bytes,5,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TrialBalanceTotal) Reset() {
+	*x = TrialBalanceTotal{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrialBalanceTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrialBalanceTotal) ProtoMessage() {}
+
+func (x *TrialBalanceTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrialBalanceTotal.ProtoReflect.Descriptor instead.
+func (*TrialBalanceTotal) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *TrialBalanceTotal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TrialBalanceTotal) GetOpeningBalance() string {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return ""
+}
+
+func (x *TrialBalanceTotal) GetTotalDebits() string {
+	if x != nil {
+		return x.TotalDebits
+	}
+	return ""
+}
+
+func (x *TrialBalanceTotal) GetTotalCredits() string {
+	if x != nil {
+		return x.TotalCredits
+	}
+	return ""
+}
+
+func (x *TrialBalanceTotal) GetClosingBalance() string {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return ""
+}
+
+type GetTrialBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodId      *int64                 `protobuf:"varint,1,opt,name=period_id,json=periodId,proto3,oneof" json:"period_id,omitempty"` // Closed period snapshot
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3,oneof" json:"from,omitempty"`                          // Defaults to start of month
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3,oneof" json:"to,omitempty"`                              // Defaults to now
+	AccountType   *AccountType           `protobuf:"varint,4,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType,oneof" json:"account_type,omitempty"`
+	Currency      *string                `protobuf:"bytes,5,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	Purpose       *AccountPurpose        `protobuf:"varint,6,opt,name=purpose,proto3,enum=accounting.v1.AccountPurpose,oneof" json:"purpose,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrialBalanceRequest) Reset() {
+	*x = GetTrialBalanceRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrialBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrialBalanceRequest) ProtoMessage() {}
+
+func (x *GetTrialBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrialBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetTrialBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetTrialBalanceRequest) GetPeriodId() int64 {
+	if x != nil && x.PeriodId != nil {
+		return *x.PeriodId
+	}
+	return 0
+}
+
+func (x *GetTrialBalanceRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetTrialBalanceRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetTrialBalanceRequest) GetAccountType() AccountType {
+	if x != nil && x.AccountType != nil {
+		return *x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *GetTrialBalanceRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *GetTrialBalanceRequest) GetPurpose() AccountPurpose {
+	if x != nil && x.Purpose != nil {
+		return *x.Purpose
+	}
+	return AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED
+}
+
+type GetTrialBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*TrialBalanceLine    `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	Totals        []*TrialBalanceTotal   `protobuf:"bytes,2,rep,name=totals,proto3" json:"totals,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Period        *AccountingPeriod      `protobuf:"bytes,5,opt,name=period,proto3,oneof" json:"period,omitempty"` // Set when served from a closed period
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrialBalanceResponse) Reset() {
+	*x = GetTrialBalanceResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrialBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrialBalanceResponse) ProtoMessage() {}
+
+func (x *GetTrialBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrialBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetTrialBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetTrialBalanceResponse) GetLines() []*TrialBalanceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *GetTrialBalanceResponse) GetTotals() []*TrialBalanceTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *GetTrialBalanceResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetTrialBalanceResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetTrialBalanceResponse) GetPeriod() *AccountingPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+type GeneralLedgerEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LedgerId        int64                  `protobuf:"varint,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	JournalId       int64                  `protobuf:"varint,2,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	AccountNumber   string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Purpose         AccountPurpose         `protobuf:"varint,4,opt,name=purpose,proto3,enum=accounting.v1.AccountPurpose" json:"purpose,omitempty"`
+	AccountType     AccountType            `protobuf:"varint,5,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
+	Currency        string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	DrCr            DrCr                   `protobuf:"varint,7,opt,name=dr_cr,json=drCr,proto3,enum=accounting.v1.DrCr" json:"dr_cr,omitempty"`
+	Amount          string                 `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
+	BalanceAfter    *string                `protobuf:"bytes,9,opt,name=balance_after,json=balanceAfter,proto3,oneof" json:"balance_after,omitempty"`
+	ReceiptCode     *string                `protobuf:"bytes,10,opt,name=receipt_code,json=receiptCode,proto3,oneof" json:"receipt_code,omitempty"`
+	Description     *string                `protobuf:"bytes,11,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	TransactionType TransactionType        `protobuf:"varint,12,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GeneralLedgerEntry) Reset() {
+	*x = GeneralLedgerEntry{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneralLedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneralLedgerEntry) ProtoMessage() {}
+
+func (x *GeneralLedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneralLedgerEntry.ProtoReflect.Descriptor instead.
+func (*GeneralLedgerEntry) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GeneralLedgerEntry) GetLedgerId() int64 {
+	if x != nil {
+		return x.LedgerId
+	}
+	return 0
+}
+
+func (x *GeneralLedgerEntry) GetJournalId() int64 {
+	if x != nil {
+		return x.JournalId
+	}
+	return 0
+}
+
+func (x *GeneralLedgerEntry) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *GeneralLedgerEntry) GetPurpose() AccountPurpose {
+	if x != nil {
+		return x.Purpose
+	}
+	return AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED
+}
+
+func (x *GeneralLedgerEntry) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *GeneralLedgerEntry) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GeneralLedgerEntry) GetDrCr() DrCr {
+	if x != nil {
+		return x.DrCr
+	}
+	return DrCr_DR_CR_UNSPECIFIED
+}
+
+func (x *GeneralLedgerEntry) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *GeneralLedgerEntry) GetBalanceAfter() string {
+	if x != nil && x.BalanceAfter != nil {
+		return *x.BalanceAfter
+	}
+	return ""
+}
+
+func (x *GeneralLedgerEntry) GetReceiptCode() string {
+	if x != nil && x.ReceiptCode != nil {
+		return *x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *GeneralLedgerEntry) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *GeneralLedgerEntry) GetTransactionType() TransactionType {
+	if x != nil {
+		return x.TransactionType
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *GeneralLedgerEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetGeneralLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Purpose       *AccountPurpose        `protobuf:"varint,3,opt,name=purpose,proto3,enum=accounting.v1.AccountPurpose,oneof" json:"purpose,omitempty"`
+	Currency      *string                `protobuf:"bytes,4,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	AccountType   *AccountType           `protobuf:"varint,5,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType,oneof" json:"account_type,omitempty"`
+	AccountNumber *string                `protobuf:"bytes,6,opt,name=account_number,json=accountNumber,proto3,oneof" json:"account_number,omitempty"`
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGeneralLedgerRequest) Reset() {
+	*x = GetGeneralLedgerRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGeneralLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGeneralLedgerRequest) ProtoMessage() {}
+
+func (x *GetGeneralLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGeneralLedgerRequest.ProtoReflect.Descriptor instead.
+func (*GetGeneralLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetGeneralLedgerRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetGeneralLedgerRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetGeneralLedgerRequest) GetPurpose() AccountPurpose {
+	if x != nil && x.Purpose != nil {
+		return *x.Purpose
+	}
+	return AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED
+}
+
+func (x *GetGeneralLedgerRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *GetGeneralLedgerRequest) GetAccountType() AccountType {
+	if x != nil && x.AccountType != nil {
+		return *x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *GetGeneralLedgerRequest) GetAccountNumber() string {
+	if x != nil && x.AccountNumber != nil {
+		return *x.AccountNumber
+	}
+	return ""
+}
+
+func (x *GetGeneralLedgerRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetGeneralLedgerRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetGeneralLedgerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sections      []*TrialBalanceLine    `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"` // Totals per purpose / currency / account type
+	Entries       []*GeneralLedgerEntry  `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	TotalEntries  int32                  `protobuf:"varint,3,opt,name=total_entries,json=totalEntries,proto3" json:"total_entries,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGeneralLedgerResponse) Reset() {
+	*x = GetGeneralLedgerResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGeneralLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGeneralLedgerResponse) ProtoMessage() {}
+
+func (x *GetGeneralLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGeneralLedgerResponse.ProtoReflect.Descriptor instead.
+func (*GetGeneralLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetGeneralLedgerResponse) GetSections() []*TrialBalanceLine {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *GetGeneralLedgerResponse) GetEntries() []*GeneralLedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetGeneralLedgerResponse) GetTotalEntries() int32 {
+	if x != nil {
+		return x.TotalEntries
+	}
+	return 0
+}
+
+func (x *GetGeneralLedgerResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetGeneralLedgerResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type TransactionApproval struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RequestedBy     int64                  `protobuf:"varint,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	TransactionType TransactionType        `protobuf:"varint,3,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
+	AccountNumber   string                 `protobuf:"bytes,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Amount          string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
+	Currency        string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Description     string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Status          ApprovalStatus         `protobuf:"varint,8,opt,name=status,proto3,enum=accounting.v1.ApprovalStatus" json:"status,omitempty"`
+	ApprovedBy      *int64                 `protobuf:"varint,9,opt,name=approved_by,json=approvedBy,proto3,oneof" json:"approved_by,omitempty"`
+	RejectionReason *string                `protobuf:"bytes,10,opt,name=rejection_reason,json=rejectionReason,proto3,oneof" json:"rejection_reason,omitempty"`
+	ReceiptCode     *string                `protobuf:"bytes,11,opt,name=receipt_code,json=receiptCode,proto3,oneof" json:"receipt_code,omitempty"`
+	ToAccountNumber *string                `protobuf:"bytes,12,opt,name=to_account_number,json=toAccountNumber,proto3,oneof" json:"to_account_number,omitempty"` // For transfers
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Policy snapshot taken when the request was created
+	PolicyId          *int64                 `protobuf:"varint,15,opt,name=policy_id,json=policyId,proto3,oneof" json:"policy_id,omitempty"`
+	RequiredApprovals int32                  `protobuf:"varint,16,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	ApproverRoles     []string               `protobuf:"bytes,17,rep,name=approver_roles,json=approverRoles,proto3" json:"approver_roles,omitempty"` // Empty = any admin role
+	DistinctRoles     bool                   `protobuf:"varint,18,opt,name=distinct_roles,json=distinctRoles,proto3" json:"distinct_roles,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	Decisions         []*ApprovalDecision    `protobuf:"bytes,20,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	// Execution
+	ExecutionAttempts int32                  `protobuf:"varint,21,opt,name=execution_attempts,json=executionAttempts,proto3" json:"execution_attempts,omitempty"`
+	ErrorMessage      *string                `protobuf:"bytes,22,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	ExecutedAt        *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=executed_at,json=executedAt,proto3,oneof" json:"executed_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TransactionApproval) Reset() {
+	*x = TransactionApproval{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionApproval) ProtoMessage() {}
+
+func (x *TransactionApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionApproval.ProtoReflect.Descriptor instead.
+func (*TransactionApproval) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *TransactionApproval) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransactionApproval) GetRequestedBy() int64 {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return 0
+}
+
+func (x *TransactionApproval) GetTransactionType() TransactionType {
+	if x != nil {
+		return x.TransactionType
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *TransactionApproval) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *TransactionApproval) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TransactionApproval) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TransactionApproval) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TransactionApproval) GetStatus() ApprovalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED
+}
+
+func (x *TransactionApproval) GetApprovedBy() int64 {
+	if x != nil && x.ApprovedBy != nil {
+		return *x.ApprovedBy
+	}
+	return 0
+}
+
+func (x *TransactionApproval) GetRejectionReason() string {
+	if x != nil && x.RejectionReason != nil {
+		return *x.RejectionReason
+	}
+	return ""
+}
+
+func (x *TransactionApproval) GetReceiptCode() string {
+	if x != nil && x.ReceiptCode != nil {
+		return *x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *TransactionApproval) GetToAccountNumber() string {
+	if x != nil && x.ToAccountNumber != nil {
+		return *x.ToAccountNumber
+	}
+	return ""
+}
+
+func (x *TransactionApproval) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TransactionApproval) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TransactionApproval) GetPolicyId() int64 {
+	if x != nil && x.PolicyId != nil {
+		return *x.PolicyId
+	}
+	return 0
+}
+
+func (x *TransactionApproval) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *TransactionApproval) GetApproverRoles() []string {
+	if x != nil {
+		return x.ApproverRoles
+	}
+	return nil
+}
+
+func (x *TransactionApproval) GetDistinctRoles() bool {
+	if x != nil {
+		return x.DistinctRoles
+	}
+	return false
+}
+
+func (x *TransactionApproval) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *TransactionApproval) GetDecisions() []*ApprovalDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+func (x *TransactionApproval) GetExecutionAttempts() int32 {
+	if x != nil {
+		return x.ExecutionAttempts
+	}
+	return 0
+}
+
+func (x *TransactionApproval) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *TransactionApproval) GetExecutedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecutedAt
+	}
+	return nil
+}
+
+type ApprovalDecision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApproverId    int64                  `protobuf:"varint,1,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	ApproverRole  string                 `protobuf:"bytes,2,opt,name=approver_role,json=approverRole,proto3" json:"approver_role,omitempty"`
+	Approved      bool                   `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	Reason        *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalDecision) Reset() {
+	*x = ApprovalDecision{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalDecision) ProtoMessage() {}
+
+func (x *ApprovalDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalDecision.ProtoReflect.Descriptor instead.
+func (*ApprovalDecision) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ApprovalDecision) GetApproverId() int64 {
+	if x != nil {
+		return x.ApproverId
+	}
+	return 0
+}
+
+func (x *ApprovalDecision) GetApproverRole() string {
+	if x != nil {
+		return x.ApproverRole
+	}
+	return ""
+}
+
+func (x *ApprovalDecision) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *ApprovalDecision) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ApprovalDecision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateTransactionApprovalRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RequestedBy     int64                  `protobuf:"varint,1,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	TransactionType TransactionType        `protobuf:"varint,2,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
+	AccountNumber   string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Amount          string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
+	Currency        string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Description     string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	ToAccountNumber *string                `protobuf:"bytes,7,opt,name=to_account_number,json=toAccountNumber,proto3,oneof" json:"to_account_number,omitempty"` // For transfers and conversion
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTransactionApprovalRequest) Reset() {
+	*x = CreateTransactionApprovalRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransactionApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransactionApprovalRequest) ProtoMessage() {}
+
+func (x *CreateTransactionApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransactionApprovalRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransactionApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *CreateTransactionApprovalRequest) GetRequestedBy() int64 {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return 0
+}
+
+func (x *CreateTransactionApprovalRequest) GetTransactionType() TransactionType {
+	if x != nil {
+		return x.TransactionType
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *CreateTransactionApprovalRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *CreateTransactionApprovalRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreateTransactionApprovalRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateTransactionApprovalRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTransactionApprovalRequest) GetToAccountNumber() string {
+	if x != nil && x.ToAccountNumber != nil {
+		return *x.ToAccountNumber
+	}
+	return ""
+}
+
+type CreateTransactionApprovalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approval      *TransactionApproval   `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransactionApprovalResponse) Reset() {
+	*x = CreateTransactionApprovalResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransactionApprovalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransactionApprovalResponse) ProtoMessage() {}
+
+func (x *CreateTransactionApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransactionApprovalResponse.ProtoReflect.Descriptor instead.
+func (*CreateTransactionApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *CreateTransactionApprovalResponse) GetApproval() *TransactionApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *CreateTransactionApprovalResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetPendingApprovalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPendingApprovalsRequest) Reset() {
+	*x = GetPendingApprovalsRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPendingApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPendingApprovalsRequest) ProtoMessage() {}
+
+func (x *GetPendingApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPendingApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*GetPendingApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GetPendingApprovalsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetPendingApprovalsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type GetPendingApprovalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approvals     []*TransactionApproval `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPendingApprovalsResponse) Reset() {
+	*x = GetPendingApprovalsResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPendingApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPendingApprovalsResponse) ProtoMessage() {}
+
+func (x *GetPendingApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPendingApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*GetPendingApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GetPendingApprovalsResponse) GetApprovals() []*TransactionApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *GetPendingApprovalsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ApproveTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int64                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ApprovedBy    int64                  `protobuf:"varint,2,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	Approved      bool                   `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	Reason        *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ApproverRole  string                 `protobuf:"bytes,5,opt,name=approver_role,json=approverRole,proto3" json:"approver_role,omitempty"` // Checked against the request's approver_roles
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTransactionRequest) Reset() {
+	*x = ApproveTransactionRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTransactionRequest) ProtoMessage() {}
+
+func (x *ApproveTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ApproveTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ApproveTransactionRequest) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *ApproveTransactionRequest) GetApprovedBy() int64 {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return 0
+}
+
+func (x *ApproveTransactionRequest) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *ApproveTransactionRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ApproveTransactionRequest) GetApproverRole() string {
+	if x != nil {
+		return x.ApproverRole
+	}
+	return ""
+}
+
+type ApproveTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approval      *TransactionApproval   `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+	ReceiptCode   *string                `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3,oneof" json:"receipt_code,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTransactionResponse) Reset() {
+	*x = ApproveTransactionResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTransactionResponse) ProtoMessage() {}
+
+func (x *ApproveTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTransactionResponse.ProtoReflect.Descriptor instead.
+func (*ApproveTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ApproveTransactionResponse) GetApproval() *TransactionApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *ApproveTransactionResponse) GetReceiptCode() string {
+	if x != nil && x.ReceiptCode != nil {
+		return *x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *ApproveTransactionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetApprovalHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestedBy   *int64                 `protobuf:"varint,1,opt,name=requested_by,json=requestedBy,proto3,oneof" json:"requested_by,omitempty"`
+	Status        *ApprovalStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=accounting.v1.ApprovalStatus,oneof" json:"status,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApprovalHistoryRequest) Reset() {
+	*x = GetApprovalHistoryRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApprovalHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApprovalHistoryRequest) ProtoMessage() {}
+
+func (x *GetApprovalHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApprovalHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetApprovalHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetApprovalHistoryRequest) GetRequestedBy() int64 {
+	if x != nil && x.RequestedBy != nil {
+		return *x.RequestedBy
+	}
+	return 0
+}
+
+func (x *GetApprovalHistoryRequest) GetStatus() ApprovalStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED
+}
+
+func (x *GetApprovalHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetApprovalHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetApprovalHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetApprovalHistoryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetApprovalHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approvals     []*TransactionApproval `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApprovalHistoryResponse) Reset() {
+	*x = GetApprovalHistoryResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApprovalHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApprovalHistoryResponse) ProtoMessage() {}
+
+func (x *GetApprovalHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApprovalHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetApprovalHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GetApprovalHistoryResponse) GetApprovals() []*TransactionApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *GetApprovalHistoryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ApprovalPolicy struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TransactionType     *TransactionType       `protobuf:"varint,3,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType,oneof" json:"transaction_type,omitempty"` // Empty = any type
+	Currency            *string                `protobuf:"bytes,4,opt,name=currency,proto3,oneof" json:"currency,omitempty"`                                                                          // Empty = any currency
+	MinAmount           string                 `protobuf:"bytes,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`                                                             // NUMERIC as string, inclusive
+	MaxAmount           *string                `protobuf:"bytes,6,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`                                                       // NUMERIC as string, exclusive; empty = unbounded
+	RequiredApprovals   int32                  `protobuf:"varint,7,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	ApproverRoles       []string               `protobuf:"bytes,8,rep,name=approver_roles,json=approverRoles,proto3" json:"approver_roles,omitempty"`                       // Empty = any admin role
+	DistinctRoles       bool                   `protobuf:"varint,9,opt,name=distinct_roles,json=distinctRoles,proto3" json:"distinct_roles,omitempty"`                      // Each approval must come from a different role
+	ExpiresAfterSeconds int64                  `protobuf:"varint,10,opt,name=expires_after_seconds,json=expiresAfterSeconds,proto3" json:"expires_after_seconds,omitempty"` // 0 = never
+	Priority            int32                  `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`                                                    // Tie-breaker between equally specific policies
+	IsActive            bool                   `protobuf:"varint,12,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedBy           string                 `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ApprovalPolicy) Reset() {
+	*x = ApprovalPolicy{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalPolicy) ProtoMessage() {}
+
+func (x *ApprovalPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalPolicy.ProtoReflect.Descriptor instead.
+func (*ApprovalPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ApprovalPolicy) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApprovalPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApprovalPolicy) GetTransactionType() TransactionType {
+	if x != nil && x.TransactionType != nil {
+		return *x.TransactionType
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ApprovalPolicy) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *ApprovalPolicy) GetMinAmount() string {
+	if x != nil {
+		return x.MinAmount
+	}
+	return ""
+}
+
+func (x *ApprovalPolicy) GetMaxAmount() string {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return ""
+}
+
+func (x *ApprovalPolicy) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *ApprovalPolicy) GetApproverRoles() []string {
+	if x != nil {
+		return x.ApproverRoles
+	}
+	return nil
+}
+
+func (x *ApprovalPolicy) GetDistinctRoles() bool {
+	if x != nil {
+		return x.DistinctRoles
+	}
+	return false
+}
+
+func (x *ApprovalPolicy) GetExpiresAfterSeconds() int64 {
+	if x != nil {
+		return x.ExpiresAfterSeconds
+	}
+	return 0
+}
+
+func (x *ApprovalPolicy) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ApprovalPolicy) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ApprovalPolicy) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ApprovalPolicy) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApprovalPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateApprovalPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *ApprovalPolicy        `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // id, created_* and updated_at are ignored
+	CreatedBy     string                 `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApprovalPolicyRequest) Reset() {
+	*x = CreateApprovalPolicyRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApprovalPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApprovalPolicyRequest) ProtoMessage() {}
+
+func (x *CreateApprovalPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApprovalPolicyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApprovalPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *CreateApprovalPolicyRequest) GetPolicy() *ApprovalPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *CreateApprovalPolicyRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateApprovalPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *ApprovalPolicy        `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApprovalPolicyResponse) Reset() {
+	*x = CreateApprovalPolicyResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApprovalPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApprovalPolicyResponse) ProtoMessage() {}
+
+func (x *CreateApprovalPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApprovalPolicyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApprovalPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *CreateApprovalPolicyResponse) GetPolicy() *ApprovalPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type UpdateApprovalPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *ApprovalPolicy        `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // Full replacement; id is required
+	UpdatedBy     string                 `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApprovalPolicyRequest) Reset() {
+	*x = UpdateApprovalPolicyRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApprovalPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApprovalPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateApprovalPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApprovalPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApprovalPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *UpdateApprovalPolicyRequest) GetPolicy() *ApprovalPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *UpdateApprovalPolicyRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type UpdateApprovalPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *ApprovalPolicy        `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApprovalPolicyResponse) Reset() {
+	*x = UpdateApprovalPolicyResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApprovalPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApprovalPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateApprovalPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApprovalPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateApprovalPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *UpdateApprovalPolicyResponse) GetPolicy() *ApprovalPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type ListApprovalPoliciesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionType *TransactionType       `protobuf:"varint,1,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType,oneof" json:"transaction_type,omitempty"`
+	Currency        *string                `protobuf:"bytes,2,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	ActiveOnly      bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListApprovalPoliciesRequest) Reset() {
+	*x = ListApprovalPoliciesRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalPoliciesRequest) ProtoMessage() {}
+
+func (x *ListApprovalPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovalPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ListApprovalPoliciesRequest) GetTransactionType() TransactionType {
+	if x != nil && x.TransactionType != nil {
+		return *x.TransactionType
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ListApprovalPoliciesRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *ListApprovalPoliciesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListApprovalPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*ApprovalPolicy      `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalPoliciesResponse) Reset() {
+	*x = ListApprovalPoliciesResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalPoliciesResponse) ProtoMessage() {}
+
+func (x *ListApprovalPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovalPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ListApprovalPoliciesResponse) GetPolicies() []*ApprovalPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type Agent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AgentExternalId  string                 `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
+	UserExternalId   *string                `protobuf:"bytes,2,opt,name=user_external_id,json=userExternalId,proto3,oneof" json:"user_external_id,omitempty"` // Auth user ID
+	Service          *string                `protobuf:"bytes,3,opt,name=service,proto3,oneof" json:"service,omitempty"`
+	CommissionRate   *string                `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,oneof" json:"commission_rate,omitempty"` // NUMERIC as string (e.g., "0.05" for 5%)
+	RelationshipType RelationshipType       `protobuf:"varint,5,opt,name=relationship_type,json=relationshipType,proto3,enum=accounting.v1.RelationshipType" json:"relationship_type,omitempty"`
+	IsActive         bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Name             *string                `protobuf:"bytes,7,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Metadata         map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Accounts         []*Account             `protobuf:"bytes,11,rep,name=accounts,proto3" json:"accounts,omitempty"` // ✅ Agent's accounts (commission account, etc.)
+	// ✅ NEW FIELDS
+	CommissionRateForDeposit *string         `protobuf:"bytes,12,opt,name=commission_rate_for_deposit,json=commissionRateForDeposit,proto3,oneof" json:"commission_rate_for_deposit,omitempty"`  // NUMERIC as string (e.g., "0.015" for 1.5%)
+	PaymentMethod            *string         `protobuf:"bytes,13,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`                                       // mpesa, bank, cash, etc.
+	Location                 map[string]bool `protobuf:"bytes,14,rep,name=location,proto3" json:"location,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Country codes with flags:  {"KE": true, "UG": false}
+	Status                   AgentStatus     `protobuf:"varint,15,opt,name=status,proto3,enum=accounting.v1.AgentStatus" json:"status,omitempty"`                                                // active, inactive, deleted
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Agent) Reset() {
+	*x = Agent{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Agent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Agent) ProtoMessage() {}
+
+func (x *Agent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Agent.ProtoReflect.Descriptor instead.
+func (*Agent) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *Agent) GetAgentExternalId() string {
+	if x != nil {
+		return x.AgentExternalId
+	}
+	return ""
+}
+
+func (x *Agent) GetUserExternalId() string {
+	if x != nil && x.UserExternalId != nil {
+		return *x.UserExternalId
+	}
+	return ""
+}
+
+func (x *Agent) GetService() string {
+	if x != nil && x.Service != nil {
+		return *x.Service
+	}
+	return ""
+}
+
+func (x *Agent) GetCommissionRate() string {
+	if x != nil && x.CommissionRate != nil {
+		return *x.CommissionRate
+	}
+	return ""
+}
+
+func (x *Agent) GetRelationshipType() RelationshipType {
+	if x != nil {
+		return x.RelationshipType
+	}
+	return RelationshipType_RELATIONSHIP_TYPE_UNSPECIFIED
+}
+
+func (x *Agent) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Agent) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Agent) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Agent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Agent) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Agent) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *Agent) GetCommissionRateForDeposit() string {
+	if x != nil && x.CommissionRateForDeposit != nil {
+		return *x.CommissionRateForDeposit
+	}
+	return ""
+}
+
+func (x *Agent) GetPaymentMethod() string {
+	if x != nil && x.PaymentMethod != nil {
+		return *x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *Agent) GetLocation() map[string]bool {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Agent) GetStatus() AgentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
+}
+
+type AgentCommission struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AgentExternalId   string                 `protobuf:"bytes,2,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
+	UserExternalId    string                 `protobuf:"bytes,3,opt,name=user_external_id,json=userExternalId,proto3" json:"user_external_id,omitempty"`
+	AgentAccountId    int64                  `protobuf:"varint,4,opt,name=agent_account_id,json=agentAccountId,proto3" json:"agent_account_id,omitempty"`
+	UserAccountId     int64                  `protobuf:"varint,5,opt,name=user_account_id,json=userAccountId,proto3" json:"user_account_id,omitempty"`
+	ReceiptCode       string                 `protobuf:"bytes,6,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
+	TransactionAmount string                 `protobuf:"bytes,7,opt,name=transaction_amount,json=transactionAmount,proto3" json:"transaction_amount,omitempty"` // NUMERIC as string
+	CommissionRate    string                 `protobuf:"bytes,8,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"`          // NUMERIC as string
+	CommissionAmount  string                 `protobuf:"bytes,9,opt,name=commission_amount,json=commissionAmount,proto3" json:"commission_amount,omitempty"`    // NUMERIC as string
+	Currency          string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	PaidOut           bool                   `protobuf:"varint,11,opt,name=paid_out,json=paidOut,proto3" json:"paid_out,omitempty"`
+	PayoutReceiptCode *string                `protobuf:"bytes,12,opt,name=payout_receipt_code,json=payoutReceiptCode,proto3,oneof" json:"payout_receipt_code,omitempty"`
+	PaidOutAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=paid_out_at,json=paidOutAt,proto3,oneof" json:"paid_out_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AgentCommission) Reset() {
+	*x = AgentCommission{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentCommission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentCommission) ProtoMessage() {}
+
+func (x *AgentCommission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentCommission.ProtoReflect.Descriptor instead.
+func (*AgentCommission) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *AgentCommission) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AgentCommission) GetAgentExternalId() string {
+	if x != nil {
+		return x.AgentExternalId
+	}
+	return ""
+}
+
+func (x *AgentCommission) GetUserExternalId() string {
+	if x != nil {
+		return x.UserExternalId
+	}
+	return ""
+}
+
+func (x *AgentCommission) GetAgentAccountId() int64 {
+	if x != nil {
+		return x.AgentAccountId
+	}
+	return 0
+}
+
+func (x *AgentCommission) GetUserAccountId() int64 {
+	if x != nil {
+		return x.UserAccountId
+	}
+	return 0
+}
+
+func (x *AgentCommission) GetReceiptCode() string {
+	if x != nil {
+		return x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *AgentCommission) GetTransactionAmount() string {
+	if x != nil {
+		return x.TransactionAmount
+	}
+	return ""
+}
+
+func (x *AgentCommission) GetCommissionRate() string {
+	if x != nil {
+		return x.CommissionRate
+	}
+	return ""
+}
+
+func (x *AgentCommission) GetCommissionAmount() string {
+	if x != nil {
+		return x.CommissionAmount
+	}
+	return ""
+}
+
+func (x *AgentCommission) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AgentCommission) GetPaidOut() bool {
+	if x != nil {
+		return x.PaidOut
+	}
+	return false
+}
+
+func (x *AgentCommission) GetPayoutReceiptCode() string {
+	if x != nil && x.PayoutReceiptCode != nil {
+		return *x.PayoutReceiptCode
+	}
+	return ""
+}
+
+func (x *AgentCommission) GetPaidOutAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidOutAt
+	}
+	return nil
+}
+
+func (x *AgentCommission) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAgentRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserExternalId   *string                `protobuf:"bytes,1,opt,name=user_external_id,json=userExternalId,proto3,oneof" json:"user_external_id,omitempty"`
+	Service          *string                `protobuf:"bytes,2,opt,name=service,proto3,oneof" json:"service,omitempty"`
+	CommissionRate   *string                `protobuf:"bytes,3,opt,name=commission_rate,json=commissionRate,proto3,oneof" json:"commission_rate,omitempty"` // "0.05" for 5%
+	RelationshipType RelationshipType       `protobuf:"varint,4,opt,name=relationship_type,json=relationshipType,proto3,enum=accounting.v1.RelationshipType" json:"relationship_type,omitempty"`
+	IsActive         bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Name             *string                `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Metadata         map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreateAccounts   bool                   `protobuf:"varint,8,opt,name=create_accounts,json=createAccounts,proto3" json:"create_accounts,omitempty"` // ✅ Whether to auto-create commission accounts
+	// ✅ NEW FIELDS
+	CommissionRateForDeposit *string         `protobuf:"bytes,9,opt,name=commission_rate_for_deposit,json=commissionRateForDeposit,proto3,oneof" json:"commission_rate_for_deposit,omitempty"`
+	PaymentMethod            *string         `protobuf:"bytes,10,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`
+	Location                 map[string]bool `protobuf:"bytes,11,rep,name=location,proto3" json:"location,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // {"KE": true, "UG": true, "TZ": false}
+	Status                   *AgentStatus    `protobuf:"varint,12,opt,name=status,proto3,enum=accounting.v1.AgentStatus,oneof" json:"status,omitempty"`                                          // defaults to ACTIVE
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *CreateAgentRequest) Reset() {
+	*x = CreateAgentRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAgentRequest) ProtoMessage() {}
+
+func (x *CreateAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAgentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAgentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *CreateAgentRequest) GetUserExternalId() string {
+	if x != nil && x.UserExternalId != nil {
+		return *x.UserExternalId
+	}
+	return ""
+}
+
+func (x *CreateAgentRequest) GetService() string {
+	if x != nil && x.Service != nil {
+		return *x.Service
+	}
+	return ""
+}
+
+func (x *CreateAgentRequest) GetCommissionRate() string {
+	if x != nil && x.CommissionRate != nil {
+		return *x.CommissionRate
+	}
+	return ""
+}
+
+func (x *CreateAgentRequest) GetRelationshipType() RelationshipType {
+	if x != nil {
+		return x.RelationshipType
+	}
+	return RelationshipType_RELATIONSHIP_TYPE_UNSPECIFIED
+}
+
+func (x *CreateAgentRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateAgentRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CreateAgentRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateAgentRequest) GetCreateAccounts() bool {
+	if x != nil {
+		return x.CreateAccounts
+	}
+	return false
+}
+
+func (x *CreateAgentRequest) GetCommissionRateForDeposit() string {
+	if x != nil && x.CommissionRateForDeposit != nil {
+		return *x.CommissionRateForDeposit
+	}
+	return ""
+}
+
+func (x *CreateAgentRequest) GetPaymentMethod() string {
+	if x != nil && x.PaymentMethod != nil {
+		return *x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CreateAgentRequest) GetLocation() map[string]bool {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreateAgentRequest) GetStatus() AgentStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
+}
+
+type CreateAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agent         *Agent                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAgentResponse) Reset() {
+	*x = CreateAgentResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAgentResponse) ProtoMessage() {}
+
+func (x *CreateAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAgentResponse.ProtoReflect.Descriptor instead.
+func (*CreateAgentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *CreateAgentResponse) GetAgent() *Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *CreateAgentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UpdateAgentRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AgentExternalId  string                 `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
+	UserExternalId   *string                `protobuf:"bytes,2,opt,name=user_external_id,json=userExternalId,proto3,oneof" json:"user_external_id,omitempty"`
+	Service          *string                `protobuf:"bytes,3,opt,name=service,proto3,oneof" json:"service,omitempty"`
+	CommissionRate   *string                `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,oneof" json:"commission_rate,omitempty"`
+	RelationshipType *RelationshipType      `protobuf:"varint,5,opt,name=relationship_type,json=relationshipType,proto3,enum=accounting.v1.RelationshipType,oneof" json:"relationship_type,omitempty"`
+	IsActive         *bool                  `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Name             *string                `protobuf:"bytes,7,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Metadata         map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// ✅ NEW FIELDS
+	CommissionRateForDeposit *string         `protobuf:"bytes,9,opt,name=commission_rate_for_deposit,json=commissionRateForDeposit,proto3,oneof" json:"commission_rate_for_deposit,omitempty"`
+	PaymentMethod            *string         `protobuf:"bytes,10,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`
+	Location                 map[string]bool `protobuf:"bytes,11,rep,name=location,proto3" json:"location,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Status                   *AgentStatus    `protobuf:"varint,12,opt,name=status,proto3,enum=accounting.v1.AgentStatus,oneof" json:"status,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *UpdateAgentRequest) Reset() {
+	*x = UpdateAgentRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAgentRequest) ProtoMessage() {}
+
+func (x *UpdateAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAgentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAgentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *UpdateAgentRequest) GetAgentExternalId() string {
+	if x != nil {
+		return x.AgentExternalId
+	}
+	return ""
+}
+
+func (x *UpdateAgentRequest) GetUserExternalId() string {
+	if x != nil && x.UserExternalId != nil {
+		return *x.UserExternalId
+	}
+	return ""
+}
+
+func (x *UpdateAgentRequest) GetService() string {
+	if x != nil && x.Service != nil {
+		return *x.Service
+	}
+	return ""
+}
+
+func (x *UpdateAgentRequest) GetCommissionRate() string {
+	if x != nil && x.CommissionRate != nil {
+		return *x.CommissionRate
+	}
+	return ""
+}
+
+func (x *UpdateAgentRequest) GetRelationshipType() RelationshipType {
+	if x != nil && x.RelationshipType != nil {
+		return *x.RelationshipType
+	}
+	return RelationshipType_RELATIONSHIP_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateAgentRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateAgentRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAgentRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UpdateAgentRequest) GetCommissionRateForDeposit() string {
+	if x != nil && x.CommissionRateForDeposit != nil {
+		return *x.CommissionRateForDeposit
+	}
+	return ""
+}
+
+func (x *UpdateAgentRequest) GetPaymentMethod() string {
+	if x != nil && x.PaymentMethod != nil {
+		return *x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *UpdateAgentRequest) GetLocation() map[string]bool {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UpdateAgentRequest) GetStatus() AgentStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
+}
+
+type UpdateAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agent         *Agent                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAgentResponse) Reset() {
+	*x = UpdateAgentResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAgentResponse) ProtoMessage() {}
+
+func (x *UpdateAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAgentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAgentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *UpdateAgentResponse) GetAgent() *Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *UpdateAgentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteAgentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AgentExternalId string                 `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteAgentRequest) Reset() {
+	*x = DeleteAgentRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAgentRequest) ProtoMessage() {}
+
+func (x *DeleteAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAgentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAgentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *DeleteAgentRequest) GetAgentExternalId() string {
+	if x != nil {
+		return x.AgentExternalId
+	}
+	return ""
+}
+
+type DeleteAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAgentResponse) Reset() {
+	*x = DeleteAgentResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAgentResponse) ProtoMessage() {}
+
+func (x *DeleteAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAgentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAgentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *DeleteAgentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetAgentByIDRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AgentExternalId string                 `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
+	IncludeAccounts bool                   `protobuf:"varint,2,opt,name=include_accounts,json=includeAccounts,proto3" json:"include_accounts,omitempty"` // ✅ Whether to include agent accounts in response
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetAgentByIDRequest) Reset() {
+	*x = GetAgentByIDRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentByIDRequest) ProtoMessage() {}
+
+func (x *GetAgentByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *GetAgentByIDRequest) GetAgentExternalId() string {
+	if x != nil {
+		return x.AgentExternalId
+	}
+	return ""
+}
+
+func (x *GetAgentByIDRequest) GetIncludeAccounts() bool {
+	if x != nil {
+		return x.IncludeAccounts
+	}
+	return false
+}
+
+type GetAgentByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agent         *Agent                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentByIDResponse) Reset() {
+	*x = GetAgentByIDResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentByIDResponse) ProtoMessage() {}
+
+func (x *GetAgentByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *GetAgentByIDResponse) GetAgent() *Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+type GetAgentByUserIDRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserExternalId  string                 `protobuf:"bytes,1,opt,name=user_external_id,json=userExternalId,proto3" json:"user_external_id,omitempty"`
+	IncludeAccounts bool                   `protobuf:"varint,2,opt,name=include_accounts,json=includeAccounts,proto3" json:"include_accounts,omitempty"` // ✅ Whether to include agent accounts in response
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetAgentByUserIDRequest) Reset() {
+	*x = GetAgentByUserIDRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentByUserIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentByUserIDRequest) ProtoMessage() {}
+
+func (x *GetAgentByUserIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentByUserIDRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentByUserIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *GetAgentByUserIDRequest) GetUserExternalId() string {
+	if x != nil {
+		return x.UserExternalId
+	}
+	return ""
+}
+
+func (x *GetAgentByUserIDRequest) GetIncludeAccounts() bool {
+	if x != nil {
+		return x.IncludeAccounts
+	}
+	return false
+}
+
+type GetAgentByUserIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agent         *Agent                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentByUserIDResponse) Reset() {
+	*x = GetAgentByUserIDResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentByUserIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentByUserIDResponse) ProtoMessage() {}
+
+func (x *GetAgentByUserIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentByUserIDResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentByUserIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *GetAgentByUserIDResponse) GetAgent() *Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+type ListAgentsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Limit           int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Max 100
+	Offset          int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeAccounts bool                   `protobuf:"varint,3,opt,name=include_accounts,json=includeAccounts,proto3" json:"include_accounts,omitempty"` // ✅ Whether to include accounts for each agent
+	// ✅ NEW FILTERS
+	CountryCode      *string           `protobuf:"bytes,4,opt,name=country_code,json=countryCode,proto3,oneof" json:"country_code,omitempty"`                                                     // Filter by country (e.g., "KE", "UG")
+	PaymentMethod    *string           `protobuf:"bytes,5,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`                                               // Filter by payment method (e.g., "mpesa", "bank")
+	Status           *AgentStatus      `protobuf:"varint,6,opt,name=status,proto3,enum=accounting.v1.AgentStatus,oneof" json:"status,omitempty"`                                                  // Filter by status
+	RelationshipType *RelationshipType `protobuf:"varint,7,opt,name=relationship_type,json=relationshipType,proto3,enum=accounting.v1.RelationshipType,oneof" json:"relationship_type,omitempty"` // Filter by relationship type
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListAgentsRequest) Reset() {
+	*x = ListAgentsRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentsRequest) ProtoMessage() {}
+
+func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAgentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *ListAgentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAgentsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListAgentsRequest) GetIncludeAccounts() bool {
+	if x != nil {
+		return x.IncludeAccounts
+	}
+	return false
+}
+
+func (x *ListAgentsRequest) GetCountryCode() string {
+	if x != nil && x.CountryCode != nil {
+		return *x.CountryCode
+	}
+	return ""
+}
+
+func (x *ListAgentsRequest) GetPaymentMethod() string {
+	if x != nil && x.PaymentMethod != nil {
+		return *x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *ListAgentsRequest) GetStatus() AgentStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
+}
+
+func (x *ListAgentsRequest) GetRelationshipType() RelationshipType {
+	if x != nil && x.RelationshipType != nil {
+		return *x.RelationshipType
+	}
+	return RelationshipType_RELATIONSHIP_TYPE_UNSPECIFIED
+}
+
+type ListAgentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agents        []*Agent               `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentsResponse) Reset() {
+	*x = ListAgentsResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentsResponse) ProtoMessage() {}
+
+func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAgentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ListAgentsResponse) GetAgents() []*Agent {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+func (x *ListAgentsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListCommissionsForAgentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AgentExternalId string                 `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
+	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Max 100
+	Offset          int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCommissionsForAgentRequest) Reset() {
+	*x = ListCommissionsForAgentRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommissionsForAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommissionsForAgentRequest) ProtoMessage() {}
+
+func (x *ListCommissionsForAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommissionsForAgentRequest.ProtoReflect.Descriptor instead.
+func (*ListCommissionsForAgentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *ListCommissionsForAgentRequest) GetAgentExternalId() string {
+	if x != nil {
+		return x.AgentExternalId
+	}
+	return ""
+}
+
+func (x *ListCommissionsForAgentRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCommissionsForAgentRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListCommissionsForAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commissions   []*AgentCommission     `protobuf:"bytes,1,rep,name=commissions,proto3" json:"commissions,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommissionsForAgentResponse) Reset() {
+	*x = ListCommissionsForAgentResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommissionsForAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommissionsForAgentResponse) ProtoMessage() {}
+
+func (x *ListCommissionsForAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommissionsForAgentResponse.ProtoReflect.Descriptor instead.
+func (*ListCommissionsForAgentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ListCommissionsForAgentResponse) GetCommissions() []*AgentCommission {
+	if x != nil {
+		return x.Commissions
+	}
+	return nil
+}
+
+func (x *ListCommissionsForAgentResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// ✅ NEW:  Get agents by multiple countries
+type GetAgentsByCountriesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CountryCodes    []string               `protobuf:"bytes,1,rep,name=country_codes,json=countryCodes,proto3" json:"country_codes,omitempty"` // ["KE", "UG", "TZ"]
+	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset          int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeAccounts bool                   `protobuf:"varint,4,opt,name=include_accounts,json=includeAccounts,proto3" json:"include_accounts,omitempty"`
+	Status          *AgentStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=accounting.v1.AgentStatus,oneof" json:"status,omitempty"` // Filter by status
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetAgentsByCountriesRequest) Reset() {
+	*x = GetAgentsByCountriesRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentsByCountriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentsByCountriesRequest) ProtoMessage() {}
+
+func (x *GetAgentsByCountriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentsByCountriesRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentsByCountriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *GetAgentsByCountriesRequest) GetCountryCodes() []string {
+	if x != nil {
+		return x.CountryCodes
+	}
+	return nil
+}
+
+func (x *GetAgentsByCountriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAgentsByCountriesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetAgentsByCountriesRequest) GetIncludeAccounts() bool {
+	if x != nil {
+		return x.IncludeAccounts
+	}
+	return false
+}
+
+func (x *GetAgentsByCountriesRequest) GetStatus() AgentStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
+}
+
+type GetAgentsByCountriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agents        []*Agent               `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentsByCountriesResponse) Reset() {
+	*x = GetAgentsByCountriesResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentsByCountriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentsByCountriesResponse) ProtoMessage() {}
+
+func (x *GetAgentsByCountriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentsByCountriesResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentsByCountriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *GetAgentsByCountriesResponse) GetAgents() []*Agent {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+func (x *GetAgentsByCountriesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// ✅ NEW: Get agent statistics
+type GetAgentStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   *string                `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3,oneof" json:"country_code,omitempty"`
+	PaymentMethod *string                `protobuf:"bytes,2,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentStatsRequest) Reset() {
+	*x = GetAgentStatsRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentStatsRequest) ProtoMessage() {}
+
+func (x *GetAgentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *GetAgentStatsRequest) GetCountryCode() string {
+	if x != nil && x.CountryCode != nil {
+		return *x.CountryCode
+	}
+	return ""
+}
+
+func (x *GetAgentStatsRequest) GetPaymentMethod() string {
+	if x != nil && x.PaymentMethod != nil {
+		return *x.PaymentMethod
+	}
+	return ""
+}
+
+type GetAgentStatsResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	TotalAgents           int32                  `protobuf:"varint,1,opt,name=total_agents,json=totalAgents,proto3" json:"total_agents,omitempty"`
+	ActiveAgents          int32                  `protobuf:"varint,2,opt,name=active_agents,json=activeAgents,proto3" json:"active_agents,omitempty"`
+	InactiveAgents        int32                  `protobuf:"varint,3,opt,name=inactive_agents,json=inactiveAgents,proto3" json:"inactive_agents,omitempty"`
+	AgentsByCountry       map[string]int32       `protobuf:"bytes,4,rep,name=agents_by_country,json=agentsByCountry,proto3" json:"agents_by_country,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`                     // {"KE": 50, "UG": 30}
+	AgentsByPaymentMethod map[string]int32       `protobuf:"bytes,5,rep,name=agents_by_payment_method,json=agentsByPaymentMethod,proto3" json:"agents_by_payment_method,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // {"mpesa": 40, "bank": 40}
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetAgentStatsResponse) Reset() {
+	*x = GetAgentStatsResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentStatsResponse) ProtoMessage() {}
+
+func (x *GetAgentStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *GetAgentStatsResponse) GetTotalAgents() int32 {
+	if x != nil {
+		return x.TotalAgents
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetActiveAgents() int32 {
+	if x != nil {
+		return x.ActiveAgents
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetInactiveAgents() int32 {
+	if x != nil {
+		return x.InactiveAgents
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetAgentsByCountry() map[string]int32 {
+	if x != nil {
+		return x.AgentsByCountry
+	}
+	return nil
+}
+
+func (x *GetAgentStatsResponse) GetAgentsByPaymentMethod() map[string]int32 {
+	if x != nil {
+		return x.AgentsByPaymentMethod
+	}
+	return nil
+}
+
+type CommissionPayout struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 0 for a dry-run preview
+	BatchId         string                 `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	AgentExternalId string                 `protobuf:"bytes,3,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
+	Currency        string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount          string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string, net of clawbacks
+	CommissionCount int32                  `protobuf:"varint,6,opt,name=commission_count,json=commissionCount,proto3" json:"commission_count,omitempty"`
+	PeriodFrom      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=period_from,json=periodFrom,proto3,oneof" json:"period_from,omitempty"`
+	PeriodTo        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=period_to,json=periodTo,proto3" json:"period_to,omitempty"`
+	Status          CommissionPayoutStatus `protobuf:"varint,9,opt,name=status,proto3,enum=accounting.v1.CommissionPayoutStatus" json:"status,omitempty"`
+	ReceiptCode     *string                `protobuf:"bytes,10,opt,name=receipt_code,json=receiptCode,proto3,oneof" json:"receipt_code,omitempty"`
+	ErrorMessage    *string                `protobuf:"bytes,11,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	PaidAt          *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=paid_at,json=paidAt,proto3,oneof" json:"paid_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CommissionPayout) Reset() {
+	*x = CommissionPayout{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommissionPayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommissionPayout) ProtoMessage() {}
+
+func (x *CommissionPayout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommissionPayout.ProtoReflect.Descriptor instead.
+func (*CommissionPayout) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *CommissionPayout) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CommissionPayout) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *CommissionPayout) GetAgentExternalId() string {
+	if x != nil {
+		return x.AgentExternalId
+	}
+	return ""
+}
+
+func (x *CommissionPayout) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CommissionPayout) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CommissionPayout) GetCommissionCount() int32 {
+	if x != nil {
+		return x.CommissionCount
+	}
+	return 0
+}
+
+func (x *CommissionPayout) GetPeriodFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodFrom
+	}
+	return nil
+}
+
+func (x *CommissionPayout) GetPeriodTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodTo
+	}
+	return nil
+}
+
+func (x *CommissionPayout) GetStatus() CommissionPayoutStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_UNSPECIFIED
+}
+
+func (x *CommissionPayout) GetReceiptCode() string {
+	if x != nil && x.ReceiptCode != nil {
+		return *x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *CommissionPayout) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *CommissionPayout) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CommissionPayout) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CommissionPayout) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+type CreateCommissionPayoutBatchRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AgentExternalId *string                `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3,oneof" json:"agent_external_id,omitempty"` // Omit for all agents
+	Currency        *string                `protobuf:"bytes,2,opt,name=currency,proto3,oneof" json:"currency,omitempty"`                                        // Omit for all currencies
+	PeriodFrom      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_from,json=periodFrom,proto3,oneof" json:"period_from,omitempty"`                  // Omit to include everything before period_to
+	PeriodTo        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_to,json=periodTo,proto3,oneof" json:"period_to,omitempty"`                        // Exclusive; defaults to now
+	MinPayoutAmount *string                `protobuf:"bytes,5,opt,name=min_payout_amount,json=minPayoutAmount,proto3,oneof" json:"min_payout_amount,omitempty"` // NUMERIC as string; smaller totals stay unpaid
+	DryRun          bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                   // Preview without paying
+	CreatedBy       string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateCommissionPayoutBatchRequest) Reset() {
+	*x = CreateCommissionPayoutBatchRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommissionPayoutBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommissionPayoutBatchRequest) ProtoMessage() {}
+
+func (x *CreateCommissionPayoutBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommissionPayoutBatchRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommissionPayoutBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *CreateCommissionPayoutBatchRequest) GetAgentExternalId() string {
+	if x != nil && x.AgentExternalId != nil {
+		return *x.AgentExternalId
+	}
+	return ""
+}
+
+func (x *CreateCommissionPayoutBatchRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *CreateCommissionPayoutBatchRequest) GetPeriodFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodFrom
+	}
+	return nil
+}
+
+func (x *CreateCommissionPayoutBatchRequest) GetPeriodTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodTo
+	}
+	return nil
+}
+
+func (x *CreateCommissionPayoutBatchRequest) GetMinPayoutAmount() string {
+	if x != nil && x.MinPayoutAmount != nil {
+		return *x.MinPayoutAmount
+	}
+	return ""
+}
+
+func (x *CreateCommissionPayoutBatchRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CreateCommissionPayoutBatchRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateCommissionPayoutBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"` // Empty for a dry run
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Payouts       []*CommissionPayout    `protobuf:"bytes,3,rep,name=payouts,proto3" json:"payouts,omitempty"`
+	Skipped       []*CommissionPayout    `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"` // Below the minimum, carried forward
+	PaidCount     int32                  `protobuf:"varint,5,opt,name=paid_count,json=paidCount,proto3" json:"paid_count,omitempty"`
+	FailedCount   int32                  `protobuf:"varint,6,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommissionPayoutBatchResponse) Reset() {
+	*x = CreateCommissionPayoutBatchResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommissionPayoutBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommissionPayoutBatchResponse) ProtoMessage() {}
+
+func (x *CreateCommissionPayoutBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommissionPayoutBatchResponse.ProtoReflect.Descriptor instead.
+func (*CreateCommissionPayoutBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *CreateCommissionPayoutBatchResponse) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *CreateCommissionPayoutBatchResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CreateCommissionPayoutBatchResponse) GetPayouts() []*CommissionPayout {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *CreateCommissionPayoutBatchResponse) GetSkipped() []*CommissionPayout {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *CreateCommissionPayoutBatchResponse) GetPaidCount() int32 {
+	if x != nil {
+		return x.PaidCount
+	}
+	return 0
+}
+
+func (x *CreateCommissionPayoutBatchResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+type ListCommissionPayoutsRequest struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	AgentExternalId *string                 `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3,oneof" json:"agent_external_id,omitempty"`
+	BatchId         *string                 `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3,oneof" json:"batch_id,omitempty"`
+	Currency        *string                 `protobuf:"bytes,3,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	Status          *CommissionPayoutStatus `protobuf:"varint,4,opt,name=status,proto3,enum=accounting.v1.CommissionPayoutStatus,oneof" json:"status,omitempty"`
+	Limit           int32                   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"` // Max 200
+	Offset          int32                   `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCommissionPayoutsRequest) Reset() {
+	*x = ListCommissionPayoutsRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommissionPayoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommissionPayoutsRequest) ProtoMessage() {}
+
+func (x *ListCommissionPayoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommissionPayoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommissionPayoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *ListCommissionPayoutsRequest) GetAgentExternalId() string {
+	if x != nil && x.AgentExternalId != nil {
+		return *x.AgentExternalId
+	}
+	return ""
+}
+
+func (x *ListCommissionPayoutsRequest) GetBatchId() string {
+	if x != nil && x.BatchId != nil {
+		return *x.BatchId
+	}
+	return ""
+}
+
+func (x *ListCommissionPayoutsRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *ListCommissionPayoutsRequest) GetStatus() CommissionPayoutStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return CommissionPayoutStatus_COMMISSION_PAYOUT_STATUS_UNSPECIFIED
+}
+
+func (x *ListCommissionPayoutsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCommissionPayoutsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListCommissionPayoutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payouts       []*CommissionPayout    `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommissionPayoutsResponse) Reset() {
+	*x = ListCommissionPayoutsResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommissionPayoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommissionPayoutsResponse) ProtoMessage() {}
+
+func (x *ListCommissionPayoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommissionPayoutsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommissionPayoutsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *ListCommissionPayoutsResponse) GetPayouts() []*CommissionPayout {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *ListCommissionPayoutsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Caps the non-fee debits of matching accounts in one currency. Unset scope
+// fields match anything; unset caps are unlimited. The most specific active
+// profile applies (account, KYC tier, owner type, then priority).
+type LimitProfile struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerType       *OwnerType             `protobuf:"varint,3,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType,oneof" json:"owner_type,omitempty"`
+	KycTier         *string                `protobuf:"bytes,4,opt,name=kyc_tier,json=kycTier,proto3,oneof" json:"kyc_tier,omitempty"`
+	AccountNumber   *string                `protobuf:"bytes,5,opt,name=account_number,json=accountNumber,proto3,oneof" json:"account_number,omitempty"`
+	Currency        string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	MaxSingleAmount *string                `protobuf:"bytes,7,opt,name=max_single_amount,json=maxSingleAmount,proto3,oneof" json:"max_single_amount,omitempty"` // NUMERIC as string
+	DailyAmount     *string                `protobuf:"bytes,8,opt,name=daily_amount,json=dailyAmount,proto3,oneof" json:"daily_amount,omitempty"`
+	WeeklyAmount    *string                `protobuf:"bytes,9,opt,name=weekly_amount,json=weeklyAmount,proto3,oneof" json:"weekly_amount,omitempty"`
+	MonthlyAmount   *string                `protobuf:"bytes,10,opt,name=monthly_amount,json=monthlyAmount,proto3,oneof" json:"monthly_amount,omitempty"`
+	DailyCount      *int32                 `protobuf:"varint,11,opt,name=daily_count,json=dailyCount,proto3,oneof" json:"daily_count,omitempty"`
+	WeeklyCount     *int32                 `protobuf:"varint,12,opt,name=weekly_count,json=weeklyCount,proto3,oneof" json:"weekly_count,omitempty"`
+	MonthlyCount    *int32                 `protobuf:"varint,13,opt,name=monthly_count,json=monthlyCount,proto3,oneof" json:"monthly_count,omitempty"`
+	Priority        int32                  `protobuf:"varint,14,opt,name=priority,proto3" json:"priority,omitempty"`
+	IsActive        bool                   `protobuf:"varint,15,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,16,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LimitProfile) Reset() {
+	*x = LimitProfile{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitProfile) ProtoMessage() {}
+
+func (x *LimitProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitProfile.ProtoReflect.Descriptor instead.
+func (*LimitProfile) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *LimitProfile) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LimitProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LimitProfile) GetOwnerType() OwnerType {
+	if x != nil && x.OwnerType != nil {
+		return *x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *LimitProfile) GetKycTier() string {
+	if x != nil && x.KycTier != nil {
+		return *x.KycTier
+	}
+	return ""
+}
+
+func (x *LimitProfile) GetAccountNumber() string {
+	if x != nil && x.AccountNumber != nil {
+		return *x.AccountNumber
+	}
+	return ""
+}
+
+func (x *LimitProfile) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *LimitProfile) GetMaxSingleAmount() string {
+	if x != nil && x.MaxSingleAmount != nil {
+		return *x.MaxSingleAmount
+	}
+	return ""
+}
+
+func (x *LimitProfile) GetDailyAmount() string {
+	if x != nil && x.DailyAmount != nil {
+		return *x.DailyAmount
+	}
+	return ""
+}
+
+func (x *LimitProfile) GetWeeklyAmount() string {
+	if x != nil && x.WeeklyAmount != nil {
+		return *x.WeeklyAmount
+	}
+	return ""
+}
+
+func (x *LimitProfile) GetMonthlyAmount() string {
+	if x != nil && x.MonthlyAmount != nil {
+		return *x.MonthlyAmount
+	}
+	return ""
+}
+
+func (x *LimitProfile) GetDailyCount() int32 {
+	if x != nil && x.DailyCount != nil {
+		return *x.DailyCount
+	}
+	return 0
+}
+
+func (x *LimitProfile) GetWeeklyCount() int32 {
+	if x != nil && x.WeeklyCount != nil {
+		return *x.WeeklyCount
+	}
+	return 0
+}
+
+func (x *LimitProfile) GetMonthlyCount() int32 {
+	if x != nil && x.MonthlyCount != nil {
+		return *x.MonthlyCount
+	}
+	return 0
+}
+
+func (x *LimitProfile) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *LimitProfile) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *LimitProfile) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *LimitProfile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LimitProfile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateLimitProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *LimitProfile          `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"` // id, created_at and updated_at are ignored
+	CreatedBy     string                 `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLimitProfileRequest) Reset() {
+	*x = CreateLimitProfileRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLimitProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLimitProfileRequest) ProtoMessage() {}
+
+func (x *CreateLimitProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLimitProfileRequest.ProtoReflect.Descriptor instead.
+func (*CreateLimitProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *CreateLimitProfileRequest) GetProfile() *LimitProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *CreateLimitProfileRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateLimitProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *LimitProfile          `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLimitProfileResponse) Reset() {
+	*x = CreateLimitProfileResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLimitProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLimitProfileResponse) ProtoMessage() {}
+
+func (x *CreateLimitProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLimitProfileResponse.ProtoReflect.Descriptor instead.
+func (*CreateLimitProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *CreateLimitProfileResponse) GetProfile() *LimitProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateLimitProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *LimitProfile          `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"` // Replaces scope and caps of profile.id
+	UpdatedBy     string                 `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLimitProfileRequest) Reset() {
+	*x = UpdateLimitProfileRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLimitProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLimitProfileRequest) ProtoMessage() {}
+
+func (x *UpdateLimitProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLimitProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLimitProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *UpdateLimitProfileRequest) GetProfile() *LimitProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *UpdateLimitProfileRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type UpdateLimitProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *LimitProfile          `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLimitProfileResponse) Reset() {
+	*x = UpdateLimitProfileResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLimitProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLimitProfileResponse) ProtoMessage() {}
+
+func (x *UpdateLimitProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLimitProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLimitProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *UpdateLimitProfileResponse) GetProfile() *LimitProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type ListLimitProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerType     *OwnerType             `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType,oneof" json:"owner_type,omitempty"`
+	KycTier       *string                `protobuf:"bytes,2,opt,name=kyc_tier,json=kycTier,proto3,oneof" json:"kyc_tier,omitempty"`
+	AccountNumber *string                `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3,oneof" json:"account_number,omitempty"`
+	Currency      *string                `protobuf:"bytes,4,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,5,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLimitProfilesRequest) Reset() {
+	*x = ListLimitProfilesRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLimitProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLimitProfilesRequest) ProtoMessage() {}
+
+func (x *ListLimitProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLimitProfilesRequest.ProtoReflect.Descriptor instead.
+func (*ListLimitProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *ListLimitProfilesRequest) GetOwnerType() OwnerType {
+	if x != nil && x.OwnerType != nil {
+		return *x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *ListLimitProfilesRequest) GetKycTier() string {
+	if x != nil && x.KycTier != nil {
+		return *x.KycTier
+	}
+	return ""
+}
+
+func (x *ListLimitProfilesRequest) GetAccountNumber() string {
+	if x != nil && x.AccountNumber != nil {
+		return *x.AccountNumber
+	}
+	return ""
+}
+
+func (x *ListLimitProfilesRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *ListLimitProfilesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListLimitProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profiles      []*LimitProfile        `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLimitProfilesResponse) Reset() {
+	*x = ListLimitProfilesResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLimitProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLimitProfilesResponse) ProtoMessage() {}
+
+func (x *ListLimitProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLimitProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ListLimitProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *ListLimitProfilesResponse) GetProfiles() []*LimitProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type SetOwnerKYCTierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerType     OwnerType              `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType" json:"owner_type,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	KycTier       string                 `protobuf:"bytes,3,opt,name=kyc_tier,json=kycTier,proto3" json:"kyc_tier,omitempty"`
+	SetBy         string                 `protobuf:"bytes,4,opt,name=set_by,json=setBy,proto3" json:"set_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOwnerKYCTierRequest) Reset() {
+	*x = SetOwnerKYCTierRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOwnerKYCTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOwnerKYCTierRequest) ProtoMessage() {}
+
+func (x *SetOwnerKYCTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOwnerKYCTierRequest.ProtoReflect.Descriptor instead.
+func (*SetOwnerKYCTierRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *SetOwnerKYCTierRequest) GetOwnerType() OwnerType {
+	if x != nil {
+		return x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *SetOwnerKYCTierRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SetOwnerKYCTierRequest) GetKycTier() string {
+	if x != nil {
+		return x.KycTier
+	}
+	return ""
+}
+
+func (x *SetOwnerKYCTierRequest) GetSetBy() string {
+	if x != nil {
+		return x.SetBy
+	}
+	return ""
+}
+
+type SetOwnerKYCTierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerType     OwnerType              `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType" json:"owner_type,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	KycTier       string                 `protobuf:"bytes,3,opt,name=kyc_tier,json=kycTier,proto3" json:"kyc_tier,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOwnerKYCTierResponse) Reset() {
+	*x = SetOwnerKYCTierResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOwnerKYCTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOwnerKYCTierResponse) ProtoMessage() {}
+
+func (x *SetOwnerKYCTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOwnerKYCTierResponse.ProtoReflect.Descriptor instead.
+func (*SetOwnerKYCTierResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *SetOwnerKYCTierResponse) GetOwnerType() OwnerType {
+	if x != nil {
+		return x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *SetOwnerKYCTierResponse) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SetOwnerKYCTierResponse) GetKycTier() string {
+	if x != nil {
+		return x.KycTier
+	}
+	return ""
+}
+
+func (x *SetOwnerKYCTierResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type LimitUsage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Window          LimitWindow            `protobuf:"varint,1,opt,name=window,proto3,enum=accounting.v1.LimitWindow" json:"window,omitempty"`
+	WindowStart     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	ResetsAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=resets_at,json=resetsAt,proto3" json:"resets_at,omitempty"`
+	UsedAmount      string                 `protobuf:"bytes,4,opt,name=used_amount,json=usedAmount,proto3" json:"used_amount,omitempty"` // NUMERIC as string
+	UsedCount       int32                  `protobuf:"varint,5,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`
+	AmountCap       *string                `protobuf:"bytes,6,opt,name=amount_cap,json=amountCap,proto3,oneof" json:"amount_cap,omitempty"` // Unset = no amount cap
+	CountCap        *int32                 `protobuf:"varint,7,opt,name=count_cap,json=countCap,proto3,oneof" json:"count_cap,omitempty"`   // Unset = no count cap
+	RemainingAmount *string                `protobuf:"bytes,8,opt,name=remaining_amount,json=remainingAmount,proto3,oneof" json:"remaining_amount,omitempty"`
+	RemainingCount  *int32                 `protobuf:"varint,9,opt,name=remaining_count,json=remainingCount,proto3,oneof" json:"remaining_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LimitUsage) Reset() {
+	*x = LimitUsage{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitUsage) ProtoMessage() {}
+
+func (x *LimitUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitUsage.ProtoReflect.Descriptor instead.
+func (*LimitUsage) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *LimitUsage) GetWindow() LimitWindow {
+	if x != nil {
+		return x.Window
+	}
+	return LimitWindow_LIMIT_WINDOW_UNSPECIFIED
+}
+
+func (x *LimitUsage) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *LimitUsage) GetResetsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetsAt
+	}
+	return nil
+}
+
+func (x *LimitUsage) GetUsedAmount() string {
+	if x != nil {
+		return x.UsedAmount
+	}
+	return ""
+}
+
+func (x *LimitUsage) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *LimitUsage) GetAmountCap() string {
+	if x != nil && x.AmountCap != nil {
+		return *x.AmountCap
+	}
+	return ""
+}
+
+func (x *LimitUsage) GetCountCap() int32 {
+	if x != nil && x.CountCap != nil {
+		return *x.CountCap
+	}
+	return 0
+}
+
+func (x *LimitUsage) GetRemainingAmount() string {
+	if x != nil && x.RemainingAmount != nil {
+		return *x.RemainingAmount
+	}
+	return ""
+}
+
+func (x *LimitUsage) GetRemainingCount() int32 {
+	if x != nil && x.RemainingCount != nil {
+		return *x.RemainingCount
+	}
+	return 0
+}
+
+type GetAccountLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountLimitsRequest) Reset() {
+	*x = GetAccountLimitsRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountLimitsRequest) ProtoMessage() {}
+
+func (x *GetAccountLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *GetAccountLimitsRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+type GetAccountLimitsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber   string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Currency        string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	KycTier         *string                `protobuf:"bytes,3,opt,name=kyc_tier,json=kycTier,proto3,oneof" json:"kyc_tier,omitempty"`
+	Profile         *LimitProfile          `protobuf:"bytes,4,opt,name=profile,proto3,oneof" json:"profile,omitempty"` // Unset = no limits apply
+	MaxSingleAmount *string                `protobuf:"bytes,5,opt,name=max_single_amount,json=maxSingleAmount,proto3,oneof" json:"max_single_amount,omitempty"`
+	Usage           []*LimitUsage          `protobuf:"bytes,6,rep,name=usage,proto3" json:"usage,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateTransactionApprovalRequest) Reset() {
-	*x = CreateTransactionApprovalRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[81]
+func (x *GetAccountLimitsResponse) Reset() {
+	*x = GetAccountLimitsResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountLimitsResponse) ProtoMessage() {}
+
+func (x *GetAccountLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *GetAccountLimitsResponse) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *GetAccountLimitsResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetAccountLimitsResponse) GetKycTier() string {
+	if x != nil && x.KycTier != nil {
+		return *x.KycTier
+	}
+	return ""
+}
+
+func (x *GetAccountLimitsResponse) GetProfile() *LimitProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *GetAccountLimitsResponse) GetMaxSingleAmount() string {
+	if x != nil && x.MaxSingleAmount != nil {
+		return *x.MaxSingleAmount
+	}
+	return ""
+}
+
+func (x *GetAccountLimitsResponse) GetUsage() []*LimitUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// A standing order (cron_expr or interval_seconds) or, with neither set, a
+// single transfer at start_at. Cron expressions have 5 fields and run in UTC.
+type ScheduledTransfer struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Id                int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerType         OwnerType               `protobuf:"varint,2,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType" json:"owner_type,omitempty"`
+	OwnerId           string                  `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	FromAccountNumber string                  `protobuf:"bytes,4,opt,name=from_account_number,json=fromAccountNumber,proto3" json:"from_account_number,omitempty"`
+	ToAccountNumber   string                  `protobuf:"bytes,5,opt,name=to_account_number,json=toAccountNumber,proto3" json:"to_account_number,omitempty"`
+	Amount            string                  `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"` // NUMERIC as string
+	Currency          string                  `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	AccountType       AccountType             `protobuf:"varint,8,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"`
+	Description       string                  `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	CronExpr          *string                 `protobuf:"bytes,10,opt,name=cron_expr,json=cronExpr,proto3,oneof" json:"cron_expr,omitempty"`
+	IntervalSeconds   *int64                  `protobuf:"varint,11,opt,name=interval_seconds,json=intervalSeconds,proto3,oneof" json:"interval_seconds,omitempty"` // At least 3600
+	StartAt           *timestamppb.Timestamp  `protobuf:"bytes,12,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt             *timestamppb.Timestamp  `protobuf:"bytes,13,opt,name=end_at,json=endAt,proto3,oneof" json:"end_at,omitempty"`
+	MaxRuns           *int32                  `protobuf:"varint,14,opt,name=max_runs,json=maxRuns,proto3,oneof" json:"max_runs,omitempty"`
+	MaxRetries        int32                   `protobuf:"varint,15,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"` // Attempts per occurrence on insufficient funds before skipping
+	Status            ScheduledTransferStatus `protobuf:"varint,16,opt,name=status,proto3,enum=accounting.v1.ScheduledTransferStatus" json:"status,omitempty"`
+	NextRunAt         *timestamppb.Timestamp  `protobuf:"bytes,17,opt,name=next_run_at,json=nextRunAt,proto3,oneof" json:"next_run_at,omitempty"`
+	Attempts          int32                   `protobuf:"varint,18,opt,name=attempts,proto3" json:"attempts,omitempty"` // Failed attempts of next_run_at
+	RunCount          int32                   `protobuf:"varint,19,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	LastRunAt         *timestamppb.Timestamp  `protobuf:"bytes,20,opt,name=last_run_at,json=lastRunAt,proto3,oneof" json:"last_run_at,omitempty"`
+	LastReceiptCode   *string                 `protobuf:"bytes,21,opt,name=last_receipt_code,json=lastReceiptCode,proto3,oneof" json:"last_receipt_code,omitempty"`
+	LastError         *string                 `protobuf:"bytes,22,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	CancelledBy       *string                 `protobuf:"bytes,23,opt,name=cancelled_by,json=cancelledBy,proto3,oneof" json:"cancelled_by,omitempty"`
+	CancelledAt       *timestamppb.Timestamp  `protobuf:"bytes,24,opt,name=cancelled_at,json=cancelledAt,proto3,oneof" json:"cancelled_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp  `protobuf:"bytes,25,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp  `protobuf:"bytes,26,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ScheduledTransfer) Reset() {
+	*x = ScheduledTransfer{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTransfer) ProtoMessage() {}
+
+func (x *ScheduledTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTransfer.ProtoReflect.Descriptor instead.
+func (*ScheduledTransfer) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *ScheduledTransfer) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetOwnerType() OwnerType {
+	if x != nil {
+		return x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *ScheduledTransfer) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetFromAccountNumber() string {
+	if x != nil {
+		return x.FromAccountNumber
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetToAccountNumber() string {
+	if x != nil {
+		return x.ToAccountNumber
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *ScheduledTransfer) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetCronExpr() string {
+	if x != nil && x.CronExpr != nil {
+		return *x.CronExpr
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetIntervalSeconds() int64 {
+	if x != nil && x.IntervalSeconds != nil {
+		return *x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *ScheduledTransfer) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *ScheduledTransfer) GetMaxRuns() int32 {
+	if x != nil && x.MaxRuns != nil {
+		return *x.MaxRuns
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetStatus() ScheduledTransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *ScheduledTransfer) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *ScheduledTransfer) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetRunCount() int32 {
+	if x != nil {
+		return x.RunCount
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
+
+func (x *ScheduledTransfer) GetLastReceiptCode() string {
+	if x != nil && x.LastReceiptCode != nil {
+		return *x.LastReceiptCode
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetCancelledBy() string {
+	if x != nil && x.CancelledBy != nil {
+		return *x.CancelledBy
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *ScheduledTransfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScheduledTransfer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ScheduledTransferRun struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Id            int64                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduleId    int64                      `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	OccurrenceAt  *timestamppb.Timestamp     `protobuf:"bytes,3,opt,name=occurrence_at,json=occurrenceAt,proto3" json:"occurrence_at,omitempty"`
+	Status        ScheduledTransferRunStatus `protobuf:"varint,4,opt,name=status,proto3,enum=accounting.v1.ScheduledTransferRunStatus" json:"status,omitempty"`
+	Attempts      int32                      `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ReceiptCode   *string                    `protobuf:"bytes,6,opt,name=receipt_code,json=receiptCode,proto3,oneof" json:"receipt_code,omitempty"`
+	ErrorMessage  *string                    `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	CreatedAt     *timestamppb.Timestamp     `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledTransferRun) Reset() {
+	*x = ScheduledTransferRun{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledTransferRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTransferRun) ProtoMessage() {}
+
+func (x *ScheduledTransferRun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTransferRun.ProtoReflect.Descriptor instead.
+func (*ScheduledTransferRun) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *ScheduledTransferRun) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledTransferRun) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *ScheduledTransferRun) GetOccurrenceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceAt
+	}
+	return nil
+}
+
+func (x *ScheduledTransferRun) GetStatus() ScheduledTransferRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduledTransferRunStatus_SCHEDULED_TRANSFER_RUN_STATUS_UNSPECIFIED
+}
+
+func (x *ScheduledTransferRun) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ScheduledTransferRun) GetReceiptCode() string {
+	if x != nil && x.ReceiptCode != nil {
+		return *x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *ScheduledTransferRun) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ScheduledTransferRun) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateScheduledTransferRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OwnerType         OwnerType              `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType" json:"owner_type,omitempty"` // Must own from_account_number
+	OwnerId           string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	FromAccountNumber string                 `protobuf:"bytes,3,opt,name=from_account_number,json=fromAccountNumber,proto3" json:"from_account_number,omitempty"`
+	ToAccountNumber   string                 `protobuf:"bytes,4,opt,name=to_account_number,json=toAccountNumber,proto3" json:"to_account_number,omitempty"`
+	Amount            string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	AccountType       AccountType            `protobuf:"varint,6,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType" json:"account_type,omitempty"` // Defaults to real
+	Description       string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	CronExpr          *string                `protobuf:"bytes,8,opt,name=cron_expr,json=cronExpr,proto3,oneof" json:"cron_expr,omitempty"`
+	IntervalSeconds   *int64                 `protobuf:"varint,9,opt,name=interval_seconds,json=intervalSeconds,proto3,oneof" json:"interval_seconds,omitempty"`
+	StartAt           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=start_at,json=startAt,proto3,oneof" json:"start_at,omitempty"` // Defaults to now
+	EndAt             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=end_at,json=endAt,proto3,oneof" json:"end_at,omitempty"`
+	MaxRuns           *int32                 `protobuf:"varint,12,opt,name=max_runs,json=maxRuns,proto3,oneof" json:"max_runs,omitempty"`
+	MaxRetries        *int32                 `protobuf:"varint,13,opt,name=max_retries,json=maxRetries,proto3,oneof" json:"max_retries,omitempty"` // Defaults to 3, at most 10
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateScheduledTransferRequest) Reset() {
+	*x = CreateScheduledTransferRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduledTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduledTransferRequest) ProtoMessage() {}
+
+func (x *CreateScheduledTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduledTransferRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduledTransferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *CreateScheduledTransferRequest) GetOwnerType() OwnerType {
+	if x != nil {
+		return x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *CreateScheduledTransferRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetFromAccountNumber() string {
+	if x != nil {
+		return x.FromAccountNumber
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetToAccountNumber() string {
+	if x != nil {
+		return x.ToAccountNumber
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateScheduledTransferRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetCronExpr() string {
+	if x != nil && x.CronExpr != nil {
+		return *x.CronExpr
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetIntervalSeconds() int64 {
+	if x != nil && x.IntervalSeconds != nil {
+		return *x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *CreateScheduledTransferRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *CreateScheduledTransferRequest) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *CreateScheduledTransferRequest) GetMaxRuns() int32 {
+	if x != nil && x.MaxRuns != nil {
+		return *x.MaxRuns
+	}
+	return 0
+}
+
+func (x *CreateScheduledTransferRequest) GetMaxRetries() int32 {
+	if x != nil && x.MaxRetries != nil {
+		return *x.MaxRetries
+	}
+	return 0
+}
+
+type CreateScheduledTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *ScheduledTransfer     `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduledTransferResponse) Reset() {
+	*x = CreateScheduledTransferResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduledTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduledTransferResponse) ProtoMessage() {}
+
+func (x *CreateScheduledTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduledTransferResponse.ProtoReflect.Descriptor instead.
+func (*CreateScheduledTransferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *CreateScheduledTransferResponse) GetSchedule() *ScheduledTransfer {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// Set owner fields scope the call to that owner's schedules
+type GetScheduledTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerType     *OwnerType             `protobuf:"varint,2,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType,oneof" json:"owner_type,omitempty"`
+	OwnerId       *string                `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduledTransferRequest) Reset() {
+	*x = GetScheduledTransferRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduledTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledTransferRequest) ProtoMessage() {}
+
+func (x *GetScheduledTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduledTransferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *GetScheduledTransferRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetScheduledTransferRequest) GetOwnerType() OwnerType {
+	if x != nil && x.OwnerType != nil {
+		return *x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *GetScheduledTransferRequest) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+type GetScheduledTransferResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Schedule      *ScheduledTransfer      `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	RecentRuns    []*ScheduledTransferRun `protobuf:"bytes,2,rep,name=recent_runs,json=recentRuns,proto3" json:"recent_runs,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduledTransferResponse) Reset() {
+	*x = GetScheduledTransferResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduledTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledTransferResponse) ProtoMessage() {}
+
+func (x *GetScheduledTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledTransferResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduledTransferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *GetScheduledTransferResponse) GetSchedule() *ScheduledTransfer {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *GetScheduledTransferResponse) GetRecentRuns() []*ScheduledTransferRun {
+	if x != nil {
+		return x.RecentRuns
+	}
+	return nil
+}
+
+type ListScheduledTransfersRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	OwnerType     *OwnerType               `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType,oneof" json:"owner_type,omitempty"`
+	OwnerId       *string                  `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
+	AccountNumber *string                  `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3,oneof" json:"account_number,omitempty"` // Source or destination
+	Status        *ScheduledTransferStatus `protobuf:"varint,4,opt,name=status,proto3,enum=accounting.v1.ScheduledTransferStatus,oneof" json:"status,omitempty"`
+	Limit         int32                    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledTransfersRequest) Reset() {
+	*x = ListScheduledTransfersRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransfersRequest) ProtoMessage() {}
+
+func (x *ListScheduledTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *ListScheduledTransfersRequest) GetOwnerType() OwnerType {
+	if x != nil && x.OwnerType != nil {
+		return *x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *ListScheduledTransfersRequest) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListScheduledTransfersRequest) GetAccountNumber() string {
+	if x != nil && x.AccountNumber != nil {
+		return *x.AccountNumber
+	}
+	return ""
+}
+
+func (x *ListScheduledTransfersRequest) GetStatus() ScheduledTransferStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ScheduledTransferStatus_SCHEDULED_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *ListScheduledTransfersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListScheduledTransfersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListScheduledTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*ScheduledTransfer   `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledTransfersResponse) Reset() {
+	*x = ListScheduledTransfersResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransfersResponse) ProtoMessage() {}
+
+func (x *ListScheduledTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *ListScheduledTransfersResponse) GetSchedules() []*ScheduledTransfer {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *ListScheduledTransfersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CancelScheduledTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerType     *OwnerType             `protobuf:"varint,2,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType,oneof" json:"owner_type,omitempty"`
+	OwnerId       *string                `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
+	CancelledBy   string                 `protobuf:"bytes,4,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledTransferRequest) Reset() {
+	*x = CancelScheduledTransferRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledTransferRequest) ProtoMessage() {}
+
+func (x *CancelScheduledTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledTransferRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledTransferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *CancelScheduledTransferRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelScheduledTransferRequest) GetOwnerType() OwnerType {
+	if x != nil && x.OwnerType != nil {
+		return *x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *CancelScheduledTransferRequest) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *CancelScheduledTransferRequest) GetCancelledBy() string {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return ""
+}
+
+type CancelScheduledTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *ScheduledTransfer     `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledTransferResponse) Reset() {
+	*x = CancelScheduledTransferResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledTransferResponse) ProtoMessage() {}
+
+func (x *CancelScheduledTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledTransferResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledTransferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *CancelScheduledTransferResponse) GetSchedule() *ScheduledTransfer {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type FeeRule struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 0 = new rule (simulation only)
+	RuleName          string                 `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	TransactionType   TransactionType        `protobuf:"varint,3,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
+	SourceCurrency    *string                `protobuf:"bytes,4,opt,name=source_currency,json=sourceCurrency,proto3,oneof" json:"source_currency,omitempty"`
+	TargetCurrency    *string                `protobuf:"bytes,5,opt,name=target_currency,json=targetCurrency,proto3,oneof" json:"target_currency,omitempty"`
+	AccountType       *AccountType           `protobuf:"varint,6,opt,name=account_type,json=accountType,proto3,enum=accounting.v1.AccountType,oneof" json:"account_type,omitempty"`
+	OwnerType         *OwnerType             `protobuf:"varint,7,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType,oneof" json:"owner_type,omitempty"`
+	FeeType           FeeType                `protobuf:"varint,8,opt,name=fee_type,json=feeType,proto3,enum=accounting.v1.FeeType" json:"fee_type,omitempty"`
+	CalculationMethod string                 `protobuf:"bytes,9,opt,name=calculation_method,json=calculationMethod,proto3" json:"calculation_method,omitempty"` // percentage, fixed or tiered
+	FeeValue          string                 `protobuf:"bytes,10,opt,name=fee_value,json=feeValue,proto3" json:"fee_value,omitempty"`                           // NUMERIC as string
+	MinFee            *string                `protobuf:"bytes,11,opt,name=min_fee,json=minFee,proto3,oneof" json:"min_fee,omitempty"`
+	MaxFee            *string                `protobuf:"bytes,12,opt,name=max_fee,json=maxFee,proto3,oneof" json:"max_fee,omitempty"`
+	Tiers             *string                `protobuf:"bytes,13,opt,name=tiers,proto3,oneof" json:"tiers,omitempty"`     // JSON
+	Tariffs           *string                `protobuf:"bytes,14,opt,name=tariffs,proto3,oneof" json:"tariffs,omitempty"` // JSON
+	ValidFrom         *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo           *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=valid_to,json=validTo,proto3,oneof" json:"valid_to,omitempty"`
+	IsActive          bool                   `protobuf:"varint,17,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Priority          int32                  `protobuf:"varint,18,opt,name=priority,proto3" json:"priority,omitempty"`
+	Version           int32                  `protobuf:"varint,19,opt,name=version,proto3" json:"version,omitempty"`
+	VersionId         *int64                 `protobuf:"varint,20,opt,name=version_id,json=versionId,proto3,oneof" json:"version_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FeeRule) Reset() {
+	*x = FeeRule{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRule) ProtoMessage() {}
+
+func (x *FeeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRule.ProtoReflect.Descriptor instead.
+func (*FeeRule) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *FeeRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FeeRule) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *FeeRule) GetTransactionType() TransactionType {
+	if x != nil {
+		return x.TransactionType
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *FeeRule) GetSourceCurrency() string {
+	if x != nil && x.SourceCurrency != nil {
+		return *x.SourceCurrency
+	}
+	return ""
+}
+
+func (x *FeeRule) GetTargetCurrency() string {
+	if x != nil && x.TargetCurrency != nil {
+		return *x.TargetCurrency
+	}
+	return ""
+}
+
+func (x *FeeRule) GetAccountType() AccountType {
+	if x != nil && x.AccountType != nil {
+		return *x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *FeeRule) GetOwnerType() OwnerType {
+	if x != nil && x.OwnerType != nil {
+		return *x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *FeeRule) GetFeeType() FeeType {
+	if x != nil {
+		return x.FeeType
+	}
+	return FeeType_FEE_TYPE_UNSPECIFIED
+}
+
+func (x *FeeRule) GetCalculationMethod() string {
+	if x != nil {
+		return x.CalculationMethod
+	}
+	return ""
+}
+
+func (x *FeeRule) GetFeeValue() string {
+	if x != nil {
+		return x.FeeValue
+	}
+	return ""
+}
+
+func (x *FeeRule) GetMinFee() string {
+	if x != nil && x.MinFee != nil {
+		return *x.MinFee
+	}
+	return ""
+}
+
+func (x *FeeRule) GetMaxFee() string {
+	if x != nil && x.MaxFee != nil {
+		return *x.MaxFee
+	}
+	return ""
+}
+
+func (x *FeeRule) GetTiers() string {
+	if x != nil && x.Tiers != nil {
+		return *x.Tiers
+	}
+	return ""
+}
+
+func (x *FeeRule) GetTariffs() string {
+	if x != nil && x.Tariffs != nil {
+		return *x.Tariffs
+	}
+	return ""
+}
+
+func (x *FeeRule) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *FeeRule) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *FeeRule) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *FeeRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *FeeRule) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FeeRule) GetVersionId() int64 {
+	if x != nil && x.VersionId != nil {
+		return *x.VersionId
+	}
+	return 0
+}
+
+// Immutable snapshot of a fee rule; applied fees reference the version that priced them
+type FeeRuleVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId        int64                  `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Rule          *FeeRule               `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"` // Rule as of this version
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeRuleVersion) Reset() {
+	*x = FeeRuleVersion{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeRuleVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRuleVersion) ProtoMessage() {}
+
+func (x *FeeRuleVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRuleVersion.ProtoReflect.Descriptor instead.
+func (*FeeRuleVersion) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *FeeRuleVersion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FeeRuleVersion) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *FeeRuleVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FeeRuleVersion) GetRule() *FeeRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *FeeRuleVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListFeeRuleVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeeRuleVersionsRequest) Reset() {
+	*x = ListFeeRuleVersionsRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeeRuleVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeeRuleVersionsRequest) ProtoMessage() {}
+
+func (x *ListFeeRuleVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeeRuleVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListFeeRuleVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *ListFeeRuleVersionsRequest) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+type ListFeeRuleVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*FeeRuleVersion      `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeeRuleVersionsResponse) Reset() {
+	*x = ListFeeRuleVersionsResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeeRuleVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeeRuleVersionsResponse) ProtoMessage() {}
+
+func (x *ListFeeRuleVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeeRuleVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListFeeRuleVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *ListFeeRuleVersionsResponse) GetVersions() []*FeeRuleVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// Replays historical journals in [from, to) against the current rules and a
+// proposed rule set. Nothing is written.
+type SimulateFeeRulesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	From             *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To               *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Rules            []*FeeRule             `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"` // id set = replaces that rule, id 0 = added
+	RemoveRuleIds    []int64                `protobuf:"varint,4,rep,packed,name=remove_rule_ids,json=removeRuleIds,proto3" json:"remove_rule_ids,omitempty"`
+	TransactionTypes []TransactionType      `protobuf:"varint,5,rep,packed,name=transaction_types,json=transactionTypes,proto3,enum=accounting.v1.TransactionType" json:"transaction_types,omitempty"` // Empty = all priced types
+	MaxTransactions  int32                  `protobuf:"varint,6,opt,name=max_transactions,json=maxTransactions,proto3" json:"max_transactions,omitempty"`                                              // Default 10000, max 100000
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SimulateFeeRulesRequest) Reset() {
+	*x = SimulateFeeRulesRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateFeeRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateFeeRulesRequest) ProtoMessage() {}
+
+func (x *SimulateFeeRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateFeeRulesRequest.ProtoReflect.Descriptor instead.
+func (*SimulateFeeRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *SimulateFeeRulesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SimulateFeeRulesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *SimulateFeeRulesRequest) GetRules() []*FeeRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *SimulateFeeRulesRequest) GetRemoveRuleIds() []int64 {
+	if x != nil {
+		return x.RemoveRuleIds
+	}
+	return nil
+}
+
+func (x *SimulateFeeRulesRequest) GetTransactionTypes() []TransactionType {
+	if x != nil {
+		return x.TransactionTypes
+	}
+	return nil
+}
+
+func (x *SimulateFeeRulesRequest) GetMaxTransactions() int32 {
+	if x != nil {
+		return x.MaxTransactions
+	}
+	return 0
+}
+
+// Platform fee revenue of one transaction type in one currency
+type FeeSimulationGroup struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TransactionType  TransactionType        `protobuf:"varint,1,opt,name=transaction_type,json=transactionType,proto3,enum=accounting.v1.TransactionType" json:"transaction_type,omitempty"`
+	Currency         string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	TransactionCount int32                  `protobuf:"varint,3,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	ChangedCount     int32                  `protobuf:"varint,4,opt,name=changed_count,json=changedCount,proto3" json:"changed_count,omitempty"` // Priced differently under the proposed rules
+	FailedCount      int32                  `protobuf:"varint,5,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	ChargedRevenue   string                 `protobuf:"bytes,6,opt,name=charged_revenue,json=chargedRevenue,proto3" json:"charged_revenue,omitempty"` // Fees recorded at the time
+	CurrentRevenue   string                 `protobuf:"bytes,7,opt,name=current_revenue,json=currentRevenue,proto3" json:"current_revenue,omitempty"` // Replayed under the current rules
+	ProposedRevenue  string                 `protobuf:"bytes,8,opt,name=proposed_revenue,json=proposedRevenue,proto3" json:"proposed_revenue,omitempty"`
+	Delta            string                 `protobuf:"bytes,9,opt,name=delta,proto3" json:"delta,omitempty"` // proposed_revenue - current_revenue
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FeeSimulationGroup) Reset() {
+	*x = FeeSimulationGroup{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTransactionApprovalRequest) String() string {
+func (x *FeeSimulationGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTransactionApprovalRequest) ProtoMessage() {}
+func (*FeeSimulationGroup) ProtoMessage() {}
 
-func (x *CreateTransactionApprovalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[81]
+func (x *FeeSimulationGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6660,83 +16610,100 @@ func (x *CreateTransactionApprovalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTransactionApprovalRequest.ProtoReflect.Descriptor instead.
-func (*CreateTransactionApprovalRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use FeeSimulationGroup.ProtoReflect.Descriptor instead.
+func (*FeeSimulationGroup) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *CreateTransactionApprovalRequest) GetRequestedBy() int64 {
+func (x *FeeSimulationGroup) GetTransactionType() TransactionType {
 	if x != nil {
-		return x.RequestedBy
+		return x.TransactionType
 	}
-	return 0
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
 }
 
-func (x *CreateTransactionApprovalRequest) GetTransactionType() TransactionType {
+func (x *FeeSimulationGroup) GetCurrency() string {
 	if x != nil {
-		return x.TransactionType
+		return x.Currency
 	}
-	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *CreateTransactionApprovalRequest) GetAccountNumber() string {
+func (x *FeeSimulationGroup) GetTransactionCount() int32 {
 	if x != nil {
-		return x.AccountNumber
+		return x.TransactionCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateTransactionApprovalRequest) GetAmount() float64 {
+func (x *FeeSimulationGroup) GetChangedCount() int32 {
 	if x != nil {
-		return x.Amount
+		return x.ChangedCount
 	}
 	return 0
 }
 
-func (x *CreateTransactionApprovalRequest) GetCurrency() string {
+func (x *FeeSimulationGroup) GetFailedCount() int32 {
 	if x != nil {
-		return x.Currency
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *FeeSimulationGroup) GetChargedRevenue() string {
+	if x != nil {
+		return x.ChargedRevenue
 	}
 	return ""
 }
 
-func (x *CreateTransactionApprovalRequest) GetDescription() string {
+func (x *FeeSimulationGroup) GetCurrentRevenue() string {
 	if x != nil {
-		return x.Description
+		return x.CurrentRevenue
 	}
 	return ""
 }
 
-func (x *CreateTransactionApprovalRequest) GetToAccountNumber() string {
-	if x != nil && x.ToAccountNumber != nil {
-		return *x.ToAccountNumber
+func (x *FeeSimulationGroup) GetProposedRevenue() string {
+	if x != nil {
+		return x.ProposedRevenue
 	}
 	return ""
 }
 
-type CreateTransactionApprovalResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Approval      *TransactionApproval   `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FeeSimulationGroup) GetDelta() string {
+	if x != nil {
+		return x.Delta
+	}
+	return ""
 }
 
-func (x *CreateTransactionApprovalResponse) Reset() {
-	*x = CreateTransactionApprovalResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[82]
+type SimulateFeeRulesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	From             *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To               *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	TransactionCount int32                  `protobuf:"varint,3,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	Truncated        bool                   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"` // More transactions in range than max_transactions
+	Groups           []*FeeSimulationGroup  `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SimulateFeeRulesResponse) Reset() {
+	*x = SimulateFeeRulesResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTransactionApprovalResponse) String() string {
+func (x *SimulateFeeRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTransactionApprovalResponse) ProtoMessage() {}
+func (*SimulateFeeRulesResponse) ProtoMessage() {}
 
-func (x *CreateTransactionApprovalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[82]
+func (x *SimulateFeeRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6747,48 +16714,82 @@ func (x *CreateTransactionApprovalResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTransactionApprovalResponse.ProtoReflect.Descriptor instead.
-func (*CreateTransactionApprovalResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use SimulateFeeRulesResponse.ProtoReflect.Descriptor instead.
+func (*SimulateFeeRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *CreateTransactionApprovalResponse) GetApproval() *TransactionApproval {
+func (x *SimulateFeeRulesResponse) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Approval
+		return x.From
 	}
 	return nil
 }
 
-func (x *CreateTransactionApprovalResponse) GetMessage() string {
+func (x *SimulateFeeRulesResponse) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.To
 	}
-	return ""
+	return nil
 }
 
-type GetPendingApprovalsRequest struct {
+func (x *SimulateFeeRulesResponse) GetTransactionCount() int32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *SimulateFeeRulesResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *SimulateFeeRulesResponse) GetGroups() []*FeeSimulationGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// Rate savings or investment accounts in one currency earn. Interest accrues
+// daily on the end-of-day balance and is posted monthly from the
+// interest_expense system account; one plan per currency and purpose is active.
+type InterestRatePlan struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset        *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Purpose       AccountPurpose         `protobuf:"varint,4,opt,name=purpose,proto3,enum=accounting.v1.AccountPurpose" json:"purpose,omitempty"` // SAVINGS or INVESTMENT
+	AnnualRate    string                 `protobuf:"bytes,5,opt,name=annual_rate,json=annualRate,proto3" json:"annual_rate,omitempty"`            // NUMERIC as string, 0.05 = 5% a year
+	Method        string                 `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`                                      // simple or compound (accrues on unposted interest too)
+	DayCount      int32                  `protobuf:"varint,7,opt,name=day_count,json=dayCount,proto3" json:"day_count,omitempty"`                 // 360 or 365
+	MinBalance    string                 `protobuf:"bytes,8,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"`            // No interest below this balance
+	IsActive      bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPendingApprovalsRequest) Reset() {
-	*x = GetPendingApprovalsRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[83]
+func (x *InterestRatePlan) Reset() {
+	*x = InterestRatePlan{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPendingApprovalsRequest) String() string {
+func (x *InterestRatePlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPendingApprovalsRequest) ProtoMessage() {}
+func (*InterestRatePlan) ProtoMessage() {}
 
-func (x *GetPendingApprovalsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[83]
+func (x *InterestRatePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6799,102 +16800,134 @@ func (x *GetPendingApprovalsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPendingApprovalsRequest.ProtoReflect.Descriptor instead.
-func (*GetPendingApprovalsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use InterestRatePlan.ProtoReflect.Descriptor instead.
+func (*InterestRatePlan) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *GetPendingApprovalsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *InterestRatePlan) GetId() int64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetPendingApprovalsRequest) GetOffset() int32 {
-	if x != nil && x.Offset != nil {
-		return *x.Offset
+func (x *InterestRatePlan) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type GetPendingApprovalsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Approvals     []*TransactionApproval `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *InterestRatePlan) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (x *GetPendingApprovalsResponse) Reset() {
-	*x = GetPendingApprovalsResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[84]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *InterestRatePlan) GetPurpose() AccountPurpose {
+	if x != nil {
+		return x.Purpose
+	}
+	return AccountPurpose_ACCOUNT_PURPOSE_UNSPECIFIED
 }
 
-func (x *GetPendingApprovalsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InterestRatePlan) GetAnnualRate() string {
+	if x != nil {
+		return x.AnnualRate
+	}
+	return ""
 }
 
-func (*GetPendingApprovalsResponse) ProtoMessage() {}
+func (x *InterestRatePlan) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
 
-func (x *GetPendingApprovalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[84]
+func (x *InterestRatePlan) GetDayCount() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DayCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetPendingApprovalsResponse.ProtoReflect.Descriptor instead.
-func (*GetPendingApprovalsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{84}
+func (x *InterestRatePlan) GetMinBalance() string {
+	if x != nil {
+		return x.MinBalance
+	}
+	return ""
 }
 
-func (x *GetPendingApprovalsResponse) GetApprovals() []*TransactionApproval {
+func (x *InterestRatePlan) GetIsActive() bool {
 	if x != nil {
-		return x.Approvals
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *InterestRatePlan) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *InterestRatePlan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *GetPendingApprovalsResponse) GetTotal() int32 {
+func (x *InterestRatePlan) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Total
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-type ApproveTransactionRequest struct {
+// Accrued interest capitalized into an account. amount is the accrued total
+// rounded down to the currency; the remainder is carried into the next posting.
+type InterestPosting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     int64                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	ApprovedBy    int64                  `protobuf:"varint,2,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
-	Approved      bool                   `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
-	Reason        *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	PeriodFrom    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_from,json=periodFrom,proto3" json:"period_from,omitempty"` // First accrual date
+	PeriodTo      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=period_to,json=periodTo,proto3" json:"period_to,omitempty"`       // Last accrual date
+	AccrualCount  int32                  `protobuf:"varint,6,opt,name=accrual_count,json=accrualCount,proto3" json:"accrual_count,omitempty"`
+	CarriedIn     string                 `protobuf:"bytes,7,opt,name=carried_in,json=carriedIn,proto3" json:"carried_in,omitempty"`
+	Accrued       string                 `protobuf:"bytes,8,opt,name=accrued,proto3" json:"accrued,omitempty"` // Accruals + carried_in
+	Amount        string                 `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`   // Credited to the account
+	Remainder     string                 `protobuf:"bytes,10,opt,name=remainder,proto3" json:"remainder,omitempty"`
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // pending, posted or failed
+	Attempts      int32                  `protobuf:"varint,12,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ReceiptCode   *string                `protobuf:"bytes,13,opt,name=receipt_code,json=receiptCode,proto3,oneof" json:"receipt_code,omitempty"`
+	LastError     *string                `protobuf:"bytes,14,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PostedAt      *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=posted_at,json=postedAt,proto3,oneof" json:"posted_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveTransactionRequest) Reset() {
-	*x = ApproveTransactionRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[85]
+func (x *InterestPosting) Reset() {
+	*x = InterestPosting{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveTransactionRequest) String() string {
+func (x *InterestPosting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveTransactionRequest) ProtoMessage() {}
+func (*InterestPosting) ProtoMessage() {}
 
-func (x *ApproveTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[85]
+func (x *InterestPosting) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6905,63 +16938,147 @@ func (x *ApproveTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveTransactionRequest.ProtoReflect.Descriptor instead.
-func (*ApproveTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use InterestPosting.ProtoReflect.Descriptor instead.
+func (*InterestPosting) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *ApproveTransactionRequest) GetRequestId() int64 {
+func (x *InterestPosting) GetId() int64 {
 	if x != nil {
-		return x.RequestId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ApproveTransactionRequest) GetApprovedBy() int64 {
+func (x *InterestPosting) GetAccountNumber() string {
 	if x != nil {
-		return x.ApprovedBy
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *InterestPosting) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *InterestPosting) GetPeriodFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodFrom
+	}
+	return nil
+}
+
+func (x *InterestPosting) GetPeriodTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodTo
+	}
+	return nil
+}
+
+func (x *InterestPosting) GetAccrualCount() int32 {
+	if x != nil {
+		return x.AccrualCount
+	}
+	return 0
+}
+
+func (x *InterestPosting) GetCarriedIn() string {
+	if x != nil {
+		return x.CarriedIn
+	}
+	return ""
+}
+
+func (x *InterestPosting) GetAccrued() string {
+	if x != nil {
+		return x.Accrued
+	}
+	return ""
+}
+
+func (x *InterestPosting) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *InterestPosting) GetRemainder() string {
+	if x != nil {
+		return x.Remainder
+	}
+	return ""
+}
+
+func (x *InterestPosting) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InterestPosting) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *InterestPosting) GetReceiptCode() string {
+	if x != nil && x.ReceiptCode != nil {
+		return *x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *InterestPosting) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
 	}
-	return 0
+	return ""
 }
 
-func (x *ApproveTransactionRequest) GetApproved() bool {
+func (x *InterestPosting) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Approved
+		return x.CreatedAt
 	}
-	return false
+	return nil
 }
 
-func (x *ApproveTransactionRequest) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
+func (x *InterestPosting) GetPostedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PostedAt
 	}
-	return ""
+	return nil
 }
 
-type ApproveTransactionResponse struct {
+type InterestSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Approval      *TransactionApproval   `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
-	ReceiptCode   *string                `protobuf:"bytes,2,opt,name=receipt_code,json=receiptCode,proto3,oneof" json:"receipt_code,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Accrued       string                 `protobuf:"bytes,1,opt,name=accrued,proto3" json:"accrued,omitempty"`         // Accrued on days in the period
+	Paid          string                 `protobuf:"bytes,2,opt,name=paid,proto3" json:"paid,omitempty"`               // Posted in the period
+	Outstanding   string                 `protobuf:"bytes,3,opt,name=outstanding,proto3" json:"outstanding,omitempty"` // Accrued but not yet posted, as of now
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveTransactionResponse) Reset() {
-	*x = ApproveTransactionResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[86]
+func (x *InterestSummary) Reset() {
+	*x = InterestSummary{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveTransactionResponse) String() string {
+func (x *InterestSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveTransactionResponse) ProtoMessage() {}
+func (*InterestSummary) ProtoMessage() {}
 
-func (x *ApproveTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[86]
+func (x *InterestSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6972,59 +17089,55 @@ func (x *ApproveTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveTransactionResponse.ProtoReflect.Descriptor instead.
-func (*ApproveTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use InterestSummary.ProtoReflect.Descriptor instead.
+func (*InterestSummary) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *ApproveTransactionResponse) GetApproval() *TransactionApproval {
+func (x *InterestSummary) GetAccrued() string {
 	if x != nil {
-		return x.Approval
+		return x.Accrued
 	}
-	return nil
+	return ""
 }
 
-func (x *ApproveTransactionResponse) GetReceiptCode() string {
-	if x != nil && x.ReceiptCode != nil {
-		return *x.ReceiptCode
+func (x *InterestSummary) GetPaid() string {
+	if x != nil {
+		return x.Paid
 	}
 	return ""
 }
 
-func (x *ApproveTransactionResponse) GetMessage() string {
+func (x *InterestSummary) GetOutstanding() string {
 	if x != nil {
-		return x.Message
+		return x.Outstanding
 	}
 	return ""
 }
 
-type GetApprovalHistoryRequest struct {
+type CreateInterestRatePlanRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestedBy   *int64                 `protobuf:"varint,1,opt,name=requested_by,json=requestedBy,proto3,oneof" json:"requested_by,omitempty"`
-	Status        *ApprovalStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=accounting.v1.ApprovalStatus,oneof" json:"status,omitempty"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3,oneof" json:"from,omitempty"`
-	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3,oneof" json:"to,omitempty"`
-	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Plan          *InterestRatePlan      `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"` // id, created_at and updated_at are ignored
+	CreatedBy     string                 `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApprovalHistoryRequest) Reset() {
-	*x = GetApprovalHistoryRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[87]
+func (x *CreateInterestRatePlanRequest) Reset() {
+	*x = CreateInterestRatePlanRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApprovalHistoryRequest) String() string {
+func (x *CreateInterestRatePlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApprovalHistoryRequest) ProtoMessage() {}
+func (*CreateInterestRatePlanRequest) ProtoMessage() {}
 
-func (x *GetApprovalHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[87]
+func (x *CreateInterestRatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7035,76 +17148,47 @@ func (x *GetApprovalHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApprovalHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetApprovalHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{87}
-}
-
-func (x *GetApprovalHistoryRequest) GetRequestedBy() int64 {
-	if x != nil && x.RequestedBy != nil {
-		return *x.RequestedBy
-	}
-	return 0
-}
-
-func (x *GetApprovalHistoryRequest) GetStatus() ApprovalStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED
-}
-
-func (x *GetApprovalHistoryRequest) GetFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.From
-	}
-	return nil
+// Deprecated: Use CreateInterestRatePlanRequest.ProtoReflect.Descriptor instead.
+func (*CreateInterestRatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *GetApprovalHistoryRequest) GetTo() *timestamppb.Timestamp {
+func (x *CreateInterestRatePlanRequest) GetPlan() *InterestRatePlan {
 	if x != nil {
-		return x.To
+		return x.Plan
 	}
 	return nil
 }
 
-func (x *GetApprovalHistoryRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *GetApprovalHistoryRequest) GetOffset() int32 {
+func (x *CreateInterestRatePlanRequest) GetCreatedBy() string {
 	if x != nil {
-		return x.Offset
+		return x.CreatedBy
 	}
-	return 0
+	return ""
 }
 
-type GetApprovalHistoryResponse struct {
+type CreateInterestRatePlanResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Approvals     []*TransactionApproval `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Plan          *InterestRatePlan      `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApprovalHistoryResponse) Reset() {
-	*x = GetApprovalHistoryResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[88]
+func (x *CreateInterestRatePlanResponse) Reset() {
+	*x = CreateInterestRatePlanResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApprovalHistoryResponse) String() string {
+func (x *CreateInterestRatePlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApprovalHistoryResponse) ProtoMessage() {}
+func (*CreateInterestRatePlanResponse) ProtoMessage() {}
 
-func (x *GetApprovalHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[88]
+func (x *CreateInterestRatePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7115,62 +17199,41 @@ func (x *GetApprovalHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApprovalHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetApprovalHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use CreateInterestRatePlanResponse.ProtoReflect.Descriptor instead.
+func (*CreateInterestRatePlanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *GetApprovalHistoryResponse) GetApprovals() []*TransactionApproval {
+func (x *CreateInterestRatePlanResponse) GetPlan() *InterestRatePlan {
 	if x != nil {
-		return x.Approvals
+		return x.Plan
 	}
 	return nil
 }
 
-func (x *GetApprovalHistoryResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-type Agent struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AgentExternalId  string                 `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
-	UserExternalId   *string                `protobuf:"bytes,2,opt,name=user_external_id,json=userExternalId,proto3,oneof" json:"user_external_id,omitempty"` // Auth user ID
-	Service          *string                `protobuf:"bytes,3,opt,name=service,proto3,oneof" json:"service,omitempty"`
-	CommissionRate   *string                `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,oneof" json:"commission_rate,omitempty"` // NUMERIC as string (e.g., "0.05" for 5%)
-	RelationshipType RelationshipType       `protobuf:"varint,5,opt,name=relationship_type,json=relationshipType,proto3,enum=accounting.v1.RelationshipType" json:"relationship_type,omitempty"`
-	IsActive         bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Name             *string                `protobuf:"bytes,7,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Metadata         map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Accounts         []*Account             `protobuf:"bytes,11,rep,name=accounts,proto3" json:"accounts,omitempty"` // ✅ Agent's accounts (commission account, etc.)
-	// ✅ NEW FIELDS
-	CommissionRateForDeposit *string         `protobuf:"bytes,12,opt,name=commission_rate_for_deposit,json=commissionRateForDeposit,proto3,oneof" json:"commission_rate_for_deposit,omitempty"`  // NUMERIC as string (e.g., "0.015" for 1.5%)
-	PaymentMethod            *string         `protobuf:"bytes,13,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`                                       // mpesa, bank, cash, etc.
-	Location                 map[string]bool `protobuf:"bytes,14,rep,name=location,proto3" json:"location,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Country codes with flags:  {"KE": true, "UG": false}
-	Status                   AgentStatus     `protobuf:"varint,15,opt,name=status,proto3,enum=accounting.v1.AgentStatus" json:"status,omitempty"`                                                // active, inactive, deleted
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type UpdateInterestRatePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *InterestRatePlan      `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"` // Replaces the terms of plan.id; currency and purpose are fixed
+	UpdatedBy     string                 `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Agent) Reset() {
-	*x = Agent{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[89]
+func (x *UpdateInterestRatePlanRequest) Reset() {
+	*x = UpdateInterestRatePlanRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Agent) String() string {
+func (x *UpdateInterestRatePlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Agent) ProtoMessage() {}
+func (*UpdateInterestRatePlanRequest) ProtoMessage() {}
 
-func (x *Agent) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[89]
+func (x *UpdateInterestRatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7181,151 +17244,143 @@ func (x *Agent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Agent.ProtoReflect.Descriptor instead.
-func (*Agent) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use UpdateInterestRatePlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInterestRatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *Agent) GetAgentExternalId() string {
+func (x *UpdateInterestRatePlanRequest) GetPlan() *InterestRatePlan {
 	if x != nil {
-		return x.AgentExternalId
+		return x.Plan
 	}
-	return ""
+	return nil
 }
 
-func (x *Agent) GetUserExternalId() string {
-	if x != nil && x.UserExternalId != nil {
-		return *x.UserExternalId
+func (x *UpdateInterestRatePlanRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
 	}
 	return ""
 }
 
-func (x *Agent) GetService() string {
-	if x != nil && x.Service != nil {
-		return *x.Service
-	}
-	return ""
+type UpdateInterestRatePlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *InterestRatePlan      `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Agent) GetCommissionRate() string {
-	if x != nil && x.CommissionRate != nil {
-		return *x.CommissionRate
-	}
-	return ""
+func (x *UpdateInterestRatePlanResponse) Reset() {
+	*x = UpdateInterestRatePlanResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[208]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Agent) GetRelationshipType() RelationshipType {
-	if x != nil {
-		return x.RelationshipType
-	}
-	return RelationshipType_RELATIONSHIP_TYPE_UNSPECIFIED
+func (x *UpdateInterestRatePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Agent) GetIsActive() bool {
+func (*UpdateInterestRatePlanResponse) ProtoMessage() {}
+
+func (x *UpdateInterestRatePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[208]
 	if x != nil {
-		return x.IsActive
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Agent) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+// Deprecated: Use UpdateInterestRatePlanResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInterestRatePlanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *Agent) GetMetadata() map[string]string {
+func (x *UpdateInterestRatePlanResponse) GetPlan() *InterestRatePlan {
 	if x != nil {
-		return x.Metadata
+		return x.Plan
 	}
 	return nil
 }
 
-func (x *Agent) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+type ListInterestRatePlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      *string                `protobuf:"bytes,1,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Agent) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+func (x *ListInterestRatePlansRequest) Reset() {
+	*x = ListInterestRatePlansRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Agent) GetAccounts() []*Account {
-	if x != nil {
-		return x.Accounts
-	}
-	return nil
+func (x *ListInterestRatePlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Agent) GetCommissionRateForDeposit() string {
-	if x != nil && x.CommissionRateForDeposit != nil {
-		return *x.CommissionRateForDeposit
-	}
-	return ""
-}
+func (*ListInterestRatePlansRequest) ProtoMessage() {}
 
-func (x *Agent) GetPaymentMethod() string {
-	if x != nil && x.PaymentMethod != nil {
-		return *x.PaymentMethod
+func (x *ListInterestRatePlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[209]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Agent) GetLocation() map[string]bool {
-	if x != nil {
-		return x.Location
-	}
-	return nil
+// Deprecated: Use ListInterestRatePlansRequest.ProtoReflect.Descriptor instead.
+func (*ListInterestRatePlansRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *Agent) GetStatus() AgentStatus {
-	if x != nil {
-		return x.Status
+func (x *ListInterestRatePlansRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
 	}
-	return AgentStatus_AGENT_STATUS_UNSPECIFIED
+	return ""
 }
-
-type AgentCommission struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AgentExternalId   string                 `protobuf:"bytes,2,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
-	UserExternalId    string                 `protobuf:"bytes,3,opt,name=user_external_id,json=userExternalId,proto3" json:"user_external_id,omitempty"`
-	AgentAccountId    int64                  `protobuf:"varint,4,opt,name=agent_account_id,json=agentAccountId,proto3" json:"agent_account_id,omitempty"`
-	UserAccountId     int64                  `protobuf:"varint,5,opt,name=user_account_id,json=userAccountId,proto3" json:"user_account_id,omitempty"`
-	ReceiptCode       string                 `protobuf:"bytes,6,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"`
-	TransactionAmount string                 `protobuf:"bytes,7,opt,name=transaction_amount,json=transactionAmount,proto3" json:"transaction_amount,omitempty"` // NUMERIC as string
-	CommissionRate    string                 `protobuf:"bytes,8,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"`          // NUMERIC as string
-	CommissionAmount  string                 `protobuf:"bytes,9,opt,name=commission_amount,json=commissionAmount,proto3" json:"commission_amount,omitempty"`    // NUMERIC as string
-	Currency          string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
-	PaidOut           bool                   `protobuf:"varint,11,opt,name=paid_out,json=paidOut,proto3" json:"paid_out,omitempty"`
-	PayoutReceiptCode *string                `protobuf:"bytes,12,opt,name=payout_receipt_code,json=payoutReceiptCode,proto3,oneof" json:"payout_receipt_code,omitempty"`
-	PaidOutAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=paid_out_at,json=paidOutAt,proto3,oneof" json:"paid_out_at,omitempty"`
-	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+
+func (x *ListInterestRatePlansRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
 }
 
-func (x *AgentCommission) Reset() {
-	*x = AgentCommission{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[90]
+type ListInterestRatePlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*InterestRatePlan    `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterestRatePlansResponse) Reset() {
+	*x = ListInterestRatePlansResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AgentCommission) String() string {
+func (x *ListInterestRatePlansResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentCommission) ProtoMessage() {}
+func (*ListInterestRatePlansResponse) ProtoMessage() {}
 
-func (x *AgentCommission) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[90]
+func (x *ListInterestRatePlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7336,143 +17391,155 @@ func (x *AgentCommission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentCommission.ProtoReflect.Descriptor instead.
-func (*AgentCommission) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use ListInterestRatePlansResponse.ProtoReflect.Descriptor instead.
+func (*ListInterestRatePlansResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *AgentCommission) GetId() int64 {
+func (x *ListInterestRatePlansResponse) GetPlans() []*InterestRatePlan {
 	if x != nil {
-		return x.Id
+		return x.Plans
 	}
-	return 0
+	return nil
 }
 
-func (x *AgentCommission) GetAgentExternalId() string {
-	if x != nil {
-		return x.AgentExternalId
-	}
-	return ""
+type GetAccountInterestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AgentCommission) GetUserExternalId() string {
-	if x != nil {
-		return x.UserExternalId
-	}
-	return ""
+func (x *GetAccountInterestRequest) Reset() {
+	*x = GetAccountInterestRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[211]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AgentCommission) GetAgentAccountId() int64 {
-	if x != nil {
-		return x.AgentAccountId
-	}
-	return 0
+func (x *GetAccountInterestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AgentCommission) GetUserAccountId() int64 {
+func (*GetAccountInterestRequest) ProtoMessage() {}
+
+func (x *GetAccountInterestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[211]
 	if x != nil {
-		return x.UserAccountId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AgentCommission) GetReceiptCode() string {
-	if x != nil {
-		return x.ReceiptCode
-	}
-	return ""
+// Deprecated: Use GetAccountInterestRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountInterestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *AgentCommission) GetTransactionAmount() string {
+func (x *GetAccountInterestRequest) GetAccountNumber() string {
 	if x != nil {
-		return x.TransactionAmount
+		return x.AccountNumber
 	}
 	return ""
 }
 
-func (x *AgentCommission) GetCommissionRate() string {
+func (x *GetAccountInterestRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CommissionRate
+		return x.From
 	}
-	return ""
+	return nil
 }
 
-func (x *AgentCommission) GetCommissionAmount() string {
+func (x *GetAccountInterestRequest) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CommissionAmount
+		return x.To
 	}
-	return ""
+	return nil
 }
 
-func (x *AgentCommission) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
+type GetAccountInterestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *InterestSummary       `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Postings      []*InterestPosting     `protobuf:"bytes,2,rep,name=postings,proto3" json:"postings,omitempty"` // Most recent first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AgentCommission) GetPaidOut() bool {
+func (x *GetAccountInterestResponse) Reset() {
+	*x = GetAccountInterestResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[212]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountInterestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountInterestResponse) ProtoMessage() {}
+
+func (x *GetAccountInterestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[212]
 	if x != nil {
-		return x.PaidOut
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *AgentCommission) GetPayoutReceiptCode() string {
-	if x != nil && x.PayoutReceiptCode != nil {
-		return *x.PayoutReceiptCode
-	}
-	return ""
+// Deprecated: Use GetAccountInterestResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountInterestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *AgentCommission) GetPaidOutAt() *timestamppb.Timestamp {
+func (x *GetAccountInterestResponse) GetSummary() *InterestSummary {
 	if x != nil {
-		return x.PaidOutAt
+		return x.Summary
 	}
 	return nil
 }
 
-func (x *AgentCommission) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GetAccountInterestResponse) GetPostings() []*InterestPosting {
 	if x != nil {
-		return x.CreatedAt
+		return x.Postings
 	}
 	return nil
 }
 
-type CreateAgentRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserExternalId   *string                `protobuf:"bytes,1,opt,name=user_external_id,json=userExternalId,proto3,oneof" json:"user_external_id,omitempty"`
-	Service          *string                `protobuf:"bytes,2,opt,name=service,proto3,oneof" json:"service,omitempty"`
-	CommissionRate   *string                `protobuf:"bytes,3,opt,name=commission_rate,json=commissionRate,proto3,oneof" json:"commission_rate,omitempty"` // "0.05" for 5%
-	RelationshipType RelationshipType       `protobuf:"varint,4,opt,name=relationship_type,json=relationshipType,proto3,enum=accounting.v1.RelationshipType" json:"relationship_type,omitempty"`
-	IsActive         bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Name             *string                `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Metadata         map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	CreateAccounts   bool                   `protobuf:"varint,8,opt,name=create_accounts,json=createAccounts,proto3" json:"create_accounts,omitempty"` // ✅ Whether to auto-create commission accounts
-	// ✅ NEW FIELDS
-	CommissionRateForDeposit *string         `protobuf:"bytes,9,opt,name=commission_rate_for_deposit,json=commissionRateForDeposit,proto3,oneof" json:"commission_rate_for_deposit,omitempty"`
-	PaymentMethod            *string         `protobuf:"bytes,10,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`
-	Location                 map[string]bool `protobuf:"bytes,11,rep,name=location,proto3" json:"location,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // {"KE": true, "UG": true, "TZ": false}
-	Status                   *AgentStatus    `protobuf:"varint,12,opt,name=status,proto3,enum=accounting.v1.AgentStatus,oneof" json:"status,omitempty"`                                          // defaults to ACTIVE
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type ListInterestPostingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber *string                `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3,oneof" json:"account_number,omitempty"`
+	Status        *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAgentRequest) Reset() {
-	*x = CreateAgentRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[91]
+func (x *ListInterestPostingsRequest) Reset() {
+	*x = ListInterestPostingsRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAgentRequest) String() string {
+func (x *ListInterestPostingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAgentRequest) ProtoMessage() {}
+func (*ListInterestPostingsRequest) ProtoMessage() {}
 
-func (x *CreateAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[91]
+func (x *ListInterestPostingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7483,118 +17550,162 @@ func (x *CreateAgentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAgentRequest.ProtoReflect.Descriptor instead.
-func (*CreateAgentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use ListInterestPostingsRequest.ProtoReflect.Descriptor instead.
+func (*ListInterestPostingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *CreateAgentRequest) GetUserExternalId() string {
-	if x != nil && x.UserExternalId != nil {
-		return *x.UserExternalId
+func (x *ListInterestPostingsRequest) GetAccountNumber() string {
+	if x != nil && x.AccountNumber != nil {
+		return *x.AccountNumber
 	}
 	return ""
 }
 
-func (x *CreateAgentRequest) GetService() string {
-	if x != nil && x.Service != nil {
-		return *x.Service
+func (x *ListInterestPostingsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
 	return ""
 }
 
-func (x *CreateAgentRequest) GetCommissionRate() string {
-	if x != nil && x.CommissionRate != nil {
-		return *x.CommissionRate
+func (x *ListInterestPostingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateAgentRequest) GetRelationshipType() RelationshipType {
+func (x *ListInterestPostingsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.RelationshipType
+		return x.Offset
 	}
-	return RelationshipType_RELATIONSHIP_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *CreateAgentRequest) GetIsActive() bool {
+type ListInterestPostingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Postings      []*InterestPosting     `protobuf:"bytes,1,rep,name=postings,proto3" json:"postings,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterestPostingsResponse) Reset() {
+	*x = ListInterestPostingsResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[214]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterestPostingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterestPostingsResponse) ProtoMessage() {}
+
+func (x *ListInterestPostingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[214]
 	if x != nil {
-		return x.IsActive
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CreateAgentRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+// Deprecated: Use ListInterestPostingsResponse.ProtoReflect.Descriptor instead.
+func (*ListInterestPostingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *CreateAgentRequest) GetMetadata() map[string]string {
+func (x *ListInterestPostingsResponse) GetPostings() []*InterestPosting {
 	if x != nil {
-		return x.Metadata
+		return x.Postings
 	}
 	return nil
 }
 
-func (x *CreateAgentRequest) GetCreateAccounts() bool {
+func (x *ListInterestPostingsResponse) GetTotal() int64 {
 	if x != nil {
-		return x.CreateAccounts
+		return x.Total
 	}
-	return false
+	return 0
 }
 
-func (x *CreateAgentRequest) GetCommissionRateForDeposit() string {
-	if x != nil && x.CommissionRateForDeposit != nil {
-		return *x.CommissionRateForDeposit
-	}
-	return ""
+// Accrues the days up to and including through_date that have not accrued
+// yet, then reserves and posts interest of completed months
+type RunInterestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThroughDate   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=through_date,json=throughDate,proto3" json:"through_date,omitempty"` // Default yesterday (UTC)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAgentRequest) GetPaymentMethod() string {
-	if x != nil && x.PaymentMethod != nil {
-		return *x.PaymentMethod
-	}
-	return ""
+func (x *RunInterestRequest) Reset() {
+	*x = RunInterestRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[215]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAgentRequest) GetLocation() map[string]bool {
+func (x *RunInterestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunInterestRequest) ProtoMessage() {}
+
+func (x *RunInterestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[215]
 	if x != nil {
-		return x.Location
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateAgentRequest) GetStatus() AgentStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+// Deprecated: Use RunInterestRequest.ProtoReflect.Descriptor instead.
+func (*RunInterestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *RunInterestRequest) GetThroughDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ThroughDate
 	}
-	return AgentStatus_AGENT_STATUS_UNSPECIFIED
+	return nil
 }
 
-type CreateAgentResponse struct {
+type RunInterestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Agent         *Agent                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	AccrualCount  int32                  `protobuf:"varint,1,opt,name=accrual_count,json=accrualCount,proto3" json:"accrual_count,omitempty"`
+	PostingCount  int32                  `protobuf:"varint,2,opt,name=posting_count,json=postingCount,proto3" json:"posting_count,omitempty"` // Postings reserved
+	PostedCount   int32                  `protobuf:"varint,3,opt,name=posted_count,json=postedCount,proto3" json:"posted_count,omitempty"`
+	FailedCount   int32                  `protobuf:"varint,4,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"` // Attempts that failed; retried later
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAgentResponse) Reset() {
-	*x = CreateAgentResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[92]
+func (x *RunInterestResponse) Reset() {
+	*x = RunInterestResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAgentResponse) String() string {
+func (x *RunInterestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAgentResponse) ProtoMessage() {}
+func (*RunInterestResponse) ProtoMessage() {}
 
-func (x *CreateAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[92]
+func (x *RunInterestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7605,59 +17716,78 @@ func (x *CreateAgentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAgentResponse.ProtoReflect.Descriptor instead.
-func (*CreateAgentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use RunInterestResponse.ProtoReflect.Descriptor instead.
+func (*RunInterestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *CreateAgentResponse) GetAgent() *Agent {
+func (x *RunInterestResponse) GetAccrualCount() int32 {
 	if x != nil {
-		return x.Agent
+		return x.AccrualCount
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateAgentResponse) GetMessage() string {
+func (x *RunInterestResponse) GetPostingCount() int32 {
 	if x != nil {
-		return x.Message
+		return x.PostingCount
 	}
-	return ""
+	return 0
+}
+
+func (x *RunInterestResponse) GetPostedCount() int32 {
+	if x != nil {
+		return x.PostedCount
+	}
+	return 0
 }
 
-type UpdateAgentRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AgentExternalId  string                 `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
-	UserExternalId   *string                `protobuf:"bytes,2,opt,name=user_external_id,json=userExternalId,proto3,oneof" json:"user_external_id,omitempty"`
-	Service          *string                `protobuf:"bytes,3,opt,name=service,proto3,oneof" json:"service,omitempty"`
-	CommissionRate   *string                `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,oneof" json:"commission_rate,omitempty"`
-	RelationshipType *RelationshipType      `protobuf:"varint,5,opt,name=relationship_type,json=relationshipType,proto3,enum=accounting.v1.RelationshipType,oneof" json:"relationship_type,omitempty"`
-	IsActive         *bool                  `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	Name             *string                `protobuf:"bytes,7,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Metadata         map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// ✅ NEW FIELDS
-	CommissionRateForDeposit *string         `protobuf:"bytes,9,opt,name=commission_rate_for_deposit,json=commissionRateForDeposit,proto3,oneof" json:"commission_rate_for_deposit,omitempty"`
-	PaymentMethod            *string         `protobuf:"bytes,10,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`
-	Location                 map[string]bool `protobuf:"bytes,11,rep,name=location,proto3" json:"location,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	Status                   *AgentStatus    `protobuf:"varint,12,opt,name=status,proto3,enum=accounting.v1.AgentStatus,oneof" json:"status,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+func (x *RunInterestResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
 }
 
-func (x *UpdateAgentRequest) Reset() {
-	*x = UpdateAgentRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[93]
+// A freeze, lien or closure on an account. Released restrictions are kept as
+// history; a closure is permanent.
+type AccountRestriction struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountNumber      string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Type               string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`           // debit_freeze, credit_freeze, full_freeze, lien or closure
+	Amount             *string                `protobuf:"bytes,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"` // Liens only: held back from debits
+	Reason             string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Reference          *string                `protobuf:"bytes,6,opt,name=reference,proto3,oneof" json:"reference,omitempty"` // Case, court order or ticket number
+	CreatedBy          string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ExpiresAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // Unset = until released
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Status             string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"` // active, released or expired
+	ReleasedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=released_at,json=releasedAt,proto3,oneof" json:"released_at,omitempty"`
+	ReleasedBy         *string                `protobuf:"bytes,12,opt,name=released_by,json=releasedBy,proto3,oneof" json:"released_by,omitempty"`
+	ReleaseReason      *string                `protobuf:"bytes,13,opt,name=release_reason,json=releaseReason,proto3,oneof" json:"release_reason,omitempty"`
+	SweepAccountNumber *string                `protobuf:"bytes,14,opt,name=sweep_account_number,json=sweepAccountNumber,proto3,oneof" json:"sweep_account_number,omitempty"` // Closure only
+	SweptAmount        *string                `protobuf:"bytes,15,opt,name=swept_amount,json=sweptAmount,proto3,oneof" json:"swept_amount,omitempty"`
+	SweepReceiptCode   *string                `protobuf:"bytes,16,opt,name=sweep_receipt_code,json=sweepReceiptCode,proto3,oneof" json:"sweep_receipt_code,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AccountRestriction) Reset() {
+	*x = AccountRestriction{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAgentRequest) String() string {
+func (x *AccountRestriction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAgentRequest) ProtoMessage() {}
+func (*AccountRestriction) ProtoMessage() {}
 
-func (x *UpdateAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[93]
+func (x *AccountRestriction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7668,118 +17798,151 @@ func (x *UpdateAgentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAgentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAgentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use AccountRestriction.ProtoReflect.Descriptor instead.
+func (*AccountRestriction) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{217}
 }
 
-func (x *UpdateAgentRequest) GetAgentExternalId() string {
+func (x *AccountRestriction) GetId() int64 {
 	if x != nil {
-		return x.AgentExternalId
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateAgentRequest) GetUserExternalId() string {
-	if x != nil && x.UserExternalId != nil {
-		return *x.UserExternalId
+func (x *AccountRestriction) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
 	}
 	return ""
 }
 
-func (x *UpdateAgentRequest) GetService() string {
-	if x != nil && x.Service != nil {
-		return *x.Service
+func (x *AccountRestriction) GetType() string {
+	if x != nil {
+		return x.Type
 	}
 	return ""
 }
 
-func (x *UpdateAgentRequest) GetCommissionRate() string {
-	if x != nil && x.CommissionRate != nil {
-		return *x.CommissionRate
+func (x *AccountRestriction) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
 	}
 	return ""
 }
 
-func (x *UpdateAgentRequest) GetRelationshipType() RelationshipType {
-	if x != nil && x.RelationshipType != nil {
-		return *x.RelationshipType
+func (x *AccountRestriction) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
-	return RelationshipType_RELATIONSHIP_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *UpdateAgentRequest) GetIsActive() bool {
-	if x != nil && x.IsActive != nil {
-		return *x.IsActive
+func (x *AccountRestriction) GetReference() string {
+	if x != nil && x.Reference != nil {
+		return *x.Reference
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateAgentRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *AccountRestriction) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *UpdateAgentRequest) GetMetadata() map[string]string {
+func (x *AccountRestriction) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Metadata
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *UpdateAgentRequest) GetCommissionRateForDeposit() string {
-	if x != nil && x.CommissionRateForDeposit != nil {
-		return *x.CommissionRateForDeposit
+func (x *AccountRestriction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateAgentRequest) GetPaymentMethod() string {
-	if x != nil && x.PaymentMethod != nil {
-		return *x.PaymentMethod
+func (x *AccountRestriction) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *UpdateAgentRequest) GetLocation() map[string]bool {
+func (x *AccountRestriction) GetReleasedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Location
+		return x.ReleasedAt
 	}
 	return nil
 }
 
-func (x *UpdateAgentRequest) GetStatus() AgentStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *AccountRestriction) GetReleasedBy() string {
+	if x != nil && x.ReleasedBy != nil {
+		return *x.ReleasedBy
 	}
-	return AgentStatus_AGENT_STATUS_UNSPECIFIED
+	return ""
 }
 
-type UpdateAgentResponse struct {
+func (x *AccountRestriction) GetReleaseReason() string {
+	if x != nil && x.ReleaseReason != nil {
+		return *x.ReleaseReason
+	}
+	return ""
+}
+
+func (x *AccountRestriction) GetSweepAccountNumber() string {
+	if x != nil && x.SweepAccountNumber != nil {
+		return *x.SweepAccountNumber
+	}
+	return ""
+}
+
+func (x *AccountRestriction) GetSweptAmount() string {
+	if x != nil && x.SweptAmount != nil {
+		return *x.SweptAmount
+	}
+	return ""
+}
+
+func (x *AccountRestriction) GetSweepReceiptCode() string {
+	if x != nil && x.SweepReceiptCode != nil {
+		return *x.SweepReceiptCode
+	}
+	return ""
+}
+
+type PlaceAccountRestrictionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Agent         *Agent                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`           // Any type but closure; see CloseAccount
+	Amount        *string                `protobuf:"bytes,3,opt,name=amount,proto3,oneof" json:"amount,omitempty"` // Required for a lien
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Reference     *string                `protobuf:"bytes,5,opt,name=reference,proto3,oneof" json:"reference,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAgentResponse) Reset() {
-	*x = UpdateAgentResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[94]
+func (x *PlaceAccountRestrictionRequest) Reset() {
+	*x = PlaceAccountRestrictionRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAgentResponse) String() string {
+func (x *PlaceAccountRestrictionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAgentResponse) ProtoMessage() {}
+func (*PlaceAccountRestrictionRequest) ProtoMessage() {}
 
-func (x *UpdateAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[94]
+func (x *PlaceAccountRestrictionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7790,91 +17953,82 @@ func (x *UpdateAgentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAgentResponse.ProtoReflect.Descriptor instead.
-func (*UpdateAgentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use PlaceAccountRestrictionRequest.ProtoReflect.Descriptor instead.
+func (*PlaceAccountRestrictionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *UpdateAgentResponse) GetAgent() *Agent {
+func (x *PlaceAccountRestrictionRequest) GetAccountNumber() string {
 	if x != nil {
-		return x.Agent
+		return x.AccountNumber
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateAgentResponse) GetMessage() string {
+func (x *PlaceAccountRestrictionRequest) GetType() string {
 	if x != nil {
-		return x.Message
+		return x.Type
 	}
 	return ""
 }
 
-type DeleteAgentRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	AgentExternalId string                 `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *PlaceAccountRestrictionRequest) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return ""
 }
 
-func (x *DeleteAgentRequest) Reset() {
-	*x = DeleteAgentRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[95]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PlaceAccountRestrictionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *DeleteAgentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PlaceAccountRestrictionRequest) GetReference() string {
+	if x != nil && x.Reference != nil {
+		return *x.Reference
+	}
+	return ""
 }
 
-func (*DeleteAgentRequest) ProtoMessage() {}
-
-func (x *DeleteAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[95]
+func (x *PlaceAccountRestrictionRequest) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ExpiresAt
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteAgentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAgentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{95}
+	return nil
 }
 
-func (x *DeleteAgentRequest) GetAgentExternalId() string {
+func (x *PlaceAccountRestrictionRequest) GetCreatedBy() string {
 	if x != nil {
-		return x.AgentExternalId
+		return x.CreatedBy
 	}
 	return ""
 }
 
-type DeleteAgentResponse struct {
+type PlaceAccountRestrictionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Restriction   *AccountRestriction    `protobuf:"bytes,1,opt,name=restriction,proto3" json:"restriction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAgentResponse) Reset() {
-	*x = DeleteAgentResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[96]
+func (x *PlaceAccountRestrictionResponse) Reset() {
+	*x = PlaceAccountRestrictionResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAgentResponse) String() string {
+func (x *PlaceAccountRestrictionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAgentResponse) ProtoMessage() {}
+func (*PlaceAccountRestrictionResponse) ProtoMessage() {}
 
-func (x *DeleteAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[96]
+func (x *PlaceAccountRestrictionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7885,41 +18039,42 @@ func (x *DeleteAgentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAgentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAgentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use PlaceAccountRestrictionResponse.ProtoReflect.Descriptor instead.
+func (*PlaceAccountRestrictionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *DeleteAgentResponse) GetMessage() string {
+func (x *PlaceAccountRestrictionResponse) GetRestriction() *AccountRestriction {
 	if x != nil {
-		return x.Message
+		return x.Restriction
 	}
-	return ""
+	return nil
 }
 
-type GetAgentByIDRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	AgentExternalId string                 `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
-	IncludeAccounts bool                   `protobuf:"varint,2,opt,name=include_accounts,json=includeAccounts,proto3" json:"include_accounts,omitempty"` // ✅ Whether to include agent accounts in response
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ReleaseAccountRestrictionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestrictionId int64                  `protobuf:"varint,1,opt,name=restriction_id,json=restrictionId,proto3" json:"restriction_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReleasedBy    string                 `protobuf:"bytes,3,opt,name=released_by,json=releasedBy,proto3" json:"released_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAgentByIDRequest) Reset() {
-	*x = GetAgentByIDRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[97]
+func (x *ReleaseAccountRestrictionRequest) Reset() {
+	*x = ReleaseAccountRestrictionRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAgentByIDRequest) String() string {
+func (x *ReleaseAccountRestrictionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAgentByIDRequest) ProtoMessage() {}
+func (*ReleaseAccountRestrictionRequest) ProtoMessage() {}
 
-func (x *GetAgentByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[97]
+func (x *ReleaseAccountRestrictionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7930,47 +18085,54 @@ func (x *GetAgentByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAgentByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetAgentByIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use ReleaseAccountRestrictionRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseAccountRestrictionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{220}
 }
 
-func (x *GetAgentByIDRequest) GetAgentExternalId() string {
+func (x *ReleaseAccountRestrictionRequest) GetRestrictionId() int64 {
 	if x != nil {
-		return x.AgentExternalId
+		return x.RestrictionId
+	}
+	return 0
+}
+
+func (x *ReleaseAccountRestrictionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *GetAgentByIDRequest) GetIncludeAccounts() bool {
+func (x *ReleaseAccountRestrictionRequest) GetReleasedBy() string {
 	if x != nil {
-		return x.IncludeAccounts
+		return x.ReleasedBy
 	}
-	return false
+	return ""
 }
 
-type GetAgentByIDResponse struct {
+type ReleaseAccountRestrictionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Agent         *Agent                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Restriction   *AccountRestriction    `protobuf:"bytes,1,opt,name=restriction,proto3" json:"restriction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAgentByIDResponse) Reset() {
-	*x = GetAgentByIDResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[98]
+func (x *ReleaseAccountRestrictionResponse) Reset() {
+	*x = ReleaseAccountRestrictionResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAgentByIDResponse) String() string {
+func (x *ReleaseAccountRestrictionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAgentByIDResponse) ProtoMessage() {}
+func (*ReleaseAccountRestrictionResponse) ProtoMessage() {}
 
-func (x *GetAgentByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[98]
+func (x *ReleaseAccountRestrictionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7981,41 +18143,47 @@ func (x *GetAgentByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAgentByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetAgentByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use ReleaseAccountRestrictionResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseAccountRestrictionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{221}
 }
 
-func (x *GetAgentByIDResponse) GetAgent() *Agent {
+func (x *ReleaseAccountRestrictionResponse) GetRestriction() *AccountRestriction {
 	if x != nil {
-		return x.Agent
+		return x.Restriction
 	}
 	return nil
 }
 
-type GetAgentByUserIDRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UserExternalId  string                 `protobuf:"bytes,1,opt,name=user_external_id,json=userExternalId,proto3" json:"user_external_id,omitempty"`
-	IncludeAccounts bool                   `protobuf:"varint,2,opt,name=include_accounts,json=includeAccounts,proto3" json:"include_accounts,omitempty"` // ✅ Whether to include agent accounts in response
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Sweeps the balance to sweep_to_account_number (same currency and account
+// type) and closes the account. Holds, liens and debit freezes must be
+// released first.
+type CloseAccountRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber        string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	SweepToAccountNumber string                 `protobuf:"bytes,2,opt,name=sweep_to_account_number,json=sweepToAccountNumber,proto3" json:"sweep_to_account_number,omitempty"` // May be empty when the balance is zero
+	Reason               string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Reference            *string                `protobuf:"bytes,4,opt,name=reference,proto3,oneof" json:"reference,omitempty"`
+	ClosedBy             string                 `protobuf:"bytes,5,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *GetAgentByUserIDRequest) Reset() {
-	*x = GetAgentByUserIDRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[99]
+func (x *CloseAccountRequest) Reset() {
+	*x = CloseAccountRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAgentByUserIDRequest) String() string {
+func (x *CloseAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAgentByUserIDRequest) ProtoMessage() {}
+func (*CloseAccountRequest) ProtoMessage() {}
 
-func (x *GetAgentByUserIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[99]
+func (x *CloseAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8026,47 +18194,68 @@ func (x *GetAgentByUserIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAgentByUserIDRequest.ProtoReflect.Descriptor instead.
-func (*GetAgentByUserIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use CloseAccountRequest.ProtoReflect.Descriptor instead.
+func (*CloseAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{222}
 }
 
-func (x *GetAgentByUserIDRequest) GetUserExternalId() string {
+func (x *CloseAccountRequest) GetAccountNumber() string {
 	if x != nil {
-		return x.UserExternalId
+		return x.AccountNumber
 	}
 	return ""
 }
 
-func (x *GetAgentByUserIDRequest) GetIncludeAccounts() bool {
+func (x *CloseAccountRequest) GetSweepToAccountNumber() string {
 	if x != nil {
-		return x.IncludeAccounts
+		return x.SweepToAccountNumber
 	}
-	return false
+	return ""
+}
+
+func (x *CloseAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CloseAccountRequest) GetReference() string {
+	if x != nil && x.Reference != nil {
+		return *x.Reference
+	}
+	return ""
+}
+
+func (x *CloseAccountRequest) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
 }
 
-type GetAgentByUserIDResponse struct {
+type CloseAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Agent         *Agent                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Closure       *AccountRestriction    `protobuf:"bytes,1,opt,name=closure,proto3" json:"closure,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAgentByUserIDResponse) Reset() {
-	*x = GetAgentByUserIDResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[100]
+func (x *CloseAccountResponse) Reset() {
+	*x = CloseAccountResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAgentByUserIDResponse) String() string {
+func (x *CloseAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAgentByUserIDResponse) ProtoMessage() {}
+func (*CloseAccountResponse) ProtoMessage() {}
 
-func (x *GetAgentByUserIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[100]
+func (x *CloseAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8077,47 +18266,44 @@ func (x *GetAgentByUserIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAgentByUserIDResponse.ProtoReflect.Descriptor instead.
-func (*GetAgentByUserIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use CloseAccountResponse.ProtoReflect.Descriptor instead.
+func (*CloseAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{223}
 }
 
-func (x *GetAgentByUserIDResponse) GetAgent() *Agent {
+func (x *CloseAccountResponse) GetClosure() *AccountRestriction {
 	if x != nil {
-		return x.Agent
+		return x.Closure
 	}
 	return nil
 }
 
-type ListAgentsRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Limit           int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Max 100
-	Offset          int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	IncludeAccounts bool                   `protobuf:"varint,3,opt,name=include_accounts,json=includeAccounts,proto3" json:"include_accounts,omitempty"` // ✅ Whether to include accounts for each agent
-	// ✅ NEW FILTERS
-	CountryCode      *string           `protobuf:"bytes,4,opt,name=country_code,json=countryCode,proto3,oneof" json:"country_code,omitempty"`                                                     // Filter by country (e.g., "KE", "UG")
-	PaymentMethod    *string           `protobuf:"bytes,5,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`                                               // Filter by payment method (e.g., "mpesa", "bank")
-	Status           *AgentStatus      `protobuf:"varint,6,opt,name=status,proto3,enum=accounting.v1.AgentStatus,oneof" json:"status,omitempty"`                                                  // Filter by status
-	RelationshipType *RelationshipType `protobuf:"varint,7,opt,name=relationship_type,json=relationshipType,proto3,enum=accounting.v1.RelationshipType,oneof" json:"relationship_type,omitempty"` // Filter by relationship type
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ListAccountRestrictionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber *string                `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3,oneof" json:"account_number,omitempty"`
+	Type          *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAgentsRequest) Reset() {
-	*x = ListAgentsRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[101]
+func (x *ListAccountRestrictionsRequest) Reset() {
+	*x = ListAccountRestrictionsRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAgentsRequest) String() string {
+func (x *ListAccountRestrictionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAgentsRequest) ProtoMessage() {}
+func (*ListAccountRestrictionsRequest) ProtoMessage() {}
 
-func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[101]
+func (x *ListAccountRestrictionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8128,83 +18314,69 @@ func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAgentsRequest.ProtoReflect.Descriptor instead.
-func (*ListAgentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use ListAccountRestrictionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountRestrictionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{224}
 }
 
-func (x *ListAgentsRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
+func (x *ListAccountRestrictionsRequest) GetAccountNumber() string {
+	if x != nil && x.AccountNumber != nil {
+		return *x.AccountNumber
 	}
-	return 0
+	return ""
 }
 
-func (x *ListAgentsRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
+func (x *ListAccountRestrictionsRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *ListAgentsRequest) GetIncludeAccounts() bool {
+func (x *ListAccountRestrictionsRequest) GetActiveOnly() bool {
 	if x != nil {
-		return x.IncludeAccounts
+		return x.ActiveOnly
 	}
 	return false
 }
 
-func (x *ListAgentsRequest) GetCountryCode() string {
-	if x != nil && x.CountryCode != nil {
-		return *x.CountryCode
-	}
-	return ""
-}
-
-func (x *ListAgentsRequest) GetPaymentMethod() string {
-	if x != nil && x.PaymentMethod != nil {
-		return *x.PaymentMethod
-	}
-	return ""
-}
-
-func (x *ListAgentsRequest) GetStatus() AgentStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *ListAccountRestrictionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
-	return AgentStatus_AGENT_STATUS_UNSPECIFIED
+	return 0
 }
 
-func (x *ListAgentsRequest) GetRelationshipType() RelationshipType {
-	if x != nil && x.RelationshipType != nil {
-		return *x.RelationshipType
+func (x *ListAccountRestrictionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
 	}
-	return RelationshipType_RELATIONSHIP_TYPE_UNSPECIFIED
+	return 0
 }
 
-type ListAgentsResponse struct {
+type ListAccountRestrictionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Agents        []*Agent               `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Restrictions  []*AccountRestriction  `protobuf:"bytes,1,rep,name=restrictions,proto3" json:"restrictions,omitempty"` // Most recent first
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAgentsResponse) Reset() {
-	*x = ListAgentsResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[102]
+func (x *ListAccountRestrictionsResponse) Reset() {
+	*x = ListAccountRestrictionsResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAgentsResponse) String() string {
+func (x *ListAccountRestrictionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAgentsResponse) ProtoMessage() {}
+func (*ListAccountRestrictionsResponse) ProtoMessage() {}
 
-func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[102]
+func (x *ListAccountRestrictionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8215,49 +18387,59 @@ func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAgentsResponse.ProtoReflect.Descriptor instead.
-func (*ListAgentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use ListAccountRestrictionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountRestrictionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *ListAgentsResponse) GetAgents() []*Agent {
+func (x *ListAccountRestrictionsResponse) GetRestrictions() []*AccountRestriction {
 	if x != nil {
-		return x.Agents
+		return x.Restrictions
 	}
 	return nil
 }
 
-func (x *ListAgentsResponse) GetTotal() int32 {
+func (x *ListAccountRestrictionsResponse) GetTotal() int64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-type ListCommissionsForAgentRequest struct {
+// A top-up or reset of a demo wallet, posted as a demo_funding journal
+// against the demo liquidity account
+type DemoRefill struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	AgentExternalId string                 `protobuf:"bytes,1,opt,name=agent_external_id,json=agentExternalId,proto3" json:"agent_external_id,omitempty"`
-	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Max 100
-	Offset          int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 0 when a reset found the wallet already at the default
+	AccountNumber   string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Currency        string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Action          string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // top_up or reset
+	Amount          string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"` // Signed: negative when a reset debited the wallet
+	BalanceBefore   string                 `protobuf:"bytes,6,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	BalanceAfter    string                 `protobuf:"bytes,7,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	ReceiptCode     *string                `protobuf:"bytes,8,opt,name=receipt_code,json=receiptCode,proto3,oneof" json:"receipt_code,omitempty"`
+	RequestedBy     string                 `protobuf:"bytes,9,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	NextAvailableAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=next_available_at,json=nextAvailableAt,proto3" json:"next_available_at,omitempty"` // When the same action may run again
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListCommissionsForAgentRequest) Reset() {
-	*x = ListCommissionsForAgentRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[103]
+func (x *DemoRefill) Reset() {
+	*x = DemoRefill{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommissionsForAgentRequest) String() string {
+func (x *DemoRefill) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommissionsForAgentRequest) ProtoMessage() {}
+func (*DemoRefill) ProtoMessage() {}
 
-func (x *ListCommissionsForAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[103]
+func (x *DemoRefill) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8268,111 +18450,115 @@ func (x *ListCommissionsForAgentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommissionsForAgentRequest.ProtoReflect.Descriptor instead.
-func (*ListCommissionsForAgentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use DemoRefill.ProtoReflect.Descriptor instead.
+func (*DemoRefill) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{226}
 }
 
-func (x *ListCommissionsForAgentRequest) GetAgentExternalId() string {
+func (x *DemoRefill) GetId() int64 {
 	if x != nil {
-		return x.AgentExternalId
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *ListCommissionsForAgentRequest) GetLimit() int32 {
+func (x *DemoRefill) GetAccountNumber() string {
 	if x != nil {
-		return x.Limit
+		return x.AccountNumber
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCommissionsForAgentRequest) GetOffset() int32 {
+func (x *DemoRefill) GetCurrency() string {
 	if x != nil {
-		return x.Offset
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-type ListCommissionsForAgentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Commissions   []*AgentCommission     `protobuf:"bytes,1,rep,name=commissions,proto3" json:"commissions,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DemoRefill) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
 }
 
-func (x *ListCommissionsForAgentResponse) Reset() {
-	*x = ListCommissionsForAgentResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[104]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DemoRefill) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
 }
 
-func (x *ListCommissionsForAgentResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DemoRefill) GetBalanceBefore() string {
+	if x != nil {
+		return x.BalanceBefore
+	}
+	return ""
 }
 
-func (*ListCommissionsForAgentResponse) ProtoMessage() {}
-
-func (x *ListCommissionsForAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[104]
+func (x *DemoRefill) GetBalanceAfter() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BalanceAfter
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListCommissionsForAgentResponse.ProtoReflect.Descriptor instead.
-func (*ListCommissionsForAgentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{104}
+func (x *DemoRefill) GetReceiptCode() string {
+	if x != nil && x.ReceiptCode != nil {
+		return *x.ReceiptCode
+	}
+	return ""
 }
 
-func (x *ListCommissionsForAgentResponse) GetCommissions() []*AgentCommission {
+func (x *DemoRefill) GetRequestedBy() string {
 	if x != nil {
-		return x.Commissions
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *DemoRefill) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *ListCommissionsForAgentResponse) GetTotal() int32 {
+func (x *DemoRefill) GetNextAvailableAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Total
+		return x.NextAvailableAt
 	}
-	return 0
+	return nil
 }
 
-// ✅ NEW:  Get agents by multiple countries
-type GetAgentsByCountriesRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CountryCodes    []string               `protobuf:"bytes,1,rep,name=country_codes,json=countryCodes,proto3" json:"country_codes,omitempty"` // ["KE", "UG", "TZ"]
-	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset          int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	IncludeAccounts bool                   `protobuf:"varint,4,opt,name=include_accounts,json=includeAccounts,proto3" json:"include_accounts,omitempty"`
-	Status          *AgentStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=accounting.v1.AgentStatus,oneof" json:"status,omitempty"` // Filter by status
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Adds play money to the owner's demo wallet in one currency
+type TopUpDemoAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerType     OwnerType              `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType" json:"owner_type,omitempty"` // Defaults to user when unspecified
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        *string                `protobuf:"bytes,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"` // Defaults to the currency's default demo balance
+	RequestedBy   string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAgentsByCountriesRequest) Reset() {
-	*x = GetAgentsByCountriesRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[105]
+func (x *TopUpDemoAccountRequest) Reset() {
+	*x = TopUpDemoAccountRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAgentsByCountriesRequest) String() string {
+func (x *TopUpDemoAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAgentsByCountriesRequest) ProtoMessage() {}
+func (*TopUpDemoAccountRequest) ProtoMessage() {}
 
-func (x *GetAgentsByCountriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[105]
+func (x *TopUpDemoAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8383,69 +18569,68 @@ func (x *GetAgentsByCountriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAgentsByCountriesRequest.ProtoReflect.Descriptor instead.
-func (*GetAgentsByCountriesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use TopUpDemoAccountRequest.ProtoReflect.Descriptor instead.
+func (*TopUpDemoAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{227}
 }
 
-func (x *GetAgentsByCountriesRequest) GetCountryCodes() []string {
+func (x *TopUpDemoAccountRequest) GetOwnerType() OwnerType {
 	if x != nil {
-		return x.CountryCodes
+		return x.OwnerType
 	}
-	return nil
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
 }
 
-func (x *GetAgentsByCountriesRequest) GetLimit() int32 {
+func (x *TopUpDemoAccountRequest) GetOwnerId() string {
 	if x != nil {
-		return x.Limit
+		return x.OwnerId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAgentsByCountriesRequest) GetOffset() int32 {
+func (x *TopUpDemoAccountRequest) GetCurrency() string {
 	if x != nil {
-		return x.Offset
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAgentsByCountriesRequest) GetIncludeAccounts() bool {
-	if x != nil {
-		return x.IncludeAccounts
+func (x *TopUpDemoAccountRequest) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
 	}
-	return false
+	return ""
 }
 
-func (x *GetAgentsByCountriesRequest) GetStatus() AgentStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *TopUpDemoAccountRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
 	}
-	return AgentStatus_AGENT_STATUS_UNSPECIFIED
+	return ""
 }
 
-type GetAgentsByCountriesResponse struct {
+type TopUpDemoAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Agents        []*Agent               `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Refill        *DemoRefill            `protobuf:"bytes,1,opt,name=refill,proto3" json:"refill,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAgentsByCountriesResponse) Reset() {
-	*x = GetAgentsByCountriesResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[106]
+func (x *TopUpDemoAccountResponse) Reset() {
+	*x = TopUpDemoAccountResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAgentsByCountriesResponse) String() string {
+func (x *TopUpDemoAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAgentsByCountriesResponse) ProtoMessage() {}
+func (*TopUpDemoAccountResponse) ProtoMessage() {}
 
-func (x *GetAgentsByCountriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[106]
+func (x *TopUpDemoAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8456,49 +18641,44 @@ func (x *GetAgentsByCountriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAgentsByCountriesResponse.ProtoReflect.Descriptor instead.
-func (*GetAgentsByCountriesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use TopUpDemoAccountResponse.ProtoReflect.Descriptor instead.
+func (*TopUpDemoAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{228}
 }
 
-func (x *GetAgentsByCountriesResponse) GetAgents() []*Agent {
+func (x *TopUpDemoAccountResponse) GetRefill() *DemoRefill {
 	if x != nil {
-		return x.Agents
+		return x.Refill
 	}
 	return nil
 }
 
-func (x *GetAgentsByCountriesResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-// ✅ NEW: Get agent statistics
-type GetAgentStatsRequest struct {
+// Moves the owner's demo wallet back to the default demo balance
+type ResetDemoAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CountryCode   *string                `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3,oneof" json:"country_code,omitempty"`
-	PaymentMethod *string                `protobuf:"bytes,2,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`
+	OwnerType     OwnerType              `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=accounting.v1.OwnerType" json:"owner_type,omitempty"` // Defaults to user when unspecified
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAgentStatsRequest) Reset() {
-	*x = GetAgentStatsRequest{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[107]
+func (x *ResetDemoAccountRequest) Reset() {
+	*x = ResetDemoAccountRequest{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAgentStatsRequest) String() string {
+func (x *ResetDemoAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAgentStatsRequest) ProtoMessage() {}
+func (*ResetDemoAccountRequest) ProtoMessage() {}
 
-func (x *GetAgentStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[107]
+func (x *ResetDemoAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8509,51 +18689,61 @@ func (x *GetAgentStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAgentStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetAgentStatsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use ResetDemoAccountRequest.ProtoReflect.Descriptor instead.
+func (*ResetDemoAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{229}
 }
 
-func (x *GetAgentStatsRequest) GetCountryCode() string {
-	if x != nil && x.CountryCode != nil {
-		return *x.CountryCode
+func (x *ResetDemoAccountRequest) GetOwnerType() OwnerType {
+	if x != nil {
+		return x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *ResetDemoAccountRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
 	}
 	return ""
 }
 
-func (x *GetAgentStatsRequest) GetPaymentMethod() string {
-	if x != nil && x.PaymentMethod != nil {
-		return *x.PaymentMethod
+func (x *ResetDemoAccountRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
 	return ""
 }
 
-type GetAgentStatsResponse struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	TotalAgents           int32                  `protobuf:"varint,1,opt,name=total_agents,json=totalAgents,proto3" json:"total_agents,omitempty"`
-	ActiveAgents          int32                  `protobuf:"varint,2,opt,name=active_agents,json=activeAgents,proto3" json:"active_agents,omitempty"`
-	InactiveAgents        int32                  `protobuf:"varint,3,opt,name=inactive_agents,json=inactiveAgents,proto3" json:"inactive_agents,omitempty"`
-	AgentsByCountry       map[string]int32       `protobuf:"bytes,4,rep,name=agents_by_country,json=agentsByCountry,proto3" json:"agents_by_country,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`                     // {"KE": 50, "UG": 30}
-	AgentsByPaymentMethod map[string]int32       `protobuf:"bytes,5,rep,name=agents_by_payment_method,json=agentsByPaymentMethod,proto3" json:"agents_by_payment_method,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // {"mpesa": 40, "bank": 40}
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *ResetDemoAccountRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
 }
 
-func (x *GetAgentStatsResponse) Reset() {
-	*x = GetAgentStatsResponse{}
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[108]
+type ResetDemoAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refill        *DemoRefill            `protobuf:"bytes,1,opt,name=refill,proto3" json:"refill,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetDemoAccountResponse) Reset() {
+	*x = ResetDemoAccountResponse{}
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAgentStatsResponse) String() string {
+func (x *ResetDemoAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAgentStatsResponse) ProtoMessage() {}
+func (*ResetDemoAccountResponse) ProtoMessage() {}
 
-func (x *GetAgentStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_shared_accounting_account_proto_msgTypes[108]
+func (x *ResetDemoAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shared_accounting_account_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8564,42 +18754,14 @@ func (x *GetAgentStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAgentStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetAgentStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{108}
-}
-
-func (x *GetAgentStatsResponse) GetTotalAgents() int32 {
-	if x != nil {
-		return x.TotalAgents
-	}
-	return 0
-}
-
-func (x *GetAgentStatsResponse) GetActiveAgents() int32 {
-	if x != nil {
-		return x.ActiveAgents
-	}
-	return 0
-}
-
-func (x *GetAgentStatsResponse) GetInactiveAgents() int32 {
-	if x != nil {
-		return x.InactiveAgents
-	}
-	return 0
-}
-
-func (x *GetAgentStatsResponse) GetAgentsByCountry() map[string]int32 {
-	if x != nil {
-		return x.AgentsByCountry
-	}
-	return nil
+// Deprecated: Use ResetDemoAccountResponse.ProtoReflect.Descriptor instead.
+func (*ResetDemoAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shared_accounting_account_proto_rawDescGZIP(), []int{230}
 }
 
-func (x *GetAgentStatsResponse) GetAgentsByPaymentMethod() map[string]int32 {
+func (x *ResetDemoAccountResponse) GetRefill() *DemoRefill {
 	if x != nil {
-		return x.AgentsByPaymentMethod
+		return x.Refill
 	}
 	return nil
 }
@@ -8608,7 +18770,7 @@ var File_proto_shared_accounting_account_proto protoreflect.FileDescriptor
 
 const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\n" +
-	"%proto/shared/accounting/account.proto\x12\raccounting.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd2\x04\n" +
+	"%proto/shared/accounting/account.proto\x12\raccounting.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc7\x05\n" +
 	"\aAccount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
 	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x127\n" +
@@ -8621,21 +18783,25 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\tis_active\x18\b \x01(\bR\bisActive\x12\x1b\n" +
 	"\tis_locked\x18\t \x01(\bR\bisLocked\x12'\n" +
 	"\x0foverdraft_limit\x18\n" +
-	" \x01(\x01R\x0eoverdraftLimit\x12&\n" +
+	" \x01(\tR\x0eoverdraftLimit\x12&\n" +
 	"\x0fparent_agent_id\x18\v \x01(\x03R\rparentAgentId\x12'\n" +
 	"\x0fcommission_rate\x18\f \x01(\tR\x0ecommissionRate\x129\n" +
 	"\n" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe4\x02\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x127\n" +
+	"\x15parent_account_number\x18\x0f \x01(\tH\x00R\x13parentAccountNumber\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x10 \x01(\tH\x01R\x04name\x88\x01\x01B\x18\n" +
+	"\x16_parent_account_numberB\a\n" +
+	"\x05_name\"\xe4\x02\n" +
 	"\aBalance\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\x03R\taccountId\x12%\n" +
 	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x18\n" +
-	"\abalance\x18\x03 \x01(\x01R\abalance\x12+\n" +
-	"\x11available_balance\x18\x04 \x01(\x01R\x10availableBalance\x12#\n" +
-	"\rpending_debit\x18\x05 \x01(\x01R\fpendingDebit\x12%\n" +
-	"\x0epending_credit\x18\x06 \x01(\x01R\rpendingCredit\x12\x1a\n" +
+	"\abalance\x18\x03 \x01(\tR\abalance\x12+\n" +
+	"\x11available_balance\x18\x04 \x01(\tR\x10availableBalance\x12#\n" +
+	"\rpending_debit\x18\x05 \x01(\tR\fpendingDebit\x12%\n" +
+	"\x0epending_credit\x18\x06 \x01(\tR\rpendingCredit\x12\x1a\n" +
 	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x18\n" +
 	"\aversion\x18\b \x01(\x03R\aversion\x12J\n" +
 	"\x13last_transaction_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x11lastTransactionAt\"\xf8\x02\n" +
@@ -8646,7 +18812,7 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x127\n" +
 	"\apurpose\x18\x04 \x01(\x0e2\x1d.accounting.v1.AccountPurposeR\apurpose\x12=\n" +
 	"\faccount_type\x18\x05 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12'\n" +
-	"\x0foverdraft_limit\x18\x06 \x01(\x01R\x0eoverdraftLimit\x12&\n" +
+	"\x0foverdraft_limit\x18\x06 \x01(\tR\x0eoverdraftLimit\x12&\n" +
 	"\x0fparent_agent_id\x18\a \x01(\x03R\rparentAgentId\x12'\n" +
 	"\x0fcommission_rate\x18\b \x01(\tR\x0ecommissionRate\"X\n" +
 	"\x15CreateAccountsRequest\x12?\n" +
@@ -8684,14 +18850,39 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12 \n" +
 	"\tis_active\x18\x02 \x01(\bH\x00R\bisActive\x88\x01\x01\x12 \n" +
 	"\tis_locked\x18\x03 \x01(\bH\x01R\bisLocked\x88\x01\x01\x12,\n" +
-	"\x0foverdraft_limit\x18\x04 \x01(\x01H\x02R\x0eoverdraftLimit\x88\x01\x01B\f\n" +
+	"\x0foverdraft_limit\x18\x04 \x01(\tH\x02R\x0eoverdraftLimit\x88\x01\x01B\f\n" +
 	"\n" +
 	"_is_activeB\f\n" +
 	"\n" +
 	"_is_lockedB\x12\n" +
 	"\x10_overdraft_limit\"I\n" +
 	"\x15UpdateAccountResponse\x120\n" +
-	"\aaccount\x18\x01 \x01(\v2\x16.accounting.v1.AccountR\aaccount\"k\n" +
+	"\aaccount\x18\x01 \x01(\v2\x16.accounting.v1.AccountR\aaccount\"\xee\x01\n" +
+	"\x17CreateSubAccountRequest\x122\n" +
+	"\x15parent_account_number\x18\x01 \x01(\tR\x13parentAccountNumber\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
+	"\apurpose\x18\x03 \x01(\x0e2\x1d.accounting.v1.AccountPurposeR\apurpose\x127\n" +
+	"\n" +
+	"owner_type\x18\x04 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x05 \x01(\tR\aownerId\"L\n" +
+	"\x18CreateSubAccountResponse\x120\n" +
+	"\aaccount\x18\x01 \x01(\v2\x16.accounting.v1.AccountR\aaccount\"\xcb\x01\n" +
+	"\x16ListSubAccountsRequest\x122\n" +
+	"\x15parent_account_number\x18\x01 \x01(\tR\x13parentAccountNumber\x127\n" +
+	"\n" +
+	"owner_type\x18\x02 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12)\n" +
+	"\x10include_inactive\x18\x04 \x01(\bR\x0fincludeInactive\"\x85\x01\n" +
+	"\n" +
+	"SubAccount\x120\n" +
+	"\aaccount\x18\x01 \x01(\v2\x16.accounting.v1.AccountR\aaccount\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\tR\abalance\x12+\n" +
+	"\x11available_balance\x18\x03 \x01(\tR\x10availableBalance\"\xf5\x01\n" +
+	"\x17ListSubAccountsResponse\x121\n" +
+	"\x06parent\x18\x01 \x01(\v2\x19.accounting.v1.SubAccountR\x06parent\x12<\n" +
+	"\fsub_accounts\x18\x02 \x03(\v2\x19.accounting.v1.SubAccountR\vsubAccounts\x12*\n" +
+	"\x11rolled_up_balance\x18\x03 \x01(\tR\x0frolledUpBalance\x12=\n" +
+	"\x1brolled_up_available_balance\x18\x04 \x01(\tR\x18rolledUpAvailableBalance\"k\n" +
 	"\x11GetBalanceRequest\x12\x1f\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\x03H\x00R\taccountId\x12'\n" +
@@ -8702,7 +18893,7 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\abalance\x18\x01 \x01(\v2\x16.accounting.v1.BalanceR\abalance\"\xc9\x01\n" +
 	"\vLedgerEntry\x12%\n" +
 	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12(\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12(\n" +
 	"\x05dr_cr\x18\x03 \x01(\x0e2\x13.accounting.v1.DrCrR\x04drCr\x12\x1a\n" +
 	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12%\n" +
 	"\vdescription\x18\x05 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
@@ -8731,9 +18922,9 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\freceipt_code\x18\x01 \x01(\tR\vreceiptCode\x12%\n" +
 	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x128\n" +
 	"\x06status\x18\x03 \x01(\x0e2 .accounting.v1.TransactionStatusR\x06status\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1a\n" +
 	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x10\n" +
-	"\x03fee\x18\x06 \x01(\x01R\x03fee\x12,\n" +
+	"\x03fee\x18\x06 \x01(\tR\x03fee\x12,\n" +
 	"\x12processing_time_ms\x18\a \x01(\x03R\x10processingTimeMs\x129\n" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x99\x05\n" +
@@ -8761,9 +18952,9 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\freceipt_code\x18\x01 \x01(\tR\vreceiptCode\x12%\n" +
 	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x128\n" +
 	"\x06status\x18\x03 \x01(\x0e2 .accounting.v1.TransactionStatusR\x06status\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1a\n" +
 	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x10\n" +
-	"\x03fee\x18\x06 \x01(\x01R\x03fee\x12,\n" +
+	"\x03fee\x18\x06 \x01(\tR\x03fee\x12,\n" +
 	"\x12processing_time_ms\x18\a \x01(\x03R\x10processingTimeMs\x129\n" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"@\n" +
@@ -8807,10 +18998,10 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\n" +
 	"account_id\x18\x03 \x01(\x03R\taccountId\x12%\n" +
 	"\x0eaccount_number\x18\x04 \x01(\tR\raccountNumber\x12\x16\n" +
-	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12(\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12(\n" +
 	"\x05dr_cr\x18\x06 \x01(\x0e2\x13.accounting.v1.DrCrR\x04drCr\x12\x1a\n" +
 	"\bcurrency\x18\a \x01(\tR\bcurrency\x12#\n" +
-	"\rbalance_after\x18\b \x01(\x01R\fbalanceAfter\x12%\n" +
+	"\rbalance_after\x18\b \x01(\tR\fbalanceAfter\x12%\n" +
 	"\vdescription\x18\t \x01(\tH\x00R\vdescription\x88\x01\x01\x12&\n" +
 	"\freceipt_code\x18\n" +
 	" \x01(\tH\x01R\vreceiptCode\x88\x01\x01\x129\n" +
@@ -8856,27 +19047,41 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\x03_to\"e\n" +
 	"\x1cListLedgersByAccountResponse\x12/\n" +
 	"\aledgers\x18\x01 \x03(\v2\x15.accounting.v1.LedgerR\aledgers\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xd9\x03\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xa7\x04\n" +
 	"\x10AccountStatement\x12%\n" +
 	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12=\n" +
 	"\faccount_type\x18\x02 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12/\n" +
 	"\aledgers\x18\x03 \x03(\v2\x15.accounting.v1.LedgerR\aledgers\x12'\n" +
-	"\x0fopening_balance\x18\x04 \x01(\x01R\x0eopeningBalance\x12'\n" +
-	"\x0fclosing_balance\x18\x05 \x01(\x01R\x0eclosingBalance\x12!\n" +
-	"\ftotal_debits\x18\x06 \x01(\x01R\vtotalDebits\x12#\n" +
-	"\rtotal_credits\x18\a \x01(\x01R\ftotalCredits\x12=\n" +
+	"\x0fopening_balance\x18\x04 \x01(\tR\x0eopeningBalance\x12'\n" +
+	"\x0fclosing_balance\x18\x05 \x01(\tR\x0eclosingBalance\x12!\n" +
+	"\ftotal_debits\x18\x06 \x01(\tR\vtotalDebits\x12#\n" +
+	"\rtotal_credits\x18\a \x01(\tR\ftotalCredits\x12=\n" +
 	"\fperiod_start\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
 	"\n" +
 	"period_end\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12\x1a\n" +
 	"\bcurrency\x18\n" +
-	" \x01(\tR\bcurrency\"\xde\x01\n" +
+	" \x01(\tR\bcurrency\x12?\n" +
+	"\binterest\x18\v \x01(\v2\x1e.accounting.v1.InterestSummaryH\x00R\binterest\x88\x01\x01B\v\n" +
+	"\t_interest\"\xde\x01\n" +
 	"\x1aGetAccountStatementRequest\x12%\n" +
 	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12=\n" +
 	"\faccount_type\x18\x02 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12.\n" +
 	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
 	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\\\n" +
 	"\x1bGetAccountStatementResponse\x12=\n" +
-	"\tstatement\x18\x01 \x01(\v2\x1f.accounting.v1.AccountStatementR\tstatement\"\x89\x02\n" +
+	"\tstatement\x18\x01 \x01(\v2\x1f.accounting.v1.AccountStatementR\tstatement\"\x99\x02\n" +
+	"\x1dExportAccountStatementRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12=\n" +
+	"\faccount_type\x18\x02 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x126\n" +
+	"\x06format\x18\x05 \x01(\x0e2\x1e.accounting.v1.StatementFormatR\x06format\"\x98\x01\n" +
+	"\x1bExportAccountStatementChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12&\n" +
+	"\fcontent_type\x18\x02 \x01(\tH\x00R\vcontentType\x88\x01\x01\x12\x1f\n" +
+	"\bfilename\x18\x03 \x01(\tH\x01R\bfilename\x88\x01\x01B\x0f\n" +
+	"\r_content_typeB\v\n" +
+	"\t_filename\"\x89\x02\n" +
 	"\x18GetOwnerStatementRequest\x127\n" +
 	"\n" +
 	"owner_type\x18\x01 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\townerType\x12\x19\n" +
@@ -8894,14 +19099,22 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12=\n" +
 	"\faccount_type\x18\x03 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12H\n" +
 	"\x10account_balances\x18\x04 \x03(\v2\x1d.accounting.v1.AccountBalanceR\x0faccountBalances\x12?\n" +
-	"\x1ctotal_balance_usd_equivalent\x18\x05 \x01(\x01R\x19totalBalanceUsdEquivalent\"\xb9\x01\n" +
+	"\x1ctotal_balance_usd_equivalent\x18\x05 \x01(\tR\x19totalBalanceUsdEquivalent\"\xc5\x03\n" +
 	"\x0eAccountBalance\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\x03R\taccountId\x12%\n" +
 	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x1a\n" +
 	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x18\n" +
-	"\abalance\x18\x04 \x01(\x01R\abalance\x12+\n" +
-	"\x11available_balance\x18\x05 \x01(\x01R\x10availableBalance\"\xab\x01\n" +
+	"\abalance\x18\x04 \x01(\tR\abalance\x12+\n" +
+	"\x11available_balance\x18\x05 \x01(\tR\x10availableBalance\x127\n" +
+	"\x15parent_account_number\x18\x06 \x01(\tH\x00R\x13parentAccountNumber\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\a \x01(\tH\x01R\x04name\x88\x01\x01\x12*\n" +
+	"\x11sub_account_count\x18\b \x01(\x05R\x0fsubAccountCount\x12*\n" +
+	"\x11rolled_up_balance\x18\t \x01(\tR\x0frolledUpBalance\x12=\n" +
+	"\x1brolled_up_available_balance\x18\n" +
+	" \x01(\tR\x18rolledUpAvailableBalanceB\x18\n" +
+	"\x16_parent_account_numberB\a\n" +
+	"\x05_name\"\xab\x01\n" +
 	"\x16GetOwnerSummaryRequest\x127\n" +
 	"\n" +
 	"owner_type\x18\x01 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\townerType\x12\x19\n" +
@@ -8916,12 +19129,12 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\n" +
 	"account_id\x18\x03 \x01(\x03R\taccountId\x12\x1a\n" +
 	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x1f\n" +
-	"\vtotal_debit\x18\x05 \x01(\x01R\n" +
+	"\vtotal_debit\x18\x05 \x01(\tR\n" +
 	"totalDebit\x12!\n" +
-	"\ftotal_credit\x18\x06 \x01(\x01R\vtotalCredit\x12\x18\n" +
-	"\abalance\x18\a \x01(\x01R\abalance\x12\x1d\n" +
+	"\ftotal_credit\x18\x06 \x01(\tR\vtotalCredit\x12\x18\n" +
+	"\abalance\x18\a \x01(\tR\abalance\x12\x1d\n" +
 	"\n" +
-	"net_change\x18\b \x01(\x01R\tnetChange\x12.\n" +
+	"net_change\x18\b \x01(\tR\tnetChange\x12.\n" +
 	"\x04date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\x8b\x01\n" +
 	"\x1aGenerateDailyReportRequest\x12.\n" +
 	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12=\n" +
@@ -8932,13 +19145,13 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\x10transaction_type\x18\x01 \x01(\x0e2\x1e.accounting.v1.TransactionTypeR\x0ftransactionType\x12\x1a\n" +
 	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x14\n" +
 	"\x05count\x18\x03 \x01(\x03R\x05count\x12!\n" +
-	"\ftotal_amount\x18\x04 \x01(\x01R\vtotalAmount\x12\x1d\n" +
+	"\ftotal_amount\x18\x04 \x01(\tR\vtotalAmount\x12\x1d\n" +
 	"\n" +
-	"min_amount\x18\x05 \x01(\x01R\tminAmount\x12\x1d\n" +
+	"min_amount\x18\x05 \x01(\tR\tminAmount\x12\x1d\n" +
 	"\n" +
-	"max_amount\x18\x06 \x01(\x01R\tmaxAmount\x12\x1d\n" +
+	"max_amount\x18\x06 \x01(\tR\tmaxAmount\x12\x1d\n" +
 	"\n" +
-	"avg_amount\x18\a \x01(\x01R\tavgAmount\"\xb9\x01\n" +
+	"avg_amount\x18\a \x01(\tR\tavgAmount\"\xb9\x01\n" +
 	"\x1cGetTransactionSummaryRequest\x12=\n" +
 	"\faccount_type\x18\x01 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12.\n" +
 	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
@@ -8951,13 +19164,13 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\bholdings\x18\x01 \x03(\v26.accounting.v1.GetSystemHoldingsResponse.HoldingsEntryR\bholdings\x1a;\n" +
 	"\rHoldingsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x96\x04\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe2\x04\n" +
 	"\x0eTransactionFee\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
 	"\freceipt_code\x18\x02 \x01(\tR\vreceiptCode\x12\x1e\n" +
 	"\vfee_rule_id\x18\x03 \x01(\x03R\tfeeRuleId\x121\n" +
 	"\bfee_type\x18\x04 \x01(\x0e2\x16.accounting.v1.FeeTypeR\afeeType\x12\x16\n" +
-	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x1a\n" +
 	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12:\n" +
 	"\x17collected_by_account_id\x18\a \x01(\x03H\x00R\x14collectedByAccountId\x88\x01\x01\x12 \n" +
 	"\tledger_id\x18\b \x01(\x03H\x01R\bledgerId\x88\x01\x01\x12/\n" +
@@ -8965,15 +19178,17 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\x0fcommission_rate\x18\n" +
 	" \x01(\tH\x03R\x0ecommissionRate\x88\x01\x01\x129\n" +
 	"\n" +
-	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x1a\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x122\n" +
+	"\x13fee_rule_version_id\x18\f \x01(\x03H\x04R\x10feeRuleVersionId\x88\x01\x01B\x1a\n" +
 	"\x18_collected_by_account_idB\f\n" +
 	"\n" +
 	"_ledger_idB\x14\n" +
 	"\x12_agent_external_idB\x12\n" +
-	"\x10_commission_rate\"\xd1\x03\n" +
+	"\x10_commission_rateB\x16\n" +
+	"\x14_fee_rule_version_id\"\xd1\x03\n" +
 	"\x13CalculateFeeRequest\x12I\n" +
 	"\x10transaction_type\x18\x01 \x01(\x0e2\x1e.accounting.v1.TransactionTypeR\x0ftransactionType\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12,\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12,\n" +
 	"\x0fsource_currency\x18\x03 \x01(\tH\x00R\x0esourceCurrency\x88\x01\x01\x12,\n" +
 	"\x0ftarget_currency\x18\x04 \x01(\tH\x01R\x0etargetCurrency\x88\x01\x01\x12B\n" +
 	"\faccount_type\x18\x05 \x01(\x0e2\x1a.accounting.v1.AccountTypeH\x02R\vaccountType\x88\x01\x01\x12<\n" +
@@ -8988,13 +19203,13 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\v_to_address\"\xb6\x03\n" +
 	"\x0eFeeCalculation\x121\n" +
 	"\bfee_type\x18\x01 \x01(\x0e2\x16.accounting.v1.FeeTypeR\afeeType\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1a\n" +
 	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x1f\n" +
-	"\vnetwork_fee\x18\x04 \x01(\x01R\n" +
+	"\vnetwork_fee\x18\x04 \x01(\tR\n" +
 	"networkFee\x120\n" +
-	"\x14network_fee_original\x18\x05 \x01(\x01R\x12networkFeeOriginal\x12A\n" +
+	"\x14network_fee_original\x18\x05 \x01(\tR\x12networkFeeOriginal\x12A\n" +
 	"\x1dnetwork_fee_original_currency\x18\x06 \x01(\tR\x1anetworkFeeOriginalCurrency\x12\x1b\n" +
-	"\ttotal_fee\x18\a \x01(\x01R\btotalFee\x12&\n" +
+	"\ttotal_fee\x18\a \x01(\tR\btotalFee\x12&\n" +
 	"\fapplied_rate\x18\b \x01(\tH\x00R\vappliedRate\x88\x01\x01\x12\x1c\n" +
 	"\arule_id\x18\t \x01(\x03H\x01R\x06ruleId\x88\x01\x01\x12'\n" +
 	"\x0fcalculated_from\x18\n" +
@@ -9016,7 +19231,7 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\vcommissions\x18\x01 \x03(\v2A.accounting.v1.GetAgentCommissionSummaryResponse.CommissionsEntryR\vcommissions\x1a>\n" +
 	"\x10CommissionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x14\n" +
 	"\x12HealthCheckRequest\"\xde\x01\n" +
 	"\x13HealthCheckResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12R\n" +
@@ -9058,14 +19273,14 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\freceipt_code\x18\x02 \x01(\tR\vreceiptCode\x12%\n" +
 	"\x0etransaction_id\x18\x03 \x01(\x03R\rtransactionId\x128\n" +
 	"\x06status\x18\x04 \x01(\x0e2 .accounting.v1.TransactionStatusR\x06status\x12\x16\n" +
-	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x1a\n" +
 	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12(\n" +
 	"\rerror_message\x18\a \x01(\tH\x00R\ferrorMessage\x88\x01\x01\x128\n" +
 	"\ttimestamp\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\ttimestampB\x10\n" +
-	"\x0e_error_message\"\xbd\x04\n" +
+	"\x0e_error_message\"\x89\x05\n" +
 	"\rCreditRequest\x12%\n" +
 	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1c\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1c\n" +
 	"\tcurrenccy\x18\x03 \x01(\tR\tcurrenccy\x12=\n" +
 	"\faccount_type\x18\x04 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12,\n" +
@@ -9076,21 +19291,24 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\x10transaction_type\x18\n" +
 	" \x01(\x0e2\x1e.accounting.v1.TransactionTypeR\x0ftransactionType\x12\"\n" +
 	"\n" +
-	"to_address\x18\v \x01(\tH\x02R\ttoAddress\x88\x01\x01B\x12\n" +
+	"to_address\x18\v \x01(\tH\x02R\ttoAddress\x88\x01\x01\x12<\n" +
+	"\tposted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x03R\bpostedAt\x88\x01\x01B\x12\n" +
 	"\x10_idempotency_keyB\x0f\n" +
 	"\r_external_refB\r\n" +
-	"\v_to_address\"\xd9\x01\n" +
+	"\v_to_addressB\f\n" +
+	"\n" +
+	"_posted_at\"\xd9\x01\n" +
 	"\x0eCreditResponse\x12\x1d\n" +
 	"\n" +
 	"journal_id\x18\x01 \x01(\x03R\tjournalId\x12!\n" +
 	"\freceipt_code\x18\x02 \x01(\tR\vreceiptCode\x12#\n" +
-	"\rbalance_after\x18\x03 \x01(\x01R\fbalanceAfter\x129\n" +
+	"\rbalance_after\x18\x03 \x01(\tR\fbalanceAfter\x129\n" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
-	"\x0epayable_amount\x18\x05 \x01(\x01R\rpayableAmount\"\xbc\x04\n" +
+	"\x0epayable_amount\x18\x05 \x01(\tR\rpayableAmount\"\x88\x05\n" +
 	"\fDebitRequest\x12%\n" +
 	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1c\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1c\n" +
 	"\tcurrenccy\x18\x03 \x01(\tR\tcurrenccy\x12=\n" +
 	"\faccount_type\x18\x04 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12,\n" +
@@ -9101,22 +19319,25 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\x10transaction_type\x18\n" +
 	" \x01(\x0e2\x1e.accounting.v1.TransactionTypeR\x0ftransactionType\x12\"\n" +
 	"\n" +
-	"to_address\x18\v \x01(\tH\x02R\ttoAddress\x88\x01\x01B\x12\n" +
+	"to_address\x18\v \x01(\tH\x02R\ttoAddress\x88\x01\x01\x12<\n" +
+	"\tposted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x03R\bpostedAt\x88\x01\x01B\x12\n" +
 	"\x10_idempotency_keyB\x0f\n" +
 	"\r_external_refB\r\n" +
-	"\v_to_address\"\xd8\x01\n" +
+	"\v_to_addressB\f\n" +
+	"\n" +
+	"_posted_at\"\xd8\x01\n" +
 	"\rDebitResponse\x12\x1d\n" +
 	"\n" +
 	"journal_id\x18\x01 \x01(\x03R\tjournalId\x12!\n" +
 	"\freceipt_code\x18\x02 \x01(\tR\vreceiptCode\x12#\n" +
-	"\rbalance_after\x18\x03 \x01(\x01R\fbalanceAfter\x129\n" +
+	"\rbalance_after\x18\x03 \x01(\tR\fbalanceAfter\x129\n" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
-	"\x0epayable_amount\x18\x05 \x01(\x01R\rpayableAmount\"\x9d\x05\n" +
+	"\x0epayable_amount\x18\x05 \x01(\tR\rpayableAmount\"\x9d\x05\n" +
 	"\x0fTransferRequest\x12.\n" +
 	"\x13from_account_number\x18\x01 \x01(\tR\x11fromAccountNumber\x12*\n" +
 	"\x11to_account_number\x18\x02 \x01(\tR\x0ftoAccountNumber\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12=\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12=\n" +
 	"\faccount_type\x18\x04 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12,\n" +
 	"\x0fidempotency_key\x18\x06 \x01(\tH\x00R\x0eidempotencyKey\x88\x01\x01\x12&\n" +
@@ -9137,16 +19358,16 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"journal_id\x18\x01 \x01(\x03R\tjournalId\x12!\n" +
 	"\freceipt_code\x18\x02 \x01(\tR\vreceiptCode\x12\x1d\n" +
 	"\n" +
-	"fee_amount\x18\x03 \x01(\x01R\tfeeAmount\x12)\n" +
-	"\x10agent_commission\x18\x04 \x01(\x01R\x0fagentCommission\x129\n" +
+	"fee_amount\x18\x03 \x01(\tR\tfeeAmount\x12)\n" +
+	"\x10agent_commission\x18\x04 \x01(\tR\x0fagentCommission\x129\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
-	"\rbalance_after\x18\x06 \x01(\x01R\fbalanceAfter\x12%\n" +
-	"\x0epayable_amount\x18\a \x01(\x01R\rpayableAmount\"\xb2\x04\n" +
+	"\rbalance_after\x18\x06 \x01(\tR\fbalanceAfter\x12%\n" +
+	"\x0epayable_amount\x18\a \x01(\tR\rpayableAmount\"\xdf\x04\n" +
 	"\x11ConversionRequest\x12.\n" +
 	"\x13from_account_number\x18\x01 \x01(\tR\x11fromAccountNumber\x12*\n" +
 	"\x11to_account_number\x18\x02 \x01(\tR\x0ftoAccountNumber\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12=\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12=\n" +
 	"\faccount_type\x18\x04 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12,\n" +
 	"\x0fidempotency_key\x18\x05 \x01(\tH\x00R\x0eidempotencyKey\x88\x01\x01\x12&\n" +
 	"\fexternal_ref\x18\x06 \x01(\tH\x01R\vexternalRef\x88\x01\x01\x123\n" +
@@ -9155,31 +19376,67 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\x11agent_external_id\x18\t \x01(\tH\x02R\x0fagentExternalId\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"to_address\x18\n" +
-	" \x01(\tH\x03R\ttoAddress\x88\x01\x01B\x12\n" +
+	" \x01(\tH\x03R\ttoAddress\x88\x01\x01\x12\x1e\n" +
+	"\bquote_id\x18\v \x01(\tH\x04R\aquoteId\x88\x01\x01B\x12\n" +
 	"\x10_idempotency_keyB\x0f\n" +
 	"\r_external_refB\x14\n" +
 	"\x12_agent_external_idB\r\n" +
-	"\v_to_address\"\xac\x03\n" +
+	"\v_to_addressB\v\n" +
+	"\t_quote_id\"\xac\x03\n" +
 	"\x12ConversionResponse\x12\x1d\n" +
 	"\n" +
 	"journal_id\x18\x01 \x01(\x03R\tjournalId\x12!\n" +
 	"\freceipt_code\x18\x02 \x01(\tR\vreceiptCode\x12'\n" +
 	"\x0fsource_currency\x18\x03 \x01(\tR\x0esourceCurrency\x12#\n" +
 	"\rdest_currency\x18\x04 \x01(\tR\fdestCurrency\x12#\n" +
-	"\rsource_amount\x18\x05 \x01(\x01R\fsourceAmount\x12)\n" +
-	"\x10converted_amount\x18\x06 \x01(\x01R\x0fconvertedAmount\x12\x17\n" +
+	"\rsource_amount\x18\x05 \x01(\tR\fsourceAmount\x12)\n" +
+	"\x10converted_amount\x18\x06 \x01(\tR\x0fconvertedAmount\x12\x17\n" +
 	"\afx_rate\x18\a \x01(\tR\x06fxRate\x12\x1c\n" +
 	"\n" +
 	"fx_rate_id\x18\b \x01(\x03R\bfxRateId\x12\x1d\n" +
 	"\n" +
-	"fee_amount\x18\t \x01(\x01R\tfeeAmount\x129\n" +
+	"fee_amount\x18\t \x01(\tR\tfeeAmount\x129\n" +
 	"\n" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
-	"\x0epayable_amount\x18\v \x01(\x01R\rpayableAmount\"\x9d\x03\n" +
+	"\x0epayable_amount\x18\v \x01(\tR\rpayableAmount\"\x8a\x03\n" +
+	"\x14CreateFXQuoteRequest\x12.\n" +
+	"\x13from_account_number\x18\x01 \x01(\tR\x11fromAccountNumber\x12*\n" +
+	"\x11to_account_number\x18\x02 \x01(\tR\x0ftoAccountNumber\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12=\n" +
+	"\faccount_type\x18\x04 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x123\n" +
+	"\x16created_by_external_id\x18\x05 \x01(\tR\x13createdByExternalId\x12@\n" +
+	"\x0fcreated_by_type\x18\x06 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\rcreatedByType\x121\n" +
+	"\x12expires_in_seconds\x18\a \x01(\x03H\x00R\x10expiresInSeconds\x88\x01\x01B\x15\n" +
+	"\x13_expires_in_seconds\"\x94\x05\n" +
+	"\aFXQuote\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\x12.\n" +
+	"\x13from_account_number\x18\x02 \x01(\tR\x11fromAccountNumber\x12*\n" +
+	"\x11to_account_number\x18\x03 \x01(\tR\x0ftoAccountNumber\x12'\n" +
+	"\x0fsource_currency\x18\x04 \x01(\tR\x0esourceCurrency\x12#\n" +
+	"\rdest_currency\x18\x05 \x01(\tR\fdestCurrency\x12#\n" +
+	"\rsource_amount\x18\x06 \x01(\tR\fsourceAmount\x12\x17\n" +
+	"\afx_rate\x18\a \x01(\tR\x06fxRate\x12\x19\n" +
+	"\bmid_rate\x18\b \x01(\tR\amidRate\x12\x1c\n" +
+	"\n" +
+	"fx_rate_id\x18\t \x01(\x03R\bfxRateId\x12)\n" +
+	"\x10converted_amount\x18\n" +
+	" \x01(\tR\x0fconvertedAmount\x12\x1d\n" +
+	"\n" +
+	"fee_amount\x18\v \x01(\tR\tfeeAmount\x12#\n" +
+	"\vfee_rule_id\x18\f \x01(\x03H\x00R\tfeeRuleId\x88\x01\x01\x121\n" +
+	"\bfee_type\x18\r \x01(\x0e2\x16.accounting.v1.FeeTypeR\afeeType\x12%\n" +
+	"\x0epayable_amount\x18\x0e \x01(\tR\rpayableAmount\x129\n" +
+	"\n" +
+	"expires_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_fee_rule_id\"E\n" +
+	"\x15CreateFXQuoteResponse\x12,\n" +
+	"\x05quote\x18\x01 \x01(\v2\x16.accounting.v1.FXQuoteR\x05quote\"\x9d\x03\n" +
 	"\fTradeRequest\x12%\n" +
 	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1c\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1c\n" +
 	"\tcurrenccy\x18\x03 \x01(\tR\tcurrenccy\x12=\n" +
 	"\faccount_type\x18\x04 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12\x19\n" +
 	"\btrade_id\x18\x05 \x01(\tR\atradeId\x12\x1d\n" +
@@ -9195,15 +19452,54 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\freceipt_code\x18\x02 \x01(\tR\vreceiptCode\x12\x19\n" +
 	"\btrade_id\x18\x03 \x01(\tR\atradeId\x12!\n" +
 	"\ftrade_result\x18\x04 \x01(\tR\vtradeResult\x12#\n" +
-	"\rbalance_after\x18\x05 \x01(\x01R\fbalanceAfter\x129\n" +
+	"\rbalance_after\x18\x05 \x01(\tR\fbalanceAfter\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa2\x01\n" +
+	"\x0fSettleTradeItem\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\tR\atradeId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x16\n" +
+	"\x06result\x18\x03 \x01(\tR\x06result\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1d\n" +
+	"\n" +
+	"trade_type\x18\x05 \x01(\tR\ttradeType\"\x9e\x02\n" +
+	"\x13SettleTradesRequest\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12=\n" +
+	"\faccount_type\x18\x02 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x126\n" +
+	"\x06trades\x18\x03 \x03(\v2\x1e.accounting.v1.SettleTradeItemR\x06trades\x123\n" +
+	"\x16created_by_external_id\x18\x04 \x01(\tR\x13createdByExternalId\x12@\n" +
+	"\x0fcreated_by_type\x18\x05 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\rcreatedByType\"\xa9\x03\n" +
+	"\x15TradeSettlementResult\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\tR\atradeId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x16\n" +
+	"\x06result\x18\x03 \x01(\tR\x06result\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\"\n" +
+	"\n" +
+	"journal_id\x18\x06 \x01(\x03H\x00R\tjournalId\x88\x01\x01\x12&\n" +
+	"\freceipt_code\x18\a \x01(\tH\x01R\vreceiptCode\x88\x01\x01\x12(\n" +
+	"\rbalance_after\x18\b \x01(\tH\x02R\fbalanceAfter\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\t \x01(\tH\x03R\x05error\x88\x01\x01\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xe9\x02\n" +
+	"settled_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tsettledAtB\r\n" +
+	"\v_journal_idB\x0f\n" +
+	"\r_receipt_codeB\x10\n" +
+	"\x0e_balance_afterB\b\n" +
+	"\x06_error\"\xd1\x01\n" +
+	"\x14SettleTradesResponse\x12>\n" +
+	"\aresults\x18\x01 \x03(\v2$.accounting.v1.TradeSettlementResultR\aresults\x12\x18\n" +
+	"\asettled\x18\x02 \x01(\x05R\asettled\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x03 \x01(\x05R\n" +
+	"duplicates\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12'\n" +
+	"\x0fjournals_posted\x18\x05 \x01(\x05R\x0ejournalsPosted\"\xe9\x02\n" +
 	"\x16AgentCommissionRequest\x12*\n" +
 	"\x11agent_external_id\x18\x01 \x01(\tR\x0fagentExternalId\x12'\n" +
 	"\x0ftransaction_ref\x18\x02 \x01(\tR\x0etransactionRef\x12\x1a\n" +
 	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12-\n" +
-	"\x12transaction_amount\x18\x04 \x01(\x01R\x11transactionAmount\x12+\n" +
-	"\x11commission_amount\x18\x05 \x01(\x01R\x10commissionAmount\x12,\n" +
+	"\x12transaction_amount\x18\x04 \x01(\tR\x11transactionAmount\x12+\n" +
+	"\x11commission_amount\x18\x05 \x01(\tR\x10commissionAmount\x12,\n" +
 	"\x0fcommission_rate\x18\x06 \x01(\tH\x00R\x0ecommissionRate\x88\x01\x01\x12,\n" +
 	"\x0fidempotency_key\x18\a \x01(\tH\x01R\x0eidempotencyKey\x88\x01\x01B\x12\n" +
 	"\x10_commission_rateB\x12\n" +
@@ -9213,15 +19509,372 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"journal_id\x18\x01 \x01(\x03R\tjournalId\x12!\n" +
 	"\freceipt_code\x18\x02 \x01(\tR\vreceiptCode\x12*\n" +
 	"\x11agent_external_id\x18\x03 \x01(\tR\x0fagentExternalId\x12+\n" +
-	"\x11commission_amount\x18\x04 \x01(\x01R\x10commissionAmount\x129\n" +
+	"\x11commission_amount\x18\x04 \x01(\tR\x10commissionAmount\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8f\x02\n" +
+	"\x19ReverseTransactionRequest\x12!\n" +
+	"\freceipt_code\x18\x01 \x01(\tR\vreceiptCode\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12,\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tH\x00R\x0eidempotencyKey\x88\x01\x01\x123\n" +
+	"\x16created_by_external_id\x18\x04 \x01(\tR\x13createdByExternalId\x12@\n" +
+	"\x0fcreated_by_type\x18\x05 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\rcreatedByTypeB\x12\n" +
+	"\x10_idempotency_key\"\xa6\x02\n" +
+	"\x18RefundTransactionRequest\x12!\n" +
+	"\freceipt_code\x18\x01 \x01(\tR\vreceiptCode\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12,\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tH\x00R\x0eidempotencyKey\x88\x01\x01\x123\n" +
+	"\x16created_by_external_id\x18\x05 \x01(\tR\x13createdByExternalId\x12@\n" +
+	"\x0fcreated_by_type\x18\x06 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\rcreatedByTypeB\x12\n" +
+	"\x10_idempotency_key\"\xc9\x03\n" +
+	"\x10ReversalResponse\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x01 \x01(\x03R\tjournalId\x12!\n" +
+	"\freceipt_code\x18\x02 \x01(\tR\vreceiptCode\x122\n" +
+	"\x15original_receipt_code\x18\x03 \x01(\tR\x13originalReceiptCode\x12I\n" +
+	"\x10transaction_type\x18\x04 \x01(\x0e2\x1e.accounting.v1.TransactionTypeR\x0ftransactionType\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12!\n" +
+	"\ffee_reversed\x18\a \x01(\tR\vfeeReversed\x12/\n" +
+	"\x13commission_reversed\x18\b \x01(\tR\x12commissionReversed\x121\n" +
+	"\x14refundable_remaining\x18\t \x01(\tR\x13refundableRemaining\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x87\a\n" +
+	"\x04Hold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bhold_ref\x18\x02 \x01(\tR\aholdRef\x12%\n" +
+	"\x0eaccount_number\x18\x03 \x01(\tR\raccountNumber\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12'\n" +
+	"\x0fcaptured_amount\x18\x05 \x01(\tR\x0ecapturedAmount\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x1b\n" +
+	"\thold_type\x18\a \x01(\tR\bholdType\x121\n" +
+	"\x06status\x18\b \x01(\x0e2\x19.accounting.v1.HoldStatusR\x06status\x12I\n" +
+	"\x10transaction_type\x18\t \x01(\x0e2\x1e.accounting.v1.TransactionTypeR\x0ftransactionType\x129\n" +
+	"\x16capture_account_number\x18\n" +
+	" \x01(\tH\x00R\x14captureAccountNumber\x88\x01\x01\x125\n" +
+	"\x14capture_receipt_code\x18\v \x01(\tH\x01R\x12captureReceiptCode\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\f \x01(\tH\x02R\x06reason\x88\x01\x01\x12>\n" +
+	"\n" +
+	"expires_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\x03R\texpiresAt\x88\x01\x01\x12@\n" +
+	"\vcaptured_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x04R\n" +
+	"capturedAt\x88\x01\x01\x12@\n" +
+	"\vreleased_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampH\x05R\n" +
+	"releasedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x19\n" +
+	"\x17_capture_account_numberB\x17\n" +
+	"\x15_capture_receipt_codeB\t\n" +
+	"\a_reasonB\r\n" +
+	"\v_expires_atB\x0e\n" +
+	"\f_captured_atB\x0e\n" +
+	"\f_released_at\"\xe4\x04\n" +
+	"\x10PlaceHoldRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1e\n" +
+	"\bhold_ref\x18\x03 \x01(\tH\x00R\aholdRef\x88\x01\x01\x12\x1b\n" +
+	"\thold_type\x18\x04 \x01(\tR\bholdType\x12\x1b\n" +
+	"\x06reason\x18\x05 \x01(\tH\x01R\x06reason\x88\x01\x01\x121\n" +
+	"\x12expires_in_seconds\x18\x06 \x01(\x03H\x02R\x10expiresInSeconds\x88\x01\x01\x129\n" +
+	"\x16capture_account_number\x18\a \x01(\tH\x03R\x14captureAccountNumber\x88\x01\x01\x12I\n" +
+	"\x10transaction_type\x18\b \x01(\x0e2\x1e.accounting.v1.TransactionTypeR\x0ftransactionType\x12=\n" +
+	"\faccount_type\x18\t \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x123\n" +
+	"\x16created_by_external_id\x18\n" +
+	" \x01(\tR\x13createdByExternalId\x12@\n" +
+	"\x0fcreated_by_type\x18\v \x01(\x0e2\x18.accounting.v1.OwnerTypeR\rcreatedByTypeB\v\n" +
+	"\t_hold_refB\t\n" +
+	"\a_reasonB\x15\n" +
+	"\x13_expires_in_secondsB\x19\n" +
+	"\x17_capture_account_number\"i\n" +
+	"\x11PlaceHoldResponse\x12'\n" +
+	"\x04hold\x18\x01 \x01(\v2\x13.accounting.v1.HoldR\x04hold\x12+\n" +
+	"\x11available_balance\x18\x02 \x01(\tR\x10availableBalance\"\xb1\x03\n" +
+	"\x12CaptureHoldRequest\x12\x19\n" +
+	"\bhold_ref\x18\x01 \x01(\tR\aholdRef\x12\x1b\n" +
+	"\x06amount\x18\x02 \x01(\tH\x00R\x06amount\x88\x01\x01\x12/\n" +
+	"\x11to_account_number\x18\x03 \x01(\tH\x01R\x0ftoAccountNumber\x88\x01\x01\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12/\n" +
+	"\x11agent_external_id\x18\x05 \x01(\tH\x02R\x0fagentExternalId\x88\x01\x01\x123\n" +
+	"\x16created_by_external_id\x18\x06 \x01(\tR\x13createdByExternalId\x12@\n" +
+	"\x0fcreated_by_type\x18\a \x01(\x0e2\x18.accounting.v1.OwnerTypeR\rcreatedByType\x12\"\n" +
+	"\n" +
+	"to_address\x18\b \x01(\tH\x03R\ttoAddress\x88\x01\x01B\t\n" +
+	"\a_amountB\x14\n" +
+	"\x12_to_account_numberB\x14\n" +
+	"\x12_agent_external_idB\r\n" +
+	"\v_to_address\"\xac\x02\n" +
+	"\x13CaptureHoldResponse\x12'\n" +
+	"\x04hold\x18\x01 \x01(\v2\x13.accounting.v1.HoldR\x04hold\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x02 \x01(\x03R\tjournalId\x12!\n" +
+	"\freceipt_code\x18\x03 \x01(\tR\vreceiptCode\x12'\n" +
+	"\x0fcaptured_amount\x18\x04 \x01(\tR\x0ecapturedAmount\x12'\n" +
+	"\x0freleased_amount\x18\x05 \x01(\tR\x0ereleasedAmount\x12\x1d\n" +
+	"\n" +
+	"fee_amount\x18\x06 \x01(\tR\tfeeAmount\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd2\x01\n" +
+	"\x12ReleaseHoldRequest\x12\x19\n" +
+	"\bhold_ref\x18\x01 \x01(\tR\aholdRef\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01\x125\n" +
+	"\x17released_by_external_id\x18\x03 \x01(\tR\x14releasedByExternalId\x12B\n" +
+	"\x10released_by_type\x18\x04 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\x0ereleasedByTypeB\t\n" +
+	"\a_reason\">\n" +
+	"\x13ReleaseHoldResponse\x12'\n" +
+	"\x04hold\x18\x01 \x01(\v2\x13.accounting.v1.HoldR\x04hold\"x\n" +
+	"\x11ExtendHoldRequest\x12\x19\n" +
+	"\bhold_ref\x18\x01 \x01(\tR\aholdRef\x121\n" +
+	"\x12expires_in_seconds\x18\x02 \x01(\x03H\x00R\x10expiresInSeconds\x88\x01\x01B\x15\n" +
+	"\x13_expires_in_seconds\"=\n" +
+	"\x12ExtendHoldResponse\x12'\n" +
+	"\x04hold\x18\x01 \x01(\v2\x13.accounting.v1.HoldR\x04hold\"\xf2\x01\n" +
+	"\x10ListHoldsRequest\x12*\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tH\x00R\raccountNumber\x88\x01\x01\x126\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.accounting.v1.HoldStatusH\x01R\x06status\x88\x01\x01\x12 \n" +
+	"\thold_type\x18\x03 \x01(\tH\x02R\bholdType\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offsetB\x11\n" +
+	"\x0f_account_numberB\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_hold_type\"T\n" +
+	"\x11ListHoldsResponse\x12)\n" +
+	"\x05holds\x18\x01 \x03(\v2\x13.accounting.v1.HoldR\x05holds\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xdd\x05\n" +
+	"\x11ReconciliationRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12>\n" +
+	"\x06status\x18\x02 \x01(\x0e2&.accounting.v1.ReconciliationRunStatusR\x06status\x12!\n" +
+	"\ftrigger_type\x18\x03 \x01(\tR\vtriggerType\x12&\n" +
+	"\ftriggered_by\x18\x04 \x01(\tH\x00R\vtriggeredBy\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x05 \x01(\tH\x01R\bcurrency\x88\x01\x01\x12B\n" +
+	"\faccount_type\x18\x06 \x01(\x0e2\x1a.accounting.v1.AccountTypeH\x02R\vaccountType\x88\x01\x01\x12?\n" +
+	"\rjournals_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fjournalsFrom\x12)\n" +
+	"\x10accounts_checked\x18\b \x01(\x03R\x0faccountsChecked\x12)\n" +
+	"\x10journals_checked\x18\t \x01(\x03R\x0fjournalsChecked\x12-\n" +
+	"\x12currencies_checked\x18\n" +
+	" \x01(\x03R\x11currenciesChecked\x12!\n" +
+	"\fbreaks_found\x18\v \x01(\x03R\vbreaksFound\x12\x19\n" +
+	"\x05error\x18\f \x01(\tH\x03R\x05error\x88\x01\x01\x129\n" +
+	"\n" +
+	"started_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12@\n" +
+	"\vfinished_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x04R\n" +
+	"finishedAt\x88\x01\x01B\x0f\n" +
+	"\r_triggered_byB\v\n" +
+	"\t_currencyB\x0f\n" +
+	"\r_account_typeB\b\n" +
+	"\x06_errorB\x0e\n" +
+	"\f_finished_at\"\xa3\x05\n" +
+	"\x13ReconciliationBreak\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\x03R\x05runId\x12\x1d\n" +
+	"\n" +
+	"break_type\x18\x03 \x01(\tR\tbreakType\x12\"\n" +
+	"\n" +
+	"account_id\x18\x04 \x01(\x03H\x00R\taccountId\x88\x01\x01\x12*\n" +
+	"\x0eaccount_number\x18\x05 \x01(\tH\x01R\raccountNumber\x88\x01\x01\x12B\n" +
+	"\faccount_type\x18\x06 \x01(\x0e2\x1a.accounting.v1.AccountTypeH\x02R\vaccountType\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"journal_id\x18\a \x01(\x03H\x03R\tjournalId\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bexpected\x18\t \x01(\tR\bexpected\x12\x16\n" +
+	"\x06actual\x18\n" +
+	" \x01(\tR\x06actual\x12\x1e\n" +
+	"\n" +
+	"difference\x18\v \x01(\tR\n" +
+	"difference\x12I\n" +
+	"\adetails\x18\f \x03(\v2/.accounting.v1.ReconciliationBreak.DetailsEntryR\adetails\x12\x1a\n" +
+	"\bresolved\x18\r \x01(\bR\bresolved\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
+	"\v_account_idB\x11\n" +
+	"\x0f_account_numberB\x0f\n" +
+	"\r_account_typeB\r\n" +
+	"\v_journal_id\"\x98\x02\n" +
+	"\x18RunReconciliationRequest\x12\x1f\n" +
+	"\bcurrency\x18\x01 \x01(\tH\x00R\bcurrency\x88\x01\x01\x12B\n" +
+	"\faccount_type\x18\x02 \x01(\x0e2\x1a.accounting.v1.AccountTypeH\x01R\vaccountType\x88\x01\x01\x12D\n" +
+	"\rjournals_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\fjournalsFrom\x88\x01\x01\x12!\n" +
+	"\ftriggered_by\x18\x04 \x01(\tR\vtriggeredByB\v\n" +
+	"\t_currencyB\x0f\n" +
+	"\r_account_typeB\x10\n" +
+	"\x0e_journals_from\"O\n" +
+	"\x19RunReconciliationResponse\x122\n" +
+	"\x03run\x18\x01 \x01(\v2 .accounting.v1.ReconciliationRunR\x03run\"\x85\x02\n" +
+	"\x1fListReconciliationBreaksRequest\x12\x1a\n" +
+	"\x06run_id\x18\x01 \x01(\x03H\x00R\x05runId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"break_type\x18\x02 \x01(\tH\x01R\tbreakType\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x03 \x01(\tH\x02R\bcurrency\x88\x01\x01\x12\x1f\n" +
+	"\bresolved\x18\x04 \x01(\bH\x03R\bresolved\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offsetB\t\n" +
+	"\a_run_idB\r\n" +
+	"\v_break_typeB\v\n" +
+	"\t_currencyB\v\n" +
+	"\t_resolved\"\xb5\x01\n" +
+	" ListReconciliationBreaksResponse\x12:\n" +
+	"\x06breaks\x18\x01 \x03(\v2\".accounting.v1.ReconciliationBreakR\x06breaks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x127\n" +
+	"\x03run\x18\x03 \x01(\v2 .accounting.v1.ReconciliationRunH\x00R\x03run\x88\x01\x01B\x06\n" +
+	"\x04_run\"\xee\x05\n" +
+	"\x11QueuedTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\freceipt_code\x18\x02 \x01(\tR\vreceiptCode\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\x12!\n" +
+	"\fordering_key\x18\x04 \x01(\tR\vorderingKey\x12I\n" +
+	"\x10transaction_type\x18\x05 \x01(\x0e2\x1e.accounting.v1.TransactionTypeR\x0ftransactionType\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12>\n" +
+	"\x06status\x18\a \x01(\x0e2&.accounting.v1.QueuedTransactionStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\x12!\n" +
+	"\fmax_attempts\x18\t \x01(\x05R\vmaxAttempts\x12\"\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tH\x00R\tlastError\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"journal_id\x18\v \x01(\x03H\x01R\tjournalId\x88\x01\x01\x12=\n" +
+	"\favailable_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vavailableAt\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\fcompleted_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vcompletedAt\x88\x01\x01B\r\n" +
+	"\v_last_errorB\r\n" +
+	"\v_journal_idB\x0f\n" +
+	"\r_completed_at\"\xd6\x01\n" +
+	"\x1dListQueuedTransactionsRequest\x12C\n" +
+	"\x06status\x18\x01 \x01(\x0e2&.accounting.v1.QueuedTransactionStatusH\x00R\x06status\x88\x01\x01\x12&\n" +
+	"\freceipt_code\x18\x02 \x01(\tH\x01R\vreceiptCode\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offsetB\t\n" +
+	"\a_statusB\x0f\n" +
+	"\r_receipt_code\"|\n" +
+	"\x1eListQueuedTransactionsResponse\x12D\n" +
+	"\ftransactions\x18\x01 \x03(\v2 .accounting.v1.QueuedTransactionR\ftransactions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"[\n" +
+	"$RedriveDeadLetterTransactionsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\"\x89\x01\n" +
+	"%RedriveDeadLetterTransactionsResponse\x12D\n" +
+	"\ftransactions\x18\x01 \x03(\v2 .accounting.v1.QueuedTransactionR\ftransactions\x12\x1a\n" +
+	"\bredriven\x18\x02 \x01(\x05R\bredriven\"X\n" +
+	"!SkipDeadLetterTransactionsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\"\x84\x01\n" +
+	"\"SkipDeadLetterTransactionsResponse\x12D\n" +
+	"\ftransactions\x18\x01 \x03(\v2 .accounting.v1.QueuedTransactionR\ftransactions\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x05R\askipped\"\xa1\x03\n" +
+	"\x10AccountingPeriod\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12=\n" +
+	"\fperiod_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1b\n" +
+	"\tclosed_by\x18\x05 \x01(\tR\bclosedBy\x12\x17\n" +
+	"\x04note\x18\x06 \x01(\tH\x00R\x04note\x88\x01\x01\x12%\n" +
+	"\x0eaccounts_count\x18\a \x01(\x03R\raccountsCount\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\tclosed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\bclosedAt\x88\x01\x01B\a\n" +
+	"\x05_noteB\f\n" +
+	"\n" +
+	"_closed_at\"\xd7\x01\n" +
+	"\x1cCloseAccountingPeriodRequest\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12\x1b\n" +
+	"\tclosed_by\x18\x03 \x01(\tR\bclosedBy\x12\x17\n" +
+	"\x04note\x18\x04 \x01(\tH\x00R\x04note\x88\x01\x01B\a\n" +
+	"\x05_note\"X\n" +
+	"\x1dCloseAccountingPeriodResponse\x127\n" +
+	"\x06period\x18\x01 \x01(\v2\x1f.accounting.v1.AccountingPeriodR\x06period\"\xe5\x02\n" +
+	"\x10TrialBalanceLine\x127\n" +
+	"\apurpose\x18\x01 \x01(\x0e2\x1d.accounting.v1.AccountPurposeR\apurpose\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12=\n" +
+	"\faccount_type\x18\x03 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12#\n" +
+	"\raccount_count\x18\x04 \x01(\x03R\faccountCount\x12'\n" +
+	"\x0fopening_balance\x18\x05 \x01(\tR\x0eopeningBalance\x12!\n" +
+	"\ftotal_debits\x18\x06 \x01(\tR\vtotalDebits\x12#\n" +
+	"\rtotal_credits\x18\a \x01(\tR\ftotalCredits\x12'\n" +
+	"\x0fclosing_balance\x18\b \x01(\tR\x0eclosingBalance\"\xc9\x01\n" +
+	"\x11TrialBalanceTotal\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fopening_balance\x18\x02 \x01(\tR\x0eopeningBalance\x12!\n" +
+	"\ftotal_debits\x18\x03 \x01(\tR\vtotalDebits\x12#\n" +
+	"\rtotal_credits\x18\x04 \x01(\tR\ftotalCredits\x12'\n" +
+	"\x0fclosing_balance\x18\x05 \x01(\tR\x0eclosingBalance\"\x8b\x03\n" +
+	"\x16GetTrialBalanceRequest\x12 \n" +
+	"\tperiod_id\x18\x01 \x01(\x03H\x00R\bperiodId\x88\x01\x01\x123\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x02to\x88\x01\x01\x12B\n" +
+	"\faccount_type\x18\x04 \x01(\x0e2\x1a.accounting.v1.AccountTypeH\x03R\vaccountType\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x05 \x01(\tH\x04R\bcurrency\x88\x01\x01\x12<\n" +
+	"\apurpose\x18\x06 \x01(\x0e2\x1d.accounting.v1.AccountPurposeH\x05R\apurpose\x88\x01\x01B\f\n" +
+	"\n" +
+	"_period_idB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_toB\x0f\n" +
+	"\r_account_typeB\v\n" +
+	"\t_currencyB\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb8\x05\n" +
+	"\b_purpose\"\xaf\x02\n" +
+	"\x17GetTrialBalanceResponse\x125\n" +
+	"\x05lines\x18\x01 \x03(\v2\x1f.accounting.v1.TrialBalanceLineR\x05lines\x128\n" +
+	"\x06totals\x18\x02 \x03(\v2 .accounting.v1.TrialBalanceTotalR\x06totals\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12<\n" +
+	"\x06period\x18\x05 \x01(\v2\x1f.accounting.v1.AccountingPeriodH\x00R\x06period\x88\x01\x01B\t\n" +
+	"\a_period\"\xff\x04\n" +
+	"\x12GeneralLedgerEntry\x12\x1b\n" +
+	"\tledger_id\x18\x01 \x01(\x03R\bledgerId\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x02 \x01(\x03R\tjournalId\x12%\n" +
+	"\x0eaccount_number\x18\x03 \x01(\tR\raccountNumber\x127\n" +
+	"\apurpose\x18\x04 \x01(\x0e2\x1d.accounting.v1.AccountPurposeR\apurpose\x12=\n" +
+	"\faccount_type\x18\x05 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12(\n" +
+	"\x05dr_cr\x18\a \x01(\x0e2\x13.accounting.v1.DrCrR\x04drCr\x12\x16\n" +
+	"\x06amount\x18\b \x01(\tR\x06amount\x12(\n" +
+	"\rbalance_after\x18\t \x01(\tH\x00R\fbalanceAfter\x88\x01\x01\x12&\n" +
+	"\freceipt_code\x18\n" +
+	" \x01(\tH\x01R\vreceiptCode\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\v \x01(\tH\x02R\vdescription\x88\x01\x01\x12I\n" +
+	"\x10transaction_type\x18\f \x01(\x0e2\x1e.accounting.v1.TransactionTypeR\x0ftransactionType\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x10\n" +
+	"\x0e_balance_afterB\x0f\n" +
+	"\r_receipt_codeB\x0e\n" +
+	"\f_description\"\xaf\x03\n" +
+	"\x17GetGeneralLedgerRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12<\n" +
+	"\apurpose\x18\x03 \x01(\x0e2\x1d.accounting.v1.AccountPurposeH\x00R\apurpose\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x04 \x01(\tH\x01R\bcurrency\x88\x01\x01\x12B\n" +
+	"\faccount_type\x18\x05 \x01(\x0e2\x1a.accounting.v1.AccountTypeH\x02R\vaccountType\x88\x01\x01\x12*\n" +
+	"\x0eaccount_number\x18\x06 \x01(\tH\x03R\raccountNumber\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\b \x01(\x05R\x06offsetB\n" +
+	"\n" +
+	"\b_purposeB\v\n" +
+	"\t_currencyB\x0f\n" +
+	"\r_account_typeB\x11\n" +
+	"\x0f_account_number\"\x95\x02\n" +
+	"\x18GetGeneralLedgerResponse\x12;\n" +
+	"\bsections\x18\x01 \x03(\v2\x1f.accounting.v1.TrialBalanceLineR\bsections\x12;\n" +
+	"\aentries\x18\x02 \x03(\v2!.accounting.v1.GeneralLedgerEntryR\aentries\x12#\n" +
+	"\rtotal_entries\x18\x03 \x01(\x05R\ftotalEntries\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xb0\t\n" +
 	"\x13TransactionApproval\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
 	"\frequested_by\x18\x02 \x01(\x03R\vrequestedBy\x12I\n" +
 	"\x10transaction_type\x18\x03 \x01(\x0e2\x1e.accounting.v1.TransactionTypeR\x0ftransactionType\x12%\n" +
 	"\x0eaccount_number\x18\x04 \x01(\tR\raccountNumber\x12\x16\n" +
-	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x1a\n" +
 	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12 \n" +
 	"\vdescription\x18\a \x01(\tR\vdescription\x125\n" +
 	"\x06status\x18\b \x01(\x0e2\x1d.accounting.v1.ApprovalStatusR\x06status\x12$\n" +
@@ -9234,16 +19887,41 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12 \n" +
+	"\tpolicy_id\x18\x0f \x01(\x03H\x04R\bpolicyId\x88\x01\x01\x12-\n" +
+	"\x12required_approvals\x18\x10 \x01(\x05R\x11requiredApprovals\x12%\n" +
+	"\x0eapprover_roles\x18\x11 \x03(\tR\rapproverRoles\x12%\n" +
+	"\x0edistinct_roles\x18\x12 \x01(\bR\rdistinctRoles\x12>\n" +
+	"\n" +
+	"expires_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampH\x05R\texpiresAt\x88\x01\x01\x12=\n" +
+	"\tdecisions\x18\x14 \x03(\v2\x1f.accounting.v1.ApprovalDecisionR\tdecisions\x12-\n" +
+	"\x12execution_attempts\x18\x15 \x01(\x05R\x11executionAttempts\x12(\n" +
+	"\rerror_message\x18\x16 \x01(\tH\x06R\ferrorMessage\x88\x01\x01\x12@\n" +
+	"\vexecuted_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampH\aR\n" +
+	"executedAt\x88\x01\x01B\x0e\n" +
 	"\f_approved_byB\x13\n" +
 	"\x11_rejection_reasonB\x0f\n" +
 	"\r_receipt_codeB\x14\n" +
-	"\x12_to_account_number\"\xd4\x02\n" +
+	"\x12_to_account_numberB\f\n" +
+	"\n" +
+	"_policy_idB\r\n" +
+	"\v_expires_atB\x10\n" +
+	"\x0e_error_messageB\x0e\n" +
+	"\f_executed_at\"\xd7\x01\n" +
+	"\x10ApprovalDecision\x12\x1f\n" +
+	"\vapprover_id\x18\x01 \x01(\x03R\n" +
+	"approverId\x12#\n" +
+	"\rapprover_role\x18\x02 \x01(\tR\fapproverRole\x12\x1a\n" +
+	"\bapproved\x18\x03 \x01(\bR\bapproved\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tH\x00R\x06reason\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\t\n" +
+	"\a_reason\"\xd4\x02\n" +
 	" CreateTransactionApprovalRequest\x12!\n" +
 	"\frequested_by\x18\x01 \x01(\x03R\vrequestedBy\x12I\n" +
 	"\x10transaction_type\x18\x02 \x01(\x0e2\x1e.accounting.v1.TransactionTypeR\x0ftransactionType\x12%\n" +
 	"\x0eaccount_number\x18\x03 \x01(\tR\raccountNumber\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1a\n" +
 	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12 \n" +
 	"\vdescription\x18\x06 \x01(\tR\vdescription\x12/\n" +
 	"\x11to_account_number\x18\a \x01(\tH\x00R\x0ftoAccountNumber\x88\x01\x01B\x14\n" +
@@ -9258,14 +19936,15 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\a_offset\"u\n" +
 	"\x1bGetPendingApprovalsResponse\x12@\n" +
 	"\tapprovals\x18\x01 \x03(\v2\".accounting.v1.TransactionApprovalR\tapprovals\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\x9f\x01\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xc4\x01\n" +
 	"\x19ApproveTransactionRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\x03R\trequestId\x12\x1f\n" +
 	"\vapproved_by\x18\x02 \x01(\x03R\n" +
 	"approvedBy\x12\x1a\n" +
 	"\bapproved\x18\x03 \x01(\bR\bapproved\x12\x1b\n" +
-	"\x06reason\x18\x04 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\x06reason\x18\x04 \x01(\tH\x00R\x06reason\x88\x01\x01\x12#\n" +
+	"\rapprover_role\x18\x05 \x01(\tR\fapproverRoleB\t\n" +
 	"\a_reason\"\xaf\x01\n" +
 	"\x1aApproveTransactionResponse\x12>\n" +
 	"\bapproval\x18\x01 \x01(\v2\".accounting.v1.TransactionApprovalR\bapproval\x12&\n" +
@@ -9285,7 +19964,53 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\x03_to\"t\n" +
 	"\x1aGetApprovalHistoryResponse\x12@\n" +
 	"\tapprovals\x18\x01 \x03(\v2\".accounting.v1.TransactionApprovalR\tapprovals\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xec\a\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x98\x05\n" +
+	"\x0eApprovalPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12N\n" +
+	"\x10transaction_type\x18\x03 \x01(\x0e2\x1e.accounting.v1.TransactionTypeH\x00R\x0ftransactionType\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x04 \x01(\tH\x01R\bcurrency\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x05 \x01(\tR\tminAmount\x12\"\n" +
+	"\n" +
+	"max_amount\x18\x06 \x01(\tH\x02R\tmaxAmount\x88\x01\x01\x12-\n" +
+	"\x12required_approvals\x18\a \x01(\x05R\x11requiredApprovals\x12%\n" +
+	"\x0eapprover_roles\x18\b \x03(\tR\rapproverRoles\x12%\n" +
+	"\x0edistinct_roles\x18\t \x01(\bR\rdistinctRoles\x122\n" +
+	"\x15expires_after_seconds\x18\n" +
+	" \x01(\x03R\x13expiresAfterSeconds\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\x05R\bpriority\x12\x1b\n" +
+	"\tis_active\x18\f \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\r \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x13\n" +
+	"\x11_transaction_typeB\v\n" +
+	"\t_currencyB\r\n" +
+	"\v_max_amount\"s\n" +
+	"\x1bCreateApprovalPolicyRequest\x125\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1d.accounting.v1.ApprovalPolicyR\x06policy\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\tcreatedBy\"U\n" +
+	"\x1cCreateApprovalPolicyResponse\x125\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1d.accounting.v1.ApprovalPolicyR\x06policy\"s\n" +
+	"\x1bUpdateApprovalPolicyRequest\x125\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1d.accounting.v1.ApprovalPolicyR\x06policy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x02 \x01(\tR\tupdatedBy\"U\n" +
+	"\x1cUpdateApprovalPolicyResponse\x125\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1d.accounting.v1.ApprovalPolicyR\x06policy\"\xd1\x01\n" +
+	"\x1bListApprovalPoliciesRequest\x12N\n" +
+	"\x10transaction_type\x18\x01 \x01(\x0e2\x1e.accounting.v1.TransactionTypeH\x00R\x0ftransactionType\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x02 \x01(\tH\x01R\bcurrency\x88\x01\x01\x12\x1f\n" +
+	"\vactive_only\x18\x03 \x01(\bR\n" +
+	"activeOnlyB\x13\n" +
+	"\x11_transaction_typeB\v\n" +
+	"\t_currency\"Y\n" +
+	"\x1cListApprovalPoliciesResponse\x129\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x1d.accounting.v1.ApprovalPolicyR\bpolicies\"\xec\a\n" +
 	"\x05Agent\x12*\n" +
 	"\x11agent_external_id\x18\x01 \x01(\tR\x0fagentExternalId\x12-\n" +
 	"\x10user_external_id\x18\x02 \x01(\tH\x00R\x0euserExternalId\x88\x01\x01\x12\x1d\n" +
@@ -9464,7 +20189,577 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aH\n" +
 	"\x1aAgentsByPaymentMethodEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01*\x97\x01\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xbb\x05\n" +
+	"\x10CommissionPayout\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bbatch_id\x18\x02 \x01(\tR\abatchId\x12*\n" +
+	"\x11agent_external_id\x18\x03 \x01(\tR\x0fagentExternalId\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12)\n" +
+	"\x10commission_count\x18\x06 \x01(\x05R\x0fcommissionCount\x12@\n" +
+	"\vperiod_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"periodFrom\x88\x01\x01\x127\n" +
+	"\tperiod_to\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bperiodTo\x12=\n" +
+	"\x06status\x18\t \x01(\x0e2%.accounting.v1.CommissionPayoutStatusR\x06status\x12&\n" +
+	"\freceipt_code\x18\n" +
+	" \x01(\tH\x01R\vreceiptCode\x88\x01\x01\x12(\n" +
+	"\rerror_message\x18\v \x01(\tH\x02R\ferrorMessage\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\f \x01(\tR\tcreatedBy\x12>\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tcreatedAt\x88\x01\x01\x128\n" +
+	"\apaid_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x04R\x06paidAt\x88\x01\x01B\x0e\n" +
+	"\f_period_fromB\x0f\n" +
+	"\r_receipt_codeB\x10\n" +
+	"\x0e_error_messageB\r\n" +
+	"\v_created_atB\n" +
+	"\n" +
+	"\b_paid_at\"\xb6\x03\n" +
+	"\"CreateCommissionPayoutBatchRequest\x12/\n" +
+	"\x11agent_external_id\x18\x01 \x01(\tH\x00R\x0fagentExternalId\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x02 \x01(\tH\x01R\bcurrency\x88\x01\x01\x12@\n" +
+	"\vperiod_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\n" +
+	"periodFrom\x88\x01\x01\x12<\n" +
+	"\tperiod_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\bperiodTo\x88\x01\x01\x12/\n" +
+	"\x11min_payout_amount\x18\x05 \x01(\tH\x04R\x0fminPayoutAmount\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedByB\x14\n" +
+	"\x12_agent_external_idB\v\n" +
+	"\t_currencyB\x0e\n" +
+	"\f_period_fromB\f\n" +
+	"\n" +
+	"_period_toB\x14\n" +
+	"\x12_min_payout_amount\"\x91\x02\n" +
+	"#CreateCommissionPayoutBatchResponse\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x129\n" +
+	"\apayouts\x18\x03 \x03(\v2\x1f.accounting.v1.CommissionPayoutR\apayouts\x129\n" +
+	"\askipped\x18\x04 \x03(\v2\x1f.accounting.v1.CommissionPayoutR\askipped\x12\x1d\n" +
+	"\n" +
+	"paid_count\x18\x05 \x01(\x05R\tpaidCount\x12!\n" +
+	"\ffailed_count\x18\x06 \x01(\x05R\vfailedCount\"\xbd\x02\n" +
+	"\x1cListCommissionPayoutsRequest\x12/\n" +
+	"\x11agent_external_id\x18\x01 \x01(\tH\x00R\x0fagentExternalId\x88\x01\x01\x12\x1e\n" +
+	"\bbatch_id\x18\x02 \x01(\tH\x01R\abatchId\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x03 \x01(\tH\x02R\bcurrency\x88\x01\x01\x12B\n" +
+	"\x06status\x18\x04 \x01(\x0e2%.accounting.v1.CommissionPayoutStatusH\x03R\x06status\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offsetB\x14\n" +
+	"\x12_agent_external_idB\v\n" +
+	"\t_batch_idB\v\n" +
+	"\t_currencyB\t\n" +
+	"\a_status\"p\n" +
+	"\x1dListCommissionPayoutsResponse\x129\n" +
+	"\apayouts\x18\x01 \x03(\v2\x1f.accounting.v1.CommissionPayoutR\apayouts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xa3\a\n" +
+	"\fLimitProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12<\n" +
+	"\n" +
+	"owner_type\x18\x03 \x01(\x0e2\x18.accounting.v1.OwnerTypeH\x00R\townerType\x88\x01\x01\x12\x1e\n" +
+	"\bkyc_tier\x18\x04 \x01(\tH\x01R\akycTier\x88\x01\x01\x12*\n" +
+	"\x0eaccount_number\x18\x05 \x01(\tH\x02R\raccountNumber\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12/\n" +
+	"\x11max_single_amount\x18\a \x01(\tH\x03R\x0fmaxSingleAmount\x88\x01\x01\x12&\n" +
+	"\fdaily_amount\x18\b \x01(\tH\x04R\vdailyAmount\x88\x01\x01\x12(\n" +
+	"\rweekly_amount\x18\t \x01(\tH\x05R\fweeklyAmount\x88\x01\x01\x12*\n" +
+	"\x0emonthly_amount\x18\n" +
+	" \x01(\tH\x06R\rmonthlyAmount\x88\x01\x01\x12$\n" +
+	"\vdaily_count\x18\v \x01(\x05H\aR\n" +
+	"dailyCount\x88\x01\x01\x12&\n" +
+	"\fweekly_count\x18\f \x01(\x05H\bR\vweeklyCount\x88\x01\x01\x12(\n" +
+	"\rmonthly_count\x18\r \x01(\x05H\tR\fmonthlyCount\x88\x01\x01\x12\x1a\n" +
+	"\bpriority\x18\x0e \x01(\x05R\bpriority\x12\x1b\n" +
+	"\tis_active\x18\x0f \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x10 \x01(\tR\tcreatedBy\x12>\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampH\n" +
+	"R\tcreatedAt\x88\x01\x01\x12>\n" +
+	"\n" +
+	"updated_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampH\vR\tupdatedAt\x88\x01\x01B\r\n" +
+	"\v_owner_typeB\v\n" +
+	"\t_kyc_tierB\x11\n" +
+	"\x0f_account_numberB\x14\n" +
+	"\x12_max_single_amountB\x0f\n" +
+	"\r_daily_amountB\x10\n" +
+	"\x0e_weekly_amountB\x11\n" +
+	"\x0f_monthly_amountB\x0e\n" +
+	"\f_daily_countB\x0f\n" +
+	"\r_weekly_countB\x10\n" +
+	"\x0e_monthly_countB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_at\"q\n" +
+	"\x19CreateLimitProfileRequest\x125\n" +
+	"\aprofile\x18\x01 \x01(\v2\x1b.accounting.v1.LimitProfileR\aprofile\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\tcreatedBy\"S\n" +
+	"\x1aCreateLimitProfileResponse\x125\n" +
+	"\aprofile\x18\x01 \x01(\v2\x1b.accounting.v1.LimitProfileR\aprofile\"q\n" +
+	"\x19UpdateLimitProfileRequest\x125\n" +
+	"\aprofile\x18\x01 \x01(\v2\x1b.accounting.v1.LimitProfileR\aprofile\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x02 \x01(\tR\tupdatedBy\"S\n" +
+	"\x1aUpdateLimitProfileResponse\x125\n" +
+	"\aprofile\x18\x01 \x01(\v2\x1b.accounting.v1.LimitProfileR\aprofile\"\xa2\x02\n" +
+	"\x18ListLimitProfilesRequest\x12<\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\x0e2\x18.accounting.v1.OwnerTypeH\x00R\townerType\x88\x01\x01\x12\x1e\n" +
+	"\bkyc_tier\x18\x02 \x01(\tH\x01R\akycTier\x88\x01\x01\x12*\n" +
+	"\x0eaccount_number\x18\x03 \x01(\tH\x02R\raccountNumber\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x04 \x01(\tH\x03R\bcurrency\x88\x01\x01\x12\x1f\n" +
+	"\vactive_only\x18\x05 \x01(\bR\n" +
+	"activeOnlyB\r\n" +
+	"\v_owner_typeB\v\n" +
+	"\t_kyc_tierB\x11\n" +
+	"\x0f_account_numberB\v\n" +
+	"\t_currency\"T\n" +
+	"\x19ListLimitProfilesResponse\x127\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x1b.accounting.v1.LimitProfileR\bprofiles\"\x9e\x01\n" +
+	"\x16SetOwnerKYCTierRequest\x127\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x19\n" +
+	"\bkyc_tier\x18\x03 \x01(\tR\akycTier\x12\x15\n" +
+	"\x06set_by\x18\x04 \x01(\tR\x05setBy\"\xc3\x01\n" +
+	"\x17SetOwnerKYCTierResponse\x127\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x19\n" +
+	"\bkyc_tier\x18\x03 \x01(\tR\akycTier\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe2\x03\n" +
+	"\n" +
+	"LimitUsage\x122\n" +
+	"\x06window\x18\x01 \x01(\x0e2\x1a.accounting.v1.LimitWindowR\x06window\x12=\n" +
+	"\fwindow_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x127\n" +
+	"\tresets_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bresetsAt\x12\x1f\n" +
+	"\vused_amount\x18\x04 \x01(\tR\n" +
+	"usedAmount\x12\x1d\n" +
+	"\n" +
+	"used_count\x18\x05 \x01(\x05R\tusedCount\x12\"\n" +
+	"\n" +
+	"amount_cap\x18\x06 \x01(\tH\x00R\tamountCap\x88\x01\x01\x12 \n" +
+	"\tcount_cap\x18\a \x01(\x05H\x01R\bcountCap\x88\x01\x01\x12.\n" +
+	"\x10remaining_amount\x18\b \x01(\tH\x02R\x0fremainingAmount\x88\x01\x01\x12,\n" +
+	"\x0fremaining_count\x18\t \x01(\x05H\x03R\x0eremainingCount\x88\x01\x01B\r\n" +
+	"\v_amount_capB\f\n" +
+	"\n" +
+	"_count_capB\x13\n" +
+	"\x11_remaining_amountB\x12\n" +
+	"\x10_remaining_count\"@\n" +
+	"\x17GetAccountLimitsRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\"\xca\x02\n" +
+	"\x18GetAccountLimitsResponse\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1e\n" +
+	"\bkyc_tier\x18\x03 \x01(\tH\x00R\akycTier\x88\x01\x01\x12:\n" +
+	"\aprofile\x18\x04 \x01(\v2\x1b.accounting.v1.LimitProfileH\x01R\aprofile\x88\x01\x01\x12/\n" +
+	"\x11max_single_amount\x18\x05 \x01(\tH\x02R\x0fmaxSingleAmount\x88\x01\x01\x12/\n" +
+	"\x05usage\x18\x06 \x03(\v2\x19.accounting.v1.LimitUsageR\x05usageB\v\n" +
+	"\t_kyc_tierB\n" +
+	"\n" +
+	"\b_profileB\x14\n" +
+	"\x12_max_single_amount\"\xbe\n" +
+	"\n" +
+	"\x11ScheduledTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x127\n" +
+	"\n" +
+	"owner_type\x18\x02 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12.\n" +
+	"\x13from_account_number\x18\x04 \x01(\tR\x11fromAccountNumber\x12*\n" +
+	"\x11to_account_number\x18\x05 \x01(\tR\x0ftoAccountNumber\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\tR\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12=\n" +
+	"\faccount_type\x18\b \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12 \n" +
+	"\tcron_expr\x18\n" +
+	" \x01(\tH\x00R\bcronExpr\x88\x01\x01\x12.\n" +
+	"\x10interval_seconds\x18\v \x01(\x03H\x01R\x0fintervalSeconds\x88\x01\x01\x125\n" +
+	"\bstart_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x126\n" +
+	"\x06end_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x05endAt\x88\x01\x01\x12\x1e\n" +
+	"\bmax_runs\x18\x0e \x01(\x05H\x03R\amaxRuns\x88\x01\x01\x12\x1f\n" +
+	"\vmax_retries\x18\x0f \x01(\x05R\n" +
+	"maxRetries\x12>\n" +
+	"\x06status\x18\x10 \x01(\x0e2&.accounting.v1.ScheduledTransferStatusR\x06status\x12?\n" +
+	"\vnext_run_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\tnextRunAt\x88\x01\x01\x12\x1a\n" +
+	"\battempts\x18\x12 \x01(\x05R\battempts\x12\x1b\n" +
+	"\trun_count\x18\x13 \x01(\x05R\brunCount\x12?\n" +
+	"\vlast_run_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\x05R\tlastRunAt\x88\x01\x01\x12/\n" +
+	"\x11last_receipt_code\x18\x15 \x01(\tH\x06R\x0flastReceiptCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\x16 \x01(\tH\aR\tlastError\x88\x01\x01\x12&\n" +
+	"\fcancelled_by\x18\x17 \x01(\tH\bR\vcancelledBy\x88\x01\x01\x12B\n" +
+	"\fcancelled_at\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampH\tR\vcancelledAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\f\n" +
+	"\n" +
+	"_cron_exprB\x13\n" +
+	"\x11_interval_secondsB\t\n" +
+	"\a_end_atB\v\n" +
+	"\t_max_runsB\x0e\n" +
+	"\f_next_run_atB\x0e\n" +
+	"\f_last_run_atB\x14\n" +
+	"\x12_last_receipt_codeB\r\n" +
+	"\v_last_errorB\x0f\n" +
+	"\r_cancelled_byB\x0f\n" +
+	"\r_cancelled_at\"\x97\x03\n" +
+	"\x14ScheduledTransferRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\x03R\n" +
+	"scheduleId\x12?\n" +
+	"\roccurrence_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\foccurrenceAt\x12A\n" +
+	"\x06status\x18\x04 \x01(\x0e2).accounting.v1.ScheduledTransferRunStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12&\n" +
+	"\freceipt_code\x18\x06 \x01(\tH\x00R\vreceiptCode\x88\x01\x01\x12(\n" +
+	"\rerror_message\x18\a \x01(\tH\x01R\ferrorMessage\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0f\n" +
+	"\r_receipt_codeB\x10\n" +
+	"\x0e_error_message\"\xad\x05\n" +
+	"\x1eCreateScheduledTransferRequest\x127\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12.\n" +
+	"\x13from_account_number\x18\x03 \x01(\tR\x11fromAccountNumber\x12*\n" +
+	"\x11to_account_number\x18\x04 \x01(\tR\x0ftoAccountNumber\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12=\n" +
+	"\faccount_type\x18\x06 \x01(\x0e2\x1a.accounting.v1.AccountTypeR\vaccountType\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12 \n" +
+	"\tcron_expr\x18\b \x01(\tH\x00R\bcronExpr\x88\x01\x01\x12.\n" +
+	"\x10interval_seconds\x18\t \x01(\x03H\x01R\x0fintervalSeconds\x88\x01\x01\x12:\n" +
+	"\bstart_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x02R\astartAt\x88\x01\x01\x126\n" +
+	"\x06end_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x05endAt\x88\x01\x01\x12\x1e\n" +
+	"\bmax_runs\x18\f \x01(\x05H\x04R\amaxRuns\x88\x01\x01\x12$\n" +
+	"\vmax_retries\x18\r \x01(\x05H\x05R\n" +
+	"maxRetries\x88\x01\x01B\f\n" +
+	"\n" +
+	"_cron_exprB\x13\n" +
+	"\x11_interval_secondsB\v\n" +
+	"\t_start_atB\t\n" +
+	"\a_end_atB\v\n" +
+	"\t_max_runsB\x0e\n" +
+	"\f_max_retries\"_\n" +
+	"\x1fCreateScheduledTransferResponse\x12<\n" +
+	"\bschedule\x18\x01 \x01(\v2 .accounting.v1.ScheduledTransferR\bschedule\"\xa7\x01\n" +
+	"\x1bGetScheduledTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12<\n" +
+	"\n" +
+	"owner_type\x18\x02 \x01(\x0e2\x18.accounting.v1.OwnerTypeH\x00R\townerType\x88\x01\x01\x12\x1e\n" +
+	"\bowner_id\x18\x03 \x01(\tH\x01R\aownerId\x88\x01\x01B\r\n" +
+	"\v_owner_typeB\v\n" +
+	"\t_owner_id\"\xa2\x01\n" +
+	"\x1cGetScheduledTransferResponse\x12<\n" +
+	"\bschedule\x18\x01 \x01(\v2 .accounting.v1.ScheduledTransferR\bschedule\x12D\n" +
+	"\vrecent_runs\x18\x02 \x03(\v2#.accounting.v1.ScheduledTransferRunR\n" +
+	"recentRuns\"\xd6\x02\n" +
+	"\x1dListScheduledTransfersRequest\x12<\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\x0e2\x18.accounting.v1.OwnerTypeH\x00R\townerType\x88\x01\x01\x12\x1e\n" +
+	"\bowner_id\x18\x02 \x01(\tH\x01R\aownerId\x88\x01\x01\x12*\n" +
+	"\x0eaccount_number\x18\x03 \x01(\tH\x02R\raccountNumber\x88\x01\x01\x12C\n" +
+	"\x06status\x18\x04 \x01(\x0e2&.accounting.v1.ScheduledTransferStatusH\x03R\x06status\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offsetB\r\n" +
+	"\v_owner_typeB\v\n" +
+	"\t_owner_idB\x11\n" +
+	"\x0f_account_numberB\t\n" +
+	"\a_status\"v\n" +
+	"\x1eListScheduledTransfersResponse\x12>\n" +
+	"\tschedules\x18\x01 \x03(\v2 .accounting.v1.ScheduledTransferR\tschedules\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xcd\x01\n" +
+	"\x1eCancelScheduledTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12<\n" +
+	"\n" +
+	"owner_type\x18\x02 \x01(\x0e2\x18.accounting.v1.OwnerTypeH\x00R\townerType\x88\x01\x01\x12\x1e\n" +
+	"\bowner_id\x18\x03 \x01(\tH\x01R\aownerId\x88\x01\x01\x12!\n" +
+	"\fcancelled_by\x18\x04 \x01(\tR\vcancelledByB\r\n" +
+	"\v_owner_typeB\v\n" +
+	"\t_owner_id\"_\n" +
+	"\x1fCancelScheduledTransferResponse\x12<\n" +
+	"\bschedule\x18\x01 \x01(\v2 .accounting.v1.ScheduledTransferR\bschedule\"\xd4\a\n" +
+	"\aFeeRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\trule_name\x18\x02 \x01(\tR\bruleName\x12I\n" +
+	"\x10transaction_type\x18\x03 \x01(\x0e2\x1e.accounting.v1.TransactionTypeR\x0ftransactionType\x12,\n" +
+	"\x0fsource_currency\x18\x04 \x01(\tH\x00R\x0esourceCurrency\x88\x01\x01\x12,\n" +
+	"\x0ftarget_currency\x18\x05 \x01(\tH\x01R\x0etargetCurrency\x88\x01\x01\x12B\n" +
+	"\faccount_type\x18\x06 \x01(\x0e2\x1a.accounting.v1.AccountTypeH\x02R\vaccountType\x88\x01\x01\x12<\n" +
+	"\n" +
+	"owner_type\x18\a \x01(\x0e2\x18.accounting.v1.OwnerTypeH\x03R\townerType\x88\x01\x01\x121\n" +
+	"\bfee_type\x18\b \x01(\x0e2\x16.accounting.v1.FeeTypeR\afeeType\x12-\n" +
+	"\x12calculation_method\x18\t \x01(\tR\x11calculationMethod\x12\x1b\n" +
+	"\tfee_value\x18\n" +
+	" \x01(\tR\bfeeValue\x12\x1c\n" +
+	"\amin_fee\x18\v \x01(\tH\x04R\x06minFee\x88\x01\x01\x12\x1c\n" +
+	"\amax_fee\x18\f \x01(\tH\x05R\x06maxFee\x88\x01\x01\x12\x19\n" +
+	"\x05tiers\x18\r \x01(\tH\x06R\x05tiers\x88\x01\x01\x12\x1d\n" +
+	"\atariffs\x18\x0e \x01(\tH\aR\atariffs\x88\x01\x01\x129\n" +
+	"\n" +
+	"valid_from\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x12:\n" +
+	"\bvalid_to\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampH\bR\avalidTo\x88\x01\x01\x12\x1b\n" +
+	"\tis_active\x18\x11 \x01(\bR\bisActive\x12\x1a\n" +
+	"\bpriority\x18\x12 \x01(\x05R\bpriority\x12\x18\n" +
+	"\aversion\x18\x13 \x01(\x05R\aversion\x12\"\n" +
+	"\n" +
+	"version_id\x18\x14 \x01(\x03H\tR\tversionId\x88\x01\x01B\x12\n" +
+	"\x10_source_currencyB\x12\n" +
+	"\x10_target_currencyB\x0f\n" +
+	"\r_account_typeB\r\n" +
+	"\v_owner_typeB\n" +
+	"\n" +
+	"\b_min_feeB\n" +
+	"\n" +
+	"\b_max_feeB\b\n" +
+	"\x06_tiersB\n" +
+	"\n" +
+	"\b_tariffsB\v\n" +
+	"\t_valid_toB\r\n" +
+	"\v_version_id\"\xba\x01\n" +
+	"\x0eFeeRuleVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\x03R\x06ruleId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12*\n" +
+	"\x04rule\x18\x04 \x01(\v2\x16.accounting.v1.FeeRuleR\x04rule\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"5\n" +
+	"\x1aListFeeRuleVersionsRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\"X\n" +
+	"\x1bListFeeRuleVersionsResponse\x129\n" +
+	"\bversions\x18\x01 \x03(\v2\x1d.accounting.v1.FeeRuleVersionR\bversions\"\xc3\x02\n" +
+	"\x17SimulateFeeRulesRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12,\n" +
+	"\x05rules\x18\x03 \x03(\v2\x16.accounting.v1.FeeRuleR\x05rules\x12&\n" +
+	"\x0fremove_rule_ids\x18\x04 \x03(\x03R\rremoveRuleIds\x12K\n" +
+	"\x11transaction_types\x18\x05 \x03(\x0e2\x1e.accounting.v1.TransactionTypeR\x10transactionTypes\x12)\n" +
+	"\x10max_transactions\x18\x06 \x01(\x05R\x0fmaxTransactions\"\x83\x03\n" +
+	"\x12FeeSimulationGroup\x12I\n" +
+	"\x10transaction_type\x18\x01 \x01(\x0e2\x1e.accounting.v1.TransactionTypeR\x0ftransactionType\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12+\n" +
+	"\x11transaction_count\x18\x03 \x01(\x05R\x10transactionCount\x12#\n" +
+	"\rchanged_count\x18\x04 \x01(\x05R\fchangedCount\x12!\n" +
+	"\ffailed_count\x18\x05 \x01(\x05R\vfailedCount\x12'\n" +
+	"\x0fcharged_revenue\x18\x06 \x01(\tR\x0echargedRevenue\x12'\n" +
+	"\x0fcurrent_revenue\x18\a \x01(\tR\x0ecurrentRevenue\x12)\n" +
+	"\x10proposed_revenue\x18\b \x01(\tR\x0fproposedRevenue\x12\x14\n" +
+	"\x05delta\x18\t \x01(\tR\x05delta\"\xfc\x01\n" +
+	"\x18SimulateFeeRulesResponse\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12+\n" +
+	"\x11transaction_count\x18\x03 \x01(\x05R\x10transactionCount\x12\x1c\n" +
+	"\ttruncated\x18\x04 \x01(\bR\ttruncated\x129\n" +
+	"\x06groups\x18\x05 \x03(\v2!.accounting.v1.FeeSimulationGroupR\x06groups\"\xdc\x03\n" +
+	"\x10InterestRatePlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x127\n" +
+	"\apurpose\x18\x04 \x01(\x0e2\x1d.accounting.v1.AccountPurposeR\apurpose\x12\x1f\n" +
+	"\vannual_rate\x18\x05 \x01(\tR\n" +
+	"annualRate\x12\x16\n" +
+	"\x06method\x18\x06 \x01(\tR\x06method\x12\x1b\n" +
+	"\tday_count\x18\a \x01(\x05R\bdayCount\x12\x1f\n" +
+	"\vmin_balance\x18\b \x01(\tR\n" +
+	"minBalance\x12\x1b\n" +
+	"\tis_active\x18\t \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x12>\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tcreatedAt\x88\x01\x01\x12>\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tupdatedAt\x88\x01\x01B\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_at\"\x95\x05\n" +
+	"\x0fInterestPosting\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12;\n" +
+	"\vperiod_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"periodFrom\x127\n" +
+	"\tperiod_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bperiodTo\x12#\n" +
+	"\raccrual_count\x18\x06 \x01(\x05R\faccrualCount\x12\x1d\n" +
+	"\n" +
+	"carried_in\x18\a \x01(\tR\tcarriedIn\x12\x18\n" +
+	"\aaccrued\x18\b \x01(\tR\aaccrued\x12\x16\n" +
+	"\x06amount\x18\t \x01(\tR\x06amount\x12\x1c\n" +
+	"\tremainder\x18\n" +
+	" \x01(\tR\tremainder\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\f \x01(\x05R\battempts\x12&\n" +
+	"\freceipt_code\x18\r \x01(\tH\x00R\vreceiptCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\x0e \x01(\tH\x01R\tlastError\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\tposted_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\bpostedAt\x88\x01\x01B\x0f\n" +
+	"\r_receipt_codeB\r\n" +
+	"\v_last_errorB\f\n" +
+	"\n" +
+	"_posted_at\"a\n" +
+	"\x0fInterestSummary\x12\x18\n" +
+	"\aaccrued\x18\x01 \x01(\tR\aaccrued\x12\x12\n" +
+	"\x04paid\x18\x02 \x01(\tR\x04paid\x12 \n" +
+	"\voutstanding\x18\x03 \x01(\tR\voutstanding\"s\n" +
+	"\x1dCreateInterestRatePlanRequest\x123\n" +
+	"\x04plan\x18\x01 \x01(\v2\x1f.accounting.v1.InterestRatePlanR\x04plan\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\tcreatedBy\"U\n" +
+	"\x1eCreateInterestRatePlanResponse\x123\n" +
+	"\x04plan\x18\x01 \x01(\v2\x1f.accounting.v1.InterestRatePlanR\x04plan\"s\n" +
+	"\x1dUpdateInterestRatePlanRequest\x123\n" +
+	"\x04plan\x18\x01 \x01(\v2\x1f.accounting.v1.InterestRatePlanR\x04plan\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x02 \x01(\tR\tupdatedBy\"U\n" +
+	"\x1eUpdateInterestRatePlanResponse\x123\n" +
+	"\x04plan\x18\x01 \x01(\v2\x1f.accounting.v1.InterestRatePlanR\x04plan\"m\n" +
+	"\x1cListInterestRatePlansRequest\x12\x1f\n" +
+	"\bcurrency\x18\x01 \x01(\tH\x00R\bcurrency\x88\x01\x01\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnlyB\v\n" +
+	"\t_currency\"V\n" +
+	"\x1dListInterestRatePlansResponse\x125\n" +
+	"\x05plans\x18\x01 \x03(\v2\x1f.accounting.v1.InterestRatePlanR\x05plans\"\x9e\x01\n" +
+	"\x19GetAccountInterestRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\x92\x01\n" +
+	"\x1aGetAccountInterestResponse\x128\n" +
+	"\asummary\x18\x01 \x01(\v2\x1e.accounting.v1.InterestSummaryR\asummary\x12:\n" +
+	"\bpostings\x18\x02 \x03(\v2\x1e.accounting.v1.InterestPostingR\bpostings\"\xb2\x01\n" +
+	"\x1bListInterestPostingsRequest\x12*\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tH\x00R\raccountNumber\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x02 \x01(\tH\x01R\x06status\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offsetB\x11\n" +
+	"\x0f_account_numberB\t\n" +
+	"\a_status\"p\n" +
+	"\x1cListInterestPostingsResponse\x12:\n" +
+	"\bpostings\x18\x01 \x03(\v2\x1e.accounting.v1.InterestPostingR\bpostings\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"S\n" +
+	"\x12RunInterestRequest\x12=\n" +
+	"\fthrough_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vthroughDate\"\xa5\x01\n" +
+	"\x13RunInterestResponse\x12#\n" +
+	"\raccrual_count\x18\x01 \x01(\x05R\faccrualCount\x12#\n" +
+	"\rposting_count\x18\x02 \x01(\x05R\fpostingCount\x12!\n" +
+	"\fposted_count\x18\x03 \x01(\x05R\vpostedCount\x12!\n" +
+	"\ffailed_count\x18\x04 \x01(\x05R\vfailedCount\"\xab\x06\n" +
+	"\x12AccountRestriction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1b\n" +
+	"\x06amount\x18\x04 \x01(\tH\x00R\x06amount\x88\x01\x01\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12!\n" +
+	"\treference\x18\x06 \x01(\tH\x01R\treference\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x12>\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x02R\texpiresAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12@\n" +
+	"\vreleased_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x03R\n" +
+	"releasedAt\x88\x01\x01\x12$\n" +
+	"\vreleased_by\x18\f \x01(\tH\x04R\n" +
+	"releasedBy\x88\x01\x01\x12*\n" +
+	"\x0erelease_reason\x18\r \x01(\tH\x05R\rreleaseReason\x88\x01\x01\x125\n" +
+	"\x14sweep_account_number\x18\x0e \x01(\tH\x06R\x12sweepAccountNumber\x88\x01\x01\x12&\n" +
+	"\fswept_amount\x18\x0f \x01(\tH\aR\vsweptAmount\x88\x01\x01\x121\n" +
+	"\x12sweep_receipt_code\x18\x10 \x01(\tH\bR\x10sweepReceiptCode\x88\x01\x01B\t\n" +
+	"\a_amountB\f\n" +
+	"\n" +
+	"_referenceB\r\n" +
+	"\v_expires_atB\x0e\n" +
+	"\f_released_atB\x0e\n" +
+	"\f_released_byB\x11\n" +
+	"\x0f_release_reasonB\x17\n" +
+	"\x15_sweep_account_numberB\x0f\n" +
+	"\r_swept_amountB\x15\n" +
+	"\x13_sweep_receipt_code\"\xba\x02\n" +
+	"\x1ePlaceAccountRestrictionRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1b\n" +
+	"\x06amount\x18\x03 \x01(\tH\x00R\x06amount\x88\x01\x01\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12!\n" +
+	"\treference\x18\x05 \x01(\tH\x01R\treference\x88\x01\x01\x12>\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\texpiresAt\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedByB\t\n" +
+	"\a_amountB\f\n" +
+	"\n" +
+	"_referenceB\r\n" +
+	"\v_expires_at\"f\n" +
+	"\x1fPlaceAccountRestrictionResponse\x12C\n" +
+	"\vrestriction\x18\x01 \x01(\v2!.accounting.v1.AccountRestrictionR\vrestriction\"\x82\x01\n" +
+	" ReleaseAccountRestrictionRequest\x12%\n" +
+	"\x0erestriction_id\x18\x01 \x01(\x03R\rrestrictionId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vreleased_by\x18\x03 \x01(\tR\n" +
+	"releasedBy\"h\n" +
+	"!ReleaseAccountRestrictionResponse\x12C\n" +
+	"\vrestriction\x18\x01 \x01(\v2!.accounting.v1.AccountRestrictionR\vrestriction\"\xd9\x01\n" +
+	"\x13CloseAccountRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x125\n" +
+	"\x17sweep_to_account_number\x18\x02 \x01(\tR\x14sweepToAccountNumber\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12!\n" +
+	"\treference\x18\x04 \x01(\tH\x00R\treference\x88\x01\x01\x12\x1b\n" +
+	"\tclosed_by\x18\x05 \x01(\tR\bclosedByB\f\n" +
+	"\n" +
+	"_reference\"S\n" +
+	"\x14CloseAccountResponse\x12;\n" +
+	"\aclosure\x18\x01 \x01(\v2!.accounting.v1.AccountRestrictionR\aclosure\"\xd0\x01\n" +
+	"\x1eListAccountRestrictionsRequest\x12*\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tH\x00R\raccountNumber\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12\x1f\n" +
+	"\vactive_only\x18\x03 \x01(\bR\n" +
+	"activeOnly\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offsetB\x11\n" +
+	"\x0f_account_numberB\a\n" +
+	"\x05_type\"~\n" +
+	"\x1fListAccountRestrictionsResponse\x12E\n" +
+	"\frestrictions\x18\x01 \x03(\v2!.accounting.v1.AccountRestrictionR\frestrictions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xba\x03\n" +
+	"\n" +
+	"DemoRefill\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12%\n" +
+	"\x0ebalance_before\x18\x06 \x01(\tR\rbalanceBefore\x12#\n" +
+	"\rbalance_after\x18\a \x01(\tR\fbalanceAfter\x12&\n" +
+	"\freceipt_code\x18\b \x01(\tH\x00R\vreceiptCode\x88\x01\x01\x12!\n" +
+	"\frequested_by\x18\t \x01(\tR\vrequestedBy\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12F\n" +
+	"\x11next_available_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0fnextAvailableAtB\x0f\n" +
+	"\r_receipt_code\"\xd4\x01\n" +
+	"\x17TopUpDemoAccountRequest\x127\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x1b\n" +
+	"\x06amount\x18\x04 \x01(\tH\x00R\x06amount\x88\x01\x01\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedByB\t\n" +
+	"\a_amount\"M\n" +
+	"\x18TopUpDemoAccountResponse\x121\n" +
+	"\x06refill\x18\x01 \x01(\v2\x19.accounting.v1.DemoRefillR\x06refill\"\xac\x01\n" +
+	"\x17ResetDemoAccountRequest\x127\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\x0e2\x18.accounting.v1.OwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12!\n" +
+	"\frequested_by\x18\x04 \x01(\tR\vrequestedBy\"M\n" +
+	"\x18ResetDemoAccountResponse\x121\n" +
+	"\x06refill\x18\x01 \x01(\v2\x19.accounting.v1.DemoRefillR\x06refill*\x97\x01\n" +
 	"\tOwnerType\x12\x1a\n" +
 	"\x16OWNER_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fOWNER_TYPE_USER\x10\x01\x12\x16\n" +
@@ -9475,7 +20770,7 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\vAccountType\x12\x1c\n" +
 	"\x18ACCOUNT_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11ACCOUNT_TYPE_REAL\x10\x01\x12\x15\n" +
-	"\x11ACCOUNT_TYPE_DEMO\x10\x02*\xb9\x02\n" +
+	"\x11ACCOUNT_TYPE_DEMO\x10\x02*\x9c\x03\n" +
 	"\x0eAccountPurpose\x12\x1f\n" +
 	"\x1bACCOUNT_PURPOSE_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16ACCOUNT_PURPOSE_WALLET\x10\x01\x12\x1d\n" +
@@ -9486,7 +20781,11 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\x1aACCOUNT_PURPOSE_SETTLEMENT\x10\x06\x12\x1b\n" +
 	"\x17ACCOUNT_PURPOSE_REVENUE\x10\a\x12\x1a\n" +
 	"\x16ACCOUNT_PURPOSE_CONTRA\x10\b\x12\x1e\n" +
-	"\x1aACCOUNT_PURPOSE_COMMISSION\x10\t*@\n" +
+	"\x1aACCOUNT_PURPOSE_COMMISSION\x10\t\x12\x1e\n" +
+	"\x1aACCOUNT_PURPOSE_INVESTMENT\x10\n" +
+	"\x12\x1b\n" +
+	"\x17ACCOUNT_PURPOSE_SAVINGS\x10\v\x12$\n" +
+	" ACCOUNT_PURPOSE_INTEREST_EXPENSE\x10\f*@\n" +
 	"\x04DrCr\x12\x15\n" +
 	"\x11DR_CR_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vDR_CR_DEBIT\x10\x01\x12\x10\n" +
@@ -9516,14 +20815,39 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\x10FEE_TYPE_NETWORK\x10\x02\x12\x17\n" +
 	"\x13FEE_TYPE_CONVERSION\x10\x03\x12\x17\n" +
 	"\x13FEE_TYPE_WITHDRAWAL\x10\x04\x12\x1d\n" +
-	"\x19FEE_TYPE_AGENT_COMMISSION\x10\x05*\xc4\x01\n" +
+	"\x19FEE_TYPE_AGENT_COMMISSION\x10\x05*g\n" +
+	"\x0fStatementFormat\x12 \n" +
+	"\x1cSTATEMENT_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_PDF\x10\x02*\x8e\x01\n" +
+	"\n" +
+	"HoldStatus\x12\x1b\n" +
+	"\x17HOLD_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12HOLD_STATUS_ACTIVE\x10\x01\x12\x18\n" +
+	"\x14HOLD_STATUS_CAPTURED\x10\x02\x12\x18\n" +
+	"\x14HOLD_STATUS_RELEASED\x10\x03\x12\x17\n" +
+	"\x13HOLD_STATUS_EXPIRED\x10\x04*\xba\x01\n" +
+	"\x17ReconciliationRunStatus\x12)\n" +
+	"%RECONCILIATION_RUN_STATUS_UNSPECIFIED\x10\x00\x12%\n" +
+	"!RECONCILIATION_RUN_STATUS_RUNNING\x10\x01\x12'\n" +
+	"#RECONCILIATION_RUN_STATUS_COMPLETED\x10\x02\x12$\n" +
+	" RECONCILIATION_RUN_STATUS_FAILED\x10\x03*\xaf\x02\n" +
+	"\x17QueuedTransactionStatus\x12)\n" +
+	"%QUEUED_TRANSACTION_STATUS_UNSPECIFIED\x10\x00\x12%\n" +
+	"!QUEUED_TRANSACTION_STATUS_PENDING\x10\x01\x12(\n" +
+	"$QUEUED_TRANSACTION_STATUS_PROCESSING\x10\x02\x12'\n" +
+	"#QUEUED_TRANSACTION_STATUS_COMPLETED\x10\x03\x12$\n" +
+	" QUEUED_TRANSACTION_STATUS_FAILED\x10\x04\x12\"\n" +
+	"\x1eQUEUED_TRANSACTION_STATUS_DEAD\x10\x05\x12%\n" +
+	"!QUEUED_TRANSACTION_STATUS_SKIPPED\x10\x06*\xe1\x01\n" +
 	"\x0eApprovalStatus\x12\x1f\n" +
 	"\x1bAPPROVAL_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17APPROVAL_STATUS_PENDING\x10\x01\x12\x1c\n" +
 	"\x18APPROVAL_STATUS_APPROVED\x10\x02\x12\x1c\n" +
 	"\x18APPROVAL_STATUS_REJECTED\x10\x03\x12\x1c\n" +
 	"\x18APPROVAL_STATUS_EXECUTED\x10\x04\x12\x1a\n" +
-	"\x16APPROVAL_STATUS_FAILED\x10\x05*\xb3\x01\n" +
+	"\x16APPROVAL_STATUS_FAILED\x10\x05\x12\x1b\n" +
+	"\x17APPROVAL_STATUS_EXPIRED\x10\x06*\xb3\x01\n" +
 	"\x10RelationshipType\x12!\n" +
 	"\x1dRELATIONSHIP_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18RELATIONSHIP_TYPE_DIRECT\x10\x01\x12\x1e\n" +
@@ -9534,7 +20858,26 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\x18AGENT_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13AGENT_STATUS_ACTIVE\x10\x01\x12\x19\n" +
 	"\x15AGENT_STATUS_INACTIVE\x10\x02\x12\x18\n" +
-	"\x14AGENT_STATUS_DELETED\x10\x032\xcc%\n" +
+	"\x14AGENT_STATUS_DELETED\x10\x03*\xb0\x01\n" +
+	"\x16CommissionPayoutStatus\x12(\n" +
+	"$COMMISSION_PAYOUT_STATUS_UNSPECIFIED\x10\x00\x12$\n" +
+	" COMMISSION_PAYOUT_STATUS_PENDING\x10\x01\x12!\n" +
+	"\x1dCOMMISSION_PAYOUT_STATUS_PAID\x10\x02\x12#\n" +
+	"\x1fCOMMISSION_PAYOUT_STATUS_FAILED\x10\x03*v\n" +
+	"\vLimitWindow\x12\x1c\n" +
+	"\x18LIMIT_WINDOW_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12LIMIT_WINDOW_DAILY\x10\x01\x12\x17\n" +
+	"\x13LIMIT_WINDOW_WEEKLY\x10\x02\x12\x18\n" +
+	"\x14LIMIT_WINDOW_MONTHLY\x10\x03*\xbc\x01\n" +
+	"\x17ScheduledTransferStatus\x12)\n" +
+	"%SCHEDULED_TRANSFER_STATUS_UNSPECIFIED\x10\x00\x12$\n" +
+	" SCHEDULED_TRANSFER_STATUS_ACTIVE\x10\x01\x12'\n" +
+	"#SCHEDULED_TRANSFER_STATUS_COMPLETED\x10\x02\x12'\n" +
+	"#SCHEDULED_TRANSFER_STATUS_CANCELLED\x10\x03*\xa3\x01\n" +
+	"\x1aScheduledTransferRunStatus\x12-\n" +
+	")SCHEDULED_TRANSFER_RUN_STATUS_UNSPECIFIED\x10\x00\x12+\n" +
+	"'SCHEDULED_TRANSFER_RUN_STATUS_SUCCEEDED\x10\x01\x12)\n" +
+	"%SCHEDULED_TRANSFER_RUN_STATUS_SKIPPED\x10\x022\xf2M\n" +
 	"\x11AccountingService\x12Z\n" +
 	"\rCreateAccount\x12#.accounting.v1.CreateAccountRequest\x1a$.accounting.v1.CreateAccountResponse\x12]\n" +
 	"\x0eCreateAccounts\x12$.accounting.v1.CreateAccountsRequest\x1a%.accounting.v1.CreateAccountsResponse\x12Q\n" +
@@ -9542,7 +20885,9 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"GetAccount\x12 .accounting.v1.GetAccountRequest\x1a!.accounting.v1.GetAccountResponse\x12i\n" +
 	"\x12GetAccountsByOwner\x12(.accounting.v1.GetAccountsByOwnerRequest\x1a).accounting.v1.GetAccountsByOwnerResponse\x12x\n" +
 	"\x17GetOrCreateUserAccounts\x12-.accounting.v1.GetOrCreateUserAccountsRequest\x1a..accounting.v1.GetOrCreateUserAccountsResponse\x12Z\n" +
-	"\rUpdateAccount\x12#.accounting.v1.UpdateAccountRequest\x1a$.accounting.v1.UpdateAccountResponse\x12Q\n" +
+	"\rUpdateAccount\x12#.accounting.v1.UpdateAccountRequest\x1a$.accounting.v1.UpdateAccountResponse\x12c\n" +
+	"\x10CreateSubAccount\x12&.accounting.v1.CreateSubAccountRequest\x1a'.accounting.v1.CreateSubAccountResponse\x12`\n" +
+	"\x0fListSubAccounts\x12%.accounting.v1.ListSubAccountsRequest\x1a&.accounting.v1.ListSubAccountsResponse\x12Q\n" +
 	"\n" +
 	"GetBalance\x12 .accounting.v1.GetBalanceRequest\x1a!.accounting.v1.GetBalanceResponse\x12c\n" +
 	"\x10BatchGetBalances\x12&.accounting.v1.BatchGetBalancesRequest\x1a'.accounting.v1.BatchGetBalancesResponse\x12i\n" +
@@ -9556,12 +20901,16 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\fListJournals\x12\".accounting.v1.ListJournalsRequest\x1a#.accounting.v1.ListJournalsResponse\x12o\n" +
 	"\x14ListLedgersByJournal\x12*.accounting.v1.ListLedgersByJournalRequest\x1a+.accounting.v1.ListLedgersByJournalResponse\x12o\n" +
 	"\x14ListLedgersByAccount\x12*.accounting.v1.ListLedgersByAccountRequest\x1a+.accounting.v1.ListLedgersByAccountResponse\x12l\n" +
-	"\x13GetAccountStatement\x12).accounting.v1.GetAccountStatementRequest\x1a*.accounting.v1.GetAccountStatementResponse\x12f\n" +
+	"\x13GetAccountStatement\x12).accounting.v1.GetAccountStatementRequest\x1a*.accounting.v1.GetAccountStatementResponse\x12t\n" +
+	"\x16ExportAccountStatement\x12,.accounting.v1.ExportAccountStatementRequest\x1a*.accounting.v1.ExportAccountStatementChunk0\x01\x12f\n" +
 	"\x11GetOwnerStatement\x12'.accounting.v1.GetOwnerStatementRequest\x1a(.accounting.v1.GetOwnerStatementResponse\x12`\n" +
 	"\x0fGetOwnerSummary\x12%.accounting.v1.GetOwnerSummaryRequest\x1a&.accounting.v1.GetOwnerSummaryResponse\x12l\n" +
 	"\x13GenerateDailyReport\x12).accounting.v1.GenerateDailyReportRequest\x1a*.accounting.v1.GenerateDailyReportResponse\x12r\n" +
 	"\x15GetTransactionSummary\x12+.accounting.v1.GetTransactionSummaryRequest\x1a,.accounting.v1.GetTransactionSummaryResponse\x12f\n" +
-	"\x11GetSystemHoldings\x12'.accounting.v1.GetSystemHoldingsRequest\x1a(.accounting.v1.GetSystemHoldingsResponse\x12W\n" +
+	"\x11GetSystemHoldings\x12'.accounting.v1.GetSystemHoldingsRequest\x1a(.accounting.v1.GetSystemHoldingsResponse\x12r\n" +
+	"\x15CloseAccountingPeriod\x12+.accounting.v1.CloseAccountingPeriodRequest\x1a,.accounting.v1.CloseAccountingPeriodResponse\x12`\n" +
+	"\x0fGetTrialBalance\x12%.accounting.v1.GetTrialBalanceRequest\x1a&.accounting.v1.GetTrialBalanceResponse\x12c\n" +
+	"\x10GetGeneralLedger\x12&.accounting.v1.GetGeneralLedgerRequest\x1a'.accounting.v1.GetGeneralLedgerResponse\x12W\n" +
 	"\fCalculateFee\x12\".accounting.v1.CalculateFeeRequest\x1a#.accounting.v1.CalculateFeeResponse\x12c\n" +
 	"\x10GetFeesByReceipt\x12&.accounting.v1.GetFeesByReceiptRequest\x1a'.accounting.v1.GetFeesByReceiptResponse\x12~\n" +
 	"\x19GetAgentCommissionSummary\x12/.accounting.v1.GetAgentCommissionSummaryRequest\x1a0.accounting.v1.GetAgentCommissionSummaryResponse\x12k\n" +
@@ -9569,14 +20918,32 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"\x06Credit\x12\x1c.accounting.v1.CreditRequest\x1a\x1d.accounting.v1.CreditResponse\x12B\n" +
 	"\x05Debit\x12\x1b.accounting.v1.DebitRequest\x1a\x1c.accounting.v1.DebitResponse\x12K\n" +
 	"\bTransfer\x12\x1e.accounting.v1.TransferRequest\x1a\x1f.accounting.v1.TransferResponse\x12Y\n" +
-	"\x12ConvertAndTransfer\x12 .accounting.v1.ConversionRequest\x1a!.accounting.v1.ConversionResponse\x12L\n" +
+	"\x12ConvertAndTransfer\x12 .accounting.v1.ConversionRequest\x1a!.accounting.v1.ConversionResponse\x12Z\n" +
+	"\rCreateFXQuote\x12#.accounting.v1.CreateFXQuoteRequest\x1a$.accounting.v1.CreateFXQuoteResponse\x12L\n" +
 	"\x0fProcessTradeWin\x12\x1b.accounting.v1.TradeRequest\x1a\x1c.accounting.v1.TradeResponse\x12M\n" +
-	"\x10ProcessTradeLoss\x12\x1b.accounting.v1.TradeRequest\x1a\x1c.accounting.v1.TradeResponse\x12g\n" +
-	"\x16ProcessAgentCommission\x12%.accounting.v1.AgentCommissionRequest\x1a&.accounting.v1.AgentCommissionResponse\x12~\n" +
+	"\x10ProcessTradeLoss\x12\x1b.accounting.v1.TradeRequest\x1a\x1c.accounting.v1.TradeResponse\x12W\n" +
+	"\fSettleTrades\x12\".accounting.v1.SettleTradesRequest\x1a#.accounting.v1.SettleTradesResponse\x12g\n" +
+	"\x16ProcessAgentCommission\x12%.accounting.v1.AgentCommissionRequest\x1a&.accounting.v1.AgentCommissionResponse\x12_\n" +
+	"\x12ReverseTransaction\x12(.accounting.v1.ReverseTransactionRequest\x1a\x1f.accounting.v1.ReversalResponse\x12]\n" +
+	"\x11RefundTransaction\x12'.accounting.v1.RefundTransactionRequest\x1a\x1f.accounting.v1.ReversalResponse\x12N\n" +
+	"\tPlaceHold\x12\x1f.accounting.v1.PlaceHoldRequest\x1a .accounting.v1.PlaceHoldResponse\x12T\n" +
+	"\vCaptureHold\x12!.accounting.v1.CaptureHoldRequest\x1a\".accounting.v1.CaptureHoldResponse\x12T\n" +
+	"\vReleaseHold\x12!.accounting.v1.ReleaseHoldRequest\x1a\".accounting.v1.ReleaseHoldResponse\x12Q\n" +
+	"\n" +
+	"ExtendHold\x12 .accounting.v1.ExtendHoldRequest\x1a!.accounting.v1.ExtendHoldResponse\x12N\n" +
+	"\tListHolds\x12\x1f.accounting.v1.ListHoldsRequest\x1a .accounting.v1.ListHoldsResponse\x12f\n" +
+	"\x11RunReconciliation\x12'.accounting.v1.RunReconciliationRequest\x1a(.accounting.v1.RunReconciliationResponse\x12{\n" +
+	"\x18ListReconciliationBreaks\x12..accounting.v1.ListReconciliationBreaksRequest\x1a/.accounting.v1.ListReconciliationBreaksResponse\x12u\n" +
+	"\x16ListQueuedTransactions\x12,.accounting.v1.ListQueuedTransactionsRequest\x1a-.accounting.v1.ListQueuedTransactionsResponse\x12\x8a\x01\n" +
+	"\x1dRedriveDeadLetterTransactions\x123.accounting.v1.RedriveDeadLetterTransactionsRequest\x1a4.accounting.v1.RedriveDeadLetterTransactionsResponse\x12\x81\x01\n" +
+	"\x1aSkipDeadLetterTransactions\x120.accounting.v1.SkipDeadLetterTransactionsRequest\x1a1.accounting.v1.SkipDeadLetterTransactionsResponse\x12~\n" +
 	"\x19CreateTransactionApproval\x12/.accounting.v1.CreateTransactionApprovalRequest\x1a0.accounting.v1.CreateTransactionApprovalResponse\x12l\n" +
 	"\x13GetPendingApprovals\x12).accounting.v1.GetPendingApprovalsRequest\x1a*.accounting.v1.GetPendingApprovalsResponse\x12i\n" +
 	"\x12ApproveTransaction\x12(.accounting.v1.ApproveTransactionRequest\x1a).accounting.v1.ApproveTransactionResponse\x12i\n" +
-	"\x12GetApprovalHistory\x12(.accounting.v1.GetApprovalHistoryRequest\x1a).accounting.v1.GetApprovalHistoryResponse\x12T\n" +
+	"\x12GetApprovalHistory\x12(.accounting.v1.GetApprovalHistoryRequest\x1a).accounting.v1.GetApprovalHistoryResponse\x12o\n" +
+	"\x14CreateApprovalPolicy\x12*.accounting.v1.CreateApprovalPolicyRequest\x1a+.accounting.v1.CreateApprovalPolicyResponse\x12o\n" +
+	"\x14UpdateApprovalPolicy\x12*.accounting.v1.UpdateApprovalPolicyRequest\x1a+.accounting.v1.UpdateApprovalPolicyResponse\x12o\n" +
+	"\x14ListApprovalPolicies\x12*.accounting.v1.ListApprovalPoliciesRequest\x1a+.accounting.v1.ListApprovalPoliciesResponse\x12T\n" +
 	"\vHealthCheck\x12!.accounting.v1.HealthCheckRequest\x1a\".accounting.v1.HealthCheckResponse\x12T\n" +
 	"\vCreateAgent\x12!.accounting.v1.CreateAgentRequest\x1a\".accounting.v1.CreateAgentResponse\x12T\n" +
 	"\vUpdateAgent\x12!.accounting.v1.UpdateAgentRequest\x1a\".accounting.v1.UpdateAgentResponse\x12T\n" +
@@ -9587,7 +20954,32 @@ const file_proto_shared_accounting_account_proto_rawDesc = "" +
 	"ListAgents\x12 .accounting.v1.ListAgentsRequest\x1a!.accounting.v1.ListAgentsResponse\x12o\n" +
 	"\x14GetAgentsByCountries\x12*.accounting.v1.GetAgentsByCountriesRequest\x1a+.accounting.v1.GetAgentsByCountriesResponse\x12Z\n" +
 	"\rGetAgentStats\x12#.accounting.v1.GetAgentStatsRequest\x1a$.accounting.v1.GetAgentStatsResponse\x12x\n" +
-	"\x17ListCommissionsForAgent\x12-.accounting.v1.ListCommissionsForAgentRequest\x1a..accounting.v1.ListCommissionsForAgentResponseB,Z*genproto/shared/accounting/v1;accountingpbb\x06proto3"
+	"\x17ListCommissionsForAgent\x12-.accounting.v1.ListCommissionsForAgentRequest\x1a..accounting.v1.ListCommissionsForAgentResponse\x12\x84\x01\n" +
+	"\x1bCreateCommissionPayoutBatch\x121.accounting.v1.CreateCommissionPayoutBatchRequest\x1a2.accounting.v1.CreateCommissionPayoutBatchResponse\x12r\n" +
+	"\x15ListCommissionPayouts\x12+.accounting.v1.ListCommissionPayoutsRequest\x1a,.accounting.v1.ListCommissionPayoutsResponse\x12i\n" +
+	"\x12CreateLimitProfile\x12(.accounting.v1.CreateLimitProfileRequest\x1a).accounting.v1.CreateLimitProfileResponse\x12i\n" +
+	"\x12UpdateLimitProfile\x12(.accounting.v1.UpdateLimitProfileRequest\x1a).accounting.v1.UpdateLimitProfileResponse\x12f\n" +
+	"\x11ListLimitProfiles\x12'.accounting.v1.ListLimitProfilesRequest\x1a(.accounting.v1.ListLimitProfilesResponse\x12`\n" +
+	"\x0fSetOwnerKYCTier\x12%.accounting.v1.SetOwnerKYCTierRequest\x1a&.accounting.v1.SetOwnerKYCTierResponse\x12c\n" +
+	"\x10GetAccountLimits\x12&.accounting.v1.GetAccountLimitsRequest\x1a'.accounting.v1.GetAccountLimitsResponse\x12x\n" +
+	"\x17CreateScheduledTransfer\x12-.accounting.v1.CreateScheduledTransferRequest\x1a..accounting.v1.CreateScheduledTransferResponse\x12o\n" +
+	"\x14GetScheduledTransfer\x12*.accounting.v1.GetScheduledTransferRequest\x1a+.accounting.v1.GetScheduledTransferResponse\x12u\n" +
+	"\x16ListScheduledTransfers\x12,.accounting.v1.ListScheduledTransfersRequest\x1a-.accounting.v1.ListScheduledTransfersResponse\x12x\n" +
+	"\x17CancelScheduledTransfer\x12-.accounting.v1.CancelScheduledTransferRequest\x1a..accounting.v1.CancelScheduledTransferResponse\x12c\n" +
+	"\x10SimulateFeeRules\x12&.accounting.v1.SimulateFeeRulesRequest\x1a'.accounting.v1.SimulateFeeRulesResponse\x12l\n" +
+	"\x13ListFeeRuleVersions\x12).accounting.v1.ListFeeRuleVersionsRequest\x1a*.accounting.v1.ListFeeRuleVersionsResponse\x12u\n" +
+	"\x16CreateInterestRatePlan\x12,.accounting.v1.CreateInterestRatePlanRequest\x1a-.accounting.v1.CreateInterestRatePlanResponse\x12u\n" +
+	"\x16UpdateInterestRatePlan\x12,.accounting.v1.UpdateInterestRatePlanRequest\x1a-.accounting.v1.UpdateInterestRatePlanResponse\x12r\n" +
+	"\x15ListInterestRatePlans\x12+.accounting.v1.ListInterestRatePlansRequest\x1a,.accounting.v1.ListInterestRatePlansResponse\x12i\n" +
+	"\x12GetAccountInterest\x12(.accounting.v1.GetAccountInterestRequest\x1a).accounting.v1.GetAccountInterestResponse\x12o\n" +
+	"\x14ListInterestPostings\x12*.accounting.v1.ListInterestPostingsRequest\x1a+.accounting.v1.ListInterestPostingsResponse\x12T\n" +
+	"\vRunInterest\x12!.accounting.v1.RunInterestRequest\x1a\".accounting.v1.RunInterestResponse\x12x\n" +
+	"\x17PlaceAccountRestriction\x12-.accounting.v1.PlaceAccountRestrictionRequest\x1a..accounting.v1.PlaceAccountRestrictionResponse\x12~\n" +
+	"\x19ReleaseAccountRestriction\x12/.accounting.v1.ReleaseAccountRestrictionRequest\x1a0.accounting.v1.ReleaseAccountRestrictionResponse\x12x\n" +
+	"\x17ListAccountRestrictions\x12-.accounting.v1.ListAccountRestrictionsRequest\x1a..accounting.v1.ListAccountRestrictionsResponse\x12W\n" +
+	"\fCloseAccount\x12\".accounting.v1.CloseAccountRequest\x1a#.accounting.v1.CloseAccountResponse\x12c\n" +
+	"\x10TopUpDemoAccount\x12&.accounting.v1.TopUpDemoAccountRequest\x1a'.accounting.v1.TopUpDemoAccountResponse\x12c\n" +
+	"\x10ResetDemoAccount\x12&.accounting.v1.ResetDemoAccountRequest\x1a'.accounting.v1.ResetDemoAccountResponseB,Z*genproto/shared/accounting/v1;accountingpbb\x06proto3"
 
 var (
 	file_proto_shared_accounting_account_proto_rawDescOnce sync.Once
@@ -9601,416 +20993,861 @@ func file_proto_shared_accounting_account_proto_rawDescGZIP() []byte {
 	return file_proto_shared_accounting_account_proto_rawDescData
 }
 
-var file_proto_shared_accounting_account_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
-var file_proto_shared_accounting_account_proto_msgTypes = make([]protoimpl.MessageInfo, 123)
+var file_proto_shared_accounting_account_proto_enumTypes = make([]protoimpl.EnumInfo, 18)
+var file_proto_shared_accounting_account_proto_msgTypes = make([]protoimpl.MessageInfo, 246)
 var file_proto_shared_accounting_account_proto_goTypes = []any{
-	(OwnerType)(0),                            // 0: accounting.v1.OwnerType
-	(AccountType)(0),                          // 1: accounting.v1.AccountType
-	(AccountPurpose)(0),                       // 2: accounting.v1.AccountPurpose
-	(DrCr)(0),                                 // 3: accounting.v1.DrCr
-	(TransactionType)(0),                      // 4: accounting.v1.TransactionType
-	(TransactionStatus)(0),                    // 5: accounting.v1.TransactionStatus
-	(FeeType)(0),                              // 6: accounting.v1.FeeType
-	(ApprovalStatus)(0),                       // 7: accounting.v1.ApprovalStatus
-	(RelationshipType)(0),                     // 8: accounting.v1.RelationshipType
-	(AgentStatus)(0),                          // 9: accounting.v1.AgentStatus
-	(*Account)(nil),                           // 10: accounting.v1.Account
-	(*Balance)(nil),                           // 11: accounting.v1.Balance
-	(*CreateAccountRequest)(nil),              // 12: accounting.v1.CreateAccountRequest
-	(*CreateAccountsRequest)(nil),             // 13: accounting.v1.CreateAccountsRequest
-	(*CreateAccountResponse)(nil),             // 14: accounting.v1.CreateAccountResponse
-	(*CreateAccountsResponse)(nil),            // 15: accounting.v1.CreateAccountsResponse
-	(*GetAccountRequest)(nil),                 // 16: accounting.v1.GetAccountRequest
-	(*GetAccountResponse)(nil),                // 17: accounting.v1.GetAccountResponse
-	(*GetAccountsByOwnerRequest)(nil),         // 18: accounting.v1.GetAccountsByOwnerRequest
-	(*GetAccountsByOwnerResponse)(nil),        // 19: accounting.v1.GetAccountsByOwnerResponse
-	(*GetOrCreateUserAccountsRequest)(nil),    // 20: accounting.v1.GetOrCreateUserAccountsRequest
-	(*GetOrCreateUserAccountsResponse)(nil),   // 21: accounting.v1.GetOrCreateUserAccountsResponse
-	(*UpdateAccountRequest)(nil),              // 22: accounting.v1.UpdateAccountRequest
-	(*UpdateAccountResponse)(nil),             // 23: accounting.v1.UpdateAccountResponse
-	(*GetBalanceRequest)(nil),                 // 24: accounting.v1.GetBalanceRequest
-	(*GetBalanceResponse)(nil),                // 25: accounting.v1.GetBalanceResponse
-	(*LedgerEntry)(nil),                       // 26: accounting.v1.LedgerEntry
-	(*ExecuteTransactionRequest)(nil),         // 27: accounting.v1.ExecuteTransactionRequest
-	(*ExecuteTransactionResponse)(nil),        // 28: accounting.v1.ExecuteTransactionResponse
-	(*ExecuteTransactionSyncRequest)(nil),     // 29: accounting.v1.ExecuteTransactionSyncRequest
-	(*ExecuteTransactionSyncResponse)(nil),    // 30: accounting.v1.ExecuteTransactionSyncResponse
-	(*GetTransactionStatusRequest)(nil),       // 31: accounting.v1.GetTransactionStatusRequest
-	(*GetTransactionStatusResponse)(nil),      // 32: accounting.v1.GetTransactionStatusResponse
-	(*GetTransactionByReceiptRequest)(nil),    // 33: accounting.v1.GetTransactionByReceiptRequest
-	(*GetTransactionByReceiptResponse)(nil),   // 34: accounting.v1.GetTransactionByReceiptResponse
-	(*Journal)(nil),                           // 35: accounting.v1.Journal
-	(*Ledger)(nil),                            // 36: accounting.v1.Ledger
-	(*GetJournalRequest)(nil),                 // 37: accounting.v1.GetJournalRequest
-	(*GetJournalResponse)(nil),                // 38: accounting.v1.GetJournalResponse
-	(*ListJournalsRequest)(nil),               // 39: accounting.v1.ListJournalsRequest
-	(*ListJournalsResponse)(nil),              // 40: accounting.v1.ListJournalsResponse
-	(*ListLedgersByJournalRequest)(nil),       // 41: accounting.v1.ListLedgersByJournalRequest
-	(*ListLedgersByJournalResponse)(nil),      // 42: accounting.v1.ListLedgersByJournalResponse
-	(*ListLedgersByAccountRequest)(nil),       // 43: accounting.v1.ListLedgersByAccountRequest
-	(*ListLedgersByAccountResponse)(nil),      // 44: accounting.v1.ListLedgersByAccountResponse
-	(*AccountStatement)(nil),                  // 45: accounting.v1.AccountStatement
-	(*GetAccountStatementRequest)(nil),        // 46: accounting.v1.GetAccountStatementRequest
-	(*GetAccountStatementResponse)(nil),       // 47: accounting.v1.GetAccountStatementResponse
-	(*GetOwnerStatementRequest)(nil),          // 48: accounting.v1.GetOwnerStatementRequest
-	(*GetOwnerStatementResponse)(nil),         // 49: accounting.v1.GetOwnerStatementResponse
-	(*OwnerSummary)(nil),                      // 50: accounting.v1.OwnerSummary
-	(*AccountBalance)(nil),                    // 51: accounting.v1.AccountBalance
-	(*GetOwnerSummaryRequest)(nil),            // 52: accounting.v1.GetOwnerSummaryRequest
-	(*GetOwnerSummaryResponse)(nil),           // 53: accounting.v1.GetOwnerSummaryResponse
-	(*DailyReport)(nil),                       // 54: accounting.v1.DailyReport
-	(*GenerateDailyReportRequest)(nil),        // 55: accounting.v1.GenerateDailyReportRequest
-	(*GenerateDailyReportResponse)(nil),       // 56: accounting.v1.GenerateDailyReportResponse
-	(*TransactionSummary)(nil),                // 57: accounting.v1.TransactionSummary
-	(*GetTransactionSummaryRequest)(nil),      // 58: accounting.v1.GetTransactionSummaryRequest
-	(*GetTransactionSummaryResponse)(nil),     // 59: accounting.v1.GetTransactionSummaryResponse
-	(*GetSystemHoldingsRequest)(nil),          // 60: accounting.v1.GetSystemHoldingsRequest
-	(*GetSystemHoldingsResponse)(nil),         // 61: accounting.v1.GetSystemHoldingsResponse
-	(*TransactionFee)(nil),                    // 62: accounting.v1.TransactionFee
-	(*CalculateFeeRequest)(nil),               // 63: accounting.v1.CalculateFeeRequest
-	(*FeeCalculation)(nil),                    // 64: accounting.v1.FeeCalculation
-	(*CalculateFeeResponse)(nil),              // 65: accounting.v1.CalculateFeeResponse
-	(*GetFeesByReceiptRequest)(nil),           // 66: accounting.v1.GetFeesByReceiptRequest
-	(*GetFeesByReceiptResponse)(nil),          // 67: accounting.v1.GetFeesByReceiptResponse
-	(*GetAgentCommissionSummaryRequest)(nil),  // 68: accounting.v1.GetAgentCommissionSummaryRequest
-	(*GetAgentCommissionSummaryResponse)(nil), // 69: accounting.v1.GetAgentCommissionSummaryResponse
-	(*HealthCheckRequest)(nil),                // 70: accounting.v1.HealthCheckRequest
-	(*HealthCheckResponse)(nil),               // 71: accounting.v1.HealthCheckResponse
-	(*BatchExecuteTransactionsRequest)(nil),   // 72: accounting.v1.BatchExecuteTransactionsRequest
-	(*BatchExecuteTransactionsResponse)(nil),  // 73: accounting.v1.BatchExecuteTransactionsResponse
-	(*BatchGetBalancesRequest)(nil),           // 74: accounting.v1.BatchGetBalancesRequest
-	(*BatchGetBalancesResponse)(nil),          // 75: accounting.v1.BatchGetBalancesResponse
-	(*StreamTransactionEventsRequest)(nil),    // 76: accounting.v1.StreamTransactionEventsRequest
-	(*TransactionEvent)(nil),                  // 77: accounting.v1.TransactionEvent
-	(*CreditRequest)(nil),                     // 78: accounting.v1.CreditRequest
-	(*CreditResponse)(nil),                    // 79: accounting.v1.CreditResponse
-	(*DebitRequest)(nil),                      // 80: accounting.v1.DebitRequest
-	(*DebitResponse)(nil),                     // 81: accounting.v1.DebitResponse
-	(*TransferRequest)(nil),                   // 82: accounting.v1.TransferRequest
-	(*TransferResponse)(nil),                  // 83: accounting.v1.TransferResponse
-	(*ConversionRequest)(nil),                 // 84: accounting.v1.ConversionRequest
-	(*ConversionResponse)(nil),                // 85: accounting.v1.ConversionResponse
-	(*TradeRequest)(nil),                      // 86: accounting.v1.TradeRequest
-	(*TradeResponse)(nil),                     // 87: accounting.v1.TradeResponse
-	(*AgentCommissionRequest)(nil),            // 88: accounting.v1.AgentCommissionRequest
-	(*AgentCommissionResponse)(nil),           // 89: accounting.v1.AgentCommissionResponse
-	(*TransactionApproval)(nil),               // 90: accounting.v1.TransactionApproval
-	(*CreateTransactionApprovalRequest)(nil),  // 91: accounting.v1.CreateTransactionApprovalRequest
-	(*CreateTransactionApprovalResponse)(nil), // 92: accounting.v1.CreateTransactionApprovalResponse
-	(*GetPendingApprovalsRequest)(nil),        // 93: accounting.v1.GetPendingApprovalsRequest
-	(*GetPendingApprovalsResponse)(nil),       // 94: accounting.v1.GetPendingApprovalsResponse
-	(*ApproveTransactionRequest)(nil),         // 95: accounting.v1.ApproveTransactionRequest
-	(*ApproveTransactionResponse)(nil),        // 96: accounting.v1.ApproveTransactionResponse
-	(*GetApprovalHistoryRequest)(nil),         // 97: accounting.v1.GetApprovalHistoryRequest
-	(*GetApprovalHistoryResponse)(nil),        // 98: accounting.v1.GetApprovalHistoryResponse
-	(*Agent)(nil),                             // 99: accounting.v1.Agent
-	(*AgentCommission)(nil),                   // 100: accounting.v1.AgentCommission
-	(*CreateAgentRequest)(nil),                // 101: accounting.v1.CreateAgentRequest
-	(*CreateAgentResponse)(nil),               // 102: accounting.v1.CreateAgentResponse
-	(*UpdateAgentRequest)(nil),                // 103: accounting.v1.UpdateAgentRequest
-	(*UpdateAgentResponse)(nil),               // 104: accounting.v1.UpdateAgentResponse
-	(*DeleteAgentRequest)(nil),                // 105: accounting.v1.DeleteAgentRequest
-	(*DeleteAgentResponse)(nil),               // 106: accounting.v1.DeleteAgentResponse
-	(*GetAgentByIDRequest)(nil),               // 107: accounting.v1.GetAgentByIDRequest
-	(*GetAgentByIDResponse)(nil),              // 108: accounting.v1.GetAgentByIDResponse
-	(*GetAgentByUserIDRequest)(nil),           // 109: accounting.v1.GetAgentByUserIDRequest
-	(*GetAgentByUserIDResponse)(nil),          // 110: accounting.v1.GetAgentByUserIDResponse
-	(*ListAgentsRequest)(nil),                 // 111: accounting.v1.ListAgentsRequest
-	(*ListAgentsResponse)(nil),                // 112: accounting.v1.ListAgentsResponse
-	(*ListCommissionsForAgentRequest)(nil),    // 113: accounting.v1.ListCommissionsForAgentRequest
-	(*ListCommissionsForAgentResponse)(nil),   // 114: accounting.v1.ListCommissionsForAgentResponse
-	(*GetAgentsByCountriesRequest)(nil),       // 115: accounting.v1.GetAgentsByCountriesRequest
-	(*GetAgentsByCountriesResponse)(nil),      // 116: accounting.v1.GetAgentsByCountriesResponse
-	(*GetAgentStatsRequest)(nil),              // 117: accounting.v1.GetAgentStatsRequest
-	(*GetAgentStatsResponse)(nil),             // 118: accounting.v1.GetAgentStatsResponse
-	nil,                                       // 119: accounting.v1.CreateAccountsResponse.ErrorsEntry
-	nil,                                       // 120: accounting.v1.GetSystemHoldingsResponse.HoldingsEntry
-	nil,                                       // 121: accounting.v1.GetAgentCommissionSummaryResponse.CommissionsEntry
-	nil,                                       // 122: accounting.v1.HealthCheckResponse.ComponentsEntry
-	nil,                                       // 123: accounting.v1.BatchExecuteTransactionsResponse.ErrorsEntry
-	nil,                                       // 124: accounting.v1.BatchGetBalancesResponse.ErrorsEntry
-	nil,                                       // 125: accounting.v1.Agent.MetadataEntry
-	nil,                                       // 126: accounting.v1.Agent.LocationEntry
-	nil,                                       // 127: accounting.v1.CreateAgentRequest.MetadataEntry
-	nil,                                       // 128: accounting.v1.CreateAgentRequest.LocationEntry
-	nil,                                       // 129: accounting.v1.UpdateAgentRequest.MetadataEntry
-	nil,                                       // 130: accounting.v1.UpdateAgentRequest.LocationEntry
-	nil,                                       // 131: accounting.v1.GetAgentStatsResponse.AgentsByCountryEntry
-	nil,                                       // 132: accounting.v1.GetAgentStatsResponse.AgentsByPaymentMethodEntry
-	(*timestamppb.Timestamp)(nil),             // 133: google.protobuf.Timestamp
+	(OwnerType)(0),                                // 0: accounting.v1.OwnerType
+	(AccountType)(0),                              // 1: accounting.v1.AccountType
+	(AccountPurpose)(0),                           // 2: accounting.v1.AccountPurpose
+	(DrCr)(0),                                     // 3: accounting.v1.DrCr
+	(TransactionType)(0),                          // 4: accounting.v1.TransactionType
+	(TransactionStatus)(0),                        // 5: accounting.v1.TransactionStatus
+	(FeeType)(0),                                  // 6: accounting.v1.FeeType
+	(StatementFormat)(0),                          // 7: accounting.v1.StatementFormat
+	(HoldStatus)(0),                               // 8: accounting.v1.HoldStatus
+	(ReconciliationRunStatus)(0),                  // 9: accounting.v1.ReconciliationRunStatus
+	(QueuedTransactionStatus)(0),                  // 10: accounting.v1.QueuedTransactionStatus
+	(ApprovalStatus)(0),                           // 11: accounting.v1.ApprovalStatus
+	(RelationshipType)(0),                         // 12: accounting.v1.RelationshipType
+	(AgentStatus)(0),                              // 13: accounting.v1.AgentStatus
+	(CommissionPayoutStatus)(0),                   // 14: accounting.v1.CommissionPayoutStatus
+	(LimitWindow)(0),                              // 15: accounting.v1.LimitWindow
+	(ScheduledTransferStatus)(0),                  // 16: accounting.v1.ScheduledTransferStatus
+	(ScheduledTransferRunStatus)(0),               // 17: accounting.v1.ScheduledTransferRunStatus
+	(*Account)(nil),                               // 18: accounting.v1.Account
+	(*Balance)(nil),                               // 19: accounting.v1.Balance
+	(*CreateAccountRequest)(nil),                  // 20: accounting.v1.CreateAccountRequest
+	(*CreateAccountsRequest)(nil),                 // 21: accounting.v1.CreateAccountsRequest
+	(*CreateAccountResponse)(nil),                 // 22: accounting.v1.CreateAccountResponse
+	(*CreateAccountsResponse)(nil),                // 23: accounting.v1.CreateAccountsResponse
+	(*GetAccountRequest)(nil),                     // 24: accounting.v1.GetAccountRequest
+	(*GetAccountResponse)(nil),                    // 25: accounting.v1.GetAccountResponse
+	(*GetAccountsByOwnerRequest)(nil),             // 26: accounting.v1.GetAccountsByOwnerRequest
+	(*GetAccountsByOwnerResponse)(nil),            // 27: accounting.v1.GetAccountsByOwnerResponse
+	(*GetOrCreateUserAccountsRequest)(nil),        // 28: accounting.v1.GetOrCreateUserAccountsRequest
+	(*GetOrCreateUserAccountsResponse)(nil),       // 29: accounting.v1.GetOrCreateUserAccountsResponse
+	(*UpdateAccountRequest)(nil),                  // 30: accounting.v1.UpdateAccountRequest
+	(*UpdateAccountResponse)(nil),                 // 31: accounting.v1.UpdateAccountResponse
+	(*CreateSubAccountRequest)(nil),               // 32: accounting.v1.CreateSubAccountRequest
+	(*CreateSubAccountResponse)(nil),              // 33: accounting.v1.CreateSubAccountResponse
+	(*ListSubAccountsRequest)(nil),                // 34: accounting.v1.ListSubAccountsRequest
+	(*SubAccount)(nil),                            // 35: accounting.v1.SubAccount
+	(*ListSubAccountsResponse)(nil),               // 36: accounting.v1.ListSubAccountsResponse
+	(*GetBalanceRequest)(nil),                     // 37: accounting.v1.GetBalanceRequest
+	(*GetBalanceResponse)(nil),                    // 38: accounting.v1.GetBalanceResponse
+	(*LedgerEntry)(nil),                           // 39: accounting.v1.LedgerEntry
+	(*ExecuteTransactionRequest)(nil),             // 40: accounting.v1.ExecuteTransactionRequest
+	(*ExecuteTransactionResponse)(nil),            // 41: accounting.v1.ExecuteTransactionResponse
+	(*ExecuteTransactionSyncRequest)(nil),         // 42: accounting.v1.ExecuteTransactionSyncRequest
+	(*ExecuteTransactionSyncResponse)(nil),        // 43: accounting.v1.ExecuteTransactionSyncResponse
+	(*GetTransactionStatusRequest)(nil),           // 44: accounting.v1.GetTransactionStatusRequest
+	(*GetTransactionStatusResponse)(nil),          // 45: accounting.v1.GetTransactionStatusResponse
+	(*GetTransactionByReceiptRequest)(nil),        // 46: accounting.v1.GetTransactionByReceiptRequest
+	(*GetTransactionByReceiptResponse)(nil),       // 47: accounting.v1.GetTransactionByReceiptResponse
+	(*Journal)(nil),                               // 48: accounting.v1.Journal
+	(*Ledger)(nil),                                // 49: accounting.v1.Ledger
+	(*GetJournalRequest)(nil),                     // 50: accounting.v1.GetJournalRequest
+	(*GetJournalResponse)(nil),                    // 51: accounting.v1.GetJournalResponse
+	(*ListJournalsRequest)(nil),                   // 52: accounting.v1.ListJournalsRequest
+	(*ListJournalsResponse)(nil),                  // 53: accounting.v1.ListJournalsResponse
+	(*ListLedgersByJournalRequest)(nil),           // 54: accounting.v1.ListLedgersByJournalRequest
+	(*ListLedgersByJournalResponse)(nil),          // 55: accounting.v1.ListLedgersByJournalResponse
+	(*ListLedgersByAccountRequest)(nil),           // 56: accounting.v1.ListLedgersByAccountRequest
+	(*ListLedgersByAccountResponse)(nil),          // 57: accounting.v1.ListLedgersByAccountResponse
+	(*AccountStatement)(nil),                      // 58: accounting.v1.AccountStatement
+	(*GetAccountStatementRequest)(nil),            // 59: accounting.v1.GetAccountStatementRequest
+	(*GetAccountStatementResponse)(nil),           // 60: accounting.v1.GetAccountStatementResponse
+	(*ExportAccountStatementRequest)(nil),         // 61: accounting.v1.ExportAccountStatementRequest
+	(*ExportAccountStatementChunk)(nil),           // 62: accounting.v1.ExportAccountStatementChunk
+	(*GetOwnerStatementRequest)(nil),              // 63: accounting.v1.GetOwnerStatementRequest
+	(*GetOwnerStatementResponse)(nil),             // 64: accounting.v1.GetOwnerStatementResponse
+	(*OwnerSummary)(nil),                          // 65: accounting.v1.OwnerSummary
+	(*AccountBalance)(nil),                        // 66: accounting.v1.AccountBalance
+	(*GetOwnerSummaryRequest)(nil),                // 67: accounting.v1.GetOwnerSummaryRequest
+	(*GetOwnerSummaryResponse)(nil),               // 68: accounting.v1.GetOwnerSummaryResponse
+	(*DailyReport)(nil),                           // 69: accounting.v1.DailyReport
+	(*GenerateDailyReportRequest)(nil),            // 70: accounting.v1.GenerateDailyReportRequest
+	(*GenerateDailyReportResponse)(nil),           // 71: accounting.v1.GenerateDailyReportResponse
+	(*TransactionSummary)(nil),                    // 72: accounting.v1.TransactionSummary
+	(*GetTransactionSummaryRequest)(nil),          // 73: accounting.v1.GetTransactionSummaryRequest
+	(*GetTransactionSummaryResponse)(nil),         // 74: accounting.v1.GetTransactionSummaryResponse
+	(*GetSystemHoldingsRequest)(nil),              // 75: accounting.v1.GetSystemHoldingsRequest
+	(*GetSystemHoldingsResponse)(nil),             // 76: accounting.v1.GetSystemHoldingsResponse
+	(*TransactionFee)(nil),                        // 77: accounting.v1.TransactionFee
+	(*CalculateFeeRequest)(nil),                   // 78: accounting.v1.CalculateFeeRequest
+	(*FeeCalculation)(nil),                        // 79: accounting.v1.FeeCalculation
+	(*CalculateFeeResponse)(nil),                  // 80: accounting.v1.CalculateFeeResponse
+	(*GetFeesByReceiptRequest)(nil),               // 81: accounting.v1.GetFeesByReceiptRequest
+	(*GetFeesByReceiptResponse)(nil),              // 82: accounting.v1.GetFeesByReceiptResponse
+	(*GetAgentCommissionSummaryRequest)(nil),      // 83: accounting.v1.GetAgentCommissionSummaryRequest
+	(*GetAgentCommissionSummaryResponse)(nil),     // 84: accounting.v1.GetAgentCommissionSummaryResponse
+	(*HealthCheckRequest)(nil),                    // 85: accounting.v1.HealthCheckRequest
+	(*HealthCheckResponse)(nil),                   // 86: accounting.v1.HealthCheckResponse
+	(*BatchExecuteTransactionsRequest)(nil),       // 87: accounting.v1.BatchExecuteTransactionsRequest
+	(*BatchExecuteTransactionsResponse)(nil),      // 88: accounting.v1.BatchExecuteTransactionsResponse
+	(*BatchGetBalancesRequest)(nil),               // 89: accounting.v1.BatchGetBalancesRequest
+	(*BatchGetBalancesResponse)(nil),              // 90: accounting.v1.BatchGetBalancesResponse
+	(*StreamTransactionEventsRequest)(nil),        // 91: accounting.v1.StreamTransactionEventsRequest
+	(*TransactionEvent)(nil),                      // 92: accounting.v1.TransactionEvent
+	(*CreditRequest)(nil),                         // 93: accounting.v1.CreditRequest
+	(*CreditResponse)(nil),                        // 94: accounting.v1.CreditResponse
+	(*DebitRequest)(nil),                          // 95: accounting.v1.DebitRequest
+	(*DebitResponse)(nil),                         // 96: accounting.v1.DebitResponse
+	(*TransferRequest)(nil),                       // 97: accounting.v1.TransferRequest
+	(*TransferResponse)(nil),                      // 98: accounting.v1.TransferResponse
+	(*ConversionRequest)(nil),                     // 99: accounting.v1.ConversionRequest
+	(*ConversionResponse)(nil),                    // 100: accounting.v1.ConversionResponse
+	(*CreateFXQuoteRequest)(nil),                  // 101: accounting.v1.CreateFXQuoteRequest
+	(*FXQuote)(nil),                               // 102: accounting.v1.FXQuote
+	(*CreateFXQuoteResponse)(nil),                 // 103: accounting.v1.CreateFXQuoteResponse
+	(*TradeRequest)(nil),                          // 104: accounting.v1.TradeRequest
+	(*TradeResponse)(nil),                         // 105: accounting.v1.TradeResponse
+	(*SettleTradeItem)(nil),                       // 106: accounting.v1.SettleTradeItem
+	(*SettleTradesRequest)(nil),                   // 107: accounting.v1.SettleTradesRequest
+	(*TradeSettlementResult)(nil),                 // 108: accounting.v1.TradeSettlementResult
+	(*SettleTradesResponse)(nil),                  // 109: accounting.v1.SettleTradesResponse
+	(*AgentCommissionRequest)(nil),                // 110: accounting.v1.AgentCommissionRequest
+	(*AgentCommissionResponse)(nil),               // 111: accounting.v1.AgentCommissionResponse
+	(*ReverseTransactionRequest)(nil),             // 112: accounting.v1.ReverseTransactionRequest
+	(*RefundTransactionRequest)(nil),              // 113: accounting.v1.RefundTransactionRequest
+	(*ReversalResponse)(nil),                      // 114: accounting.v1.ReversalResponse
+	(*Hold)(nil),                                  // 115: accounting.v1.Hold
+	(*PlaceHoldRequest)(nil),                      // 116: accounting.v1.PlaceHoldRequest
+	(*PlaceHoldResponse)(nil),                     // 117: accounting.v1.PlaceHoldResponse
+	(*CaptureHoldRequest)(nil),                    // 118: accounting.v1.CaptureHoldRequest
+	(*CaptureHoldResponse)(nil),                   // 119: accounting.v1.CaptureHoldResponse
+	(*ReleaseHoldRequest)(nil),                    // 120: accounting.v1.ReleaseHoldRequest
+	(*ReleaseHoldResponse)(nil),                   // 121: accounting.v1.ReleaseHoldResponse
+	(*ExtendHoldRequest)(nil),                     // 122: accounting.v1.ExtendHoldRequest
+	(*ExtendHoldResponse)(nil),                    // 123: accounting.v1.ExtendHoldResponse
+	(*ListHoldsRequest)(nil),                      // 124: accounting.v1.ListHoldsRequest
+	(*ListHoldsResponse)(nil),                     // 125: accounting.v1.ListHoldsResponse
+	(*ReconciliationRun)(nil),                     // 126: accounting.v1.ReconciliationRun
+	(*ReconciliationBreak)(nil),                   // 127: accounting.v1.ReconciliationBreak
+	(*RunReconciliationRequest)(nil),              // 128: accounting.v1.RunReconciliationRequest
+	(*RunReconciliationResponse)(nil),             // 129: accounting.v1.RunReconciliationResponse
+	(*ListReconciliationBreaksRequest)(nil),       // 130: accounting.v1.ListReconciliationBreaksRequest
+	(*ListReconciliationBreaksResponse)(nil),      // 131: accounting.v1.ListReconciliationBreaksResponse
+	(*QueuedTransaction)(nil),                     // 132: accounting.v1.QueuedTransaction
+	(*ListQueuedTransactionsRequest)(nil),         // 133: accounting.v1.ListQueuedTransactionsRequest
+	(*ListQueuedTransactionsResponse)(nil),        // 134: accounting.v1.ListQueuedTransactionsResponse
+	(*RedriveDeadLetterTransactionsRequest)(nil),  // 135: accounting.v1.RedriveDeadLetterTransactionsRequest
+	(*RedriveDeadLetterTransactionsResponse)(nil), // 136: accounting.v1.RedriveDeadLetterTransactionsResponse
+	(*SkipDeadLetterTransactionsRequest)(nil),     // 137: accounting.v1.SkipDeadLetterTransactionsRequest
+	(*SkipDeadLetterTransactionsResponse)(nil),    // 138: accounting.v1.SkipDeadLetterTransactionsResponse
+	(*AccountingPeriod)(nil),                      // 139: accounting.v1.AccountingPeriod
+	(*CloseAccountingPeriodRequest)(nil),          // 140: accounting.v1.CloseAccountingPeriodRequest
+	(*CloseAccountingPeriodResponse)(nil),         // 141: accounting.v1.CloseAccountingPeriodResponse
+	(*TrialBalanceLine)(nil),                      // 142: accounting.v1.TrialBalanceLine
+	(*TrialBalanceTotal)(nil),                     // 143: accounting.v1.TrialBalanceTotal
+	(*GetTrialBalanceRequest)(nil),                // 144: accounting.v1.GetTrialBalanceRequest
+	(*GetTrialBalanceResponse)(nil),               // 145: accounting.v1.GetTrialBalanceResponse
+	(*GeneralLedgerEntry)(nil),                    // 146: accounting.v1.GeneralLedgerEntry
+	(*GetGeneralLedgerRequest)(nil),               // 147: accounting.v1.GetGeneralLedgerRequest
+	(*GetGeneralLedgerResponse)(nil),              // 148: accounting.v1.GetGeneralLedgerResponse
+	(*TransactionApproval)(nil),                   // 149: accounting.v1.TransactionApproval
+	(*ApprovalDecision)(nil),                      // 150: accounting.v1.ApprovalDecision
+	(*CreateTransactionApprovalRequest)(nil),      // 151: accounting.v1.CreateTransactionApprovalRequest
+	(*CreateTransactionApprovalResponse)(nil),     // 152: accounting.v1.CreateTransactionApprovalResponse
+	(*GetPendingApprovalsRequest)(nil),            // 153: accounting.v1.GetPendingApprovalsRequest
+	(*GetPendingApprovalsResponse)(nil),           // 154: accounting.v1.GetPendingApprovalsResponse
+	(*ApproveTransactionRequest)(nil),             // 155: accounting.v1.ApproveTransactionRequest
+	(*ApproveTransactionResponse)(nil),            // 156: accounting.v1.ApproveTransactionResponse
+	(*GetApprovalHistoryRequest)(nil),             // 157: accounting.v1.GetApprovalHistoryRequest
+	(*GetApprovalHistoryResponse)(nil),            // 158: accounting.v1.GetApprovalHistoryResponse
+	(*ApprovalPolicy)(nil),                        // 159: accounting.v1.ApprovalPolicy
+	(*CreateApprovalPolicyRequest)(nil),           // 160: accounting.v1.CreateApprovalPolicyRequest
+	(*CreateApprovalPolicyResponse)(nil),          // 161: accounting.v1.CreateApprovalPolicyResponse
+	(*UpdateApprovalPolicyRequest)(nil),           // 162: accounting.v1.UpdateApprovalPolicyRequest
+	(*UpdateApprovalPolicyResponse)(nil),          // 163: accounting.v1.UpdateApprovalPolicyResponse
+	(*ListApprovalPoliciesRequest)(nil),           // 164: accounting.v1.ListApprovalPoliciesRequest
+	(*ListApprovalPoliciesResponse)(nil),          // 165: accounting.v1.ListApprovalPoliciesResponse
+	(*Agent)(nil),                                 // 166: accounting.v1.Agent
+	(*AgentCommission)(nil),                       // 167: accounting.v1.AgentCommission
+	(*CreateAgentRequest)(nil),                    // 168: accounting.v1.CreateAgentRequest
+	(*CreateAgentResponse)(nil),                   // 169: accounting.v1.CreateAgentResponse
+	(*UpdateAgentRequest)(nil),                    // 170: accounting.v1.UpdateAgentRequest
+	(*UpdateAgentResponse)(nil),                   // 171: accounting.v1.UpdateAgentResponse
+	(*DeleteAgentRequest)(nil),                    // 172: accounting.v1.DeleteAgentRequest
+	(*DeleteAgentResponse)(nil),                   // 173: accounting.v1.DeleteAgentResponse
+	(*GetAgentByIDRequest)(nil),                   // 174: accounting.v1.GetAgentByIDRequest
+	(*GetAgentByIDResponse)(nil),                  // 175: accounting.v1.GetAgentByIDResponse
+	(*GetAgentByUserIDRequest)(nil),               // 176: accounting.v1.GetAgentByUserIDRequest
+	(*GetAgentByUserIDResponse)(nil),              // 177: accounting.v1.GetAgentByUserIDResponse
+	(*ListAgentsRequest)(nil),                     // 178: accounting.v1.ListAgentsRequest
+	(*ListAgentsResponse)(nil),                    // 179: accounting.v1.ListAgentsResponse
+	(*ListCommissionsForAgentRequest)(nil),        // 180: accounting.v1.ListCommissionsForAgentRequest
+	(*ListCommissionsForAgentResponse)(nil),       // 181: accounting.v1.ListCommissionsForAgentResponse
+	(*GetAgentsByCountriesRequest)(nil),           // 182: accounting.v1.GetAgentsByCountriesRequest
+	(*GetAgentsByCountriesResponse)(nil),          // 183: accounting.v1.GetAgentsByCountriesResponse
+	(*GetAgentStatsRequest)(nil),                  // 184: accounting.v1.GetAgentStatsRequest
+	(*GetAgentStatsResponse)(nil),                 // 185: accounting.v1.GetAgentStatsResponse
+	(*CommissionPayout)(nil),                      // 186: accounting.v1.CommissionPayout
+	(*CreateCommissionPayoutBatchRequest)(nil),    // 187: accounting.v1.CreateCommissionPayoutBatchRequest
+	(*CreateCommissionPayoutBatchResponse)(nil),   // 188: accounting.v1.CreateCommissionPayoutBatchResponse
+	(*ListCommissionPayoutsRequest)(nil),          // 189: accounting.v1.ListCommissionPayoutsRequest
+	(*ListCommissionPayoutsResponse)(nil),         // 190: accounting.v1.ListCommissionPayoutsResponse
+	(*LimitProfile)(nil),                          // 191: accounting.v1.LimitProfile
+	(*CreateLimitProfileRequest)(nil),             // 192: accounting.v1.CreateLimitProfileRequest
+	(*CreateLimitProfileResponse)(nil),            // 193: accounting.v1.CreateLimitProfileResponse
+	(*UpdateLimitProfileRequest)(nil),             // 194: accounting.v1.UpdateLimitProfileRequest
+	(*UpdateLimitProfileResponse)(nil),            // 195: accounting.v1.UpdateLimitProfileResponse
+	(*ListLimitProfilesRequest)(nil),              // 196: accounting.v1.ListLimitProfilesRequest
+	(*ListLimitProfilesResponse)(nil),             // 197: accounting.v1.ListLimitProfilesResponse
+	(*SetOwnerKYCTierRequest)(nil),                // 198: accounting.v1.SetOwnerKYCTierRequest
+	(*SetOwnerKYCTierResponse)(nil),               // 199: accounting.v1.SetOwnerKYCTierResponse
+	(*LimitUsage)(nil),                            // 200: accounting.v1.LimitUsage
+	(*GetAccountLimitsRequest)(nil),               // 201: accounting.v1.GetAccountLimitsRequest
+	(*GetAccountLimitsResponse)(nil),              // 202: accounting.v1.GetAccountLimitsResponse
+	(*ScheduledTransfer)(nil),                     // 203: accounting.v1.ScheduledTransfer
+	(*ScheduledTransferRun)(nil),                  // 204: accounting.v1.ScheduledTransferRun
+	(*CreateScheduledTransferRequest)(nil),        // 205: accounting.v1.CreateScheduledTransferRequest
+	(*CreateScheduledTransferResponse)(nil),       // 206: accounting.v1.CreateScheduledTransferResponse
+	(*GetScheduledTransferRequest)(nil),           // 207: accounting.v1.GetScheduledTransferRequest
+	(*GetScheduledTransferResponse)(nil),          // 208: accounting.v1.GetScheduledTransferResponse
+	(*ListScheduledTransfersRequest)(nil),         // 209: accounting.v1.ListScheduledTransfersRequest
+	(*ListScheduledTransfersResponse)(nil),        // 210: accounting.v1.ListScheduledTransfersResponse
+	(*CancelScheduledTransferRequest)(nil),        // 211: accounting.v1.CancelScheduledTransferRequest
+	(*CancelScheduledTransferResponse)(nil),       // 212: accounting.v1.CancelScheduledTransferResponse
+	(*FeeRule)(nil),                               // 213: accounting.v1.FeeRule
+	(*FeeRuleVersion)(nil),                        // 214: accounting.v1.FeeRuleVersion
+	(*ListFeeRuleVersionsRequest)(nil),            // 215: accounting.v1.ListFeeRuleVersionsRequest
+	(*ListFeeRuleVersionsResponse)(nil),           // 216: accounting.v1.ListFeeRuleVersionsResponse
+	(*SimulateFeeRulesRequest)(nil),               // 217: accounting.v1.SimulateFeeRulesRequest
+	(*FeeSimulationGroup)(nil),                    // 218: accounting.v1.FeeSimulationGroup
+	(*SimulateFeeRulesResponse)(nil),              // 219: accounting.v1.SimulateFeeRulesResponse
+	(*InterestRatePlan)(nil),                      // 220: accounting.v1.InterestRatePlan
+	(*InterestPosting)(nil),                       // 221: accounting.v1.InterestPosting
+	(*InterestSummary)(nil),                       // 222: accounting.v1.InterestSummary
+	(*CreateInterestRatePlanRequest)(nil),         // 223: accounting.v1.CreateInterestRatePlanRequest
+	(*CreateInterestRatePlanResponse)(nil),        // 224: accounting.v1.CreateInterestRatePlanResponse
+	(*UpdateInterestRatePlanRequest)(nil),         // 225: accounting.v1.UpdateInterestRatePlanRequest
+	(*UpdateInterestRatePlanResponse)(nil),        // 226: accounting.v1.UpdateInterestRatePlanResponse
+	(*ListInterestRatePlansRequest)(nil),          // 227: accounting.v1.ListInterestRatePlansRequest
+	(*ListInterestRatePlansResponse)(nil),         // 228: accounting.v1.ListInterestRatePlansResponse
+	(*GetAccountInterestRequest)(nil),             // 229: accounting.v1.GetAccountInterestRequest
+	(*GetAccountInterestResponse)(nil),            // 230: accounting.v1.GetAccountInterestResponse
+	(*ListInterestPostingsRequest)(nil),           // 231: accounting.v1.ListInterestPostingsRequest
+	(*ListInterestPostingsResponse)(nil),          // 232: accounting.v1.ListInterestPostingsResponse
+	(*RunInterestRequest)(nil),                    // 233: accounting.v1.RunInterestRequest
+	(*RunInterestResponse)(nil),                   // 234: accounting.v1.RunInterestResponse
+	(*AccountRestriction)(nil),                    // 235: accounting.v1.AccountRestriction
+	(*PlaceAccountRestrictionRequest)(nil),        // 236: accounting.v1.PlaceAccountRestrictionRequest
+	(*PlaceAccountRestrictionResponse)(nil),       // 237: accounting.v1.PlaceAccountRestrictionResponse
+	(*ReleaseAccountRestrictionRequest)(nil),      // 238: accounting.v1.ReleaseAccountRestrictionRequest
+	(*ReleaseAccountRestrictionResponse)(nil),     // 239: accounting.v1.ReleaseAccountRestrictionResponse
+	(*CloseAccountRequest)(nil),                   // 240: accounting.v1.CloseAccountRequest
+	(*CloseAccountResponse)(nil),                  // 241: accounting.v1.CloseAccountResponse
+	(*ListAccountRestrictionsRequest)(nil),        // 242: accounting.v1.ListAccountRestrictionsRequest
+	(*ListAccountRestrictionsResponse)(nil),       // 243: accounting.v1.ListAccountRestrictionsResponse
+	(*DemoRefill)(nil),                            // 244: accounting.v1.DemoRefill
+	(*TopUpDemoAccountRequest)(nil),               // 245: accounting.v1.TopUpDemoAccountRequest
+	(*TopUpDemoAccountResponse)(nil),              // 246: accounting.v1.TopUpDemoAccountResponse
+	(*ResetDemoAccountRequest)(nil),               // 247: accounting.v1.ResetDemoAccountRequest
+	(*ResetDemoAccountResponse)(nil),              // 248: accounting.v1.ResetDemoAccountResponse
+	nil,                                           // 249: accounting.v1.CreateAccountsResponse.ErrorsEntry
+	nil,                                           // 250: accounting.v1.GetSystemHoldingsResponse.HoldingsEntry
+	nil,                                           // 251: accounting.v1.GetAgentCommissionSummaryResponse.CommissionsEntry
+	nil,                                           // 252: accounting.v1.HealthCheckResponse.ComponentsEntry
+	nil,                                           // 253: accounting.v1.BatchExecuteTransactionsResponse.ErrorsEntry
+	nil,                                           // 254: accounting.v1.BatchGetBalancesResponse.ErrorsEntry
+	nil,                                           // 255: accounting.v1.ReconciliationBreak.DetailsEntry
+	nil,                                           // 256: accounting.v1.Agent.MetadataEntry
+	nil,                                           // 257: accounting.v1.Agent.LocationEntry
+	nil,                                           // 258: accounting.v1.CreateAgentRequest.MetadataEntry
+	nil,                                           // 259: accounting.v1.CreateAgentRequest.LocationEntry
+	nil,                                           // 260: accounting.v1.UpdateAgentRequest.MetadataEntry
+	nil,                                           // 261: accounting.v1.UpdateAgentRequest.LocationEntry
+	nil,                                           // 262: accounting.v1.GetAgentStatsResponse.AgentsByCountryEntry
+	nil,                                           // 263: accounting.v1.GetAgentStatsResponse.AgentsByPaymentMethodEntry
+	(*timestamppb.Timestamp)(nil),                 // 264: google.protobuf.Timestamp
 }
 var file_proto_shared_accounting_account_proto_depIdxs = []int32{
 	0,   // 0: accounting.v1.Account.owner_type:type_name -> accounting.v1.OwnerType
 	2,   // 1: accounting.v1.Account.purpose:type_name -> accounting.v1.AccountPurpose
 	1,   // 2: accounting.v1.Account.account_type:type_name -> accounting.v1.AccountType
-	133, // 3: accounting.v1.Account.created_at:type_name -> google.protobuf.Timestamp
-	133, // 4: accounting.v1.Account.updated_at:type_name -> google.protobuf.Timestamp
-	133, // 5: accounting.v1.Balance.last_transaction_at:type_name -> google.protobuf.Timestamp
+	264, // 3: accounting.v1.Account.created_at:type_name -> google.protobuf.Timestamp
+	264, // 4: accounting.v1.Account.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 5: accounting.v1.Balance.last_transaction_at:type_name -> google.protobuf.Timestamp
 	0,   // 6: accounting.v1.CreateAccountRequest.owner_type:type_name -> accounting.v1.OwnerType
 	2,   // 7: accounting.v1.CreateAccountRequest.purpose:type_name -> accounting.v1.AccountPurpose
 	1,   // 8: accounting.v1.CreateAccountRequest.account_type:type_name -> accounting.v1.AccountType
-	12,  // 9: accounting.v1.CreateAccountsRequest.accounts:type_name -> accounting.v1.CreateAccountRequest
-	10,  // 10: accounting.v1.CreateAccountResponse.account:type_name -> accounting.v1.Account
-	10,  // 11: accounting.v1.CreateAccountsResponse.accounts:type_name -> accounting.v1.Account
-	119, // 12: accounting.v1.CreateAccountsResponse.errors:type_name -> accounting.v1.CreateAccountsResponse.ErrorsEntry
-	10,  // 13: accounting.v1.GetAccountResponse.account:type_name -> accounting.v1.Account
+	20,  // 9: accounting.v1.CreateAccountsRequest.accounts:type_name -> accounting.v1.CreateAccountRequest
+	18,  // 10: accounting.v1.CreateAccountResponse.account:type_name -> accounting.v1.Account
+	18,  // 11: accounting.v1.CreateAccountsResponse.accounts:type_name -> accounting.v1.Account
+	249, // 12: accounting.v1.CreateAccountsResponse.errors:type_name -> accounting.v1.CreateAccountsResponse.ErrorsEntry
+	18,  // 13: accounting.v1.GetAccountResponse.account:type_name -> accounting.v1.Account
 	0,   // 14: accounting.v1.GetAccountsByOwnerRequest.owner_type:type_name -> accounting.v1.OwnerType
 	1,   // 15: accounting.v1.GetAccountsByOwnerRequest.account_type:type_name -> accounting.v1.AccountType
-	10,  // 16: accounting.v1.GetAccountsByOwnerResponse.accounts:type_name -> accounting.v1.Account
+	18,  // 16: accounting.v1.GetAccountsByOwnerResponse.accounts:type_name -> accounting.v1.Account
 	1,   // 17: accounting.v1.GetOrCreateUserAccountsRequest.account_type:type_name -> accounting.v1.AccountType
 	0,   // 18: accounting.v1.GetOrCreateUserAccountsRequest.owner_type:type_name -> accounting.v1.OwnerType
-	10,  // 19: accounting.v1.GetOrCreateUserAccountsResponse.accounts:type_name -> accounting.v1.Account
-	10,  // 20: accounting.v1.UpdateAccountResponse.account:type_name -> accounting.v1.Account
-	11,  // 21: accounting.v1.GetBalanceResponse.balance:type_name -> accounting.v1.Balance
-	3,   // 22: accounting.v1.LedgerEntry.dr_cr:type_name -> accounting.v1.DrCr
-	4,   // 23: accounting.v1.ExecuteTransactionRequest.transaction_type:type_name -> accounting.v1.TransactionType
-	1,   // 24: accounting.v1.ExecuteTransactionRequest.account_type:type_name -> accounting.v1.AccountType
-	26,  // 25: accounting.v1.ExecuteTransactionRequest.entries:type_name -> accounting.v1.LedgerEntry
-	0,   // 26: accounting.v1.ExecuteTransactionRequest.created_by_type:type_name -> accounting.v1.OwnerType
-	5,   // 27: accounting.v1.ExecuteTransactionResponse.status:type_name -> accounting.v1.TransactionStatus
-	133, // 28: accounting.v1.ExecuteTransactionResponse.created_at:type_name -> google.protobuf.Timestamp
-	4,   // 29: accounting.v1.ExecuteTransactionSyncRequest.transaction_type:type_name -> accounting.v1.TransactionType
-	1,   // 30: accounting.v1.ExecuteTransactionSyncRequest.account_type:type_name -> accounting.v1.AccountType
-	26,  // 31: accounting.v1.ExecuteTransactionSyncRequest.entries:type_name -> accounting.v1.LedgerEntry
-	0,   // 32: accounting.v1.ExecuteTransactionSyncRequest.created_by_type:type_name -> accounting.v1.OwnerType
-	5,   // 33: accounting.v1.ExecuteTransactionSyncResponse.status:type_name -> accounting.v1.TransactionStatus
-	133, // 34: accounting.v1.ExecuteTransactionSyncResponse.created_at:type_name -> google.protobuf.Timestamp
-	5,   // 35: accounting.v1.GetTransactionStatusResponse.status:type_name -> accounting.v1.TransactionStatus
-	133, // 36: accounting.v1.GetTransactionStatusResponse.started_at:type_name -> google.protobuf.Timestamp
-	133, // 37: accounting.v1.GetTransactionStatusResponse.completed_at:type_name -> google.protobuf.Timestamp
-	35,  // 38: accounting.v1.GetTransactionByReceiptResponse.journal:type_name -> accounting.v1.Journal
-	36,  // 39: accounting.v1.GetTransactionByReceiptResponse.ledgers:type_name -> accounting.v1.Ledger
-	62,  // 40: accounting.v1.GetTransactionByReceiptResponse.fees:type_name -> accounting.v1.TransactionFee
-	4,   // 41: accounting.v1.Journal.transaction_type:type_name -> accounting.v1.TransactionType
-	1,   // 42: accounting.v1.Journal.account_type:type_name -> accounting.v1.AccountType
-	0,   // 43: accounting.v1.Journal.created_by_type:type_name -> accounting.v1.OwnerType
-	133, // 44: accounting.v1.Journal.created_at:type_name -> google.protobuf.Timestamp
-	3,   // 45: accounting.v1.Ledger.dr_cr:type_name -> accounting.v1.DrCr
-	133, // 46: accounting.v1.Ledger.created_at:type_name -> google.protobuf.Timestamp
-	35,  // 47: accounting.v1.GetJournalResponse.journal:type_name -> accounting.v1.Journal
-	4,   // 48: accounting.v1.ListJournalsRequest.transaction_type:type_name -> accounting.v1.TransactionType
-	1,   // 49: accounting.v1.ListJournalsRequest.account_type:type_name -> accounting.v1.AccountType
-	133, // 50: accounting.v1.ListJournalsRequest.from:type_name -> google.protobuf.Timestamp
-	133, // 51: accounting.v1.ListJournalsRequest.to:type_name -> google.protobuf.Timestamp
-	35,  // 52: accounting.v1.ListJournalsResponse.journals:type_name -> accounting.v1.Journal
-	36,  // 53: accounting.v1.ListLedgersByJournalResponse.ledgers:type_name -> accounting.v1.Ledger
-	1,   // 54: accounting.v1.ListLedgersByAccountRequest.account_type:type_name -> accounting.v1.AccountType
-	133, // 55: accounting.v1.ListLedgersByAccountRequest.from:type_name -> google.protobuf.Timestamp
-	133, // 56: accounting.v1.ListLedgersByAccountRequest.to:type_name -> google.protobuf.Timestamp
-	36,  // 57: accounting.v1.ListLedgersByAccountResponse.ledgers:type_name -> accounting.v1.Ledger
-	1,   // 58: accounting.v1.AccountStatement.account_type:type_name -> accounting.v1.AccountType
-	36,  // 59: accounting.v1.AccountStatement.ledgers:type_name -> accounting.v1.Ledger
-	133, // 60: accounting.v1.AccountStatement.period_start:type_name -> google.protobuf.Timestamp
-	133, // 61: accounting.v1.AccountStatement.period_end:type_name -> google.protobuf.Timestamp
-	1,   // 62: accounting.v1.GetAccountStatementRequest.account_type:type_name -> accounting.v1.AccountType
-	133, // 63: accounting.v1.GetAccountStatementRequest.from:type_name -> google.protobuf.Timestamp
-	133, // 64: accounting.v1.GetAccountStatementRequest.to:type_name -> google.protobuf.Timestamp
-	45,  // 65: accounting.v1.GetAccountStatementResponse.statement:type_name -> accounting.v1.AccountStatement
-	0,   // 66: accounting.v1.GetOwnerStatementRequest.owner_type:type_name -> accounting.v1.OwnerType
-	1,   // 67: accounting.v1.GetOwnerStatementRequest.account_type:type_name -> accounting.v1.AccountType
-	133, // 68: accounting.v1.GetOwnerStatementRequest.from:type_name -> google.protobuf.Timestamp
-	133, // 69: accounting.v1.GetOwnerStatementRequest.to:type_name -> google.protobuf.Timestamp
-	45,  // 70: accounting.v1.GetOwnerStatementResponse.statements:type_name -> accounting.v1.AccountStatement
-	0,   // 71: accounting.v1.OwnerSummary.owner_type:type_name -> accounting.v1.OwnerType
-	1,   // 72: accounting.v1.OwnerSummary.account_type:type_name -> accounting.v1.AccountType
-	51,  // 73: accounting.v1.OwnerSummary.account_balances:type_name -> accounting.v1.AccountBalance
-	0,   // 74: accounting.v1.GetOwnerSummaryRequest.owner_type:type_name -> accounting.v1.OwnerType
-	1,   // 75: accounting.v1.GetOwnerSummaryRequest.account_type:type_name -> accounting.v1.AccountType
-	50,  // 76: accounting.v1.GetOwnerSummaryResponse.summary:type_name -> accounting.v1.OwnerSummary
-	0,   // 77: accounting.v1.DailyReport.owner_type:type_name -> accounting.v1.OwnerType
-	133, // 78: accounting.v1.DailyReport.date:type_name -> google.protobuf.Timestamp
-	133, // 79: accounting.v1.GenerateDailyReportRequest.date:type_name -> google.protobuf.Timestamp
-	1,   // 80: accounting.v1.GenerateDailyReportRequest.account_type:type_name -> accounting.v1.AccountType
-	54,  // 81: accounting.v1.GenerateDailyReportResponse.reports:type_name -> accounting.v1.DailyReport
-	4,   // 82: accounting.v1.TransactionSummary.transaction_type:type_name -> accounting.v1.TransactionType
-	1,   // 83: accounting.v1.GetTransactionSummaryRequest.account_type:type_name -> accounting.v1.AccountType
-	133, // 84: accounting.v1.GetTransactionSummaryRequest.from:type_name -> google.protobuf.Timestamp
-	133, // 85: accounting.v1.GetTransactionSummaryRequest.to:type_name -> google.protobuf.Timestamp
-	57,  // 86: accounting.v1.GetTransactionSummaryResponse.summaries:type_name -> accounting.v1.TransactionSummary
-	1,   // 87: accounting.v1.GetSystemHoldingsRequest.account_type:type_name -> accounting.v1.AccountType
-	120, // 88: accounting.v1.GetSystemHoldingsResponse.holdings:type_name -> accounting.v1.GetSystemHoldingsResponse.HoldingsEntry
-	6,   // 89: accounting.v1.TransactionFee.fee_type:type_name -> accounting.v1.FeeType
-	133, // 90: accounting.v1.TransactionFee.created_at:type_name -> google.protobuf.Timestamp
-	4,   // 91: accounting.v1.CalculateFeeRequest.transaction_type:type_name -> accounting.v1.TransactionType
-	1,   // 92: accounting.v1.CalculateFeeRequest.account_type:type_name -> accounting.v1.AccountType
-	0,   // 93: accounting.v1.CalculateFeeRequest.owner_type:type_name -> accounting.v1.OwnerType
-	6,   // 94: accounting.v1.FeeCalculation.fee_type:type_name -> accounting.v1.FeeType
-	64,  // 95: accounting.v1.CalculateFeeResponse.calculation:type_name -> accounting.v1.FeeCalculation
-	62,  // 96: accounting.v1.GetFeesByReceiptResponse.fees:type_name -> accounting.v1.TransactionFee
-	133, // 97: accounting.v1.GetAgentCommissionSummaryRequest.from:type_name -> google.protobuf.Timestamp
-	133, // 98: accounting.v1.GetAgentCommissionSummaryRequest.to:type_name -> google.protobuf.Timestamp
-	121, // 99: accounting.v1.GetAgentCommissionSummaryResponse.commissions:type_name -> accounting.v1.GetAgentCommissionSummaryResponse.CommissionsEntry
-	122, // 100: accounting.v1.HealthCheckResponse.components:type_name -> accounting.v1.HealthCheckResponse.ComponentsEntry
-	27,  // 101: accounting.v1.BatchExecuteTransactionsRequest.transactions:type_name -> accounting.v1.ExecuteTransactionRequest
-	28,  // 102: accounting.v1.BatchExecuteTransactionsResponse.results:type_name -> accounting.v1.ExecuteTransactionResponse
-	123, // 103: accounting.v1.BatchExecuteTransactionsResponse.errors:type_name -> accounting.v1.BatchExecuteTransactionsResponse.ErrorsEntry
-	11,  // 104: accounting.v1.BatchGetBalancesResponse.balances:type_name -> accounting.v1.Balance
-	124, // 105: accounting.v1.BatchGetBalancesResponse.errors:type_name -> accounting.v1.BatchGetBalancesResponse.ErrorsEntry
-	0,   // 106: accounting.v1.StreamTransactionEventsRequest.owner_type:type_name -> accounting.v1.OwnerType
-	1,   // 107: accounting.v1.StreamTransactionEventsRequest.account_type:type_name -> accounting.v1.AccountType
-	5,   // 108: accounting.v1.TransactionEvent.status:type_name -> accounting.v1.TransactionStatus
-	133, // 109: accounting.v1.TransactionEvent.timestamp:type_name -> google.protobuf.Timestamp
-	1,   // 110: accounting.v1.CreditRequest.account_type:type_name -> accounting.v1.AccountType
-	0,   // 111: accounting.v1.CreditRequest.created_by_type:type_name -> accounting.v1.OwnerType
-	4,   // 112: accounting.v1.CreditRequest.transaction_type:type_name -> accounting.v1.TransactionType
-	133, // 113: accounting.v1.CreditResponse.created_at:type_name -> google.protobuf.Timestamp
-	1,   // 114: accounting.v1.DebitRequest.account_type:type_name -> accounting.v1.AccountType
-	0,   // 115: accounting.v1.DebitRequest.created_by_type:type_name -> accounting.v1.OwnerType
-	4,   // 116: accounting.v1.DebitRequest.transaction_type:type_name -> accounting.v1.TransactionType
-	133, // 117: accounting.v1.DebitResponse.created_at:type_name -> google.protobuf.Timestamp
-	1,   // 118: accounting.v1.TransferRequest.account_type:type_name -> accounting.v1.AccountType
-	0,   // 119: accounting.v1.TransferRequest.created_by_type:type_name -> accounting.v1.OwnerType
-	4,   // 120: accounting.v1.TransferRequest.transaction_type:type_name -> accounting.v1.TransactionType
-	133, // 121: accounting.v1.TransferResponse.created_at:type_name -> google.protobuf.Timestamp
-	1,   // 122: accounting.v1.ConversionRequest.account_type:type_name -> accounting.v1.AccountType
-	0,   // 123: accounting.v1.ConversionRequest.created_by_type:type_name -> accounting.v1.OwnerType
-	133, // 124: accounting.v1.ConversionResponse.created_at:type_name -> google.protobuf.Timestamp
-	1,   // 125: accounting.v1.TradeRequest.account_type:type_name -> accounting.v1.AccountType
-	0,   // 126: accounting.v1.TradeRequest.created_by_type:type_name -> accounting.v1.OwnerType
-	133, // 127: accounting.v1.TradeResponse.created_at:type_name -> google.protobuf.Timestamp
-	133, // 128: accounting.v1.AgentCommissionResponse.created_at:type_name -> google.protobuf.Timestamp
-	4,   // 129: accounting.v1.TransactionApproval.transaction_type:type_name -> accounting.v1.TransactionType
-	7,   // 130: accounting.v1.TransactionApproval.status:type_name -> accounting.v1.ApprovalStatus
-	133, // 131: accounting.v1.TransactionApproval.created_at:type_name -> google.protobuf.Timestamp
-	133, // 132: accounting.v1.TransactionApproval.updated_at:type_name -> google.protobuf.Timestamp
-	4,   // 133: accounting.v1.CreateTransactionApprovalRequest.transaction_type:type_name -> accounting.v1.TransactionType
-	90,  // 134: accounting.v1.CreateTransactionApprovalResponse.approval:type_name -> accounting.v1.TransactionApproval
-	90,  // 135: accounting.v1.GetPendingApprovalsResponse.approvals:type_name -> accounting.v1.TransactionApproval
-	90,  // 136: accounting.v1.ApproveTransactionResponse.approval:type_name -> accounting.v1.TransactionApproval
-	7,   // 137: accounting.v1.GetApprovalHistoryRequest.status:type_name -> accounting.v1.ApprovalStatus
-	133, // 138: accounting.v1.GetApprovalHistoryRequest.from:type_name -> google.protobuf.Timestamp
-	133, // 139: accounting.v1.GetApprovalHistoryRequest.to:type_name -> google.protobuf.Timestamp
-	90,  // 140: accounting.v1.GetApprovalHistoryResponse.approvals:type_name -> accounting.v1.TransactionApproval
-	8,   // 141: accounting.v1.Agent.relationship_type:type_name -> accounting.v1.RelationshipType
-	125, // 142: accounting.v1.Agent.metadata:type_name -> accounting.v1.Agent.MetadataEntry
-	133, // 143: accounting.v1.Agent.created_at:type_name -> google.protobuf.Timestamp
-	133, // 144: accounting.v1.Agent.updated_at:type_name -> google.protobuf.Timestamp
-	10,  // 145: accounting.v1.Agent.accounts:type_name -> accounting.v1.Account
-	126, // 146: accounting.v1.Agent.location:type_name -> accounting.v1.Agent.LocationEntry
-	9,   // 147: accounting.v1.Agent.status:type_name -> accounting.v1.AgentStatus
-	133, // 148: accounting.v1.AgentCommission.paid_out_at:type_name -> google.protobuf.Timestamp
-	133, // 149: accounting.v1.AgentCommission.created_at:type_name -> google.protobuf.Timestamp
-	8,   // 150: accounting.v1.CreateAgentRequest.relationship_type:type_name -> accounting.v1.RelationshipType
-	127, // 151: accounting.v1.CreateAgentRequest.metadata:type_name -> accounting.v1.CreateAgentRequest.MetadataEntry
-	128, // 152: accounting.v1.CreateAgentRequest.location:type_name -> accounting.v1.CreateAgentRequest.LocationEntry
-	9,   // 153: accounting.v1.CreateAgentRequest.status:type_name -> accounting.v1.AgentStatus
-	99,  // 154: accounting.v1.CreateAgentResponse.agent:type_name -> accounting.v1.Agent
-	8,   // 155: accounting.v1.UpdateAgentRequest.relationship_type:type_name -> accounting.v1.RelationshipType
-	129, // 156: accounting.v1.UpdateAgentRequest.metadata:type_name -> accounting.v1.UpdateAgentRequest.MetadataEntry
-	130, // 157: accounting.v1.UpdateAgentRequest.location:type_name -> accounting.v1.UpdateAgentRequest.LocationEntry
-	9,   // 158: accounting.v1.UpdateAgentRequest.status:type_name -> accounting.v1.AgentStatus
-	99,  // 159: accounting.v1.UpdateAgentResponse.agent:type_name -> accounting.v1.Agent
-	99,  // 160: accounting.v1.GetAgentByIDResponse.agent:type_name -> accounting.v1.Agent
-	99,  // 161: accounting.v1.GetAgentByUserIDResponse.agent:type_name -> accounting.v1.Agent
-	9,   // 162: accounting.v1.ListAgentsRequest.status:type_name -> accounting.v1.AgentStatus
-	8,   // 163: accounting.v1.ListAgentsRequest.relationship_type:type_name -> accounting.v1.RelationshipType
-	99,  // 164: accounting.v1.ListAgentsResponse.agents:type_name -> accounting.v1.Agent
-	100, // 165: accounting.v1.ListCommissionsForAgentResponse.commissions:type_name -> accounting.v1.AgentCommission
-	9,   // 166: accounting.v1.GetAgentsByCountriesRequest.status:type_name -> accounting.v1.AgentStatus
-	99,  // 167: accounting.v1.GetAgentsByCountriesResponse.agents:type_name -> accounting.v1.Agent
-	131, // 168: accounting.v1.GetAgentStatsResponse.agents_by_country:type_name -> accounting.v1.GetAgentStatsResponse.AgentsByCountryEntry
-	132, // 169: accounting.v1.GetAgentStatsResponse.agents_by_payment_method:type_name -> accounting.v1.GetAgentStatsResponse.AgentsByPaymentMethodEntry
-	12,  // 170: accounting.v1.AccountingService.CreateAccount:input_type -> accounting.v1.CreateAccountRequest
-	13,  // 171: accounting.v1.AccountingService.CreateAccounts:input_type -> accounting.v1.CreateAccountsRequest
-	16,  // 172: accounting.v1.AccountingService.GetAccount:input_type -> accounting.v1.GetAccountRequest
-	18,  // 173: accounting.v1.AccountingService.GetAccountsByOwner:input_type -> accounting.v1.GetAccountsByOwnerRequest
-	20,  // 174: accounting.v1.AccountingService.GetOrCreateUserAccounts:input_type -> accounting.v1.GetOrCreateUserAccountsRequest
-	22,  // 175: accounting.v1.AccountingService.UpdateAccount:input_type -> accounting.v1.UpdateAccountRequest
-	24,  // 176: accounting.v1.AccountingService.GetBalance:input_type -> accounting.v1.GetBalanceRequest
-	74,  // 177: accounting.v1.AccountingService.BatchGetBalances:input_type -> accounting.v1.BatchGetBalancesRequest
-	27,  // 178: accounting.v1.AccountingService.ExecuteTransaction:input_type -> accounting.v1.ExecuteTransactionRequest
-	29,  // 179: accounting.v1.AccountingService.ExecuteTransactionSync:input_type -> accounting.v1.ExecuteTransactionSyncRequest
-	72,  // 180: accounting.v1.AccountingService.BatchExecuteTransactions:input_type -> accounting.v1.BatchExecuteTransactionsRequest
-	31,  // 181: accounting.v1.AccountingService.GetTransactionStatus:input_type -> accounting.v1.GetTransactionStatusRequest
-	33,  // 182: accounting.v1.AccountingService.GetTransactionByReceipt:input_type -> accounting.v1.GetTransactionByReceiptRequest
-	37,  // 183: accounting.v1.AccountingService.GetJournal:input_type -> accounting.v1.GetJournalRequest
-	39,  // 184: accounting.v1.AccountingService.ListJournals:input_type -> accounting.v1.ListJournalsRequest
-	41,  // 185: accounting.v1.AccountingService.ListLedgersByJournal:input_type -> accounting.v1.ListLedgersByJournalRequest
-	43,  // 186: accounting.v1.AccountingService.ListLedgersByAccount:input_type -> accounting.v1.ListLedgersByAccountRequest
-	46,  // 187: accounting.v1.AccountingService.GetAccountStatement:input_type -> accounting.v1.GetAccountStatementRequest
-	48,  // 188: accounting.v1.AccountingService.GetOwnerStatement:input_type -> accounting.v1.GetOwnerStatementRequest
-	52,  // 189: accounting.v1.AccountingService.GetOwnerSummary:input_type -> accounting.v1.GetOwnerSummaryRequest
-	55,  // 190: accounting.v1.AccountingService.GenerateDailyReport:input_type -> accounting.v1.GenerateDailyReportRequest
-	58,  // 191: accounting.v1.AccountingService.GetTransactionSummary:input_type -> accounting.v1.GetTransactionSummaryRequest
-	60,  // 192: accounting.v1.AccountingService.GetSystemHoldings:input_type -> accounting.v1.GetSystemHoldingsRequest
-	63,  // 193: accounting.v1.AccountingService.CalculateFee:input_type -> accounting.v1.CalculateFeeRequest
-	66,  // 194: accounting.v1.AccountingService.GetFeesByReceipt:input_type -> accounting.v1.GetFeesByReceiptRequest
-	68,  // 195: accounting.v1.AccountingService.GetAgentCommissionSummary:input_type -> accounting.v1.GetAgentCommissionSummaryRequest
-	76,  // 196: accounting.v1.AccountingService.StreamTransactionEvents:input_type -> accounting.v1.StreamTransactionEventsRequest
-	78,  // 197: accounting.v1.AccountingService.Credit:input_type -> accounting.v1.CreditRequest
-	80,  // 198: accounting.v1.AccountingService.Debit:input_type -> accounting.v1.DebitRequest
-	82,  // 199: accounting.v1.AccountingService.Transfer:input_type -> accounting.v1.TransferRequest
-	84,  // 200: accounting.v1.AccountingService.ConvertAndTransfer:input_type -> accounting.v1.ConversionRequest
-	86,  // 201: accounting.v1.AccountingService.ProcessTradeWin:input_type -> accounting.v1.TradeRequest
-	86,  // 202: accounting.v1.AccountingService.ProcessTradeLoss:input_type -> accounting.v1.TradeRequest
-	88,  // 203: accounting.v1.AccountingService.ProcessAgentCommission:input_type -> accounting.v1.AgentCommissionRequest
-	91,  // 204: accounting.v1.AccountingService.CreateTransactionApproval:input_type -> accounting.v1.CreateTransactionApprovalRequest
-	93,  // 205: accounting.v1.AccountingService.GetPendingApprovals:input_type -> accounting.v1.GetPendingApprovalsRequest
-	95,  // 206: accounting.v1.AccountingService.ApproveTransaction:input_type -> accounting.v1.ApproveTransactionRequest
-	97,  // 207: accounting.v1.AccountingService.GetApprovalHistory:input_type -> accounting.v1.GetApprovalHistoryRequest
-	70,  // 208: accounting.v1.AccountingService.HealthCheck:input_type -> accounting.v1.HealthCheckRequest
-	101, // 209: accounting.v1.AccountingService.CreateAgent:input_type -> accounting.v1.CreateAgentRequest
-	103, // 210: accounting.v1.AccountingService.UpdateAgent:input_type -> accounting.v1.UpdateAgentRequest
-	105, // 211: accounting.v1.AccountingService.DeleteAgent:input_type -> accounting.v1.DeleteAgentRequest
-	107, // 212: accounting.v1.AccountingService.GetAgentByID:input_type -> accounting.v1.GetAgentByIDRequest
-	109, // 213: accounting.v1.AccountingService.GetAgentByUserID:input_type -> accounting.v1.GetAgentByUserIDRequest
-	111, // 214: accounting.v1.AccountingService.ListAgents:input_type -> accounting.v1.ListAgentsRequest
-	115, // 215: accounting.v1.AccountingService.GetAgentsByCountries:input_type -> accounting.v1.GetAgentsByCountriesRequest
-	117, // 216: accounting.v1.AccountingService.GetAgentStats:input_type -> accounting.v1.GetAgentStatsRequest
-	113, // 217: accounting.v1.AccountingService.ListCommissionsForAgent:input_type -> accounting.v1.ListCommissionsForAgentRequest
-	14,  // 218: accounting.v1.AccountingService.CreateAccount:output_type -> accounting.v1.CreateAccountResponse
-	15,  // 219: accounting.v1.AccountingService.CreateAccounts:output_type -> accounting.v1.CreateAccountsResponse
-	17,  // 220: accounting.v1.AccountingService.GetAccount:output_type -> accounting.v1.GetAccountResponse
-	19,  // 221: accounting.v1.AccountingService.GetAccountsByOwner:output_type -> accounting.v1.GetAccountsByOwnerResponse
-	21,  // 222: accounting.v1.AccountingService.GetOrCreateUserAccounts:output_type -> accounting.v1.GetOrCreateUserAccountsResponse
-	23,  // 223: accounting.v1.AccountingService.UpdateAccount:output_type -> accounting.v1.UpdateAccountResponse
-	25,  // 224: accounting.v1.AccountingService.GetBalance:output_type -> accounting.v1.GetBalanceResponse
-	75,  // 225: accounting.v1.AccountingService.BatchGetBalances:output_type -> accounting.v1.BatchGetBalancesResponse
-	28,  // 226: accounting.v1.AccountingService.ExecuteTransaction:output_type -> accounting.v1.ExecuteTransactionResponse
-	30,  // 227: accounting.v1.AccountingService.ExecuteTransactionSync:output_type -> accounting.v1.ExecuteTransactionSyncResponse
-	73,  // 228: accounting.v1.AccountingService.BatchExecuteTransactions:output_type -> accounting.v1.BatchExecuteTransactionsResponse
-	32,  // 229: accounting.v1.AccountingService.GetTransactionStatus:output_type -> accounting.v1.GetTransactionStatusResponse
-	34,  // 230: accounting.v1.AccountingService.GetTransactionByReceipt:output_type -> accounting.v1.GetTransactionByReceiptResponse
-	38,  // 231: accounting.v1.AccountingService.GetJournal:output_type -> accounting.v1.GetJournalResponse
-	40,  // 232: accounting.v1.AccountingService.ListJournals:output_type -> accounting.v1.ListJournalsResponse
-	42,  // 233: accounting.v1.AccountingService.ListLedgersByJournal:output_type -> accounting.v1.ListLedgersByJournalResponse
-	44,  // 234: accounting.v1.AccountingService.ListLedgersByAccount:output_type -> accounting.v1.ListLedgersByAccountResponse
-	47,  // 235: accounting.v1.AccountingService.GetAccountStatement:output_type -> accounting.v1.GetAccountStatementResponse
-	49,  // 236: accounting.v1.AccountingService.GetOwnerStatement:output_type -> accounting.v1.GetOwnerStatementResponse
-	53,  // 237: accounting.v1.AccountingService.GetOwnerSummary:output_type -> accounting.v1.GetOwnerSummaryResponse
-	56,  // 238: accounting.v1.AccountingService.GenerateDailyReport:output_type -> accounting.v1.GenerateDailyReportResponse
-	59,  // 239: accounting.v1.AccountingService.GetTransactionSummary:output_type -> accounting.v1.GetTransactionSummaryResponse
-	61,  // 240: accounting.v1.AccountingService.GetSystemHoldings:output_type -> accounting.v1.GetSystemHoldingsResponse
-	65,  // 241: accounting.v1.AccountingService.CalculateFee:output_type -> accounting.v1.CalculateFeeResponse
-	67,  // 242: accounting.v1.AccountingService.GetFeesByReceipt:output_type -> accounting.v1.GetFeesByReceiptResponse
-	69,  // 243: accounting.v1.AccountingService.GetAgentCommissionSummary:output_type -> accounting.v1.GetAgentCommissionSummaryResponse
-	77,  // 244: accounting.v1.AccountingService.StreamTransactionEvents:output_type -> accounting.v1.TransactionEvent
-	79,  // 245: accounting.v1.AccountingService.Credit:output_type -> accounting.v1.CreditResponse
-	81,  // 246: accounting.v1.AccountingService.Debit:output_type -> accounting.v1.DebitResponse
-	83,  // 247: accounting.v1.AccountingService.Transfer:output_type -> accounting.v1.TransferResponse
-	85,  // 248: accounting.v1.AccountingService.ConvertAndTransfer:output_type -> accounting.v1.ConversionResponse
-	87,  // 249: accounting.v1.AccountingService.ProcessTradeWin:output_type -> accounting.v1.TradeResponse
-	87,  // 250: accounting.v1.AccountingService.ProcessTradeLoss:output_type -> accounting.v1.TradeResponse
-	89,  // 251: accounting.v1.AccountingService.ProcessAgentCommission:output_type -> accounting.v1.AgentCommissionResponse
-	92,  // 252: accounting.v1.AccountingService.CreateTransactionApproval:output_type -> accounting.v1.CreateTransactionApprovalResponse
-	94,  // 253: accounting.v1.AccountingService.GetPendingApprovals:output_type -> accounting.v1.GetPendingApprovalsResponse
-	96,  // 254: accounting.v1.AccountingService.ApproveTransaction:output_type -> accounting.v1.ApproveTransactionResponse
-	98,  // 255: accounting.v1.AccountingService.GetApprovalHistory:output_type -> accounting.v1.GetApprovalHistoryResponse
-	71,  // 256: accounting.v1.AccountingService.HealthCheck:output_type -> accounting.v1.HealthCheckResponse
-	102, // 257: accounting.v1.AccountingService.CreateAgent:output_type -> accounting.v1.CreateAgentResponse
-	104, // 258: accounting.v1.AccountingService.UpdateAgent:output_type -> accounting.v1.UpdateAgentResponse
-	106, // 259: accounting.v1.AccountingService.DeleteAgent:output_type -> accounting.v1.DeleteAgentResponse
-	108, // 260: accounting.v1.AccountingService.GetAgentByID:output_type -> accounting.v1.GetAgentByIDResponse
-	110, // 261: accounting.v1.AccountingService.GetAgentByUserID:output_type -> accounting.v1.GetAgentByUserIDResponse
-	112, // 262: accounting.v1.AccountingService.ListAgents:output_type -> accounting.v1.ListAgentsResponse
-	116, // 263: accounting.v1.AccountingService.GetAgentsByCountries:output_type -> accounting.v1.GetAgentsByCountriesResponse
-	118, // 264: accounting.v1.AccountingService.GetAgentStats:output_type -> accounting.v1.GetAgentStatsResponse
-	114, // 265: accounting.v1.AccountingService.ListCommissionsForAgent:output_type -> accounting.v1.ListCommissionsForAgentResponse
-	218, // [218:266] is the sub-list for method output_type
-	170, // [170:218] is the sub-list for method input_type
-	170, // [170:170] is the sub-list for extension type_name
-	170, // [170:170] is the sub-list for extension extendee
-	0,   // [0:170] is the sub-list for field type_name
+	18,  // 19: accounting.v1.GetOrCreateUserAccountsResponse.accounts:type_name -> accounting.v1.Account
+	18,  // 20: accounting.v1.UpdateAccountResponse.account:type_name -> accounting.v1.Account
+	2,   // 21: accounting.v1.CreateSubAccountRequest.purpose:type_name -> accounting.v1.AccountPurpose
+	0,   // 22: accounting.v1.CreateSubAccountRequest.owner_type:type_name -> accounting.v1.OwnerType
+	18,  // 23: accounting.v1.CreateSubAccountResponse.account:type_name -> accounting.v1.Account
+	0,   // 24: accounting.v1.ListSubAccountsRequest.owner_type:type_name -> accounting.v1.OwnerType
+	18,  // 25: accounting.v1.SubAccount.account:type_name -> accounting.v1.Account
+	35,  // 26: accounting.v1.ListSubAccountsResponse.parent:type_name -> accounting.v1.SubAccount
+	35,  // 27: accounting.v1.ListSubAccountsResponse.sub_accounts:type_name -> accounting.v1.SubAccount
+	19,  // 28: accounting.v1.GetBalanceResponse.balance:type_name -> accounting.v1.Balance
+	3,   // 29: accounting.v1.LedgerEntry.dr_cr:type_name -> accounting.v1.DrCr
+	4,   // 30: accounting.v1.ExecuteTransactionRequest.transaction_type:type_name -> accounting.v1.TransactionType
+	1,   // 31: accounting.v1.ExecuteTransactionRequest.account_type:type_name -> accounting.v1.AccountType
+	39,  // 32: accounting.v1.ExecuteTransactionRequest.entries:type_name -> accounting.v1.LedgerEntry
+	0,   // 33: accounting.v1.ExecuteTransactionRequest.created_by_type:type_name -> accounting.v1.OwnerType
+	5,   // 34: accounting.v1.ExecuteTransactionResponse.status:type_name -> accounting.v1.TransactionStatus
+	264, // 35: accounting.v1.ExecuteTransactionResponse.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 36: accounting.v1.ExecuteTransactionSyncRequest.transaction_type:type_name -> accounting.v1.TransactionType
+	1,   // 37: accounting.v1.ExecuteTransactionSyncRequest.account_type:type_name -> accounting.v1.AccountType
+	39,  // 38: accounting.v1.ExecuteTransactionSyncRequest.entries:type_name -> accounting.v1.LedgerEntry
+	0,   // 39: accounting.v1.ExecuteTransactionSyncRequest.created_by_type:type_name -> accounting.v1.OwnerType
+	5,   // 40: accounting.v1.ExecuteTransactionSyncResponse.status:type_name -> accounting.v1.TransactionStatus
+	264, // 41: accounting.v1.ExecuteTransactionSyncResponse.created_at:type_name -> google.protobuf.Timestamp
+	5,   // 42: accounting.v1.GetTransactionStatusResponse.status:type_name -> accounting.v1.TransactionStatus
+	264, // 43: accounting.v1.GetTransactionStatusResponse.started_at:type_name -> google.protobuf.Timestamp
+	264, // 44: accounting.v1.GetTransactionStatusResponse.completed_at:type_name -> google.protobuf.Timestamp
+	48,  // 45: accounting.v1.GetTransactionByReceiptResponse.journal:type_name -> accounting.v1.Journal
+	49,  // 46: accounting.v1.GetTransactionByReceiptResponse.ledgers:type_name -> accounting.v1.Ledger
+	77,  // 47: accounting.v1.GetTransactionByReceiptResponse.fees:type_name -> accounting.v1.TransactionFee
+	4,   // 48: accounting.v1.Journal.transaction_type:type_name -> accounting.v1.TransactionType
+	1,   // 49: accounting.v1.Journal.account_type:type_name -> accounting.v1.AccountType
+	0,   // 50: accounting.v1.Journal.created_by_type:type_name -> accounting.v1.OwnerType
+	264, // 51: accounting.v1.Journal.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 52: accounting.v1.Ledger.dr_cr:type_name -> accounting.v1.DrCr
+	264, // 53: accounting.v1.Ledger.created_at:type_name -> google.protobuf.Timestamp
+	48,  // 54: accounting.v1.GetJournalResponse.journal:type_name -> accounting.v1.Journal
+	4,   // 55: accounting.v1.ListJournalsRequest.transaction_type:type_name -> accounting.v1.TransactionType
+	1,   // 56: accounting.v1.ListJournalsRequest.account_type:type_name -> accounting.v1.AccountType
+	264, // 57: accounting.v1.ListJournalsRequest.from:type_name -> google.protobuf.Timestamp
+	264, // 58: accounting.v1.ListJournalsRequest.to:type_name -> google.protobuf.Timestamp
+	48,  // 59: accounting.v1.ListJournalsResponse.journals:type_name -> accounting.v1.Journal
+	49,  // 60: accounting.v1.ListLedgersByJournalResponse.ledgers:type_name -> accounting.v1.Ledger
+	1,   // 61: accounting.v1.ListLedgersByAccountRequest.account_type:type_name -> accounting.v1.AccountType
+	264, // 62: accounting.v1.ListLedgersByAccountRequest.from:type_name -> google.protobuf.Timestamp
+	264, // 63: accounting.v1.ListLedgersByAccountRequest.to:type_name -> google.protobuf.Timestamp
+	49,  // 64: accounting.v1.ListLedgersByAccountResponse.ledgers:type_name -> accounting.v1.Ledger
+	1,   // 65: accounting.v1.AccountStatement.account_type:type_name -> accounting.v1.AccountType
+	49,  // 66: accounting.v1.AccountStatement.ledgers:type_name -> accounting.v1.Ledger
+	264, // 67: accounting.v1.AccountStatement.period_start:type_name -> google.protobuf.Timestamp
+	264, // 68: accounting.v1.AccountStatement.period_end:type_name -> google.protobuf.Timestamp
+	222, // 69: accounting.v1.AccountStatement.interest:type_name -> accounting.v1.InterestSummary
+	1,   // 70: accounting.v1.GetAccountStatementRequest.account_type:type_name -> accounting.v1.AccountType
+	264, // 71: accounting.v1.GetAccountStatementRequest.from:type_name -> google.protobuf.Timestamp
+	264, // 72: accounting.v1.GetAccountStatementRequest.to:type_name -> google.protobuf.Timestamp
+	58,  // 73: accounting.v1.GetAccountStatementResponse.statement:type_name -> accounting.v1.AccountStatement
+	1,   // 74: accounting.v1.ExportAccountStatementRequest.account_type:type_name -> accounting.v1.AccountType
+	264, // 75: accounting.v1.ExportAccountStatementRequest.from:type_name -> google.protobuf.Timestamp
+	264, // 76: accounting.v1.ExportAccountStatementRequest.to:type_name -> google.protobuf.Timestamp
+	7,   // 77: accounting.v1.ExportAccountStatementRequest.format:type_name -> accounting.v1.StatementFormat
+	0,   // 78: accounting.v1.GetOwnerStatementRequest.owner_type:type_name -> accounting.v1.OwnerType
+	1,   // 79: accounting.v1.GetOwnerStatementRequest.account_type:type_name -> accounting.v1.AccountType
+	264, // 80: accounting.v1.GetOwnerStatementRequest.from:type_name -> google.protobuf.Timestamp
+	264, // 81: accounting.v1.GetOwnerStatementRequest.to:type_name -> google.protobuf.Timestamp
+	58,  // 82: accounting.v1.GetOwnerStatementResponse.statements:type_name -> accounting.v1.AccountStatement
+	0,   // 83: accounting.v1.OwnerSummary.owner_type:type_name -> accounting.v1.OwnerType
+	1,   // 84: accounting.v1.OwnerSummary.account_type:type_name -> accounting.v1.AccountType
+	66,  // 85: accounting.v1.OwnerSummary.account_balances:type_name -> accounting.v1.AccountBalance
+	0,   // 86: accounting.v1.GetOwnerSummaryRequest.owner_type:type_name -> accounting.v1.OwnerType
+	1,   // 87: accounting.v1.GetOwnerSummaryRequest.account_type:type_name -> accounting.v1.AccountType
+	65,  // 88: accounting.v1.GetOwnerSummaryResponse.summary:type_name -> accounting.v1.OwnerSummary
+	0,   // 89: accounting.v1.DailyReport.owner_type:type_name -> accounting.v1.OwnerType
+	264, // 90: accounting.v1.DailyReport.date:type_name -> google.protobuf.Timestamp
+	264, // 91: accounting.v1.GenerateDailyReportRequest.date:type_name -> google.protobuf.Timestamp
+	1,   // 92: accounting.v1.GenerateDailyReportRequest.account_type:type_name -> accounting.v1.AccountType
+	69,  // 93: accounting.v1.GenerateDailyReportResponse.reports:type_name -> accounting.v1.DailyReport
+	4,   // 94: accounting.v1.TransactionSummary.transaction_type:type_name -> accounting.v1.TransactionType
+	1,   // 95: accounting.v1.GetTransactionSummaryRequest.account_type:type_name -> accounting.v1.AccountType
+	264, // 96: accounting.v1.GetTransactionSummaryRequest.from:type_name -> google.protobuf.Timestamp
+	264, // 97: accounting.v1.GetTransactionSummaryRequest.to:type_name -> google.protobuf.Timestamp
+	72,  // 98: accounting.v1.GetTransactionSummaryResponse.summaries:type_name -> accounting.v1.TransactionSummary
+	1,   // 99: accounting.v1.GetSystemHoldingsRequest.account_type:type_name -> accounting.v1.AccountType
+	250, // 100: accounting.v1.GetSystemHoldingsResponse.holdings:type_name -> accounting.v1.GetSystemHoldingsResponse.HoldingsEntry
+	6,   // 101: accounting.v1.TransactionFee.fee_type:type_name -> accounting.v1.FeeType
+	264, // 102: accounting.v1.TransactionFee.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 103: accounting.v1.CalculateFeeRequest.transaction_type:type_name -> accounting.v1.TransactionType
+	1,   // 104: accounting.v1.CalculateFeeRequest.account_type:type_name -> accounting.v1.AccountType
+	0,   // 105: accounting.v1.CalculateFeeRequest.owner_type:type_name -> accounting.v1.OwnerType
+	6,   // 106: accounting.v1.FeeCalculation.fee_type:type_name -> accounting.v1.FeeType
+	79,  // 107: accounting.v1.CalculateFeeResponse.calculation:type_name -> accounting.v1.FeeCalculation
+	77,  // 108: accounting.v1.GetFeesByReceiptResponse.fees:type_name -> accounting.v1.TransactionFee
+	264, // 109: accounting.v1.GetAgentCommissionSummaryRequest.from:type_name -> google.protobuf.Timestamp
+	264, // 110: accounting.v1.GetAgentCommissionSummaryRequest.to:type_name -> google.protobuf.Timestamp
+	251, // 111: accounting.v1.GetAgentCommissionSummaryResponse.commissions:type_name -> accounting.v1.GetAgentCommissionSummaryResponse.CommissionsEntry
+	252, // 112: accounting.v1.HealthCheckResponse.components:type_name -> accounting.v1.HealthCheckResponse.ComponentsEntry
+	40,  // 113: accounting.v1.BatchExecuteTransactionsRequest.transactions:type_name -> accounting.v1.ExecuteTransactionRequest
+	41,  // 114: accounting.v1.BatchExecuteTransactionsResponse.results:type_name -> accounting.v1.ExecuteTransactionResponse
+	253, // 115: accounting.v1.BatchExecuteTransactionsResponse.errors:type_name -> accounting.v1.BatchExecuteTransactionsResponse.ErrorsEntry
+	19,  // 116: accounting.v1.BatchGetBalancesResponse.balances:type_name -> accounting.v1.Balance
+	254, // 117: accounting.v1.BatchGetBalancesResponse.errors:type_name -> accounting.v1.BatchGetBalancesResponse.ErrorsEntry
+	0,   // 118: accounting.v1.StreamTransactionEventsRequest.owner_type:type_name -> accounting.v1.OwnerType
+	1,   // 119: accounting.v1.StreamTransactionEventsRequest.account_type:type_name -> accounting.v1.AccountType
+	5,   // 120: accounting.v1.TransactionEvent.status:type_name -> accounting.v1.TransactionStatus
+	264, // 121: accounting.v1.TransactionEvent.timestamp:type_name -> google.protobuf.Timestamp
+	1,   // 122: accounting.v1.CreditRequest.account_type:type_name -> accounting.v1.AccountType
+	0,   // 123: accounting.v1.CreditRequest.created_by_type:type_name -> accounting.v1.OwnerType
+	4,   // 124: accounting.v1.CreditRequest.transaction_type:type_name -> accounting.v1.TransactionType
+	264, // 125: accounting.v1.CreditRequest.posted_at:type_name -> google.protobuf.Timestamp
+	264, // 126: accounting.v1.CreditResponse.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 127: accounting.v1.DebitRequest.account_type:type_name -> accounting.v1.AccountType
+	0,   // 128: accounting.v1.DebitRequest.created_by_type:type_name -> accounting.v1.OwnerType
+	4,   // 129: accounting.v1.DebitRequest.transaction_type:type_name -> accounting.v1.TransactionType
+	264, // 130: accounting.v1.DebitRequest.posted_at:type_name -> google.protobuf.Timestamp
+	264, // 131: accounting.v1.DebitResponse.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 132: accounting.v1.TransferRequest.account_type:type_name -> accounting.v1.AccountType
+	0,   // 133: accounting.v1.TransferRequest.created_by_type:type_name -> accounting.v1.OwnerType
+	4,   // 134: accounting.v1.TransferRequest.transaction_type:type_name -> accounting.v1.TransactionType
+	264, // 135: accounting.v1.TransferResponse.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 136: accounting.v1.ConversionRequest.account_type:type_name -> accounting.v1.AccountType
+	0,   // 137: accounting.v1.ConversionRequest.created_by_type:type_name -> accounting.v1.OwnerType
+	264, // 138: accounting.v1.ConversionResponse.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 139: accounting.v1.CreateFXQuoteRequest.account_type:type_name -> accounting.v1.AccountType
+	0,   // 140: accounting.v1.CreateFXQuoteRequest.created_by_type:type_name -> accounting.v1.OwnerType
+	6,   // 141: accounting.v1.FXQuote.fee_type:type_name -> accounting.v1.FeeType
+	264, // 142: accounting.v1.FXQuote.expires_at:type_name -> google.protobuf.Timestamp
+	264, // 143: accounting.v1.FXQuote.created_at:type_name -> google.protobuf.Timestamp
+	102, // 144: accounting.v1.CreateFXQuoteResponse.quote:type_name -> accounting.v1.FXQuote
+	1,   // 145: accounting.v1.TradeRequest.account_type:type_name -> accounting.v1.AccountType
+	0,   // 146: accounting.v1.TradeRequest.created_by_type:type_name -> accounting.v1.OwnerType
+	264, // 147: accounting.v1.TradeResponse.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 148: accounting.v1.SettleTradesRequest.account_type:type_name -> accounting.v1.AccountType
+	106, // 149: accounting.v1.SettleTradesRequest.trades:type_name -> accounting.v1.SettleTradeItem
+	0,   // 150: accounting.v1.SettleTradesRequest.created_by_type:type_name -> accounting.v1.OwnerType
+	264, // 151: accounting.v1.TradeSettlementResult.settled_at:type_name -> google.protobuf.Timestamp
+	108, // 152: accounting.v1.SettleTradesResponse.results:type_name -> accounting.v1.TradeSettlementResult
+	264, // 153: accounting.v1.AgentCommissionResponse.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 154: accounting.v1.ReverseTransactionRequest.created_by_type:type_name -> accounting.v1.OwnerType
+	0,   // 155: accounting.v1.RefundTransactionRequest.created_by_type:type_name -> accounting.v1.OwnerType
+	4,   // 156: accounting.v1.ReversalResponse.transaction_type:type_name -> accounting.v1.TransactionType
+	264, // 157: accounting.v1.ReversalResponse.created_at:type_name -> google.protobuf.Timestamp
+	8,   // 158: accounting.v1.Hold.status:type_name -> accounting.v1.HoldStatus
+	4,   // 159: accounting.v1.Hold.transaction_type:type_name -> accounting.v1.TransactionType
+	264, // 160: accounting.v1.Hold.expires_at:type_name -> google.protobuf.Timestamp
+	264, // 161: accounting.v1.Hold.captured_at:type_name -> google.protobuf.Timestamp
+	264, // 162: accounting.v1.Hold.released_at:type_name -> google.protobuf.Timestamp
+	264, // 163: accounting.v1.Hold.created_at:type_name -> google.protobuf.Timestamp
+	264, // 164: accounting.v1.Hold.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 165: accounting.v1.PlaceHoldRequest.transaction_type:type_name -> accounting.v1.TransactionType
+	1,   // 166: accounting.v1.PlaceHoldRequest.account_type:type_name -> accounting.v1.AccountType
+	0,   // 167: accounting.v1.PlaceHoldRequest.created_by_type:type_name -> accounting.v1.OwnerType
+	115, // 168: accounting.v1.PlaceHoldResponse.hold:type_name -> accounting.v1.Hold
+	0,   // 169: accounting.v1.CaptureHoldRequest.created_by_type:type_name -> accounting.v1.OwnerType
+	115, // 170: accounting.v1.CaptureHoldResponse.hold:type_name -> accounting.v1.Hold
+	264, // 171: accounting.v1.CaptureHoldResponse.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 172: accounting.v1.ReleaseHoldRequest.released_by_type:type_name -> accounting.v1.OwnerType
+	115, // 173: accounting.v1.ReleaseHoldResponse.hold:type_name -> accounting.v1.Hold
+	115, // 174: accounting.v1.ExtendHoldResponse.hold:type_name -> accounting.v1.Hold
+	8,   // 175: accounting.v1.ListHoldsRequest.status:type_name -> accounting.v1.HoldStatus
+	115, // 176: accounting.v1.ListHoldsResponse.holds:type_name -> accounting.v1.Hold
+	9,   // 177: accounting.v1.ReconciliationRun.status:type_name -> accounting.v1.ReconciliationRunStatus
+	1,   // 178: accounting.v1.ReconciliationRun.account_type:type_name -> accounting.v1.AccountType
+	264, // 179: accounting.v1.ReconciliationRun.journals_from:type_name -> google.protobuf.Timestamp
+	264, // 180: accounting.v1.ReconciliationRun.started_at:type_name -> google.protobuf.Timestamp
+	264, // 181: accounting.v1.ReconciliationRun.finished_at:type_name -> google.protobuf.Timestamp
+	1,   // 182: accounting.v1.ReconciliationBreak.account_type:type_name -> accounting.v1.AccountType
+	255, // 183: accounting.v1.ReconciliationBreak.details:type_name -> accounting.v1.ReconciliationBreak.DetailsEntry
+	264, // 184: accounting.v1.ReconciliationBreak.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 185: accounting.v1.RunReconciliationRequest.account_type:type_name -> accounting.v1.AccountType
+	264, // 186: accounting.v1.RunReconciliationRequest.journals_from:type_name -> google.protobuf.Timestamp
+	126, // 187: accounting.v1.RunReconciliationResponse.run:type_name -> accounting.v1.ReconciliationRun
+	127, // 188: accounting.v1.ListReconciliationBreaksResponse.breaks:type_name -> accounting.v1.ReconciliationBreak
+	126, // 189: accounting.v1.ListReconciliationBreaksResponse.run:type_name -> accounting.v1.ReconciliationRun
+	4,   // 190: accounting.v1.QueuedTransaction.transaction_type:type_name -> accounting.v1.TransactionType
+	10,  // 191: accounting.v1.QueuedTransaction.status:type_name -> accounting.v1.QueuedTransactionStatus
+	264, // 192: accounting.v1.QueuedTransaction.available_at:type_name -> google.protobuf.Timestamp
+	264, // 193: accounting.v1.QueuedTransaction.created_at:type_name -> google.protobuf.Timestamp
+	264, // 194: accounting.v1.QueuedTransaction.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 195: accounting.v1.QueuedTransaction.completed_at:type_name -> google.protobuf.Timestamp
+	10,  // 196: accounting.v1.ListQueuedTransactionsRequest.status:type_name -> accounting.v1.QueuedTransactionStatus
+	132, // 197: accounting.v1.ListQueuedTransactionsResponse.transactions:type_name -> accounting.v1.QueuedTransaction
+	132, // 198: accounting.v1.RedriveDeadLetterTransactionsResponse.transactions:type_name -> accounting.v1.QueuedTransaction
+	132, // 199: accounting.v1.SkipDeadLetterTransactionsResponse.transactions:type_name -> accounting.v1.QueuedTransaction
+	264, // 200: accounting.v1.AccountingPeriod.period_start:type_name -> google.protobuf.Timestamp
+	264, // 201: accounting.v1.AccountingPeriod.period_end:type_name -> google.protobuf.Timestamp
+	264, // 202: accounting.v1.AccountingPeriod.created_at:type_name -> google.protobuf.Timestamp
+	264, // 203: accounting.v1.AccountingPeriod.closed_at:type_name -> google.protobuf.Timestamp
+	264, // 204: accounting.v1.CloseAccountingPeriodRequest.period_start:type_name -> google.protobuf.Timestamp
+	264, // 205: accounting.v1.CloseAccountingPeriodRequest.period_end:type_name -> google.protobuf.Timestamp
+	139, // 206: accounting.v1.CloseAccountingPeriodResponse.period:type_name -> accounting.v1.AccountingPeriod
+	2,   // 207: accounting.v1.TrialBalanceLine.purpose:type_name -> accounting.v1.AccountPurpose
+	1,   // 208: accounting.v1.TrialBalanceLine.account_type:type_name -> accounting.v1.AccountType
+	264, // 209: accounting.v1.GetTrialBalanceRequest.from:type_name -> google.protobuf.Timestamp
+	264, // 210: accounting.v1.GetTrialBalanceRequest.to:type_name -> google.protobuf.Timestamp
+	1,   // 211: accounting.v1.GetTrialBalanceRequest.account_type:type_name -> accounting.v1.AccountType
+	2,   // 212: accounting.v1.GetTrialBalanceRequest.purpose:type_name -> accounting.v1.AccountPurpose
+	142, // 213: accounting.v1.GetTrialBalanceResponse.lines:type_name -> accounting.v1.TrialBalanceLine
+	143, // 214: accounting.v1.GetTrialBalanceResponse.totals:type_name -> accounting.v1.TrialBalanceTotal
+	264, // 215: accounting.v1.GetTrialBalanceResponse.from:type_name -> google.protobuf.Timestamp
+	264, // 216: accounting.v1.GetTrialBalanceResponse.to:type_name -> google.protobuf.Timestamp
+	139, // 217: accounting.v1.GetTrialBalanceResponse.period:type_name -> accounting.v1.AccountingPeriod
+	2,   // 218: accounting.v1.GeneralLedgerEntry.purpose:type_name -> accounting.v1.AccountPurpose
+	1,   // 219: accounting.v1.GeneralLedgerEntry.account_type:type_name -> accounting.v1.AccountType
+	3,   // 220: accounting.v1.GeneralLedgerEntry.dr_cr:type_name -> accounting.v1.DrCr
+	4,   // 221: accounting.v1.GeneralLedgerEntry.transaction_type:type_name -> accounting.v1.TransactionType
+	264, // 222: accounting.v1.GeneralLedgerEntry.created_at:type_name -> google.protobuf.Timestamp
+	264, // 223: accounting.v1.GetGeneralLedgerRequest.from:type_name -> google.protobuf.Timestamp
+	264, // 224: accounting.v1.GetGeneralLedgerRequest.to:type_name -> google.protobuf.Timestamp
+	2,   // 225: accounting.v1.GetGeneralLedgerRequest.purpose:type_name -> accounting.v1.AccountPurpose
+	1,   // 226: accounting.v1.GetGeneralLedgerRequest.account_type:type_name -> accounting.v1.AccountType
+	142, // 227: accounting.v1.GetGeneralLedgerResponse.sections:type_name -> accounting.v1.TrialBalanceLine
+	146, // 228: accounting.v1.GetGeneralLedgerResponse.entries:type_name -> accounting.v1.GeneralLedgerEntry
+	264, // 229: accounting.v1.GetGeneralLedgerResponse.from:type_name -> google.protobuf.Timestamp
+	264, // 230: accounting.v1.GetGeneralLedgerResponse.to:type_name -> google.protobuf.Timestamp
+	4,   // 231: accounting.v1.TransactionApproval.transaction_type:type_name -> accounting.v1.TransactionType
+	11,  // 232: accounting.v1.TransactionApproval.status:type_name -> accounting.v1.ApprovalStatus
+	264, // 233: accounting.v1.TransactionApproval.created_at:type_name -> google.protobuf.Timestamp
+	264, // 234: accounting.v1.TransactionApproval.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 235: accounting.v1.TransactionApproval.expires_at:type_name -> google.protobuf.Timestamp
+	150, // 236: accounting.v1.TransactionApproval.decisions:type_name -> accounting.v1.ApprovalDecision
+	264, // 237: accounting.v1.TransactionApproval.executed_at:type_name -> google.protobuf.Timestamp
+	264, // 238: accounting.v1.ApprovalDecision.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 239: accounting.v1.CreateTransactionApprovalRequest.transaction_type:type_name -> accounting.v1.TransactionType
+	149, // 240: accounting.v1.CreateTransactionApprovalResponse.approval:type_name -> accounting.v1.TransactionApproval
+	149, // 241: accounting.v1.GetPendingApprovalsResponse.approvals:type_name -> accounting.v1.TransactionApproval
+	149, // 242: accounting.v1.ApproveTransactionResponse.approval:type_name -> accounting.v1.TransactionApproval
+	11,  // 243: accounting.v1.GetApprovalHistoryRequest.status:type_name -> accounting.v1.ApprovalStatus
+	264, // 244: accounting.v1.GetApprovalHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	264, // 245: accounting.v1.GetApprovalHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	149, // 246: accounting.v1.GetApprovalHistoryResponse.approvals:type_name -> accounting.v1.TransactionApproval
+	4,   // 247: accounting.v1.ApprovalPolicy.transaction_type:type_name -> accounting.v1.TransactionType
+	264, // 248: accounting.v1.ApprovalPolicy.created_at:type_name -> google.protobuf.Timestamp
+	264, // 249: accounting.v1.ApprovalPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	159, // 250: accounting.v1.CreateApprovalPolicyRequest.policy:type_name -> accounting.v1.ApprovalPolicy
+	159, // 251: accounting.v1.CreateApprovalPolicyResponse.policy:type_name -> accounting.v1.ApprovalPolicy
+	159, // 252: accounting.v1.UpdateApprovalPolicyRequest.policy:type_name -> accounting.v1.ApprovalPolicy
+	159, // 253: accounting.v1.UpdateApprovalPolicyResponse.policy:type_name -> accounting.v1.ApprovalPolicy
+	4,   // 254: accounting.v1.ListApprovalPoliciesRequest.transaction_type:type_name -> accounting.v1.TransactionType
+	159, // 255: accounting.v1.ListApprovalPoliciesResponse.policies:type_name -> accounting.v1.ApprovalPolicy
+	12,  // 256: accounting.v1.Agent.relationship_type:type_name -> accounting.v1.RelationshipType
+	256, // 257: accounting.v1.Agent.metadata:type_name -> accounting.v1.Agent.MetadataEntry
+	264, // 258: accounting.v1.Agent.created_at:type_name -> google.protobuf.Timestamp
+	264, // 259: accounting.v1.Agent.updated_at:type_name -> google.protobuf.Timestamp
+	18,  // 260: accounting.v1.Agent.accounts:type_name -> accounting.v1.Account
+	257, // 261: accounting.v1.Agent.location:type_name -> accounting.v1.Agent.LocationEntry
+	13,  // 262: accounting.v1.Agent.status:type_name -> accounting.v1.AgentStatus
+	264, // 263: accounting.v1.AgentCommission.paid_out_at:type_name -> google.protobuf.Timestamp
+	264, // 264: accounting.v1.AgentCommission.created_at:type_name -> google.protobuf.Timestamp
+	12,  // 265: accounting.v1.CreateAgentRequest.relationship_type:type_name -> accounting.v1.RelationshipType
+	258, // 266: accounting.v1.CreateAgentRequest.metadata:type_name -> accounting.v1.CreateAgentRequest.MetadataEntry
+	259, // 267: accounting.v1.CreateAgentRequest.location:type_name -> accounting.v1.CreateAgentRequest.LocationEntry
+	13,  // 268: accounting.v1.CreateAgentRequest.status:type_name -> accounting.v1.AgentStatus
+	166, // 269: accounting.v1.CreateAgentResponse.agent:type_name -> accounting.v1.Agent
+	12,  // 270: accounting.v1.UpdateAgentRequest.relationship_type:type_name -> accounting.v1.RelationshipType
+	260, // 271: accounting.v1.UpdateAgentRequest.metadata:type_name -> accounting.v1.UpdateAgentRequest.MetadataEntry
+	261, // 272: accounting.v1.UpdateAgentRequest.location:type_name -> accounting.v1.UpdateAgentRequest.LocationEntry
+	13,  // 273: accounting.v1.UpdateAgentRequest.status:type_name -> accounting.v1.AgentStatus
+	166, // 274: accounting.v1.UpdateAgentResponse.agent:type_name -> accounting.v1.Agent
+	166, // 275: accounting.v1.GetAgentByIDResponse.agent:type_name -> accounting.v1.Agent
+	166, // 276: accounting.v1.GetAgentByUserIDResponse.agent:type_name -> accounting.v1.Agent
+	13,  // 277: accounting.v1.ListAgentsRequest.status:type_name -> accounting.v1.AgentStatus
+	12,  // 278: accounting.v1.ListAgentsRequest.relationship_type:type_name -> accounting.v1.RelationshipType
+	166, // 279: accounting.v1.ListAgentsResponse.agents:type_name -> accounting.v1.Agent
+	167, // 280: accounting.v1.ListCommissionsForAgentResponse.commissions:type_name -> accounting.v1.AgentCommission
+	13,  // 281: accounting.v1.GetAgentsByCountriesRequest.status:type_name -> accounting.v1.AgentStatus
+	166, // 282: accounting.v1.GetAgentsByCountriesResponse.agents:type_name -> accounting.v1.Agent
+	262, // 283: accounting.v1.GetAgentStatsResponse.agents_by_country:type_name -> accounting.v1.GetAgentStatsResponse.AgentsByCountryEntry
+	263, // 284: accounting.v1.GetAgentStatsResponse.agents_by_payment_method:type_name -> accounting.v1.GetAgentStatsResponse.AgentsByPaymentMethodEntry
+	264, // 285: accounting.v1.CommissionPayout.period_from:type_name -> google.protobuf.Timestamp
+	264, // 286: accounting.v1.CommissionPayout.period_to:type_name -> google.protobuf.Timestamp
+	14,  // 287: accounting.v1.CommissionPayout.status:type_name -> accounting.v1.CommissionPayoutStatus
+	264, // 288: accounting.v1.CommissionPayout.created_at:type_name -> google.protobuf.Timestamp
+	264, // 289: accounting.v1.CommissionPayout.paid_at:type_name -> google.protobuf.Timestamp
+	264, // 290: accounting.v1.CreateCommissionPayoutBatchRequest.period_from:type_name -> google.protobuf.Timestamp
+	264, // 291: accounting.v1.CreateCommissionPayoutBatchRequest.period_to:type_name -> google.protobuf.Timestamp
+	186, // 292: accounting.v1.CreateCommissionPayoutBatchResponse.payouts:type_name -> accounting.v1.CommissionPayout
+	186, // 293: accounting.v1.CreateCommissionPayoutBatchResponse.skipped:type_name -> accounting.v1.CommissionPayout
+	14,  // 294: accounting.v1.ListCommissionPayoutsRequest.status:type_name -> accounting.v1.CommissionPayoutStatus
+	186, // 295: accounting.v1.ListCommissionPayoutsResponse.payouts:type_name -> accounting.v1.CommissionPayout
+	0,   // 296: accounting.v1.LimitProfile.owner_type:type_name -> accounting.v1.OwnerType
+	264, // 297: accounting.v1.LimitProfile.created_at:type_name -> google.protobuf.Timestamp
+	264, // 298: accounting.v1.LimitProfile.updated_at:type_name -> google.protobuf.Timestamp
+	191, // 299: accounting.v1.CreateLimitProfileRequest.profile:type_name -> accounting.v1.LimitProfile
+	191, // 300: accounting.v1.CreateLimitProfileResponse.profile:type_name -> accounting.v1.LimitProfile
+	191, // 301: accounting.v1.UpdateLimitProfileRequest.profile:type_name -> accounting.v1.LimitProfile
+	191, // 302: accounting.v1.UpdateLimitProfileResponse.profile:type_name -> accounting.v1.LimitProfile
+	0,   // 303: accounting.v1.ListLimitProfilesRequest.owner_type:type_name -> accounting.v1.OwnerType
+	191, // 304: accounting.v1.ListLimitProfilesResponse.profiles:type_name -> accounting.v1.LimitProfile
+	0,   // 305: accounting.v1.SetOwnerKYCTierRequest.owner_type:type_name -> accounting.v1.OwnerType
+	0,   // 306: accounting.v1.SetOwnerKYCTierResponse.owner_type:type_name -> accounting.v1.OwnerType
+	264, // 307: accounting.v1.SetOwnerKYCTierResponse.updated_at:type_name -> google.protobuf.Timestamp
+	15,  // 308: accounting.v1.LimitUsage.window:type_name -> accounting.v1.LimitWindow
+	264, // 309: accounting.v1.LimitUsage.window_start:type_name -> google.protobuf.Timestamp
+	264, // 310: accounting.v1.LimitUsage.resets_at:type_name -> google.protobuf.Timestamp
+	191, // 311: accounting.v1.GetAccountLimitsResponse.profile:type_name -> accounting.v1.LimitProfile
+	200, // 312: accounting.v1.GetAccountLimitsResponse.usage:type_name -> accounting.v1.LimitUsage
+	0,   // 313: accounting.v1.ScheduledTransfer.owner_type:type_name -> accounting.v1.OwnerType
+	1,   // 314: accounting.v1.ScheduledTransfer.account_type:type_name -> accounting.v1.AccountType
+	264, // 315: accounting.v1.ScheduledTransfer.start_at:type_name -> google.protobuf.Timestamp
+	264, // 316: accounting.v1.ScheduledTransfer.end_at:type_name -> google.protobuf.Timestamp
+	16,  // 317: accounting.v1.ScheduledTransfer.status:type_name -> accounting.v1.ScheduledTransferStatus
+	264, // 318: accounting.v1.ScheduledTransfer.next_run_at:type_name -> google.protobuf.Timestamp
+	264, // 319: accounting.v1.ScheduledTransfer.last_run_at:type_name -> google.protobuf.Timestamp
+	264, // 320: accounting.v1.ScheduledTransfer.cancelled_at:type_name -> google.protobuf.Timestamp
+	264, // 321: accounting.v1.ScheduledTransfer.created_at:type_name -> google.protobuf.Timestamp
+	264, // 322: accounting.v1.ScheduledTransfer.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 323: accounting.v1.ScheduledTransferRun.occurrence_at:type_name -> google.protobuf.Timestamp
+	17,  // 324: accounting.v1.ScheduledTransferRun.status:type_name -> accounting.v1.ScheduledTransferRunStatus
+	264, // 325: accounting.v1.ScheduledTransferRun.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 326: accounting.v1.CreateScheduledTransferRequest.owner_type:type_name -> accounting.v1.OwnerType
+	1,   // 327: accounting.v1.CreateScheduledTransferRequest.account_type:type_name -> accounting.v1.AccountType
+	264, // 328: accounting.v1.CreateScheduledTransferRequest.start_at:type_name -> google.protobuf.Timestamp
+	264, // 329: accounting.v1.CreateScheduledTransferRequest.end_at:type_name -> google.protobuf.Timestamp
+	203, // 330: accounting.v1.CreateScheduledTransferResponse.schedule:type_name -> accounting.v1.ScheduledTransfer
+	0,   // 331: accounting.v1.GetScheduledTransferRequest.owner_type:type_name -> accounting.v1.OwnerType
+	203, // 332: accounting.v1.GetScheduledTransferResponse.schedule:type_name -> accounting.v1.ScheduledTransfer
+	204, // 333: accounting.v1.GetScheduledTransferResponse.recent_runs:type_name -> accounting.v1.ScheduledTransferRun
+	0,   // 334: accounting.v1.ListScheduledTransfersRequest.owner_type:type_name -> accounting.v1.OwnerType
+	16,  // 335: accounting.v1.ListScheduledTransfersRequest.status:type_name -> accounting.v1.ScheduledTransferStatus
+	203, // 336: accounting.v1.ListScheduledTransfersResponse.schedules:type_name -> accounting.v1.ScheduledTransfer
+	0,   // 337: accounting.v1.CancelScheduledTransferRequest.owner_type:type_name -> accounting.v1.OwnerType
+	203, // 338: accounting.v1.CancelScheduledTransferResponse.schedule:type_name -> accounting.v1.ScheduledTransfer
+	4,   // 339: accounting.v1.FeeRule.transaction_type:type_name -> accounting.v1.TransactionType
+	1,   // 340: accounting.v1.FeeRule.account_type:type_name -> accounting.v1.AccountType
+	0,   // 341: accounting.v1.FeeRule.owner_type:type_name -> accounting.v1.OwnerType
+	6,   // 342: accounting.v1.FeeRule.fee_type:type_name -> accounting.v1.FeeType
+	264, // 343: accounting.v1.FeeRule.valid_from:type_name -> google.protobuf.Timestamp
+	264, // 344: accounting.v1.FeeRule.valid_to:type_name -> google.protobuf.Timestamp
+	213, // 345: accounting.v1.FeeRuleVersion.rule:type_name -> accounting.v1.FeeRule
+	264, // 346: accounting.v1.FeeRuleVersion.created_at:type_name -> google.protobuf.Timestamp
+	214, // 347: accounting.v1.ListFeeRuleVersionsResponse.versions:type_name -> accounting.v1.FeeRuleVersion
+	264, // 348: accounting.v1.SimulateFeeRulesRequest.from:type_name -> google.protobuf.Timestamp
+	264, // 349: accounting.v1.SimulateFeeRulesRequest.to:type_name -> google.protobuf.Timestamp
+	213, // 350: accounting.v1.SimulateFeeRulesRequest.rules:type_name -> accounting.v1.FeeRule
+	4,   // 351: accounting.v1.SimulateFeeRulesRequest.transaction_types:type_name -> accounting.v1.TransactionType
+	4,   // 352: accounting.v1.FeeSimulationGroup.transaction_type:type_name -> accounting.v1.TransactionType
+	264, // 353: accounting.v1.SimulateFeeRulesResponse.from:type_name -> google.protobuf.Timestamp
+	264, // 354: accounting.v1.SimulateFeeRulesResponse.to:type_name -> google.protobuf.Timestamp
+	218, // 355: accounting.v1.SimulateFeeRulesResponse.groups:type_name -> accounting.v1.FeeSimulationGroup
+	2,   // 356: accounting.v1.InterestRatePlan.purpose:type_name -> accounting.v1.AccountPurpose
+	264, // 357: accounting.v1.InterestRatePlan.created_at:type_name -> google.protobuf.Timestamp
+	264, // 358: accounting.v1.InterestRatePlan.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 359: accounting.v1.InterestPosting.period_from:type_name -> google.protobuf.Timestamp
+	264, // 360: accounting.v1.InterestPosting.period_to:type_name -> google.protobuf.Timestamp
+	264, // 361: accounting.v1.InterestPosting.created_at:type_name -> google.protobuf.Timestamp
+	264, // 362: accounting.v1.InterestPosting.posted_at:type_name -> google.protobuf.Timestamp
+	220, // 363: accounting.v1.CreateInterestRatePlanRequest.plan:type_name -> accounting.v1.InterestRatePlan
+	220, // 364: accounting.v1.CreateInterestRatePlanResponse.plan:type_name -> accounting.v1.InterestRatePlan
+	220, // 365: accounting.v1.UpdateInterestRatePlanRequest.plan:type_name -> accounting.v1.InterestRatePlan
+	220, // 366: accounting.v1.UpdateInterestRatePlanResponse.plan:type_name -> accounting.v1.InterestRatePlan
+	220, // 367: accounting.v1.ListInterestRatePlansResponse.plans:type_name -> accounting.v1.InterestRatePlan
+	264, // 368: accounting.v1.GetAccountInterestRequest.from:type_name -> google.protobuf.Timestamp
+	264, // 369: accounting.v1.GetAccountInterestRequest.to:type_name -> google.protobuf.Timestamp
+	222, // 370: accounting.v1.GetAccountInterestResponse.summary:type_name -> accounting.v1.InterestSummary
+	221, // 371: accounting.v1.GetAccountInterestResponse.postings:type_name -> accounting.v1.InterestPosting
+	221, // 372: accounting.v1.ListInterestPostingsResponse.postings:type_name -> accounting.v1.InterestPosting
+	264, // 373: accounting.v1.RunInterestRequest.through_date:type_name -> google.protobuf.Timestamp
+	264, // 374: accounting.v1.AccountRestriction.expires_at:type_name -> google.protobuf.Timestamp
+	264, // 375: accounting.v1.AccountRestriction.created_at:type_name -> google.protobuf.Timestamp
+	264, // 376: accounting.v1.AccountRestriction.released_at:type_name -> google.protobuf.Timestamp
+	264, // 377: accounting.v1.PlaceAccountRestrictionRequest.expires_at:type_name -> google.protobuf.Timestamp
+	235, // 378: accounting.v1.PlaceAccountRestrictionResponse.restriction:type_name -> accounting.v1.AccountRestriction
+	235, // 379: accounting.v1.ReleaseAccountRestrictionResponse.restriction:type_name -> accounting.v1.AccountRestriction
+	235, // 380: accounting.v1.CloseAccountResponse.closure:type_name -> accounting.v1.AccountRestriction
+	235, // 381: accounting.v1.ListAccountRestrictionsResponse.restrictions:type_name -> accounting.v1.AccountRestriction
+	264, // 382: accounting.v1.DemoRefill.created_at:type_name -> google.protobuf.Timestamp
+	264, // 383: accounting.v1.DemoRefill.next_available_at:type_name -> google.protobuf.Timestamp
+	0,   // 384: accounting.v1.TopUpDemoAccountRequest.owner_type:type_name -> accounting.v1.OwnerType
+	244, // 385: accounting.v1.TopUpDemoAccountResponse.refill:type_name -> accounting.v1.DemoRefill
+	0,   // 386: accounting.v1.ResetDemoAccountRequest.owner_type:type_name -> accounting.v1.OwnerType
+	244, // 387: accounting.v1.ResetDemoAccountResponse.refill:type_name -> accounting.v1.DemoRefill
+	20,  // 388: accounting.v1.AccountingService.CreateAccount:input_type -> accounting.v1.CreateAccountRequest
+	21,  // 389: accounting.v1.AccountingService.CreateAccounts:input_type -> accounting.v1.CreateAccountsRequest
+	24,  // 390: accounting.v1.AccountingService.GetAccount:input_type -> accounting.v1.GetAccountRequest
+	26,  // 391: accounting.v1.AccountingService.GetAccountsByOwner:input_type -> accounting.v1.GetAccountsByOwnerRequest
+	28,  // 392: accounting.v1.AccountingService.GetOrCreateUserAccounts:input_type -> accounting.v1.GetOrCreateUserAccountsRequest
+	30,  // 393: accounting.v1.AccountingService.UpdateAccount:input_type -> accounting.v1.UpdateAccountRequest
+	32,  // 394: accounting.v1.AccountingService.CreateSubAccount:input_type -> accounting.v1.CreateSubAccountRequest
+	34,  // 395: accounting.v1.AccountingService.ListSubAccounts:input_type -> accounting.v1.ListSubAccountsRequest
+	37,  // 396: accounting.v1.AccountingService.GetBalance:input_type -> accounting.v1.GetBalanceRequest
+	89,  // 397: accounting.v1.AccountingService.BatchGetBalances:input_type -> accounting.v1.BatchGetBalancesRequest
+	40,  // 398: accounting.v1.AccountingService.ExecuteTransaction:input_type -> accounting.v1.ExecuteTransactionRequest
+	42,  // 399: accounting.v1.AccountingService.ExecuteTransactionSync:input_type -> accounting.v1.ExecuteTransactionSyncRequest
+	87,  // 400: accounting.v1.AccountingService.BatchExecuteTransactions:input_type -> accounting.v1.BatchExecuteTransactionsRequest
+	44,  // 401: accounting.v1.AccountingService.GetTransactionStatus:input_type -> accounting.v1.GetTransactionStatusRequest
+	46,  // 402: accounting.v1.AccountingService.GetTransactionByReceipt:input_type -> accounting.v1.GetTransactionByReceiptRequest
+	50,  // 403: accounting.v1.AccountingService.GetJournal:input_type -> accounting.v1.GetJournalRequest
+	52,  // 404: accounting.v1.AccountingService.ListJournals:input_type -> accounting.v1.ListJournalsRequest
+	54,  // 405: accounting.v1.AccountingService.ListLedgersByJournal:input_type -> accounting.v1.ListLedgersByJournalRequest
+	56,  // 406: accounting.v1.AccountingService.ListLedgersByAccount:input_type -> accounting.v1.ListLedgersByAccountRequest
+	59,  // 407: accounting.v1.AccountingService.GetAccountStatement:input_type -> accounting.v1.GetAccountStatementRequest
+	61,  // 408: accounting.v1.AccountingService.ExportAccountStatement:input_type -> accounting.v1.ExportAccountStatementRequest
+	63,  // 409: accounting.v1.AccountingService.GetOwnerStatement:input_type -> accounting.v1.GetOwnerStatementRequest
+	67,  // 410: accounting.v1.AccountingService.GetOwnerSummary:input_type -> accounting.v1.GetOwnerSummaryRequest
+	70,  // 411: accounting.v1.AccountingService.GenerateDailyReport:input_type -> accounting.v1.GenerateDailyReportRequest
+	73,  // 412: accounting.v1.AccountingService.GetTransactionSummary:input_type -> accounting.v1.GetTransactionSummaryRequest
+	75,  // 413: accounting.v1.AccountingService.GetSystemHoldings:input_type -> accounting.v1.GetSystemHoldingsRequest
+	140, // 414: accounting.v1.AccountingService.CloseAccountingPeriod:input_type -> accounting.v1.CloseAccountingPeriodRequest
+	144, // 415: accounting.v1.AccountingService.GetTrialBalance:input_type -> accounting.v1.GetTrialBalanceRequest
+	147, // 416: accounting.v1.AccountingService.GetGeneralLedger:input_type -> accounting.v1.GetGeneralLedgerRequest
+	78,  // 417: accounting.v1.AccountingService.CalculateFee:input_type -> accounting.v1.CalculateFeeRequest
+	81,  // 418: accounting.v1.AccountingService.GetFeesByReceipt:input_type -> accounting.v1.GetFeesByReceiptRequest
+	83,  // 419: accounting.v1.AccountingService.GetAgentCommissionSummary:input_type -> accounting.v1.GetAgentCommissionSummaryRequest
+	91,  // 420: accounting.v1.AccountingService.StreamTransactionEvents:input_type -> accounting.v1.StreamTransactionEventsRequest
+	93,  // 421: accounting.v1.AccountingService.Credit:input_type -> accounting.v1.CreditRequest
+	95,  // 422: accounting.v1.AccountingService.Debit:input_type -> accounting.v1.DebitRequest
+	97,  // 423: accounting.v1.AccountingService.Transfer:input_type -> accounting.v1.TransferRequest
+	99,  // 424: accounting.v1.AccountingService.ConvertAndTransfer:input_type -> accounting.v1.ConversionRequest
+	101, // 425: accounting.v1.AccountingService.CreateFXQuote:input_type -> accounting.v1.CreateFXQuoteRequest
+	104, // 426: accounting.v1.AccountingService.ProcessTradeWin:input_type -> accounting.v1.TradeRequest
+	104, // 427: accounting.v1.AccountingService.ProcessTradeLoss:input_type -> accounting.v1.TradeRequest
+	107, // 428: accounting.v1.AccountingService.SettleTrades:input_type -> accounting.v1.SettleTradesRequest
+	110, // 429: accounting.v1.AccountingService.ProcessAgentCommission:input_type -> accounting.v1.AgentCommissionRequest
+	112, // 430: accounting.v1.AccountingService.ReverseTransaction:input_type -> accounting.v1.ReverseTransactionRequest
+	113, // 431: accounting.v1.AccountingService.RefundTransaction:input_type -> accounting.v1.RefundTransactionRequest
+	116, // 432: accounting.v1.AccountingService.PlaceHold:input_type -> accounting.v1.PlaceHoldRequest
+	118, // 433: accounting.v1.AccountingService.CaptureHold:input_type -> accounting.v1.CaptureHoldRequest
+	120, // 434: accounting.v1.AccountingService.ReleaseHold:input_type -> accounting.v1.ReleaseHoldRequest
+	122, // 435: accounting.v1.AccountingService.ExtendHold:input_type -> accounting.v1.ExtendHoldRequest
+	124, // 436: accounting.v1.AccountingService.ListHolds:input_type -> accounting.v1.ListHoldsRequest
+	128, // 437: accounting.v1.AccountingService.RunReconciliation:input_type -> accounting.v1.RunReconciliationRequest
+	130, // 438: accounting.v1.AccountingService.ListReconciliationBreaks:input_type -> accounting.v1.ListReconciliationBreaksRequest
+	133, // 439: accounting.v1.AccountingService.ListQueuedTransactions:input_type -> accounting.v1.ListQueuedTransactionsRequest
+	135, // 440: accounting.v1.AccountingService.RedriveDeadLetterTransactions:input_type -> accounting.v1.RedriveDeadLetterTransactionsRequest
+	137, // 441: accounting.v1.AccountingService.SkipDeadLetterTransactions:input_type -> accounting.v1.SkipDeadLetterTransactionsRequest
+	151, // 442: accounting.v1.AccountingService.CreateTransactionApproval:input_type -> accounting.v1.CreateTransactionApprovalRequest
+	153, // 443: accounting.v1.AccountingService.GetPendingApprovals:input_type -> accounting.v1.GetPendingApprovalsRequest
+	155, // 444: accounting.v1.AccountingService.ApproveTransaction:input_type -> accounting.v1.ApproveTransactionRequest
+	157, // 445: accounting.v1.AccountingService.GetApprovalHistory:input_type -> accounting.v1.GetApprovalHistoryRequest
+	160, // 446: accounting.v1.AccountingService.CreateApprovalPolicy:input_type -> accounting.v1.CreateApprovalPolicyRequest
+	162, // 447: accounting.v1.AccountingService.UpdateApprovalPolicy:input_type -> accounting.v1.UpdateApprovalPolicyRequest
+	164, // 448: accounting.v1.AccountingService.ListApprovalPolicies:input_type -> accounting.v1.ListApprovalPoliciesRequest
+	85,  // 449: accounting.v1.AccountingService.HealthCheck:input_type -> accounting.v1.HealthCheckRequest
+	168, // 450: accounting.v1.AccountingService.CreateAgent:input_type -> accounting.v1.CreateAgentRequest
+	170, // 451: accounting.v1.AccountingService.UpdateAgent:input_type -> accounting.v1.UpdateAgentRequest
+	172, // 452: accounting.v1.AccountingService.DeleteAgent:input_type -> accounting.v1.DeleteAgentRequest
+	174, // 453: accounting.v1.AccountingService.GetAgentByID:input_type -> accounting.v1.GetAgentByIDRequest
+	176, // 454: accounting.v1.AccountingService.GetAgentByUserID:input_type -> accounting.v1.GetAgentByUserIDRequest
+	178, // 455: accounting.v1.AccountingService.ListAgents:input_type -> accounting.v1.ListAgentsRequest
+	182, // 456: accounting.v1.AccountingService.GetAgentsByCountries:input_type -> accounting.v1.GetAgentsByCountriesRequest
+	184, // 457: accounting.v1.AccountingService.GetAgentStats:input_type -> accounting.v1.GetAgentStatsRequest
+	180, // 458: accounting.v1.AccountingService.ListCommissionsForAgent:input_type -> accounting.v1.ListCommissionsForAgentRequest
+	187, // 459: accounting.v1.AccountingService.CreateCommissionPayoutBatch:input_type -> accounting.v1.CreateCommissionPayoutBatchRequest
+	189, // 460: accounting.v1.AccountingService.ListCommissionPayouts:input_type -> accounting.v1.ListCommissionPayoutsRequest
+	192, // 461: accounting.v1.AccountingService.CreateLimitProfile:input_type -> accounting.v1.CreateLimitProfileRequest
+	194, // 462: accounting.v1.AccountingService.UpdateLimitProfile:input_type -> accounting.v1.UpdateLimitProfileRequest
+	196, // 463: accounting.v1.AccountingService.ListLimitProfiles:input_type -> accounting.v1.ListLimitProfilesRequest
+	198, // 464: accounting.v1.AccountingService.SetOwnerKYCTier:input_type -> accounting.v1.SetOwnerKYCTierRequest
+	201, // 465: accounting.v1.AccountingService.GetAccountLimits:input_type -> accounting.v1.GetAccountLimitsRequest
+	205, // 466: accounting.v1.AccountingService.CreateScheduledTransfer:input_type -> accounting.v1.CreateScheduledTransferRequest
+	207, // 467: accounting.v1.AccountingService.GetScheduledTransfer:input_type -> accounting.v1.GetScheduledTransferRequest
+	209, // 468: accounting.v1.AccountingService.ListScheduledTransfers:input_type -> accounting.v1.ListScheduledTransfersRequest
+	211, // 469: accounting.v1.AccountingService.CancelScheduledTransfer:input_type -> accounting.v1.CancelScheduledTransferRequest
+	217, // 470: accounting.v1.AccountingService.SimulateFeeRules:input_type -> accounting.v1.SimulateFeeRulesRequest
+	215, // 471: accounting.v1.AccountingService.ListFeeRuleVersions:input_type -> accounting.v1.ListFeeRuleVersionsRequest
+	223, // 472: accounting.v1.AccountingService.CreateInterestRatePlan:input_type -> accounting.v1.CreateInterestRatePlanRequest
+	225, // 473: accounting.v1.AccountingService.UpdateInterestRatePlan:input_type -> accounting.v1.UpdateInterestRatePlanRequest
+	227, // 474: accounting.v1.AccountingService.ListInterestRatePlans:input_type -> accounting.v1.ListInterestRatePlansRequest
+	229, // 475: accounting.v1.AccountingService.GetAccountInterest:input_type -> accounting.v1.GetAccountInterestRequest
+	231, // 476: accounting.v1.AccountingService.ListInterestPostings:input_type -> accounting.v1.ListInterestPostingsRequest
+	233, // 477: accounting.v1.AccountingService.RunInterest:input_type -> accounting.v1.RunInterestRequest
+	236, // 478: accounting.v1.AccountingService.PlaceAccountRestriction:input_type -> accounting.v1.PlaceAccountRestrictionRequest
+	238, // 479: accounting.v1.AccountingService.ReleaseAccountRestriction:input_type -> accounting.v1.ReleaseAccountRestrictionRequest
+	242, // 480: accounting.v1.AccountingService.ListAccountRestrictions:input_type -> accounting.v1.ListAccountRestrictionsRequest
+	240, // 481: accounting.v1.AccountingService.CloseAccount:input_type -> accounting.v1.CloseAccountRequest
+	245, // 482: accounting.v1.AccountingService.TopUpDemoAccount:input_type -> accounting.v1.TopUpDemoAccountRequest
+	247, // 483: accounting.v1.AccountingService.ResetDemoAccount:input_type -> accounting.v1.ResetDemoAccountRequest
+	22,  // 484: accounting.v1.AccountingService.CreateAccount:output_type -> accounting.v1.CreateAccountResponse
+	23,  // 485: accounting.v1.AccountingService.CreateAccounts:output_type -> accounting.v1.CreateAccountsResponse
+	25,  // 486: accounting.v1.AccountingService.GetAccount:output_type -> accounting.v1.GetAccountResponse
+	27,  // 487: accounting.v1.AccountingService.GetAccountsByOwner:output_type -> accounting.v1.GetAccountsByOwnerResponse
+	29,  // 488: accounting.v1.AccountingService.GetOrCreateUserAccounts:output_type -> accounting.v1.GetOrCreateUserAccountsResponse
+	31,  // 489: accounting.v1.AccountingService.UpdateAccount:output_type -> accounting.v1.UpdateAccountResponse
+	33,  // 490: accounting.v1.AccountingService.CreateSubAccount:output_type -> accounting.v1.CreateSubAccountResponse
+	36,  // 491: accounting.v1.AccountingService.ListSubAccounts:output_type -> accounting.v1.ListSubAccountsResponse
+	38,  // 492: accounting.v1.AccountingService.GetBalance:output_type -> accounting.v1.GetBalanceResponse
+	90,  // 493: accounting.v1.AccountingService.BatchGetBalances:output_type -> accounting.v1.BatchGetBalancesResponse
+	41,  // 494: accounting.v1.AccountingService.ExecuteTransaction:output_type -> accounting.v1.ExecuteTransactionResponse
+	43,  // 495: accounting.v1.AccountingService.ExecuteTransactionSync:output_type -> accounting.v1.ExecuteTransactionSyncResponse
+	88,  // 496: accounting.v1.AccountingService.BatchExecuteTransactions:output_type -> accounting.v1.BatchExecuteTransactionsResponse
+	45,  // 497: accounting.v1.AccountingService.GetTransactionStatus:output_type -> accounting.v1.GetTransactionStatusResponse
+	47,  // 498: accounting.v1.AccountingService.GetTransactionByReceipt:output_type -> accounting.v1.GetTransactionByReceiptResponse
+	51,  // 499: accounting.v1.AccountingService.GetJournal:output_type -> accounting.v1.GetJournalResponse
+	53,  // 500: accounting.v1.AccountingService.ListJournals:output_type -> accounting.v1.ListJournalsResponse
+	55,  // 501: accounting.v1.AccountingService.ListLedgersByJournal:output_type -> accounting.v1.ListLedgersByJournalResponse
+	57,  // 502: accounting.v1.AccountingService.ListLedgersByAccount:output_type -> accounting.v1.ListLedgersByAccountResponse
+	60,  // 503: accounting.v1.AccountingService.GetAccountStatement:output_type -> accounting.v1.GetAccountStatementResponse
+	62,  // 504: accounting.v1.AccountingService.ExportAccountStatement:output_type -> accounting.v1.ExportAccountStatementChunk
+	64,  // 505: accounting.v1.AccountingService.GetOwnerStatement:output_type -> accounting.v1.GetOwnerStatementResponse
+	68,  // 506: accounting.v1.AccountingService.GetOwnerSummary:output_type -> accounting.v1.GetOwnerSummaryResponse
+	71,  // 507: accounting.v1.AccountingService.GenerateDailyReport:output_type -> accounting.v1.GenerateDailyReportResponse
+	74,  // 508: accounting.v1.AccountingService.GetTransactionSummary:output_type -> accounting.v1.GetTransactionSummaryResponse
+	76,  // 509: accounting.v1.AccountingService.GetSystemHoldings:output_type -> accounting.v1.GetSystemHoldingsResponse
+	141, // 510: accounting.v1.AccountingService.CloseAccountingPeriod:output_type -> accounting.v1.CloseAccountingPeriodResponse
+	145, // 511: accounting.v1.AccountingService.GetTrialBalance:output_type -> accounting.v1.GetTrialBalanceResponse
+	148, // 512: accounting.v1.AccountingService.GetGeneralLedger:output_type -> accounting.v1.GetGeneralLedgerResponse
+	80,  // 513: accounting.v1.AccountingService.CalculateFee:output_type -> accounting.v1.CalculateFeeResponse
+	82,  // 514: accounting.v1.AccountingService.GetFeesByReceipt:output_type -> accounting.v1.GetFeesByReceiptResponse
+	84,  // 515: accounting.v1.AccountingService.GetAgentCommissionSummary:output_type -> accounting.v1.GetAgentCommissionSummaryResponse
+	92,  // 516: accounting.v1.AccountingService.StreamTransactionEvents:output_type -> accounting.v1.TransactionEvent
+	94,  // 517: accounting.v1.AccountingService.Credit:output_type -> accounting.v1.CreditResponse
+	96,  // 518: accounting.v1.AccountingService.Debit:output_type -> accounting.v1.DebitResponse
+	98,  // 519: accounting.v1.AccountingService.Transfer:output_type -> accounting.v1.TransferResponse
+	100, // 520: accounting.v1.AccountingService.ConvertAndTransfer:output_type -> accounting.v1.ConversionResponse
+	103, // 521: accounting.v1.AccountingService.CreateFXQuote:output_type -> accounting.v1.CreateFXQuoteResponse
+	105, // 522: accounting.v1.AccountingService.ProcessTradeWin:output_type -> accounting.v1.TradeResponse
+	105, // 523: accounting.v1.AccountingService.ProcessTradeLoss:output_type -> accounting.v1.TradeResponse
+	109, // 524: accounting.v1.AccountingService.SettleTrades:output_type -> accounting.v1.SettleTradesResponse
+	111, // 525: accounting.v1.AccountingService.ProcessAgentCommission:output_type -> accounting.v1.AgentCommissionResponse
+	114, // 526: accounting.v1.AccountingService.ReverseTransaction:output_type -> accounting.v1.ReversalResponse
+	114, // 527: accounting.v1.AccountingService.RefundTransaction:output_type -> accounting.v1.ReversalResponse
+	117, // 528: accounting.v1.AccountingService.PlaceHold:output_type -> accounting.v1.PlaceHoldResponse
+	119, // 529: accounting.v1.AccountingService.CaptureHold:output_type -> accounting.v1.CaptureHoldResponse
+	121, // 530: accounting.v1.AccountingService.ReleaseHold:output_type -> accounting.v1.ReleaseHoldResponse
+	123, // 531: accounting.v1.AccountingService.ExtendHold:output_type -> accounting.v1.ExtendHoldResponse
+	125, // 532: accounting.v1.AccountingService.ListHolds:output_type -> accounting.v1.ListHoldsResponse
+	129, // 533: accounting.v1.AccountingService.RunReconciliation:output_type -> accounting.v1.RunReconciliationResponse
+	131, // 534: accounting.v1.AccountingService.ListReconciliationBreaks:output_type -> accounting.v1.ListReconciliationBreaksResponse
+	134, // 535: accounting.v1.AccountingService.ListQueuedTransactions:output_type -> accounting.v1.ListQueuedTransactionsResponse
+	136, // 536: accounting.v1.AccountingService.RedriveDeadLetterTransactions:output_type -> accounting.v1.RedriveDeadLetterTransactionsResponse
+	138, // 537: accounting.v1.AccountingService.SkipDeadLetterTransactions:output_type -> accounting.v1.SkipDeadLetterTransactionsResponse
+	152, // 538: accounting.v1.AccountingService.CreateTransactionApproval:output_type -> accounting.v1.CreateTransactionApprovalResponse
+	154, // 539: accounting.v1.AccountingService.GetPendingApprovals:output_type -> accounting.v1.GetPendingApprovalsResponse
+	156, // 540: accounting.v1.AccountingService.ApproveTransaction:output_type -> accounting.v1.ApproveTransactionResponse
+	158, // 541: accounting.v1.AccountingService.GetApprovalHistory:output_type -> accounting.v1.GetApprovalHistoryResponse
+	161, // 542: accounting.v1.AccountingService.CreateApprovalPolicy:output_type -> accounting.v1.CreateApprovalPolicyResponse
+	163, // 543: accounting.v1.AccountingService.UpdateApprovalPolicy:output_type -> accounting.v1.UpdateApprovalPolicyResponse
+	165, // 544: accounting.v1.AccountingService.ListApprovalPolicies:output_type -> accounting.v1.ListApprovalPoliciesResponse
+	86,  // 545: accounting.v1.AccountingService.HealthCheck:output_type -> accounting.v1.HealthCheckResponse
+	169, // 546: accounting.v1.AccountingService.CreateAgent:output_type -> accounting.v1.CreateAgentResponse
+	171, // 547: accounting.v1.AccountingService.UpdateAgent:output_type -> accounting.v1.UpdateAgentResponse
+	173, // 548: accounting.v1.AccountingService.DeleteAgent:output_type -> accounting.v1.DeleteAgentResponse
+	175, // 549: accounting.v1.AccountingService.GetAgentByID:output_type -> accounting.v1.GetAgentByIDResponse
+	177, // 550: accounting.v1.AccountingService.GetAgentByUserID:output_type -> accounting.v1.GetAgentByUserIDResponse
+	179, // 551: accounting.v1.AccountingService.ListAgents:output_type -> accounting.v1.ListAgentsResponse
+	183, // 552: accounting.v1.AccountingService.GetAgentsByCountries:output_type -> accounting.v1.GetAgentsByCountriesResponse
+	185, // 553: accounting.v1.AccountingService.GetAgentStats:output_type -> accounting.v1.GetAgentStatsResponse
+	181, // 554: accounting.v1.AccountingService.ListCommissionsForAgent:output_type -> accounting.v1.ListCommissionsForAgentResponse
+	188, // 555: accounting.v1.AccountingService.CreateCommissionPayoutBatch:output_type -> accounting.v1.CreateCommissionPayoutBatchResponse
+	190, // 556: accounting.v1.AccountingService.ListCommissionPayouts:output_type -> accounting.v1.ListCommissionPayoutsResponse
+	193, // 557: accounting.v1.AccountingService.CreateLimitProfile:output_type -> accounting.v1.CreateLimitProfileResponse
+	195, // 558: accounting.v1.AccountingService.UpdateLimitProfile:output_type -> accounting.v1.UpdateLimitProfileResponse
+	197, // 559: accounting.v1.AccountingService.ListLimitProfiles:output_type -> accounting.v1.ListLimitProfilesResponse
+	199, // 560: accounting.v1.AccountingService.SetOwnerKYCTier:output_type -> accounting.v1.SetOwnerKYCTierResponse
+	202, // 561: accounting.v1.AccountingService.GetAccountLimits:output_type -> accounting.v1.GetAccountLimitsResponse
+	206, // 562: accounting.v1.AccountingService.CreateScheduledTransfer:output_type -> accounting.v1.CreateScheduledTransferResponse
+	208, // 563: accounting.v1.AccountingService.GetScheduledTransfer:output_type -> accounting.v1.GetScheduledTransferResponse
+	210, // 564: accounting.v1.AccountingService.ListScheduledTransfers:output_type -> accounting.v1.ListScheduledTransfersResponse
+	212, // 565: accounting.v1.AccountingService.CancelScheduledTransfer:output_type -> accounting.v1.CancelScheduledTransferResponse
+	219, // 566: accounting.v1.AccountingService.SimulateFeeRules:output_type -> accounting.v1.SimulateFeeRulesResponse
+	216, // 567: accounting.v1.AccountingService.ListFeeRuleVersions:output_type -> accounting.v1.ListFeeRuleVersionsResponse
+	224, // 568: accounting.v1.AccountingService.CreateInterestRatePlan:output_type -> accounting.v1.CreateInterestRatePlanResponse
+	226, // 569: accounting.v1.AccountingService.UpdateInterestRatePlan:output_type -> accounting.v1.UpdateInterestRatePlanResponse
+	228, // 570: accounting.v1.AccountingService.ListInterestRatePlans:output_type -> accounting.v1.ListInterestRatePlansResponse
+	230, // 571: accounting.v1.AccountingService.GetAccountInterest:output_type -> accounting.v1.GetAccountInterestResponse
+	232, // 572: accounting.v1.AccountingService.ListInterestPostings:output_type -> accounting.v1.ListInterestPostingsResponse
+	234, // 573: accounting.v1.AccountingService.RunInterest:output_type -> accounting.v1.RunInterestResponse
+	237, // 574: accounting.v1.AccountingService.PlaceAccountRestriction:output_type -> accounting.v1.PlaceAccountRestrictionResponse
+	239, // 575: accounting.v1.AccountingService.ReleaseAccountRestriction:output_type -> accounting.v1.ReleaseAccountRestrictionResponse
+	243, // 576: accounting.v1.AccountingService.ListAccountRestrictions:output_type -> accounting.v1.ListAccountRestrictionsResponse
+	241, // 577: accounting.v1.AccountingService.CloseAccount:output_type -> accounting.v1.CloseAccountResponse
+	246, // 578: accounting.v1.AccountingService.TopUpDemoAccount:output_type -> accounting.v1.TopUpDemoAccountResponse
+	248, // 579: accounting.v1.AccountingService.ResetDemoAccount:output_type -> accounting.v1.ResetDemoAccountResponse
+	484, // [484:580] is the sub-list for method output_type
+	388, // [388:484] is the sub-list for method input_type
+	388, // [388:388] is the sub-list for extension type_name
+	388, // [388:388] is the sub-list for extension extendee
+	0,   // [0:388] is the sub-list for field type_name
 }
 
 func init() { file_proto_shared_accounting_account_proto_init() }
@@ -10018,53 +21855,108 @@ func file_proto_shared_accounting_account_proto_init() {
 	if File_proto_shared_accounting_account_proto != nil {
 		return
 	}
+	file_proto_shared_accounting_account_proto_msgTypes[0].OneofWrappers = []any{}
 	file_proto_shared_accounting_account_proto_msgTypes[6].OneofWrappers = []any{
 		(*GetAccountRequest_Id)(nil),
 		(*GetAccountRequest_AccountNumber)(nil),
 	}
 	file_proto_shared_accounting_account_proto_msgTypes[12].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[14].OneofWrappers = []any{
+	file_proto_shared_accounting_account_proto_msgTypes[19].OneofWrappers = []any{
 		(*GetBalanceRequest_AccountId)(nil),
 		(*GetBalanceRequest_AccountNumber)(nil),
 	}
-	file_proto_shared_accounting_account_proto_msgTypes[16].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[17].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[19].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[21].OneofWrappers = []any{}
 	file_proto_shared_accounting_account_proto_msgTypes[22].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[26].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[29].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[33].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[52].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[53].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[54].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[66].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[67].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[68].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[70].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[72].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[24].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[27].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[31].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[34].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[38].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[40].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[44].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[48].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[59].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[60].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[61].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[73].OneofWrappers = []any{}
 	file_proto_shared_accounting_account_proto_msgTypes[74].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[76].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[78].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[80].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[75].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[77].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[79].OneofWrappers = []any{}
 	file_proto_shared_accounting_account_proto_msgTypes[81].OneofWrappers = []any{}
 	file_proto_shared_accounting_account_proto_msgTypes[83].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[85].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[84].OneofWrappers = []any{}
 	file_proto_shared_accounting_account_proto_msgTypes[86].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[87].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[89].OneofWrappers = []any{}
 	file_proto_shared_accounting_account_proto_msgTypes[90].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[91].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[93].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[101].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[105].OneofWrappers = []any{}
-	file_proto_shared_accounting_account_proto_msgTypes[107].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[92].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[94].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[95].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[97].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[98].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[100].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[102].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[104].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[106].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[108].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[109].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[110].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[112].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[113].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[114].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[115].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[121].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[122].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[126].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[127].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[128].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[129].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[131].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[132].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[133].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[135].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[137].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[138].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[139].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[141].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[146].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[148].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[149].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[150].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[152].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[160].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[164].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[166].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[168].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[169].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[171].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[173].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[178].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[182].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[184].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[185].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[186].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[187].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[189].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[191].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[193].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[195].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[202].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[203].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[209].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[213].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[217].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[218].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[222].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[224].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[226].OneofWrappers = []any{}
+	file_proto_shared_accounting_account_proto_msgTypes[227].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_shared_accounting_account_proto_rawDesc), len(file_proto_shared_accounting_account_proto_rawDesc)),
-			NumEnums:      10,
-			NumMessages:   123,
+			NumEnums:      18,
+			NumMessages:   246,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,54 +19,102 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AccountingService_CreateAccount_FullMethodName             = "/accounting.v1.AccountingService/CreateAccount"
-	AccountingService_CreateAccounts_FullMethodName            = "/accounting.v1.AccountingService/CreateAccounts"
-	AccountingService_GetAccount_FullMethodName                = "/accounting.v1.AccountingService/GetAccount"
-	AccountingService_GetAccountsByOwner_FullMethodName        = "/accounting.v1.AccountingService/GetAccountsByOwner"
-	AccountingService_GetOrCreateUserAccounts_FullMethodName   = "/accounting.v1.AccountingService/GetOrCreateUserAccounts"
-	AccountingService_UpdateAccount_FullMethodName             = "/accounting.v1.AccountingService/UpdateAccount"
-	AccountingService_GetBalance_FullMethodName                = "/accounting.v1.AccountingService/GetBalance"
-	AccountingService_BatchGetBalances_FullMethodName          = "/accounting.v1.AccountingService/BatchGetBalances"
-	AccountingService_ExecuteTransaction_FullMethodName        = "/accounting.v1.AccountingService/ExecuteTransaction"
-	AccountingService_ExecuteTransactionSync_FullMethodName    = "/accounting.v1.AccountingService/ExecuteTransactionSync"
-	AccountingService_BatchExecuteTransactions_FullMethodName  = "/accounting.v1.AccountingService/BatchExecuteTransactions"
-	AccountingService_GetTransactionStatus_FullMethodName      = "/accounting.v1.AccountingService/GetTransactionStatus"
-	AccountingService_GetTransactionByReceipt_FullMethodName   = "/accounting.v1.AccountingService/GetTransactionByReceipt"
-	AccountingService_GetJournal_FullMethodName                = "/accounting.v1.AccountingService/GetJournal"
-	AccountingService_ListJournals_FullMethodName              = "/accounting.v1.AccountingService/ListJournals"
-	AccountingService_ListLedgersByJournal_FullMethodName      = "/accounting.v1.AccountingService/ListLedgersByJournal"
-	AccountingService_ListLedgersByAccount_FullMethodName      = "/accounting.v1.AccountingService/ListLedgersByAccount"
-	AccountingService_GetAccountStatement_FullMethodName       = "/accounting.v1.AccountingService/GetAccountStatement"
-	AccountingService_GetOwnerStatement_FullMethodName         = "/accounting.v1.AccountingService/GetOwnerStatement"
-	AccountingService_GetOwnerSummary_FullMethodName           = "/accounting.v1.AccountingService/GetOwnerSummary"
-	AccountingService_GenerateDailyReport_FullMethodName       = "/accounting.v1.AccountingService/GenerateDailyReport"
-	AccountingService_GetTransactionSummary_FullMethodName     = "/accounting.v1.AccountingService/GetTransactionSummary"
-	AccountingService_GetSystemHoldings_FullMethodName         = "/accounting.v1.AccountingService/GetSystemHoldings"
-	AccountingService_CalculateFee_FullMethodName              = "/accounting.v1.AccountingService/CalculateFee"
-	AccountingService_GetFeesByReceipt_FullMethodName          = "/accounting.v1.AccountingService/GetFeesByReceipt"
-	AccountingService_GetAgentCommissionSummary_FullMethodName = "/accounting.v1.AccountingService/GetAgentCommissionSummary"
-	AccountingService_StreamTransactionEvents_FullMethodName   = "/accounting.v1.AccountingService/StreamTransactionEvents"
-	AccountingService_Credit_FullMethodName                    = "/accounting.v1.AccountingService/Credit"
-	AccountingService_Debit_FullMethodName                     = "/accounting.v1.AccountingService/Debit"
-	AccountingService_Transfer_FullMethodName                  = "/accounting.v1.AccountingService/Transfer"
-	AccountingService_ConvertAndTransfer_FullMethodName        = "/accounting.v1.AccountingService/ConvertAndTransfer"
-	AccountingService_ProcessTradeWin_FullMethodName           = "/accounting.v1.AccountingService/ProcessTradeWin"
-	AccountingService_ProcessTradeLoss_FullMethodName          = "/accounting.v1.AccountingService/ProcessTradeLoss"
-	AccountingService_ProcessAgentCommission_FullMethodName    = "/accounting.v1.AccountingService/ProcessAgentCommission"
-	AccountingService_CreateTransactionApproval_FullMethodName = "/accounting.v1.AccountingService/CreateTransactionApproval"
-	AccountingService_GetPendingApprovals_FullMethodName       = "/accounting.v1.AccountingService/GetPendingApprovals"
-	AccountingService_ApproveTransaction_FullMethodName        = "/accounting.v1.AccountingService/ApproveTransaction"
-	AccountingService_GetApprovalHistory_FullMethodName        = "/accounting.v1.AccountingService/GetApprovalHistory"
-	AccountingService_HealthCheck_FullMethodName               = "/accounting.v1.AccountingService/HealthCheck"
-	AccountingService_CreateAgent_FullMethodName               = "/accounting.v1.AccountingService/CreateAgent"
-	AccountingService_UpdateAgent_FullMethodName               = "/accounting.v1.AccountingService/UpdateAgent"
-	AccountingService_DeleteAgent_FullMethodName               = "/accounting.v1.AccountingService/DeleteAgent"
-	AccountingService_GetAgentByID_FullMethodName              = "/accounting.v1.AccountingService/GetAgentByID"
-	AccountingService_GetAgentByUserID_FullMethodName          = "/accounting.v1.AccountingService/GetAgentByUserID"
-	AccountingService_ListAgents_FullMethodName                = "/accounting.v1.AccountingService/ListAgents"
-	AccountingService_GetAgentsByCountries_FullMethodName      = "/accounting.v1.AccountingService/GetAgentsByCountries"
-	AccountingService_GetAgentStats_FullMethodName             = "/accounting.v1.AccountingService/GetAgentStats"
-	AccountingService_ListCommissionsForAgent_FullMethodName   = "/accounting.v1.AccountingService/ListCommissionsForAgent"
+	AccountingService_CreateAccount_FullMethodName                 = "/accounting.v1.AccountingService/CreateAccount"
+	AccountingService_CreateAccounts_FullMethodName                = "/accounting.v1.AccountingService/CreateAccounts"
+	AccountingService_GetAccount_FullMethodName                    = "/accounting.v1.AccountingService/GetAccount"
+	AccountingService_GetAccountsByOwner_FullMethodName            = "/accounting.v1.AccountingService/GetAccountsByOwner"
+	AccountingService_GetOrCreateUserAccounts_FullMethodName       = "/accounting.v1.AccountingService/GetOrCreateUserAccounts"
+	AccountingService_UpdateAccount_FullMethodName                 = "/accounting.v1.AccountingService/UpdateAccount"
+	AccountingService_CreateSubAccount_FullMethodName              = "/accounting.v1.AccountingService/CreateSubAccount"
+	AccountingService_ListSubAccounts_FullMethodName               = "/accounting.v1.AccountingService/ListSubAccounts"
+	AccountingService_GetBalance_FullMethodName                    = "/accounting.v1.AccountingService/GetBalance"
+	AccountingService_BatchGetBalances_FullMethodName              = "/accounting.v1.AccountingService/BatchGetBalances"
+	AccountingService_ExecuteTransaction_FullMethodName            = "/accounting.v1.AccountingService/ExecuteTransaction"
+	AccountingService_ExecuteTransactionSync_FullMethodName        = "/accounting.v1.AccountingService/ExecuteTransactionSync"
+	AccountingService_BatchExecuteTransactions_FullMethodName      = "/accounting.v1.AccountingService/BatchExecuteTransactions"
+	AccountingService_GetTransactionStatus_FullMethodName          = "/accounting.v1.AccountingService/GetTransactionStatus"
+	AccountingService_GetTransactionByReceipt_FullMethodName       = "/accounting.v1.AccountingService/GetTransactionByReceipt"
+	AccountingService_GetJournal_FullMethodName                    = "/accounting.v1.AccountingService/GetJournal"
+	AccountingService_ListJournals_FullMethodName                  = "/accounting.v1.AccountingService/ListJournals"
+	AccountingService_ListLedgersByJournal_FullMethodName          = "/accounting.v1.AccountingService/ListLedgersByJournal"
+	AccountingService_ListLedgersByAccount_FullMethodName          = "/accounting.v1.AccountingService/ListLedgersByAccount"
+	AccountingService_GetAccountStatement_FullMethodName           = "/accounting.v1.AccountingService/GetAccountStatement"
+	AccountingService_ExportAccountStatement_FullMethodName        = "/accounting.v1.AccountingService/ExportAccountStatement"
+	AccountingService_GetOwnerStatement_FullMethodName             = "/accounting.v1.AccountingService/GetOwnerStatement"
+	AccountingService_GetOwnerSummary_FullMethodName               = "/accounting.v1.AccountingService/GetOwnerSummary"
+	AccountingService_GenerateDailyReport_FullMethodName           = "/accounting.v1.AccountingService/GenerateDailyReport"
+	AccountingService_GetTransactionSummary_FullMethodName         = "/accounting.v1.AccountingService/GetTransactionSummary"
+	AccountingService_GetSystemHoldings_FullMethodName             = "/accounting.v1.AccountingService/GetSystemHoldings"
+	AccountingService_CloseAccountingPeriod_FullMethodName         = "/accounting.v1.AccountingService/CloseAccountingPeriod"
+	AccountingService_GetTrialBalance_FullMethodName               = "/accounting.v1.AccountingService/GetTrialBalance"
+	AccountingService_GetGeneralLedger_FullMethodName              = "/accounting.v1.AccountingService/GetGeneralLedger"
+	AccountingService_CalculateFee_FullMethodName                  = "/accounting.v1.AccountingService/CalculateFee"
+	AccountingService_GetFeesByReceipt_FullMethodName              = "/accounting.v1.AccountingService/GetFeesByReceipt"
+	AccountingService_GetAgentCommissionSummary_FullMethodName     = "/accounting.v1.AccountingService/GetAgentCommissionSummary"
+	AccountingService_StreamTransactionEvents_FullMethodName       = "/accounting.v1.AccountingService/StreamTransactionEvents"
+	AccountingService_Credit_FullMethodName                        = "/accounting.v1.AccountingService/Credit"
+	AccountingService_Debit_FullMethodName                         = "/accounting.v1.AccountingService/Debit"
+	AccountingService_Transfer_FullMethodName                      = "/accounting.v1.AccountingService/Transfer"
+	AccountingService_ConvertAndTransfer_FullMethodName            = "/accounting.v1.AccountingService/ConvertAndTransfer"
+	AccountingService_CreateFXQuote_FullMethodName                 = "/accounting.v1.AccountingService/CreateFXQuote"
+	AccountingService_ProcessTradeWin_FullMethodName               = "/accounting.v1.AccountingService/ProcessTradeWin"
+	AccountingService_ProcessTradeLoss_FullMethodName              = "/accounting.v1.AccountingService/ProcessTradeLoss"
+	AccountingService_SettleTrades_FullMethodName                  = "/accounting.v1.AccountingService/SettleTrades"
+	AccountingService_ProcessAgentCommission_FullMethodName        = "/accounting.v1.AccountingService/ProcessAgentCommission"
+	AccountingService_ReverseTransaction_FullMethodName            = "/accounting.v1.AccountingService/ReverseTransaction"
+	AccountingService_RefundTransaction_FullMethodName             = "/accounting.v1.AccountingService/RefundTransaction"
+	AccountingService_PlaceHold_FullMethodName                     = "/accounting.v1.AccountingService/PlaceHold"
+	AccountingService_CaptureHold_FullMethodName                   = "/accounting.v1.AccountingService/CaptureHold"
+	AccountingService_ReleaseHold_FullMethodName                   = "/accounting.v1.AccountingService/ReleaseHold"
+	AccountingService_ExtendHold_FullMethodName                    = "/accounting.v1.AccountingService/ExtendHold"
+	AccountingService_ListHolds_FullMethodName                     = "/accounting.v1.AccountingService/ListHolds"
+	AccountingService_RunReconciliation_FullMethodName             = "/accounting.v1.AccountingService/RunReconciliation"
+	AccountingService_ListReconciliationBreaks_FullMethodName      = "/accounting.v1.AccountingService/ListReconciliationBreaks"
+	AccountingService_ListQueuedTransactions_FullMethodName        = "/accounting.v1.AccountingService/ListQueuedTransactions"
+	AccountingService_RedriveDeadLetterTransactions_FullMethodName = "/accounting.v1.AccountingService/RedriveDeadLetterTransactions"
+	AccountingService_SkipDeadLetterTransactions_FullMethodName    = "/accounting.v1.AccountingService/SkipDeadLetterTransactions"
+	AccountingService_CreateTransactionApproval_FullMethodName     = "/accounting.v1.AccountingService/CreateTransactionApproval"
+	AccountingService_GetPendingApprovals_FullMethodName           = "/accounting.v1.AccountingService/GetPendingApprovals"
+	AccountingService_ApproveTransaction_FullMethodName            = "/accounting.v1.AccountingService/ApproveTransaction"
+	AccountingService_GetApprovalHistory_FullMethodName            = "/accounting.v1.AccountingService/GetApprovalHistory"
+	AccountingService_CreateApprovalPolicy_FullMethodName          = "/accounting.v1.AccountingService/CreateApprovalPolicy"
+	AccountingService_UpdateApprovalPolicy_FullMethodName          = "/accounting.v1.AccountingService/UpdateApprovalPolicy"
+	AccountingService_ListApprovalPolicies_FullMethodName          = "/accounting.v1.AccountingService/ListApprovalPolicies"
+	AccountingService_HealthCheck_FullMethodName                   = "/accounting.v1.AccountingService/HealthCheck"
+	AccountingService_CreateAgent_FullMethodName                   = "/accounting.v1.AccountingService/CreateAgent"
+	AccountingService_UpdateAgent_FullMethodName                   = "/accounting.v1.AccountingService/UpdateAgent"
+	AccountingService_DeleteAgent_FullMethodName                   = "/accounting.v1.AccountingService/DeleteAgent"
+	AccountingService_GetAgentByID_FullMethodName                  = "/accounting.v1.AccountingService/GetAgentByID"
+	AccountingService_GetAgentByUserID_FullMethodName              = "/accounting.v1.AccountingService/GetAgentByUserID"
+	AccountingService_ListAgents_FullMethodName                    = "/accounting.v1.AccountingService/ListAgents"
+	AccountingService_GetAgentsByCountries_FullMethodName          = "/accounting.v1.AccountingService/GetAgentsByCountries"
+	AccountingService_GetAgentStats_FullMethodName                 = "/accounting.v1.AccountingService/GetAgentStats"
+	AccountingService_ListCommissionsForAgent_FullMethodName       = "/accounting.v1.AccountingService/ListCommissionsForAgent"
+	AccountingService_CreateCommissionPayoutBatch_FullMethodName   = "/accounting.v1.AccountingService/CreateCommissionPayoutBatch"
+	AccountingService_ListCommissionPayouts_FullMethodName         = "/accounting.v1.AccountingService/ListCommissionPayouts"
+	AccountingService_CreateLimitProfile_FullMethodName            = "/accounting.v1.AccountingService/CreateLimitProfile"
+	AccountingService_UpdateLimitProfile_FullMethodName            = "/accounting.v1.AccountingService/UpdateLimitProfile"
+	AccountingService_ListLimitProfiles_FullMethodName             = "/accounting.v1.AccountingService/ListLimitProfiles"
+	AccountingService_SetOwnerKYCTier_FullMethodName               = "/accounting.v1.AccountingService/SetOwnerKYCTier"
+	AccountingService_GetAccountLimits_FullMethodName              = "/accounting.v1.AccountingService/GetAccountLimits"
+	AccountingService_CreateScheduledTransfer_FullMethodName       = "/accounting.v1.AccountingService/CreateScheduledTransfer"
+	AccountingService_GetScheduledTransfer_FullMethodName          = "/accounting.v1.AccountingService/GetScheduledTransfer"
+	AccountingService_ListScheduledTransfers_FullMethodName        = "/accounting.v1.AccountingService/ListScheduledTransfers"
+	AccountingService_CancelScheduledTransfer_FullMethodName       = "/accounting.v1.AccountingService/CancelScheduledTransfer"
+	AccountingService_SimulateFeeRules_FullMethodName              = "/accounting.v1.AccountingService/SimulateFeeRules"
+	AccountingService_ListFeeRuleVersions_FullMethodName           = "/accounting.v1.AccountingService/ListFeeRuleVersions"
+	AccountingService_CreateInterestRatePlan_FullMethodName        = "/accounting.v1.AccountingService/CreateInterestRatePlan"
+	AccountingService_UpdateInterestRatePlan_FullMethodName        = "/accounting.v1.AccountingService/UpdateInterestRatePlan"
+	AccountingService_ListInterestRatePlans_FullMethodName         = "/accounting.v1.AccountingService/ListInterestRatePlans"
+	AccountingService_GetAccountInterest_FullMethodName            = "/accounting.v1.AccountingService/GetAccountInterest"
+	AccountingService_ListInterestPostings_FullMethodName          = "/accounting.v1.AccountingService/ListInterestPostings"
+	AccountingService_RunInterest_FullMethodName                   = "/accounting.v1.AccountingService/RunInterest"
+	AccountingService_PlaceAccountRestriction_FullMethodName       = "/accounting.v1.AccountingService/PlaceAccountRestriction"
+	AccountingService_ReleaseAccountRestriction_FullMethodName     = "/accounting.v1.AccountingService/ReleaseAccountRestriction"
+	AccountingService_ListAccountRestrictions_FullMethodName       = "/accounting.v1.AccountingService/ListAccountRestrictions"
+	AccountingService_CloseAccount_FullMethodName                  = "/accounting.v1.AccountingService/CloseAccount"
+	AccountingService_TopUpDemoAccount_FullMethodName              = "/accounting.v1.AccountingService/TopUpDemoAccount"
+	AccountingService_ResetDemoAccount_FullMethodName              = "/accounting.v1.AccountingService/ResetDemoAccount"
 )
 
 // AccountingServiceClient is the client API for AccountingService service.
@@ -87,6 +135,10 @@ type AccountingServiceClient interface {
 	GetOrCreateUserAccounts(ctx context.Context, in *GetOrCreateUserAccountsRequest, opts ...grpc.CallOption) (*GetOrCreateUserAccountsResponse, error)
 	// Update account settings
 	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error)
+	// Open a named sub-account under a main account
+	CreateSubAccount(ctx context.Context, in *CreateSubAccountRequest, opts ...grpc.CallOption) (*CreateSubAccountResponse, error)
+	// List a main account's sub-accounts with rolled-up balances
+	ListSubAccounts(ctx context.Context, in *ListSubAccountsRequest, opts ...grpc.CallOption) (*ListSubAccountsResponse, error)
 	// Get account balance
 	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
 	// Batch get balances (optimized)
@@ -111,6 +163,8 @@ type AccountingServiceClient interface {
 	ListLedgersByAccount(ctx context.Context, in *ListLedgersByAccountRequest, opts ...grpc.CallOption) (*ListLedgersByAccountResponse, error)
 	// Get account statement for period
 	GetAccountStatement(ctx context.Context, in *GetAccountStatementRequest, opts ...grpc.CallOption) (*GetAccountStatementResponse, error)
+	// Stream an account statement as CSV or PDF
+	ExportAccountStatement(ctx context.Context, in *ExportAccountStatementRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportAccountStatementChunk], error)
 	// Get all statements for an owner
 	GetOwnerStatement(ctx context.Context, in *GetOwnerStatementRequest, opts ...grpc.CallOption) (*GetOwnerStatementResponse, error)
 	// Get owner summary (consolidated balance)
@@ -121,6 +175,12 @@ type AccountingServiceClient interface {
 	GetTransactionSummary(ctx context.Context, in *GetTransactionSummaryRequest, opts ...grpc.CallOption) (*GetTransactionSummaryResponse, error)
 	// Get system holdings by currency
 	GetSystemHoldings(ctx context.Context, in *GetSystemHoldingsRequest, opts ...grpc.CallOption) (*GetSystemHoldingsResponse, error)
+	// Close an accounting period and snapshot closing balances
+	CloseAccountingPeriod(ctx context.Context, in *CloseAccountingPeriodRequest, opts ...grpc.CallOption) (*CloseAccountingPeriodResponse, error)
+	// Trial balance by purpose and currency (closed period or date range)
+	GetTrialBalance(ctx context.Context, in *GetTrialBalanceRequest, opts ...grpc.CallOption) (*GetTrialBalanceResponse, error)
+	// General ledger postings with per purpose / currency totals
+	GetGeneralLedger(ctx context.Context, in *GetGeneralLedgerRequest, opts ...grpc.CallOption) (*GetGeneralLedgerResponse, error)
 	// Calculate fee for a transaction (preview)
 	CalculateFee(ctx context.Context, in *CalculateFeeRequest, opts ...grpc.CallOption) (*CalculateFeeResponse, error)
 	// Get fees by receipt
@@ -137,12 +197,40 @@ type AccountingServiceClient interface {
 	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
 	// Currency conversion transfer (FEES APPLY)
 	ConvertAndTransfer(ctx context.Context, in *ConversionRequest, opts ...grpc.CallOption) (*ConversionResponse, error)
+	// Lock an FX rate and fee for a later ConvertAndTransfer (quote_id)
+	CreateFXQuote(ctx context.Context, in *CreateFXQuoteRequest, opts ...grpc.CallOption) (*CreateFXQuoteResponse, error)
 	// Process trade win (NO FEES)
 	ProcessTradeWin(ctx context.Context, in *TradeRequest, opts ...grpc.CallOption) (*TradeResponse, error)
 	// Process trade loss (NO FEES)
 	ProcessTradeLoss(ctx context.Context, in *TradeRequest, opts ...grpc.CallOption) (*TradeResponse, error)
+	// Settle many trades at once, netted per account, idempotent per trade ID (NO FEES)
+	SettleTrades(ctx context.Context, in *SettleTradesRequest, opts ...grpc.CallOption) (*SettleTradesResponse, error)
 	// Process agent commission (NO FEES)
 	ProcessAgentCommission(ctx context.Context, in *AgentCommissionRequest, opts ...grpc.CallOption) (*AgentCommissionResponse, error)
+	// Reverse a posted journal in full (mirror-image ledgers, fees and commissions)
+	ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*ReversalResponse, error)
+	// Refund part of a posted journal (pro-rata mirror)
+	RefundTransaction(ctx context.Context, in *RefundTransactionRequest, opts ...grpc.CallOption) (*ReversalResponse, error)
+	// Reserve funds on an account (available -> pending_debit)
+	PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error)
+	// Post a hold in full or in part; any remainder is released
+	CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error)
+	// Return held funds to the available balance
+	ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error)
+	// Move or clear the expiry of an active hold
+	ExtendHold(ctx context.Context, in *ExtendHoldRequest, opts ...grpc.CallOption) (*ExtendHoldResponse, error)
+	// List holds by account / status
+	ListHolds(ctx context.Context, in *ListHoldsRequest, opts ...grpc.CallOption) (*ListHoldsResponse, error)
+	// Recompute balances from ledgers and check double-entry integrity
+	RunReconciliation(ctx context.Context, in *RunReconciliationRequest, opts ...grpc.CallOption) (*RunReconciliationResponse, error)
+	// List discrepancies found by reconciliation runs
+	ListReconciliationBreaks(ctx context.Context, in *ListReconciliationBreaksRequest, opts ...grpc.CallOption) (*ListReconciliationBreaksResponse, error)
+	// Inspect the async processing queue (dead letters by default)
+	ListQueuedTransactions(ctx context.Context, in *ListQueuedTransactionsRequest, opts ...grpc.CallOption) (*ListQueuedTransactionsResponse, error)
+	// Re-queue dead-lettered transactions
+	RedriveDeadLetterTransactions(ctx context.Context, in *RedriveDeadLetterTransactionsRequest, opts ...grpc.CallOption) (*RedriveDeadLetterTransactionsResponse, error)
+	// Give up dead-lettered transactions so later transactions of the same account can run
+	SkipDeadLetterTransactions(ctx context.Context, in *SkipDeadLetterTransactionsRequest, opts ...grpc.CallOption) (*SkipDeadLetterTransactionsResponse, error)
 	// Create transaction approval request (for regular admins)
 	CreateTransactionApproval(ctx context.Context, in *CreateTransactionApprovalRequest, opts ...grpc.CallOption) (*CreateTransactionApprovalResponse, error)
 	// Get pending approvals (for super admins)
@@ -151,6 +239,10 @@ type AccountingServiceClient interface {
 	ApproveTransaction(ctx context.Context, in *ApproveTransactionRequest, opts ...grpc.CallOption) (*ApproveTransactionResponse, error)
 	// Get approval history
 	GetApprovalHistory(ctx context.Context, in *GetApprovalHistoryRequest, opts ...grpc.CallOption) (*GetApprovalHistoryResponse, error)
+	// Approval policies (amount-tiered N-of-M rules)
+	CreateApprovalPolicy(ctx context.Context, in *CreateApprovalPolicyRequest, opts ...grpc.CallOption) (*CreateApprovalPolicyResponse, error)
+	UpdateApprovalPolicy(ctx context.Context, in *UpdateApprovalPolicyRequest, opts ...grpc.CallOption) (*UpdateApprovalPolicyResponse, error)
+	ListApprovalPolicies(ctx context.Context, in *ListApprovalPoliciesRequest, opts ...grpc.CallOption) (*ListApprovalPoliciesResponse, error)
 	// Health check
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 	// Create a new agent
@@ -171,6 +263,47 @@ type AccountingServiceClient interface {
 	GetAgentStats(ctx context.Context, in *GetAgentStatsRequest, opts ...grpc.CallOption) (*GetAgentStatsResponse, error)
 	// List commissions for a specific agent
 	ListCommissionsForAgent(ctx context.Context, in *ListCommissionsForAgentRequest, opts ...grpc.CallOption) (*ListCommissionsForAgentResponse, error)
+	// Pay out unpaid commissions per agent and currency (or preview with dry_run)
+	CreateCommissionPayoutBatch(ctx context.Context, in *CreateCommissionPayoutBatchRequest, opts ...grpc.CallOption) (*CreateCommissionPayoutBatchResponse, error)
+	// List commission payouts by agent / batch / status
+	ListCommissionPayouts(ctx context.Context, in *ListCommissionPayoutsRequest, opts ...grpc.CallOption) (*ListCommissionPayoutsResponse, error)
+	// Limit profiles (per owner type, KYC tier or account)
+	CreateLimitProfile(ctx context.Context, in *CreateLimitProfileRequest, opts ...grpc.CallOption) (*CreateLimitProfileResponse, error)
+	UpdateLimitProfile(ctx context.Context, in *UpdateLimitProfileRequest, opts ...grpc.CallOption) (*UpdateLimitProfileResponse, error)
+	ListLimitProfiles(ctx context.Context, in *ListLimitProfilesRequest, opts ...grpc.CallOption) (*ListLimitProfilesResponse, error)
+	// Set the KYC tier an owner's limit profile is selected by
+	SetOwnerKYCTier(ctx context.Context, in *SetOwnerKYCTierRequest, opts ...grpc.CallOption) (*SetOwnerKYCTierResponse, error)
+	// Effective limits of an account and the allowance left in each window
+	GetAccountLimits(ctx context.Context, in *GetAccountLimitsRequest, opts ...grpc.CallOption) (*GetAccountLimitsResponse, error)
+	// Standing orders and future-dated transfers, paid by a background executor
+	CreateScheduledTransfer(ctx context.Context, in *CreateScheduledTransferRequest, opts ...grpc.CallOption) (*CreateScheduledTransferResponse, error)
+	GetScheduledTransfer(ctx context.Context, in *GetScheduledTransferRequest, opts ...grpc.CallOption) (*GetScheduledTransferResponse, error)
+	ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error)
+	// Stop future occurrences
+	CancelScheduledTransfer(ctx context.Context, in *CancelScheduledTransferRequest, opts ...grpc.CallOption) (*CancelScheduledTransferResponse, error)
+	// Revenue impact of a proposed rule set, replayed over historical journals
+	SimulateFeeRules(ctx context.Context, in *SimulateFeeRulesRequest, opts ...grpc.CallOption) (*SimulateFeeRulesResponse, error)
+	// Immutable version history of a rule
+	ListFeeRuleVersions(ctx context.Context, in *ListFeeRuleVersionsRequest, opts ...grpc.CallOption) (*ListFeeRuleVersionsResponse, error)
+	// Rate plans for savings and investment accounts
+	CreateInterestRatePlan(ctx context.Context, in *CreateInterestRatePlanRequest, opts ...grpc.CallOption) (*CreateInterestRatePlanResponse, error)
+	UpdateInterestRatePlan(ctx context.Context, in *UpdateInterestRatePlanRequest, opts ...grpc.CallOption) (*UpdateInterestRatePlanResponse, error)
+	ListInterestRatePlans(ctx context.Context, in *ListInterestRatePlansRequest, opts ...grpc.CallOption) (*ListInterestRatePlansResponse, error)
+	// Interest accrued vs. paid on an account
+	GetAccountInterest(ctx context.Context, in *GetAccountInterestRequest, opts ...grpc.CallOption) (*GetAccountInterestResponse, error)
+	ListInterestPostings(ctx context.Context, in *ListInterestPostingsRequest, opts ...grpc.CallOption) (*ListInterestPostingsResponse, error)
+	// Run the accrual and posting pass now instead of waiting for the executor
+	RunInterest(ctx context.Context, in *RunInterestRequest, opts ...grpc.CallOption) (*RunInterestResponse, error)
+	// Debit, credit or full freezes and liens, with actor, reason and expiry
+	PlaceAccountRestriction(ctx context.Context, in *PlaceAccountRestrictionRequest, opts ...grpc.CallOption) (*PlaceAccountRestrictionResponse, error)
+	ReleaseAccountRestriction(ctx context.Context, in *ReleaseAccountRestrictionRequest, opts ...grpc.CallOption) (*ReleaseAccountRestrictionResponse, error)
+	ListAccountRestrictions(ctx context.Context, in *ListAccountRestrictionsRequest, opts ...grpc.CallOption) (*ListAccountRestrictionsResponse, error)
+	// Sweep the remaining balance elsewhere and forbid further postings
+	CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error)
+	// Refill a demo wallet (cooldown and balance cap apply)
+	TopUpDemoAccount(ctx context.Context, in *TopUpDemoAccountRequest, opts ...grpc.CallOption) (*TopUpDemoAccountResponse, error)
+	// Return a demo wallet to the default demo balance (cooldown applies)
+	ResetDemoAccount(ctx context.Context, in *ResetDemoAccountRequest, opts ...grpc.CallOption) (*ResetDemoAccountResponse, error)
 }
 
 type accountingServiceClient struct {
@@ -241,6 +374,26 @@ func (c *accountingServiceClient) UpdateAccount(ctx context.Context, in *UpdateA
 	return out, nil
 }
 
+func (c *accountingServiceClient) CreateSubAccount(ctx context.Context, in *CreateSubAccountRequest, opts ...grpc.CallOption) (*CreateSubAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSubAccountResponse)
+	err := c.cc.Invoke(ctx, AccountingService_CreateSubAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) ListSubAccounts(ctx context.Context, in *ListSubAccountsRequest, opts ...grpc.CallOption) (*ListSubAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubAccountsResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ListSubAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountingServiceClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetBalanceResponse)
@@ -361,6 +514,25 @@ func (c *accountingServiceClient) GetAccountStatement(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *accountingServiceClient) ExportAccountStatement(ctx context.Context, in *ExportAccountStatementRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportAccountStatementChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AccountingService_ServiceDesc.Streams[0], AccountingService_ExportAccountStatement_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportAccountStatementRequest, ExportAccountStatementChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AccountingService_ExportAccountStatementClient = grpc.ServerStreamingClient[ExportAccountStatementChunk]
+
 func (c *accountingServiceClient) GetOwnerStatement(ctx context.Context, in *GetOwnerStatementRequest, opts ...grpc.CallOption) (*GetOwnerStatementResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetOwnerStatementResponse)
@@ -411,6 +583,36 @@ func (c *accountingServiceClient) GetSystemHoldings(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *accountingServiceClient) CloseAccountingPeriod(ctx context.Context, in *CloseAccountingPeriodRequest, opts ...grpc.CallOption) (*CloseAccountingPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseAccountingPeriodResponse)
+	err := c.cc.Invoke(ctx, AccountingService_CloseAccountingPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) GetTrialBalance(ctx context.Context, in *GetTrialBalanceRequest, opts ...grpc.CallOption) (*GetTrialBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrialBalanceResponse)
+	err := c.cc.Invoke(ctx, AccountingService_GetTrialBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) GetGeneralLedger(ctx context.Context, in *GetGeneralLedgerRequest, opts ...grpc.CallOption) (*GetGeneralLedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGeneralLedgerResponse)
+	err := c.cc.Invoke(ctx, AccountingService_GetGeneralLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountingServiceClient) CalculateFee(ctx context.Context, in *CalculateFeeRequest, opts ...grpc.CallOption) (*CalculateFeeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CalculateFeeResponse)
@@ -443,7 +645,7 @@ func (c *accountingServiceClient) GetAgentCommissionSummary(ctx context.Context,
 
 func (c *accountingServiceClient) StreamTransactionEvents(ctx context.Context, in *StreamTransactionEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TransactionEvent], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &AccountingService_ServiceDesc.Streams[0], AccountingService_StreamTransactionEvents_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &AccountingService_ServiceDesc.Streams[1], AccountingService_StreamTransactionEvents_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -500,6 +702,16 @@ func (c *accountingServiceClient) ConvertAndTransfer(ctx context.Context, in *Co
 	return out, nil
 }
 
+func (c *accountingServiceClient) CreateFXQuote(ctx context.Context, in *CreateFXQuoteRequest, opts ...grpc.CallOption) (*CreateFXQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFXQuoteResponse)
+	err := c.cc.Invoke(ctx, AccountingService_CreateFXQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountingServiceClient) ProcessTradeWin(ctx context.Context, in *TradeRequest, opts ...grpc.CallOption) (*TradeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TradeResponse)
@@ -520,6 +732,16 @@ func (c *accountingServiceClient) ProcessTradeLoss(ctx context.Context, in *Trad
 	return out, nil
 }
 
+func (c *accountingServiceClient) SettleTrades(ctx context.Context, in *SettleTradesRequest, opts ...grpc.CallOption) (*SettleTradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleTradesResponse)
+	err := c.cc.Invoke(ctx, AccountingService_SettleTrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountingServiceClient) ProcessAgentCommission(ctx context.Context, in *AgentCommissionRequest, opts ...grpc.CallOption) (*AgentCommissionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AgentCommissionResponse)
@@ -530,6 +752,126 @@ func (c *accountingServiceClient) ProcessAgentCommission(ctx context.Context, in
 	return out, nil
 }
 
+func (c *accountingServiceClient) ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*ReversalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReversalResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ReverseTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) RefundTransaction(ctx context.Context, in *RefundTransactionRequest, opts ...grpc.CallOption) (*ReversalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReversalResponse)
+	err := c.cc.Invoke(ctx, AccountingService_RefundTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceHoldResponse)
+	err := c.cc.Invoke(ctx, AccountingService_PlaceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaptureHoldResponse)
+	err := c.cc.Invoke(ctx, AccountingService_CaptureHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseHoldResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ReleaseHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) ExtendHold(ctx context.Context, in *ExtendHoldRequest, opts ...grpc.CallOption) (*ExtendHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendHoldResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ExtendHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) ListHolds(ctx context.Context, in *ListHoldsRequest, opts ...grpc.CallOption) (*ListHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHoldsResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ListHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) RunReconciliation(ctx context.Context, in *RunReconciliationRequest, opts ...grpc.CallOption) (*RunReconciliationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunReconciliationResponse)
+	err := c.cc.Invoke(ctx, AccountingService_RunReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) ListReconciliationBreaks(ctx context.Context, in *ListReconciliationBreaksRequest, opts ...grpc.CallOption) (*ListReconciliationBreaksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReconciliationBreaksResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ListReconciliationBreaks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) ListQueuedTransactions(ctx context.Context, in *ListQueuedTransactionsRequest, opts ...grpc.CallOption) (*ListQueuedTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQueuedTransactionsResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ListQueuedTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) RedriveDeadLetterTransactions(ctx context.Context, in *RedriveDeadLetterTransactionsRequest, opts ...grpc.CallOption) (*RedriveDeadLetterTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedriveDeadLetterTransactionsResponse)
+	err := c.cc.Invoke(ctx, AccountingService_RedriveDeadLetterTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) SkipDeadLetterTransactions(ctx context.Context, in *SkipDeadLetterTransactionsRequest, opts ...grpc.CallOption) (*SkipDeadLetterTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkipDeadLetterTransactionsResponse)
+	err := c.cc.Invoke(ctx, AccountingService_SkipDeadLetterTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountingServiceClient) CreateTransactionApproval(ctx context.Context, in *CreateTransactionApprovalRequest, opts ...grpc.CallOption) (*CreateTransactionApprovalResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTransactionApprovalResponse)
@@ -570,6 +912,36 @@ func (c *accountingServiceClient) GetApprovalHistory(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *accountingServiceClient) CreateApprovalPolicy(ctx context.Context, in *CreateApprovalPolicyRequest, opts ...grpc.CallOption) (*CreateApprovalPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApprovalPolicyResponse)
+	err := c.cc.Invoke(ctx, AccountingService_CreateApprovalPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) UpdateApprovalPolicy(ctx context.Context, in *UpdateApprovalPolicyRequest, opts ...grpc.CallOption) (*UpdateApprovalPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateApprovalPolicyResponse)
+	err := c.cc.Invoke(ctx, AccountingService_UpdateApprovalPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingServiceClient) ListApprovalPolicies(ctx context.Context, in *ListApprovalPoliciesRequest, opts ...grpc.CallOption) (*ListApprovalPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApprovalPoliciesResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ListApprovalPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountingServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthCheckResponse)
@@ -670,232 +1042,636 @@ func (c *accountingServiceClient) ListCommissionsForAgent(ctx context.Context, i
 	return out, nil
 }
 
-// AccountingServiceServer is the server API for AccountingService service.
-// All implementations must embed UnimplementedAccountingServiceServer
-// for forward compatibility.
-//
-// Accounting Service Definition
-type AccountingServiceServer interface {
-	// Create single account
-	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
-	// Create multiple accounts (batch)
-	CreateAccounts(context.Context, *CreateAccountsRequest) (*CreateAccountsResponse, error)
-	// Get account by ID or account number
-	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
-	// Get all accounts for an owner
-	GetAccountsByOwner(context.Context, *GetAccountsByOwnerRequest) (*GetAccountsByOwnerResponse, error)
-	// Get or create user accounts (lazy initialization)
-	GetOrCreateUserAccounts(context.Context, *GetOrCreateUserAccountsRequest) (*GetOrCreateUserAccountsResponse, error)
-	// Update account settings
-	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error)
-	// Get account balance
-	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
-	// Batch get balances (optimized)
-	BatchGetBalances(context.Context, *BatchGetBalancesRequest) (*BatchGetBalancesResponse, error)
-	// Execute transaction asynchronously (returns immediately)
-	ExecuteTransaction(context.Context, *ExecuteTransactionRequest) (*ExecuteTransactionResponse, error)
-	// Execute transaction synchronously (waits for completion)
-	ExecuteTransactionSync(context.Context, *ExecuteTransactionSyncRequest) (*ExecuteTransactionSyncResponse, error)
-	// Batch execute transactions (high throughput)
-	BatchExecuteTransactions(context.Context, *BatchExecuteTransactionsRequest) (*BatchExecuteTransactionsResponse, error)
-	// Get transaction status
-	GetTransactionStatus(context.Context, *GetTransactionStatusRequest) (*GetTransactionStatusResponse, error)
-	// Get full transaction details by receipt
-	GetTransactionByReceipt(context.Context, *GetTransactionByReceiptRequest) (*GetTransactionByReceiptResponse, error)
-	// Get journal by ID
-	GetJournal(context.Context, *GetJournalRequest) (*GetJournalResponse, error)
-	// List journals with filters
-	ListJournals(context.Context, *ListJournalsRequest) (*ListJournalsResponse, error)
-	// List ledgers for a journal
-	ListLedgersByJournal(context.Context, *ListLedgersByJournalRequest) (*ListLedgersByJournalResponse, error)
-	// List ledgers for an account
-	ListLedgersByAccount(context.Context, *ListLedgersByAccountRequest) (*ListLedgersByAccountResponse, error)
-	// Get account statement for period
-	GetAccountStatement(context.Context, *GetAccountStatementRequest) (*GetAccountStatementResponse, error)
-	// Get all statements for an owner
-	GetOwnerStatement(context.Context, *GetOwnerStatementRequest) (*GetOwnerStatementResponse, error)
-	// Get owner summary (consolidated balance)
-	GetOwnerSummary(context.Context, *GetOwnerSummaryRequest) (*GetOwnerSummaryResponse, error)
-	// Generate daily report
-	GenerateDailyReport(context.Context, *GenerateDailyReportRequest) (*GenerateDailyReportResponse, error)
-	// Get transaction summary (statistics)
-	GetTransactionSummary(context.Context, *GetTransactionSummaryRequest) (*GetTransactionSummaryResponse, error)
-	// Get system holdings by currency
-	GetSystemHoldings(context.Context, *GetSystemHoldingsRequest) (*GetSystemHoldingsResponse, error)
-	// Calculate fee for a transaction (preview)
-	CalculateFee(context.Context, *CalculateFeeRequest) (*CalculateFeeResponse, error)
-	// Get fees by receipt
-	GetFeesByReceipt(context.Context, *GetFeesByReceiptRequest) (*GetFeesByReceiptResponse, error)
-	// Get agent commission summary
-	GetAgentCommissionSummary(context.Context, *GetAgentCommissionSummaryRequest) (*GetAgentCommissionSummaryResponse, error)
-	// Stream transaction events (for real-time updates)
-	StreamTransactionEvents(*StreamTransactionEventsRequest, grpc.ServerStreamingServer[TransactionEvent]) error
-	// Credit account (system → user, NO FEES)
-	Credit(context.Context, *CreditRequest) (*CreditResponse, error)
-	// Debit account (user → system, NO FEES)
-	Debit(context.Context, *DebitRequest) (*DebitResponse, error)
-	// Transfer between accounts (P2P, FEES APPLY)
-	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
-	// Currency conversion transfer (FEES APPLY)
-	ConvertAndTransfer(context.Context, *ConversionRequest) (*ConversionResponse, error)
-	// Process trade win (NO FEES)
-	ProcessTradeWin(context.Context, *TradeRequest) (*TradeResponse, error)
-	// Process trade loss (NO FEES)
-	ProcessTradeLoss(context.Context, *TradeRequest) (*TradeResponse, error)
-	// Process agent commission (NO FEES)
-	ProcessAgentCommission(context.Context, *AgentCommissionRequest) (*AgentCommissionResponse, error)
-	// Create transaction approval request (for regular admins)
-	CreateTransactionApproval(context.Context, *CreateTransactionApprovalRequest) (*CreateTransactionApprovalResponse, error)
-	// Get pending approvals (for super admins)
-	GetPendingApprovals(context.Context, *GetPendingApprovalsRequest) (*GetPendingApprovalsResponse, error)
-	// Approve or reject transaction (for super admins)
-	ApproveTransaction(context.Context, *ApproveTransactionRequest) (*ApproveTransactionResponse, error)
-	// Get approval history
-	GetApprovalHistory(context.Context, *GetApprovalHistoryRequest) (*GetApprovalHistoryResponse, error)
-	// Health check
-	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
-	// Create a new agent
-	CreateAgent(context.Context, *CreateAgentRequest) (*CreateAgentResponse, error)
-	// Update agent information
-	UpdateAgent(context.Context, *UpdateAgentRequest) (*UpdateAgentResponse, error)
-	// Delete agent (soft delete recommended)
-	DeleteAgent(context.Context, *DeleteAgentRequest) (*DeleteAgentResponse, error)
-	// Get agent by agent external ID
-	GetAgentByID(context.Context, *GetAgentByIDRequest) (*GetAgentByIDResponse, error)
-	// Get agent by user external ID
-	GetAgentByUserID(context.Context, *GetAgentByUserIDRequest) (*GetAgentByUserIDResponse, error)
-	// List all agents with pagination and filters
-	ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error)
-	// ✅ NEW: Get agents by multiple countries
-	GetAgentsByCountries(context.Context, *GetAgentsByCountriesRequest) (*GetAgentsByCountriesResponse, error)
-	// ✅ NEW: Get agent statistics
-	GetAgentStats(context.Context, *GetAgentStatsRequest) (*GetAgentStatsResponse, error)
-	// List commissions for a specific agent
-	ListCommissionsForAgent(context.Context, *ListCommissionsForAgentRequest) (*ListCommissionsForAgentResponse, error)
-	mustEmbedUnimplementedAccountingServiceServer()
+func (c *accountingServiceClient) CreateCommissionPayoutBatch(ctx context.Context, in *CreateCommissionPayoutBatchRequest, opts ...grpc.CallOption) (*CreateCommissionPayoutBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCommissionPayoutBatchResponse)
+	err := c.cc.Invoke(ctx, AccountingService_CreateCommissionPayoutBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedAccountingServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedAccountingServiceServer struct{}
-
-func (UnimplementedAccountingServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateAccount not implemented")
-}
-func (UnimplementedAccountingServiceServer) CreateAccounts(context.Context, *CreateAccountsRequest) (*CreateAccountsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateAccounts not implemented")
-}
-func (UnimplementedAccountingServiceServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetAccount not implemented")
-}
-func (UnimplementedAccountingServiceServer) GetAccountsByOwner(context.Context, *GetAccountsByOwnerRequest) (*GetAccountsByOwnerResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetAccountsByOwner not implemented")
-}
-func (UnimplementedAccountingServiceServer) GetOrCreateUserAccounts(context.Context, *GetOrCreateUserAccountsRequest) (*GetOrCreateUserAccountsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetOrCreateUserAccounts not implemented")
-}
-func (UnimplementedAccountingServiceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateAccount not implemented")
-}
-func (UnimplementedAccountingServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetBalance not implemented")
-}
-func (UnimplementedAccountingServiceServer) BatchGetBalances(context.Context, *BatchGetBalancesRequest) (*BatchGetBalancesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method BatchGetBalances not implemented")
-}
-func (UnimplementedAccountingServiceServer) ExecuteTransaction(context.Context, *ExecuteTransactionRequest) (*ExecuteTransactionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ExecuteTransaction not implemented")
-}
-func (UnimplementedAccountingServiceServer) ExecuteTransactionSync(context.Context, *ExecuteTransactionSyncRequest) (*ExecuteTransactionSyncResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ExecuteTransactionSync not implemented")
-}
-func (UnimplementedAccountingServiceServer) BatchExecuteTransactions(context.Context, *BatchExecuteTransactionsRequest) (*BatchExecuteTransactionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method BatchExecuteTransactions not implemented")
-}
-func (UnimplementedAccountingServiceServer) GetTransactionStatus(context.Context, *GetTransactionStatusRequest) (*GetTransactionStatusResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTransactionStatus not implemented")
-}
-func (UnimplementedAccountingServiceServer) GetTransactionByReceipt(context.Context, *GetTransactionByReceiptRequest) (*GetTransactionByReceiptResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTransactionByReceipt not implemented")
+func (c *accountingServiceClient) ListCommissionPayouts(ctx context.Context, in *ListCommissionPayoutsRequest, opts ...grpc.CallOption) (*ListCommissionPayoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommissionPayoutsResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ListCommissionPayouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) GetJournal(context.Context, *GetJournalRequest) (*GetJournalResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetJournal not implemented")
+
+func (c *accountingServiceClient) CreateLimitProfile(ctx context.Context, in *CreateLimitProfileRequest, opts ...grpc.CallOption) (*CreateLimitProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLimitProfileResponse)
+	err := c.cc.Invoke(ctx, AccountingService_CreateLimitProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) ListJournals(context.Context, *ListJournalsRequest) (*ListJournalsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListJournals not implemented")
+
+func (c *accountingServiceClient) UpdateLimitProfile(ctx context.Context, in *UpdateLimitProfileRequest, opts ...grpc.CallOption) (*UpdateLimitProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLimitProfileResponse)
+	err := c.cc.Invoke(ctx, AccountingService_UpdateLimitProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) ListLedgersByJournal(context.Context, *ListLedgersByJournalRequest) (*ListLedgersByJournalResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListLedgersByJournal not implemented")
+
+func (c *accountingServiceClient) ListLimitProfiles(ctx context.Context, in *ListLimitProfilesRequest, opts ...grpc.CallOption) (*ListLimitProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLimitProfilesResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ListLimitProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) ListLedgersByAccount(context.Context, *ListLedgersByAccountRequest) (*ListLedgersByAccountResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListLedgersByAccount not implemented")
+
+func (c *accountingServiceClient) SetOwnerKYCTier(ctx context.Context, in *SetOwnerKYCTierRequest, opts ...grpc.CallOption) (*SetOwnerKYCTierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOwnerKYCTierResponse)
+	err := c.cc.Invoke(ctx, AccountingService_SetOwnerKYCTier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) GetAccountStatement(context.Context, *GetAccountStatementRequest) (*GetAccountStatementResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetAccountStatement not implemented")
+
+func (c *accountingServiceClient) GetAccountLimits(ctx context.Context, in *GetAccountLimitsRequest, opts ...grpc.CallOption) (*GetAccountLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountLimitsResponse)
+	err := c.cc.Invoke(ctx, AccountingService_GetAccountLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) GetOwnerStatement(context.Context, *GetOwnerStatementRequest) (*GetOwnerStatementResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetOwnerStatement not implemented")
+
+func (c *accountingServiceClient) CreateScheduledTransfer(ctx context.Context, in *CreateScheduledTransferRequest, opts ...grpc.CallOption) (*CreateScheduledTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, AccountingService_CreateScheduledTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) GetOwnerSummary(context.Context, *GetOwnerSummaryRequest) (*GetOwnerSummaryResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetOwnerSummary not implemented")
+
+func (c *accountingServiceClient) GetScheduledTransfer(ctx context.Context, in *GetScheduledTransferRequest, opts ...grpc.CallOption) (*GetScheduledTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, AccountingService_GetScheduledTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) GenerateDailyReport(context.Context, *GenerateDailyReportRequest) (*GenerateDailyReportResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GenerateDailyReport not implemented")
+
+func (c *accountingServiceClient) ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledTransfersResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ListScheduledTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) GetTransactionSummary(context.Context, *GetTransactionSummaryRequest) (*GetTransactionSummaryResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTransactionSummary not implemented")
+
+func (c *accountingServiceClient) CancelScheduledTransfer(ctx context.Context, in *CancelScheduledTransferRequest, opts ...grpc.CallOption) (*CancelScheduledTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, AccountingService_CancelScheduledTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) GetSystemHoldings(context.Context, *GetSystemHoldingsRequest) (*GetSystemHoldingsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetSystemHoldings not implemented")
+
+func (c *accountingServiceClient) SimulateFeeRules(ctx context.Context, in *SimulateFeeRulesRequest, opts ...grpc.CallOption) (*SimulateFeeRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateFeeRulesResponse)
+	err := c.cc.Invoke(ctx, AccountingService_SimulateFeeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) CalculateFee(context.Context, *CalculateFeeRequest) (*CalculateFeeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CalculateFee not implemented")
+
+func (c *accountingServiceClient) ListFeeRuleVersions(ctx context.Context, in *ListFeeRuleVersionsRequest, opts ...grpc.CallOption) (*ListFeeRuleVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFeeRuleVersionsResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ListFeeRuleVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) GetFeesByReceipt(context.Context, *GetFeesByReceiptRequest) (*GetFeesByReceiptResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetFeesByReceipt not implemented")
+
+func (c *accountingServiceClient) CreateInterestRatePlan(ctx context.Context, in *CreateInterestRatePlanRequest, opts ...grpc.CallOption) (*CreateInterestRatePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInterestRatePlanResponse)
+	err := c.cc.Invoke(ctx, AccountingService_CreateInterestRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) GetAgentCommissionSummary(context.Context, *GetAgentCommissionSummaryRequest) (*GetAgentCommissionSummaryResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetAgentCommissionSummary not implemented")
+
+func (c *accountingServiceClient) UpdateInterestRatePlan(ctx context.Context, in *UpdateInterestRatePlanRequest, opts ...grpc.CallOption) (*UpdateInterestRatePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInterestRatePlanResponse)
+	err := c.cc.Invoke(ctx, AccountingService_UpdateInterestRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) StreamTransactionEvents(*StreamTransactionEventsRequest, grpc.ServerStreamingServer[TransactionEvent]) error {
-	return status.Error(codes.Unimplemented, "method StreamTransactionEvents not implemented")
+
+func (c *accountingServiceClient) ListInterestRatePlans(ctx context.Context, in *ListInterestRatePlansRequest, opts ...grpc.CallOption) (*ListInterestRatePlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInterestRatePlansResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ListInterestRatePlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) Credit(context.Context, *CreditRequest) (*CreditResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Credit not implemented")
+
+func (c *accountingServiceClient) GetAccountInterest(ctx context.Context, in *GetAccountInterestRequest, opts ...grpc.CallOption) (*GetAccountInterestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountInterestResponse)
+	err := c.cc.Invoke(ctx, AccountingService_GetAccountInterest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) Debit(context.Context, *DebitRequest) (*DebitResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Debit not implemented")
+
+func (c *accountingServiceClient) ListInterestPostings(ctx context.Context, in *ListInterestPostingsRequest, opts ...grpc.CallOption) (*ListInterestPostingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInterestPostingsResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ListInterestPostings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Transfer not implemented")
+
+func (c *accountingServiceClient) RunInterest(ctx context.Context, in *RunInterestRequest, opts ...grpc.CallOption) (*RunInterestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunInterestResponse)
+	err := c.cc.Invoke(ctx, AccountingService_RunInterest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) ConvertAndTransfer(context.Context, *ConversionRequest) (*ConversionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ConvertAndTransfer not implemented")
+
+func (c *accountingServiceClient) PlaceAccountRestriction(ctx context.Context, in *PlaceAccountRestrictionRequest, opts ...grpc.CallOption) (*PlaceAccountRestrictionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceAccountRestrictionResponse)
+	err := c.cc.Invoke(ctx, AccountingService_PlaceAccountRestriction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) ProcessTradeWin(context.Context, *TradeRequest) (*TradeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ProcessTradeWin not implemented")
+
+func (c *accountingServiceClient) ReleaseAccountRestriction(ctx context.Context, in *ReleaseAccountRestrictionRequest, opts ...grpc.CallOption) (*ReleaseAccountRestrictionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseAccountRestrictionResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ReleaseAccountRestriction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) ProcessTradeLoss(context.Context, *TradeRequest) (*TradeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ProcessTradeLoss not implemented")
+
+func (c *accountingServiceClient) ListAccountRestrictions(ctx context.Context, in *ListAccountRestrictionsRequest, opts ...grpc.CallOption) (*ListAccountRestrictionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountRestrictionsResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ListAccountRestrictions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) ProcessAgentCommission(context.Context, *AgentCommissionRequest) (*AgentCommissionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ProcessAgentCommission not implemented")
+
+func (c *accountingServiceClient) CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseAccountResponse)
+	err := c.cc.Invoke(ctx, AccountingService_CloseAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) CreateTransactionApproval(context.Context, *CreateTransactionApprovalRequest) (*CreateTransactionApprovalResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateTransactionApproval not implemented")
+
+func (c *accountingServiceClient) TopUpDemoAccount(ctx context.Context, in *TopUpDemoAccountRequest, opts ...grpc.CallOption) (*TopUpDemoAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopUpDemoAccountResponse)
+	err := c.cc.Invoke(ctx, AccountingService_TopUpDemoAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) GetPendingApprovals(context.Context, *GetPendingApprovalsRequest) (*GetPendingApprovalsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetPendingApprovals not implemented")
+
+func (c *accountingServiceClient) ResetDemoAccount(ctx context.Context, in *ResetDemoAccountRequest, opts ...grpc.CallOption) (*ResetDemoAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetDemoAccountResponse)
+	err := c.cc.Invoke(ctx, AccountingService_ResetDemoAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAccountingServiceServer) ApproveTransaction(context.Context, *ApproveTransactionRequest) (*ApproveTransactionResponse, error) {
+
+// AccountingServiceServer is the server API for AccountingService service.
+// All implementations must embed UnimplementedAccountingServiceServer
+// for forward compatibility.
+//
+// Accounting Service Definition
+type AccountingServiceServer interface {
+	// Create single account
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	// Create multiple accounts (batch)
+	CreateAccounts(context.Context, *CreateAccountsRequest) (*CreateAccountsResponse, error)
+	// Get account by ID or account number
+	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	// Get all accounts for an owner
+	GetAccountsByOwner(context.Context, *GetAccountsByOwnerRequest) (*GetAccountsByOwnerResponse, error)
+	// Get or create user accounts (lazy initialization)
+	GetOrCreateUserAccounts(context.Context, *GetOrCreateUserAccountsRequest) (*GetOrCreateUserAccountsResponse, error)
+	// Update account settings
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error)
+	// Open a named sub-account under a main account
+	CreateSubAccount(context.Context, *CreateSubAccountRequest) (*CreateSubAccountResponse, error)
+	// List a main account's sub-accounts with rolled-up balances
+	ListSubAccounts(context.Context, *ListSubAccountsRequest) (*ListSubAccountsResponse, error)
+	// Get account balance
+	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
+	// Batch get balances (optimized)
+	BatchGetBalances(context.Context, *BatchGetBalancesRequest) (*BatchGetBalancesResponse, error)
+	// Execute transaction asynchronously (returns immediately)
+	ExecuteTransaction(context.Context, *ExecuteTransactionRequest) (*ExecuteTransactionResponse, error)
+	// Execute transaction synchronously (waits for completion)
+	ExecuteTransactionSync(context.Context, *ExecuteTransactionSyncRequest) (*ExecuteTransactionSyncResponse, error)
+	// Batch execute transactions (high throughput)
+	BatchExecuteTransactions(context.Context, *BatchExecuteTransactionsRequest) (*BatchExecuteTransactionsResponse, error)
+	// Get transaction status
+	GetTransactionStatus(context.Context, *GetTransactionStatusRequest) (*GetTransactionStatusResponse, error)
+	// Get full transaction details by receipt
+	GetTransactionByReceipt(context.Context, *GetTransactionByReceiptRequest) (*GetTransactionByReceiptResponse, error)
+	// Get journal by ID
+	GetJournal(context.Context, *GetJournalRequest) (*GetJournalResponse, error)
+	// List journals with filters
+	ListJournals(context.Context, *ListJournalsRequest) (*ListJournalsResponse, error)
+	// List ledgers for a journal
+	ListLedgersByJournal(context.Context, *ListLedgersByJournalRequest) (*ListLedgersByJournalResponse, error)
+	// List ledgers for an account
+	ListLedgersByAccount(context.Context, *ListLedgersByAccountRequest) (*ListLedgersByAccountResponse, error)
+	// Get account statement for period
+	GetAccountStatement(context.Context, *GetAccountStatementRequest) (*GetAccountStatementResponse, error)
+	// Stream an account statement as CSV or PDF
+	ExportAccountStatement(*ExportAccountStatementRequest, grpc.ServerStreamingServer[ExportAccountStatementChunk]) error
+	// Get all statements for an owner
+	GetOwnerStatement(context.Context, *GetOwnerStatementRequest) (*GetOwnerStatementResponse, error)
+	// Get owner summary (consolidated balance)
+	GetOwnerSummary(context.Context, *GetOwnerSummaryRequest) (*GetOwnerSummaryResponse, error)
+	// Generate daily report
+	GenerateDailyReport(context.Context, *GenerateDailyReportRequest) (*GenerateDailyReportResponse, error)
+	// Get transaction summary (statistics)
+	GetTransactionSummary(context.Context, *GetTransactionSummaryRequest) (*GetTransactionSummaryResponse, error)
+	// Get system holdings by currency
+	GetSystemHoldings(context.Context, *GetSystemHoldingsRequest) (*GetSystemHoldingsResponse, error)
+	// Close an accounting period and snapshot closing balances
+	CloseAccountingPeriod(context.Context, *CloseAccountingPeriodRequest) (*CloseAccountingPeriodResponse, error)
+	// Trial balance by purpose and currency (closed period or date range)
+	GetTrialBalance(context.Context, *GetTrialBalanceRequest) (*GetTrialBalanceResponse, error)
+	// General ledger postings with per purpose / currency totals
+	GetGeneralLedger(context.Context, *GetGeneralLedgerRequest) (*GetGeneralLedgerResponse, error)
+	// Calculate fee for a transaction (preview)
+	CalculateFee(context.Context, *CalculateFeeRequest) (*CalculateFeeResponse, error)
+	// Get fees by receipt
+	GetFeesByReceipt(context.Context, *GetFeesByReceiptRequest) (*GetFeesByReceiptResponse, error)
+	// Get agent commission summary
+	GetAgentCommissionSummary(context.Context, *GetAgentCommissionSummaryRequest) (*GetAgentCommissionSummaryResponse, error)
+	// Stream transaction events (for real-time updates)
+	StreamTransactionEvents(*StreamTransactionEventsRequest, grpc.ServerStreamingServer[TransactionEvent]) error
+	// Credit account (system → user, NO FEES)
+	Credit(context.Context, *CreditRequest) (*CreditResponse, error)
+	// Debit account (user → system, NO FEES)
+	Debit(context.Context, *DebitRequest) (*DebitResponse, error)
+	// Transfer between accounts (P2P, FEES APPLY)
+	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	// Currency conversion transfer (FEES APPLY)
+	ConvertAndTransfer(context.Context, *ConversionRequest) (*ConversionResponse, error)
+	// Lock an FX rate and fee for a later ConvertAndTransfer (quote_id)
+	CreateFXQuote(context.Context, *CreateFXQuoteRequest) (*CreateFXQuoteResponse, error)
+	// Process trade win (NO FEES)
+	ProcessTradeWin(context.Context, *TradeRequest) (*TradeResponse, error)
+	// Process trade loss (NO FEES)
+	ProcessTradeLoss(context.Context, *TradeRequest) (*TradeResponse, error)
+	// Settle many trades at once, netted per account, idempotent per trade ID (NO FEES)
+	SettleTrades(context.Context, *SettleTradesRequest) (*SettleTradesResponse, error)
+	// Process agent commission (NO FEES)
+	ProcessAgentCommission(context.Context, *AgentCommissionRequest) (*AgentCommissionResponse, error)
+	// Reverse a posted journal in full (mirror-image ledgers, fees and commissions)
+	ReverseTransaction(context.Context, *ReverseTransactionRequest) (*ReversalResponse, error)
+	// Refund part of a posted journal (pro-rata mirror)
+	RefundTransaction(context.Context, *RefundTransactionRequest) (*ReversalResponse, error)
+	// Reserve funds on an account (available -> pending_debit)
+	PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error)
+	// Post a hold in full or in part; any remainder is released
+	CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error)
+	// Return held funds to the available balance
+	ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error)
+	// Move or clear the expiry of an active hold
+	ExtendHold(context.Context, *ExtendHoldRequest) (*ExtendHoldResponse, error)
+	// List holds by account / status
+	ListHolds(context.Context, *ListHoldsRequest) (*ListHoldsResponse, error)
+	// Recompute balances from ledgers and check double-entry integrity
+	RunReconciliation(context.Context, *RunReconciliationRequest) (*RunReconciliationResponse, error)
+	// List discrepancies found by reconciliation runs
+	ListReconciliationBreaks(context.Context, *ListReconciliationBreaksRequest) (*ListReconciliationBreaksResponse, error)
+	// Inspect the async processing queue (dead letters by default)
+	ListQueuedTransactions(context.Context, *ListQueuedTransactionsRequest) (*ListQueuedTransactionsResponse, error)
+	// Re-queue dead-lettered transactions
+	RedriveDeadLetterTransactions(context.Context, *RedriveDeadLetterTransactionsRequest) (*RedriveDeadLetterTransactionsResponse, error)
+	// Give up dead-lettered transactions so later transactions of the same account can run
+	SkipDeadLetterTransactions(context.Context, *SkipDeadLetterTransactionsRequest) (*SkipDeadLetterTransactionsResponse, error)
+	// Create transaction approval request (for regular admins)
+	CreateTransactionApproval(context.Context, *CreateTransactionApprovalRequest) (*CreateTransactionApprovalResponse, error)
+	// Get pending approvals (for super admins)
+	GetPendingApprovals(context.Context, *GetPendingApprovalsRequest) (*GetPendingApprovalsResponse, error)
+	// Approve or reject transaction (for super admins)
+	ApproveTransaction(context.Context, *ApproveTransactionRequest) (*ApproveTransactionResponse, error)
+	// Get approval history
+	GetApprovalHistory(context.Context, *GetApprovalHistoryRequest) (*GetApprovalHistoryResponse, error)
+	// Approval policies (amount-tiered N-of-M rules)
+	CreateApprovalPolicy(context.Context, *CreateApprovalPolicyRequest) (*CreateApprovalPolicyResponse, error)
+	UpdateApprovalPolicy(context.Context, *UpdateApprovalPolicyRequest) (*UpdateApprovalPolicyResponse, error)
+	ListApprovalPolicies(context.Context, *ListApprovalPoliciesRequest) (*ListApprovalPoliciesResponse, error)
+	// Health check
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	// Create a new agent
+	CreateAgent(context.Context, *CreateAgentRequest) (*CreateAgentResponse, error)
+	// Update agent information
+	UpdateAgent(context.Context, *UpdateAgentRequest) (*UpdateAgentResponse, error)
+	// Delete agent (soft delete recommended)
+	DeleteAgent(context.Context, *DeleteAgentRequest) (*DeleteAgentResponse, error)
+	// Get agent by agent external ID
+	GetAgentByID(context.Context, *GetAgentByIDRequest) (*GetAgentByIDResponse, error)
+	// Get agent by user external ID
+	GetAgentByUserID(context.Context, *GetAgentByUserIDRequest) (*GetAgentByUserIDResponse, error)
+	// List all agents with pagination and filters
+	ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error)
+	// ✅ NEW: Get agents by multiple countries
+	GetAgentsByCountries(context.Context, *GetAgentsByCountriesRequest) (*GetAgentsByCountriesResponse, error)
+	// ✅ NEW: Get agent statistics
+	GetAgentStats(context.Context, *GetAgentStatsRequest) (*GetAgentStatsResponse, error)
+	// List commissions for a specific agent
+	ListCommissionsForAgent(context.Context, *ListCommissionsForAgentRequest) (*ListCommissionsForAgentResponse, error)
+	// Pay out unpaid commissions per agent and currency (or preview with dry_run)
+	CreateCommissionPayoutBatch(context.Context, *CreateCommissionPayoutBatchRequest) (*CreateCommissionPayoutBatchResponse, error)
+	// List commission payouts by agent / batch / status
+	ListCommissionPayouts(context.Context, *ListCommissionPayoutsRequest) (*ListCommissionPayoutsResponse, error)
+	// Limit profiles (per owner type, KYC tier or account)
+	CreateLimitProfile(context.Context, *CreateLimitProfileRequest) (*CreateLimitProfileResponse, error)
+	UpdateLimitProfile(context.Context, *UpdateLimitProfileRequest) (*UpdateLimitProfileResponse, error)
+	ListLimitProfiles(context.Context, *ListLimitProfilesRequest) (*ListLimitProfilesResponse, error)
+	// Set the KYC tier an owner's limit profile is selected by
+	SetOwnerKYCTier(context.Context, *SetOwnerKYCTierRequest) (*SetOwnerKYCTierResponse, error)
+	// Effective limits of an account and the allowance left in each window
+	GetAccountLimits(context.Context, *GetAccountLimitsRequest) (*GetAccountLimitsResponse, error)
+	// Standing orders and future-dated transfers, paid by a background executor
+	CreateScheduledTransfer(context.Context, *CreateScheduledTransferRequest) (*CreateScheduledTransferResponse, error)
+	GetScheduledTransfer(context.Context, *GetScheduledTransferRequest) (*GetScheduledTransferResponse, error)
+	ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error)
+	// Stop future occurrences
+	CancelScheduledTransfer(context.Context, *CancelScheduledTransferRequest) (*CancelScheduledTransferResponse, error)
+	// Revenue impact of a proposed rule set, replayed over historical journals
+	SimulateFeeRules(context.Context, *SimulateFeeRulesRequest) (*SimulateFeeRulesResponse, error)
+	// Immutable version history of a rule
+	ListFeeRuleVersions(context.Context, *ListFeeRuleVersionsRequest) (*ListFeeRuleVersionsResponse, error)
+	// Rate plans for savings and investment accounts
+	CreateInterestRatePlan(context.Context, *CreateInterestRatePlanRequest) (*CreateInterestRatePlanResponse, error)
+	UpdateInterestRatePlan(context.Context, *UpdateInterestRatePlanRequest) (*UpdateInterestRatePlanResponse, error)
+	ListInterestRatePlans(context.Context, *ListInterestRatePlansRequest) (*ListInterestRatePlansResponse, error)
+	// Interest accrued vs. paid on an account
+	GetAccountInterest(context.Context, *GetAccountInterestRequest) (*GetAccountInterestResponse, error)
+	ListInterestPostings(context.Context, *ListInterestPostingsRequest) (*ListInterestPostingsResponse, error)
+	// Run the accrual and posting pass now instead of waiting for the executor
+	RunInterest(context.Context, *RunInterestRequest) (*RunInterestResponse, error)
+	// Debit, credit or full freezes and liens, with actor, reason and expiry
+	PlaceAccountRestriction(context.Context, *PlaceAccountRestrictionRequest) (*PlaceAccountRestrictionResponse, error)
+	ReleaseAccountRestriction(context.Context, *ReleaseAccountRestrictionRequest) (*ReleaseAccountRestrictionResponse, error)
+	ListAccountRestrictions(context.Context, *ListAccountRestrictionsRequest) (*ListAccountRestrictionsResponse, error)
+	// Sweep the remaining balance elsewhere and forbid further postings
+	CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error)
+	// Refill a demo wallet (cooldown and balance cap apply)
+	TopUpDemoAccount(context.Context, *TopUpDemoAccountRequest) (*TopUpDemoAccountResponse, error)
+	// Return a demo wallet to the default demo balance (cooldown applies)
+	ResetDemoAccount(context.Context, *ResetDemoAccountRequest) (*ResetDemoAccountResponse, error)
+	mustEmbedUnimplementedAccountingServiceServer()
+}
+
+// UnimplementedAccountingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAccountingServiceServer struct{}
+
+func (UnimplementedAccountingServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedAccountingServiceServer) CreateAccounts(context.Context, *CreateAccountsRequest) (*CreateAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAccounts not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetAccountsByOwner(context.Context, *GetAccountsByOwnerRequest) (*GetAccountsByOwnerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountsByOwner not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetOrCreateUserAccounts(context.Context, *GetOrCreateUserAccountsRequest) (*GetOrCreateUserAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOrCreateUserAccounts not implemented")
+}
+func (UnimplementedAccountingServiceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAccount not implemented")
+}
+func (UnimplementedAccountingServiceServer) CreateSubAccount(context.Context, *CreateSubAccountRequest) (*CreateSubAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSubAccount not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListSubAccounts(context.Context, *ListSubAccountsRequest) (*ListSubAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSubAccounts not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedAccountingServiceServer) BatchGetBalances(context.Context, *BatchGetBalancesRequest) (*BatchGetBalancesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetBalances not implemented")
+}
+func (UnimplementedAccountingServiceServer) ExecuteTransaction(context.Context, *ExecuteTransactionRequest) (*ExecuteTransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExecuteTransaction not implemented")
+}
+func (UnimplementedAccountingServiceServer) ExecuteTransactionSync(context.Context, *ExecuteTransactionSyncRequest) (*ExecuteTransactionSyncResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExecuteTransactionSync not implemented")
+}
+func (UnimplementedAccountingServiceServer) BatchExecuteTransactions(context.Context, *BatchExecuteTransactionsRequest) (*BatchExecuteTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchExecuteTransactions not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetTransactionStatus(context.Context, *GetTransactionStatusRequest) (*GetTransactionStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransactionStatus not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetTransactionByReceipt(context.Context, *GetTransactionByReceiptRequest) (*GetTransactionByReceiptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransactionByReceipt not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetJournal(context.Context, *GetJournalRequest) (*GetJournalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetJournal not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListJournals(context.Context, *ListJournalsRequest) (*ListJournalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJournals not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListLedgersByJournal(context.Context, *ListLedgersByJournalRequest) (*ListLedgersByJournalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLedgersByJournal not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListLedgersByAccount(context.Context, *ListLedgersByAccountRequest) (*ListLedgersByAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLedgersByAccount not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetAccountStatement(context.Context, *GetAccountStatementRequest) (*GetAccountStatementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountStatement not implemented")
+}
+func (UnimplementedAccountingServiceServer) ExportAccountStatement(*ExportAccountStatementRequest, grpc.ServerStreamingServer[ExportAccountStatementChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportAccountStatement not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetOwnerStatement(context.Context, *GetOwnerStatementRequest) (*GetOwnerStatementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOwnerStatement not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetOwnerSummary(context.Context, *GetOwnerSummaryRequest) (*GetOwnerSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOwnerSummary not implemented")
+}
+func (UnimplementedAccountingServiceServer) GenerateDailyReport(context.Context, *GenerateDailyReportRequest) (*GenerateDailyReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateDailyReport not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetTransactionSummary(context.Context, *GetTransactionSummaryRequest) (*GetTransactionSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransactionSummary not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetSystemHoldings(context.Context, *GetSystemHoldingsRequest) (*GetSystemHoldingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSystemHoldings not implemented")
+}
+func (UnimplementedAccountingServiceServer) CloseAccountingPeriod(context.Context, *CloseAccountingPeriodRequest) (*CloseAccountingPeriodResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloseAccountingPeriod not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetTrialBalance(context.Context, *GetTrialBalanceRequest) (*GetTrialBalanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTrialBalance not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetGeneralLedger(context.Context, *GetGeneralLedgerRequest) (*GetGeneralLedgerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGeneralLedger not implemented")
+}
+func (UnimplementedAccountingServiceServer) CalculateFee(context.Context, *CalculateFeeRequest) (*CalculateFeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CalculateFee not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetFeesByReceipt(context.Context, *GetFeesByReceiptRequest) (*GetFeesByReceiptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFeesByReceipt not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetAgentCommissionSummary(context.Context, *GetAgentCommissionSummaryRequest) (*GetAgentCommissionSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAgentCommissionSummary not implemented")
+}
+func (UnimplementedAccountingServiceServer) StreamTransactionEvents(*StreamTransactionEventsRequest, grpc.ServerStreamingServer[TransactionEvent]) error {
+	return status.Error(codes.Unimplemented, "method StreamTransactionEvents not implemented")
+}
+func (UnimplementedAccountingServiceServer) Credit(context.Context, *CreditRequest) (*CreditResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Credit not implemented")
+}
+func (UnimplementedAccountingServiceServer) Debit(context.Context, *DebitRequest) (*DebitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Debit not implemented")
+}
+func (UnimplementedAccountingServiceServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedAccountingServiceServer) ConvertAndTransfer(context.Context, *ConversionRequest) (*ConversionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConvertAndTransfer not implemented")
+}
+func (UnimplementedAccountingServiceServer) CreateFXQuote(context.Context, *CreateFXQuoteRequest) (*CreateFXQuoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFXQuote not implemented")
+}
+func (UnimplementedAccountingServiceServer) ProcessTradeWin(context.Context, *TradeRequest) (*TradeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProcessTradeWin not implemented")
+}
+func (UnimplementedAccountingServiceServer) ProcessTradeLoss(context.Context, *TradeRequest) (*TradeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProcessTradeLoss not implemented")
+}
+func (UnimplementedAccountingServiceServer) SettleTrades(context.Context, *SettleTradesRequest) (*SettleTradesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SettleTrades not implemented")
+}
+func (UnimplementedAccountingServiceServer) ProcessAgentCommission(context.Context, *AgentCommissionRequest) (*AgentCommissionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProcessAgentCommission not implemented")
+}
+func (UnimplementedAccountingServiceServer) ReverseTransaction(context.Context, *ReverseTransactionRequest) (*ReversalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReverseTransaction not implemented")
+}
+func (UnimplementedAccountingServiceServer) RefundTransaction(context.Context, *RefundTransactionRequest) (*ReversalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefundTransaction not implemented")
+}
+func (UnimplementedAccountingServiceServer) PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlaceHold not implemented")
+}
+func (UnimplementedAccountingServiceServer) CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CaptureHold not implemented")
+}
+func (UnimplementedAccountingServiceServer) ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedAccountingServiceServer) ExtendHold(context.Context, *ExtendHoldRequest) (*ExtendHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExtendHold not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListHolds(context.Context, *ListHoldsRequest) (*ListHoldsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListHolds not implemented")
+}
+func (UnimplementedAccountingServiceServer) RunReconciliation(context.Context, *RunReconciliationRequest) (*RunReconciliationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunReconciliation not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListReconciliationBreaks(context.Context, *ListReconciliationBreaksRequest) (*ListReconciliationBreaksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReconciliationBreaks not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListQueuedTransactions(context.Context, *ListQueuedTransactionsRequest) (*ListQueuedTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQueuedTransactions not implemented")
+}
+func (UnimplementedAccountingServiceServer) RedriveDeadLetterTransactions(context.Context, *RedriveDeadLetterTransactionsRequest) (*RedriveDeadLetterTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RedriveDeadLetterTransactions not implemented")
+}
+func (UnimplementedAccountingServiceServer) SkipDeadLetterTransactions(context.Context, *SkipDeadLetterTransactionsRequest) (*SkipDeadLetterTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SkipDeadLetterTransactions not implemented")
+}
+func (UnimplementedAccountingServiceServer) CreateTransactionApproval(context.Context, *CreateTransactionApprovalRequest) (*CreateTransactionApprovalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTransactionApproval not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetPendingApprovals(context.Context, *GetPendingApprovalsRequest) (*GetPendingApprovalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPendingApprovals not implemented")
+}
+func (UnimplementedAccountingServiceServer) ApproveTransaction(context.Context, *ApproveTransactionRequest) (*ApproveTransactionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ApproveTransaction not implemented")
 }
 func (UnimplementedAccountingServiceServer) GetApprovalHistory(context.Context, *GetApprovalHistoryRequest) (*GetApprovalHistoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetApprovalHistory not implemented")
 }
+func (UnimplementedAccountingServiceServer) CreateApprovalPolicy(context.Context, *CreateApprovalPolicyRequest) (*CreateApprovalPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateApprovalPolicy not implemented")
+}
+func (UnimplementedAccountingServiceServer) UpdateApprovalPolicy(context.Context, *UpdateApprovalPolicyRequest) (*UpdateApprovalPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateApprovalPolicy not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListApprovalPolicies(context.Context, *ListApprovalPoliciesRequest) (*ListApprovalPoliciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListApprovalPolicies not implemented")
+}
 func (UnimplementedAccountingServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -914,892 +1690,1824 @@ func (UnimplementedAccountingServiceServer) GetAgentByID(context.Context, *GetAg
 func (UnimplementedAccountingServiceServer) GetAgentByUserID(context.Context, *GetAgentByUserIDRequest) (*GetAgentByUserIDResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAgentByUserID not implemented")
 }
-func (UnimplementedAccountingServiceServer) ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListAgents not implemented")
+func (UnimplementedAccountingServiceServer) ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAgents not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetAgentsByCountries(context.Context, *GetAgentsByCountriesRequest) (*GetAgentsByCountriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAgentsByCountries not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetAgentStats(context.Context, *GetAgentStatsRequest) (*GetAgentStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAgentStats not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListCommissionsForAgent(context.Context, *ListCommissionsForAgentRequest) (*ListCommissionsForAgentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCommissionsForAgent not implemented")
+}
+func (UnimplementedAccountingServiceServer) CreateCommissionPayoutBatch(context.Context, *CreateCommissionPayoutBatchRequest) (*CreateCommissionPayoutBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCommissionPayoutBatch not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListCommissionPayouts(context.Context, *ListCommissionPayoutsRequest) (*ListCommissionPayoutsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCommissionPayouts not implemented")
+}
+func (UnimplementedAccountingServiceServer) CreateLimitProfile(context.Context, *CreateLimitProfileRequest) (*CreateLimitProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateLimitProfile not implemented")
+}
+func (UnimplementedAccountingServiceServer) UpdateLimitProfile(context.Context, *UpdateLimitProfileRequest) (*UpdateLimitProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateLimitProfile not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListLimitProfiles(context.Context, *ListLimitProfilesRequest) (*ListLimitProfilesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLimitProfiles not implemented")
+}
+func (UnimplementedAccountingServiceServer) SetOwnerKYCTier(context.Context, *SetOwnerKYCTierRequest) (*SetOwnerKYCTierResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetOwnerKYCTier not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetAccountLimits(context.Context, *GetAccountLimitsRequest) (*GetAccountLimitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountLimits not implemented")
+}
+func (UnimplementedAccountingServiceServer) CreateScheduledTransfer(context.Context, *CreateScheduledTransferRequest) (*CreateScheduledTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateScheduledTransfer not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetScheduledTransfer(context.Context, *GetScheduledTransferRequest) (*GetScheduledTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetScheduledTransfer not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledTransfers not implemented")
+}
+func (UnimplementedAccountingServiceServer) CancelScheduledTransfer(context.Context, *CancelScheduledTransferRequest) (*CancelScheduledTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledTransfer not implemented")
+}
+func (UnimplementedAccountingServiceServer) SimulateFeeRules(context.Context, *SimulateFeeRulesRequest) (*SimulateFeeRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SimulateFeeRules not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListFeeRuleVersions(context.Context, *ListFeeRuleVersionsRequest) (*ListFeeRuleVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFeeRuleVersions not implemented")
+}
+func (UnimplementedAccountingServiceServer) CreateInterestRatePlan(context.Context, *CreateInterestRatePlanRequest) (*CreateInterestRatePlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInterestRatePlan not implemented")
+}
+func (UnimplementedAccountingServiceServer) UpdateInterestRatePlan(context.Context, *UpdateInterestRatePlanRequest) (*UpdateInterestRatePlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateInterestRatePlan not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListInterestRatePlans(context.Context, *ListInterestRatePlansRequest) (*ListInterestRatePlansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInterestRatePlans not implemented")
+}
+func (UnimplementedAccountingServiceServer) GetAccountInterest(context.Context, *GetAccountInterestRequest) (*GetAccountInterestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountInterest not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListInterestPostings(context.Context, *ListInterestPostingsRequest) (*ListInterestPostingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInterestPostings not implemented")
+}
+func (UnimplementedAccountingServiceServer) RunInterest(context.Context, *RunInterestRequest) (*RunInterestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunInterest not implemented")
+}
+func (UnimplementedAccountingServiceServer) PlaceAccountRestriction(context.Context, *PlaceAccountRestrictionRequest) (*PlaceAccountRestrictionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlaceAccountRestriction not implemented")
+}
+func (UnimplementedAccountingServiceServer) ReleaseAccountRestriction(context.Context, *ReleaseAccountRestrictionRequest) (*ReleaseAccountRestrictionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseAccountRestriction not implemented")
+}
+func (UnimplementedAccountingServiceServer) ListAccountRestrictions(context.Context, *ListAccountRestrictionsRequest) (*ListAccountRestrictionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAccountRestrictions not implemented")
+}
+func (UnimplementedAccountingServiceServer) CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloseAccount not implemented")
+}
+func (UnimplementedAccountingServiceServer) TopUpDemoAccount(context.Context, *TopUpDemoAccountRequest) (*TopUpDemoAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TopUpDemoAccount not implemented")
+}
+func (UnimplementedAccountingServiceServer) ResetDemoAccount(context.Context, *ResetDemoAccountRequest) (*ResetDemoAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetDemoAccount not implemented")
+}
+func (UnimplementedAccountingServiceServer) mustEmbedUnimplementedAccountingServiceServer() {}
+func (UnimplementedAccountingServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAccountingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountingServiceServer will
+// result in compilation errors.
+type UnsafeAccountingServiceServer interface {
+	mustEmbedUnimplementedAccountingServiceServer()
+}
+
+func RegisterAccountingServiceServer(s grpc.ServiceRegistrar, srv AccountingServiceServer) {
+	// If the following call panics, it indicates UnimplementedAccountingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AccountingService_ServiceDesc, srv)
+}
+
+func _AccountingService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_CreateAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).CreateAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_CreateAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).CreateAccounts(ctx, req.(*CreateAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetAccountsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetAccountsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetAccountsByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetAccountsByOwner(ctx, req.(*GetAccountsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetOrCreateUserAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrCreateUserAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetOrCreateUserAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetOrCreateUserAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetOrCreateUserAccounts(ctx, req.(*GetOrCreateUserAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_UpdateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_CreateSubAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).CreateSubAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_CreateSubAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).CreateSubAccount(ctx, req.(*CreateSubAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_ListSubAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ListSubAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ListSubAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ListSubAccounts(ctx, req.(*ListSubAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_BatchGetBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).BatchGetBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_BatchGetBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).BatchGetBalances(ctx, req.(*BatchGetBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_ExecuteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ExecuteTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ExecuteTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ExecuteTransaction(ctx, req.(*ExecuteTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_ExecuteTransactionSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteTransactionSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ExecuteTransactionSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ExecuteTransactionSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ExecuteTransactionSync(ctx, req.(*ExecuteTransactionSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_BatchExecuteTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchExecuteTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).BatchExecuteTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_BatchExecuteTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).BatchExecuteTransactions(ctx, req.(*BatchExecuteTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetTransactionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetTransactionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetTransactionStatus(ctx, req.(*GetTransactionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetTransactionByReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetTransactionByReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetTransactionByReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetTransactionByReceipt(ctx, req.(*GetTransactionByReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJournalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetJournal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetJournal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetJournal(ctx, req.(*GetJournalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_ListJournals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJournalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ListJournals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ListJournals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ListJournals(ctx, req.(*ListJournalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_ListLedgersByJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLedgersByJournalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ListLedgersByJournal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ListLedgersByJournal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ListLedgersByJournal(ctx, req.(*ListLedgersByJournalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_ListLedgersByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLedgersByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ListLedgersByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ListLedgersByAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ListLedgersByAccount(ctx, req.(*ListLedgersByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetAccountStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetAccountStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetAccountStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetAccountStatement(ctx, req.(*GetAccountStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_ExportAccountStatement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAccountStatementRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountingServiceServer).ExportAccountStatement(m, &grpc.GenericServerStream[ExportAccountStatementRequest, ExportAccountStatementChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AccountingService_ExportAccountStatementServer = grpc.ServerStreamingServer[ExportAccountStatementChunk]
+
+func _AccountingService_GetOwnerStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOwnerStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetOwnerStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetOwnerStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetOwnerStatement(ctx, req.(*GetOwnerStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetOwnerSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOwnerSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetOwnerSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetOwnerSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetOwnerSummary(ctx, req.(*GetOwnerSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GenerateDailyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateDailyReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GenerateDailyReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GenerateDailyReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GenerateDailyReport(ctx, req.(*GenerateDailyReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetTransactionSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetTransactionSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetTransactionSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetTransactionSummary(ctx, req.(*GetTransactionSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetSystemHoldings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemHoldingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetSystemHoldings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetSystemHoldings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetSystemHoldings(ctx, req.(*GetSystemHoldingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_CloseAccountingPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAccountingPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).CloseAccountingPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_CloseAccountingPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).CloseAccountingPeriod(ctx, req.(*CloseAccountingPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetTrialBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrialBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetTrialBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetTrialBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetTrialBalance(ctx, req.(*GetTrialBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetGeneralLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGeneralLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetGeneralLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetGeneralLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetGeneralLedger(ctx, req.(*GetGeneralLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_CalculateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).CalculateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_CalculateFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).CalculateFee(ctx, req.(*CalculateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetFeesByReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeesByReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetFeesByReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetFeesByReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetFeesByReceipt(ctx, req.(*GetFeesByReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_GetAgentCommissionSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentCommissionSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).GetAgentCommissionSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_GetAgentCommissionSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).GetAgentCommissionSummary(ctx, req.(*GetAgentCommissionSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_StreamTransactionEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTransactionEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountingServiceServer).StreamTransactionEvents(m, &grpc.GenericServerStream[StreamTransactionEventsRequest, TransactionEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AccountingService_StreamTransactionEventsServer = grpc.ServerStreamingServer[TransactionEvent]
+
+func _AccountingService_Credit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).Credit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_Credit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).Credit(ctx, req.(*CreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_Debit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).Debit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_Debit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).Debit(ctx, req.(*DebitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_ConvertAndTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ConvertAndTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ConvertAndTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ConvertAndTransfer(ctx, req.(*ConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_CreateFXQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFXQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).CreateFXQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_CreateFXQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).CreateFXQuote(ctx, req.(*CreateFXQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_ProcessTradeWin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ProcessTradeWin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ProcessTradeWin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ProcessTradeWin(ctx, req.(*TradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_ProcessTradeLoss_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ProcessTradeLoss(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ProcessTradeLoss_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ProcessTradeLoss(ctx, req.(*TradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_SettleTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).SettleTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_SettleTrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).SettleTrades(ctx, req.(*SettleTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_ProcessAgentCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ProcessAgentCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ProcessAgentCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ProcessAgentCommission(ctx, req.(*AgentCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_ReverseTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ReverseTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ReverseTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ReverseTransaction(ctx, req.(*ReverseTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingService_RefundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).RefundTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_RefundTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).RefundTransaction(ctx, req.(*RefundTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAccountingServiceServer) GetAgentsByCountries(context.Context, *GetAgentsByCountriesRequest) (*GetAgentsByCountriesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetAgentsByCountries not implemented")
+
+func _AccountingService_PlaceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).PlaceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_PlaceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).PlaceHold(ctx, req.(*PlaceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAccountingServiceServer) GetAgentStats(context.Context, *GetAgentStatsRequest) (*GetAgentStatsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetAgentStats not implemented")
+
+func _AccountingService_CaptureHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).CaptureHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_CaptureHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).CaptureHold(ctx, req.(*CaptureHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAccountingServiceServer) ListCommissionsForAgent(context.Context, *ListCommissionsForAgentRequest) (*ListCommissionsForAgentResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListCommissionsForAgent not implemented")
+
+func _AccountingService_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ReleaseHold(ctx, req.(*ReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAccountingServiceServer) mustEmbedUnimplementedAccountingServiceServer() {}
-func (UnimplementedAccountingServiceServer) testEmbeddedByValue()                           {}
 
-// UnsafeAccountingServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AccountingServiceServer will
-// result in compilation errors.
-type UnsafeAccountingServiceServer interface {
-	mustEmbedUnimplementedAccountingServiceServer()
+func _AccountingService_ExtendHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ExtendHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ExtendHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ExtendHold(ctx, req.(*ExtendHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAccountingServiceServer(s grpc.ServiceRegistrar, srv AccountingServiceServer) {
-	// If the following call panics, it indicates UnimplementedAccountingServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _AccountingService_ListHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&AccountingService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(AccountingServiceServer).ListHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingService_ListHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServiceServer).ListHolds(ctx, req.(*ListHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
+func _AccountingService_RunReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunReconciliationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).CreateAccount(ctx, in)
+		return srv.(AccountingServiceServer).RunReconciliation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_CreateAccount_FullMethodName,
+		FullMethod: AccountingService_RunReconciliation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+		return srv.(AccountingServiceServer).RunReconciliation(ctx, req.(*RunReconciliationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_CreateAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountsRequest)
+func _AccountingService_ListReconciliationBreaks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReconciliationBreaksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).CreateAccounts(ctx, in)
+		return srv.(AccountingServiceServer).ListReconciliationBreaks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_CreateAccounts_FullMethodName,
+		FullMethod: AccountingService_ListReconciliationBreaks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).CreateAccounts(ctx, req.(*CreateAccountsRequest))
+		return srv.(AccountingServiceServer).ListReconciliationBreaks(ctx, req.(*ListReconciliationBreaksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountRequest)
+func _AccountingService_ListQueuedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueuedTransactionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetAccount(ctx, in)
+		return srv.(AccountingServiceServer).ListQueuedTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetAccount_FullMethodName,
+		FullMethod: AccountingService_ListQueuedTransactions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+		return srv.(AccountingServiceServer).ListQueuedTransactions(ctx, req.(*ListQueuedTransactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetAccountsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountsByOwnerRequest)
+func _AccountingService_RedriveDeadLetterTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedriveDeadLetterTransactionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetAccountsByOwner(ctx, in)
+		return srv.(AccountingServiceServer).RedriveDeadLetterTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetAccountsByOwner_FullMethodName,
+		FullMethod: AccountingService_RedriveDeadLetterTransactions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetAccountsByOwner(ctx, req.(*GetAccountsByOwnerRequest))
+		return srv.(AccountingServiceServer).RedriveDeadLetterTransactions(ctx, req.(*RedriveDeadLetterTransactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetOrCreateUserAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrCreateUserAccountsRequest)
+func _AccountingService_SkipDeadLetterTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipDeadLetterTransactionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetOrCreateUserAccounts(ctx, in)
+		return srv.(AccountingServiceServer).SkipDeadLetterTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetOrCreateUserAccounts_FullMethodName,
+		FullMethod: AccountingService_SkipDeadLetterTransactions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetOrCreateUserAccounts(ctx, req.(*GetOrCreateUserAccountsRequest))
+		return srv.(AccountingServiceServer).SkipDeadLetterTransactions(ctx, req.(*SkipDeadLetterTransactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAccountRequest)
+func _AccountingService_CreateTransactionApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionApprovalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).UpdateAccount(ctx, in)
+		return srv.(AccountingServiceServer).CreateTransactionApproval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_UpdateAccount_FullMethodName,
+		FullMethod: AccountingService_CreateTransactionApproval_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+		return srv.(AccountingServiceServer).CreateTransactionApproval(ctx, req.(*CreateTransactionApprovalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBalanceRequest)
+func _AccountingService_GetPendingApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingApprovalsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetBalance(ctx, in)
+		return srv.(AccountingServiceServer).GetPendingApprovals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetBalance_FullMethodName,
+		FullMethod: AccountingService_GetPendingApprovals_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+		return srv.(AccountingServiceServer).GetPendingApprovals(ctx, req.(*GetPendingApprovalsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_BatchGetBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchGetBalancesRequest)
+func _AccountingService_ApproveTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).BatchGetBalances(ctx, in)
+		return srv.(AccountingServiceServer).ApproveTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_BatchGetBalances_FullMethodName,
+		FullMethod: AccountingService_ApproveTransaction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).BatchGetBalances(ctx, req.(*BatchGetBalancesRequest))
+		return srv.(AccountingServiceServer).ApproveTransaction(ctx, req.(*ApproveTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_ExecuteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecuteTransactionRequest)
+func _AccountingService_GetApprovalHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApprovalHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).ExecuteTransaction(ctx, in)
+		return srv.(AccountingServiceServer).GetApprovalHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_ExecuteTransaction_FullMethodName,
+		FullMethod: AccountingService_GetApprovalHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).ExecuteTransaction(ctx, req.(*ExecuteTransactionRequest))
+		return srv.(AccountingServiceServer).GetApprovalHistory(ctx, req.(*GetApprovalHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_ExecuteTransactionSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecuteTransactionSyncRequest)
+func _AccountingService_CreateApprovalPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApprovalPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).ExecuteTransactionSync(ctx, in)
+		return srv.(AccountingServiceServer).CreateApprovalPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_ExecuteTransactionSync_FullMethodName,
+		FullMethod: AccountingService_CreateApprovalPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).ExecuteTransactionSync(ctx, req.(*ExecuteTransactionSyncRequest))
+		return srv.(AccountingServiceServer).CreateApprovalPolicy(ctx, req.(*CreateApprovalPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_BatchExecuteTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchExecuteTransactionsRequest)
+func _AccountingService_UpdateApprovalPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApprovalPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).BatchExecuteTransactions(ctx, in)
+		return srv.(AccountingServiceServer).UpdateApprovalPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_BatchExecuteTransactions_FullMethodName,
+		FullMethod: AccountingService_UpdateApprovalPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).BatchExecuteTransactions(ctx, req.(*BatchExecuteTransactionsRequest))
+		return srv.(AccountingServiceServer).UpdateApprovalPolicy(ctx, req.(*UpdateApprovalPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionStatusRequest)
+func _AccountingService_ListApprovalPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovalPoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetTransactionStatus(ctx, in)
+		return srv.(AccountingServiceServer).ListApprovalPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetTransactionStatus_FullMethodName,
+		FullMethod: AccountingService_ListApprovalPolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetTransactionStatus(ctx, req.(*GetTransactionStatusRequest))
+		return srv.(AccountingServiceServer).ListApprovalPolicies(ctx, req.(*ListApprovalPoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetTransactionByReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionByReceiptRequest)
+func _AccountingService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetTransactionByReceipt(ctx, in)
+		return srv.(AccountingServiceServer).HealthCheck(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetTransactionByReceipt_FullMethodName,
+		FullMethod: AccountingService_HealthCheck_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetTransactionByReceipt(ctx, req.(*GetTransactionByReceiptRequest))
+		return srv.(AccountingServiceServer).HealthCheck(ctx, req.(*HealthCheckRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetJournalRequest)
+func _AccountingService_CreateAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAgentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetJournal(ctx, in)
+		return srv.(AccountingServiceServer).CreateAgent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetJournal_FullMethodName,
+		FullMethod: AccountingService_CreateAgent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetJournal(ctx, req.(*GetJournalRequest))
+		return srv.(AccountingServiceServer).CreateAgent(ctx, req.(*CreateAgentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_ListJournals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListJournalsRequest)
+func _AccountingService_UpdateAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAgentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).ListJournals(ctx, in)
+		return srv.(AccountingServiceServer).UpdateAgent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_ListJournals_FullMethodName,
+		FullMethod: AccountingService_UpdateAgent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).ListJournals(ctx, req.(*ListJournalsRequest))
+		return srv.(AccountingServiceServer).UpdateAgent(ctx, req.(*UpdateAgentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_ListLedgersByJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLedgersByJournalRequest)
+func _AccountingService_DeleteAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAgentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).ListLedgersByJournal(ctx, in)
+		return srv.(AccountingServiceServer).DeleteAgent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_ListLedgersByJournal_FullMethodName,
+		FullMethod: AccountingService_DeleteAgent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).ListLedgersByJournal(ctx, req.(*ListLedgersByJournalRequest))
+		return srv.(AccountingServiceServer).DeleteAgent(ctx, req.(*DeleteAgentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_ListLedgersByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLedgersByAccountRequest)
+func _AccountingService_GetAgentByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).ListLedgersByAccount(ctx, in)
+		return srv.(AccountingServiceServer).GetAgentByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_ListLedgersByAccount_FullMethodName,
+		FullMethod: AccountingService_GetAgentByID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).ListLedgersByAccount(ctx, req.(*ListLedgersByAccountRequest))
+		return srv.(AccountingServiceServer).GetAgentByID(ctx, req.(*GetAgentByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetAccountStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountStatementRequest)
+func _AccountingService_GetAgentByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentByUserIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetAccountStatement(ctx, in)
+		return srv.(AccountingServiceServer).GetAgentByUserID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetAccountStatement_FullMethodName,
+		FullMethod: AccountingService_GetAgentByUserID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetAccountStatement(ctx, req.(*GetAccountStatementRequest))
+		return srv.(AccountingServiceServer).GetAgentByUserID(ctx, req.(*GetAgentByUserIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetOwnerStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOwnerStatementRequest)
+func _AccountingService_ListAgents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetOwnerStatement(ctx, in)
+		return srv.(AccountingServiceServer).ListAgents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetOwnerStatement_FullMethodName,
+		FullMethod: AccountingService_ListAgents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetOwnerStatement(ctx, req.(*GetOwnerStatementRequest))
+		return srv.(AccountingServiceServer).ListAgents(ctx, req.(*ListAgentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetOwnerSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOwnerSummaryRequest)
+func _AccountingService_GetAgentsByCountries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentsByCountriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetOwnerSummary(ctx, in)
+		return srv.(AccountingServiceServer).GetAgentsByCountries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetOwnerSummary_FullMethodName,
+		FullMethod: AccountingService_GetAgentsByCountries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetOwnerSummary(ctx, req.(*GetOwnerSummaryRequest))
+		return srv.(AccountingServiceServer).GetAgentsByCountries(ctx, req.(*GetAgentsByCountriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GenerateDailyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateDailyReportRequest)
+func _AccountingService_GetAgentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GenerateDailyReport(ctx, in)
+		return srv.(AccountingServiceServer).GetAgentStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GenerateDailyReport_FullMethodName,
+		FullMethod: AccountingService_GetAgentStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GenerateDailyReport(ctx, req.(*GenerateDailyReportRequest))
+		return srv.(AccountingServiceServer).GetAgentStats(ctx, req.(*GetAgentStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetTransactionSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionSummaryRequest)
+func _AccountingService_ListCommissionsForAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommissionsForAgentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetTransactionSummary(ctx, in)
+		return srv.(AccountingServiceServer).ListCommissionsForAgent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetTransactionSummary_FullMethodName,
+		FullMethod: AccountingService_ListCommissionsForAgent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetTransactionSummary(ctx, req.(*GetTransactionSummaryRequest))
+		return srv.(AccountingServiceServer).ListCommissionsForAgent(ctx, req.(*ListCommissionsForAgentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetSystemHoldings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSystemHoldingsRequest)
+func _AccountingService_CreateCommissionPayoutBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommissionPayoutBatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetSystemHoldings(ctx, in)
+		return srv.(AccountingServiceServer).CreateCommissionPayoutBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetSystemHoldings_FullMethodName,
+		FullMethod: AccountingService_CreateCommissionPayoutBatch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetSystemHoldings(ctx, req.(*GetSystemHoldingsRequest))
+		return srv.(AccountingServiceServer).CreateCommissionPayoutBatch(ctx, req.(*CreateCommissionPayoutBatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_CalculateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CalculateFeeRequest)
+func _AccountingService_ListCommissionPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommissionPayoutsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).CalculateFee(ctx, in)
+		return srv.(AccountingServiceServer).ListCommissionPayouts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_CalculateFee_FullMethodName,
+		FullMethod: AccountingService_ListCommissionPayouts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).CalculateFee(ctx, req.(*CalculateFeeRequest))
+		return srv.(AccountingServiceServer).ListCommissionPayouts(ctx, req.(*ListCommissionPayoutsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetFeesByReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFeesByReceiptRequest)
+func _AccountingService_CreateLimitProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLimitProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetFeesByReceipt(ctx, in)
+		return srv.(AccountingServiceServer).CreateLimitProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetFeesByReceipt_FullMethodName,
+		FullMethod: AccountingService_CreateLimitProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetFeesByReceipt(ctx, req.(*GetFeesByReceiptRequest))
+		return srv.(AccountingServiceServer).CreateLimitProfile(ctx, req.(*CreateLimitProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetAgentCommissionSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAgentCommissionSummaryRequest)
+func _AccountingService_UpdateLimitProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLimitProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetAgentCommissionSummary(ctx, in)
+		return srv.(AccountingServiceServer).UpdateLimitProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetAgentCommissionSummary_FullMethodName,
+		FullMethod: AccountingService_UpdateLimitProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetAgentCommissionSummary(ctx, req.(*GetAgentCommissionSummaryRequest))
+		return srv.(AccountingServiceServer).UpdateLimitProfile(ctx, req.(*UpdateLimitProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_StreamTransactionEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamTransactionEventsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(AccountingServiceServer).StreamTransactionEvents(m, &grpc.GenericServerStream[StreamTransactionEventsRequest, TransactionEvent]{ServerStream: stream})
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type AccountingService_StreamTransactionEventsServer = grpc.ServerStreamingServer[TransactionEvent]
-
-func _AccountingService_Credit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreditRequest)
+func _AccountingService_ListLimitProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLimitProfilesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).Credit(ctx, in)
+		return srv.(AccountingServiceServer).ListLimitProfiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_Credit_FullMethodName,
+		FullMethod: AccountingService_ListLimitProfiles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).Credit(ctx, req.(*CreditRequest))
+		return srv.(AccountingServiceServer).ListLimitProfiles(ctx, req.(*ListLimitProfilesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_Debit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DebitRequest)
+func _AccountingService_SetOwnerKYCTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOwnerKYCTierRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).Debit(ctx, in)
+		return srv.(AccountingServiceServer).SetOwnerKYCTier(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_Debit_FullMethodName,
+		FullMethod: AccountingService_SetOwnerKYCTier_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).Debit(ctx, req.(*DebitRequest))
+		return srv.(AccountingServiceServer).SetOwnerKYCTier(ctx, req.(*SetOwnerKYCTierRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferRequest)
+func _AccountingService_GetAccountLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountLimitsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).Transfer(ctx, in)
+		return srv.(AccountingServiceServer).GetAccountLimits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_Transfer_FullMethodName,
+		FullMethod: AccountingService_GetAccountLimits_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).Transfer(ctx, req.(*TransferRequest))
+		return srv.(AccountingServiceServer).GetAccountLimits(ctx, req.(*GetAccountLimitsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_ConvertAndTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConversionRequest)
+func _AccountingService_CreateScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).ConvertAndTransfer(ctx, in)
+		return srv.(AccountingServiceServer).CreateScheduledTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_ConvertAndTransfer_FullMethodName,
+		FullMethod: AccountingService_CreateScheduledTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).ConvertAndTransfer(ctx, req.(*ConversionRequest))
+		return srv.(AccountingServiceServer).CreateScheduledTransfer(ctx, req.(*CreateScheduledTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_ProcessTradeWin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TradeRequest)
+func _AccountingService_GetScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).ProcessTradeWin(ctx, in)
+		return srv.(AccountingServiceServer).GetScheduledTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_ProcessTradeWin_FullMethodName,
+		FullMethod: AccountingService_GetScheduledTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).ProcessTradeWin(ctx, req.(*TradeRequest))
+		return srv.(AccountingServiceServer).GetScheduledTransfer(ctx, req.(*GetScheduledTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_ProcessTradeLoss_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TradeRequest)
+func _AccountingService_ListScheduledTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransfersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).ProcessTradeLoss(ctx, in)
+		return srv.(AccountingServiceServer).ListScheduledTransfers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_ProcessTradeLoss_FullMethodName,
+		FullMethod: AccountingService_ListScheduledTransfers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).ProcessTradeLoss(ctx, req.(*TradeRequest))
+		return srv.(AccountingServiceServer).ListScheduledTransfers(ctx, req.(*ListScheduledTransfersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_ProcessAgentCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AgentCommissionRequest)
+func _AccountingService_CancelScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).ProcessAgentCommission(ctx, in)
+		return srv.(AccountingServiceServer).CancelScheduledTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_ProcessAgentCommission_FullMethodName,
+		FullMethod: AccountingService_CancelScheduledTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).ProcessAgentCommission(ctx, req.(*AgentCommissionRequest))
+		return srv.(AccountingServiceServer).CancelScheduledTransfer(ctx, req.(*CancelScheduledTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_CreateTransactionApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTransactionApprovalRequest)
+func _AccountingService_SimulateFeeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateFeeRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).CreateTransactionApproval(ctx, in)
+		return srv.(AccountingServiceServer).SimulateFeeRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_CreateTransactionApproval_FullMethodName,
+		FullMethod: AccountingService_SimulateFeeRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).CreateTransactionApproval(ctx, req.(*CreateTransactionApprovalRequest))
+		return srv.(AccountingServiceServer).SimulateFeeRules(ctx, req.(*SimulateFeeRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetPendingApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPendingApprovalsRequest)
+func _AccountingService_ListFeeRuleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeeRuleVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetPendingApprovals(ctx, in)
+		return srv.(AccountingServiceServer).ListFeeRuleVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetPendingApprovals_FullMethodName,
+		FullMethod: AccountingService_ListFeeRuleVersions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetPendingApprovals(ctx, req.(*GetPendingApprovalsRequest))
+		return srv.(AccountingServiceServer).ListFeeRuleVersions(ctx, req.(*ListFeeRuleVersionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_ApproveTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApproveTransactionRequest)
+func _AccountingService_CreateInterestRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInterestRatePlanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).ApproveTransaction(ctx, in)
+		return srv.(AccountingServiceServer).CreateInterestRatePlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_ApproveTransaction_FullMethodName,
+		FullMethod: AccountingService_CreateInterestRatePlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).ApproveTransaction(ctx, req.(*ApproveTransactionRequest))
+		return srv.(AccountingServiceServer).CreateInterestRatePlan(ctx, req.(*CreateInterestRatePlanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetApprovalHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApprovalHistoryRequest)
+func _AccountingService_UpdateInterestRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInterestRatePlanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetApprovalHistory(ctx, in)
+		return srv.(AccountingServiceServer).UpdateInterestRatePlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetApprovalHistory_FullMethodName,
+		FullMethod: AccountingService_UpdateInterestRatePlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetApprovalHistory(ctx, req.(*GetApprovalHistoryRequest))
+		return srv.(AccountingServiceServer).UpdateInterestRatePlan(ctx, req.(*UpdateInterestRatePlanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HealthCheckRequest)
+func _AccountingService_ListInterestRatePlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInterestRatePlansRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).HealthCheck(ctx, in)
+		return srv.(AccountingServiceServer).ListInterestRatePlans(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_HealthCheck_FullMethodName,
+		FullMethod: AccountingService_ListInterestRatePlans_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+		return srv.(AccountingServiceServer).ListInterestRatePlans(ctx, req.(*ListInterestRatePlansRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_CreateAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAgentRequest)
+func _AccountingService_GetAccountInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInterestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).CreateAgent(ctx, in)
+		return srv.(AccountingServiceServer).GetAccountInterest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_CreateAgent_FullMethodName,
+		FullMethod: AccountingService_GetAccountInterest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).CreateAgent(ctx, req.(*CreateAgentRequest))
+		return srv.(AccountingServiceServer).GetAccountInterest(ctx, req.(*GetAccountInterestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_UpdateAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAgentRequest)
+func _AccountingService_ListInterestPostings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInterestPostingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).UpdateAgent(ctx, in)
+		return srv.(AccountingServiceServer).ListInterestPostings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_UpdateAgent_FullMethodName,
+		FullMethod: AccountingService_ListInterestPostings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).UpdateAgent(ctx, req.(*UpdateAgentRequest))
+		return srv.(AccountingServiceServer).ListInterestPostings(ctx, req.(*ListInterestPostingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_DeleteAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAgentRequest)
+func _AccountingService_RunInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunInterestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).DeleteAgent(ctx, in)
+		return srv.(AccountingServiceServer).RunInterest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_DeleteAgent_FullMethodName,
+		FullMethod: AccountingService_RunInterest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).DeleteAgent(ctx, req.(*DeleteAgentRequest))
+		return srv.(AccountingServiceServer).RunInterest(ctx, req.(*RunInterestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetAgentByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAgentByIDRequest)
+func _AccountingService_PlaceAccountRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceAccountRestrictionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetAgentByID(ctx, in)
+		return srv.(AccountingServiceServer).PlaceAccountRestriction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetAgentByID_FullMethodName,
+		FullMethod: AccountingService_PlaceAccountRestriction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetAgentByID(ctx, req.(*GetAgentByIDRequest))
+		return srv.(AccountingServiceServer).PlaceAccountRestriction(ctx, req.(*PlaceAccountRestrictionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetAgentByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAgentByUserIDRequest)
+func _AccountingService_ReleaseAccountRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseAccountRestrictionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetAgentByUserID(ctx, in)
+		return srv.(AccountingServiceServer).ReleaseAccountRestriction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetAgentByUserID_FullMethodName,
+		FullMethod: AccountingService_ReleaseAccountRestriction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetAgentByUserID(ctx, req.(*GetAgentByUserIDRequest))
+		return srv.(AccountingServiceServer).ReleaseAccountRestriction(ctx, req.(*ReleaseAccountRestrictionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_ListAgents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAgentsRequest)
+func _AccountingService_ListAccountRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountRestrictionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).ListAgents(ctx, in)
+		return srv.(AccountingServiceServer).ListAccountRestrictions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_ListAgents_FullMethodName,
+		FullMethod: AccountingService_ListAccountRestrictions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).ListAgents(ctx, req.(*ListAgentsRequest))
+		return srv.(AccountingServiceServer).ListAccountRestrictions(ctx, req.(*ListAccountRestrictionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetAgentsByCountries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAgentsByCountriesRequest)
+func _AccountingService_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetAgentsByCountries(ctx, in)
+		return srv.(AccountingServiceServer).CloseAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetAgentsByCountries_FullMethodName,
+		FullMethod: AccountingService_CloseAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetAgentsByCountries(ctx, req.(*GetAgentsByCountriesRequest))
+		return srv.(AccountingServiceServer).CloseAccount(ctx, req.(*CloseAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_GetAgentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAgentStatsRequest)
+func _AccountingService_TopUpDemoAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpDemoAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).GetAgentStats(ctx, in)
+		return srv.(AccountingServiceServer).TopUpDemoAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_GetAgentStats_FullMethodName,
+		FullMethod: AccountingService_TopUpDemoAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).GetAgentStats(ctx, req.(*GetAgentStatsRequest))
+		return srv.(AccountingServiceServer).TopUpDemoAccount(ctx, req.(*TopUpDemoAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountingService_ListCommissionsForAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCommissionsForAgentRequest)
+func _AccountingService_ResetDemoAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetDemoAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountingServiceServer).ListCommissionsForAgent(ctx, in)
+		return srv.(AccountingServiceServer).ResetDemoAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountingService_ListCommissionsForAgent_FullMethodName,
+		FullMethod: AccountingService_ResetDemoAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountingServiceServer).ListCommissionsForAgent(ctx, req.(*ListCommissionsForAgentRequest))
+		return srv.(AccountingServiceServer).ResetDemoAccount(ctx, req.(*ResetDemoAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1835,6 +3543,14 @@ var AccountingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAccount",
 			Handler:    _AccountingService_UpdateAccount_Handler,
 		},
+		{
+			MethodName: "CreateSubAccount",
+			Handler:    _AccountingService_CreateSubAccount_Handler,
+		},
+		{
+			MethodName: "ListSubAccounts",
+			Handler:    _AccountingService_ListSubAccounts_Handler,
+		},
 		{
 			MethodName: "GetBalance",
 			Handler:    _AccountingService_GetBalance_Handler,
@@ -1903,6 +3619,18 @@ var AccountingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSystemHoldings",
 			Handler:    _AccountingService_GetSystemHoldings_Handler,
 		},
+		{
+			MethodName: "CloseAccountingPeriod",
+			Handler:    _AccountingService_CloseAccountingPeriod_Handler,
+		},
+		{
+			MethodName: "GetTrialBalance",
+			Handler:    _AccountingService_GetTrialBalance_Handler,
+		},
+		{
+			MethodName: "GetGeneralLedger",
+			Handler:    _AccountingService_GetGeneralLedger_Handler,
+		},
 		{
 			MethodName: "CalculateFee",
 			Handler:    _AccountingService_CalculateFee_Handler,
@@ -1931,6 +3659,10 @@ var AccountingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertAndTransfer",
 			Handler:    _AccountingService_ConvertAndTransfer_Handler,
 		},
+		{
+			MethodName: "CreateFXQuote",
+			Handler:    _AccountingService_CreateFXQuote_Handler,
+		},
 		{
 			MethodName: "ProcessTradeWin",
 			Handler:    _AccountingService_ProcessTradeWin_Handler,
@@ -1939,10 +3671,62 @@ var AccountingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessTradeLoss",
 			Handler:    _AccountingService_ProcessTradeLoss_Handler,
 		},
+		{
+			MethodName: "SettleTrades",
+			Handler:    _AccountingService_SettleTrades_Handler,
+		},
 		{
 			MethodName: "ProcessAgentCommission",
 			Handler:    _AccountingService_ProcessAgentCommission_Handler,
 		},
+		{
+			MethodName: "ReverseTransaction",
+			Handler:    _AccountingService_ReverseTransaction_Handler,
+		},
+		{
+			MethodName: "RefundTransaction",
+			Handler:    _AccountingService_RefundTransaction_Handler,
+		},
+		{
+			MethodName: "PlaceHold",
+			Handler:    _AccountingService_PlaceHold_Handler,
+		},
+		{
+			MethodName: "CaptureHold",
+			Handler:    _AccountingService_CaptureHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _AccountingService_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "ExtendHold",
+			Handler:    _AccountingService_ExtendHold_Handler,
+		},
+		{
+			MethodName: "ListHolds",
+			Handler:    _AccountingService_ListHolds_Handler,
+		},
+		{
+			MethodName: "RunReconciliation",
+			Handler:    _AccountingService_RunReconciliation_Handler,
+		},
+		{
+			MethodName: "ListReconciliationBreaks",
+			Handler:    _AccountingService_ListReconciliationBreaks_Handler,
+		},
+		{
+			MethodName: "ListQueuedTransactions",
+			Handler:    _AccountingService_ListQueuedTransactions_Handler,
+		},
+		{
+			MethodName: "RedriveDeadLetterTransactions",
+			Handler:    _AccountingService_RedriveDeadLetterTransactions_Handler,
+		},
+		{
+			MethodName: "SkipDeadLetterTransactions",
+			Handler:    _AccountingService_SkipDeadLetterTransactions_Handler,
+		},
 		{
 			MethodName: "CreateTransactionApproval",
 			Handler:    _AccountingService_CreateTransactionApproval_Handler,
@@ -1959,6 +3743,18 @@ var AccountingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetApprovalHistory",
 			Handler:    _AccountingService_GetApprovalHistory_Handler,
 		},
+		{
+			MethodName: "CreateApprovalPolicy",
+			Handler:    _AccountingService_CreateApprovalPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateApprovalPolicy",
+			Handler:    _AccountingService_UpdateApprovalPolicy_Handler,
+		},
+		{
+			MethodName: "ListApprovalPolicies",
+			Handler:    _AccountingService_ListApprovalPolicies_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _AccountingService_HealthCheck_Handler,
@@ -1999,8 +3795,113 @@ var AccountingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCommissionsForAgent",
 			Handler:    _AccountingService_ListCommissionsForAgent_Handler,
 		},
+		{
+			MethodName: "CreateCommissionPayoutBatch",
+			Handler:    _AccountingService_CreateCommissionPayoutBatch_Handler,
+		},
+		{
+			MethodName: "ListCommissionPayouts",
+			Handler:    _AccountingService_ListCommissionPayouts_Handler,
+		},
+		{
+			MethodName: "CreateLimitProfile",
+			Handler:    _AccountingService_CreateLimitProfile_Handler,
+		},
+		{
+			MethodName: "UpdateLimitProfile",
+			Handler:    _AccountingService_UpdateLimitProfile_Handler,
+		},
+		{
+			MethodName: "ListLimitProfiles",
+			Handler:    _AccountingService_ListLimitProfiles_Handler,
+		},
+		{
+			MethodName: "SetOwnerKYCTier",
+			Handler:    _AccountingService_SetOwnerKYCTier_Handler,
+		},
+		{
+			MethodName: "GetAccountLimits",
+			Handler:    _AccountingService_GetAccountLimits_Handler,
+		},
+		{
+			MethodName: "CreateScheduledTransfer",
+			Handler:    _AccountingService_CreateScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "GetScheduledTransfer",
+			Handler:    _AccountingService_GetScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransfers",
+			Handler:    _AccountingService_ListScheduledTransfers_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransfer",
+			Handler:    _AccountingService_CancelScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "SimulateFeeRules",
+			Handler:    _AccountingService_SimulateFeeRules_Handler,
+		},
+		{
+			MethodName: "ListFeeRuleVersions",
+			Handler:    _AccountingService_ListFeeRuleVersions_Handler,
+		},
+		{
+			MethodName: "CreateInterestRatePlan",
+			Handler:    _AccountingService_CreateInterestRatePlan_Handler,
+		},
+		{
+			MethodName: "UpdateInterestRatePlan",
+			Handler:    _AccountingService_UpdateInterestRatePlan_Handler,
+		},
+		{
+			MethodName: "ListInterestRatePlans",
+			Handler:    _AccountingService_ListInterestRatePlans_Handler,
+		},
+		{
+			MethodName: "GetAccountInterest",
+			Handler:    _AccountingService_GetAccountInterest_Handler,
+		},
+		{
+			MethodName: "ListInterestPostings",
+			Handler:    _AccountingService_ListInterestPostings_Handler,
+		},
+		{
+			MethodName: "RunInterest",
+			Handler:    _AccountingService_RunInterest_Handler,
+		},
+		{
+			MethodName: "PlaceAccountRestriction",
+			Handler:    _AccountingService_PlaceAccountRestriction_Handler,
+		},
+		{
+			MethodName: "ReleaseAccountRestriction",
+			Handler:    _AccountingService_ReleaseAccountRestriction_Handler,
+		},
+		{
+			MethodName: "ListAccountRestrictions",
+			Handler:    _AccountingService_ListAccountRestrictions_Handler,
+		},
+		{
+			MethodName: "CloseAccount",
+			Handler:    _AccountingService_CloseAccount_Handler,
+		},
+		{
+			MethodName: "TopUpDemoAccount",
+			Handler:    _AccountingService_TopUpDemoAccount_Handler,
+		},
+		{
+			MethodName: "ResetDemoAccount",
+			Handler:    _AccountingService_ResetDemoAccount_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportAccountStatement",
+			Handler:       _AccountingService_ExportAccountStatement_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "StreamTransactionEvents",
 			Handler:       _AccountingService_StreamTransactionEvents_Handler,
@@ -176,6 +176,51 @@ var (
 	ErrTransactionAlreadyProcessed = errors.New("transaction already processed")
 )
 
+// Reversal errors
+var (
+	ErrTransactionAlreadyReversed = errors.New("transaction already reversed")
+	ErrTransactionNotReversible   = errors.New("transaction cannot be reversed")
+	ErrRefundExceedsRemaining     = errors.New("refund exceeds refundable amount")
+)
+
+// Hold errors
+var (
+	ErrHoldNotFound             = errors.New("hold not found")
+	ErrHoldNotActive            = errors.New("hold is not active")
+	ErrHoldExpired              = errors.New("hold has expired")
+	ErrHoldCaptureExceedsAmount = errors.New("capture exceeds held amount")
+	ErrHoldReferenceConflict    = errors.New("hold reference already used for a different hold")
+)
+
+// Transaction queue errors
+var (
+	ErrQueueLeaseLost = errors.New("queued transaction lease lost to another worker")
+)
+
+// FX rate errors
+var (
+	ErrFXRateNotFound = errors.New("fx rate not found")
+	ErrFXRateStale    = errors.New("fx rate is stale")
+	ErrInvalidFXRate  = errors.New("invalid fx rate")
+
+	ErrFXQuoteNotFound = errors.New("fx quote not found")
+	ErrFXQuoteExpired  = errors.New("fx quote has expired")
+	ErrFXQuoteMismatch = errors.New("conversion does not match fx quote")
+)
+
+// Reconciliation errors
+var (
+	ErrReconciliationInProgress  = errors.New("reconciliation already in progress")
+	ErrReconciliationRunNotFound = errors.New("reconciliation run not found")
+)
+
+// Accounting period errors
+var (
+	ErrAccountingPeriodClosed   = errors.New("accounting period is closed")
+	ErrAccountingPeriodNotFound = errors.New("accounting period not found")
+	ErrInvalidAccountingPeriod  = errors.New("invalid accounting period")
+)
+
 // Ledger errors
 var (
 	ErrLedgerNotFound      = errors.New("ledger not found")
@@ -276,4 +321,57 @@ var (
     ErrApprovalAlreadyProcessed = errors.New("approval already processed")
     ErrApprovalNotFound = errors.New("approval request not found")
     ErrInvalidApprovalStatus = errors.New("invalid approval status")
-)
\ No newline at end of file
+    ErrApprovalExpired = errors.New("approval request has expired")
+    ErrApproverRoleNotAllowed = errors.New("approver role not allowed by approval policy")
+    ErrApprovalAlreadyVoted = errors.New("approver has already voted on this request")
+    ErrApprovalRoleAlreadyCounted = errors.New("an approver with this role has already approved")
+    ErrApprovalPolicyNotFound = errors.New("approval policy not found")
+)
+
+// Transaction limit errors
+var (
+	ErrLimitExceeded        = errors.New("transaction limit exceeded")
+	ErrLimitProfileNotFound = errors.New("limit profile not found")
+)
+
+// Scheduled transfer errors
+var (
+	ErrScheduledTransferNotFound = errors.New("scheduled transfer not found")
+	ErrInvalidSchedule           = errors.New("invalid schedule")
+	ErrScheduleNotActive         = errors.New("scheduled transfer is not active")
+)
+
+// Interest errors
+var (
+	ErrInterestRatePlanNotFound = errors.New("interest rate plan not found")
+	ErrInvalidInterestRatePlan  = errors.New("invalid interest rate plan")
+	ErrInterestRatePlanConflict = errors.New("an active interest rate plan already exists for this currency and purpose")
+)
+
+// Account restriction errors
+var (
+	ErrAccountClosed               = errors.New("account is closed")
+	ErrAccountDebitFrozen          = errors.New("account is frozen for debits")
+	ErrAccountCreditFrozen         = errors.New("account is frozen for credits")
+	ErrAccountRestrictionNotFound  = errors.New("account restriction not found")
+	ErrInvalidAccountRestriction   = errors.New("invalid account restriction")
+	ErrAccountRestrictionNotActive = errors.New("account restriction is not active")
+	ErrAccountNotEmpty             = errors.New("account still holds funds")
+)
+
+// Demo account errors
+var (
+	ErrDemoRealAccountMix  = errors.New("journal cannot mix demo and real accounts")
+	ErrDemoNotSupported    = errors.New("demo accounts are not supported for this currency")
+	ErrDemoAccountNotFound = errors.New("demo account not found")
+	ErrDemoRefillCooldown  = errors.New("demo account refill is cooling down")
+	ErrDemoBalanceCap      = errors.New("demo account balance cap reached")
+)
+
+// Sub-account errors
+var (
+	ErrSubAccountNameTaken    = errors.New("sub-account name already used under this account")
+	ErrSubAccountLimitReached = errors.New("sub-account limit reached")
+	ErrSubAccountNotAllowed   = errors.New("account cannot have sub-accounts")
+	ErrNotAccountOwner        = errors.New("account belongs to a different owner")
+)